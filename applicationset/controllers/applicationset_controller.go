@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -197,6 +198,11 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
 	generatedApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
+	var fileErrors generators.FileErrors
+	errors.As(err, &fileErrors)
+	if updateErr := r.updateFileErrorsStatus(ctx, &applicationSetInfo, fileErrors); updateErr != nil {
+		logCtx.Errorf("unable to set the file errors of the application set status: %v", updateErr)
+	}
 	if err != nil {
 		logCtx.Errorf("unable to generate applications: %v", err)
 		_ = r.setApplicationSetStatusCondition(ctx,
@@ -250,17 +256,17 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	appSyncMap := map[string]bool{}
 
 	if r.EnableProgressiveSyncs {
-		if !progressivesync.IsRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
-			// If an appset was previously syncing with a `RollingSync` strategy but it has switched to the default strategy, clean up the progressive sync application statuses
+		if !progressivesync.IsProgressiveSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
+			// If an appset was previously syncing with a `RollingSync` or `SyncWave` strategy but it has switched to the default strategy, clean up the progressive sync application statuses
 			logCtx.Infof("Removing %v unnecessary AppStatus entries from ApplicationSet %v", len(applicationSetInfo.Status.ApplicationStatus), applicationSetInfo.Name)
 
 			err := r.SetAppSetApplicationStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetApplicationStatus{})
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet application statuses for %v: %w", applicationSetInfo.Name, err)
 			}
-		} else if progressivesync.IsRollingSyncStrategy(&applicationSetInfo) {
+		} else if progressivesync.IsProgressiveSyncStrategy(&applicationSetInfo) {
 			// before starting progressive sync, checks if steps
-			if progressivesync.IsStepsEmpty(&applicationSetInfo) {
+			if progressivesync.IsRollingSyncStrategy(&applicationSetInfo) && progressivesync.IsStepsEmpty(&applicationSetInfo) {
 				_ = r.setApplicationSetStatusCondition(ctx,
 					&applicationSetInfo,
 					[]argov1alpha1.ApplicationSetCondition{
@@ -328,8 +334,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	if r.EnableProgressiveSyncs {
-		// trigger appropriate application syncs if RollingSync strategy is enabled
-		if progressivesync.RollingSyncStrategyEnabled(&applicationSetInfo) {
+		// trigger appropriate application syncs if the RollingSync or SyncWave strategy is enabled
+		if progressivesync.ProgressiveSyncStrategyEnabled(&applicationSetInfo) {
 			validApps = r.ProgressiveSyncManager.SyncDesiredApplications(logCtx, &applicationSetInfo, appSyncMap, validApps)
 		}
 	}
@@ -480,7 +486,7 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 		argov1alpha1.ApplicationSetConditionInvalidRolloutConfig: false,
 	}
 
-	if !progressivesync.IsRollingSyncStrategy(applicationSet) {
+	if !progressivesync.IsProgressiveSyncStrategy(applicationSet) {
 		// Progressing sync is always evaluated so conditions are removed when it is not enabled
 		evaluatedTypes[argov1alpha1.ApplicationSetConditionInvalidRolloutConfig] = true
 		evaluatedTypes[argov1alpha1.ApplicationSetConditionRolloutProgressing] = true
@@ -532,13 +538,13 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 				})
 			}
 		case argov1alpha1.ApplicationSetConditionRolloutProgressing:
-			if !progressivesync.IsRollingSyncStrategy(applicationSet) {
+			if !progressivesync.IsProgressiveSyncStrategy(applicationSet) {
 				// if the condition is a rolling sync and it is disabled, ignore it
 				evaluatedTypes[condition.Type] = false
 			}
 
 		case argov1alpha1.ApplicationSetConditionInvalidRolloutConfig:
-			if !progressivesync.IsRollingSyncStrategy(applicationSet) {
+			if !progressivesync.IsProgressiveSyncStrategy(applicationSet) {
 				evaluatedTypes[condition.Type] = false
 			}
 		}
@@ -730,54 +736,7 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 					found.Operation = generatedApp.Operation
 				}
 
-				preservedAnnotations := make([]string, 0)
-				preservedLabels := make([]string, 0)
-
-				if applicationSet.Spec.PreservedFields != nil {
-					preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
-					preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
-				}
-
-				if len(r.GlobalPreservedAnnotations) > 0 {
-					preservedAnnotations = append(preservedAnnotations, r.GlobalPreservedAnnotations...)
-				}
-
-				if len(r.GlobalPreservedLabels) > 0 {
-					preservedLabels = append(preservedLabels, r.GlobalPreservedLabels...)
-				}
-
-				// Preserve specially treated argo cd annotations:
-				// * https://github.com/argoproj/applicationset/issues/180
-				// * https://github.com/argoproj/argo-cd/issues/10500
-				preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
-
-				for _, key := range preservedAnnotations {
-					if state, exists := found.Annotations[key]; exists {
-						if generatedApp.Annotations == nil {
-							generatedApp.Annotations = map[string]string{}
-						}
-						generatedApp.Annotations[key] = state
-					}
-				}
-
-				for _, key := range preservedLabels {
-					if state, exists := found.Labels[key]; exists {
-						if generatedApp.Labels == nil {
-							generatedApp.Labels = map[string]string{}
-						}
-						generatedApp.Labels[key] = state
-					}
-				}
-
-				// Preserve deleting finalizers and avoid diff conflicts
-				for _, finalizer := range defaultPreservedFinalizers {
-					for _, f := range found.Finalizers {
-						// For finalizers, use prefix matching in case it contains "/" stages
-						if strings.HasPrefix(f, finalizer) {
-							generatedApp.Finalizers = append(generatedApp.Finalizers, f)
-						}
-					}
-				}
+				PreserveApplicationFields(applicationSet, found, &generatedApp, r.GlobalPreservedAnnotations, r.GlobalPreservedLabels)
 
 				found.Annotations = generatedApp.Annotations
 				found.Labels = generatedApp.Labels
@@ -819,6 +778,59 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 	return firstAppError(appErrors)
 }
 
+// PreserveApplicationFields copies to the generated application the annotations, labels and finalizers of the live
+// application which are preserved by the application set or by the given global settings
+func PreserveApplicationFields(applicationSet argov1alpha1.ApplicationSet, live, generated *argov1alpha1.Application, globalPreservedAnnotations, globalPreservedLabels []string) {
+	preservedAnnotations := make([]string, 0)
+	preservedLabels := make([]string, 0)
+
+	if applicationSet.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, applicationSet.Spec.PreservedFields.Annotations...)
+		preservedLabels = append(preservedLabels, applicationSet.Spec.PreservedFields.Labels...)
+	}
+
+	if len(globalPreservedAnnotations) > 0 {
+		preservedAnnotations = append(preservedAnnotations, globalPreservedAnnotations...)
+	}
+
+	if len(globalPreservedLabels) > 0 {
+		preservedLabels = append(preservedLabels, globalPreservedLabels...)
+	}
+
+	// Preserve specially treated argo cd annotations:
+	// * https://github.com/argoproj/applicationset/issues/180
+	// * https://github.com/argoproj/argo-cd/issues/10500
+	preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
+
+	for _, key := range preservedAnnotations {
+		if state, exists := live.Annotations[key]; exists {
+			if generated.Annotations == nil {
+				generated.Annotations = map[string]string{}
+			}
+			generated.Annotations[key] = state
+		}
+	}
+
+	for _, key := range preservedLabels {
+		if state, exists := live.Labels[key]; exists {
+			if generated.Labels == nil {
+				generated.Labels = map[string]string{}
+			}
+			generated.Labels[key] = state
+		}
+	}
+
+	// Preserve deleting finalizers and avoid diff conflicts
+	for _, finalizer := range defaultPreservedFinalizers {
+		for _, f := range live.Finalizers {
+			// For finalizers, use prefix matching in case it contains "/" stages
+			if strings.HasPrefix(f, finalizer) {
+				generated.Finalizers = append(generated.Finalizers, f)
+			}
+		}
+	}
+}
+
 // createInCluster will filter from the desiredApplications only the application that needs to be created
 // Then it will call createOrUpdateInCluster to do the actual create
 func (r *ApplicationSetReconciler) createInCluster(ctx context.Context, logCtx *log.Entry, applicationSet argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application) error {
@@ -1128,6 +1140,32 @@ func (r *ApplicationSetReconciler) updateResourcesStatus(ctx context.Context, lo
 	return nil
 }
 
+// updateFileErrorsStatus sets the files of the Git files generators which could not be used in the status of the
+// ApplicationSet, or clears them once all files can be used again.
+func (r *ApplicationSetReconciler) updateFileErrorsStatus(ctx context.Context, appset *argov1alpha1.ApplicationSet, fileErrors []argov1alpha1.ApplicationSetFileError) error {
+	if slices.Equal(appset.Status.FileErrors, fileErrors) {
+		return nil
+	}
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		namespacedName := types.NamespacedName{Namespace: appset.Namespace, Name: appset.Name}
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.FileErrors = fileErrors
+		if err := r.Client.Status().Update(ctx, updatedAppset); err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(appset)
+		return nil
+	})
+}
+
 // setAppSetApplicationStatus updates the ApplicationSet's status field
 // with any new/changed Application statuses.
 func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applicationStatuses []argov1alpha1.ApplicationSetApplicationStatus) error {
@@ -4388,6 +4388,35 @@ func TestResourceStatusAreOrdered(t *testing.T) {
 	}
 }
 
+func TestUpdateFileErrorsStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+	}
+	client := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&appSet).WithObjects(&appSet).Build()
+	r := ApplicationSetReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+
+	fileErrors := []v1alpha1.ApplicationSetFileError{{RepoURL: "https://example.com/repo.git", Path: "clusters/a/config.yaml", Message: "unable to parse file"}}
+	require.NoError(t, r.updateFileErrorsStatus(t.Context(), &appSet, fileErrors))
+	assert.Equal(t, fileErrors, appSet.Status.FileErrors)
+
+	var stored v1alpha1.ApplicationSet
+	require.NoError(t, client.Get(t.Context(), crtclient.ObjectKeyFromObject(&appSet), &stored))
+	assert.Equal(t, fileErrors, stored.Status.FileErrors)
+
+	require.NoError(t, r.updateFileErrorsStatus(t.Context(), &appSet, nil))
+	require.NoError(t, client.Get(t.Context(), crtclient.ObjectKeyFromObject(&appSet), &stored))
+	assert.Empty(t, stored.Status.FileErrors)
+}
+
 func TestApplicationOwnsHandler(t *testing.T) {
 	// progressive syncs do not affect create, delete, or generic
 	ownsHandler := getApplicationOwnsHandler(true)
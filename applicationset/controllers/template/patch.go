@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	jsonpatch "github.com/evanphx/json-patch"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -41,3 +42,48 @@ func applyTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Ap
 
 	return &finalApp, nil
 }
+
+// applyJSONTemplatePatch applies a JSON patch, in YAML or JSON, to the application
+func applyJSONTemplatePatch(app *appv1.Application, templatePatch string) (*appv1.Application, error) {
+	appString, err := json.Marshal(app)
+	if err != nil {
+		return nil, fmt.Errorf("error while marshalling Application %w", err)
+	}
+
+	convertedTemplatePatch, err := utils.ConvertYAMLToJSON(templatePatch)
+	if err != nil {
+		return nil, fmt.Errorf("error while converting template to json %q: %w", convertedTemplatePatch, err)
+	}
+
+	patch, err := jsonpatch.DecodePatch([]byte(convertedTemplatePatch))
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON patch %q: %w", convertedTemplatePatch, err)
+	}
+
+	data, err := patch.Apply(appString)
+	if err != nil {
+		return nil, fmt.Errorf("error while applying JSON patch %q: %w", convertedTemplatePatch, err)
+	}
+
+	finalApp := appv1.Application{}
+	err = json.Unmarshal(data, &finalApp)
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshalling patched application: %w", err)
+	}
+
+	// Prevent changes to the `project` field. This helps prevent malicious template patches
+	finalApp.Spec.Project = app.Spec.Project
+
+	return &finalApp, nil
+}
+
+// applyOverride applies the override file of a generated application, stored in git, as a strategic merge patch. The
+// name of the application cannot be overridden either.
+func applyOverride(app *appv1.Application, override string) (*appv1.Application, error) {
+	finalApp, err := applyTemplatePatch(app, override)
+	if err != nil {
+		return nil, fmt.Errorf("error applying override file: %w", err)
+	}
+	finalApp.Name = app.Name
+	return finalApp, nil
+}
@@ -248,3 +248,37 @@ func TestError(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func Test_ApplyJSONTemplatePatch(t *testing.T) {
+	t.Parallel()
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec: appv1.ApplicationSpec{
+			Project: "default",
+			Source: &appv1.ApplicationSource{
+				RepoURL:   "https://github.com/argoproj/argocd-example-apps.git",
+				Path:      "guestbook",
+				Directory: &appv1.ApplicationSourceDirectory{Recurse: true},
+			},
+		},
+	}
+
+	result, err := applyJSONTemplatePatch(app, `
+- op: remove
+  path: /spec/source/directory
+- op: replace
+  path: /spec/project
+  value: other
+- op: add
+  path: /metadata/labels
+  value:
+    env: prod
+`)
+	require.NoError(t, err)
+	assert.Nil(t, result.Spec.Source.Directory)
+	assert.Equal(t, "default", result.Spec.Project)
+	assert.Equal(t, map[string]string{"env": "prod"}, result.Labels)
+
+	_, err = applyJSONTemplatePatch(app, `{"op": "remove"}`)
+	require.ErrorContains(t, err, "invalid JSON patch")
+}
@@ -63,6 +63,38 @@ func GenerateApplications(logCtx *log.Entry, applicationSetInfo argov1alpha1.App
 					app = patchedApplication
 				}
 
+				if len(applicationSetInfo.Spec.TemplatePatches) > 0 {
+					patchedApplication, err := renderTemplatePatches(renderer, app, applicationSetInfo, p)
+					if err != nil {
+						log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error generating application from params")
+
+						if firstError == nil {
+							firstError = err
+							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+						}
+						continue
+					}
+
+					app = patchedApplication
+				}
+
+				if override, ok := p[generators.OverrideParamKey].(string); ok {
+					overriddenApplication, err := applyOverride(app, override)
+					if err != nil {
+						log.WithError(err).WithField("params", a.Params).WithField("generator", requestedGenerator).
+							Error("error generating application from params")
+
+						if firstError == nil {
+							firstError = err
+							applicationSetReason = argov1alpha1.ApplicationSetReasonRenderTemplateParamsError
+						}
+						continue
+					}
+
+					app = overriddenApplication
+				}
+
 				// The app's namespace must be the same as the AppSet's namespace to preserve the appsets-in-any-namespace
 				// security boundary.
 				app.Namespace = applicationSetInfo.Namespace
@@ -88,6 +120,35 @@ func renderTemplatePatch(r utils.Renderer, app *argov1alpha1.Application, applic
 	return applyTemplatePatch(app, replacedTemplate)
 }
 
+// renderTemplatePatches applies in order the template patches of the application set which match the given parameters
+func renderTemplatePatches(r utils.Renderer, app *argov1alpha1.Application, applicationSetInfo argov1alpha1.ApplicationSet, params map[string]any) (*argov1alpha1.Application, error) {
+	for i, templatePatch := range applicationSetInfo.Spec.TemplatePatches {
+		matches, err := generators.ParamsMatchSelector(params, templatePatch.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("error matching templatePatches[%d]: %w", i, err)
+		}
+		if !matches {
+			continue
+		}
+		replacedPatch, err := r.Replace(templatePatch.Patch, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("error replacing values in templatePatches[%d]: %w", i, err)
+		}
+		switch templatePatch.Type {
+		case "", argov1alpha1.ApplicationSetTemplatePatchTypeStrategic:
+			app, err = applyTemplatePatch(app, replacedPatch)
+		case argov1alpha1.ApplicationSetTemplatePatchTypeJSON:
+			app, err = applyJSONTemplatePatch(app, replacedPatch)
+		default:
+			err = fmt.Errorf("unknown patch type %q", templatePatch.Type)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error applying templatePatches[%d]: %w", i, err)
+		}
+	}
+	return app, nil
+}
+
 func GetTempApplication(applicationSetTemplate argov1alpha1.ApplicationSetTemplate) *argov1alpha1.Application {
 	var tmplApplication argov1alpha1.Application
 	tmplApplication.Annotations = applicationSetTemplate.Annotations
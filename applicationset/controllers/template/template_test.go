@@ -354,3 +354,99 @@ func TestGenerateAppsUsingPullRequestGenerator(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTemplatePatches(t *testing.T) {
+	t.Parallel()
+	appSet := v1alpha1.ApplicationSet{
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			TemplatePatches: []v1alpha1.ApplicationSetTemplatePatch{
+				{
+					Patch: `metadata: {labels: {cluster: '{{ .name }}'}}`,
+				},
+				{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+					Patch:    `spec: {syncPolicy: {automated: {prune: true}}}`,
+				},
+				{
+					Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"prod"}},
+					}},
+					Type:  v1alpha1.ApplicationSetTemplatePatchTypeJSON,
+					Patch: `[{"op": "replace", "path": "/spec/source/targetRevision", "value": "{{ .env }}"}]`,
+				},
+			},
+		},
+	}
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", TargetRevision: "HEAD"},
+		},
+	}
+
+	prod, err := renderTemplatePatches(&utils.Render{}, app, appSet, map[string]any{"name": "cluster-1", "env": "prod"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "cluster-1"}, prod.Labels)
+	require.NotNil(t, prod.Spec.SyncPolicy)
+	assert.True(t, *prod.Spec.SyncPolicy.Automated.Prune)
+	assert.Equal(t, "HEAD", prod.Spec.Source.TargetRevision)
+
+	staging, err := renderTemplatePatches(&utils.Render{}, app, appSet, map[string]any{"name": "cluster-2", "env": "staging"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "cluster-2"}, staging.Labels)
+	assert.Nil(t, staging.Spec.SyncPolicy)
+	assert.Equal(t, "staging", staging.Spec.Source.TargetRevision)
+
+	appSet.Spec.TemplatePatches = []v1alpha1.ApplicationSetTemplatePatch{{Type: "unknown", Patch: "{}"}}
+	_, err = renderTemplatePatches(&utils.Render{}, app, appSet, map[string]any{})
+	require.EqualError(t, err, `error applying templatePatches[0]: unknown patch type "unknown"`)
+}
+
+func TestGenerateApplicationsWithOverride(t *testing.T) {
+	t.Parallel()
+	generator := v1alpha1.ApplicationSetGenerator{Git: &v1alpha1.GitGenerator{}}
+	generatorMock := &genmock.Generator{}
+	generatorMock.EXPECT().GetTemplate(&generator).Return(&v1alpha1.ApplicationSetTemplate{})
+	generatorMock.EXPECT().GenerateParams(&generator, mock.AnythingOfType("*v1alpha1.ApplicationSet"), mock.Anything).
+		Return([]map[string]any{
+			{"name": "dev"},
+			{"name": "prod", generators.OverrideParamKey: `
+metadata:
+  name: renamed
+  labels:
+    tier: critical
+spec:
+  project: other
+  syncPolicy:
+    automated: {}
+`},
+			{"name": "broken", generators.OverrideParamKey: `spec: {syncPolicy: true}`},
+		}, nil)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "namespace"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+			Generators: []v1alpha1.ApplicationSetGenerator{generator},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{ .name }}"},
+				Spec:                       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+		},
+	}
+
+	got, reason, err := GenerateApplications(log.NewEntry(log.StandardLogger()), appSet, map[string]generators.Generator{"Git": generatorMock}, &utils.Render{}, nil)
+	require.ErrorContains(t, err, "error applying override file")
+	assert.EqualValues(t, v1alpha1.ApplicationSetReasonRenderTemplateParamsError, reason)
+	require.Len(t, got, 2)
+
+	assert.Equal(t, "dev", got[0].Name)
+	assert.Nil(t, got[0].Spec.SyncPolicy)
+
+	assert.Equal(t, "prod", got[1].Name)
+	assert.Equal(t, "default", got[1].Spec.Project)
+	assert.Equal(t, map[string]string{"tier": "critical"}, got[1].Labels)
+	require.NotNil(t, got[1].Spec.SyncPolicy)
+	assert.NotNil(t, got[1].Spec.SyncPolicy.Automated)
+}
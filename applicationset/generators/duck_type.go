@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/jsonpath"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -143,6 +144,11 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 		return nil, nil
 	}
 
+	jsonPathParsers, err := parseJSONPathParameters(appSetGenerator.ClusterDecisionResource.JSONPathParameters)
+	if err != nil {
+		return nil, err
+	}
+
 	clusterDecisions := buildClusterDecisions(duckResources, statusListKey)
 	if len(clusterDecisions) == 0 {
 		log.Warningf("clusterDecisionResource status.%s missing", statusListKey)
@@ -167,6 +173,14 @@ func (g *DuckTypeGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.A
 			params[key] = fmt.Sprintf("%v", value)
 		}
 
+		for key, parser := range jsonPathParsers {
+			value, err := extractJSONPathParameter(parser, clusterDecision)
+			if err != nil {
+				return nil, fmt.Errorf("error extracting parameter %q from cluster decision: %w", key, err)
+			}
+			params[key] = value
+		}
+
 		for key, value := range appSetGenerator.ClusterDecisionResource.Values {
 			collectParams(appSet, params, key, value)
 		}
@@ -219,6 +233,29 @@ func findCluster(clustersFromArgoCD []utils.ClusterSpecifier, cluster any, match
 	return nil
 }
 
+// parseJSONPathParameters parses the JSONPath expressions of the given parameters, by parameter name
+func parseJSONPathParameters(parameters map[string]string) (map[string]*jsonpath.JSONPath, error) {
+	parsers := make(map[string]*jsonpath.JSONPath, len(parameters))
+	for key, expr := range parameters {
+		parser := jsonpath.New(key).AllowMissingKeys(true)
+		if err := parser.Parse(expr); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath expression %q of parameter %q: %w", expr, key, err)
+		}
+		parsers[key] = parser
+	}
+	return parsers, nil
+}
+
+// extractJSONPathParameter returns the value extracted by the given parser from a cluster decision. Missing keys result
+// in an empty value.
+func extractJSONPathParameter(parser *jsonpath.JSONPath, clusterDecision any) (string, error) {
+	var buf strings.Builder
+	if err := parser.Execute(&buf, clusterDecision); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
 func collectParams(appSet *argoprojiov1alpha1.ApplicationSet, params map[string]any, key string, value string) {
 	if appSet.Spec.GoTemplate {
 		if params["values"] == nil {
@@ -660,3 +660,120 @@ func TestGenerateParamsForDuckTypeGoTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateParamsForDuckTypeJSONPathParameters(t *testing.T) {
+	cluster := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "production-01",
+			Namespace: "namespace",
+			Labels: map[string]string{
+				"argocd.argoproj.io/secret-type": "cluster",
+			},
+		},
+		Data: map[string][]byte{
+			"config": []byte("{}"),
+			"name":   []byte("production-01"),
+			"server": []byte("https://production-01.example.com"),
+		},
+	}
+
+	duckType := &unstructured.Unstructured{
+		Object: map[string]any{
+			"apiVersion": resourceAPIVersion,
+			"kind":       "Duck",
+			"metadata": map[string]any{
+				"name":      resourceName,
+				"namespace": "namespace",
+			},
+			"status": map[string]any{
+				"decisions": []any{
+					map[string]any{
+						"clusterName": "production-01",
+						"hints": map[string]any{
+							"region":   "eu-west-1",
+							"zones":    []any{"a", "b"},
+							"capacity": int64(42),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-configmap",
+			Namespace: "namespace",
+		},
+		Data: map[string]string{
+			"apiVersion":    resourceAPIVersion,
+			"kind":          resourceKind,
+			"statusListKey": "decisions",
+			"matchKey":      "clusterName",
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		jsonPathParameters map[string]string
+		expected           []map[string]any
+		expectedError      string
+	}{
+		{
+			name: "parameters extracted from the decision",
+			jsonPathParameters: map[string]string{
+				"region":   "{.hints.region}",
+				"zones":    "{.hints.zones[*]}",
+				"capacity": "{.hints.capacity}",
+				"missing":  "{.hints.missing}",
+			},
+			expected: []map[string]any{{
+				"clusterName": "production-01",
+				"hints":       "map[capacity:42 region:eu-west-1 zones:[a b]]",
+				"name":        "production-01",
+				"server":      "https://production-01.example.com",
+				"region":      "eu-west-1",
+				"zones":       "a b",
+				"capacity":    "42",
+				"missing":     "",
+			}},
+		},
+		{
+			name:               "invalid expression",
+			jsonPathParameters: map[string]string{"region": "{.hints.region"},
+			expectedError:      `invalid JSONPath expression "{.hints.region" of parameter "region": unclosed action`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			appClientset := kubefake.NewSimpleClientset(cluster, configMap)
+			gvrToListKind := map[schema.GroupVersionResource]string{{
+				Group:    "mallard.io",
+				Version:  "v1",
+				Resource: "ducks",
+			}: "DuckList"}
+			fakeDynClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), gvrToListKind, duckType)
+
+			clusterInformer, err := settings.NewClusterInformer(appClientset, "namespace")
+			require.NoError(t, err)
+			defer test.StartInformer(clusterInformer)()
+
+			duckTypeGenerator := NewDuckTypeGenerator(t.Context(), fakeDynClient, appClientset, "namespace", clusterInformer)
+			got, err := duckTypeGenerator.GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+				ClusterDecisionResource: &argoprojiov1alpha1.DuckTypeGenerator{
+					ConfigMapRef:       "my-configmap",
+					Name:               resourceName,
+					JSONPathParameters: testCase.jsonPathParameters,
+				},
+			}, &argoprojiov1alpha1.ApplicationSet{Spec: argoprojiov1alpha1.ApplicationSetSpec{GoTemplate: true}}, nil)
+
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
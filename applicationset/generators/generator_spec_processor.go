@@ -9,6 +9,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -123,6 +124,23 @@ func GetRelevantGenerators(requestedGenerator *argoprojiov1alpha1.ApplicationSet
 	return res
 }
 
+// ParamsMatchSelector returns whether the given parameters match the label selector, the same way as the selector of a
+// generator. Parameters always match a nil selector.
+func ParamsMatchSelector(params map[string]any, labelSelector *metav1.LabelSelector) (bool, error) {
+	if labelSelector == nil {
+		return true, nil
+	}
+	selector, err := utils.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, fmt.Errorf("error parsing label selector: %w", err)
+	}
+	flatParams, err := flattenParameters(params)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(flatParams)), nil
+}
+
 func flattenParameters(in map[string]any) (map[string]string, error) {
 	flat, err := flatten.Flatten(normalizeMapForFlatten(in), "", flatten.DotStyle)
 	if err != nil {
@@ -793,6 +793,8 @@ func TestInterpolateGeneratorValuesHandling(t *testing.T) {
 			IgnoreDifferences: argov1alpha1.IgnoreDifferences{},
 			Info:              []argov1alpha1.Info{},
 			Sources:           argov1alpha1.ApplicationSources{},
+			Destinations:      []argov1alpha1.ApplicationDestination{},
+			HealthRollupRules: []argov1alpha1.HealthRollupRule{},
 		},
 	}
 	type args struct {
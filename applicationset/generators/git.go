@@ -1,7 +1,10 @@
 package generators
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"maps"
 	"path"
@@ -11,7 +14,11 @@ import (
 	"time"
 
 	"github.com/jeremywohl/flatten"
+	"github.com/pelletier/go-toml/v2"
+	"github.com/santhosh-tekuri/jsonschema/v6"
 	log "github.com/sirupsen/logrus"
+	"github.com/titanous/json5"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
@@ -23,11 +30,28 @@ import (
 
 var _ Generator = (*GitGenerator)(nil)
 
+// OverrideParamKey is the parameter which holds the content of the override file of a generated application. It is
+// applied to the Application after it is rendered.
+const OverrideParamKey = "argocd.argoproj.io/override"
+
 type GitGenerator struct {
 	repos     services.Repos
 	namespace string
 }
 
+// FileErrors is returned by the Git files generator when some of the files could not be parsed or do not match the
+// schema of the generator. No parameters are generated in that case, so that the applications of the other files
+// are not deleted because of a broken file.
+type FileErrors []argoprojiov1alpha1.ApplicationSetFileError
+
+func (e FileErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fileErr := range e {
+		msgs = append(msgs, fmt.Sprintf("unable to process file '%s' from repository '%s': %s", fileErr.Path, fileErr.RepoURL, fileErr.Message))
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // NewGitGenerator creates a new instance of Git Generator
 func NewGitGenerator(repos services.Repos, controllerNamespace string) Generator {
 	g := &GitGenerator{
@@ -136,9 +160,51 @@ func (g *GitGenerator) generateParamsForGitDirectories(appSetGenerator *argoproj
 		return nil, fmt.Errorf("error generating params from apps: %w", err)
 	}
 
+	overrides, err := g.getOverrideFiles(appSetGenerator, noRevisionCache, sourceIntegrity, project)
+	if err != nil {
+		return nil, err
+	}
+	for i, app := range requestedApps {
+		setOverrideParam(res[i], overrides, app)
+	}
+
 	return res, nil
 }
 
+// getOverrideFiles returns the content of the override files of the generator by the directory they are in
+func (g *GitGenerator) getOverrideFiles(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, noRevisionCache bool, sourceIntegrity *argoprojiov1alpha1.SourceIntegrity, project string) (map[string]string, error) {
+	overrideFile := appSetGenerator.Git.OverrideFile
+	if overrideFile == "" {
+		return nil, nil
+	}
+	files, err := g.repos.GetFiles(
+		context.TODO(),
+		appSetGenerator.Git.RepoURL,
+		appSetGenerator.Git.Revision,
+		project,
+		path.Join("**", overrideFile),
+		noRevisionCache,
+		sourceIntegrity,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting override files from repo: %w", err)
+	}
+	overrides := make(map[string]string, len(files))
+	for filePath, content := range files {
+		if path.Base(filePath) == overrideFile {
+			overrides[path.Dir(filePath)] = string(content)
+		}
+	}
+	return overrides, nil
+}
+
+// setOverrideParam sets the override parameter to the content of the override file in the given directory, if any
+func setOverrideParam(params map[string]any, overrides map[string]string, dir string) {
+	if override, ok := overrides[dir]; ok {
+		params[OverrideParamKey] = override
+	}
+}
+
 // generateParamsForGitFiles generates parameters for an ApplicationSet using a file-based Git generator.
 // It retrieves and processes specified files from the Git repository, supporting both YAML and JSON formats,
 // and returns a list of parameter maps extracted from the content.
@@ -203,36 +269,117 @@ func (g *GitGenerator) generateParamsForGitFiles(appSetGenerator *argoprojiov1al
 	}
 	sort.Strings(filePaths)
 
+	schema, err := compileFileSchema(appSetGenerator.Git.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := g.getOverrideFiles(appSetGenerator, noRevisionCache, sourceIntegrity, project)
+	if err != nil {
+		return nil, err
+	}
+
 	var allParams []map[string]any
+	var fileErrors FileErrors
 	for _, filePath := range filePaths {
-		// A JSON / YAML file path can contain multiple sets of parameters (ie it is an array)
-		paramsFromFileArray, err := g.generateParamsFromGitFile(filePath, fileContentMap[filePath], appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix)
+		// A file can contain multiple sets of parameters (ie it is an array)
+		paramsFromFileArray, err := g.generateParamsFromGitFile(filePath, fileContentMap[filePath], schema, appSetGenerator.Git.Values, useGoTemplate, goTemplateOptions, appSetGenerator.Git.PathParamPrefix)
 		if err != nil {
-			return nil, fmt.Errorf("unable to process file '%s' from repository '%s': %w", filePath, appSetGenerator.Git.RepoURL, err)
+			fileErrors = append(fileErrors, argoprojiov1alpha1.ApplicationSetFileError{
+				RepoURL: appSetGenerator.Git.RepoURL,
+				Path:    filePath,
+				Message: err.Error(),
+			})
+			continue
+		}
+		for _, params := range paramsFromFileArray {
+			setOverrideParam(params, overrides, path.Dir(filePath))
 		}
 		allParams = append(allParams, paramsFromFileArray...)
 	}
+	if len(fileErrors) > 0 {
+		return nil, fileErrors
+	}
 
 	return allParams, nil
 }
 
-// generateParamsFromGitFile parses the content of a Git-tracked file and generates a slice of parameter maps.
-// The file can contain a single YAML/JSON object or an array of such objects. Depending on the useGoTemplate flag,
-// it either preserves structure for Go templating or flattens the objects for use as plain key-value parameters.
-func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, values map[string]string, useGoTemplate bool, goTemplateOptions []string, pathParamPrefix string) ([]map[string]any, error) {
-	objectsFound := []map[string]any{}
+// compileFileSchema compiles the JSON schema of a Git files generator, or returns nil if the generator has none
+func compileFileSchema(schemaJSON *apiextensionsv1.JSON) (*jsonschema.Schema, error) {
+	if schemaJSON == nil || len(schemaJSON.Raw) == 0 {
+		return nil, nil
+	}
+	schemaDoc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaJSON.Raw))
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling the schema of the files generator: %w", err)
+	}
+	compiler := jsonschema.NewCompiler()
+	// the schema must not reference other files or URLs
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := compiler.AddResource("schema.json", schemaDoc); err != nil {
+		return nil, fmt.Errorf("error adding the schema of the files generator: %w", err)
+	}
+	schema, err := compiler.Compile("schema.json")
+	if err != nil {
+		return nil, fmt.Errorf("error compiling the schema of the files generator: %w", err)
+	}
+	return schema, nil
+}
+
+// parseGitFile parses the content of a file of a Git files generator into the objects it contains. TOML and JSON5
+// files are recognized by their extension, all other files are parsed as YAML, which includes JSON.
+func parseGitFile(filePath string, fileContent []byte) ([]map[string]any, error) {
+	var err error
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".toml":
+		obj := map[string]any{}
+		if err = toml.Unmarshal(fileContent, &obj); err != nil {
+			return nil, err
+		}
+		// the content is converted to JSON, so that the values have the same types as the values of YAML files
+		fileContent, err = json.Marshal(obj)
+	case ".json5":
+		var obj any
+		if err = json5.Unmarshal(fileContent, &obj); err != nil {
+			return nil, err
+		}
+		fileContent, err = json.Marshal(obj)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// First, we attempt to parse as a single object.
 	// This will also succeed for empty files.
 	singleObj := map[string]any{}
-	err := yaml.Unmarshal(fileContent, &singleObj)
-	if err == nil {
-		objectsFound = append(objectsFound, singleObj)
-	} else {
-		// If unable to parse as an object, try to parse as an array
-		err = yaml.Unmarshal(fileContent, &objectsFound)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse file: %w", err)
+	if err := yaml.Unmarshal(fileContent, &singleObj); err == nil {
+		return []map[string]any{singleObj}, nil
+	}
+	// If unable to parse as an object, try to parse as an array
+	objectsFound := []map[string]any{}
+	if err := yaml.Unmarshal(fileContent, &objectsFound); err != nil {
+		return nil, err
+	}
+	return objectsFound, nil
+}
+
+// generateParamsFromGitFile parses the content of a Git-tracked file and generates a slice of parameter maps.
+// The file can contain a single YAML/JSON/JSON5/TOML object or an array of such objects, which are validated against
+// the schema if there is one. Depending on the useGoTemplate flag, it either preserves structure for Go templating or
+// flattens the objects for use as plain key-value parameters.
+func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []byte, schema *jsonschema.Schema, values map[string]string, useGoTemplate bool, goTemplateOptions []string, pathParamPrefix string) ([]map[string]any, error) {
+	objectsFound, err := parseGitFile(filePath, fileContent)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse file: %w", err)
+	}
+	if schema != nil {
+		for i, objectFound := range objectsFound {
+			if err := validateGitFileObject(schema, objectFound); err != nil {
+				if len(objectsFound) > 1 {
+					return nil, fmt.Errorf("object %d does not match the schema: %w", i, err)
+				}
+				return nil, fmt.Errorf("file does not match the schema: %w", err)
+			}
 		}
 	}
 
@@ -292,6 +439,43 @@ func (g *GitGenerator) generateParamsFromGitFile(filePath string, fileContent []
 	return res, nil
 }
 
+// validateGitFileObject validates an object of a file against the schema of the files generator
+func validateGitFileObject(schema *jsonschema.Schema, obj map[string]any) error {
+	// the object is converted to the types of the JSON schema library, e.g. json.Number instead of float64
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	err = schema.Validate(instance)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return err
+	}
+	var violations []string
+	collectSchemaViolations(validationErr.DetailedOutput(), &violations)
+	return errors.New(strings.Join(violations, ", "))
+}
+
+func collectSchemaViolations(unit *jsonschema.OutputUnit, violations *[]string) {
+	if len(unit.Errors) == 0 {
+		if unit.Error != nil {
+			location := unit.InstanceLocation
+			if location == "" {
+				location = "/"
+			}
+			*violations = append(*violations, fmt.Sprintf("at '%s': %s", location, unit.Error.String()))
+		}
+		return
+	}
+	for i := range unit.Errors {
+		collectSchemaViolations(&unit.Errors[i], violations)
+	}
+}
+
 // filterApps filters the list of all application paths based on inclusion and exclusion rules
 // defined in GitDirectoryGeneratorItems. Each item can either include or exclude matching paths.
 func (g *GitGenerator) filterApps(directories []argoprojiov1alpha1.GitDirectoryGeneratorItem, allPaths []string) []string {
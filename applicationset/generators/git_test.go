@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -207,7 +208,7 @@ foo:
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			params, err := (*GitGenerator)(nil).generateParamsFromGitFile(tt.args.filePath, tt.args.fileContent, tt.args.values, tt.args.useGoTemplate, tt.args.goTemplateOptions, tt.args.pathParamPrefix)
+			params, err := (*GitGenerator)(nil).generateParamsFromGitFile(tt.args.filePath, tt.args.fileContent, nil, tt.args.values, tt.args.useGoTemplate, tt.args.goTemplateOptions, tt.args.pathParamPrefix)
 			if tt.wantErr {
 				assert.Error(t, err, "GitGenerator.generateParamsFromGitFile()")
 			} else {
@@ -1075,6 +1076,197 @@ cluster:
 	}
 }
 
+func TestGitGenerateParamsFromFilesFormatsAndSchema(t *testing.T) {
+	schema := &apiextensionsv1.JSON{Raw: []byte(`{
+	"type": "object",
+	"required": ["cluster"],
+	"properties": {
+		"cluster": {
+			"type": "object",
+			"required": ["name"],
+			"properties": {"name": {"type": "string"}, "replicas": {"type": "integer", "minimum": 1}}
+		}
+	}
+}`)}
+
+	cases := []struct {
+		name               string
+		schema             *apiextensionsv1.JSON
+		repoFileContents   map[string][]byte
+		expected           []map[string]any
+		expectedFileErrors FileErrors
+	}{
+		{
+			name: "TOML and JSON5 files",
+			repoFileContents: map[string][]byte{
+				"cluster-config/production/config.toml": []byte(`
+[cluster]
+name = "production"
+replicas = 3
+`),
+				"cluster-config/staging/config.json5": []byte(`{
+	// the staging cluster
+	cluster: {name: 'staging', replicas: 1,},
+}`),
+			},
+			expected: []map[string]any{
+				{
+					"cluster.name":            "production",
+					"cluster.replicas":        "3",
+					"path":                    "cluster-config/production",
+					"path.basename":           "production",
+					"path.filename":           "config.toml",
+					"path.basenameNormalized": "production",
+					"path.filenameNormalized": "config.toml",
+					"path[0]":                 "cluster-config",
+					"path[1]":                 "production",
+				},
+				{
+					"cluster.name":            "staging",
+					"cluster.replicas":        "1",
+					"path":                    "cluster-config/staging",
+					"path.basename":           "staging",
+					"path.filename":           "config.json5",
+					"path.basenameNormalized": "staging",
+					"path.filenameNormalized": "config.json5",
+					"path[0]":                 "cluster-config",
+					"path[1]":                 "staging",
+				},
+			},
+		},
+		{
+			name:   "files matching the schema",
+			schema: schema,
+			repoFileContents: map[string][]byte{
+				"cluster-config/production/config.yaml": []byte("cluster:\n  name: production\n  replicas: 3\n"),
+			},
+			expected: []map[string]any{
+				{
+					"cluster.name":            "production",
+					"cluster.replicas":        "3",
+					"path":                    "cluster-config/production",
+					"path.basename":           "production",
+					"path.filename":           "config.yaml",
+					"path.basenameNormalized": "production",
+					"path.filenameNormalized": "config.yaml",
+					"path[0]":                 "cluster-config",
+					"path[1]":                 "production",
+				},
+			},
+		},
+		{
+			name:   "every file which cannot be used is reported",
+			schema: schema,
+			repoFileContents: map[string][]byte{
+				"cluster-config/production/config.yaml": []byte("cluster:\n  name: production\n"),
+				"cluster-config/staging/config.yaml":    []byte("cluster:\n  replicas: 0\n"),
+				"cluster-config/test/config.toml":       []byte("cluster = "),
+			},
+			expectedFileErrors: FileErrors{
+				{RepoURL: "RepoURL", Path: "cluster-config/staging/config.yaml", Message: "file does not match the schema: at '/cluster': missing property 'name', at '/cluster/replicas': minimum: got 0, want 1"},
+				{RepoURL: "RepoURL", Path: "cluster-config/test/config.toml", Message: "unable to parse file: toml: expected value, not eof"},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			argoCDServiceMock := mocks.NewRepos(t)
+			argoCDServiceMock.EXPECT().GetFiles(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(testCase.repoFileContents, nil)
+
+			gitGenerator := NewGitGenerator(argoCDServiceMock, "")
+			applicationSetInfo := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Generators: []v1alpha1.ApplicationSetGenerator{{
+						Git: &v1alpha1.GitGenerator{
+							RepoURL:  "RepoURL",
+							Revision: "Revision",
+							Files:    []v1alpha1.GitFileGeneratorItem{{Path: "**/config.*"}},
+							Schema:   testCase.schema,
+						},
+					}},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			require.NoError(t, v1alpha1.AddToScheme(scheme))
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+			got, err := gitGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, client)
+			if testCase.expectedFileErrors != nil {
+				var fileErrors FileErrors
+				require.ErrorAs(t, err, &fileErrors)
+				assert.Equal(t, testCase.expectedFileErrors, fileErrors)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestGitGenerateParamsWithOverrideFile(t *testing.T) {
+	overrideFiles := map[string][]byte{
+		"cluster-config/production/appset-overrides.yaml": []byte("spec: {syncPolicy: null}"),
+		"apps/guestbook/appset-overrides.yaml":            []byte("metadata: {labels: {tier: critical}}"),
+	}
+	newAppSet := func(gitGenerator *v1alpha1.GitGenerator) v1alpha1.ApplicationSet {
+		gitGenerator.RepoURL = "RepoURL"
+		gitGenerator.Revision = "Revision"
+		gitGenerator.OverrideFile = "appset-overrides.yaml"
+		return v1alpha1.ApplicationSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "set"},
+			Spec: v1alpha1.ApplicationSetSpec{
+				GoTemplate: true,
+				Generators: []v1alpha1.ApplicationSetGenerator{{Git: gitGenerator}},
+			},
+		}
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&v1alpha1.AppProject{}).Build()
+
+	t.Run("Directories", func(t *testing.T) {
+		argoCDServiceMock := mocks.NewRepos(t)
+		argoCDServiceMock.EXPECT().GetDirectories(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return([]string{"apps/guestbook", "apps/helm-guestbook"}, nil)
+		argoCDServiceMock.EXPECT().GetFiles(mock.Anything, "RepoURL", "Revision", mock.Anything, "**/appset-overrides.yaml", mock.Anything, mock.Anything).
+			Return(overrideFiles, nil)
+
+		appSet := newAppSet(&v1alpha1.GitGenerator{Directories: []v1alpha1.GitDirectoryGeneratorItem{{Path: "apps/*"}}})
+		got, err := NewGitGenerator(argoCDServiceMock, "").GenerateParams(&appSet.Spec.Generators[0], &appSet, client)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		assert.Equal(t, "metadata: {labels: {tier: critical}}", got[0][OverrideParamKey])
+		assert.NotContains(t, got[1], OverrideParamKey)
+	})
+
+	t.Run("Files", func(t *testing.T) {
+		argoCDServiceMock := mocks.NewRepos(t)
+		argoCDServiceMock.EXPECT().GetFiles(mock.Anything, "RepoURL", "Revision", mock.Anything, "**/config.json", mock.Anything, mock.Anything).
+			Return(map[string][]byte{
+				"cluster-config/production/config.json": []byte(`{"cluster": "production"}`),
+				"cluster-config/staging/config.json":    []byte(`[{"cluster": "staging-1"}, {"cluster": "staging-2"}]`),
+			}, nil)
+		argoCDServiceMock.EXPECT().GetFiles(mock.Anything, "RepoURL", "Revision", mock.Anything, "**/appset-overrides.yaml", mock.Anything, mock.Anything).
+			Return(overrideFiles, nil)
+
+		appSet := newAppSet(&v1alpha1.GitGenerator{Files: []v1alpha1.GitFileGeneratorItem{{Path: "**/config.json"}}})
+		got, err := NewGitGenerator(argoCDServiceMock, "").GenerateParams(&appSet.Spec.Generators[0], &appSet, client)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		assert.Equal(t, "production", got[0]["cluster"])
+		assert.Equal(t, "spec: {syncPolicy: null}", got[0][OverrideParamKey])
+		assert.NotContains(t, got[1], OverrideParamKey)
+		assert.NotContains(t, got[2], OverrideParamKey)
+	})
+}
+
 // TestGitGeneratorParamsFromFilesWithExcludeOptionWithNewGlobbing tests the params values generated by git file generator
 // when exclude option is set to true. It gives the result files based on new globbing pattern - doublestar package
 func TestGitGeneratorParamsFromFilesWithExcludeOptionWithNewGlobbing(t *testing.T) {
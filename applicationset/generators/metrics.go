@@ -0,0 +1,43 @@
+package generators
+
+import (
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// GeneratorMetrics records how long generators take to evaluate and how many parameter sets they produce.
+type GeneratorMetrics interface {
+	ObserveGeneratorEvaluation(appset *argoprojiov1alpha1.ApplicationSet, generator string, duration time.Duration, items int)
+}
+
+// generatorWithMetrics wraps a Generator and reports every GenerateParams call to a GeneratorMetrics.
+type generatorWithMetrics struct {
+	Generator
+	name    string
+	metrics GeneratorMetrics
+}
+
+// WithMetrics wraps every generator of the map so that its evaluations are reported to the given metrics,
+// labelled with the generator's key in the map. A nil metrics returns the map unchanged.
+func WithMetrics(generators map[string]Generator, metrics GeneratorMetrics) map[string]Generator {
+	if metrics == nil {
+		return generators
+	}
+	res := make(map[string]Generator, len(generators))
+	for name, g := range generators {
+		res[name] = &generatorWithMetrics{Generator: g, name: name, metrics: metrics}
+	}
+	return res
+}
+
+func (g *generatorWithMetrics) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	start := time.Now()
+	params, err := g.Generator.GenerateParams(appSetGenerator, applicationSetInfo, client)
+	if applicationSetInfo != nil {
+		g.metrics.ObserveGeneratorEvaluation(applicationSetInfo, g.name, time.Since(start), len(params))
+	}
+	return params, err
+}
@@ -0,0 +1,63 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/generators/mocks"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type generatorEvaluation struct {
+	appset    string
+	generator string
+	items     int
+}
+
+type fakeGeneratorMetrics struct {
+	evaluations []generatorEvaluation
+}
+
+func (m *fakeGeneratorMetrics) ObserveGeneratorEvaluation(appset *argoprojiov1alpha1.ApplicationSet, generator string, _ time.Duration, items int) {
+	m.evaluations = append(m.evaluations, generatorEvaluation{appset: appset.Name, generator: generator, items: items})
+}
+
+func TestWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	appSet := &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"}}
+	failingGenerator := mocks.NewGenerator(t)
+	failingGenerator.EXPECT().GenerateParams(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+
+	metrics := &fakeGeneratorMetrics{}
+	generators := WithMetrics(map[string]Generator{
+		"List":   NewListGenerator(),
+		"Plugin": failingGenerator,
+	}, metrics)
+
+	params, err := generators["List"].GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{
+		List: &argoprojiov1alpha1.ListGenerator{
+			Elements: []apiextensionsv1.JSON{{Raw: []byte(`{"cluster": "a"}`)}, {Raw: []byte(`{"cluster": "b"}`)}},
+		},
+	}, appSet, nil)
+	require.NoError(t, err)
+	assert.Len(t, params, 2)
+
+	_, err = generators["Plugin"].GenerateParams(&argoprojiov1alpha1.ApplicationSetGenerator{}, appSet, nil)
+	require.EqualError(t, err, "boom")
+
+	assert.Equal(t, []generatorEvaluation{
+		{appset: "set", generator: "List", items: 2},
+		{appset: "set", generator: "Plugin", items: 0},
+	}, metrics.evaluations)
+
+	unwrapped := map[string]Generator{"List": NewListGenerator()}
+	assert.Equal(t, unwrapped, WithMetrics(unwrapped, nil))
+}
@@ -28,12 +28,14 @@ var _ Generator = (*PluginGenerator)(nil)
 type PluginGenerator struct {
 	client    client.Client
 	namespace string
+	repoCreds RepoCredsGetter
 }
 
-func NewPluginGenerator(client client.Client, namespace string) Generator {
+func NewPluginGenerator(client client.Client, namespace string, repoCreds RepoCredsGetter) Generator {
 	g := &PluginGenerator{
 		client:    client,
 		namespace: namespace,
+		repoCreds: repoCreds,
 	}
 	return g
 }
@@ -65,7 +67,7 @@ func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 
 	providerConfig := appSetGenerator.Plugin
 
-	pluginClient, err := g.getPluginFromGenerator(ctx, applicationSetInfo.Name, providerConfig)
+	pluginClient, err := g.getPluginFromGenerator(ctx, applicationSetInfo.Name, resolveProjectName(applicationSetInfo.Spec.Template.Spec.Project), providerConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error getting plugin from generator: %w", err)
 	}
@@ -83,14 +85,24 @@ func (g *PluginGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.App
 	return res, nil
 }
 
-func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName string, generatorConfig *argoprojiov1alpha1.PluginGenerator) (*plugin.Service, error) {
+func (g *PluginGenerator) getPluginFromGenerator(ctx context.Context, appSetName string, project string, generatorConfig *argoprojiov1alpha1.PluginGenerator) (*plugin.Service, error) {
 	cm, err := g.getConfigMap(ctx, generatorConfig.ConfigMapRef.Name)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching ConfigMap: %w", err)
 	}
-	token, err := g.getToken(ctx, cm["token"])
-	if err != nil {
-		return nil, fmt.Errorf("error fetching Secret token: %w", err)
+	var token string
+	if cm["token"] == "" {
+		// the ConfigMap references repository credentials instead of a token
+		repoCreds, err := getRepoCreds(ctx, g.repoCreds, cm["credentialsURL"], cm["baseUrl"], project)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching repository credentials: %w", err)
+		}
+		token = repoCredsToken(repoCreds)
+	} else {
+		token, err = g.getToken(ctx, cm["token"])
+		if err != nil {
+			return nil, fmt.Errorf("error fetching Secret token: %w", err)
+		}
 	}
 
 	var requestTimeout int
@@ -192,8 +204,7 @@ func (g *PluginGenerator) getConfigMap(ctx context.Context, configMapRef string)
 		return nil, errors.New("baseUrl not found in ConfigMap")
 	}
 
-	token, ok := cm.Data["token"]
-	if !ok || token == "" {
+	if cm.Data["token"] == "" && cm.Data["credentialsURL"] == "" {
 		return nil, errors.New("token not found in ConfigMap")
 	}
 
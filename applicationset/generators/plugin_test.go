@@ -664,7 +664,7 @@ func TestPluginGenerateParams(t *testing.T) {
 
 			fakeClientWithCache := fake.NewClientBuilder().WithObjects([]client.Object{testCase.configmap, testCase.secret}...).Build()
 
-			pluginGenerator := NewPluginGenerator(fakeClientWithCache, "default")
+			pluginGenerator := NewPluginGenerator(fakeClientWithCache, "default", nil)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -693,3 +693,70 @@ func TestPluginGenerateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestPluginGenerateParamsWithRepoCreds(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer repo-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"output": {"parameters": [{"key1": "val1"}]}}`))
+		assert.NoError(t, err)
+	})
+	fakeServer := httptest.NewServer(handler)
+	defer fakeServer.Close()
+
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "repo-creds-plugin-cm",
+			Namespace: "default",
+		},
+		Data: map[string]string{
+			"baseUrl":        fakeServer.URL,
+			"credentialsURL": fakeServer.URL,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithObjects(configmap).Build()
+	generatorConfig := argoprojiov1alpha1.ApplicationSetGenerator{
+		Plugin: &argoprojiov1alpha1.PluginGenerator{
+			ConfigMapRef: argoprojiov1alpha1.PluginConfigMapRef{Name: configmap.Name},
+		},
+	}
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			GoTemplate: true,
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		pluginGenerator := NewPluginGenerator(fakeClient, "default", nil)
+		_, err := pluginGenerator.GenerateParams(&generatorConfig, &applicationSetInfo, nil)
+		assert.ErrorIs(t, err, ErrRepoCredsDisabled)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		repoCreds := fakeRepoCreds{
+			fakeServer.URL: {Repo: fakeServer.URL, Password: "repo-token"},
+		}
+		pluginGenerator := NewPluginGenerator(fakeClient, "default", repoCreds)
+		got, err := pluginGenerator.GenerateParams(&generatorConfig, &applicationSetInfo, nil)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "val1", got[0]["key1"])
+	})
+
+	t.Run("credentials of another host", func(t *testing.T) {
+		repoCreds := fakeRepoCreds{
+			"https://plugin.example.com": {Repo: "https://plugin.example.com", Password: "repo-token"},
+		}
+		configmap := configmap.DeepCopy()
+		configmap.Data["credentialsURL"] = "https://plugin.example.com"
+		pluginGenerator := NewPluginGenerator(fake.NewClientBuilder().WithObjects(configmap).Build(), "default", repoCreds)
+		_, err := pluginGenerator.GenerateParams(&generatorConfig, &applicationSetInfo, nil)
+		assert.ErrorContains(t, err, "does not have the host of the SCM API")
+	})
+}
@@ -126,6 +126,25 @@ func (g *PullRequestGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return params, nil
 }
 
+// pullRequestAPIURL returns the URL of the API of the pull request provider, including the default API of the hosted
+// providers
+func pullRequestAPIURL(generatorConfig *argoprojiov1alpha1.PullRequestGenerator) string {
+	if apiURL := generatorConfig.CustomApiUrl(); apiURL != "" {
+		return apiURL
+	}
+	switch {
+	case generatorConfig.Github != nil:
+		return "https://api.github.com"
+	case generatorConfig.GitLab != nil:
+		return "https://gitlab.com"
+	case generatorConfig.Bitbucket != nil:
+		return "https://api.bitbucket.org"
+	case generatorConfig.AzureDevOps != nil:
+		return pullrequest.AZURE_DEVOPS_DEFAULT_URL
+	}
+	return ""
+}
+
 // selectServiceProvider selects the provider to get pull requests from the configuration
 func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, generatorConfig *argoprojiov1alpha1.PullRequestGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
 	if !g.enableSCMProviders {
@@ -134,9 +153,13 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	if err := ScmProviderAllowed(applicationSetInfo, generatorConfig, g.allowedSCMProviders); err != nil {
 		return nil, fmt.Errorf("scm provider not allowed: %w", err)
 	}
+	repoCreds, err := getRepoCreds(ctx, g.repoCreds, generatorConfig.CredentialsURL, pullRequestAPIURL(generatorConfig), resolveProjectName(applicationSetInfo.Spec.Template.Spec.Project))
+	if err != nil {
+		return nil, err
+	}
 
 	if generatorConfig.Github != nil {
-		return g.github(ctx, generatorConfig.Github, applicationSetInfo)
+		return g.github(ctx, generatorConfig.Github, applicationSetInfo, repoCreds)
 	}
 	if generatorConfig.GitLab != nil {
 		providerConfig := generatorConfig.GitLab
@@ -148,7 +171,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", prErr)
 			}
 		}
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
@@ -156,7 +179,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	}
 	if generatorConfig.Gitea != nil {
 		providerConfig := generatorConfig.Gitea
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
@@ -185,6 +208,10 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
 			return pullrequest.NewBitbucketServiceBasicAuth(ctx, providerConfig.BasicAuth.Username, password, providerConfig.API, providerConfig.Project, providerConfig.Repo, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
+		} else if repoCreds != nil && repoCreds.BearerToken != "" {
+			return pullrequest.NewBitbucketServiceBearerToken(ctx, repoCreds.BearerToken, providerConfig.API, providerConfig.Project, providerConfig.Repo, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
+		} else if repoCreds != nil {
+			return pullrequest.NewBitbucketServiceBasicAuth(ctx, repoCreds.Username, repoCreds.Password, providerConfig.API, providerConfig.Project, providerConfig.Repo, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
 		}
 		return pullrequest.NewBitbucketServiceNoAuth(ctx, providerConfig.API, providerConfig.Project, providerConfig.Repo, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
 	}
@@ -202,12 +229,16 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
 			return pullrequest.NewBitbucketCloudServiceBasicAuth(providerConfig.API, providerConfig.BasicAuth.Username, password, providerConfig.Owner, providerConfig.Repo)
+		} else if repoCreds != nil && repoCreds.BearerToken != "" {
+			return pullrequest.NewBitbucketCloudServiceBearerToken(providerConfig.API, repoCreds.BearerToken, providerConfig.Owner, providerConfig.Repo)
+		} else if repoCreds != nil {
+			return pullrequest.NewBitbucketCloudServiceBasicAuth(providerConfig.API, repoCreds.Username, repoCreds.Password, providerConfig.Owner, providerConfig.Repo)
 		}
 		return pullrequest.NewBitbucketCloudServiceNoAuth(providerConfig.API, providerConfig.Owner, providerConfig.Repo)
 	}
 	if generatorConfig.AzureDevOps != nil {
 		providerConfig := generatorConfig.AzureDevOps
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Secret token: %w", err)
 		}
@@ -216,7 +247,7 @@ func (g *PullRequestGenerator) selectServiceProvider(ctx context.Context, genera
 	return nil, errors.New("no Pull Request provider implementation configured")
 }
 
-func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alpha1.PullRequestGeneratorGithub, applicationSetInfo *argoprojiov1alpha1.ApplicationSet) (pullrequest.PullRequestService, error) {
+func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alpha1.PullRequestGeneratorGithub, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, repoCreds *argoprojiov1alpha1.Repository) (pullrequest.PullRequestService, error) {
 	httpClient := g.newSCMHTTPClient()
 	if g.enableGitHubAPIMetrics {
 		metricsCtx := &services.MetricsContext{
@@ -234,9 +265,12 @@ func (g *PullRequestGenerator) github(ctx context.Context, cfg *argoprojiov1alph
 		}
 		return pullrequest.NewGithubAppService(ctx, *auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
 	}
+	if auth := repoCredsGitHubApp(repoCreds); auth != nil && cfg.TokenRef == nil {
+		return pullrequest.NewGithubAppService(ctx, *auth, cfg.API, cfg.Owner, cfg.Repo, cfg.Labels, httpClient)
+	}
 
 	// always default to token, even if not set (public access)
-	token, err := utils.GetSecretRef(ctx, g.client, cfg.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	token, err := g.getToken(ctx, g.client, cfg.TokenRef, applicationSetInfo.Namespace, repoCreds)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching Secret token: %w", err)
 	}
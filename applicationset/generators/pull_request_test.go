@@ -441,3 +441,27 @@ func TestSCMProviderDisabled_PRGenerator(t *testing.T) {
 	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
 	assert.ErrorIs(t, err, ErrSCMProvidersDisabled)
 }
+
+func TestRepoCredsDisabled_PRGenerator(t *testing.T) {
+	generator := NewPullRequestGenerator(nil, NewSCMConfig("", []string{}, true, false, nil, true))
+
+	applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "set",
+		},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+				PullRequest: &argoprojiov1alpha1.PullRequestGenerator{
+					Github: &argoprojiov1alpha1.PullRequestGeneratorGithub{
+						Owner: "argoproj",
+						Repo:  "argo-cd",
+					},
+					CredentialsURL: "https://github.com/argoproj",
+				},
+			}},
+		},
+	}
+
+	_, err := generator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+	assert.ErrorIs(t, err, ErrRepoCredsDisabled)
+}
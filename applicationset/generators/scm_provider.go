@@ -44,6 +44,7 @@ type SCMConfig struct {
 	tokenRefStrictMode     bool
 	scmProxyURL            string
 	scmNoProxy             string
+	repoCreds              RepoCredsGetter
 }
 
 func NewSCMConfig(scmRootCAPath string, allowedSCMProviders []string, enableSCMProviders bool, enableGitHubAPIMetrics bool, gitHubApps github_app_auth.Credentials, tokenRefStrictMode bool, opts ...SCMConfigOpts) SCMConfig {
@@ -76,6 +77,13 @@ func WithProxyURL(scmProxyURL string) SCMConfigOpts {
 	}
 }
 
+// WithRepoCreds allows generators to use the Argo CD repository credentials matching their credentialsURL
+func WithRepoCreds(repoCreds RepoCredsGetter) SCMConfigOpts {
+	return func(config *SCMConfig) {
+		config.repoCreds = repoCreds
+	}
+}
+
 func NewSCMProviderGenerator(client client.Client, scmConfig SCMConfig) Generator {
 	return &SCMProviderGenerator{
 		client:    client,
@@ -163,14 +171,17 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	}
 
 	ctx := context.Background()
+	repoCreds, err := getRepoCreds(ctx, g.repoCreds, providerConfig.CredentialsURL, scmProviderAPIURL(providerConfig), resolveProjectName(applicationSetInfo.Spec.Template.Spec.Project))
+	if err != nil {
+		return nil, fmt.Errorf("scm provider: %w", err)
+	}
 	scmHTTPClient := g.newSCMHTTPClient()
 	var provider scm_provider.SCMProviderService
 	switch {
 	case g.overrideProvider != nil:
 		provider = g.overrideProvider
 	case providerConfig.Github != nil:
-		var err error
-		provider, err = g.githubProvider(ctx, providerConfig.Github, applicationSetInfo, scmHTTPClient)
+		provider, err = g.githubProvider(ctx, providerConfig.Github, applicationSetInfo, scmHTTPClient, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("scm provider: %w", err)
 		}
@@ -184,7 +195,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 				return nil, fmt.Errorf("error fetching CA certificates from ConfigMap: %w", scmError)
 			}
 		}
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.TokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Gitlab token: %w", err)
 		}
@@ -193,7 +204,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			return nil, fmt.Errorf("error initializing Gitlab service: %w", err)
 		}
 	case providerConfig.Gitea != nil:
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.Gitea.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.Gitea.TokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Gitea token: %w", err)
 		}
@@ -224,6 +235,10 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 				return nil, fmt.Errorf("error fetching Secret token: %w", err)
 			}
 			provider, scmError = scm_provider.NewBitbucketServerProviderBasicAuth(ctx, providerConfig.BasicAuth.Username, password, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
+		case repoCreds != nil && repoCreds.BearerToken != "":
+			provider, scmError = scm_provider.NewBitbucketServerProviderBearerToken(ctx, repoCreds.BearerToken, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
+		case repoCreds != nil:
+			provider, scmError = scm_provider.NewBitbucketServerProviderBasicAuth(ctx, repoCreds.Username, repoCreds.Password, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
 		default:
 			provider, scmError = scm_provider.NewBitbucketServerProviderNoAuth(ctx, providerConfig.API, providerConfig.Project, providerConfig.AllBranches, g.scmRootCAPath, providerConfig.Insecure, caCerts, g.scmProxyURL, g.scmNoProxy)
 		}
@@ -231,7 +246,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			return nil, fmt.Errorf("error initializing Bitbucket Server service: %w", scmError)
 		}
 	case providerConfig.AzureDevOps != nil:
-		token, err := utils.GetSecretRef(ctx, g.client, providerConfig.AzureDevOps.AccessTokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		token, err := g.getToken(ctx, g.client, providerConfig.AzureDevOps.AccessTokenRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Azure Devops access token: %w", err)
 		}
@@ -240,7 +255,7 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 			return nil, fmt.Errorf("error initializing Azure Devops service: %w", err)
 		}
 	case providerConfig.Bitbucket != nil:
-		appPassword, err := utils.GetSecretRef(ctx, g.client, providerConfig.Bitbucket.AppPasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+		appPassword, err := g.getToken(ctx, g.client, providerConfig.Bitbucket.AppPasswordRef, applicationSetInfo.Namespace, repoCreds)
 		if err != nil {
 			return nil, fmt.Errorf("error fetching Bitbucket cloud appPassword: %w", err)
 		}
@@ -294,7 +309,25 @@ func (g *SCMProviderGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha
 	return paramsArray, nil
 }
 
-func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argoprojiov1alpha1.SCMProviderGeneratorGithub, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, baseHTTPClient *http.Client) (scm_provider.SCMProviderService, error) {
+// scmProviderAPIURL returns the URL of the API of the SCM provider, including the default API of the hosted providers
+func scmProviderAPIURL(providerConfig *argoprojiov1alpha1.SCMProviderGenerator) string {
+	if apiURL := providerConfig.CustomApiUrl(); apiURL != "" {
+		return apiURL
+	}
+	switch {
+	case providerConfig.Github != nil:
+		return "https://api.github.com"
+	case providerConfig.Gitlab != nil:
+		return "https://gitlab.com"
+	case providerConfig.AzureDevOps != nil:
+		return scm_provider.AZURE_DEVOPS_DEFAULT_URL
+	case providerConfig.Bitbucket != nil:
+		return "https://api.bitbucket.org"
+	}
+	return ""
+}
+
+func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argoprojiov1alpha1.SCMProviderGeneratorGithub, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, baseHTTPClient *http.Client, repoCreds *argoprojiov1alpha1.Repository) (scm_provider.SCMProviderService, error) {
 	httpClient := baseHTTPClient
 	if g.enableGitHubAPIMetrics {
 		metricsCtx := &services.MetricsContext{
@@ -311,8 +344,11 @@ func (g *SCMProviderGenerator) githubProvider(ctx context.Context, github *argop
 		}
 		return scm_provider.NewGithubAppProviderFor(ctx, *auth, github.Organization, github.API, github.AllBranches, github.ExcludeArchivedRepos, httpClient)
 	}
+	if auth := repoCredsGitHubApp(repoCreds); auth != nil && github.TokenRef == nil {
+		return scm_provider.NewGithubAppProviderFor(ctx, *auth, github.Organization, github.API, github.AllBranches, github.ExcludeArchivedRepos, httpClient)
+	}
 
-	token, err := utils.GetSecretRef(ctx, g.client, github.TokenRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+	token, err := g.getToken(ctx, g.client, github.TokenRef, applicationSetInfo.Namespace, repoCreds)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching Github token: %w", err)
 	}
@@ -1,12 +1,15 @@
 package generators
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
 	"github.com/argoproj/argo-cd/v3/applicationset/services/scm_provider"
@@ -298,6 +301,106 @@ func TestSCMProviderDisabled_SCMGenerator(t *testing.T) {
 	assert.ErrorIs(t, err, ErrSCMProvidersDisabled)
 }
 
+// fakeRepoCreds returns the repository of a URL, or a repository without credentials if it is unknown
+type fakeRepoCreds map[string]*argoprojiov1alpha1.Repository
+
+func (f fakeRepoCreds) GetRepository(_ context.Context, repoURL, _ string) (*argoprojiov1alpha1.Repository, error) {
+	if repo, ok := f[repoURL]; ok {
+		return repo, nil
+	}
+	return &argoprojiov1alpha1.Repository{Repo: repoURL}, nil
+}
+
+func TestSCMProviderRepoCreds(t *testing.T) {
+	repoCreds := fakeRepoCreds{
+		"https://github.com/argoproj":   {Repo: "https://github.com/argoproj", Password: "repo-token"},
+		"https://bitbucket.example.com": {Repo: "https://bitbucket.example.com", Password: "password", BearerToken: "bearer-token"},
+		"https://github.com/other":      {Repo: "https://github.com/other", Password: "other-token", Project: "other"},
+		"https://github.com/argoproj-labs": {
+			Repo:                    "https://github.com/argoproj-labs",
+			GithubAppId:             123,
+			GithubAppInstallationId: 456,
+			GithubAppPrivateKey:     "private key",
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		generator := &SCMProviderGenerator{SCMConfig: SCMConfig{enableSCMProviders: true}}
+		appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+			SCMProvider: &argoprojiov1alpha1.SCMProviderGenerator{
+				Github:         &argoprojiov1alpha1.SCMProviderGeneratorGithub{Organization: "argoproj"},
+				CredentialsURL: "https://github.com/argoproj",
+			},
+		}
+		_, err := generator.GenerateParams(appSetGenerator, &argoprojiov1alpha1.ApplicationSet{}, nil)
+		assert.ErrorIs(t, err, ErrRepoCredsDisabled)
+	})
+
+	t.Run("no URL", func(t *testing.T) {
+		repo, err := getRepoCreds(t.Context(), repoCreds, "", "https://api.github.com", "default")
+		require.NoError(t, err)
+		assert.Nil(t, repo)
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		_, err := getRepoCreds(t.Context(), repoCreds, "https://gitlab.com/argoproj", "https://gitlab.com", "default")
+		assert.EqualError(t, err, "no repository credentials found for https://gitlab.com/argoproj")
+	})
+
+	t.Run("credentials of another project", func(t *testing.T) {
+		_, err := getRepoCreds(t.Context(), repoCreds, "https://github.com/other", "https://api.github.com", "default")
+		require.EqualError(t, err, "no repository credentials found for https://github.com/other")
+
+		repo, err := getRepoCreds(t.Context(), repoCreds, "https://github.com/other", "https://api.github.com", "other")
+		require.NoError(t, err)
+		assert.Equal(t, "other-token", repo.Password)
+	})
+
+	t.Run("credentials of another host", func(t *testing.T) {
+		_, err := getRepoCreds(t.Context(), repoCreds, "https://github.com/argoproj", "https://attacker.example.com", "default")
+		require.EqualError(t, err, "credentials URL https://github.com/argoproj does not have the host of the SCM API https://attacker.example.com")
+
+		_, err = getRepoCreds(t.Context(), repoCreds, "https://github.com/argoproj", "", "default")
+		assert.Error(t, err)
+	})
+
+	t.Run("token from repository credentials", func(t *testing.T) {
+		config := NewSCMConfig("", nil, true, false, nil, false, WithRepoCreds(repoCreds))
+		repo, err := getRepoCreds(t.Context(), config.repoCreds, "https://github.com/argoproj", "https://api.github.com", "default")
+		require.NoError(t, err)
+		token, err := config.getToken(t.Context(), nil, nil, "argocd", repo)
+		require.NoError(t, err)
+		assert.Equal(t, "repo-token", token)
+
+		repo, err = getRepoCreds(t.Context(), config.repoCreds, "https://bitbucket.example.com", "https://bitbucket.example.com/rest", "default")
+		require.NoError(t, err)
+		token, err = config.getToken(t.Context(), nil, nil, "argocd", repo)
+		require.NoError(t, err)
+		assert.Equal(t, "bearer-token", token)
+	})
+
+	t.Run("secret reference takes precedence", func(t *testing.T) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "scm-token", Namespace: "argocd"},
+			Data:       map[string][]byte{"token": []byte("secret-token")},
+		}
+		k8sClient := fake.NewClientBuilder().WithObjects(secret).Build()
+		config := NewSCMConfig("", nil, true, false, nil, false, WithRepoCreds(repoCreds))
+		token, err := config.getToken(t.Context(), k8sClient, &argoprojiov1alpha1.SecretRef{SecretName: "scm-token", Key: "token"}, "argocd", repoCreds["https://github.com/argoproj"])
+		require.NoError(t, err)
+		assert.Equal(t, "secret-token", token)
+	})
+
+	t.Run("GitHub App from repository credentials", func(t *testing.T) {
+		assert.Nil(t, repoCredsGitHubApp(repoCreds["https://github.com/argoproj"]))
+		assert.Equal(t, &github_app_auth.Authentication{
+			Id:             123,
+			InstallationId: 456,
+			PrivateKey:     "private key",
+		}, repoCredsGitHubApp(repoCreds["https://github.com/argoproj-labs"]))
+	})
+}
+
 func TestNewSCMHTTPClient(t *testing.T) {
 	t.Run("no proxy", func(t *testing.T) {
 		g := &SCMProviderGenerator{
@@ -407,3 +510,13 @@ func TestGithubProvider_ProxyWithoutMetrics(t *testing.T) {
 		assert.Nil(t, httpClient.Transport) // bare &http.Client{} — uses DefaultTransport
 	})
 }
+
+func TestCredentialsURLMatchesAPI(t *testing.T) {
+	assert.True(t, credentialsURLMatchesAPI("https://github.com/argoproj", "https://api.github.com"))
+	assert.True(t, credentialsURLMatchesAPI("https://ghe.example.com/org", "https://ghe.example.com/api/v3"))
+	assert.True(t, credentialsURLMatchesAPI("https://GitLab.com/group", "https://gitlab.com"))
+	assert.False(t, credentialsURLMatchesAPI("https://github.com/argoproj", "https://github.com.attacker.example.com"))
+	assert.False(t, credentialsURLMatchesAPI("https://github.com/argoproj", "https://api.attacker.example.com"))
+	assert.False(t, credentialsURLMatchesAPI("git@github.com:argoproj/argo-cd.git", "https://api.github.com"))
+	assert.False(t, credentialsURLMatchesAPI("https://github.com/argoproj", ""))
+}
@@ -1,5 +1,95 @@
 package generators
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
 type SCMGeneratorWithCustomApiUrl interface { //nolint:revive //FIXME(var-naming)
 	CustomApiUrl() string
 }
+
+// RepoCredsGetter returns the repository matching a URL, including the credentials it inherits from a matching
+// repository credential template.
+type RepoCredsGetter interface {
+	GetRepository(ctx context.Context, repoURL, project string) (*argoprojiov1alpha1.Repository, error)
+}
+
+var ErrRepoCredsDisabled = errors.New("using repository credentials in generators is disabled")
+
+// getRepoCreds returns the repository credentials matching credentialsURL, or nil if no URL is given. Only the
+// credentials of the given project and the global ones are returned, and the credentials URL must have the host of the
+// API the credentials are sent to, so that an ApplicationSet cannot send them to a server of its choosing. Credentials
+// are looked up on every call, so rotated secrets are picked up on the next reconciliation.
+func getRepoCreds(ctx context.Context, repoCreds RepoCredsGetter, credentialsURL string, apiURL string, project string) (*argoprojiov1alpha1.Repository, error) {
+	if credentialsURL == "" {
+		return nil, nil
+	}
+	if repoCreds == nil {
+		return nil, ErrRepoCredsDisabled
+	}
+	if !credentialsURLMatchesAPI(credentialsURL, apiURL) {
+		return nil, fmt.Errorf("credentials URL %s does not have the host of the SCM API %s", credentialsURL, apiURL)
+	}
+	repo, err := repoCreds.GetRepository(ctx, credentialsURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository credentials: %w", err)
+	}
+	if !repo.HasCredentials() || (repo.Project != "" && repo.Project != project) {
+		return nil, fmt.Errorf("no repository credentials found for %s", credentialsURL)
+	}
+	return repo, nil
+}
+
+// credentialsURLMatchesAPI returns whether the credentials URL has the host of the API URL. The API of the hosted
+// providers is served by the api subdomain of the host of their repositories, e.g. api.github.com for github.com.
+func credentialsURLMatchesAPI(credentialsURL string, apiURL string) bool {
+	credentials, err := url.Parse(credentialsURL)
+	if err != nil || credentials.Host == "" {
+		return false
+	}
+	api, err := url.Parse(apiURL)
+	if err != nil || api.Host == "" {
+		return false
+	}
+	return strings.EqualFold(api.Host, credentials.Host) || strings.EqualFold(api.Host, "api."+credentials.Host)
+}
+
+// getToken returns the value of the referenced secret key if a reference is given, or the token of the repository
+// credentials otherwise.
+func (g *SCMConfig) getToken(ctx context.Context, k8sClient client.Client, ref *argoprojiov1alpha1.SecretRef, namespace string, repoCreds *argoprojiov1alpha1.Repository) (string, error) {
+	if ref == nil && repoCreds != nil {
+		return repoCredsToken(repoCreds), nil
+	}
+	return utils.GetSecretRef(ctx, k8sClient, ref, namespace, g.tokenRefStrictMode)
+}
+
+// repoCredsToken returns the bearer token of the repository credentials if set, or the password otherwise.
+func repoCredsToken(repoCreds *argoprojiov1alpha1.Repository) string {
+	if repoCreds.BearerToken != "" {
+		return repoCreds.BearerToken
+	}
+	return repoCreds.Password
+}
+
+// repoCredsGitHubApp returns the GitHub App of the repository credentials, or nil if they do not hold one.
+func repoCredsGitHubApp(repoCreds *argoprojiov1alpha1.Repository) *github_app_auth.Authentication {
+	if repoCreds == nil || repoCreds.GithubAppPrivateKey == "" {
+		return nil
+	}
+	return &github_app_auth.Authentication{
+		Id:                repoCreds.GithubAppId,
+		InstallationId:    repoCreds.GithubAppInstallationId,
+		EnterpriseBaseURL: repoCreds.GitHubAppEnterpriseBaseURL,
+		PrivateKey:        repoCreds.GithubAppPrivateKey,
+	}
+}
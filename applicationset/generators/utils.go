@@ -11,16 +11,16 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, clusterInformer *settings.ClusterInformer) map[string]Generator {
-	terminalGenerators := map[string]Generator{
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, controllerNamespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, clusterInformer *settings.ClusterInformer, generatorMetrics GeneratorMetrics) map[string]Generator {
+	terminalGenerators := WithMetrics(map[string]Generator{
 		"List":                    NewListGenerator(),
 		"Clusters":                NewClusterGenerator(c, controllerNamespace),
 		"Git":                     NewGitGenerator(argoCDService, controllerNamespace),
 		"SCMProvider":             NewSCMProviderGenerator(c, scmConfig),
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, controllerNamespace, clusterInformer),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
-		"Plugin":                  NewPluginGenerator(c, controllerNamespace),
-	}
+		"Plugin":                  NewPluginGenerator(c, controllerNamespace, scmConfig.repoCreds),
+	}, generatorMetrics)
 
 	nestedGenerators := map[string]Generator{
 		"List":                    terminalGenerators["List"],
@@ -30,8 +30,12 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
-		"Matrix":                  NewMatrixGenerator(terminalGenerators),
-		"Merge":                   NewMergeGenerator(terminalGenerators),
+	}
+	for name, g := range WithMetrics(map[string]Generator{
+		"Matrix": NewMatrixGenerator(terminalGenerators),
+		"Merge":  NewMergeGenerator(terminalGenerators),
+	}, generatorMetrics) {
+		nestedGenerators[name] = g
 	}
 
 	topLevelGenerators := map[string]Generator{
@@ -42,8 +46,12 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
-		"Matrix":                  NewMatrixGenerator(nestedGenerators),
-		"Merge":                   NewMergeGenerator(nestedGenerators),
+	}
+	for name, g := range WithMetrics(map[string]Generator{
+		"Matrix": NewMatrixGenerator(nestedGenerators),
+		"Merge":  NewMergeGenerator(nestedGenerators),
+	}, generatorMetrics) {
+		topLevelGenerators[name] = g
 	}
 
 	return topLevelGenerators
@@ -15,7 +15,11 @@ func NewFakeAppsetMetrics() *ApplicationsetMetrics {
 		[]string{"name", "namespace"},
 	)
 
+	generatorDurationHistogram, generatorItemsHistogram := newGeneratorHistograms()
+
 	return &ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:         reconcileHistogram,
+		generatorDurationHistogram: generatorDurationHistogram,
+		generatorItemsHistogram:    generatorItemsHistogram,
 	}
 }
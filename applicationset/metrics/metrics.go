@@ -32,7 +32,9 @@ var (
 )
 
 type ApplicationsetMetrics struct {
-	reconcileHistogram *prometheus.HistogramVec
+	reconcileHistogram         *prometheus.HistogramVec
+	generatorDurationHistogram *prometheus.HistogramVec
+	generatorItemsHistogram    *prometheus.HistogramVec
 }
 
 type appsetCollector struct {
@@ -52,24 +54,59 @@ func NewApplicationsetMetrics(appsetLister applisters.ApplicationSetLister, apps
 		descAppsetDefaultLabels,
 	)
 
+	generatorDurationHistogram, generatorItemsHistogram := newGeneratorHistograms()
+
 	appsetCollector := newAppsetCollector(appsetLister, appsetLabels, appsetFilter)
 
 	// Register collectors and metrics
 	metrics.Registry.MustRegister(reconcileHistogram)
+	metrics.Registry.MustRegister(generatorDurationHistogram)
+	metrics.Registry.MustRegister(generatorItemsHistogram)
 	metrics.Registry.MustRegister(appsetCollector)
 
 	kubectl.RegisterWithClientGo()
 	kubectl.RegisterWithPrometheus(metrics.Registry)
 
 	return ApplicationsetMetrics{
-		reconcileHistogram: reconcileHistogram,
+		reconcileHistogram:         reconcileHistogram,
+		generatorDurationHistogram: generatorDurationHistogram,
+		generatorItemsHistogram:    generatorItemsHistogram,
 	}
 }
 
+func newGeneratorHistograms() (*prometheus.HistogramVec, *prometheus.HistogramVec) {
+	generatorDurationHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_appset_generator_duration_seconds",
+			Help:    "ApplicationSet generator evaluation latency in seconds.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60},
+		},
+		append(descAppsetDefaultLabels, "generator"),
+	)
+
+	generatorItemsHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_appset_generator_items",
+			Help:    "Number of parameter sets produced by an ApplicationSet generator evaluation.",
+			Buckets: []float64{0, 1, 5, 10, 25, 50, 100, 250, 500, 1000},
+		},
+		append(descAppsetDefaultLabels, "generator"),
+	)
+
+	return generatorDurationHistogram, generatorItemsHistogram
+}
+
 func (m *ApplicationsetMetrics) ObserveReconcile(appset *argoappv1.ApplicationSet, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(appset.Namespace, appset.Name).Observe(duration.Seconds())
 }
 
+// ObserveGeneratorEvaluation records how long a single generator took to produce its parameters for the given
+// applicationset, and how many parameter sets it produced.
+func (m *ApplicationsetMetrics) ObserveGeneratorEvaluation(appset *argoappv1.ApplicationSet, generator string, duration time.Duration, items int) {
+	m.generatorDurationHistogram.WithLabelValues(appset.Namespace, appset.Name, generator).Observe(duration.Seconds())
+	m.generatorItemsHistogram.WithLabelValues(appset.Namespace, appset.Name, generator).Observe(float64(items))
+}
+
 func newAppsetCollector(lister applisters.ApplicationSetLister, labels []string, filter func(appset *argoappv1.ApplicationSet) bool) *appsetCollector {
 	descAppsetDefaultLabels = []string{"namespace", "name"}
 
@@ -231,6 +231,35 @@ argocd_appset_reconcile_count{name="test1",namespace="argocd"} 1
 `)
 }
 
+func TestObserveGeneratorEvaluation(t *testing.T) {
+	appsetList := newFakeAppsets(fakeAppsetList)
+	client := initializeClient(appsetList)
+	metrics.Registry = prometheus.NewRegistry()
+
+	appsetMetrics := NewApplicationsetMetrics(utils.NewAppsetLister(client), collectedLabels, filter)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	handler := promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+	appsetMetrics.ObserveGeneratorEvaluation(&appsetList[0], "Git", 2*time.Second, 3)
+	appsetMetrics.ObserveGeneratorEvaluation(&appsetList[0], "Git", 1*time.Second, 4)
+	appsetMetrics.ObserveGeneratorEvaluation(&appsetList[0], "List", 1*time.Second, 1)
+	handler.ServeHTTP(rr, req)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_duration_seconds_sum{generator="Git",name="test1",namespace="argocd"} 3
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_duration_seconds_count{generator="Git",name="test1",namespace="argocd"} 2
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_items_sum{generator="Git",name="test1",namespace="argocd"} 7
+`)
+	assert.Contains(t, rr.Body.String(), `
+argocd_appset_generator_items_count{generator="List",name="test1",namespace="argocd"} 1
+`)
+}
+
 func initializeClient(appsets []argoappv1.ApplicationSet) ctrlclient.WithWatch {
 	scheme := runtime.NewScheme()
 	err := argoappv1.AddToScheme(scheme)
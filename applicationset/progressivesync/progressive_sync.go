@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 	"sort"
@@ -21,12 +22,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
+	// SyncWaveStrategyType rolls out the generated Applications in the order of their sync wave annotation
+	SyncWaveStrategyType      = "SyncWave"
 	ReverseDeletionOrder      = "Reverse"
 	AllAtOnceDeletionOrder    = "AllAtOnce"
 	revisionAndSpecChangedMsg = "Application has pending changes (revision and spec differ), setting status to Waiting"
@@ -113,7 +117,6 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, currentApps []argov1alpha1.Application) (time.Duration, error) {
 	requeueTime := 10 * time.Second
-	stepLength := len(appset.Spec.Strategy.RollingSync.Steps)
 
 	// map applications by name using current applications
 	appMap := make(map[string]*argov1alpha1.Application)
@@ -122,7 +125,8 @@ func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry,
 	}
 
 	// Get Rolling Sync Step Maps
-	_, appStepMap, _ := buildAppDependencyList(logCtx, appset, currentApps)
+	appDependencyList, appStepMap, _ := buildAppDependencyList(logCtx, appset, currentApps)
+	stepLength := len(appDependencyList)
 	// reverse the AppStepMap to perform deletion
 	var reverseDeleteAppSteps []deleteInOrder
 	for appName, appStep := range appStepMap {
@@ -176,6 +180,9 @@ func buildAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.Appli
 	if applicationSet.Spec.Strategy == nil || applicationSet.Spec.Strategy.Type == "" || applicationSet.Spec.Strategy.Type == "AllAtOnce" {
 		return [][]string{}, map[string]int{}, issues
 	}
+	if IsSyncWaveStrategy(&applicationSet) {
+		return buildSyncWaveDependencyList(applicationSet, applications)
+	}
 
 	steps := []argov1alpha1.ApplicationSetRolloutStep{}
 	if RollingSyncStrategyEnabled(&applicationSet) {
@@ -245,6 +252,41 @@ func buildAppDependencyList(logCtx *log.Entry, applicationSet argov1alpha1.Appli
 	return appDependencyList, appStepMap, issues
 }
 
+// buildSyncWaveDependencyList puts the Applications of each sync wave into a step, in ascending order of the waves.
+// Applications with an invalid sync wave are not put into any step, so they are not synced.
+func buildSyncWaveDependencyList(applicationSet argov1alpha1.ApplicationSet, applications []argov1alpha1.Application) ([][]string, map[string]int, *ValidationIssues) {
+	issues := &ValidationIssues{}
+
+	appWaves := map[string]int{}
+	for _, app := range applications {
+		wave := 0
+		if val, ok := app.Annotations[common.AnnotationApplicationSetSyncWave]; ok {
+			var err error
+			wave, err = strconv.Atoi(strings.TrimSpace(val))
+			if err != nil {
+				log.WithField("appset", applicationSet.Name).Warnf("Application '%v' has an invalid sync wave %q: %v", app.Name, val, err)
+				if issues.InvalidSyncWaves == nil {
+					issues.InvalidSyncWaves = map[string]string{}
+				}
+				issues.InvalidSyncWaves[app.Name] = val
+				continue
+			}
+		}
+		appWaves[app.Name] = wave
+	}
+
+	waves := slices.Compact(slices.Sorted(maps.Values(appWaves)))
+	appDependencyList := make([][]string, len(waves))
+	appStepMap := map[string]int{}
+	for _, appName := range slices.Sorted(maps.Keys(appWaves)) {
+		step, _ := slices.BinarySearch(waves, appWaves[appName])
+		appDependencyList[step] = append(appDependencyList[step], appName)
+		appStepMap[appName] = step
+	}
+
+	return appDependencyList, appStepMap, issues
+}
+
 func labelMatchedExpression(val string, matchExpression argov1alpha1.ApplicationMatchExpression) (bool, error) {
 	if matchExpression.Operator != "In" && matchExpression.Operator != "NotIn" {
 		return false, fmt.Errorf("skipping AppSet rollingUpdate step Application selection, invalid matchExpression operator provided: %q ", matchExpression.Operator)
@@ -479,9 +521,24 @@ func RollingSyncStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
 	return IsRollingSyncStrategy(appset) && len(appset.Spec.Strategy.RollingSync.Steps) > 0
 }
 
+// IsSyncWaveStrategy returns whether the generated Applications are rolled out in the order of their sync waves
+func IsSyncWaveStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == SyncWaveStrategyType
+}
+
+// IsProgressiveSyncStrategy returns whether the ApplicationSet uses the RollingSync or the SyncWave strategy
+func IsProgressiveSyncStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	return IsRollingSyncStrategy(appset) || IsSyncWaveStrategy(appset)
+}
+
+// ProgressiveSyncStrategyEnabled returns whether the generated Applications are rolled out in steps
+func ProgressiveSyncStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
+	return RollingSyncStrategyEnabled(appset) || IsSyncWaveStrategy(appset)
+}
+
 func IsDeletionOrderReversed(appset *argov1alpha1.ApplicationSet) bool {
 	// When progressive sync is enabled + deletionOrder is set to Reverse (case-insensitive)
-	return RollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.DeletionOrder, ReverseDeletionOrder)
+	return ProgressiveSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.DeletionOrder, ReverseDeletionOrder)
 }
 
 func isApplicationWithError(app argov1alpha1.Application) bool {
@@ -503,8 +560,8 @@ func (m *Manager) UpdateApplicationSetApplicationStatusProgress(ctx context.Cont
 	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
 
 	// if we have no RollingUpdate steps, clear out the existing ApplicationStatus entries
-	if RollingSyncStrategyEnabled(applicationSet) {
-		length := len(applicationSet.Spec.Strategy.RollingSync.Steps)
+	if ProgressiveSyncStrategyEnabled(applicationSet) {
+		length := stepCount(applicationSet, appStepMap)
 
 		updateCountMap := make([]int, length)
 		totalCountMap := make([]int, length)
@@ -528,7 +585,7 @@ func (m *Manager) UpdateApplicationSetApplicationStatusProgress(ctx context.Cont
 			})
 
 			maxUpdateAllowed := true
-			maxUpdate := &intstr.IntOrString{}
+			var maxUpdate *intstr.IntOrString
 			if RollingSyncStrategyEnabled(applicationSet) {
 				maxUpdate = applicationSet.Spec.Strategy.RollingSync.Steps[appStepMap[appStatus.Application]].MaxUpdate
 			}
@@ -597,8 +654,21 @@ func (m *Manager) UpdateApplicationSetApplicationStatusProgress(ctx context.Cont
 	return appStatuses, nil
 }
 
+// stepCount returns the number of steps of the rollout of the ApplicationSet
+func stepCount(applicationSet *argov1alpha1.ApplicationSet, appStepMap map[string]int) int {
+	if RollingSyncStrategyEnabled(applicationSet) {
+		return len(applicationSet.Spec.Strategy.RollingSync.Steps)
+	}
+	// the statuses of Applications which are in no step are counted in the first step, like with RollingSync
+	count := 1
+	for _, step := range appStepMap {
+		count = max(count, step+1)
+	}
+	return count
+}
+
 func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.ApplicationSet) *argov1alpha1.ApplicationSetCondition {
-	if !IsRollingSyncStrategy(applicationSet) {
+	if !IsProgressiveSyncStrategy(applicationSet) {
 		return nil
 	}
 	completedWaves := map[string]bool{}
@@ -610,16 +680,20 @@ func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.Applicati
 		}
 	}
 
+	// steps which have no applications are completed, and applications which are in no step (-1) are ignored
+	var steps []int
+	for step := range completedWaves {
+		if i, err := strconv.Atoi(step); err == nil && i > 0 {
+			steps = append(steps, i)
+		}
+	}
+	slices.Sort(steps)
+
 	isProgressing := false
 	progressingStep := ""
-	for i := range applicationSet.Spec.Strategy.RollingSync.Steps {
-		step := strconv.Itoa(i + 1)
-		isCompleted, ok := completedWaves[step]
-		if !ok {
-			// Step has no applications, so it is completed
-			continue
-		}
-		if !isCompleted {
+	for _, i := range steps {
+		step := strconv.Itoa(i)
+		if !completedWaves[step] {
 			isProgressing = true
 			progressingStep = step
 			break
@@ -644,7 +718,7 @@ func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.Applicati
 }
 
 func (m *Manager) getInvalidRolloutConfig(applicationSet *argov1alpha1.ApplicationSet) *argov1alpha1.ApplicationSetCondition {
-	if !IsRollingSyncStrategy(applicationSet) {
+	if !IsProgressiveSyncStrategy(applicationSet) {
 		return nil
 	}
 	if m.validationIssues.HasIssues() {
@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -919,6 +920,51 @@ func TestBuildAppDependencyList(t *testing.T) {
 	}
 }
 
+func TestBuildSyncWaveDependencyList(t *testing.T) {
+	t.Parallel()
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: SyncWaveStrategyType,
+			},
+		},
+	}
+	newApp := func(name string, wave string) v1alpha1.Application {
+		app := v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if wave != "" {
+			app.Annotations = map[string]string{common.AnnotationApplicationSetSyncWave: wave}
+		}
+		return app
+	}
+	apps := []v1alpha1.Application{
+		newApp("workload-b", "10"),
+		newApp("platform", ""),
+		newApp("workload-a", "10"),
+		newApp("infra", "-1"),
+		newApp("broken", "first"),
+	}
+
+	appDependencyList, appStepMap, validationIssues := buildAppDependencyList(log.NewEntry(log.StandardLogger()), appSet, apps)
+	assert.Equal(t, [][]string{{"infra"}, {"platform"}, {"workload-a", "workload-b"}}, appDependencyList)
+	assert.Equal(t, map[string]int{"infra": 0, "platform": 1, "workload-a": 2, "workload-b": 2}, appStepMap)
+	assert.Equal(t, &ValidationIssues{InvalidSyncWaves: map[string]string{"broken": "first"}}, validationIssues)
+
+	// the first wave must be healthy before the second wave is synced
+	appSet.Status.ApplicationStatus = []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "infra", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1"},
+		{Application: "platform", Status: v1alpha1.ProgressiveSyncProgressing, Step: "2"},
+	}
+	appsToSync := getAppsToSync(appSet, appDependencyList, apps)
+	assert.Equal(t, map[string]bool{"infra": true, "platform": true}, appsToSync)
+
+	condition := (&Manager{}).getProgressingCondition(&appSet)
+	assert.Equal(t, "ApplicationSet is performing rollout of step 2", condition.Message)
+}
+
 func TestGetAppsToSync(t *testing.T) {
 	t.Parallel()
 	scheme := runtime.NewScheme()
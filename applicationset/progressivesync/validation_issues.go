@@ -16,6 +16,7 @@ type ValidationIssues struct {
 	DuplicateAppSelections  map[string][]int
 	EmptySteps              []int // step indices (0-based) with no matching apps
 	InvalidMaxUpdates       []InvalidMaxUpdate
+	InvalidSyncWaves        map[string]string // application names to their sync wave annotation which is not an integer
 }
 
 // InvalidMatchExpression represents a step with an invalid matchExpression operator
@@ -36,7 +37,8 @@ func (v *ValidationIssues) HasIssues() bool {
 	return len(v.InvalidMatchExpressions) > 0 ||
 		len(v.DuplicateAppSelections) > 0 ||
 		len(v.EmptySteps) > 0 ||
-		len(v.InvalidMaxUpdates) > 0
+		len(v.InvalidMaxUpdates) > 0 ||
+		len(v.InvalidSyncWaves) > 0
 }
 
 func (v *ValidationIssues) alreadyExists(stepIndex int, operator string) bool {
@@ -101,6 +103,19 @@ func (v *ValidationIssues) formatInvalidMaxUpdateMessage() string {
 	return fmt.Sprintf("Steps %v have invalid maxUpdate values: [%v]", strings.Join(stepNums, ", "), strings.Join(values, ", "))
 }
 
+// formatInvalidSyncWaveMessage formats error message for invalid sync wave annotations
+func (v *ValidationIssues) formatInvalidSyncWaveMessage() string {
+	appNames := slices.Sorted(maps.Keys(v.InvalidSyncWaves))
+	values := make([]string, len(appNames))
+	for i, appName := range appNames {
+		values[i] = v.InvalidSyncWaves[appName]
+	}
+	if len(appNames) == 1 {
+		return fmt.Sprintf("Application '%s' has an invalid sync wave: %q. Sync waves must be integers", appNames[0], values[0])
+	}
+	return fmt.Sprintf("Applications '%s' have invalid sync waves: %q. Sync waves must be integers", strings.Join(appNames, "', '"), values)
+}
+
 // formatEmptyStepsMessage formats warning message for empty steps
 func (v *ValidationIssues) formatEmptyStepsMessage() string {
 	count := len(v.EmptySteps)
@@ -121,6 +136,8 @@ func (v *ValidationIssues) getConditionMessage() string {
 		rolloutMessage = v.formatDuplicateAppSelectionMessage()
 	case len(v.InvalidMaxUpdates) > 0:
 		rolloutMessage = v.formatInvalidMaxUpdateMessage()
+	case len(v.InvalidSyncWaves) > 0:
+		rolloutMessage = v.formatInvalidSyncWaveMessage()
 	case len(v.EmptySteps) > 0:
 		rolloutMessage = v.formatEmptyStepsMessage()
 	}
@@ -165,3 +165,11 @@ func TestFormatEmptyStepsMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatInvalidSyncWaveMessage(t *testing.T) {
+	issues := &ValidationIssues{InvalidSyncWaves: map[string]string{"app-b": "last"}}
+	assert.Equal(t, `Application 'app-b' has an invalid sync wave: "last". Sync waves must be integers`, issues.getConditionMessage())
+
+	issues.InvalidSyncWaves["app-a"] = "1.5"
+	assert.Equal(t, `Applications 'app-a', 'app-b' have invalid sync waves: ["1.5" "last"]. Sync waves must be integers`, issues.getConditionMessage())
+}
@@ -21,6 +21,7 @@ const (
 	githubAPIRateLimitLimitMetricName     = "argocd_github_api_rate_limit_limit"
 	githubAPIRateLimitResetMetricName     = "argocd_github_api_rate_limit_reset_seconds"
 	githubAPIRateLimitUsedMetricName      = "argocd_github_api_rate_limit_used"
+	githubAPIRateLimitedTotalMetricName   = "argocd_github_api_rate_limited_requests_total"
 )
 
 // GitHubMetrics groups all metric vectors for easier injection and registration
@@ -31,6 +32,7 @@ type GitHubMetrics struct {
 	RateLimitLimit     *prometheus.GaugeVec
 	RateLimitReset     *prometheus.GaugeVec
 	RateLimitUsed      *prometheus.GaugeVec
+	RateLimitedTotal   *prometheus.CounterVec
 }
 
 // Factory for a new set of GitHub metrics (for tests or custom registries)
@@ -42,6 +44,7 @@ func NewGitHubMetrics() *GitHubMetrics {
 		RateLimitLimit:     NewGitHubAPIRateLimitLimit(),
 		RateLimitReset:     NewGitHubAPIRateLimitReset(),
 		RateLimitUsed:      NewGitHubAPIRateLimitUsed(),
+		RateLimitedTotal:   NewGitHubAPIRateLimitedTotal(),
 	}
 }
 
@@ -107,6 +110,16 @@ func NewGitHubAPIRateLimitUsed() *prometheus.GaugeVec {
 	)
 }
 
+func NewGitHubAPIRateLimitedTotal() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: githubAPIRateLimitedTotalMetricName,
+			Help: "Total number of GitHub API requests rejected because a rate limit was exceeded",
+		},
+		[]string{"endpoint", "appset_namespace", "appset_name", "resource"},
+	)
+}
+
 // Global metrics (registered with the default registry)
 var globalGitHubMetrics = NewGitHubMetrics()
 
@@ -118,6 +131,7 @@ func init() {
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitLimit)
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitReset)
 	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitUsed)
+	metrics.Registry.MustRegister(globalGitHubMetrics.RateLimitedTotal)
 }
 
 type MetricsContext struct {
@@ -196,6 +210,10 @@ func (t *GitHubMetricsTransport) RoundTrip(req *http.Request) (*http.Response, e
 			}
 		}
 
+		if isRateLimited(resp) {
+			t.metrics.RateLimitedTotal.WithLabelValues(endpoint, appsetNamespace, appsetName, resource).Inc()
+		}
+
 		log.WithFields(log.Fields{
 			"endpoint":       endpoint,
 			"reset":          resetHumanReadableTime,
@@ -210,6 +228,18 @@ func (t *GitHubMetricsTransport) RoundTrip(req *http.Request) (*http.Response, e
 	return resp, err
 }
 
+// isRateLimited returns whether GitHub rejected the request because either the primary or a secondary rate limit
+// was exceeded. See https://docs.github.com/en/rest/using-the-rest-api/troubleshooting-the-rest-api#rate-limit-errors
+func isRateLimited(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
+
 // Full constructor (for tests and advanced use)
 func NewGitHubMetricsTransport(
 	transport http.RoundTripper,
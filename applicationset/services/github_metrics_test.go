@@ -12,7 +12,9 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type Metric struct {
@@ -192,6 +194,49 @@ func TestGitHubMetrics_CollectorApproach_NoRateLimitMetricsOnNilResponse(t *test
 	}
 }
 
+func TestGitHubMetrics_RateLimitedRequests(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   int
+		headers  map[string]string
+		expected float64
+	}{
+		{name: "success is not rate limited", status: http.StatusOK, headers: map[string]string{"X-RateLimit-Remaining": "42"}, expected: 0},
+		{name: "too many requests", status: http.StatusTooManyRequests, expected: 1},
+		{name: "primary rate limit exceeded", status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "0"}, expected: 1},
+		{name: "secondary rate limit exceeded", status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "10", "Retry-After": "60"}, expected: 1},
+		{name: "forbidden for another reason", status: http.StatusForbidden, headers: map[string]string{"X-RateLimit-Remaining": "10"}, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metrics := NewGitHubMetrics()
+			client := &http.Client{
+				Transport: NewGitHubMetricsTransport(
+					RoundTripperFunc(func(*http.Request) (*http.Response, error) {
+						header := http.Header{}
+						header.Set("X-RateLimit-Resource", "core")
+						for k, v := range tc.headers {
+							header.Set(k, v)
+						}
+						return &http.Response{StatusCode: tc.status, Header: header, Body: http.NoBody}, nil
+					}),
+					&MetricsContext{AppSetNamespace: appsetNamespace, AppSetName: appsetName},
+					metrics,
+				),
+			}
+
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, URL, http.NoBody)
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			resp.Body.Close()
+
+			assert.InDelta(t, tc.expected, testutil.ToFloat64(metrics.RateLimitedTotal.WithLabelValues(URL, appsetNamespace, appsetName, "core")), 0)
+		})
+	}
+}
+
 func TestNewGitHubMetricsClient(t *testing.T) {
 	// Test cases
 	testCases := []struct {
@@ -130,6 +130,18 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, dif
 	return controllerutil.OperationResultUpdated, nil
 }
 
+// NormalizeApplicationsForDiff returns copies of the live and desired applications normalized the same way as they are
+// compared by CreateOrUpdate, so that they are equal when CreateOrUpdate would not update the live application
+func NormalizeApplicationsForDiff(diffConfig argodiff.DiffConfig, live, desired *argov1alpha1.Application) (*argov1alpha1.Application, *argov1alpha1.Application, error) {
+	normalizedLive := live.DeepCopy()
+	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
+	normalizedDesired := desired.DeepCopy()
+	if err := applyIgnoreDifferences(diffConfig, normalizedLive, normalizedDesired); err != nil {
+		return nil, nil, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+	return normalizedLive, normalizedDesired, nil
+}
+
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
 	patchBytes, err := patch.Data(obj)
 	if err != nil {
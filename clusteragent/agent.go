@@ -0,0 +1,274 @@
+// Package clusteragent implements the agent that runs in a spoke cluster and registers it with an Argo CD instance.
+// The agent creates the tokens Argo CD uses to access the cluster, submits them in a registration request, waits
+// for an administrator to approve the request and then keeps the token rotated.
+package clusteragent
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/server/clusterregistration"
+)
+
+// Options configures the agent.
+type Options struct {
+	// HubURL is the URL of the Argo CD API server
+	HubURL string
+	// BootstrapToken authenticates registration requests
+	BootstrapToken string
+	// Name is the name the cluster is registered with
+	Name string
+	// Server is the URL of the API server of the cluster, as reachable from Argo CD
+	Server string
+	// CAData holds the PEM-encoded CA bundle of the API server
+	CAData []byte
+	// Namespace is the namespace of the agent
+	Namespace string
+	// ServiceAccount is the service account in Namespace whose tokens are handed to Argo CD
+	ServiceAccount string
+	// StateSecret is the name of the secret in Namespace the agent stores its state in
+	StateSecret string
+	// TokenTTL is the lifetime of the tokens handed to Argo CD. Tokens are rotated after two thirds of their lifetime.
+	TokenTTL time.Duration
+	// PollInterval is the interval at which a pending registration request is polled
+	PollInterval time.Duration
+}
+
+// Agent registers the cluster it runs in with Argo CD and rotates the token Argo CD uses to access it.
+type Agent struct {
+	opts       Options
+	kubeClient kubernetes.Interface
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewAgent returns a new agent.
+func NewAgent(opts Options, kubeClient kubernetes.Interface, httpClient *http.Client) *Agent {
+	return &Agent{opts: opts, kubeClient: kubeClient, httpClient: httpClient, now: time.Now}
+}
+
+// state is persisted in the state secret, so that a restarted agent continues with the same registration request.
+type state struct {
+	// key authenticates the agent to the hub
+	key string
+	// status is the last known status of the registration request
+	status string
+	// token is the token submitted with a registration request that is not approved yet
+	token string
+	// tokenExpiresAt is the expiry of the token last handed to Argo CD
+	tokenExpiresAt time.Time
+}
+
+// Run registers the cluster and rotates its token until the context is done.
+func (a *Agent) Run(ctx context.Context) error {
+	for {
+		wait, err := a.reconcile(ctx)
+		if err != nil {
+			log.Errorf("Failed to reconcile cluster registration: %v", err)
+			wait = a.opts.PollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+	}
+}
+
+// reconcile performs the next step of the registration and returns how long to wait until the next step.
+func (a *Agent) reconcile(ctx context.Context) (time.Duration, error) {
+	st, err := a.loadState(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if st.status == clusterregistration.StatusApproved {
+		rotateAt := st.tokenExpiresAt.Add(-a.opts.TokenTTL / 3)
+		if wait := rotateAt.Sub(a.now()); wait > 0 {
+			return wait, nil
+		}
+		token, expiresAt, err := a.createToken(ctx)
+		if err != nil {
+			return 0, err
+		}
+		var resp clusterregistration.Response
+		err = a.post(ctx, clusterregistration.RotateTokenPath, "", &clusterregistration.RotateTokenRequest{Name: a.opts.Name, Key: st.key, BearerToken: token}, &resp)
+		var statusErr *statusError
+		if errors.As(err, &statusErr) && statusErr.code == http.StatusNotFound {
+			// the registration request was deleted, so request the registration again
+			log.Warn("Registration request not found, requesting registration again")
+			st.status = ""
+			return 0, a.saveState(ctx, st)
+		}
+		if err != nil {
+			return 0, fmt.Errorf("error rotating token: %w", err)
+		}
+		log.Infof("Rotated the token of cluster %q", a.opts.Name)
+		st.tokenExpiresAt = expiresAt
+		if err := a.saveState(ctx, st); err != nil {
+			return 0, err
+		}
+		return expiresAt.Add(-a.opts.TokenTTL / 3).Sub(a.now()), nil
+	}
+
+	if st.token == "" || !a.now().Before(st.tokenExpiresAt.Add(-a.opts.TokenTTL/3)) {
+		if st.token, st.tokenExpiresAt, err = a.createToken(ctx); err != nil {
+			return 0, err
+		}
+	}
+	var resp clusterregistration.Response
+	err = a.post(ctx, clusterregistration.RegisterPath, a.opts.BootstrapToken, &clusterregistration.RegisterRequest{
+		Name:        a.opts.Name,
+		Server:      a.opts.Server,
+		CAData:      a.opts.CAData,
+		BearerToken: st.token,
+		Key:         st.key,
+	}, &resp)
+	if err != nil {
+		// keep the token so that the request is not updated with a new token on every attempt
+		if saveErr := a.saveState(ctx, st); saveErr != nil {
+			log.Warnf("Failed to save state: %v", saveErr)
+		}
+		return 0, fmt.Errorf("error submitting registration request: %w", err)
+	}
+	if resp.Status != st.status {
+		log.Infof("Registration request of cluster %q is %s %s", a.opts.Name, resp.Status, resp.Message)
+	}
+	st.status = resp.Status
+	if st.status == clusterregistration.StatusApproved {
+		// Argo CD stored the token in the cluster secret
+		st.token = ""
+	}
+	if err := a.saveState(ctx, st); err != nil {
+		return 0, err
+	}
+	if st.status == clusterregistration.StatusApproved {
+		return 0, nil
+	}
+	return a.opts.PollInterval, nil
+}
+
+// createToken requests a new token for the service account handed to Argo CD.
+func (a *Agent) createToken(ctx context.Context) (string, time.Time, error) {
+	expirationSeconds := int64(a.opts.TokenTTL.Seconds())
+	tokenRequest, err := a.kubeClient.CoreV1().ServiceAccounts(a.opts.Namespace).CreateToken(ctx, a.opts.ServiceAccount, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{ExpirationSeconds: &expirationSeconds},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("error creating token for service account %q: %w", a.opts.ServiceAccount, err)
+	}
+	return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+func (a *Agent) loadState(ctx context.Context) (*state, error) {
+	secret, err := a.kubeClient.CoreV1().Secrets(a.opts.Namespace).Get(ctx, a.opts.StateSecret, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("error generating key: %w", err)
+		}
+		st := &state{key: hex.EncodeToString(key)}
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: a.opts.StateSecret, Namespace: a.opts.Namespace}}
+		stateToSecret(st, secret)
+		if _, err := a.kubeClient.CoreV1().Secrets(a.opts.Namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return nil, fmt.Errorf("error creating state secret: %w", err)
+		}
+		return st, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting state secret: %w", err)
+	}
+	st := &state{
+		key:    string(secret.Data["key"]),
+		status: string(secret.Data["status"]),
+		token:  string(secret.Data["token"]),
+	}
+	if st.key == "" {
+		return nil, fmt.Errorf("state secret %q has no key", a.opts.StateSecret)
+	}
+	if expiresAt, ok := secret.Data["tokenExpiresAt"]; ok {
+		if st.tokenExpiresAt, err = time.Parse(time.RFC3339, string(expiresAt)); err != nil {
+			return nil, fmt.Errorf("state secret %q has an invalid token expiry: %w", a.opts.StateSecret, err)
+		}
+	}
+	return st, nil
+}
+
+func (a *Agent) saveState(ctx context.Context, st *state) error {
+	secret, err := a.kubeClient.CoreV1().Secrets(a.opts.Namespace).Get(ctx, a.opts.StateSecret, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting state secret: %w", err)
+	}
+	stateToSecret(st, secret)
+	if _, err := a.kubeClient.CoreV1().Secrets(a.opts.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating state secret: %w", err)
+	}
+	return nil
+}
+
+func stateToSecret(st *state, secret *corev1.Secret) {
+	secret.Data = map[string][]byte{"key": []byte(st.key)}
+	if st.status != "" {
+		secret.Data["status"] = []byte(st.status)
+	}
+	if st.token != "" {
+		secret.Data["token"] = []byte(st.token)
+	}
+	if !st.tokenExpiresAt.IsZero() {
+		secret.Data["tokenExpiresAt"] = []byte(st.tokenExpiresAt.UTC().Format(time.RFC3339))
+	}
+}
+
+// statusError is returned if the hub responds with an unexpected status code.
+type statusError struct {
+	code    int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.code, http.StatusText(e.code), e.message)
+}
+
+func (a *Agent) post(ctx context.Context, path, bearerToken string, body, out any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(a.opts.HubURL, "/")+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &statusError{code: resp.StatusCode, message: strings.TrimSpace(string(respBody))}
+	}
+	return json.Unmarshal(respBody, out)
+}
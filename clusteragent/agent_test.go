@@ -0,0 +1,159 @@
+package clusteragent
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/server/clusterregistration"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	hubNamespace   = "argocd"
+	agentNamespace = "argocd-agent"
+	bootstrapToken = "bootstrap-token"
+	spokeServer    = "https://spoke.example.com"
+)
+
+type testEnv struct {
+	agent  *Agent
+	now    time.Time
+	tokens int
+	store  *clusterregistration.Store
+	argoDB db.ArgoDB
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	hubClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: hubNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: hubNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data: map[string][]byte{
+				"server.secretkey":           []byte("test"),
+				"cluster.registration.token": []byte(bootstrapToken),
+			},
+		},
+	)
+	settingsMgr := settings.NewSettingsManager(t.Context(), hubClient, hubNamespace)
+	argoDB := db.NewDB(hubNamespace, settingsMgr, hubClient)
+	hub := httptest.NewServer(clusterregistration.NewHandler(hubNamespace, hubClient, argoDB, settingsMgr))
+	t.Cleanup(hub.Close)
+
+	env := &testEnv{
+		now:    time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		store:  clusterregistration.NewStore(hubClient, hubNamespace),
+		argoDB: argoDB,
+	}
+	spokeClient := fake.NewClientset()
+	spokeClient.PrependReactor("create", "serviceaccounts", func(action kubetesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		req := action.(kubetesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		env.tokens++
+		req.Status = authenticationv1.TokenRequestStatus{
+			Token:               fmt.Sprintf("token-%d", env.tokens),
+			ExpirationTimestamp: metav1.NewTime(env.now.Add(time.Duration(*req.Spec.ExpirationSeconds) * time.Second)),
+		}
+		return true, req, nil
+	})
+	env.agent = NewAgent(Options{
+		HubURL:         hub.URL,
+		BootstrapToken: bootstrapToken,
+		Name:           "spoke",
+		Server:         spokeServer,
+		CAData:         []byte("ca"),
+		Namespace:      agentNamespace,
+		ServiceAccount: "argocd-manager",
+		StateSecret:    "argocd-cluster-agent-state",
+		TokenTTL:       3 * time.Hour,
+		PollInterval:   time.Minute,
+	}, spokeClient, hub.Client())
+	env.agent.now = func() time.Time { return env.now }
+	return env
+}
+
+func TestAgent(t *testing.T) {
+	env := newTestEnv(t)
+
+	wait, err := env.agent.reconcile(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait, "a pending request is polled")
+	reg, err := env.store.Get(t.Context(), "spoke")
+	require.NoError(t, err)
+	assert.Equal(t, clusterregistration.StatusPending, reg.Status)
+	assert.Equal(t, "token-1", reg.BearerToken)
+
+	// polling reuses the token until it is due for rotation
+	_, err = env.agent.reconcile(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 1, env.tokens)
+
+	_, err = env.store.Approve(t.Context(), env.argoDB, "spoke")
+	require.NoError(t, err)
+
+	wait, err = env.agent.reconcile(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, time.Duration(0), wait)
+	st, err := env.agent.loadState(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, clusterregistration.StatusApproved, st.status)
+	assert.Empty(t, st.token)
+
+	wait, err = env.agent.reconcile(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, wait, "the token is rotated after two thirds of its lifetime")
+	assert.Equal(t, 1, env.tokens)
+
+	env.now = env.now.Add(2 * time.Hour)
+	wait, err = env.agent.reconcile(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Hour, wait)
+	cluster, err := env.argoDB.GetCluster(t.Context(), spokeServer)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", cluster.Config.BearerToken)
+
+	t.Run("RegistrationDeleted", func(t *testing.T) {
+		require.NoError(t, env.store.Delete(t.Context(), "spoke"))
+		env.now = env.now.Add(2 * time.Hour)
+		_, err := env.agent.reconcile(t.Context())
+		require.NoError(t, err)
+		st, err := env.agent.loadState(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, st.status)
+
+		_, err = env.agent.reconcile(t.Context())
+		require.NoError(t, err)
+		reg, err := env.store.Get(t.Context(), "spoke")
+		require.NoError(t, err)
+		assert.Equal(t, clusterregistration.StatusPending, reg.Status)
+	})
+}
+
+func TestAgent_InvalidBootstrapToken(t *testing.T) {
+	env := newTestEnv(t)
+	env.agent.opts.BootstrapToken = "invalid"
+
+	_, err := env.agent.reconcile(t.Context())
+	require.ErrorContains(t, err, "401 Unauthorized")
+	st, err := env.agent.loadState(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", st.token, "the token is kept for the next attempt")
+	assert.Len(t, st.key, 64)
+}
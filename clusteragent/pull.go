@@ -0,0 +1,525 @@
+package clusteragent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/utils/ptr"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// fieldManager is the field manager the agent applies the resources with
+const fieldManager = "argocd-cluster-agent"
+
+// PullOptions configures the agent of a pull mode cluster.
+type PullOptions struct {
+	// Name is the name of the cluster in Argo CD. The agent deploys the applications whose destination is this cluster.
+	Name string
+	// Interval is the interval at which the applications are reconciled
+	Interval time.Duration
+}
+
+// ResourceClient reads, applies and deletes the resources of the cluster the agent runs in.
+type ResourceClient interface {
+	// IsNamespaced returns whether the resources of the given kind are namespaced
+	IsNamespaced(gvk schema.GroupVersionKind) (bool, error)
+	// Get returns the live state of the resource, or nil if it does not exist
+	Get(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+	// Apply applies the resource using server-side apply and returns its resulting state
+	Apply(ctx context.Context, obj *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error)
+	// Delete deletes the resource
+	Delete(ctx context.Context, obj *unstructured.Unstructured) error
+}
+
+// errPruneNotSupported is reported for the applications which request pruning, which the agent does not carry out
+var errPruneNotSupported = errors.New("pruning is not supported in pull mode")
+
+// Puller deploys the applications of a pull mode cluster. It pulls the desired manifests from Argo CD, applies them
+// in the cluster it runs in and reports the status of the applications back, so that Argo CD never connects to the
+// cluster.
+type Puller struct {
+	opts          PullOptions
+	appClient     applicationpkg.ApplicationServiceClient
+	projClient    projectpkg.ProjectServiceClient
+	clusterClient clusterpkg.ClusterServiceClient
+	resources     ResourceClient
+}
+
+// NewPuller returns a new agent of a pull mode cluster.
+func NewPuller(opts PullOptions, appClient applicationpkg.ApplicationServiceClient, projClient projectpkg.ProjectServiceClient, clusterClient clusterpkg.ClusterServiceClient, resources ResourceClient) *Puller {
+	return &Puller{opts: opts, appClient: appClient, projClient: projClient, clusterClient: clusterClient, resources: resources}
+}
+
+// Run reconciles the applications of the cluster until the context is done.
+func (p *Puller) Run(ctx context.Context) error {
+	for {
+		if err := p.reconcile(ctx); err != nil {
+			log.Errorf("Failed to reconcile applications: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(p.opts.Interval):
+		}
+	}
+}
+
+// reconcile deploys the applications whose destination is the cluster of the agent, deletes the resources of the
+// applications being deleted and reports their status.
+func (p *Puller) reconcile(ctx context.Context) error {
+	cluster, err := p.clusterClient.Get(ctx, &clusterpkg.ClusterQuery{Name: p.opts.Name})
+	if err != nil {
+		return fmt.Errorf("error getting cluster %s: %w", p.opts.Name, err)
+	}
+	apps, err := p.appClient.List(ctx, &applicationpkg.ApplicationQuery{})
+	if err != nil {
+		return fmt.Errorf("error listing applications: %w", err)
+	}
+	for i := range apps.Items {
+		app := &apps.Items[i]
+		if app.Spec.Destination.Name != p.opts.Name {
+			continue
+		}
+		var req *applicationpkg.ApplicationAgentStatusRequest
+		if app.DeletionTimestamp != nil {
+			if !app.CascadedDeletion() {
+				continue
+			}
+			req = p.deleteApp(ctx, app, cluster)
+		} else {
+			req = p.reconcileApp(ctx, app, cluster)
+		}
+		if _, err := p.appClient.UpdateAgentStatus(ctx, req); err != nil {
+			log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to report application status: %v", err)
+		}
+	}
+	return nil
+}
+
+// newStatusRequest returns the status report of the application with an unknown sync status
+func (p *Puller) newStatusRequest(app *v1alpha1.Application) *applicationpkg.ApplicationAgentStatusRequest {
+	return &applicationpkg.ApplicationAgentStatusRequest{
+		Name:         ptr.To(app.Name),
+		AppNamespace: ptr.To(app.Namespace),
+		Project:      ptr.To(app.Spec.Project),
+		Cluster:      ptr.To(p.opts.Name),
+		SyncStatus:   ptr.To(string(v1alpha1.SyncStatusCodeUnknown)),
+	}
+}
+
+// getManifests returns the desired manifests of the application, at the revisions requested by the sync operation if
+// any
+func (p *Puller) getManifests(ctx context.Context, app *v1alpha1.Application, syncOp *v1alpha1.SyncOperation) (*apiclient.ManifestResponse, error) {
+	if syncOp != nil && len(syncOp.Manifests) > 0 {
+		return &apiclient.ManifestResponse{Manifests: syncOp.Manifests}, nil
+	}
+	q := &applicationpkg.ApplicationManifestQuery{
+		Name:         ptr.To(app.Name),
+		AppNamespace: ptr.To(app.Namespace),
+		Project:      ptr.To(app.Spec.Project),
+	}
+	if syncOp != nil {
+		if app.Spec.HasMultipleSources() && len(syncOp.Revisions) > 0 {
+			q.Revisions = syncOp.Revisions
+			for i := range syncOp.Revisions {
+				q.SourcePositions = append(q.SourcePositions, int64(i+1))
+			}
+		} else if syncOp.Revision != "" {
+			q.Revision = ptr.To(syncOp.Revision)
+		}
+	}
+	return p.appClient.GetManifests(ctx, q)
+}
+
+// projectChecker checks the resources of an application against the restrictions of its project, as the application
+// controller does before syncing them
+type projectChecker struct {
+	proj     *v1alpha1.AppProject
+	clusters []*v1alpha1.Cluster
+	cluster  *v1alpha1.Cluster
+}
+
+func (p *Puller) getProjectChecker(ctx context.Context, app *v1alpha1.Application, cluster *v1alpha1.Cluster) (*projectChecker, error) {
+	res, err := p.projClient.GetDetailedProject(ctx, &projectpkg.ProjectQuery{Name: app.Spec.GetProject()})
+	if err != nil {
+		return nil, fmt.Errorf("error getting project %s: %w", app.Spec.GetProject(), err)
+	}
+	return &projectChecker{
+		proj:     argo.MergeGlobalProjects(res.Project, res.GlobalProjects),
+		clusters: res.Clusters,
+		cluster:  cluster,
+	}, nil
+}
+
+// check returns an error if the project does not permit the resource
+func (c *projectChecker) check(obj *unstructured.Unstructured, namespaced bool) error {
+	gvk := obj.GroupVersionKind()
+	if !c.proj.IsGroupKindNamePermitted(gvk.GroupKind(), obj.GetName(), namespaced) {
+		return fmt.Errorf("resource %s:%s is not permitted in project %s", gvk.Group, gvk.Kind, c.proj.Name)
+	}
+	if namespaced {
+		permitted, err := c.proj.IsDestinationPermitted(c.cluster, obj.GetNamespace(), func(_ string) ([]*v1alpha1.Cluster, error) {
+			return c.clusters, nil
+		})
+		if err != nil {
+			return err
+		}
+		if !permitted {
+			return fmt.Errorf("namespace %v is not permitted in project '%s'", obj.GetNamespace(), c.proj.Name)
+		}
+	}
+	return nil
+}
+
+// syncOptions configures how the resources of an application are synced
+type syncOptions struct {
+	// apply applies the out of sync resources
+	apply bool
+	// dryRun only validates the out of sync resources against the cluster without persisting them
+	dryRun bool
+	// resources limits the applied resources to the given ones, if not empty
+	resources []v1alpha1.SyncOperationResource
+}
+
+// reconcileApp compares the desired manifests of the application with the live resources, applies them if automated
+// sync is enabled or a sync operation is requested and returns the resulting status, including the outcome of the
+// operation.
+func (p *Puller) reconcileApp(ctx context.Context, app *v1alpha1.Application, cluster *v1alpha1.Cluster) *applicationpkg.ApplicationAgentStatusRequest {
+	req := p.newStatusRequest(app)
+	var syncOp *v1alpha1.SyncOperation
+	if app.Operation != nil {
+		syncOp = app.Operation.Sync
+	}
+	// fail reports the error, failing the requested operation if any
+	fail := func(message string) *applicationpkg.ApplicationAgentStatusRequest {
+		req.Error = ptr.To(message)
+		if syncOp != nil {
+			req.OperationPhase = ptr.To(string(synccommon.OperationFailed))
+			req.OperationMessage = ptr.To(message)
+		}
+		return req
+	}
+
+	checker, err := p.getProjectChecker(ctx, app, cluster)
+	if err != nil {
+		return fail(err.Error())
+	}
+	if syncOp != nil && syncOp.Prune {
+		return fail(errPruneNotSupported.Error())
+	}
+	manifests, err := p.getManifests(ctx, app, syncOp)
+	if err != nil {
+		return fail(fmt.Sprintf("error getting manifests: %v", err))
+	}
+	req.Revision = ptr.To(manifests.Revision)
+
+	opts := syncOptions{apply: app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.IsAutomatedSyncEnabled()}
+	var errs []string
+	if syncOp != nil {
+		opts = syncOptions{apply: true, dryRun: syncOp.DryRun, resources: syncOp.Resources}
+	} else if opts.apply && app.Spec.SyncPolicy.Automated.GetPrune() {
+		errs = append(errs, errPruneNotSupported.Error())
+	}
+	syncStatus := v1alpha1.SyncStatusCodeSynced
+	appHealth := health.HealthStatusHealthy
+	for _, manifest := range manifests.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error unmarshaling manifest: %v", err))
+			syncStatus = v1alpha1.SyncStatusCodeUnknown
+			continue
+		}
+		res, err := p.reconcileResource(ctx, app.Spec.Destination.Namespace, obj, checker, opts)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s/%s: %v", obj.GetKind(), obj.GetName(), err))
+		}
+		req.Resources = append(req.Resources, &res)
+		switch {
+		case res.Status == v1alpha1.SyncStatusCodeUnknown:
+			syncStatus = v1alpha1.SyncStatusCodeUnknown
+		case res.Status == v1alpha1.SyncStatusCodeOutOfSync && syncStatus == v1alpha1.SyncStatusCodeSynced:
+			syncStatus = v1alpha1.SyncStatusCodeOutOfSync
+		}
+		if res.Health != nil && health.IsWorse(appHealth, res.Health.Status) {
+			appHealth = res.Health.Status
+		}
+	}
+	req.SyncStatus = ptr.To(string(syncStatus))
+	req.Health = &v1alpha1.HealthStatus{Status: appHealth}
+	if len(errs) > 0 {
+		req.Error = ptr.To(strings.Join(errs, "; "))
+	}
+	if syncOp != nil {
+		if len(errs) > 0 {
+			req.OperationPhase = ptr.To(string(synccommon.OperationFailed))
+			req.OperationMessage = req.Error
+		} else {
+			req.OperationPhase = ptr.To(string(synccommon.OperationSucceeded))
+			req.OperationMessage = ptr.To("successfully synced")
+		}
+	}
+	return req
+}
+
+// setNamespace defaults the namespace of a namespaced resource to the given one and returns whether it is namespaced
+func (p *Puller) setNamespace(obj *unstructured.Unstructured, namespace string) (bool, error) {
+	namespaced, err := p.resources.IsNamespaced(obj.GroupVersionKind())
+	if err != nil {
+		return false, err
+	}
+	if namespaced && obj.GetNamespace() == "" {
+		obj.SetNamespace(namespace)
+	}
+	return namespaced, nil
+}
+
+// reconcileResource compares the desired state of a resource with its live state, applies it if it is out of sync
+// and selected by the sync options, and returns its status. Resources the project does not permit are never applied.
+func (p *Puller) reconcileResource(ctx context.Context, namespace string, obj *unstructured.Unstructured, checker *projectChecker, opts syncOptions) (v1alpha1.ResourceStatus, error) {
+	gvk := obj.GroupVersionKind()
+	res := v1alpha1.ResourceStatus{
+		Group:   gvk.Group,
+		Version: gvk.Version,
+		Kind:    gvk.Kind,
+		Name:    obj.GetName(),
+		Status:  v1alpha1.SyncStatusCodeUnknown,
+	}
+	namespaced, err := p.setNamespace(obj, namespace)
+	if err != nil {
+		return res, err
+	}
+	res.Namespace = obj.GetNamespace()
+	if err := checker.check(obj, namespaced); err != nil {
+		return res, err
+	}
+
+	live, err := p.resources.Get(ctx, obj)
+	if err != nil {
+		return res, err
+	}
+	desired, err := p.resources.Apply(ctx, obj, true)
+	if err != nil {
+		return res, err
+	}
+	res.Status = v1alpha1.SyncStatusCodeSynced
+	if live == nil || !equalIgnoringServerFields(live, desired) {
+		res.Status = v1alpha1.SyncStatusCodeOutOfSync
+	}
+	selected := len(opts.resources) == 0 || argo.ContainsSyncResource(obj.GetName(), obj.GetNamespace(), gvk, opts.resources)
+	if res.Status == v1alpha1.SyncStatusCodeOutOfSync && opts.apply && selected && !opts.dryRun {
+		if live, err = p.resources.Apply(ctx, obj, false); err != nil {
+			return res, err
+		}
+		res.Status = v1alpha1.SyncStatusCodeSynced
+	}
+
+	if live == nil {
+		res.Health = &v1alpha1.HealthStatus{Status: health.HealthStatusMissing}
+		return res, nil
+	}
+	resHealth, err := health.GetResourceHealth(live, nil)
+	if err != nil {
+		return res, err
+	}
+	if resHealth != nil {
+		res.Health = &v1alpha1.HealthStatus{Status: resHealth.Status, Message: resHealth.Message}
+	}
+	return res, nil
+}
+
+// deleteApp deletes the resources the agent applied for an application being deleted with its resources, and reports
+// once they are gone so that the API server removes the finalizer of the application.
+func (p *Puller) deleteApp(ctx context.Context, app *v1alpha1.Application, cluster *v1alpha1.Cluster) *applicationpkg.ApplicationAgentStatusRequest {
+	req := p.newStatusRequest(app)
+	checker, err := p.getProjectChecker(ctx, app, cluster)
+	if err != nil {
+		req.Error = ptr.To(err.Error())
+		return req
+	}
+	manifests, err := p.getManifests(ctx, app, nil)
+	if err != nil {
+		req.Error = ptr.To(fmt.Sprintf("error getting manifests: %v", err))
+		return req
+	}
+	var errs []string
+	deleted := true
+	for _, manifest := range manifests.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error unmarshaling manifest: %v", err))
+			deleted = false
+			continue
+		}
+		gone, err := p.deleteResource(ctx, app.Spec.Destination.Namespace, obj, checker)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s/%s: %v", obj.GetKind(), obj.GetName(), err))
+		}
+		deleted = deleted && gone
+	}
+	if len(errs) > 0 {
+		req.Error = ptr.To(strings.Join(errs, "; "))
+	}
+	if deleted {
+		req.ResourcesDeleted = ptr.To(true)
+	}
+	return req
+}
+
+// deleteResource deletes a resource the agent applied and returns whether it is gone. Resources the agent did not
+// apply are left in place.
+func (p *Puller) deleteResource(ctx context.Context, namespace string, obj *unstructured.Unstructured, checker *projectChecker) (bool, error) {
+	namespaced, err := p.setNamespace(obj, namespace)
+	if err != nil {
+		return false, err
+	}
+	live, err := p.resources.Get(ctx, obj)
+	if err != nil || live == nil {
+		return live == nil && err == nil, err
+	}
+	if !appliedByAgent(live) {
+		return true, nil
+	}
+	if err := checker.check(obj, namespaced); err != nil {
+		return false, err
+	}
+	if live.GetDeletionTimestamp() == nil {
+		if err := p.resources.Delete(ctx, live); err != nil {
+			return false, err
+		}
+	}
+	live, err = p.resources.Get(ctx, obj)
+	return live == nil && err == nil, err
+}
+
+// appliedByAgent returns whether the agent applied the resource
+func appliedByAgent(obj *unstructured.Unstructured) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == fieldManager {
+			return true
+		}
+	}
+	return false
+}
+
+// equalIgnoringServerFields returns whether two states of a resource are equal, ignoring the fields the API server
+// updates on every write
+func equalIgnoringServerFields(a, b *unstructured.Unstructured) bool {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	for _, obj := range []*unstructured.Unstructured{a, b} {
+		obj.SetManagedFields(nil)
+		obj.SetResourceVersion("")
+		obj.SetGeneration(0)
+	}
+	return equality.Semantic.DeepEqual(a.Object, b.Object)
+}
+
+// kubeResourceClient is the ResourceClient of the cluster the agent runs in
+type kubeResourceClient struct {
+	dynamicClient dynamic.Interface
+	mapper        *restmapper.DeferredDiscoveryRESTMapper
+}
+
+// NewKubeResourceClient returns a ResourceClient for the cluster of the given config.
+func NewKubeResourceClient(config *rest.Config) (ResourceClient, error) {
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client: %w", err)
+	}
+	return &kubeResourceClient{
+		dynamicClient: dynamicClient,
+		mapper:        restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient)),
+	}, nil
+}
+
+func (c *kubeResourceClient) mapping(gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		// the kind may have been added since the discovery information was cached
+		c.mapper.Reset()
+		mapping, err = c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	}
+	return mapping, err
+}
+
+func (c *kubeResourceClient) resource(obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	mapping, err := c.mapping(obj.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		return c.dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace()), nil
+	}
+	return c.dynamicClient.Resource(mapping.Resource), nil
+}
+
+func (c *kubeResourceClient) IsNamespaced(gvk schema.GroupVersionKind) (bool, error) {
+	mapping, err := c.mapping(gvk)
+	if err != nil {
+		return false, err
+	}
+	return mapping.Scope.Name() == meta.RESTScopeNameNamespace, nil
+}
+
+func (c *kubeResourceClient) Get(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	ri, err := c.resource(obj)
+	if err != nil {
+		return nil, err
+	}
+	live, err := ri.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return live, err
+}
+
+func (c *kubeResourceClient) Apply(ctx context.Context, obj *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error) {
+	ri, err := c.resource(obj)
+	if err != nil {
+		return nil, err
+	}
+	opts := metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	return ri.Apply(ctx, obj.GetName(), obj, opts)
+}
+
+func (c *kubeResourceClient) Delete(ctx context.Context, obj *unstructured.Unstructured) error {
+	ri, err := c.resource(obj)
+	if err != nil {
+		return err
+	}
+	err = ri.Delete(ctx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: ptr.To(metav1.DeletePropagationForeground)})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
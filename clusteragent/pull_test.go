@@ -0,0 +1,269 @@
+package clusteragent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	applicationmocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/application/mocks"
+	clustermocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	projectmocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/project/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+// fakeResourceClient keeps the live resources in memory
+type fakeResourceClient struct {
+	live    map[string]*unstructured.Unstructured
+	applied []string
+	deleted []string
+}
+
+func resourceKey(obj *unstructured.Unstructured) string {
+	return obj.GetKind() + "/" + obj.GetNamespace() + "/" + obj.GetName()
+}
+
+func (c *fakeResourceClient) IsNamespaced(gvk schema.GroupVersionKind) (bool, error) {
+	return gvk.Kind != "Namespace", nil
+}
+
+func (c *fakeResourceClient) Get(_ context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if live, ok := c.live[resourceKey(obj)]; ok {
+		return live.DeepCopy(), nil
+	}
+	return nil, nil
+}
+
+func (c *fakeResourceClient) Apply(_ context.Context, obj *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error) {
+	result := obj.DeepCopy()
+	result.SetResourceVersion("2")
+	if !dryRun {
+		result.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: fieldManager}})
+		c.live[resourceKey(obj)] = result
+		c.applied = append(c.applied, resourceKey(obj))
+	}
+	return result.DeepCopy(), nil
+}
+
+func (c *fakeResourceClient) Delete(_ context.Context, obj *unstructured.Unstructured) error {
+	delete(c.live, resourceKey(obj))
+	c.deleted = append(c.deleted, resourceKey(obj))
+	return nil
+}
+
+func newPullTestProject(namespace string, clusterResources bool) *v1alpha1.AppProject {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: namespace}},
+		},
+	}
+	if clusterResources {
+		proj.Spec.ClusterResourceWhitelist = []v1alpha1.ClusterResourceRestrictionItem{{Group: "*", Kind: "*"}}
+	}
+	return proj
+}
+
+// newPullTestPuller returns an agent of the cluster "spoke" whose applications belong to the given project
+func newPullTestPuller(t *testing.T, appClient application.ApplicationServiceClient, proj *v1alpha1.AppProject, resources ResourceClient) *Puller {
+	t.Helper()
+	projClient := projectmocks.NewProjectServiceClient(t)
+	projClient.EXPECT().GetDetailedProject(mock.Anything, mock.Anything).Return(&project.DetailedProjectsResponse{Project: proj}, nil).Maybe()
+	clusterClient := clustermocks.NewClusterServiceClient(t)
+	clusterClient.EXPECT().Get(mock.Anything, mock.Anything).Return(&v1alpha1.Cluster{Name: "spoke", Server: "https://spoke"}, nil)
+	return NewPuller(PullOptions{Name: "spoke"}, appClient, projClient, clusterClient, resources)
+}
+
+// recordReports records the status reports of the agent by application name
+func recordReports(appClient *applicationmocks.ApplicationServiceClient) map[string]*application.ApplicationAgentStatusRequest {
+	reports := map[string]*application.ApplicationAgentStatusRequest{}
+	appClient.EXPECT().UpdateAgentStatus(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, req *application.ApplicationAgentStatusRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+		reports[req.GetName()] = req
+		return &v1alpha1.Application{}, nil
+	})
+	return reports
+}
+
+func newPullTestApp(name, cluster string, automated bool) v1alpha1.Application {
+	app := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Destination: v1alpha1.ApplicationDestination{Name: cluster, Namespace: "guestbook"},
+		},
+	}
+	if automated {
+		app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{Automated: &v1alpha1.SyncPolicyAutomated{}}
+	}
+	return app
+}
+
+func TestPuller_Reconcile(t *testing.T) {
+	live := &unstructured.Unstructured{}
+	live.SetAPIVersion("v1")
+	live.SetKind("ConfigMap")
+	live.SetNamespace("guestbook")
+	live.SetName("manual-config")
+	live.SetResourceVersion("1")
+	resources := &fakeResourceClient{live: map[string]*unstructured.Unstructured{resourceKey(live): live}}
+
+	appClient := applicationmocks.NewApplicationServiceClient(t)
+	appClient.EXPECT().List(mock.Anything, mock.Anything).Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{
+		newPullTestApp("automated", "spoke", true),
+		newPullTestApp("manual", "spoke", false),
+		newPullTestApp("other", "other-spoke", true),
+	}}, nil)
+	appClient.EXPECT().GetManifests(mock.Anything, mock.MatchedBy(func(q *application.ApplicationManifestQuery) bool {
+		return q.GetName() == "automated"
+	})).Return(&apiclient.ManifestResponse{
+		Revision:  "abc123",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"automated-config"},"data":{"foo":"bar"}}`},
+	}, nil)
+	appClient.EXPECT().GetManifests(mock.Anything, mock.MatchedBy(func(q *application.ApplicationManifestQuery) bool {
+		return q.GetName() == "manual"
+	})).Return(&apiclient.ManifestResponse{
+		Revision:  "abc123",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"manual-config"},"data":{"foo":"bar"}}`},
+	}, nil)
+	reports := recordReports(appClient)
+
+	puller := newPullTestPuller(t, appClient, newPullTestProject("*", true), resources)
+	require.NoError(t, puller.reconcile(t.Context()))
+
+	assert.Equal(t, []string{"ConfigMap/guestbook/automated-config"}, resources.applied)
+	require.Len(t, reports, 2)
+
+	automated := reports["automated"]
+	assert.Equal(t, "spoke", automated.GetCluster())
+	assert.Equal(t, "abc123", automated.GetRevision())
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), automated.GetSyncStatus())
+	assert.Equal(t, health.HealthStatusHealthy, automated.GetHealth().Status)
+	require.Len(t, automated.GetResources(), 1)
+	assert.Equal(t, "guestbook", automated.GetResources()[0].Namespace)
+	assert.Empty(t, automated.GetError())
+
+	manual := reports["manual"]
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeOutOfSync), manual.GetSyncStatus())
+	require.Len(t, manual.GetResources(), 1)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, manual.GetResources()[0].Status)
+}
+
+func TestPuller_Reconcile_ProjectRestrictions(t *testing.T) {
+	resources := &fakeResourceClient{live: map[string]*unstructured.Unstructured{}}
+	appClient := applicationmocks.NewApplicationServiceClient(t)
+	appClient.EXPECT().List(mock.Anything, mock.Anything).Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{
+		newPullTestApp("automated", "spoke", true),
+	}}, nil)
+	appClient.EXPECT().GetManifests(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Revision: "abc123",
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"permitted"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"other-namespace","namespace":"kube-system"}}`,
+			`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"cluster-scoped"}}`,
+		},
+	}, nil)
+	reports := recordReports(appClient)
+
+	puller := newPullTestPuller(t, appClient, newPullTestProject("guestbook", false), resources)
+	require.NoError(t, puller.reconcile(t.Context()))
+
+	assert.Equal(t, []string{"ConfigMap/guestbook/permitted"}, resources.applied)
+	report := reports["automated"]
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeUnknown), report.GetSyncStatus())
+	assert.Contains(t, report.GetError(), "namespace kube-system is not permitted in project 'default'")
+	assert.Contains(t, report.GetError(), "resource :Namespace is not permitted in project default")
+}
+
+func TestPuller_Reconcile_Operation(t *testing.T) {
+	manual := newPullTestApp("manual", "spoke", false)
+	manual.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "v2"}}
+	prune := newPullTestApp("prune", "spoke", false)
+	prune.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Prune: true}}
+
+	resources := &fakeResourceClient{live: map[string]*unstructured.Unstructured{}}
+	appClient := applicationmocks.NewApplicationServiceClient(t)
+	appClient.EXPECT().List(mock.Anything, mock.Anything).Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{manual, prune}}, nil)
+	appClient.EXPECT().GetManifests(mock.Anything, mock.MatchedBy(func(q *application.ApplicationManifestQuery) bool {
+		return q.GetName() == "manual" && q.GetRevision() == "v2"
+	})).Return(&apiclient.ManifestResponse{
+		Revision:  "def456",
+		Manifests: []string{`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"manual-config"}}`},
+	}, nil)
+	reports := recordReports(appClient)
+
+	puller := newPullTestPuller(t, appClient, newPullTestProject("*", true), resources)
+	require.NoError(t, puller.reconcile(t.Context()))
+
+	assert.Equal(t, []string{"ConfigMap/guestbook/manual-config"}, resources.applied)
+	assert.Equal(t, string(synccommon.OperationSucceeded), reports["manual"].GetOperationPhase())
+	assert.Equal(t, "def456", reports["manual"].GetRevision())
+	assert.Equal(t, string(v1alpha1.SyncStatusCodeSynced), reports["manual"].GetSyncStatus())
+	assert.Equal(t, string(synccommon.OperationFailed), reports["prune"].GetOperationPhase())
+	assert.Equal(t, errPruneNotSupported.Error(), reports["prune"].GetOperationMessage())
+}
+
+func TestPuller_Reconcile_Deletion(t *testing.T) {
+	applied := &unstructured.Unstructured{}
+	applied.SetAPIVersion("v1")
+	applied.SetKind("ConfigMap")
+	applied.SetNamespace("guestbook")
+	applied.SetName("applied")
+	applied.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: fieldManager}})
+	adopted := applied.DeepCopy()
+	adopted.SetName("adopted")
+	adopted.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+	resources := &fakeResourceClient{live: map[string]*unstructured.Unstructured{
+		resourceKey(applied): applied,
+		resourceKey(adopted): adopted,
+	}}
+
+	deleting := newPullTestApp("deleting", "spoke", true)
+	deleting.DeletionTimestamp = &metav1.Time{}
+	deleting.Finalizers = []string{v1alpha1.ResourcesFinalizerName}
+	orphaning := newPullTestApp("orphaning", "spoke", true)
+	orphaning.DeletionTimestamp = &metav1.Time{}
+
+	appClient := applicationmocks.NewApplicationServiceClient(t)
+	appClient.EXPECT().List(mock.Anything, mock.Anything).Return(&v1alpha1.ApplicationList{Items: []v1alpha1.Application{deleting, orphaning}}, nil)
+	appClient.EXPECT().GetManifests(mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{
+		Manifests: []string{
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"applied"}}`,
+			`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"adopted"}}`,
+		},
+	}, nil)
+	reports := recordReports(appClient)
+
+	puller := newPullTestPuller(t, appClient, newPullTestProject("*", true), resources)
+	require.NoError(t, puller.reconcile(t.Context()))
+
+	assert.Equal(t, []string{"ConfigMap/guestbook/applied"}, resources.deleted)
+	assert.Contains(t, resources.live, "ConfigMap/guestbook/adopted")
+	require.Len(t, reports, 1)
+	assert.True(t, reports["deleting"].GetResourcesDeleted())
+	assert.Empty(t, reports["deleting"].GetError())
+}
+
+func TestEqualIgnoringServerFields(t *testing.T) {
+	a := &unstructured.Unstructured{Object: map[string]any{"apiVersion": "v1", "kind": "ConfigMap", "data": map[string]any{"foo": "bar"}}}
+	a.SetName("my-config")
+	b := a.DeepCopy()
+	b.SetResourceVersion("2")
+	b.SetGeneration(3)
+	b.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: fieldManager}})
+	assert.True(t, equalIgnoringServerFields(a, b))
+
+	b.Object["data"] = map[string]any{"foo": "baz"}
+	assert.False(t, equalIgnoringServerFields(a, b))
+}
@@ -42,7 +42,9 @@ import (
 
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/controller/registration"
 	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	registrationv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/registration/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -77,6 +79,7 @@ func NewCommand() *cobra.Command {
 		enableGitHubAPIMetrics       bool
 		metricsAplicationsetLabels   []string
 		enableScmProviders           bool
+		enableScmRepoCreds           bool
 		webhookParallelism           int
 		tokenRefStrictMode           bool
 		maxResourcesStatusCount      int
@@ -85,10 +88,12 @@ func NewCommand() *cobra.Command {
 		repoServerClientTLSConfigSrc func() (tls.Configuration, error)
 		scmProxyURL                  string
 		scmNoProxy                   string
+		enableRegistration           bool
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = appv1alpha1.AddToScheme(scheme)
+	_ = registrationv1alpha1.AddToScheme(scheme)
 	command := cobra.Command{
 		Use:               common.CommandApplicationSetController,
 		Short:             "Starts Argo CD ApplicationSet controller",
@@ -207,14 +212,17 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			scmConfigOpts := []generators.SCMConfigOpts{generators.WithProxyURL(scmProxyURL), generators.WithNoProxyList(scmNoProxy)}
+			if enableScmRepoCreds {
+				scmConfigOpts = append(scmConfigOpts, generators.WithRepoCreds(argoCDDB))
+			}
 			scmConfig := generators.NewSCMConfig(
 				scmRootCAPath,
 				allowedScmProviders,
 				enableScmProviders,
 				enableGitHubAPIMetrics,
 				github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)),
-				tokenRefStrictMode, generators.WithProxyURL(scmProxyURL),
-				generators.WithNoProxyList(scmNoProxy))
+				tokenRefStrictMode, scmConfigOpts...)
 
 			tlsConfig, err := repoServerClientTLSConfigSrc()
 			errors.CheckError(err)
@@ -233,7 +241,13 @@ func NewCommand() *cobra.Command {
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, repoServerTimeoutSeconds, tlsConfig)
 			argoCDService := services.NewArgoCDService(argoCDDB, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, clusterInformer)
+			metrics := appsetmetrics.NewApplicationsetMetrics(
+				utils.NewAppsetLister(mgr.GetClient()),
+				metricsAplicationsetLabels,
+				func(appset *appv1alpha1.ApplicationSet) bool {
+					return utils.IsNamespaceAllowed(applicationSetNamespaces, appset.Namespace)
+				})
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, clusterInformer, &metrics)
 			cacheSyncClient := utils.NewCacheSyncingClient(mgr.GetClient(), mgr.GetCache())
 
 			// start a webhook server that listens to incoming webhook payloads
@@ -245,12 +259,6 @@ func NewCommand() *cobra.Command {
 				startWebhookServer(webhookHandler, webhookAddr)
 			}
 
-			metrics := appsetmetrics.NewApplicationsetMetrics(
-				utils.NewAppsetLister(mgr.GetClient()),
-				metricsAplicationsetLabels,
-				func(appset *appv1alpha1.ApplicationSet) bool {
-					return utils.IsNamespaceAllowed(applicationSetNamespaces, appset.Namespace)
-				})
 			appsetReconciler := &controllers.ApplicationSetReconciler{
 				Generators: topLevelGenerators,
 				Client:     cacheSyncClient,
@@ -281,6 +289,19 @@ func NewCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			if enableRegistration {
+				repoReconciler := &registration.RepositoryReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Namespace: namespace}
+				if err = repoReconciler.SetupWithManager(mgr); err != nil {
+					log.Error(err, "unable to create controller", "controller", "Repository")
+					os.Exit(1)
+				}
+				clusterReconciler := &registration.ClusterReconciler{Client: mgr.GetClient(), Scheme: mgr.GetScheme(), Namespace: namespace}
+				if err = clusterReconciler.SetupWithManager(mgr); err != nil {
+					log.Error(err, "unable to create controller", "controller", "Cluster")
+					os.Exit(1)
+				}
+			}
+
 			stats.StartStatsTicker(10 * time.Minute)
 			log.Info("Starting manager")
 			if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -306,6 +327,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().StringSliceVar(&allowedScmProviders, "allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
 	command.Flags().BoolVar(&enableScmProviders, "enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
+	command.Flags().BoolVar(&enableScmRepoCreds, "enable-scm-repo-creds", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_REPO_CREDS", false), "Enable the SCM, PR and plugin generators to use the Argo CD repository credentials matching their credentialsURL (Default: false)")
 	command.Flags().BoolVar(&dryRun, "dry-run", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DRY_RUN", false), "Enable dry run mode")
 	command.Flags().BoolVar(&tokenRefStrictMode, "token-ref-strict-mode", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TOKENREF_STRICT_MODE", false), fmt.Sprintf("Set to true to require secrets referenced by SCM providers to have the %s=%s label set (Default: false)", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds))
 	command.Flags().BoolVar(&enableProgressiveSyncs, "enable-progressive-syncs", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_PROGRESSIVE_SYNCS", false), "Enable use of the experimental progressive syncs feature.")
@@ -326,6 +348,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&maxResourcesStatusCount, "max-resources-status-count", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_MAX_RESOURCES_STATUS_COUNT", 5000, 0, math.MaxInt), "Max number of resources stored in appset status.")
 	command.Flags().DurationVar(&cacheSyncPeriod, "cache-sync-period", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CACHE_SYNC_PERIOD", time.Hour*10, 0, time.Hour*24), "Period at which the manager client cache is forcefully resynced with the Kubernetes API server. 0 disables periodic resync.")
 	command.Flags().IntVar(&concurrentApplicationUpdates, "concurrent-application-updates", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_CONCURRENT_APPLICATION_UPDATES", 1, 1, 200), "Number of concurrent Application create/update/delete operations per ApplicationSet reconcile.")
+	command.Flags().BoolVar(&enableRegistration, "enable-declarative-registration", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_DECLARATIVE_REGISTRATION", false), "Reconcile Repository and Cluster resources in the Argo CD namespace into repository and cluster secrets. Requires the Repository and Cluster CRDs to be installed.")
 	repoServerClientTLSConfigSrc = tls.AddClientTLSFlagsToCmdWithPrefix(&command, "APPLICATIONSET_CONTROLLER")
 	return &command
 }
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/clusteragent"
+	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// inClusterCAPath is the path of the CA bundle of the API server mounted into pods
+	inClusterCAPath = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	// modeRegister registers the cluster, so that Argo CD connects to it
+	modeRegister = "register"
+	// modePull deploys the applications of the cluster without Argo CD connecting to it
+	modePull = "pull"
+)
+
+// NewCommand returns a new instance of an argocd-cluster-agent command
+func NewCommand() *cobra.Command {
+	var (
+		clientConfig   clientcmd.ClientConfig
+		opts           clusteragent.Options
+		caPath         string
+		hubInsecure    bool
+		hubRootCAPath  string
+		requestTimeout time.Duration
+		mode           string
+		pullOpts       clusteragent.PullOptions
+		hubGRPCWeb     bool
+	)
+	command := &cobra.Command{
+		Use:               common.CommandClusterAgent,
+		Short:             "Run the Argo CD cluster agent",
+		Long:              "The Argo CD cluster agent runs in a spoke cluster and registers it with an Argo CD instance. Once an administrator approved the registration, it keeps the token Argo CD uses to access the cluster rotated. In pull mode, the agent instead pulls the manifests of the applications of the cluster from Argo CD, applies them and reports their status back, so that Argo CD never connects to the cluster.",
+		DisableAutoGenTag: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vers := common.GetVersion()
+			vers.LogStartupInfo("Argo CD Cluster Agent", map[string]any{"hub": opts.HubURL, "cluster": opts.Name, "mode": mode})
+
+			cli.SetLogFormat(cmdutil.LogFormat)
+			cli.SetLogLevel(cmdutil.LogLevel)
+
+			switch mode {
+			case modeRegister:
+			case modePull:
+				pullOpts.Name = opts.Name
+				return runPull(cmd.Context(), clientConfig, opts.HubURL, hubInsecure, hubRootCAPath, hubGRPCWeb, pullOpts)
+			default:
+				return fmt.Errorf("unsupported mode %q (possible values: %s, %s)", mode, modeRegister, modePull)
+			}
+
+			if opts.HubURL == "" || opts.Name == "" || opts.Server == "" {
+				return errors.New("--hub-url, --name and --server are required")
+			}
+			if opts.BootstrapToken == "" {
+				return errors.New("the bootstrap token must be set using the ARGOCD_CLUSTER_AGENT_BOOTSTRAP_TOKEN environment variable")
+			}
+			if opts.TokenTTL < 10*time.Minute {
+				return errors.New("--token-ttl must be at least 10m")
+			}
+
+			restConfig, err := clientConfig.ClientConfig()
+			if err != nil {
+				return fmt.Errorf("error getting kubernetes config: %w", err)
+			}
+			kubeClient, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("error creating kubernetes client: %w", err)
+			}
+			if opts.Namespace, _, err = clientConfig.Namespace(); err != nil {
+				return fmt.Errorf("error getting namespace: %w", err)
+			}
+			if caPath != "" {
+				if opts.CAData, err = os.ReadFile(caPath); err != nil {
+					return fmt.Errorf("error reading CA bundle of the cluster: %w", err)
+				}
+			}
+
+			tlsConfig := &tls.Config{InsecureSkipVerify: hubInsecure} //nolint:gosec // explicitly requested by the user
+			if hubRootCAPath != "" {
+				pem, err := os.ReadFile(hubRootCAPath)
+				if err != nil {
+					return fmt.Errorf("error reading root CA of the hub: %w", err)
+				}
+				tlsConfig.RootCAs = x509.NewCertPool()
+				if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+					return fmt.Errorf("no certificates found in %s", hubRootCAPath)
+				}
+			}
+			httpClient := &http.Client{
+				Timeout:   requestTimeout,
+				Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+			}
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			return clusteragent.NewAgent(opts, kubeClient, httpClient).Run(ctx)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	defaultCAPath := ""
+	if _, err := os.Stat(inClusterCAPath); err == nil {
+		defaultCAPath = inClusterCAPath
+	}
+	opts.BootstrapToken = os.Getenv("ARGOCD_CLUSTER_AGENT_BOOTSTRAP_TOKEN")
+	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_LOGFORMAT", "json"), "Set the logging format. One of: json|text")
+	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
+	command.Flags().StringVar(&opts.HubURL, "hub-url", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_HUB_URL", ""), "URL of the Argo CD API server the cluster is registered with")
+	command.Flags().BoolVar(&hubInsecure, "hub-insecure", env.ParseBoolFromEnv("ARGOCD_CLUSTER_AGENT_HUB_INSECURE", false), "Skip verification of the TLS certificate of the Argo CD API server")
+	command.Flags().StringVar(&hubRootCAPath, "hub-root-ca-path", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_HUB_ROOT_CA_PATH", ""), "Path of a PEM-encoded root CA used to verify the TLS certificate of the Argo CD API server")
+	command.Flags().StringVar(&opts.Name, "name", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_NAME", ""), "Name the cluster is registered with")
+	command.Flags().StringVar(&opts.Server, "server", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_SERVER", ""), "URL of the API server of the cluster, as reachable from Argo CD")
+	command.Flags().StringVar(&caPath, "server-ca-path", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_SERVER_CA_PATH", defaultCAPath), "Path of the PEM-encoded CA bundle of the API server of the cluster")
+	command.Flags().StringVar(&opts.ServiceAccount, "service-account", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_SERVICE_ACCOUNT", "argocd-manager"), "Service account whose tokens are handed to Argo CD")
+	command.Flags().StringVar(&opts.StateSecret, "state-secret", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_STATE_SECRET", "argocd-cluster-agent-state"), "Secret the agent stores its state in")
+	command.Flags().DurationVar(&opts.TokenTTL, "token-ttl", env.ParseDurationFromEnv("ARGOCD_CLUSTER_AGENT_TOKEN_TTL", 24*time.Hour, 10*time.Minute, 365*24*time.Hour), "Lifetime of the tokens handed to Argo CD. Tokens are rotated after two thirds of their lifetime.")
+	command.Flags().DurationVar(&opts.PollInterval, "poll-interval", env.ParseDurationFromEnv("ARGOCD_CLUSTER_AGENT_POLL_INTERVAL", 30*time.Second, time.Second, time.Hour), "Interval at which a pending registration request is polled")
+	command.Flags().StringVar(&mode, "mode", env.StringFromEnv("ARGOCD_CLUSTER_AGENT_MODE", modeRegister), "Mode of the agent. One of: register|pull")
+	command.Flags().DurationVar(&pullOpts.Interval, "sync-interval", env.ParseDurationFromEnv("ARGOCD_CLUSTER_AGENT_SYNC_INTERVAL", 3*time.Minute, 10*time.Second, 24*time.Hour), "Interval at which the applications of the cluster are reconciled in pull mode")
+	command.Flags().BoolVar(&hubGRPCWeb, "hub-grpc-web", env.ParseBoolFromEnv("ARGOCD_CLUSTER_AGENT_HUB_GRPC_WEB", false), "Use the gRPC-web protocol to connect to the Argo CD API server in pull mode, e.g. behind a proxy without HTTP/2 support")
+	command.Flags().DurationVar(&requestTimeout, "request-timeout", env.ParseDurationFromEnv("ARGOCD_CLUSTER_AGENT_REQUEST_TIMEOUT", 30*time.Second, time.Second, 10*time.Minute), "Timeout of requests to the Argo CD API server")
+	return command
+}
+
+// runPull runs the agent in pull mode until a termination signal is received.
+func runPull(ctx context.Context, clientConfig clientcmd.ClientConfig, hubURL string, hubInsecure bool, hubRootCAPath string, hubGRPCWeb bool, opts clusteragent.PullOptions) error {
+	if hubURL == "" || opts.Name == "" {
+		return errors.New("--hub-url and --name are required")
+	}
+	authToken := os.Getenv("ARGOCD_CLUSTER_AGENT_AUTH_TOKEN")
+	if authToken == "" {
+		return errors.New("the token of the account of the agent must be set using the ARGOCD_CLUSTER_AGENT_AUTH_TOKEN environment variable")
+	}
+	hub, err := url.Parse(hubURL)
+	if err != nil {
+		return fmt.Errorf("invalid --hub-url: %w", err)
+	}
+	serverAddr := hub.Host
+	if hub.Port() == "" {
+		if hub.Scheme == "http" {
+			serverAddr += ":80"
+		} else {
+			serverAddr += ":443"
+		}
+	}
+	apiClient, err := apiclient.NewClient(&apiclient.ClientOptions{
+		ServerAddr: serverAddr,
+		PlainText:  hub.Scheme == "http",
+		Insecure:   hubInsecure,
+		CertFile:   hubRootCAPath,
+		AuthToken:  authToken,
+		GRPCWeb:    hubGRPCWeb,
+		UserAgent:  common.CommandClusterAgent + "/" + common.GetVersion().Version,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Argo CD client: %w", err)
+	}
+	conn, appClient, err := apiClient.NewApplicationClient()
+	if err != nil {
+		return fmt.Errorf("error creating application client: %w", err)
+	}
+	defer utilio.Close(conn)
+	projConn, projClient, err := apiClient.NewProjectClient()
+	if err != nil {
+		return fmt.Errorf("error creating project client: %w", err)
+	}
+	defer utilio.Close(projConn)
+	clusterConn, clusterClient, err := apiClient.NewClusterClient()
+	if err != nil {
+		return fmt.Errorf("error creating cluster client: %w", err)
+	}
+	defer utilio.Close(clusterConn)
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("error getting kubernetes config: %w", err)
+	}
+	resources, err := clusteragent.NewKubeResourceClient(restConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return clusteragent.NewPuller(opts, appClient, projClient, clusterClient, resources).Run(ctx)
+}
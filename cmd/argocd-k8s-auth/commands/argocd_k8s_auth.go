@@ -1,7 +1,12 @@
 package commands
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthv1beta1 "k8s.io/client-go/pkg/apis/clientauthentication/v1beta1"
 
 	"github.com/argoproj/argo-cd/v3/common"
 )
@@ -22,3 +27,19 @@ func NewCommand() *cobra.Command {
 
 	return command
 }
+
+func formatJSON(token string, expiration time.Time) string {
+	expirationTimestamp := metav1.NewTime(expiration)
+	execInput := &clientauthv1beta1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "client.authentication.k8s.io/v1beta1",
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthv1beta1.ExecCredentialStatus{
+			ExpirationTimestamp: &expirationTimestamp,
+			Token:               token,
+		},
+	}
+	enc, _ := json.Marshal(execInput)
+	return string(enc)
+}
@@ -6,55 +6,10 @@ import (
 	"testing"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestGetSignedRequest(t *testing.T) {
-	t.Parallel()
-
-	t.Run("returns error when context is cancelled", func(t *testing.T) {
-		t.Parallel()
-		ctx, cancel := context.WithCancel(t.Context())
-		cancel()
-
-		url, err := getSignedRequest(ctx, "my-cluster", "", "")
-
-		require.ErrorIs(t, err, context.Canceled)
-		assert.Empty(t, url)
-	})
-
-	t.Run("returns error for non-existent profile", func(t *testing.T) {
-		t.Parallel()
-		ctx := t.Context()
-		profile := "argocd-k8s-auth-test-nonexistent-profile-12345"
-
-		url, err := getSignedRequest(ctx, "my-cluster", "", profile)
-
-		require.Error(t, err)
-		assert.Empty(t, url)
-		assert.Contains(t, err.Error(), "configuration", "error should mention configuration load failed")
-	})
-
-	t.Run("returns error when roleARN is provided and assume role fails", func(t *testing.T) {
-		t.Parallel()
-		ctx := t.Context()
-		cfg, err := config.LoadDefaultConfig(ctx,
-			config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("test", "test", "")),
-			config.WithRegion("us-east-1"),
-		)
-		require.NoError(t, err)
-
-		url, err := getSignedRequestWithConfig(ctx, "my-cluster", "arn:aws:iam::123456789012:role/NonExistentRole", cfg)
-
-		require.Error(t, err)
-		assert.Empty(t, url)
-		assert.Contains(t, err.Error(), "presigning", "error should mention presigning failed when assume role is used")
-	})
-}
-
 func TestGetSignedRequestWithRetry(t *testing.T) {
 	t.Parallel()
 
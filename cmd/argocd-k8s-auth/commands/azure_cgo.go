@@ -10,11 +10,12 @@ import (
 	"github.com/Azure/kubelogin/pkg/token"
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 )
 
 const (
-	DEFAULT_AAD_SERVER_APPLICATION_ID = "6dae42f8-4368-4678-94ff-3960e28e3630"
+	DEFAULT_AAD_SERVER_APPLICATION_ID = clusterauth.DefaultAzureServerApplicationID
 	envServerApplicationID            = "AAD_SERVER_APPLICATION_ID"
 	envEnvironmentName                = "AAD_ENVIRONMENT_NAME"
 	envIsPoPTokenEnabled              = "AAD_IS_POP_TOKEN_ENABLED"
@@ -79,11 +79,17 @@ func NewCommand() *cobra.Command {
 		disableOCIManifestMaxExtractedSize bool
 		disableManifestMaxExtractedSize    bool
 		includeHiddenDirectories           bool
+		enableHelmDependencyCache          bool
+		helmRegistryMirrors                map[string]string
 		cmpUseManifestGeneratePaths        bool
 		ociMediaTypes                      []string
 		enableBuiltinGitConfig             bool
 		clientCAPath                       string
 		disableTLS                         bool
+		repositoryHealthCheckInterval      time.Duration
+		repoFetchLockTimeout               time.Duration
+		lfsFetchAll                        bool
+		strictSourceOverrides              bool
 	)
 	command := cobra.Command{
 		Use:               common.CommandRepoServer,
@@ -161,11 +167,16 @@ func NewCommand() *cobra.Command {
 				OCIManifestMaxExtractedSize:                  ociManifestMaxExtractedSizeQuantity.ToDec().Value(),
 				DisableOCIManifestMaxExtractedSize:           disableOCIManifestMaxExtractedSize,
 				IncludeHiddenDirectories:                     includeHiddenDirectories,
+				EnableHelmDependencyCache:                    enableHelmDependencyCache,
+				HelmRegistryMirrors:                          helmRegistryMirrors,
 				CMPUseManifestGeneratePaths:                  cmpUseManifestGeneratePaths,
 				OCIMediaTypes:                                ociMediaTypes,
 				EnableBuiltinGitConfig:                       enableBuiltinGitConfig,
 				HelmUserAgent:                                helmUserAgent,
 				HelmChartCacheExpiration:                     repoCacheExpiration,
+				RepoFetchLockTimeout:                         repoFetchLockTimeout,
+				LFSFetchAll:                                  lfsFetchAll,
+				StrictSourceOverrides:                        strictSourceOverrides,
 			}, askPassServer, clientCAPath, disableTLS)
 			errors.CheckError(err)
 
@@ -214,6 +225,10 @@ func NewCommand() *cobra.Command {
 			go func() { errors.CheckError(http.ListenAndServe(fmt.Sprintf("%s:%d", metricsHost, metricsPort), mux)) }()
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
+			if repositoryHealthCheckInterval > 0 {
+				go server.RunRepositoryHealthChecks(ctx, repositoryHealthCheckInterval)
+			}
+
 			if sourceintegrity.IsGPGEnabled() {
 				log.Infof("Initializing GnuPG keyring at %s", common.GetGnuPGHomePath())
 				err = sourceintegrity.InitializeGnuPG()
@@ -276,10 +291,16 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&disableOCIManifestMaxExtractedSize, "disable-oci-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_OCI_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of oci manifest archives when extracted")
 	command.Flags().BoolVar(&disableManifestMaxExtractedSize, "disable-helm-manifest-max-extracted-size", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_HELM_MANIFEST_MAX_EXTRACTED_SIZE", false), "Disable maximum size of helm manifest archives when extracted")
 	command.Flags().BoolVar(&includeHiddenDirectories, "include-hidden-directories", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_INCLUDE_HIDDEN_DIRECTORIES", false), "Include hidden directories from Git")
+	command.Flags().BoolVar(&enableHelmDependencyCache, "enable-helm-dependency-cache", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_HELM_DEPENDENCY_CACHE", false), "Share the dependencies of helm charts having a lock file between applications instead of downloading them for each application")
+	command.Flags().StringToStringVar(&helmRegistryMirrors, "helm-registry-mirrors", env.ParseStringToStringFromEnv("ARGOCD_REPO_SERVER_HELM_REGISTRY_MIRRORS", map[string]string{}, ","), "Mirrors from which the Helm charts and OCI dependencies of OCI registries are pulled, as comma-separated registry=mirror pairs (e.g. docker.io=registry.example.com/docker-hub,ghcr.io=registry.example.com/ghcr)")
 	command.Flags().BoolVar(&cmpUseManifestGeneratePaths, "plugin-use-manifest-generate-paths", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_PLUGIN_USE_MANIFEST_GENERATE_PATHS", false), "Pass the resources described in argocd.argoproj.io/manifest-generate-paths value to the cmpserver to generate the application manifests.")
 	command.Flags().StringSliceVar(&ociMediaTypes, "oci-layer-media-types", env.StringsFromEnv("ARGOCD_REPO_SERVER_OCI_LAYER_MEDIA_TYPES", []string{"application/vnd.oci.image.layer.v1.tar", "application/vnd.oci.image.layer.v1.tar+gzip", "application/vnd.cncf.helm.chart.content.v1.tar+gzip"}, ","), "Comma separated list of allowed media types for OCI media types. This only accounts for media types within layers.")
 	command.Flags().BoolVar(&enableBuiltinGitConfig, "enable-builtin-git-config", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_ENABLE_BUILTIN_GIT_CONFIG", true), "Enable builtin git configuration options that are required for correct argocd-repo-server operation.")
 	command.Flags().BoolVar(&disableTLS, "disable-tls", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_DISABLE_TLS", false), "Disable TLS for the repo-server gRPC endpoint")
+	command.Flags().DurationVar(&repositoryHealthCheckInterval, "repository-health-check-interval", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_REPOSITORY_HEALTH_CHECK_INTERVAL", 10*time.Minute, 0, math.MaxInt64), "Interval of the background health checks of the repositories used within the last 24 hours. Set to 0 to disable the health checks.")
+	command.Flags().DurationVar(&repoFetchLockTimeout, "repo-fetch-lock-timeout", env.ParseDurationFromEnv("ARGOCD_REPO_SERVER_FETCH_LOCK_TIMEOUT", 0, 0, math.MaxInt64), "Maximum duration a replica fetches a revision of a repository on behalf of the other replicas, which wait for its result instead of fetching the same revision. Set to 0 to disable.")
+	command.Flags().BoolVar(&lfsFetchAll, "lfs-fetch-all", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_LFS_FETCH_ALL", false), "Fetch the LFS objects of all refs of the repositories with LFS enabled instead of only the LFS files within the paths of the applications")
+	command.Flags().BoolVar(&strictSourceOverrides, "strict-source-overrides", env.ParseBoolFromEnv("ARGOCD_REPO_SERVER_STRICT_SOURCE_OVERRIDES", false), "Fail the manifest generation of the applications with invalid .argocd-source*.yaml parameter override files instead of ignoring their invalid fields")
 	command.Flags().StringVar(&clientCAPath, "client-ca-path", env.StringFromEnv("ARGOCD_REPO_SERVER_CLIENT_CA_PATH", "/app/config/reposerver/mtls/client-ca.crt"), "Path to the client CA certificate file for mTLS. Defaults to the auto-mounted Secret path; mTLS is skipped if the file does not exist.")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(&command)
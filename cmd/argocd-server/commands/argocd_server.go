@@ -93,6 +93,10 @@ func NewCommand() *cobra.Command {
 		webhookRefreshWorkers    int
 		hydratorEnabled          bool
 		syncWithReplaceAllowed   bool
+		readOnly                 bool
+		enableAdmissionWebhook   bool
+		admissionWebhookPort     int
+		trustedProxyCIDRs        []string
 
 		// ApplicationSet
 		enableNewGitFileGlobbing bool
@@ -258,6 +262,10 @@ func NewCommand() *cobra.Command {
 				EnableK8sEvent:          enableK8sEvent,
 				HydratorEnabled:         hydratorEnabled,
 				SyncWithReplaceAllowed:  syncWithReplaceAllowed,
+				ReadOnly:                readOnly,
+				EnableAdmissionWebhook:  enableAdmissionWebhook,
+				AdmissionWebhookPort:    admissionWebhookPort,
+				TrustedProxyCIDRs:       trustedProxyCIDRs,
 			}
 
 			appsetOpts := server.ApplicationSetOpts{
@@ -343,6 +351,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
 	command.Flags().BoolVar(&syncWithReplaceAllowed, "sync-with-replace-allowed", env.ParseBoolFromEnv("ARGOCD_SYNC_WITH_REPLACE_ALLOWED", true), "Whether to allow users to select replace for syncs from UI/CLI")
+	command.Flags().BoolVar(&readOnly, "read-only", env.ParseBoolFromEnv("ARGOCD_SERVER_READ_ONLY", false), "Run the server as a read-only replica, which only serves the API requests which do not mutate any state, e.g. get, list and watch requests")
+	command.Flags().BoolVar(&enableAdmissionWebhook, "enable-admission-webhook", env.ParseBoolFromEnv("ARGOCD_SERVER_ENABLE_ADMISSION_WEBHOOK", false), "Serve the validating admission webhook for applications and projects at /api/admission/validate, and the conversion webhook for applications at /api/admission/convert, on the admission webhook port")
+	command.Flags().IntVar(&admissionWebhookPort, "admission-webhook-port", env.ParseNumFromEnv("ARGOCD_SERVER_ADMISSION_WEBHOOK_PORT", common.DefaultPortAPIServerAdmission, 1, 65535), "Serve the admission webhooks on given port")
+	command.Flags().StringSliceVar(&trustedProxyCIDRs, "trusted-proxy-cidrs", env.StringsFromEnv("ARGOCD_SERVER_TRUSTED_PROXY_CIDRS", []string{}, ","), "List of networks of the reverse proxies in front of the server whose X-Forwarded-For entries are used to determine the client address (e.g. 10.0.0.0/8)")
 
 	// Flags related to the applicationSet component.
 	command.Flags().StringVar(&scmRootCAPath, "appset-scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
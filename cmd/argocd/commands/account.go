@@ -62,6 +62,8 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountRevokeTokenCommand(clientOpts))
+	command.AddCommand(NewAccountIntrospectTokenCommand(clientOpts))
 	command.AddCommand(NewAccountSessionTokenCommand(clientOpts))
 	command.AddCommand(NewBcryptCmd())
 	return command
@@ -110,7 +112,9 @@ has appropriate RBAC permissions to change other accounts.
 			}
 
 			if account == "" {
-				account = userInfo.Username
+				var err error
+				account, err = getCurrentAccountName(userInfo)
+				errors.CheckError(err)
 			}
 
 			if newPassword == "" {
@@ -301,6 +305,15 @@ func getCurrentAccount(ctx context.Context, clientset argocdclient.Client) sessi
 	return *userInfo
 }
 
+// getCurrentAccountName returns the name of the Argo CD account of the current user. In core mode, the current user is
+// the Kubernetes user of the CLI, which has no Argo CD account.
+func getCurrentAccountName(userInfo session.GetUserInfoResponse) (string, error) {
+	if userInfo.Iss == sessionutil.KubernetesClaimsIssuer {
+		return "", fmt.Errorf("the current user '%s' is a Kubernetes user without an Argo CD account, use --account to select an account", userInfo.Username)
+	}
+	return userInfo.Username, nil
+}
+
 func NewAccountGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		output  string
@@ -320,7 +333,9 @@ argocd account get --account <account-name>`,
 			clientset := headless.NewClientOrDie(clientOpts, c)
 
 			if account == "" {
-				account = getCurrentAccount(ctx, clientset).Username
+				var err error
+				account, err = getCurrentAccountName(getCurrentAccount(ctx, clientset))
+				errors.CheckError(err)
 			}
 
 			conn, client := clientset.NewAccountClientOrDie()
@@ -356,28 +371,46 @@ func printAccountDetails(acc *accountpkg.Account) {
 		fmt.Println("NONE")
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprint(w, "ID\tISSUED AT\tEXPIRING AT\n")
+		fmt.Fprint(w, "ID\tISSUED AT\tEXPIRING AT\tAUDIENCE\tREVOKED AT\n")
 		for _, t := range acc.Tokens {
-			expiresAtFormatted := "never"
-			if t.ExpiresAt > 0 {
-				expiresAt := time.Unix(t.ExpiresAt, 0)
-				expiresAtFormatted = expiresAt.Format(time.RFC3339)
-				if expiresAt.Before(time.Now()) {
-					expiresAtFormatted = expiresAtFormatted + " (expired)"
-				}
-			}
-
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), formatTokenExpiresAt(t), formatTokenAudience(t), formatTokenRevokedAt(t))
 		}
 		_ = w.Flush()
 	}
 }
 
+func formatTokenExpiresAt(t *accountpkg.Token) string {
+	if t.ExpiresAt == 0 {
+		return "never"
+	}
+	expiresAt := time.Unix(t.ExpiresAt, 0)
+	if expiresAt.Before(time.Now()) {
+		return expiresAt.Format(time.RFC3339) + " (expired)"
+	}
+	return expiresAt.Format(time.RFC3339)
+}
+
+func formatTokenAudience(t *accountpkg.Token) string {
+	if len(t.Audience) == 0 {
+		return "any"
+	}
+	return strings.Join(t.Audience, ",")
+}
+
+func formatTokenRevokedAt(t *accountpkg.Token) string {
+	if t.RevokedAt == 0 {
+		return "-"
+	}
+	return time.Unix(t.RevokedAt, 0).Format(time.RFC3339)
+}
+
 func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
 		account   string
 		expiresIn string
+		expiresAt string
 		id        string
+		audience  []string
 	)
 	cmd := &cobra.Command{
 		Use:   "generate-token",
@@ -386,7 +419,13 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 argocd account generate-token
 
 # Generate token for the account with the specified name
-argocd account generate-token --account <account-name>`,
+argocd account generate-token --account <account-name>
+
+# Generate token which expires at the given time
+argocd account generate-token --account <account-name> --expires-at 2030-01-01T00:00:00Z
+
+# Generate token which is only accepted by the Argo CD instance with the given URL
+argocd account generate-token --account <account-name> --audience https://argocd.example.com`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
@@ -394,14 +433,24 @@ argocd account generate-token --account <account-name>`,
 			conn, client := clientset.NewAccountClientOrDie()
 			defer utilio.Close(conn)
 			if account == "" {
-				account = getCurrentAccount(ctx, clientset).Username
+				var err error
+				account, err = getCurrentAccountName(getCurrentAccount(ctx, clientset))
+				errors.CheckError(err)
 			}
 			expiresIn, err := timeutil.ParseDuration(expiresIn)
 			errors.CheckError(err)
+			var expiresAtUnix int64
+			if expiresAt != "" {
+				t, err := time.Parse(time.RFC3339, expiresAt)
+				errors.CheckError(err)
+				expiresAtUnix = t.Unix()
+			}
 			response, err := client.CreateToken(ctx, &accountpkg.CreateTokenRequest{
 				Name:      account,
 				ExpiresIn: int64(expiresIn.Seconds()),
+				ExpiresAt: expiresAtUnix,
 				Id:        id,
+				Audience:  audience,
 			})
 			errors.CheckError(err)
 			fmt.Println(response.Token)
@@ -409,7 +458,10 @@ argocd account generate-token --account <account-name>`,
 	}
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
+	cmd.Flags().StringVar(&expiresAt, "expires-at", "", "Time at which the token will expire, in RFC3339 format, e.g. 2030-01-01T00:00:00Z")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fall back to uuid if not value specified.")
+	cmd.Flags().StringArrayVar(&audience, "audience", []string{}, "URL of an Argo CD instance which accepts the token. Can be repeated. (Default: accepted by any instance)")
+	cmd.MarkFlagsMutuallyExclusive("expires-in", "expires-at")
 	return cmd
 }
 
@@ -436,7 +488,9 @@ argocd account delete-token --account <account-name> ID`,
 			conn, client := clientset.NewAccountClientOrDie()
 			defer utilio.Close(conn)
 			if account == "" {
-				account = getCurrentAccount(ctx, clientset).Username
+				var err error
+				account, err = getCurrentAccountName(getCurrentAccount(ctx, clientset))
+				errors.CheckError(err)
 			}
 			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
 			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to delete '%s' token? [y/n]", id))
@@ -452,6 +506,102 @@ argocd account delete-token --account <account-name> ID`,
 	return cmd
 }
 
+func NewAccountRevokeTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var account string
+	cmd := &cobra.Command{
+		Use:   "revoke-token",
+		Short: "Revoke account token",
+		Long:  "Revoke a token of an account. Unlike deleted tokens, revoked tokens are still listed in the tokens of the account.",
+		Example: `# Revoke token of the currently logged in account
+argocd account revoke-token ID
+
+# Revoke token of the account with the specified name
+argocd account revoke-token --account <account-name> ID`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			id := args[0]
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer utilio.Close(conn)
+			if account == "" {
+				var err error
+				account, err = getCurrentAccountName(getCurrentAccount(ctx, clientset))
+				errors.CheckError(err)
+			}
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canRevoke := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to revoke '%s' token? [y/n]", id))
+			if canRevoke {
+				_, err := client.RevokeToken(ctx, &accountpkg.RevokeTokenRequest{Name: account, Id: id})
+				errors.CheckError(err)
+			} else {
+				fmt.Printf("The command to revoke '%s' was cancelled.\n", id)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
+
+func NewAccountIntrospectTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "introspect-token TOKEN",
+		Short: "Display the account, expiry, audience and status of an account token",
+		Example: `# Introspect a token
+argocd account introspect-token $ARGOCD_TOKEN
+
+# Introspect a token and print the result as JSON
+argocd account introspect-token $ARGOCD_TOKEN -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := client.IntrospectToken(ctx, &accountpkg.IntrospectTokenRequest{Token: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(res, output)
+				errors.CheckError(err)
+			case "wide", "":
+				printTokenIntrospection(res)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return cmd
+}
+
+func printTokenIntrospection(res *accountpkg.TokenIntrospection) {
+	fmt.Printf(printOpFmtStr, "Active:", strconv.FormatBool(res.Active))
+	if res.Reason != "" {
+		fmt.Printf(printOpFmtStr, "Reason:", res.Reason)
+	}
+	fmt.Printf(printOpFmtStr, "Account:", res.Name)
+	fmt.Printf(printOpFmtStr, "Capability:", res.Capability)
+	if t := res.Token; t != nil {
+		fmt.Printf(printOpFmtStr, "ID:", t.Id)
+		fmt.Printf(printOpFmtStr, "Issued At:", time.Unix(t.IssuedAt, 0).Format(time.RFC3339))
+		fmt.Printf(printOpFmtStr, "Expiring At:", formatTokenExpiresAt(t))
+		fmt.Printf(printOpFmtStr, "Audience:", formatTokenAudience(t))
+		fmt.Printf(printOpFmtStr, "Revoked At:", formatTokenRevokedAt(t))
+	}
+}
+
 func NewAccountSessionTokenCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
 	cmd := &cobra.Command{
@@ -493,6 +643,10 @@ curl -H "Authorization: Bearer $ARGOCD_AUTH_TOKEN" $ARGOCD_SERVER/api/v1/applica
 				log.Fatal("No context found. Please login first with 'argocd login'")
 			}
 
+			if clientOpts.Core || configCtx.Server.Core {
+				log.Fatal("There is no session token in core mode, as the CLI authenticates to Kubernetes directly")
+			}
+
 			if configCtx.User.AuthToken == "" {
 				log.Fatal("No authentication token found. Please login first with 'argocd login'")
 			}
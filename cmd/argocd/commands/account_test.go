@@ -0,0 +1,20 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
+	sessionutil "github.com/argoproj/argo-cd/v3/util/session"
+)
+
+func TestGetCurrentAccountName(t *testing.T) {
+	name, err := getCurrentAccountName(session.GetUserInfoResponse{LoggedIn: true, Username: "admin", Iss: sessionutil.SessionManagerClaimsIssuer})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", name)
+
+	_, err = getCurrentAccountName(session.GetUserInfoResponse{LoggedIn: true, Username: "kubernetes-admin", Iss: sessionutil.KubernetesClaimsIssuer})
+	require.EqualError(t, err, "the current user 'kubernetes-admin' is a Kubernetes user without an Argo CD account, use --account to select an account")
+}
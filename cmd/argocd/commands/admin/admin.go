@@ -77,10 +77,14 @@ $ argocd admin initial-password reset
 	command.AddCommand(NewRepoCommand())
 	command.AddCommand(NewImportCommand())
 	command.AddCommand(NewExportCommand())
+	command.AddCommand(NewImportBundleCommand())
+	command.AddCommand(NewExportBundleCommand())
 	command.AddCommand(NewDashboardCommand(clientOpts))
 	command.AddCommand(NewNotificationsCommand())
 	command.AddCommand(NewInitialPasswordCommand())
 	command.AddCommand(NewRedisInitialPasswordCommand())
+	command.AddCommand(NewCacheCommand(clientOpts))
+	command.AddCommand(NewControllerCommand(clientOpts))
 
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", "json", "Set the logging format. One of: json|text")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", "info", "Set the logging level. One of: debug|info|warn|error")
@@ -6,6 +6,7 @@ import (
 	stderrors "errors"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"sort"
 	"time"
@@ -31,7 +32,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha2"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	appinformers "github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions"
 	reposerverclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -61,6 +64,12 @@ argocd admin app generate-spec APPNAME
 
 # Reconcile all applications and store reconciliation summary in the specified file
 argocd admin app get-reconcile-results APPNAME
+
+# Convert the applications in a manifest file to the v1alpha2 API version
+argocd admin app convert -f apps.yaml --api-version argoproj.io/v1alpha2
+
+# Render the manifests of an application from a local checkout of its repository
+argocd admin app materialize -f app.yaml --local-repo https://github.com/argoproj/argocd-example-apps.git=.
 `,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
@@ -70,9 +79,89 @@ argocd admin app get-reconcile-results APPNAME
 	command.AddCommand(NewGenAppSpecCommand())
 	command.AddCommand(NewReconcileCommand(clientOpts))
 	command.AddCommand(NewDiffReconcileResults())
+	command.AddCommand(NewConvertCommand())
+	command.AddCommand(NewMaterializeCommand())
 	return command
 }
 
+// NewConvertCommand converts the applications in manifest files between the API versions
+func NewConvertCommand() *cobra.Command {
+	var (
+		fileURL    string
+		apiVersion string
+		inline     bool
+	)
+	command := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert the applications in a manifest file to another API version",
+		Example: `
+	# Convert the applications in a file to the v1alpha2 API version
+	argocd admin app convert -f apps.yaml --api-version argoproj.io/v1alpha2
+
+	# Convert the applications in a file back to the v1alpha1 API version, and write them back to the file
+	argocd admin app convert -f apps.yaml --api-version argoproj.io/v1alpha1 --inline
+`,
+		Run: func(c *cobra.Command, args []string) {
+			if fileURL == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var data []byte
+			var err error
+			if parsedURL, parseErr := url.ParseRequestURI(fileURL); parseErr != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+				data, err = os.ReadFile(fileURL)
+			} else {
+				data, err = config.ReadRemoteFile(fileURL)
+			}
+			errors.CheckError(err)
+			converted, err := convertApplications(data, apiVersion)
+			errors.CheckError(err)
+
+			out, closer, err := getOutWriter(inline, fileURL)
+			errors.CheckError(err)
+			defer utilio.Close(closer)
+			_, err = out.Write(converted)
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL of the manifests to convert")
+	command.Flags().StringVar(&apiVersion, "api-version", v1alpha2.SchemeGroupVersion.String(), "API version to convert the applications to. One of: argoproj.io/v1alpha1|argoproj.io/v1alpha2")
+	command.Flags().BoolVarP(&inline, "inline", "i", false, "If set then the converted manifests are written back to the file specified in --file flag")
+	return command
+}
+
+// convertApplications converts the applications in the given YAML manifests to the given API version. All other
+// resources are returned as is.
+func convertApplications(data []byte, apiVersion string) ([]byte, error) {
+	objs, err := kube.SplitYAML(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing manifests: %w", err)
+	}
+	var out []byte
+	for i, obj := range objs {
+		if obj.GroupVersionKind().Group == application.Group && obj.GetKind() == application.ApplicationKind {
+			_, hasStatus := obj.Object["status"]
+			if obj, err = v1alpha2.Convert(obj, apiVersion); err != nil {
+				return nil, err
+			}
+			// the conversion sets the fields which are not omitted if empty, which are not part of the manifests
+			unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+			if !hasStatus {
+				unstructured.RemoveNestedField(obj.Object, "status")
+			}
+		}
+		yamlBytes, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling yaml: %w", err)
+		}
+		if i > 0 {
+			out = append(out, []byte("---\n")...)
+		}
+		out = append(out, yamlBytes...)
+	}
+	return out, nil
+}
+
 // NewGenAppSpecCommand generates declarative configuration file for given application
 func NewGenAppSpecCommand() *cobra.Command {
 	var (
@@ -480,5 +569,5 @@ func reconcileApplications(
 }
 
 func newLiveStateCache(argoDB db.ArgoDB, appInformer kubecache.SharedIndexInformer, settingsMgr *settings.SettingsManager, server *metrics.MetricsServer) cache.LiveStateCache {
-	return cache.NewLiveStateCache(argoDB, appInformer, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking())
+	return cache.NewLiveStateCache(argoDB, appInformer, nil, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking())
 }
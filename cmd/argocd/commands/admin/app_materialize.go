@@ -0,0 +1,224 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/repository"
+	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// materializeOptions are the settings of the Argo CD instance which affect the rendered manifests
+type materializeOptions struct {
+	controllerNamespace string
+	appLabelKey         string
+	trackingMethod      string
+	installationID      string
+	kubeVersion         string
+	apiVersions         []string
+}
+
+// NewMaterializeCommand renders the manifests of an application from local checkouts of its repositories
+func NewMaterializeCommand() *cobra.Command {
+	var (
+		fileURL            string
+		localRepos         []string
+		outputFormat       string
+		pluginSockFilePath string
+		opts               materializeOptions
+	)
+	command := &cobra.Command{
+		Use:   "materialize",
+		Short: "Render the manifests of an application locally, the same way the repo server does",
+		Long: `Render the manifests of an application locally, the same way the repo server does.
+
+The sources of the application are read from local checkouts of their repositories, which are mapped to the repository
+URLs with the --local-repo flag. Helm chart sources are read from the directory of the unpacked chart. The rendered
+manifests include the tracking labels or annotations Argo CD sets when syncing them.
+
+Config management plugins are invoked through the socket files of their sidecars, which can be run locally with the
+same image as in the repo server and a shared socket directory passed with the --plugin-sock-file-path flag.`,
+		Example: `
+	# Render the manifests of a single source application
+	argocd admin app materialize -f guestbook.yaml --local-repo https://github.com/argoproj/argocd-example-apps.git=.
+
+	# Render the manifests of a multi-source application referencing a values repository
+	argocd admin app materialize -f app.yaml --local-repo https://github.com/org/charts.git=../charts --local-repo https://github.com/org/values.git=.
+
+	# Render the manifests for a specific Kubernetes version, using the label tracking method
+	argocd admin app materialize -f app.yaml --local-repo https://github.com/org/apps.git=. --kube-version 1.31 --tracking-method label
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if fileURL == "" {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			apps, err := cmdutil.ConstructApps(fileURL, "", nil, nil, nil, cmdutil.AppOptions{}, pflag.NewFlagSet("materialize", pflag.ContinueOnError))
+			errors.CheckError(err)
+			if len(apps) != 1 {
+				errors.CheckError(fmt.Errorf("expected exactly one application in %s, found %d", fileURL, len(apps)))
+			}
+			repoPaths, err := parseLocalRepos(localRepos)
+			errors.CheckError(err)
+			if pluginSockFilePath != "" {
+				errors.CheckError(os.Setenv(common.EnvPluginSockFilePath, pluginSockFilePath))
+			}
+
+			objs, err := materializeApplication(ctx, apps[0], repoPaths, opts)
+			errors.CheckError(err)
+			errors.CheckError(printManifests(os.Stdout, outputFormat, objs))
+		},
+	}
+	command.Flags().StringVarP(&fileURL, "file", "f", "", "Filename or URL of the application manifest, or - to read it from stdin")
+	command.Flags().StringArrayVar(&localRepos, "local-repo", []string{}, "Local checkout of a repository of the application, in the form REPO_URL=PATH")
+	command.Flags().StringVarP(&outputFormat, "output", "o", "yaml", "Output format. One of: json|yaml")
+	command.Flags().StringVar(&pluginSockFilePath, "plugin-sock-file-path", "", "Directory of the socket files of the config management plugin sidecars")
+	command.Flags().StringVar(&opts.controllerNamespace, "controller-namespace", "argocd", "Namespace of the Argo CD instance, used in the tracking id of the applications of other namespaces")
+	command.Flags().StringVar(&opts.appLabelKey, "app-label-key", common.LabelKeyAppInstance, "Label key used to track the resources of the application")
+	command.Flags().StringVar(&opts.trackingMethod, "tracking-method", string(v1alpha1.TrackingMethodAnnotation), "Resource tracking method. One of: annotation|label|annotation+label")
+	command.Flags().StringVar(&opts.installationID, "installation-id", "", "Installation id of the Argo CD instance, added to the tracking annotation")
+	command.Flags().StringVar(&opts.kubeVersion, "kube-version", "", "Kubernetes version of the destination cluster, passed to Helm and Kustomize")
+	command.Flags().StringArrayVar(&opts.apiVersions, "api-versions", []string{}, "API versions available in the destination cluster, passed to Helm (e.g. monitoring.coreos.com/v1)")
+	return command
+}
+
+// parseLocalRepos parses the REPO_URL=PATH mappings of the local checkouts of repositories into a map of normalized
+// repository URLs to absolute paths
+func parseLocalRepos(localRepos []string) (map[string]string, error) {
+	res := make(map[string]string, len(localRepos))
+	for _, localRepo := range localRepos {
+		repoURL, path, ok := strings.Cut(localRepo, "=")
+		if !ok || repoURL == "" || path == "" {
+			return nil, fmt.Errorf("invalid local repository '%s', expected REPO_URL=PATH", localRepo)
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("error resolving path of local repository '%s': %w", localRepo, err)
+		}
+		res[git.NormalizeGitURL(repoURL)] = absPath
+	}
+	return res, nil
+}
+
+// materializeApplication renders the manifests of all sources of the application from the given local checkouts of
+// their repositories
+func materializeApplication(ctx context.Context, app *v1alpha1.Application, repoPaths map[string]string, opts materializeOptions) ([]*unstructured.Unstructured, error) {
+	sources := app.Spec.GetSources()
+	if len(sources) == 0 {
+		return nil, stderrors.New("application has no sources")
+	}
+	hasMultipleSources := app.Spec.HasMultipleSources()
+
+	refSources, err := argo.GetRefSources(ctx, sources, app.Spec.Project, func(_ context.Context, url string, _ string) (*v1alpha1.Repository, error) {
+		return &v1alpha1.Repository{Repo: url}, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	gitRepoPaths := utilio.NewRandomizedTempPaths(os.TempDir())
+	for repoURL, path := range repoPaths {
+		gitRepoPaths.Add(repoURL, path)
+	}
+
+	var objs []*unstructured.Unstructured
+	for i := range sources {
+		source := sources[i]
+		// like in the repo server, sources only referenced by the other sources do not produce manifests
+		if hasMultipleSources && source.Path == "" && !source.IsOCI() && !source.IsHelm() && source.IsRef() {
+			continue
+		}
+		repoRoot, ok := repoPaths[git.NormalizeGitURL(source.RepoURL)]
+		if !ok {
+			return nil, fmt.Errorf("no local checkout of repository %s, use --local-repo %s=PATH", source.RepoURL, source.RepoURL)
+		}
+		appPath, err := apppathutil.Path(repoRoot, source.Path)
+		if err != nil {
+			return nil, err
+		}
+		res, err := repository.GenerateManifests(ctx, appPath, repoRoot, source.TargetRevision, &repoapiclient.ManifestRequest{
+			Repo:                            &v1alpha1.Repository{Repo: source.RepoURL},
+			AppLabelKey:                     opts.appLabelKey,
+			AppName:                         app.InstanceName(opts.controllerNamespace),
+			SourceEnvironment:               app.Labels[v1alpha1.LabelKeySourceEnvironment],
+			Namespace:                       app.Spec.Destination.Namespace,
+			ApplicationSource:               &source,
+			KustomizeOptions:                &v1alpha1.KustomizeOptions{},
+			KubeVersion:                     opts.kubeVersion,
+			ApiVersions:                     opts.apiVersions,
+			TrackingMethod:                  opts.trackingMethod,
+			InstallationID:                  opts.installationID,
+			ProjectName:                     app.Spec.GetProject(),
+			HasMultipleSources:              hasMultipleSources,
+			RefSources:                      refSources,
+			AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+		}, true, &git.NoopCredsStore{}, resource.MustParse("0"), gitRepoPaths)
+		if err != nil {
+			return nil, fmt.Errorf("error generating manifests of source %s: %w", source.RepoURL, err)
+		}
+		for _, manifest := range res.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+			}
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// printManifests prints the given manifests as a YAML stream, or as a JSON array
+func printManifests(out io.Writer, format string, objs []*unstructured.Unstructured) error {
+	switch format {
+	case "json":
+		items := make([]map[string]any, 0, len(objs))
+		for _, obj := range objs {
+			items = append(items, obj.Object)
+		}
+		jsonBytes, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling json: %w", err)
+		}
+		_, err = fmt.Fprintln(out, string(jsonBytes))
+		return err
+	case "yaml":
+		for i, obj := range objs {
+			yamlBytes, err := yaml.Marshal(obj.Object)
+			if err != nil {
+				return fmt.Errorf("error marshaling yaml: %w", err)
+			}
+			if i > 0 {
+				if _, err := fmt.Fprint(out, "---\n"); err != nil {
+					return err
+				}
+			}
+			if _, err := out.Write(yamlBytes); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output format: %s", format)
+	}
+}
@@ -1,6 +1,10 @@
 package admin
 
 import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	clustermocks "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
@@ -9,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -109,6 +115,7 @@ func TestGetReconcileResults_Refresh(t *testing.T) {
 	clusterCache.EXPECT().IsNamespaced(mock.Anything).Return(true, nil)
 	clusterCache.EXPECT().GetGVKParser().Return(nil)
 	repoServerClient := &mocks.RepoServerServiceClient{}
+	repoServerClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unimplemented, ""))
 	repoServerClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Return(&argocdclient.ManifestResponse{
 		Manifests: []string{test.DeploymentManifest},
 	}, nil)
@@ -198,3 +205,111 @@ app3
 >   status: OutOfSync
 `, logs)
 }
+
+func TestConvertApplications(t *testing.T) {
+	manifests := `apiVersion: argoproj.io/v1alpha1
+kind: Application
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  project: default
+  source:
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+    path: guestbook
+  destination:
+    server: https://kubernetes.default.svc
+    namespace: guestbook
+  syncPolicy:
+    syncOptions:
+    - CreateNamespace=true
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`
+	converted, err := convertApplications([]byte(manifests), "argoproj.io/v1alpha2")
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: argoproj.io/v1alpha2
+kind: Application
+metadata:
+  name: guestbook
+  namespace: argocd
+spec:
+  destinations:
+  - namespace: guestbook
+    server: https://kubernetes.default.svc
+  project: default
+  sources:
+  - path: guestbook
+    repoURL: https://github.com/argoproj/argocd-example-apps.git
+  syncPolicy:
+    syncOptions:
+      createNamespace: true
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+`, string(converted))
+
+	back, err := convertApplications(converted, "argoproj.io/v1alpha1")
+	require.NoError(t, err)
+	assert.Contains(t, string(back), "apiVersion: argoproj.io/v1alpha1")
+	assert.Contains(t, string(back), "  source:\n    path: guestbook\n")
+	assert.Contains(t, string(back), "    - CreateNamespace=true\n")
+
+	_, err = convertApplications([]byte(manifests), "argoproj.io/v1beta1")
+	require.EqualError(t, err, "cannot convert argoproj.io/v1alpha1 to argoproj.io/v1beta1")
+}
+
+func TestMaterializeApplication(t *testing.T) {
+	repoDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "guestbook"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "guestbook", "cm.yaml"), []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: guestbook-config
+data:
+  key: value
+`), 0o644))
+
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+				{RepoURL: "https://github.com/argoproj/values.git", Ref: "values"},
+			},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+		},
+	}
+	repoPaths, err := parseLocalRepos([]string{"https://github.com/argoproj/argocd-example-apps.git=" + repoDir})
+	require.NoError(t, err)
+	opts := materializeOptions{controllerNamespace: "argocd", appLabelKey: common.LabelKeyAppInstance, trackingMethod: string(v1alpha1.TrackingMethodLabel)}
+
+	objs, err := materializeApplication(t.Context(), app, repoPaths, opts)
+	require.NoError(t, err)
+	require.Len(t, objs, 1)
+	assert.Equal(t, "guestbook-config", objs[0].GetName())
+	assert.Equal(t, "guestbook", objs[0].GetLabels()[common.LabelKeyAppInstance])
+
+	var out bytes.Buffer
+	require.NoError(t, printManifests(&out, "yaml", append(objs, objs[0])))
+	assert.Equal(t, 1, strings.Count(out.String(), "---\n"))
+
+	app.Spec.Sources[0].RepoURL = "https://github.com/argoproj/unknown.git"
+	_, err = materializeApplication(t.Context(), app, repoPaths, opts)
+	require.ErrorContains(t, err, "no local checkout of repository https://github.com/argoproj/unknown.git")
+}
+
+func TestParseLocalRepos(t *testing.T) {
+	repoPaths, err := parseLocalRepos([]string{"https://github.com/argoproj/argocd-example-apps.git=/tmp/apps"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"https://github.com/argoproj/argocd-example-apps": "/tmp/apps"}, repoPaths)
+
+	_, err = parseLocalRepos([]string{"/tmp/apps"})
+	require.EqualError(t, err, "invalid local repository '/tmp/apps', expected REPO_URL=PATH")
+}
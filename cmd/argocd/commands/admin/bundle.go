@@ -0,0 +1,720 @@
+package admin
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	appclient "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// bundleVersion is the version of the bundle format. It must be changed whenever the format changes in an
+	// incompatible way.
+	bundleVersion = "v1"
+
+	bundleMetadataFile     = "metadata.json"
+	bundleProjectsFile     = "projects.yaml"
+	bundleRepositoriesFile = "repositories.yaml"
+	bundleRepoCredsFile    = "repository-credentials.yaml"
+	bundleClustersFile     = "clusters.yaml"
+	bundleCredentialsFile  = "credentials.enc"
+)
+
+// bundleCredentialsMode describes how the credentials of repositories and clusters are stored in a bundle
+type bundleCredentialsMode string
+
+const (
+	// bundleCredentialsRedacted means that credentials are not part of the bundle
+	bundleCredentialsRedacted bundleCredentialsMode = "redacted"
+	// bundleCredentialsEncrypted means that credentials are stored encrypted with a key provided on export
+	bundleCredentialsEncrypted bundleCredentialsMode = "encrypted"
+)
+
+// bundleMetadata describes the content of a bundle
+type bundleMetadata struct {
+	Version     string                `json:"version"`
+	CreatedAt   metav1.Time           `json:"createdAt"`
+	Credentials bundleCredentialsMode `json:"credentials"`
+}
+
+// bundle holds the projects, repositories and clusters exported from an Argo CD instance
+type bundle struct {
+	Projects              []*v1alpha1.AppProject `json:"projects,omitempty"`
+	Repositories          []*v1alpha1.Repository `json:"repositories,omitempty"`
+	RepositoryCredentials []*v1alpha1.RepoCreds  `json:"repositoryCredentials,omitempty"`
+	Clusters              []*v1alpha1.Cluster    `json:"clusters,omitempty"`
+}
+
+// NewExportBundleCommand defines a new command for exporting projects, repositories and clusters into a bundle.
+func NewExportBundleCommand() *cobra.Command {
+	var (
+		clientConfig      clientcmd.ClientConfig
+		out               string
+		encryptionKeyFile string
+	)
+	command := cobra.Command{
+		Use:   "export-bundle",
+		Short: "Export projects, repositories and clusters into a bundle for migrating them to another Argo CD instance",
+		Long: `Export projects, repositories, repository credential templates and clusters into a versioned, gzipped tar archive.
+
+Credentials of repositories and clusters are redacted unless an encryption key is provided, in which case they are
+encrypted with the key and restored by 'argocd admin import-bundle' using the same key.`,
+		Example: `# Export a bundle without credentials
+argocd admin export-bundle -o bundle.tar.gz
+
+# Export a bundle with credentials encrypted using the passphrase stored in a file
+argocd admin export-bundle -o bundle.tar.gz --encryption-key-file passphrase.txt`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(config)
+			appClient := versioned.NewForConfigOrDie(config)
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+
+			var key []byte
+			if encryptionKeyFile != "" {
+				key, err = readBundleKey(encryptionKeyFile)
+				errors.CheckError(err)
+			}
+
+			b, err := collectBundle(ctx, argoDB, appClient.ArgoprojV1alpha1().AppProjects(namespace))
+			errors.CheckError(err)
+
+			var writer io.Writer
+			if out == "-" {
+				writer = os.Stdout
+			} else {
+				f, err := os.Create(out)
+				errors.CheckError(err)
+				bw := bufio.NewWriter(f)
+				writer = bw
+				defer func() {
+					err = bw.Flush()
+					errors.CheckError(err)
+					err = f.Close()
+					errors.CheckError(err)
+				}()
+			}
+			errors.CheckError(writeBundle(writer, b, key, time.Now()))
+			if out != "-" {
+				fmt.Printf("Exported %d projects, %d repositories, %d repository credentials and %d clusters to %s\n",
+					len(b.Projects), len(b.Repositories), len(b.RepositoryCredentials), len(b.Clusters), out)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVarP(&out, "out", "o", "-", "Output to the specified file instead of stdout")
+	command.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "File containing the passphrase used to encrypt credentials. Credentials are redacted if not specified")
+	return &command
+}
+
+// NewImportBundleCommand defines a new command for importing projects, repositories and clusters from a bundle.
+func NewImportBundleCommand() *cobra.Command {
+	var (
+		clientConfig      clientcmd.ClientConfig
+		encryptionKeyFile string
+		dryRun            bool
+		overwrite         bool
+	)
+	command := cobra.Command{
+		Use:   "import-bundle SOURCE",
+		Short: "Import projects, repositories and clusters from a bundle created by 'argocd admin export-bundle' from stdin (specify `-') or a file",
+		Long: `Import projects, repositories, repository credential templates and clusters from a bundle.
+
+The whole bundle is validated before any resource is created. Existing resources are left unchanged unless --overwrite
+is specified. Repositories and clusters of bundles with redacted credentials are imported without credentials.`,
+		Example: `# Print what would be imported
+argocd admin import-bundle bundle.tar.gz --dry-run
+
+# Import a bundle with encrypted credentials, replacing existing resources
+argocd admin import-bundle bundle.tar.gz --encryption-key-file passphrase.txt --overwrite`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			config, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(config)
+			appClient := versioned.NewForConfigOrDie(config)
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+
+			var key []byte
+			if encryptionKeyFile != "" {
+				key, err = readBundleKey(encryptionKeyFile)
+				errors.CheckError(err)
+			}
+
+			var reader io.Reader
+			if in := args[0]; in == "-" {
+				reader = os.Stdin
+			} else {
+				f, err := os.Open(in)
+				errors.CheckError(err)
+				defer f.Close()
+				reader = f
+			}
+			b, metadata, err := readBundle(reader, key)
+			errors.CheckError(err)
+			if metadata.Credentials == bundleCredentialsRedacted {
+				log.Warn("Credentials are redacted in the bundle, repositories and clusters are imported without credentials")
+			}
+
+			opts := bundleImportOpts{dryRun: dryRun, overwrite: overwrite}
+			errors.CheckError(importBundle(ctx, argoDB, appClient.ArgoprojV1alpha1().AppProjects(namespace), b, opts, os.Stdout))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "File containing the passphrase used to encrypt the credentials on export")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print what will be performed")
+	command.Flags().BoolVar(&overwrite, "overwrite", false, "Replace projects, repositories and clusters which already exist")
+	return &command
+}
+
+// readBundleKey derives the encryption key of a bundle from the passphrase stored in the given file
+func readBundleKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading encryption key file: %w", err)
+	}
+	passphrase := strings.TrimSpace(string(data))
+	if passphrase == "" {
+		return nil, fmt.Errorf("encryption key file %s is empty", path)
+	}
+	return crypto.KeyFromPassphrase(passphrase)
+}
+
+// collectBundle reads the projects, repositories and clusters of an Argo CD instance. Server-generated fields and the
+// in-cluster destination, which is not backed by a cluster secret, are omitted.
+func collectBundle(ctx context.Context, argoDB db.ArgoDB, projIf appclient.AppProjectInterface) (*bundle, error) {
+	b := &bundle{}
+
+	projects, err := projIf.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+	for _, proj := range projects.Items {
+		b.Projects = append(b.Projects, &v1alpha1.AppProject{
+			TypeMeta: metav1.TypeMeta{Kind: "AppProject", APIVersion: v1alpha1.SchemeGroupVersion.String()},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        proj.Name,
+				Labels:      proj.Labels,
+				Annotations: proj.Annotations,
+			},
+			Spec: proj.Spec,
+		})
+	}
+
+	repos, err := argoDB.ListRepositories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories: %w", err)
+	}
+	for _, repo := range repos {
+		repo = repo.DeepCopy()
+		repo.ConnectionState = v1alpha1.ConnectionState{}
+		if repo.InheritedCreds {
+			// credentials are exported with the repository credential template they are inherited from
+			redactRepository(repo)
+			repo.InheritedCreds = false
+		}
+		b.Repositories = append(b.Repositories, repo)
+	}
+
+	credsURLs, err := argoDB.ListRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing repository credentials: %w", err)
+	}
+	for _, url := range credsURLs {
+		creds, err := argoDB.GetRepositoryCredentials(ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("error getting repository credentials %s: %w", url, err)
+		}
+		if creds != nil {
+			b.RepositoryCredentials = append(b.RepositoryCredentials, creds)
+		}
+	}
+
+	clusters, err := argoDB.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing clusters: %w", err)
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Server == v1alpha1.KubernetesInternalAPIServerAddr && cluster.ID == "" {
+			continue
+		}
+		b.Clusters = append(b.Clusters, &v1alpha1.Cluster{
+			Server:           cluster.Server,
+			Name:             cluster.Name,
+			Config:           cluster.Config,
+			Namespaces:       cluster.Namespaces,
+			Shard:            cluster.Shard,
+			ClusterResources: cluster.ClusterResources,
+			Project:          cluster.Project,
+			Labels:           cluster.Labels,
+			Annotations:      cluster.Annotations,
+		})
+	}
+	return b, nil
+}
+
+func redactRepository(repo *v1alpha1.Repository) {
+	repo.Password = ""
+	repo.SSHPrivateKey = ""
+	repo.TLSClientCertKey = ""
+	repo.GithubAppPrivateKey = ""
+	repo.GCPServiceAccountKey = ""
+	repo.BearerToken = ""
+	repo.AzureServicePrincipalClientSecret = ""
+}
+
+func redactRepoCreds(creds *v1alpha1.RepoCreds) {
+	creds.Password = ""
+	creds.SSHPrivateKey = ""
+	creds.TLSClientCertKey = ""
+	creds.GithubAppPrivateKey = ""
+	creds.GCPServiceAccountKey = ""
+	creds.BearerToken = ""
+	creds.AzureServicePrincipalClientSecret = ""
+}
+
+func redactCluster(cluster *v1alpha1.Cluster) {
+	cluster.Config.Password = ""
+	cluster.Config.BearerToken = ""
+	cluster.Config.KeyData = nil
+}
+
+// redacted returns a copy of the bundle without credentials
+func (b *bundle) redacted() *bundle {
+	res := &bundle{Projects: b.Projects}
+	for _, repo := range b.Repositories {
+		repo = repo.DeepCopy()
+		redactRepository(repo)
+		res.Repositories = append(res.Repositories, repo)
+	}
+	for _, creds := range b.RepositoryCredentials {
+		creds = creds.DeepCopy()
+		redactRepoCreds(creds)
+		res.RepositoryCredentials = append(res.RepositoryCredentials, creds)
+	}
+	for _, cluster := range b.Clusters {
+		cluster = cluster.DeepCopy()
+		redactCluster(cluster)
+		res.Clusters = append(res.Clusters, cluster)
+	}
+	return res
+}
+
+// writeBundle writes the bundle as a gzipped tar archive. Credentials are encrypted with the given key, or redacted if
+// no key is given.
+func writeBundle(w io.Writer, b *bundle, key []byte, createdAt time.Time) error {
+	metadata := bundleMetadata{
+		Version:     bundleVersion,
+		CreatedAt:   metav1.NewTime(createdAt),
+		Credentials: bundleCredentialsRedacted,
+	}
+	if key != nil {
+		metadata.Credentials = bundleCredentialsEncrypted
+	}
+	redacted := b.redacted()
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{bundleProjectsFile, redacted.Projects},
+		{bundleRepositoriesFile, redacted.Repositories},
+		{bundleRepoCredsFile, redacted.RepositoryCredentials},
+		{bundleClustersFile, redacted.Clusters},
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	writeFile := func(name string, data []byte) error {
+		err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: createdAt})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(data)
+		return err
+	}
+
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFile(bundleMetadataFile, data); err != nil {
+		return fmt.Errorf("error writing %s: %w", bundleMetadataFile, err)
+	}
+	for _, f := range files {
+		data, err := yaml.Marshal(f.data)
+		if err != nil {
+			return fmt.Errorf("error marshaling %s: %w", f.name, err)
+		}
+		if err := writeFile(f.name, data); err != nil {
+			return fmt.Errorf("error writing %s: %w", f.name, err)
+		}
+	}
+	if key != nil {
+		data, err := json.Marshal(&bundle{
+			Repositories:          b.Repositories,
+			RepositoryCredentials: b.RepositoryCredentials,
+			Clusters:              b.Clusters,
+		})
+		if err != nil {
+			return fmt.Errorf("error marshaling credentials: %w", err)
+		}
+		encrypted, err := crypto.Encrypt(data, key)
+		if err != nil {
+			return fmt.Errorf("error encrypting credentials: %w", err)
+		}
+		if err := writeFile(bundleCredentialsFile, encrypted); err != nil {
+			return fmt.Errorf("error writing %s: %w", bundleCredentialsFile, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// readBundle reads a bundle written by writeBundle. The given key is required to restore encrypted credentials.
+func readBundle(r io.Reader, key []byte) (*bundle, *bundleMetadata, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading bundle: %w", err)
+	}
+	defer gzr.Close()
+	tr := tar.NewReader(gzr)
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if stderrors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading bundle: %w", err)
+		}
+		switch header.Name {
+		case bundleMetadataFile, bundleProjectsFile, bundleRepositoriesFile, bundleRepoCredsFile, bundleClustersFile, bundleCredentialsFile:
+		default:
+			return nil, nil, fmt.Errorf("unexpected file %s in bundle", header.Name)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error reading %s: %w", header.Name, err)
+		}
+		files[header.Name] = data
+	}
+
+	data, ok := files[bundleMetadataFile]
+	if !ok {
+		return nil, nil, fmt.Errorf("bundle is missing %s", bundleMetadataFile)
+	}
+	var metadata bundleMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshaling %s: %w", bundleMetadataFile, err)
+	}
+	if metadata.Version != bundleVersion {
+		return nil, nil, fmt.Errorf("unsupported bundle version %q, expected %q", metadata.Version, bundleVersion)
+	}
+
+	b := &bundle{}
+	for name, dest := range map[string]any{
+		bundleProjectsFile:     &b.Projects,
+		bundleRepositoriesFile: &b.Repositories,
+		bundleRepoCredsFile:    &b.RepositoryCredentials,
+		bundleClustersFile:     &b.Clusters,
+	} {
+		if data, ok := files[name]; ok {
+			if err := yaml.UnmarshalStrict(data, dest); err != nil {
+				return nil, nil, fmt.Errorf("error unmarshaling %s: %w", name, err)
+			}
+		}
+	}
+
+	switch metadata.Credentials {
+	case bundleCredentialsRedacted:
+	case bundleCredentialsEncrypted:
+		if key == nil {
+			return nil, nil, stderrors.New("credentials are encrypted in the bundle, an encryption key is required")
+		}
+		encrypted, ok := files[bundleCredentialsFile]
+		if !ok {
+			return nil, nil, fmt.Errorf("bundle is missing %s", bundleCredentialsFile)
+		}
+		data, err := crypto.Decrypt(encrypted, key)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error decrypting credentials, the encryption key may be wrong: %w", err)
+		}
+		var creds bundle
+		if err := json.Unmarshal(data, &creds); err != nil {
+			return nil, nil, fmt.Errorf("error unmarshaling credentials: %w", err)
+		}
+		if err := restoreCredentials(b, &creds); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported credentials mode %q", metadata.Credentials)
+	}
+	return b, &metadata, nil
+}
+
+// restoreCredentials replaces the redacted repositories and clusters of the bundle with the ones holding credentials
+func restoreCredentials(b *bundle, creds *bundle) error {
+	repoKey := func(repo *v1alpha1.Repository) string { return repo.Project + "/" + repo.Repo }
+	credsKey := func(creds *v1alpha1.RepoCreds) string { return creds.URL }
+	clusterKey := func(cluster *v1alpha1.Cluster) string { return cluster.Server }
+	if !slices.Equal(mapSlice(b.Repositories, repoKey), mapSlice(creds.Repositories, repoKey)) ||
+		!slices.Equal(mapSlice(b.RepositoryCredentials, credsKey), mapSlice(creds.RepositoryCredentials, credsKey)) ||
+		!slices.Equal(mapSlice(b.Clusters, clusterKey), mapSlice(creds.Clusters, clusterKey)) {
+		return stderrors.New("encrypted credentials do not match the content of the bundle")
+	}
+	b.Repositories = creds.Repositories
+	b.RepositoryCredentials = creds.RepositoryCredentials
+	b.Clusters = creds.Clusters
+	return nil
+}
+
+func mapSlice[T any](items []T, f func(T) string) []string {
+	res := make([]string, len(items))
+	for i := range items {
+		res[i] = f(items[i])
+	}
+	return res
+}
+
+// validate checks that the bundle can be imported. Repositories and clusters may only reference projects which are
+// part of the bundle or already exist.
+func (b *bundle) validate(existingProjects []string) error {
+	var errs []error
+	projects := map[string]bool{}
+	for _, name := range existingProjects {
+		projects[name] = true
+	}
+	seen := map[string]bool{}
+	for _, proj := range b.Projects {
+		if seen[proj.Name] {
+			errs = append(errs, fmt.Errorf("project %q: duplicate project", proj.Name))
+			continue
+		}
+		seen[proj.Name] = true
+		projects[proj.Name] = true
+		if err := proj.ValidateProject(); err != nil {
+			errs = append(errs, fmt.Errorf("project %q: %w", proj.Name, err))
+		}
+	}
+	checkProject := func(kind string, name string, project string) {
+		if project != "" && !projects[project] {
+			errs = append(errs, fmt.Errorf("%s %q: project %q does not exist", kind, name, project))
+		}
+	}
+
+	seen = map[string]bool{}
+	for _, repo := range b.Repositories {
+		if repo.Repo == "" {
+			errs = append(errs, stderrors.New("repository: URL is required"))
+			continue
+		}
+		if key := repo.Project + "/" + repo.Repo; seen[key] {
+			errs = append(errs, fmt.Errorf("repository %q: duplicate repository", repo.Repo))
+		} else {
+			seen[key] = true
+		}
+		checkProject("repository", repo.Repo, repo.Project)
+	}
+
+	seen = map[string]bool{}
+	for _, creds := range b.RepositoryCredentials {
+		if creds.URL == "" {
+			errs = append(errs, stderrors.New("repository credentials: URL is required"))
+			continue
+		}
+		if seen[creds.URL] {
+			errs = append(errs, fmt.Errorf("repository credentials %q: duplicate repository credentials", creds.URL))
+		}
+		seen[creds.URL] = true
+	}
+
+	seen = map[string]bool{}
+	for _, cluster := range b.Clusters {
+		if cluster.Server == "" {
+			errs = append(errs, fmt.Errorf("cluster %q: server is required", cluster.Name))
+			continue
+		}
+		if seen[cluster.Server] {
+			errs = append(errs, fmt.Errorf("cluster %q: duplicate cluster", cluster.Server))
+		}
+		seen[cluster.Server] = true
+		checkProject("cluster", cluster.Server, cluster.Project)
+	}
+	return stderrors.Join(errs...)
+}
+
+type bundleImportOpts struct {
+	dryRun    bool
+	overwrite bool
+}
+
+// importBundle validates the bundle and creates its projects, repositories and clusters. Existing resources are only
+// updated if overwrite is enabled.
+func importBundle(ctx context.Context, argoDB db.ArgoDB, projIf appclient.AppProjectInterface, b *bundle, opts bundleImportOpts, out io.Writer) error {
+	projects, err := projIf.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing projects: %w", err)
+	}
+	existingProjects := make([]string, 0, len(projects.Items))
+	for _, proj := range projects.Items {
+		existingProjects = append(existingProjects, proj.Name)
+	}
+	if err := b.validate(existingProjects); err != nil {
+		return fmt.Errorf("bundle is invalid: %w", err)
+	}
+
+	var dryRunMsg string
+	if opts.dryRun {
+		dryRunMsg = " (dry run)"
+	}
+	report := func(kind string, name string, action string) {
+		_, _ = fmt.Fprintf(out, "%s %s %s%s\n", kind, name, action, dryRunMsg)
+	}
+
+	// projects are imported first since repositories and clusters may reference them
+	for _, proj := range b.Projects {
+		live, err := projIf.Get(ctx, proj.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			if !opts.dryRun {
+				proj := proj.DeepCopy()
+				proj.ResourceVersion = ""
+				if _, err := projIf.Create(ctx, proj, metav1.CreateOptions{}); err != nil {
+					return fmt.Errorf("error creating project %s: %w", proj.Name, err)
+				}
+			}
+			report("AppProject", proj.Name, "created")
+		case err != nil:
+			return fmt.Errorf("error getting project %s: %w", proj.Name, err)
+		case !opts.overwrite:
+			report("AppProject", proj.Name, "already exists, skipped")
+		default:
+			if !opts.dryRun {
+				live.Labels = proj.Labels
+				live.Annotations = proj.Annotations
+				live.Spec = proj.Spec
+				if _, err := projIf.Update(ctx, live, metav1.UpdateOptions{}); err != nil {
+					return fmt.Errorf("error updating project %s: %w", proj.Name, err)
+				}
+			}
+			report("AppProject", proj.Name, "updated")
+		}
+	}
+
+	for _, creds := range b.RepositoryCredentials {
+		live, err := argoDB.GetRepositoryCredentials(ctx, creds.URL)
+		switch {
+		case err != nil:
+			return fmt.Errorf("error getting repository credentials %s: %w", creds.URL, err)
+		case live == nil:
+			if !opts.dryRun {
+				if _, err := argoDB.CreateRepositoryCredentials(ctx, creds); err != nil {
+					return fmt.Errorf("error creating repository credentials %s: %w", creds.URL, err)
+				}
+			}
+			report("RepositoryCredentials", creds.URL, "created")
+		case !opts.overwrite:
+			report("RepositoryCredentials", creds.URL, "already exists, skipped")
+		default:
+			if !opts.dryRun {
+				if _, err := argoDB.UpdateRepositoryCredentials(ctx, creds); err != nil {
+					return fmt.Errorf("error updating repository credentials %s: %w", creds.URL, err)
+				}
+			}
+			report("RepositoryCredentials", creds.URL, "updated")
+		}
+	}
+
+	for _, repo := range b.Repositories {
+		exists, err := argoDB.RepositoryExists(ctx, repo.Repo, repo.Project)
+		switch {
+		case err != nil:
+			return fmt.Errorf("error getting repository %s: %w", repo.Repo, err)
+		case !exists:
+			if !opts.dryRun {
+				if _, err := argoDB.CreateRepository(ctx, repo); err != nil {
+					return fmt.Errorf("error creating repository %s: %w", repo.Repo, err)
+				}
+			}
+			report("Repository", repo.Repo, "created")
+		case !opts.overwrite:
+			report("Repository", repo.Repo, "already exists, skipped")
+		default:
+			if !opts.dryRun {
+				if _, err := argoDB.UpdateRepository(ctx, repo); err != nil {
+					return fmt.Errorf("error updating repository %s: %w", repo.Repo, err)
+				}
+			}
+			report("Repository", repo.Repo, "updated")
+		}
+	}
+
+	for _, cluster := range b.Clusters {
+		_, err := argoDB.GetCluster(ctx, cluster.Server)
+		switch {
+		case status.Code(err) == codes.NotFound:
+			if !opts.dryRun {
+				if _, err := argoDB.CreateCluster(ctx, cluster); err != nil {
+					return fmt.Errorf("error creating cluster %s: %w", cluster.Server, err)
+				}
+			}
+			report("Cluster", cluster.Server, "created")
+		case err != nil:
+			return fmt.Errorf("error getting cluster %s: %w", cluster.Server, err)
+		case !opts.overwrite:
+			report("Cluster", cluster.Server, "already exists, skipped")
+		default:
+			if !opts.dryRun {
+				if _, err := argoDB.UpdateCluster(ctx, cluster); err != nil {
+					return fmt.Errorf("error updating cluster %s: %w", cluster.Server, err)
+				}
+			}
+			report("Cluster", cluster.Server, "updated")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+package admin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	fakeapps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/util/crypto"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+type bundleTestInstance struct {
+	db        db.ArgoDB
+	appClient *fakeapps.Clientset
+}
+
+func newBundleTestInstance(t *testing.T, projects ...*v1alpha1.AppProject) *bundleTestInstance {
+	t.Helper()
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDConfigMapName,
+				Namespace: "argocd",
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDSecretName,
+				Namespace: "argocd",
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Data: map[string][]byte{"server.secretkey": []byte("test")},
+		},
+	)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, "argocd")
+	appClient := fakeapps.NewSimpleClientset()
+	for _, proj := range projects {
+		_, err := appClient.ArgoprojV1alpha1().AppProjects("argocd").Create(t.Context(), proj, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	return &bundleTestInstance{db: db.NewDB("argocd", settingsMgr, kubeClient), appClient: appClient}
+}
+
+func (i *bundleTestInstance) importBundle(ctx context.Context, b *bundle, opts bundleImportOpts) (string, error) {
+	var out bytes.Buffer
+	err := importBundle(ctx, i.db, i.appClient.ArgoprojV1alpha1().AppProjects("argocd"), b, opts, &out)
+	return out.String(), err
+}
+
+func newBundleTestSource(t *testing.T) *bundleTestInstance {
+	t.Helper()
+	ctx := t.Context()
+	src := newBundleTestInstance(t, &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "argocd", ResourceVersion: "1"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	})
+	_, err := src.db.CreateRepository(ctx, &v1alpha1.Repository{Repo: "https://github.com/example/apps", Username: "user", Password: "repo-password", Project: "team-a"})
+	require.NoError(t, err)
+	_, err = src.db.CreateRepositoryCredentials(ctx, &v1alpha1.RepoCreds{URL: "https://github.com/example", Username: "user", Password: "creds-password"})
+	require.NoError(t, err)
+	_, err = src.db.CreateCluster(ctx, &v1alpha1.Cluster{
+		Server: "https://remote.example.com",
+		Name:   "remote",
+		Config: v1alpha1.ClusterConfig{BearerToken: "cluster-token"},
+	})
+	require.NoError(t, err)
+	return src
+}
+
+func TestBundle_RoundTrip(t *testing.T) {
+	ctx := t.Context()
+	src := newBundleTestSource(t)
+	b, err := collectBundle(ctx, src.db, src.appClient.ArgoprojV1alpha1().AppProjects("argocd"))
+	require.NoError(t, err)
+	require.Len(t, b.Projects, 1)
+	assert.Empty(t, b.Projects[0].ResourceVersion)
+	require.Len(t, b.Repositories, 1)
+	require.Len(t, b.RepositoryCredentials, 1)
+	require.Len(t, b.Clusters, 1, "in-cluster destination is not exported")
+
+	key, err := crypto.KeyFromPassphrase("passphrase")
+	require.NoError(t, err)
+	var data bytes.Buffer
+	require.NoError(t, writeBundle(&data, b, key, time.Now()))
+
+	t.Run("Encrypted", func(t *testing.T) {
+		read, metadata, err := readBundle(bytes.NewReader(data.Bytes()), key)
+		require.NoError(t, err)
+		assert.Equal(t, bundleCredentialsEncrypted, metadata.Credentials)
+
+		dest := newBundleTestInstance(t)
+		out, err := dest.importBundle(ctx, read, bundleImportOpts{})
+		require.NoError(t, err)
+		assert.Contains(t, out, "AppProject team-a created")
+		assert.Contains(t, out, "Cluster https://remote.example.com created")
+
+		repo, err := dest.db.GetRepository(ctx, "https://github.com/example/apps", "team-a")
+		require.NoError(t, err)
+		assert.Equal(t, "repo-password", repo.Password)
+		creds, err := dest.db.GetRepositoryCredentials(ctx, "https://github.com/example")
+		require.NoError(t, err)
+		assert.Equal(t, "creds-password", creds.Password)
+		cluster, err := dest.db.GetCluster(ctx, "https://remote.example.com")
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-token", cluster.Config.BearerToken)
+	})
+
+	t.Run("MissingKey", func(t *testing.T) {
+		_, _, err := readBundle(bytes.NewReader(data.Bytes()), nil)
+		require.ErrorContains(t, err, "encryption key is required")
+	})
+
+	t.Run("WrongKey", func(t *testing.T) {
+		wrongKey, err := crypto.KeyFromPassphrase("wrong")
+		require.NoError(t, err)
+		_, _, err = readBundle(bytes.NewReader(data.Bytes()), wrongKey)
+		require.ErrorContains(t, err, "error decrypting credentials")
+	})
+
+	t.Run("Redacted", func(t *testing.T) {
+		var data bytes.Buffer
+		require.NoError(t, writeBundle(&data, b, nil, time.Now()))
+		assert.NotContains(t, data.String(), "repo-password")
+
+		read, metadata, err := readBundle(&data, key)
+		require.NoError(t, err)
+		assert.Equal(t, bundleCredentialsRedacted, metadata.Credentials)
+		assert.Empty(t, read.Repositories[0].Password)
+		assert.Equal(t, "user", read.Repositories[0].Username)
+		assert.Empty(t, read.RepositoryCredentials[0].Password)
+		assert.Empty(t, read.Clusters[0].Config.BearerToken)
+	})
+}
+
+func TestReadBundle_Invalid(t *testing.T) {
+	writeArchive := func(t *testing.T, files map[string]string) *bytes.Buffer {
+		t.Helper()
+		var data bytes.Buffer
+		gzw := gzip.NewWriter(&data)
+		tw := tar.NewWriter(gzw)
+		for name, content := range files {
+			require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}))
+			_, err := tw.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		require.NoError(t, gzw.Close())
+		return &data
+	}
+
+	_, _, err := readBundle(writeArchive(t, map[string]string{bundleProjectsFile: "[]"}), nil)
+	require.ErrorContains(t, err, "bundle is missing metadata.json")
+
+	_, _, err = readBundle(writeArchive(t, map[string]string{bundleMetadataFile: `{"version": "v0", "credentials": "redacted"}`}), nil)
+	require.ErrorContains(t, err, `unsupported bundle version "v0"`)
+
+	_, _, err = readBundle(writeArchive(t, map[string]string{bundleMetadataFile: `{"version": "v1", "credentials": "redacted"}`, "secrets.yaml": ""}), nil)
+	require.ErrorContains(t, err, "unexpected file secrets.yaml")
+
+	_, _, err = readBundle(writeArchive(t, map[string]string{bundleMetadataFile: `{"version": "v1", "credentials": "redacted"}`, bundleClustersFile: "- serverr: foo"}), nil)
+	require.ErrorContains(t, err, "error unmarshaling clusters.yaml")
+}
+
+func TestImportBundle_Validation(t *testing.T) {
+	ctx := t.Context()
+	dest := newBundleTestInstance(t, &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"}})
+	b := &bundle{
+		Repositories: []*v1alpha1.Repository{
+			{Repo: "https://github.com/example/apps", Project: "default"},
+			{Repo: "https://github.com/example/other", Project: "missing"},
+		},
+		Clusters: []*v1alpha1.Cluster{{Name: "no-server"}},
+	}
+	_, err := dest.importBundle(ctx, b, bundleImportOpts{})
+	require.ErrorContains(t, err, `repository "https://github.com/example/other": project "missing" does not exist`)
+	require.ErrorContains(t, err, `cluster "no-server": server is required`)
+
+	repos, err := dest.db.ListRepositories(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, repos, "nothing is imported if the bundle is invalid")
+}
+
+func TestImportBundle_Existing(t *testing.T) {
+	ctx := t.Context()
+	dest := newBundleTestInstance(t)
+	_, err := dest.db.CreateRepository(ctx, &v1alpha1.Repository{Repo: "https://github.com/example/apps", Username: "old"})
+	require.NoError(t, err)
+	b := &bundle{Repositories: []*v1alpha1.Repository{{Repo: "https://github.com/example/apps", Username: "new"}}}
+
+	out, err := dest.importBundle(ctx, b, bundleImportOpts{})
+	require.NoError(t, err)
+	assert.Equal(t, "Repository https://github.com/example/apps already exists, skipped\n", out)
+
+	out, err = dest.importBundle(ctx, b, bundleImportOpts{overwrite: true, dryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, "Repository https://github.com/example/apps updated (dry run)\n", out)
+	repo, err := dest.db.GetRepository(ctx, "https://github.com/example/apps", "")
+	require.NoError(t, err)
+	assert.Equal(t, "old", repo.Username)
+
+	_, err = dest.importBundle(ctx, b, bundleImportOpts{overwrite: true})
+	require.NoError(t, err)
+	repo, err = dest.db.GetRepository(ctx, "https://github.com/example/apps", "")
+	require.NoError(t, err)
+	assert.Equal(t, "new", repo.Username)
+}
@@ -0,0 +1,231 @@
+package admin
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+)
+
+// NewCacheCommand returns a new instance of an `argocd admin cache` command
+func NewCacheCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect, flush, export and import the Argo CD Redis cache",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewCacheVersionsCommand(clientOpts))
+	command.AddCommand(NewCacheFlushCommand(clientOpts))
+	command.AddCommand(NewCacheExportCommand(clientOpts))
+	command.AddCommand(NewCacheImportCommand(clientOpts))
+	return command
+}
+
+// cacheCommandOpts holds the flags used to connect to the Redis cache
+type cacheCommandOpts struct {
+	clientConfig     clientcmd.ClientConfig
+	cacheSrc         func() (*cacheutil.Cache, error)
+	portForwardRedis bool
+}
+
+func addCacheCommandFlags(command *cobra.Command) *cacheCommandOpts {
+	opts := &cacheCommandOpts{}
+	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().BoolVar(&opts.portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	opts.cacheSrc = cacheutil.AddCacheFlagsToCmd(command)
+	return opts
+}
+
+func (opts *cacheCommandOpts) getCache(ctx context.Context, clientOpts *argocdclient.ClientOptions) (*cacheutil.Cache, error) {
+	if !opts.portForwardRedis {
+		return opts.cacheSrc()
+	}
+	clientCfg, err := opts.clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	namespace, _, err := opts.clientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+	return newPortForwardedRedisCache(ctx, kubeClient, namespace, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+}
+
+// NewCacheVersionsCommand returns a new instance of an `argocd admin cache versions` command
+func NewCacheVersionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var opts *cacheCommandOpts
+	command := cobra.Command{
+		Use:   "versions",
+		Short: "Print the number of cached items per key prefix and cache schema version",
+		Example: `# Print the number of cached items per key prefix and schema version
+argocd admin cache versions`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			cache, err := opts.getCache(ctx, clientOpts)
+			errors.CheckError(err)
+			counts, err := cache.CountKeysByVersion(ctx)
+			errors.CheckError(err)
+			printCacheVersionCounts(counts)
+		},
+	}
+	opts = addCacheCommandFlags(&command)
+	return &command
+}
+
+func printCacheVersionCounts(counts []cacheutil.KeyVersionCount) {
+	slices.SortFunc(counts, func(a, b cacheutil.KeyVersionCount) int {
+		return cmp.Or(cmp.Compare(a.Prefix, b.Prefix), cmp.Compare(a.Version, b.Version))
+	})
+	fmt.Printf("Current cache version: %s\n\n", common.CacheVersion)
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "PREFIX\tVERSION\tKEYS\n")
+	for _, c := range counts {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\n", c.Prefix, c.Version, c.Count)
+	}
+	_ = w.Flush()
+}
+
+// NewCacheFlushCommand returns a new instance of an `argocd admin cache flush` command
+func NewCacheFlushCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		opts    *cacheCommandOpts
+		version string
+		prefix  string
+	)
+	command := cobra.Command{
+		Use:   "flush",
+		Short: "Delete the cached items stored using the given cache schema version",
+		Example: `# Delete the items cached by a previous Argo CD release once all components are upgraded
+argocd admin cache flush --version 1.8.2
+
+# Delete only the cached manifests of the given schema version
+argocd admin cache flush --version 1.8.2 --prefix mfst`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			cache, err := opts.getCache(ctx, clientOpts)
+			errors.CheckError(err)
+			deleted, err := cache.FlushKeysByVersion(ctx, version, prefix)
+			errors.CheckError(err)
+			fmt.Printf("Deleted %d cached items with schema version %s\n", deleted, version)
+		},
+	}
+	command.Flags().StringVar(&version, "version", "", "Cache schema version of the items to delete")
+	command.Flags().StringVar(&prefix, "prefix", "", "Only delete the items with the given key prefix, e.g. mfst")
+	errors.CheckError(command.MarkFlagRequired("version"))
+	opts = addCacheCommandFlags(&command)
+	return &command
+}
+
+// NewCacheExportCommand returns a new instance of an `argocd admin cache export` command
+func NewCacheExportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		opts     *cacheCommandOpts
+		out      string
+		prefixes []string
+	)
+	command := cobra.Command{
+		Use:   "export",
+		Short: "Export the cached items to stdout (default) or a file",
+		Long: `Export the cached items, so that they can be imported into the Redis instance of a failover Argo CD installation.
+The failover installation then starts with a warm cache instead of generating the manifests of every application again.
+The export is only readable by installations which run the same Argo CD version and use the same Redis compression.`,
+		Example: `# Export the manifests cached by the repo server and the application state cached by the controller
+argocd admin cache export --prefix mfst,app --out cache.json
+
+# Export all the cached items
+argocd admin cache export > cache.json`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			cache, err := opts.getCache(ctx, clientOpts)
+			errors.CheckError(err)
+
+			var writer io.Writer = os.Stdout
+			if out != "-" {
+				f, err := os.Create(out)
+				errors.CheckError(err)
+				bw := bufio.NewWriter(f)
+				writer = bw
+				defer func() {
+					errors.CheckError(bw.Flush())
+					errors.CheckError(f.Close())
+				}()
+			}
+			count, err := cache.Export(ctx, writer, prefixes)
+			errors.CheckError(err)
+			_, _ = fmt.Fprintf(os.Stderr, "Exported %d cached items\n", count)
+		},
+	}
+	command.Flags().StringVarP(&out, "out", "o", "-", "Output to the specified file instead of stdout")
+	command.Flags().StringSliceVar(&prefixes, "prefix", nil, "Only export the items with the given key prefixes, e.g. mfst,app")
+	opts = addCacheCommandFlags(&command)
+	return &command
+}
+
+// NewCacheImportCommand returns a new instance of an `argocd admin cache import` command
+func NewCacheImportCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		opts      *cacheCommandOpts
+		overwrite bool
+	)
+	command := cobra.Command{
+		Use:   "import SOURCE",
+		Short: "Import the cached items exported by `argocd admin cache export` from a file or stdin (-)",
+		Long: `Import the cached items exported by 'argocd admin cache export'. Items keep their original expiration, so the
+items which expired since the export are skipped. Items which already exist are kept unless --overwrite is set.`,
+		Example: `# Warm up the cache of a failover installation
+argocd admin cache import cache.json`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			log.SetLevel(log.WarnLevel)
+
+			cache, err := opts.getCache(ctx, clientOpts)
+			errors.CheckError(err)
+
+			var reader io.Reader = os.Stdin
+			if in := args[0]; in != "-" {
+				f, err := os.Open(in)
+				errors.CheckError(err)
+				defer f.Close()
+				reader = f
+			}
+			result, err := cache.Import(ctx, reader, overwrite)
+			errors.CheckError(err)
+			fmt.Printf("Imported %d cached items, skipped %d existing and %d expired items\n", result.Imported, result.Existing, result.Expired)
+		},
+	}
+	command.Flags().BoolVar(&overwrite, "overwrite", false, "Replace the items which already exist")
+	opts = addCacheCommandFlags(&command)
+	return &command
+}
@@ -66,6 +66,7 @@ argocd admin cluster namespaces my-cluster `,
 	namespacesCommand.AddCommand(NewClusterEnableNamespacedMode())
 	namespacesCommand.AddCommand(NewClusterDisableNamespacedMode())
 	command.AddCommand(namespacesCommand)
+	command.AddCommand(NewClusterRegistrationsCommand())
 
 	return command
 }
@@ -96,25 +97,11 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 
 	var cache *appstatecache.Cache
 	if portForwardRedis {
-		overrides := clientcmd.ConfigOverrides{}
-		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
-		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
-		port, err := kubeutil.PortForward(6379, namespace, &overrides,
-			redisHaProxyPodLabelSelector, redisPodLabelSelector)
+		redisCache, err := newPortForwardedRedisCache(ctx, kubeClient, namespace, redisName, redisHaProxyName, redisCompressionStr)
 		if err != nil {
 			return nil, err
 		}
-
-		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
-		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
-			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
-		}
-		client := redis.NewClient(redisOptions)
-		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
-		if err != nil {
-			return nil, err
-		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
+		cache = appstatecache.NewCache(redisCache, time.Hour)
 	} else {
 		cache, err = cacheSrc()
 		if err != nil {
@@ -164,6 +151,30 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	return clusters, nil
 }
 
+// newPortForwardedRedisCache port-forwards the Argo CD Redis (or Redis HA proxy) in the given namespace and returns a
+// cache connected to it
+func newPortForwardedRedisCache(ctx context.Context, kubeClient kubernetes.Interface, namespace string, redisName string, redisHaProxyName string, redisCompressionStr string) (*cacheutil.Cache, error) {
+	overrides := clientcmd.ConfigOverrides{}
+	redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
+	redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
+	port, err := kubeutil.PortForward(6379, namespace, &overrides,
+		redisHaProxyPodLabelSelector, redisPodLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
+	if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
+		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+	}
+	client := redis.NewClient(redisOptions)
+	compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+	return cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), nil
+}
+
 func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, appControllerName string) (int, error) {
 	appControllerPodLabelSelector := common.LabelKeyAppName + "=" + appControllerName
 	controllerPods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
@@ -632,6 +643,8 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 			kubeClientset := fake.NewClientset(argoCDCM, argoCDSecret)
 
 			var awsAuthConf *v1alpha1.AWSAuthConfig
+			var gcpAuthConf *v1alpha1.GCPAuthConfig
+			var azureAuthConf *v1alpha1.AzureAuthConfig
 			var execProviderConf *v1alpha1.ExecProviderConfig
 			switch {
 			case clusterOpts.AwsClusterName != "":
@@ -640,6 +653,10 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 					RoleARN:     clusterOpts.AwsRoleArn,
 					Profile:     clusterOpts.AwsProfile,
 				}
+			case clusterOpts.GCPAuth:
+				gcpAuthConf = &v1alpha1.GCPAuthConfig{}
+			case clusterOpts.AzureAuth:
+				azureAuthConf = &v1alpha1.AzureAuthConfig{ServerApplicationID: clusterOpts.AzureServerAppID}
 			case clusterOpts.ExecProviderCommand != "":
 				execProviderConf = &v1alpha1.ExecProviderConfig{
 					Command:     clusterOpts.ExecProviderCommand,
@@ -664,6 +681,9 @@ func NewGenClusterConfigCommand(pathOpts *clientcmd.PathOptions) *cobra.Command
 			errors.CheckError(err)
 
 			clst := cmdutil.NewCluster(contextName, clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, bearerToken, awsAuthConf, execProviderConf, labelsMap, annotationsMap)
+			clst.NamespacedCache = clusterOpts.NamespacedCache
+			clst.Config.GCPAuthConfig = gcpAuthConf
+			clst.Config.AzureAuthConfig = azureAuthConf
 			if clusterOpts.InClusterEndpoint() {
 				clst.Server = v1alpha1.KubernetesInternalAPIServerAddr
 			}
@@ -0,0 +1,186 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/server/clusterregistration"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// NewClusterRegistrationsCommand returns a new instance of the `argocd admin cluster registrations` command
+func NewClusterRegistrationsCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "registrations",
+		Short: "Manage registration requests submitted by cluster agents",
+		Example: `
+# List registration requests
+argocd admin cluster registrations list
+
+# Add the cluster of a pending registration request
+argocd admin cluster registrations approve my-cluster
+
+# Deny a pending registration request
+argocd admin cluster registrations deny my-cluster --message "unknown cluster"`,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewClusterRegistrationsListCommand())
+	command.AddCommand(NewClusterRegistrationsApproveCommand())
+	command.AddCommand(NewClusterRegistrationsDenyCommand())
+	command.AddCommand(NewClusterRegistrationsDeleteCommand())
+	return command
+}
+
+func runClusterRegistrationsCommand(ctx context.Context, clientConfig clientcmd.ClientConfig, action func(store *clusterregistration.Store, argoDB db.ArgoDB) error) error {
+	clientCfg, err := clientConfig.ClientConfig()
+	if err != nil {
+		return fmt.Errorf("error while creating client config: %w", err)
+	}
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return fmt.Errorf("error while getting namespace from client config: %w", err)
+	}
+	kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+	return action(clusterregistration.NewStore(kubeClient, namespace), db.NewDB(namespace, settingsMgr, kubeClient))
+}
+
+// NewClusterRegistrationsListCommand returns a new instance of the `argocd admin cluster registrations list` command
+func NewClusterRegistrationsListCommand() *cobra.Command {
+	var clientConfig clientcmd.ClientConfig
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List registration requests",
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			errors.CheckError(runClusterRegistrationsCommand(ctx, clientConfig, func(store *clusterregistration.Store, _ db.ArgoDB) error {
+				registrations, err := store.List(ctx)
+				if err != nil {
+					return err
+				}
+				printClusterRegistrations(registrations)
+				return nil
+			}))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+func printClusterRegistrations(registrations []*clusterregistration.Registration) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAME\tSERVER\tSTATUS\tREQUESTED\tROTATED\tMESSAGE\n")
+	for _, reg := range registrations {
+		rotated := ""
+		if reg.RotatedAt != nil {
+			rotated = reg.RotatedAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", reg.Name, reg.Server, reg.Status, reg.RequestedAt.Format(time.RFC3339), rotated, reg.Message)
+	}
+	_ = w.Flush()
+}
+
+// NewClusterRegistrationsApproveCommand returns a new instance of the `argocd admin cluster registrations approve` command
+func NewClusterRegistrationsApproveCommand() *cobra.Command {
+	var clientConfig clientcmd.ClientConfig
+	command := &cobra.Command{
+		Use:   "approve NAME",
+		Short: "Add the cluster of a pending registration request",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			errors.CheckError(runClusterRegistrationsCommand(ctx, clientConfig, func(store *clusterregistration.Store, argoDB db.ArgoDB) error {
+				cluster, err := store.Approve(ctx, argoDB, args[0])
+				if err != nil {
+					return err
+				}
+				fmt.Printf("Cluster '%s' added\n", cluster.Server)
+				return nil
+			}))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
+
+// NewClusterRegistrationsDenyCommand returns a new instance of the `argocd admin cluster registrations deny` command
+func NewClusterRegistrationsDenyCommand() *cobra.Command {
+	var (
+		clientConfig clientcmd.ClientConfig
+		message      string
+	)
+	command := &cobra.Command{
+		Use:   "deny NAME",
+		Short: "Deny a pending registration request",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			errors.CheckError(runClusterRegistrationsCommand(ctx, clientConfig, func(store *clusterregistration.Store, _ db.ArgoDB) error {
+				if err := store.Deny(ctx, args[0], message); err != nil {
+					return err
+				}
+				fmt.Printf("Registration request '%s' denied\n", args[0])
+				return nil
+			}))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&message, "message", "", "Message returned to the cluster agent")
+	return command
+}
+
+// NewClusterRegistrationsDeleteCommand returns a new instance of the `argocd admin cluster registrations delete` command
+func NewClusterRegistrationsDeleteCommand() *cobra.Command {
+	var clientConfig clientcmd.ClientConfig
+	command := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a registration request, so that the cluster agent can submit a new one. The cluster of an approved request is not removed.",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			errors.CheckError(runClusterRegistrationsCommand(ctx, clientConfig, func(store *clusterregistration.Store, _ db.ArgoDB) error {
+				if err := store.Delete(ctx, args[0]); err != nil {
+					return err
+				}
+				fmt.Printf("Registration request '%s' deleted\n", args[0])
+				return nil
+			}))
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	return command
+}
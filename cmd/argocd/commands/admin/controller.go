@@ -0,0 +1,145 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+)
+
+// NewControllerCommand returns a new instance of an `argocd admin controller` command
+func NewControllerCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "controller",
+		Short: "Inspect the Argo CD application controller",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewControllerQueueCommand(clientOpts))
+	return command
+}
+
+// NewControllerQueueCommand returns a new instance of an `argocd admin controller queue` command
+func NewControllerQueueCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		clientConfig      clientcmd.ClientConfig
+		controllerAddress string
+		shard             int
+		queue             string
+		states            []string
+		output            string
+	)
+	command := cobra.Command{
+		Use:   "queue",
+		Short: "List the applications queued for reconciliation or operation processing by the application controller",
+		Long: `List the applications queued for reconciliation or operation processing by the application controller.
+
+Pending applications wait for their delay or rate limit to elapse, queued applications wait for a free worker and in
+flight applications are being processed. The reason is the event which added the application to the queue.`,
+		Example: `# List the queued applications of the application controller
+argocd admin controller queue
+
+# List the applications being processed by the controller of shard 2
+argocd admin controller queue --shard 2 --state InFlight
+
+# Query the metrics endpoint of the controller directly
+argocd admin controller queue --controller-address localhost:8082 -o json`,
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			if controllerAddress == "" {
+				namespace, _, err := clientConfig.Namespace()
+				errors.CheckError(err)
+				selector := common.LabelKeyAppName + "=" + clientOpts.AppControllerName
+				if shard >= 0 {
+					selector = fmt.Sprintf("statefulset.kubernetes.io/pod-name=%s-%d", clientOpts.AppControllerName, shard)
+				}
+				port, err := kubeutil.PortForward(common.DefaultPortArgoCDMetrics, namespace, &clientcmd.ConfigOverrides{}, selector)
+				errors.CheckError(err)
+				controllerAddress = fmt.Sprintf("localhost:%d", port)
+			}
+			items, err := getControllerQueueItems(ctx, controllerAddress)
+			errors.CheckError(err)
+			items = slices.DeleteFunc(items, func(item controller.QueueItem) bool {
+				return (queue != "" && item.Queue != queue) || (len(states) > 0 && !slices.Contains(states, item.State))
+			})
+			switch output {
+			case "json":
+				data, err := json.MarshalIndent(controller.QueueItemList{Items: items}, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(data))
+			case "wide", "":
+				printControllerQueueItems(os.Stdout, items, time.Now(), output == "wide")
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().StringVar(&controllerAddress, "controller-address", "", "Address of the metrics endpoint of the application controller. The controller pod is port-forwarded if not set.")
+	command.Flags().IntVar(&shard, "shard", -1, "Shard of the application controller to port-forward. The first ready controller pod is used if not set.")
+	command.Flags().StringVar(&queue, "queue", "", "Only list the applications of the given queue, e.g. app_reconciliation_queue or app_operation_processing_queue")
+	command.Flags().StringSliceVar(&states, "state", nil, "Only list the applications in the given states. One or more of: Pending|Queued|InFlight")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|wide")
+	return &command
+}
+
+func getControllerQueueItems(ctx context.Context, controllerAddress string) ([]controller.QueueItem, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", controllerAddress, metrics.QueuesPath), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query the application controller: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to query the application controller: unexpected status %s", resp.Status)
+	}
+	var list controller.QueueItemList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to read the queues of the application controller: %w", err)
+	}
+	return list.Items, nil
+}
+
+func printControllerQueueItems(out io.Writer, items []controller.QueueItem, now time.Time, wide bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	if wide {
+		_, _ = fmt.Fprint(w, "QUEUE\tAPP\tSTATE\tREASON\tAGE\tIN STATE\tREQUEUED\n")
+	} else {
+		_, _ = fmt.Fprint(w, "QUEUE\tAPP\tSTATE\tREASON\tAGE\n")
+	}
+	for _, item := range items {
+		age := duration.HumanDuration(now.Sub(item.AddedAt))
+		if wide {
+			requeued := item.Requeued
+			if requeued == "" {
+				requeued = "-"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", item.Queue, item.Key, item.State, item.Reason, age, duration.HumanDuration(now.Sub(item.Since)), requeued)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", item.Queue, item.Key, item.State, item.Reason, age)
+		}
+	}
+	_ = w.Flush()
+}
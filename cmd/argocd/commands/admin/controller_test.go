@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+)
+
+func TestGetControllerQueueItems(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	items := []controller.QueueItem{{Queue: "app_reconciliation_queue", Key: "argocd/guestbook", State: controller.QueueItemStateQueued, Reason: "watch event", AddedAt: now, Since: now}}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != metrics.QueuesPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(controller.QueueItemList{Items: items})
+	}))
+	defer server.Close()
+
+	actual, err := getControllerQueueItems(t.Context(), strings.TrimPrefix(server.URL, "http://"))
+	require.NoError(t, err)
+	assert.Equal(t, items, actual)
+}
+
+func TestPrintControllerQueueItems(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 10, 0, 0, time.UTC)
+	items := []controller.QueueItem{
+		{Queue: "app_reconciliation_queue", Key: "argocd/guestbook", State: controller.QueueItemStateInFlight, Reason: "spec change", AddedAt: now.Add(-2 * time.Minute), Since: now.Add(-30 * time.Second), Requeued: "watch event"},
+		{Queue: "app_reconciliation_queue", Key: "argocd/helm-guestbook", State: controller.QueueItemStatePending, Reason: "delayed auto-sync", AddedAt: now.Add(-5 * time.Second), Since: now.Add(-5 * time.Second)},
+	}
+
+	var out bytes.Buffer
+	printControllerQueueItems(&out, items, now, false)
+	assert.Equal(t, `QUEUE                     APP                    STATE     REASON             AGE
+app_reconciliation_queue  argocd/guestbook       InFlight  spec change        2m
+app_reconciliation_queue  argocd/helm-guestbook  Pending   delayed auto-sync  5s
+`, out.String())
+
+	out.Reset()
+	printControllerQueueItems(&out, items, now, true)
+	assert.Equal(t, `QUEUE                     APP                    STATE     REASON             AGE  IN STATE  REQUEUED
+app_reconciliation_queue  argocd/guestbook       InFlight  spec change        2m   30s       watch event
+app_reconciliation_queue  argocd/helm-guestbook  Pending   delayed auto-sync  5s   5s        -
+`, out.String())
+}
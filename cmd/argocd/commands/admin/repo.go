@@ -150,6 +150,10 @@ func NewGenRepoSpecCommand() *cobra.Command {
 			repoOpts.Repo.UseAzureWorkloadIdentity = repoOpts.UseAzureWorkloadIdentity
 			repoOpts.Repo.InsecureOCIForceHttp = repoOpts.InsecureOCIForceHTTP
 			repoOpts.Repo.WebhookManifestCacheWarmDisabled = repoOpts.WebhookManifestCacheWarmDisabled
+			repoOpts.Repo.SparseCheckout = repoOpts.SparseCheckout
+			repoOpts.Repo.ManifestGenerationLimits = repoOpts.GetManifestGenerationLimits()
+			repoOpts.Repo.RegistryMirrors = repoOpts.RegistryMirrors
+			repoOpts.Repo.SubmodulePolicy = repoOpts.GetSubmodulePolicy()
 
 			if repoOpts.Repo.Type == "helm" && repoOpts.Repo.Name == "" {
 				errors.CheckError(stderrors.New("must specify --name for repos of type 'helm'"))
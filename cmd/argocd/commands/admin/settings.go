@@ -263,6 +263,16 @@ var validatorsByGroup = map[string]settingValidator{
 		}
 		return fmt.Sprintf("%d resource overrides", len(overrides)), nil
 	},
+	"drift-exclusions": func(manager *settings.SettingsManager) (string, error) {
+		if err := manager.ValidateDriftExclusions(); err != nil {
+			return "", err
+		}
+		exclusions, err := manager.GetDriftExclusions()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d drift exclusions", len(exclusions)), nil
+	},
 }
 
 func NewValidateSettingsCommand(cmdCtx commandContext) *cobra.Command {
@@ -606,10 +616,13 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 				}
 
 				luaVM := lua.VM{ResourceOverrides: overrides}
+				resolvedParams, err := luaVM.ResolveResourceActionParameters(&res, action, parsedParams)
+				errors.CheckError(err)
+
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, parsedParams)
+				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, resolvedParams)
 				errors.CheckError(err)
 
 				for _, impactedResource := range modifiedRes {
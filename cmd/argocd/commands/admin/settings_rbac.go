@@ -42,6 +42,8 @@ var resourceMap = map[string]string{
 	"key":             rbac.ResourceGPGKeys,
 	"log":             rbac.ResourceLogs,
 	"logs":            rbac.ResourceLogs,
+	"loglevel":        rbac.ResourceLogLevels,
+	"loglevels":       rbac.ResourceLogLevels,
 	"exec":            rbac.ResourceExec,
 	"proj":            rbac.ResourceProjects,
 	"projs":           rbac.ResourceProjects,
@@ -57,12 +59,13 @@ var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceApplications:    applicationsActions,
 	rbac.ResourceApplicationSets: defaultCRUDActions,
 	rbac.ResourceCertificates:    defaultCRDActions,
-	rbac.ResourceClusters:        defaultCRUDActions,
+	rbac.ResourceClusters:        clustersActions,
 	rbac.ResourceExtensions:      extensionActions,
 	rbac.ResourceGPGKeys:         defaultCRDActions,
 	rbac.ResourceLogs:            logsActions,
+	rbac.ResourceLogLevels:       logLevelsActions,
 	rbac.ResourceExec:            execActions,
-	rbac.ResourceProjects:        defaultCRUDActions,
+	rbac.ResourceProjects:        projectsActions,
 	rbac.ResourceRepositories:    defaultCRUDActions,
 }
 
@@ -90,6 +93,22 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionSync:     rbacTrait{},
 }
 
+var clustersActions = actionTraitMap{
+	rbac.ActionCreate: rbacTrait{},
+	rbac.ActionGet:    rbacTrait{},
+	rbac.ActionUpdate: rbacTrait{},
+	rbac.ActionDelete: rbacTrait{},
+	rbac.ActionReport: rbacTrait{},
+}
+
+var projectsActions = actionTraitMap{
+	rbac.ActionCreate: rbacTrait{},
+	rbac.ActionGet:    rbacTrait{},
+	rbac.ActionUpdate: rbacTrait{},
+	rbac.ActionDelete: rbacTrait{},
+	rbac.ActionUnlock: rbacTrait{},
+}
+
 var accountsActions = actionTraitMap{
 	rbac.ActionCreate: rbacTrait{},
 	rbac.ActionUpdate: rbacTrait{},
@@ -97,12 +116,18 @@ var accountsActions = actionTraitMap{
 
 var execActions = actionTraitMap{
 	rbac.ActionCreate: rbacTrait{},
+	rbac.ActionReplay: rbacTrait{},
 }
 
 var logsActions = actionTraitMap{
 	rbac.ActionGet: rbacTrait{},
 }
 
+var logLevelsActions = actionTraitMap{
+	rbac.ActionGet:    rbacTrait{},
+	rbac.ActionUpdate: rbacTrait{},
+}
+
 var extensionActions = actionTraitMap{
 	rbac.ActionInvoke: rbacTrait{},
 }
@@ -178,6 +178,26 @@ admissionregistration.k8s.io/MutatingWebhookConfiguration:
 			},
 			containsSummary: "2 resource overrides",
 		},
+		"DriftExclusions": {
+			validator: "drift-exclusions",
+			data: map[string]string{
+				"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: live.spec.replicas < desired.spec.replicas`,
+			},
+			containsSummary: "1 drift exclusions",
+		},
+		"DriftExclusions_InvalidExpression": {
+			validator: "drift-exclusions",
+			data: map[string]string{
+				"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: live.spec.replicas >`,
+			},
+			containsError: "invalid drift exclusion for apps/Deployment",
+		},
 	}
 	for name := range testCases {
 		tc := testCases[name]
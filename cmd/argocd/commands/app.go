@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	stderrors "errors"
@@ -27,8 +28,10 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8swatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
@@ -93,10 +96,13 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
+	command.AddCommand(NewApplicationTreeCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationConfirmDeletionCommand(clientOpts))
+	command.AddCommand(NewApplicationSyncWindowsCommand(clientOpts))
+	command.AddCommand(NewApplicationSnapshotsCommand(clientOpts))
 	return command
 }
 
@@ -108,6 +114,8 @@ type watchOpts struct {
 	degraded  bool
 	delete    bool
 	hydrated  bool
+	// conditions are additional conditions the application has to meet
+	conditions []*waitCondition
 }
 
 // NewApplicationCreateCommand returns a new instance of an `argocd app create` command
@@ -326,6 +334,22 @@ func getSourceNameToPositionMap(app *argoappv1.Application) map[string]int64 {
 	return sourceNameToPosition
 }
 
+// getHistorySourceNameToPositionMap returns the positions of the named sources recorded in the history entry of the
+// application with the given ID
+func getHistorySourceNameToPositionMap(app *argoappv1.Application, historyID int64) (map[string]int64, error) {
+	history, err := findRevisionHistory(app, historyID)
+	if err != nil {
+		return nil, err
+	}
+	sourceNameToPosition := make(map[string]int64)
+	for i, s := range history.Sources {
+		if s.Name != "" {
+			sourceNameToPosition[s.Name] = int64(i + 1)
+		}
+	}
+	return sourceNameToPosition, nil
+}
+
 // NewApplicationGetCommand returns a new instance of an `argocd app get` command
 func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -466,7 +490,7 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: app.Spec.Project})
 			errors.CheckError(err)
 
-			windows := proj.Spec.SyncWindows.Matches(app)
+			windows := proj.MatchingSyncWindows(app)
 
 			switch output {
 			case "yaml", "json":
@@ -514,19 +538,21 @@ func NewApplicationGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Com
 // NewApplicationLogsCommand returns logs of application pods
 func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		group        string
-		kind         string
-		namespace    string
-		resourceName string
-		follow       bool
-		tail         int64
-		sinceSeconds int64
-		untilTime    string
-		filter       string
-		container    string
-		previous     bool
-		matchCase    bool
-		appNamespace string
+		group         string
+		kind          string
+		namespace     string
+		resourceName  string
+		follow        bool
+		tail          int64
+		sinceSeconds  int64
+		untilTime     string
+		filter        string
+		container     string
+		previous      bool
+		matchCase     bool
+		regex         bool
+		allContainers bool
+		appNamespace  string
 	)
 	command := &cobra.Command{
 		Use:   "logs APPNAME",
@@ -565,9 +591,15 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   # Filter logs to show only those containing a specific string and match case
   argocd app logs my-app --filter "error" --match-case
 
+  # Filter logs to show only those matching a regular expression
+  argocd app logs my-app --filter "error|warn(ing)?" --regex
+
   # Get logs for a specific container within the pods
   argocd app logs my-app -c my-container
 
+  # Get logs of all the containers of the pods of a deployment
+  argocd app logs my-app --kind Deployment --name my-deployment --all-containers
+
   # Get previously terminated container logs
   argocd app logs my-app -p
   		`),
@@ -588,20 +620,22 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			for retry {
 				retry = false
 				stream, err := appIf.PodLogs(ctx, &application.ApplicationPodLogsQuery{
-					Name:         &appName,
-					Group:        &group,
-					Namespace:    new(namespace),
-					Kind:         &kind,
-					ResourceName: &resourceName,
-					Follow:       new(follow),
-					TailLines:    new(tail),
-					SinceSeconds: new(sinceSeconds),
-					UntilTime:    &untilTime,
-					Filter:       &filter,
-					MatchCase:    new(matchCase),
-					Container:    new(container),
-					Previous:     new(previous),
-					AppNamespace: &appNs,
+					Name:          &appName,
+					Group:         &group,
+					Namespace:     new(namespace),
+					Kind:          &kind,
+					ResourceName:  &resourceName,
+					Follow:        new(follow),
+					TailLines:     new(tail),
+					SinceSeconds:  new(sinceSeconds),
+					UntilTime:     &untilTime,
+					Filter:        &filter,
+					MatchCase:     new(matchCase),
+					Regex:         new(regex),
+					Container:     new(container),
+					AllContainers: new(allContainers),
+					Previous:      new(previous),
+					AppNamespace:  &appNs,
 				})
 				if err != nil {
 					log.Fatalf("failed to get pod logs: %v", err)
@@ -644,6 +678,8 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&container, "container", "c", "", "Optional container name")
 	command.Flags().BoolVarP(&previous, "previous", "p", false, "Specify if the previously terminated container logs should be returned")
 	command.Flags().BoolVarP(&matchCase, "match-case", "m", false, "Specify if the filter should be case-sensitive")
+	command.Flags().BoolVar(&regex, "regex", false, "Specify if the filter is a regular expression")
+	command.Flags().BoolVar(&allContainers, "all-containers", false, "Get the logs of all the containers of the pods, including the init containers")
 
 	return command
 }
@@ -662,6 +698,12 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	for _, source := range app.Spec.GetSources() {
 		printAppSourceDetails(&source)
 	}
+	if app.Spec.HasMultipleDestinations() {
+		fmt.Println("Destinations:")
+		for _, dest := range app.Spec.Destinations {
+			printAppDestinationDetails(app, dest)
+		}
+	}
 	var wds []string
 	var status string
 	var allow, deny, inactiveAllows bool
@@ -723,6 +765,9 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 	if !git.IsCommitSHA(app.Spec.GetSource().TargetRevision) && !git.IsTruncatedCommitSHA(app.Spec.GetSource().TargetRevision) && len(app.Status.Sync.Revision) > 7 {
 		syncStatusStr += fmt.Sprintf(" (%s)", app.Status.Sync.Revision[0:7])
 	}
+	if app.Status.Sync.PruneOnly {
+		syncStatusStr += ", prune only"
+	}
 	fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
 	healthStr := string(app.Status.Health.Status)
 	fmt.Printf(printOpFmtStr, "Health Status:", healthStr)
@@ -745,10 +790,31 @@ func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
 	}
 }
 
+func printAppDestinationDetails(app *argoappv1.Application, dest argoappv1.ApplicationDestination) {
+	server := dest.Server
+	if dest.Name != "" {
+		server = dest.Name
+	}
+	fmt.Printf(printOpFmtStr, "- Server:", server)
+	fmt.Printf(printOpFmtStr, "  Namespace:", dest.Namespace)
+	for _, status := range app.Status.Destinations {
+		if status.Destination == dest {
+			fmt.Printf(printOpFmtStr, "  Status:", fmt.Sprintf("%s, %s", status.Sync, status.Health))
+			if status.Message != "" {
+				fmt.Printf(printOpFmtStr, "  Message:", status.Message)
+			}
+		}
+	}
+}
+
 func printAppConditions(w io.Writer, app *argoappv1.Application) {
-	_, _ = fmt.Fprint(w, "CONDITION\tMESSAGE\tLAST TRANSITION\n")
+	_, _ = fmt.Fprint(w, "CONDITION\tSEVERITY\tMESSAGE\tLAST TRANSITION\n")
+	now := time.Now()
 	for _, item := range app.Status.Conditions {
-		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", item.Type, item.Message, item.LastTransitionTime)
+		if item.IsExpired(now) {
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Type, item.GetSeverity(), item.Message, item.LastTransitionTime)
 	}
 }
 
@@ -1267,6 +1333,7 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 		selector          string
 		wait              bool
 		appNamespace      string
+		dryRun            bool
 	)
 	command := &cobra.Command{
 		Use:   "delete APPNAME",
@@ -1282,7 +1349,10 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
   argocd app delete -l app.kubernetes.io/instance!=my-app
   argocd app delete -l app.kubernetes.io/instance
   argocd app delete -l '!app.kubernetes.io/instance'
-  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app delete -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Show the resources which would be deleted, without deleting anything
+  argocd app delete my-app --dry-run`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1328,6 +1398,12 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 				if c.Flag("propagation-policy").Changed {
 					appDeleteReq.PropagationPolicy = &propagationPolicy
 				}
+				if dryRun {
+					preview, err := appIf.DeletePreview(ctx, &appDeleteReq)
+					errors.CheckError(err)
+					printDeletePreview(appFullName, preview)
+					continue
+				}
 				messageForSingle := "Are you sure you want to delete '" + appFullName + "' and all its resources? [y/n] "
 				messageForAll := "Are you sure you want to delete '" + appFullName + "' and all its resources? [y/n/a] where 'a' is to delete all specified apps and their resources without prompting "
 
@@ -1353,9 +1429,34 @@ func NewApplicationDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 	command.Flags().StringVarP(&selector, "selector", "l", "", "Delete all apps with matching label. Supports '=', '==', '!=', in, notin, exists & not exists. Matching apps must satisfy all of the specified label constraints.")
 	command.Flags().BoolVar(&wait, "wait", false, "Wait until deletion of the application(s) completes")
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace where the application will be deleted from")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the resources which would be deleted, without deleting the application(s)")
 	return command
 }
 
+// printDeletePreview prints the resources affected by the deletion of an application
+func printDeletePreview(appFullName string, preview *application.ApplicationDeletePreviewResponse) {
+	fmt.Printf("Deleting application '%s' would delete %d resource(s) and %d dependent resource(s), and retain %d resource(s)", appFullName, len(preview.Resources), len(preview.Dependents), len(preview.Retained))
+	if preview.GetPropagationPolicy() != "" {
+		fmt.Printf(" (propagation policy: %s)", preview.GetPropagationPolicy())
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "ACTION\tGROUP\tKIND\tNAMESPACE\tNAME\n")
+	for _, refs := range []struct {
+		action string
+		items  []*argoappv1.ResourceRef
+	}{
+		{"delete", preview.Resources},
+		{"delete (dependent)", preview.Dependents},
+		{"retain", preview.Retained},
+	} {
+		for _, ref := range refs.items {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", refs.action, ref.Group, ref.Kind, ref.Namespace, ref.Name)
+		}
+	}
+	_ = w.Flush()
+}
+
 func checkForDeleteEvent(ctx context.Context, acdClient argocdclient.Client, appFullName string) {
 	appEventCh := acdClient.WatchApplicationWithRetry(ctx, appFullName, "")
 	for appEvent := range appEventCh {
@@ -1373,15 +1474,20 @@ func printApplicationNames(apps []argoappv1.Application) {
 }
 
 // Print table of application data
-func printApplicationTable(apps []argoappv1.Application, output *string) {
+// printApplicationTable prints the applications as a table, followed by the given custom columns
+func printApplicationTable(apps []argoappv1.Application, output *string, columns ...*settings.ApplicationColumn) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
 	headers := []any{"NAME", "CLUSTER", "NAMESPACE", "PROJECT", "STATUS", "HEALTH", "SYNCPOLICY", "CONDITIONS"}
 	if *output == "wide" {
-		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s"
 		headers = append(headers, "REPO", "PATH", "TARGET")
 	} else {
-		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+		fmtStr = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s"
+	}
+	fmtStr += strings.Repeat("\t%s", len(columns)) + "\n"
+	for _, column := range columns {
+		headers = append(headers, strings.ToUpper(column.Name))
 	}
 	_, _ = fmt.Fprintf(w, fmtStr, headers...)
 	for _, app := range apps {
@@ -1398,21 +1504,43 @@ func printApplicationTable(apps []argoappv1.Application, output *string) {
 		if *output == "wide" {
 			vals = append(vals, app.Spec.GetSource().RepoURL, app.Spec.GetSource().Path, app.Spec.GetSource().TargetRevision)
 		}
+		for _, column := range columns {
+			vals = append(vals, formatApplicationColumn(&app, column))
+		}
 		_, _ = fmt.Fprintf(w, fmtStr, vals...)
 	}
 	_ = w.Flush()
 }
 
+// formatApplicationColumn evaluates the JSONPath of a custom column against the application. Missing fields and
+// invalid expressions result in an empty value.
+func formatApplicationColumn(app *argoappv1.Application, column *settings.ApplicationColumn) string {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return ""
+	}
+	parser := jsonpath.New(column.Name).AllowMissingKeys(true)
+	if err := parser.Parse("{" + column.JSONPath + "}"); err != nil {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := parser.Execute(&buf, obj); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
 // NewApplicationListCommand returns a new instance of an `argocd app list` command
 func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output       string
-		selector     string
-		projects     []string
-		repo         string
-		appNamespace string
-		cluster      string
-		path         string
+		output              string
+		selector            string
+		projects            []string
+		repo                string
+		appNamespace        string
+		cluster             string
+		path                string
+		conditionSeverities []string
 	)
 	command := &cobra.Command{
 		Use:   "list",
@@ -1425,15 +1553,19 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app list -l app.kubernetes.io/instance!=my-app
   argocd app list -l app.kubernetes.io/instance
   argocd app list -l '!app.kubernetes.io/instance'
-  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app list -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # List apps with an error or warning condition
+  argocd app list --condition-severity Error --condition-severity Warning`,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
 
 			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 			defer utilio.Close(conn)
 			apps, err := appIf.List(ctx, &application.ApplicationQuery{
-				Selector:     new(selector),
-				AppNamespace: &appNamespace,
+				Selector:            new(selector),
+				AppNamespace:        &appNamespace,
+				ConditionSeverities: conditionSeverities,
 			})
 
 			errors.CheckError(err)
@@ -1459,7 +1591,15 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			case "name":
 				printApplicationNames(appList)
 			case "wide", "":
-				printApplicationTable(appList, &output)
+				var columns []*settings.ApplicationColumn
+				conn, settingsIf := headless.NewClientOrDie(clientOpts, c).NewSettingsClientOrDie()
+				defer utilio.Close(conn)
+				if argoSettings, err := settingsIf.Get(ctx, &settings.SettingsQuery{}); err == nil {
+					columns = argoSettings.ApplicationColumns
+				} else {
+					log.Warnf("Failed to get custom application columns: %v", err)
+				}
+				printApplicationTable(appList, &output, columns...)
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
 			}
@@ -1472,6 +1612,7 @@ func NewApplicationListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only list applications in namespace")
 	command.Flags().StringVarP(&cluster, "cluster", "c", "", "List apps by cluster name or url")
 	command.Flags().StringVarP(&path, "path", "P", "", "List apps by path")
+	command.Flags().StringArrayVar(&conditionSeverities, "condition-severity", []string{}, "List apps with a condition of the given severity, one of: Info, Warning, Error (can be repeated)")
 	return command
 }
 
@@ -1573,7 +1714,7 @@ func parseSelectedResources(resources []string) ([]*argoappv1.SyncOperationResou
 
 func getWatchOpts(watch watchOpts) watchOpts {
 	// if no opts are defined should wait for sync,health,operation
-	if (watch == watchOpts{}) {
+	if !watch.sync && !watch.health && !watch.operation && !watch.suspended && !watch.degraded && !watch.delete && !watch.hydrated && len(watch.conditions) == 0 {
 		return watchOpts{
 			sync:      true,
 			health:    true,
@@ -1586,12 +1727,15 @@ func getWatchOpts(watch watchOpts) watchOpts {
 // NewApplicationWaitCommand returns a new instance of an `argocd app wait` command
 func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		watch        watchOpts
-		timeout      uint
-		selector     string
-		resources    []string
-		output       string
-		appNamespace string
+		watch              watchOpts
+		timeout            uint
+		timeoutPerResource bool
+		selector           string
+		resources          []string
+		conditions         []string
+		output             string
+		appNamespace       string
+		detailedExitCode   bool
 	)
 	command := &cobra.Command{
 		Use:   "wait [APPNAME.. | -l selector]",
@@ -1617,7 +1761,14 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app wait -l app.kubernetes.io/instance!=my-app
   argocd app wait -l app.kubernetes.io/instance
   argocd app wait -l '!app.kubernetes.io/instance'
-  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'`,
+  argocd app wait -l 'app.kubernetes.io/instance notin (my-app,other-app)'
+
+  # Wait for apps to match a combination of conditions
+  argocd app wait my-app --for health=Healthy --for sync=Synced
+  argocd app wait my-app --for 'jsonpath={.status.operationState.phase}=Succeeded'
+
+  # Wait for all apps of an app-of-apps within 10 minutes in total, and tell timeouts apart from degraded apps
+  argocd app wait -l app.kubernetes.io/instance=my-app --timeout 600 --timeout-per-resource=false --detailed-exit-code`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -1625,6 +1776,9 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				c.HelpFunc()(c, args)
 				os.Exit(1)
 			}
+			var err error
+			watch.conditions, err = parseWaitConditions(conditions, &watch)
+			errors.CheckError(err)
 			watch = getWatchOpts(watch)
 			selectedResources, err := parseSelectedResources(resources)
 			errors.CheckError(err)
@@ -1639,17 +1793,30 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 					appNames = append(appNames, i.QualifiedName())
 				}
 			}
+			deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 			for _, appName := range appNames {
 				// Construct QualifiedName
 				if appNamespace != "" && !strings.Contains(appName, "/") {
 					appName = appNamespace + "/" + appName
 				}
-				_, _, err := waitOnApplicationStatus(ctx, acdClient, appName, timeout, watch, selectedResources, output)
+				appTimeout := timeout
+				if timeout != 0 && !timeoutPerResource {
+					remaining := time.Until(deadline)
+					if remaining <= 0 {
+						err = fmt.Errorf("%w (%ds) waiting for app %q to match the expected conditions", errWaitTimeout, timeout, appName)
+						exitOnWaitError(err, detailedExitCode)
+					}
+					appTimeout = uint(remaining.Round(time.Second).Seconds())
+				}
+				_, opState, err := waitOnApplicationStatus(ctx, acdClient, appName, appTimeout, watch, selectedResources, output)
 				if err != nil {
 					if isContextCanceledErr(err) {
-						log.Fatalf("timed out (%ds) waiting for app %q to match the expected conditions", timeout, appName)
+						err = fmt.Errorf("%w (%ds) waiting for app %q to match the expected conditions", errWaitTimeout, timeout, appName)
 					}
-					errors.CheckError(err)
+					exitOnWaitError(err, detailedExitCode)
+				}
+				if detailedExitCode && watch.operation && opState != nil && opState.Phase.Completed() && !opState.Phase.Successful() {
+					errors.Fatalf(waitExitCodeOperationFailed, "Operation of app %q has completed with phase: %s", appName, opState.Phase)
 				}
 			}
 		},
@@ -1664,6 +1831,9 @@ func NewApplicationWaitCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&resources, "resource", []string{}, fmt.Sprintf("Sync only specific resources as GROUP%[1]sKIND%[1]sNAME or %[2]sGROUP%[1]sKIND%[1]sNAME. Fields may be blank and '*' can be used. This option may be specified repeatedly", resourceFieldDelimiter, resourceExcludeIndicator))
 	command.Flags().BoolVar(&watch.operation, "operation", false, "Wait for pending operations")
 	command.Flags().UintVar(&timeout, "timeout", defaultCheckTimeoutSeconds, "Time out after this many seconds")
+	command.Flags().BoolVar(&timeoutPerResource, "timeout-per-resource", true, "Apply the timeout to each application separately. If false, the timeout applies to waiting for all applications")
+	command.Flags().StringArrayVar(&conditions, "for", []string{}, "Wait for a condition: health=STATUS, sync=STATUS, operation=PHASE, jsonpath={EXPR}[=VALUE] or delete. This option may be specified repeatedly, all conditions must be met")
+	command.Flags().BoolVar(&detailedExitCode, "detailed-exit-code", false, fmt.Sprintf("Return exit code %d on timeout, %d if the application health degrades and %d if the operation fails", waitExitCodeTimeout, waitExitCodeDegraded, waitExitCodeOperationFailed))
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only wait for an application  in namespace")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|tree|tree=detailed")
 	return command
@@ -1730,6 +1900,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		ignoreNormalizerOpts      normalizers.IgnoreNormalizerOpts
 		serverSideDiffConcurrency int
 		serverSideDiffMaxBatchKB  int
+		cascadeChildren           bool
 	)
 	command := &cobra.Command{
 		Use:   "sync [APPNAME... | -l selector | --project project-name]",
@@ -1759,7 +1930,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
   argocd app sync my-app --resource apps:Deployment:my-service --resource :Service:my-service
   argocd app sync my-app --resource '!*:Service:*'
   # Specify namespace if the application has resources with the same name in different namespaces
-  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout`,
+  argocd app sync my-app --resource argoproj.io:Rollout:my-namespace/my-rollout
+
+  # Sync an app-of-apps, then its child apps wave by wave
+  argocd app sync my-app --cascade-children`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 			if len(args) == 0 && selector == "" && len(projects) == 0 {
@@ -1796,6 +1970,10 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				}
 			}
 
+			if cascadeChildren && (async || dryRun || local != "") {
+				log.Fatal("Cannot use --cascade-children with --async, --dry-run or --local")
+			}
+
 			acdClient := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := acdClient.NewApplicationClientOrDie()
 			defer utilio.Close(conn)
@@ -2052,6 +2230,23 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 							}
 						}
 					}
+
+					if cascadeChildren {
+						// the child apps are synced with the same strategy and options as their parent, but to their
+						// own target revision and with all their resources
+						syncer := newCascadeSyncer(acdClient, appIf, timeout, func(childName string, childNs string) *application.ApplicationSyncRequest {
+							return &application.ApplicationSyncRequest{
+								Name:          &childName,
+								AppNamespace:  &childNs,
+								Prune:         &prune,
+								Infos:         syncReq.Infos,
+								SyncOptions:   syncReq.SyncOptions,
+								Strategy:      syncReq.Strategy,
+								RetryStrategy: syncReq.RetryStrategy,
+							}
+						})
+						errors.CheckError(syncer.syncChildren(ctx, app))
+					}
 				}
 			}
 		},
@@ -2086,6 +2281,7 @@ func NewApplicationSyncCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for source position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().BoolVar(&cascadeChildren, "cascade-children", false, "After syncing an app-of-apps, sync its child apps wave by wave, waiting for each wave to be synced and healthy before syncing the next one")
 	addServerSideDiffPerfFlags(command, &serverSideDiffConcurrency, &serverSideDiffMaxBatchKB)
 	return command
 }
@@ -2347,6 +2543,7 @@ func checkAppWaitConditions(app *argoappv1.Application, watch watchOpts, selecte
 		// Wait on the application as a whole
 		ready = checkResourceStatus(watch, string(app.Status.Health.Status), string(app.Status.Sync.Status), app.Operation, hydrationFinished)
 	}
+	ready = ready && checkWaitConditions(app, watch.conditions)
 
 	return ready, operationInProgress
 }
@@ -2520,7 +2717,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 			if prevState, found := prevStates[stateKey]; found {
 				if watch.health && prevState.Health != string(health.HealthStatusUnknown) && prevState.Health != string(health.HealthStatusDegraded) && newState.Health == string(health.HealthStatusDegraded) {
 					_ = printFinalStatus(app)
-					return nil, finalOperationState, fmt.Errorf("application '%s' health state has transitioned from %s to %s", appName, prevState.Health, newState.Health)
+					return nil, finalOperationState, fmt.Errorf("application '%s' health state has transitioned from %s to %s: %w", appName, prevState.Health, newState.Health, errWaitDegraded)
 				}
 				doPrint = prevState.Merge(newState)
 			} else {
@@ -2534,7 +2731,7 @@ func waitOnApplicationStatus(ctx context.Context, acdClient argocdclient.Client,
 		_ = w.Flush()
 	}
 	_ = printFinalStatus(appWithLock.GetApp())
-	return nil, finalOperationState, fmt.Errorf("timed out (%ds) waiting for app %q match desired state", timeout, appName)
+	return nil, finalOperationState, fmt.Errorf("%w (%ds) waiting for app %q match desired state", errWaitTimeout, timeout, appName)
 }
 
 // isContextCanceledErr returns true if the error is a context cancellation or deadline exceeded,
@@ -2791,6 +2988,13 @@ func printOperationResult(opState *argoappv1.OperationState) {
 	if opState.Message != "" {
 		fmt.Printf(printOpFmtStr, "Message:", opState.Message)
 	}
+	for _, res := range opState.StuckResources {
+		status := "Stuck"
+		if res.Skipped {
+			status = "Skipped"
+		}
+		fmt.Printf(printOpFmtStr, status+":", fmt.Sprintf("%s/%s/%s/%s (%s wave %d): %s", res.Group, res.Kind, res.Namespace, res.Name, res.SyncPhase, res.SyncWave, res.Message))
+	}
 }
 
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
@@ -2801,6 +3005,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 		revisions       []string
 		sourcePositions []int64
 		sourceNames     []string
+		historyID       int32
 		local           string
 		localRepoRoot   string
 		appNamespace    string
@@ -2820,6 +3025,15 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
   # Get manifests for a multi-source application at specific revisions for specific sources
   argocd app manifests my-app --revisions 0.0.1 --source-positions 1 --revisions 0.0.2 --source-positions 2
+
+  # Get manifests for a multi-source application at a specific commit for specific sources
+  argocd app manifests my-app --revision 4b1c7e5 --source-positions 1 --source-positions 3
+
+  # Get manifests of the sources the application was synced with in its history entry with ID 5
+  argocd app manifests my-app --history-id 5
+
+  # Get manifests of the sources of the history entry with ID 5, at a specific revision for the second source
+  argocd app manifests my-app --history-id 5 --revisions 0.0.2 --source-positions 2
   		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -2833,14 +3047,18 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 				errors.Fatal(errors.ErrorGeneric, "Only one of source-positions and source-names can be specified.")
 			}
 
-			if len(sourcePositions) > 0 && len(revisions) != len(sourcePositions) {
+			if len(sourcePositions) > 0 && (len(revisions) > 0 || revision == "") && len(revisions) != len(sourcePositions) {
 				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-positions, length of values for both flags should be same.")
 			}
 
-			if len(sourceNames) > 0 && len(revisions) != len(sourceNames) {
+			if len(sourceNames) > 0 && (len(revisions) > 0 || revision == "") && len(revisions) != len(sourceNames) {
 				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-names, length of values for both flags should be same.")
 			}
 
+			if historyID < 0 {
+				errors.Fatal(errors.ErrorGeneric, "history-id cannot be less than 0")
+			}
+
 			for _, pos := range sourcePositions {
 				if pos <= 0 {
 					log.Fatal("source-position cannot be less than or equal to 0, Counting starts at 1")
@@ -2860,6 +3078,10 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
 			if len(sourceNames) > 0 {
 				sourceNameToPosition := getSourceNameToPositionMap(app)
+				if c.Flags().Changed("history-id") {
+					sourceNameToPosition, err = getHistorySourceNameToPositionMap(app, int64(historyID))
+					errors.CheckError(err)
+				}
 
 				for _, name := range sourceNames {
 					pos, ok := sourceNameToPosition[name]
@@ -2893,7 +3115,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 
 					proj := getProject(ctx, c, clientOpts, app.Spec.Project)
 					unstructureds = getLocalObjects(context.Background(), app, proj.Project, local, localRepoRoot, argoSettings, &cluster.Info)
-				case len(revisions) > 0 && len(sourcePositions) > 0:
+				case len(sourcePositions) > 0 || revision != "" || c.Flags().Changed("history-id"):
 					q := application.ApplicationManifestQuery{
 						Name:            &appName,
 						AppNamespace:    &appNs,
@@ -2901,19 +3123,8 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 						Revisions:       revisions,
 						SourcePositions: sourcePositions,
 					}
-					res, err := appIf.GetManifests(ctx, &q)
-					errors.CheckError(err)
-
-					for _, mfst := range res.Manifests {
-						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
-						errors.CheckError(err)
-						unstructureds = append(unstructureds, obj)
-					}
-				case revision != "":
-					q := application.ApplicationManifestQuery{
-						Name:         &appName,
-						AppNamespace: &appNs,
-						Revision:     new(revision),
+					if c.Flags().Changed("history-id") {
+						q.VersionId = new(historyID)
 					}
 					res, err := appIf.GetManifests(ctx, &q)
 					errors.CheckError(err)
@@ -2949,6 +3160,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringArrayVar(&revisions, "revisions", []string{}, "Show manifests at specific revisions for the source at position in source-positions")
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
+	command.Flags().Int32Var(&historyID, "history-id", 0, "Show manifests of the sources recorded in the history entry with the given ID, at the revisions they were synced to")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
 	return command
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	log "github.com/sirupsen/logrus"
@@ -32,6 +34,7 @@ type DisplayedAction struct {
 	Name     string
 	Action   string
 	Disabled bool
+	Params   []v1alpha1.ResourceActionParam `json:",omitempty"`
 }
 
 var appActionExample = templates.Examples(`
@@ -39,7 +42,7 @@ var appActionExample = templates.Examples(`
 	argocd app actions list APPNAME
 
 	# Run an available action for an application
-	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP]
+	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP] [--param NAME=VALUE]
 	`)
 
 // NewApplicationResourceActionsCommand returns a new instance of an `argocd app actions` command
@@ -109,6 +112,7 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 					Name:     obj.GetName(),
 					Action:   action.Name,
 					Disabled: action.Disabled,
+					Params:   action.Params,
 				}
 				availableActions = append(availableActions, displayAction)
 			}
@@ -125,9 +129,9 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 			fmt.Println(string(jsonBytes))
 		case "":
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprint(w, "GROUP\tKIND\tNAME\tACTION\tDISABLED\n")
+			fmt.Fprint(w, "GROUP\tKIND\tNAME\tACTION\tDISABLED\tPARAMS\n")
 			for _, action := range availableActions {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", action.Group, action.Kind, action.Name, action.Action, strconv.FormatBool(action.Disabled))
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", action.Group, action.Kind, action.Name, action.Action, strconv.FormatBool(action.Disabled), formatActionParams(action.Params))
 			}
 			_ = w.Flush()
 		}
@@ -150,6 +154,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var kind string
 	var group string
 	var all bool
+	var selector string
+	var resourceActionParameters []string
 	command := &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s) matching the specified filters.",
@@ -157,6 +163,12 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		Example: templates.Examples(`
 	# Run an available action for an application
 	argocd app actions run APPNAME ACTION --kind KIND [--resource-name RESOURCE] [--namespace NAMESPACE] [--group GROUP]
+
+	# Run an action which takes parameters
+	argocd app actions run APPNAME scale --kind Deployment --resource-name RESOURCE --param replicas=3
+
+	# Run an action on all the resources of a kind matching a label selector
+	argocd app actions run APPNAME restart --kind Deployment --group apps --selector tier=frontend
 	`),
 	}
 
@@ -167,6 +179,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group of the resource on which the action should be run")
 	errors.CheckError(command.MarkFlagRequired("kind"))
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringArrayVar(&resourceActionParameters, "param", []string{}, "Action parameters (e.g. --param key1=value1)")
+	command.Flags().StringVarP(&selector, "selector", "l", "", "Run the action on all the resources of the kind matching the label selector (e.g. -l key1=value1,key2=value2)")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		ctx := c.Context()
@@ -177,12 +191,17 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		}
 		appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
 		actionName := args[1]
+		parsedParams, err := parseResourceActionParameters(resourceActionParameters)
+		errors.CheckError(err)
+		if selector != "" && resourceName != "" {
+			log.Fatal("Flags --selector and --resource-name cannot be used together.")
+		}
 
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer utilio.Close(conn)
 		resources, err := getActionableResourcesForApplication(ctx, appIf, &appNs, &appName)
 		errors.CheckError(err)
-		filteredObjects, err := util.FilterResources(command.Flags().Changed("group"), resources, group, kind, namespace, resourceName, all)
+		filteredObjects, err := util.FilterResources(command.Flags().Changed("group"), resources, group, kind, namespace, resourceName, all || selector != "")
 		errors.CheckError(err)
 		resGroup := filteredObjects[0].GroupVersionKind().Group
 		for i := range filteredObjects[1:] {
@@ -191,6 +210,24 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 			}
 		}
 
+		if selector != "" {
+			resp, err := appIf.RunResourceActionBulk(ctx, &applicationpkg.ResourceActionBulkRunRequest{
+				Name:                     &appName,
+				AppNamespace:             &appNs,
+				Group:                    &resGroup,
+				Kind:                     &kind,
+				Namespace:                &namespace,
+				LabelSelector:            &selector,
+				Action:                   &actionName,
+				ResourceActionParameters: parsedParams,
+			})
+			errors.CheckError(err)
+			if !printResourceActionResults(os.Stdout, resp.GetResults()) {
+				os.Exit(1)
+			}
+			return
+		}
+
 		for i := range filteredObjects {
 			obj := filteredObjects[i]
 			gvk := obj.GroupVersionKind()
@@ -204,7 +241,8 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 				Kind:         new(gvk.Kind),
 				Version:      new(gvk.GroupVersion().Version),
 				Action:       new(actionName),
-				// TODO: add support for parameters
+
+				ResourceActionParameters: parsedParams,
 			})
 			if err == nil {
 				continue
@@ -212,6 +250,9 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 			if grpc.UnwrapGRPCStatus(err).Code() != codes.Unimplemented {
 				errors.CheckError(err)
 			}
+			if len(parsedParams) > 0 {
+				log.Fatal("RunResourceActionV2 is not supported by the server, action parameters require a newer server.")
+			}
 			fmt.Println("RunResourceActionV2 is not supported by the server, falling back to RunResourceAction.")
 			//nolint:staticcheck // RunResourceAction is deprecated, but we still need to support it for backward compatibility.
 			_, err = appIf.RunResourceAction(ctx, &applicationpkg.ResourceActionRunRequest{
@@ -260,3 +301,56 @@ func getActionableResourcesForApplication(ctx context.Context, appIf application
 		LiveState: string(appManifest),
 	}), nil
 }
+
+// parseResourceActionParameters parses action parameters given in the NAME=VALUE format
+func parseResourceActionParameters(params []string) ([]*applicationpkg.ResourceActionParameters, error) {
+	parsedParams := make([]*applicationpkg.ResourceActionParameters, 0, len(params))
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid parameter format: %s, expected NAME=VALUE", param)
+		}
+		parsedParams = append(parsedParams, &applicationpkg.ResourceActionParameters{
+			Name:  new(name),
+			Value: new(value),
+		})
+	}
+	return parsedParams, nil
+}
+
+// formatActionParams formats the parameters of an action as a comma separated list of NAME:TYPE=DEFAULT
+func formatActionParams(params []v1alpha1.ResourceActionParam) string {
+	formatted := make([]string, 0, len(params))
+	for _, param := range params {
+		paramType := param.Type
+		if paramType == "" {
+			paramType = v1alpha1.ResourceActionParamTypeString
+		}
+		if paramType == v1alpha1.ResourceActionParamTypeEnum {
+			paramType = fmt.Sprintf("%s(%s)", paramType, strings.Join(param.Options, "|"))
+		}
+		f := param.Name + ":" + paramType
+		if param.Default != "" {
+			f += "=" + param.Default
+		}
+		formatted = append(formatted, f)
+	}
+	return strings.Join(formatted, ",")
+}
+
+// printResourceActionResults prints the results of a bulk action and returns false if the action failed on any resource
+func printResourceActionResults(out io.Writer, results []*applicationpkg.ResourceActionResult) bool {
+	succeeded := true
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "GROUP\tKIND\tNAMESPACE\tNAME\tRESULT\n")
+	for _, result := range results {
+		message := "Succeeded"
+		if result.GetError() != "" {
+			succeeded = false
+			message = result.GetError()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.GetGroup(), result.GetKind(), result.GetNamespace(), result.GetName(), message)
+	}
+	_ = w.Flush()
+	return succeeded
+}
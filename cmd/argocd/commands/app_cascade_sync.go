@@ -0,0 +1,157 @@
+package commands
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"golang.org/x/sync/errgroup"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// childApplicationWave is a group of child applications which share the same sync wave
+type childApplicationWave struct {
+	wave int64
+	apps []string
+}
+
+// getChildApplicationWaves returns the qualified names of the applications managed by the application (app-of-apps
+// pattern), grouped by sync wave and ordered by wave
+func getChildApplicationWaves(app *argoappv1.Application) []childApplicationWave {
+	appsByWave := map[int64][]string{}
+	for _, res := range app.Status.Resources {
+		if res.Group != application.Group || res.Kind != application.ApplicationKind {
+			continue
+		}
+		name := res.Name
+		if res.Namespace != "" {
+			name = res.Namespace + "/" + res.Name
+		}
+		appsByWave[res.SyncWave] = append(appsByWave[res.SyncWave], name)
+	}
+	waves := make([]childApplicationWave, 0, len(appsByWave))
+	for _, wave := range slices.Sorted(maps.Keys(appsByWave)) {
+		apps := appsByWave[wave]
+		slices.Sort(apps)
+		waves = append(waves, childApplicationWave{wave: wave, apps: apps})
+	}
+	return waves
+}
+
+// cascadeSyncer syncs the child applications of an application wave by wave, recursively. The applications of a wave
+// are synced concurrently, and the next wave starts once all the applications of the wave are synced and healthy.
+type cascadeSyncer struct {
+	acdClient argocdclient.Client
+	appIf     applicationpkg.ApplicationServiceClient
+	// newSyncRequest returns the sync request of a child application
+	newSyncRequest func(appName string, appNs string) *applicationpkg.ApplicationSyncRequest
+	timeout        uint
+
+	lock    sync.Mutex
+	visited map[string]bool
+}
+
+func newCascadeSyncer(acdClient argocdclient.Client, appIf applicationpkg.ApplicationServiceClient, timeout uint, newSyncRequest func(appName string, appNs string) *applicationpkg.ApplicationSyncRequest) *cascadeSyncer {
+	return &cascadeSyncer{
+		acdClient:      acdClient,
+		appIf:          appIf,
+		newSyncRequest: newSyncRequest,
+		timeout:        timeout,
+		visited:        map[string]bool{},
+	}
+}
+
+// markVisited returns false if the application was already synced, to stop on cyclic app-of-apps
+func (s *cascadeSyncer) markVisited(appName string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.visited[appName] {
+		return false
+	}
+	s.visited[appName] = true
+	return true
+}
+
+// syncChildren syncs the child applications of the parent application, wave by wave
+func (s *cascadeSyncer) syncChildren(ctx context.Context, parent *argoappv1.Application) error {
+	s.markVisited(parent.QualifiedName())
+	for _, wave := range getChildApplicationWaves(parent) {
+		apps := slices.DeleteFunc(wave.apps, func(appName string) bool {
+			return !s.markVisited(appName)
+		})
+		if len(apps) == 0 {
+			continue
+		}
+		printCascadeProgress(parent.QualifiedName(), "Wave", fmt.Sprintf("syncing wave %d: %s", wave.wave, strings.Join(apps, ", ")))
+		g, gCtx := errgroup.WithContext(ctx)
+		for _, appName := range apps {
+			g.Go(func() error {
+				child, err := s.syncChild(gCtx, appName)
+				if err != nil {
+					return err
+				}
+				return s.syncChildren(gCtx, child)
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return fmt.Errorf("wave %d of the child applications of %s failed: %w", wave.wave, parent.QualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// syncChild syncs the child application and waits for the sync to complete and the application to be healthy
+func (s *cascadeSyncer) syncChild(ctx context.Context, appQualifiedName string) (*argoappv1.Application, error) {
+	appName, appNs := argo.ParseFromQualifiedName(appQualifiedName, "")
+	app, err := s.appIf.Sync(ctx, s.newSyncRequest(appName, appNs))
+	if err != nil {
+		return nil, fmt.Errorf("error syncing application %s: %w", appQualifiedName, err)
+	}
+	printCascadeProgress(appQualifiedName, "Syncing", "")
+
+	if s.timeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(s.timeout)*time.Second)
+		defer cancel()
+	}
+	watch := watchOpts{operation: true, health: true, degraded: true, suspended: true}
+	var phase string
+	for appEvent := range s.acdClient.WatchApplicationWithRetry(ctx, appQualifiedName, app.ResourceVersion) {
+		app = &appEvent.Application
+		if opState := app.Status.OperationState; opState != nil && string(opState.Phase) != phase {
+			phase = string(opState.Phase)
+			printCascadeProgress(appQualifiedName, phase, opState.Message)
+		}
+		ready, operationInProgress := checkAppWaitConditions(app, watch, nil)
+		if !ready || operationInProgress {
+			continue
+		}
+		if opState := app.Status.OperationState; opState != nil && !opState.Phase.Successful() {
+			return nil, fmt.Errorf("sync of application %s has completed with phase %s: %s", appQualifiedName, opState.Phase, opState.Message)
+		}
+		if app.Status.Health.Status != health.HealthStatusHealthy {
+			return nil, fmt.Errorf("application %s is %s after sync", appQualifiedName, app.Status.Health.Status)
+		}
+		printCascadeProgress(appQualifiedName, "Healthy", string(app.Status.Sync.Status))
+		return app, nil
+	}
+	if stderrors.Is(ctx.Err(), context.Canceled) {
+		return nil, ctx.Err()
+	}
+	return nil, fmt.Errorf("%w (%ds) waiting for app %q to be synced and healthy", errWaitTimeout, s.timeout, appQualifiedName)
+}
+
+func printCascadeProgress(appName string, status string, message string) {
+	fmt.Printf("%s  %-40s  %-12s  %s\n", time.Now().Format("2006-01-02T15:04:05-07:00"), appName, status, message)
+}
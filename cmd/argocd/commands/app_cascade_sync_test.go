@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newChildAppResource(name string, wave int64) v1alpha1.ResourceStatus {
+	return v1alpha1.ResourceStatus{Group: "argoproj.io", Kind: "Application", Namespace: "argocd", Name: name, SyncWave: wave}
+}
+
+// cascadeAcdClient emits a single event per watched application, with the status of the application once synced
+type cascadeAcdClient struct {
+	*fakeAcdClient
+	resources map[string][]v1alpha1.ResourceStatus
+	health    map[string]health.HealthStatusCode
+}
+
+func (c *cascadeAcdClient) WatchApplicationWithRetry(_ context.Context, appName string, _ string) chan *v1alpha1.ApplicationWatchEvent {
+	finishedAt := metav1.Now()
+	appHealth := health.HealthStatusHealthy
+	if h, ok := c.health[appName]; ok {
+		appHealth = h
+	}
+	appEventsCh := make(chan *v1alpha1.ApplicationWatchEvent, 1)
+	appEventsCh <- &v1alpha1.ApplicationWatchEvent{
+		Type: watch.Modified,
+		Application: v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: appName[len("argocd/"):], Namespace: "argocd"},
+			Status: v1alpha1.ApplicationStatus{
+				Sync:           v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+				Health:         v1alpha1.AppHealthStatus{Status: appHealth},
+				Resources:      c.resources[appName],
+				ReconciledAt:   &finishedAt,
+				OperationState: &v1alpha1.OperationState{Phase: "Succeeded", FinishedAt: &finishedAt},
+			},
+		},
+	}
+	close(appEventsCh)
+	return appEventsCh
+}
+
+// cascadeAppServiceClient records the applications it syncs
+type cascadeAppServiceClient struct {
+	fakeAppServiceClient
+	lock   sync.Mutex
+	synced []string
+}
+
+func (c *cascadeAppServiceClient) Sync(_ context.Context, in *applicationpkg.ApplicationSyncRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.synced = append(c.synced, in.GetAppNamespace()+"/"+in.GetName())
+	return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: in.GetName(), Namespace: in.GetAppNamespace()}}, nil
+}
+
+func newTestCascadeSyncer(acdClient *cascadeAcdClient, appIf *cascadeAppServiceClient) *cascadeSyncer {
+	return newCascadeSyncer(acdClient, appIf, 0, func(appName string, appNs string) *applicationpkg.ApplicationSyncRequest {
+		return &applicationpkg.ApplicationSyncRequest{Name: &appName, AppNamespace: &appNs}
+	})
+}
+
+func TestGetChildApplicationWaves(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+		newChildAppResource("monitoring", 1),
+		{Group: "apps", Kind: "Deployment", Namespace: "argocd", Name: "guestbook"},
+		newChildAppResource("ingress", 0),
+		newChildAppResource("cert-manager", -1),
+		newChildAppResource("dns", 0),
+	}}}
+
+	assert.Equal(t, []childApplicationWave{
+		{wave: -1, apps: []string{"argocd/cert-manager"}},
+		{wave: 0, apps: []string{"argocd/dns", "argocd/ingress"}},
+		{wave: 1, apps: []string{"argocd/monitoring"}},
+	}, getChildApplicationWaves(app))
+	assert.Empty(t, getChildApplicationWaves(&v1alpha1.Application{}))
+}
+
+func TestCascadeSyncer_SyncChildren(t *testing.T) {
+	parent := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{Resources: []v1alpha1.ResourceStatus{
+			newChildAppResource("platform", 0),
+			newChildAppResource("guestbook", 1),
+		}},
+	}
+
+	t.Run("Waves", func(t *testing.T) {
+		acdClient := &cascadeAcdClient{fakeAcdClient: &fakeAcdClient{}, resources: map[string][]v1alpha1.ResourceStatus{
+			// platform is itself an app-of-apps, which refers back to the root app
+			"argocd/platform": {newChildAppResource("cert-manager", 0), newChildAppResource("root", 1)},
+		}}
+		appIf := &cascadeAppServiceClient{}
+
+		require.NoError(t, newTestCascadeSyncer(acdClient, appIf).syncChildren(t.Context(), parent))
+		assert.Equal(t, []string{"argocd/platform", "argocd/cert-manager", "argocd/guestbook"}, appIf.synced)
+	})
+
+	t.Run("FailedWave", func(t *testing.T) {
+		acdClient := &cascadeAcdClient{fakeAcdClient: &fakeAcdClient{}, health: map[string]health.HealthStatusCode{
+			"argocd/platform": health.HealthStatusDegraded,
+		}}
+		appIf := &cascadeAppServiceClient{}
+
+		err := newTestCascadeSyncer(acdClient, appIf).syncChildren(t.Context(), parent)
+		require.ErrorContains(t, err, "application argocd/platform is Degraded after sync")
+		assert.Equal(t, []string{"argocd/platform"}, appIf.synced)
+	})
+}
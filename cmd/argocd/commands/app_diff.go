@@ -41,6 +41,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/io"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/templates"
 )
 
 // targetManifestProvider is a function that retrieves target manifests for diff
@@ -193,6 +194,7 @@ func getLocalObjectsString(
 		Repo:                            &argoappv1.Repository{Repo: source.RepoURL},
 		AppLabelKey:                     argoSettings.AppLabelKey,
 		AppName:                         app.InstanceName(argoSettings.ControllerNamespace),
+		SourceEnvironment:               app.Labels[argoappv1.LabelKeySourceEnvironment],
 		Namespace:                       app.Spec.Destination.Namespace,
 		ApplicationSource:               &source,
 		KustomizeOptions:                argoSettings.KustomizeOptions,
@@ -507,6 +509,82 @@ func newLiveManifestProvider(liveState *application.ManagedResourcesResponse, ex
 	}
 }
 
+// liveStateSnapshot holds everything needed to diff an application without contacting the API server
+type liveStateSnapshot struct {
+	Application *argoappv1.Application                `json:"application"`
+	Project     *argoappv1.AppProject                 `json:"project"`
+	Settings    *settings.Settings                    `json:"settings"`
+	ClusterInfo *argoappv1.ClusterInfo                `json:"clusterInfo"`
+	Resources   *application.ManagedResourcesResponse `json:"resources"`
+}
+
+// writeLiveStateSnapshot writes the snapshot as JSON to the given file
+func writeLiveStateSnapshot(path string, snapshot *liveStateSnapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling live state snapshot: %w", err)
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// readLiveStateSnapshot reads a snapshot previously written by writeLiveStateSnapshot and checks that it belongs to
+// the given application
+func readLiveStateSnapshot(path string, appName string, appNs string) (*liveStateSnapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading live state snapshot: %w", err)
+	}
+	snapshot := &liveStateSnapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("error unmarshaling live state snapshot: %w", err)
+	}
+	if snapshot.Application == nil || snapshot.Project == nil || snapshot.Settings == nil || snapshot.Resources == nil {
+		return nil, fmt.Errorf("live state snapshot %s is incomplete", path)
+	}
+	if snapshot.Application.Name != appName || (appNs != "" && snapshot.Application.Namespace != appNs) {
+		return nil, fmt.Errorf("live state snapshot %s belongs to application %s, not %s", path, snapshot.Application.QualifiedName(), appName)
+	}
+	if snapshot.ClusterInfo == nil {
+		snapshot.ClusterInfo = &argoappv1.ClusterInfo{}
+	}
+	return snapshot, nil
+}
+
+// newLocalOfflineProvider creates a provider for local manifests generated on the client, using the cluster
+// information of a live state snapshot instead of querying the API server
+func newLocalOfflineProvider(snapshot *liveStateSnapshot, localPath string, localRepoRoot string) manifestProvider {
+	return func(ctx context.Context) ([]*unstructured.Unstructured, error) {
+		return getLocalObjects(
+			ctx,
+			snapshot.Application,
+			snapshot.Project,
+			localPath,
+			localRepoRoot,
+			snapshot.Settings,
+			snapshot.ClusterInfo,
+		), nil
+	}
+}
+
+// compareOffline diffs local manifests against the live state of a snapshot. Manifests are generated and compared on
+// the client, so secrets are excluded from the diff as in other client-side local diffs.
+func compareOffline(
+	ctx context.Context,
+	snapshot *liveStateSnapshot,
+	localPath string,
+	localRepoRoot string,
+	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+) ([]comparisonObject, error) {
+	infoProvider := getInfoProviderFromState(snapshot.Resources)
+	getTargetManifests := newNormalizeTargetManifestsProvider(newLocalOfflineProvider(snapshot, localPath, localRepoRoot), snapshot.Application, snapshot.Settings, infoProvider)
+	getLiveManifests := newLiveManifestProvider(snapshot.Resources, true)
+	diffHandler, err := newClientSideDiffStrategy(snapshot.Application, snapshot.Settings, ignoreNormalizerOpts)
+	if err != nil {
+		return nil, err
+	}
+	return compareManifests(ctx, getTargetManifests, getLiveManifests, diffHandler)
+}
+
 // normalizeTargetManifestsProvider wraps a manifestProvider to normalize target objects
 // This ensures namespace normalization and tracking annotation updates after deduplication
 func newNormalizeTargetManifestsProvider(
@@ -640,12 +718,23 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions           []int64
 		sourceNames               []string
 		ignoreNormalizerOpts      normalizers.IgnoreNormalizerOpts
+		offline                   bool
+		liveStateFile             string
+		exportLiveState           string
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
 		Use:   "diff APPNAME",
 		Short: shortDesc,
 		Long:  shortDesc + "\nUses 'diff' to render the difference. KUBECTL_EXTERNAL_DIFF environment variable can be used to select your own diff tool.\nReturns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found\nKubernetes Secrets are ignored from this diff.",
+		Example: templates.Examples(`
+  # Compare the live state of an app to local manifests
+  argocd app diff my-app --local ./manifests --server-side-generate
+
+  # Export the live state of an app, then compare it to local manifests without access to the API server
+  argocd app diff my-app --export-live-state my-app-live.json
+  argocd app diff my-app --offline --live-state my-app-live.json --local ./manifests --local-repo-root .
+		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -670,10 +759,28 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.Fatal(errors.ErrorGeneric, "invalid value for --server-side-diff-concurrency: 0 is not allowed (use -1 for unlimited, or a positive number to limit concurrency)")
 			}
 
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+
+			if offline {
+				if local == "" || liveStateFile == "" {
+					errors.Fatal(errors.ErrorGeneric, "--offline requires --local and --live-state.")
+				}
+				if serverSideGenerate || serverSideDiff || revision != "" || len(revisions) > 0 || exportLiveState != "" {
+					errors.Fatal(errors.ErrorGeneric, "--offline cannot be combined with --server-side-generate, --server-side-diff, --revision, --revisions or --export-live-state.")
+				}
+				snapshot, err := readLiveStateSnapshot(liveStateFile, appName, appNs)
+				errors.CheckError(err)
+				results, err := compareOffline(ctx, snapshot, local, localRepoRoot, ignoreNormalizerOpts)
+				errors.CheckError(err)
+				if printDiffResults(results) && exitCode {
+					os.Exit(diffExitCode)
+				}
+				return
+			}
+
 			clientset := headless.NewClientOrDie(clientOpts, c)
 			conn, appIf := clientset.NewApplicationClientOrDie()
 			defer io.Close(conn)
-			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
 			app, err := appIf.Get(ctx, &application.ApplicationQuery{
 				Name:         &appName,
 				Refresh:      getRefreshType(refresh, hardRefresh),
@@ -717,6 +824,26 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 			proj := getProject(ctx, c, clientOpts, app.Spec.Project)
 
+			if exportLiveState != "" {
+				conn, clusterIf := clientset.NewClusterClientOrDie()
+				defer io.Close(conn)
+				cluster, err := clusterIf.Get(ctx, &clusterpkg.ClusterQuery{
+					Name:   app.Spec.Destination.Name,
+					Server: app.Spec.Destination.Server,
+				})
+				errors.CheckError(err)
+				err = writeLiveStateSnapshot(exportLiveState, &liveStateSnapshot{
+					Application: app,
+					Project:     proj.Project,
+					Settings:    argoSettings,
+					ClusterInfo: &cluster.Info,
+					Resources:   liveState,
+				})
+				errors.CheckError(err)
+				fmt.Fprintf(os.Stderr, "Live state of application %s written to %s\n", app.QualifiedName(), exportLiveState)
+				return
+			}
+
 			// Build resource info provider from live state to determine if resources are namespaced
 			infoProvider := getInfoProviderFromState(liveState)
 
@@ -774,14 +901,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			results, err := compareManifests(ctx, getTargetManifests, getLiveManifests, diffHandler)
 			errors.CheckError(err)
 
-			sort.Slice(results, func(i, j int) bool {
-				return results[i].key.String() < results[j].key.String()
-			})
-			for _, result := range results {
-				printResourceDiff(result.key.Group, result.key.Kind, result.key.Namespace, result.key.Name, result.live, result.target)
-			}
-
-			foundDiffs := len(results) > 0
+			foundDiffs := printDiffResults(results)
 			if foundDiffs && exitCode {
 				os.Exit(diffExitCode)
 			}
@@ -803,9 +923,23 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().StringVar(&exportLiveState, "export-live-state", "", "Write the live state of the app, along with the settings needed to diff it, to the given file instead of printing a diff. The file can be used with --offline --live-state")
+	command.Flags().BoolVar(&offline, "offline", false, "Compare local manifests to an exported live state without contacting the Argo CD API server. Requires --local and --live-state")
+	command.Flags().StringVar(&liveStateFile, "live-state", "", "Used with --offline, path to a live state file written by --export-live-state")
 	return command
 }
 
+// printDiffResults prints the diff of every resource, ordered by resource key, and returns whether any diff was found
+func printDiffResults(results []comparisonObject) bool {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].key.String() < results[j].key.String()
+	})
+	for _, result := range results {
+		printResourceDiff(result.key.Group, result.key.Kind, result.key.Namespace, result.key.Name, result.live, result.target)
+	}
+	return len(results) > 0
+}
+
 // addServerSideDiffPerfFlags adds server-side diff performance tuning flags to a command
 func addServerSideDiffPerfFlags(command *cobra.Command, serverSideDiffConcurrency *int, serverSideDiffMaxBatchKB *int) {
 	command.Flags().IntVar(serverSideDiffConcurrency, "server-side-diff-concurrency", -1, "Max concurrent batches for server-side diff. -1 = unlimited, 1 = sequential, 2+ = concurrent (0 = invalid)")
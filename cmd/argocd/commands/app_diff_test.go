@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
@@ -1522,3 +1524,82 @@ func TestNewNormalizeTargetManifestsProvider(t *testing.T) {
 		assert.Equal(t, "test-app", labels["app.kubernetes.io/instance"])
 	})
 }
+
+func TestLiveStateSnapshot(t *testing.T) {
+	app := createTestApp("test-app", "argocd", v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "."})
+	snapshot := &liveStateSnapshot{
+		Application: app,
+		Project:     &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		Settings:    &settingspkg.Settings{AppLabelKey: "app.kubernetes.io/instance", TrackingMethod: "label"},
+		Resources:   &applicationpkg.ManagedResourcesResponse{},
+	}
+	path := filepath.Join(t.TempDir(), "live.json")
+	require.NoError(t, writeLiveStateSnapshot(path, snapshot))
+
+	t.Run("Reads snapshot of the application", func(t *testing.T) {
+		read, err := readLiveStateSnapshot(path, "test-app", "")
+		require.NoError(t, err)
+		assert.Equal(t, "test-app", read.Application.Name)
+		assert.Equal(t, "app.kubernetes.io/instance", read.Settings.AppLabelKey)
+		assert.NotNil(t, read.ClusterInfo)
+	})
+
+	t.Run("Rejects snapshot of another application", func(t *testing.T) {
+		_, err := readLiveStateSnapshot(path, "other-app", "")
+		require.ErrorContains(t, err, "belongs to application argocd/test-app")
+		_, err = readLiveStateSnapshot(path, "test-app", "other-namespace")
+		require.Error(t, err)
+	})
+
+	t.Run("Rejects incomplete snapshot", func(t *testing.T) {
+		incompletePath := filepath.Join(t.TempDir(), "live.json")
+		require.NoError(t, os.WriteFile(incompletePath, []byte(`{"application": {"metadata": {"name": "test-app"}}}`), 0o600))
+		_, err := readLiveStateSnapshot(incompletePath, "test-app", "")
+		require.ErrorContains(t, err, "incomplete")
+	})
+}
+
+func TestCompareOffline(t *testing.T) {
+	localPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(localPath, "cm.yaml"), []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+data:
+  key: new-value
+`), 0o600))
+
+	liveConfigMap := createTestUnstructured(&corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default", Labels: map[string]string{"app.kubernetes.io/instance": "test-app"}},
+		Data:       map[string]string{"key": "old-value"},
+	})
+	liveBytes, err := json.Marshal(liveConfigMap)
+	require.NoError(t, err)
+
+	snapshot := &liveStateSnapshot{
+		Application: createTestApp("test-app", "argocd", v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "."}),
+		Project:     &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		Settings: &settingspkg.Settings{
+			AppLabelKey:         "app.kubernetes.io/instance",
+			TrackingMethod:      "label",
+			ControllerNamespace: "argocd",
+		},
+		ClusterInfo: &v1alpha1.ClusterInfo{},
+		Resources: &applicationpkg.ManagedResourcesResponse{
+			Items: []*v1alpha1.ResourceDiff{{
+				Kind:                "ConfigMap",
+				Namespace:           "default",
+				Name:                "my-config",
+				NormalizedLiveState: string(liveBytes),
+			}},
+		},
+	}
+
+	results, err := compareOffline(t.Context(), snapshot, localPath, localPath, normalizers.IgnoreNormalizerOpts{})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "my-config", results[0].key.Name)
+	assert.Equal(t, "old-value", results[0].live.Object["data"].(map[string]any)["key"])
+	assert.Equal(t, "new-value", results[0].target.Object["data"].(map[string]any)["key"])
+}
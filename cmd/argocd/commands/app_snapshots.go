@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationSnapshotsCommand returns a new instance of an `argocd app snapshots` command
+func NewApplicationSnapshotsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage snapshots of the live state of an application",
+		Example: templates.Examples(`
+	# Snapshot the live state of the resources of an application
+	argocd app snapshots create my-app
+
+	# List the snapshots of an application
+	argocd app snapshots list my-app
+
+	# Show the changes restoring a snapshot would make, then restore it
+	argocd app snapshots restore my-app 20260101-120000-abcde --dry-run
+	argocd app snapshots restore my-app 20260101-120000-abcde
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationSnapshotsCreateCommand(clientOpts))
+	command.AddCommand(NewApplicationSnapshotsListCommand(clientOpts))
+	command.AddCommand(NewApplicationSnapshotsRestoreCommand(clientOpts))
+	command.AddCommand(NewApplicationSnapshotsDeleteCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSnapshotsCreateCommand returns a new instance of an `argocd app snapshots create` command
+func NewApplicationSnapshotsCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "create APPNAME",
+		Short: "Snapshot the live state of the resources of an application",
+		Long:  "Store a copy of the live state of the resources managed by an application, except Secrets, in the snapshot store",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			snapshot, err := appIf.CreateSnapshot(ctx, &applicationpkg.ApplicationSnapshotQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				errors.CheckError(PrintResource(snapshot, output))
+			case "name":
+				fmt.Println(snapshot.GetId())
+			case "wide", "":
+				printApplicationSnapshots(os.Stdout, []*applicationpkg.ApplicationSnapshot{snapshot})
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationSnapshotsListCommand returns a new instance of an `argocd app snapshots list` command
+func NewApplicationSnapshotsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List the snapshots of an application",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			snapshots, err := appIf.ListSnapshots(ctx, &applicationpkg.ApplicationSnapshotQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				errors.CheckError(PrintResourceList(snapshots.Items, output, false))
+			case "name":
+				for _, snapshot := range snapshots.Items {
+					fmt.Println(snapshot.GetId())
+				}
+			case "wide", "":
+				printApplicationSnapshots(os.Stdout, snapshots.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|name")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationSnapshotsRestoreCommand returns a new instance of an `argocd app snapshots restore` command
+func NewApplicationSnapshotsRestoreCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		dryRun       bool
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "restore APPNAME SNAPSHOT",
+		Short: "Restore the live state of the resources of an application from a snapshot",
+		Long:  "Create the resources of the snapshot which no longer exist and patch the other ones back to their state in the snapshot. Disable the automated sync of the application first, since self-heal reverts the restored resources to the target state.",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			resp, err := appIf.RestoreSnapshot(ctx, &applicationpkg.ApplicationSnapshotRestoreRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           &args[1],
+				DryRun:       &dryRun,
+			})
+			errors.CheckError(err)
+			if !printApplicationSnapshotRestoreResults(os.Stdout, resp.GetResults()) {
+				os.Exit(1)
+			}
+		},
+	}
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Show the operations restoring the snapshot would perform without changing any resource")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+// NewApplicationSnapshotsDeleteCommand returns a new instance of an `argocd app snapshots delete` command
+func NewApplicationSnapshotsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var appNamespace string
+	command := &cobra.Command{
+		Use:   "delete APPNAME SNAPSHOT",
+		Short: "Delete a snapshot of an application",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err := appIf.DeleteSnapshot(ctx, &applicationpkg.ApplicationSnapshotDeleteRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Id:           &args[1],
+			})
+			errors.CheckError(err)
+			fmt.Printf("Snapshot '%s' of application '%s' deleted\n", args[1], args[0])
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+func printApplicationSnapshots(out io.Writer, snapshots []*applicationpkg.ApplicationSnapshot) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "ID\tCREATED AT\tCREATED BY\tRESOURCES\tREVISIONS\n")
+	for _, snapshot := range snapshots {
+		createdAt := "-"
+		if snapshot.CreatedAt != nil {
+			createdAt = snapshot.CreatedAt.UTC().Format(time.RFC3339)
+		}
+		createdBy := snapshot.GetCreatedBy()
+		if createdBy == "" {
+			createdBy = "-"
+		}
+		revisions := strings.Join(snapshot.Revisions, ",")
+		if revisions == "" {
+			revisions = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", snapshot.GetId(), createdAt, createdBy, len(snapshot.Resources), revisions)
+	}
+	_ = w.Flush()
+}
+
+// printApplicationSnapshotRestoreResults prints the result of restoring each resource, and returns whether all the
+// resources were restored
+func printApplicationSnapshotRestoreResults(out io.Writer, results []*applicationpkg.ApplicationSnapshotRestoreResult) bool {
+	succeeded := true
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "GROUP\tKIND\tNAMESPACE\tNAME\tRESULT\n")
+	for _, result := range results {
+		message := result.GetOperation()
+		if result.GetError() != "" {
+			succeeded = false
+			message = result.GetError()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.GetGroup(), result.GetKind(), result.GetNamespace(), result.GetName(), message)
+	}
+	_ = w.Flush()
+	return succeeded
+}
@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintApplicationSnapshots(t *testing.T) {
+	var out bytes.Buffer
+	printApplicationSnapshots(&out, []*applicationpkg.ApplicationSnapshot{{
+		Id:        new("20260101-000000-abcde"),
+		CreatedAt: &metav1.Time{Time: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		CreatedBy: new("admin"),
+		Revisions: []string{"abc123"},
+		Resources: []*v1alpha1.ResourceRef{{Kind: "ConfigMap", Name: "config"}, {Group: "apps", Kind: "Deployment", Name: "web"}},
+	}, {
+		Id: new("20260102-000000-fghij"),
+	}})
+	assert.Equal(t, `ID                     CREATED AT            CREATED BY  RESOURCES  REVISIONS
+20260101-000000-abcde  2026-01-01T00:00:00Z  admin       2          abc123
+20260102-000000-fghij  -                     -           0          -
+`, out.String())
+}
+
+func TestPrintApplicationSnapshotRestoreResults(t *testing.T) {
+	var out bytes.Buffer
+	succeeded := printApplicationSnapshotRestoreResults(&out, []*applicationpkg.ApplicationSnapshotRestoreResult{
+		{Group: new("apps"), Kind: new("Deployment"), Namespace: new("default"), Name: new("web"), Operation: new("patched")},
+		{Kind: new("ConfigMap"), Namespace: new("default"), Name: new("config"), Error: new("forbidden")},
+	})
+	assert.False(t, succeeded)
+	assert.Equal(t, `GROUP  KIND        NAMESPACE  NAME    RESULT
+apps   Deployment  default    web     patched
+       ConfigMap   default    config  forbidden
+`, out.String())
+}
@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationSyncWindowsCommand returns a new instance of an `argocd app sync-windows` command
+func NewApplicationSyncWindowsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "sync-windows APPNAME",
+		Short: "Show the state of the sync windows of an application",
+		Long:  "Show the sync windows assigned to an application, whether they are active, when they next become active or inactive, and whether the application can currently be synced",
+		Example: templates.Examples(`
+	# Show the state of the sync windows of an application
+	argocd app sync-windows my-app
+
+	# Show the state of the sync windows of an application in json format
+	argocd app sync-windows my-app -o json
+	`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			state, err := appIf.GetApplicationSyncWindows(ctx, &applicationpkg.ApplicationSyncWindowsQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+			})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				errors.CheckError(PrintResource(state, output))
+			case "wide", "":
+				printApplicationSyncWindows(os.Stdout, state)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	return command
+}
+
+func printApplicationSyncWindows(out io.Writer, state *applicationpkg.ApplicationSyncWindowsResponse) {
+	fmt.Fprintf(out, printOpFmtStr, "Can Sync:", strconv.FormatBool(state.GetCanSync()))
+	fmt.Fprintf(out, printOpFmtStr, "Can Auto Sync:", strconv.FormatBool(state.GetCanAutoSync()))
+	nextTransition := "-"
+	if state.NextTransition != nil {
+		nextTransition = state.NextTransition.UTC().Format(time.RFC3339)
+	}
+	fmt.Fprintf(out, printOpFmtStr, "Next Transition:", nextTransition)
+	if len(state.AssignedWindows) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "KIND\tSCHEDULE\tDURATION\tTIMEZONE\tMANUALSYNC\tSTATUS\tNEXT TRANSITION\n")
+	for _, window := range state.AssignedWindows {
+		status := formatBoolOutput(window.GetActive())
+		if window.GetUnlocked() {
+			status += " (Unlocked)"
+		}
+		transition := "-"
+		if window.NextTransition != nil {
+			transition = window.NextTransition.UTC().Format(time.RFC3339)
+		}
+		timeZone := window.GetTimeZone()
+		if timeZone == "" {
+			timeZone = "UTC"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", window.GetKind(), window.GetSchedule(), window.GetDuration(), timeZone, formatBoolEnabledOutput(window.GetManualSync()), status, transition)
+	}
+	_ = w.Flush()
+}
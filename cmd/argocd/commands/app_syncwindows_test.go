@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+)
+
+func TestPrintApplicationSyncWindows(t *testing.T) {
+	t.Run("NoWindows", func(t *testing.T) {
+		var out bytes.Buffer
+		printApplicationSyncWindows(&out, &applicationpkg.ApplicationSyncWindowsResponse{CanSync: new(true), CanAutoSync: new(true)})
+		assert.Equal(t, `Can Sync:           true
+Can Auto Sync:      true
+Next Transition:    -
+`, out.String())
+	})
+	t.Run("Windows", func(t *testing.T) {
+		transition := &metav1.Time{Time: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)}
+		var out bytes.Buffer
+		printApplicationSyncWindows(&out, &applicationpkg.ApplicationSyncWindowsResponse{
+			CanSync:        new(true),
+			CanAutoSync:    new(false),
+			NextTransition: transition,
+			AssignedWindows: []*applicationpkg.ApplicationSyncWindow{{
+				Kind:       new("deny"),
+				Schedule:   new("* * * * *"),
+				Duration:   new("1h"),
+				ManualSync: new(true),
+				Active:     new(true),
+			}, {
+				Kind:           new("deny"),
+				Schedule:       new("0 0 1 1 *"),
+				Duration:       new("1h"),
+				TimeZone:       new("Europe/Paris"),
+				ManualSync:     new(false),
+				Active:         new(true),
+				Unlocked:       new(true),
+				NextTransition: transition,
+			}},
+		})
+		assert.Equal(t, `Can Sync:           true
+Can Auto Sync:      false
+Next Transition:    2026-01-01T00:00:00Z
+
+KIND  SCHEDULE   DURATION  TIMEZONE      MANUALSYNC  STATUS             NEXT TRANSITION
+deny  * * * * *  1h        UTC           Enabled     Active             -
+deny  0 0 1 1 *  1h        Europe/Paris  Disabled    Active (Unlocked)  2026-01-01T00:00:00Z
+`, out.String())
+	})
+}
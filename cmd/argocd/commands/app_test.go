@@ -362,6 +362,22 @@ func TestFindRevisionHistoryWithPassedIdThatNotExist(t *testing.T) {
 	require.EqualError(t, err, "application '' does not have deployment id '4' in history", "Find revision history should fail with correct error message")
 }
 
+func TestGetHistorySourceNameToPositionMap(t *testing.T) {
+	application := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{Sources: v1alpha1.ApplicationSources{{Name: "values"}}},
+		Status: v1alpha1.ApplicationStatus{History: v1alpha1.RevisionHistories{
+			{ID: 0, Sources: v1alpha1.ApplicationSources{{Name: "chart"}, {}, {Name: "values"}}},
+		}},
+	}
+
+	sourceNameToPosition, err := getHistorySourceNameToPositionMap(application, 0)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"chart": 1, "values": 3}, sourceNameToPosition)
+
+	_, err = getHistorySourceNameToPositionMap(application, 1)
+	require.EqualError(t, err, "application '' does not have deployment id '1' in history")
+}
+
 func TestFormatSyncPolicy(t *testing.T) {
 	t.Run("Policy not defined", func(t *testing.T) {
 		app := v1alpha1.Application{}
@@ -499,6 +515,38 @@ func TestPrintOperationResult(t *testing.T) {
 		expectation := "Operation:          Sync\nSync Revision:      revision\nPhase:              \nStart:              0001-01-01 00:00:00 +0000 UTC\nFinished:           2020-11-10 23:00:00 +0000 UTC\nDuration:           2333448h16m18.871345152s\nMessage:            test\n"
 		require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
 	})
+
+	t.Run("Operation state with stuck resources", func(t *testing.T) {
+		time := metav1.Date(2020, time.November, 10, 23, 0, 0, 0, time.UTC)
+		output, _ := captureOutput(func() error {
+			printOperationResult(&v1alpha1.OperationState{
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: "revision"},
+				FinishedAt: &time,
+				StuckResources: []v1alpha1.StuckResource{{
+					Kind:      "Deployment",
+					Namespace: "default",
+					Name:      "guestbook",
+					SyncPhase: "Sync",
+					SyncWave:  1,
+					Message:   "did not become healthy within 5m0s",
+				}, {
+					Group:     "batch",
+					Kind:      "Job",
+					Namespace: "default",
+					Name:      "migrate",
+					SyncPhase: "PreSync",
+					Message:   "skipped, did not complete within 5m0s",
+					Skipped:   true,
+				}},
+			})
+			return nil
+		})
+
+		expectation := "Operation:          Sync\nSync Revision:      revision\nPhase:              \nStart:              0001-01-01 00:00:00 +0000 UTC\nFinished:           2020-11-10 23:00:00 +0000 UTC\nDuration:           2333448h16m18.871345152s\n" +
+			"Stuck:              /Deployment/default/guestbook (Sync wave 1): did not become healthy within 5m0s\n" +
+			"Skipped:            batch/Job/default/migrate (PreSync wave 0): skipped, did not complete within 5m0s\n"
+		require.Equalf(t, output, expectation, "Incorrect print operation output %q, should be %q", output, expectation)
+	})
 }
 
 func TestPrintApplicationHistoryTable(t *testing.T) {
@@ -701,6 +749,103 @@ Health Status:      Progressing
 	assert.Equalf(t, expectation, output, "Incorrect print app summary output %q, should be %q", output, expectation)
 }
 
+func TestPrintAppSummaryTable_MultipleDestinations(t *testing.T) {
+	output, _ := captureOutput(func() error {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "argocd",
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "default",
+				Destination: v1alpha1.ApplicationDestination{Server: "local", Namespace: "team-a"},
+				Destinations: []v1alpha1.ApplicationDestination{
+					{Server: "local", Namespace: "team-a"},
+					{Name: "remote", Namespace: "team-b"},
+				},
+				Source: &v1alpha1.ApplicationSource{
+					RepoURL:        "test",
+					TargetRevision: "master",
+					Path:           "/test",
+				},
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Sync: v1alpha1.SyncStatus{
+					Status: v1alpha1.SyncStatusCodeOutOfSync,
+				},
+				Health: v1alpha1.AppHealthStatus{
+					Status: health.HealthStatusMissing,
+				},
+				Destinations: []v1alpha1.ApplicationDestinationStatus{
+					{Destination: v1alpha1.ApplicationDestination{Server: "local", Namespace: "team-a"}, Sync: v1alpha1.SyncStatusCodeSynced, Health: health.HealthStatusHealthy},
+					{Destination: v1alpha1.ApplicationDestination{Name: "remote", Namespace: "team-b"}, Sync: v1alpha1.SyncStatusCodeOutOfSync, Health: health.HealthStatusMissing},
+				},
+			},
+		}
+
+		printAppSummaryTable(app, "url", nil)
+		return nil
+	})
+
+	expectation := `Name:               argocd/test
+Project:            default
+Server:             local
+Namespace:          team-a
+URL:                url
+Source:
+- Repo:             test
+  Target:           master
+  Path:             /test
+Destinations:
+- Server:           local
+  Namespace:        team-a
+  Status:           Synced, Healthy
+- Server:           remote
+  Namespace:        team-b
+  Status:           OutOfSync, Missing
+SyncWindow:         Sync Allowed
+Sync Policy:        Manual
+Sync Status:        OutOfSync from master
+Health Status:      Missing
+`
+	assert.Equalf(t, expectation, output, "Incorrect print app summary output %q, should be %q", output, expectation)
+}
+
+func TestPrintAppSummaryTable_PruneOnly(t *testing.T) {
+	output, _ := captureOutput(func() error {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "argocd",
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Project:     "default",
+				Destination: v1alpha1.ApplicationDestination{Server: "local", Namespace: "argocd"},
+				Source: &v1alpha1.ApplicationSource{
+					RepoURL:        "test",
+					TargetRevision: "master",
+					Path:           "/test",
+				},
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Sync: v1alpha1.SyncStatus{
+					Status:    v1alpha1.SyncStatusCodeOutOfSync,
+					Revision:  "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+					PruneOnly: true,
+				},
+				Health: v1alpha1.AppHealthStatus{
+					Status: health.HealthStatusHealthy,
+				},
+			},
+		}
+
+		printAppSummaryTable(app, "url", nil)
+		return nil
+	})
+
+	assert.Contains(t, output, "Sync Status:        OutOfSync from master (aaaaaaa), prune only\n")
+}
+
 func TestPrintAppSummaryTable_MultipleSources(t *testing.T) {
 	output, _ := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -815,13 +960,23 @@ func TestPrintAppConditions(t *testing.T) {
 						Type:    v1alpha1.ApplicationConditionRepeatedResourceWarning,
 						Message: "test3",
 					},
+					{
+						Type:     v1alpha1.ApplicationConditionSyncPreconditionWarning,
+						Message:  "test4",
+						Severity: v1alpha1.ApplicationConditionSeverityInfo,
+					},
+					{
+						Type:      v1alpha1.ApplicationConditionOrphanedResourceWarning,
+						Message:   "expired",
+						ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+					},
 				},
 			},
 		}
 		printAppConditions(os.Stdout, app)
 		return nil
 	})
-	expectation := "CONDITION\tMESSAGE\tLAST TRANSITION\nDeletionError\ttest\t<nil>\nExcludedResourceWarning\ttest2\t<nil>\nRepeatedResourceWarning\ttest3\t<nil>\n"
+	expectation := "CONDITION\tSEVERITY\tMESSAGE\tLAST TRANSITION\nDeletionError\tError\ttest\t<nil>\nExcludedResourceWarning\tWarning\ttest2\t<nil>\nRepeatedResourceWarning\tWarning\ttest3\t<nil>\nSyncPreconditionWarning\tInfo\ttest4\t<nil>\n"
 	require.Equalf(t, output, expectation, "Incorrect print app conditions output %q, should be %q", output, expectation)
 }
 
@@ -1591,6 +1746,43 @@ func TestPrintApplicationTableWide(t *testing.T) {
 	assert.Equal(t, output, expectation)
 }
 
+func TestPrintApplicationTableWithColumns(t *testing.T) {
+	output, err := captureOutput(func() error {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "app-name",
+				Labels: map[string]string{"team": "platform"},
+			},
+			Spec: v1alpha1.ApplicationSpec{
+				Destination: v1alpha1.ApplicationDestination{
+					Server:    "http://localhost:8080",
+					Namespace: "default",
+				},
+				Project: "prj",
+			},
+			Status: v1alpha1.ApplicationStatus{
+				Sync: v1alpha1.SyncStatus{
+					Status:   "OutOfSync",
+					Revision: "abc",
+				},
+				Health: v1alpha1.AppHealthStatus{
+					Status: "Healthy",
+				},
+			},
+		}
+		output := "table"
+		printApplicationTable([]v1alpha1.Application{*app}, &output,
+			&settingspkg.ApplicationColumn{Name: "Team", JSONPath: ".metadata.labels.team"},
+			&settingspkg.ApplicationColumn{Name: "Revision", JSONPath: ".status.sync.revision"},
+			&settingspkg.ApplicationColumn{Name: "Owner", JSONPath: ".metadata.labels.owner"},
+		)
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := "NAME      CLUSTER                NAMESPACE  PROJECT  STATUS     HEALTH   SYNCPOLICY  CONDITIONS  TEAM      REVISION  OWNER\napp-name  http://localhost:8080  default    prj      OutOfSync  Healthy  Manual      <none>      platform  abc       \n"
+	assert.Equal(t, expectation, output)
+}
+
 func TestResourceStateKey(t *testing.T) {
 	rst := resourceState{
 		Group:     "group",
@@ -2456,6 +2648,10 @@ func (c *fakeAppServiceClient) RevisionMetadata(_ context.Context, _ *applicatio
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) RevisionComparison(_ context.Context, _ *applicationpkg.RevisionComparisonQuery, _ ...grpc.CallOption) (*v1alpha1.RevisionComparison, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) RevisionChartDetails(_ context.Context, _ *applicationpkg.RevisionMetadataQuery, _ ...grpc.CallOption) (*v1alpha1.ChartDetails, error) {
 	return nil, nil
 }
@@ -2480,10 +2676,22 @@ func (c *fakeAppServiceClient) Patch(_ context.Context, _ *applicationpkg.Applic
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) PatchParameters(_ context.Context, _ *applicationpkg.ApplicationPatchParametersRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) MetricsHistory(_ context.Context, _ *applicationpkg.ApplicationMetricsHistoryQuery, _ ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) Delete(_ context.Context, _ *applicationpkg.ApplicationDeleteRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) DeletePreview(_ context.Context, _ *applicationpkg.ApplicationDeleteRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationDeletePreviewResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) Sync(_ context.Context, _ *applicationpkg.ApplicationSyncRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
 	return nil, nil
 }
@@ -2529,6 +2737,10 @@ func (c *fakeAppServiceClient) RunResourceActionV2(_ context.Context, _ *applica
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) RunResourceActionBulk(_ context.Context, _ *applicationpkg.ResourceActionBulkRunRequest, _ ...grpc.CallOption) (*applicationpkg.ResourceActionBulkRunResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) DeleteResource(_ context.Context, _ *applicationpkg.ApplicationResourceDeleteRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
 	return nil, nil
 }
@@ -2545,6 +2757,26 @@ func (c *fakeAppServiceClient) ListResourceLinks(_ context.Context, _ *applicati
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) CreateSnapshot(_ context.Context, _ *applicationpkg.ApplicationSnapshotQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSnapshot, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) ListSnapshots(_ context.Context, _ *applicationpkg.ApplicationSnapshotQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationSnapshotList, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) RestoreSnapshot(_ context.Context, _ *applicationpkg.ApplicationSnapshotRestoreRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationSnapshotRestoreResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) DeleteSnapshot(_ context.Context, _ *applicationpkg.ApplicationSnapshotDeleteRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationResponse, error) {
+	return nil, nil
+}
+
+func (c *fakeAppServiceClient) UpdateAgentStatus(_ context.Context, _ *applicationpkg.ApplicationAgentStatusRequest, _ ...grpc.CallOption) (*v1alpha1.Application, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationpkg.ApplicationServerSideDiffQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationServerSideDiffResponse, error) {
 	return nil, nil
 }
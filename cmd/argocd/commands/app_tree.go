@@ -0,0 +1,836 @@
+package commands
+
+import (
+	"cmp"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/kubectl/pkg/util/templates"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/events"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// NewApplicationTreeCommand returns a new instance of an `argocd app tree` command
+func NewApplicationTreeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		interactive  bool
+		orphaned     bool
+		project      string
+		appNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "tree APPNAME",
+		Short: "Show the resource tree of an application",
+		Long: `Show the resource tree of an application, including the sync and health status of each resource.
+
+In interactive mode, the tree is updated live and can be browsed using the keyboard:
+
+  up/k, down/j, pgup, pgdn   move the selection or scroll
+  enter/m                    show the manifest of the selected resource
+  e                          show the events of the selected resource
+  l                          show the logs of the selected pod
+  s                          sync the selected resource
+  d                          delete the selected resource
+  a                          list and run the actions of the selected resource
+  r                          refresh the tree
+  esc                        go back to the tree
+  q, ctrl+c                  quit`,
+		Example: templates.Examples(`
+  # Print the resource tree of an application
+  argocd app tree my-app
+
+  # Browse the resource tree of an application interactively
+  argocd app tree my-app --interactive
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			browser := newTreeBrowser(ctx, appIf, appName, appNs, project)
+			browser.showOrphaned = orphaned
+			if interactive {
+				errors.CheckError(runTreeBrowser(ctx, browser, os.Stdin, os.Stdout))
+				return
+			}
+			errors.CheckError(browser.refresh())
+			printTreeRows(os.Stdout, browser.rows)
+		},
+	}
+	command.Flags().BoolVarP(&interactive, "interactive", "i", false, "Browse the resource tree interactively, with live updates")
+	command.Flags().BoolVar(&orphaned, "orphaned", false, "Include orphaned resources")
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the application")
+	command.Flags().StringVar(&project, "project", "", `The name of the application's project - specifying this allows the command to report "not found" instead of "permission denied" if the app does not exist`)
+	return command
+}
+
+// treeRow is a resource of the application tree as displayed on a single line
+type treeRow struct {
+	prefix     string
+	node       v1alpha1.ResourceNode
+	syncStatus v1alpha1.SyncStatusCode
+	orphaned   bool
+}
+
+func (r *treeRow) name() string {
+	return r.node.Kind + "/" + r.node.Name
+}
+
+func (r *treeRow) health() (string, string) {
+	if r.node.Health == nil {
+		return "", ""
+	}
+	return string(r.node.Health.Status), r.node.Health.Message
+}
+
+func resourceNodeKey(node v1alpha1.ResourceNode) kube.ResourceKey {
+	return kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+}
+
+func compareResourceNodes(a, b v1alpha1.ResourceNode) int {
+	return cmp.Or(
+		cmp.Compare(a.Group, b.Group),
+		cmp.Compare(a.Kind, b.Kind),
+		cmp.Compare(a.Namespace, b.Namespace),
+		cmp.Compare(a.Name, b.Name),
+	)
+}
+
+// flattenTree returns the rows of the given resource nodes, ordered so that children directly follow their parent
+func flattenTree(nodes []v1alpha1.ResourceNode, syncStatuses map[kube.ResourceKey]v1alpha1.SyncStatusCode, orphaned bool) []treeRow {
+	mapUIDToNode, mapParentToChild, parentNodes := parentChildInfo(nodes)
+	var roots []v1alpha1.ResourceNode
+	for uid := range parentNodes {
+		roots = append(roots, mapUIDToNode[uid])
+	}
+	slices.SortFunc(roots, compareResourceNodes)
+
+	var rows []treeRow
+	var visit func(prefix string, node v1alpha1.ResourceNode)
+	visit = func(prefix string, node v1alpha1.ResourceNode) {
+		rows = append(rows, treeRow{
+			prefix:     printPrefix(prefix),
+			node:       node,
+			syncStatus: syncStatuses[resourceNodeKey(node)],
+			orphaned:   orphaned,
+		})
+		var children []v1alpha1.ResourceNode
+		for _, uid := range mapParentToChild[node.UID] {
+			if child, ok := mapUIDToNode[uid]; ok {
+				children = append(children, child)
+			}
+		}
+		slices.SortFunc(children, compareResourceNodes)
+		for i, child := range children {
+			if i == len(children)-1 {
+				visit(prefix+lastElemPrefix, child)
+			} else {
+				visit(prefix+firstElemPrefix, child)
+			}
+		}
+	}
+	for _, root := range roots {
+		visit("", root)
+	}
+	return rows
+}
+
+func printTreeRows(out io.Writer, rows []treeRow) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "NAME\tSYNC\tHEALTH\tAGE\tMESSAGE\n")
+	for _, row := range rows {
+		_, _ = fmt.Fprintf(w, "%s\n", formatTreeRow(row))
+	}
+	_ = w.Flush()
+}
+
+func formatTreeRow(row treeRow) string {
+	healthStatus, message := row.health()
+	age := "<unknown>"
+	if row.node.CreatedAt != nil {
+		age = duration.HumanDuration(time.Since(row.node.CreatedAt.Time))
+	}
+	syncStatus := string(row.syncStatus)
+	if row.orphaned {
+		syncStatus = "Orphaned"
+	}
+	return fmt.Sprintf("%s%s\t%s\t%s\t%s\t%s", row.prefix, row.name(), syncStatus, healthStatus, age, message)
+}
+
+const (
+	keyUp       = "up"
+	keyDown     = "down"
+	keyPageUp   = "pgup"
+	keyPageDown = "pgdown"
+	keyEnter    = "enter"
+	keyEscape   = "esc"
+	keyCtrlC    = "ctrl+c"
+)
+
+// parseKeys converts the bytes read from a terminal in raw mode into key names. Printable characters are returned as
+// is, unsupported escape sequences are ignored.
+func parseKeys(data []byte) []string {
+	sequences := map[string]string{
+		"\x1b[A":  keyUp,
+		"\x1bOA":  keyUp,
+		"\x1b[B":  keyDown,
+		"\x1bOB":  keyDown,
+		"\x1b[5~": keyPageUp,
+		"\x1b[6~": keyPageDown,
+	}
+	var keys []string
+	for len(data) > 0 {
+		switch data[0] {
+		case '\x1b':
+			if len(data) == 1 {
+				keys = append(keys, keyEscape)
+				data = data[1:]
+				continue
+			}
+			matched := false
+			for seq, key := range sequences {
+				if strings.HasPrefix(string(data), seq) {
+					keys = append(keys, key)
+					data = data[len(seq):]
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				// skip an unsupported escape sequence up to its final byte
+				end := 1
+				for end < len(data) && (data[end] == '[' || data[end] == 'O' || data[end] == ';' || (data[end] >= '0' && data[end] <= '9')) {
+					end++
+				}
+				if end == 1 {
+					keys = append(keys, keyEscape)
+				} else if end < len(data) {
+					end++
+				}
+				data = data[end:]
+			}
+		case '\r', '\n':
+			keys = append(keys, keyEnter)
+			data = data[1:]
+		case 3:
+			keys = append(keys, keyCtrlC)
+			data = data[1:]
+		default:
+			keys = append(keys, string(data[0]))
+			data = data[1:]
+		}
+	}
+	return keys
+}
+
+type treeBrowserView int
+
+const (
+	// treeBrowserViewTree shows the resource tree
+	treeBrowserViewTree treeBrowserView = iota
+	// treeBrowserViewDetails shows the manifest, events or logs of a resource
+	treeBrowserViewDetails
+	// treeBrowserViewActions shows the actions available for a resource
+	treeBrowserViewActions
+)
+
+// treeBrowserConfirmation is an operation which requires a confirmation of the user
+type treeBrowserConfirmation struct {
+	prompt string
+	run    func() (string, error)
+}
+
+// treeBrowser holds the state of the interactive resource tree browser
+type treeBrowser struct {
+	ctx          context.Context
+	appIf        applicationpkg.ApplicationServiceClient
+	appName      string
+	appNs        string
+	project      string
+	showOrphaned bool
+
+	app    *v1alpha1.Application
+	tree   *v1alpha1.ApplicationTree
+	rows   []treeRow
+	cursor int
+	offset int
+
+	view    treeBrowserView
+	title   string
+	lines   []string
+	scroll  int
+	actions []*v1alpha1.ResourceAction
+
+	confirmation *treeBrowserConfirmation
+	message      string
+	quit         bool
+}
+
+func newTreeBrowser(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName string, appNs string, project string) *treeBrowser {
+	return &treeBrowser{ctx: ctx, appIf: appIf, appName: appName, appNs: appNs, project: project}
+}
+
+// refresh loads the application and its resource tree
+func (b *treeBrowser) refresh() error {
+	tree, err := b.appIf.ResourceTree(b.ctx, &applicationpkg.ResourcesQuery{
+		ApplicationName: &b.appName,
+		AppNamespace:    &b.appNs,
+		Project:         &b.project,
+	})
+	if err != nil {
+		return err
+	}
+	return b.setTree(tree)
+}
+
+// setTree updates the displayed tree, keeping the selected resource if it still exists
+func (b *treeBrowser) setTree(tree *v1alpha1.ApplicationTree) error {
+	app, err := b.appIf.Get(b.ctx, &applicationpkg.ApplicationQuery{
+		Name:         &b.appName,
+		AppNamespace: &b.appNs,
+		Projects:     getProjectsFilter(b.project),
+	})
+	if err != nil {
+		return err
+	}
+	var selected *v1alpha1.ResourceNode
+	if row := b.selected(); row != nil {
+		selected = &row.node
+	}
+
+	b.app = app
+	b.tree = tree
+	syncStatuses := make(map[kube.ResourceKey]v1alpha1.SyncStatusCode)
+	for _, res := range app.Status.Resources {
+		syncStatuses[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Status
+	}
+	b.rows = flattenTree(tree.Nodes, syncStatuses, false)
+	if b.showOrphaned {
+		b.rows = append(b.rows, flattenTree(tree.OrphanedNodes, syncStatuses, true)...)
+	}
+	if selected != nil {
+		for i, row := range b.rows {
+			if resourceNodeKey(row.node) == resourceNodeKey(*selected) {
+				b.cursor = i
+				break
+			}
+		}
+	}
+	b.cursor = min(b.cursor, max(len(b.rows)-1, 0))
+	return nil
+}
+
+func getProjectsFilter(project string) []string {
+	if project == "" {
+		return nil
+	}
+	return []string{project}
+}
+
+func (b *treeBrowser) selected() *treeRow {
+	if b.cursor < 0 || b.cursor >= len(b.rows) {
+		return nil
+	}
+	return &b.rows[b.cursor]
+}
+
+func (b *treeBrowser) showDetails(view treeBrowserView, title string, content string) {
+	b.view = view
+	b.title = title
+	b.lines = strings.Split(strings.TrimRight(content, "\n"), "\n")
+	b.scroll = 0
+}
+
+// handleKey updates the state of the browser according to the given key
+func (b *treeBrowser) handleKey(key string) {
+	if key == keyCtrlC {
+		b.quit = true
+		return
+	}
+	if b.confirmation != nil {
+		confirmation := b.confirmation
+		b.confirmation = nil
+		if key != "y" && key != "Y" {
+			b.message = "Cancelled"
+			return
+		}
+		msg, err := confirmation.run()
+		if err != nil {
+			b.message = "Error: " + err.Error()
+		} else {
+			b.message = msg
+		}
+		return
+	}
+	b.message = ""
+
+	if b.view != treeBrowserViewTree {
+		switch key {
+		case keyEscape, "q":
+			b.view = treeBrowserViewTree
+		case keyUp, "k":
+			b.scroll = max(b.scroll-1, 0)
+		case keyDown, "j":
+			b.scroll = min(b.scroll+1, max(len(b.lines)-1, 0))
+		case keyPageUp:
+			b.scroll = max(b.scroll-10, 0)
+		case keyPageDown:
+			b.scroll = min(b.scroll+10, max(len(b.lines)-1, 0))
+		default:
+			if b.view == treeBrowserViewActions {
+				if i, err := strconv.Atoi(key); err == nil && i >= 1 && i <= len(b.actions) {
+					b.confirmAction(b.actions[i-1].Name)
+				}
+			}
+		}
+		return
+	}
+
+	row := b.selected()
+	switch key {
+	case "q", keyEscape:
+		b.quit = true
+	case keyUp, "k":
+		b.cursor = max(b.cursor-1, 0)
+	case keyDown, "j":
+		b.cursor = min(b.cursor+1, max(len(b.rows)-1, 0))
+	case keyPageUp:
+		b.cursor = max(b.cursor-10, 0)
+	case keyPageDown:
+		b.cursor = min(b.cursor+10, max(len(b.rows)-1, 0))
+	case "r":
+		if err := b.refresh(); err != nil {
+			b.message = "Error: " + err.Error()
+		}
+	case keyEnter, "m":
+		if row != nil {
+			b.run(b.showManifest(row))
+		}
+	case "e":
+		if row != nil {
+			b.run(b.showEvents(row))
+		}
+	case "l":
+		if row != nil {
+			b.run(b.showLogs(row))
+		}
+	case "a":
+		if row != nil {
+			b.run(b.showActions(row))
+		}
+	case "s":
+		if row != nil {
+			b.confirmation = &treeBrowserConfirmation{
+				prompt: fmt.Sprintf("Sync %s? [y/n]", row.name()),
+				run:    func() (string, error) { return b.syncResource(row.node) },
+			}
+		}
+	case "d":
+		if row != nil {
+			b.confirmation = &treeBrowserConfirmation{
+				prompt: fmt.Sprintf("Delete %s? [y/n]", row.name()),
+				run:    func() (string, error) { return b.deleteResource(row.node) },
+			}
+		}
+	}
+}
+
+func (b *treeBrowser) run(err error) {
+	if err != nil {
+		b.message = "Error: " + err.Error()
+	}
+}
+
+func (b *treeBrowser) resourceRequest(node v1alpha1.ResourceNode) *applicationpkg.ApplicationResourceRequest {
+	return &applicationpkg.ApplicationResourceRequest{
+		Name:         &b.appName,
+		AppNamespace: &b.appNs,
+		Project:      &b.project,
+		Namespace:    new(node.Namespace),
+		ResourceName: new(node.Name),
+		Group:        new(node.Group),
+		Kind:         new(node.Kind),
+		Version:      new(node.Version),
+	}
+}
+
+func (b *treeBrowser) showManifest(row *treeRow) error {
+	res, err := b.appIf.GetResource(b.ctx, b.resourceRequest(row.node))
+	if err != nil {
+		return err
+	}
+	manifest, err := yaml.JSONToYAML([]byte(res.GetManifest()))
+	if err != nil {
+		return err
+	}
+	b.showDetails(treeBrowserViewDetails, "Manifest of "+row.name(), string(manifest))
+	return nil
+}
+
+func (b *treeBrowser) showEvents(row *treeRow) error {
+	eventList, err := b.appIf.ListResourceEvents(b.ctx, &applicationpkg.ApplicationResourceEventsQuery{
+		Name:              &b.appName,
+		AppNamespace:      &b.appNs,
+		Project:           &b.project,
+		ResourceNamespace: new(row.node.Namespace),
+		ResourceName:      new(row.node.Name),
+		ResourceUID:       new(row.node.UID),
+	})
+	if err != nil {
+		return err
+	}
+	items := eventList.Items
+	slices.SortFunc(items, func(a, b events.Event) int {
+		return a.LastTimestamp.Compare(b.LastTimestamp.Time)
+	})
+	var sb strings.Builder
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "LAST SEEN\tTYPE\tREASON\tMESSAGE\n")
+	for _, event := range items {
+		lastSeen := "<unknown>"
+		if !event.LastTimestamp.IsZero() {
+			lastSeen = duration.HumanDuration(time.Since(event.LastTimestamp.Time))
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", lastSeen, event.Type, event.Reason, event.Message)
+	}
+	_ = w.Flush()
+	if len(items) == 0 {
+		sb.WriteString("No events")
+	}
+	b.showDetails(treeBrowserViewDetails, "Events of "+row.name(), sb.String())
+	return nil
+}
+
+func (b *treeBrowser) showLogs(row *treeRow) error {
+	if row.node.Kind != "Pod" || row.node.Group != "" {
+		return stderrors.New("logs are only available for pods")
+	}
+	stream, err := b.appIf.PodLogs(b.ctx, &applicationpkg.ApplicationPodLogsQuery{
+		Name:         &b.appName,
+		AppNamespace: &b.appNs,
+		Project:      &b.project,
+		Namespace:    new(row.node.Namespace),
+		PodName:      new(row.node.Name),
+		TailLines:    new(int64(500)),
+		Follow:       new(false),
+	})
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	for {
+		entry, err := stream.Recv()
+		if stderrors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if entry.GetLast() {
+			break
+		}
+		sb.WriteString(entry.GetContent())
+		sb.WriteString("\n")
+	}
+	b.showDetails(treeBrowserViewDetails, "Logs of "+row.name(), sb.String())
+	return nil
+}
+
+func (b *treeBrowser) showActions(row *treeRow) error {
+	res, err := b.appIf.ListResourceActions(b.ctx, b.resourceRequest(row.node))
+	if err != nil {
+		return err
+	}
+	b.actions = nil
+	for _, action := range res.Actions {
+		if !action.Disabled {
+			b.actions = append(b.actions, action)
+		}
+	}
+	var sb strings.Builder
+	for i, action := range b.actions {
+		fmt.Fprintf(&sb, "%d. %s\n", i+1, action.Name)
+	}
+	if len(b.actions) == 0 {
+		sb.WriteString("No actions available")
+	}
+	b.showDetails(treeBrowserViewActions, "Actions of "+row.name()+" (press the number of an action to run it)", sb.String())
+	return nil
+}
+
+func (b *treeBrowser) confirmAction(action string) {
+	row := b.selected()
+	if row == nil {
+		return
+	}
+	node := row.node
+	b.confirmation = &treeBrowserConfirmation{
+		prompt: fmt.Sprintf("Run action %s on %s? [y/n]", action, row.name()),
+		run: func() (string, error) {
+			_, err := b.appIf.RunResourceActionV2(b.ctx, &applicationpkg.ResourceActionRunRequestV2{
+				Name:         &b.appName,
+				AppNamespace: &b.appNs,
+				Project:      &b.project,
+				Namespace:    new(node.Namespace),
+				ResourceName: new(node.Name),
+				Group:        new(node.Group),
+				Kind:         new(node.Kind),
+				Version:      new(node.Version),
+				Action:       new(action),
+			})
+			if err != nil {
+				return "", err
+			}
+			b.view = treeBrowserViewTree
+			return fmt.Sprintf("Action %s started on %s/%s", action, node.Kind, node.Name), nil
+		},
+	}
+}
+
+func (b *treeBrowser) syncResource(node v1alpha1.ResourceNode) (string, error) {
+	_, err := b.appIf.Sync(b.ctx, &applicationpkg.ApplicationSyncRequest{
+		Name:         &b.appName,
+		AppNamespace: &b.appNs,
+		Project:      &b.project,
+		Resources: []*v1alpha1.SyncOperationResource{{
+			Group:     node.Group,
+			Kind:      node.Kind,
+			Name:      node.Name,
+			Namespace: node.Namespace,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Sync of %s/%s started", node.Kind, node.Name), nil
+}
+
+func (b *treeBrowser) deleteResource(node v1alpha1.ResourceNode) (string, error) {
+	_, err := b.appIf.DeleteResource(b.ctx, &applicationpkg.ApplicationResourceDeleteRequest{
+		Name:         &b.appName,
+		AppNamespace: &b.appNs,
+		Project:      &b.project,
+		Namespace:    new(node.Namespace),
+		ResourceName: new(node.Name),
+		Group:        new(node.Group),
+		Kind:         new(node.Kind),
+		Version:      new(node.Version),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s/%s deleted", node.Kind, node.Name), nil
+}
+
+func truncateLine(line string, width int) string {
+	if width <= 0 {
+		return line
+	}
+	runes := []rune(line)
+	if len(runes) <= width {
+		return line
+	}
+	return string(runes[:width])
+}
+
+// render returns the lines to display on a terminal with the given size
+func (b *treeBrowser) render(width int, height int) []string {
+	var lines []string
+	header := "Application: " + b.appName
+	if b.app != nil {
+		header = fmt.Sprintf("Application: %s  Sync: %s  Health: %s", b.app.QualifiedName(), b.app.Status.Sync.Status, b.app.Status.Health.Status)
+	}
+	lines = append(lines, header, "")
+
+	footer := "enter:manifest e:events l:logs s:sync d:delete a:actions r:refresh q:quit"
+	if b.view != treeBrowserViewTree {
+		footer = "up/down/pgup/pgdown:scroll esc:back"
+	}
+	switch {
+	case b.confirmation != nil:
+		footer = b.confirmation.prompt
+	case b.message != "":
+		footer = b.message
+	}
+	bodyHeight := max(height-len(lines)-2, 1)
+
+	if b.view == treeBrowserViewTree {
+		var sb strings.Builder
+		w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprint(w, "  NAME\tSYNC\tHEALTH\tAGE\tMESSAGE\n")
+		for i, row := range b.rows {
+			marker := "  "
+			if i == b.cursor {
+				marker = "> "
+			}
+			_, _ = fmt.Fprintf(w, "%s%s\n", marker, formatTreeRow(row))
+		}
+		_ = w.Flush()
+		tableLines := strings.Split(strings.TrimRight(sb.String(), "\n"), "\n")
+		lines = append(lines, tableLines[0])
+		rows := tableLines[1:]
+		visible := max(bodyHeight-1, 1)
+		if b.cursor < b.offset {
+			b.offset = b.cursor
+		} else if b.cursor >= b.offset+visible {
+			b.offset = b.cursor - visible + 1
+		}
+		for i := b.offset; i < len(rows) && i < b.offset+visible; i++ {
+			line := truncateLine(rows[i], width)
+			if i == b.cursor {
+				// reverse video
+				line = "\x1b[7m" + line + "\x1b[0m"
+			}
+			lines = append(lines, line)
+		}
+	} else {
+		lines = append(lines, b.title)
+		visible := max(bodyHeight-1, 1)
+		for i := b.scroll; i < len(b.lines) && i < b.scroll+visible; i++ {
+			lines = append(lines, truncateLine(b.lines[i], width))
+		}
+	}
+	for len(lines) < height-1 {
+		lines = append(lines, "")
+	}
+	return append(lines, truncateLine(footer, width))
+}
+
+// watchTree sends the resource tree of the application on each change until the context is done
+func (b *treeBrowser) watchTree(ctx context.Context, trees chan<- *v1alpha1.ApplicationTree, errs chan<- error) {
+	for ctx.Err() == nil {
+		stream, err := b.appIf.WatchResourceTree(ctx, &applicationpkg.ResourcesQuery{
+			ApplicationName: &b.appName,
+			AppNamespace:    &b.appNs,
+			Project:         &b.project,
+		})
+		if err == nil {
+			for {
+				var tree *v1alpha1.ApplicationTree
+				tree, err = stream.Recv()
+				if err != nil {
+					break
+				}
+				select {
+				case trees <- tree:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case errs <- err:
+		case <-ctx.Done():
+			return
+		}
+		// the watch is retried since it is closed by the API server after a while
+		select {
+		case <-time.After(time.Second):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runTreeBrowser runs the interactive tree browser on the terminal until the user quits
+func runTreeBrowser(ctx context.Context, b *treeBrowser, in *os.File, out *os.File) error {
+	inFd := int(in.Fd())
+	outFd := int(out.Fd())
+	if !term.IsTerminal(inFd) || !term.IsTerminal(outFd) {
+		return stderrors.New("interactive mode requires a terminal")
+	}
+	if err := b.refresh(); err != nil {
+		return err
+	}
+	oldState, err := term.MakeRaw(inFd)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = term.Restore(inFd, oldState) }()
+	// switch to the alternate screen and hide the cursor
+	_, _ = fmt.Fprint(out, "\x1b[?1049h\x1b[?25l")
+	defer func() { _, _ = fmt.Fprint(out, "\x1b[?25h\x1b[?1049l") }()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	keys := make(chan string)
+	go func() {
+		buf := make([]byte, 64)
+		for {
+			n, err := in.Read(buf)
+			if err != nil {
+				close(keys)
+				return
+			}
+			for _, key := range parseKeys(buf[:n]) {
+				select {
+				case keys <- key:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	trees := make(chan *v1alpha1.ApplicationTree)
+	watchErrs := make(chan error)
+	go b.watchTree(ctx, trees, watchErrs)
+	// the terminal is redrawn periodically to handle resizes and refresh ages
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		width, height, err := term.GetSize(outFd)
+		if err != nil {
+			width, height = 120, 40
+		}
+		_, _ = fmt.Fprint(out, "\x1b[H\x1b[2J"+strings.Join(b.render(width, height), "\r\n"))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case key, ok := <-keys:
+			if !ok {
+				return nil
+			}
+			b.handleKey(key)
+			if b.quit {
+				return nil
+			}
+		case tree := <-trees:
+			if err := b.setTree(tree); err != nil {
+				b.message = "Error: " + err.Error()
+			}
+		case err := <-watchErrs:
+			b.message = "Error watching resource tree: " + err.Error()
+		case <-ticker.C:
+		}
+	}
+}
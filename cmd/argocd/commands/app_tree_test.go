@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	applicationmocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/application/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestResourceTree() *v1alpha1.ApplicationTree {
+	return &v1alpha1.ApplicationTree{
+		Nodes: []v1alpha1.ResourceNode{
+			{
+				ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-ui-1", UID: "rs"},
+				ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", UID: "deploy"}},
+			},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Service", Namespace: "default", Name: "guestbook-ui", UID: "svc"},
+				Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+			},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook-ui-1-a", UID: "pod-a"},
+				ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-ui-1", UID: "rs"}},
+				Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing, Message: "pulling image"},
+			},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", UID: "deploy"},
+				Health:      &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+			},
+			{
+				ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: "default", Name: "guestbook-ui-1-b", UID: "pod-b"},
+				ParentRefs:  []v1alpha1.ResourceRef{{Group: "apps", Kind: "ReplicaSet", Namespace: "default", Name: "guestbook-ui-1", UID: "rs"}},
+			},
+		},
+		OrphanedNodes: []v1alpha1.ResourceNode{
+			{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "leftover", UID: "cm"}},
+		},
+	}
+}
+
+func newTestTreeBrowser(t *testing.T) (*treeBrowser, *applicationmocks.ApplicationServiceClient) {
+	t.Helper()
+	appIf := applicationmocks.NewApplicationServiceClient(t)
+	appIf.EXPECT().Get(mock.Anything, mock.Anything).Return(&v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{
+			Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+			Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusProgressing},
+			Resources: []v1alpha1.ResourceStatus{
+				{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook-ui", Status: v1alpha1.SyncStatusCodeOutOfSync},
+				{Kind: "Service", Namespace: "default", Name: "guestbook-ui", Status: v1alpha1.SyncStatusCodeSynced},
+			},
+		},
+	}, nil).Maybe()
+	b := newTreeBrowser(t.Context(), appIf, "guestbook", "argocd", "")
+	require.NoError(t, b.setTree(newTestResourceTree()))
+	return b, appIf
+}
+
+func TestFlattenTree(t *testing.T) {
+	b, _ := newTestTreeBrowser(t)
+	var names []string
+	for _, row := range b.rows {
+		names = append(names, row.prefix+row.name())
+	}
+	assert.Equal(t, []string{
+		"Service/guestbook-ui",
+		"Deployment/guestbook-ui",
+		"└─ReplicaSet/guestbook-ui-1",
+		"  ├─Pod/guestbook-ui-1-a",
+		"  └─Pod/guestbook-ui-1-b",
+	}, names)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, b.rows[0].syncStatus)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, b.rows[1].syncStatus)
+
+	b.showOrphaned = true
+	require.NoError(t, b.setTree(newTestResourceTree()))
+	require.Len(t, b.rows, 6)
+	assert.True(t, b.rows[5].orphaned)
+
+	var out bytes.Buffer
+	printTreeRows(&out, b.rows)
+	assert.Contains(t, out.String(), "Service/guestbook-ui")
+	assert.Contains(t, out.String(), "ConfigMap/leftover")
+	assert.Contains(t, out.String(), "Orphaned")
+}
+
+func TestParseKeys(t *testing.T) {
+	assert.Equal(t, []string{keyUp, keyDown, "j", keyEnter, keyPageUp, keyPageDown, keyCtrlC}, parseKeys([]byte("\x1b[A\x1bOBj\r\x1b[5~\x1b[6~\x03")))
+	assert.Equal(t, []string{keyEscape}, parseKeys([]byte("\x1b")))
+	assert.Equal(t, []string{"q"}, parseKeys([]byte("\x1b[1;5Cq")), "unsupported sequences are ignored")
+}
+
+func TestTreeBrowser_Navigation(t *testing.T) {
+	b, _ := newTestTreeBrowser(t)
+	b.handleKey(keyDown)
+	b.handleKey("j")
+	assert.Equal(t, "ReplicaSet/guestbook-ui-1", b.selected().name())
+	b.handleKey(keyPageDown)
+	assert.Equal(t, 4, b.cursor, "cursor stays on the last row")
+	b.handleKey(keyUp)
+	assert.Equal(t, 3, b.cursor)
+
+	// the selection follows the resource when the tree changes
+	tree := newTestResourceTree()
+	tree.Nodes = append(tree.Nodes, v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: "default", Name: "config", UID: "config"}})
+	require.NoError(t, b.setTree(tree))
+	assert.Equal(t, "Pod/guestbook-ui-1-a", b.selected().name())
+
+	lines := b.render(80, 12)
+	require.Len(t, lines, 12)
+	assert.Contains(t, lines[0], "Sync: OutOfSync")
+	assert.Contains(t, lines[0], "Health: Progressing")
+	selected := ""
+	for _, line := range lines {
+		if strings.HasPrefix(line, "\x1b[7m") {
+			selected = line
+		}
+	}
+	assert.Contains(t, selected, "> ")
+	assert.Contains(t, selected, "Pod/guestbook-ui-1-a")
+
+	b.handleKey("q")
+	assert.True(t, b.quit)
+}
+
+func TestTreeBrowser_Manifest(t *testing.T) {
+	b, appIf := newTestTreeBrowser(t)
+	appIf.EXPECT().GetResource(mock.Anything, mock.MatchedBy(func(req *applicationpkg.ApplicationResourceRequest) bool {
+		return req.GetKind() == "Service" && req.GetResourceName() == "guestbook-ui" && req.GetVersion() == "v1"
+	})).Return(&applicationpkg.ApplicationResourceResponse{Manifest: new(`{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`)}, nil)
+
+	b.handleKey(keyEnter)
+	assert.Equal(t, treeBrowserViewDetails, b.view)
+	assert.Equal(t, "Manifest of Service/guestbook-ui", b.title)
+	assert.Equal(t, []string{"apiVersion: v1", "kind: Service", "metadata:", "  name: guestbook-ui"}, b.lines)
+
+	b.handleKey(keyDown)
+	assert.Equal(t, 1, b.scroll)
+	b.handleKey(keyEscape)
+	assert.Equal(t, treeBrowserViewTree, b.view)
+	assert.False(t, b.quit)
+}
+
+func TestTreeBrowser_Logs(t *testing.T) {
+	b, _ := newTestTreeBrowser(t)
+	b.handleKey("l")
+	assert.Equal(t, treeBrowserViewTree, b.view)
+	assert.Equal(t, "Error: logs are only available for pods", b.message)
+}
+
+func TestTreeBrowser_Sync(t *testing.T) {
+	b, appIf := newTestTreeBrowser(t)
+	b.handleKey(keyDown)
+
+	b.handleKey("s")
+	require.NotNil(t, b.confirmation)
+	assert.Equal(t, "Sync Deployment/guestbook-ui? [y/n]", b.render(80, 10)[9])
+	b.handleKey("n")
+	assert.Nil(t, b.confirmation)
+	assert.Equal(t, "Cancelled", b.message)
+
+	appIf.EXPECT().Sync(mock.Anything, mock.MatchedBy(func(req *applicationpkg.ApplicationSyncRequest) bool {
+		return len(req.Resources) == 1 && req.Resources[0].Kind == "Deployment" && req.Resources[0].Name == "guestbook-ui"
+	})).Return(&v1alpha1.Application{}, nil).Once()
+	b.handleKey("s")
+	b.handleKey("y")
+	assert.Equal(t, "Sync of Deployment/guestbook-ui started", b.message)
+}
+
+func TestTreeBrowser_Actions(t *testing.T) {
+	b, appIf := newTestTreeBrowser(t)
+	b.handleKey(keyDown)
+	appIf.EXPECT().ListResourceActions(mock.Anything, mock.Anything).Return(&applicationpkg.ResourceActionsListResponse{
+		Actions: []*v1alpha1.ResourceAction{{Name: "restart"}, {Name: "pause", Disabled: true}, {Name: "resume"}},
+	}, nil)
+
+	b.handleKey("a")
+	assert.Equal(t, treeBrowserViewActions, b.view)
+	assert.Equal(t, []string{"1. restart", "2. resume"}, b.lines)
+
+	appIf.EXPECT().RunResourceActionV2(mock.Anything, mock.MatchedBy(func(req *applicationpkg.ResourceActionRunRequestV2) bool {
+		return req.GetAction() == "resume" && req.GetKind() == "Deployment"
+	})).Return(&applicationpkg.ApplicationResponse{}, nil).Once()
+	b.handleKey("2")
+	require.NotNil(t, b.confirmation)
+	b.handleKey("y")
+	assert.Equal(t, "Action resume started on Deployment/guestbook-ui", b.message)
+	assert.Equal(t, treeBrowserViewTree, b.view)
+}
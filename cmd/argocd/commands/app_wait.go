@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"bytes"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+)
+
+// Exit codes returned by `argocd app wait --detailed-exit-code` for each class of failure. Any other error results
+// in the generic exit code of the CLI.
+const (
+	waitExitCodeTimeout         = 2
+	waitExitCodeDegraded        = 3
+	waitExitCodeOperationFailed = 4
+)
+
+var (
+	// errWaitTimeout is returned when an application does not match the expected conditions before the timeout
+	errWaitTimeout = stderrors.New("timed out")
+	// errWaitDegraded is returned when the health of an application degrades while waiting for it to become healthy
+	errWaitDegraded = stderrors.New("health degraded")
+)
+
+// waitCondition is a condition passed to `argocd app wait --for`. The condition is met when the JSONPath expression
+// evaluated against the application matches the expected value or, if no value is expected, is not empty.
+type waitCondition struct {
+	expr     string
+	parser   *jsonpath.JSONPath
+	value    string
+	hasValue bool
+}
+
+// waitConditionAliases maps the short form of a condition to the JSONPath of the application field it checks
+var waitConditionAliases = map[string]string{
+	"health":    "{.status.health.status}",
+	"sync":      "{.status.sync.status}",
+	"operation": "{.status.operationState.phase}",
+}
+
+// parseWaitConditions parses the values of `argocd app wait --for`. Conditions are one of `health=STATUS`,
+// `sync=STATUS`, `operation=PHASE`, `jsonpath={EXPR}` or `jsonpath={EXPR}=VALUE`. The `delete` condition sets the
+// delete watch option instead.
+func parseWaitConditions(exprs []string, watch *watchOpts) ([]*waitCondition, error) {
+	var conditions []*waitCondition
+	for _, expr := range exprs {
+		if expr == "delete" {
+			watch.delete = true
+			continue
+		}
+		condition, err := parseWaitCondition(expr)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+func parseWaitCondition(expr string) (*waitCondition, error) {
+	name, arg, found := strings.Cut(expr, "=")
+	if !found || arg == "" {
+		return nil, fmt.Errorf("invalid condition %q: expected one of health=STATUS, sync=STATUS, operation=PHASE, jsonpath={EXPR}[=VALUE] or delete", expr)
+	}
+	var path, value string
+	hasValue := true
+	if alias, ok := waitConditionAliases[name]; ok {
+		path, value = alias, arg
+	} else if name == "jsonpath" {
+		end := strings.LastIndex(arg, "}")
+		if !strings.HasPrefix(arg, "{") || end < 0 {
+			return nil, fmt.Errorf("invalid condition %q: JSONPath expression must be enclosed in curly braces", expr)
+		}
+		path = arg[:end+1]
+		switch rest := arg[end+1:]; {
+		case rest == "":
+			hasValue = false
+		case strings.HasPrefix(rest, "="):
+			value = strings.TrimPrefix(rest, "=")
+		default:
+			return nil, fmt.Errorf("invalid condition %q: expected '=' after JSONPath expression", expr)
+		}
+	} else {
+		return nil, fmt.Errorf("invalid condition %q: unknown condition type %q", expr, name)
+	}
+	parser := jsonpath.New(expr).AllowMissingKeys(true)
+	if err := parser.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid condition %q: %w", expr, err)
+	}
+	return &waitCondition{expr: expr, parser: parser, value: value, hasValue: hasValue}, nil
+}
+
+// matches returns whether the application meets the condition. Expressions which cannot be evaluated against the
+// application are not met.
+func (c *waitCondition) matches(app *argoappv1.Application) bool {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return false
+	}
+	var buf bytes.Buffer
+	if err := c.parser.Execute(&buf, obj); err != nil {
+		return false
+	}
+	if !c.hasValue {
+		return buf.Len() > 0
+	}
+	return buf.String() == c.value
+}
+
+// checkWaitConditions returns whether the application meets all conditions
+func checkWaitConditions(app *argoappv1.Application, conditions []*waitCondition) bool {
+	for _, condition := range conditions {
+		if !condition.matches(app) {
+			return false
+		}
+	}
+	return true
+}
+
+// waitExitCode returns the exit code of `argocd app wait --detailed-exit-code` for the given wait error
+func waitExitCode(err error) int {
+	switch {
+	case stderrors.Is(err, errWaitTimeout) || isContextCanceledErr(err):
+		return waitExitCodeTimeout
+	case stderrors.Is(err, errWaitDegraded):
+		return waitExitCodeDegraded
+	}
+	return 0
+}
+
+// exitOnWaitError exits with the exit code of the failure class of the wait error if detailed exit codes are
+// requested, or the generic exit code otherwise
+func exitOnWaitError(err error, detailedExitCode bool) {
+	if code := waitExitCode(err); detailedExitCode && code != 0 {
+		errors.Fatal(code, err)
+	}
+	errors.CheckError(err)
+}
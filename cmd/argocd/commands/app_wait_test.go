@@ -0,0 +1,73 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestParseWaitConditions(t *testing.T) {
+	watch := watchOpts{}
+	conditions, err := parseWaitConditions([]string{"health=Healthy", "jsonpath={.status.operationState.phase}=Succeeded", "jsonpath={.status.reconciledAt}", "delete"}, &watch)
+	require.NoError(t, err)
+	assert.True(t, watch.delete)
+	require.Len(t, conditions, 3)
+	assert.Equal(t, "Healthy", conditions[0].value)
+	assert.Equal(t, "Succeeded", conditions[1].value)
+	assert.False(t, conditions[2].hasValue)
+
+	for _, expr := range []string{"health", "health=", "ready=true", "jsonpath=.status.health.status", "jsonpath={.status.health.status}Healthy", "jsonpath={.status[}=x"} {
+		_, err := parseWaitConditions([]string{expr}, &watchOpts{})
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCheckWaitConditions(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+		Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+		Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+	}}
+	parse := func(exprs ...string) []*waitCondition {
+		conditions, err := parseWaitConditions(exprs, &watchOpts{})
+		require.NoError(t, err)
+		return conditions
+	}
+
+	assert.True(t, checkWaitConditions(app, nil))
+	assert.True(t, checkWaitConditions(app, parse("health=Healthy", "sync=Synced")))
+	assert.False(t, checkWaitConditions(app, parse("health=Healthy", "sync=OutOfSync")))
+	assert.False(t, checkWaitConditions(app, parse("jsonpath={.status.operationState.phase}")))
+	assert.False(t, checkWaitConditions(app, parse("operation=Succeeded")))
+
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded}
+	assert.True(t, checkWaitConditions(app, parse("jsonpath={.status.operationState.phase}")))
+	assert.True(t, checkWaitConditions(app, parse("operation=Succeeded")))
+}
+
+func TestCheckAppWaitConditions_WithConditions(t *testing.T) {
+	app := &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+		Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+		Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync},
+	}}
+	conditions, err := parseWaitConditions([]string{"health=Healthy"}, &watchOpts{})
+	require.NoError(t, err)
+
+	ready, _ := checkAppWaitConditions(app, getWatchOpts(watchOpts{conditions: conditions}), nil)
+	assert.True(t, ready, "conditions replace the default sync and health checks")
+
+	ready, _ = checkAppWaitConditions(app, watchOpts{sync: true, conditions: conditions}, nil)
+	assert.False(t, ready)
+}
+
+func TestWaitExitCode(t *testing.T) {
+	assert.Equal(t, waitExitCodeTimeout, waitExitCode(fmt.Errorf("%w (%ds) waiting for app", errWaitTimeout, 10)))
+	assert.Equal(t, waitExitCodeDegraded, waitExitCode(fmt.Errorf("health state has transitioned: %w", errWaitDegraded)))
+	assert.Equal(t, 0, waitExitCode(stderrors.New("permission denied")))
+}
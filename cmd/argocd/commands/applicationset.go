@@ -248,6 +248,7 @@ func NewApplicationSetCreateCommand(clientOpts *argocdclient.ClientOptions) *cob
 func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
 	var appSetNamespace string
+	var dryRun bool
 	command := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate apps of ApplicationSet rendered templates",
@@ -257,6 +258,9 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 
 	# Generate apps of ApplicationSet rendered templates in a specific namespace
 	argocd appset generate --appset-namespace=APPSET_NAMESPACE <filename or URL> (<filename or URL>...)
+
+	# Show the changes the ApplicationSet controller would make to the existing apps of the ApplicationSet
+	argocd appset generate --dry-run <filename or URL>
 `),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -289,10 +293,27 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 
 			req := applicationset.ApplicationSetGenerateRequest{
 				ApplicationSet: appset,
+				DryRun:         dryRun,
 			}
 			resp, err := appIf.Generate(ctx, &req)
 			errors.CheckError(err)
 
+			if dryRun {
+				switch output {
+				case "yaml", "json":
+					var diffs []any
+					for _, diff := range resp.Diffs {
+						diffs = append(diffs, diff)
+					}
+					cobra.CheckErr(admin.PrintResources(output, os.Stdout, diffs...))
+				case "wide", "":
+					printApplicationSetDiffs(resp.Diffs)
+				default:
+					errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+				}
+				return
+			}
+
 			var appsList []arogappsetv1.Application
 			for i := range resp.Applications {
 				appsList = append(appsList, *resp.Applications[i])
@@ -319,9 +340,27 @@ func NewApplicationSetGenerateCommand(clientOpts *argocdclient.ClientOptions) *c
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
 	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace used for generating Applications (ignored when provided YAML file has namespace set in metadata)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Compare the generated Applications with the existing Applications of the ApplicationSet and print the changes")
 	return command
 }
 
+// printApplicationSetDiffs prints the actions the ApplicationSet controller would take, followed by the diffs of the
+// changed applications
+func printApplicationSetDiffs(diffs []*applicationset.ApplicationSetApplicationDiff) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAME\tACTION\n")
+	for _, diff := range diffs {
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", diff.Name, diff.Action)
+	}
+	_ = w.Flush()
+	for _, diff := range diffs {
+		if diff.Diff == "" {
+			continue
+		}
+		fmt.Printf("\n===== %s %s =====\n%s", diff.Action, diff.Name, diff.Diff)
+	}
+}
+
 // NewApplicationSetListCommand returns a new instance of an `argocd appset list` command
 func NewApplicationSetListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/watch"
 
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
@@ -117,6 +118,25 @@ func TestPrintApplicationSetNames(t *testing.T) {
 	require.Equalf(t, output, expectation, "Incorrect print params output %q, should be %q", output, expectation)
 }
 
+func TestPrintApplicationSetDiffs(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printApplicationSetDiffs([]*applicationset.ApplicationSetApplicationDiff{
+			{Name: "app1", Action: "create", Diff: "+name: app1\n"},
+			{Name: "app2", Action: "unchanged"},
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	expectation := `NAME  ACTION
+app1  create
+app2  unchanged
+
+===== create app1 =====
++name: app1
+`
+	assert.Equal(t, expectation, output)
+}
+
 func TestPrintApplicationSetTable(t *testing.T) {
 	output, err := captureOutput(func() error {
 		app := &v1alpha1.ApplicationSet{
@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"encoding/csv"
 	"fmt"
 	"net/http"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -42,6 +44,8 @@ const (
 	clusterFieldLabel = "labels"
 	// cluster field is 'annotations'
 	clusterFieldAnnotation = "annotations"
+	// cluster field is 'cordoned'
+	clusterFieldCordoned = "cordoned"
 	// indicates managing all namespaces
 	allNamespaces = "*"
 )
@@ -78,6 +82,9 @@ func NewClusterCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clientc
 	command.AddCommand(NewClusterRemoveCommand(clientOpts, pathOpts))
 	command.AddCommand(NewClusterRotateAuthCommand(clientOpts))
 	command.AddCommand(NewClusterSetCommand(clientOpts))
+	command.AddCommand(NewClusterDrainCommand(clientOpts))
+	command.AddCommand(NewClusterUncordonCommand(clientOpts))
+	command.AddCommand(NewClusterInventoryCommand(clientOpts))
 	return command
 }
 
@@ -119,6 +126,8 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 			errors.CheckError(err)
 			managerBearerToken := ""
 			var awsAuthConf *argoappv1.AWSAuthConfig
+			var gcpAuthConf *argoappv1.GCPAuthConfig
+			var azureAuthConf *argoappv1.AzureAuthConfig
 			var execProviderConf *argoappv1.ExecProviderConfig
 			switch {
 			case clusterOpts.AwsClusterName != "":
@@ -127,6 +136,10 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 					RoleARN:     clusterOpts.AwsRoleArn,
 					Profile:     clusterOpts.AwsProfile,
 				}
+			case clusterOpts.GCPAuth:
+				gcpAuthConf = &argoappv1.GCPAuthConfig{}
+			case clusterOpts.AzureAuth:
+				azureAuthConf = &argoappv1.AzureAuthConfig{ServerApplicationID: clusterOpts.AzureServerAppID}
 			case clusterOpts.ExecProviderCommand != "":
 				execProviderConf = &argoappv1.ExecProviderConfig{
 					Command:     clusterOpts.ExecProviderCommand,
@@ -167,6 +180,9 @@ func NewClusterAddCommand(clientOpts *argocdclient.ClientOptions, pathOpts *clie
 				contextName = clusterOpts.Name
 			}
 			clst := cmdutil.NewCluster(contextName, clusterOpts.Namespaces, clusterOpts.ClusterResources, conf, managerBearerToken, awsAuthConf, execProviderConf, labelsMap, annotationsMap)
+			clst.NamespacedCache = clusterOpts.NamespacedCache
+			clst.Config.GCPAuthConfig = gcpAuthConf
+			clst.Config.AzureAuthConfig = azureAuthConf
 			// If --server-proxy-url was explicitly provided, override the proxy that the ArgoCD server will use to
 			// reach this cluster.  An explicit empty string means "no proxy", which is the common case when the local
 			// machine needs a proxy but the two clusters can reach each other directly.
@@ -411,6 +427,8 @@ func printClusterDetails(clusters []argoappv1.Cluster) {
 		fmt.Printf("  Basic authentication:  %v\n", cluster.Config.Username != "")
 		fmt.Printf("  oAuth authentication:  %v\n", cluster.Config.BearerToken != "")
 		fmt.Printf("  AWS authentication:    %v\n", cluster.Config.AWSAuthConfig != nil)
+		fmt.Printf("  GCP authentication:    %v\n", cluster.Config.GCPAuthConfig != nil)
+		fmt.Printf("  Azure authentication:  %v\n", cluster.Config.AzureAuthConfig != nil)
 		fmt.Printf("\nDisable compression: %v\n", cluster.Config.DisableCompression)
 		fmt.Printf("\nUse proxy: %v\n", cluster.Config.ProxyUrl != "")
 		fmt.Println()
@@ -442,10 +460,10 @@ argocd cluster rm cluster-name`,
 				var lowercaseAnswer string
 				if !noPrompt {
 					if numOfClusters == 1 {
-						lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n] ")
+						lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Clusters which are still the destination of applications must be drained first.[y/n] ")
 					} else {
 						if !isConfirmAll {
-							lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Any Apps deploying to this cluster will go to health status Unknown.[y/n/A] where 'A' is to remove all specified clusters without prompting. Any Apps deploying to these clusters will go to health status Unknown. ")
+							lowercaseAnswer = cli.AskToProceedS("Are you sure you want to remove '" + clusterSelector + "'? Clusters which are still the destination of applications must be drained first.[y/n/A] where 'A' is to remove all specified clusters without prompting. ")
 							if lowercaseAnswer == "a" {
 								lowercaseAnswer = "y"
 								isConfirmAll = true
@@ -513,6 +531,15 @@ func getQueryBySelector(clusterSelector string) *clusterpkg.ClusterQuery {
 	return &query
 }
 
+// getClusterIDBySelector returns the ID of the cluster given by a server URL or a name
+func getClusterIDBySelector(clusterSelector string) *clusterpkg.ClusterID {
+	query := getQueryBySelector(clusterSelector)
+	if query.Name != "" {
+		return &clusterpkg.ClusterID{Type: clusterIdTypeName, Value: query.Name}
+	}
+	return &clusterpkg.ClusterID{Value: query.Server}
+}
+
 // Print list of cluster servers
 func printClusterServers(clusters []argoappv1.Cluster) {
 	for _, c := range clusters {
@@ -594,3 +621,184 @@ argocd cluster rotate-auth cluster-name`,
 	}
 	return command
 }
+
+// NewClusterDrainCommand returns a new instance of an `argocd cluster drain` command
+func NewClusterDrainCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		destination      string
+		namespaceMapping []string
+		dryRun           bool
+	)
+	command := &cobra.Command{
+		Use:   "drain SERVER/NAME",
+		Short: "Cordon a cluster and migrate its applications to another cluster",
+		Long:  "Cordon a cluster, so that no application is synced to it anymore, and list or migrate the applications deployed to it. A cluster can only be removed once no application is deployed to it anymore.",
+		Example: `  # Cordon a cluster and list the applications deployed to it
+  argocd cluster drain https://12.34.567.89
+
+  # Cordon a cluster and migrate its applications to another cluster
+  argocd cluster drain old-cluster --to new-cluster
+
+  # Migrate the applications deployed to the namespace 'guestbook' to the namespace 'guestbook-v2' of the other cluster
+  argocd cluster drain old-cluster --to new-cluster --namespace-mapping guestbook=guestbook-v2
+
+  # List the applications which would be migrated, without cordoning the cluster
+  argocd cluster drain old-cluster --to new-cluster --dry-run`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			namespaces, err := label.Parse(namespaceMapping)
+			errors.CheckError(err)
+			if len(namespaces) > 0 && destination == "" {
+				log.Fatal("--namespace-mapping requires --to")
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer utilio.Close(conn)
+
+			req := clusterpkg.ClusterDrainRequest{
+				Id:         getClusterIDBySelector(args[0]),
+				Namespaces: namespaces,
+				DryRun:     dryRun,
+			}
+			if destination != "" {
+				destinationQuery := getQueryBySelector(destination)
+				req.DestinationServer = destinationQuery.Server
+				req.DestinationName = destinationQuery.Name
+			}
+			res, err := clusterIf.Drain(ctx, &req)
+			errors.CheckError(err)
+
+			if !dryRun {
+				fmt.Printf("Cluster '%s' cordoned\n", args[0])
+			}
+			for _, app := range res.Migrated {
+				if dryRun {
+					fmt.Printf("Application '%s' would be migrated to cluster '%s'\n", app, destination)
+				} else {
+					fmt.Printf("Application '%s' migrated to cluster '%s'\n", app, destination)
+				}
+			}
+			if len(res.Applications) > 0 {
+				fmt.Printf("%d application(s) still deployed to cluster '%s':\n", len(res.Applications), args[0])
+				for _, app := range res.Applications {
+					fmt.Println(app)
+				}
+			}
+		},
+	}
+	command.Flags().StringVar(&destination, "to", "", "Server URL or name of the cluster to migrate the applications to")
+	command.Flags().StringArrayVar(&namespaceMapping, "namespace-mapping", nil, "Map the destination namespace of the migrated applications to another namespace (e.g. --namespace-mapping old=new)")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "List the applications which would be migrated, without cordoning the cluster or migrating them")
+	return command
+}
+
+// NewClusterUncordonCommand returns a new instance of an `argocd cluster uncordon` command
+func NewClusterUncordonCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "uncordon SERVER/NAME",
+		Short: "Uncordon a cluster, so that applications are synced to it again",
+		Example: `argocd cluster uncordon https://12.34.567.89
+argocd cluster uncordon cluster-name`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err := clusterIf.Update(ctx, &clusterpkg.ClusterUpdateRequest{
+				Cluster:       &argoappv1.Cluster{Cordoned: false},
+				UpdatedFields: []string{clusterFieldCordoned},
+				Id:            getClusterIDBySelector(args[0]),
+			})
+			errors.CheckError(err)
+			fmt.Printf("Cluster '%s' uncordoned\n", args[0])
+		},
+	}
+	return command
+}
+
+// NewClusterInventoryCommand returns a new instance of an `argocd cluster inventory` command
+func NewClusterInventoryCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "inventory [SERVER/NAME]",
+		Short: "List the kinds and the number of the resources managed by applications in clusters",
+		Example: `  # List the resources managed by applications in all clusters
+  argocd cluster inventory
+
+  # List the resources managed by applications in a cluster
+  argocd cluster inventory https://12.34.567.89
+
+  # Export the resources managed by applications in all clusters as CSV
+  argocd cluster inventory -o csv > inventory.csv`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) > 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, clusterIf := headless.NewClientOrDie(clientOpts, c).NewClusterClientOrDie()
+			defer utilio.Close(conn)
+
+			query := clusterpkg.ClusterInventoryQuery{}
+			if len(args) == 1 {
+				query.Id = getClusterIDBySelector(args[0])
+			}
+			inventory, err := clusterIf.Inventory(ctx, &query)
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(inventory.Clusters, output, false)
+				errors.CheckError(err)
+			case "csv":
+				err := printClusterInventoryCSV(inventory.Clusters)
+				errors.CheckError(err)
+			case "wide", "":
+				printClusterInventoryTable(inventory.Clusters)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|csv")
+	return command
+}
+
+// printClusterInventoryTable prints the resources managed by applications in clusters as a table
+func printClusterInventoryTable(clusters []*clusterpkg.ClusterInventory) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "SERVER\tNAME\tGROUP\tKIND\tCOUNT\tAPPLICATIONS\n")
+	for _, c := range clusters {
+		for _, item := range c.Items {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\n", c.Server, c.Name, item.Group, item.Kind, item.Count, item.Applications)
+		}
+	}
+	_ = w.Flush()
+}
+
+// printClusterInventoryCSV prints the resources managed by applications in clusters as CSV
+func printClusterInventoryCSV(clusters []*clusterpkg.ClusterInventory) error {
+	w := csv.NewWriter(os.Stdout)
+	if err := w.Write([]string{"server", "name", "group", "kind", "count", "applications"}); err != nil {
+		return err
+	}
+	for _, c := range clusters {
+		for _, item := range c.Items {
+			record := []string{c.Server, c.Name, item.Group, item.Kind, strconv.FormatInt(item.Count, 10), strconv.FormatInt(item.Applications, 10)}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
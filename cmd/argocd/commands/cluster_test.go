@@ -11,6 +11,7 @@ import (
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
@@ -54,6 +55,24 @@ func Test_printClusterTable(_ *testing.T) {
 	})
 }
 
+func Test_printClusterInventoryCSV(t *testing.T) {
+	out, err := captureOutput(func() error {
+		return printClusterInventoryCSV([]*clusterpkg.ClusterInventory{{
+			Server: "https://my-server",
+			Name:   "my-name",
+			Items: []*clusterpkg.ClusterInventoryItem{
+				{Group: "", Kind: "ConfigMap", Count: 3, Applications: 2},
+				{Group: "apps", Kind: "Deployment", Count: 1, Applications: 1},
+			},
+		}})
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `server,name,group,kind,count,applications
+https://my-server,my-name,,ConfigMap,3,2
+https://my-server,my-name,apps,Deployment,1,1
+`, out)
+}
+
 func Test_getRestConfig(t *testing.T) {
 	type args struct {
 		pathOpts *clientcmd.PathOptions
@@ -211,6 +211,11 @@ compdef _argocd argocd
 Optionally, also add the following, in case you are getting errors involving compdef & compinit such as command not found: compdef:
 autoload -Uz compinit
 compinit 
+
+Application, project and cluster names, and the resource kinds of applications, are completed with the values returned
+by the Argo CD API. They are cached for 30 seconds, which can be changed with the ARGOCD_COMPLETION_CACHE_TTL environment
+variable (0 disables the cache), and are not completed if the API does not respond within 5 seconds, which can be changed
+with the ARGOCD_COMPLETION_TIMEOUT environment variable.
 `,
 		Example: `# For bash
 $ source <(argocd completion bash)
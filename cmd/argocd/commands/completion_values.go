@@ -0,0 +1,351 @@
+package commands
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/common"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/env"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	defaultCompletionTimeout  = 5 * time.Second
+	defaultCompletionCacheTTL = 30 * time.Second
+)
+
+// completionLister lists the values of a kind of argument from the API
+type completionLister func(ctx context.Context, client argocdclient.Client, args []string) ([]string, error)
+
+// completionCache caches the values completed in the shell on disk, so that completing several arguments in a row
+// does not query the API every time
+type completionCache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+func newCompletionCache() *completionCache {
+	ttl := env.ParseDurationFromEnv(common.EnvCompletionCacheTTL, defaultCompletionCacheTTL, 0, 24*time.Hour)
+	cacheDir, err := os.UserCacheDir()
+	if err != nil || ttl == 0 {
+		return &completionCache{now: time.Now}
+	}
+	return &completionCache{dir: filepath.Join(cacheDir, "argocd", "completion"), ttl: ttl, now: time.Now}
+}
+
+func (c *completionCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// get returns the cached values of the given key, if they have not expired
+func (c *completionCache) get(key string) ([]string, bool) {
+	if c.dir == "" {
+		return nil, false
+	}
+	path := c.path(key)
+	info, err := os.Stat(path)
+	if err != nil || c.now().Sub(info.ModTime()) > c.ttl {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, false
+	}
+	return values, true
+}
+
+func (c *completionCache) set(key string, values []string) error {
+	if c.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return fmt.Errorf("error creating completion cache directory: %w", err)
+	}
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(c.path(key), data, 0o600)
+}
+
+// completionCacheKey identifies the values of a kind of argument for the Argo CD instance the CLI talks to
+func completionCacheKey(clientOpts *argocdclient.ClientOptions, kind string, args []string) string {
+	parts := []string{kind, clientOpts.ConfigPath, clientOpts.Context, clientOpts.ServerAddr, strconv.FormatBool(clientOpts.Core)}
+	if clientOpts.KubeOverrides != nil {
+		parts = append(parts, clientOpts.KubeOverrides.CurrentContext)
+	}
+	return strings.Join(append(parts, args...), "\x00")
+}
+
+// fetchWithTimeout returns the result of the given function, or an error if it does not return within the timeout. The
+// result is awaited in a separate goroutine, so that a connection which does not honor the context cannot block the
+// shell.
+func fetchWithTimeout(ctx context.Context, timeout time.Duration, fetch func(ctx context.Context) ([]string, error)) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	type result struct {
+		values []string
+		err    error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		values, err := fetch(ctx)
+		resCh <- result{values: values, err: err}
+	}()
+	select {
+	case res := <-resCh:
+		return res.values, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// completeFromAPI returns a completion function which completes the values returned by the given lister. The values are
+// cached, and the shell gets no values if the API cannot be queried within the completion timeout.
+func completeFromAPI(clientOpts *argocdclient.ClientOptions, kind string, list completionLister, keyArgs func(args []string) []string) cobra.CompletionFunc {
+	return func(c *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		listArgs := keyArgs(args)
+		if listArgs == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		cache := newCompletionCache()
+		key := completionCacheKey(clientOpts, kind, listArgs)
+		values, ok := cache.get(key)
+		if !ok {
+			ctx := c.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			timeout := env.ParseDurationFromEnv(common.EnvCompletionTimeout, defaultCompletionTimeout, 0, time.Minute)
+			var err error
+			values, err = fetchWithTimeout(ctx, timeout, func(ctx context.Context) ([]string, error) {
+				client, err := headless.NewClient(ctx, clientOpts, c)
+				if err != nil {
+					return nil, err
+				}
+				return list(ctx, client, listArgs)
+			})
+			if err != nil {
+				cobra.CompDebugln(fmt.Sprintf("error listing %s: %v", kind, err), false)
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			if err := cache.set(key, values); err != nil {
+				cobra.CompDebugln(fmt.Sprintf("error caching %s: %v", kind, err), false)
+			}
+		}
+		return filterCompletions(values, toComplete, args), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// filterCompletions returns the values starting with the word being completed which are not already given as arguments
+func filterCompletions(values []string, toComplete string, args []string) []cobra.Completion {
+	var res []cobra.Completion
+	for _, value := range values {
+		if strings.HasPrefix(value, toComplete) && !slices.Contains(args, value) {
+			res = append(res, value)
+		}
+	}
+	return res
+}
+
+func listApplicationNames(ctx context.Context, client argocdclient.Client, _ []string) ([]string, error) {
+	conn, appIf, err := client.NewApplicationClient()
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(conn)
+	apps, err := appIf.List(ctx, &applicationpkg.ApplicationQuery{})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(apps.Items))
+	for _, app := range apps.Items {
+		names = append(names, app.QualifiedName())
+	}
+	return names, nil
+}
+
+// listApplicationResourceKinds lists the kinds of the resources of the application given as first argument
+func listApplicationResourceKinds(ctx context.Context, client argocdclient.Client, args []string) ([]string, error) {
+	conn, appIf, err := client.NewApplicationClient()
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(conn)
+	appName, appNs := argo.ParseFromQualifiedName(args[0], "")
+	app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, AppNamespace: &appNs})
+	if err != nil {
+		return nil, err
+	}
+	var kinds []string
+	for _, res := range app.Status.Resources {
+		if !slices.Contains(kinds, res.Kind) {
+			kinds = append(kinds, res.Kind)
+		}
+	}
+	slices.Sort(kinds)
+	return kinds, nil
+}
+
+func listProjectNames(ctx context.Context, client argocdclient.Client, _ []string) ([]string, error) {
+	conn, projIf, err := client.NewProjectClient()
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(conn)
+	projects, err := projIf.List(ctx, &projectpkg.ProjectQuery{})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(projects.Items))
+	for _, proj := range projects.Items {
+		names = append(names, proj.Name)
+	}
+	return names, nil
+}
+
+func listClusters(ctx context.Context, client argocdclient.Client) ([]string, []string, error) {
+	conn, clusterIf, err := client.NewClusterClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer utilio.Close(conn)
+	clusters, err := clusterIf.List(ctx, &clusterpkg.ClusterQuery{})
+	if err != nil {
+		return nil, nil, err
+	}
+	var names, servers []string
+	for _, cluster := range clusters.Items {
+		if cluster.Name != "" {
+			names = append(names, cluster.Name)
+		}
+		servers = append(servers, cluster.Server)
+	}
+	return names, servers, nil
+}
+
+func listClusterNames(ctx context.Context, client argocdclient.Client, _ []string) ([]string, error) {
+	names, _, err := listClusters(ctx, client)
+	return names, err
+}
+
+func listClusterServers(ctx context.Context, client argocdclient.Client, _ []string) ([]string, error) {
+	_, servers, err := listClusters(ctx, client)
+	return servers, err
+}
+
+// argPlaceholder returns the placeholder of the first argument in the usage of a command, and whether the command
+// accepts several of them, e.g. "APPNAME" and true for "sync [APPNAME... | -l selector]"
+func argPlaceholder(use string) (string, bool) {
+	fields := strings.Fields(use)
+	if len(fields) < 2 {
+		return "", false
+	}
+	placeholder := strings.Trim(fields[1], "[]")
+	variadic := strings.HasSuffix(placeholder, "..")
+	return strings.TrimRight(placeholder, "."), variadic
+}
+
+// firstArgOnly only completes the first argument of a command
+func firstArgOnly(args []string) []string {
+	if len(args) > 0 {
+		return nil
+	}
+	return []string{}
+}
+
+// anyArg completes every argument of a command
+func anyArg(_ []string) []string {
+	return []string{}
+}
+
+// appArg completes values which depend on the application given as first argument
+func appArg(args []string) []string {
+	if len(args) == 0 {
+		return nil
+	}
+	return args[:1]
+}
+
+// registerDynamicCompletions registers completion functions which query the API for the application, project and cluster
+// arguments and flags of the given command and its sub-commands
+func registerDynamicCompletions(command *cobra.Command, clientOpts *argocdclient.ClientOptions) error {
+	argListers := map[string]struct {
+		kind string
+		list completionLister
+	}{
+		"APPNAME":     {"applications", listApplicationNames},
+		"PROJECT":     {"projects", listProjectNames},
+		"SERVER/NAME": {"clusters", listClusterNames},
+	}
+	flagListers := map[string]struct {
+		kind string
+		list completionLister
+	}{
+		"project":     {"projects", listProjectNames},
+		"dest-name":   {"clusters", listClusterNames},
+		"dest-server": {"cluster servers", listClusterServers},
+	}
+
+	var errs []error
+	var register func(c *cobra.Command)
+	register = func(c *cobra.Command) {
+		placeholder, variadic := argPlaceholder(c.Use)
+		if lister, ok := argListers[placeholder]; ok && c.ValidArgsFunction == nil {
+			keyArgs := firstArgOnly
+			if variadic {
+				keyArgs = anyArg
+			}
+			c.ValidArgsFunction = completeFromAPI(clientOpts, lister.kind, lister.list, keyArgs)
+		}
+		for name, lister := range flagListers {
+			if c.Flags().Lookup(name) == nil {
+				continue
+			}
+			if _, ok := c.GetFlagCompletionFunc(name); ok {
+				continue
+			}
+			errs = append(errs, c.RegisterFlagCompletionFunc(name, completeFromAPI(clientOpts, lister.kind, lister.list, anyArg)))
+		}
+		if placeholder == "APPNAME" && c.Flags().Lookup("kind") != nil {
+			if _, ok := c.GetFlagCompletionFunc("kind"); !ok {
+				errs = append(errs, c.RegisterFlagCompletionFunc("kind", completeFromAPI(clientOpts, "resource kinds", listApplicationResourceKinds, appArg)))
+			}
+		}
+		for _, sub := range c.Commands() {
+			register(sub)
+		}
+	}
+	for _, sub := range command.Commands() {
+		// admin commands talk to Kubernetes directly rather than through the API
+		if sub.Name() == "admin" {
+			continue
+		}
+		register(sub)
+	}
+	return errors.Join(errs...)
+}
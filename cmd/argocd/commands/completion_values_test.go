@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+)
+
+func TestCompletionCache(t *testing.T) {
+	now := time.Now()
+	cache := &completionCache{dir: t.TempDir(), ttl: time.Minute, now: func() time.Time { return now }}
+
+	_, ok := cache.get("apps")
+	assert.False(t, ok)
+
+	require.NoError(t, cache.set("apps", []string{"guestbook", "argocd/helm-guestbook"}))
+	values, ok := cache.get("apps")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"guestbook", "argocd/helm-guestbook"}, values)
+
+	_, ok = cache.get("projects")
+	assert.False(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.get("apps")
+	assert.False(t, ok)
+}
+
+func TestCompletionCacheDisabled(t *testing.T) {
+	cache := &completionCache{now: time.Now}
+	require.NoError(t, cache.set("apps", []string{"guestbook"}))
+	_, ok := cache.get("apps")
+	assert.False(t, ok)
+}
+
+func TestFetchWithTimeout(t *testing.T) {
+	values, err := fetchWithTimeout(t.Context(), time.Second, func(_ context.Context) ([]string, error) {
+		return []string{"default"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, values)
+
+	block := make(chan struct{})
+	defer close(block)
+	_, err = fetchWithTimeout(t.Context(), 10*time.Millisecond, func(_ context.Context) ([]string, error) {
+		<-block
+		return nil, nil
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestFilterCompletions(t *testing.T) {
+	values := []string{"guestbook", "guestbook-dev", "helm-guestbook"}
+	assert.Equal(t, []string{"guestbook", "guestbook-dev"}, filterCompletions(values, "guest", nil))
+	assert.Equal(t, []string{"guestbook-dev"}, filterCompletions(values, "guest", []string{"guestbook"}))
+	assert.Empty(t, filterCompletions(values, "other", nil))
+}
+
+func TestArgPlaceholder(t *testing.T) {
+	for use, expected := range map[string]struct {
+		placeholder string
+		variadic    bool
+	}{
+		"get APPNAME":           {"APPNAME", false},
+		"rollback APPNAME [ID]": {"APPNAME", false},
+		"sync [APPNAME... | -l selector | --project project-name]": {"APPNAME", true},
+		"wait [APPNAME.. | -l selector]":                           {"APPNAME", true},
+		"simulate [PROJECT]":                                       {"PROJECT", false},
+		"rm SERVER/NAME":                                           {"SERVER/NAME", false},
+		"list":                                                     {"", false},
+	} {
+		placeholder, variadic := argPlaceholder(use)
+		assert.Equal(t, expected.placeholder, placeholder, use)
+		assert.Equal(t, expected.variadic, variadic, use)
+	}
+}
+
+func TestRegisterDynamicCompletions(t *testing.T) {
+	command := NewCommand()
+	find := func(args ...string) *cobra.Command {
+		c, _, err := command.Find(args)
+		require.NoError(t, err)
+		return c
+	}
+
+	assert.NotNil(t, find("app", "get").ValidArgsFunction)
+	assert.NotNil(t, find("app", "sync").ValidArgsFunction)
+	assert.NotNil(t, find("proj", "get").ValidArgsFunction)
+	assert.NotNil(t, find("proj", "role", "get").ValidArgsFunction)
+	assert.NotNil(t, find("cluster", "get").ValidArgsFunction)
+	assert.Nil(t, find("app", "list").ValidArgsFunction)
+	assert.Nil(t, find("admin", "app", "get-reconcile-results").ValidArgsFunction)
+
+	for _, flag := range [][]string{
+		{"app", "create", "project"},
+		{"app", "create", "dest-name"},
+		{"app", "create", "dest-server"},
+		{"app", "list", "project"},
+		{"app", "patch-resource", "kind"},
+	} {
+		_, ok := find(flag[:len(flag)-1]...).GetFlagCompletionFunc(flag[len(flag)-1])
+		assert.True(t, ok, flag)
+	}
+}
+
+func TestCompleteFromAPICached(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	cache := newCompletionCache()
+	require.NotEmpty(t, cache.dir)
+
+	clientOpts := &argocdclient.ClientOptions{ServerAddr: "argocd.example.com"}
+	require.NoError(t, cache.set(completionCacheKey(clientOpts, "applications", []string{}), []string{"guestbook", "argocd/guestbook-dev", "helm-guestbook"}))
+
+	// cached values are completed without querying the API
+	complete := completeFromAPI(clientOpts, "applications", func(_ context.Context, _ argocdclient.Client, _ []string) ([]string, error) {
+		return nil, errors.New("unexpected API request")
+	}, firstArgOnly)
+	values, directive := complete(&cobra.Command{}, nil, "guest")
+	assert.Equal(t, []string{"guestbook"}, values)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	values, _ = complete(&cobra.Command{}, []string{"guestbook"}, "")
+	assert.Empty(t, values)
+}
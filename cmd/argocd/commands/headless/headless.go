@@ -2,6 +2,7 @@ package headless
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
@@ -9,10 +10,12 @@ import (
 	"time"
 
 	"github.com/alicebob/miniredis/v2"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,6 +42,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/localconfig"
+	"github.com/argoproj/argo-cd/v3/util/session"
 )
 
 type forwardCacheClient struct {
@@ -155,6 +159,24 @@ func (c *forwardRepoClientset) NewRepoServerClient() (utilio.Closer, repoapiclie
 	return c.repoClientset.NewRepoServerClient()
 }
 
+// kubernetesUserClaims returns the claims identifying the Kubernetes user of the given clientset, which is the current
+// user of the API server started in core mode
+func kubernetesUserClaims(ctx context.Context, kubeClientset kubernetes.Interface) (jwt.MapClaims, error) {
+	review, err := kubeClientset.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error reviewing the Kubernetes user: %w", err)
+	}
+	if review.Status.UserInfo.Username == "" {
+		return nil, errors.New("the Kubernetes user has no username")
+	}
+	return jwt.MapClaims{
+		"iss":    session.KubernetesClaimsIssuer,
+		"sub":    review.Status.UserInfo.Username,
+		"groups": review.Status.UserInfo.Groups,
+		"iat":    time.Now().Unix(),
+	}, nil
+}
+
 func testAPI(ctx context.Context, clientOpts *apiclient.ClientOptions) error {
 	apiClient, err := apiclient.NewClient(clientOpts)
 	if err != nil {
@@ -270,6 +292,14 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
 	}
 
+	// requests are served as the Kubernetes user of the CLI, or anonymously if the user cannot be reviewed
+	var coreClaims jwt.Claims
+	if claims, err := kubernetesUserClaims(ctx, kubeClientset); err == nil {
+		coreClaims = claims
+	} else {
+		log.Warnf("Failed to identify the Kubernetes user: %v", err)
+	}
+
 	appstateCache := appstatecache.NewCache(cache.NewCache(&forwardCacheClient{namespace: namespace, context: ctxStr, compression: cache.RedisCompressionType(clientOpts.RedisCompression), redisHaProxyName: clientOpts.RedisHaProxyName, redisName: clientOpts.RedisName, redisPassword: redisOptions.Password}), time.Hour)
 	srv := server.NewServer(ctx, server.ArgoCDServerOpts{
 		EnableGZip:              false,
@@ -287,6 +317,7 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 		RepoClientset:           &forwardRepoClientset{namespace: namespace, context: ctxStr, repoServerName: clientOpts.RepoServerName, kubeClientset: kubeClientset},
 		EnableProxyExtension:    false,
 		SyncWithReplaceAllowed:  true,
+		CoreClaims:              coreClaims,
 	}, server.ApplicationSetOpts{})
 	srv.Init(ctx)
 
@@ -317,20 +348,23 @@ func MaybeStartLocalServer(ctx context.Context, clientOpts *apiclient.ClientOpti
 
 // NewClientOrDie creates a new API client from a set of config options, or fails fatally if the new client creation fails.
 func NewClientOrDie(opts *apiclient.ClientOptions, c *cobra.Command) apiclient.Client {
-	ctx := c.Context()
+	client, err := NewClient(c.Context(), opts, c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return client
+}
 
+// NewClient creates a new API client from a set of config options, starting a local API server in core mode.
+func NewClient(ctx context.Context, opts *apiclient.ClientOptions, c *cobra.Command) (apiclient.Client, error) {
 	ctxStr := resolveAndApplyKubeContext(opts, c)
 	// If we're in core mode, start the API server on the fly and configure the client `opts` to use it.
 	// If we're not in core mode, this function call will do nothing.
 	_, err := MaybeStartLocalServer(ctx, opts, ctxStr, nil, nil, nil)
 	if err != nil {
-		log.Fatal(err)
-	}
-	client, err := apiclient.NewClient(opts)
-	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	return client
+	return apiclient.NewClient(opts)
 }
 
 // newClientConfig creates a new clientcmd.ClientConfig based on the provided overrides.
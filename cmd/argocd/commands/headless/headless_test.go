@@ -8,9 +8,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/session"
 )
 
 func TestKubeContextName(t *testing.T) {
@@ -97,3 +102,29 @@ users:
 		assert.Equal(t, "target-ns", namespace)
 	})
 }
+
+func TestKubernetesUserClaims(t *testing.T) {
+	kubeClientset := fake.NewClientset()
+	kubeClientset.PrependReactor("create", "selfsubjectreviews", func(_ kubetesting.Action) (bool, runtime.Object, error) {
+		return true, &authenticationv1.SelfSubjectReview{Status: authenticationv1.SelfSubjectReviewStatus{
+			UserInfo: authenticationv1.UserInfo{Username: "kubernetes-admin", Groups: []string{"system:masters", "system:authenticated"}},
+		}}, nil
+	})
+
+	claims, err := kubernetesUserClaims(t.Context(), kubeClientset)
+	require.NoError(t, err)
+	assert.Equal(t, session.KubernetesClaimsIssuer, claims["iss"])
+	assert.Equal(t, "kubernetes-admin", claims["sub"])
+	assert.Equal(t, []string{"system:masters", "system:authenticated"}, claims["groups"])
+	assert.NotNil(t, claims["iat"])
+}
+
+func TestKubernetesUserClaimsWithoutUsername(t *testing.T) {
+	kubeClientset := fake.NewClientset()
+	kubeClientset.PrependReactor("create", "selfsubjectreviews", func(_ kubetesting.Action) (bool, runtime.Object, error) {
+		return true, &authenticationv1.SelfSubjectReview{}, nil
+	})
+
+	_, err := kubernetesUserClaims(t.Context(), kubeClientset)
+	require.EqualError(t, err, "the Kubernetes user has no username")
+}
@@ -87,6 +87,7 @@ func NewProjectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewProjectAddDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectRemoveDestinationServiceAccountCommand(clientOpts))
 	command.AddCommand(NewProjectSourceIntegrityCommand(clientOpts))
+	command.AddCommand(NewProjectSimulateCommand(clientOpts))
 	return command
 }
 
@@ -1168,7 +1169,10 @@ func NewProjectEditCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 
 // NewProjectAddDestinationServiceAccountCommand returns a new instance of an `argocd proj add-destination-service-account` command
 func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var serviceAccountNamespace string
+	var (
+		serviceAccountNamespace string
+		allowedServiceAccounts  []string
+	)
 
 	buildApplicationDestinationServiceAccount := func(destination string, namespace string, serviceAccount string, serviceAccountNamespace string) v1alpha1.ApplicationDestinationServiceAccount {
 		if serviceAccountNamespace != "" {
@@ -1194,6 +1198,9 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 
 			# Add project destination service account (SERVICE_ACCOUNT) from a different namespace
 			argocd proj add-destination PROJECT SERVER NAMESPACE SERVICE_ACCOUNT --service-account-namespace <service_account_namespace>
+
+			# Add project destination service account (SERVICE_ACCOUNT) and allow applications to select other service accounts with spec.destination.serviceAccount
+			argocd proj add-destination-service-account PROJECT SERVER NAMESPACE SERVICE_ACCOUNT --allowed-service-account 'deployer-*'
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -1216,6 +1223,7 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 			}
 
 			destinationServiceAccount := buildApplicationDestinationServiceAccount(server, namespace, serviceAccount, serviceAccountNamespace)
+			destinationServiceAccount.AllowedServiceAccounts = allowedServiceAccounts
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
 			defer utilio.Close(conn)
 
@@ -1235,6 +1243,7 @@ func NewProjectAddDestinationServiceAccountCommand(clientOpts *argocdclient.Clie
 		},
 	}
 	command.Flags().StringVar(&serviceAccountNamespace, "service-account-namespace", "", "Use service-account-namespace as namespace where the service account is present")
+	command.Flags().StringArrayVar(&allowedServiceAccounts, "allowed-service-account", nil, "Glob pattern of a service account which applications of the destination may impersonate instead of the default service account (e.g. deployer-* or my-namespace:deployer-*)")
 	return command
 }
 
@@ -0,0 +1,282 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoerrors "github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// projectSimulation is the outcome of checking a hypothetical application against the rules of a project
+type projectSimulation struct {
+	check     string
+	permitted bool
+	rule      string
+}
+
+// NewProjectSimulateCommand returns a new instance of an `argocd proj simulate` command
+func NewProjectSimulateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		file           string
+		repo           string
+		destServer     string
+		destName       string
+		destNamespace  string
+		group          string
+		kind           string
+		resourceName   string
+		clusterScoped  bool
+		exitCodeOnDeny bool
+	)
+	command := &cobra.Command{
+		Use:   "simulate [PROJECT]",
+		Short: "Check whether a source, destination or resource kind is permitted by a project",
+		Example: templates.Examples(`
+			# Check whether apps of project PROJECT may use a source repository and deploy to a destination
+			argocd proj simulate PROJECT --repo https://github.com/argoproj/argocd-example-apps.git --dest-server https://kubernetes.default.svc --dest-namespace guestbook
+
+			# Check whether apps of project PROJECT may manage ClusterRoles
+			argocd proj simulate PROJECT --group rbac.authorization.k8s.io --kind ClusterRole --cluster-resource
+
+			# Test changes to a project before applying them, without contacting the API server
+			argocd proj get PROJECT -o yaml > project.yaml
+			argocd proj simulate --file project.yaml --kind Secret
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if (file == "") == (len(args) != 1) || len(args) > 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if repo == "" && destServer == "" && destName == "" && kind == "" {
+				argoerrors.Fatal(argoerrors.ErrorGeneric, "at least one of --repo, --dest-server, --dest-name or --kind must be specified")
+			}
+
+			var proj *v1alpha1.AppProject
+			var projectClusters []*v1alpha1.Cluster
+			if file != "" {
+				var err error
+				proj, err = readProjectFile(file)
+				argoerrors.CheckError(err)
+				if proj.Spec.PermitOnlyProjectScopedClusters {
+					_, _ = fmt.Fprintln(os.Stderr, "Warning: project scoped clusters are not known when simulating a project file, so destinations are never permitted")
+				}
+			} else {
+				detailedProject := getProject(ctx, c, clientOpts, args[0])
+				proj = detailedProject.Project
+				projectClusters = detailedProject.Clusters
+			}
+
+			var results []projectSimulation
+			if repo != "" {
+				results = append(results, simulateProjectSource(proj, repo))
+			}
+			if destServer != "" || destName != "" {
+				result, err := simulateProjectDestination(proj, destServer, destName, destNamespace, projectClusters)
+				argoerrors.CheckError(err)
+				results = append(results, result)
+			}
+			if kind != "" {
+				results = append(results, simulateProjectResource(proj, schema.GroupKind{Group: group, Kind: kind}, resourceName, !clusterScoped))
+			}
+			printProjectSimulation(os.Stdout, results)
+
+			if exitCodeOnDeny {
+				for _, result := range results {
+					if !result.permitted {
+						os.Exit(1)
+					}
+				}
+			}
+		},
+	}
+	command.Flags().StringVarP(&file, "file", "f", "", "Simulate the project defined in the given YAML or JSON file instead of an existing project")
+	command.Flags().StringVar(&repo, "repo", "", "Source repository URL to check")
+	command.Flags().StringVar(&destServer, "dest-server", "", "Destination server URL to check")
+	command.Flags().StringVar(&destName, "dest-name", "", "Destination cluster name to check")
+	command.Flags().StringVar(&destNamespace, "dest-namespace", "", "Destination namespace to check")
+	command.Flags().StringVar(&group, "group", "", "API group of the resource kind to check")
+	command.Flags().StringVar(&kind, "kind", "", "Resource kind to check")
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of the resource to check, used to match named cluster resource rules")
+	command.Flags().BoolVar(&clusterScoped, "cluster-resource", false, "Check the resource kind as a cluster-scoped resource")
+	command.Flags().BoolVar(&exitCodeOnDeny, "exit-code", false, "Return a non-zero exit code if any check is denied")
+	return command
+}
+
+// readProjectFile reads an AppProject from a YAML or JSON file
+func readProjectFile(path string) (*v1alpha1.AppProject, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading project file: %w", err)
+	}
+	proj := &v1alpha1.AppProject{}
+	if err := yaml.UnmarshalStrict(data, proj); err != nil {
+		return nil, fmt.Errorf("error unmarshaling project file: %w", err)
+	}
+	if proj.Name == "" {
+		return nil, errors.New("project file does not define a project name")
+	}
+	return proj, nil
+}
+
+// simulateProjectSource checks the repository URL with IsSourcePermitted and reports the source repository pattern
+// which led to the decision
+func simulateProjectSource(proj *v1alpha1.AppProject, repoURL string) projectSimulation {
+	src := v1alpha1.ApplicationSource{RepoURL: repoURL}
+	result := projectSimulation{check: "source", permitted: proj.IsSourcePermitted(src)}
+	for _, pattern := range proj.Spec.SourceRepos {
+		single := proj.DeepCopy()
+		single.Spec.SourceRepos = []string{pattern}
+		// on its own, a deny pattern rejects the sources it matches and permits all others
+		if single.IsSourcePermitted(src) == result.permitted && (result.permitted || strings.HasPrefix(pattern, "!")) {
+			result.rule = "source repository " + pattern
+			return result
+		}
+	}
+	result.rule = "no source repository matches"
+	return result
+}
+
+// simulateProjectDestination checks the destination with IsDestinationPermitted and reports the destination which
+// led to the decision
+func simulateProjectDestination(proj *v1alpha1.AppProject, server string, name string, namespace string, projectClusters []*v1alpha1.Cluster) (projectSimulation, error) {
+	cluster := &v1alpha1.Cluster{Server: server, Name: name}
+	getProjectClusters := func(_ string) ([]*v1alpha1.Cluster, error) {
+		return projectClusters, nil
+	}
+	permitted, err := proj.IsDestinationPermitted(cluster, namespace, getProjectClusters)
+	if err != nil {
+		return projectSimulation{}, err
+	}
+	result := projectSimulation{check: "destination", permitted: permitted}
+
+	if !permitted && proj.Spec.PermitOnlyProjectScopedClusters {
+		unscoped := proj.DeepCopy()
+		unscoped.Spec.PermitOnlyProjectScopedClusters = false
+		matched, err := unscoped.IsDestinationPermitted(cluster, namespace, getProjectClusters)
+		if err != nil {
+			return projectSimulation{}, err
+		}
+		if matched {
+			result.rule = "cluster is not scoped to the project, which only permits project scoped clusters"
+			return result, nil
+		}
+	}
+	for _, dest := range proj.Spec.Destinations {
+		single := proj.DeepCopy()
+		single.Spec.Destinations = []v1alpha1.ApplicationDestination{dest}
+		single.Spec.PermitOnlyProjectScopedClusters = false
+		singlePermitted, err := single.IsDestinationPermitted(cluster, namespace, getProjectClusters)
+		if err != nil {
+			return projectSimulation{}, err
+		}
+		isDeny := strings.HasPrefix(dest.Server, "!") || strings.HasPrefix(dest.Name, "!") || strings.HasPrefix(dest.Namespace, "!")
+		if singlePermitted == permitted && (permitted || isDeny) {
+			result.rule = "destination " + formatDestinationRule(dest)
+			return result, nil
+		}
+	}
+	result.rule = "no destination matches"
+	return result, nil
+}
+
+func formatDestinationRule(dest v1alpha1.ApplicationDestination) string {
+	var fields []string
+	if dest.Server != "" {
+		fields = append(fields, "server="+dest.Server)
+	}
+	if dest.Name != "" {
+		fields = append(fields, "name="+dest.Name)
+	}
+	fields = append(fields, "namespace="+dest.Namespace)
+	return strings.Join(fields, ",")
+}
+
+// simulateProjectResource checks the resource kind with IsGroupKindNamePermitted and reports the whitelist or
+// blacklist entry which led to the decision
+func simulateProjectResource(proj *v1alpha1.AppProject, gk schema.GroupKind, name string, namespaced bool) projectSimulation {
+	result := projectSimulation{check: "namespace resource", permitted: proj.IsGroupKindNamePermitted(gk, name, namespaced)}
+	anyKind := metav1.GroupKind{Group: "*", Kind: "*"}
+	if namespaced {
+		for _, item := range proj.Spec.NamespaceResourceBlacklist {
+			single := proj.DeepCopy()
+			single.Spec.NamespaceResourceWhitelist = nil
+			single.Spec.NamespaceResourceBlacklist = []metav1.GroupKind{item}
+			if !single.IsGroupKindNamePermitted(gk, name, namespaced) {
+				result.rule = "namespace resource blacklist " + formatGroupKind(item.Group, item.Kind, "")
+				return result
+			}
+		}
+		if proj.Spec.NamespaceResourceWhitelist == nil {
+			result.rule = "no namespace resource whitelist is set"
+			return result
+		}
+		for _, item := range proj.Spec.NamespaceResourceWhitelist {
+			single := proj.DeepCopy()
+			single.Spec.NamespaceResourceWhitelist = []metav1.GroupKind{item}
+			single.Spec.NamespaceResourceBlacklist = nil
+			if single.IsGroupKindNamePermitted(gk, name, namespaced) {
+				result.rule = "namespace resource whitelist " + formatGroupKind(item.Group, item.Kind, "")
+				return result
+			}
+		}
+		result.rule = "no namespace resource whitelist entry matches"
+		return result
+	}
+
+	result.check = "cluster resource"
+	for _, item := range proj.Spec.ClusterResourceBlacklist {
+		single := proj.DeepCopy()
+		single.Spec.ClusterResourceWhitelist = []v1alpha1.ClusterResourceRestrictionItem{{Group: anyKind.Group, Kind: anyKind.Kind}}
+		single.Spec.ClusterResourceBlacklist = []v1alpha1.ClusterResourceRestrictionItem{item}
+		if !single.IsGroupKindNamePermitted(gk, name, namespaced) {
+			result.rule = "cluster resource blacklist " + formatGroupKind(item.Group, item.Kind, item.Name)
+			return result
+		}
+	}
+	for _, item := range proj.Spec.ClusterResourceWhitelist {
+		single := proj.DeepCopy()
+		single.Spec.ClusterResourceWhitelist = []v1alpha1.ClusterResourceRestrictionItem{item}
+		single.Spec.ClusterResourceBlacklist = nil
+		if single.IsGroupKindNamePermitted(gk, name, namespaced) {
+			result.rule = "cluster resource whitelist " + formatGroupKind(item.Group, item.Kind, item.Name)
+			return result
+		}
+	}
+	result.rule = "no cluster resource whitelist entry matches"
+	return result
+}
+
+func formatGroupKind(group string, kind string, name string) string {
+	res := group + "/" + kind
+	if name != "" {
+		res += "/" + name
+	}
+	return res
+}
+
+func printProjectSimulation(out io.Writer, results []projectSimulation) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "CHECK\tRESULT\tRULE\n")
+	for _, result := range results {
+		decision := "deny"
+		if result.permitted {
+			decision = "permit"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", result.check, decision, result.rule)
+	}
+	_ = w.Flush()
+}
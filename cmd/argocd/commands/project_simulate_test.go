@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func simulatedProject() *v1alpha1.AppProject {
+	return &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos: []string{"https://github.com/argoproj/*", "!https://github.com/argoproj/argo-cd.git"},
+			Destinations: []v1alpha1.ApplicationDestination{
+				{Server: "https://kubernetes.default.svc", Namespace: "*"},
+				{Server: "*", Namespace: "!kube-system"},
+			},
+			ClusterResourceWhitelist: []v1alpha1.ClusterResourceRestrictionItem{
+				{Group: "rbac.authorization.k8s.io", Kind: "*"},
+			},
+			ClusterResourceBlacklist: []v1alpha1.ClusterResourceRestrictionItem{
+				{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding", Name: "admin-*"},
+			},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "Secret"}},
+		},
+	}
+}
+
+func TestSimulateProjectSource(t *testing.T) {
+	proj := simulatedProject()
+
+	result := simulateProjectSource(proj, "https://github.com/argoproj/argocd-example-apps.git")
+	assert.True(t, result.permitted)
+	assert.Equal(t, "source repository https://github.com/argoproj/*", result.rule)
+
+	result = simulateProjectSource(proj, "https://github.com/argoproj/argo-cd.git")
+	assert.False(t, result.permitted)
+	assert.Equal(t, "source repository !https://github.com/argoproj/argo-cd.git", result.rule)
+
+	// a deny pattern permits every source it does not match
+	result = simulateProjectSource(proj, "https://gitlab.com/other/repo.git")
+	assert.True(t, result.permitted)
+	assert.Equal(t, "source repository !https://github.com/argoproj/argo-cd.git", result.rule)
+
+	proj.Spec.SourceRepos = []string{"https://github.com/argoproj/*"}
+	result = simulateProjectSource(proj, "https://gitlab.com/other/repo.git")
+	assert.False(t, result.permitted)
+	assert.Equal(t, "no source repository matches", result.rule)
+}
+
+func TestSimulateProjectDestination(t *testing.T) {
+	proj := simulatedProject()
+
+	result, err := simulateProjectDestination(proj, "https://kubernetes.default.svc", "", "guestbook", nil)
+	require.NoError(t, err)
+	assert.True(t, result.permitted)
+	assert.Equal(t, "destination server=https://kubernetes.default.svc,namespace=*", result.rule)
+
+	result, err = simulateProjectDestination(proj, "https://remote.example.com", "", "kube-system", nil)
+	require.NoError(t, err)
+	assert.False(t, result.permitted)
+	assert.Equal(t, "destination server=*,namespace=!kube-system", result.rule)
+
+	proj.Spec.PermitOnlyProjectScopedClusters = true
+	result, err = simulateProjectDestination(proj, "https://remote.example.com", "", "guestbook", nil)
+	require.NoError(t, err)
+	assert.False(t, result.permitted)
+	assert.Contains(t, result.rule, "not scoped to the project")
+
+	result, err = simulateProjectDestination(proj, "https://remote.example.com", "", "guestbook", []*v1alpha1.Cluster{{Server: "https://remote.example.com"}})
+	require.NoError(t, err)
+	assert.True(t, result.permitted)
+}
+
+func TestSimulateProjectResource(t *testing.T) {
+	proj := simulatedProject()
+
+	result := simulateProjectResource(proj, schema.GroupKind{Group: "apps", Kind: "Deployment"}, "", true)
+	assert.True(t, result.permitted)
+	assert.Equal(t, "namespace resource", result.check)
+	assert.Equal(t, "no namespace resource whitelist is set", result.rule)
+
+	result = simulateProjectResource(proj, schema.GroupKind{Kind: "Secret"}, "", true)
+	assert.False(t, result.permitted)
+	assert.Equal(t, "namespace resource blacklist /Secret", result.rule)
+
+	result = simulateProjectResource(proj, schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, "viewer", false)
+	assert.True(t, result.permitted)
+	assert.Equal(t, "cluster resource", result.check)
+	assert.Equal(t, "cluster resource whitelist rbac.authorization.k8s.io/*", result.rule)
+
+	result = simulateProjectResource(proj, schema.GroupKind{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"}, "admin-binding", false)
+	assert.False(t, result.permitted)
+	assert.Equal(t, "cluster resource blacklist rbac.authorization.k8s.io/ClusterRoleBinding/admin-*", result.rule)
+
+	result = simulateProjectResource(proj, schema.GroupKind{Kind: "Namespace"}, "", false)
+	assert.False(t, result.permitted)
+	assert.Equal(t, "no cluster resource whitelist entry matches", result.rule)
+}
+
+func TestReadProjectFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "project.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`apiVersion: argoproj.io/v1alpha1
+kind: AppProject
+metadata:
+  name: test-project
+spec:
+  sourceRepos:
+  - '*'
+`), 0o600))
+	proj, err := readProjectFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "test-project", proj.Name)
+	assert.Equal(t, []string{"*"}, proj.Spec.SourceRepos)
+
+	require.NoError(t, os.WriteFile(path, []byte(`spec: {}`), 0o600))
+	_, err = readProjectFile(path)
+	require.ErrorContains(t, err, "project name")
+}
+
+func TestPrintProjectSimulation(t *testing.T) {
+	var out bytes.Buffer
+	printProjectSimulation(&out, []projectSimulation{
+		{check: "source", permitted: true, rule: "source repository *"},
+		{check: "destination", permitted: false, rule: "no destination matches"},
+	})
+	assert.Equal(t, "CHECK        RESULT  RULE\nsource       permit  source repository *\ndestination  deny    no destination matches\n", out.String())
+}
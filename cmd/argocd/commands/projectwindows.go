@@ -48,6 +48,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsUnlockCommand(clientOpts))
 	return roleCommand
 }
 
@@ -176,17 +177,18 @@ argocd proj windows enable-sync-overrun default 2`,
 // NewProjectWindowsAddWindowCommand returns a new instance of an `argocd proj windows add` command
 func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		kind         string
-		schedule     string
-		duration     string
-		applications []string
-		namespaces   []string
-		clusters     []string
-		manualSync   bool
-		syncOverrun  bool
-		timeZone     string
-		andOperator  bool
-		description  string
+		kind            string
+		schedule        string
+		duration        string
+		applications    []string
+		namespaces      []string
+		clusters        []string
+		manualSync      bool
+		syncOverrun     bool
+		requireApproval bool
+		timeZone        string
+		andOperator     bool
+		description     string
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -210,7 +212,16 @@ argocd proj windows add PROJECT \
     --clusters "prod,staging" \
     --manual-sync \
     --sync-overrun \
-    --description "Ticket 123"`,
+    --description "Ticket 123"
+
+#Add a deny sync window which blocks the syncs until it is unlocked
+argocd proj windows add PROJECT \
+    --kind deny \
+    --schedule "0 8 * * 5" \
+    --duration 8h \
+    --applications "*" \
+    --require-approval \
+    --description "Change freeze"`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -225,7 +236,7 @@ argocd proj windows add PROJECT \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description, syncOverrun)
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description, syncOverrun, requireApproval)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -240,6 +251,7 @@ argocd proj windows add PROJECT \
 	command.Flags().StringSliceVar(&clusters, "clusters", []string{}, "Clusters that the schedule will be applied to. Comma separated, wildcards supported (e.g. --clusters prod,staging)")
 	command.Flags().BoolVar(&manualSync, "manual-sync", false, "Allow manual syncs for both deny and allow windows")
 	command.Flags().BoolVar(&syncOverrun, "sync-overrun", false, "Allow syncs to continue: for deny windows, syncs that started before the window; for allow windows, syncs that started during the window")
+	command.Flags().BoolVar(&requireApproval, "require-approval", false, "Block the syncs during a deny window until the window is unlocked with \"argocd proj windows unlock\"")
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
@@ -352,6 +364,51 @@ argocd proj windows update PROJECT ID \
 	return command
 }
 
+// NewProjectWindowsUnlockCommand returns a new instance of an `argocd proj windows unlock` command
+func NewProjectWindowsUnlockCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		duration string
+		reason   string
+	)
+	command := &cobra.Command{
+		Use:   "unlock PROJECT ID",
+		Short: "Unlock a project sync window which requires an approval",
+		Long:  "Unlock a project sync window which requires an approval, allowing the syncs during the window until the unlock expires. Requires ID which can be found by running \"argocd proj windows list PROJECT\"",
+		Example: `
+#Unlock the sync window with ID 0 for the duration of the window
+argocd proj windows unlock PROJECT 0 --reason "CHG-1234 approved"
+
+#Unlock the sync window with ID 1 for 30 minutes
+argocd proj windows unlock PROJECT 1 --duration 30m`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			projName := args[0]
+			id, err := strconv.Atoi(args[1])
+			errors.CheckError(err)
+
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			_, err = projIf.UnlockSyncWindow(ctx, &projectpkg.SyncWindowUnlockRequest{
+				Name:     projName,
+				Id:       int32(id),
+				Duration: duration,
+				Reason:   reason,
+			})
+			errors.CheckError(err)
+		},
+	}
+	command.Flags().StringVar(&duration, "duration", "", "Duration of the unlock, defaults to the duration of the sync window. (e.g. --duration 1h)")
+	command.Flags().StringVar(&reason, "reason", "", "Reason of the unlock, e.g. the reference of the approved change request")
+	return command
+}
+
 // NewProjectWindowsListCommand returns a new instance of an `argocd proj windows list` command
 func NewProjectWindowsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var output string
@@ -399,7 +456,7 @@ argocd proj windows list test-project`,
 func printSyncWindows(proj *v1alpha1.AppProject) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	var fmtStr string
-	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"}
+	headers := []any{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "REQUIREAPPROVAL", "TIMEZONE", "USEANDOPERATOR"}
 	fmtStr = strings.Repeat("%s\t", len(headers)) + "\n"
 	fmt.Fprintf(w, fmtStr, headers...)
 	if proj.Spec.SyncWindows.HasWindows() {
@@ -416,6 +473,7 @@ func printSyncWindows(proj *v1alpha1.AppProject) {
 				formatListOutput(window.Clusters),
 				formatBoolEnabledOutput(window.ManualSync),
 				formatBoolEnabledOutput(window.SyncOverrun),
+				formatBoolEnabledOutput(window.RequireApproval),
 				window.TimeZone,
 				formatBoolEnabledOutput(window.UseAndOperator),
 			}
@@ -59,10 +59,10 @@ func TestPrintSyncWindows(t *testing.T) {
 					},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "REQUIREAPPROVAL", "TIMEZONE", "USEANDOPERATOR"},
 			expectedRows: [][]string{
-				{"0", "Active", "allow", "0 0 * * *", "1h", "app1,app2", "default", "cluster1", "Disabled", "Disabled", "UTC", "Disabled"},
-				{"1", "Inactive", "deny", "0 12 * * *", "2h", "*", "production", "*", "Enabled", "Enabled", "America/New_York", "Enabled"},
+				{"0", "Active", "allow", "0 0 * * *", "1h", "app1,app2", "default", "cluster1", "Disabled", "Disabled", "Disabled", "UTC", "Disabled"},
+				{"1", "Inactive", "deny", "0 12 * * *", "2h", "*", "production", "*", "Enabled", "Enabled", "Disabled", "America/New_York", "Enabled"},
 			},
 		},
 		{
@@ -88,9 +88,9 @@ func TestPrintSyncWindows(t *testing.T) {
 					},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "REQUIREAPPROVAL", "TIMEZONE", "USEANDOPERATOR"},
 			expectedRows: [][]string{
-				{"0", "Inactive", "allow", "0 1 * * *", "30m", "-", "-", "-", "Disabled", "Disabled", "UTC", "Disabled"},
+				{"0", "Inactive", "allow", "0 1 * * *", "30m", "-", "-", "-", "Disabled", "Disabled", "Disabled", "UTC", "Disabled"},
 			},
 		},
 		{
@@ -103,7 +103,7 @@ func TestPrintSyncWindows(t *testing.T) {
 					SyncWindows: v1alpha1.SyncWindows{},
 				},
 			},
-			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "TIMEZONE", "USEANDOPERATOR"},
+			expectedHeader: []string{"ID", "STATUS", "KIND", "SCHEDULE", "DURATION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC", "SYNCOVERRUN", "REQUIREAPPROVAL", "TIMEZONE", "USEANDOPERATOR"},
 			expectedRows:   [][]string{},
 		},
 	}
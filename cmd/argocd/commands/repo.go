@@ -3,12 +3,15 @@ package commands
 import (
 	stderrors "errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"text/tabwriter"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/text"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
@@ -16,6 +19,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/git"
@@ -55,7 +59,10 @@ argocd repo rm https://github.com/yourusername/your-repo.git
 
 // NewRepoAddCommand returns a new instance of an `argocd repo add` command
 func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var repoOpts cmdutil.RepoOptions
+	var (
+		repoOpts cmdutil.RepoOptions
+		verify   bool
+	)
 
 	// For better readability and easier formatting
 	repoAddExamples := `  # Add a Git repository via SSH using a private key for authentication, ignoring the server's host key:
@@ -73,6 +80,9 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
   # Add a private Git repository via HTTPS using username/password and TLS client certificates:
   argocd repo add https://git.example.com/repos/repo --username git --password secret --tls-client-cert-path ~/mycert.crt --tls-client-cert-key-path ~/mycert.key
 
+  # Add a private Git repository via HTTPS using username/password, printing the diagnostics of each stage of the access to it
+  argocd repo add https://git.example.com/repos/repo --username git --password secret --verify
+
   # Add a private Git repository via HTTPS using username/password without verifying the server's TLS certificate
   argocd repo add https://git.example.com/repos/repo --username git --password secret --insecure-skip-server-verification
 
@@ -203,6 +213,10 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 			repoOpts.Repo.AzureActiveDirectoryEndpoint = repoOpts.AzureActiveDirectoryEndpoint
 			repoOpts.Repo.Depth = repoOpts.Depth
 			repoOpts.Repo.WebhookManifestCacheWarmDisabled = repoOpts.WebhookManifestCacheWarmDisabled
+			repoOpts.Repo.SparseCheckout = repoOpts.SparseCheckout
+			repoOpts.Repo.ManifestGenerationLimits = repoOpts.GetManifestGenerationLimits()
+			repoOpts.Repo.RegistryMirrors = repoOpts.RegistryMirrors
+			repoOpts.Repo.SubmodulePolicy = repoOpts.GetSubmodulePolicy()
 
 			if repoOpts.Repo.Type == "helm" && repoOpts.Repo.Name == "" {
 				errors.Fatal(errors.ErrorGeneric, "Must specify --name for repos of type 'helm'")
@@ -263,9 +277,19 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				AzureServicePrincipalClientId:     repoOpts.Repo.AzureServicePrincipalClientId,
 				AzureServicePrincipalClientSecret: repoOpts.Repo.AzureServicePrincipalClientSecret,
 				AzureActiveDirectoryEndpoint:      repoOpts.Repo.AzureActiveDirectoryEndpoint,
+				EnableLfs:                         repoOpts.Repo.EnableLFS,
+			}
+			if verify {
+				validation, err := repoIf.Validate(ctx, &repoAccessReq)
+				errors.CheckError(err)
+				printRepoValidationStages(os.Stdout, validation.Stages)
+				if !isRepoValidationSuccessful(validation.Stages) {
+					errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Validation of repository '%s' failed", repoOpts.Repo.Repo))
+				}
+			} else {
+				_, err = repoIf.ValidateAccess(ctx, &repoAccessReq)
+				errors.CheckError(err)
 			}
-			_, err = repoIf.ValidateAccess(ctx, &repoAccessReq)
-			errors.CheckError(err)
 
 			repoCreateReq := repositorypkg.RepoCreateRequest{
 				Repo:   &repoOpts.Repo,
@@ -278,10 +302,34 @@ func NewRepoAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 		},
 	}
 	command.Flags().BoolVar(&repoOpts.Upsert, "upsert", false, "Override an existing repository with the same name even if the spec differs")
+	command.Flags().BoolVar(&verify, "verify", false, "Check the access to the repository in stages (DNS, TCP, TLS, auth, refs and LFS) and print the result of each stage before adding it")
 	cmdutil.AddRepoFlags(command, &repoOpts)
 	return command
 }
 
+// printRepoValidationStages prints the results of the stages of the validation of a repository as table
+func printRepoValidationStages(out io.Writer, stages []*repoapiclient.RepositoryValidationStage) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "STAGE\tSTATUS\tMESSAGE\n")
+	for _, stage := range stages {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", stage.Name, stage.Status, stage.Message)
+		for _, detail := range stage.Details {
+			_, _ = fmt.Fprintf(w, "\t\t  %s\n", detail)
+		}
+	}
+	_ = w.Flush()
+}
+
+// isRepoValidationSuccessful returns whether none of the stages of the validation of a repository failed
+func isRepoValidationSuccessful(stages []*repoapiclient.RepositoryValidationStage) bool {
+	for _, stage := range stages {
+		if stage.Status == appsv1.ConnectionStatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
 // NewRepoRemoveCommand returns a new instance of an `argocd repo rm` command
 func NewRepoRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var project string
@@ -345,6 +393,28 @@ func printRepoTable(repos appsv1.Repositories) {
 	_ = w.Flush()
 }
 
+// Print the results of the latest health checks of repositories as table
+func printRepoHealthTable(repos appsv1.Repositories) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprint(w, "REPO\tPROJECT\tCREDS\tSTATUS\tFAILED CHECK\tLAST CHECKED\tLAST SUCCEEDED\tERROR\n")
+	for _, r := range repos {
+		if r.Status == nil {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t-\tUnknown\t-\t-\t-\t-\n", r.Repo, r.Project)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Repo, r.Project, text.FirstNonEmpty(r.Status.CredentialsURL, "-"), r.Status.Status,
+			text.FirstNonEmpty(r.Status.FailedCheck, "-"), formatRepoStatusTime(r.Status.LastCheckedAt), formatRepoStatusTime(r.Status.LastSucceededAt), text.FirstNonEmpty(r.Status.LastError, "-"))
+	}
+	_ = w.Flush()
+}
+
+func formatRepoStatusTime(t *metav1.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return humanizeTimestamp(t.Unix())
+}
+
 // Print list of repo urls or url patterns for repository credentials
 func printRepoUrls(repos appsv1.Repositories) {
 	for _, r := range repos {
@@ -355,8 +425,9 @@ func printRepoUrls(repos appsv1.Repositories) {
 // NewRepoListCommand returns a new instance of an `argocd repo list` command
 func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		output  string
-		refresh string
+		output    string
+		refresh   string
+		unhealthy bool
 	)
 	command := &cobra.Command{
 		Use:   "list",
@@ -379,6 +450,9 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
   # Force refresh of cached repository connection status
   argocd repo list --refresh hard
+
+  # List repositories whose latest background health check failed
+  argocd repo list --unhealthy
 `,
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
@@ -398,6 +472,11 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 
 			repos, err := repoIf.ListRepositories(ctx, &repositorypkg.RepoQuery{ForceRefresh: forceRefresh})
 			errors.CheckError(err)
+			if unhealthy {
+				repos.Items = repos.Items.Filter(func(r *appsv1.Repository) bool {
+					return !r.Status.IsHealthy()
+				})
+			}
 
 			switch output {
 			case "yaml", "json":
@@ -407,7 +486,11 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 				printRepoUrls(repos.Items)
 				// wide is the default
 			case "wide", "":
-				printRepoTable(repos.Items)
+				if unhealthy {
+					printRepoHealthTable(repos.Items)
+				} else {
+					printRepoTable(repos.Items)
+				}
 			default:
 				errors.CheckError(fmt.Errorf("unknown output format: %s. Supported formats: yaml|json|url|wide", output))
 			}
@@ -415,6 +498,7 @@ func NewRepoListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. Supported formats: yaml|json|url|wide")
 	command.Flags().StringVar(&refresh, "refresh", "", "Force a cache refresh on connection status. Supported values: hard")
+	command.Flags().BoolVar(&unhealthy, "unhealthy", false, "List only repositories whose latest background health check failed")
 	return command
 }
 
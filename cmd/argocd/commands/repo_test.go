@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+)
+
+func TestPrintRepoValidationStages(t *testing.T) {
+	stages := []*repoapiclient.RepositoryValidationStage{
+		{Name: "dns", Status: "Successful", Message: "resolved git.example.com", Details: []string{"192.0.2.1"}},
+		{Name: "auth", Status: "Failed", Message: "authentication required"},
+		{Name: "refs", Status: "Skipped", Message: "skipped, since a previous stage failed"},
+	}
+
+	var out bytes.Buffer
+	printRepoValidationStages(&out, stages)
+	assert.Equal(t, `STAGE  STATUS      MESSAGE
+dns    Successful  resolved git.example.com
+                     192.0.2.1
+auth   Failed      authentication required
+refs   Skipped     skipped, since a previous stage failed
+`, out.String())
+
+	assert.False(t, isRepoValidationSuccessful(stages))
+	assert.True(t, isRepoValidationSuccessful(stages[:1]))
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"context"
 	stderrors "errors"
 	"fmt"
 	"os"
@@ -15,6 +16,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	repocredspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repocreds"
+	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -89,6 +91,9 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 
   # Add credentials with Azure Service Principal to use for all repositories under https://dev.azure.com/my-devops-organization when not using default Azure public cloud
   argocd repocreds add https://dev.azure.com/my-devops-organization --azure-service-principal-client-id 12345678-1234-1234-1234-123456789012 --azure-service-principal-client-secret test --azure-service-principal-tenant-id 12345678-1234-1234-1234-123456789012 --azure-active-directory-endpoint https://login.microsoftonline.de
+
+  # Add credentials to use only for the repositories under https://git.example.com/team-a of the project team-a
+  argocd repocreds add https://git.example.com/team-a/ --username git --password secret --project team-a
 `
 
 	command := &cobra.Command{
@@ -214,18 +219,23 @@ func NewRepoCredsAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comma
 	command.Flags().StringVar(&repo.AzureServicePrincipalClientId, "azure-service-principal-client-id", "", "client id of the Azure Service Principal")
 	command.Flags().StringVar(&repo.AzureServicePrincipalClientSecret, "azure-service-principal-client-secret", "", "client secret of the Azure Service Principal")
 	command.Flags().StringVar(&repo.AzureServicePrincipalTenantId, "azure-service-principal-tenant-id", "", "tenant id of the Azure Service Principal")
+	command.Flags().StringVar(&repo.Project, "project", "", "project the credentials are scoped to")
 	command.Flags().StringVar(&repo.AzureActiveDirectoryEndpoint, "azure-active-directory-endpoint", "", "Active Directory endpoint when not using default Azure public cloud (e.g. https://login.microsoftonline.de)")
 	return command
 }
 
 // NewRepoCredsRemoveCommand returns a new instance of an `argocd repocreds rm` command
 func NewRepoCredsRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var project string
 	command := &cobra.Command{
 		Use:   "rm CREDSURL",
 		Short: "Remove repository credentials",
 		Example: templates.Examples(`
 			# Remove credentials for the repositories with URL https://git.example.com/repos
 			argocd repocreds rm https://git.example.com/repos/
+
+			# Remove credentials of the project team-a for the repositories with URL https://git.example.com/team-a
+			argocd repocreds rm https://git.example.com/team-a/ --project team-a
 		`),
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -242,7 +252,7 @@ func NewRepoCredsRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			for _, repoURL := range args {
 				canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to remove '%s'? [y/n] ", repoURL))
 				if canDelete {
-					_, err := repoIf.DeleteRepositoryCredentials(ctx, &repocredspkg.RepoCredsDeleteRequest{Url: repoURL})
+					_, err := repoIf.DeleteRepositoryCredentials(ctx, &repocredspkg.RepoCredsDeleteRequest{Url: repoURL, Project: project})
 					errors.CheckError(err)
 					fmt.Printf("Repository credentials for '%s' removed\n", repoURL)
 				} else {
@@ -251,18 +261,22 @@ func NewRepoCredsRemoveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			}
 		},
 	}
+	command.Flags().StringVar(&project, "project", "", "project the credentials are scoped to")
 	return command
 }
 
 // Print the repository credentials as table
 func printRepoCredsTable(repos []appsv1.RepoCreds) {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprint(w, "URL PATTERN\tUSERNAME\tSSH_CREDS\tTLS_CREDS\n")
+	fmt.Fprint(w, "URL PATTERN\tUSERNAME\tSSH_CREDS\tTLS_CREDS\tPROJECT\n")
 	for _, r := range repos {
 		if r.Username == "" {
 			r.Username = "-"
 		}
-		fmt.Fprintf(w, "%s\t%s\t%v\t%v\n", r.URL, r.Username, r.SSHPrivateKey != "", r.TLSClientCertData != "")
+		if r.Project == "" {
+			r.Project = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%v\t%v\t%s\n", r.URL, r.Username, r.SSHPrivateKey != "", r.TLSClientCertData != "", r.Project)
 	}
 	_ = w.Flush()
 }
@@ -276,7 +290,10 @@ func printRepoCredsUrls(repos []appsv1.RepoCreds) {
 
 // NewRepoCredsListCommand returns a new instance of an `argocd repo list` command
 func NewRepoCredsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	var output string
+	var (
+		output    string
+		unhealthy bool
+	)
 	command := &cobra.Command{
 		Use:   "list",
 		Short: "List configured repository credentials",
@@ -292,6 +309,9 @@ func NewRepoCredsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 
 			# List all repo urls in url format
 			argocd repocreds list -o url
+
+			# List repository credentials used by repositories whose latest background health check failed
+			argocd repocreds list --unhealthy
 		`),
 		Run: func(c *cobra.Command, _ []string) {
 			ctx := c.Context()
@@ -300,6 +320,9 @@ func NewRepoCredsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 			defer utilio.Close(conn)
 			repos, err := repoIf.ListRepositoryCredentials(ctx, &repocredspkg.RepoCredsQuery{})
 			errors.CheckError(err)
+			if unhealthy {
+				repos.Items = filterUnhealthyRepoCreds(ctx, clientOpts, c, repos.Items)
+			}
 			switch output {
 			case "yaml", "json":
 				err := PrintResourceList(repos.Items, output, false)
@@ -314,5 +337,27 @@ func NewRepoCredsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 		},
 	}
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide|url")
+	command.Flags().BoolVar(&unhealthy, "unhealthy", false, "List only repository credentials used by repositories whose latest background health check failed")
 	return command
 }
+
+// filterUnhealthyRepoCreds returns the repository credentials used by repositories whose latest health check failed
+func filterUnhealthyRepoCreds(ctx context.Context, clientOpts *argocdclient.ClientOptions, c *cobra.Command, creds []appsv1.RepoCreds) []appsv1.RepoCreds {
+	conn, repoIf := headless.NewClientOrDie(clientOpts, c).NewRepoClientOrDie()
+	defer utilio.Close(conn)
+	repos, err := repoIf.ListRepositories(ctx, &repositorypkg.RepoQuery{})
+	errors.CheckError(err)
+	unhealthyCreds := map[string]bool{}
+	for _, r := range repos.Items {
+		if !r.Status.IsHealthy() && r.Status.CredentialsURL != "" {
+			unhealthyCreds[r.Status.CredentialsURL] = true
+		}
+	}
+	var res []appsv1.RepoCreds
+	for _, r := range creds {
+		if unhealthyCreds[r.URL] {
+			res = append(res, r)
+		}
+	}
+	return res
+}
@@ -100,5 +100,7 @@ func NewCommand() *cobra.Command {
 	clientOpts.KubeOverrides = &clientcmd.ConfigOverrides{}
 	command.PersistentFlags().StringVar(&clientOpts.KubeOverrides.CurrentContext, "kube-context", "", "Directs the command to the given kube-context")
 
+	errors.CheckError(registerDynamicCompletions(command, &clientOpts))
+
 	return command
 }
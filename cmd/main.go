@@ -11,6 +11,7 @@ import (
 
 	appcontroller "github.com/argoproj/argo-cd/v3/cmd/argocd-application-controller/commands"
 	applicationset "github.com/argoproj/argo-cd/v3/cmd/argocd-applicationset-controller/commands"
+	clusteragent "github.com/argoproj/argo-cd/v3/cmd/argocd-cluster-agent/commands"
 	cmpserver "github.com/argoproj/argo-cd/v3/cmd/argocd-cmp-server/commands"
 	commitserver "github.com/argoproj/argo-cd/v3/cmd/argocd-commit-server/commands"
 	dex "github.com/argoproj/argo-cd/v3/cmd/argocd-dex/commands"
@@ -21,6 +22,8 @@ import (
 	apiserver "github.com/argoproj/argo-cd/v3/cmd/argocd-server/commands"
 	cli "github.com/argoproj/argo-cd/v3/cmd/argocd/commands"
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/log"
 )
 
@@ -31,6 +34,8 @@ const (
 func init() {
 	// Make sure klog uses the configured log level and format.
 	klog.SetLogger(log.NewLogrusLogger(log.NewWithCurrentConfig()))
+	// The clusters authenticating using a cloud workload identity request their tokens from the cloud providers.
+	v1alpha1.SetCloudAuthRestConfigFunc(clusterauth.CloudAuthRestConfig)
 }
 
 func main() {
@@ -70,6 +75,8 @@ func main() {
 	case common.CommandK8sAuth:
 		command = k8sauth.NewCommand()
 		isArgocdCLI = true
+	case common.CommandClusterAgent:
+		command = clusteragent.NewCommand()
 	default:
 		// "argocd-linux-amd64", "argocd-darwin-amd64", "argocd-windows-amd64.exe" are also valid binary names
 		command = cli.NewCommand()
@@ -39,6 +39,7 @@ type AppOptions struct {
 	destName                        string
 	destServer                      string
 	destNamespace                   string
+	destServiceAccount              string
 	Parameters                      []string
 	valuesFiles                     []string
 	ignoreMissingValueFiles         bool
@@ -61,6 +62,10 @@ type AppOptions struct {
 	autoPrune                       bool
 	selfHeal                        bool
 	allowEmpty                      bool
+	minRevisionAge                  time.Duration
+	refreshInterval                 time.Duration
+	syncSchedule                    string
+	syncScheduleTimeZone            string
 	namePrefix                      string
 	nameSuffix                      string
 	directoryRecurse                bool
@@ -119,6 +124,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringVar(&opts.destServer, "dest-server", "", "K8s cluster URL (e.g. https://kubernetes.default.svc)")
 	command.Flags().StringVar(&opts.destName, "dest-name", "", "K8s cluster Name (e.g. minikube)")
 	command.Flags().StringVar(&opts.destNamespace, "dest-namespace", "", "K8s target namespace")
+	command.Flags().StringVar(&opts.destServiceAccount, "dest-service-account", "", "Service account to impersonate during sync operations instead of the default service account of the project destination (e.g. my-sa or my-namespace:my-sa)")
 	command.Flags().StringArrayVarP(&opts.Parameters, "parameter", "p", []string{}, "set a parameter override (e.g. -p guestbook=image=example/guestbook:latest)")
 	command.Flags().StringArrayVar(&opts.valuesFiles, "values", []string{}, "Helm values file(s) to use")
 	command.Flags().BoolVar(&opts.ignoreMissingValueFiles, "ignore-missing-value-files", false, "Ignore locally missing valueFiles when setting helm template --values")
@@ -141,6 +147,10 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().BoolVar(&opts.autoPrune, "auto-prune", false, "Set automatic pruning for automated sync policy")
 	command.Flags().BoolVar(&opts.selfHeal, "self-heal", false, "Set self healing for automated sync policy")
 	command.Flags().BoolVar(&opts.allowEmpty, "allow-empty", false, "Set allow zero live resources for automated sync policy")
+	command.Flags().DurationVar(&opts.minRevisionAge, "min-revision-age", 0, "Set the minimum age of a new revision, based on its commit date, before it is automatically synced (e.g. 1h)")
+	command.Flags().DurationVar(&opts.refreshInterval, "refresh-interval", 0, "Override the reconciliation timeout of the application controller for the application, bounded by the maximum refresh interval of the project (e.g. 1h). Remove the override using 0")
+	command.Flags().StringVar(&opts.syncSchedule, "sync-schedule", "", "Restrict the automated syncs to the times of a cron schedule, e.g. `0 2 * * *` to sync nightly. Remove the schedule using an empty value")
+	command.Flags().StringVar(&opts.syncScheduleTimeZone, "sync-schedule-timezone", "", "Set the time zone of the sync schedule (default: UTC)")
 	command.Flags().StringVar(&opts.namePrefix, "nameprefix", "", "Kustomize nameprefix")
 	command.Flags().StringVar(&opts.nameSuffix, "namesuffix", "", "Kustomize namesuffix")
 	command.Flags().StringVar(&opts.kustomizeVersion, "kustomize-version", "", "Kustomize version")
@@ -217,6 +227,8 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			spec.Destination.Server = appOpts.destServer
 		case "dest-namespace":
 			spec.Destination.Namespace = appOpts.destNamespace
+		case "dest-service-account":
+			spec.Destination.ServiceAccount = appOpts.destServiceAccount
 		case "project":
 			spec.Project = appOpts.project
 		case "sync-policy":
@@ -276,6 +288,46 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			default:
 				log.Fatalf("Invalid sync-retry-limit [%d]", appOpts.retryLimit)
 			}
+		case "sync-schedule":
+			if appOpts.syncSchedule == "" {
+				if spec.SyncPolicy != nil {
+					spec.SyncPolicy.Scheduled = nil
+					if spec.SyncPolicy.IsZero() {
+						spec.SyncPolicy = nil
+					}
+				}
+				break
+			}
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			if spec.SyncPolicy.Scheduled == nil {
+				spec.SyncPolicy.Scheduled = &argoappv1.SyncPolicyScheduled{}
+			}
+			spec.SyncPolicy.Scheduled.Schedule = appOpts.syncSchedule
+		case "sync-schedule-timezone":
+			if spec.SyncPolicy == nil || spec.SyncPolicy.Scheduled == nil {
+				// the time zone goes away with a schedule removed by --sync-schedule
+				if flags.Changed("sync-schedule") {
+					break
+				}
+				log.Fatal("--sync-schedule-timezone requires a sync schedule")
+			}
+			spec.SyncPolicy.Scheduled.TimeZone = appOpts.syncScheduleTimeZone
+		case "refresh-interval":
+			if appOpts.refreshInterval <= 0 {
+				if spec.SyncPolicy != nil {
+					spec.SyncPolicy.RefreshInterval = nil
+					if spec.SyncPolicy.IsZero() {
+						spec.SyncPolicy = nil
+					}
+				}
+				break
+			}
+			if spec.SyncPolicy == nil {
+				spec.SyncPolicy = &argoappv1.SyncPolicy{}
+			}
+			spec.SyncPolicy.RefreshInterval = &metav1.Duration{Duration: appOpts.refreshInterval}
 		case "sync-retry-refresh":
 			if spec.SyncPolicy == nil {
 				spec.SyncPolicy = &argoappv1.SyncPolicy{}
@@ -287,7 +339,7 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		}
 	})
 
-	if flags.Changed("auto-prune") || flags.Changed("self-heal") || flags.Changed("allow-empty") {
+	if flags.Changed("auto-prune") || flags.Changed("self-heal") || flags.Changed("allow-empty") || flags.Changed("min-revision-age") {
 		if spec.SyncPolicy == nil {
 			spec.SyncPolicy = &argoappv1.SyncPolicy{}
 		}
@@ -305,6 +357,9 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 		if flags.Changed("allow-empty") {
 			spec.SyncPolicy.Automated.AllowEmpty = &appOpts.allowEmpty
 		}
+		if flags.Changed("min-revision-age") {
+			spec.SyncPolicy.Automated.MinRevisionAge = &metav1.Duration{Duration: appOpts.minRevisionAge}
+		}
 	}
 	return visited
 }
@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -314,6 +315,38 @@ func Test_setAppSpecOptions(t *testing.T) {
 		require.NotNil(t, f.spec.SyncPolicy.Automated.AllowEmpty)
 		assert.False(t, *f.spec.SyncPolicy.Automated.AllowEmpty)
 	})
+	t.Run("MinRevisionAgeFlag", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("min-revision-age", "1h"))
+		require.NotNil(t, f.spec.SyncPolicy.Automated.Enabled)
+		assert.False(t, *f.spec.SyncPolicy.Automated.Enabled)
+		require.NotNil(t, f.spec.SyncPolicy.Automated.MinRevisionAge)
+		assert.Equal(t, time.Hour, f.spec.SyncPolicy.Automated.GetMinRevisionAge())
+	})
+	t.Run("RefreshIntervalFlag", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("refresh-interval", "1h"))
+		require.NotNil(t, f.spec.SyncPolicy.RefreshInterval)
+		assert.Equal(t, time.Hour, f.spec.SyncPolicy.GetRefreshInterval())
+
+		require.NoError(t, f.SetFlag("refresh-interval", "0"))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
+	t.Run("SyncScheduleFlags", func(t *testing.T) {
+		f := newAppOptionsFixture()
+
+		require.NoError(t, f.SetFlag("sync-schedule", "0 2 * * *"))
+		require.NotNil(t, f.spec.SyncPolicy.Scheduled)
+		assert.Equal(t, "0 2 * * *", f.spec.SyncPolicy.Scheduled.Schedule)
+
+		require.NoError(t, f.SetFlag("sync-schedule-timezone", "Europe/Paris"))
+		assert.Equal(t, "Europe/Paris", f.spec.SyncPolicy.Scheduled.TimeZone)
+
+		require.NoError(t, f.SetFlag("sync-schedule", ""))
+		assert.Nil(t, f.spec.SyncPolicy)
+	})
 	t.Run("RetryLimit", func(t *testing.T) {
 		require.NoError(t, f.SetFlag("sync-retry-limit", "5"))
 		assert.Equal(t, int64(5), f.spec.SyncPolicy.Retry.Limit)
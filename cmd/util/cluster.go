@@ -156,9 +156,13 @@ type ClusterOptions struct {
 	AwsRoleArn              string
 	AwsProfile              string
 	AwsClusterName          string
+	GCPAuth                 bool
+	AzureAuth               bool
+	AzureServerAppID        string
 	SystemNamespace         string
 	Namespaces              []string
 	ClusterResources        bool
+	NamespacedCache         bool
 	Name                    string
 	Project                 string
 	Shard                   int64
@@ -184,8 +188,12 @@ func AddClusterFlags(command *cobra.Command, opts *ClusterOptions) {
 	command.Flags().StringVar(&opts.AwsClusterName, "aws-cluster-name", "", "AWS Cluster name if set then aws cli eks token command will be used to access cluster")
 	command.Flags().StringVar(&opts.AwsRoleArn, "aws-role-arn", "", "Optional AWS role arn. If set then AWS IAM Authenticator assumes a role to perform cluster operations instead of the default AWS credential provider chain.")
 	command.Flags().StringVar(&opts.AwsProfile, "aws-profile", "", "Optional AWS profile. If set then AWS IAM Authenticator uses this profile to perform cluster operations instead of the default AWS credential provider chain.")
+	command.Flags().BoolVar(&opts.GCPAuth, "gcp-auth", false, "Authenticate to the GKE cluster with access tokens obtained from the application default credentials of Argo CD, e.g. GKE Workload Identity")
+	command.Flags().BoolVar(&opts.AzureAuth, "azure-auth", false, "Authenticate to the AKS cluster with access tokens obtained using the workload identity of Argo CD")
+	command.Flags().StringVar(&opts.AzureServerAppID, "azure-server-application-id", "", "Optional application ID of the Microsoft Entra ID server application of the AKS cluster. Defaults to the application of AKS clusters with managed Microsoft Entra ID integration.")
 	command.Flags().StringArrayVar(&opts.Namespaces, "namespace", nil, "List of namespaces which are allowed to manage")
 	command.Flags().BoolVar(&opts.ClusterResources, "cluster-resources", false, "Indicates if cluster level resources should be managed. The setting is used only if list of managed namespaces is not empty.")
+	command.Flags().BoolVar(&opts.NamespacedCache, "namespaced-cache", false, "Indicates if the controller should list and watch only the namespaces of the applications deployed to the cluster instead of the whole cluster. The setting is used only if list of managed namespaces is empty.")
 	command.Flags().StringVar(&opts.Name, "name", "", "Overwrite the cluster name")
 	command.Flags().StringVar(&opts.Project, "project", "", "project of the cluster")
 	command.Flags().Int64Var(&opts.Shard, "shard", -1, "Cluster shard number; inferred from hostname if not set")
@@ -29,6 +29,10 @@ type RepoOptions struct {
 	UseAzureWorkloadIdentity          bool
 	Depth                             int64
 	WebhookManifestCacheWarmDisabled  bool
+	SparseCheckout                    bool
+	ManifestGenerationLimits          appsv1.ManifestGenerationLimits
+	RegistryMirrors                   map[string]string
+	SubmodulePolicy                   appsv1.SubmodulePolicy
 	AzureServicePrincipalTenantId     string
 	AzureServicePrincipalClientId     string
 	AzureServicePrincipalClientSecret string
@@ -61,8 +65,34 @@ func AddRepoFlags(command *cobra.Command, opts *RepoOptions) {
 	command.Flags().BoolVar(&opts.InsecureOCIForceHTTP, "insecure-oci-force-http", false, "Use http when accessing an OCI repository")
 	command.Flags().Int64Var(&opts.Depth, "depth", 0, "Specify a custom depth for git clone operations. Unless specified, a full clone is performed using the depth of 0")
 	command.Flags().BoolVar(&opts.WebhookManifestCacheWarmDisabled, "webhook-manifest-cache-warm-disabled", false, "disable manifest cache warming during webhook processing for this repository (recommended for large monorepos with plain YAML manifests)")
+	command.Flags().BoolVar(&opts.SparseCheckout, "sparse-checkout", false, "fetch the repository as a partial clone and check out only the path and the value files of each application (recommended for large monorepos)")
+	command.Flags().StringVar(&opts.ManifestGenerationLimits.CPUTime, "manifest-generation-cpu-time", "", "CPU time after which each manifest generation command of the applications of the repository is terminated (e.g. 30s)")
+	command.Flags().StringVar(&opts.ManifestGenerationLimits.Memory, "manifest-generation-memory", "", "maximum memory of each manifest generation command of the applications of the repository (e.g. 512Mi)")
+	command.Flags().StringVar(&opts.ManifestGenerationLimits.Timeout, "manifest-generation-timeout", "", "wall time after which each manifest generation command of the applications of the repository is terminated (e.g. 2m)")
+	command.Flags().StringToStringVar(&opts.RegistryMirrors, "registry-mirror", nil, "mirror from which the Helm charts and OCI dependencies of an OCI registry are pulled, as registry=mirror (e.g. docker.io=registry.example.com/docker-hub); can be repeated")
+	command.Flags().BoolVar(&opts.SubmodulePolicy.Disabled, "disable-submodules", false, "do not check out the submodules of the repository, even if submodules are enabled on the repo server")
+	command.Flags().Int64Var(&opts.SubmodulePolicy.RecursionDepth, "submodule-recursion-depth", 0, "maximum nesting level of the submodules checked out, 1 checking out only the submodules of the repository itself (default no limit)")
+	command.Flags().StringToStringVar(&opts.SubmodulePolicy.CredentialMappings, "submodule-credentials", nil, "repository or credential template whose credentials are used to fetch a submodule, as submodule-url=credentials-url (e.g. https://gitlab.example.com/vendor/lib.git=https://gitlab.example.com/vendor); can be repeated")
 	command.Flags().StringVar(&opts.AzureServicePrincipalTenantId, "azure-service-principal-tenant-id", "", "tenant id of the Azure Service Principal")
 	command.Flags().StringVar(&opts.AzureServicePrincipalClientId, "azure-service-principal-client-id", "", "client id of the Azure Service Principal")
 	command.Flags().StringVar(&opts.AzureServicePrincipalClientSecret, "azure-service-principal-client-secret", "", "client secret of the Azure Service Principal")
 	command.Flags().StringVar(&opts.AzureActiveDirectoryEndpoint, "azure-active-directory-endpoint", "", "Active Directory endpoint when not using default Azure public cloud (e.g. https://login.microsoftonline.de)")
 }
+
+// GetManifestGenerationLimits returns the manifest generation limits set with the flags, or nil if none is set
+func (opts *RepoOptions) GetManifestGenerationLimits() *appsv1.ManifestGenerationLimits {
+	if opts.ManifestGenerationLimits == (appsv1.ManifestGenerationLimits{}) {
+		return nil
+	}
+	limits := opts.ManifestGenerationLimits
+	return &limits
+}
+
+// GetSubmodulePolicy returns the submodule policy set with the flags, or nil if none is set
+func (opts *RepoOptions) GetSubmodulePolicy() *appsv1.SubmodulePolicy {
+	policy := opts.SubmodulePolicy
+	if !policy.Disabled && policy.RecursionDepth == 0 && len(policy.CredentialMappings) == 0 {
+		return nil
+	}
+	return &policy
+}
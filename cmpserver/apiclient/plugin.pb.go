@@ -130,10 +130,12 @@ type ManifestRequestMetadata struct {
 	// size relates to the file size in bytes
 	Size_ int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	// env is a list with the environment variables needed to generate manifests
-	Env                  []*EnvEntry `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Env []*EnvEntry `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty"`
+	// limits are the resource limits of the repository of the application
+	Limits               *ManifestGenerationLimits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *ManifestRequestMetadata) Reset()         { *m = ManifestRequestMetadata{} }
@@ -204,6 +206,80 @@ func (m *ManifestRequestMetadata) GetEnv() []*EnvEntry {
 	return nil
 }
 
+func (m *ManifestRequestMetadata) GetLimits() *ManifestGenerationLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+// ManifestGenerationLimits defines the resources that each manifest generation command is allowed to use
+type ManifestGenerationLimits struct {
+	// cpuTime is the CPU time after which the command is terminated, e.g. 30s
+	CpuTime string `protobuf:"bytes,1,opt,name=cpuTime,proto3" json:"cpuTime,omitempty"`
+	// memory is the maximum memory that the command can allocate, e.g. 512Mi
+	Memory string `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// timeout is the wall time after which the command is terminated, e.g. 2m
+	Timeout              string   `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestGenerationLimits) Reset()         { *m = ManifestGenerationLimits{} }
+func (m *ManifestGenerationLimits) String() string { return proto.CompactTextString(m) }
+func (*ManifestGenerationLimits) ProtoMessage()    {}
+func (*ManifestGenerationLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b21875a7079a06ed, []int{2}
+}
+func (m *ManifestGenerationLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestGenerationLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestGenerationLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestGenerationLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestGenerationLimits.Merge(m, src)
+}
+func (m *ManifestGenerationLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestGenerationLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestGenerationLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestGenerationLimits proto.InternalMessageInfo
+
+func (m *ManifestGenerationLimits) GetCpuTime() string {
+	if m != nil {
+		return m.CpuTime
+	}
+	return ""
+}
+
+func (m *ManifestGenerationLimits) GetMemory() string {
+	if m != nil {
+		return m.Memory
+	}
+	return ""
+}
+
+func (m *ManifestGenerationLimits) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
 // EnvEntry represents an entry in the application's environment
 type EnvEntry struct {
 	// Name is the name of the variable, usually expressed in uppercase
@@ -219,7 +295,7 @@ func (m *EnvEntry) Reset()         { *m = EnvEntry{} }
 func (m *EnvEntry) String() string { return proto.CompactTextString(m) }
 func (*EnvEntry) ProtoMessage()    {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{2}
+	return fileDescriptor_b21875a7079a06ed, []int{3}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +350,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{3}
+	return fileDescriptor_b21875a7079a06ed, []int{4}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +405,7 @@ func (m *RepositoryResponse) Reset()         { *m = RepositoryResponse{} }
 func (m *RepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*RepositoryResponse) ProtoMessage()    {}
 func (*RepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{4}
+	return fileDescriptor_b21875a7079a06ed, []int{5}
 }
 func (m *RepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +461,7 @@ func (m *ParametersAnnouncementResponse) Reset()         { *m = ParametersAnnoun
 func (m *ParametersAnnouncementResponse) String() string { return proto.CompactTextString(m) }
 func (*ParametersAnnouncementResponse) ProtoMessage()    {}
 func (*ParametersAnnouncementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{5}
+	return fileDescriptor_b21875a7079a06ed, []int{6}
 }
 func (m *ParametersAnnouncementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,7 +508,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{6}
+	return fileDescriptor_b21875a7079a06ed, []int{7}
 }
 func (m *File) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +557,7 @@ func (m *CheckPluginConfigurationResponse) Reset()         { *m = CheckPluginCon
 func (m *CheckPluginConfigurationResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckPluginConfigurationResponse) ProtoMessage()    {}
 func (*CheckPluginConfigurationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b21875a7079a06ed, []int{7}
+	return fileDescriptor_b21875a7079a06ed, []int{8}
 }
 func (m *CheckPluginConfigurationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,6 +603,7 @@ func (m *CheckPluginConfigurationResponse) GetProvideGitCreds() bool {
 func init() {
 	proto.RegisterType((*AppStreamRequest)(nil), "plugin.AppStreamRequest")
 	proto.RegisterType((*ManifestRequestMetadata)(nil), "plugin.ManifestRequestMetadata")
+	proto.RegisterType((*ManifestGenerationLimits)(nil), "plugin.ManifestGenerationLimits")
 	proto.RegisterType((*EnvEntry)(nil), "plugin.EnvEntry")
 	proto.RegisterType((*ManifestResponse)(nil), "plugin.ManifestResponse")
 	proto.RegisterType((*RepositoryResponse)(nil), "plugin.RepositoryResponse")
@@ -538,49 +615,53 @@ func init() {
 func init() { proto.RegisterFile("cmpserver/plugin/plugin.proto", fileDescriptor_b21875a7079a06ed) }
 
 var fileDescriptor_b21875a7079a06ed = []byte{
-	// 668 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x9b, 0xb4, 0x4d, 0x26, 0x95, 0x1a, 0xad, 0xa0, 0x98, 0xd0, 0x86, 0xe0, 0x03, 0xca,
-	0x05, 0x47, 0x4a, 0x7b, 0x45, 0xa2, 0x2d, 0xa1, 0x15, 0x28, 0x28, 0x72, 0xb9, 0xc0, 0x01, 0x69,
-	0xe3, 0x4c, 0x92, 0xa5, 0xf6, 0xee, 0xb2, 0x5e, 0x5b, 0x0a, 0x5c, 0x10, 0x2f, 0xc3, 0xab, 0x70,
-	0xe4, 0x11, 0x50, 0x5f, 0x83, 0x0b, 0xf2, 0xda, 0x4e, 0xa2, 0x36, 0x6d, 0x4f, 0x9e, 0xbf, 0xfd,
-	0xfc, 0xcd, 0xec, 0x37, 0x0b, 0x07, 0x7e, 0x28, 0x23, 0x54, 0x09, 0xaa, 0xae, 0x0c, 0xe2, 0x29,
-	0xe3, 0xf9, 0xc7, 0x95, 0x4a, 0x68, 0x41, 0xb6, 0x32, 0xaf, 0xd9, 0x9f, 0x32, 0x3d, 0x8b, 0x47,
-	0xae, 0x2f, 0xc2, 0x2e, 0x55, 0x53, 0x21, 0x95, 0xf8, 0x62, 0x8c, 0x17, 0xfe, 0xb8, 0x9b, 0x1c,
-	0x76, 0x15, 0x4a, 0x91, 0xc3, 0x18, 0x93, 0x69, 0xa1, 0xe6, 0x2b, 0x66, 0x06, 0xd7, 0x7c, 0x32,
-	0x15, 0x62, 0x1a, 0x60, 0xd7, 0x78, 0xa3, 0x78, 0xd2, 0xc5, 0x50, 0xea, 0x3c, 0xe9, 0xfc, 0xb0,
-	0xa0, 0x71, 0x2c, 0xe5, 0x85, 0x56, 0x48, 0x43, 0x0f, 0xbf, 0xc6, 0x18, 0x69, 0xf2, 0x12, 0xaa,
-	0x21, 0x6a, 0x3a, 0xa6, 0x9a, 0xda, 0x56, 0xdb, 0xea, 0xd4, 0x7b, 0x4f, 0xdd, 0x9c, 0xe1, 0x80,
-	0x72, 0x36, 0xc1, 0x48, 0xe7, 0xa5, 0x83, 0xbc, 0xec, 0xbc, 0xe4, 0x2d, 0x8e, 0x10, 0x07, 0x2a,
-	0x13, 0x16, 0xa0, 0xbd, 0x61, 0x8e, 0xee, 0x14, 0x47, 0xdf, 0xb0, 0x00, 0xcf, 0x4b, 0x9e, 0xc9,
-	0x9d, 0xd4, 0x60, 0x5b, 0x65, 0x10, 0xce, 0x2f, 0x0b, 0x1e, 0xdd, 0x02, 0x4b, 0x6c, 0xd8, 0xa6,
-	0x52, 0xbe, 0xa7, 0x21, 0x1a, 0x22, 0x35, 0xaf, 0x70, 0x49, 0x0b, 0x80, 0x4a, 0xe9, 0x61, 0x30,
-	0xa4, 0x7a, 0x66, 0x7e, 0x55, 0xf3, 0x56, 0x22, 0xa4, 0x09, 0x55, 0x7f, 0x86, 0xfe, 0x65, 0x14,
-	0x87, 0x76, 0xd9, 0x64, 0x17, 0x3e, 0x21, 0x50, 0x89, 0xd8, 0x37, 0xb4, 0x2b, 0x6d, 0xab, 0x53,
-	0xf6, 0x8c, 0x4d, 0x1c, 0x28, 0x23, 0x4f, 0xec, 0xcd, 0x76, 0xb9, 0x53, 0xef, 0x35, 0x0a, 0xce,
-	0x7d, 0x9e, 0xf4, 0xb9, 0x56, 0x73, 0x2f, 0x4d, 0x3a, 0x47, 0x50, 0x2d, 0x02, 0x29, 0x06, 0x5f,
-	0xd2, 0x32, 0x36, 0x79, 0x00, 0x9b, 0x09, 0x0d, 0x62, 0xcc, 0xe9, 0x64, 0x8e, 0x33, 0x84, 0xc6,
-	0xb2, 0xbd, 0x48, 0x0a, 0x1e, 0x21, 0xd9, 0x87, 0x5a, 0x98, 0xc7, 0x22, 0xdb, 0x6a, 0x97, 0x3b,
-	0x35, 0x6f, 0x19, 0x48, 0x7b, 0x8b, 0x44, 0xac, 0x7c, 0xfc, 0x30, 0x97, 0x05, 0xd8, 0x4a, 0xc4,
-	0x99, 0x00, 0xf1, 0x16, 0xb7, 0xbc, 0xc0, 0x6c, 0x43, 0x9d, 0x45, 0x17, 0xb1, 0x94, 0x42, 0x69,
-	0x1c, 0x1b, 0x62, 0x55, 0x6f, 0x35, 0x44, 0x5c, 0x20, 0x2c, 0x7a, 0xcd, 0x22, 0x5f, 0x24, 0xa8,
-	0xe6, 0x7d, 0x4e, 0x47, 0x01, 0x8e, 0x0d, 0x7e, 0xd5, 0x5b, 0x93, 0x71, 0xbe, 0x43, 0x6b, 0x48,
-	0x15, 0x0d, 0x51, 0xa3, 0x8a, 0x8e, 0x39, 0x17, 0x31, 0xf7, 0x31, 0x44, 0xbe, 0xec, 0xe3, 0x23,
-	0xec, 0xc9, 0xa2, 0x62, 0xb5, 0x20, 0x6b, 0xaa, 0xde, 0x7b, 0xe6, 0xae, 0xc8, 0x71, 0xb8, 0xae,
-	0xd2, 0xbb, 0x05, 0xc0, 0xd9, 0x87, 0x4a, 0xaa, 0x98, 0x74, 0xa8, 0xfe, 0x2c, 0xe6, 0x97, 0xa6,
-	0xa1, 0x1d, 0x2f, 0x73, 0x9c, 0x9f, 0x16, 0xb4, 0x4f, 0xd3, 0xfb, 0x1c, 0x9a, 0x8b, 0x3a, 0x15,
-	0x7c, 0xc2, 0xa6, 0xb1, 0xa2, 0x9a, 0x09, 0xbe, 0x60, 0x77, 0x04, 0x0f, 0x57, 0xba, 0x2a, 0x6a,
-	0x16, 0xb3, 0x59, 0x9f, 0x24, 0x1d, 0xd8, 0x95, 0x4a, 0x24, 0x6c, 0x8c, 0x67, 0x4c, 0x9f, 0x2a,
-	0x1c, 0x47, 0xf9, 0x88, 0xae, 0x87, 0x7b, 0xff, 0x36, 0xe0, 0x20, 0x3b, 0x38, 0xa0, 0x9c, 0x4e,
-	0x0d, 0xf1, 0x8c, 0xcf, 0x05, 0xaa, 0x84, 0xf9, 0x48, 0xde, 0x42, 0xe3, 0x0c, 0x39, 0x2a, 0xaa,
-	0xb1, 0xd0, 0x00, 0xb1, 0x0b, 0x71, 0x5d, 0xdf, 0xbb, 0xa6, 0x7d, 0x73, 0xcb, 0xb2, 0x4e, 0x9c,
-	0x52, 0xc7, 0x22, 0x9f, 0xc1, 0xbe, 0xad, 0x63, 0xb2, 0xe7, 0x66, 0x4b, 0xee, 0x16, 0x4b, 0xee,
-	0xf6, 0xd3, 0x25, 0x6f, 0x76, 0x0a, 0xc4, 0xfb, 0x66, 0xe5, 0x94, 0xc8, 0x3b, 0xd8, 0x1d, 0x50,
-	0xed, 0xcf, 0x96, 0xd2, 0xba, 0x83, 0x6a, 0xb3, 0xc8, 0xdc, 0x14, 0xa2, 0x21, 0x4b, 0xe1, 0xf1,
-	0x19, 0xea, 0xf5, 0xea, 0xb9, 0x03, 0xf6, 0x79, 0x91, 0xb9, 0x5b, 0x77, 0xe9, 0x2f, 0x4e, 0x5e,
-	0xfd, 0xbe, 0x6a, 0x59, 0x7f, 0xae, 0x5a, 0xd6, 0xdf, 0xab, 0x96, 0xf5, 0xa9, 0x77, 0xcf, 0x63,
-	0xb9, 0x7c, 0x72, 0xa9, 0x64, 0x7e, 0xc0, 0x90, 0xeb, 0xd1, 0x96, 0x99, 0xd6, 0xe1, 0xff, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xb0, 0x8d, 0xb8, 0x47, 0x90, 0x05, 0x00, 0x00,
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xda, 0x48,
+	0x14, 0xc6, 0x81, 0x10, 0x78, 0x44, 0x0a, 0x1a, 0xed, 0x66, 0xbd, 0x6c, 0xc2, 0xb2, 0x3e, 0xac,
+	0xb8, 0xac, 0x91, 0x48, 0x0e, 0x7b, 0x59, 0x69, 0x93, 0x2c, 0x9b, 0xa8, 0x2d, 0x15, 0x72, 0x72,
+	0x69, 0x0f, 0x95, 0x06, 0xf3, 0x80, 0x69, 0xec, 0x99, 0xe9, 0x78, 0x6c, 0x89, 0xf6, 0x52, 0xf5,
+	0xaf, 0xeb, 0xb1, 0x7f, 0x42, 0x9b, 0x7f, 0xa3, 0x97, 0xca, 0x63, 0x1b, 0x50, 0x02, 0xc9, 0x89,
+	0x79, 0x3f, 0xe6, 0xf3, 0xf7, 0x3d, 0x7f, 0x3c, 0xc3, 0xb1, 0x1f, 0xca, 0x08, 0x55, 0x82, 0xaa,
+	0x27, 0x83, 0x78, 0xc6, 0x78, 0xfe, 0xe3, 0x4a, 0x25, 0xb4, 0x20, 0xd5, 0x2c, 0x6a, 0x0d, 0x66,
+	0x4c, 0xcf, 0xe3, 0xb1, 0xeb, 0x8b, 0xb0, 0x47, 0xd5, 0x4c, 0x48, 0x25, 0xde, 0x9a, 0xc3, 0x5f,
+	0xfe, 0xa4, 0x97, 0x9c, 0xf4, 0x14, 0x4a, 0x91, 0xc3, 0x98, 0x23, 0xd3, 0x42, 0x2d, 0xd6, 0x8e,
+	0x19, 0x5c, 0xeb, 0xb7, 0x99, 0x10, 0xb3, 0x00, 0x7b, 0x26, 0x1a, 0xc7, 0xd3, 0x1e, 0x86, 0x52,
+	0xe7, 0x45, 0xe7, 0xa3, 0x05, 0xcd, 0x33, 0x29, 0xaf, 0xb5, 0x42, 0x1a, 0x7a, 0xf8, 0x2e, 0xc6,
+	0x48, 0x93, 0x7f, 0xa0, 0x16, 0xa2, 0xa6, 0x13, 0xaa, 0xa9, 0x6d, 0x75, 0xac, 0x6e, 0xa3, 0xff,
+	0xbb, 0x9b, 0x33, 0x1c, 0x52, 0xce, 0xa6, 0x18, 0xe9, 0xbc, 0x75, 0x98, 0xb7, 0x5d, 0x95, 0xbc,
+	0xe5, 0x15, 0xe2, 0x40, 0x65, 0xca, 0x02, 0xb4, 0x77, 0xcc, 0xd5, 0xfd, 0xe2, 0xea, 0xff, 0x2c,
+	0xc0, 0xab, 0x92, 0x67, 0x6a, 0xe7, 0x75, 0xd8, 0x53, 0x19, 0x84, 0xf3, 0xcd, 0x82, 0x5f, 0xb6,
+	0xc0, 0x12, 0x1b, 0xf6, 0xa8, 0x94, 0x2f, 0x69, 0x88, 0x86, 0x48, 0xdd, 0x2b, 0x42, 0xd2, 0x06,
+	0xa0, 0x52, 0x7a, 0x18, 0x8c, 0xa8, 0x9e, 0x9b, 0x47, 0xd5, 0xbd, 0xb5, 0x0c, 0x69, 0x41, 0xcd,
+	0x9f, 0xa3, 0x7f, 0x1b, 0xc5, 0xa1, 0x5d, 0x36, 0xd5, 0x65, 0x4c, 0x08, 0x54, 0x22, 0xf6, 0x1e,
+	0xed, 0x4a, 0xc7, 0xea, 0x96, 0x3d, 0x73, 0x26, 0x0e, 0x94, 0x91, 0x27, 0xf6, 0x6e, 0xa7, 0xdc,
+	0x6d, 0xf4, 0x9b, 0x05, 0xe7, 0x01, 0x4f, 0x06, 0x5c, 0xab, 0x85, 0x97, 0x16, 0xc9, 0xdf, 0x50,
+	0x0d, 0x58, 0xc8, 0x74, 0x64, 0x57, 0x8d, 0xb4, 0xce, 0xfd, 0xa9, 0x5c, 0x22, 0x47, 0x45, 0x35,
+	0x13, 0xfc, 0x85, 0xe9, 0xf3, 0xf2, 0x7e, 0x67, 0x0a, 0xf6, 0xb6, 0x9e, 0x54, 0xa3, 0x2f, 0xe3,
+	0x1b, 0xb6, 0xd2, 0x98, 0x87, 0xe4, 0x10, 0xaa, 0x21, 0x86, 0x42, 0x2d, 0x72, 0x7d, 0x79, 0x94,
+	0xde, 0xd0, 0x2c, 0x44, 0x11, 0xeb, 0x5c, 0x5a, 0x11, 0x3a, 0xa7, 0x50, 0x2b, 0x28, 0xa7, 0x2a,
+	0xf9, 0x6a, 0x70, 0xe6, 0x4c, 0x7e, 0x82, 0xdd, 0x84, 0x06, 0x31, 0xe6, 0x80, 0x59, 0xe0, 0x8c,
+	0xa0, 0xb9, 0x7a, 0x01, 0x91, 0x14, 0x3c, 0x42, 0x72, 0x04, 0xf5, 0x30, 0xcf, 0x45, 0xb6, 0xd5,
+	0x29, 0x77, 0xeb, 0xde, 0x2a, 0x91, 0x4e, 0x3f, 0x12, 0xb1, 0xf2, 0xf1, 0x66, 0x21, 0x0b, 0xb0,
+	0xb5, 0x8c, 0x33, 0x05, 0xe2, 0x2d, 0x7d, 0xb8, 0xc4, 0xec, 0x40, 0x83, 0x45, 0xd7, 0xb1, 0x94,
+	0x42, 0x69, 0x9c, 0x18, 0x62, 0x35, 0x6f, 0x3d, 0x45, 0x5c, 0x20, 0x2c, 0xfa, 0x8f, 0x45, 0xbe,
+	0x48, 0x50, 0x2d, 0x06, 0x9c, 0x8e, 0x03, 0x9c, 0x18, 0xfc, 0x9a, 0xb7, 0xa1, 0xe2, 0x7c, 0x80,
+	0xf6, 0x88, 0x2a, 0x1a, 0xa2, 0x46, 0x15, 0x9d, 0x71, 0x2e, 0x62, 0xee, 0x63, 0x88, 0x7c, 0xa5,
+	0xe3, 0x15, 0x1c, 0xca, 0xa2, 0x63, 0xbd, 0x21, 0x13, 0xd5, 0xe8, 0xff, 0xe1, 0xae, 0xfd, 0x61,
+	0x46, 0x9b, 0x3a, 0xbd, 0x2d, 0x00, 0xce, 0x11, 0x54, 0x52, 0x4f, 0xa7, 0x43, 0xf5, 0xe7, 0x31,
+	0xbf, 0x35, 0x82, 0xf6, 0xbd, 0x2c, 0x70, 0x3e, 0x59, 0xd0, 0xb9, 0x48, 0x1d, 0x37, 0x32, 0x1e,
+	0xb9, 0x10, 0x7c, 0xca, 0x66, 0x71, 0xf6, 0xe6, 0x97, 0xec, 0x4e, 0xe1, 0xe7, 0x35, 0x55, 0x45,
+	0xcf, 0x72, 0x36, 0x9b, 0x8b, 0xa4, 0x0b, 0x07, 0x52, 0x89, 0x84, 0x4d, 0xf0, 0x92, 0xe9, 0x0b,
+	0x85, 0x93, 0x28, 0x1f, 0xd1, 0xfd, 0x74, 0xff, 0xfb, 0x0e, 0x1c, 0x67, 0x17, 0x87, 0x94, 0xd3,
+	0x99, 0x21, 0x9e, 0xf1, 0xb9, 0x46, 0x95, 0x30, 0x1f, 0xc9, 0x33, 0x68, 0xe6, 0x8e, 0xc4, 0xc2,
+	0x03, 0xc4, 0x2e, 0x7c, 0x7d, 0x7f, 0x33, 0xb4, 0xec, 0x87, 0x7b, 0x20, 0x53, 0xe2, 0x94, 0xba,
+	0x16, 0x79, 0x03, 0xf6, 0x36, 0xc5, 0xe4, 0xd0, 0xcd, 0xd6, 0x90, 0x5b, 0xac, 0x21, 0x77, 0x90,
+	0xae, 0xa1, 0x56, 0xb7, 0x40, 0x7c, 0x6a, 0x56, 0x4e, 0x89, 0x3c, 0x87, 0x83, 0x21, 0xd5, 0xfe,
+	0x7c, 0x65, 0xad, 0x47, 0xa8, 0xb6, 0x8a, 0xca, 0x43, 0x23, 0x1a, 0xb2, 0x14, 0x7e, 0xbd, 0x44,
+	0xbd, 0xd9, 0x3d, 0x8f, 0xc0, 0xfe, 0x59, 0x54, 0x1e, 0xf7, 0x5d, 0xfa, 0x88, 0xf3, 0x7f, 0x3f,
+	0xdf, 0xb5, 0xad, 0x2f, 0x77, 0x6d, 0xeb, 0xeb, 0x5d, 0xdb, 0x7a, 0xdd, 0x7f, 0x62, 0x9d, 0xaf,
+	0x3e, 0x0a, 0x54, 0x32, 0x3f, 0x60, 0xc8, 0xf5, 0xb8, 0x6a, 0xa6, 0x75, 0xf2, 0x63, 0x00, 0x83,
+	0xf6, 0x74, 0x98, 0x32, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -988,6 +1069,18 @@ func (m *ManifestRequestMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Limits != nil {
+		{
+			size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlugin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Env) > 0 {
 		for iNdEx := len(m.Env) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1031,6 +1124,54 @@ func (m *ManifestRequestMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestGenerationLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestGenerationLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestGenerationLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Timeout) > 0 {
+		i -= len(m.Timeout)
+		copy(dAtA[i:], m.Timeout)
+		i = encodeVarintPlugin(dAtA, i, uint64(len(m.Timeout)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Memory) > 0 {
+		i -= len(m.Memory)
+		copy(dAtA[i:], m.Memory)
+		i = encodeVarintPlugin(dAtA, i, uint64(len(m.Memory)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CpuTime) > 0 {
+		i -= len(m.CpuTime)
+		copy(dAtA[i:], m.CpuTime)
+		i = encodeVarintPlugin(dAtA, i, uint64(len(m.CpuTime)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EnvEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1361,6 +1502,34 @@ func (m *ManifestRequestMetadata) Size() (n int) {
 			n += 1 + l + sovPlugin(uint64(l))
 		}
 	}
+	if m.Limits != nil {
+		l = m.Limits.Size()
+		n += 1 + l + sovPlugin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestGenerationLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CpuTime)
+	if l > 0 {
+		n += 1 + l + sovPlugin(uint64(l))
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovPlugin(uint64(l))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovPlugin(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1784,6 +1953,189 @@ func (m *ManifestRequestMetadata) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = &ManifestGenerationLimits{}
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlugin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestGenerationLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlugin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestGenerationLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestGenerationLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CpuTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlugin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlugin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlugin(dAtA[iNdEx:])
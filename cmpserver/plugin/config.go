@@ -10,6 +10,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	configUtil "github.com/argoproj/argo-cd/v3/util/config"
+	argoexec "github.com/argoproj/argo-cd/v3/util/exec"
 )
 
 const (
@@ -30,6 +31,19 @@ type PluginConfigSpec struct {
 	Parameters       Parameters `yaml:"parameters"`
 	PreserveFileMode bool       `json:"preserveFileMode,omitempty"`
 	ProvideGitCreds  bool       `json:"provideGitCreds,omitempty"`
+	Limits           Limits     `json:"limits,omitempty"`
+}
+
+// Limits holds the resources that the init and generate commands are allowed to use
+type Limits struct {
+	CPUTime string `json:"cpuTime,omitempty"`
+	Memory  string `json:"memory,omitempty"`
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// ResourceLimits parses the limits
+func (l Limits) ResourceLimits() (argoexec.ResourceLimits, error) {
+	return argoexec.ParseResourceLimits(l.CPUTime, l.Memory, l.Timeout)
 }
 
 // Discover holds find and fileName
@@ -92,6 +106,9 @@ func ValidatePluginConfig(config PluginConfig) error {
 	if len(config.Spec.Generate.Command) == 0 {
 		return errors.New("invalid plugin configuration file. spec.generate command should be non-empty")
 	}
+	if _, err := config.Spec.Limits.ResourceLimits(); err != nil {
+		return fmt.Errorf("invalid plugin configuration file. spec.limits: %w", err)
+	}
 	// discovery field is optional as apps can now specify plugin names directly
 	return nil
 }
@@ -123,6 +123,21 @@ metadata:
 			expected:    nil,
 			expectedErr: "invalid plugin configuration file. spec.generate command should be non-empty",
 		},
+		{
+			name: "invalid limits",
+			fileContents: `
+kind: ConfigManagementPlugin
+metadata:
+  name: name
+spec:
+  generate:
+    command: [command]
+  limits:
+    cpuTime: "30"
+`,
+			expected:    nil,
+			expectedErr: "invalid plugin configuration file. spec.limits: invalid cpu time limit \"30\": time: missing unit in duration \"30\"",
+		},
 		{
 			name: "valid config",
 			fileContents: `
@@ -28,6 +28,8 @@ import (
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/mattn/go-zglob"
 	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // cmpTimeoutBuffer is the amount of time before the request deadline to timeout server-side work. It makes sure there's
@@ -73,9 +75,20 @@ func randExecID() (string, error) {
 }
 
 func runCommand(ctx context.Context, command Command, path string, env []string) (string, error) {
+	return runCommandWithLimits(ctx, command, path, env, argoexec.ResourceLimits{})
+}
+
+// runCommandWithLimits runs the command, terminating it and its child processes if it exceeds the given resource limits
+func runCommandWithLimits(ctx context.Context, command Command, path string, env []string, limits argoexec.ResourceLimits) (string, error) {
 	if len(command.Command) == 0 {
 		return "", errors.New("Command is empty")
 	}
+	parentCtx := ctx
+	if limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limits.Timeout)
+		defer cancel()
+	}
 	cmd := exec.CommandContext(ctx, command.Command[0], append(command.Command[1:], command.Args...)...)
 
 	cmd.Env = env
@@ -103,6 +116,11 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 	if err != nil {
 		return "", err
 	}
+	if err := argoexec.SetResourceLimits(cmd, limits); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return "", err
+	}
 
 	go func() {
 		<-ctx.Done()
@@ -127,7 +145,13 @@ func runCommand(ctx context.Context, command Command, path string, env []string)
 	logCtx.WithFields(log.Fields{"duration": duration}).Debug(output)
 
 	if err != nil {
-		err := newCmdError(argsToLog, errors.New(err.Error()), strings.TrimSpace(stderr.String()))
+		cause := errors.New(err.Error())
+		if limits.Timeout > 0 && errors.Is(ctx.Err(), context.DeadlineExceeded) && parentCtx.Err() == nil {
+			cause = &argoexec.ResourceLimitExceededError{Command: filepath.Base(cmd.Path), Limit: fmt.Sprintf("timeout of %v", limits.Timeout)}
+		} else if limitErr := argoexec.ResourceLimitExceeded(cmd, limits, stderr.String()); limitErr != nil {
+			cause = limitErr
+		}
+		err := newCmdError(argsToLog, cause, strings.TrimSpace(stderr.String()))
 		logCtx.Error(err.Error())
 		return strings.TrimSuffix(output, "\n"), err
 	}
@@ -160,6 +184,10 @@ func (ce *CmdError) Error() string {
 	return res
 }
 
+func (ce *CmdError) Unwrap() error {
+	return ce.Cause
+}
+
 func newCmdError(args string, cause error, stderr string) *CmdError {
 	return &CmdError{Args: args, Stderr: stderr, Cause: cause}
 }
@@ -225,8 +253,12 @@ func (s *Service) generateManifestGeneric(stream GenerateManifestStream) error {
 	if !strings.HasPrefix(appPath, workDir) {
 		return errors.New("illegal appPath: out of workDir bound")
 	}
-	response, err := s.generateManifest(ctx, appPath, metadata.GetEnv())
+	response, err := s.generateManifest(ctx, appPath, metadata.GetEnv(), metadata.GetLimits())
 	if err != nil {
+		var limitErr *argoexec.ResourceLimitExceededError
+		if errors.As(err, &limitErr) {
+			return status.Errorf(codes.ResourceExhausted, "error generating manifests: %v", err)
+		}
 		return fmt.Errorf("error generating manifests: %w", err)
 	}
 
@@ -239,8 +271,9 @@ func (s *Service) generateManifestGeneric(stream GenerateManifestStream) error {
 	return nil
 }
 
-// generateManifest runs generate command from plugin config file and returns generated manifest files
-func (s *Service) generateManifest(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry) (*apiclient.ManifestResponse, error) {
+// generateManifest runs generate command from plugin config file and returns generated manifest files. The commands are
+// limited to the strictest of the limits of the plugin and of the repository of the application.
+func (s *Service) generateManifest(ctx context.Context, appDir string, envEntries []*apiclient.EnvEntry, repoLimits *apiclient.ManifestGenerationLimits) (*apiclient.ManifestResponse, error) {
 	if deadline, ok := ctx.Deadline(); ok {
 		log.Infof("Generating manifests with deadline %v from now", time.Until(deadline))
 	} else {
@@ -249,15 +282,27 @@ func (s *Service) generateManifest(ctx context.Context, appDir string, envEntrie
 
 	config := s.initConstants.PluginConfig
 
+	limits, err := config.Spec.Limits.ResourceLimits()
+	if err != nil {
+		return &apiclient.ManifestResponse{}, err
+	}
+	if repoLimits != nil {
+		parsedRepoLimits, err := argoexec.ParseResourceLimits(repoLimits.CpuTime, repoLimits.Memory, repoLimits.Timeout)
+		if err != nil {
+			return &apiclient.ManifestResponse{}, fmt.Errorf("invalid repository limits: %w", err)
+		}
+		limits = limits.Merge(parsedRepoLimits)
+	}
+
 	env := append(os.Environ(), environ(envEntries)...)
 	if len(config.Spec.Init.Command) > 0 {
-		_, err := runCommand(ctx, config.Spec.Init, appDir, env)
+		_, err := runCommandWithLimits(ctx, config.Spec.Init, appDir, env, limits)
 		if err != nil {
 			return &apiclient.ManifestResponse{}, err
 		}
 	}
 
-	out, err := runCommand(ctx, config.Spec.Generate, appDir, env)
+	out, err := runCommandWithLimits(ctx, config.Spec.Generate, appDir, env, limits)
 	if err != nil {
 		return &apiclient.ManifestResponse{}, err
 	}
@@ -22,6 +22,7 @@ import (
 	repoclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	argoexec "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/tgzstream"
 )
 
@@ -323,7 +324,7 @@ func TestGenerateManifest(t *testing.T) {
 		service, err := newService(configFilePath)
 		require.NoError(t, err)
 
-		res1, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res1, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.NoError(t, err)
 		require.NotNil(t, res1)
 
@@ -338,7 +339,7 @@ func TestGenerateManifest(t *testing.T) {
 		require.NoError(t, err)
 		service.WithGenerateCommand(Command{Command: []string{"bad-command"}})
 
-		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.ErrorContains(t, err, "executable file not found")
 		assert.Nil(t, res.Manifests)
 	})
@@ -348,12 +349,52 @@ func TestGenerateManifest(t *testing.T) {
 		require.NoError(t, err)
 		service.WithGenerateCommand(Command{Command: []string{"echo", "invalid yaml: }"}})
 
-		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil)
+		res, err := service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
 		require.ErrorContains(t, err, "failed to unmarshal manifest")
 		assert.Nil(t, res.Manifests)
 	})
 }
 
+func TestGenerateManifest_limits(t *testing.T) {
+	t.Parallel()
+	configFilePath := "./testdata/kustomize/config"
+
+	t.Run("plugin limits", func(t *testing.T) {
+		t.Parallel()
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"sleep", "5"}})
+		service.initConstants.PluginConfig.Spec.Init = Command{}
+		service.initConstants.PluginConfig.Spec.Limits = Limits{Timeout: "200ms"}
+
+		_, err = service.generateManifest(t.Context(), "testdata/kustomize", nil, nil)
+		var limitErr *argoexec.ResourceLimitExceededError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, "timeout of 200ms", limitErr.Limit)
+	})
+	t.Run("repository limits", func(t *testing.T) {
+		t.Parallel()
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+		service.WithGenerateCommand(Command{Command: []string{"sleep", "5"}})
+		service.initConstants.PluginConfig.Spec.Init = Command{}
+		service.initConstants.PluginConfig.Spec.Limits = Limits{Timeout: "1m"}
+
+		_, err = service.generateManifest(t.Context(), "testdata/kustomize", nil, &apiclient.ManifestGenerationLimits{Timeout: "100ms"})
+		var limitErr *argoexec.ResourceLimitExceededError
+		require.ErrorAs(t, err, &limitErr)
+		assert.Equal(t, "timeout of 100ms", limitErr.Limit)
+	})
+	t.Run("invalid repository limits", func(t *testing.T) {
+		t.Parallel()
+		service, err := newService(configFilePath)
+		require.NoError(t, err)
+
+		_, err = service.generateManifest(t.Context(), "testdata/kustomize", nil, &apiclient.ManifestGenerationLimits{Memory: "lots"})
+		require.ErrorContains(t, err, "invalid repository limits")
+	})
+}
+
 func TestGenerateManifest_deadline_exceeded(t *testing.T) {
 	t.Parallel()
 	configFilePath := "./testdata/kustomize/config"
@@ -362,7 +403,7 @@ func TestGenerateManifest_deadline_exceeded(t *testing.T) {
 
 	expiredCtx, cancel := context.WithTimeout(t.Context(), time.Second*0)
 	defer cancel()
-	_, err = service.generateManifest(expiredCtx, "", nil)
+	_, err = service.generateManifest(expiredCtx, "", nil, nil)
 	require.ErrorContains(t, err, "context deadline exceeded")
 }
 
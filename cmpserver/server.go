@@ -50,12 +50,14 @@ func NewServer(initConstants plugin.CMPServerInitConstants) (*ArgoCDCMPServer, e
 
 	serverLog := log.NewEntry(log.StandardLogger())
 	streamInterceptors := []grpc.StreamServerInterceptor{
-		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		grpc_util.CorrelationIDStreamServerInterceptor(),
+		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
 		serverMetrics.StreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		grpc_util.CorrelationIDUnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
 		serverMetrics.UnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 	}
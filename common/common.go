@@ -31,6 +31,7 @@ const (
 	CommandGitAskPass               = "argocd-git-ask-pass"
 	CommandNotifications            = "argocd-notifications"
 	CommandK8sAuth                  = "argocd-k8s-auth"
+	CommandClusterAgent             = "argocd-cluster-agent"
 	CommandDex                      = "argocd-dex"
 	CommandRepoServer               = "argocd-repo-server"
 )
@@ -62,6 +63,8 @@ const (
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
+	// ArgoCDRevokedTokensConfigMapName contains the revoked tokens of the API server when no Redis is configured
+	ArgoCDRevokedTokensConfigMapName = "argocd-revoked-tokens"
 )
 
 // Some default configurables
@@ -77,6 +80,7 @@ const (
 	DefaultPortArgoCDMetrics          = 8082
 	DefaultPortArgoCDAPIServerMetrics = 8083
 	DefaultPortRepoServerMetrics      = 8084
+	DefaultPortAPIServerAdmission     = 8085
 	DefaultPortCommitServer           = 8086
 	DefaultPortCommitServerMetrics    = 8087
 )
@@ -198,6 +202,10 @@ const (
 	LabelKeySecretType = "argocd.argoproj.io/secret-type"
 	// LabelKeyClusterKubernetesVersion contains the kubernetes version of the cluster secret if it has been enabled
 	LabelKeyClusterKubernetesVersion = "argocd.argoproj.io/kubernetes-version"
+	// LabelKeyClusterAgentMode is set on the cluster secrets of the clusters whose applications are deployed by an agent running in the cluster
+	LabelKeyClusterAgentMode = "argocd.argoproj.io/cluster-agent-mode"
+	// LabelValueClusterAgentModePull indicates that Argo CD does not connect to the cluster, the agent pulls the manifests of its applications instead
+	LabelValueClusterAgentModePull = "pull"
 	// LabelValueSecretTypeCluster indicates a secret type of cluster
 	LabelValueSecretTypeCluster = "cluster"
 	// LabelValueSecretTypeRepository indicates a secret type of repository
@@ -208,6 +216,8 @@ const (
 	LabelValueSecretTypeRepositoryWrite = "repository-write"
 	// LabelValueSecretTypeRepoCredsWrite indicates a secret type of repository credentials for writing for templating
 	LabelValueSecretTypeRepoCredsWrite = "repo-write-creds"
+	// LabelValueSecretTypeClusterRegistration indicates a secret type of cluster registration request
+	LabelValueSecretTypeClusterRegistration = "cluster-registration"
 	// LabelValueSecretTypeSCMCreds indicates a secret type of SCM credentials
 	LabelValueSecretTypeSCMCreds = "scm-creds"
 
@@ -244,10 +254,24 @@ const (
 	// This only disables the default behavior of generating links based on the ingress spec, and does not disable AnnotationKeyLinkPrefix
 	AnnotationKeyIgnoreDefaultLinks = "argocd.argoproj.io/ignore-default-links"
 
+	// AnnotationKeyTraceContextPrefix is the prefix of the annotations which hold the W3C trace context of the request
+	// which initiated the operation of an Application, so that the controller continues the trace of the request.
+	// Ex: trace.argocd.argoproj.io/traceparent
+	AnnotationKeyTraceContextPrefix = "trace.argocd.argoproj.io/"
+
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
 
+	// AnnotationKeyWriteBackMethod tells the API server how to persist parameter changes made to the Application.
+	// When set to AnnotationValueWriteBackMethodGit, changes are committed to the .argocd-source-<app>.yaml file in
+	// the source repository and the Application spec is left untouched.
+	AnnotationKeyWriteBackMethod = "argocd.argoproj.io/write-back-method"
+	// AnnotationValueWriteBackMethodGit is the AnnotationKeyWriteBackMethod value which commits parameter changes to Git
+	AnnotationValueWriteBackMethodGit = "git"
+	// AnnotationKeyWriteBackBranch is the branch parameter changes are committed to. Defaults to the target revision of the source.
+	AnnotationKeyWriteBackBranch = "argocd.argoproj.io/write-back-branch"
+
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -292,6 +316,9 @@ const (
 	EnvControllerHeartbeatTime = "ARGOCD_CONTROLLER_HEARTBEAT_TIME"
 	// EnvControllerShard is the shard number that should be handled by controller
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
+	// EnvImageUpdateInterval is the interval at which the controller checks the images of applications with an image
+	// update policy for updates. Image updates are disabled if it is not set or zero.
+	EnvImageUpdateInterval = "ARGOCD_IMAGE_UPDATE_INTERVAL"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
@@ -308,6 +335,10 @@ const (
 	EnvAppConfigPath = "ARGOCD_APP_CONF_PATH"
 	// EnvAuthToken is the environment variable name for the auth token used by the CLI
 	EnvAuthToken = "ARGOCD_AUTH_TOKEN"
+	// EnvCompletionTimeout is the timeout of the API requests made by the CLI to complete dynamic values in the shell (default: 5s)
+	EnvCompletionTimeout = "ARGOCD_COMPLETION_TIMEOUT"
+	// EnvCompletionCacheTTL is how long the CLI caches the dynamic values completed in the shell (default: 30s)
+	EnvCompletionCacheTTL = "ARGOCD_COMPLETION_CACHE_TTL"
 	// EnvLogFormat log format that is defined by `--logformat` option
 	EnvLogFormat = "ARGOCD_LOG_FORMAT"
 	// EnvLogLevel log level that is defined by `--loglevel` option
@@ -316,6 +347,10 @@ const (
 	EnvLogFormatEnableFullTimestamp = "ARGOCD_LOG_FORMAT_ENABLE_FULL_TIMESTAMP"
 	// EnvLogFormatTimestamp is the timestamp format used in logs
 	EnvLogFormatTimestamp = "ARGOCD_LOG_FORMAT_TIMESTAMP"
+	// EnvLogFormatJSONFieldMap renames the default fields of JSON logs. Format: "time=ts,msg=message,level=severity"
+	EnvLogFormatJSONFieldMap = "ARGOCD_LOG_FORMAT_JSON_FIELD_MAP"
+	// EnvLogRedactFields is a comma separated list of additional log field names whose values are redacted
+	EnvLogRedactFields = "ARGOCD_LOG_REDACT_FIELDS"
 	// EnvMaxCookieNumber max number of chunks a cookie can be broken into
 	EnvMaxCookieNumber = "ARGOCD_MAX_COOKIE_NUMBER"
 	// EnvPluginSockFilePath allows to override the pluginSockFilePath for repo server and cmp server
@@ -443,6 +478,8 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetSyncWave is the wave of an Application generated by an ApplicationSet with the SyncWave strategy. Waves are rolled out in ascending order, and default to 0.
+	AnnotationApplicationSetSyncWave = "argocd.argoproj.io/application-set-sync-wave"
 )
 
 // gRPC settings
@@ -21,7 +21,6 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
-	resourceutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/resource"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
@@ -50,18 +49,21 @@ import (
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/hydrator"
 	hydratortypes "github.com/argoproj/argo-cd/v3/controller/hydrator/types"
+	"github.com/argoproj/argo-cd/v3/controller/imageupdater"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
-	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/controller/webhook"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/pkg/client/informers/externalversions/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/app/writeback"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/askpass"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 
@@ -70,7 +72,6 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/glob"
-	"github.com/argoproj/argo-cd/v3/util/helm"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -88,8 +89,20 @@ const (
 	// appOperationMaxRequeueInterval is the backstop interval at which an in-progress operation
 	// re-enqueues itself. It bounds how long the controller can go without polling an ongoing sync.
 	appOperationMaxRequeueInterval = 30 * time.Second
+	// transientConditionTTL is the time after which the transient conditions of an application age out, unless they
+	// are reported again by a later reconciliation
+	transientConditionTTL = time.Hour
 )
 
+// transientConditionSeverities are the severities of the conditions which only reflect the outcome of a reconciliation
+// or an operation, and age out after transientConditionTTL
+var transientConditionSeverities = map[appv1.ApplicationConditionType]appv1.ApplicationConditionSeverity{
+	appv1.ApplicationConditionComparisonError:         appv1.ApplicationConditionSeverityError,
+	appv1.ApplicationConditionRepeatedResourceWarning: appv1.ApplicationConditionSeverityWarning,
+	appv1.ApplicationConditionSyncError:               appv1.ApplicationConditionSeverityError,
+	appv1.ApplicationConditionDeletionError:           appv1.ApplicationConditionSeverityError,
+}
+
 type CompareWith int
 
 const (
@@ -125,6 +138,11 @@ type ApplicationController struct {
 	auditLogger          *argo.AuditLogger
 	// queue contains app namespace/name
 	appRefreshQueue workqueue.TypedRateLimitingInterface[string]
+	// priority queues backing appRefreshQueue and appOperationQueue
+	appRefreshPriorityQueue   *priorityQueue
+	appOperationPriorityQueue *priorityQueue
+	// queueTracker records the state of the items of appRefreshQueue and appOperationQueue
+	queueTracker *queueTracker
 	// queue contains app namespace/name/comparisonType and used to request app refresh with the predefined comparison type
 	appComparisonTypeRefreshQueue workqueue.TypedRateLimitingInterface[string]
 	appOperationQueue             workqueue.TypedRateLimitingInterface[string]
@@ -154,11 +172,20 @@ type ApplicationController struct {
 	applicationNamespaces         []string
 	ignoreNormalizerOpts          normalizers.IgnoreNormalizerOpts
 
+	// autoSyncThrottledUntil holds the time until which the automated sync of an application was last throttled by the
+	// minimum sync interval of its project, so that a throttle event is only recorded once per interval
+	autoSyncThrottledUntil sync.Map
+
 	// dynamicClusterDistributionEnabled if disabled deploymentInformer is never initialized
 	dynamicClusterDistributionEnabled bool
 	deploymentInformer                informerv1.DeploymentInformer
 
 	hydrator *hydrator.Hydrator
+
+	imageUpdater        *imageupdater.ImageUpdater
+	imageUpdateInterval time.Duration
+
+	operationWebhookDispatcher *webhook.Dispatcher
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -182,6 +209,7 @@ func NewApplicationController(
 	metricsCacheExpiration time.Duration,
 	metricsApplicationLabels []string,
 	metricsApplicationConditions []string,
+	metricsSyncPhaseDurationLabels []string,
 	metricsClusterLabels []string,
 	kubectlParallelismLimit int64,
 	persistResourceHealth bool,
@@ -206,8 +234,6 @@ func NewApplicationController(
 		kubeClientset:                     kubeClientset,
 		kubectl:                           kubectl,
 		applicationClientset:              applicationClientset,
-		appRefreshQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_reconciliation_queue"}),
-		appOperationQueue:                 workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_operation_processing_queue"}),
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
@@ -229,10 +255,25 @@ func NewApplicationController(
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
-	}
+		operationWebhookDispatcher:        webhook.NewDispatcher(),
+		queueTracker:                      newQueueTracker(),
+	}
+	// the priority of the applications and the rate limits of their projects are looked up when they are queued
+	ctrl.appRefreshPriorityQueue = newPriorityQueue(ctrl.getAppRefreshPriority)
+	ctrl.appRefreshQueue = newPriorityRateLimitingQueue(appReconciliationQueueName, workqueue.NewTypedMaxOfRateLimiter(
+		ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig),
+		newProjectRateLimiter(ctrl.getAppReconcileRateLimit),
+	), ctrl.queueTracker.wrap(appReconciliationQueueName, ctrl.appRefreshPriorityQueue))
+	ctrl.appOperationPriorityQueue = newPriorityQueue(ctrl.getAppReconcilePriority)
+	ctrl.appOperationQueue = newPriorityRateLimitingQueue(appOperationQueueName, ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), ctrl.queueTracker.wrap(appOperationQueueName, ctrl.appOperationPriorityQueue))
 	if hydratorEnabled {
 		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, repoClientset, db)
 	}
+	// image updates write to the sources of applications, so they are only enabled when an interval is configured
+	ctrl.imageUpdateInterval = env.ParseDurationFromEnv(common.EnvImageUpdateInterval, 0, 0, 24*time.Hour)
+	if ctrl.imageUpdateInterval > 0 {
+		ctrl.imageUpdater = imageupdater.NewImageUpdater(&ctrl, namespace, imageupdater.NewRegistryClient, writeback.NewGitWriter(askpass.ControllerSocketPath))
+	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
 	}
@@ -291,6 +332,7 @@ func NewApplicationController(
 						}
 						key, err := cache.MetaNamespaceKeyFunc(app)
 						if err == nil {
+							ctrl.queueTracker.requested(appReconciliationQueueName, key, queueReasonShardChange)
 							ctrl.appRefreshQueue.AddRateLimited(key)
 							ctrl.clusterSharding.AddApp(app)
 						}
@@ -307,14 +349,24 @@ func NewApplicationController(
 	if err != nil {
 		return nil, err
 	}
+	err = ctrl.metricsServer.RegisterSyncPhaseDuration(metricsSyncPhaseDurationLabels)
+	if err != nil {
+		return nil, err
+	}
+	ctrl.metricsServer.RegisterPriorityQueues(map[string]metrics.PriorityQueue{
+		appReconciliationQueueName: ctrl.appRefreshPriorityQueue,
+		appOperationQueueName:      ctrl.appOperationPriorityQueue,
+	})
+	ctrl.metricsServer.RegisterQueuesHandler(ctrl.queueTracker)
+	ctrl.metricsServer.RegisterProjects(appLister, applisters.NewAppProjectLister(projInformer.GetIndexer()).AppProjects(namespace), ctrl.canProcessApp)
 	if metricsCacheExpiration.Seconds() != 0 {
 		err = ctrl.metricsServer.SetExpiration(metricsCacheExpiration)
 		if err != nil {
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
-	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
+	stateCache := statecache.NewLiveStateCache(db, appInformer, projInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
+	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts, WithApplicationLister(appLister))
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
 	ctrl.projInformer = projInformer
@@ -358,15 +410,6 @@ func (ctrl *ApplicationController) onKubectlRun(command string) (kube.CleanupFun
 	}, nil
 }
 
-func isSelfReferencedApp(app *appv1.Application, ref corev1.ObjectReference) bool {
-	gvk := ref.GroupVersionKind()
-	return ref.UID == app.UID &&
-		ref.Name == app.Name &&
-		ref.Namespace == app.Namespace &&
-		gvk.Group == application.Group &&
-		gvk.Kind == application.ApplicationKind
-}
-
 func (ctrl *ApplicationController) newAppProjCache(name string) *appProjCache {
 	return &appProjCache{name: name, ctrl: ctrl}
 }
@@ -416,6 +459,78 @@ func (ctrl *ApplicationController) getAppProj(app *appv1.Application) (*appv1.Ap
 	return proj, nil
 }
 
+// applyDestinationMappings rewrites the destinations of the given application with the destination mappings of its project.
+// The application is left unchanged if its project cannot be retrieved, the error being reported later on by the processing.
+func (ctrl *ApplicationController) applyDestinationMappings(app *appv1.Application) {
+	if ctrl.projInformer == nil {
+		return
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil || len(proj.Spec.DestinationMappings) == 0 {
+		return
+	}
+	now := time.Now()
+	app.Spec.Destination = proj.MapDestination(app.Spec.Destination, now)
+	for i := range app.Spec.Destinations {
+		app.Spec.Destinations[i] = proj.MapDestination(app.Spec.Destinations[i], now)
+	}
+}
+
+// getQueuedAppProject returns the project of the application with the given key from the informer caches, without
+// calling the API server, since it is called when the application is queued
+func (ctrl *ApplicationController) getQueuedAppProject(key string) (string, *appv1.AppProject) {
+	if ctrl.appInformer == nil || ctrl.projInformer == nil {
+		return "", nil
+	}
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+	if err != nil || !exists {
+		return "", nil
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return "", nil
+	}
+	projName := app.Spec.GetProject()
+	obj, exists, err = ctrl.projInformer.GetIndexer().GetByKey(ctrl.namespace + "/" + projName)
+	if err != nil || !exists {
+		return projName, nil
+	}
+	proj, ok := obj.(*appv1.AppProject)
+	if !ok {
+		return projName, nil
+	}
+	return projName, proj
+}
+
+// getAppReconcilePriority returns the reconcile priority of the project of the application with the given key
+func (ctrl *ApplicationController) getAppReconcilePriority(key string) int32 {
+	if _, proj := ctrl.getQueuedAppProject(key); proj != nil {
+		return proj.Spec.ReconcilePriority
+	}
+	return 0
+}
+
+// getAppRefreshPriority returns the priority of the application with the given key in the refresh queue. Refreshes
+// requested by users are processed ahead of the other refreshes, regardless of the priority of the project.
+func (ctrl *ApplicationController) getAppRefreshPriority(key string) int32 {
+	if ctrl.appInformer != nil {
+		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key)
+		if app, ok := obj.(*appv1.Application); err == nil && exists && ok && app.IsInteractiveRefreshRequested() {
+			return interactiveRefreshPriority
+		}
+	}
+	return ctrl.getAppReconcilePriority(key)
+}
+
+// getAppReconcileRateLimit returns the reconcile rate limit of the project of the application with the given key
+func (ctrl *ApplicationController) getAppReconcileRateLimit(key string) (string, *appv1.ReconcileRateLimit) {
+	projName, proj := ctrl.getQueuedAppProject(key)
+	if proj == nil {
+		return projName, nil
+	}
+	return projName, proj.Spec.ReconcileRateLimit
+}
+
 func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]bool, ref corev1.ObjectReference) {
 	// if namespaced resource is not managed by any app it might be orphaned resource of some other apps
 	if len(managedByApp) == 0 && ref.Namespace != "" {
@@ -437,7 +552,7 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 		appKey := ctrl.toAppKey(appName)
 		obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
 		app, ok := obj.(*appv1.Application)
-		if exists && err == nil && ok && isSelfReferencedApp(app, ref) {
+		if exists && err == nil && ok && argo.IsSelfReferencedApp(app, ref) {
 			// Don't force refresh app if related resource is application itself. This prevents infinite reconciliation loop.
 			continue
 		}
@@ -484,10 +599,11 @@ func (ctrl *ApplicationController) handleObjectUpdated(managedByApp map[string]b
 			refreshLogCtx.Debug("Requesting app refresh caused by object update")
 		}
 
-		ctrl.requestAppRefresh(app.QualifiedName(), &level, nil)
+		ctrl.requestAppRefresh(app.QualifiedName(), &level, nil, queueReasonWatchEvent)
 
 		if isManagedResource && ctrl.shouldProcessOperation(app) {
 			// When a managed object is updated, we re-evaluate the ongoing sync operation for progress.
+			ctrl.queueTracker.requested(appOperationQueueName, ctrl.toAppKey(app.QualifiedName()), queueReasonWatchEvent)
 			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), appOperationRequeueDelay)
 		}
 	}
@@ -840,7 +956,7 @@ func (ctrl *ApplicationController) hideSecretData(ctx context.Context, destClust
 				return nil, fmt.Errorf("error getting cluster cache: %w", err)
 			}
 			diffConfig, err := argodiff.NewDiffConfigBuilder().
-				WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
+				WithDiffSettings(comparisonResult.ignoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
 				WithTracking(appLabelKey, trackingMethod).
 				WithNoCache().
 				WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
@@ -992,13 +1108,21 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 		}
 	}
 
+	if ctrl.imageUpdater != nil {
+		go wait.Until(func() {
+			ctrl.imageUpdater.UpdateApps(ctx)
+		}, ctrl.imageUpdateInterval, ctx.Done())
+	}
+
 	<-ctx.Done()
 }
 
 // requestAppRefresh adds a request for given app to the refresh queue. appName
 // needs to be the qualified name of the application, i.e. <namespace>/<name>.
-func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
+// The reason is reported by the queue introspection endpoint.
+func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration, reason string) {
 	key := ctrl.toAppKey(appName)
+	ctrl.queueTracker.requested(appReconciliationQueueName, key, reason)
 
 	if compareWith != nil && after != nil {
 		ctrl.appComparisonTypeRefreshQueue.AddAfter(fmt.Sprintf("%s/%d", key, *compareWith), *after)
@@ -1033,10 +1157,12 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		return processNext
 	}
 	processNext = true
+	ctrl.queueTracker.started(appOperationQueueName, appKey)
 	defer func() {
 		if r := recover(); r != nil {
 			log.WithField("appkey", appKey).Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
 		}
+		ctrl.queueTracker.done(appOperationQueueName, appKey)
 		ctrl.appOperationQueue.Done(appKey)
 	}()
 
@@ -1055,6 +1181,7 @@ func (ctrl *ApplicationController) processAppOperationQueueItem() (processNext b
 		return processNext
 	}
 	app := origApp.DeepCopy()
+	ctrl.applyDestinationMappings(app)
 
 	if !ctrl.shouldProcessOperation(app) {
 		// Exit early if the app was added to the operation queue,
@@ -1139,7 +1266,7 @@ func (ctrl *ApplicationController) processAppComparisonTypeQueueItem() (processN
 			log.WithField("appkey", key).WithError(err).Warn("Unable to parse comparison type")
 			return processNext
 		}
-		ctrl.requestAppRefresh(ctrl.toAppQualifiedName(parts[1], parts[0]), CompareWith(compareWith).Pointer(), nil)
+		ctrl.requestAppRefresh(ctrl.toAppQualifiedName(parts[1], parts[0]), CompareWith(compareWith).Pointer(), nil, queueReasonDelayedAutoSync)
 	}
 	return processNext
 }
@@ -1213,14 +1340,7 @@ func (ctrl *ApplicationController) removeProjectFinalizer(proj *appv1.AppProject
 
 // shouldBeDeleted returns whether a given resource obj should be deleted on cascade delete of application app
 func (ctrl *ApplicationController) shouldBeDeleted(app *appv1.Application, obj *unstructured.Unstructured) bool {
-	deleteOption := resourceutil.GetAnnotationOptionValue(obj, synccommon.AnnotationSyncOptions, synccommon.SyncOptionDelete)
-	if deleteOption == nil && app.Spec.SyncPolicy != nil {
-		deleteOption = app.Spec.SyncPolicy.SyncOptions.GetOptionValue(synccommon.SyncOptionDelete)
-	}
-
-	return !kube.IsCRD(obj) && !isSelfReferencedApp(app, kube.GetObjectRef(obj)) &&
-		(deleteOption == nil || *deleteOption != synccommon.SyncValueFalse) &&
-		!resourceutil.HasAnnotationOption(obj, helm.ResourcePolicyAnnotation, helm.ResourcePolicyKeep)
+	return argo.ShouldBeDeletedOnCascade(app, obj)
 }
 
 func (ctrl *ApplicationController) getPermittedAppLiveObjects(destCluster *appv1.Cluster, app *appv1.Application, proj *appv1.AppProject, projectClusters func(project string) ([]*appv1.Cluster, error)) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
@@ -1242,6 +1362,64 @@ func (ctrl *ApplicationController) getPermittedAppLiveObjects(destCluster *appv1
 	return objsMap, nil
 }
 
+// deleteAppResources deletes the live objects of an application being deleted on cascade from a destination cluster,
+// and returns true once all of them are gone, along with the number of objects deleted.
+func (ctrl *ApplicationController) deleteAppResources(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, destCluster *appv1.Cluster, config *rest.Config, deletionApproved bool, projectClusters func(project string) ([]*appv1.Cluster, error), logCtx *log.Entry) (bool, int, error) {
+	objs := make([]*unstructured.Unstructured, 0)
+	objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+	if err != nil {
+		return false, 0, err
+	}
+
+	for k := range objsMap {
+		// Wait for objects pending deletion to complete before proceeding with next sync wave
+		if objsMap[k].GetDeletionTimestamp() != nil {
+			logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+			return false, 0, nil
+		}
+
+		if ctrl.shouldBeDeleted(app, objsMap[k]) {
+			objs = append(objs, objsMap[k])
+			if res, ok := app.Status.FindResource(k); ok && res.RequiresDeletionConfirmation && !deletionApproved {
+				logCtx.Infof("Resource %v requires manual confirmation to delete", k)
+				return false, 0, nil
+			}
+		}
+	}
+
+	filteredObjs := FilterObjectsForDeletion(objs)
+
+	propagationPolicy := metav1.DeletePropagationForeground
+	if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
+		propagationPolicy = metav1.DeletePropagationBackground
+	}
+	logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
+
+	err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
+		obj := filteredObjs[i]
+		return ctrl.kubectl.DeleteResource(ctx, config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+	})
+	if err != nil {
+		return false, 0, err
+	}
+
+	objsMap, err = ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+	if err != nil {
+		return false, 0, err
+	}
+
+	for k, obj := range objsMap {
+		if !ctrl.shouldBeDeleted(app, obj) {
+			delete(objsMap, k)
+		}
+	}
+	if len(objsMap) > 0 {
+		logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+		return false, 0, nil
+	}
+	return true, len(objs), nil
+}
+
 func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Context, app *appv1.Application, projectClusters func(project string) ([]*appv1.Cluster, error)) (retErr error) {
 	ctx, span := tracer.Start(ctx, "controller.finalizeApplicationDeletion")
 	setAppTraceAttrs(span, app)
@@ -1310,60 +1488,25 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 	if app.CascadedDeletion() {
 		deletionApproved := app.IsDeletionConfirmed(app.DeletionTimestamp.Time)
 		logCtx.Infof("Deleting resources")
-		// ApplicationDestination points to a valid cluster, so we may clean up the live objects
-		objs := make([]*unstructured.Unstructured, 0)
-		objsMap, err := ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
+		destinations, err := ctrl.getDeletionDestinations(ctx, app, proj, destCluster, config, logCtx)
 		if err != nil {
 			return err
 		}
-
-		for k := range objsMap {
-			// Wait for objects pending deletion to complete before proceeding with next sync wave
-			if objsMap[k].GetDeletionTimestamp() != nil {
-				logCtx.Infof("%d objects remaining for deletion", len(objsMap))
-				return nil
-			}
-
-			if ctrl.shouldBeDeleted(app, objsMap[k]) {
-				objs = append(objs, objsMap[k])
-				if res, ok := app.Status.FindResource(k); ok && res.RequiresDeletionConfirmation && !deletionApproved {
-					logCtx.Infof("Resource %v requires manual confirmation to delete", k)
-					return nil
-				}
-			}
-		}
-
-		filteredObjs := FilterObjectsForDeletion(objs)
-
-		propagationPolicy := metav1.DeletePropagationForeground
-		if app.GetPropagationPolicy() == appv1.BackgroundPropagationPolicyFinalizer {
-			propagationPolicy = metav1.DeletePropagationBackground
-		}
-		logCtx.Infof("Deleting application's resources with %s propagation policy", propagationPolicy)
-
-		err = kube.RunAllAsync(len(filteredObjs), func(i int) error {
-			obj := filteredObjs[i]
-			return ctrl.kubectl.DeleteResource(ctx, config, obj.GroupVersionKind(), obj.GetName(), obj.GetNamespace(), metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
-		})
-		if err != nil {
-			return err
-		}
-
-		objsMap, err = ctrl.getPermittedAppLiveObjects(destCluster, app, proj, projectClusters)
-		if err != nil {
-			return err
-		}
-
-		for k, obj := range objsMap {
-			if !ctrl.shouldBeDeleted(app, obj) {
-				delete(objsMap, k)
+		deleted := 0
+		allDone := true
+		for _, dest := range destinations {
+			// ApplicationDestination points to a valid cluster, so we may clean up the live objects
+			done, count, err := ctrl.deleteAppResources(ctx, app, proj, dest.cluster, dest.config, deletionApproved, projectClusters, logCtx)
+			if err != nil {
+				return err
 			}
+			allDone = allDone && done
+			deleted += count
 		}
-		if len(objsMap) > 0 {
-			logCtx.Infof("%d objects remaining for deletion", len(objsMap))
+		if !allDone {
 			return nil
 		}
-		logCtx.Infof("Successfully deleted %d resources", len(objs))
+		logCtx.Infof("Successfully deleted %d resources", deleted)
 		app.UnSetCascadedDeletion()
 		return ctrl.updateFinalizers(app)
 	}
@@ -1427,6 +1570,10 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 		if err := ctrl.cache.SetAppResourcesTree(app.InstanceName(ctrl.namespace), nil); err != nil {
 			return err
 		}
+
+		if err := ctrl.cache.DeleteAppMetricsHistory(app.InstanceName(ctrl.namespace)); err != nil {
+			return err
+		}
 		ctrl.projectRefreshQueue.Add(fmt.Sprintf("%s/%s", ctrl.namespace, app.Spec.GetProject()))
 	}
 
@@ -1450,6 +1597,16 @@ func (ctrl *ApplicationController) updateFinalizers(app *appv1.Application) erro
 	return err
 }
 
+// setTransientConditionExpiry sets the severity and the expiry of the transient conditions among the given conditions
+func setTransientConditionExpiry(conditions []appv1.ApplicationCondition, now time.Time) {
+	for i := range conditions {
+		if severity, ok := transientConditionSeverities[conditions[i].Type]; ok {
+			conditions[i].Severity = severity
+			conditions[i].ExpiresAt = &metav1.Time{Time: now.Add(transientConditionTTL)}
+		}
+	}
+}
+
 func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condition appv1.ApplicationCondition) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	// do nothing if app already has same condition
@@ -1459,7 +1616,9 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 		}
 	}
 
-	app.Status.SetConditions([]appv1.ApplicationCondition{condition}, map[appv1.ApplicationConditionType]bool{condition.Type: true})
+	conditions := []appv1.ApplicationCondition{condition}
+	setTransientConditionExpiry(conditions, time.Now())
+	app.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{condition.Type: true})
 
 	var patch []byte
 	patch, err := json.Marshal(map[string]any{
@@ -1475,11 +1634,24 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// operationCorrelationID returns the correlation ID of the operation of the application, or a new correlation ID if
+// there is no operation or the request which initiated it had no correlation ID
+func operationCorrelationID(app *appv1.Application) string {
+	if app.Operation != nil && app.Operation.CorrelationID != "" {
+		return app.Operation.CorrelationID
+	}
+	return logutils.NewCorrelationID()
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	// processAppOperationQueueItem is a workqueue entry point with no inbound request
-	// context, so context.Background() roots this operation's context tree.
-	ctx := context.Background()
+	// context, so context.Background() roots this operation's context tree. The operation
+	// keeps the correlation ID of the request which initiated it.
+	ctx := logutils.ContextWithCorrelationID(context.Background(), operationCorrelationID(app))
+	logCtx = logutils.WithCorrelationID(ctx, logCtx)
+	// continue the trace of the request which initiated the operation, e.g. the sync request to the API server
+	ctx = traceutil.ExtractFromAnnotations(ctx, app.Annotations, common.AnnotationKeyTraceContextPrefix)
 	ctx, span := tracer.Start(ctx, "controller.Operation")
 	setAppTraceAttrs(span, app)
 	var state *appv1.OperationState
@@ -1525,6 +1697,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				requeueAfter = remaining
 			}
 		}
+		ctrl.queueTracker.requested(appOperationQueueName, ctrl.toAppKey(app.QualifiedName()), queueReasonOperationProgress)
 		ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), requeueAfter)
 	}()
 
@@ -1649,7 +1822,7 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 				// synced after commit but before app. refresh (see #18153)
 				compareWith = CompareWithLatestForceResolve
 			}
-			ctrl.requestAppRefresh(app.QualifiedName(), compareWith.Pointer(), nil)
+			ctrl.requestAppRefresh(app.QualifiedName(), compareWith.Pointer(), nil, queueReasonOperationCompleted)
 		} else {
 			logCtx.WithError(err).Warn("Fails to requeue application")
 		}
@@ -1676,6 +1849,16 @@ func (ctrl *ApplicationController) setOperationState(ctx context.Context, app *a
 		// If operation is completed, clear the operation field to indicate no operation is
 		// in progress.
 		patch["operation"] = nil
+		// forget the trace context of the completed operation so that it is not continued by the next one
+		traceAnnotations := map[string]any{}
+		for key := range app.Annotations {
+			if strings.HasPrefix(key, common.AnnotationKeyTraceContextPrefix) {
+				traceAnnotations[key] = nil
+			}
+		}
+		if len(traceAnnotations) > 0 {
+			patch["metadata"] = map[string]any{"annotations": traceAnnotations}
+		}
 	}
 	if reflect.DeepEqual(app.Status.OperationState, state) {
 		logCtx.Infof("No operation updates necessary to '%s'. Skipping patch", app.QualifiedName())
@@ -1710,26 +1893,17 @@ func (ctrl *ApplicationController) setOperationState(ctx context.Context, app *a
 	})
 
 	logCtx.Infof("updated '%s' operation (phase: %s)", app.QualifiedName(), state.Phase)
+	var previousPhase synccommon.OperationPhase
+	if app.Status.OperationState != nil {
+		previousPhase = app.Status.OperationState.Phase
+	}
+	if previousPhase != state.Phase {
+		ctrl.notifyOperationWebhooks(app, previousPhase, state)
+	}
+	for _, event := range syncOperationEvents(app.Status.OperationState, state) {
+		ctrl.logAppEvent(ctx, app, event.info, event.message)
+	}
 	if state.Phase.Completed() {
-		eventInfo := argo.EventInfo{Reason: argo.EventReasonOperationCompleted}
-		var messages []string
-		if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
-			messages = []string{"Partial sync operation"}
-		} else {
-			messages = []string{"Sync operation"}
-		}
-		if state.SyncResult != nil {
-			messages = append(messages, "to", state.SyncResult.Revision)
-		}
-		if state.Phase.Successful() {
-			eventInfo.Type = corev1.EventTypeNormal
-			messages = append(messages, "succeeded")
-		} else {
-			eventInfo.Type = corev1.EventTypeWarning
-			messages = append(messages, "failed:", state.Message)
-		}
-		ctrl.logAppEvent(ctx, app, eventInfo, strings.Join(messages, " "))
-
 		destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
 		if err != nil {
 			logCtx.WithError(err).Warn("Unable to get destination cluster, setting dest_server label to empty string in sync metric")
@@ -1740,9 +1914,86 @@ func (ctrl *ApplicationController) setOperationState(ctx context.Context, app *a
 		}
 		ctrl.metricsServer.IncSync(app, destServer, state)
 		ctrl.metricsServer.IncAppSyncDuration(app, destServer, state)
+		if state.FinishedAt != nil {
+			sample := appv1.SyncSample{
+				Time:       *state.FinishedAt,
+				DurationMs: state.FinishedAt.Sub(state.StartedAt.Time).Milliseconds(),
+				Phase:      state.Phase,
+			}
+			if err := ctrl.cache.AddAppSyncSample(app.InstanceName(ctrl.namespace), sample); err != nil {
+				logCtx.WithError(err).Warn("Failed to record sync in metrics history")
+			}
+		}
 	}
 }
 
+// notifyOperationWebhooks calls the global and project operation webhooks which are configured for the new phase of
+// the operation of the application
+func (ctrl *ApplicationController) notifyOperationWebhooks(app *appv1.Application, previousPhase synccommon.OperationPhase, state *appv1.OperationState) {
+	if ctrl.operationWebhookDispatcher == nil {
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	webhooks, err := ctrl.settingsMgr.GetOperationWebhooks()
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to get operation webhooks")
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to get project operation webhooks")
+	} else if len(proj.Spec.OperationWebhooks) > 0 {
+		webhooks = append(webhooks, ctrl.getPermittedProjectOperationWebhooks(proj, logCtx)...)
+	}
+	var targets []webhook.Target
+	var secrets map[string]string
+	for _, w := range webhooks {
+		if !w.IsCalledFor(state.Phase) {
+			continue
+		}
+		target := webhook.Target{Name: w.Name, URL: w.URL}
+		if w.Secret != "" {
+			if secrets == nil {
+				argoSettings, err := ctrl.settingsMgr.GetSettings()
+				if err != nil {
+					logCtx.WithError(err).Warn("Failed to get operation webhook secrets")
+					return
+				}
+				secrets = argoSettings.Secrets
+			}
+			key, ok := strings.CutPrefix(w.Secret, "$")
+			if target.Secret = secrets[key]; !ok || target.Secret == "" {
+				logCtx.Warnf("Skipping operation webhook '%s': secret '%s' does not reference a key of the argocd-secret", w.Name, w.Secret)
+				continue
+			}
+		}
+		targets = append(targets, target)
+	}
+	ctrl.operationWebhookDispatcher.Dispatch(webhook.NewOperationPhaseChangedEvent(app, previousPhase, state), targets)
+}
+
+// getPermittedProjectOperationWebhooks returns the operation webhooks of the project which call a URL permitted by the
+// administrator and reference a secret project webhooks may use. Projects can be changed without the validation of the
+// API server, so the restrictions are enforced again before calling the webhooks.
+func (ctrl *ApplicationController) getPermittedProjectOperationWebhooks(proj *appv1.AppProject, logCtx *log.Entry) []appv1.OperationWebhook {
+	allowedURLs, err := ctrl.settingsMgr.GetOperationWebhookAllowedURLs()
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to get the URLs permitted for project operation webhooks")
+		return nil
+	}
+	var webhooks []appv1.OperationWebhook
+	for _, w := range proj.Spec.OperationWebhooks {
+		switch {
+		case !w.IsURLPermitted(allowedURLs):
+			logCtx.Warnf("Skipping operation webhook '%s' of project '%s': URL '%s' is not permitted", w.Name, proj.Name, w.URL)
+		case w.Secret != "" && !w.IsProjectSecretKey():
+			logCtx.Warnf("Skipping operation webhook '%s' of project '%s': secret '%s' does not reference a key starting with '%s'", w.Name, proj.Name, w.Secret, appv1.OperationWebhookSecretKeyPrefix)
+		default:
+			webhooks = append(webhooks, w)
+		}
+	}
+	return webhooks
+}
+
 // writeBackToInformer writes a just recently updated App back into the informer cache.
 // This prevents the situation where the controller operates on a stale app and repeats work
 func (ctrl *ApplicationController) writeBackToInformer(app *appv1.Application) {
@@ -1773,10 +2024,12 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return processNext
 	}
 	processNext = true
+	ctrl.queueTracker.started(appReconciliationQueueName, appKey)
 	defer func() {
 		if r := recover(); r != nil {
 			log.WithField("appkey", appKey).Errorf("Recovered from panic: %+v\n%s", r, debug.Stack())
 		}
+		ctrl.queueTracker.done(appReconciliationQueueName, appKey)
 		ctrl.appRefreshQueue.Done(appKey)
 	}()
 	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appKey)
@@ -1794,7 +2047,17 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		return processNext
 	}
 	origApp = origApp.DeepCopy()
-	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, ctrl.statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
+	ctrl.applyDestinationMappings(origApp)
+	statusRefreshTimeout := ctrl.statusRefreshTimeout
+	if refreshInterval := ctrl.getAppRefreshInterval(origApp); refreshInterval > 0 {
+		statusRefreshTimeout = refreshInterval
+		// the informer only resyncs the applications at the reconciliation timeout of the controller, so the applications
+		// with a shorter refresh interval are requeued when their interval elapses
+		if resyncPeriod := ctrl.informerResyncPeriod(); resyncPeriod == 0 || refreshInterval < resyncPeriod {
+			defer ctrl.requestAppRefresh(origApp.QualifiedName(), nil, &refreshInterval, queueReasonTimer)
+		}
+	}
+	needRefresh, refreshType, comparisonLevel := ctrl.needRefreshAppStatus(origApp, statusRefreshTimeout, ctrl.statusHardRefreshTimeout)
 
 	if !needRefresh {
 		return processNext
@@ -1808,8 +2071,10 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	})
 
 	// processAppRefreshQueueItem is a workqueue entry point with no inbound request
-	// context, so context.Background() roots this reconciliation's context tree.
-	ctx := context.Background()
+	// context, so context.Background() roots this reconciliation's context tree. A
+	// reconciliation during an operation shares the correlation ID of the operation.
+	ctx := logutils.ContextWithCorrelationID(context.Background(), operationCorrelationID(origApp))
+	logCtx = logutils.WithCorrelationID(ctx, logCtx)
 	ctx, span := tracer.Start(ctx, "controller.Refresh")
 	setAppTraceAttrs(span, origApp)
 	defer span.End()
@@ -1827,6 +2092,18 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			destServer = destCluster.Server
 		}
 		ctrl.metricsServer.IncReconcile(origApp, destServer, reconcileDuration)
+		sample := appv1.ReconciliationSample{
+			Time:       metav1.Now(),
+			DurationMs: reconcileDuration.Milliseconds(),
+		}
+		for _, res := range app.Status.Resources {
+			if res.Status == appv1.SyncStatusCodeOutOfSync {
+				sample.OutOfSyncResources++
+			}
+		}
+		if err := ctrl.cache.AddAppReconciliationSample(app.InstanceName(ctrl.namespace), sample); err != nil {
+			logCtx.WithError(err).Warn("Failed to record reconciliation in metrics history")
+		}
 		for k, v := range ts.Timings() {
 			logCtx = logCtx.WithField(k, v.Milliseconds())
 		}
@@ -1859,6 +2136,11 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 	}
 	ts.AddCheckpoint("comparison_with_nothing_ms")
 
+	if app.Spec.HasMultipleDestinations() && app.Spec.Destination != app.Spec.Destinations[0] {
+		// the primary destination of the application must be set before it is validated and compared
+		ctrl.normalizeApplication(app)
+	}
+
 	project, hasErrors := ctrl.refreshAppConditions(ctx, app)
 	ts.AddCheckpoint("refresh_app_conditions_ms")
 	now := metav1.Now()
@@ -1930,6 +2212,13 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		logCtx = logCtx.WithField(k, v.Milliseconds())
 	}
 
+	if app.Spec.HasMultipleDestinations() {
+		app.Status.Destinations = ctrl.compareDestinations(ctx, app, project, sources, localManifests, compareResult)
+		ts.AddCheckpoint("compare_destinations_ms")
+	} else {
+		app.Status.Destinations = nil
+	}
+
 	ctrl.normalizeApplication(app)
 	ts.AddCheckpoint("normalize_application_ms")
 
@@ -1941,7 +2230,7 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false, nil)
+	canSync, _ := project.MatchingSyncWindows(app).CanSync(false, nil)
 	if canSync {
 		// The manifest-generate-paths optimization can report no changes for a newer commit that
 		// arrives while the app is still syncing, which would skip auto-sync and leave the app
@@ -1951,16 +2240,16 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		shouldCompareRevisions := compareResult.revisionsMayHaveChanges || compareResult.syncStatus.Status == appv1.SyncStatusCodeOutOfSync
 		syncErrCond, opDuration := ctrl.autoSync(ctx, app, compareResult.syncStatus, compareResult.resources, shouldCompareRevisions)
 		setOpDuration = opDuration
+		autoSyncConditionTypes := map[appv1.ApplicationConditionType]bool{
+			appv1.ApplicationConditionSyncError:               true,
+			appv1.ApplicationConditionSyncPreconditionWarning: true,
+		}
 		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			conditions := []appv1.ApplicationCondition{*syncErrCond}
+			setTransientConditionExpiry(conditions, time.Now())
+			app.Status.SetConditions(conditions, autoSyncConditionTypes)
 		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{}, autoSyncConditionTypes)
 		}
 	} else {
 		logCtx.Info("Sync prevented by sync window")
@@ -2136,6 +2425,8 @@ func (ctrl *ApplicationController) needRefreshAppStatus(app *appv1.Application,
 			}
 		} else if !reflect.DeepEqual(app.Spec.Destination, app.Status.Sync.ComparedTo.Destination) {
 			reason = "spec.destination differs"
+		} else if hasChangedDestinations(app) {
+			reason = "spec.destinations differs"
 		} else if app.HasChangedManagedNamespaceMetadata() {
 			reason = "spec.syncPolicy.managedNamespaceMetadata differs"
 		} else if !app.Spec.IgnoreDifferences.Equals(app.Status.Sync.ComparedTo.IgnoreDifferences) {
@@ -2167,6 +2458,7 @@ func (ctrl *ApplicationController) refreshAppConditions(ctx context.Context, app
 			})
 		} else {
 			errorConditions = append(errorConditions, specConditions...)
+			errorConditions = append(errorConditions, argo.ValidateDestinationServiceAccount(ctx, app, proj, ctrl.db)...)
 		}
 	}
 	app.Status.SetConditions(errorConditions, map[appv1.ApplicationConditionType]bool{
@@ -2217,6 +2509,7 @@ func (ctrl *ApplicationController) persistReconciliationStatus(ctx context.Conte
 	newAnnotations := make(map[string]string)
 	maps.Copy(newAnnotations, orig.GetAnnotations())
 	delete(newAnnotations, appv1.AnnotationKeyRefresh)
+	delete(newAnnotations, appv1.AnnotationKeyRefreshPriority)
 	return ctrl.persistAppStatus(ctx, orig, newStatus, newAnnotations)
 }
 
@@ -2235,7 +2528,16 @@ func (ctrl *ApplicationController) persistAppStatus(ctx context.Context, orig *a
 	logCtx := log.WithFields(applog.GetAppLogFields(orig))
 	if orig.Status.Sync.Status != newStatus.Sync.Status {
 		message := fmt.Sprintf("Updated sync status: %s -> %s", orig.Status.Sync.Status, newStatus.Sync.Status)
-		ctrl.logAppEvent(context.TODO(), orig, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: corev1.EventTypeNormal}, message)
+		annotations := map[string]string{
+			argo.EventAnnotationPreviousStatus: string(orig.Status.Sync.Status),
+			argo.EventAnnotationStatus:         string(newStatus.Sync.Status),
+		}
+		if newStatus.Sync.Revision != "" {
+			annotations[argo.EventAnnotationRevision] = newStatus.Sync.Revision
+		} else if len(newStatus.Sync.Revisions) > 0 {
+			annotations[argo.EventAnnotationRevision] = strings.Join(newStatus.Sync.Revisions, ",")
+		}
+		ctrl.logAppEvent(context.TODO(), orig, argo.EventInfo{Reason: argo.EventReasonSyncStatusChanged, Type: corev1.EventTypeNormal, Annotations: annotations}, message)
 	}
 	if orig.Status.Health.Status != newStatus.Health.Status {
 		// Update the last transition time to now. This should be the ONLY place in code where this is set, because it's
@@ -2247,7 +2549,11 @@ func (ctrl *ApplicationController) persistAppStatus(ctx context.Context, orig *a
 		if newStatus.Health.Message != "" {
 			message = fmt.Sprintf("%s (%s)", message, newStatus.Health.Message)
 		}
-		ctrl.logAppEvent(context.TODO(), orig, argo.EventInfo{Reason: argo.EventReasonResourceUpdated, Type: corev1.EventTypeNormal}, message)
+		annotations := map[string]string{
+			argo.EventAnnotationPreviousStatus: string(orig.Status.Health.Status),
+			argo.EventAnnotationStatus:         string(newStatus.Health.Status),
+		}
+		ctrl.logAppEvent(context.TODO(), orig, argo.EventInfo{Reason: argo.EventReasonHealthStatusChanged, Type: corev1.EventTypeNormal, Annotations: annotations}, message)
 	} else {
 		// make sure the last transition time is the same and populated if the health is the same
 		newStatus.Health.LastTransitionTime = orig.Status.Health.LastTransitionTime
@@ -2326,7 +2632,8 @@ func (ctrl *ApplicationController) persistAppStatus(ctx context.Context, orig *a
 
 // autoSync will initiate a sync operation for an application configured with automated sync
 func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Application, syncStatus *appv1.SyncStatus, resources []appv1.ResourceStatus, shouldCompareRevisions bool) (*appv1.ApplicationCondition, time.Duration) {
-	_, span := tracer.Start(ctx, "controller.autoSync")
+	// the span is the parent of the operation initiated below, which continues the trace through SetAppOperation
+	ctx, span := tracer.Start(ctx, "controller.autoSync")
 	setAppTraceAttrs(span, app)
 	defer span.End()
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
@@ -2339,8 +2646,16 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		logCtx.Debug("Finished auto sync")
 	}()
 	if app.Spec.SyncPolicy == nil || !app.Spec.SyncPolicy.IsAutomatedSyncEnabled() {
+		app.Status.NextScheduledSync = nil
+		app.Status.SelfHeal = nil
 		return nil, 0
 	}
+	if app.Spec.SyncPolicy.Scheduled == nil {
+		app.Status.NextScheduledSync = nil
+	}
+	if app.Status.SelfHeal != nil && selfHealCooledDown(app, syncStatus) {
+		app.Status.SelfHeal = nil
+	}
 
 	if app.Operation != nil {
 		logCtx.Infof("Skipping auto-sync: another operation is in progress")
@@ -2351,25 +2666,44 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		return nil, 0
 	}
 
+	scheduledSyncDone := false
+	if app.Spec.SyncPolicy.Scheduled != nil {
+		due, err := ctrl.scheduledSyncDue(app, time.Now())
+		if err != nil {
+			message := fmt.Sprintf("Invalid sync schedule: %v", err)
+			logCtx.Warn(message)
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+		}
+		if !due {
+			logCtx.Infof("Skipping auto-sync: next scheduled sync is at %s", app.Status.NextScheduledSync.Time)
+			return nil, 0
+		}
+		// the due scheduled sync is only consumed once the sync is initiated, or when there is nothing to sync, so that a
+		// sync which is held back by one of the checks below is performed as soon as it is possible
+		defer func() {
+			if scheduledSyncDone {
+				ctrl.advanceScheduledSync(app, time.Now())
+			}
+		}()
+	}
+
 	// Only perform auto-sync if we detect OutOfSync status. This is to prevent us from attempting
 	// a sync when application is already in a Synced or Unknown state
 	if syncStatus.Status != appv1.SyncStatusCodeOutOfSync {
 		logCtx.Infof("Skipping auto-sync: application status is %s", syncStatus.Status)
+		scheduledSyncDone = true
 		return nil, 0
 	}
 
-	if !app.Spec.SyncPolicy.Automated.GetPrune() {
-		requirePruneOnly := true
-		for _, r := range resources {
-			if r.Status != appv1.SyncStatusCodeSynced && !r.RequiresPruning {
-				requirePruneOnly = false
-				break
-			}
-		}
-		if requirePruneOnly {
-			logCtx.Infof("Skipping auto-sync: need to prune extra resources only but automated prune is disabled")
-			return nil, 0
-		}
+	// The drift of the metadata of a namespace created with managedNamespaceMetadata is not reported by the resources of
+	// the application, but is fixed by any sync since the namespace is always applied
+	managedNsOutOfSync := app.HasChangedManagedNamespaceMetadata() ||
+		len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionManagedNamespaceMetadataWarning: true})) > 0
+
+	if !app.Spec.SyncPolicy.Automated.GetPrune() && !managedNsOutOfSync && syncStatus.PruneOnly {
+		logCtx.Infof("Skipping auto-sync: need to prune extra resources only but automated prune is disabled")
+		scheduledSyncDone = true
+		return nil, 0
 	}
 
 	source := new(app.Spec.GetSource())
@@ -2400,6 +2734,8 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 	// application in an infinite loop. To detect this, we only attempt the Sync if the revision
 	// and parameter overrides are different from our most recent sync operation.
 	alreadyAttempted, lastAttemptedRevisions, lastAttemptedPhase := alreadyAttemptedSync(app, desiredRevisions, shouldCompareRevisions)
+	// the self-heal attempts are only recorded for a self-heal sync, any other sync resets them
+	var selfHeal *appv1.SelfHealStatus
 	ts.AddCheckpoint("already_attempted_sync_ms")
 	if alreadyAttempted {
 		if !lastAttemptedPhase.Successful() {
@@ -2409,6 +2745,7 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		}
 		if !app.Spec.SyncPolicy.Automated.GetSelfHeal() {
 			logCtx.Infof("Skipping auto-sync: most recent sync already to %s", desiredRevisions)
+			scheduledSyncDone = true
 			return nil, 0
 		}
 		// Self heal will trigger a new sync operation when the desired state changes and cause the application to
@@ -2418,13 +2755,28 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 			op.Sync.SelfHealAttemptsCount = app.Status.OperationState.Operation.Sync.SelfHealAttemptsCount
 		}
 
-		if remainingTime := ctrl.selfHealRemainingBackoff(app, int(op.Sync.SelfHealAttemptsCount)); remainingTime > 0 {
+		if backoff := app.Spec.SyncPolicy.Automated.SelfHealBackoff; backoff != nil {
+			remainingTime, err := appSelfHealRemainingBackoff(app, backoff)
+			if err != nil {
+				message := fmt.Sprintf("Skipping self-heal of %s: %v", lastAttemptedRevisions, err)
+				logCtx.Warn(message)
+				return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+			}
+			if remainingTime > 0 {
+				logCtx.Infof("Skipping auto-sync: already attempted sync to %s %d times (retrying in %v)", lastAttemptedRevisions, app.Status.SelfHeal.GetAttempts(), remainingTime)
+				ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime, queueReasonDelayedAutoSync)
+				return nil, 0
+			}
+		} else if remainingTime := ctrl.selfHealRemainingBackoff(app, int(op.Sync.SelfHealAttemptsCount)); remainingTime > 0 {
 			logCtx.Infof("Skipping auto-sync: already attempted sync to %s with timeout %v (retrying in %v)", lastAttemptedRevisions, ctrl.selfHealTimeout, remainingTime)
-			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime, queueReasonDelayedAutoSync)
 			return nil, 0
 		}
 
 		op.Sync.SelfHealAttemptsCount++
+		if app.Spec.SyncPolicy.Automated.SelfHealBackoff != nil {
+			selfHeal = &appv1.SelfHealStatus{Attempts: app.Status.SelfHeal.GetAttempts() + 1, LastAttemptedAt: new(metav1.Now())}
+		}
 		for _, resource := range resources {
 			if resource.Status != appv1.SyncStatusCodeSynced {
 				op.Sync.Resources = append(op.Sync.Resources, appv1.SyncOperationResource{
@@ -2434,10 +2786,24 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 				})
 			}
 		}
+	} else if minAge := app.Spec.SyncPolicy.Automated.GetMinRevisionAge(); minAge > 0 {
+		// A new revision is only synced once it has soaked for the configured minimum age since its commit.
+		commitTime, err := ctrl.appStateManager.GetRevisionsCommitTime(ctx, app, app.Spec.GetSources(), desiredRevisions)
+		if err != nil {
+			message := fmt.Sprintf("Failed to determine commit time of %s: %v", desiredRevisions, err)
+			logCtx.Warn(message)
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+		}
+		if remainingTime := minAge - time.Since(commitTime); !commitTime.IsZero() && remainingTime > 0 {
+			logCtx.Infof("Skipping auto-sync: revision %s is younger than the minimum revision age of %v (retrying in %v)", desiredRevisions, minAge, remainingTime)
+			ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime, queueReasonDelayedAutoSync)
+			return nil, 0
+		}
 	}
 	ts.AddCheckpoint("already_attempted_check_ms")
 
-	if app.Spec.SyncPolicy.Automated.GetPrune() && !app.Spec.SyncPolicy.Automated.GetAllowEmpty() {
+	// an application without resources but with a drifted managed namespace is synced, since there is nothing to wipe out
+	if app.Spec.SyncPolicy.Automated.GetPrune() && !app.Spec.SyncPolicy.Automated.GetAllowEmpty() && (len(resources) > 0 || !managedNsOutOfSync) {
 		bAllNeedPrune := true
 		for _, r := range resources {
 			if !r.RequiresPruning {
@@ -2451,10 +2817,35 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 		}
 	}
 
+	for _, dest := range app.Spec.GetDestinations() {
+		if destCluster, err := argo.GetDestinationCluster(ctx, dest, ctrl.db); err == nil && destCluster.Cordoned {
+			message := fmt.Sprintf("Skipping auto-sync: cluster '%s' is cordoned", destCluster.Server)
+			logCtx.Info(message)
+			return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: message}, 0
+		}
+	}
+
+	if len(app.Spec.SyncPolicy.Preconditions) > 0 {
+		if cond := ctrl.checkSyncPreconditions(ctx, app); cond != nil {
+			logCtx.Infof("Skipping auto-sync: %s", cond.Message)
+			return cond, 0
+		}
+		ts.AddCheckpoint("sync_preconditions_ms")
+	}
+
+	if throttledUntil := ctrl.autoSyncThrottledUntilTime(app); time.Now().Before(throttledUntil) {
+		remainingTime := time.Until(throttledUntil)
+		logCtx.Infof("Skipping auto-sync: minimum sync interval of the project is not elapsed (retrying in %v)", remainingTime)
+		ctrl.recordAutoSyncThrottled(app, desiredRevisions, throttledUntil)
+		ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime, queueReasonDelayedAutoSync)
+		return nil, 0
+	}
+	ctrl.autoSyncThrottledUntil.Delete(app.QualifiedName())
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	ts.AddCheckpoint("get_applications_ms")
 	start := time.Now()
-	updatedApp, err := argo.SetAppOperation(appIf, app.Name, &op)
+	updatedApp, err := argo.SetAppOperation(ctx, appIf, app.Name, &op)
 	ts.AddCheckpoint("set_app_operation_ms")
 	setOpTime := time.Since(start)
 	if err != nil {
@@ -2470,13 +2861,92 @@ func (ctrl *ApplicationController) autoSync(ctx context.Context, app *appv1.Appl
 	}
 	ctrl.writeBackToInformer(updatedApp)
 	ts.AddCheckpoint("write_back_to_informer_ms")
+	app.Status.SelfHeal = selfHeal
+	scheduledSyncDone = true
 
 	message := fmt.Sprintf("Initiated automated sync to '%s'", strings.Join(desiredRevisions, ", "))
-	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonOperationStarted, Type: corev1.EventTypeNormal}, message)
+	annotations := map[string]string{
+		argo.EventAnnotationRevision:    strings.Join(desiredRevisions, ","),
+		argo.EventAnnotationInitiatedBy: "automated",
+	}
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonSyncStarted, Type: corev1.EventTypeNormal, Annotations: annotations}, message)
 	logCtx.Info(message)
 	return nil, setOpTime
 }
 
+// autoSyncThrottledUntilTime returns the time until which the automated sync of an application is throttled by the
+// minimum sync interval of its project, measured from the start of the most recent sync of the application
+func (ctrl *ApplicationController) autoSyncThrottledUntilTime(app *appv1.Application) time.Time {
+	if app.Status.OperationState == nil || app.Status.OperationState.Operation.Sync == nil {
+		return time.Time{}
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to get the project of the application to check its minimum sync interval")
+		return time.Time{}
+	}
+	interval := proj.GetMinSyncInterval()
+	if interval <= 0 {
+		return time.Time{}
+	}
+	return app.Status.OperationState.StartedAt.Add(interval)
+}
+
+// recordAutoSyncThrottled records an event when the automated sync of an application starts being throttled until the
+// given time
+func (ctrl *ApplicationController) recordAutoSyncThrottled(app *appv1.Application, desiredRevisions []string, throttledUntil time.Time) {
+	if previous, ok := ctrl.autoSyncThrottledUntil.Load(app.QualifiedName()); ok && previous.(time.Time).Equal(throttledUntil) {
+		return
+	}
+	ctrl.autoSyncThrottledUntil.Store(app.QualifiedName(), throttledUntil)
+	message := fmt.Sprintf("Throttled automated sync to '%s' until %s by the minimum sync interval of project '%s'", strings.Join(desiredRevisions, ", "), throttledUntil.UTC().Format(time.RFC3339), app.Spec.GetProject())
+	annotations := map[string]string{
+		argo.EventAnnotationRevision:    strings.Join(desiredRevisions, ","),
+		argo.EventAnnotationInitiatedBy: "automated",
+	}
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonSyncThrottled, Type: corev1.EventTypeWarning, Annotations: annotations}, message)
+}
+
+// scheduledSyncDue returns whether the scheduled sync of an application is due. The next scheduled sync is recorded in
+// the status of the application when it is missing or not part of the schedule, and a refresh is requested for it.
+func (ctrl *ApplicationController) scheduledSyncDue(app *appv1.Application, now time.Time) (bool, error) {
+	scheduled := app.Spec.SyncPolicy.Scheduled
+	next := app.Status.NextScheduledSync
+	// a recorded time which is not part of the schedule comes from a previous schedule and is discarded
+	if next == nil || !scheduled.IsScheduledAt(next.Time) {
+		nextTime, err := scheduled.Next(now)
+		if err != nil {
+			return false, err
+		}
+		app.Status.NextScheduledSync = &metav1.Time{Time: nextTime}
+		ctrl.requestScheduledSyncRefresh(app, now)
+		return false, nil
+	}
+	if now.Before(next.Time) {
+		ctrl.requestScheduledSyncRefresh(app, now)
+		return false, nil
+	}
+	return true, nil
+}
+
+// advanceScheduledSync consumes the due scheduled sync of an application by recording the following time of the
+// schedule in the status of the application, and requests a refresh for it
+func (ctrl *ApplicationController) advanceScheduledSync(app *appv1.Application, now time.Time) {
+	nextTime, err := app.Spec.SyncPolicy.Scheduled.Next(now)
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to get the next scheduled sync")
+		return
+	}
+	app.Status.NextScheduledSync = &metav1.Time{Time: nextTime}
+	ctrl.requestScheduledSyncRefresh(app, now)
+}
+
+// requestScheduledSyncRefresh requests a refresh of an application at the time of its next scheduled sync
+func (ctrl *ApplicationController) requestScheduledSyncRefresh(app *appv1.Application, now time.Time) {
+	remainingTime := app.Status.NextScheduledSync.Sub(now)
+	ctrl.requestAppRefresh(app.QualifiedName(), CompareWithLatest.Pointer(), &remainingTime, queueReasonDelayedAutoSync)
+}
+
 // alreadyAttemptedSync returns whether the most recently synced revision(s) exactly match the given desiredRevisions
 // and for the same application source. If the revision(s) have changed or the Application source configuration has been updated,
 // it will return false, indicating that a new sync should be attempted.
@@ -2551,6 +3021,41 @@ func (ctrl *ApplicationController) selfHealRemainingBackoff(app *appv1.Applicati
 	return retryAfter
 }
 
+// appSelfHealRemainingBackoff returns the time to wait before the next self-heal attempt of an application with a
+// self-heal backoff, or an error once the maximum number of consecutive attempts is reached
+func appSelfHealRemainingBackoff(app *appv1.Application, backoff *appv1.SelfHealBackoff) (time.Duration, error) {
+	attempts := app.Status.SelfHeal.GetAttempts()
+	if backoff.MaxAttempts > 0 && attempts >= backoff.MaxAttempts {
+		return 0, fmt.Errorf("reached the maximum of %d self-heal attempts, waiting for the application to stay synced for the cooldown period", backoff.MaxAttempts)
+	}
+	delay, err := backoff.Delay(attempts)
+	if err != nil {
+		return 0, fmt.Errorf("invalid self-heal backoff: %w", err)
+	}
+	if app.Status.OperationState != nil && app.Status.OperationState.FinishedAt != nil {
+		return delay - time.Since(app.Status.OperationState.FinishedAt.Time), nil
+	}
+	return delay, nil
+}
+
+// selfHealCooledDown returns whether the self-heal attempts of an application can be reset, which is when the
+// self-heal backoff has been removed or when the application is synced and the cooldown period has elapsed since the
+// last attempt
+func selfHealCooledDown(app *appv1.Application, syncStatus *appv1.SyncStatus) bool {
+	backoff := app.Spec.SyncPolicy.Automated.SelfHealBackoff
+	if backoff == nil {
+		return true
+	}
+	if syncStatus.Status != appv1.SyncStatusCodeSynced || app.Status.SelfHeal.LastAttemptedAt == nil {
+		return false
+	}
+	cooldown, err := backoff.CooldownDuration()
+	if err != nil {
+		return false
+	}
+	return time.Since(app.Status.SelfHeal.LastAttemptedAt.Time) >= cooldown
+}
+
 // isAppNamespaceAllowed returns whether the application is allowed in the
 // namespace it's residing in.
 func (ctrl *ApplicationController) isAppNamespaceAllowed(app *appv1.Application) bool {
@@ -2583,13 +3088,46 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 		}
 	}
 
-	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	dest := app.Spec.Destination
+	if ctrl.projInformer != nil {
+		if proj, err := ctrl.getAppProj(app); err == nil {
+			dest = proj.MapDestination(dest, time.Now())
+		}
+	}
+	destCluster, err := argo.GetDestinationCluster(context.Background(), dest, ctrl.db)
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
+	if destCluster.IsPullMode() {
+		// the application is deployed by the agent running in the cluster, which reports its status to the API server
+		return false
+	}
 	return ctrl.clusterSharding.IsManagedCluster(destCluster)
 }
 
+// informerResyncPeriod returns the period at which the application informer resyncs the applications, which requeues
+// them for reconciliation
+func (ctrl *ApplicationController) informerResyncPeriod() time.Duration {
+	if ctrl.statusHardRefreshTimeout.Seconds() != 0 && (ctrl.statusHardRefreshTimeout < ctrl.statusRefreshTimeout) {
+		return ctrl.statusHardRefreshTimeout
+	}
+	return ctrl.statusRefreshTimeout
+}
+
+// getAppRefreshInterval returns the refresh interval set in the sync policy of an application, bounded by the maximum
+// refresh interval of its project. It returns 0 if the application uses the reconciliation timeout of the controller.
+func (ctrl *ApplicationController) getAppRefreshInterval(app *appv1.Application) time.Duration {
+	if app.Spec.SyncPolicy.GetRefreshInterval() <= 0 {
+		return 0
+	}
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to get the project of the application to bound its refresh interval")
+		return 0
+	}
+	return proj.GetRefreshInterval(app.Spec.SyncPolicy)
+}
+
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
 	watchNamespace := ctrl.namespace
 	// If we have at least one additional namespace configured, we need to
@@ -2597,10 +3135,6 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 	if len(ctrl.applicationNamespaces) > 0 {
 		watchNamespace = ""
 	}
-	refreshTimeout := ctrl.statusRefreshTimeout
-	if ctrl.statusHardRefreshTimeout.Seconds() != 0 && (ctrl.statusHardRefreshTimeout < ctrl.statusRefreshTimeout) {
-		refreshTimeout = ctrl.statusHardRefreshTimeout
-	}
 	informer := cache.NewSharedIndexInformer(
 		&cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (apiruntime.Object, error) {
@@ -2624,7 +3158,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 			},
 		},
 		&appv1.Application{},
-		refreshTimeout,
+		ctrl.informerResyncPeriod(),
 		cache.Indexers{
 			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
 			orphanedIndex: func(obj any) (i []string, e error) {
@@ -2712,6 +3246,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			}
 			key, err := cache.MetaNamespaceKeyFunc(obj)
 			if err == nil {
+				ctrl.queueTracker.requested(appReconciliationQueueName, key, queueReasonAppCreated)
 				ctrl.appRefreshQueue.AddRateLimited(key)
 			}
 			newApp, newOK := obj.(*appv1.Application)
@@ -2731,10 +3266,12 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 
 			var compareWith *CompareWith
 			var delay *time.Duration
+			reason := queueReasonAppUpdated
 
 			oldApp, oldOK := old.(*appv1.Application)
 			newApp, newOK := new.(*appv1.Application)
 			if oldOK && newOK {
+				reason = appUpdateQueueReason(oldApp, newApp)
 				if automatedSyncEnabled(oldApp, newApp) {
 					log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 					compareWith = CompareWithLatest.Pointer()
@@ -2744,12 +3281,18 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 					jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
 					delay = &jitter
 				}
+				if reason == queueReasonInteractiveRefresh {
+					// Refreshes requested by users are rate limited per user by the API server, skip the queue rate limits
+					var noDelay time.Duration
+					delay = &noDelay
+				}
 			}
-			ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay)
+			ctrl.requestAppRefresh(newApp.QualifiedName(), compareWith, delay, reason)
 			if ctrl.hydrator != nil && newOK {
 				ctrl.appHydrateQueue.AddRateLimited(newApp.QualifiedName())
 			}
 			if newOK && ctrl.shouldProcessOperation(newApp) {
+				ctrl.queueTracker.requested(appOperationQueueName, key, queueReasonOperation)
 				ctrl.appOperationQueue.AddRateLimited(key)
 			}
 			ctrl.clusterSharding.UpdateApp(newApp)
@@ -2767,6 +3310,7 @@ func (ctrl *ApplicationController) applicationEventHandlerFuncs() cache.Resource
 			key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
 			if err == nil {
 				// for deletes, we immediately add to the refresh queue
+				ctrl.queueTracker.requested(appReconciliationQueueName, key, queueReasonAppDeleted)
 				ctrl.appRefreshQueue.Add(key)
 			}
 			delApp, delOK := obj.(*appv1.Application)
@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,6 +22,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -25,7 +31,9 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	"github.com/argoproj/argo-cd/v3/controller/webhook"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
@@ -81,6 +89,7 @@ type fakeData struct {
 	updateRevisionForPathsResponse  *apiclient.UpdateRevisionForPathsResponse
 	updateRevisionForPathsResponses []*apiclient.UpdateRevisionForPathsResponse
 	resolveRevisionResponses        []*apiclient.ResolveRevisionResponse
+	revisionMetadataResponse        *v1alpha1.RevisionMetadata
 	additionalObjs                  []runtime.Object
 	// onGenerateManifest, if set, is invoked with the ManifestRequest each
 	// time GenerateManifest is called. Useful for asserting fields that the
@@ -89,6 +98,10 @@ type fakeData struct {
 	// persistResourceHealth controls whether managed resource health is stored
 	// inline on the Application. When nil it defaults to true.
 	persistResourceHealth *bool
+	// clusterResources are the resources of the destination cluster cache returned by FindResources
+	clusterResources []*unstructured.Unstructured
+	// secretData are additional keys of the argocd-secret
+	secretData map[string][]byte
 }
 
 type MockKubectl struct {
@@ -163,6 +176,8 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 			mockRepoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).Run(captureRun).Return(data.manifestResponse, nil).Once()
 		}
 	}
+	// Fall back to the GenerateManifest calls mocked above
+	mockRepoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).Return(nil, status.Error(codes.Unimplemented, "")).Maybe()
 
 	if len(data.updateRevisionForPathsResponses) > 0 {
 		for _, response := range data.updateRevisionForPathsResponses {
@@ -190,6 +205,10 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 		}
 	}
 
+	if data.revisionMetadataResponse != nil {
+		mockRepoClient.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(data.revisionMetadataResponse, repoErr)
+	}
+
 	mockRepoClientset := &mockrepoclient.Clientset{RepoServerServiceClient: mockRepoClient}
 
 	mockCommitClientset := &mockcommitclient.Clientset{}
@@ -204,6 +223,7 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 			"server.secretkey": []byte("test"),
 		},
 	}
+	maps.Copy(secret.Data, data.secretData)
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      "argocd-cm",
@@ -248,6 +268,7 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 		data.metricsCacheExpiration,
 		[]string{},
 		[]string{},
+		metrics.DefaultSyncPhaseDurationLabels(),
 		[]string{},
 		0,
 		persistResourceHealth,
@@ -274,6 +295,18 @@ func newFakeControllerWithResync(ctx context.Context, data *fakeData, appResyncP
 	clusterCacheMock := &mocks.ClusterCache{}
 	clusterCacheMock.EXPECT().IsNamespaced(mock.Anything).Return(true, nil)
 	clusterCacheMock.EXPECT().GetGVKParser().Return(nil)
+	clusterCacheMock.EXPECT().FindResources(mock.Anything, mock.Anything).RunAndReturn(func(namespace string, predicates ...func(r *clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		result := map[kube.ResourceKey]*clustercache.Resource{}
+		for _, obj := range data.clusterResources {
+			res := &clustercache.Resource{Ref: kube.GetObjectRef(obj), Resource: obj}
+			if (namespace == "" || obj.GetNamespace() == namespace) && !slices.ContainsFunc(predicates, func(predicate func(r *clustercache.Resource) bool) bool {
+				return !predicate(res)
+			}) {
+				result[kube.GetResourceKey(obj)] = res
+			}
+		}
+		return result
+	}).Maybe()
 
 	mockStateCache := &mockstatecache.LiveStateCache{}
 	ctrl.appStateManager.(*appStateManager).liveStateCache = mockStateCache
@@ -729,6 +762,312 @@ func TestAutoSyncEnabledSetToTrue(t *testing.T) {
 	assert.False(t, app.Operation.Sync.Prune)
 }
 
+func TestAutoSyncMinRevisionAge(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("RevisionYoungerThanMinAgeShouldNotTriggerAutoSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.MinRevisionAge = &metav1.Duration{Duration: time.Hour}
+		ctrl := newFakeController(t.Context(), &fakeData{
+			apps:                     []runtime.Object{app},
+			revisionMetadataResponse: &v1alpha1.RevisionMetadata{Date: &metav1.Time{Time: time.Now().Add(-10 * time.Minute)}},
+		}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("RevisionOlderThanMinAgeShouldTriggerAutoSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.MinRevisionAge = &metav1.Duration{Duration: time.Hour}
+		ctrl := newFakeController(t.Context(), &fakeData{
+			apps:                     []runtime.Object{app},
+			revisionMetadataResponse: &v1alpha1.RevisionMetadata{Date: &metav1.Time{Time: time.Now().Add(-2 * time.Hour)}},
+		}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("FailureToGetRevisionMetadataShouldIndicateError", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.MinRevisionAge = &metav1.Duration{Duration: time.Hour}
+		ctrl := newFakeController(t.Context(), &fakeData{
+			apps:                     []runtime.Object{app},
+			revisionMetadataResponse: &v1alpha1.RevisionMetadata{},
+		}, errors.New("repo unavailable"))
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.Contains(t, cond.Message, "repo unavailable")
+	})
+}
+
+func TestAutoSyncScheduled(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("FirstReconciliationShouldOnlyRecordNextScheduledSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Scheduled = &v1alpha1.SyncPolicyScheduled{Schedule: "0 2 * * *"}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		require.NotNil(t, app.Status.NextScheduledSync)
+		assert.True(t, app.Spec.SyncPolicy.Scheduled.IsScheduledAt(app.Status.NextScheduledSync.Time))
+		assert.True(t, app.Status.NextScheduledSync.After(time.Now()))
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+
+	t.Run("DueScheduledSyncShouldTriggerAutoSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Scheduled = &v1alpha1.SyncPolicyScheduled{Schedule: "0 2 * * *"}
+		due := time.Date(2026, 1, 15, 2, 0, 0, 0, time.UTC)
+		app.Status.NextScheduledSync = &metav1.Time{Time: due}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		require.NotNil(t, app.Status.NextScheduledSync)
+		assert.True(t, app.Status.NextScheduledSync.After(due))
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("BlockedScheduledSyncShouldRemainDue", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Scheduled = &v1alpha1.SyncPolicyScheduled{Schedule: "0 2 * * *"}
+		app.Spec.SyncPolicy.Automated.Prune = new(true)
+		due := time.Date(2026, 1, 15, 2, 0, 0, 0, time.UTC)
+		app.Status.NextScheduledSync = &metav1.Time{Time: due}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+
+		// the sync is held back since it would wipe out all the resources
+		pruneOnly := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true}}
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, pruneOnly, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		require.NotNil(t, app.Status.NextScheduledSync)
+		assert.True(t, app.Status.NextScheduledSync.Equal(&metav1.Time{Time: due}))
+
+		// the scheduled sync is performed once it is no longer held back
+		cond, _ = ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		assert.True(t, app.Status.NextScheduledSync.After(due))
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("InvalidScheduleShouldIndicateError", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Scheduled = &v1alpha1.SyncPolicyScheduled{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.Contains(t, cond.Message, "Invalid sync schedule")
+	})
+
+	t.Run("RemovedScheduleShouldClearNextScheduledSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Status.NextScheduledSync = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		assert.Nil(t, app.Status.NextScheduledSync)
+	})
+}
+
+func TestAutoSyncPreconditions(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+
+	t.Run("MetPreconditionShouldTriggerAutoSync", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Preconditions = []v1alpha1.SyncPrecondition{{Expression: `cluster.labels.?maintenance.orValue("") != "true"`}}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("UnmetPreconditionShouldIndicateWarning", func(t *testing.T) {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Preconditions = []v1alpha1.SyncPrecondition{{Expression: `app.metadata.name == "other-app"`, Message: "not my app"}}
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncPreconditionWarning, cond.Type)
+		assert.Contains(t, cond.Message, "not my app")
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, app.Operation)
+	})
+}
+
+func TestAutoSyncMinSyncInterval(t *testing.T) {
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.MinSyncInterval = &metav1.Duration{Duration: 5 * time.Minute}
+	newSyncedApp := func(startedAt time.Time) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Status.OperationState = &v1alpha1.OperationState{
+			Operation: v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}},
+			Phase:     synccommon.OperationSucceeded,
+			StartedAt: metav1.NewTime(startedAt),
+			SyncResult: &v1alpha1.SyncOperationResult{
+				Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				Source:   *app.Spec.Source.DeepCopy(),
+			},
+		}
+		return app
+	}
+
+	t.Run("SyncWithinIntervalShouldBeThrottled", func(t *testing.T) {
+		app := newSyncedApp(time.Now().Add(-time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+		for range 2 {
+			cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+			assert.Nil(t, cond)
+		}
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+
+		events, err := ctrl.kubeClientset.CoreV1().Events(test.FakeArgoCDNamespace).List(t.Context(), metav1.ListOptions{})
+		require.NoError(t, err)
+		throttled := 0
+		for _, event := range events.Items {
+			if event.Reason == argo.EventReasonSyncThrottled {
+				throttled++
+				assert.Equal(t, corev1.EventTypeWarning, event.Type)
+			}
+		}
+		assert.Equal(t, 1, throttled)
+	})
+
+	t.Run("SyncAfterIntervalShouldTriggerAutoSync", func(t *testing.T) {
+		app := newSyncedApp(time.Now().Add(-10 * time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedApp.Operation)
+	})
+
+	t.Run("ProjectWithoutIntervalShouldTriggerAutoSync", func(t *testing.T) {
+		app := newSyncedApp(time.Now().Add(-time.Minute))
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, resources, true)
+		assert.Nil(t, cond)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedApp.Operation)
+	})
+}
+
+func TestAutoSyncSelfHealBackoff(t *testing.T) {
+	outOfSync := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	}
+	resources := []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}
+	newSelfHealApp := func(backoff *v1alpha1.SelfHealBackoff, attempts int64) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.Automated.SelfHeal = new(true)
+		app.Spec.SyncPolicy.Automated.SelfHealBackoff = backoff
+		if attempts > 0 {
+			app.Status.SelfHeal = &v1alpha1.SelfHealStatus{Attempts: attempts, LastAttemptedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)}}
+		}
+		return app
+	}
+
+	t.Run("SelfHealShouldRecordAttempt", func(t *testing.T) {
+		app := newSelfHealApp(&v1alpha1.SelfHealBackoff{Duration: "1s"}, 1)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &outOfSync, resources, true)
+		assert.Nil(t, cond)
+		require.NotNil(t, app.Status.SelfHeal)
+		assert.Equal(t, int64(2), app.Status.SelfHeal.Attempts)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, updatedApp.Operation)
+	})
+
+	t.Run("BackoffShouldDelaySelfHeal", func(t *testing.T) {
+		app := newSelfHealApp(&v1alpha1.SelfHealBackoff{Duration: "1h"}, 1)
+		app.Status.OperationState.FinishedAt = new(metav1.Now())
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &outOfSync, resources, true)
+		assert.Nil(t, cond)
+		assert.Equal(t, int64(1), app.Status.SelfHeal.Attempts)
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+	})
+
+	t.Run("MaxAttemptsShouldStopSelfHeal", func(t *testing.T) {
+		app := newSelfHealApp(&v1alpha1.SelfHealBackoff{Duration: "1s", MaxAttempts: 2}, 2)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &outOfSync, resources, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.Contains(t, cond.Message, "maximum of 2 self-heal attempts")
+		updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, updatedApp.Operation)
+	})
+
+	t.Run("NewRevisionShouldResetAttempts", func(t *testing.T) {
+		app := newSelfHealApp(&v1alpha1.SelfHealBackoff{MaxAttempts: 2}, 2)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		newRevision := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeOutOfSync, Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}
+		cond, _ := ctrl.autoSync(t.Context(), app, &newRevision, resources, true)
+		assert.Nil(t, cond)
+		assert.Nil(t, app.Status.SelfHeal)
+	})
+
+	t.Run("SyncedApplicationShouldResetAttemptsAfterCooldown", func(t *testing.T) {
+		synced := v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: outOfSync.Revision}
+
+		app := newSelfHealApp(&v1alpha1.SelfHealBackoff{Cooldown: "2h"}, 3)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &synced, nil, true)
+		assert.Nil(t, cond)
+		assert.NotNil(t, app.Status.SelfHeal)
+
+		app = newSelfHealApp(&v1alpha1.SelfHealBackoff{Cooldown: "30m"}, 3)
+		ctrl = newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ = ctrl.autoSync(t.Context(), app, &synced, nil, true)
+		assert.Nil(t, cond)
+		assert.Nil(t, app.Status.SelfHeal)
+	})
+}
+
 func TestAutoSyncMultiSourceWithoutSelfHeal(t *testing.T) {
 	// Simulate OutOfSync caused by object change in cluster
 	// So our Sync Revisions and SyncStatus Revisions should deep equal
@@ -834,6 +1173,48 @@ func TestAutoSyncAllowEmpty(t *testing.T) {
 	assert.Nil(t, cond)
 }
 
+// TestAutoSyncManagedNamespaceMetadataDrift verifies we auto-sync a drifted managed namespace even if all the resources
+// of the application are synced
+func TestAutoSyncManagedNamespaceMetadataDrift(t *testing.T) {
+	newDriftedApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.ManagedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"foo": "bar"}}
+		app.Status.OperationState = &v1alpha1.OperationState{SyncResult: &v1alpha1.SyncOperationResult{
+			ManagedNamespaceMetadata: app.Spec.SyncPolicy.ManagedNamespaceMetadata.DeepCopy(),
+		}}
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{
+			Type:    v1alpha1.ApplicationConditionManagedNamespaceMetadataWarning,
+			Message: `Metadata of managed namespace fake-dest-ns has drifted: label "foo" is missing`,
+		}}
+		return app
+	}
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+
+	t.Run("Synced resources", func(t *testing.T) {
+		app := newDriftedApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeSynced}}, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+
+	t.Run("No resources with prune", func(t *testing.T) {
+		app := newDriftedApp()
+		app.Spec.SyncPolicy.Automated.Prune = new(true)
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{}, true)
+		assert.Nil(t, cond)
+		app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotNil(t, app.Operation)
+	})
+}
+
 func TestSkipAutoSync(t *testing.T) {
 	// Verify we skip when we previously synced to it in our most recent history
 	// Set current to 'aaaaa', desired to 'aaaa' and mark system OutOfSync
@@ -969,8 +1350,9 @@ func TestSkipAutoSync(t *testing.T) {
 		app := newFakeApp()
 		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
 		syncStatus := v1alpha1.SyncStatus{
-			Status:   v1alpha1.SyncStatusCodeOutOfSync,
-			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			Status:    v1alpha1.SyncStatusCodeOutOfSync,
+			Revision:  "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			PruneOnly: true,
 		}
 		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{
 			{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync, RequiresPruning: true},
@@ -1912,6 +2294,27 @@ func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	assert.True(t, patched)
 }
 
+func TestSetOperationStateClearsTraceContext(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{
+		common.AnnotationKeyTraceContextPrefix + "traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"team": "my-team",
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	var patch map[string]any
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		require.NoError(t, json.Unmarshal(action.(kubetesting.PatchAction).GetPatch(), &patch))
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.setOperationState(t.Context(), app, &v1alpha1.OperationState{Phase: synccommon.OperationRunning})
+	assert.NotContains(t, patch, "metadata")
+
+	ctrl.setOperationState(t.Context(), app, &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded})
+	assert.Equal(t, map[string]any{"annotations": map[string]any{common.AnnotationKeyTraceContextPrefix + "traceparent": nil}}, patch["metadata"])
+}
+
 func TestSetOperationStateLogRetries(t *testing.T) {
 	hook := utilTest.LogHook{}
 	logrus.AddHook(&hook)
@@ -1938,6 +2341,74 @@ func TestSetOperationStateLogRetries(t *testing.T) {
 	assert.Contains(t, errorVal.Error(), "fake error")
 }
 
+func TestSetOperationStateNotifiesOperationWebhooks(t *testing.T) {
+	type delivery struct {
+		event     webhook.Event
+		signature string
+		valid     bool
+	}
+	deliveries := make(chan delivery, 4)
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return
+		}
+		var event webhook.Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			return
+		}
+		signature := r.Header.Get(webhook.SignatureHeader)
+		deliveries <- delivery{event: event, signature: signature, valid: signature == webhook.Sign(body, "test")}
+	}))
+	defer server.Close()
+
+	proj := defaultProj.DeepCopy()
+	proj.Spec.OperationWebhooks = []v1alpha1.OperationWebhook{
+		{Name: "project", URL: server.URL + "/project", Secret: "$webhook.operation.test"},
+		{Name: "not-permitted-url", URL: "http://169.254.169.254/latest"},
+		{Name: "not-permitted-secret", URL: server.URL + "/secret", Secret: "$server.secretkey"},
+	}
+	app := newFakeApp()
+	app.Status.OperationState = &v1alpha1.OperationState{Phase: synccommon.OperationRunning}
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps: []runtime.Object{app, proj},
+		configMapData: map[string]string{
+			"operation.webhooks": fmt.Sprintf(`
+- name: global
+  url: %s/global
+- name: running
+  url: %s/running
+  phases: [Running]`, server.URL, server.URL),
+			"operation.webhooks.allowedURLs": fmt.Sprintf("- %s/*", server.URL),
+		},
+		secretData: map[string][]byte{"webhook.operation.test": []byte("test")},
+	}, nil)
+
+	ctrl.setOperationState(t.Context(), app, &v1alpha1.OperationState{Phase: synccommon.OperationSucceeded, Message: "successfully synced"})
+
+	signed := 0
+	for range 2 {
+		select {
+		case d := <-deliveries:
+			assert.Equal(t, app.Name, d.event.Application)
+			assert.Equal(t, synccommon.OperationSucceeded, d.event.Phase)
+			assert.Equal(t, synccommon.OperationRunning, d.event.PreviousPhase)
+			if d.signature != "" {
+				assert.True(t, d.valid)
+				signed++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the operation webhooks")
+		}
+	}
+	assert.Equal(t, 1, signed)
+	select {
+	case d := <-deliveries:
+		t.Fatalf("unexpected delivery for phase %s", d.event.Phase)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 func TestNeedRefreshAppStatus(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -1988,8 +2459,8 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 				ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{}}, nil)
 
 				// refresh app using the 'deepest' requested comparison level
-				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
-				ctrl.requestAppRefresh(app.Name, ComparisonWithNothing.Pointer(), nil)
+				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil, queueReasonRefreshRequested)
+				ctrl.requestAppRefresh(app.Name, ComparisonWithNothing.Pointer(), nil, queueReasonRefreshRequested)
 
 				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
 				assert.True(t, needRefresh)
@@ -2007,7 +2478,7 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 				// refresh app with a non-nil delay
 				// use zero-second delay to test the add later logic without waiting in the test
 				delay := time.Duration(0)
-				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), &delay)
+				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), &delay, queueReasonRefreshRequested)
 
 				ctrl.processAppComparisonTypeQueueItem()
 				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
@@ -2037,7 +2508,7 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 				needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
 				assert.False(t, needRefresh)
 
-				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil, queueReasonRefreshRequested)
 				reconciledAt := metav1.NewTime(time.Now().UTC().Add(-1 * time.Hour))
 				app.Status.ReconciledAt = &reconciledAt
 				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 1*time.Minute, 2*time.Hour)
@@ -2066,7 +2537,7 @@ func TestNeedRefreshAppStatus(t *testing.T) {
 
 				needRefresh, _, _ := ctrl.needRefreshAppStatus(app, 1*time.Hour, 2*time.Hour)
 				assert.False(t, needRefresh)
-				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+				ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil, queueReasonRefreshRequested)
 				reconciledAt := metav1.NewTime(time.Now().UTC().Add(-1 * time.Hour))
 				app.Status.ReconciledAt = &reconciledAt
 				needRefresh, refreshType, compareWith := ctrl.needRefreshAppStatus(app, 2*time.Hour, 1*time.Minute)
@@ -2228,6 +2699,26 @@ func TestRefreshAppConditions(t *testing.T) {
 		assert.Equal(t, v1alpha1.ApplicationConditionExcludedResourceWarning, app.Status.Conditions[0].Type)
 	})
 
+	t.Run("RemovesExpiredTransientCondition", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+
+		ctrl.setAppCondition(app, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionDeletionError, Message: "failed to delete"})
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSeverityError, app.Status.Conditions[0].Severity)
+		require.NotNil(t, app.Status.Conditions[0].ExpiresAt)
+		assert.True(t, app.Status.Conditions[0].ExpiresAt.After(time.Now()))
+
+		_, hasErrors := ctrl.refreshAppConditions(t.Context(), app)
+		assert.False(t, hasErrors)
+		assert.Len(t, app.Status.Conditions, 1)
+
+		app.Status.Conditions[0].ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Second)}
+		_, hasErrors = ctrl.refreshAppConditions(t.Context(), app)
+		assert.False(t, hasErrors)
+		assert.Empty(t, app.Status.Conditions)
+	})
+
 	t.Run("ReplacesSpecErrorCondition", func(t *testing.T) {
 		app := newFakeApp()
 		app.Spec.Project = "wrong project"
@@ -2284,7 +2775,7 @@ func TestUpdateReconciledAt(t *testing.T) {
 
 	t.Run("UpdatedOnFullReconciliation", func(t *testing.T) {
 		receivedPatch = map[string]any{}
-		ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil)
+		ctrl.requestAppRefresh(app.Name, CompareWithLatest.Pointer(), nil, queueReasonRefreshRequested)
 		ctrl.appRefreshQueue.AddRateLimited(key)
 
 		ctrl.processAppRefreshQueueItem()
@@ -2301,7 +2792,7 @@ func TestUpdateReconciledAt(t *testing.T) {
 	t.Run("NotUpdatedOnPartialReconciliation", func(t *testing.T) {
 		receivedPatch = map[string]any{}
 		ctrl.appRefreshQueue.AddRateLimited(key)
-		ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+		ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil, queueReasonRefreshRequested)
 
 		ctrl.processAppRefreshQueueItem()
 
@@ -2575,7 +3066,7 @@ apps/Deployment:
 				assert.NotEqual(t, testTimestamp, *apps[0].Status.Health.LastTransitionTime)
 			}
 
-			ctrl.requestAppRefresh(app.Name, nil, nil)
+			ctrl.requestAppRefresh(app.Name, nil, nil, queueReasonRefreshRequested)
 			time.Sleep(time.Millisecond * 15)
 		})
 	}
@@ -2596,7 +3087,7 @@ func TestProjectErrorToCondition(t *testing.T) {
 	}, nil)
 	key, _ := cache.MetaNamespaceKeyFunc(app)
 	ctrl.appRefreshQueue.AddRateLimited(key)
-	ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil)
+	ctrl.requestAppRefresh(app.Name, CompareWithRecent.Pointer(), nil, queueReasonRefreshRequested)
 
 	ctrl.processAppRefreshQueueItem()
 
@@ -2617,6 +3108,8 @@ func TestOrphanedIndexDoesNotQueryProjectDuringStartupRace(t *testing.T) {
 	mockRepoClient := &mockrepoclient.RepoServerServiceClient{}
 	mockRepoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).
 		Return(&apiclient.ManifestResponse{}, nil).Maybe()
+	mockRepoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unimplemented, "")).Maybe()
 	mockRepoClient.EXPECT().UpdateRevisionForPaths(mock.Anything, mock.Anything).
 		Return(nil, nil).Maybe()
 	mockRepoClientset := &mockrepoclient.Clientset{RepoServerServiceClient: mockRepoClient}
@@ -2653,7 +3146,7 @@ func TestOrphanedIndexDoesNotQueryProjectDuringStartupRace(t *testing.T) {
 		&MockKubectl{Kubectl: &kubetest.MockKubectlCmd{}},
 		time.Minute, time.Hour, time.Second, time.Minute, nil, 0, 10*time.Second,
 		common.DefaultPortArgoCDMetrics, 0,
-		[]string{}, []string{}, []string{},
+		[]string{}, []string{}, []string{}, []string{},
 		0, true, nil, nil, nil, false, false,
 		normalizers.IgnoreNormalizerOpts{}, testEnableEventList, false,
 	)
@@ -2682,6 +3175,8 @@ func TestOrphanedIndexReturnsNamespaceWhenProjectHasOrphanedResources(t *testing
 	mockRepoClient := &mockrepoclient.RepoServerServiceClient{}
 	mockRepoClient.EXPECT().GenerateManifest(mock.Anything, mock.Anything).
 		Return(&apiclient.ManifestResponse{}, nil).Maybe()
+	mockRepoClient.EXPECT().GenerateManifestStream(mock.Anything, mock.Anything).
+		Return(nil, status.Error(codes.Unimplemented, "")).Maybe()
 	mockRepoClient.EXPECT().UpdateRevisionForPaths(mock.Anything, mock.Anything).
 		Return(nil, nil).Maybe()
 	mockRepoClientset := &mockrepoclient.Clientset{RepoServerServiceClient: mockRepoClient}
@@ -2718,7 +3213,7 @@ func TestOrphanedIndexReturnsNamespaceWhenProjectHasOrphanedResources(t *testing
 		&MockKubectl{Kubectl: &kubetest.MockKubectlCmd{}},
 		time.Minute, time.Hour, time.Second, time.Minute, nil, 0, 10*time.Second,
 		common.DefaultPortArgoCDMetrics, 0,
-		[]string{}, []string{}, []string{},
+		[]string{}, []string{}, []string{}, []string{},
 		0, true, nil, nil, nil, false, false,
 		normalizers.IgnoreNormalizerOpts{}, testEnableEventList, false,
 	)
@@ -2868,6 +3363,18 @@ func TestFinalizeProjectDeletion_IgnoresAppsNotPermittedByProject(t *testing.T)
 	}, receivedPatch)
 }
 
+func TestOperationCorrelationID(t *testing.T) {
+	app := newFakeApp()
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, CorrelationID: "request-id"}
+	assert.Equal(t, "request-id", operationCorrelationID(app))
+
+	app.Operation.CorrelationID = ""
+	assert.NotEmpty(t, operationCorrelationID(app))
+
+	app.Operation = nil
+	assert.NotEqual(t, operationCorrelationID(app), operationCorrelationID(app))
+}
+
 func TestProcessRequestedAppOperation_FailedNoRetries(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.Project = "default"
@@ -3651,6 +4158,28 @@ func Test_canProcessApp(t *testing.T) {
 	})
 }
 
+func TestApplyDestinationMappings(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destinations = []v1alpha1.ApplicationDestination{{Name: "old-cluster", Namespace: "other"}}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.DestinationMappings = []v1alpha1.DestinationMapping{
+		{Server: app.Spec.Destination.Server, TargetName: "new-cluster"},
+		{Name: "old-cluster", TargetServer: "https://new-cluster.example.com"},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+
+	mapped := app.DeepCopy()
+	ctrl.applyDestinationMappings(mapped)
+	assert.Equal(t, v1alpha1.ApplicationDestination{Name: "new-cluster", Namespace: app.Spec.Destination.Namespace}, mapped.Spec.Destination)
+	assert.Equal(t, []v1alpha1.ApplicationDestination{{Server: "https://new-cluster.example.com", Namespace: "other"}}, mapped.Spec.Destinations)
+
+	proj.Spec.DestinationMappings[0].EffectiveFrom = &metav1.Time{Time: time.Now().Add(time.Hour)}
+	ctrl = newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+	mapped = app.DeepCopy()
+	ctrl.applyDestinationMappings(mapped)
+	assert.Equal(t, app.Spec.Destination, mapped.Spec.Destination)
+}
+
 func Test_canProcessAppSkipReconcileAnnotation(t *testing.T) {
 	appSkipReconcileInvalid := newFakeApp()
 	appSkipReconcileInvalid.Annotations = map[string]string{common.AnnotationKeyAppSkipReconcile: "invalid-value"}
@@ -3677,6 +4206,30 @@ func Test_canProcessAppSkipReconcileAnnotation(t *testing.T) {
 	}
 }
 
+func Test_canProcessAppPullModeCluster(t *testing.T) {
+	pullCluster := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spoke",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				common.LabelKeySecretType:       common.LabelValueSecretTypeCluster,
+				common.LabelKeyClusterAgentMode: common.LabelValueClusterAgentModePull,
+			},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("spoke"),
+			"server": []byte("https://spoke.invalid"),
+			"config": []byte("{}"),
+		},
+	}
+	app := newFakeApp()
+	app.Spec.Destination = v1alpha1.ApplicationDestination{Name: "spoke", Namespace: test.FakeDestNamespace}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}, additionalObjs: []runtime.Object{pullCluster}}, nil)
+
+	assert.False(t, ctrl.canProcessApp(app))
+	assert.True(t, ctrl.canProcessApp(newFakeApp()))
+}
+
 func Test_syncDeleteOption(t *testing.T) {
 	app := newFakeApp()
 	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app}}, nil)
@@ -4148,3 +4701,100 @@ func TestPersistAppStatus_AnnotationManagement(t *testing.T) {
 		assert.Equal(t, "other-value", otherValue)
 	})
 }
+
+func TestGetAppReconcilePriorityAndRateLimit(t *testing.T) {
+	app := newFakeApp()
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			ReconcilePriority:  100,
+			ReconcileRateLimit: &v1alpha1.ReconcileRateLimit{PerMinute: 30},
+		},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+	key := ctrl.toAppKey(app.QualifiedName())
+
+	assert.Equal(t, int32(100), ctrl.getAppReconcilePriority(key))
+	projName, limit := ctrl.getAppReconcileRateLimit(key)
+	assert.Equal(t, "default", projName)
+	assert.Equal(t, &v1alpha1.ReconcileRateLimit{PerMinute: 30}, limit)
+
+	assert.Equal(t, int32(0), ctrl.getAppReconcilePriority(test.FakeArgoCDNamespace+"/unknown"))
+	_, limit = ctrl.getAppReconcileRateLimit(test.FakeArgoCDNamespace + "/unknown")
+	assert.Nil(t, limit)
+}
+
+func TestGetAppRefreshInterval(t *testing.T) {
+	app := newFakeApp()
+	proj := defaultProj.DeepCopy()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+	assert.Equal(t, time.Duration(0), ctrl.getAppRefreshInterval(app))
+
+	app.Spec.SyncPolicy.RefreshInterval = &metav1.Duration{Duration: time.Hour}
+	assert.Equal(t, time.Hour, ctrl.getAppRefreshInterval(app))
+
+	proj.Spec.MaxRefreshInterval = &metav1.Duration{Duration: 30 * time.Minute}
+	ctrl = newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, proj}}, nil)
+	assert.Equal(t, 30*time.Minute, ctrl.getAppRefreshInterval(app))
+}
+
+func TestProcessAppRefreshQueueItem_RefreshInterval(t *testing.T) {
+	newApp := func(reconciledAgo, refreshInterval time.Duration) *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.RefreshInterval = &metav1.Duration{Duration: refreshInterval}
+		app.Status.ReconciledAt = &metav1.Time{Time: time.Now().Add(-reconciledAgo)}
+		app.Status.Sync.ComparedTo = v1alpha1.ComparedTo{Source: *app.Spec.Source.DeepCopy(), Destination: app.Spec.Destination}
+		return app
+	}
+	reconciled := func(t *testing.T, app *v1alpha1.Application, proj *v1alpha1.AppProject) bool {
+		t.Helper()
+		ctrl := newFakeController(t.Context(), &fakeData{
+			apps:             []runtime.Object{app, proj},
+			manifestResponse: &apiclient.ManifestResponse{Manifests: []string{}, Namespace: test.FakeDestNamespace, Server: test.FakeClusterURL, Revision: "abc123"},
+			managedLiveObjs:  make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}, nil)
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		fakeAppCs.ReactionChain = nil
+		patched := false
+		fakeAppCs.AddReactor("patch", "*", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			patched = true
+			return true, &v1alpha1.Application{}, nil
+		})
+		key, _ := cache.MetaNamespaceKeyFunc(app)
+		ctrl.appRefreshQueue.AddRateLimited(key)
+		ctrl.processAppRefreshQueueItem()
+		return patched
+	}
+
+	t.Run("NotRefreshedWithinInterval", func(t *testing.T) {
+		assert.False(t, reconciled(t, newApp(30*time.Minute, 45*time.Minute), &defaultProj))
+	})
+
+	t.Run("RefreshedAfterInterval", func(t *testing.T) {
+		assert.True(t, reconciled(t, newApp(30*time.Minute, 10*time.Minute), &defaultProj))
+	})
+
+	t.Run("RefreshedAfterProjectMaxInterval", func(t *testing.T) {
+		proj := defaultProj.DeepCopy()
+		proj.Spec.MaxRefreshInterval = &metav1.Duration{Duration: 10 * time.Minute}
+		assert.True(t, reconciled(t, newApp(30*time.Minute, 45*time.Minute), proj))
+	})
+}
+
+func TestGetAppRefreshPriority(t *testing.T) {
+	app := newFakeApp()
+	interactiveApp := newFakeApp()
+	interactiveApp.Name = "interactive"
+	interactiveApp.Annotations = map[string]string{
+		v1alpha1.AnnotationKeyRefresh:         string(v1alpha1.RefreshTypeNormal),
+		v1alpha1.AnnotationKeyRefreshPriority: string(v1alpha1.RefreshPriorityInteractive),
+	}
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec:       v1alpha1.AppProjectSpec{ReconcilePriority: 100},
+	}
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, interactiveApp, proj}}, nil)
+
+	assert.Equal(t, int32(100), ctrl.getAppRefreshPriority(ctrl.toAppKey(app.QualifiedName())))
+	assert.Equal(t, int32(interactiveRefreshPriority), ctrl.getAppRefreshPriority(ctrl.toAppKey(interactiveApp.QualifiedName())))
+}
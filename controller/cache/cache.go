@@ -76,6 +76,12 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheManagedGroupKindsRefreshDuration is the env variable to control the interval between recomputations of the group kinds managed on each cluster when only the managed group kinds are watched
+	EnvClusterCacheManagedGroupKindsRefreshDuration = "ARGOCD_CLUSTER_CACHE_MANAGED_GROUP_KINDS_REFRESH_DURATION"
+
+	// EnvClusterCacheManagedNamespacesRefreshDuration is the env variable to control the interval between recomputations of the namespaces watched by the namespaced cluster caches
+	EnvClusterCacheManagedNamespacesRefreshDuration = "ARGOCD_CLUSTER_CACHE_MANAGED_NAMESPACES_REFRESH_DURATION"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -116,6 +122,12 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheManagedGroupKindsRefreshDuration specifies the interval between recomputations of the group kinds managed on each cluster
+	clusterCacheManagedGroupKindsRefreshDuration = time.Minute
+
+	// clusterCacheManagedNamespacesRefreshDuration specifies the interval between recomputations of the namespaces watched by the namespaced cluster caches
+	clusterCacheManagedNamespacesRefreshDuration = time.Minute
 )
 
 func init() {
@@ -129,6 +141,8 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheManagedGroupKindsRefreshDuration = env.ParseDurationFromEnv(EnvClusterCacheManagedGroupKindsRefreshDuration, clusterCacheManagedGroupKindsRefreshDuration, time.Second, math.MaxInt64)
+	clusterCacheManagedNamespacesRefreshDuration = env.ParseDurationFromEnv(EnvClusterCacheManagedNamespacesRefreshDuration, clusterCacheManagedNamespacesRefreshDuration, time.Second, math.MaxInt64)
 }
 
 type LiveStateCache interface {
@@ -189,6 +203,7 @@ type ResourceInfo struct {
 func NewLiveStateCache(
 	db db.ArgoDB,
 	appInformer cache.SharedIndexInformer,
+	projInformer cache.SharedIndexInformer,
 	settingsMgr *settings.SettingsManager,
 	metricsServer *metrics.MetricsServer,
 	onObjectUpdated ObjectUpdatedHandler,
@@ -196,14 +211,17 @@ func NewLiveStateCache(
 	resourceTracking argo.ResourceTracking,
 ) LiveStateCache {
 	return &liveStateCache{
-		appInformer:      appInformer,
-		db:               db,
-		clusters:         make(map[string]clustercache.ClusterCache),
-		onObjectUpdated:  onObjectUpdated,
-		settingsMgr:      settingsMgr,
-		metricsServer:    metricsServer,
-		clusterSharding:  clusterSharding,
-		resourceTracking: resourceTracking,
+		appInformer:       appInformer,
+		projInformer:      projInformer,
+		db:                db,
+		clusters:          make(map[string]clustercache.ClusterCache),
+		managedGroupKinds: make(map[string]map[schema.GroupKind]bool),
+		managedNamespaces: make(map[string][]string),
+		onObjectUpdated:   onObjectUpdated,
+		settingsMgr:       settingsMgr,
+		metricsServer:     metricsServer,
+		clusterSharding:   clusterSharding,
+		resourceTracking:  resourceTracking,
 	}
 }
 
@@ -217,11 +235,15 @@ type cacheSettings struct {
 
 	// ignoreResourceUpdates is a flag to enable resource-ignore rules.
 	ignoreResourceUpdatesEnabled bool
+
+	// watchManagedGroupKindsOnly is a flag to only watch the group kinds managed by the applications of each cluster.
+	watchManagedGroupKindsOnly bool
 }
 
 type liveStateCache struct {
 	db                   db.ArgoDB
 	appInformer          cache.SharedIndexInformer
+	projInformer         cache.SharedIndexInformer
 	onObjectUpdated      ObjectUpdatedHandler
 	settingsMgr          *settings.SettingsManager
 	metricsServer        *metrics.MetricsServer
@@ -229,9 +251,13 @@ type liveStateCache struct {
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
 
-	clusters      map[string]clustercache.ClusterCache
-	cacheSettings cacheSettings
-	lock          sync.RWMutex
+	clusters map[string]clustercache.ClusterCache
+	// managedGroupKinds holds the group kinds watched on each cluster when only the managed group kinds are watched
+	managedGroupKinds map[string]map[schema.GroupKind]bool
+	// managedNamespaces holds the namespaces watched by the caches of the clusters with a namespaced cache
+	managedNamespaces map[string][]string
+	cacheSettings     cacheSettings
+	lock              sync.RWMutex
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -263,12 +289,16 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	if err != nil {
 		return nil, err
 	}
+	watchManagedGroupKindsOnly, err := c.settingsMgr.IsWatchManagedGroupKindsOnlyEnabled()
+	if err != nil {
+		return nil, err
+	}
 	clusterSettings := clustercache.Settings{
 		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
 		ResourcesFilter:        resourcesFilter,
 	}
 
-	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled}, nil
+	return &cacheSettings{clusterSettings, appInstanceLabelKey, appv1.TrackingMethod(trackingMethod), installationID, resourceUpdatesOverrides, ignoreResourceUpdatesEnabled, watchManagedGroupKindsOnly}, nil
 }
 
 func asResourceNode(r *clustercache.Resource, namespaceResources map[kube.ResourceKey]*clustercache.Resource) appv1.ResourceNode {
@@ -542,6 +572,24 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clusterCacheConfig.WarningHandler = rest.NoWarnings{}
 	}
 
+	namespaces, err := c.getClusterNamespaces(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if isNamespacedCache(cluster) {
+		c.managedNamespaces[cluster.Server] = namespaces
+	}
+
+	clusterSettings := cacheSettings.clusterSettings
+	if cacheSettings.watchManagedGroupKindsOnly {
+		groupKinds, ok := c.managedGroupKinds[cluster.Server]
+		if !ok {
+			groupKinds = c.getManagedGroupKinds()[cluster.Server]
+			c.managedGroupKinds[cluster.Server] = groupKinds
+		}
+		clusterSettings = withManagedGroupKinds(clusterSettings, groupKinds)
+	}
+
 	clusterCacheOpts := []clustercache.UpdateSettingsFunc{
 		clustercache.SetListSemaphore(semaphore.NewWeighted(clusterCacheListSemaphoreSize)),
 		clustercache.SetListPageSize(clusterCacheListPageSize),
@@ -549,8 +597,8 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetWatchResyncTimeout(clusterCacheWatchResyncDuration),
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
-		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
+		clustercache.SetSettings(clusterSettings),
+		clustercache.SetNamespaces(namespaces),
 		clustercache.SetClusterResources(cluster.ClusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
 			res := &ResourceInfo{}
@@ -578,8 +626,11 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			}
 
 			// edge case. we do not label CRDs, so they miss the tracking label we inject. But we still
-			// want the full resource to be available in our cache (to diff), so we store all CRDs
-			return res, res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind
+			// want the full resource to be available in our cache (to diff), so we store all CRDs.
+			// Namespaces created with CreateNamespace=true are not labeled either, but their metadata is compared with
+			// the managedNamespaceMetadata of the application, so we store all namespaces too
+			isNamespace := gvk.Group == "" && gvk.Kind == kube.NamespaceKind
+			return res, res.AppName != "" || gvk.Kind == kube.CustomResourceDefinitionKind || isNamespace
 		}),
 		clustercache.SetLogr(logutils.NewLogrusLogger(log.WithField("server", cluster.Server))),
 		clustercache.SetRetryOptions(clusterCacheAttemptLimit, clusterCacheRetryUseBackoff, isRetryableError),
@@ -663,13 +714,27 @@ func (c *liveStateCache) getSyncedCluster(server *appv1.Cluster) (clustercache.C
 
 func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	log.Info("invalidating live state cache")
+	var managedGroupKinds map[string]map[schema.GroupKind]bool
+	if cacheSettings.watchManagedGroupKindsOnly {
+		managedGroupKinds = c.getManagedGroupKinds()
+	}
 	c.lock.Lock()
 	c.cacheSettings = cacheSettings
 	clusters := c.clusters
+	c.managedGroupKinds = make(map[string]map[schema.GroupKind]bool)
+	for server := range clusters {
+		if cacheSettings.watchManagedGroupKindsOnly {
+			c.managedGroupKinds[server] = managedGroupKinds[server]
+		}
+	}
 	c.lock.Unlock()
 
-	for _, clust := range clusters {
-		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
+	for server, clust := range clusters {
+		clusterSettings := cacheSettings.clusterSettings
+		if cacheSettings.watchManagedGroupKindsOnly {
+			clusterSettings = withManagedGroupKinds(clusterSettings, managedGroupKinds[server])
+		}
+		clust.Invalidate(clustercache.SetSettings(clusterSettings))
 	}
 	log.Info("live state cache invalidated")
 }
@@ -727,6 +792,10 @@ func (c *liveStateCache) GetNamespaceTopLevelResources(server *appv1.Cluster, na
 }
 
 func (c *liveStateCache) GetManagedLiveObjs(destCluster *appv1.Cluster, a *appv1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	if _, err := c.getCluster(destCluster); err == nil {
+		c.watchTargetGroupKinds(destCluster.Server, targetObjs)
+		c.watchTargetNamespaces(destCluster.Server, targetObjs)
+	}
 	clusterInfo, err := c.getSyncedCluster(destCluster)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster info for %q: %w", destCluster.Server, err)
@@ -807,6 +876,8 @@ func (c *liveStateCache) Init() error {
 // Run watches for resource changes annotated with application label on all registered clusters and schedule corresponding app refresh.
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
+	go c.watchManagedGroupKinds(ctx)
+	go c.watchManagedNamespaces(ctx)
 
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
@@ -818,7 +889,8 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 }
 
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	// Argo CD does not connect to pull mode clusters
+	return !cluster.IsPullMode() && c.clusterSharding.IsManagedCluster(cluster)
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -855,6 +927,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			delete(c.managedNamespaces, newCluster.Server)
 			c.lock.Unlock()
 			return
 		}
@@ -868,8 +941,20 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 				log.Errorf("error getting cluster REST config: %v", err)
 			}
 		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) || oldCluster.NamespacedCache != newCluster.NamespacedCache {
+			namespaces, err := c.getClusterNamespaces(newCluster)
+			if err == nil {
+				c.lock.Lock()
+				if isNamespacedCache(newCluster) {
+					c.managedNamespaces[newCluster.Server] = namespaces
+				} else {
+					delete(c.managedNamespaces, newCluster.Server)
+				}
+				c.lock.Unlock()
+				updateSettings = append(updateSettings, clustercache.SetNamespaces(namespaces))
+			} else {
+				log.Errorf("error getting cluster namespaces: %v", err)
+			}
 		}
 		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
 			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
@@ -900,6 +985,8 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		cluster.Invalidate()
 		c.lock.Lock()
 		delete(c.clusters, clusterServer)
+		delete(c.managedGroupKinds, clusterServer)
+		delete(c.managedNamespaces, clusterServer)
 		c.lock.Unlock()
 	}
 }
@@ -0,0 +1,197 @@
+package cache
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// alwaysWatchedGroupKinds are watched on every cluster when only the managed group kinds are watched. They are not
+// managed by the applications directly, but are the children of the commonly managed resources and are required to
+// build the resource trees and to assess the health of the applications.
+var alwaysWatchedGroupKinds = map[schema.GroupKind]bool{
+	{Group: "", Kind: kube.PodKind}:                    true,
+	{Group: "", Kind: kube.EndpointsKind}:              true,
+	{Group: "", Kind: kube.PersistentVolumeClaimKind}:  true,
+	{Group: "apps", Kind: kube.ReplicaSetKind}:         true,
+	{Group: "apps", Kind: "ControllerRevision"}:        true,
+	{Group: "batch", Kind: kube.JobKind}:               true,
+	{Group: "discovery.k8s.io", Kind: "EndpointSlice"}: true,
+}
+
+// managedGroupKindsFilter excludes the resources of the group kinds which are not managed on the cluster, in addition
+// to the resources excluded by the settings
+type managedGroupKindsFilter struct {
+	resourcesFilter kube.ResourceFilter
+	groupKinds      map[schema.GroupKind]bool
+}
+
+func (f *managedGroupKindsFilter) IsExcludedResource(group, kind, cluster string) bool {
+	if f.resourcesFilter != nil && f.resourcesFilter.IsExcludedResource(group, kind, cluster) {
+		return true
+	}
+	gk := schema.GroupKind{Group: group, Kind: kind}
+	return !f.groupKinds[gk] && !alwaysWatchedGroupKinds[gk]
+}
+
+// withManagedGroupKinds returns the cluster settings which only watch the given group kinds
+func withManagedGroupKinds(clusterSettings clustercache.Settings, groupKinds map[schema.GroupKind]bool) clustercache.Settings {
+	return clustercache.Settings{
+		ResourceHealthOverride: clusterSettings.ResourceHealthOverride,
+		ResourcesFilter:        &managedGroupKindsFilter{resourcesFilter: clusterSettings.ResourcesFilter, groupKinds: groupKinds},
+	}
+}
+
+// getManagedGroupKinds returns the group kinds managed on each cluster: the group kinds of the resources of the
+// applications targeting the cluster and the group kinds explicitly whitelisted by the projects of these applications.
+// Whitelist entries with wildcards are ignored.
+func (c *liveStateCache) getManagedGroupKinds() map[string]map[schema.GroupKind]bool {
+	managedGroupKinds := map[string]map[schema.GroupKind]bool{}
+	if c.appInformer == nil {
+		return managedGroupKinds
+	}
+	projectsByCluster := map[string]map[string]bool{}
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+		if err != nil {
+			log.Debugf("Failed to get destination cluster of application %s: %v", app.QualifiedName(), err)
+			continue
+		}
+		groupKinds, ok := managedGroupKinds[destCluster.Server]
+		if !ok {
+			groupKinds = map[schema.GroupKind]bool{}
+			managedGroupKinds[destCluster.Server] = groupKinds
+			projectsByCluster[destCluster.Server] = map[string]bool{}
+		}
+		for _, res := range app.Status.Resources {
+			groupKinds[schema.GroupKind{Group: res.Group, Kind: res.Kind}] = true
+		}
+		projectsByCluster[destCluster.Server][app.Spec.GetProject()] = true
+	}
+	if c.projInformer == nil {
+		return managedGroupKinds
+	}
+	for server, projects := range projectsByCluster {
+		for projName := range projects {
+			obj, exists, err := c.projInformer.GetIndexer().GetByKey(c.settingsMgr.GetNamespace() + "/" + projName)
+			if err != nil || !exists {
+				continue
+			}
+			proj, ok := obj.(*appv1.AppProject)
+			if !ok {
+				continue
+			}
+			for _, item := range proj.Spec.ClusterResourceWhitelist {
+				addWhitelistedGroupKind(managedGroupKinds[server], item.Group, item.Kind)
+			}
+			for _, item := range proj.Spec.NamespaceResourceWhitelist {
+				addWhitelistedGroupKind(managedGroupKinds[server], item.Group, item.Kind)
+			}
+		}
+	}
+	return managedGroupKinds
+}
+
+func addWhitelistedGroupKind(groupKinds map[schema.GroupKind]bool, group string, kind string) {
+	if group == "*" || kind == "*" || kind == "" {
+		return
+	}
+	groupKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+}
+
+// watchTargetGroupKinds makes sure the group kinds of the target objects of an application are watched on the
+// destination cluster, so that the live state of resources added to an application is known before the next refresh
+// of the managed group kinds
+func (c *liveStateCache) watchTargetGroupKinds(server string, targetObjs []*unstructured.Unstructured) {
+	c.lock.Lock()
+	if !c.cacheSettings.watchManagedGroupKindsOnly {
+		c.lock.Unlock()
+		return
+	}
+	groupKinds := c.managedGroupKinds[server]
+	var nextGroupKinds map[schema.GroupKind]bool
+	for _, obj := range targetObjs {
+		gk := obj.GroupVersionKind().GroupKind()
+		if groupKinds[gk] || alwaysWatchedGroupKinds[gk] {
+			continue
+		}
+		if nextGroupKinds == nil {
+			nextGroupKinds = make(map[schema.GroupKind]bool, len(groupKinds)+1)
+			maps.Copy(nextGroupKinds, groupKinds)
+		}
+		nextGroupKinds[gk] = true
+	}
+	if nextGroupKinds == nil {
+		c.lock.Unlock()
+		return
+	}
+	c.managedGroupKinds[server] = nextGroupKinds
+	clusterCache, ok := c.clusters[server]
+	clusterSettings := c.cacheSettings.clusterSettings
+	c.lock.Unlock()
+
+	if ok {
+		log.Infof("Start watching new managed group kinds of cluster %s", server)
+		clusterCache.Invalidate(clustercache.SetSettings(withManagedGroupKinds(clusterSettings, nextGroupKinds)))
+	}
+}
+
+// refreshManagedGroupKinds recomputes the group kinds managed on each cluster and invalidates the caches of the
+// clusters whose managed group kinds have changed
+func (c *liveStateCache) refreshManagedGroupKinds() {
+	c.lock.RLock()
+	enabled := c.cacheSettings.watchManagedGroupKindsOnly
+	c.lock.RUnlock()
+	if !enabled {
+		return
+	}
+
+	managedGroupKinds := c.getManagedGroupKinds()
+
+	c.lock.Lock()
+	clusterSettings := c.cacheSettings.clusterSettings
+	changed := map[string]clustercache.ClusterCache{}
+	for server, clusterCache := range c.clusters {
+		groupKinds := managedGroupKinds[server]
+		if groupKinds == nil {
+			groupKinds = map[schema.GroupKind]bool{}
+		}
+		if maps.Equal(c.managedGroupKinds[server], groupKinds) {
+			continue
+		}
+		c.managedGroupKinds[server] = groupKinds
+		changed[server] = clusterCache
+	}
+	c.lock.Unlock()
+
+	for server, clusterCache := range changed {
+		log.Infof("Managed group kinds of cluster %s have changed, invalidating cluster cache", server)
+		clusterCache.Invalidate(clustercache.SetSettings(withManagedGroupKinds(clusterSettings, managedGroupKinds[server])))
+	}
+}
+
+func (c *liveStateCache) watchManagedGroupKinds(ctx context.Context) {
+	ticker := time.NewTicker(clusterCacheManagedGroupKindsRefreshDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.refreshManagedGroupKinds()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
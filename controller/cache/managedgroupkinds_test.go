@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubecache "k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func newManagedGroupKindsTestCache(t *testing.T) *liveStateCache {
+	t.Helper()
+	_, settingsManager := fixtures(t.Context(), map[string]string{"resource.watchManagedGroupKindsOnly": "true"})
+
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetCluster(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, server string) (*appv1.Cluster, error) {
+		return &appv1.Cluster{Server: server}, nil
+	}).Maybe()
+
+	appInformer := kubecache.NewSharedIndexInformer(&kubecache.ListWatch{}, &appv1.Application{}, 0, kubecache.Indexers{})
+	for _, app := range []*appv1.Application{{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: appv1.ApplicationSpec{
+			Project:     "team",
+			Destination: appv1.ApplicationDestination{Server: "https://cluster-a"},
+		},
+		Status: appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Name: "guestbook"},
+			{Kind: "Service", Name: "guestbook"},
+		}},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Name: "certs", Namespace: "default"},
+		Spec: appv1.ApplicationSpec{
+			Project:     "default",
+			Destination: appv1.ApplicationDestination{Server: "https://cluster-b"},
+		},
+		Status: appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{
+			{Group: "cert-manager.io", Kind: "Certificate", Name: "example"},
+		}},
+	}} {
+		require.NoError(t, appInformer.GetStore().Add(app))
+	}
+
+	projInformer := kubecache.NewSharedIndexInformer(&kubecache.ListWatch{}, &appv1.AppProject{}, 0, kubecache.Indexers{})
+	require.NoError(t, projInformer.GetStore().Add(&appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "default"},
+		Spec: appv1.AppProjectSpec{
+			ClusterResourceWhitelist:   []appv1.ClusterResourceRestrictionItem{{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}, {Group: "*", Kind: "*"}},
+			NamespaceResourceWhitelist: []metav1.GroupKind{{Group: "networking.k8s.io", Kind: "Ingress"}, {Group: "apps", Kind: "*"}},
+		},
+	}))
+
+	c := &liveStateCache{
+		db:                db,
+		appInformer:       appInformer,
+		projInformer:      projInformer,
+		settingsMgr:       settingsManager,
+		clusters:          map[string]clustercache.ClusterCache{},
+		managedGroupKinds: map[string]map[schema.GroupKind]bool{},
+	}
+	require.NoError(t, c.Init())
+	return c
+}
+
+func TestGetManagedGroupKinds(t *testing.T) {
+	t.Parallel()
+	c := newManagedGroupKindsTestCache(t)
+
+	assert.Equal(t, map[string]map[schema.GroupKind]bool{
+		"https://cluster-a": {
+			{Group: "apps", Kind: "Deployment"}:                       true,
+			{Group: "", Kind: "Service"}:                              true,
+			{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"}: true,
+			{Group: "networking.k8s.io", Kind: "Ingress"}:             true,
+		},
+		"https://cluster-b": {
+			{Group: "cert-manager.io", Kind: "Certificate"}: true,
+		},
+	}, c.getManagedGroupKinds())
+}
+
+func TestManagedGroupKindsFilter(t *testing.T) {
+	t.Parallel()
+	c := newManagedGroupKindsTestCache(t)
+	filter := withManagedGroupKinds(c.cacheSettings.clusterSettings, c.getManagedGroupKinds()["https://cluster-a"]).ResourcesFilter
+
+	assert.False(t, filter.IsExcludedResource("apps", "Deployment", "https://cluster-a"))
+	assert.False(t, filter.IsExcludedResource("apps", "ReplicaSet", "https://cluster-a"))
+	assert.False(t, filter.IsExcludedResource("", "Pod", "https://cluster-a"))
+	assert.True(t, filter.IsExcludedResource("cert-manager.io", "Certificate", "https://cluster-a"))
+	assert.True(t, filter.IsExcludedResource("", "ConfigMap", "https://cluster-a"))
+	// resources excluded by the settings stay excluded
+	assert.True(t, filter.IsExcludedResource("events.k8s.io", "Event", "https://cluster-a"))
+}
+
+func TestRefreshManagedGroupKinds(t *testing.T) {
+	t.Parallel()
+	c := newManagedGroupKindsTestCache(t)
+	clusterA := mocks.NewClusterCache(t)
+	clusterB := mocks.NewClusterCache(t)
+	c.clusters["https://cluster-a"] = clusterA
+	c.clusters["https://cluster-b"] = clusterB
+	c.managedGroupKinds["https://cluster-b"] = c.getManagedGroupKinds()["https://cluster-b"]
+
+	clusterA.EXPECT().Invalidate(mock.Anything).Return().Once()
+	c.refreshManagedGroupKinds()
+	assert.Len(t, c.managedGroupKinds["https://cluster-a"], 4)
+
+	// nothing changed, no cluster is invalidated
+	c.refreshManagedGroupKinds()
+}
+
+func TestWatchTargetGroupKinds(t *testing.T) {
+	t.Parallel()
+	c := newManagedGroupKindsTestCache(t)
+	clusterB := mocks.NewClusterCache(t)
+	c.clusters["https://cluster-b"] = clusterB
+	c.managedGroupKinds["https://cluster-b"] = c.getManagedGroupKinds()["https://cluster-b"]
+
+	certificate := &unstructured.Unstructured{}
+	certificate.SetGroupVersionKind(schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"})
+	pod := &unstructured.Unstructured{}
+	pod.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "Pod"})
+	c.watchTargetGroupKinds("https://cluster-b", []*unstructured.Unstructured{certificate, pod})
+
+	configMap := &unstructured.Unstructured{}
+	configMap.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"})
+	clusterB.EXPECT().Invalidate(mock.Anything).Return().Once()
+	c.watchTargetGroupKinds("https://cluster-b", []*unstructured.Unstructured{certificate, configMap})
+	assert.True(t, c.managedGroupKinds["https://cluster-b"][schema.GroupKind{Kind: "ConfigMap"}])
+}
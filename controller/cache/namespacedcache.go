@@ -0,0 +1,149 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// isNamespacedCache returns true if the cache of the cluster only lists and watches the namespaces of the applications
+// deployed to the cluster
+func isNamespacedCache(cluster *appv1.Cluster) bool {
+	return cluster.NamespacedCache && len(cluster.Namespaces) == 0
+}
+
+// getClusterNamespaces returns the namespaces listed and watched by the cache of the cluster
+func (c *liveStateCache) getClusterNamespaces(cluster *appv1.Cluster) ([]string, error) {
+	if !isNamespacedCache(cluster) {
+		return cluster.Namespaces, nil
+	}
+	namespaces := c.getManagedNamespaces()[cluster.Server]
+	if len(namespaces) == 0 {
+		// an empty list of namespaces would make the cache list and watch the whole cluster
+		return nil, fmt.Errorf("cluster %s uses a namespaced cache, but no application is deployed to any of its namespaces", cluster.Server)
+	}
+	return namespaces, nil
+}
+
+// getManagedNamespaces returns the sorted namespaces managed on each cluster: the destination namespaces of the
+// applications targeting the cluster and the namespaces of their resources
+func (c *liveStateCache) getManagedNamespaces() map[string][]string {
+	managedNamespaces := map[string][]string{}
+	if c.appInformer == nil {
+		return managedNamespaces
+	}
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+		if err != nil {
+			log.Debugf("Failed to get destination cluster of application %s: %v", app.QualifiedName(), err)
+			continue
+		}
+		namespaces := managedNamespaces[destCluster.Server]
+		if app.Spec.Destination.Namespace != "" {
+			namespaces = append(namespaces, app.Spec.Destination.Namespace)
+		}
+		for _, res := range app.Status.Resources {
+			if res.Namespace != "" {
+				namespaces = append(namespaces, res.Namespace)
+			}
+		}
+		managedNamespaces[destCluster.Server] = namespaces
+	}
+	for server, namespaces := range managedNamespaces {
+		slices.Sort(namespaces)
+		managedNamespaces[server] = slices.Compact(namespaces)
+	}
+	return managedNamespaces
+}
+
+// watchTargetNamespaces makes sure the namespaces of the target objects of an application are watched by the namespaced
+// cache of the destination cluster, so that the live state of resources deployed to new namespaces is known before the
+// next refresh of the managed namespaces
+func (c *liveStateCache) watchTargetNamespaces(server string, targetObjs []*unstructured.Unstructured) {
+	c.lock.Lock()
+	namespaces, ok := c.managedNamespaces[server]
+	if !ok {
+		c.lock.Unlock()
+		return
+	}
+	var nextNamespaces []string
+	for _, obj := range targetObjs {
+		ns := obj.GetNamespace()
+		if ns == "" || slices.Contains(namespaces, ns) || slices.Contains(nextNamespaces, ns) {
+			continue
+		}
+		nextNamespaces = append(nextNamespaces, ns)
+	}
+	if len(nextNamespaces) == 0 {
+		c.lock.Unlock()
+		return
+	}
+	nextNamespaces = append(nextNamespaces, namespaces...)
+	slices.Sort(nextNamespaces)
+	c.managedNamespaces[server] = nextNamespaces
+	clusterCache, ok := c.clusters[server]
+	c.lock.Unlock()
+
+	if ok {
+		log.Infof("Start watching new managed namespaces of cluster %s", server)
+		clusterCache.Invalidate(clustercache.SetNamespaces(nextNamespaces))
+	}
+}
+
+// refreshManagedNamespaces recomputes the namespaces managed on each cluster with a namespaced cache and invalidates
+// the caches of the clusters whose managed namespaces have changed
+func (c *liveStateCache) refreshManagedNamespaces() {
+	c.lock.RLock()
+	enabled := len(c.managedNamespaces) > 0
+	c.lock.RUnlock()
+	if !enabled {
+		return
+	}
+
+	managedNamespaces := c.getManagedNamespaces()
+
+	c.lock.Lock()
+	changed := map[string]clustercache.ClusterCache{}
+	for server, namespaces := range c.managedNamespaces {
+		nextNamespaces := managedNamespaces[server]
+		// keep watching the previous namespaces rather than the whole cluster if no application is left
+		if len(nextNamespaces) == 0 || slices.Equal(namespaces, nextNamespaces) {
+			continue
+		}
+		c.managedNamespaces[server] = nextNamespaces
+		if clusterCache, ok := c.clusters[server]; ok {
+			changed[server] = clusterCache
+		}
+	}
+	c.lock.Unlock()
+
+	for server, clusterCache := range changed {
+		log.Infof("Managed namespaces of cluster %s have changed, invalidating cluster cache", server)
+		clusterCache.Invalidate(clustercache.SetNamespaces(managedNamespaces[server]))
+	}
+}
+
+func (c *liveStateCache) watchManagedNamespaces(ctx context.Context) {
+	ticker := time.NewTicker(clusterCacheManagedNamespacesRefreshDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.refreshManagedNamespaces()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kubecache "k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+)
+
+func newNamespacedTestCache(t *testing.T, apps ...*appv1.Application) *liveStateCache {
+	t.Helper()
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetCluster(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, server string) (*appv1.Cluster, error) {
+		return &appv1.Cluster{Server: server}, nil
+	}).Maybe()
+
+	appInformer := kubecache.NewSharedIndexInformer(&kubecache.ListWatch{}, &appv1.Application{}, 0, kubecache.Indexers{})
+	for _, app := range apps {
+		require.NoError(t, appInformer.GetStore().Add(app))
+	}
+	return &liveStateCache{
+		db:                db,
+		appInformer:       appInformer,
+		clusters:          map[string]clustercache.ClusterCache{},
+		managedNamespaces: map[string][]string{},
+	}
+}
+
+func newNamespacedTestApp(name string, server string, namespace string, resourceNamespaces ...string) *appv1.Application {
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec: appv1.ApplicationSpec{
+			Destination: appv1.ApplicationDestination{Server: server, Namespace: namespace},
+		},
+	}
+	for _, ns := range resourceNamespaces {
+		app.Status.Resources = append(app.Status.Resources, appv1.ResourceStatus{Kind: "ConfigMap", Name: name, Namespace: ns})
+	}
+	return app
+}
+
+func TestGetManagedNamespaces(t *testing.T) {
+	t.Parallel()
+	c := newNamespacedTestCache(t,
+		newNamespacedTestApp("guestbook", "https://cluster-a", "guestbook", "guestbook", "shared"),
+		newNamespacedTestApp("helm-guestbook", "https://cluster-a", "helm", "shared"),
+		newNamespacedTestApp("crds", "https://cluster-b", ""),
+	)
+
+	assert.Equal(t, map[string][]string{
+		"https://cluster-a": {"guestbook", "helm", "shared"},
+		"https://cluster-b": nil,
+	}, c.getManagedNamespaces())
+}
+
+func TestGetClusterNamespaces(t *testing.T) {
+	t.Parallel()
+	c := newNamespacedTestCache(t,
+		newNamespacedTestApp("guestbook", "https://cluster-a", "guestbook"),
+		newNamespacedTestApp("crds", "https://cluster-b", ""),
+	)
+
+	namespaces, err := c.getClusterNamespaces(&appv1.Cluster{Server: "https://cluster-a"})
+	require.NoError(t, err)
+	assert.Empty(t, namespaces)
+
+	namespaces, err = c.getClusterNamespaces(&appv1.Cluster{Server: "https://cluster-a", NamespacedCache: true, Namespaces: []string{"default"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, namespaces)
+
+	namespaces, err = c.getClusterNamespaces(&appv1.Cluster{Server: "https://cluster-a", NamespacedCache: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"guestbook"}, namespaces)
+
+	_, err = c.getClusterNamespaces(&appv1.Cluster{Server: "https://cluster-b", NamespacedCache: true})
+	require.ErrorContains(t, err, "no application is deployed to any of its namespaces")
+}
+
+func TestRefreshManagedNamespaces(t *testing.T) {
+	t.Parallel()
+	c := newNamespacedTestCache(t,
+		newNamespacedTestApp("guestbook", "https://cluster-a", "guestbook"),
+		newNamespacedTestApp("helm-guestbook", "https://cluster-a", "helm"),
+	)
+	clusterA := mocks.NewClusterCache(t)
+	clusterB := mocks.NewClusterCache(t)
+	c.clusters["https://cluster-a"] = clusterA
+	c.clusters["https://cluster-b"] = clusterB
+	c.managedNamespaces["https://cluster-a"] = []string{"guestbook"}
+	c.managedNamespaces["https://cluster-b"] = []string{"default"}
+
+	clusterA.EXPECT().Invalidate(mock.Anything).Return().Once()
+	c.refreshManagedNamespaces()
+	assert.Equal(t, []string{"guestbook", "helm"}, c.managedNamespaces["https://cluster-a"])
+	// cluster-b has no application left, the previous namespaces are kept
+	assert.Equal(t, []string{"default"}, c.managedNamespaces["https://cluster-b"])
+
+	// nothing changed, no cluster is invalidated
+	c.refreshManagedNamespaces()
+}
+
+func TestWatchTargetNamespaces(t *testing.T) {
+	t.Parallel()
+	c := newNamespacedTestCache(t)
+	clusterA := mocks.NewClusterCache(t)
+	clusterB := mocks.NewClusterCache(t)
+	c.clusters["https://cluster-a"] = clusterA
+	c.clusters["https://cluster-b"] = clusterB
+	c.managedNamespaces["https://cluster-a"] = []string{"guestbook"}
+
+	newObj := func(namespace string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetNamespace(namespace)
+		return obj
+	}
+
+	// namespaces already watched or cluster level objects do not invalidate the cache
+	c.watchTargetNamespaces("https://cluster-a", []*unstructured.Unstructured{newObj("guestbook"), newObj("")})
+
+	clusterA.EXPECT().Invalidate(mock.Anything).Return().Once()
+	c.watchTargetNamespaces("https://cluster-a", []*unstructured.Unstructured{newObj("monitoring"), newObj("guestbook"), newObj("monitoring")})
+	assert.Equal(t, []string{"guestbook", "monitoring"}, c.managedNamespaces["https://cluster-a"])
+
+	// clusters without a namespaced cache are ignored
+	c.watchTargetNamespaces("https://cluster-b", []*unstructured.Unstructured{newObj("monitoring")})
+}
@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// compareDestinations compares the state of the destinations of an application with multiple destinations other than
+// the primary one, and aggregates their sync and health status into the comparison result of the primary destination.
+// The manifests are generated for the revisions resolved by the comparison of the primary destination, so that all the
+// destinations are compared to the same manifests.
+func (ctrl *ApplicationController) compareDestinations(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, sources []v1alpha1.ApplicationSource, localManifests []string, compareResult *comparisonResult) []v1alpha1.ApplicationDestinationStatus {
+	revisions := []string{compareResult.syncStatus.Revision}
+	if app.Spec.HasMultipleSources() {
+		revisions = compareResult.syncStatus.Revisions
+	}
+	statuses := []v1alpha1.ApplicationDestinationStatus{{
+		Destination: app.Spec.Destination,
+		Sync:        compareResult.syncStatus.Status,
+		Health:      compareResult.healthStatus,
+	}}
+	for _, dest := range app.Spec.Destinations[1:] {
+		destApp := app.DeepCopy()
+		destApp.Spec.Destination = dest
+		destStatus := v1alpha1.ApplicationDestinationStatus{
+			Destination: dest,
+			Sync:        v1alpha1.SyncStatusCodeUnknown,
+			Health:      health.HealthStatusUnknown,
+		}
+		destResult, err := ctrl.appStateManager.CompareAppState(ctx, destApp, project, revisions, sources, false, false, localManifests, app.Spec.HasMultipleSources())
+		if err != nil {
+			destStatus.Message = err.Error()
+		} else {
+			destStatus.Sync = destResult.syncStatus.Status
+			destStatus.Health = destResult.healthStatus
+			if errConditions := destApp.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionComparisonError: true, v1alpha1.ApplicationConditionManifestGenerationLimitError: true}); len(errConditions) > 0 {
+				destStatus.Message = argo.FormatAppConditions(errConditions)
+			}
+		}
+		statuses = append(statuses, destStatus)
+	}
+
+	syncStatus, healthStatus := aggregateDestinationStatuses(statuses)
+	compareResult.syncStatus.Status = syncStatus
+	if healthStatus != compareResult.healthStatus {
+		compareResult.healthStatus = healthStatus
+		compareResult.healthMessage = ""
+	}
+	return statuses
+}
+
+// aggregateDestinationStatuses returns the sync and health status of an application from the status of its
+// destinations: the application is OutOfSync if any destination is OutOfSync, so that the other destinations can still
+// be synced automatically, and its health is the worst health of its destinations
+func aggregateDestinationStatuses(statuses []v1alpha1.ApplicationDestinationStatus) (v1alpha1.SyncStatusCode, health.HealthStatusCode) {
+	syncStatus := v1alpha1.SyncStatusCodeSynced
+	healthStatus := health.HealthStatusHealthy
+	for _, status := range statuses {
+		switch {
+		case status.Sync == v1alpha1.SyncStatusCodeOutOfSync:
+			syncStatus = v1alpha1.SyncStatusCodeOutOfSync
+		case status.Sync == v1alpha1.SyncStatusCodeUnknown && syncStatus == v1alpha1.SyncStatusCodeSynced:
+			syncStatus = v1alpha1.SyncStatusCodeUnknown
+		}
+		if health.IsWorse(healthStatus, status.Health) {
+			healthStatus = status.Health
+		}
+	}
+	return syncStatus, healthStatus
+}
+
+// hasChangedDestinations returns true if the destinations of the application differ from the destinations of its status
+func hasChangedDestinations(app *v1alpha1.Application) bool {
+	if len(app.Spec.Destinations) != len(app.Status.Destinations) {
+		return true
+	}
+	for i, dest := range app.Spec.Destinations {
+		if dest != app.Status.Destinations[i].Destination {
+			return true
+		}
+	}
+	return false
+}
+
+// removeOtherDestinationsLiveObjs returns the live objects without the objects deployed to the namespaces of the other
+// destinations of an application with multiple destinations on the same cluster. The resources of all the destinations
+// are tracked with the same application instance, so they would otherwise be considered as extraneous resources of the
+// destination.
+func (m *appStateManager) removeOtherDestinationsLiveObjs(ctx context.Context, app *v1alpha1.Application, destCluster *v1alpha1.Cluster, targetObjs []*unstructured.Unstructured, liveObjByKey map[kubeutil.ResourceKey]*unstructured.Unstructured) map[kubeutil.ResourceKey]*unstructured.Unstructured {
+	otherNamespaces := map[string]bool{}
+	for _, dest := range app.Spec.Destinations {
+		if dest == app.Spec.Destination || dest.Namespace == app.Spec.Destination.Namespace {
+			continue
+		}
+		otherCluster, err := argo.GetDestinationCluster(ctx, dest, m.db)
+		if err == nil && otherCluster.Server == destCluster.Server {
+			otherNamespaces[dest.Namespace] = true
+		}
+	}
+	if len(otherNamespaces) == 0 {
+		return liveObjByKey
+	}
+	targetKeys := map[kubeutil.ResourceKey]bool{}
+	for _, obj := range targetObjs {
+		targetKeys[kubeutil.GetResourceKey(obj)] = true
+	}
+	res := make(map[kubeutil.ResourceKey]*unstructured.Unstructured, len(liveObjByKey))
+	for key, obj := range liveObjByKey {
+		if !otherNamespaces[key.Namespace] || targetKeys[key] {
+			res[key] = obj
+		}
+	}
+	return res
+}
+
+// syncDestinations syncs the destinations of an application with multiple destinations one after the other, within a
+// single operation. The results of the destinations already synced are kept in the sync result, so that the operation
+// continues with the next destination. The operation stops at the first destination which fails to sync, and restarts
+// from the first destination when it is retried, since the destinations already synced may have drifted in between.
+func (m *appStateManager) syncDestinations(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) {
+	synced := 0
+	if state.SyncResult != nil {
+		synced = len(state.SyncResult.Destinations)
+		if synced > 0 && !state.SyncResult.Destinations[synced-1].Phase.Successful() {
+			state.SyncResult.Destinations = nil
+			synced = 0
+		}
+	}
+	if synced >= len(app.Spec.Destinations) {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("all the %d destinations of the application have already been synced", len(app.Spec.Destinations))
+		return
+	}
+	dest := app.Spec.Destinations[synced]
+	destApp := app.DeepCopy()
+	destApp.Spec.Destination = dest
+	m.syncAppState(ctx, destApp, project, state)
+	if state.SyncResult == nil || !state.Phase.Completed() {
+		return
+	}
+
+	state.SyncResult.Destinations = append(state.SyncResult.Destinations, v1alpha1.SyncOperationDestinationResult{
+		Destination: dest,
+		Phase:       state.Phase,
+		Message:     state.Message,
+		Resources:   state.SyncResult.Resources,
+	})
+	if state.Phase.Successful() && synced+1 < len(app.Spec.Destinations) {
+		state.Phase = common.OperationRunning
+		state.Message = fmt.Sprintf("successfully synced destination %d of %d, syncing the next destination", synced+1, len(app.Spec.Destinations))
+		state.SyncResult.Resources = nil
+	}
+}
+
+// isSyncingLastDestination returns true if the destination being synced is the last destination of the application
+func isSyncingLastDestination(app *v1alpha1.Application, state *v1alpha1.OperationState) bool {
+	return !app.Spec.HasMultipleDestinations() || len(state.SyncResult.Destinations) == len(app.Spec.Destinations)-1
+}
+
+// deletionDestination is a destination cluster of an application being deleted on cascade, with the REST config used
+// to delete the resources of the application from the cluster
+type deletionDestination struct {
+	cluster *v1alpha1.Cluster
+	config  *rest.Config
+}
+
+// getDeletionDestinations returns the clusters from which the resources of an application are deleted on cascade: the
+// cluster of the primary destination, followed by the clusters of the other destinations of an application with
+// multiple destinations. The live objects of the application are looked up across the whole cluster, so each cluster
+// is returned only once. The destinations whose cluster is no longer defined are skipped.
+func (ctrl *ApplicationController) getDeletionDestinations(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, destCluster *v1alpha1.Cluster, config *rest.Config, logCtx *log.Entry) ([]deletionDestination, error) {
+	destinations := []deletionDestination{{cluster: destCluster, config: config}}
+	if !app.Spec.HasMultipleDestinations() {
+		return destinations, nil
+	}
+	servers := map[string]bool{destCluster.Server: true}
+	for _, dest := range app.Spec.Destinations {
+		cluster, err := argo.GetDestinationCluster(ctx, dest, ctrl.db)
+		if err != nil {
+			logCtx.WithError(err).WithField("destination-namespace", dest.Namespace).Warn("Unable to get destination cluster")
+			continue
+		}
+		if servers[cluster.Server] {
+			continue
+		}
+		servers[cluster.Server] = true
+		destApp := app.DeepCopy()
+		destApp.Spec.Destination = dest
+		restConfig, err := cluster.RESTConfig()
+		if err != nil {
+			return nil, err
+		}
+		destConfig := metrics.AddMetricsTransportWrapper(ctrl.metricsServer, destApp, restConfig)
+		if err := ctrl.applyImpersonationConfig(destConfig, project, destApp, cluster); err != nil {
+			return nil, fmt.Errorf("cannot apply impersonation: %w", err)
+		}
+		destinations = append(destinations, deletionDestination{cluster: cluster, config: destConfig})
+	}
+	return destinations, nil
+}
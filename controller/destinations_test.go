@@ -0,0 +1,288 @@
+package controller
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	. "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	mockstatecache "github.com/argoproj/argo-cd/v3/controller/cache/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeMultiDestinationApp() *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.Destinations = []v1alpha1.ApplicationDestination{
+		{Server: app.Spec.Destination.Server, Namespace: test.FakeDestNamespace},
+		{Server: app.Spec.Destination.Server, Namespace: "other-ns"},
+	}
+	app.Spec.Destination = app.Spec.Destinations[0]
+	return app
+}
+
+func newFakeMultiDestinationData(app *v1alpha1.Application, liveObjs ...*unstructured.Unstructured) *fakeData {
+	data := &fakeData{
+		apps:            []runtime.Object{app, &defaultProj},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{},
+	}
+	// the manifests are generated once per destination
+	for _, dest := range app.Spec.Destinations {
+		data.manifestResponses = append(data.manifestResponses, &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: dest.Namespace,
+			Server:    dest.Server,
+			Revision:  "abc123",
+		})
+	}
+	for _, obj := range liveObjs {
+		data.managedLiveObjs[kube.GetResourceKey(obj)] = obj
+	}
+	return data
+}
+
+func TestAggregateDestinationStatuses(t *testing.T) {
+	newStatus := func(sync v1alpha1.SyncStatusCode, health health.HealthStatusCode) v1alpha1.ApplicationDestinationStatus {
+		return v1alpha1.ApplicationDestinationStatus{Sync: sync, Health: health}
+	}
+
+	syncStatus, healthStatus := aggregateDestinationStatuses([]v1alpha1.ApplicationDestinationStatus{
+		newStatus(v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		newStatus(v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing),
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, syncStatus)
+	assert.Equal(t, health.HealthStatusProgressing, healthStatus)
+
+	syncStatus, healthStatus = aggregateDestinationStatuses([]v1alpha1.ApplicationDestinationStatus{
+		newStatus(v1alpha1.SyncStatusCodeUnknown, health.HealthStatusUnknown),
+		newStatus(v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, syncStatus)
+	assert.Equal(t, health.HealthStatusUnknown, healthStatus)
+
+	syncStatus, healthStatus = aggregateDestinationStatuses([]v1alpha1.ApplicationDestinationStatus{
+		newStatus(v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusMissing),
+		newStatus(v1alpha1.SyncStatusCodeUnknown, health.HealthStatusDegraded),
+	})
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, syncStatus)
+	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+}
+
+func TestHasChangedDestinations(t *testing.T) {
+	app := newFakeApp()
+	assert.False(t, hasChangedDestinations(app))
+
+	app = newFakeMultiDestinationApp()
+	assert.True(t, hasChangedDestinations(app))
+
+	app.Status.Destinations = []v1alpha1.ApplicationDestinationStatus{
+		{Destination: app.Spec.Destinations[0]},
+		{Destination: app.Spec.Destinations[1]},
+	}
+	assert.False(t, hasChangedDestinations(app))
+
+	app.Spec.Destinations[1].Namespace = "changed"
+	assert.True(t, hasChangedDestinations(app))
+}
+
+func TestCompareDestinations(t *testing.T) {
+	// the pod is deployed to the other destination only, and is not an extraneous resource of the primary destination
+	pod := NewPod()
+	pod.SetNamespace("other-ns")
+	app := newFakeMultiDestinationApp()
+	ctrl := newFakeController(t.Context(), newFakeMultiDestinationData(app, pod), nil)
+
+	compareResult, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, []string{""}, app.Spec.GetSources(), false, false, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compareResult.syncStatus.Status)
+
+	statuses := ctrl.compareDestinations(t.Context(), app, &defaultProj, app.Spec.GetSources(), nil, compareResult)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, app.Spec.Destinations[0], statuses[0].Destination)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, statuses[0].Sync)
+	assert.Equal(t, app.Spec.Destinations[1], statuses[1].Destination)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, statuses[1].Sync)
+	assert.Empty(t, statuses[1].Message)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compareResult.syncStatus.Status)
+}
+
+func TestSyncDestinations(t *testing.T) {
+	app := newFakeMultiDestinationApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	ctrl := newFakeController(t.Context(), newFakeMultiDestinationData(app), nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, &defaultProj, opState)
+	assert.Equal(t, synccommon.OperationRunning, opState.Phase)
+	assert.Equal(t, "successfully synced destination 1 of 2, syncing the next destination", opState.Message)
+	require.Len(t, opState.SyncResult.Destinations, 1)
+	assert.Equal(t, app.Spec.Destinations[0], opState.SyncResult.Destinations[0].Destination)
+	assert.Equal(t, synccommon.OperationSucceeded, opState.SyncResult.Destinations[0].Phase)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, updatedApp.Status.History)
+
+	ctrl.appStateManager.SyncAppState(t.Context(), app, &defaultProj, opState)
+	assert.Equal(t, synccommon.OperationSucceeded, opState.Phase)
+	require.Len(t, opState.SyncResult.Destinations, 2)
+	assert.Equal(t, app.Spec.Destinations[1], opState.SyncResult.Destinations[1].Destination)
+
+	updatedApp, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, updatedApp.Status.History, 1)
+}
+
+func TestSyncDestinationsCordonedCluster(t *testing.T) {
+	remoteCluster := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "remote-cluster",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":     []byte("remote"),
+			"server":   []byte("https://remote:6443"),
+			"config":   []byte(`{"bearerToken":"fake","tlsClientConfig":{"insecure":true}}`),
+			"cordoned": []byte("true"),
+		},
+	}
+	app := newFakeMultiDestinationApp()
+	app.Spec.Destinations[1] = v1alpha1.ApplicationDestination{Server: "https://remote:6443", Namespace: "remote-ns"}
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	data := newFakeMultiDestinationData(app)
+	data.additionalObjs = []runtime.Object{remoteCluster}
+	ctrl := newFakeController(t.Context(), data, nil)
+
+	t.Run("Sync", func(t *testing.T) {
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{},
+		}}
+		ctrl.appStateManager.SyncAppState(t.Context(), app, &defaultProj, opState)
+		assert.Equal(t, synccommon.OperationRunning, opState.Phase)
+
+		// the sync of the second destination is a new sync, even though the sync of the first destination has started
+		ctrl.appStateManager.SyncAppState(t.Context(), app, &defaultProj, opState)
+		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+		assert.Equal(t, "Cluster 'https://remote:6443' is cordoned and does not accept new syncs", opState.Message)
+	})
+
+	t.Run("AutoSync", func(t *testing.T) {
+		syncStatus := v1alpha1.SyncStatus{
+			Status:   v1alpha1.SyncStatusCodeOutOfSync,
+			Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		}
+		cond, _ := ctrl.autoSync(t.Context(), app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, cond.Type)
+		assert.Equal(t, "Skipping auto-sync: cluster 'https://remote:6443' is cordoned", cond.Message)
+	})
+}
+
+func TestFinalizeMultiDestinationAppDeletion(t *testing.T) {
+	remoteCluster := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "remote-cluster",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeCluster},
+		},
+		Data: map[string][]byte{
+			"name":   []byte("remote"),
+			"server": []byte("https://remote:6443"),
+			"config": []byte(`{"bearerToken":"fake","tlsClientConfig":{"insecure":true}}`),
+		},
+	}
+	app := newFakeMultiDestinationApp()
+	app.Spec.Destinations[1] = v1alpha1.ApplicationDestination{Server: "https://remote:6443", Namespace: "remote-ns"}
+	app.SetCascadedDeletion(v1alpha1.ResourcesFinalizerName)
+	now := metav1.Now()
+	app.DeletionTimestamp = &now
+	data := newFakeMultiDestinationData(app)
+	data.additionalObjs = []runtime.Object{remoteCluster}
+	ctrl := newFakeController(t.Context(), data, nil)
+
+	localPod := NewPod()
+	localPod.SetNamespace(test.FakeDestNamespace)
+	remotePod := NewPod()
+	remotePod.SetNamespace("remote-ns")
+	liveObjs := map[string]*unstructured.Unstructured{
+		app.Spec.Destination.Server: localPod,
+		"https://remote:6443":       remotePod,
+	}
+	kubectl := ctrl.kubectl.(*MockKubectl)
+	stateCache := &mockstatecache.LiveStateCache{}
+	stateCache.EXPECT().GetManagedLiveObjs(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(cluster *v1alpha1.Cluster, _ *v1alpha1.Application, _ []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+		objs := map[kube.ResourceKey]*unstructured.Unstructured{}
+		if obj, ok := liveObjs[cluster.Server]; ok && !slices.Contains(kubectl.DeletedResources, kube.GetResourceKey(obj)) {
+			objs[kube.GetResourceKey(obj)] = obj
+		}
+		return objs, nil
+	})
+	ctrl.stateCache = stateCache
+
+	err := ctrl.finalizeApplicationDeletion(t.Context(), app, func(_ string) ([]*v1alpha1.Cluster, error) {
+		return []*v1alpha1.Cluster{}, nil
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []kube.ResourceKey{kube.GetResourceKey(localPod), kube.GetResourceKey(remotePod)}, kubectl.DeletedResources)
+
+	updatedApp, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(t.Context(), app.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, updatedApp.CascadedDeletion())
+}
+
+func TestSyncDestinationsRetry(t *testing.T) {
+	app := newFakeMultiDestinationApp()
+	app.Operation = &v1alpha1.Operation{
+		Sync:  &v1alpha1.SyncOperation{},
+		Retry: v1alpha1.RetryStrategy{Limit: 1},
+	}
+	app.Status.History = nil
+	app.Status.OperationState.Operation = *app.Operation
+	app.Status.OperationState.Phase = synccommon.OperationRunning
+	app.Status.OperationState.RetryCount = 1
+	app.Status.OperationState.FinishedAt = &metav1.Time{Time: time.Now().Add(-5 * time.Minute)}
+	app.Status.OperationState.SyncResult.Destinations = []v1alpha1.SyncOperationDestinationResult{
+		{Destination: app.Spec.Destinations[0], Phase: synccommon.OperationSucceeded},
+		{Destination: app.Spec.Destinations[1], Phase: synccommon.OperationFailed},
+	}
+	ctrl := newFakeController(t.Context(), newFakeMultiDestinationData(app), nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	// the retry restarts from the first destination
+	message, _, _ := unstructured.NestedString(receivedPatch, "status", "operationState", "message")
+	assert.Equal(t, "successfully synced destination 1 of 2, syncing the next destination", message)
+	destinations, _, _ := unstructured.NestedSlice(receivedPatch, "status", "operationState", "syncResult", "destinations")
+	require.Len(t, destinations, 1)
+	namespace, _, _ := unstructured.NestedString(destinations[0].(map[string]any), "destination", "namespace")
+	assert.Equal(t, app.Spec.Destinations[0].Namespace, namespace)
+}
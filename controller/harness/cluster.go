@@ -0,0 +1,225 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// defaultClusterScopedKinds are the built-in kinds which are not namespaced
+var defaultClusterScopedKinds = []schema.GroupKind{
+	{Kind: kube.NamespaceKind},
+	{Kind: "Node"},
+	{Kind: "PersistentVolume"},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole"},
+	{Group: "rbac.authorization.k8s.io", Kind: "ClusterRoleBinding"},
+	{Group: "storage.k8s.io", Kind: "StorageClass"},
+	{Group: "apiextensions.k8s.io", Kind: kube.CustomResourceDefinitionKind},
+	{Group: "admissionregistration.k8s.io", Kind: "MutatingWebhookConfiguration"},
+	{Group: "admissionregistration.k8s.io", Kind: "ValidatingWebhookConfiguration"},
+	{Group: "apiregistration.k8s.io", Kind: "APIService"},
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+}
+
+// FakeCluster is an in-memory cluster which holds the live state of the resources. It implements the live state cache
+// of the application controller, so applications are compared against the resources stored in it.
+type FakeCluster struct {
+	settingsMgr   *settings.SettingsManager
+	namespace     string
+	serverVersion string
+
+	lock          sync.RWMutex
+	objects       map[kube.ResourceKey]*unstructured.Unstructured
+	clusterScoped map[schema.GroupKind]bool
+}
+
+// compile-time validation of adherence of the LiveStateCache contract
+var _ statecache.LiveStateCache = &FakeCluster{}
+
+func newFakeCluster(settingsMgr *settings.SettingsManager, namespace string, serverVersion string) *FakeCluster {
+	c := &FakeCluster{
+		settingsMgr:   settingsMgr,
+		namespace:     namespace,
+		serverVersion: serverVersion,
+		objects:       map[kube.ResourceKey]*unstructured.Unstructured{},
+		clusterScoped: map[schema.GroupKind]bool{},
+	}
+	for _, gk := range defaultClusterScopedKinds {
+		c.clusterScoped[gk] = true
+	}
+	return c
+}
+
+// SetClusterScoped marks the given group kind, e.g. of a custom resource, as cluster scoped
+func (c *FakeCluster) SetClusterScoped(gk schema.GroupKind) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.clusterScoped[gk] = true
+}
+
+// Apply creates or replaces the given resources
+func (c *FakeCluster) Apply(objs ...*unstructured.Unstructured) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for _, obj := range objs {
+		c.objects[kube.GetResourceKey(obj)] = obj.DeepCopy()
+	}
+}
+
+// ApplyYAML creates or replaces the resources of the given, possibly multi-document, YAML manifest
+func (c *FakeCluster) ApplyYAML(manifest string) error {
+	objs, err := kube.SplitYAML([]byte(manifest))
+	if err != nil {
+		return fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	c.Apply(objs...)
+	return nil
+}
+
+// Get returns a copy of the resource with the given key, or nil if it does not exist
+func (c *FakeCluster) Get(key kube.ResourceKey) *unstructured.Unstructured {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if obj, ok := c.objects[key]; ok {
+		return obj.DeepCopy()
+	}
+	return nil
+}
+
+// Update applies the given function to the resource with the given key
+func (c *FakeCluster) Update(key kube.ResourceKey, update func(obj *unstructured.Unstructured) error) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	obj, ok := c.objects[key]
+	if !ok {
+		return fmt.Errorf("resource %s not found", key.String())
+	}
+	obj = obj.DeepCopy()
+	if err := update(obj); err != nil {
+		return err
+	}
+	c.objects[key] = obj
+	return nil
+}
+
+// Delete deletes the resource with the given key
+func (c *FakeCluster) Delete(key kube.ResourceKey) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.objects, key)
+}
+
+func (c *FakeCluster) isNamespaced(gk schema.GroupKind) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return !c.clusterScoped[gk]
+}
+
+func (c *FakeCluster) GetVersionsInfo(_ *v1alpha1.Cluster) (string, []kube.APIResourceInfo, error) {
+	return c.serverVersion, nil, nil
+}
+
+func (c *FakeCluster) IsNamespaced(_ *v1alpha1.Cluster, gk schema.GroupKind) (bool, error) {
+	return c.isNamespaced(gk), nil
+}
+
+func (c *FakeCluster) GetClusterCache(_ *v1alpha1.Cluster) (clustercache.ClusterCache, error) {
+	return &fakeClusterCache{cluster: c}, nil
+}
+
+func (c *FakeCluster) IterateHierarchyV2(_ *v1alpha1.Cluster, _ []kube.ResourceKey, _ func(child v1alpha1.ResourceNode, appName string) bool) error {
+	return nil
+}
+
+// GetManagedLiveObjs returns the resources tracked as part of the application and the resources matching the target
+// objects, like the cluster cache of the application controller.
+func (c *FakeCluster) GetManagedLiveObjs(_ *v1alpha1.Cluster, a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
+	appLabelKey, err := c.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, err
+	}
+	trackingMethod, err := c.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := c.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, err
+	}
+	resourceTracking := argo.NewResourceTracking()
+	instanceName := a.InstanceName(c.namespace)
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	managedObjs := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for key, obj := range c.objects {
+		if len(obj.GetOwnerReferences()) == 0 && resourceTracking.GetAppName(obj, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID) == instanceName {
+			managedObjs[key] = obj.DeepCopy()
+		}
+	}
+	for _, targetObj := range targetObjs {
+		key := kube.GetResourceKey(targetObj)
+		if _, ok := managedObjs[key]; ok {
+			continue
+		}
+		if obj, ok := c.objects[key]; ok {
+			managedObjs[key] = obj.DeepCopy()
+		}
+	}
+	return managedObjs, nil
+}
+
+func (c *FakeCluster) IterateResources(_ *v1alpha1.Cluster, _ func(res *clustercache.Resource, info *statecache.ResourceInfo)) error {
+	return nil
+}
+
+func (c *FakeCluster) GetNamespaceTopLevelResources(_ *v1alpha1.Cluster, _ string) (map[kube.ResourceKey]v1alpha1.ResourceNode, error) {
+	return map[kube.ResourceKey]v1alpha1.ResourceNode{}, nil
+}
+
+func (c *FakeCluster) Run(ctx context.Context) error {
+	<-ctx.Done()
+	return nil
+}
+
+func (c *FakeCluster) GetClustersInfo() []clustercache.ClusterInfo {
+	return nil
+}
+
+func (c *FakeCluster) Init() error {
+	return nil
+}
+
+func (c *FakeCluster) UpdateShard(_ int) bool {
+	return false
+}
+
+// fakeClusterCache provides the resource information used while comparing applications. Other methods of the
+// cluster cache are not supported and panic.
+type fakeClusterCache struct {
+	clustercache.ClusterCache
+	cluster *FakeCluster
+}
+
+func (c *fakeClusterCache) IsNamespaced(gk schema.GroupKind) (bool, error) {
+	return c.cluster.isNamespaced(gk), nil
+}
+
+func (c *fakeClusterCache) GetGVKParser() *managedfields.GvkParser {
+	return nil
+}
+
+func (c *fakeClusterCache) GetServerVersion() string {
+	return c.cluster.serverVersion
+}
@@ -0,0 +1,314 @@
+// Package harness simulates the reconciliation of applications by the application controller against an in-memory
+// cluster and repo server. It allows testing custom health checks, ignoreDifferences, resource actions and sync wave
+// assumptions against the real comparison logic without a Kubernetes cluster.
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/hook"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// DefaultNamespace is the namespace Argo CD is simulated to be installed in
+	DefaultNamespace = "argocd"
+	// DefaultServerVersion is the Kubernetes version reported by the simulated cluster
+	DefaultServerVersion = "1.34"
+)
+
+type options struct {
+	namespace            string
+	configMapData        map[string]string
+	project              *v1alpha1.AppProject
+	startTime            time.Time
+	repoErrorGracePeriod time.Duration
+	serverVersion        string
+}
+
+// Option configures the harness
+type Option func(*options)
+
+// WithNamespace sets the namespace Argo CD is simulated to be installed in
+func WithNamespace(namespace string) Option {
+	return func(o *options) {
+		o.namespace = namespace
+	}
+}
+
+// WithConfigMapData sets the data of the argocd-cm ConfigMap, e.g. resource customizations
+func WithConfigMapData(data map[string]string) Option {
+	return func(o *options) {
+		o.configMapData = data
+	}
+}
+
+// WithProject sets the project of the reconciled applications. Defaults to a project permitting all sources,
+// destinations and resources.
+func WithProject(project *v1alpha1.AppProject) Option {
+	return func(o *options) {
+		o.project = project
+	}
+}
+
+// WithStartTime sets the initial time of the harness clock
+func WithStartTime(t time.Time) Option {
+	return func(o *options) {
+		o.startTime = t
+	}
+}
+
+// WithRepoErrorGracePeriod sets the period during which manifest generation errors are ignored
+func WithRepoErrorGracePeriod(period time.Duration) Option {
+	return func(o *options) {
+		o.repoErrorGracePeriod = period
+	}
+}
+
+// WithServerVersion sets the Kubernetes version reported by the simulated cluster
+func WithServerVersion(version string) Option {
+	return func(o *options) {
+		o.serverVersion = version
+	}
+}
+
+// Harness reconciles applications using the comparison logic of the application controller against an in-memory
+// cluster and repo server
+type Harness struct {
+	// Cluster holds the live state of the resources
+	Cluster *FakeCluster
+	// Repo returns the target state of the applications
+	Repo *FakeRepoServer
+	// Clock is the clock used during reconciliation. Advance it to simulate the passing of time.
+	Clock *clocktesting.FakeClock
+
+	namespace            string
+	project              *v1alpha1.AppProject
+	repoErrorGracePeriod time.Duration
+	settingsMgr          *settings.SettingsManager
+	stateManager         controller.AppStateManager
+}
+
+// Result holds the state of an application after a reconciliation
+type Result struct {
+	Sync         v1alpha1.SyncStatus
+	Health       v1alpha1.AppHealthStatus
+	Resources    []v1alpha1.ResourceStatus
+	Conditions   []v1alpha1.ApplicationCondition
+	ReconciledAt metav1.Time
+}
+
+// Resource returns the status of the resource with the given kind, namespace and name, or nil if the resource is not
+// part of the application
+func (r *Result) Resource(kind string, namespace string, name string) *v1alpha1.ResourceStatus {
+	for i := range r.Resources {
+		if res := &r.Resources[i]; res.Kind == kind && res.Namespace == namespace && res.Name == name {
+			return res
+		}
+	}
+	return nil
+}
+
+func defaultProject(namespace string) *v1alpha1.AppProject {
+	return &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: namespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:              []string{"*"},
+			Destinations:             []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			ClusterResourceWhitelist: []v1alpha1.ClusterResourceRestrictionItem{{Group: "*", Kind: "*"}},
+		},
+	}
+}
+
+// New returns a harness with an empty cluster and repo server
+func New(ctx context.Context, opts ...Option) (*Harness, error) {
+	o := options{
+		namespace:     DefaultNamespace,
+		startTime:     time.Now(),
+		serverVersion: DefaultServerVersion,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.project == nil {
+		o.project = defaultProject(o.namespace)
+	}
+
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDConfigMapName,
+				Namespace: o.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Data: o.configMapData,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      common.ArgoCDSecretName,
+				Namespace: o.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+			},
+			Data: map[string][]byte{"server.secretkey": []byte("harness")},
+		},
+	)
+	settingsMgr := settings.NewSettingsManager(ctx, kubeClient, o.namespace)
+	if err := settingsMgr.ResyncInformers(); err != nil {
+		return nil, fmt.Errorf("failed to load settings: %w", err)
+	}
+
+	h := &Harness{
+		Cluster:              newFakeCluster(settingsMgr, o.namespace, o.serverVersion),
+		Repo:                 newFakeRepoServer(),
+		Clock:                clocktesting.NewFakeClock(o.startTime),
+		namespace:            o.namespace,
+		project:              o.project,
+		repoErrorGracePeriod: o.repoErrorGracePeriod,
+		settingsMgr:          settingsMgr,
+	}
+	h.stateManager = controller.NewAppStateManager(
+		db.NewDB(o.namespace, settingsMgr, kubeClient),
+		appclientset.NewSimpleClientset(o.project),
+		h.Repo,
+		o.namespace,
+		kubeutil.NewKubectl(),
+		func(_ string) (kube.CleanupFunc, error) {
+			return func() {}, nil
+		},
+		settingsMgr,
+		h.Cluster,
+		nil,
+		appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour),
+		time.Hour,
+		argo.NewResourceTracking(),
+		true,
+		o.repoErrorGracePeriod,
+		false,
+		normalizers.IgnoreNormalizerOpts{},
+		controller.WithClock(h.Clock),
+	)
+	return h, nil
+}
+
+// Reconcile compares the target state of the application, as returned by the repo server, with the live state of the
+// cluster. When a repo error grace period is configured, returns controller.ErrCompareStateRepo while manifest
+// generation errors are ignored; otherwise, they are reported as a ComparisonError condition.
+func (h *Harness) Reconcile(ctx context.Context, app *v1alpha1.Application) (*Result, error) {
+	app = app.DeepCopy()
+	if app.Namespace == "" {
+		app.Namespace = h.namespace
+	}
+	sources := app.Spec.GetSources()
+	revisions := make([]string, len(sources))
+	for i, source := range sources {
+		revisions[i] = source.TargetRevision
+	}
+	// the controller ignores the first occurrence of a repo error unless the revision cache is bypassed, which would hide
+	// errors forever since the clock does not advance on its own
+	noRevisionCache := h.repoErrorGracePeriod == 0
+	res, err := h.stateManager.CompareAppState(ctx, app, h.project, revisions, sources, true, noRevisionCache, nil, app.Spec.HasMultipleSources())
+	if err != nil {
+		return nil, err
+	}
+	return &Result{
+		Sync:         *res.GetSyncStatus(),
+		Health:       v1alpha1.AppHealthStatus{Status: res.GetHealthStatus(), Message: res.GetHealthMessage()},
+		Resources:    res.GetResources(),
+		Conditions:   app.Status.Conditions,
+		ReconciledAt: metav1.NewTime(h.Clock.Now()),
+	}, nil
+}
+
+// ApplyTargetState simulates a successful sync of the application by applying the resources of its target state,
+// labeled with the resource tracking of the application, to the cluster. Hooks and skipped resources are not applied.
+func (h *Harness) ApplyTargetState(app *v1alpha1.Application) ([]*unstructured.Unstructured, error) {
+	appLabelKey, err := h.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, err
+	}
+	trackingMethod, err := h.settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, err
+	}
+	installationID, err := h.settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, err
+	}
+	resourceTracking := argo.NewResourceTracking()
+	var applied []*unstructured.Unstructured
+	for _, source := range app.Spec.GetSources() {
+		manifests, err := h.Repo.getManifests(&source)
+		if err != nil {
+			return nil, err
+		}
+		for _, manifest := range manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), &obj.Object); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+			}
+			if hook.IsHook(obj) || hook.Skip(obj) {
+				continue
+			}
+			if obj.GetNamespace() == "" && h.Cluster.isNamespaced(obj.GroupVersionKind().GroupKind()) {
+				obj.SetNamespace(app.Spec.Destination.Namespace)
+			}
+			err := resourceTracking.SetAppInstance(obj, appLabelKey, app.InstanceName(h.namespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set resource tracking: %w", err)
+			}
+			applied = append(applied, obj)
+		}
+	}
+	h.Cluster.Apply(applied...)
+	return applied, nil
+}
+
+// RunResourceAction runs the resource action with the given name against the live resource with the given key, using
+// the built-in and configured resource customizations, and applies the resulting changes to the cluster
+func (h *Harness) RunResourceAction(key kube.ResourceKey, action string, params ...*application.ResourceActionParameters) ([]lua.ImpactedResource, error) {
+	liveObj := h.Cluster.Get(key)
+	if liveObj == nil {
+		return nil, fmt.Errorf("resource %s not found", key.String())
+	}
+	resourceOverrides, err := h.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	luaVM := lua.VM{
+		ResourceOverrides: resourceOverrides,
+	}
+	actionDef, err := luaVM.GetResourceAction(liveObj, action)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+	}
+	impactedResources, err := luaVM.ExecuteResourceAction(liveObj, actionDef.ActionLua, params)
+	if err != nil {
+		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+	}
+	for _, impactedResource := range impactedResources {
+		h.Cluster.Apply(impactedResource.UnstructuredObj)
+	}
+	return impactedResources, nil
+}
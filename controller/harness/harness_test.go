@@ -0,0 +1,240 @@
+package harness
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	repoURL = "https://github.com/argoproj/argocd-example-apps"
+	appPath = "guestbook"
+)
+
+const guestbookManifests = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook-ui
+  annotations:
+    argocd.argoproj.io/sync-wave: "-1"
+spec:
+  ports:
+  - port: 80
+    targetPort: 80
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook-ui
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: guestbook-ui
+  template:
+    metadata:
+      labels:
+        app: guestbook-ui
+    spec:
+      containers:
+      - name: guestbook-ui
+        image: quay.io/argoprojlabs/argocd-e2e-container:0.2
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+  annotations:
+    argocd.argoproj.io/hook: PreSync
+spec:
+  template:
+    spec:
+      containers:
+      - name: migrate
+        image: busybox
+      restartPolicy: Never
+`
+
+func newApp() *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: DefaultNamespace},
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source: &v1alpha1.ApplicationSource{
+				RepoURL:        repoURL,
+				Path:           appPath,
+				TargetRevision: "HEAD",
+			},
+			Destination: v1alpha1.ApplicationDestination{
+				Server:    v1alpha1.KubernetesInternalAPIServerAddr,
+				Namespace: "default",
+			},
+		},
+	}
+}
+
+func newHarness(t *testing.T, opts ...Option) *Harness {
+	t.Helper()
+	h, err := New(t.Context(), opts...)
+	require.NoError(t, err)
+	require.NoError(t, h.Repo.SetManifests(repoURL, appPath, guestbookManifests))
+	return h
+}
+
+func setDeploymentAvailable(t *testing.T, h *Harness) {
+	t.Helper()
+	key := kube.NewResourceKey("apps", "Deployment", "default", "guestbook-ui")
+	require.NoError(t, h.Cluster.Update(key, func(obj *unstructured.Unstructured) error {
+		return unstructured.SetNestedMap(obj.Object, map[string]any{
+			"observedGeneration": int64(0),
+			"replicas":           int64(1),
+			"updatedReplicas":    int64(1),
+			"availableReplicas":  int64(1),
+		}, "status")
+	}))
+}
+
+func TestHarness_Reconcile(t *testing.T) {
+	h := newHarness(t)
+	app := newApp()
+
+	res, err := h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, res.Sync.Status)
+	assert.Equal(t, health.HealthStatusMissing, res.Health.Status)
+
+	applied, err := h.ApplyTargetState(app)
+	require.NoError(t, err)
+	assert.Len(t, applied, 2, "hooks are not applied")
+	setDeploymentAvailable(t, h)
+
+	res, err = h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, res.Sync.Status)
+	assert.Equal(t, health.HealthStatusHealthy, res.Health.Status)
+
+	svc := res.Resource("Service", "default", "guestbook-ui")
+	require.NotNil(t, svc)
+	assert.Equal(t, int64(-1), svc.SyncWave)
+	deploy := res.Resource("Deployment", "default", "guestbook-ui")
+	require.NotNil(t, deploy)
+	assert.Equal(t, int64(0), deploy.SyncWave)
+	assert.Nil(t, res.Resource("Job", "default", "migrate"), "hooks are not part of the resources")
+}
+
+func TestHarness_CustomHealthCheck(t *testing.T) {
+	h := newHarness(t, WithConfigMapData(map[string]string{
+		"resource.customizations.health.apps_Deployment": `
+hs = {}
+if obj.metadata.annotations ~= nil and obj.metadata.annotations["example.com/ready"] == "true" then
+  hs.status = "Healthy"
+  return hs
+end
+hs.status = "Progressing"
+hs.message = "Waiting for readiness annotation"
+return hs
+`,
+	}))
+	app := newApp()
+	_, err := h.ApplyTargetState(app)
+	require.NoError(t, err)
+
+	res, err := h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusProgressing, res.Health.Status)
+	deploy := res.Resource("Deployment", "default", "guestbook-ui")
+	require.NotNil(t, deploy)
+	assert.Equal(t, "Waiting for readiness annotation", deploy.Health.Message)
+
+	require.NoError(t, h.Cluster.Update(kube.NewResourceKey("apps", "Deployment", "default", "guestbook-ui"), func(obj *unstructured.Unstructured) error {
+		obj.SetAnnotations(map[string]string{"example.com/ready": "true"})
+		return nil
+	}))
+	res, err = h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, health.HealthStatusHealthy, res.Health.Status)
+}
+
+func TestHarness_IgnoreDifferences(t *testing.T) {
+	h := newHarness(t)
+	app := newApp()
+	_, err := h.ApplyTargetState(app)
+	require.NoError(t, err)
+	require.NoError(t, h.Cluster.Update(kube.NewResourceKey("apps", "Deployment", "default", "guestbook-ui"), func(obj *unstructured.Unstructured) error {
+		return unstructured.SetNestedField(obj.Object, int64(3), "spec", "replicas")
+	}))
+
+	res, err := h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, res.Sync.Status)
+
+	app.Spec.IgnoreDifferences = []v1alpha1.ResourceIgnoreDifferences{{
+		Group:        "apps",
+		Kind:         "Deployment",
+		JSONPointers: []string{"/spec/replicas"},
+	}}
+	res, err = h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, res.Sync.Status)
+}
+
+func TestHarness_RunResourceAction(t *testing.T) {
+	h := newHarness(t)
+	app := newApp()
+	_, err := h.ApplyTargetState(app)
+	require.NoError(t, err)
+
+	key := kube.NewResourceKey("apps", "Deployment", "default", "guestbook-ui")
+	impacted, err := h.RunResourceAction(key, "restart")
+	require.NoError(t, err)
+	require.Len(t, impacted, 1)
+
+	restartedAt, found, err := unstructured.NestedString(h.Cluster.Get(key).Object, "spec", "template", "metadata", "annotations", "kubectl.kubernetes.io/restartedAt")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.NotEmpty(t, restartedAt)
+
+	res, err := h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeSynced, res.Sync.Status, "fields only set in the live state are not compared")
+
+	_, err = h.RunResourceAction(kube.NewResourceKey("apps", "Deployment", "default", "missing"), "restart")
+	require.Error(t, err)
+}
+
+func TestHarness_RepoErrorGracePeriod(t *testing.T) {
+	h := newHarness(t, WithRepoErrorGracePeriod(time.Minute))
+	app := newApp()
+	h.Repo.SetError(repoURL, appPath, errors.New("repository not found"))
+
+	_, err := h.Reconcile(t.Context(), app)
+	require.ErrorIs(t, err, controller.ErrCompareStateRepo)
+
+	h.Clock.Step(30 * time.Second)
+	_, err = h.Reconcile(t.Context(), app)
+	require.ErrorIs(t, err, controller.ErrCompareStateRepo)
+
+	h.Clock.Step(time.Minute)
+	res, err := h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	require.Len(t, res.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, res.Conditions[0].Type)
+	assert.Contains(t, res.Conditions[0].Message, "repository not found")
+	assert.Equal(t, h.Clock.Now().Unix(), res.ReconciledAt.Unix())
+
+	h.Repo.SetError(repoURL, appPath, nil)
+	res, err = h.Reconcile(t.Context(), app)
+	require.NoError(t, err)
+	assert.Empty(t, res.Conditions)
+}
@@ -0,0 +1,147 @@
+package harness
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+type sourceKey struct {
+	repoURL string
+	path    string
+}
+
+// FakeRepoServer is a repo server which returns canned manifests for each application source instead of generating
+// them from a repository. Only the methods used by the application controller to load the target state are supported;
+// other methods panic.
+type FakeRepoServer struct {
+	apiclient.RepoServerServiceClient
+
+	lock      sync.RWMutex
+	manifests map[sourceKey][]string
+	errors    map[sourceKey]error
+}
+
+// compile-time validation of adherence of the Clientset contract
+var _ apiclient.Clientset = &FakeRepoServer{}
+
+func newFakeRepoServer() *FakeRepoServer {
+	return &FakeRepoServer{
+		manifests: map[sourceKey][]string{},
+		errors:    map[sourceKey]error{},
+	}
+}
+
+// SetManifests sets the manifests generated for the sources with the given repository URL and path. Manifests are
+// YAML documents, each of which may contain several resources.
+func (r *FakeRepoServer) SetManifests(repoURL string, path string, manifests ...string) error {
+	var res []string
+	for _, manifest := range manifests {
+		objs, err := kube.SplitYAML([]byte(manifest))
+		if err != nil {
+			return fmt.Errorf("failed to parse manifest: %w", err)
+		}
+		for _, obj := range objs {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			res = append(res, string(data))
+		}
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.manifests[sourceKey{repoURL, path}] = res
+	delete(r.errors, sourceKey{repoURL, path})
+	return nil
+}
+
+// SetError makes manifest generation fail with the given error for the sources with the given repository URL and
+// path. A nil error clears the failure.
+func (r *FakeRepoServer) SetError(repoURL string, path string, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err == nil {
+		delete(r.errors, sourceKey{repoURL, path})
+	} else {
+		r.errors[sourceKey{repoURL, path}] = err
+	}
+}
+
+func (r *FakeRepoServer) getManifests(source *v1alpha1.ApplicationSource) ([]string, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	key := sourceKey{source.RepoURL, source.Path}
+	if err, ok := r.errors[key]; ok {
+		return nil, err
+	}
+	manifests, ok := r.manifests[key]
+	if !ok {
+		return nil, fmt.Errorf("no manifests for repository %q and path %q", source.RepoURL, source.Path)
+	}
+	return manifests, nil
+}
+
+func (r *FakeRepoServer) NewRepoServerClient() (utilio.Closer, apiclient.RepoServerServiceClient, error) {
+	return utilio.NopCloser, r, nil
+}
+
+func (r *FakeRepoServer) GenerateManifest(_ context.Context, in *apiclient.ManifestRequest, _ ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+	manifests, err := r.getManifests(in.ApplicationSource)
+	if err != nil {
+		return nil, err
+	}
+	return &apiclient.ManifestResponse{
+		Manifests:  manifests,
+		Namespace:  in.Namespace,
+		Revision:   in.Revision,
+		SourceType: string(v1alpha1.ApplicationSourceTypeDirectory),
+	}, nil
+}
+
+func (r *FakeRepoServer) GenerateManifestStream(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error) {
+	res, err := r.GenerateManifest(ctx, in, opts...)
+	if err != nil {
+		return nil, err
+	}
+	stream := &manifestResponseStream{}
+	// one manifest per chunk, to exercise the reassembly of the chunks by the application controller
+	err = apiclient.SendManifestResponseChunks(res, 1, func(chunk *apiclient.ManifestResponseChunk) error {
+		stream.chunks = append(stream.chunks, chunk)
+		return nil
+	})
+	return stream, err
+}
+
+// manifestResponseStream is a client stream which receives the chunks of a manifest response from memory
+type manifestResponseStream struct {
+	grpc.ClientStream
+
+	chunks []*apiclient.ManifestResponseChunk
+}
+
+func (s *manifestResponseStream) Recv() (*apiclient.ManifestResponseChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func (r *FakeRepoServer) ResolveRevision(_ context.Context, in *apiclient.ResolveRevisionRequest, _ ...grpc.CallOption) (*apiclient.ResolveRevisionResponse, error) {
+	return &apiclient.ResolveRevisionResponse{Revision: in.AmbiguousRevision, AmbiguousRevision: in.AmbiguousRevision}, nil
+}
+
+func (r *FakeRepoServer) UpdateRevisionForPaths(_ context.Context, in *apiclient.UpdateRevisionForPathsRequest, _ ...grpc.CallOption) (*apiclient.UpdateRevisionForPathsResponse, error) {
+	return &apiclient.UpdateRevisionForPathsResponse{Revision: in.Revision, Changes: true}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 )
 
@@ -21,12 +22,13 @@ import (
 const maxHealthCausesShown = 3
 
 // setApplicationHealth updates the health statuses of all resources performed in the comparison.
-// It returns the aggregated application health status along with the resources that caused that status.
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
+// It returns the aggregated application health status along with the resources that caused that status. The health
+// roll-up rules of the application and project control how the health of each resource contributes to the aggregate.
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, proj *appv1.AppProject, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
 	var savedErr error
 	var errCount uint
 	var containsResources, containsLiveResources bool
-	var causes []managedResource
+	var causes, warnings []managedResource
 
 	appHealthStatus := health.HealthStatusHealthy
 	for i, res := range resources {
@@ -55,7 +57,7 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			healthStatus = &health.HealthStatus{Status: health.HealthStatusMissing}
 		} else {
 			// App that manages itself should not affect own health
-			if isSelfReferencedApp(app, kubeutil.GetObjectRef(res.Live)) {
+			if argo.IsSelfReferencedApp(app, kubeutil.GetObjectRef(res.Live)) {
 				continue
 			}
 			healthStatus, err = health.GetResourceHealth(res.Live, healthOverrides)
@@ -88,10 +90,26 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			continue
 		}
 
-		if health.IsWorse(appHealthStatus, healthStatus.Status) {
-			appHealthStatus = healthStatus.Status
+		rollupStatus := healthStatus.Status
+		if rule := appv1.GetHealthRollupRule(app, proj, res.Group, res.Kind); rule != nil {
+			switch rule.Action {
+			case appv1.HealthRollupActionIgnore:
+				continue
+			case appv1.HealthRollupActionWarn:
+				if rollupStatus != health.HealthStatusHealthy {
+					warnings = append(warnings, res)
+				}
+				continue
+			}
+			if rule.MaxStatus != "" && health.IsWorse(rule.MaxStatus, rollupStatus) {
+				rollupStatus = rule.MaxStatus
+			}
+		}
+
+		if health.IsWorse(appHealthStatus, rollupStatus) {
+			appHealthStatus = rollupStatus
 			causes = []managedResource{res}
-		} else if appHealthStatus == healthStatus.Status && appHealthStatus != health.HealthStatusHealthy {
+		} else if appHealthStatus == rollupStatus && appHealthStatus != health.HealthStatusHealthy {
 			causes = append(causes, res)
 		}
 	}
@@ -110,7 +128,16 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	if savedErr != nil && errCount > 1 {
 		savedErr = fmt.Errorf("see application-controller logs for %d other errors; most recent error was: %w", errCount-1, savedErr)
 	}
-	return appHealthStatus, formatHealthCauses(causes), savedErr
+	message := formatHealthCauses(causes)
+	if len(warnings) > 0 {
+		warning := "Warnings for " + formatHealthResources(warnings)
+		if message != "" {
+			message = message + "; " + warning
+		} else {
+			message = warning
+		}
+	}
+	return appHealthStatus, message, savedErr
 }
 
 // formatHealthCauses renders a human-readable, truncated summary of the resources that caused
@@ -119,8 +146,13 @@ func formatHealthCauses(causes []managedResource) string {
 	if len(causes) == 0 {
 		return ""
 	}
+	return "Caused by " + formatHealthResources(causes)
+}
+
+// formatHealthResources renders a truncated list of the given resources.
+func formatHealthResources(resources []managedResource) string {
 	parts := make([]string, 0, maxHealthCausesShown)
-	for i, c := range causes {
+	for i, c := range resources {
 		if i >= maxHealthCausesShown {
 			break
 		}
@@ -135,8 +167,8 @@ func formatHealthCauses(causes []managedResource) string {
 		parts = append(parts, fmt.Sprintf("%s:%s", gk, name))
 	}
 	summary := strings.Join(parts, ", ")
-	if len(causes) > maxHealthCausesShown {
-		summary = fmt.Sprintf("%s and %d more", summary, len(causes)-maxHealthCausesShown)
+	if len(resources) > maxHealthCausesShown {
+		summary = fmt.Sprintf("%s and %d more", summary, len(resources)-maxHealthCausesShown)
 	}
-	return "Caused by " + summary
+	return summary
 }
@@ -66,7 +66,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
@@ -77,7 +77,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// A Healthy app has no contributing causes.
@@ -89,7 +89,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	failedJob.SetAnnotations(nil)
 	failedJobIgnoreHealthcheck := resourceFromFile("./testdata/job-failed-ignore-healthcheck.yaml")
 	resources[1].Live = &failedJobIgnoreHealthcheck
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -103,7 +103,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, false)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 
@@ -116,7 +116,7 @@ func TestSetApplicationHealth_NoResource(t *testing.T) {
 	resources := []managedResource{}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -131,7 +131,7 @@ func TestSetApplicationHealth_OnlyHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// Hooks are skipped, so the Healthy app has no causes.
@@ -149,7 +149,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The missing target-only resource does not degrade the app, so there are no causes.
@@ -168,7 +168,7 @@ func TestSetApplicationHealth_MissingResource_WithIgnoreHealthcheck(t *testing.T
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The ignored resource is not aggregated, so the Healthy app has no causes.
@@ -184,7 +184,7 @@ func TestSetApplicationHealth_MissingResource_WithChildApp(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// An Unknown child app does not affect the parent, so the Healthy app has no causes.
@@ -202,7 +202,7 @@ func TestSetApplicationHealth_AllMissingResources(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The Missing app health from the all-missing fallback does not attribute individual causes.
@@ -222,7 +222,7 @@ func TestSetApplicationHealth_AllMissingResources_WithHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The all-missing fallback does not attribute individual causes.
@@ -242,13 +242,75 @@ func TestSetApplicationHealth_MultipleCauses(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	// Both failed Jobs are causes; the healthy Pod is not.
 	assert.Equal(t, "Caused by batch/Job:default/failed-job, batch/Job:default/failed-job-2", healthCauses)
 }
 
+func TestSetApplicationHealth_RollupRules(t *testing.T) {
+	failedJob := resourceFromFile("./testdata/job-failed.yaml")
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+
+	resources := []managedResource{
+		{Group: "", Version: "v1", Kind: "Pod", Namespace: "default", Name: "running-pod", Live: &runningPod},
+		{Group: "batch", Version: "v1", Kind: "Job", Namespace: "default", Name: "failed-job", Live: &failedJob},
+	}
+
+	tests := []struct {
+		name            string
+		appRules        []appv1.HealthRollupRule
+		projRules       []appv1.HealthRollupRule
+		expectedStatus  health.HealthStatusCode
+		expectedMessage string
+	}{{
+		name:            "NoRules",
+		expectedStatus:  health.HealthStatusDegraded,
+		expectedMessage: "Caused by batch/Job:default/failed-job",
+	}, {
+		name:           "Ignore",
+		projRules:      []appv1.HealthRollupRule{{Group: "batch", Kind: "Job", Action: appv1.HealthRollupActionIgnore}},
+		expectedStatus: health.HealthStatusHealthy,
+	}, {
+		name:            "Warn",
+		projRules:       []appv1.HealthRollupRule{{Group: "batch", Kind: "*", Action: appv1.HealthRollupActionWarn}},
+		expectedStatus:  health.HealthStatusHealthy,
+		expectedMessage: "Warnings for batch/Job:default/failed-job",
+	}, {
+		name:            "MaxStatus",
+		projRules:       []appv1.HealthRollupRule{{Group: "batch", Kind: "Job", MaxStatus: health.HealthStatusProgressing}},
+		expectedStatus:  health.HealthStatusProgressing,
+		expectedMessage: "Caused by batch/Job:default/failed-job",
+	}, {
+		name:            "ApplicationRuleTakesPrecedence",
+		appRules:        []appv1.HealthRollupRule{{Group: "batch", Kind: "Job", MaxStatus: health.HealthStatusDegraded}},
+		projRules:       []appv1.HealthRollupRule{{Group: "batch", Kind: "Job", Action: appv1.HealthRollupActionIgnore}},
+		expectedStatus:  health.HealthStatusDegraded,
+		expectedMessage: "Caused by batch/Job:default/failed-job",
+	}, {
+		name:            "OtherGroupKind",
+		projRules:       []appv1.HealthRollupRule{{Kind: "Job", Action: appv1.HealthRollupActionIgnore}},
+		expectedStatus:  health.HealthStatusDegraded,
+		expectedMessage: "Caused by batch/Job:default/failed-job",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApp := app.DeepCopy()
+			testApp.Spec.HealthRollupRules = tt.appRules
+			proj := &appv1.AppProject{Spec: appv1.AppProjectSpec{HealthRollupRules: tt.projRules}}
+			resourceStatuses := initStatuses(resources)
+
+			healthStatus, healthMessage, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, testApp, proj, true)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, healthStatus)
+			assert.Equal(t, tt.expectedMessage, healthMessage)
+			// the health of the resources themselves is not affected by the rules
+			assert.Equal(t, health.HealthStatusDegraded, resourceStatuses[1].Health.Status)
+		})
+	}
+}
+
 func TestFormatHealthCauses(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		assert.Empty(t, formatHealthCauses(nil))
@@ -305,7 +367,7 @@ func TestSetApplicationHealth_HealthImproves(t *testing.T) {
 		resourceStatuses := initStatuses(resources)
 
 		t.Run(string(fmt.Sprintf("%s to %s", tc.oldStatus, tc.newStatus)), func(t *testing.T) {
-			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil, true)
 			require.NoError(t, err)
 			assert.Equal(t, tc.newStatus, healthStatus)
 			// A non-Healthy app attributes the offending Pod as its cause; a Healthy app has none.
@@ -363,7 +425,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 		// The Degraded child app is the cause of the parent's Degraded health.
@@ -377,7 +439,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// A Missing child app does not affect the parent, so there are no causes.
@@ -391,7 +453,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// An Unknown child app does not affect the parent, so there are no causes.
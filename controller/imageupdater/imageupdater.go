@@ -0,0 +1,431 @@
+package imageupdater
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/app/writeback"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	"github.com/argoproj/argo-cd/v3/util/versions"
+)
+
+const (
+	defaultHelmImageParameter = "image.repository"
+	defaultHelmTagParameter   = "image.tag"
+	defaultDigestTag          = "latest"
+)
+
+// Dependencies is the interface for the dependencies of the ImageUpdater. It serves two purposes: 1) it prevents the
+// image updater from having direct access to the app controller, and 2) it allows for easy mocking of dependencies in
+// tests.
+type Dependencies interface {
+	// GetImageUpdateApps returns the applications processable by the controller which have an image update policy.
+	GetImageUpdateApps() ([]*appv1.Application, error)
+
+	// GetRepository returns the repository with credentials for the given repository URL and project. It is used both
+	// for the registries of the images and for the Git repositories the image updates are written back to.
+	GetRepository(ctx context.Context, repoURL string, project string) (*appv1.Repository, error)
+
+	// UpdateAppSources replaces the sources of the given application with the given sources.
+	UpdateAppSources(ctx context.Context, app *appv1.Application, sources appv1.ApplicationSources) error
+
+	// PersistImageUpdateStatus persists the image update status of the given application.
+	PersistImageUpdateStatus(orig *appv1.Application, imageUpdates []appv1.ImageUpdateStatus)
+
+	// RequestAppRefresh requests a refresh of the application with the given name and namespace.
+	RequestAppRefresh(appName string, appNamespace string) error
+
+	// LogImageUpdateEvent emits an event about an image update of the given application.
+	LogImageUpdateEvent(app *appv1.Application, message string)
+}
+
+// RegistryClientFactory creates an OCI client for the registry of the given image, using the credentials of the
+// given repository.
+type RegistryClientFactory func(image string, repo *appv1.Repository) (oci.Client, error)
+
+// NewRegistryClient creates an OCI client for the registry of the given image.
+func NewRegistryClient(image string, repo *appv1.Repository) (oci.Client, error) {
+	return oci.NewClient(imageRepoURL(image), repo.GetOCICreds(), repo.Proxy, repo.NoProxy, nil, oci.WithEventHandlers(noopEventHandlers()))
+}
+
+// ImageUpdater periodically updates the images of applications with an image update policy to the newest versions
+// available in their registries.
+type ImageUpdater struct {
+	dependencies      Dependencies
+	namespace         string
+	newRegistryClient RegistryClientFactory
+	writer            writeback.Writer
+	now               func() time.Time
+}
+
+// NewImageUpdater creates a new ImageUpdater. The namespace is the namespace of the controller, which is needed to
+// determine the parameter override file of applications written back to Git.
+func NewImageUpdater(dependencies Dependencies, namespace string, newRegistryClient RegistryClientFactory, writer writeback.Writer) *ImageUpdater {
+	return &ImageUpdater{
+		dependencies:      dependencies,
+		namespace:         namespace,
+		newRegistryClient: newRegistryClient,
+		writer:            writer,
+		now:               time.Now,
+	}
+}
+
+// UpdateApps checks the images of all applications with an image update policy and applies the available updates.
+func (u *ImageUpdater) UpdateApps(ctx context.Context) {
+	apps, err := u.dependencies.GetImageUpdateApps()
+	if err != nil {
+		log.WithError(err).Error("Failed to list applications for image updates")
+		return
+	}
+	for _, app := range apps {
+		if err := u.UpdateApp(ctx, app); err != nil {
+			log.WithFields(applog.GetAppLogFields(app)).WithError(err).Warn("Failed to update images")
+		}
+	}
+}
+
+// imageReference is the version of an image selected by the update strategy
+type imageReference struct {
+	tag    string
+	digest string
+}
+
+// String returns the reference recorded in the application status
+func (r imageReference) String() string {
+	if r.digest != "" {
+		return r.digest
+	}
+	return r.tag
+}
+
+// UpdateApp applies the available image updates to the given application.
+func (u *ImageUpdater) UpdateApp(ctx context.Context, origApp *appv1.Application) error {
+	app := origApp.DeepCopy()
+	policy := app.Spec.ImageUpdatePolicy
+	if policy == nil {
+		return nil
+	}
+	if err := policy.Validate(); err != nil {
+		return fmt.Errorf("invalid image update policy: %w", err)
+	}
+	if app.Spec.SourceHydrator != nil {
+		return errors.New("image updates are not supported for applications with a source hydrator")
+	}
+	if policy.GetWriteBack() == appv1.ImageUpdateWriteBackMethodGit && app.Spec.HasMultipleSources() {
+		return errors.New("git write-back of image updates requires a single source")
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+
+	sources := app.Spec.GetSources()
+	desired := cloneSources(sources)
+	// updated contains the images whose reference differs from the status, changed those whose parameters changed
+	updated := map[string]imageReference{}
+	changed := map[string]imageReference{}
+	var errs []error
+	for _, image := range policy.Images {
+		index, err := imageSourceIndex(sources, image)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ref, err := u.resolveImage(ctx, app.Spec.Project, image)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to resolve image %s: %w", image.Image, err))
+			continue
+		}
+		status := app.Status.GetImageUpdate(image.Image)
+		if policy.GetWriteBack() == appv1.ImageUpdateWriteBackMethodGit && status != nil && status.Reference == ref.String() {
+			// the override file cannot be inspected without cloning the repository, so only the status tells whether
+			// the reference was already committed
+			continue
+		}
+		before := cloneSources(desired)
+		if err := setImage(&desired[index], detectedSourceType(app, index), image, ref); err != nil {
+			desired = before
+			errs = append(errs, err)
+			continue
+		}
+		if !sourcesEqual(before, desired) {
+			changed[image.Image] = ref
+		}
+		if status == nil || status.Reference != ref.String() {
+			updated[image.Image] = ref
+		}
+	}
+
+	if len(changed) > 0 {
+		var err error
+		switch policy.GetWriteBack() {
+		case appv1.ImageUpdateWriteBackMethodGit:
+			err = u.writeBackToGit(ctx, app, changed, sources[0], desired[0])
+		default:
+			err = u.dependencies.UpdateAppSources(ctx, app, desired)
+		}
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		message := "Updated images: " + describeUpdates(policy, changed)
+		logCtx.Info(message)
+		u.dependencies.LogImageUpdateEvent(app, message)
+	}
+	if len(updated) > 0 {
+		imageUpdates := slices.Clone(app.Status.ImageUpdates)
+		now := metav1.NewTime(u.now())
+		for _, image := range policy.Images {
+			ref, ok := updated[image.Image]
+			if !ok {
+				continue
+			}
+			status := appv1.ImageUpdateStatus{Image: image.Image, Reference: ref.String(), LastUpdated: now}
+			if i := slices.IndexFunc(imageUpdates, func(s appv1.ImageUpdateStatus) bool { return s.Image == image.Image }); i >= 0 {
+				imageUpdates[i] = status
+			} else {
+				imageUpdates = append(imageUpdates, status)
+			}
+		}
+		u.dependencies.PersistImageUpdateStatus(origApp, imageUpdates)
+	}
+	return errors.Join(errs...)
+}
+
+// resolveImage selects the version of the image to update to according to its update strategy.
+func (u *ImageUpdater) resolveImage(ctx context.Context, project string, image appv1.ImageUpdate) (imageReference, error) {
+	repo, err := u.dependencies.GetRepository(ctx, imageRepoURL(image.Image), project)
+	if err != nil {
+		return imageReference{}, fmt.Errorf("failed to get registry credentials: %w", err)
+	}
+	client, err := u.newRegistryClient(image.Image, repo)
+	if err != nil {
+		return imageReference{}, fmt.Errorf("failed to create registry client: %w", err)
+	}
+
+	if image.GetStrategy() == appv1.ImageUpdateStrategyDigest {
+		tag := image.Constraint
+		if tag == "" {
+			tag = defaultDigestTag
+		}
+		digest, err := client.ResolveRevision(ctx, tag, true)
+		if err != nil {
+			return imageReference{}, fmt.Errorf("failed to resolve digest of tag %s: %w", tag, err)
+		}
+		return imageReference{tag: tag, digest: digest}, nil
+	}
+
+	tags, err := client.GetTags(ctx, true)
+	if err != nil {
+		return imageReference{}, fmt.Errorf("failed to get tags: %w", err)
+	}
+	// the OCI client converts underscores of tags to plus signs to get valid semantic versions, image tags cannot
+	// contain plus signs
+	for i := range tags {
+		tags[i] = strings.ReplaceAll(tags[i], "+", "_")
+	}
+	switch image.GetStrategy() {
+	case appv1.ImageUpdateStrategyLatest:
+		tag, err := latestTag(image.Constraint, tags)
+		return imageReference{tag: tag}, err
+	default:
+		constraint := image.Constraint
+		if constraint == "" {
+			constraint = "*"
+		}
+		tag, err := versions.MaxVersion(constraint, tags, "")
+		return imageReference{tag: tag}, err
+	}
+}
+
+// latestTag returns the lexically greatest tag matching the given regular expression.
+func latestTag(pattern string, tags []string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid tag pattern: %w", err)
+	}
+	var latest string
+	for _, tag := range tags {
+		if re.MatchString(tag) && tag > latest {
+			latest = tag
+		}
+	}
+	if latest == "" {
+		return "", fmt.Errorf("no tag matching %q found in %d tags", pattern, len(tags))
+	}
+	return latest, nil
+}
+
+// imageSourceIndex returns the index of the source the image is updated in, which is either the source named by the
+// image update or the only source which is not a reference to the files of other sources.
+func imageSourceIndex(sources appv1.ApplicationSources, image appv1.ImageUpdate) (int, error) {
+	if image.SourceName != "" {
+		i := slices.IndexFunc(sources, func(source appv1.ApplicationSource) bool { return source.Name == image.SourceName })
+		if i < 0 {
+			return -1, fmt.Errorf("cannot update image %s: source %q not found", image.Image, image.SourceName)
+		}
+		if sources[i].IsRef() {
+			return -1, fmt.Errorf("cannot update image %s: source %q is a reference to the files of other sources", image.Image, image.SourceName)
+		}
+		return i, nil
+	}
+	index := -1
+	for i := range sources {
+		if sources[i].IsRef() {
+			continue
+		}
+		if index >= 0 {
+			return -1, fmt.Errorf("cannot update image %s: the application has multiple sources, set the name of the source to update", image.Image)
+		}
+		index = i
+	}
+	if index < 0 {
+		return -1, fmt.Errorf("cannot update image %s: the application has no source to update", image.Image)
+	}
+	return index, nil
+}
+
+// detectedSourceType returns the type of the source with the given index which the controller detected when it last
+// generated the manifests of the application, or an empty string if it is unknown.
+func detectedSourceType(app *appv1.Application, index int) appv1.ApplicationSourceType {
+	if !app.Spec.HasMultipleSources() {
+		return app.Status.SourceType
+	}
+	if index < len(app.Status.SourceTypes) {
+		return app.Status.SourceTypes[index]
+	}
+	return ""
+}
+
+// setImage sets the given image reference in the parameters of the source. Helm sources set the image name and tag
+// parameters, Kustomize sources set an image override. Other sources are not updated, since setting parameters of
+// another type would change how they are rendered.
+func setImage(source *appv1.ApplicationSource, sourceType appv1.ApplicationSourceType, image appv1.ImageUpdate, ref imageReference) error {
+	if source.IsHelm() || source.Helm != nil || sourceType == appv1.ApplicationSourceTypeHelm {
+		if source.Helm == nil {
+			source.Helm = &appv1.ApplicationSourceHelm{}
+		}
+		imageParameter, tagParameter := image.HelmImageParameter, image.HelmTagParameter
+		if imageParameter == "" {
+			imageParameter = defaultHelmImageParameter
+		}
+		if tagParameter == "" {
+			tagParameter = defaultHelmTagParameter
+		}
+		tag := ref.tag
+		if ref.digest != "" {
+			tag += "@" + ref.digest
+		}
+		source.Helm.AddParameter(appv1.HelmParameter{Name: imageParameter, Value: image.Image})
+		source.Helm.AddParameter(appv1.HelmParameter{Name: tagParameter, Value: tag})
+		return nil
+	}
+	if source.Kustomize == nil {
+		if source.Directory != nil || source.Plugin != nil || sourceType != appv1.ApplicationSourceTypeKustomize {
+			return fmt.Errorf("cannot update image %s of source %s: only Helm and Kustomize sources are supported", image.Image, source.RepoURL)
+		}
+		source.Kustomize = &appv1.ApplicationSourceKustomize{}
+	}
+	override := appv1.KustomizeImage(image.Image + ":" + ref.tag)
+	if ref.digest != "" {
+		override = appv1.KustomizeImage(image.Image + "@" + ref.digest)
+	}
+	// KustomizeImage.Match splits at the delimiter of the new override, which does not find an existing override of
+	// the image with a tag when it is replaced by one with a digest and vice versa
+	if i := slices.IndexFunc(source.Kustomize.Images, func(existing appv1.KustomizeImage) bool {
+		return kustomizeImageName(existing) == image.Image
+	}); i >= 0 {
+		source.Kustomize.Images[i] = override
+	} else {
+		source.Kustomize.Images = append(source.Kustomize.Images, override)
+	}
+	return nil
+}
+
+// kustomizeImageName returns the name of the image an override applies to
+func kustomizeImageName(image appv1.KustomizeImage) string {
+	name, _, found := strings.Cut(string(image), "=")
+	if found {
+		return name
+	}
+	name, _, _ = strings.Cut(name, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name
+}
+
+// writeBackToGit commits the changed parameters of the source to the parameter override file of the application.
+func (u *ImageUpdater) writeBackToGit(ctx context.Context, app *appv1.Application, updated map[string]imageReference, live, desired appv1.ApplicationSource) error {
+	branch := app.Spec.ImageUpdatePolicy.WriteBackBranch
+	if branch == "" {
+		branch = live.TargetRevision
+	}
+	if branch == "" || branch == "HEAD" {
+		return errors.New("cannot write back image updates: the target revision is not a branch, set writeBackBranch")
+	}
+	repo, err := u.dependencies.GetRepository(ctx, live.RepoURL, app.Spec.Project)
+	if err != nil {
+		return fmt.Errorf("failed to get repository: %w", err)
+	}
+	path := writeback.OverrideFilePath(live.Path, app.InstanceName(u.namespace))
+	message := fmt.Sprintf("Update images of application %s: %s", app.QualifiedName(), describeUpdates(app.Spec.ImageUpdatePolicy, updated))
+	err = u.writer.WriteFile(ctx, repo, branch, path, message, func(existing []byte) ([]byte, error) {
+		return writeback.MergeParameterOverrides(&live, &desired, existing)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write back image updates: %w", err)
+	}
+	return u.dependencies.RequestAppRefresh(app.Name, app.Namespace)
+}
+
+// describeUpdates lists the updated images in the order of the policy
+func describeUpdates(policy *appv1.ImageUpdatePolicy, updated map[string]imageReference) string {
+	var descriptions []string
+	for _, image := range policy.Images {
+		if ref, ok := updated[image.Image]; ok {
+			descriptions = append(descriptions, fmt.Sprintf("%s to %s", image.Image, ref))
+		}
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func cloneSources(sources appv1.ApplicationSources) appv1.ApplicationSources {
+	clone := make(appv1.ApplicationSources, len(sources))
+	for i := range sources {
+		clone[i] = *sources[i].DeepCopy()
+	}
+	return clone
+}
+
+func sourcesEqual(a, b appv1.ApplicationSources) bool {
+	return slices.EqualFunc(a, b, func(x, y appv1.ApplicationSource) bool { return x.Equals(&y) })
+}
+
+// imageRepoURL returns the OCI repository URL of the image, as used for repository credentials
+func imageRepoURL(image string) string {
+	return "oci://" + image
+}
+
+func noopEventHandlers() oci.EventHandlers {
+	noop := func(string) func() { return func() {} }
+	noopFail := func(string) func(string) { return func(string) {} }
+	return oci.EventHandlers{
+		OnExtract:             noop,
+		OnResolveRevision:     noop,
+		OnDigestMetadata:      noop,
+		OnTestRepo:            noop,
+		OnGetTags:             noop,
+		OnExtractFail:         noopFail,
+		OnResolveRevisionFail: noopFail,
+		OnDigestMetadataFail:  noopFail,
+		OnTestRepoFail:        noop,
+		OnGetTagsFail:         noop,
+	}
+}
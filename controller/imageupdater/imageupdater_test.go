@@ -0,0 +1,251 @@
+package imageupdater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+type fakeDependencies struct {
+	sources      appv1.ApplicationSources
+	imageUpdates []appv1.ImageUpdateStatus
+	events       []string
+	refreshed    []string
+}
+
+func (d *fakeDependencies) GetImageUpdateApps() ([]*appv1.Application, error) {
+	return nil, nil
+}
+
+func (d *fakeDependencies) GetRepository(_ context.Context, repoURL string, _ string) (*appv1.Repository, error) {
+	return &appv1.Repository{Repo: repoURL}, nil
+}
+
+func (d *fakeDependencies) UpdateAppSources(_ context.Context, _ *appv1.Application, sources appv1.ApplicationSources) error {
+	d.sources = sources
+	return nil
+}
+
+func (d *fakeDependencies) PersistImageUpdateStatus(_ *appv1.Application, imageUpdates []appv1.ImageUpdateStatus) {
+	d.imageUpdates = imageUpdates
+}
+
+func (d *fakeDependencies) RequestAppRefresh(appName string, appNamespace string) error {
+	d.refreshed = append(d.refreshed, appNamespace+"/"+appName)
+	return nil
+}
+
+func (d *fakeDependencies) LogImageUpdateEvent(_ *appv1.Application, message string) {
+	d.events = append(d.events, message)
+}
+
+type fakeWriter struct {
+	branch  string
+	path    string
+	message string
+	content []byte
+}
+
+func (w *fakeWriter) WriteFile(_ context.Context, _ *appv1.Repository, branch, path, message string, update func(existing []byte) ([]byte, error)) error {
+	content, err := update(w.content)
+	if err != nil {
+		return err
+	}
+	w.branch, w.path, w.message, w.content = branch, path, message, content
+	return nil
+}
+
+func newTestUpdater(t *testing.T, deps Dependencies, writer *fakeWriter, setup func(client *ocimocks.Client)) *ImageUpdater {
+	t.Helper()
+	client := ocimocks.NewClient(t)
+	setup(client)
+	updater := NewImageUpdater(deps, "argocd", func(string, *appv1.Repository) (oci.Client, error) {
+		return client, nil
+	}, writer)
+	updater.now = func() time.Time { return time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC) }
+	return updater
+}
+
+func newTestApp(source appv1.ApplicationSource, policy appv1.ImageUpdatePolicy) *appv1.Application {
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec: appv1.ApplicationSpec{
+			Project:           "default",
+			Source:            &source,
+			ImageUpdatePolicy: &policy,
+		},
+	}
+}
+
+func TestLatestTag(t *testing.T) {
+	tag, err := latestTag(`^main-\d+$`, []string{"main-20260101", "main-20260301", "v1.0.0", "main-20260201"})
+	require.NoError(t, err)
+	assert.Equal(t, "main-20260301", tag)
+
+	_, err = latestTag(`^release-`, []string{"main-20260101"})
+	require.ErrorContains(t, err, "no tag matching")
+}
+
+func TestSetImage(t *testing.T) {
+	t.Run("Helm", func(t *testing.T) {
+		source := appv1.ApplicationSource{Chart: "app", RepoURL: "https://charts.example.com"}
+		require.NoError(t, setImage(&source, "", appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}))
+		assert.Equal(t, []appv1.HelmParameter{
+			{Name: "image.repository", Value: "ghcr.io/example/app"},
+			{Name: "image.tag", Value: "1.2.0"},
+		}, source.Helm.Parameters)
+	})
+	t.Run("HelmDigest", func(t *testing.T) {
+		source := appv1.ApplicationSource{Path: "chart", Helm: &appv1.ApplicationSourceHelm{}}
+		image := appv1.ImageUpdate{Image: "ghcr.io/example/app", HelmImageParameter: "app.image", HelmTagParameter: "app.tag"}
+		require.NoError(t, setImage(&source, "", image, imageReference{tag: "stable", digest: "sha256:abc"}))
+		assert.Equal(t, []appv1.HelmParameter{
+			{Name: "app.image", Value: "ghcr.io/example/app"},
+			{Name: "app.tag", Value: "stable@sha256:abc"},
+		}, source.Helm.Parameters)
+	})
+	t.Run("Kustomize", func(t *testing.T) {
+		source := appv1.ApplicationSource{Path: "app", Kustomize: &appv1.ApplicationSourceKustomize{Images: appv1.KustomizeImages{"ghcr.io/example/app:1.0.0"}}}
+		require.NoError(t, setImage(&source, "", appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}))
+		assert.Equal(t, appv1.KustomizeImages{"ghcr.io/example/app:1.2.0"}, source.Kustomize.Images)
+		require.NoError(t, setImage(&source, "", appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "latest", digest: "sha256:abc"}))
+		assert.Equal(t, appv1.KustomizeImages{"ghcr.io/example/app@sha256:abc"}, source.Kustomize.Images)
+	})
+	t.Run("DetectedKustomize", func(t *testing.T) {
+		source := appv1.ApplicationSource{Path: "app"}
+		require.NoError(t, setImage(&source, appv1.ApplicationSourceTypeKustomize, appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}))
+		assert.Equal(t, appv1.KustomizeImages{"ghcr.io/example/app:1.2.0"}, source.Kustomize.Images)
+	})
+	t.Run("DetectedHelm", func(t *testing.T) {
+		source := appv1.ApplicationSource{Path: "chart"}
+		require.NoError(t, setImage(&source, appv1.ApplicationSourceTypeHelm, appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}))
+		assert.Len(t, source.Helm.Parameters, 2)
+	})
+	t.Run("Directory", func(t *testing.T) {
+		source := appv1.ApplicationSource{Path: "app", Directory: &appv1.ApplicationSourceDirectory{}}
+		require.ErrorContains(t, setImage(&source, "", appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}), "only Helm and Kustomize sources are supported")
+	})
+	t.Run("PlainDirectory", func(t *testing.T) {
+		for _, sourceType := range []appv1.ApplicationSourceType{"", appv1.ApplicationSourceTypeDirectory} {
+			source := appv1.ApplicationSource{Path: "app"}
+			require.ErrorContains(t, setImage(&source, sourceType, appv1.ImageUpdate{Image: "ghcr.io/example/app"}, imageReference{tag: "1.2.0"}), "only Helm and Kustomize sources are supported")
+			assert.Nil(t, source.Kustomize, "a Kustomize block changes how the source is rendered")
+		}
+	})
+}
+
+func TestImageSourceIndex(t *testing.T) {
+	sources := appv1.ApplicationSources{
+		{RepoURL: "https://github.com/example/values.git", Ref: "values"},
+		{RepoURL: "https://charts.example.com", Chart: "app", Name: "app"},
+		{RepoURL: "https://github.com/example/apps.git", Path: "monitoring", Name: "monitoring"},
+	}
+	index, err := imageSourceIndex(sources, appv1.ImageUpdate{Image: "ghcr.io/example/app", SourceName: "monitoring"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, index)
+
+	_, err = imageSourceIndex(sources, appv1.ImageUpdate{Image: "ghcr.io/example/app"})
+	require.ErrorContains(t, err, "set the name of the source to update")
+	_, err = imageSourceIndex(sources, appv1.ImageUpdate{Image: "ghcr.io/example/app", SourceName: "other"})
+	require.ErrorContains(t, err, `source "other" not found`)
+
+	index, err = imageSourceIndex(sources[:2], appv1.ImageUpdate{Image: "ghcr.io/example/app"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, index, "reference sources are skipped")
+}
+
+func TestUpdateApp_Spec(t *testing.T) {
+	deps := &fakeDependencies{}
+	updater := newTestUpdater(t, deps, &fakeWriter{}, func(client *ocimocks.Client) {
+		client.EXPECT().GetTags(t.Context(), true).Return([]string{"1.0.0", "1.2.0", "2.0.0", "latest"}, nil)
+	})
+	app := newTestApp(
+		appv1.ApplicationSource{RepoURL: "https://github.com/example/apps.git", Path: "app", TargetRevision: "main"},
+		appv1.ImageUpdatePolicy{Images: []appv1.ImageUpdate{{Image: "ghcr.io/example/app", Constraint: "^1.0"}}},
+	)
+	app.Status.SourceType = appv1.ApplicationSourceTypeKustomize
+
+	require.NoError(t, updater.UpdateApp(t.Context(), app))
+	require.Len(t, deps.sources, 1)
+	assert.Equal(t, appv1.KustomizeImages{"ghcr.io/example/app:1.2.0"}, deps.sources[0].Kustomize.Images)
+	assert.Equal(t, []appv1.ImageUpdateStatus{{Image: "ghcr.io/example/app", Reference: "1.2.0", LastUpdated: metav1.NewTime(updater.now())}}, deps.imageUpdates)
+	assert.Equal(t, []string{"Updated images: ghcr.io/example/app to 1.2.0"}, deps.events)
+
+	// nothing is updated once the spec and the status contain the selected version
+	app.Spec.Source = &deps.sources[0]
+	app.Status.ImageUpdates = deps.imageUpdates
+	*deps = fakeDependencies{}
+	require.NoError(t, updater.UpdateApp(t.Context(), app))
+	assert.Nil(t, deps.sources)
+	assert.Nil(t, deps.imageUpdates)
+	assert.Empty(t, deps.events)
+}
+
+func TestUpdateApp_Git(t *testing.T) {
+	deps := &fakeDependencies{}
+	writer := &fakeWriter{}
+	updater := newTestUpdater(t, deps, writer, func(client *ocimocks.Client) {
+		client.EXPECT().ResolveRevision(t.Context(), "stable", true).Return("sha256:abc", nil)
+	})
+	app := newTestApp(
+		appv1.ApplicationSource{RepoURL: "https://github.com/example/apps.git", Path: "chart", TargetRevision: "HEAD", Helm: &appv1.ApplicationSourceHelm{ReleaseName: "app"}},
+		appv1.ImageUpdatePolicy{
+			Images:    []appv1.ImageUpdate{{Image: "ghcr.io/example/app", Strategy: appv1.ImageUpdateStrategyDigest, Constraint: "stable"}},
+			WriteBack: appv1.ImageUpdateWriteBackMethodGit,
+		},
+	)
+
+	require.ErrorContains(t, updater.UpdateApp(t.Context(), app), "the target revision is not a branch")
+	assert.Nil(t, deps.imageUpdates)
+
+	app.Spec.ImageUpdatePolicy.WriteBackBranch = "main"
+	require.NoError(t, updater.UpdateApp(t.Context(), app))
+	assert.Nil(t, deps.sources)
+	assert.Equal(t, "main", writer.branch)
+	assert.Equal(t, "chart/.argocd-source-guestbook.yaml", writer.path)
+	assert.Equal(t, "Update images of application argocd/guestbook: ghcr.io/example/app to sha256:abc", writer.message)
+	assert.YAMLEq(t, `
+helm:
+  parameters:
+  - name: image.repository
+    value: ghcr.io/example/app
+  - name: image.tag
+    value: stable@sha256:abc
+`, string(writer.content))
+	assert.Equal(t, []string{"argocd/guestbook"}, deps.refreshed)
+	assert.Equal(t, []appv1.ImageUpdateStatus{{Image: "ghcr.io/example/app", Reference: "sha256:abc", LastUpdated: metav1.NewTime(updater.now())}}, deps.imageUpdates)
+
+	// the digest is not committed again once it is recorded in the status
+	app.Status.ImageUpdates = deps.imageUpdates
+	*deps = fakeDependencies{}
+	*writer = fakeWriter{}
+	require.NoError(t, updater.UpdateApp(t.Context(), app))
+	assert.Empty(t, writer.path)
+	assert.Nil(t, deps.imageUpdates)
+}
+
+func TestUpdateApp_MultipleSources(t *testing.T) {
+	deps := &fakeDependencies{}
+	updater := newTestUpdater(t, deps, &fakeWriter{}, func(client *ocimocks.Client) {
+		client.EXPECT().GetTags(t.Context(), true).Return([]string{"1.0.0", "1.2.0"}, nil)
+	})
+	app := newTestApp(appv1.ApplicationSource{}, appv1.ImageUpdatePolicy{Images: []appv1.ImageUpdate{{Image: "ghcr.io/example/app", SourceName: "app"}}})
+	app.Spec.Source = nil
+	app.Spec.Sources = appv1.ApplicationSources{
+		{RepoURL: "https://charts.example.com", Chart: "app", TargetRevision: "1.0.0", Name: "app"},
+		{RepoURL: "https://charts.example.com", Chart: "monitoring", TargetRevision: "1.0.0", Name: "monitoring"},
+	}
+
+	require.NoError(t, updater.UpdateApp(t.Context(), app))
+	require.Len(t, deps.sources, 2)
+	assert.Len(t, deps.sources[0].Helm.Parameters, 2)
+	assert.Nil(t, deps.sources[1].Helm, "the images are only updated in the named source")
+}
@@ -0,0 +1,67 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+/**
+This file implements the imageupdater.Dependencies interface for the ApplicationController.
+
+Image update logic does not belong in this file. The methods here only give the image updater minimal access to the
+applications and repositories of the app controller.
+*/
+
+// GetImageUpdateApps returns the applications processed by this controller shard which have an image update policy.
+func (ctrl *ApplicationController) GetImageUpdateApps() ([]*appv1.Application, error) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	var result []*appv1.Application
+	for _, app := range apps {
+		if app.Spec.ImageUpdatePolicy != nil && app.DeletionTimestamp == nil && ctrl.canProcessApp(app) {
+			result = append(result, app)
+		}
+	}
+	return result, nil
+}
+
+func (ctrl *ApplicationController) GetRepository(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
+	return ctrl.db.GetRepository(ctx, repoURL, project)
+}
+
+// UpdateAppSources replaces the sources of the application. The update fails with a conflict if the application was
+// modified since it was listed, in which case the image updater retries on its next run.
+func (ctrl *ApplicationController) UpdateAppSources(ctx context.Context, app *appv1.Application, sources appv1.ApplicationSources) error {
+	updated := app.DeepCopy()
+	if updated.Spec.HasMultipleSources() {
+		updated.Spec.Sources = sources
+	} else {
+		updated.Spec.Source = &sources[0]
+	}
+	_, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, updated, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to update application sources: %w", err)
+	}
+	return nil
+}
+
+func (ctrl *ApplicationController) PersistImageUpdateStatus(orig *appv1.Application, imageUpdates []appv1.ImageUpdateStatus) {
+	status := orig.Status.DeepCopy()
+	status.ImageUpdates = imageUpdates
+	// image updates are checked periodically rather than on behalf of a request, so there is no trace to continue
+	ctrl.persistAppStatus(context.Background(), orig, status, maps.Clone(orig.GetAnnotations()))
+}
+
+func (ctrl *ApplicationController) LogImageUpdateEvent(app *appv1.Application, message string) {
+	ctrl.logAppEvent(context.TODO(), app, argo.EventInfo{Reason: argo.EventReasonImageUpdated, Type: corev1.EventTypeNormal}, message)
+}
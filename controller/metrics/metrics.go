@@ -32,6 +32,7 @@ import (
 
 type MetricsServer struct {
 	*http.Server
+	mux                               *http.ServeMux
 	syncCounter                       *prometheus.CounterVec
 	syncDuration                      *prometheus.CounterVec
 	kubectlExecCounter                *prometheus.CounterVec
@@ -44,6 +45,8 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	syncPhaseHistogram                *prometheus.HistogramVec
+	syncPhaseLabels                   []string
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
@@ -52,8 +55,30 @@ type MetricsServer struct {
 const (
 	// MetricsPath is the endpoint to collect application metrics
 	MetricsPath = "/metrics"
+	// QueuesPath is the endpoint to inspect the items of the work queues of the controller
+	QueuesPath = "/debug/queues"
 )
 
+// Phases of a sync whose duration is reported by the argocd_app_sync_phase_duration_seconds metric
+const (
+	// SyncPhaseGenerate is the generation of the manifests of the application
+	SyncPhaseGenerate = "generate"
+	// SyncPhaseDiff is the comparison of the generated manifests with the live state
+	SyncPhaseDiff = "diff"
+	// SyncPhaseApply is the application of the resources to the cluster
+	SyncPhaseApply = "apply"
+	// SyncPhaseHook is the execution of a sync hook, from its creation until its completion
+	SyncPhaseHook = "hook"
+)
+
+// syncPhaseLabelNames are the labels which may be added to the argocd_app_sync_phase_duration_seconds metric
+var syncPhaseLabelNames = []string{"namespace", "name", "project", "dest_server"}
+
+// DefaultSyncPhaseDurationLabels returns the labels added to the argocd_app_sync_phase_duration_seconds metric by default
+func DefaultSyncPhaseDurationLabels() []string {
+	return []string{"namespace", "name", "project"}
+}
+
 // Follow Prometheus naming practices
 // https://prometheus.io/docs/practices/naming/
 var (
@@ -214,6 +239,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 
 	metricsServer := &MetricsServer{
 		registry: registry,
+		mux:      mux,
 		Server: &http.Server{
 			Addr:    addr,
 			Handler: mux,
@@ -245,6 +271,21 @@ func (m *MetricsServer) RegisterClustersInfoSource(ctx context.Context, source H
 	m.registry.MustRegister(collector)
 }
 
+// RegisterPriorityQueues registers the queues whose depth per priority is reported
+func (m *MetricsServer) RegisterPriorityQueues(queues map[string]PriorityQueue) {
+	m.registry.MustRegister(NewQueueDepthCollector(queues))
+}
+
+// RegisterQueuesHandler serves the items of the work queues of the controller at QueuesPath
+func (m *MetricsServer) RegisterQueuesHandler(handler http.Handler) {
+	m.mux.Handle(QueuesPath, handler)
+}
+
+// RegisterProjects registers the collector of the applications aggregated per project
+func (m *MetricsServer) RegisterProjects(appLister applister.ApplicationLister, projLister applister.AppProjectNamespaceLister, appFilter func(obj any) bool) {
+	m.registry.MustRegister(NewProjectCollector(appLister, projLister, appFilter))
+}
+
 // IncSync increments the sync counter for an application
 func (m *MetricsServer) IncSync(app *argoappv1.Application, destServer string, state *argoappv1.OperationState) {
 	if !state.Phase.Completed() {
@@ -262,6 +303,51 @@ func (m *MetricsServer) IncAppSyncDuration(app *argoappv1.Application, destServe
 	}
 }
 
+// RegisterSyncPhaseDuration registers the argocd_app_sync_phase_duration_seconds metric, which is labeled with the
+// given application labels in addition to the phase. The metric is not registered if the labels contain "none".
+func (m *MetricsServer) RegisterSyncPhaseDuration(labels []string) error {
+	if slices.Contains(labels, "none") {
+		return nil
+	}
+	for _, label := range labels {
+		if !slices.Contains(syncPhaseLabelNames, label) {
+			return fmt.Errorf("unsupported sync phase duration label '%s', supported labels are %v", label, syncPhaseLabelNames)
+		}
+	}
+	m.syncPhaseLabels = labels
+	m.syncPhaseHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "argocd_app_sync_phase_duration_seconds",
+			Help: "Application sync duration in seconds by phase.",
+			// Buckets span from the quick diff of small applications up to long-running hooks
+			Buckets: []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300, 600},
+		},
+		append(slices.Clone(labels), "phase"),
+	)
+	return m.registry.Register(m.syncPhaseHistogram)
+}
+
+// ObserveSyncPhaseDuration observes the duration of a phase of the sync of an application
+func (m *MetricsServer) ObserveSyncPhaseDuration(app *argoappv1.Application, destServer, phase string, duration time.Duration) {
+	if m.syncPhaseHistogram == nil {
+		return
+	}
+	labelValues := make([]string, 0, len(m.syncPhaseLabels)+1)
+	for _, label := range m.syncPhaseLabels {
+		switch label {
+		case "namespace":
+			labelValues = append(labelValues, app.Namespace)
+		case "name":
+			labelValues = append(labelValues, app.Name)
+		case "project":
+			labelValues = append(labelValues, app.Spec.GetProject())
+		case "dest_server":
+			labelValues = append(labelValues, destServer)
+		}
+	}
+	m.syncPhaseHistogram.WithLabelValues(append(labelValues, phase)...).Observe(duration.Seconds())
+}
+
 func (m *MetricsServer) IncKubectlExec(command string) {
 	m.kubectlExecCounter.WithLabelValues(m.hostname, command).Inc()
 }
@@ -344,6 +430,9 @@ func (m *MetricsServer) SetExpiration(cacheExpiration time.Duration) error {
 		m.redisRequestHistogram.Reset()
 		m.resourceEventsProcessingHistogram.Reset()
 		m.resourceEventsNumberGauge.Reset()
+		if m.syncPhaseHistogram != nil {
+			m.syncPhaseHistogram.Reset()
+		}
 		kubectl.ResetAll()
 	})
 	if err != nil {
@@ -566,6 +566,57 @@ argocd_app_sync_duration_seconds_total{dest_server="https://localhost:6443",name
 	})
 }
 
+func TestSyncPhaseDurationMetrics(t *testing.T) {
+	cancel, appLister := newFakeLister(t.Context())
+	defer cancel()
+	mockDB := mocks.NewArgoDB(t)
+
+	t.Run("metric is labeled with the allowed labels", func(t *testing.T) {
+		metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+		require.NoError(t, err)
+		require.NoError(t, metricsServ.RegisterSyncPhaseDuration([]string{"name", "dest_server"}))
+
+		fakeApp := newFakeApp(fakeApp)
+		metricsServ.ObserveSyncPhaseDuration(fakeApp, "https://localhost:6443", SyncPhaseApply, 3*time.Second)
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		metricsServ.Handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		body := rr.Body.String()
+		log.Println(body)
+		assertMetricsPrinted(t, `
+argocd_app_sync_phase_duration_seconds_bucket{dest_server="https://localhost:6443",name="my-app",phase="apply",le="2.5"} 0
+argocd_app_sync_phase_duration_seconds_bucket{dest_server="https://localhost:6443",name="my-app",phase="apply",le="5"} 1
+argocd_app_sync_phase_duration_seconds_sum{dest_server="https://localhost:6443",name="my-app",phase="apply"} 3
+argocd_app_sync_phase_duration_seconds_count{dest_server="https://localhost:6443",name="my-app",phase="apply"} 1
+`, body)
+	})
+
+	t.Run("metric is not registered when disabled", func(t *testing.T) {
+		metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+		require.NoError(t, err)
+		require.NoError(t, metricsServ.RegisterSyncPhaseDuration([]string{"none"}))
+
+		fakeApp := newFakeApp(fakeApp)
+		metricsServ.ObserveSyncPhaseDuration(fakeApp, "https://localhost:6443", SyncPhaseApply, 3*time.Second)
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/metrics", http.NoBody)
+		require.NoError(t, err)
+		rr := httptest.NewRecorder()
+		metricsServ.Handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.NotContains(t, rr.Body.String(), "argocd_app_sync_phase_duration_seconds")
+	})
+
+	t.Run("unsupported label is rejected", func(t *testing.T) {
+		metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{}, mockDB)
+		require.NoError(t, err)
+		require.ErrorContains(t, metricsServ.RegisterSyncPhaseDuration([]string{"revision"}), "unsupported sync phase duration label 'revision'")
+	})
+}
+
 func TestReconcileMetrics(t *testing.T) {
 	cancel, appLister := newFakeLister(t.Context())
 	defer cancel()
@@ -0,0 +1,117 @@
+package metrics
+
+import (
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+var (
+	descProjectApps = prometheus.NewDesc(
+		"argocd_project_apps",
+		"Number of applications of the project.",
+		[]string{"name"},
+		nil,
+	)
+	descProjectAppsOutOfSync = prometheus.NewDesc(
+		"argocd_project_apps_out_of_sync",
+		"Number of applications of the project which are out of sync.",
+		[]string{"name"},
+		nil,
+	)
+	descProjectAppsDegraded = prometheus.NewDesc(
+		"argocd_project_apps_degraded",
+		"Number of applications of the project which are degraded.",
+		[]string{"name"},
+		nil,
+	)
+	descProjectAppsSyncBlocked = prometheus.NewDesc(
+		"argocd_project_apps_sync_blocked",
+		"Number of applications of the project whose automated syncs are currently blocked by a sync window.",
+		[]string{"name"},
+		nil,
+	)
+)
+
+type projectStats struct {
+	apps        int
+	outOfSync   int
+	degraded    int
+	syncBlocked int
+}
+
+type projectCollector struct {
+	appLister  applister.ApplicationLister
+	projLister applister.AppProjectNamespaceLister
+	appFilter  func(obj any) bool
+}
+
+// NewProjectCollector returns a collector which reports the applications of each project aggregated by sync and
+// health status. Only the applications accepted by the given filter are counted.
+func NewProjectCollector(appLister applister.ApplicationLister, projLister applister.AppProjectNamespaceLister, appFilter func(obj any) bool) prometheus.Collector {
+	return &projectCollector{
+		appLister:  appLister,
+		projLister: projLister,
+		appFilter:  appFilter,
+	}
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *projectCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descProjectApps
+	ch <- descProjectAppsOutOfSync
+	ch <- descProjectAppsDegraded
+	ch <- descProjectAppsSyncBlocked
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *projectCollector) Collect(ch chan<- prometheus.Metric) {
+	projects, err := c.projLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to collect projects: %v", err)
+		return
+	}
+	apps, err := c.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to collect applications: %v", err)
+		return
+	}
+
+	projectsByName := make(map[string]*argoappv1.AppProject, len(projects))
+	stats := make(map[string]*projectStats, len(projects))
+	for _, proj := range projects {
+		projectsByName[proj.Name] = proj
+		stats[proj.Name] = &projectStats{}
+	}
+	for _, app := range apps {
+		if !c.appFilter(app) {
+			continue
+		}
+		proj, ok := projectsByName[app.Spec.GetProject()]
+		if !ok {
+			continue
+		}
+		projStats := stats[proj.Name]
+		projStats.apps++
+		if app.Status.Sync.Status == argoappv1.SyncStatusCodeOutOfSync {
+			projStats.outOfSync++
+		}
+		if app.Status.Health.Status == health.HealthStatusDegraded {
+			projStats.degraded++
+		}
+		if canSync, _ := proj.MatchingSyncWindows(app).CanSync(false, nil); !canSync {
+			projStats.syncBlocked++
+		}
+	}
+
+	for name, projStats := range stats {
+		ch <- prometheus.MustNewConstMetric(descProjectApps, prometheus.GaugeValue, float64(projStats.apps), name)
+		ch <- prometheus.MustNewConstMetric(descProjectAppsOutOfSync, prometheus.GaugeValue, float64(projStats.outOfSync), name)
+		ch <- prometheus.MustNewConstMetric(descProjectAppsDegraded, prometheus.GaugeValue, float64(projStats.degraded), name)
+		ch <- prometheus.MustNewConstMetric(descProjectAppsSyncBlocked, prometheus.GaugeValue, float64(projStats.syncBlocked), name)
+	}
+}
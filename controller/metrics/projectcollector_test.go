@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applister "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+func TestProjectCollector(t *testing.T) {
+	appIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, appYAML := range []string{fakeApp, fakeApp2, fakeApp3} {
+		require.NoError(t, appIndexer.Add(newFakeApp(appYAML)))
+	}
+	projIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, projIndexer.Add(&argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "important-project", Namespace: "argocd"},
+		Spec: argoappv1.AppProjectSpec{
+			SyncWindows: argoappv1.SyncWindows{{
+				Kind:         "deny",
+				Schedule:     "* * * * *",
+				Duration:     "1h",
+				Applications: []string{"my-app-3"},
+			}},
+		},
+	}))
+	require.NoError(t, projIndexer.Add(&argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "empty-project", Namespace: "argocd"},
+	}))
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewProjectCollector(
+		applister.NewApplicationLister(appIndexer),
+		applister.NewAppProjectLister(projIndexer).AppProjects("argocd"),
+		func(obj any) bool { return obj.(*argoappv1.Application).Name != "my-app-2" },
+	))
+
+	expected := `
+# HELP argocd_project_apps Number of applications of the project.
+# TYPE argocd_project_apps gauge
+argocd_project_apps{name="empty-project"} 0
+argocd_project_apps{name="important-project"} 2
+# HELP argocd_project_apps_degraded Number of applications of the project which are degraded.
+# TYPE argocd_project_apps_degraded gauge
+argocd_project_apps_degraded{name="empty-project"} 0
+argocd_project_apps_degraded{name="important-project"} 1
+# HELP argocd_project_apps_out_of_sync Number of applications of the project which are out of sync.
+# TYPE argocd_project_apps_out_of_sync gauge
+argocd_project_apps_out_of_sync{name="empty-project"} 0
+argocd_project_apps_out_of_sync{name="important-project"} 1
+# HELP argocd_project_apps_sync_blocked Number of applications of the project whose automated syncs are currently blocked by a sync window.
+# TYPE argocd_project_apps_sync_blocked gauge
+argocd_project_apps_sync_blocked{name="empty-project"} 0
+argocd_project_apps_sync_blocked{name="important-project"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"argocd_project_apps", "argocd_project_apps_degraded", "argocd_project_apps_out_of_sync", "argocd_project_apps_sync_blocked"))
+}
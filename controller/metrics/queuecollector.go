@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var descAppQueueDepth = prometheus.NewDesc(
+	"argocd_app_queue_depth",
+	"Number of applications waiting in the queues of the application controller, per reconcile priority.",
+	[]string{"name", "priority"},
+	nil,
+)
+
+// PriorityQueue is a queue which reports the number of queued items per priority
+type PriorityQueue interface {
+	DepthsByPriority() map[int32]int
+}
+
+type queueDepthCollector struct {
+	queues map[string]PriorityQueue
+}
+
+// NewQueueDepthCollector returns a collector which reports the depth of the given queues per priority
+func NewQueueDepthCollector(queues map[string]PriorityQueue) prometheus.Collector {
+	return &queueDepthCollector{queues: queues}
+}
+
+// Describe implements the prometheus.Collector interface
+func (c *queueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- descAppQueueDepth
+}
+
+// Collect implements the prometheus.Collector interface
+func (c *queueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	for name, queue := range c.queues {
+		for priority, depth := range queue.DepthsByPriority() {
+			ch <- prometheus.MustNewConstMetric(descAppQueueDepth, prometheus.GaugeValue, float64(depth), name, strconv.Itoa(int(priority)))
+		}
+	}
+}
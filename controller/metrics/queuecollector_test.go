@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePriorityQueue map[int32]int
+
+func (q fakePriorityQueue) DepthsByPriority() map[int32]int {
+	return q
+}
+
+func TestQueueDepthCollector(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewQueueDepthCollector(map[string]PriorityQueue{
+		"app_reconciliation_queue":       fakePriorityQueue{0: 12, 100: 3},
+		"app_operation_processing_queue": fakePriorityQueue{},
+	}))
+
+	expected := `
+# HELP argocd_app_queue_depth Number of applications waiting in the queues of the application controller, per reconcile priority.
+# TYPE argocd_app_queue_depth gauge
+argocd_app_queue_depth{name="app_reconciliation_queue",priority="0"} 12
+argocd_app_queue_depth{name="app_reconciliation_queue",priority="100"} 3
+`
+	require.NoError(t, testutil.GatherAndCompare(registry, strings.NewReader(expected), "argocd_app_queue_depth"))
+}
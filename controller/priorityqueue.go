@@ -0,0 +1,157 @@
+package controller
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// interactiveRefreshPriority is the priority of the refreshes requested by users, higher than any project priority
+const interactiveRefreshPriority = math.MaxInt32
+
+// priorityQueue is a workqueue.Queue which pops the items with the highest priority first. Items with the same
+// priority are popped in FIFO order.
+type priorityQueue struct {
+	lock       sync.Mutex
+	priorityOf func(key string) int32
+	items      map[int32][]string
+}
+
+var _ workqueue.Queue[string] = &priorityQueue{}
+
+func newPriorityQueue(priorityOf func(key string) int32) *priorityQueue {
+	return &priorityQueue{priorityOf: priorityOf, items: map[int32][]string{}}
+}
+
+// Touch does not change the priority of a queued item, the priority is determined when the item is pushed
+func (q *priorityQueue) Touch(_ string) {}
+
+func (q *priorityQueue) Push(key string) {
+	priority := q.priorityOf(key)
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.items[priority] = append(q.items[priority], key)
+}
+
+func (q *priorityQueue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	n := 0
+	for _, items := range q.items {
+		n += len(items)
+	}
+	return n
+}
+
+func (q *priorityQueue) Pop() string {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	var highest int32
+	found := false
+	for priority := range q.items {
+		if !found || priority > highest {
+			highest = priority
+			found = true
+		}
+	}
+	if !found {
+		return ""
+	}
+	items := q.items[highest]
+	key := items[0]
+	// avoid memory leak
+	items[0] = ""
+	if len(items) == 1 {
+		delete(q.items, highest)
+	} else {
+		q.items[highest] = items[1:]
+	}
+	return key
+}
+
+// DepthsByPriority returns the number of queued items per priority
+func (q *priorityQueue) DepthsByPriority() map[int32]int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	depths := make(map[int32]int, len(q.items))
+	for priority, items := range q.items {
+		depths[priority] = len(items)
+	}
+	return depths
+}
+
+// newPriorityRateLimitingQueue creates a rate limiting workqueue backed by the given priority queue
+func newPriorityRateLimitingQueue(name string, rateLimiter workqueue.TypedRateLimiter[string], queue workqueue.Queue[string]) workqueue.TypedRateLimitingInterface[string] {
+	return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[string]{
+		Name: name,
+		DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{
+			Name:  name,
+			Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Name: name, Queue: queue}),
+		}),
+	})
+}
+
+// projectRateLimiter delays the items by the reconcile rate limits of the projects of the applications. The limit of a
+// project is shared by all of its applications.
+type projectRateLimiter struct {
+	lock        sync.Mutex
+	rateLimitOf func(key string) (string, *appv1.ReconcileRateLimit)
+	limiters    map[string]*projectLimiter
+}
+
+type projectLimiter struct {
+	limit   appv1.ReconcileRateLimit
+	limiter *rate.Limiter
+	// pending holds the time at which the delayed applications of the project are ready to be reconciled
+	pending map[string]time.Time
+}
+
+var _ workqueue.TypedRateLimiter[string] = &projectRateLimiter{}
+
+func newProjectRateLimiter(rateLimitOf func(key string) (string, *appv1.ReconcileRateLimit)) *projectRateLimiter {
+	return &projectRateLimiter{rateLimitOf: rateLimitOf, limiters: map[string]*projectLimiter{}}
+}
+
+func (r *projectRateLimiter) When(key string) time.Duration {
+	project, limit := r.rateLimitOf(key)
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if limit == nil {
+		delete(r.limiters, project)
+		return 0
+	}
+	limiter, ok := r.limiters[project]
+	if !ok || limiter.limit != *limit {
+		limiter = &projectLimiter{
+			limit:   *limit,
+			limiter: rate.NewLimiter(rate.Limit(float64(limit.PerMinute)/60), limit.GetBurst()),
+			pending: map[string]time.Time{},
+		}
+		r.limiters[project] = limiter
+	}
+	now := time.Now()
+	// an application which is already waiting does not take another token, since the delaying queue only keeps one
+	// entry per application
+	if readyAt, ok := limiter.pending[key]; ok {
+		if readyAt.After(now) {
+			return readyAt.Sub(now)
+		}
+		delete(limiter.pending, key)
+	}
+	delay := limiter.limiter.ReserveN(now, 1).DelayFrom(now)
+	if delay > 0 {
+		limiter.pending[key] = now.Add(delay)
+	}
+	return delay
+}
+
+func (r *projectRateLimiter) Forget(_ string) {}
+
+func (r *projectRateLimiter) NumRequeues(_ string) int {
+	return 0
+}
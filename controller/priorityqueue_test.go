@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	priorities := map[string]int32{"prod/a": 100, "prod/b": 100, "staging/a": 10}
+	queue := newPriorityQueue(func(key string) int32 {
+		return priorities[key]
+	})
+	for _, key := range []string{"sandbox/a", "staging/a", "prod/a", "sandbox/b", "prod/b"} {
+		queue.Push(key)
+	}
+	assert.Equal(t, 5, queue.Len())
+	assert.Equal(t, map[int32]int{0: 2, 10: 1, 100: 2}, queue.DepthsByPriority())
+
+	var popped []string
+	for queue.Len() > 0 {
+		popped = append(popped, queue.Pop())
+	}
+	assert.Equal(t, []string{"prod/a", "prod/b", "staging/a", "sandbox/a", "sandbox/b"}, popped)
+	assert.Empty(t, queue.DepthsByPriority())
+}
+
+func TestPriorityRateLimitingQueue(t *testing.T) {
+	priorities := map[string]int32{"prod/a": 1}
+	queue := newPriorityRateLimitingQueue("", workqueue.DefaultTypedControllerRateLimiter[string](), newPriorityQueue(func(key string) int32 {
+		return priorities[key]
+	}))
+	defer queue.ShutDown()
+	queue.Add("sandbox/a")
+	queue.Add("prod/a")
+	queue.Add("sandbox/a")
+
+	key, _ := queue.Get()
+	assert.Equal(t, "prod/a", key)
+	queue.Done(key)
+	key, _ = queue.Get()
+	assert.Equal(t, "sandbox/a", key)
+	queue.Done(key)
+	assert.Equal(t, 0, queue.Len())
+}
+
+func TestProjectRateLimiter(t *testing.T) {
+	limits := map[string]*appv1.ReconcileRateLimit{
+		"sandbox": {PerMinute: 60, Burst: 2},
+	}
+	limiter := newProjectRateLimiter(func(key string) (string, *appv1.ReconcileRateLimit) {
+		project := key[:len(key)-2]
+		return project, limits[project]
+	})
+
+	// the burst is shared by all applications of the project
+	assert.Equal(t, time.Duration(0), limiter.When("sandbox/a"))
+	assert.Equal(t, time.Duration(0), limiter.When("sandbox/b"))
+	assert.Greater(t, limiter.When("sandbox/a"), 500*time.Millisecond)
+
+	// repeated adds of an application which is already waiting do not delay the project further
+	delay := limiter.When("sandbox/b")
+	assert.Greater(t, delay, time.Second)
+	for range 100 {
+		assert.LessOrEqual(t, limiter.When("sandbox/b"), delay)
+	}
+	assert.Less(t, limiter.When("sandbox/c"), 3500*time.Millisecond)
+
+	// applications of projects without limit are not delayed
+	for range 10 {
+		assert.Equal(t, time.Duration(0), limiter.When("prod/a"))
+	}
+
+	// the limiter is recreated when the limit changes
+	limits["sandbox"] = &appv1.ReconcileRateLimit{PerMinute: 60, Burst: 3}
+	assert.Equal(t, time.Duration(0), limiter.When("sandbox/a"))
+
+	// the limiter is removed when the limit is removed
+	delete(limits, "sandbox")
+	assert.Equal(t, time.Duration(0), limiter.When("sandbox/a"))
+	assert.Empty(t, limiter.limiters)
+}
@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"cmp"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Names of the queues whose items are tracked
+const (
+	appReconciliationQueueName = "app_reconciliation_queue"
+	appOperationQueueName      = "app_operation_processing_queue"
+)
+
+// Reasons an application is added to a queue, as reported by the queue introspection endpoint
+const (
+	queueReasonAppCreated         = "app created"
+	queueReasonAppDeleted         = "app deleted"
+	queueReasonSpecChange         = "spec change"
+	queueReasonRefreshRequested   = "refresh requested"
+	queueReasonInteractiveRefresh = "interactive refresh"
+	queueReasonAppUpdated         = "app updated"
+	queueReasonTimer              = "timer"
+	queueReasonWatchEvent         = "watch event"
+	queueReasonShardChange        = "shard change"
+	queueReasonOperation          = "operation"
+	queueReasonOperationProgress  = "operation in progress"
+	queueReasonOperationCompleted = "operation completed"
+	queueReasonDelayedAutoSync    = "delayed auto-sync"
+	// queueReasonRetry is reported for the items queued again by the queue itself, e.g. after an error
+	queueReasonRetry = "retry"
+)
+
+// States of the items of a queue, as reported by the queue introspection endpoint
+const (
+	// QueueItemStatePending is the state of the items waiting for their delay or rate limit to elapse
+	QueueItemStatePending = "Pending"
+	// QueueItemStateQueued is the state of the items waiting for a worker
+	QueueItemStateQueued = "Queued"
+	// QueueItemStateInFlight is the state of the items being processed by a worker
+	QueueItemStateInFlight = "InFlight"
+)
+
+// QueueItem describes an application added to one of the work queues of the controller
+type QueueItem struct {
+	// Queue is the name of the queue, e.g. app_reconciliation_queue
+	Queue string `json:"queue"`
+	// Key is the key of the application, i.e. <namespace>/<name>
+	Key string `json:"key"`
+	// State is one of Pending, Queued or InFlight
+	State string `json:"state"`
+	// Reason is the reason the application was added to the queue
+	Reason string `json:"reason"`
+	// AddedAt is the time the application was added to the queue
+	AddedAt time.Time `json:"addedAt"`
+	// Since is the time the item entered its current state
+	Since time.Time `json:"since"`
+	// Requeued is set if the application was added to the queue again while in flight, with the reason of the new request
+	Requeued string `json:"requeued,omitempty"`
+}
+
+// QueueItemList is the response of the queue introspection endpoint
+type QueueItemList struct {
+	Items []QueueItem `json:"items"`
+}
+
+type queueItemKey struct {
+	queue string
+	key   string
+}
+
+// queueTracker records the state of the applications added to the work queues of the controller, together with the
+// reason they were added, so that the queues can be inspected without reading the logs
+type queueTracker struct {
+	lock  sync.Mutex
+	items map[queueItemKey]*QueueItem
+	now   func() time.Time
+}
+
+func newQueueTracker() *queueTracker {
+	return &queueTracker{items: map[queueItemKey]*QueueItem{}, now: time.Now}
+}
+
+// requested records that the application was added to the queue for the given reason. The reason of an item already in
+// the queue is kept, since the item is processed once for all the requests.
+func (t *queueTracker) requested(queue string, key string, reason string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	item, ok := t.items[queueItemKey{queue, key}]
+	switch {
+	case !ok:
+		now := t.now()
+		t.items[queueItemKey{queue, key}] = &QueueItem{Queue: queue, Key: key, State: QueueItemStatePending, Reason: reason, AddedAt: now, Since: now}
+	case item.State == QueueItemStateInFlight && item.Requeued == "":
+		item.Requeued = reason
+	}
+}
+
+// pushed records that the item became ready to be processed
+func (t *queueTracker) pushed(queue string, key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	now := t.now()
+	item, ok := t.items[queueItemKey{queue, key}]
+	if !ok {
+		item = &QueueItem{Queue: queue, Key: key, Reason: queueReasonRetry, AddedAt: now}
+		t.items[queueItemKey{queue, key}] = item
+	}
+	item.State = QueueItemStateQueued
+	item.Since = now
+}
+
+// started records that a worker started processing the item
+func (t *queueTracker) started(queue string, key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if item, ok := t.items[queueItemKey{queue, key}]; ok {
+		item.State = QueueItemStateInFlight
+		item.Since = t.now()
+	}
+}
+
+// done records that a worker finished processing the item. Must be called before the item is marked as done in the
+// queue, so that a request received while the item was in flight is tracked as a new pending item.
+func (t *queueTracker) done(queue string, key string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	item, ok := t.items[queueItemKey{queue, key}]
+	if !ok {
+		return
+	}
+	if item.Requeued == "" {
+		delete(t.items, queueItemKey{queue, key})
+		return
+	}
+	now := t.now()
+	t.items[queueItemKey{queue, key}] = &QueueItem{Queue: queue, Key: key, State: QueueItemStatePending, Reason: item.Requeued, AddedAt: now, Since: now}
+}
+
+// list returns the tracked items, in flight items first and then the oldest items first
+func (t *queueTracker) list() []QueueItem {
+	t.lock.Lock()
+	items := make([]QueueItem, 0, len(t.items))
+	for _, item := range t.items {
+		items = append(items, *item)
+	}
+	t.lock.Unlock()
+	stateOrder := map[string]int{QueueItemStateInFlight: 0, QueueItemStateQueued: 1, QueueItemStatePending: 2}
+	slices.SortFunc(items, func(a, b QueueItem) int {
+		return cmp.Or(
+			cmp.Compare(a.Queue, b.Queue),
+			cmp.Compare(stateOrder[a.State], stateOrder[b.State]),
+			a.AddedAt.Compare(b.AddedAt),
+			cmp.Compare(a.Key, b.Key),
+		)
+	})
+	return items
+}
+
+// ServeHTTP returns the tracked items as a QueueItemList
+func (t *queueTracker) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(QueueItemList{Items: t.list()}); err != nil {
+		log.Warnf("Failed to write the state of the queues: %v", err)
+	}
+}
+
+// appUpdateQueueReason returns the reason an updated application is added to the refresh queue
+func appUpdateQueueReason(oldApp *appv1.Application, newApp *appv1.Application) string {
+	_, oldRefresh := oldApp.IsRefreshRequested()
+	_, newRefresh := newApp.IsRefreshRequested()
+	switch {
+	case oldApp.ResourceVersion == newApp.ResourceVersion:
+		// the informer resyncs the applications periodically
+		return queueReasonTimer
+	case newApp.IsInteractiveRefreshRequested() && !oldApp.IsInteractiveRefreshRequested():
+		return queueReasonInteractiveRefresh
+	case newRefresh && !oldRefresh:
+		return queueReasonRefreshRequested
+	case oldApp.Generation != newApp.Generation:
+		return queueReasonSpecChange
+	default:
+		return queueReasonAppUpdated
+	}
+}
+
+// wrap returns a queue which records in the tracker when the items of the given queue become ready to be processed
+func (t *queueTracker) wrap(name string, queue workqueue.Queue[string]) workqueue.Queue[string] {
+	return &trackedQueue{Queue: queue, name: name, tracker: t}
+}
+
+type trackedQueue struct {
+	workqueue.Queue[string]
+	name    string
+	tracker *queueTracker
+}
+
+func (q *trackedQueue) Push(key string) {
+	q.tracker.pushed(q.name, key)
+	q.Queue.Push(key)
+}
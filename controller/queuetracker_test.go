@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestQueueTracker(t *testing.T) {
+	tracker := newQueueTracker()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+	tracker.now = func() time.Time {
+		return now
+	}
+	queue := newPriorityRateLimitingQueue("", workqueue.DefaultTypedControllerRateLimiter[string](), tracker.wrap("test", newPriorityQueue(func(_ string) int32 {
+		return 0
+	})))
+	defer queue.ShutDown()
+	add := func(key string, reason string) {
+		tracker.requested("test", key, reason)
+		queue.Add(key)
+		now = now.Add(time.Second)
+	}
+
+	tracker.requested("test", "argocd/delayed", queueReasonDelayedAutoSync)
+	add("argocd/a", queueReasonAppCreated)
+	add("argocd/b", queueReasonWatchEvent)
+	add("argocd/a", queueReasonSpecChange)
+
+	key, _ := queue.Get()
+	require.Equal(t, "argocd/a", key)
+	tracker.started("test", key)
+	add("argocd/a", queueReasonRefreshRequested)
+
+	assert.Equal(t, []QueueItem{
+		{Queue: "test", Key: "argocd/a", State: QueueItemStateInFlight, Reason: queueReasonAppCreated, AddedAt: at(0), Since: at(3), Requeued: queueReasonRefreshRequested},
+		{Queue: "test", Key: "argocd/b", State: QueueItemStateQueued, Reason: queueReasonWatchEvent, AddedAt: at(1), Since: at(1)},
+		{Queue: "test", Key: "argocd/delayed", State: QueueItemStatePending, Reason: queueReasonDelayedAutoSync, AddedAt: at(0), Since: at(0)},
+	}, tracker.list())
+
+	tracker.done("test", key)
+	queue.Done(key)
+
+	// the request received while the item was in flight is processed again
+	items := tracker.list()
+	require.Len(t, items, 3)
+	assert.Equal(t, QueueItem{Queue: "test", Key: "argocd/b", State: QueueItemStateQueued, Reason: queueReasonWatchEvent, AddedAt: at(1), Since: at(1)}, items[0])
+	assert.Equal(t, QueueItem{Queue: "test", Key: "argocd/a", State: QueueItemStateQueued, Reason: queueReasonRefreshRequested, AddedAt: at(4), Since: at(4)}, items[1])
+
+	for range 2 {
+		key, _ = queue.Get()
+		tracker.started("test", key)
+		tracker.done("test", key)
+		queue.Done(key)
+	}
+	assert.Equal(t, []QueueItem{
+		{Queue: "test", Key: "argocd/delayed", State: QueueItemStatePending, Reason: queueReasonDelayedAutoSync, AddedAt: at(0), Since: at(0)},
+	}, tracker.list())
+
+	t.Run("RateLimitedRetry", func(t *testing.T) {
+		queue.Add("argocd/retried")
+		assert.Equal(t, queueReasonRetry, tracker.list()[0].Reason)
+	})
+
+	t.Run("ServeHTTP", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		tracker.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/queues", http.NoBody))
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var list QueueItemList
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+		assert.Len(t, list.Items, 2)
+	})
+}
+
+func TestAppUpdateQueueReason(t *testing.T) {
+	app := &appv1.Application{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1", Generation: 1}}
+	assert.Equal(t, queueReasonTimer, appUpdateQueueReason(app, app.DeepCopy()))
+
+	updated := app.DeepCopy()
+	updated.ResourceVersion = "2"
+	assert.Equal(t, queueReasonAppUpdated, appUpdateQueueReason(app, updated))
+
+	updated.Generation = 2
+	assert.Equal(t, queueReasonSpecChange, appUpdateQueueReason(app, updated))
+
+	updated.Annotations = map[string]string{appv1.AnnotationKeyRefresh: string(appv1.RefreshTypeNormal)}
+	assert.Equal(t, queueReasonRefreshRequested, appUpdateQueueReason(app, updated))
+
+	updated.Annotations[appv1.AnnotationKeyRefreshPriority] = string(appv1.RefreshPriorityInteractive)
+	assert.Equal(t, queueReasonInteractiveRefresh, appUpdateQueueReason(app, updated))
+}
@@ -0,0 +1,121 @@
+package registration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/registration/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+// ClusterReconciler reconciles Cluster resources in the Argo CD namespace into cluster secrets.
+type ClusterReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Namespace string
+}
+
+func (r *ClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var cluster v1alpha1.Cluster
+	if err := r.Get(ctx, req.NamespacedName, &cluster); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if cluster.DeletionTimestamp != nil {
+		// the cluster secret is owned by the resource and garbage collected with it
+		return ctrl.Result{}, nil
+	}
+	err := register(ctx, r.Client, r.Scheme, application.ClusterKind, &cluster, &cluster.Status, func() (*corev1.Secret, error) {
+		return r.clusterSecret(ctx, &cluster)
+	})
+	return ctrl.Result{}, err
+}
+
+// clusterSecret returns the cluster secret for the given resource.
+func (r *ClusterReconciler) clusterSecret(ctx context.Context, cluster *v1alpha1.Cluster) (*corev1.Secret, error) {
+	spec := cluster.Spec
+	secretName, err := db.URIToSecretName("cluster", spec.Server)
+	if err != nil {
+		return nil, invalidSpec("invalid server %q: %v", spec.Server, err)
+	}
+	if spec.Annotations[corev1.LastAppliedConfigAnnotation] != "" {
+		return nil, invalidSpec("annotation %s cannot be set", corev1.LastAppliedConfigAnnotation)
+	}
+	if spec.SecretRef == nil && spec.Server != appv1alpha1.KubernetesInternalAPIServerAddr {
+		return nil, invalidSpec("secretRef is required for external clusters")
+	}
+	if err := validateProject(ctx, r.Client, cluster.Namespace, spec.Project); err != nil {
+		return nil, err
+	}
+	creds, err := getCredentialsSecret(ctx, r.Client, cluster.Namespace, spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &appv1alpha1.Cluster{
+		Server:           spec.Server,
+		Name:             spec.Name,
+		Namespaces:       spec.Namespaces,
+		ClusterResources: spec.ClusterResources,
+		Project:          spec.Project,
+		Shard:            spec.Shard,
+		Labels:           maps.Clone(spec.Labels),
+		Annotations:      maps.Clone(spec.Annotations),
+	}
+	if creds != nil {
+		config, ok := creds.Data["config"]
+		if !ok {
+			return nil, &registrationError{reason: v1alpha1.RegistrationReasonSecretError, message: fmt.Sprintf("secret %q has no config key", creds.Name)}
+		}
+		if err := json.Unmarshal(config, &c.Config); err != nil {
+			return nil, &registrationError{reason: v1alpha1.RegistrationReasonSecretError, message: fmt.Sprintf("secret %q has an invalid config: %v", creds.Name, err)}
+		}
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = secretName
+	secret.Namespace = cluster.Namespace
+	if err := db.ClusterToSecret(c, secret); err != nil {
+		return nil, fmt.Errorf("error converting cluster to secret: %w", err)
+	}
+	return secret, nil
+}
+
+// SetupWithManager registers the reconciler with the manager. Besides Cluster resources, it watches the secrets
+// the clusters are stored in, and the secrets referenced by spec.secretRef.
+func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("cluster").
+		For(&v1alpha1.Cluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.clustersReferencingSecret)).
+		WithEventFilter(inNamespace(r.Namespace)).
+		Complete(r)
+}
+
+// clustersReferencingSecret returns a request for each Cluster that references the given secret.
+func (r *ClusterReconciler) clustersReferencingSecret(ctx context.Context, secret client.Object) []ctrl.Request {
+	var clusters v1alpha1.ClusterList
+	if err := r.List(ctx, &clusters, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, cluster := range clusters.Items {
+		if cluster.Spec.SecretRef != nil && cluster.Spec.SecretRef.Name == secret.GetName() {
+			requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}})
+		}
+	}
+	return requests
+}
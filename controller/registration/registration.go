@@ -0,0 +1,171 @@
+// Package registration reconciles the declarative Repository and Cluster resources into the labeled secrets that
+// Argo CD uses to store repositories and clusters.
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/registration/v1alpha1"
+)
+
+// registrationError is caused by the spec of a resource or by the secret it references. It is reported in the
+// status of the resource instead of being retried, since the resource is reconciled again once either changes.
+type registrationError struct {
+	reason  string
+	message string
+}
+
+func (e *registrationError) Error() string {
+	return e.message
+}
+
+func invalidSpec(format string, args ...any) error {
+	return &registrationError{reason: v1alpha1.RegistrationReasonInvalidSpec, message: fmt.Sprintf(format, args...)}
+}
+
+// getCredentialsSecret returns the secret referenced by a resource, or nil if the resource does not reference one.
+func getCredentialsSecret(ctx context.Context, c client.Client, namespace string, ref *corev1.LocalObjectReference) (*corev1.Secret, error) {
+	if ref == nil {
+		return nil, nil
+	}
+	if ref.Name == "" {
+		return nil, invalidSpec("secretRef.name is required")
+	}
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, &secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &registrationError{reason: v1alpha1.RegistrationReasonSecretError, message: fmt.Sprintf("secret %q not found", ref.Name)}
+		}
+		return nil, fmt.Errorf("error getting secret %q: %w", ref.Name, err)
+	}
+	return &secret, nil
+}
+
+// validateProject verifies that the project a resource is scoped to exists.
+func validateProject(ctx context.Context, c client.Client, namespace, project string) error {
+	if project == "" {
+		return nil
+	}
+	var proj appv1alpha1.AppProject
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: project}, &proj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return invalidSpec("project %q does not exist", project)
+		}
+		return fmt.Errorf("error getting project %q: %w", project, err)
+	}
+	return nil
+}
+
+// applySecret creates or updates the desired secret, which is owned by the given resource. It refuses to modify
+// secrets that are not owned by the resource, such as secrets created using the CLI or API. Once the secret has
+// been written, the secret the resource was previously stored in is removed if its name changed.
+func applySecret(ctx context.Context, c client.Client, scheme *runtime.Scheme, kind string, owner client.Object, status *v1alpha1.RegistrationStatus, desired *corev1.Secret) error {
+	if err := controllerutil.SetControllerReference(owner, desired, scheme); err != nil {
+		return fmt.Errorf("error setting owner reference: %w", err)
+	}
+
+	var existing corev1.Secret
+	err := c.Get(ctx, client.ObjectKeyFromObject(desired), &existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		if err := c.Create(ctx, desired); err != nil {
+			return fmt.Errorf("error creating secret %q: %w", desired.Name, err)
+		}
+	case err != nil:
+		return fmt.Errorf("error getting secret %q: %w", desired.Name, err)
+	case !metav1.IsControlledBy(&existing, owner):
+		return &registrationError{
+			reason:  v1alpha1.RegistrationReasonConflict,
+			message: fmt.Sprintf("secret %q already exists and is not managed by %s %q", desired.Name, kind, owner.GetName()),
+		}
+	case !equality.Semantic.DeepEqual(existing.Data, desired.Data) ||
+		!equality.Semantic.DeepEqual(existing.Labels, desired.Labels) ||
+		!equality.Semantic.DeepEqual(existing.Annotations, desired.Annotations):
+		existing.Data = desired.Data
+		existing.Labels = desired.Labels
+		existing.Annotations = desired.Annotations
+		if err := c.Update(ctx, &existing); err != nil {
+			return fmt.Errorf("error updating secret %q: %w", desired.Name, err)
+		}
+	}
+
+	if status.SecretName != "" && status.SecretName != desired.Name {
+		var previous corev1.Secret
+		err := c.Get(ctx, types.NamespacedName{Namespace: desired.Namespace, Name: status.SecretName}, &previous)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting secret %q: %w", status.SecretName, err)
+		}
+		if err == nil && metav1.IsControlledBy(&previous, owner) {
+			if err := c.Delete(ctx, &previous); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("error deleting secret %q: %w", status.SecretName, err)
+			}
+		}
+	}
+	status.SecretName = desired.Name
+	return nil
+}
+
+// register stores a resource in its backing secret and records the outcome in the status of the resource.
+// buildSecret returns the desired secret of the resource.
+func register(ctx context.Context, c client.Client, scheme *runtime.Scheme, kind string, obj client.Object, status *v1alpha1.RegistrationStatus, buildSecret func() (*corev1.Secret, error)) error {
+	logCtx := log.WithFields(log.Fields{"kind": kind, "name": obj.GetName(), "namespace": obj.GetNamespace()})
+	original := status.DeepCopy()
+
+	secret, err := buildSecret()
+	if err == nil {
+		err = applySecret(ctx, c, scheme, kind, obj, status, secret)
+	}
+	var regErr *registrationError
+	switch {
+	case errors.As(err, &regErr):
+		logCtx.Warnf("Unable to register resource: %s", regErr.message)
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               v1alpha1.RegistrationConditionReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             regErr.reason,
+			Message:            regErr.message,
+			ObservedGeneration: obj.GetGeneration(),
+		})
+	case err != nil:
+		return err
+	default:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               v1alpha1.RegistrationConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             v1alpha1.RegistrationReasonRegistered,
+			Message:            fmt.Sprintf("Stored in secret %q", status.SecretName),
+			ObservedGeneration: obj.GetGeneration(),
+		})
+	}
+	status.ObservedGeneration = obj.GetGeneration()
+
+	if equality.Semantic.DeepEqual(original, status) {
+		return nil
+	}
+	if err := c.Status().Update(ctx, obj); err != nil {
+		return fmt.Errorf("error updating status: %w", err)
+	}
+	return nil
+}
+
+// inNamespace filters events to objects in the given namespace.
+func inNamespace(namespace string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == namespace
+	})
+}
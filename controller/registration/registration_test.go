@@ -0,0 +1,219 @@
+package registration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/registration/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+const testNamespace = "argocd"
+
+func newFakeClient(t *testing.T, objs ...client.Object) (client.Client, *runtime.Scheme) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, appv1alpha1.AddToScheme(scheme))
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	objs = append(objs, &appv1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace}})
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&v1alpha1.Repository{}, &v1alpha1.Cluster{}).
+		Build()
+	return c, scheme
+}
+
+func readyCondition(t *testing.T, conditions []metav1.Condition) *metav1.Condition {
+	t.Helper()
+	cond := meta.FindStatusCondition(conditions, v1alpha1.RegistrationConditionReady)
+	require.NotNil(t, cond)
+	return cond
+}
+
+func TestRepositoryReconciler(t *testing.T) {
+	repo := &v1alpha1.Repository{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace, Generation: 1},
+		Spec: v1alpha1.RepositorySpec{
+			URL:       "https://github.com/argoproj/argocd-example-apps",
+			Project:   "default",
+			EnableLFS: true,
+			SecretRef: &corev1.LocalObjectReference{Name: "guestbook-creds"},
+		},
+	}
+	creds := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-creds", Namespace: testNamespace},
+		Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("secret")},
+	}
+	c, scheme := newFakeClient(t, repo, creds)
+	r := &RepositoryReconciler{Client: c, Scheme: scheme, Namespace: testNamespace}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: testNamespace, Name: "guestbook"}}
+
+	_, err := r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Get(t.Context(), req.NamespacedName, repo))
+	cond := readyCondition(t, repo.Status.Conditions)
+	assert.Equal(t, metav1.ConditionTrue, cond.Status)
+	assert.Equal(t, int64(1), repo.Status.ObservedGeneration)
+	secretName := db.RepoURLToSecretName("repo", repo.Spec.URL, "default")
+	assert.Equal(t, secretName, repo.Status.SecretName)
+
+	var secret corev1.Secret
+	require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: testNamespace, Name: secretName}, &secret))
+	assert.Equal(t, common.LabelValueSecretTypeRepository, secret.Labels[common.LabelKeySecretType])
+	assert.True(t, metav1.IsControlledBy(&secret, repo))
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", string(secret.Data["url"]))
+	assert.Equal(t, "admin", string(secret.Data["username"]))
+	assert.Equal(t, "secret", string(secret.Data["password"]))
+	assert.Equal(t, "true", string(secret.Data["enableLfs"]))
+
+	t.Run("CredentialsChanged", func(t *testing.T) {
+		creds.Data["password"] = []byte("rotated")
+		require.NoError(t, c.Update(t.Context(), creds))
+		assert.Equal(t, []ctrl.Request{req}, r.repositoriesReferencingSecret(t.Context(), creds))
+
+		_, err := r.Reconcile(t.Context(), req)
+		require.NoError(t, err)
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: testNamespace, Name: secretName}, &secret))
+		assert.Equal(t, "rotated", string(secret.Data["password"]))
+	})
+
+	t.Run("URLChanged", func(t *testing.T) {
+		require.NoError(t, c.Get(t.Context(), req.NamespacedName, repo))
+		repo.Spec.URL = "https://github.com/argoproj/argo-cd"
+		require.NoError(t, c.Update(t.Context(), repo))
+
+		_, err := r.Reconcile(t.Context(), req)
+		require.NoError(t, err)
+		require.NoError(t, c.Get(t.Context(), req.NamespacedName, repo))
+		assert.NotEqual(t, secretName, repo.Status.SecretName)
+		err = c.Get(t.Context(), types.NamespacedName{Namespace: testNamespace, Name: secretName}, &secret)
+		assert.True(t, apierrors.IsNotFound(err), "the previous secret is removed")
+		require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: testNamespace, Name: repo.Status.SecretName}, &secret))
+	})
+}
+
+func TestRepositoryReconciler_Invalid(t *testing.T) {
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      db.RepoURLToSecretName("repo", "https://github.com/argoproj/existing", ""),
+			Namespace: testNamespace,
+			Labels:    map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository},
+		},
+	}
+	c, scheme := newFakeClient(t,
+		existing,
+		&v1alpha1.Repository{
+			ObjectMeta: metav1.ObjectMeta{Name: "missing-project", Namespace: testNamespace},
+			Spec:       v1alpha1.RepositorySpec{URL: "https://github.com/argoproj/argo-cd", Project: "missing"},
+		},
+		&v1alpha1.Repository{
+			ObjectMeta: metav1.ObjectMeta{Name: "missing-secret", Namespace: testNamespace},
+			Spec:       v1alpha1.RepositorySpec{URL: "https://github.com/argoproj/argo-cd", SecretRef: &corev1.LocalObjectReference{Name: "missing"}},
+		},
+		&v1alpha1.Repository{
+			ObjectMeta: metav1.ObjectMeta{Name: "conflict", Namespace: testNamespace},
+			Spec:       v1alpha1.RepositorySpec{URL: "https://github.com/argoproj/existing"},
+		},
+	)
+	r := &RepositoryReconciler{Client: c, Scheme: scheme, Namespace: testNamespace}
+
+	tests := []struct {
+		name    string
+		reason  string
+		message string
+	}{
+		{"missing-project", v1alpha1.RegistrationReasonInvalidSpec, `project "missing" does not exist`},
+		{"missing-secret", v1alpha1.RegistrationReasonSecretError, `secret "missing" not found`},
+		{"conflict", v1alpha1.RegistrationReasonConflict, `already exists and is not managed by Repository "conflict"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := types.NamespacedName{Namespace: testNamespace, Name: tt.name}
+			_, err := r.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+			require.NoError(t, err)
+
+			var repo v1alpha1.Repository
+			require.NoError(t, c.Get(t.Context(), key, &repo))
+			cond := readyCondition(t, repo.Status.Conditions)
+			assert.Equal(t, metav1.ConditionFalse, cond.Status)
+			assert.Equal(t, tt.reason, cond.Reason)
+			assert.Contains(t, cond.Message, tt.message)
+			assert.Empty(t, repo.Status.SecretName)
+		})
+	}
+
+	var secret corev1.Secret
+	require.NoError(t, c.Get(t.Context(), client.ObjectKeyFromObject(existing), &secret))
+	assert.Empty(t, secret.Data, "secrets not owned by the resource are not modified")
+}
+
+func TestClusterReconciler(t *testing.T) {
+	config, err := json.Marshal(appv1alpha1.ClusterConfig{BearerToken: "token", TLSClientConfig: appv1alpha1.TLSClientConfig{Insecure: true}})
+	require.NoError(t, err)
+	c, scheme := newFakeClient(t,
+		&v1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod", Namespace: testNamespace},
+			Spec: v1alpha1.ClusterSpec{
+				Server:     "https://prod.example.com",
+				Name:       "prod",
+				Namespaces: []string{"guestbook"},
+				Labels:     map[string]string{"env": "prod"},
+				SecretRef:  &corev1.LocalObjectReference{Name: "prod-config"},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-config", Namespace: testNamespace},
+			Data:       map[string][]byte{"config": config},
+		},
+		&v1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-secret", Namespace: testNamespace},
+			Spec:       v1alpha1.ClusterSpec{Server: "https://staging.example.com"},
+		},
+	)
+	r := &ClusterReconciler{Client: c, Scheme: scheme, Namespace: testNamespace}
+
+	key := types.NamespacedName{Namespace: testNamespace, Name: "prod"}
+	_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	var cluster v1alpha1.Cluster
+	require.NoError(t, c.Get(t.Context(), key, &cluster))
+	assert.Equal(t, metav1.ConditionTrue, readyCondition(t, cluster.Status.Conditions).Status)
+
+	var secret corev1.Secret
+	require.NoError(t, c.Get(t.Context(), types.NamespacedName{Namespace: testNamespace, Name: cluster.Status.SecretName}, &secret))
+	stored, err := db.SecretToCluster(&secret)
+	require.NoError(t, err)
+	assert.Equal(t, "https://prod.example.com", stored.Server)
+	assert.Equal(t, []string{"guestbook"}, stored.Namespaces)
+	assert.Equal(t, "token", stored.Config.BearerToken)
+	assert.True(t, stored.Config.Insecure)
+	assert.Equal(t, "prod", secret.Labels["env"])
+	assert.Equal(t, common.LabelValueSecretTypeCluster, secret.Labels[common.LabelKeySecretType])
+	assert.Empty(t, cluster.Spec.Labels[common.LabelKeySecretType], "the spec is not modified")
+
+	key = types.NamespacedName{Namespace: testNamespace, Name: "no-secret"}
+	_, err = r.Reconcile(t.Context(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+	require.NoError(t, c.Get(t.Context(), key, &cluster))
+	cond := readyCondition(t, cluster.Status.Conditions)
+	assert.Equal(t, v1alpha1.RegistrationReasonInvalidSpec, cond.Reason)
+	assert.Equal(t, "secretRef is required for external clusters", cond.Message)
+}
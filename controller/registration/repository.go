@@ -0,0 +1,122 @@
+package registration
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/registration/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+// repoSecretPrefix matches the prefix of the repository secrets created through the API
+const repoSecretPrefix = "repo"
+
+// RepositoryReconciler reconciles Repository resources in the Argo CD namespace into repository secrets.
+type RepositoryReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	Namespace string
+}
+
+func (r *RepositoryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var repo v1alpha1.Repository
+	if err := r.Get(ctx, req.NamespacedName, &repo); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if repo.DeletionTimestamp != nil {
+		// the repository secret is owned by the resource and garbage collected with it
+		return ctrl.Result{}, nil
+	}
+	err := register(ctx, r.Client, r.Scheme, application.RepositoryKind, &repo, &repo.Status, func() (*corev1.Secret, error) {
+		return r.repositorySecret(ctx, &repo)
+	})
+	return ctrl.Result{}, err
+}
+
+// repositorySecret returns the repository secret for the given resource.
+func (r *RepositoryReconciler) repositorySecret(ctx context.Context, repo *v1alpha1.Repository) (*corev1.Secret, error) {
+	spec := repo.Spec
+	if spec.URL == "" {
+		return nil, invalidSpec("url is required")
+	}
+	if spec.Type == "helm" && spec.Name == "" {
+		return nil, invalidSpec("name is required for helm repositories")
+	}
+	if err := validateProject(ctx, r.Client, repo.Namespace, spec.Project); err != nil {
+		return nil, err
+	}
+	creds, err := getCredentialsSecret(ctx, r.Client, repo.Namespace, spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := &appv1alpha1.Repository{
+		Repo:                       spec.URL,
+		Type:                       spec.Type,
+		Name:                       spec.Name,
+		Project:                    spec.Project,
+		Insecure:                   spec.Insecure,
+		EnableLFS:                  spec.EnableLFS,
+		EnableOCI:                  spec.EnableOCI,
+		ForceHttpBasicAuth:         spec.ForceHTTPBasicAuth,
+		Proxy:                      spec.Proxy,
+		NoProxy:                    spec.NoProxy,
+		GithubAppId:                spec.GitHubAppID,
+		GithubAppInstallationId:    spec.GitHubAppInstallationID,
+		GitHubAppEnterpriseBaseURL: spec.GitHubAppEnterpriseBaseURL,
+		UseAzureWorkloadIdentity:   spec.UseAzureWorkloadIdentity,
+		Depth:                      spec.Depth,
+	}
+	if creds != nil {
+		repository.Username = string(creds.Data["username"])
+		repository.Password = string(creds.Data["password"])
+		repository.BearerToken = string(creds.Data["bearerToken"])
+		repository.SSHPrivateKey = string(creds.Data["sshPrivateKey"])
+		repository.TLSClientCertData = string(creds.Data["tlsClientCertData"])
+		repository.TLSClientCertKey = string(creds.Data["tlsClientCertKey"])
+		repository.GithubAppPrivateKey = string(creds.Data["githubAppPrivateKey"])
+		repository.GCPServiceAccountKey = string(creds.Data["gcpServiceAccountKey"])
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = db.RepoURLToSecretName(repoSecretPrefix, spec.URL, spec.Project)
+	secret.Namespace = repo.Namespace
+	return db.RepositoryToSecret(repository, secret), nil
+}
+
+// SetupWithManager registers the reconciler with the manager. Besides Repository resources, it watches the secrets
+// the repositories are stored in, and the secrets referenced by spec.secretRef.
+func (r *RepositoryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("repository").
+		For(&v1alpha1.Repository{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&corev1.Secret{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.repositoriesReferencingSecret)).
+		WithEventFilter(inNamespace(r.Namespace)).
+		Complete(r)
+}
+
+// repositoriesReferencingSecret returns a request for each Repository that references the given secret.
+func (r *RepositoryReconciler) repositoriesReferencingSecret(ctx context.Context, secret client.Object) []ctrl.Request {
+	var repos v1alpha1.RepositoryList
+	if err := r.List(ctx, &repos, client.InNamespace(secret.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, repo := range repos.Items {
+		if repo.Spec.SecretRef != nil && repo.Spec.SecretRef.Name == secret.GetName() {
+			requests = append(requests, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: repo.Namespace, Name: repo.Name}})
+		}
+	}
+	return requests
+}
@@ -14,6 +14,7 @@ import (
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	corev1 "k8s.io/api/core/v1"
 
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync"
@@ -29,16 +30,20 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/app/path"
@@ -97,6 +102,7 @@ type AppStateManager interface {
 	SyncAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState)
 	EvaluateAppRevisionsChanges(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, proj *v1alpha1.AppProject, sendRuntimeState bool, noRevisionCache bool) (bool, []string, error)
 	GetRepoObjs(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, appLabelKey string, revisions []string, noCache, noRevisionCache bool, sourceIntegrity *v1alpha1.SourceIntegrity, proj *v1alpha1.AppProject, sendRuntimeState bool) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, bool, error)
+	GetRevisionsCommitTime(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string) (time.Time, error)
 }
 
 // comparisonResult holds the state of an application after the reconciliation
@@ -108,7 +114,9 @@ type comparisonResult struct {
 	managedResources     []managedResource
 	reconciliationResult sync.ReconciliationResult
 	diffConfig           argodiff.DiffConfig
-	appSourceType        v1alpha1.ApplicationSourceType
+	// ignoreDifferences are the ignore differences of the application, merged with the ones referenced by its project
+	ignoreDifferences v1alpha1.IgnoreDifferences
+	appSourceType     v1alpha1.ApplicationSourceType
 	// appSourceTypes stores the SourceType for each application source under sources field
 	appSourceTypes []v1alpha1.ApplicationSourceType
 	// timings maps phases of comparison to the duration it took to complete (for statistical purposes)
@@ -128,6 +136,14 @@ func (res *comparisonResult) GetHealthStatus() health.HealthStatusCode {
 	return res.healthStatus
 }
 
+func (res *comparisonResult) GetHealthMessage() string {
+	return res.healthMessage
+}
+
+func (res *comparisonResult) GetResources() []v1alpha1.ResourceStatus {
+	return res.resources
+}
+
 // appStateManager allows to compare applications to git
 type appStateManager struct {
 	metricsServer         *metrics.MetricsServer
@@ -144,9 +160,33 @@ type appStateManager struct {
 	resourceTracking      argo.ResourceTracking
 	persistResourceHealth bool
 	repoErrorCache        goSync.Map
-	repoErrorGracePeriod  time.Duration
-	serverSideDiff        bool
-	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	// hookStartTimes maps the hooks of running sync operations to the time they were first observed running
+	hookStartTimes       goSync.Map
+	repoErrorGracePeriod time.Duration
+	serverSideDiff       bool
+	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	clock                clock.PassiveClock
+	// appLister lists the applications whose requests count towards the resource budgets of their project
+	appLister applisters.ApplicationLister
+}
+
+// AppStateManagerOpt configures optional behavior of the AppStateManager
+type AppStateManagerOpt func(*appStateManager)
+
+// WithApplicationLister sets the lister used to compute the resource budget usage of the other applications of a
+// project. Only the requests of the synced application are counted when not set.
+func WithApplicationLister(appLister applisters.ApplicationLister) AppStateManagerOpt {
+	return func(m *appStateManager) {
+		m.appLister = appLister
+	}
+}
+
+// WithClock sets the clock used to timestamp application conditions and revision history and to evaluate the repo
+// error grace period. Defaults to the real clock.
+func WithClock(clock clock.PassiveClock) AppStateManagerOpt {
+	return func(m *appStateManager) {
+		m.clock = clock
+	}
 }
 
 // EvaluateAppRevisionsChanges checks if any source revisions have changes without generating manifests.
@@ -221,6 +261,38 @@ func (m *appStateManager) EvaluateAppRevisionsChanges(ctx context.Context, app *
 	return hasChanges, resolvedRevisions, nil
 }
 
+// GetRevisionsCommitTime returns the most recent commit date among the given revisions of the application sources.
+// Only Git sources are considered, a zero time is returned if the application has no Git source.
+func (m *appStateManager) GetRevisionsCommitTime(ctx context.Context, app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string) (time.Time, error) {
+	var commitTime time.Time
+	conn, repoClient, err := m.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return commitTime, fmt.Errorf("failed to connect to repo server: %w", err)
+	}
+	defer utilio.Close(conn)
+
+	for i, source := range sources {
+		if source.IsHelm() || source.IsOCI() || i >= len(revisions) || revisions[i] == "" {
+			continue
+		}
+		repo, err := m.db.GetRepository(ctx, source.RepoURL, app.Spec.Project)
+		if err != nil {
+			return commitTime, fmt.Errorf("failed to get repo %q: %w", git.SanitizeRepoURL(source.RepoURL), err)
+		}
+		metadata, err := repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+			Repo:     repo,
+			Revision: revisions[i],
+		})
+		if err != nil {
+			return commitTime, fmt.Errorf("failed to get revision metadata for %s: %w", revisions[i], err)
+		}
+		if metadata != nil && metadata.Date != nil && metadata.Date.After(commitTime) {
+			commitTime = metadata.Date.Time
+		}
+	}
+	return commitTime, nil
+}
+
 // GetRepoObjs will generate the manifests for the given application delegating the
 // task to the repo-server. It returns the list of generated manifests as unstructured
 // objects. It also returns the full response from all calls to the repo server as the
@@ -391,7 +463,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 			}
 
 			log.Debugf("Generating Manifest for source %s revision %s", source, revision)
-			manifestInfo, err := repoClient.GenerateManifest(srcCtx, &apiclient.ManifestRequest{
+			manifestInfo, err := apiclient.GenerateManifestStreamed(srcCtx, repoClient, &apiclient.ManifestRequest{
 				Repo:                            repo,
 				Repos:                           repos,
 				Revision:                        revision,
@@ -399,6 +471,7 @@ func (m *appStateManager) GetRepoObjs(ctx context.Context, app *v1alpha1.Applica
 				NoRevisionCache:                 noRevisionCache,
 				AppLabelKey:                     appLabelKey,
 				AppName:                         app.InstanceName(m.namespace),
+				SourceEnvironment:               app.Labels[v1alpha1.LabelKeySourceEnvironment],
 				Namespace:                       appNamespace,
 				ApplicationSource:               &source,
 				KustomizeOptions:                kustomizeSettings,
@@ -513,6 +586,7 @@ func (m *appStateManager) evaluateRevisionChanges(ctx context.Context, app *v1al
 			Paths:              path.GetSourceRefreshPaths(app, source),
 			AppLabelKey:        appLabelKey,
 			AppName:            app.InstanceName(m.namespace),
+			SourceEnvironment:  app.Labels[v1alpha1.LabelKeySourceEnvironment],
 			Namespace:          app.Spec.Destination.Namespace,
 			ApplicationSource:  &source,
 			KubeVersion:        serverVersion,
@@ -652,6 +726,21 @@ func isManagedNamespace(ns *unstructured.Unstructured, app *v1alpha1.Application
 	return ns != nil && ns.GetKind() == kubeutil.NamespaceKind && ns.GetName() == app.Spec.Destination.Namespace && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil
 }
 
+// getLiveNamespace returns the live namespace from the cache of the destination cluster, or nil if the namespace does not
+// exist or is not watched by the cache
+func (m *appStateManager) getLiveNamespace(destCluster *v1alpha1.Cluster, name string) (*unstructured.Unstructured, error) {
+	clusterCache, err := m.liveStateCache.GetClusterCache(destCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster cache: %w", err)
+	}
+	for _, res := range clusterCache.FindResources("", func(r *clustercache.Resource) bool {
+		return r.Ref.Kind == kubeutil.NamespaceKind && r.Ref.Name == name && r.Ref.GroupVersionKind().Group == ""
+	}) {
+		return res.Resource, nil
+	}
+	return nil, nil
+}
+
 // partitionTargetObjsForSync returns the manifest subset passed to gitops-engine sync, and whether
 // the full manifest set declared PreDelete and/or PostDelete hooks (for finalizer handling).
 // Uses isPreDeleteHook / isPostDeleteHook / hasGitOpsEngineSyncPhaseHook from hook.go.
@@ -725,7 +814,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 	logCtx.Infof("Comparing app state (cluster: %s, namespace: %s)", app.Spec.Destination.Server, app.Spec.Destination.Namespace)
 
 	var targetObjs []*unstructured.Unstructured
-	now := metav1.Now()
+	now := metav1.NewTime(m.clock.Now())
 
 	var manifestInfos []*apiclient.ManifestResponse
 	targetNsExists := false
@@ -746,9 +835,17 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		if err != nil {
 			targetObjs = make([]*unstructured.Unstructured, 0)
 			msg := "Failed to load target state: " + err.Error()
-			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+			conditionType := v1alpha1.ApplicationConditionComparisonError
+			if status.Code(err) == codes.ResourceExhausted {
+				// the manifest generation exceeded the resource limits of the repository or of the plugin
+				conditionType = v1alpha1.ApplicationConditionManifestGenerationLimitError
+			} else if status.Code(err) == codes.InvalidArgument {
+				// the source is invalid, e.g. the helm values do not match the schema of the chart
+				conditionType = v1alpha1.ApplicationConditionInvalidSpecError
+			}
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: msg, LastTransitionTime: &now})
 			if firstSeen, ok := m.repoErrorCache.Load(app.Name); ok {
-				if time.Since(firstSeen.(time.Time)) <= m.repoErrorGracePeriod && !noRevisionCache {
+				if m.clock.Since(firstSeen.(time.Time)) <= m.repoErrorGracePeriod && !noRevisionCache {
 					// if first seen is less than grace period and it's not a Level 3 comparison,
 					// ignore error and short circuit
 					logCtx.Debugf("Ignoring repo error %v, already encountered error in grace period", err.Error())
@@ -756,7 +853,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 				}
 			} else if !noRevisionCache {
 				logCtx.Debugf("Ignoring repo error %v, new occurrence", err.Error())
-				m.repoErrorCache.Store(app.Name, time.Now())
+				m.repoErrorCache.Store(app.Name, m.clock.Now())
 				return nil, ErrCompareStateRepo
 			}
 			failedToLoadObjs = true
@@ -828,6 +925,9 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		failedToLoadObjs = true
 	}
+	if app.Spec.HasMultipleDestinations() {
+		liveObjByKey = m.removeOtherDestinationsLiveObjs(ctx, app, destCluster, targetObjs, liveObjByKey)
+	}
 
 	logCtx.Debugf("Retrieved live manifests")
 	// filter out all resources which are not permitted in the application project
@@ -851,6 +951,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		}
 	}
 
+	liveNsTracked := false
 	for _, liveObj := range liveObjByKey {
 		if liveObj != nil {
 			appInstanceName := m.resourceTracking.GetAppName(liveObj, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID)
@@ -876,6 +977,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 			// targetNsExists == true implies that it already exists as a target, so no need to add the namespace to the
 			// targetObjs array.
 			if isManagedNamespace(liveObj, app) && !targetNsExists {
+				liveNsTracked = true
 				nsSpec := &corev1.Namespace{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: kubeutil.NamespaceKind}, ObjectMeta: metav1.ObjectMeta{Name: liveObj.GetName()}}
 				managedNs, err := kubeutil.ToUnstructured(nsSpec)
 				if err != nil {
@@ -895,6 +997,24 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 			}
 		}
 	}
+
+	// A namespace created with CreateNamespace=true is usually not tracked as a resource of the application, so the drift
+	// of its managed metadata is not caught by the diff of the managed resources and has to be checked separately.
+	managedNsDrift := false
+	if !targetNsExists && !liveNsTracked && !failedToLoadObjs && app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.ManagedNamespaceMetadata != nil &&
+		app.Spec.SyncPolicy.SyncOptions.HasOption("CreateNamespace=true") {
+		liveNs, err := m.getLiveNamespace(destCluster, app.Spec.Destination.Namespace)
+		if err != nil {
+			logCtx.Warnf("Failed to get live managed namespace %s: %v", app.Spec.Destination.Namespace, err)
+		} else if drift := getManagedNamespaceMetadataDrift(app.Spec.SyncPolicy, liveNs); len(drift) > 0 {
+			managedNsDrift = true
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionManagedNamespaceMetadataWarning,
+				Message:            fmt.Sprintf("Metadata of managed namespace %s has drifted: %s", liveNs.GetName(), strings.Join(drift, ", ")),
+				LastTransitionTime: &now,
+			})
+		}
+	}
 	targetObjsForSync, hasPreDeleteHooks, hasPostDeleteHooks := partitionTargetObjsForSync(targetObjs)
 
 	reconciliation := sync.Reconcile(targetObjsForSync, liveObjByKey, app.Spec.Destination.Namespace, infoProvider)
@@ -922,8 +1042,24 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	useDiffCache := useDiffCache(noCache, manifestInfos, sources, app, manifestRevisions, m.statusRefreshTimeout, serverSideDiff, logCtx)
 
+	// the status is unknown if the ignore differences of the project cannot be loaded, so that the ignored fields are not
+	// reverted by an automated sync
+	ignoreDifferences, err := argo.GetIgnoreDifferences(&app.Spec, project, m.settingsMgr)
+	if err != nil {
+		ignoreDifferences = app.Spec.IgnoreDifferences
+		failedToLoadObjs = true
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+
+	driftExclusions, err := argo.GetDriftExclusions(project, m.settingsMgr)
+	if err != nil {
+		failedToLoadObjs = true
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+	}
+
 	diffConfigBuilder := argodiff.NewDiffConfigBuilder().
-		WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
+		WithDiffSettings(ignoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, m.ignoreNormalizerOpts).
+		WithDriftExclusions(driftExclusions).
 		WithTracking(appLabelKey, string(trackingMethod))
 
 	if useDiffCache {
@@ -941,7 +1077,11 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionUnknownError, Message: err.Error(), LastTransitionTime: &now})
 	}
 	diffConfigBuilder.WithGVKParser(gvkParser)
-	diffConfigBuilder.WithManager(common.ArgoCDSSAManager)
+	var syncOptions v1alpha1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+	diffConfigBuilder.WithManager(syncOptions.GetServerSideApplyManager())
 
 	diffConfigBuilder.WithServerSideDiff(serverSideDiff)
 
@@ -983,6 +1123,8 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 	ts.AddCheckpoint("diff_ms")
 
 	syncCode := v1alpha1.SyncStatusCodeSynced
+	// contentOutOfSync is set when the application is OutOfSync because of other resources than the ones requiring pruning
+	contentOutOfSync := false
 	managedResources := make([]managedResource, len(reconciliation.Target))
 	resourceSummaries := make([]v1alpha1.ResourceStatus, len(reconciliation.Target))
 	for i, targetObj := range reconciliation.Target {
@@ -1051,6 +1193,9 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 			needsPruning := targetObj == nil && liveObj != nil
 			if !needsPruning || !resourceutil.HasAnnotationOption(obj, common.AnnotationCompareOptions, "IgnoreExtraneous") {
 				syncCode = v1alpha1.SyncStatusCodeOutOfSync
+				if !needsPruning {
+					contentOutOfSync = true
+				}
 			}
 		default:
 			resState.Status = v1alpha1.SyncStatusCodeSynced
@@ -1091,11 +1236,13 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	if failedToLoadObjs {
 		syncCode = v1alpha1.SyncStatusCodeUnknown
-	} else if app.HasChangedManagedNamespaceMetadata() {
+	} else if app.HasChangedManagedNamespaceMetadata() || managedNsDrift {
 		syncCode = v1alpha1.SyncStatusCodeOutOfSync
+		contentOutOfSync = true
 	}
 
 	syncStatus.Status = syncCode
+	syncStatus.PruneOnly = syncCode == v1alpha1.SyncStatusCodeOutOfSync && !contentOutOfSync
 
 	// Update the initial revision to the resolved manifest SHA
 	if hasMultipleSources {
@@ -1106,7 +1253,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, project, m.persistResourceHealth)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1147,6 +1294,7 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		managedResources:        managedResources,
 		reconciliationResult:    reconciliation,
 		diffConfig:              diffConfig,
+		ignoreDifferences:       ignoreDifferences,
 		diffResultList:          diffResults,
 		hasPostDeleteHooks:      hasPostDeleteHooks,
 		hasPreDeleteHooks:       hasPreDeleteHooks,
@@ -1164,11 +1312,14 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		}
 	}
 
+	setTransientConditionExpiry(conditions, time.Now())
 	app.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:         true,
-		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
-		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
-		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionComparisonError:                 true,
+		v1alpha1.ApplicationConditionManifestGenerationLimitError:    true,
+		v1alpha1.ApplicationConditionSharedResourceWarning:           true,
+		v1alpha1.ApplicationConditionRepeatedResourceWarning:         true,
+		v1alpha1.ApplicationConditionExcludedResourceWarning:         true,
+		v1alpha1.ApplicationConditionManagedNamespaceMetadataWarning: true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -1266,7 +1417,7 @@ func (m *appStateManager) persistRevisionHistory(
 
 	if hasMultipleSources {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
+			DeployedAt:      metav1.NewTime(m.clock.Now().UTC()),
 			DeployStartedAt: &startedAt,
 			ID:              nextID,
 			Sources:         sources,
@@ -1276,7 +1427,7 @@ func (m *appStateManager) persistRevisionHistory(
 	} else {
 		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
 			Revision:        revision,
-			DeployedAt:      metav1.NewTime(time.Now().UTC()),
+			DeployedAt:      metav1.NewTime(m.clock.Now().UTC()),
 			DeployStartedAt: &startedAt,
 			ID:              nextID,
 			Source:          source,
@@ -1316,8 +1467,9 @@ func NewAppStateManager(
 	repoErrorGracePeriod time.Duration,
 	serverSideDiff bool,
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+	opts ...AppStateManagerOpt,
 ) AppStateManager {
-	return &appStateManager{
+	m := &appStateManager{
 		liveStateCache:        liveStateCache,
 		cache:                 cache,
 		db:                    db,
@@ -1334,7 +1486,12 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		clock:                 clock.RealClock{},
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
 // isSelfReferencedObj returns whether the given obj is managed by the application
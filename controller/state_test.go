@@ -18,6 +18,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
@@ -88,6 +90,34 @@ func TestCompareAppStateRepoError(t *testing.T) {
 	assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
 }
 
+// TestCompareAppStateManifestGenerationLimitError tests the case when the manifest generation exceeds its resource limits
+func TestCompareAppStateManifestGenerationLimitError(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{manifestResponses: make([]*apiclient.ManifestResponse, 1)}, status.Error(codes.ResourceExhausted, "`helm` exceeded its cpu time limit of 30s"))
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+	compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, revisions, sources, false, true, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionManifestGenerationLimitError, app.Status.Conditions[0].Type)
+	assert.Contains(t, app.Status.Conditions[0].Message, "exceeded its cpu time limit of 30s")
+}
+
+// TestCompareAppStateInvalidSpecError tests the case when the repo server rejects the source of the application
+func TestCompareAppStateInvalidSpecError(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{manifestResponses: make([]*apiclient.ManifestResponse, 1)}, status.Error(codes.InvalidArgument, "helm values do not match the values.schema.json file of the chart:\n- at '/replicaCount': got string, want integer"))
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+	compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, revisions, sources, false, true, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionInvalidSpecError, app.Status.Conditions[0].Type)
+	assert.Contains(t, app.Status.Conditions[0].Message, "at '/replicaCount': got string, want integer")
+}
+
 // TestCompareAppStateNamespaceMetadataDiffers tests comparison when managed namespace metadata differs
 func TestCompareAppStateNamespaceMetadataDiffers(t *testing.T) {
 	app := newFakeApp()
@@ -293,6 +323,75 @@ func TestCompareAppStateNamespaceMetadataIsTheSame(t *testing.T) {
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestCompareAppStateManagedNamespaceMetadataDrift tests comparison when the live metadata of a namespace created with
+// managed namespace metadata has drifted
+func TestCompareAppStateManagedNamespaceMetadataDrift(t *testing.T) {
+	newApp := func() *v1alpha1.Application {
+		app := newFakeApp()
+		app.Spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"CreateNamespace=true"}
+		app.Spec.SyncPolicy.ManagedNamespaceMetadata = &v1alpha1.ManagedNamespaceMetadata{
+			Labels:      map[string]string{"foo": "bar"},
+			Annotations: map[string]string{"foo": "bar"},
+		}
+		app.Status.OperationState = &v1alpha1.OperationState{
+			SyncResult: &v1alpha1.SyncOperationResult{
+				ManagedNamespaceMetadata: app.Spec.SyncPolicy.ManagedNamespaceMetadata.DeepCopy(),
+			},
+		}
+		return app
+	}
+	newLiveNs := func(labels map[string]string) *unstructured.Unstructured {
+		ns := NewNamespace()
+		ns.SetName(test.FakeDestNamespace)
+		ns.SetLabels(labels)
+		ns.SetAnnotations(map[string]string{"foo": "bar", "other": "value"})
+		return ns
+	}
+	compareAppState := func(t *testing.T, app *v1alpha1.Application, liveNs *unstructured.Unstructured) *comparisonResult {
+		t.Helper()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs:  make(map[kube.ResourceKey]*unstructured.Unstructured),
+			clusterResources: []*unstructured.Unstructured{liveNs},
+		}
+		ctrl := newFakeController(t.Context(), &data, nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, []string{""}, []v1alpha1.ApplicationSource{app.Spec.GetSource()}, false, false, nil, false)
+		require.NoError(t, err)
+		return compRes
+	}
+
+	t.Run("Drifted", func(t *testing.T) {
+		app := newApp()
+		compRes := compareAppState(t, app, newLiveNs(map[string]string{"foo": "baz"}))
+		assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+		assert.Empty(t, compRes.managedResources)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionManagedNamespaceMetadataWarning, app.Status.Conditions[0].Type)
+		assert.Equal(t, `Metadata of managed namespace fake-dest-ns has drifted: label "foo" is "baz" instead of "bar"`, app.Status.Conditions[0].Message)
+	})
+
+	t.Run("Restored", func(t *testing.T) {
+		app := newApp()
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionManagedNamespaceMetadataWarning}}
+		compRes := compareAppState(t, app, newLiveNs(map[string]string{"foo": "bar"}))
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, app.Status.Conditions)
+	})
+
+	t.Run("NamespaceNotCreated", func(t *testing.T) {
+		app := newApp()
+		app.Spec.SyncPolicy.SyncOptions = nil
+		compRes := compareAppState(t, app, newLiveNs(nil))
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Empty(t, app.Status.Conditions)
+	})
+}
+
 // TestCompareAppStateMissing tests when there is a manifest defined in the repo which doesn't exist in live
 func TestCompareAppStateMissing(t *testing.T) {
 	app := newFakeApp()
@@ -316,6 +415,7 @@ func TestCompareAppStateMissing(t *testing.T) {
 	assert.NotNil(t, compRes)
 	assert.NotNil(t, compRes.syncStatus)
 	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.False(t, compRes.syncStatus.PruneOnly)
 	assert.Len(t, compRes.resources, 1)
 	assert.Len(t, compRes.managedResources, 1)
 	assert.Empty(t, app.Status.Conditions)
@@ -347,11 +447,78 @@ func TestCompareAppStateExtra(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, compRes)
 	assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	assert.True(t, compRes.syncStatus.PruneOnly)
 	assert.Len(t, compRes.resources, 1)
 	assert.Len(t, compRes.managedResources, 1)
 	assert.Empty(t, app.Status.Conditions)
 }
 
+// TestCompareAppStateProjectIgnoreDifferences checks that the ignore differences referenced by the project are applied
+func TestCompareAppStateProjectIgnoreDifferences(t *testing.T) {
+	newData := func(cmData map[string]string) *fakeData {
+		target := NewPod()
+		target.SetNamespace(test.FakeDestNamespace)
+		target.SetAnnotations(map[string]string{"injected": "false"})
+		live := target.DeepCopy()
+		live.SetAnnotations(map[string]string{"injected": "true"})
+		data := &fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests: []string{toJSON(t, target)},
+				Namespace: test.FakeDestNamespace,
+				Server:    test.FakeClusterURL,
+				Revision:  "abc123",
+			},
+			managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{
+				kube.GetResourceKey(live): live,
+			},
+		}
+		if cmData != nil {
+			data.additionalObjs = []runtime.Object{&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "platform-ignore-differences",
+					Namespace: test.FakeArgoCDNamespace,
+					Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+				},
+				Data: cmData,
+			}}
+		}
+		return data
+	}
+	proj := defaultProj.DeepCopy()
+	proj.Spec.IgnoreDifferencesRef = &v1alpha1.ConfigMapKeyRef{ConfigMapName: "platform-ignore-differences", Key: "ignoreDifferences"}
+
+	t.Run("Ignored", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(t.Context(), newData(map[string]string{
+			"ignoreDifferences": `[{"kind": "Pod", "jsonPointers": ["/metadata/annotations/injected"]}]`,
+		}), nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, proj, []string{""}, app.Spec.GetSources(), false, false, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		assert.Len(t, compRes.ignoreDifferences, 1)
+		assert.Empty(t, app.Status.Conditions)
+	})
+
+	t.Run("NotIgnored", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(t.Context(), newData(nil), nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, []string{""}, app.Spec.GetSources(), false, false, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeOutOfSync, compRes.syncStatus.Status)
+	})
+
+	t.Run("MissingConfigMap", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(t.Context(), newData(nil), nil)
+		compRes, err := ctrl.appStateManager.CompareAppState(t.Context(), app, proj, []string{""}, app.Spec.GetSources(), false, false, nil, false)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeUnknown, compRes.syncStatus.Status)
+		require.Len(t, app.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionComparisonError, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, `error getting ignore differences config map "platform-ignore-differences"`)
+	})
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
@@ -20,7 +21,9 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	jsonpatch "github.com/evanphx/json-patch"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	otel_codes "go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -121,6 +124,15 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		}
 		span.End()
 	}()
+	if app.Spec.HasMultipleDestinations() {
+		m.syncDestinations(ctx, app, project, state)
+		return
+	}
+	m.syncAppState(ctx, app, project, state)
+}
+
+// syncAppState syncs the application to its destination
+func (m *appStateManager) syncAppState(ctx context.Context, app *v1alpha1.Application, project *v1alpha1.AppProject, state *v1alpha1.OperationState) {
 	syncId, err := syncid.Generate()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -137,7 +149,9 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 
 	syncOp := *state.Operation.Sync
 
-	if state.SyncResult == nil {
+	// the sync has not started yet, or is retried
+	newSync := state.SyncResult == nil
+	if newSync {
 		state.SyncResult = newSyncOperationResult(app, syncOp)
 	}
 
@@ -172,6 +186,12 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	// what we should be syncing to when resuming operations.
 	state.SyncResult.Revision = compareResult.syncStatus.Revision
 	state.SyncResult.Revisions = compareResult.syncStatus.Revisions
+	if span := oteltrace.SpanFromContext(ctx); span.IsRecording() {
+		span.SetAttributes(
+			attribute.String("argocd.revision", compareResult.syncStatus.Revision),
+			attribute.StringSlice("argocd.revisions", compareResult.syncStatus.Revisions),
+		)
+	}
 
 	// validates if it should fail the sync on that revision if it finds shared resources
 	hasSharedResource, sharedResourceMessage := hasSharedResourceCondition(app)
@@ -183,20 +203,49 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 
 	// If there are any comparison or spec errors error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:              true,
+		v1alpha1.ApplicationConditionManifestGenerationLimitError: true,
+		v1alpha1.ApplicationConditionInvalidSpecError:             true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
 		return
 	}
 
+	if syncOp.SyncOptions.HasOption("CreateNamespace=true") && !project.IsNamespaceCreationPermitted() {
+		state.Phase = common.OperationFailed
+		state.Message = fmt.Sprintf("Project %s does not permit the creation of the destination namespace with the CreateNamespace=true sync option", project.Name)
+		return
+	}
+
 	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, m.db)
 	if err != nil {
 		state.Phase = common.OperationError
 		state.Message = fmt.Sprintf("Failed to get destination cluster: %v", err)
 		return
 	}
+	// the sync of the destination has not started yet: the resources of the sync result are reset before syncing each
+	// of the next destinations of an application with multiple destinations
+	newDestinationSync := newSync || (app.Spec.HasMultipleDestinations() && len(state.SyncResult.Resources) == 0)
+	if destCluster.Cordoned && newDestinationSync {
+		state.Phase = common.OperationFailed
+		state.Message = fmt.Sprintf("Cluster '%s' is cordoned and does not accept new syncs", destCluster.Server)
+		return
+	}
+
+	if len(project.Spec.ResourceBudgets) > 0 {
+		budgetReport, err := m.checkResourceBudgets(ctx, app, project, destCluster, compareResult.reconciliationResult.Target)
+		if err != nil {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Failed to evaluate resource budgets: %v", err)
+			return
+		}
+		if budgetReport != "" {
+			state.Phase = common.OperationFailed
+			state.Message = budgetReport
+			return
+		}
+	}
 
 	rawConfig, err := destCluster.RawRestConfig()
 	if err != nil {
@@ -213,6 +262,9 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	}
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clusterRESTConfig)
 
+	m.metricsServer.ObserveSyncPhaseDuration(app, destCluster.Server, metrics.SyncPhaseGenerate, compareResult.timings["git_ms"])
+	m.metricsServer.ObserveSyncPhaseDuration(app, destCluster.Server, metrics.SyncPhaseDiff, compareResult.timings["diff_ms"])
+
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		state.Phase = common.OperationError
@@ -230,9 +282,24 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			HookPhase:   res.HookPhase,
 			HookType:    res.HookType,
 			SyncPhase:   res.SyncPhase,
+			SyncWave:    int(res.SyncWave),
 			Version:     res.Version,
 			Images:      res.Images,
 			Order:       i + 1,
+			Stuck:       res.Stuck,
+		}
+		if res.RunningSince != nil {
+			initialResourcesRes[i].RunningSince = *res.RunningSince
+		}
+	}
+
+	var resourceTimeout time.Duration
+	if value := syncOp.SyncOptions.GetOptionValue(common.SyncOptionResourceTimeout); value != nil {
+		resourceTimeout, err = time.ParseDuration(*value)
+		if err != nil || resourceTimeout < 0 {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Invalid value of the %s sync option: %q", common.SyncOptionResourceTimeout, *value)
+			return
 		}
 	}
 
@@ -352,7 +419,8 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		sync.WithPrunePropagationPolicy(&prunePropagationPolicy),
 		sync.WithReplace(syncOp.SyncOptions.HasOption(common.SyncOptionReplace)),
 		sync.WithServerSideApply(syncOp.SyncOptions.HasOption(common.SyncOptionServerSideApply)),
-		sync.WithServerSideApplyManager(cdcommon.ArgoCDSSAManager),
+		sync.WithServerSideApplyManager(syncOp.SyncOptions.GetServerSideApplyManager()),
+		sync.WithServerSideApplyForceConflicts(!syncOp.SyncOptions.HasOption(common.SyncOptionDisableServerSideApplyForceConflicts)),
 		sync.WithClientSideApplyMigration(
 			!syncOp.SyncOptions.HasOption(common.SyncOptionDisableClientSideApplyMigration),
 			clientSideApplyManager,
@@ -360,10 +428,11 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
 		sync.WithDefaultPruneOption(syncOp.SyncOptions.GetOptionValue(common.SyncOptionPrune)),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		sync.WithResourceTimeout(resourceTimeout, syncOp.SyncOptions.HasOption(common.SyncOptionSkipStuckResources)),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
-		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(app.Spec.SyncPolicy)))
+		opts = append(opts, sync.WithNamespaceModifier(syncNamespace(namespaceSyncPolicy(app, project))))
 	}
 
 	syncCtx, cleanup, err := sync.NewSyncContext(
@@ -389,10 +458,13 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		syncCtx.Terminate(ctx)
 	} else {
 		syncCtx.Sync(ctx)
+		m.metricsServer.ObserveSyncPhaseDuration(app, destCluster.Server, metrics.SyncPhaseApply, time.Since(start))
 	}
 	var resState []common.ResourceSyncResult
 	state.Phase, state.Message, resState = syncCtx.GetState()
+	m.observeHookDurations(app, destCluster.Server, state, resState)
 	state.SyncResult.Resources = nil
+	state.StuckResources = nil
 
 	if app.Spec.SyncPolicy != nil {
 		state.SyncResult.ManagedNamespaceMetadata = app.Spec.SyncPolicy.ManagedNamespaceMetadata
@@ -416,24 +488,48 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			res.Message = augmentedMsg
 		}
 
+		var runningSince *metav1.Time
+		if !res.RunningSince.IsZero() {
+			runningSince = res.RunningSince.DeepCopy()
+		}
+
 		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
-			HookType:  res.HookType,
-			Group:     res.ResourceKey.Group,
-			Kind:      res.ResourceKey.Kind,
-			Namespace: res.ResourceKey.Namespace,
-			Name:      res.ResourceKey.Name,
-			Version:   res.Version,
-			SyncPhase: res.SyncPhase,
-			HookPhase: res.HookPhase,
-			Status:    res.Status,
-			Message:   res.Message,
-			Images:    res.Images,
+			HookType:               res.HookType,
+			Group:                  res.ResourceKey.Group,
+			Kind:                   res.ResourceKey.Kind,
+			Namespace:              res.ResourceKey.Namespace,
+			Name:                   res.ResourceKey.Name,
+			Version:                res.Version,
+			SyncPhase:              res.SyncPhase,
+			SyncWave:               int64(res.SyncWave),
+			HookPhase:              res.HookPhase,
+			Status:                 res.Status,
+			Message:                res.Message,
+			Images:                 res.Images,
+			ServerSideApplyManager: res.ServerSideApplyManager,
+			ForceConflicts:         res.ForceConflicts,
+			RunningSince:           runningSince,
+			Stuck:                  res.Stuck,
 		})
+		if res.Stuck {
+			state.StuckResources = append(state.StuckResources, v1alpha1.StuckResource{
+				Group:     res.ResourceKey.Group,
+				Kind:      res.ResourceKey.Kind,
+				Namespace: res.ResourceKey.Namespace,
+				Name:      res.ResourceKey.Name,
+				SyncPhase: res.SyncPhase,
+				SyncWave:  int64(res.SyncWave),
+				Message:   res.Message,
+				Since:     runningSince,
+				Skipped:   res.HookPhase.Successful(),
+			})
+		}
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
 
-	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() {
+	// the sync of an application with multiple destinations is recorded once all the destinations are synced
+	if !syncOp.DryRun && len(syncOp.Resources) == 0 && state.Phase.Successful() && isSyncingLastDestination(app, state) {
 		err := m.persistRevisionHistory(app, compareResult.syncStatus.Revision, compareResult.syncStatus.ComparedTo.Source, compareResult.syncStatus.Revisions, compareResult.syncStatus.ComparedTo.Sources, isMultiSourceSync, state.StartedAt, state.Operation.InitiatedBy)
 		if err != nil {
 			state.Phase = common.OperationError
@@ -442,6 +538,37 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 	}
 }
 
+// observeHookDurations reports the duration of the hooks of the given sync results which completed since the last
+// sync. Hooks are timed from the sync in which they were first observed running, so hooks which complete within a
+// single sync, or whose start was observed by another controller instance, are not reported.
+func (m *appStateManager) observeHookDurations(app *v1alpha1.Application, destServer string, state *v1alpha1.OperationState, resState []common.ResourceSyncResult) {
+	prefix := fmt.Sprintf("%s/%s/", app.QualifiedName(), state.StartedAt.UTC().Format(time.RFC3339))
+	now := m.clock.Now()
+	for _, res := range resState {
+		if res.HookType == "" {
+			continue
+		}
+		key := fmt.Sprintf("%s%s/%s", prefix, res.SyncPhase, res.ResourceKey.String())
+		switch {
+		case res.HookPhase.Running():
+			m.hookStartTimes.LoadOrStore(key, now)
+		case res.HookPhase.Completed():
+			if started, ok := m.hookStartTimes.LoadAndDelete(key); ok {
+				m.metricsServer.ObserveSyncPhaseDuration(app, destServer, metrics.SyncPhaseHook, now.Sub(started.(time.Time)))
+			}
+		}
+	}
+	if state.Phase.Completed() {
+		// forget the hooks which are left running by a failed or terminated operation
+		m.hookStartTimes.Range(func(key, _ any) bool {
+			if strings.HasPrefix(key.(string), prefix) {
+				m.hookStartTimes.Delete(key)
+			}
+			return true
+		})
+	}
+}
+
 // normalizeTargetResources modifies target resources to ensure ignored fields are not touched during synchronization:
 //   - applies normalization to the target resources based on the live resources
 //   - copies ignored fields from the matching live resources: apply normalizer to the live resource,
@@ -678,7 +805,7 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 }
 
 func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+	window := proj.MatchingSyncWindows(app)
 	isManual := false
 	var operationStartTime *time.Time
 	if app.Status.OperationState != nil {
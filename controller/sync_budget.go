@@ -0,0 +1,284 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// budgetedResources are the resources whose requests are limited by the resource budgets of a project
+var budgetedResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// appResourceRequests are the total requests of the workloads of an application in a destination
+type appResourceRequests struct {
+	app         string
+	destination appv1.ApplicationDestination
+	requests    corev1.ResourceList
+}
+
+// podSpecPath returns the path of the pod spec of the given workload, and the number of pods the workload runs. DaemonSets
+// are counted as a single pod, as the number of nodes they are scheduled on is not known from the manifests.
+func podSpecPath(obj *unstructured.Unstructured) ([]string, int64, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	switch {
+	case gk.Group == "" && gk.Kind == kube.PodKind:
+		return []string{"spec"}, 1, nil
+	case gk.Group == "" && gk.Kind == "ReplicationController",
+		gk.Group == "apps" && (gk.Kind == kube.DeploymentKind || gk.Kind == kube.ReplicaSetKind || gk.Kind == kube.StatefulSetKind):
+		replicas, err := nestedInt64OrDefault(obj, 1, "spec", "replicas")
+		return []string{"spec", "template", "spec"}, replicas, err
+	case gk.Group == "apps" && gk.Kind == kube.DaemonSetKind:
+		return []string{"spec", "template", "spec"}, 1, nil
+	case gk.Group == "batch" && gk.Kind == kube.JobKind:
+		parallelism, err := nestedInt64OrDefault(obj, 1, "spec", "parallelism")
+		return []string{"spec", "template", "spec"}, parallelism, err
+	case gk.Group == "batch" && gk.Kind == "CronJob":
+		parallelism, err := nestedInt64OrDefault(obj, 1, "spec", "jobTemplate", "spec", "parallelism")
+		return []string{"spec", "jobTemplate", "spec", "template", "spec"}, parallelism, err
+	}
+	return nil, 0, nil
+}
+
+func nestedInt64OrDefault(obj *unstructured.Unstructured, defaultValue int64, fields ...string) (int64, error) {
+	val, found, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil || !found || val == nil {
+		return defaultValue, err
+	}
+	switch v := val.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	}
+	return 0, fmt.Errorf("%s of %s/%s is not an integer", strings.Join(fields, "."), obj.GetKind(), obj.GetName())
+}
+
+// containerRequests returns the requests of a container, which default to its limits like in Kubernetes
+func containerRequests(container corev1.Container) corev1.ResourceList {
+	res := corev1.ResourceList{}
+	for _, name := range budgetedResources {
+		if q, ok := container.Resources.Requests[name]; ok {
+			res[name] = q
+		} else if q, ok := container.Resources.Limits[name]; ok {
+			res[name] = q
+		}
+	}
+	return res
+}
+
+func addRequests(total corev1.ResourceList, requests corev1.ResourceList) {
+	for name, q := range requests {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+}
+
+// podRequests returns the effective requests of a pod: the maximum of the sum of its containers and sidecars, and of
+// each of its init containers, plus its overhead
+func podRequests(spec corev1.PodSpec) corev1.ResourceList {
+	res := corev1.ResourceList{}
+	for _, container := range spec.Containers {
+		addRequests(res, containerRequests(container))
+	}
+	for _, container := range spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			addRequests(res, containerRequests(container))
+		}
+	}
+	for _, container := range spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			continue
+		}
+		for name, q := range containerRequests(container) {
+			if current, ok := res[name]; !ok || q.Cmp(current) > 0 {
+				res[name] = q
+			}
+		}
+	}
+	for _, name := range budgetedResources {
+		if q, ok := spec.Overhead[name]; ok {
+			addRequests(res, corev1.ResourceList{name: q})
+		}
+	}
+	return res
+}
+
+// workloadRequests returns the total CPU and memory requests of the pods run by the given objects
+func workloadRequests(objs []*unstructured.Unstructured) (corev1.ResourceList, error) {
+	total := corev1.ResourceList{}
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		path, pods, err := podSpecPath(obj)
+		if err != nil {
+			return nil, err
+		}
+		if path == nil || pods <= 0 {
+			continue
+		}
+		specObj, found, err := unstructured.NestedMap(obj.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+		var spec corev1.PodSpec
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(specObj, &spec); err != nil {
+			return nil, fmt.Errorf("failed to read the pod spec of %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		for name, q := range podRequests(spec) {
+			q.Mul(pods)
+			addRequests(total, corev1.ResourceList{name: q})
+		}
+	}
+	return total, nil
+}
+
+// resourceBudgetViolations returns a description of every resource budget of the project which would be exceeded once
+// the application is synced with the given requests, given the requests of the other applications of the project
+func resourceBudgetViolations(proj *appv1.AppProject, app appResourceRequests, others []appResourceRequests) []string {
+	var violations []string
+	for i := range proj.Spec.ResourceBudgets {
+		budget := &proj.Spec.ResourceBudgets[i]
+		if !budget.Matches(app.destination) {
+			continue
+		}
+		var contributors []appResourceRequests
+		for _, other := range others {
+			if budget.Matches(other.destination) {
+				contributors = append(contributors, other)
+			}
+		}
+		for _, name := range budgetedResources {
+			var limit *resource.Quantity
+			switch name {
+			case corev1.ResourceCPU:
+				limit = budget.CPU
+			case corev1.ResourceMemory:
+				limit = budget.Memory
+			}
+			if limit == nil {
+				continue
+			}
+			total := app.requests.Name(name, resource.DecimalSI).DeepCopy()
+			for _, other := range contributors {
+				total.Add(*other.requests.Name(name, resource.DecimalSI))
+			}
+			if total.Cmp(*limit) <= 0 {
+				continue
+			}
+			sort.SliceStable(contributors, func(i, j int) bool {
+				return contributors[i].requests.Name(name, resource.DecimalSI).Cmp(*contributors[j].requests.Name(name, resource.DecimalSI)) > 0
+			})
+			details := []string{"this application: " + app.requests.Name(name, resource.DecimalSI).String()}
+			for _, other := range contributors {
+				details = append(details, fmt.Sprintf("%s: %s", other.app, other.requests.Name(name, resource.DecimalSI).String()))
+			}
+			violations = append(violations, fmt.Sprintf("%s requests of %s exceed the budget of %s for destination '%s' (%s)",
+				name, total.String(), limit.String(), budget.DestinationString(), strings.Join(details, ", ")))
+		}
+	}
+	return violations
+}
+
+// checkResourceBudgets verifies that syncing the given target objects of the application does not exceed the resource
+// budgets of its project. The requests of the other applications of the project are computed from their live objects,
+// and the applications whose live objects cannot be retrieved are ignored with a warning.
+// It returns a detailed report of the exceeded budgets, or an empty string if none is exceeded.
+func (m *appStateManager) checkResourceBudgets(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, destCluster *appv1.Cluster, targetObjs []*unstructured.Unstructured) (string, error) {
+	dest := appv1.ApplicationDestination{Server: destCluster.Server, Name: destCluster.Name, Namespace: app.Spec.Destination.Namespace}
+	matched := false
+	for i := range proj.Spec.ResourceBudgets {
+		if proj.Spec.ResourceBudgets[i].Matches(dest) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return "", nil
+	}
+
+	requests, err := workloadRequests(targetObjs)
+	if err != nil {
+		return "", err
+	}
+	appRequests := appResourceRequests{app: app.QualifiedName(), destination: dest, requests: requests}
+
+	var others []appResourceRequests
+	if m.appLister != nil {
+		apps, err := m.appLister.List(labels.Everything())
+		if err != nil {
+			return "", fmt.Errorf("error listing applications: %w", err)
+		}
+		sort.Slice(apps, func(i, j int) bool {
+			return apps[i].QualifiedName() < apps[j].QualifiedName()
+		})
+		for _, other := range apps {
+			if other.Spec.GetProject() != proj.Name || other.QualifiedName() == app.QualifiedName() {
+				continue
+			}
+			otherRequests, err := m.otherAppResourceRequests(ctx, proj, dest, other)
+			if err != nil {
+				// an application whose requests cannot be computed, e.g. because its cluster is unavailable, must not
+				// prevent every other application of the project from syncing
+				log.WithFields(applog.GetAppLogFields(app)).Warnf("Ignoring application %s when evaluating the resource budgets of project %s: %v", other.QualifiedName(), proj.Name, err)
+				continue
+			}
+			if otherRequests != nil {
+				others = append(others, *otherRequests)
+			}
+		}
+	}
+
+	violations := resourceBudgetViolations(proj, appRequests, others)
+	if len(violations) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("Resource budget of project '%s' exceeded: %s", proj.Name, strings.Join(violations, "; ")), nil
+}
+
+// otherAppResourceRequests returns the requests of the live objects of another application of the project, or nil if
+// its destination is not subject to the same resource budgets as the given destination
+func (m *appStateManager) otherAppResourceRequests(ctx context.Context, proj *appv1.AppProject, dest appv1.ApplicationDestination, other *appv1.Application) (*appResourceRequests, error) {
+	otherCluster, err := argo.GetDestinationCluster(ctx, other.Spec.Destination, m.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	otherDest := appv1.ApplicationDestination{Server: otherCluster.Server, Name: otherCluster.Name, Namespace: other.Spec.Destination.Namespace}
+	otherMatched := false
+	for i := range proj.Spec.ResourceBudgets {
+		if proj.Spec.ResourceBudgets[i].Matches(dest) && proj.Spec.ResourceBudgets[i].Matches(otherDest) {
+			otherMatched = true
+			break
+		}
+	}
+	if !otherMatched {
+		return nil, nil
+	}
+	liveObjs, err := m.liveStateCache.GetManagedLiveObjs(otherCluster, other, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting live objects: %w", err)
+	}
+	objs := make([]*unstructured.Unstructured, 0, len(liveObjs))
+	for _, obj := range liveObjs {
+		objs = append(objs, obj)
+	}
+	otherRequests, err := workloadRequests(objs)
+	if err != nil {
+		return nil, err
+	}
+	return &appResourceRequests{app: other.QualifiedName(), destination: otherDest, requests: otherRequests}, nil
+}
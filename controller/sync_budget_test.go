@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestWorkloadRequests(t *testing.T) {
+	deployment := test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: 3
+  template:
+    spec:
+      initContainers:
+      - name: migrate
+        resources:
+          requests:
+            cpu: "2"
+      - name: proxy
+        restartPolicy: Always
+        resources:
+          requests:
+            cpu: 100m
+            memory: 64Mi
+      containers:
+      - name: app
+        resources:
+          requests:
+            cpu: 200m
+          limits:
+            memory: 128Mi
+`)
+	cronJob := test.YamlToUnstructured(`
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: report
+spec:
+  jobTemplate:
+    spec:
+      parallelism: 2
+      template:
+        spec:
+          containers:
+          - name: report
+            resources:
+              requests:
+                cpu: 500m
+                memory: 1Gi
+`)
+	configMap := test.YamlToUnstructured(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`)
+
+	requests, err := workloadRequests([]*unstructured.Unstructured{deployment, cronJob, configMap, nil})
+	require.NoError(t, err)
+	// deployment: 3 * max(200m + 100m, 2) cpu and 3 * (128Mi + 64Mi) memory, cron job: 2 * 500m cpu and 2 * 1Gi memory
+	assert.Equal(t, "7", requests.Cpu().String())
+	assert.Equal(t, "2624Mi", requests.Memory().String())
+}
+
+func TestWorkloadRequestsInvalidReplicas(t *testing.T) {
+	deployment := test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: three
+`)
+	_, err := workloadRequests([]*unstructured.Unstructured{deployment})
+	require.ErrorContains(t, err, "spec.replicas of Deployment/guestbook is not an integer")
+}
+
+func TestResourceBudgetViolations(t *testing.T) {
+	cpu := resource.MustParse("2")
+	memory := resource.MustParse("1Gi")
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha1.AppProjectSpec{ResourceBudgets: []v1alpha1.ResourceBudget{{
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "team-*"},
+			CPU:         &cpu,
+			Memory:      &memory,
+		}}},
+	}
+	dest := v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Name: "in-cluster", Namespace: "team-a"}
+	app := appResourceRequests{app: "argocd/guestbook", destination: dest, requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("256Mi"),
+	}}
+
+	t.Run("WithinBudget", func(t *testing.T) {
+		others := []appResourceRequests{{app: "argocd/other", destination: dest, requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}}}
+		assert.Empty(t, resourceBudgetViolations(proj, app, others))
+	})
+	t.Run("Exceeded", func(t *testing.T) {
+		others := []appResourceRequests{
+			{app: "argocd/small", destination: dest, requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")}},
+			{app: "argocd/big", destination: dest, requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+			{app: "argocd/elsewhere", destination: v1alpha1.ApplicationDestination{Server: "https://other", Namespace: "team-a"}, requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("8")}},
+		}
+		assert.Equal(t, []string{
+			"cpu requests of 2200m exceed the budget of 2 for destination 'https://kubernetes.default.svc/team-*' (this application: 1, argocd/big: 1, argocd/small: 200m)",
+		}, resourceBudgetViolations(proj, app, others))
+	})
+	t.Run("OtherDestination", func(t *testing.T) {
+		other := app
+		other.destination = v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "kube-system"}
+		other.requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10")}
+		assert.Empty(t, resourceBudgetViolations(proj, other, nil))
+	})
+}
+
+func TestCheckResourceBudgets(t *testing.T) {
+	app := newFakeApp()
+	big := newFakeApp()
+	big.Name = "big"
+	unavailable := newFakeApp()
+	unavailable.Name = "unavailable"
+	unavailable.Spec.Destination.Server = "https://unavailable:6443"
+	deployment := test.YamlToUnstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: big
+  namespace: ` + test.FakeDestNamespace + `
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        resources:
+          requests:
+            cpu: "1"
+`)
+	ctrl := newFakeController(t.Context(), &fakeData{
+		apps:            []runtime.Object{app, big, unavailable, &defaultProj},
+		managedLiveObjs: map[kube.ResourceKey]*unstructured.Unstructured{kube.GetResourceKey(deployment): deployment},
+	}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	cpu := resource.MustParse("500m")
+	proj := defaultProj.DeepCopy()
+	proj.Spec.ResourceBudgets = []v1alpha1.ResourceBudget{{
+		Destination: v1alpha1.ApplicationDestination{Server: "*", Namespace: "*"},
+		CPU:         &cpu,
+	}}
+
+	// the application whose cluster is unavailable is ignored instead of failing the sync
+	report, err := manager.checkResourceBudgets(t.Context(), app, proj, &v1alpha1.Cluster{Server: "https://localhost:6443"}, nil)
+	require.NoError(t, err)
+	assert.Contains(t, report, "cpu requests of 1 exceed the budget of 500m")
+	assert.Contains(t, report, big.QualifiedName()+": 1")
+	assert.NotContains(t, report, "unavailable")
+}
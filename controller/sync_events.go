@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	corev1 "k8s.io/api/core/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// syncEvent is an event reporting the progress of a sync operation
+type syncEvent struct {
+	info    argo.EventInfo
+	message string
+}
+
+// syncOperationRevision returns the revision, or the comma separated revisions of a multi-source application, a sync
+// operation synced to
+func syncOperationRevision(state *appv1.OperationState) string {
+	if state.SyncResult == nil {
+		return ""
+	}
+	if state.SyncResult.Revision != "" {
+		return state.SyncResult.Revision
+	}
+	return strings.Join(state.SyncResult.Revisions, ",")
+}
+
+// syncOperationInitiator returns who initiated an operation, which is either "automated" or the name of a user
+func syncOperationInitiator(operation appv1.Operation) string {
+	if operation.InitiatedBy.Automated {
+		return "automated"
+	}
+	return operation.InitiatedBy.Username
+}
+
+// syncResourceEvent returns the event to report for the result of a resource, or nil if the result did not change
+// since the previous state of the operation or is not worth reporting
+func syncResourceEvent(prev, res *appv1.ResourceResult, revision string) *syncEvent {
+	key := kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)
+	annotations := map[string]string{
+		argo.EventAnnotationResource:  key.String(),
+		argo.EventAnnotationSyncPhase: string(res.SyncPhase),
+		argo.EventAnnotationSyncWave:  strconv.FormatInt(res.SyncWave, 10),
+	}
+	if revision != "" {
+		annotations[argo.EventAnnotationRevision] = revision
+	}
+	switch {
+	case res.HookType != "" && (res.HookPhase == synccommon.OperationFailed || res.HookPhase == synccommon.OperationError):
+		if prev != nil && prev.HookPhase == res.HookPhase {
+			return nil
+		}
+		annotations[argo.EventAnnotationHookType] = string(res.HookType)
+		return &syncEvent{
+			info:    argo.EventInfo{Reason: argo.EventReasonHookFailed, Type: corev1.EventTypeWarning, Annotations: annotations},
+			message: fmt.Sprintf("%s hook %s failed: %s", res.HookType, key.String(), res.Message),
+		}
+	case res.Status == synccommon.ResultCodePruneSkipped:
+		if prev != nil && prev.Status == res.Status {
+			return nil
+		}
+		return &syncEvent{
+			info:    argo.EventInfo{Reason: argo.EventReasonPruneSkipped, Type: corev1.EventTypeNormal, Annotations: annotations},
+			message: fmt.Sprintf("Skipped pruning of %s: %s", key.String(), res.Message),
+		}
+	case res.Status == synccommon.ResultCodeSyncFailed:
+		if prev != nil && prev.Status == res.Status {
+			return nil
+		}
+		return &syncEvent{
+			info:    argo.EventInfo{Reason: argo.EventReasonResourceSyncFailed, Type: corev1.EventTypeWarning, Annotations: annotations},
+			message: fmt.Sprintf("Failed to sync %s: %s", key.String(), res.Message),
+		}
+	}
+	return nil
+}
+
+// syncOperationEvents returns the events to report for the progress of a sync operation since its previous state: one
+// for each failed hook, skipped prune and failed resource, followed by one for the completion of the operation.
+func syncOperationEvents(prev, state *appv1.OperationState) []syncEvent {
+	if prev != nil && !prev.StartedAt.Equal(&state.StartedAt) {
+		// the previous state belongs to another operation
+		prev = nil
+	}
+	revision := syncOperationRevision(state)
+
+	var events []syncEvent
+	if state.SyncResult != nil {
+		for _, res := range state.SyncResult.Resources {
+			var prevRes *appv1.ResourceResult
+			if prev != nil && prev.SyncResult != nil {
+				_, prevRes = prev.SyncResult.Resources.Find(res.Group, res.Kind, res.Namespace, res.Name, res.SyncPhase)
+			}
+			if event := syncResourceEvent(prevRes, res, revision); event != nil {
+				events = append(events, *event)
+			}
+		}
+	}
+
+	if !state.Phase.Completed() {
+		return events
+	}
+	var messages []string
+	if state.Operation.Sync != nil && len(state.Operation.Sync.Resources) > 0 {
+		messages = []string{"Partial sync operation"}
+	} else {
+		messages = []string{"Sync operation"}
+	}
+	if state.SyncResult != nil {
+		messages = append(messages, "to", state.SyncResult.Revision)
+	}
+	annotations := map[string]string{
+		argo.EventAnnotationOperationPhase: string(state.Phase),
+		argo.EventAnnotationInitiatedBy:    syncOperationInitiator(state.Operation),
+	}
+	if revision != "" {
+		annotations[argo.EventAnnotationRevision] = revision
+	}
+	eventInfo := argo.EventInfo{Annotations: annotations}
+	if state.Phase.Successful() {
+		eventInfo.Reason = argo.EventReasonSyncSucceeded
+		eventInfo.Type = corev1.EventTypeNormal
+		messages = append(messages, "succeeded")
+	} else {
+		eventInfo.Reason = argo.EventReasonSyncFailed
+		eventInfo.Type = corev1.EventTypeWarning
+		messages = append(messages, "failed:", state.Message)
+	}
+	return append(events, syncEvent{info: eventInfo, message: strings.Join(messages, " ")})
+}
@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+func TestSyncOperationEvents(t *testing.T) {
+	startedAt := metav1.Now()
+	failedHook := &v1alpha1.ResourceResult{
+		Kind:      "Job",
+		Namespace: "default",
+		Name:      "migrate",
+		HookType:  synccommon.HookTypePreSync,
+		HookPhase: synccommon.OperationFailed,
+		SyncPhase: synccommon.SyncPhasePreSync,
+		SyncWave:  -1,
+		Message:   "job failed",
+	}
+	prunedSkipped := &v1alpha1.ResourceResult{
+		Kind:      "ConfigMap",
+		Namespace: "default",
+		Name:      "old",
+		Status:    synccommon.ResultCodePruneSkipped,
+		SyncPhase: synccommon.SyncPhaseSync,
+		Message:   "ignored (requires pruning)",
+	}
+	synced := &v1alpha1.ResourceResult{
+		Group:     "apps",
+		Kind:      "Deployment",
+		Namespace: "default",
+		Name:      "guestbook",
+		Status:    synccommon.ResultCodeSynced,
+		SyncPhase: synccommon.SyncPhaseSync,
+	}
+	newState := func(phase synccommon.OperationPhase, resources ...*v1alpha1.ResourceResult) *v1alpha1.OperationState {
+		return &v1alpha1.OperationState{
+			Operation:  v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}, InitiatedBy: v1alpha1.OperationInitiator{Automated: true}},
+			Phase:      phase,
+			Message:    "one or more hooks failed",
+			StartedAt:  startedAt,
+			SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc123", Resources: resources},
+		}
+	}
+
+	t.Run("ResourceEvents", func(t *testing.T) {
+		events := syncOperationEvents(nil, newState(synccommon.OperationRunning, failedHook, prunedSkipped, synced))
+		require.Len(t, events, 2)
+
+		assert.Equal(t, argo.EventReasonHookFailed, events[0].info.Reason)
+		assert.Equal(t, corev1.EventTypeWarning, events[0].info.Type)
+		assert.Equal(t, "PreSync hook /Job/default/migrate failed: job failed", events[0].message)
+		assert.Equal(t, map[string]string{
+			argo.EventAnnotationResource:  "/Job/default/migrate",
+			argo.EventAnnotationSyncPhase: "PreSync",
+			argo.EventAnnotationSyncWave:  "-1",
+			argo.EventAnnotationHookType:  "PreSync",
+			argo.EventAnnotationRevision:  "abc123",
+		}, events[0].info.Annotations)
+
+		assert.Equal(t, argo.EventReasonPruneSkipped, events[1].info.Reason)
+		assert.Equal(t, corev1.EventTypeNormal, events[1].info.Type)
+		assert.Equal(t, "0", events[1].info.Annotations[argo.EventAnnotationSyncWave])
+	})
+
+	t.Run("ResourceEventsAreNotRepeated", func(t *testing.T) {
+		prev := newState(synccommon.OperationRunning, failedHook, prunedSkipped)
+		events := syncOperationEvents(prev, newState(synccommon.OperationRunning, failedHook, prunedSkipped, synced))
+		assert.Empty(t, events)
+	})
+
+	t.Run("PreviousOperation", func(t *testing.T) {
+		prev := newState(synccommon.OperationFailed, failedHook)
+		prev.StartedAt = metav1.NewTime(startedAt.Add(-time.Hour))
+		events := syncOperationEvents(prev, newState(synccommon.OperationRunning, failedHook))
+		require.Len(t, events, 1)
+		assert.Equal(t, argo.EventReasonHookFailed, events[0].info.Reason)
+	})
+
+	t.Run("Succeeded", func(t *testing.T) {
+		events := syncOperationEvents(nil, newState(synccommon.OperationSucceeded, synced))
+		require.Len(t, events, 1)
+		assert.Equal(t, argo.EventReasonSyncSucceeded, events[0].info.Reason)
+		assert.Equal(t, corev1.EventTypeNormal, events[0].info.Type)
+		assert.Equal(t, "Sync operation to abc123 succeeded", events[0].message)
+		assert.Equal(t, map[string]string{
+			argo.EventAnnotationOperationPhase: "Succeeded",
+			argo.EventAnnotationInitiatedBy:    "automated",
+			argo.EventAnnotationRevision:       "abc123",
+		}, events[0].info.Annotations)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		state := newState(synccommon.OperationFailed, failedHook)
+		state.Operation.InitiatedBy = v1alpha1.OperationInitiator{Username: "admin"}
+		events := syncOperationEvents(newState(synccommon.OperationRunning, failedHook), state)
+		require.Len(t, events, 1)
+		assert.Equal(t, argo.EventReasonSyncFailed, events[0].info.Reason)
+		assert.Equal(t, corev1.EventTypeWarning, events[0].info.Type)
+		assert.Equal(t, "Sync operation to abc123 failed: one or more hooks failed", events[0].message)
+		assert.Equal(t, "admin", events[0].info.Annotations[argo.EventAnnotationInitiatedBy])
+	})
+
+	t.Run("MultiSourceRevisions", func(t *testing.T) {
+		state := newState(synccommon.OperationSucceeded)
+		state.SyncResult.Revision = ""
+		state.SyncResult.Revisions = []string{"abc123", "def456"}
+		events := syncOperationEvents(nil, state)
+		require.Len(t, events, 1)
+		assert.Equal(t, "abc123,def456", events[0].info.Annotations[argo.EventAnnotationRevision])
+	})
+}
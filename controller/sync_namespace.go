@@ -1,7 +1,9 @@
 package controller
 
 import (
+	"fmt"
 	"maps"
+	"slices"
 
 	gitopscommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -45,6 +47,21 @@ func syncNamespace(syncPolicy *v1alpha1.SyncPolicy) func(m *unstructured.Unstruc
 	}
 }
 
+// namespaceSyncPolicy returns the sync policy of the application, with the managed namespace metadata required by the
+// namespace creation policy of the project
+func namespaceSyncPolicy(app *v1alpha1.Application, project *v1alpha1.AppProject) *v1alpha1.SyncPolicy {
+	metadata := project.GetManagedNamespaceMetadata(app.Spec.SyncPolicy)
+	if app.Spec.SyncPolicy != nil && metadata == app.Spec.SyncPolicy.ManagedNamespaceMetadata {
+		return app.Spec.SyncPolicy
+	}
+	syncPolicy := &v1alpha1.SyncPolicy{}
+	if app.Spec.SyncPolicy != nil {
+		syncPolicy = app.Spec.SyncPolicy.DeepCopy()
+	}
+	syncPolicy.ManagedNamespaceMetadata = metadata
+	return syncPolicy
+}
+
 // appendSSAAnnotation will set the managed namespace to be synced
 // with server-side apply
 func appendSSAAnnotation(in map[string]string) map[string]string {
@@ -53,3 +70,28 @@ func appendSSAAnnotation(in map[string]string) map[string]string {
 	r[gitopscommon.AnnotationSyncOptions] = gitopscommon.SyncOptionServerSideApply
 	return r
 }
+
+// getManagedNamespaceMetadataDrift returns the differences between the managed namespace metadata of the sync policy
+// and the labels and annotations of the live namespace. Labels and annotations which are not part of the managed
+// namespace metadata are ignored, since they might be set by other tools.
+func getManagedNamespaceMetadataDrift(syncPolicy *v1alpha1.SyncPolicy, liveNs *unstructured.Unstructured) []string {
+	if syncPolicy == nil || syncPolicy.ManagedNamespaceMetadata == nil || liveNs == nil {
+		return nil
+	}
+	drift := getMetadataDrift("label", syncPolicy.ManagedNamespaceMetadata.Labels, liveNs.GetLabels())
+	return append(drift, getMetadataDrift("annotation", syncPolicy.ManagedNamespaceMetadata.Annotations, liveNs.GetAnnotations())...)
+}
+
+func getMetadataDrift(field string, desired map[string]string, live map[string]string) []string {
+	var drift []string
+	for _, key := range slices.Sorted(maps.Keys(desired)) {
+		liveValue, ok := live[key]
+		switch {
+		case !ok:
+			drift = append(drift, fmt.Sprintf("%s %q is missing", field, key))
+		case liveValue != desired[key]:
+			drift = append(drift, fmt.Sprintf("%s %q is %q instead of %q", field, key, liveValue, desired[key]))
+		}
+	}
+	return drift
+}
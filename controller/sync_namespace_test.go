@@ -260,3 +260,49 @@ func Test_shouldNamespaceSync(t *testing.T) {
 		})
 	}
 }
+
+func Test_getManagedNamespaceMetadataDrift(t *testing.T) {
+	syncPolicy := &v1alpha1.SyncPolicy{
+		ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{
+			Labels:      map[string]string{"my-cool-label": "some-value", "my-other-label": "some-other-value"},
+			Annotations: map[string]string{"my-cool-annotation": "some-value"},
+		},
+	}
+
+	assert.Empty(t, getManagedNamespaceMetadataDrift(syncPolicy, createFakeNamespace("something", "1",
+		map[string]string{"my-cool-label": "some-value", "my-other-label": "some-other-value", "unmanaged-label": "value"},
+		map[string]string{"my-cool-annotation": "some-value"})))
+	assert.Equal(t, []string{
+		`label "my-cool-label" is "changed" instead of "some-value"`,
+		`label "my-other-label" is missing`,
+		`annotation "my-cool-annotation" is missing`,
+	}, getManagedNamespaceMetadataDrift(syncPolicy, createFakeNamespace("something", "1", map[string]string{"my-cool-label": "changed"}, map[string]string{})))
+	assert.Empty(t, getManagedNamespaceMetadataDrift(&v1alpha1.SyncPolicy{}, createFakeNamespace("something", "1", map[string]string{}, map[string]string{})))
+	assert.Empty(t, getManagedNamespaceMetadataDrift(syncPolicy, nil))
+}
+
+func Test_namespaceSyncPolicy(t *testing.T) {
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{SyncPolicy: &v1alpha1.SyncPolicy{
+		SyncOptions:              v1alpha1.SyncOptions{"CreateNamespace=true"},
+		ManagedNamespaceMetadata: &v1alpha1.ManagedNamespaceMetadata{Labels: map[string]string{"team": "app"}},
+	}}}
+	project := &v1alpha1.AppProject{}
+	assert.Same(t, app.Spec.SyncPolicy, namespaceSyncPolicy(app, project))
+
+	project.Spec.NamespaceCreationPolicy = &v1alpha1.NamespaceCreationPolicy{
+		Mode:   v1alpha1.NamespaceCreationModeAllowWithRequiredLabels,
+		Labels: map[string]string{"team": "team1"},
+	}
+	syncPolicy := namespaceSyncPolicy(app, project)
+	assert.Equal(t, v1alpha1.SyncOptions{"CreateNamespace=true"}, syncPolicy.SyncOptions)
+	assert.Equal(t, map[string]string{"team": "team1"}, syncPolicy.ManagedNamespaceMetadata.Labels)
+	assert.Equal(t, map[string]string{"team": "app"}, app.Spec.SyncPolicy.ManagedNamespaceMetadata.Labels)
+
+	app.Spec.SyncPolicy = nil
+	syncPolicy = namespaceSyncPolicy(app, project)
+	managedNs := createFakeNamespace("", "", nil, nil)
+	synced, err := syncNamespace(syncPolicy)(managedNs, nil)
+	require.NoError(t, err)
+	assert.True(t, synced)
+	assert.Equal(t, map[string]string{"team": "team1"}, managedNs.GetLabels())
+}
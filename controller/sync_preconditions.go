@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	celutil "github.com/argoproj/argo-cd/v3/util/cel"
+)
+
+// syncPreconditionEnv returns the objects against which the sync preconditions of an application are evaluated. Only
+// the non-sensitive fields of the cluster are exposed.
+func syncPreconditionEnv(app *appv1.Application, proj *appv1.AppProject, cluster *appv1.Cluster) (map[string]any, error) {
+	appObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	if err != nil {
+		return nil, fmt.Errorf("error converting application: %w", err)
+	}
+	projObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(proj)
+	if err != nil {
+		return nil, fmt.Errorf("error converting project: %w", err)
+	}
+	return map[string]any{
+		"app":         appObj,
+		"application": appObj,
+		"project":     projObj,
+		"cluster": map[string]any{
+			"name":        cluster.Name,
+			"server":      cluster.Server,
+			"namespaces":  cluster.Namespaces,
+			"project":     cluster.Project,
+			"labels":      cluster.Labels,
+			"annotations": cluster.Annotations,
+		},
+	}, nil
+}
+
+// failedSyncPrecondition returns the first of the given sync preconditions which is not met, or nil if all of them are
+func failedSyncPrecondition(preconditions []appv1.SyncPrecondition, env map[string]any) (*appv1.SyncPrecondition, error) {
+	for i, precondition := range preconditions {
+		program, err := argo.CompileSyncPrecondition(precondition.Expression)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile sync precondition '%s': %w", precondition.Expression, err)
+		}
+		met, err := celutil.EvalBool(program, env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate sync precondition '%s': %w", precondition.Expression, err)
+		}
+		if !met {
+			return &preconditions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// checkSyncPreconditions evaluates the sync preconditions of an application and returns the condition to report when
+// one of them is not met, or when they cannot be evaluated
+func (ctrl *ApplicationController) checkSyncPreconditions(ctx context.Context, app *appv1.Application) *appv1.ApplicationCondition {
+	proj, err := ctrl.getAppProj(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Failed to evaluate sync preconditions: %v", err)}
+	}
+	cluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, ctrl.db)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Failed to evaluate sync preconditions: %v", err)}
+	}
+	env, err := syncPreconditionEnv(app, proj, cluster)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: fmt.Sprintf("Failed to evaluate sync preconditions: %v", err)}
+	}
+	failed, err := failedSyncPrecondition(app.Spec.SyncPolicy.Preconditions, env)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncError, Message: err.Error()}
+	}
+	if failed == nil {
+		return nil
+	}
+	message := fmt.Sprintf("Sync precondition '%s' is not met", failed.Expression)
+	if failed.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, failed.Message)
+	}
+	return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionSyncPreconditionWarning, Message: message}
+}
@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestFailedSyncPrecondition(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Labels: map[string]string{"tier": "batch"}}}
+	proj := &v1alpha1.AppProject{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	cluster := &v1alpha1.Cluster{
+		Name:   "in-cluster",
+		Server: "https://kubernetes.default.svc",
+		Labels: map[string]string{"maintenance": "true"},
+		Config: v1alpha1.ClusterConfig{BearerToken: "secret"},
+	}
+	env, err := syncPreconditionEnv(app, proj, cluster)
+	require.NoError(t, err)
+
+	t.Run("Met", func(t *testing.T) {
+		failed, err := failedSyncPrecondition([]v1alpha1.SyncPrecondition{
+			{Expression: `app.metadata.labels.tier == "batch"`},
+			{Expression: `project.metadata.name == "default"`},
+		}, env)
+		require.NoError(t, err)
+		assert.Nil(t, failed)
+	})
+	t.Run("NotMet", func(t *testing.T) {
+		failed, err := failedSyncPrecondition([]v1alpha1.SyncPrecondition{
+			{Expression: `cluster.name == "in-cluster"`},
+			{Expression: `cluster.labels.maintenance != "true"`, Message: "cluster under maintenance"},
+		}, env)
+		require.NoError(t, err)
+		require.NotNil(t, failed)
+		assert.Equal(t, "cluster under maintenance", failed.Message)
+	})
+	t.Run("MissingLabel", func(t *testing.T) {
+		failed, err := failedSyncPrecondition([]v1alpha1.SyncPrecondition{{Expression: `cluster.labels.?region.orValue("") != "eu"`}}, env)
+		require.NoError(t, err)
+		assert.Nil(t, failed)
+
+		_, err = failedSyncPrecondition([]v1alpha1.SyncPrecondition{{Expression: `cluster.labels.region != "eu"`}}, env)
+		require.ErrorContains(t, err, "no such key: region")
+	})
+	t.Run("ClusterCredentialsAreNotExposed", func(t *testing.T) {
+		_, err := failedSyncPrecondition([]v1alpha1.SyncPrecondition{{Expression: `cluster.config.bearerToken == "secret"`}}, env)
+		require.Error(t, err)
+	})
+	t.Run("NonBooleanExpression", func(t *testing.T) {
+		_, err := failedSyncPrecondition([]v1alpha1.SyncPrecondition{{Expression: `cluster.name`}}, env)
+		require.ErrorContains(t, err, "must evaluate to a boolean")
+
+		_, err = failedSyncPrecondition([]v1alpha1.SyncPrecondition{{Expression: `cluster.namespaces.size()`}}, env)
+		require.ErrorContains(t, err, "must evaluate to a boolean")
+	})
+}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"testing"
@@ -223,6 +224,122 @@ func TestSyncComparisonError(t *testing.T) {
 	assert.Equal(t, "abc123", opState.SyncResult.Revision)
 }
 
+func TestSyncNamespaceCreationDenied(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			NamespaceCreationPolicy: &v1alpha1.NamespaceCreationPolicy{Mode: v1alpha1.NamespaceCreationModeDeny},
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Source:      &v1alpha1.ApplicationSource{},
+			SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true"},
+		},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, project, opState)
+
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "Project default does not permit the creation of the destination namespace with the CreateNamespace=true sync option", opState.Message)
+}
+
+func TestSyncCordonedCluster(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+
+	project := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	cluster, err := ctrl.db.GetCluster(t.Context(), app.Spec.Destination.Server)
+	require.NoError(t, err)
+	cluster.Cordoned = true
+	_, err = ctrl.db.UpdateCluster(t.Context(), cluster)
+	require.NoError(t, err)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{
+			Source: &v1alpha1.ApplicationSource{},
+		},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, project, opState)
+
+	assert.Equal(t, synccommon.OperationFailed, opState.Phase)
+	assert.Equal(t, "Cluster '"+cluster.Server+"' is cordoned and does not accept new syncs", opState.Message)
+}
+
+func TestSyncInvalidResourceTimeout(t *testing.T) {
+	for _, value := range []string{"5", "-1m"} {
+		t.Run(value, func(t *testing.T) {
+			app := newFakeApp()
+			app.Status.OperationState = nil
+			app.Status.History = nil
+
+			project := &v1alpha1.AppProject{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: test.FakeArgoCDNamespace,
+					Name:      "default",
+				},
+			}
+			data := fakeData{
+				apps: []runtime.Object{app, project},
+				manifestResponse: &apiclient.ManifestResponse{
+					Manifests: []string{},
+					Namespace: test.FakeDestNamespace,
+					Server:    test.FakeClusterURL,
+					Revision:  "abc123",
+				},
+				managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+			}
+			ctrl := newFakeController(t.Context(), &data, nil)
+
+			opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+				Sync: &v1alpha1.SyncOperation{
+					Source:      &v1alpha1.ApplicationSource{},
+					SyncOptions: v1alpha1.SyncOptions{"ResourceTimeout=" + value},
+				},
+			}}
+			ctrl.appStateManager.SyncAppState(t.Context(), app, project, opState)
+
+			assert.Equal(t, synccommon.OperationError, opState.Phase)
+			assert.Equal(t, fmt.Sprintf("Invalid value of the ResourceTimeout sync option: %q", value), opState.Message)
+		})
+	}
+}
+
 func TestAppStateManager_SyncAppState(t *testing.T) {
 	t.Parallel()
 
@@ -2325,3 +2442,46 @@ func TestValidateSyncPermissions(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestObserveHookDurations(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	manager := ctrl.appStateManager.(*appStateManager)
+	state := &v1alpha1.OperationState{Phase: synccommon.OperationRunning, StartedAt: metav1.Now()}
+	hook := func(name string, phase synccommon.OperationPhase) synccommon.ResourceSyncResult {
+		return synccommon.ResourceSyncResult{
+			ResourceKey: kube.ResourceKey{Group: "batch", Kind: "Job", Namespace: test.FakeDestNamespace, Name: name},
+			HookType:    synccommon.HookTypePreSync,
+			HookPhase:   phase,
+			SyncPhase:   synccommon.SyncPhasePreSync,
+		}
+	}
+	countHooks := func() int {
+		count := 0
+		manager.hookStartTimes.Range(func(_, _ any) bool {
+			count++
+			return true
+		})
+		return count
+	}
+
+	manager.observeHookDurations(app, test.FakeClusterURL, state, []synccommon.ResourceSyncResult{
+		hook("migrate", synccommon.OperationRunning),
+		hook("seed", synccommon.OperationRunning),
+	})
+	assert.Equal(t, 2, countHooks())
+
+	manager.observeHookDurations(app, test.FakeClusterURL, state, []synccommon.ResourceSyncResult{
+		hook("migrate", synccommon.OperationSucceeded),
+		hook("seed", synccommon.OperationRunning),
+	})
+	assert.Equal(t, 1, countHooks())
+
+	// the hooks left running are forgotten once the operation completes
+	state.Phase = synccommon.OperationFailed
+	manager.observeHookDurations(app, test.FakeClusterURL, state, []synccommon.ResourceSyncResult{
+		hook("migrate", synccommon.OperationSucceeded),
+		hook("seed", synccommon.OperationRunning),
+	})
+	assert.Equal(t, 0, countHooks())
+}
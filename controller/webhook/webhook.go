@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// EventHeader is the header containing the type of the delivered event
+	EventHeader = "X-Argo-CD-Event"
+	// DeliveryHeader is the header containing the unique ID of the delivery, which is retained across retries
+	DeliveryHeader = "X-Argo-CD-Delivery"
+	// SignatureHeader is the header containing the HMAC-SHA256 signature of the payload, in the form of sha256=<hex>
+	SignatureHeader = "X-Argo-CD-Signature-256"
+
+	// EventTypeOperationPhaseChanged is the type of the event delivered when the phase of an operation changes
+	EventTypeOperationPhaseChanged = "operation.phaseChanged"
+)
+
+// Event is the payload posted to the operation webhooks
+type Event struct {
+	Type          string                      `json:"type"`
+	Application   string                      `json:"application"`
+	AppNamespace  string                      `json:"appNamespace"`
+	Project       string                      `json:"project"`
+	Phase         synccommon.OperationPhase   `json:"phase"`
+	PreviousPhase synccommon.OperationPhase   `json:"previousPhase,omitempty"`
+	Message       string                      `json:"message,omitempty"`
+	Revisions     []string                    `json:"revisions,omitempty"`
+	InitiatedBy   v1alpha1.OperationInitiator `json:"initiatedBy"`
+	StartedAt     metav1.Time                 `json:"startedAt"`
+	FinishedAt    *metav1.Time                `json:"finishedAt,omitempty"`
+	Timestamp     metav1.Time                 `json:"timestamp"`
+}
+
+// NewOperationPhaseChangedEvent creates the event for the transition of the operation of the given application from
+// the previous phase to the phase of the given operation state
+func NewOperationPhaseChangedEvent(app *v1alpha1.Application, previousPhase synccommon.OperationPhase, state *v1alpha1.OperationState) Event {
+	event := Event{
+		Type:          EventTypeOperationPhaseChanged,
+		Application:   app.Name,
+		AppNamespace:  app.Namespace,
+		Project:       app.Spec.GetProject(),
+		Phase:         state.Phase,
+		PreviousPhase: previousPhase,
+		Message:       state.Message,
+		InitiatedBy:   state.Operation.InitiatedBy,
+		StartedAt:     state.StartedAt,
+		FinishedAt:    state.FinishedAt,
+		Timestamp:     metav1.Now(),
+	}
+	if state.SyncResult != nil {
+		if len(state.SyncResult.Revisions) > 0 {
+			event.Revisions = state.SyncResult.Revisions
+		} else if state.SyncResult.Revision != "" {
+			event.Revisions = []string{state.SyncResult.Revision}
+		}
+	}
+	return event
+}
+
+// Target is an operation webhook whose secret has been resolved
+type Target struct {
+	Name   string
+	URL    string
+	Secret string
+}
+
+// errRetryable is returned for deliveries which failed temporarily and are retried
+var errRetryable = errors.New("retryable delivery failure")
+
+// Dispatcher delivers events to operation webhooks in the background and retries failed deliveries with an
+// exponential backoff
+type Dispatcher struct {
+	client  *http.Client
+	backoff wait.Backoff
+}
+
+// NewDispatcher creates a new Dispatcher
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		client:  &http.Client{Timeout: 10 * time.Second},
+		backoff: wait.Backoff{Steps: 5, Duration: time.Second, Factor: 2, Jitter: 0.1},
+	}
+}
+
+// Dispatch delivers the event to the given targets without waiting for the deliveries to complete
+func (d *Dispatcher) Dispatch(event Event, targets []Target) {
+	if len(targets) == 0 {
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		log.WithError(err).Error("Failed to marshal operation webhook event")
+		return
+	}
+	for _, target := range targets {
+		go func() {
+			logCtx := log.WithFields(log.Fields{
+				"application": event.Application,
+				"webhook":     target.Name,
+				"phase":       event.Phase,
+			})
+			if err := d.deliver(context.Background(), target, event.Type, payload); err != nil {
+				logCtx.WithError(err).Warn("Failed to deliver operation webhook event")
+				return
+			}
+			logCtx.Debug("Delivered operation webhook event")
+		}()
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, target Target, eventType string, payload []byte) error {
+	deliveryID := uuid.NewString()
+	return retry.OnError(d.backoff, func(err error) bool {
+		return errors.Is(err, errRetryable)
+	}, func() error {
+		return d.post(ctx, target, eventType, deliveryID, payload)
+	})
+}
+
+func (d *Dispatcher) post(ctx context.Context, target Target, eventType string, deliveryID string, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "argocd-application-controller/"+common.GetVersion().Version)
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(DeliveryHeader, deliveryID)
+	if target.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(payload, target.Secret))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errRetryable, err)
+	}
+	defer utilio.Close(resp.Body)
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("%w: unexpected response: %s", errRetryable, resp.Status)
+	default:
+		return fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+}
+
+// Sign returns the HMAC-SHA256 signature of the payload, in the form of sha256=<hex>
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newTestDispatcher() *Dispatcher {
+	return &Dispatcher{
+		client:  &http.Client{Timeout: time.Second},
+		backoff: wait.Backoff{Steps: 3, Duration: time.Millisecond, Factor: 1},
+	}
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=b82fcb791acec57859b989b430a826488ce2e479fdf92326bd0a2e8375a42ba4", Sign([]byte("payload"), "secret"))
+}
+
+func TestNewOperationPhaseChangedEvent(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+	}
+	state := &v1alpha1.OperationState{
+		Operation:  v1alpha1.Operation{InitiatedBy: v1alpha1.OperationInitiator{Username: "admin"}},
+		Phase:      synccommon.OperationSucceeded,
+		Message:    "successfully synced",
+		SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc"},
+	}
+	event := NewOperationPhaseChangedEvent(app, synccommon.OperationRunning, state)
+	assert.Equal(t, EventTypeOperationPhaseChanged, event.Type)
+	assert.Equal(t, "guestbook", event.Application)
+	assert.Equal(t, "argocd", event.AppNamespace)
+	assert.Equal(t, "default", event.Project)
+	assert.Equal(t, synccommon.OperationSucceeded, event.Phase)
+	assert.Equal(t, synccommon.OperationRunning, event.PreviousPhase)
+	assert.Equal(t, []string{"abc"}, event.Revisions)
+	assert.Equal(t, "admin", event.InitiatedBy.Username)
+
+	state.SyncResult.Revisions = []string{"abc", "def"}
+	event = NewOperationPhaseChangedEvent(app, synccommon.OperationRunning, state)
+	assert.Equal(t, []string{"abc", "def"}, event.Revisions)
+}
+
+func TestDeliver(t *testing.T) {
+	t.Run("Signed", func(t *testing.T) {
+		var received *http.Request
+		var body []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received = r
+			body, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		payload, err := json.Marshal(Event{Type: EventTypeOperationPhaseChanged, Application: "guestbook"})
+		require.NoError(t, err)
+		err = newTestDispatcher().deliver(t.Context(), Target{Name: "test", URL: server.URL, Secret: "secret"}, EventTypeOperationPhaseChanged, payload)
+		require.NoError(t, err)
+		require.NotNil(t, received)
+		assert.Equal(t, payload, body)
+		assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+		assert.Equal(t, EventTypeOperationPhaseChanged, received.Header.Get(EventHeader))
+		assert.NotEmpty(t, received.Header.Get(DeliveryHeader))
+		assert.Equal(t, Sign(payload, "secret"), received.Header.Get(SignatureHeader))
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		var signature atomic.Value
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			signature.Store(r.Header.Get(SignatureHeader))
+		}))
+		defer server.Close()
+
+		err := newTestDispatcher().deliver(t.Context(), Target{Name: "test", URL: server.URL}, EventTypeOperationPhaseChanged, []byte("{}"))
+		require.NoError(t, err)
+		assert.Empty(t, signature.Load())
+	})
+
+	t.Run("RetriedOnServerError", func(t *testing.T) {
+		var attempts atomic.Int32
+		deliveryIDs := map[string]bool{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			deliveryIDs[r.Header.Get(DeliveryHeader)] = true
+			if attempts.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		err := newTestDispatcher().deliver(t.Context(), Target{Name: "test", URL: server.URL}, EventTypeOperationPhaseChanged, []byte("{}"))
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), attempts.Load())
+		assert.Len(t, deliveryIDs, 1)
+	})
+
+	t.Run("RetriesExhausted", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		err := newTestDispatcher().deliver(t.Context(), Target{Name: "test", URL: server.URL}, EventTypeOperationPhaseChanged, []byte("{}"))
+		require.ErrorIs(t, err, errRetryable)
+		assert.Equal(t, int32(3), attempts.Load())
+	})
+
+	t.Run("NotRetriedOnClientError", func(t *testing.T) {
+		var attempts atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		err := newTestDispatcher().deliver(t.Context(), Target{Name: "test", URL: server.URL}, EventTypeOperationPhaseChanged, []byte("{}"))
+		require.Error(t, err)
+		require.NotErrorIs(t, err, errRetryable)
+		assert.Equal(t, int32(1), attempts.Load())
+	})
+}
+
+func TestDispatch(t *testing.T) {
+	received := make(chan Event, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var event Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err == nil {
+			received <- event
+		}
+	}))
+	defer server.Close()
+
+	newTestDispatcher().Dispatch(Event{Type: EventTypeOperationPhaseChanged, Application: "guestbook", Phase: synccommon.OperationFailed}, []Target{
+		{Name: "first", URL: server.URL},
+		{Name: "second", URL: server.URL, Secret: "secret"},
+	})
+	for range 2 {
+		select {
+		case event := <-received:
+			assert.Equal(t, "guestbook", event.Application)
+			assert.Equal(t, synccommon.OperationFailed, event.Phase)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the event to be delivered")
+		}
+	}
+}
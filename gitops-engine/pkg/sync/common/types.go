@@ -34,6 +34,12 @@ const (
 	SyncOptionServerSideApply = "ServerSideApply=true"
 	// Sync option that disables use of --server-side flag instead of client-side
 	SyncOptionDisableServerSideApply = "ServerSideApply=false"
+	// Sync option that enables forcing the ownership of conflicting fields during server-side apply
+	SyncOptionServerSideApplyForceConflicts = "ServerSideApplyForceConflicts=true"
+	// Sync option that disables forcing the ownership of conflicting fields during server-side apply
+	SyncOptionDisableServerSideApplyForceConflicts = "ServerSideApplyForceConflicts=false"
+	// Sync option that sets the name of the field manager used by server-side apply
+	SyncOptionServerSideApplyManager = "ServerSideApplyManager"
 	// Sync option that sync only out of sync resources
 	SyncOptionApplyOutOfSyncOnly = "ApplyOutOfSyncOnly=true"
 	// Sync option that disables sync only out of sync resources
@@ -50,6 +56,18 @@ const (
 	SyncOptionClientSideApplyMigration = "ClientSideApplyMigration=true"
 	// Sync option that disables client-side apply migration
 	SyncOptionDisableClientSideApplyMigration = "ClientSideApplyMigration=false"
+	// Sync option that sets the duration after which a resource which is still applying, progressing or running is
+	// considered stuck
+	SyncOptionResourceTimeout = "ResourceTimeout"
+	// Sync option that skips the resources which are stuck and continues the sync instead of failing it
+	SyncOptionSkipStuckResources = "SkipStuckResources=true"
+
+	// EnvSyncFailMessage is the environment variable injected into the containers of the SyncFail hooks which holds
+	// the message of the failed sync operation
+	EnvSyncFailMessage = "ARGOCD_SYNC_FAIL_MESSAGE"
+	// EnvSyncFailedResources is the environment variable injected into the containers of the SyncFail hooks which holds
+	// the JSON list of the resources which failed to sync
+	EnvSyncFailedResources = "ARGOCD_SYNC_FAILED_RESOURCES"
 
 	// Default field manager for client-side apply migration
 	DefaultClientSideApplyMigrationManager = "kubectl-client-side-apply"
@@ -166,4 +184,14 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the sync wave in which the resource was synced
+	SyncWave int
+	// the field manager used to apply the resource with server-side apply, empty if server-side apply was not used
+	ServerSideApplyManager string
+	// indicates if the ownership of conflicting fields was forced when applying the resource with server-side apply
+	ForceConflicts bool
+	// the time at which the sync started waiting for the resource to become healthy or for the hook to complete
+	RunningSince metav1.Time
+	// indicates the resource did not complete within the resource timeout of the sync
+	Stuck bool
 }
@@ -43,12 +43,18 @@ var tracer = otel.Tracer("github.com/argoproj/argo-cd/gitops-engine/pkg/sync")
 
 // taskTraceAttrs returns the standard argocd.resource.* span attributes for a sync task.
 func taskTraceAttrs(t *syncTask) []attribute.KeyValue {
-	return []attribute.KeyValue{
+	attrs := []attribute.KeyValue{
 		attribute.String("argocd.resource.group", t.group()),
 		attribute.String("argocd.resource.kind", t.kind()),
 		attribute.String("argocd.resource.namespace", t.namespace()),
 		attribute.String("argocd.resource.name", t.name()),
+		attribute.String("argocd.sync.phase", string(t.phase)),
+		attribute.Int("argocd.sync.wave", t.wave()),
 	}
+	if t.isHook() {
+		attrs = append(attrs, attribute.String("argocd.hook.type", string(t.hookType())))
+	}
+	return attrs
 }
 
 type reconciledResource struct {
@@ -230,6 +236,15 @@ func WithServerSideApplyManager(manager string) SyncOpt {
 	}
 }
 
+// WithServerSideApplyForceConflicts specifies if the ownership of fields managed by other field managers is forced
+// during server-side apply. Defaults to true. It can be overridden per resource with the
+// ServerSideApplyForceConflicts sync option annotation.
+func WithServerSideApplyForceConflicts(forceConflicts bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.serverSideApplyForceConflicts = forceConflicts
+	}
+}
+
 // WithClientSideApplyMigration configures client-side apply migration for server-side apply.
 // When enabled, fields managed by the specified manager will be migrated to server-side apply.
 // Defaults to enabled=true with manager="kubectl-client-side-apply" if not configured.
@@ -242,6 +257,16 @@ func WithClientSideApplyMigration(enabled bool, manager string) SyncOpt {
 	}
 }
 
+// WithResourceTimeout sets the duration after which a resource which is still being applied, is not healthy yet or is
+// a hook which has not completed is considered stuck. Stuck resources fail the sync, unless skipStuck is set in which
+// case the resources which are waited on are skipped and the sync continues. A zero timeout disables the detection.
+func WithResourceTimeout(timeout time.Duration, skipStuck bool) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.resourceTimeout = timeout
+		ctx.skipStuckResources = skipStuck
+	}
+}
+
 // NewSyncContext creates new instance of a SyncContext
 func NewSyncContext(
 	revision string,
@@ -286,6 +311,7 @@ func NewSyncContext(
 		syncRes:                         map[string]common.ResourceSyncResult{},
 		clientSideApplyMigrationManager: common.DefaultClientSideApplyMigrationManager,
 		enableClientSideApplyMigration:  true,
+		serverSideApplyForceConflicts:   true,
 		permissionValidator: func(_ *unstructured.Unstructured, _ *metav1.APIResource) error {
 			return nil
 		},
@@ -409,12 +435,15 @@ type syncContext struct {
 	replace                         bool
 	serverSideApply                 bool
 	serverSideApplyManager          string
+	serverSideApplyForceConflicts   bool
 	pruneLast                       bool
 	prunePropagationPolicy          *metav1.DeletionPropagation
 	pruneConfirmed                  bool
 	defaultPruneOption              *string
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	resourceTimeout                 time.Duration
+	skipStuckResources              bool
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -593,6 +622,10 @@ func (sc *syncContext) Sync(ctx context.Context) {
 	// then wait...
 	multiStep := tasks.multiStep()
 	runningTasks := tasks.Filter(func(t *syncTask) bool { return (multiStep || t.isHook()) && t.running() })
+	if sc.resourceTimeout > 0 {
+		sc.processStuckTasks(runningTasks)
+		runningTasks = runningTasks.Filter(func(t *syncTask) bool { return t.running() })
+	}
 	if runningTasks.Len() > 0 {
 		// check if any of the running task's resources are missing to prevent infinite loop of waiting for healthy
 		for _, task := range runningTasks {
@@ -652,8 +685,12 @@ func (sc *syncContext) Sync(ctx context.Context) {
 	// if there are any completed but unsuccessful tasks, sync is a failure.
 	// we already know tasks do not contain running tasks
 	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() }) {
+		message := "one or more synchronization tasks completed unsuccessfully"
+		if tasks.Any(func(t *syncTask) bool { return t.stuck && !t.successful() }) {
+			message = fmt.Sprintf("one or more synchronization tasks did not complete within %s", sc.resourceTimeout)
+		}
 		sc.deleteHooks(ctx, hooksPendingDeletionFailed)
-		sc.executeSyncFailPhase(ctx, syncFailTasks, syncFailedTasks, "one or more synchronization tasks completed unsuccessfully")
+		sc.executeSyncFailPhase(ctx, syncFailTasks, syncFailedTasks, message)
 		return
 	}
 
@@ -680,6 +717,13 @@ func (sc *syncContext) Sync(ctx context.Context) {
 
 	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
 	tasks, remainingTasks := tasks.Split(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.String("argocd.sync.phase", string(phase)),
+			attribute.Int("argocd.sync.wave", wave),
+			attribute.Bool("argocd.sync.final_wave", finalWave),
+		)
+	}
 
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
@@ -956,6 +1000,11 @@ func (sc *syncContext) executeSyncFailPhase(ctx context.Context, syncFailTasks,
 	// otherwise, we need to start the pending failure hooks, and then return WITHOUT setting
 	// the phase to failed, since we want the failure hooks to complete their running state before failing
 	pendingSyncFailTasks := syncFailTasks.Filter(func(task *syncTask) bool { return !task.completed() && !task.running() })
+	for _, task := range pendingSyncFailTasks {
+		if task.targetObj != nil {
+			task.targetObj = withSyncFailContext(task.targetObj, errorMessage, syncFailedTasks)
+		}
+	}
 	sc.log.WithValues("syncFailTasks", pendingSyncFailTasks).V(1).Info("Running sync fail tasks")
 	sc.runTasks(ctx, pendingSyncFailTasks, false)
 	sc.setRunningPhase(pendingSyncFailTasks, false)
@@ -1179,6 +1228,7 @@ func (sc *syncContext) getSyncTasks(ctx context.Context) (_ syncTasks, successfu
 			task.syncStatus = result.Status
 			task.operationState = result.HookPhase
 			task.message = result.Message
+			task.stuck = result.Stuck
 		}
 	}
 
@@ -1322,6 +1372,18 @@ func (sc *syncContext) shouldUseServerSideApply(targetObj *unstructured.Unstruct
 	return sc.serverSideApply || resourceutil.HasAnnotationOption(targetObj, common.AnnotationSyncOptions, common.SyncOptionServerSideApply)
 }
 
+// shouldForceConflicts returns true if the ownership of conflicting fields should be forced when applying the target
+// object with server-side apply. The sync option annotation of the resource takes precedence over the sync context.
+func (sc *syncContext) shouldForceConflicts(targetObj *unstructured.Unstructured) bool {
+	if resourceutil.HasAnnotationOption(targetObj, common.AnnotationSyncOptions, common.SyncOptionDisableServerSideApplyForceConflicts) {
+		return false
+	}
+	if resourceutil.HasAnnotationOption(targetObj, common.AnnotationSyncOptions, common.SyncOptionServerSideApplyForceConflicts) {
+		return true
+	}
+	return sc.serverSideApplyForceConflicts
+}
+
 // needsClientSideApplyMigration checks if a resource has fields managed by the specified manager
 // with operation "Update" (client-side apply) that need to be migrated to server-side apply.
 // Client-side apply uses operation "Update", while server-side apply uses operation "Apply".
@@ -1470,7 +1532,12 @@ func (sc *syncContext) applyObject(ctx context.Context, t *syncTask, dryRun, val
 			message, err = sc.resourceOps.CreateResource(ctx, t.targetObj, dryRunStrategy, validate)
 		}
 	} else {
-		message, err = sc.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, sc.serverSideApplyManager)
+		forceConflicts := sc.shouldForceConflicts(t.targetObj)
+		if serverSideApply && !dryRun {
+			t.serverSideApplyManager = sc.serverSideApplyManager
+			t.forceConflicts = forceConflicts
+		}
+		message, err = sc.resourceOps.ApplyResource(ctx, t.targetObj, dryRunStrategy, force, validate, serverSideApply, forceConflicts, sc.serverSideApplyManager)
 	}
 	if err != nil {
 		return common.ResultCodeSyncFailed, err.Error()
@@ -1762,7 +1829,7 @@ func (sc *syncContext) processCreateTasks(ctx context.Context, state runState, t
 			logCtx := sc.log.WithValues("dryRun", dryRun, "task", t)
 			logCtx.V(1).Info("Applying")
 			validate := sc.validate && !resourceutil.HasAnnotationOption(t.targetObj, common.AnnotationSyncOptions, common.SyncOptionsDisableValidation)
-			result, message := sc.applyObject(ctx, t, dryRun, validate)
+			result, message := sc.applyObjectWithTimeout(ctx, t, dryRun, validate)
 			if result == common.ResultCodeSyncFailed {
 				logCtx.WithValues("message", message).Info("Apply failed")
 				state = failed
@@ -1782,6 +1849,63 @@ func (sc *syncContext) processCreateTasks(ctx context.Context, state runState, t
 	return ss.Wait()
 }
 
+// applyObjectWithTimeout applies the object, giving up on the apply if it does not complete within the resource
+// timeout, e.g. because it is blocked by an admission webhook. Dry-runs are never timed out.
+func (sc *syncContext) applyObjectWithTimeout(ctx context.Context, t *syncTask, dryRun, validate bool) (common.ResultCode, string) {
+	if sc.resourceTimeout <= 0 || dryRun {
+		return sc.applyObject(ctx, t, dryRun, validate)
+	}
+	ctx, cancel := context.WithTimeout(ctx, sc.resourceTimeout)
+	defer cancel()
+
+	type applyResult struct {
+		code    common.ResultCode
+		message string
+	}
+	done := make(chan applyResult, 1)
+	go func() {
+		code, message := sc.applyObject(ctx, t, dryRun, validate)
+		done <- applyResult{code: code, message: message}
+	}()
+	select {
+	case res := <-done:
+		return res.code, res.message
+	case <-ctx.Done():
+		t.stuck = true
+		return common.ResultCodeSyncFailed, fmt.Sprintf("apply did not complete within %s", sc.resourceTimeout)
+	}
+}
+
+// processStuckTasks finds the running tasks which have been waited on for longer than the resource timeout, and
+// either fails them or, if stuck resources are skipped, marks them as successful so that the sync can continue.
+func (sc *syncContext) processStuckTasks(runningTasks syncTasks) {
+	now := time.Now()
+	for _, task := range runningTasks {
+		sc.lock.Lock()
+		runningSince := sc.syncRes[task.resultKey()].RunningSince
+		sc.lock.Unlock()
+		if runningSince.IsZero() || now.Sub(runningSince.Time) < sc.resourceTimeout {
+			continue
+		}
+
+		reason := "become healthy"
+		if task.isHook() {
+			reason = "complete"
+		}
+		message := fmt.Sprintf("did not %s within %s", reason, sc.resourceTimeout)
+		if task.message != "" {
+			message = fmt.Sprintf("%s: %s", message, task.message)
+		}
+		task.stuck = true
+		sc.log.WithValues("task", task, "runningSince", runningSince, "skip", sc.skipStuckResources).Info("Resource is stuck")
+		if sc.skipStuckResources {
+			sc.setResourceResult(task, task.syncStatus, common.OperationSucceeded, "skipped, "+message)
+		} else {
+			sc.setResourceResult(task, task.syncStatus, common.OperationFailed, message)
+		}
+	}
+}
+
 // setResourceResult sets a resource details in the SyncResult.Resources list
 func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.ResultCode, operationState common.OperationPhase, message string) {
 	task.syncStatus = syncStatus
@@ -1796,14 +1920,21 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 	existing, ok := sc.syncRes[task.resultKey()]
 
 	res := common.ResourceSyncResult{
-		ResourceKey: kubeutil.GetResourceKey(task.obj()),
-		Images:      kubeutil.GetResourceImages(task.obj()),
-		Version:     task.version(),
-		Status:      task.syncStatus,
-		Message:     task.message,
-		HookType:    task.hookType(),
-		HookPhase:   task.operationState,
-		SyncPhase:   task.phase,
+		ResourceKey:            kubeutil.GetResourceKey(task.obj()),
+		Images:                 kubeutil.GetResourceImages(task.obj()),
+		Version:                task.version(),
+		Status:                 task.syncStatus,
+		Message:                task.message,
+		HookType:               task.hookType(),
+		HookPhase:              task.operationState,
+		SyncPhase:              task.phase,
+		SyncWave:               task.wave(),
+		ServerSideApplyManager: task.serverSideApplyManager,
+		ForceConflicts:         task.forceConflicts,
+		Stuck:                  task.stuck,
+	}
+	if operationState.Running() {
+		res.RunningSince = metav1.Now()
 	}
 
 	logCtx := sc.log.WithValues("namespace", task.namespace(), "kind", task.kind(), "name", task.name(), "phase", task.phase)
@@ -1819,6 +1950,14 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 			existing.HookPhase = res.HookPhase
 			existing.Message = res.Message
 		}
+		if res.ServerSideApplyManager != "" {
+			existing.ServerSideApplyManager = res.ServerSideApplyManager
+			existing.ForceConflicts = res.ForceConflicts
+		}
+		if res.HookPhase.Running() && existing.RunningSince.IsZero() {
+			existing.RunningSince = res.RunningSince
+		}
+		existing.Stuck = existing.Stuck || res.Stuck
 		sc.syncRes[task.resultKey()] = existing
 	} else {
 		logCtx.Info(fmt.Sprintf("Adding resource result, status: '%s', phase: '%s', message: '%s'", res.Status, res.HookPhase, res.Message))
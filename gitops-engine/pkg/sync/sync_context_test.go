@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/klog/v2/textlogger"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
@@ -358,6 +359,13 @@ func TestSyncSuccessfully_Multistep(t *testing.T) {
 	assert.Equal(t, synccommon.OperationSucceeded, phase)
 	assert.Equal(t, "successfully synced (all tasks run)", message)
 	assert.Len(t, resources, 2)
+	for _, res := range resources {
+		if res.ResourceKey.Name == newSvc2.GetName() {
+			assert.Equal(t, 5, res.SyncWave)
+		} else {
+			assert.Equal(t, 0, res.SyncWave)
+		}
+	}
 }
 
 func TestSync_MultistepResourceDeletionMidstep(t *testing.T) {
@@ -1264,6 +1272,47 @@ func TestSync_ServerSideApply(t *testing.T) {
 	}
 }
 
+func TestSync_ServerSideApplyForceConflicts(t *testing.T) {
+	withSyncOptions := func(un *unstructured.Unstructured, options string) *unstructured.Unstructured {
+		un.SetAnnotations(map[string]string{synccommon.AnnotationSyncOptions: options})
+		return un
+	}
+	testCases := []struct {
+		name                   string
+		target                 *unstructured.Unstructured
+		forceConflicts         bool
+		expectedForceConflicts bool
+		expectedManager        string
+	}{
+		{"Default", testingutils.NewPod(), true, true, "managerA"},
+		{"DisabledBySyncContext", testingutils.NewPod(), false, false, "managerA"},
+		{"DisabledByAnnotation", withSyncOptions(testingutils.NewPod(), synccommon.SyncOptionDisableServerSideApplyForceConflicts), true, false, "managerA"},
+		{"EnabledByAnnotation", withSyncOptions(testingutils.NewPod(), synccommon.SyncOptionServerSideApplyForceConflicts), false, true, "managerA"},
+		{"ClientSideApply", withDisableServerSideApplyAnnotation(testingutils.NewPod()), true, true, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			syncCtx := newTestSyncCtx(nil, WithServerSideApply(true), WithServerSideApplyManager("managerA"), WithServerSideApplyForceConflicts(tc.forceConflicts))
+			tc.target.SetNamespace(testingutils.FakeArgoCDNamespace)
+			syncCtx.resources = groupResources(ReconciliationResult{
+				Live:   []*unstructured.Unstructured{testingutils.NewPod()},
+				Target: []*unstructured.Unstructured{tc.target},
+			})
+
+			syncCtx.Sync(context.Background())
+
+			resourceOps, _ := syncCtx.resourceOps.(*kubetest.MockResourceOps)
+			assert.Equal(t, tc.expectedForceConflicts, resourceOps.GetLastForceConflicts())
+			_, _, resources := syncCtx.GetState()
+			require.Len(t, resources, 1)
+			assert.Equal(t, tc.expectedManager, resources[0].ServerSideApplyManager)
+			assert.Equal(t, tc.expectedManager != "" && tc.expectedForceConflicts, resources[0].ForceConflicts)
+		})
+	}
+}
+
 func TestSyncContext_ServerSideApplyWithDryRun(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -3331,3 +3380,121 @@ func TestTerminate_Hooks_Error(t *testing.T) {
 	assert.Equal(t, synccommon.OperationError, results[0].HookPhase)
 	assert.Contains(t, results[0].Message, "update failed")
 }
+
+func TestSync_StuckResources(t *testing.T) {
+	newSyncCtx := func(opts ...SyncOpt) (*syncContext, *unstructured.Unstructured, *unstructured.Unstructured) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "-1"})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod2.SetNamespace(testingutils.FakeArgoCDNamespace)
+
+		opts = append(opts,
+			WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+				pod1.GetName(): health.HealthStatusProgressing,
+			})),
+			WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+				ResourceKey:  kube.GetResourceKey(pod1),
+				HookPhase:    synccommon.OperationRunning,
+				Status:       synccommon.ResultCodeSynced,
+				SyncPhase:    synccommon.SyncPhaseSync,
+				SyncWave:     -1,
+				Message:      "test",
+				RunningSince: metav1.NewTime(time.Now().Add(-10 * time.Minute)),
+			}}, metav1.Now()),
+		)
+		syncCtx := newTestSyncCtx(nil, opts...)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{pod1, nil},
+			Target: []*unstructured.Unstructured{pod1, pod2},
+		})
+		return syncCtx, pod1, pod2
+	}
+
+	t.Run("NoTimeout", func(t *testing.T) {
+		syncCtx, pod1, pod2 := newSyncCtx()
+		syncCtx.Sync(context.Background())
+
+		phase, message, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Equal(t, "waiting for healthy state of /Pod/pod-1", message)
+		assert.False(t, getResourceResult(resources, kube.GetResourceKey(pod1)).Stuck)
+		assert.Nil(t, getResourceResult(resources, kube.GetResourceKey(pod2)))
+	})
+
+	t.Run("NotStuckYet", func(t *testing.T) {
+		syncCtx, pod1, _ := newSyncCtx(WithResourceTimeout(time.Hour, false))
+		syncCtx.Sync(context.Background())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.False(t, getResourceResult(resources, kube.GetResourceKey(pod1)).Stuck)
+	})
+
+	t.Run("Fail", func(t *testing.T) {
+		syncCtx, pod1, pod2 := newSyncCtx(WithResourceTimeout(5*time.Minute, false))
+		syncCtx.Sync(context.Background())
+
+		phase, message, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "one or more synchronization tasks did not complete within 5m0s", message)
+		pod1Res := getResourceResult(resources, kube.GetResourceKey(pod1))
+		require.NotNil(t, pod1Res)
+		assert.True(t, pod1Res.Stuck)
+		assert.Equal(t, synccommon.OperationFailed, pod1Res.HookPhase)
+		assert.Equal(t, "did not become healthy within 5m0s: test", pod1Res.Message)
+		assert.Nil(t, getResourceResult(resources, kube.GetResourceKey(pod2)))
+	})
+
+	t.Run("Skip", func(t *testing.T) {
+		syncCtx, pod1, pod2 := newSyncCtx(WithResourceTimeout(5*time.Minute, true))
+		syncCtx.Sync(context.Background())
+
+		phase, _, resources := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		pod1Res := getResourceResult(resources, kube.GetResourceKey(pod1))
+		require.NotNil(t, pod1Res)
+		assert.True(t, pod1Res.Stuck)
+		assert.Equal(t, synccommon.OperationSucceeded, pod1Res.HookPhase)
+		assert.Equal(t, "skipped, did not become healthy within 5m0s: test", pod1Res.Message)
+		pod2Res := getResourceResult(resources, kube.GetResourceKey(pod2))
+		require.NotNil(t, pod2Res)
+		assert.False(t, pod2Res.Stuck)
+		assert.Equal(t, synccommon.OperationRunning, pod2Res.HookPhase)
+		assert.False(t, pod2Res.RunningSince.IsZero())
+	})
+}
+
+// blockingApplyResourceOps blocks the applies, other than dry-runs, until they are cancelled
+type blockingApplyResourceOps struct {
+	*kubetest.MockResourceOps
+}
+
+func (r *blockingApplyResourceOps) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRun cmdutil.DryRunStrategy, force, validate, serverSideApply, forceConflicts bool, manager string) (string, error) {
+	if dryRun != cmdutil.DryRunNone {
+		return r.MockResourceOps.ApplyResource(ctx, obj, dryRun, force, validate, serverSideApply, forceConflicts, manager)
+	}
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+func TestSync_ApplyTimeout(t *testing.T) {
+	syncCtx := newTestSyncCtx(nil, WithResourceTimeout(10*time.Millisecond, false))
+	syncCtx.resourceOps = &blockingApplyResourceOps{MockResourceOps: &kubetest.MockResourceOps{}}
+	pod := testingutils.NewPod()
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   []*unstructured.Unstructured{nil},
+		Target: []*unstructured.Unstructured{pod},
+	})
+
+	syncCtx.Sync(context.Background())
+
+	phase, _, resources := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationFailed, phase)
+	require.Len(t, resources, 1)
+	assert.True(t, resources[0].Stuck)
+	assert.Equal(t, synccommon.ResultCodeSyncFailed, resources[0].Status)
+	assert.Equal(t, "apply did not complete within 10ms", resources[0].Message)
+}
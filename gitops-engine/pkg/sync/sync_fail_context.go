@@ -0,0 +1,94 @@
+package sync
+
+import (
+	"encoding/json"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// failedResource describes a resource which failed to sync, as exposed to the SyncFail hooks
+type failedResource struct {
+	Group     string `json:"group"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+}
+
+// podSpecPaths are the paths of the pod specs of the resources which run containers
+var podSpecPaths = [][]string{
+	{"spec", "template", "spec"},
+	{"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// withSyncFailContext returns a copy of the SyncFail hook with the failure context of the sync injected as environment
+// variables into all of its containers, so that the hook can act on what actually failed. Environment variables already
+// defined by the containers are not overridden.
+func withSyncFailContext(hook *unstructured.Unstructured, message string, syncFailedTasks syncTasks) *unstructured.Unstructured {
+	failedResources := make([]failedResource, 0, len(syncFailedTasks))
+	for _, task := range syncFailedTasks {
+		failedResources = append(failedResources, failedResource{
+			Group:     task.group(),
+			Kind:      task.kind(),
+			Namespace: task.namespace(),
+			Name:      task.name(),
+			Message:   task.message,
+		})
+	}
+	failedResourcesJSON, err := json.Marshal(failedResources)
+	if err != nil {
+		return hook
+	}
+	env := []corev1.EnvVar{
+		{Name: common.EnvSyncFailMessage, Value: message},
+		{Name: common.EnvSyncFailedResources, Value: string(failedResourcesJSON)},
+	}
+
+	paths := podSpecPaths
+	if hook.GetKind() == kubeutil.PodKind {
+		paths = [][]string{{"spec"}}
+	}
+	hook = hook.DeepCopy()
+	for _, path := range paths {
+		podSpec, found, err := unstructured.NestedMap(hook.Object, path...)
+		if err != nil || !found {
+			continue
+		}
+		for _, field := range []string{"initContainers", "containers"} {
+			injectContainersEnv(podSpec, field, env)
+		}
+		_ = unstructured.SetNestedMap(hook.Object, podSpec, path...)
+	}
+	return hook
+}
+
+func injectContainersEnv(podSpec map[string]any, field string, env []corev1.EnvVar) {
+	containers, found, err := unstructured.NestedSlice(podSpec, field)
+	if err != nil || !found {
+		return
+	}
+	for i := range containers {
+		container, ok := containers[i].(map[string]any)
+		if !ok {
+			continue
+		}
+		containerEnv, _, _ := unstructured.NestedSlice(container, "env")
+		for _, envVar := range env {
+			if slices.ContainsFunc(containerEnv, func(item any) bool {
+				existing, ok := item.(map[string]any)
+				return ok && existing["name"] == envVar.Name
+			}) {
+				continue
+			}
+			containerEnv = append(containerEnv, map[string]any{"name": envVar.Name, "value": envVar.Value})
+		}
+		container["env"] = containerEnv
+		containers[i] = container
+	}
+	podSpec[field] = containers
+}
@@ -0,0 +1,93 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	testingutils "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+)
+
+func TestWithSyncFailContext(t *testing.T) {
+	failedPod := testingutils.NewPod()
+	failedPod.SetNamespace(testingutils.FakeArgoCDNamespace)
+	syncFailedTasks := syncTasks{{
+		phase:      synccommon.SyncPhaseSync,
+		targetObj:  failedPod,
+		syncStatus: synccommon.ResultCodeSyncFailed,
+		message:    "pods \"my-pod\" is forbidden",
+	}}
+	expectedFailedResources := `[{"group":"","kind":"Pod","namespace":"fake-argocd-ns","name":"my-pod","message":"pods \"my-pod\" is forbidden"}]`
+
+	containersEnv := func(t *testing.T, obj *unstructured.Unstructured, path ...string) []map[string]string {
+		t.Helper()
+		containers, found, err := unstructured.NestedSlice(obj.Object, path...)
+		require.NoError(t, err)
+		require.True(t, found)
+		var envs []map[string]string
+		for _, c := range containers {
+			env := map[string]string{}
+			items, _, _ := unstructured.NestedSlice(c.(map[string]any), "env")
+			for _, item := range items {
+				envVar := item.(map[string]any)
+				env[envVar["name"].(string)], _ = envVar["value"].(string)
+			}
+			envs = append(envs, env)
+		}
+		return envs
+	}
+
+	t.Run("Pod", func(t *testing.T) {
+		hook := newHook("sync-fail-hook", synccommon.HookTypeSyncFail, synccommon.HookDeletePolicyHookSucceeded)
+		require.NoError(t, unstructured.SetNestedSlice(hook.Object, []any{map[string]any{
+			"name":  "main",
+			"image": "alpine",
+			"env":   []any{map[string]any{"name": synccommon.EnvSyncFailMessage, "value": "custom"}},
+		}}, "spec", "containers"))
+
+		injected := withSyncFailContext(hook, "one or more objects failed to apply", syncFailedTasks)
+
+		assert.Equal(t, []map[string]string{{
+			synccommon.EnvSyncFailMessage:     "custom",
+			synccommon.EnvSyncFailedResources: expectedFailedResources,
+		}}, containersEnv(t, injected, "spec", "containers"))
+		// the original hook is not modified
+		assert.Len(t, containersEnv(t, hook, "spec", "containers")[0], 1)
+	})
+
+	t.Run("Job", func(t *testing.T) {
+		hook := testingutils.Unstructured(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: sync-fail-hook
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: alpine
+      containers:
+      - name: main
+        image: alpine
+`)
+
+		injected := withSyncFailContext(hook, "one or more objects failed to apply", syncFailedTasks)
+
+		expectedEnv := []map[string]string{{
+			synccommon.EnvSyncFailMessage:     "one or more objects failed to apply",
+			synccommon.EnvSyncFailedResources: expectedFailedResources,
+		}}
+		assert.Equal(t, expectedEnv, containersEnv(t, injected, "spec", "template", "spec", "initContainers"))
+		assert.Equal(t, expectedEnv, containersEnv(t, injected, "spec", "template", "spec", "containers"))
+	})
+
+	t.Run("WithoutContainers", func(t *testing.T) {
+		hook := testingutils.NewService()
+
+		assert.Equal(t, hook, withSyncFailContext(hook, "one or more objects failed to apply", syncFailedTasks))
+	})
+}
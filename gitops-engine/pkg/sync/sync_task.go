@@ -24,6 +24,11 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	// the field manager and the force conflicts flag used to apply the target object with server-side apply
+	serverSideApplyManager string
+	forceConflicts         bool
+	// indicates the task did not complete within the resource timeout
+	stuck bool
 }
 
 func ternary(val bool, a, b string) string {
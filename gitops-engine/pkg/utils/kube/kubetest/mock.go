@@ -132,12 +132,12 @@ func (k *MockKubectlCmd) ManageServerSideDiffDryRuns(config *rest.Config) (diff.
 // MockKubeApplier is a mock implementation of diff.KubeApplier for testing
 type MockKubeApplier struct {
 	// ApplyResourceFunc allows custom override behavior
-	ApplyResourceFunc func(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error)
+	ApplyResourceFunc func(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force, validate, serverSideApply, forceConflicts bool, manager string) (string, error)
 }
 
-func (m *MockKubeApplier) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+func (m *MockKubeApplier) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy util.DryRunStrategy, force, validate, serverSideApply, forceConflicts bool, manager string) (string, error) {
 	if m.ApplyResourceFunc != nil {
-		return m.ApplyResourceFunc(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+		return m.ApplyResourceFunc(ctx, obj, dryRunStrategy, force, validate, serverSideApply, forceConflicts, manager)
 	}
 	return "", nil
 }
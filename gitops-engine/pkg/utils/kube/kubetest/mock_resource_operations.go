@@ -25,6 +25,7 @@ type MockResourceOps struct {
 	serverSideApply        bool
 	serverSideApplyManager string
 	lastForce              bool
+	lastForceConflicts     bool
 
 	recordLock sync.RWMutex
 
@@ -89,6 +90,19 @@ func (r *MockResourceOps) GetLastForce() bool {
 	return force
 }
 
+func (r *MockResourceOps) SetLastForceConflicts(forceConflicts bool) {
+	r.recordLock.Lock()
+	r.lastForceConflicts = forceConflicts
+	r.recordLock.Unlock()
+}
+
+func (r *MockResourceOps) GetLastForceConflicts() bool {
+	r.recordLock.RLock()
+	forceConflicts := r.lastForceConflicts
+	r.recordLock.RUnlock()
+	return forceConflicts
+}
+
 func (r *MockResourceOps) SetLastResourceCommand(key kube.ResourceKey, cmd string) {
 	r.recordLock.Lock()
 	if r.lastCommandPerResource == nil {
@@ -107,7 +121,7 @@ func (r *MockResourceOps) GetLastResourceCommand(key kube.ResourceKey) string {
 	return r.lastCommandPerResource[key]
 }
 
-func (r *MockResourceOps) ApplyResource(_ context.Context, obj *unstructured.Unstructured, dryRun cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+func (r *MockResourceOps) ApplyResource(_ context.Context, obj *unstructured.Unstructured, dryRun cmdutil.DryRunStrategy, force, validate, serverSideApply, forceConflicts bool, manager string) (string, error) {
 	if dryRun != cmdutil.DryRunNone && !r.ExecuteForDryRun {
 		return "", nil
 	}
@@ -115,6 +129,7 @@ func (r *MockResourceOps) ApplyResource(_ context.Context, obj *unstructured.Uns
 	r.SetLastServerSideApply(serverSideApply)
 	r.SetLastServerSideApplyManager(manager)
 	r.SetLastForce(force)
+	r.SetLastForceConflicts(forceConflicts)
 	r.SetLastResourceCommand(kube.GetResourceKey(obj), "apply")
 	command, ok := r.Commands[obj.GetName()]
 	if !ok {
@@ -117,7 +117,7 @@ func TestAuthReconcileUsage(t *testing.T) {
 		cmdMocks.On("Apply", mock.Anything).Return(nil)
 
 		ssa := true
-		_, err := k.ApplyResource(t.Context(), role, cmdutil.DryRunNone, false, false, ssa, "")
+		_, err := k.ApplyResource(t.Context(), role, cmdutil.DryRunNone, false, false, ssa, ssa, "")
 		require.NoError(t, err)
 		cmdMocks.AssertNotCalled(t, "AuthReconcile")
 	})
@@ -129,7 +129,7 @@ func TestAuthReconcileUsage(t *testing.T) {
 		cmdMocks.On("AuthReconcile", mock.Anything).Return(nil)
 
 		ssa := false
-		_, err := k.ApplyResource(t.Context(), role, cmdutil.DryRunNone, false, false, ssa, "")
+		_, err := k.ApplyResource(t.Context(), role, cmdutil.DryRunNone, false, false, ssa, ssa, "")
 		require.NoError(t, err)
 	})
 }
@@ -164,7 +164,7 @@ func TestOutputModeLog(t *testing.T) {
 		cmdMocks.On("Apply", mock.Anything).Return(nil)
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, false, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, false, false, "test-manager")
 		require.NoError(t, err)
 	})
 
@@ -174,7 +174,7 @@ func TestOutputModeLog(t *testing.T) {
 		cmdMocks.On("Apply", mock.Anything).Return(nil)
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, true, "test-manager")
 		require.NoError(t, err)
 	})
 }
@@ -211,7 +211,7 @@ func TestOutputModeJSON(t *testing.T) {
 		k.outputMode = outputModeJSON
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, true, "test-manager")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid dry run strategy used with JSON output")
 	})
@@ -222,7 +222,7 @@ func TestOutputModeJSON(t *testing.T) {
 		k.outputMode = outputModeJSON
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunClient, false, false, true, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunClient, false, false, true, true, "test-manager")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid dry run strategy used with JSON output")
 	})
@@ -233,7 +233,7 @@ func TestOutputModeJSON(t *testing.T) {
 		k.outputMode = outputModeJSON
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, false, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, false, false, "test-manager")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "invalid Apply strategy used with JSON output")
 	})
@@ -252,7 +252,7 @@ func TestOutputModeJSON(t *testing.T) {
 			require.NoError(t, err)
 		}).Return(nil)
 
-		result, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, "test-manager")
+		result, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, true, "test-manager")
 		require.NoError(t, err)
 		assert.JSONEq(t, string(jsonObj), result)
 	})
@@ -275,7 +275,7 @@ func TestOutputModeJSON(t *testing.T) {
 			require.NoError(t, err)
 		}).Return(nil)
 
-		result, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, "test-manager")
+		result, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, true, "test-manager")
 		require.NoError(t, err)
 		assert.JSONEq(t, string(jsonObj), result)
 	})
@@ -293,7 +293,7 @@ func TestOutputModeJSON(t *testing.T) {
 			require.NoError(t, err)
 		}).Return(nil)
 
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, true, "test-manager")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "error message")
 	})
@@ -324,7 +324,7 @@ func TestApplyOptionsConfiguration(t *testing.T) {
 				}).Return(nil)
 
 				obj := testingutils.NewPod()
-				_, err := k.ApplyResource(t.Context(), obj, tc.strategy, false, false, false, "test-manager")
+				_, err := k.ApplyResource(t.Context(), obj, tc.strategy, false, false, false, false, "test-manager")
 				require.NoError(t, err)
 
 				assert.Equal(t, tc.strategy, capturedOpts.DryRunStrategy)
@@ -369,7 +369,7 @@ func TestApplyOptionsConfiguration(t *testing.T) {
 
 				ssa := true
 				obj := testingutils.NewPod()
-				_, err := k.ApplyResource(t.Context(), obj, tc.strategy, false, false, ssa, "test-manager")
+				_, err := k.ApplyResource(t.Context(), obj, tc.strategy, false, false, ssa, ssa, "test-manager")
 
 				if tc.expectedError == "" {
 					require.NoError(t, err)
@@ -383,6 +383,22 @@ func TestApplyOptionsConfiguration(t *testing.T) {
 		}
 	})
 
+	t.Run("forceConflicts=false sets ServerSideApply=true and ForceConflicts=false", func(t *testing.T) {
+		t.Parallel()
+		k, cmdMocks := newTestKubectlResourceOperations(t)
+
+		var capturedOpts *apply.ApplyOptions
+		cmdMocks.On("Apply", mock.Anything).Run(func(args mock.Arguments) {
+			capturedOpts = args[0].(*apply.ApplyOptions)
+		}).Return(nil)
+
+		obj := testingutils.NewPod()
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, false, "test-manager")
+		require.NoError(t, err)
+		assert.True(t, capturedOpts.ServerSideApply)
+		assert.False(t, capturedOpts.ForceConflicts)
+	})
+
 	t.Run("force=true sets DeleteOptions.ForceDeletion", func(t *testing.T) {
 		t.Parallel()
 		testCases := []struct {
@@ -405,7 +421,7 @@ func TestApplyOptionsConfiguration(t *testing.T) {
 				}).Return(nil)
 
 				obj := testingutils.NewPod()
-				_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, true, false, false, "")
+				_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, true, false, false, false, "")
 				require.NoError(t, err)
 
 				assert.True(t, capturedOpts.DeleteOptions.ForceDeletion)
@@ -424,7 +440,7 @@ func TestApplyOptionsConfiguration(t *testing.T) {
 		}).Return(nil)
 
 		obj := testingutils.NewPod()
-		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, "test-manager")
+		_, err := k.ApplyResource(t.Context(), obj, cmdutil.DryRunServer, false, false, true, true, "test-manager")
 		require.NoError(t, err)
 
 		// Call ToPrinter and verify it returns a JSON printer
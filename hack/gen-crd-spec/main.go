@@ -21,16 +21,26 @@ var kindToCRDPath = map[string]string{
 	application.ApplicationFullName:    "manifests/crds/application-crd.yaml",
 	application.AppProjectFullName:     "manifests/crds/appproject-crd.yaml",
 	application.ApplicationSetFullName: "manifests/crds/applicationset-crd.yaml",
+	application.RepositoryFullName:     "manifests/crds/repository-crd.yaml",
+	application.ClusterFullName:        "manifests/crds/cluster-crd.yaml",
 }
 
 func getCustomResourceDefinitions(ctx context.Context) map[string]*apiextensionsv1.CustomResourceDefinition {
-	crdYamlBytes, err := exec.CommandContext(ctx,
-		"controller-gen",
-		"paths=./pkg/apis/application/...",
-		"crd:crdVersions=v1",
-		"output:crd:stdout",
-	).Output()
-	checkErr(err)
+	var crdYamlBytes []byte
+	// the packages are generated separately, because both of them declare Repository and Cluster types in the same
+	// API group and version, which controller-gen would otherwise consider to be versions of the same CRD. The draft
+	// v1alpha2 application API is not served by the CRD yet and is left out.
+	for _, path := range []string{"./pkg/apis/application/v1alpha1", "./pkg/apis/registration/..."} {
+		out, err := exec.CommandContext(ctx,
+			"controller-gen",
+			"paths="+path,
+			"crd:crdVersions=v1",
+			"output:crd:stdout",
+		).Output()
+		checkErr(err)
+		crdYamlBytes = append(crdYamlBytes, []byte("\n---\n")...)
+		crdYamlBytes = append(crdYamlBytes, out...)
+	}
 
 	// clean up stuff left by controller-gen
 	deleteFile("config/webhook/manifests.yaml")
@@ -38,6 +48,8 @@ func getCustomResourceDefinitions(ctx context.Context) map[string]*apiextensions
 	deleteFile("config/argoproj.io_applications.yaml")
 	deleteFile("config/argoproj.io_appprojects.yaml")
 	deleteFile("config/argoproj.io_applicationsets.yaml")
+	deleteFile("config/argoproj.io_repositories.yaml")
+	deleteFile("config/argoproj.io_clusters.yaml")
 	deleteFile("config")
 
 	objs, err := kube.SplitYAML(crdYamlBytes)
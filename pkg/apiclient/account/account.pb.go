@@ -405,9 +405,13 @@ func (m *AccountsList) GetItems() []*Account {
 }
 
 type Token struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IssuedAt             int64    `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
-	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssuedAt  int64  `protobuf:"varint,2,opt,name=issuedAt,proto3" json:"issuedAt,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// audience lists the URLs of the Argo CD instances which accept the token
+	Audience []string `protobuf:"bytes,4,rep,name=audience,proto3" json:"audience,omitempty"`
+	// revokedAt is the time the token was revoked, in seconds since the epoch
+	RevokedAt            int64    `protobuf:"varint,5,opt,name=revokedAt,proto3" json:"revokedAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -467,6 +471,20 @@ func (m *Token) GetExpiresAt() int64 {
 	return 0
 }
 
+func (m *Token) GetAudience() []string {
+	if m != nil {
+		return m.Audience
+	}
+	return nil
+}
+
+func (m *Token) GetRevokedAt() int64 {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return 0
+}
+
 type TokensList struct {
 	Items                []*Token `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -517,8 +535,12 @@ func (m *TokensList) GetItems() []*Token {
 type CreateTokenRequest struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresIn int64  `protobuf:"varint,2,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// expiresAt is the time the token expires, in seconds since the epoch. It is an alternative to expiresIn.
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// audience restricts the token to the Argo CD instances with one of the given URLs
+	Audience             []string `protobuf:"bytes,5,rep,name=audience,proto3" json:"audience,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -578,6 +600,20 @@ func (m *CreateTokenRequest) GetId() string {
 	return ""
 }
 
+func (m *CreateTokenRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CreateTokenRequest) GetAudience() []string {
+	if m != nil {
+		return m.Audience
+	}
+	return nil
+}
+
 type CreateTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -719,6 +755,238 @@ func (m *ListAccountRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListAccountRequest proto.InternalMessageInfo
 
+type ListTokensRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTokensRequest) Reset()         { *m = ListTokensRequest{} }
+func (m *ListTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTokensRequest) ProtoMessage()    {}
+func (*ListTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{13}
+}
+func (m *ListTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTokensRequest.Merge(m, src)
+}
+func (m *ListTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTokensRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTokensRequest proto.InternalMessageInfo
+
+func (m *ListTokensRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RevokeTokenRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenRequest) Reset()         { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()    {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{14}
+}
+func (m *RevokeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenRequest.Merge(m, src)
+}
+func (m *RevokeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeTokenRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RevokeTokenRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type IntrospectTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IntrospectTokenRequest) Reset()         { *m = IntrospectTokenRequest{} }
+func (m *IntrospectTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*IntrospectTokenRequest) ProtoMessage()    {}
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{15}
+}
+func (m *IntrospectTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntrospectTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntrospectTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntrospectTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntrospectTokenRequest.Merge(m, src)
+}
+func (m *IntrospectTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntrospectTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntrospectTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntrospectTokenRequest proto.InternalMessageInfo
+
+func (m *IntrospectTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type TokenIntrospection struct {
+	// active is true if the token is accepted by this Argo CD instance
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// reason explains why the token is not active
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// name is the name of the account of the token
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// capability is the capability of the account the token was issued for
+	Capability           string   `protobuf:"bytes,4,opt,name=capability,proto3" json:"capability,omitempty"`
+	Token                *Token   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenIntrospection) Reset()         { *m = TokenIntrospection{} }
+func (m *TokenIntrospection) String() string { return proto.CompactTextString(m) }
+func (*TokenIntrospection) ProtoMessage()    {}
+func (*TokenIntrospection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_56d089a9b5e998c0, []int{16}
+}
+func (m *TokenIntrospection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenIntrospection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenIntrospection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenIntrospection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenIntrospection.Merge(m, src)
+}
+func (m *TokenIntrospection) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenIntrospection) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenIntrospection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenIntrospection proto.InternalMessageInfo
+
+func (m *TokenIntrospection) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *TokenIntrospection) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TokenIntrospection) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenIntrospection) GetCapability() string {
+	if m != nil {
+		return m.Capability
+	}
+	return ""
+}
+
+func (m *TokenIntrospection) GetToken() *Token {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
 type EmptyResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -729,7 +997,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_56d089a9b5e998c0, []int{13}
+	return fileDescriptor_56d089a9b5e998c0, []int{17}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,60 +1040,76 @@ func init() {
 	proto.RegisterType((*CreateTokenResponse)(nil), "account.CreateTokenResponse")
 	proto.RegisterType((*DeleteTokenRequest)(nil), "account.DeleteTokenRequest")
 	proto.RegisterType((*ListAccountRequest)(nil), "account.ListAccountRequest")
+	proto.RegisterType((*ListTokensRequest)(nil), "account.ListTokensRequest")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "account.RevokeTokenRequest")
+	proto.RegisterType((*IntrospectTokenRequest)(nil), "account.IntrospectTokenRequest")
+	proto.RegisterType((*TokenIntrospection)(nil), "account.TokenIntrospection")
 	proto.RegisterType((*EmptyResponse)(nil), "account.EmptyResponse")
 }
 
 func init() { proto.RegisterFile("server/account/account.proto", fileDescriptor_56d089a9b5e998c0) }
 
 var fileDescriptor_56d089a9b5e998c0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0xa6, 0x3f, 0x27, 0xb9, 0xe9, 0xed, 0xdc, 0x36, 0xd7, 0x32, 0x21, 0xa4, 0xd3,
-	0xaa, 0x0d, 0x41, 0xad, 0x45, 0x8b, 0x10, 0xaa, 0x60, 0xd1, 0x16, 0x84, 0x2a, 0xb1, 0x80, 0xf0,
-	0xb3, 0x28, 0xab, 0x89, 0x33, 0x0a, 0x43, 0x13, 0xdb, 0xf5, 0x8c, 0x13, 0x50, 0x94, 0x0d, 0x3c,
-	0x02, 0x5b, 0x1e, 0x88, 0x25, 0x12, 0x2f, 0x80, 0x2a, 0x1e, 0x04, 0x79, 0x3c, 0xe3, 0x38, 0x4e,
-	0x8a, 0x58, 0xd9, 0xe7, 0x67, 0xce, 0xf7, 0x9d, 0x6f, 0xce, 0xb1, 0xa1, 0xca, 0x69, 0x30, 0xa0,
-	0x81, 0x4d, 0x1c, 0xc7, 0x0b, 0x5d, 0xa1, 0x9f, 0xfb, 0x7e, 0xe0, 0x09, 0x0f, 0x2d, 0x29, 0xd3,
-	0xaa, 0x76, 0x3d, 0xaf, 0xdb, 0xa3, 0x36, 0xf1, 0x99, 0x4d, 0x5c, 0xd7, 0x13, 0x44, 0x30, 0xcf,
-	0xe5, 0x71, 0x1a, 0x1e, 0xc2, 0xc6, 0x6b, 0xbf, 0x43, 0x04, 0x7d, 0x4e, 0x38, 0x1f, 0x7a, 0x41,
-	0xa7, 0x45, 0x2f, 0x43, 0xca, 0x05, 0xaa, 0x43, 0xd1, 0xa5, 0x43, 0xed, 0x35, 0x8d, 0xba, 0xd1,
-	0x58, 0x69, 0xa5, 0x5d, 0xa8, 0x01, 0xab, 0x4e, 0x18, 0x04, 0xd4, 0x15, 0x49, 0x56, 0x4e, 0x66,
-	0x65, 0xdd, 0x08, 0xc1, 0x82, 0x4b, 0xfa, 0xd4, 0xcc, 0xcb, 0xb0, 0x7c, 0xc7, 0x26, 0x54, 0xb2,
-	0xc0, 0xdc, 0xf7, 0x5c, 0x4e, 0xb1, 0x03, 0xc5, 0x53, 0xe2, 0x9e, 0x69, 0x22, 0x16, 0x2c, 0x07,
-	0x94, 0x7b, 0x61, 0xe0, 0x50, 0xc5, 0x22, 0xb1, 0x51, 0x05, 0x16, 0x89, 0x13, 0xb5, 0xa3, 0x90,
-	0x95, 0x15, 0x91, 0xe7, 0x61, 0x3b, 0x39, 0x16, 0xe3, 0xa6, 0x5d, 0x78, 0x1b, 0x4a, 0x31, 0x48,
-	0x0c, 0x8a, 0xd6, 0xa1, 0x30, 0x20, 0xbd, 0x50, 0x43, 0xc4, 0x06, 0xde, 0x85, 0xb5, 0xa7, 0x54,
-	0x1c, 0xc7, 0x4a, 0x6a, 0x42, 0xba, 0x1b, 0x23, 0xd5, 0xcd, 0x67, 0x03, 0x96, 0x54, 0xda, 0xbc,
-	0x38, 0x32, 0x61, 0x89, 0xba, 0xa4, 0xdd, 0xa3, 0xb1, 0x46, 0xcb, 0x2d, 0x6d, 0x22, 0x0c, 0x25,
-	0x87, 0xf8, 0xa4, 0xcd, 0x7a, 0x4c, 0x30, 0xca, 0xcd, 0x7c, 0x3d, 0xdf, 0x58, 0x69, 0x4d, 0xf9,
-	0xd0, 0x0e, 0x2c, 0x0a, 0xef, 0x82, 0xba, 0xdc, 0x5c, 0xa8, 0xe7, 0x1b, 0xc5, 0x83, 0xf2, 0xbe,
-	0xbe, 0xeb, 0x57, 0x91, 0xbb, 0xa5, 0xa2, 0xf8, 0x3e, 0x94, 0x14, 0x09, 0xfe, 0x8c, 0x71, 0x81,
-	0x76, 0xa0, 0xc0, 0x04, 0xed, 0x73, 0xd3, 0x90, 0xc7, 0xfe, 0x4d, 0x8e, 0xe9, 0x8e, 0xe2, 0x30,
-	0x7e, 0x01, 0x05, 0x59, 0x08, 0x95, 0x21, 0xc7, 0xf4, 0x5d, 0xe7, 0x58, 0x27, 0xd2, 0x9e, 0x71,
-	0x1e, 0xd2, 0xce, 0xb1, 0x90, 0xbc, 0xf3, 0xad, 0xc4, 0x46, 0x55, 0x58, 0xa1, 0x1f, 0x7c, 0x16,
-	0x50, 0x7e, 0x2c, 0xa4, 0xc2, 0xf9, 0xd6, 0xc4, 0x81, 0x0f, 0x00, 0x64, 0xc9, 0x98, 0xc8, 0xf6,
-	0x34, 0x91, 0x2c, 0x7f, 0x45, 0xe3, 0x0d, 0xa0, 0xd3, 0x80, 0x12, 0x41, 0x63, 0xef, 0xf5, 0x72,
-	0xa7, 0xb0, 0xcf, 0x5c, 0x45, 0x6c, 0xe2, 0x50, 0x5d, 0xe4, 0x75, 0x17, 0xf8, 0x0e, 0xfc, 0x37,
-	0x55, 0x77, 0x72, 0xe5, 0x52, 0x37, 0x7d, 0xe5, 0xd2, 0xc0, 0x0f, 0x00, 0x3d, 0xa6, 0x3d, 0xfa,
-	0x17, 0x24, 0x62, 0x98, 0x5c, 0x02, 0xb3, 0x0e, 0x28, 0x6a, 0x76, 0x7a, 0x5a, 0xf0, 0x2a, 0xfc,
-	0xf3, 0xa4, 0xef, 0x8b, 0x8f, 0x1a, 0xf6, 0xe0, 0x6b, 0x01, 0xca, 0x2a, 0xe7, 0x25, 0x0d, 0x06,
-	0xcc, 0xa1, 0x68, 0x08, 0x0b, 0xd1, 0x30, 0xa2, 0xf5, 0x44, 0x97, 0xd4, 0x02, 0x58, 0x1b, 0x19,
-	0xaf, 0x5a, 0x93, 0x93, 0x4f, 0x3f, 0x7e, 0x7d, 0xc9, 0x3d, 0x44, 0x47, 0x72, 0xb3, 0x07, 0x77,
-	0x93, 0xef, 0x80, 0x43, 0xdc, 0x3d, 0x66, 0x8f, 0xf4, 0xa8, 0x8f, 0xed, 0x51, 0xbc, 0x15, 0x63,
-	0x7b, 0x94, 0xda, 0x80, 0x47, 0xcd, 0xe6, 0x18, 0x0d, 0xa0, 0x3c, 0xbd, 0x84, 0xa8, 0x96, 0x80,
-	0xcd, 0xfd, 0x2c, 0x58, 0xb7, 0xae, 0x8d, 0x2b, 0x5a, 0x5b, 0x92, 0xd6, 0x4d, 0xcb, 0xcc, 0xd2,
-	0xf2, 0x55, 0xe6, 0x91, 0xd1, 0x44, 0x6f, 0xa1, 0x94, 0x92, 0x8a, 0xa3, 0x1b, 0x49, 0xd5, 0x59,
-	0x05, 0x53, 0xfd, 0xa7, 0x87, 0x1b, 0xff, 0x2f, 0x81, 0xd6, 0xd0, 0x6a, 0x06, 0x08, 0x9d, 0x03,
-	0x4c, 0x96, 0x16, 0x59, 0xc9, 0xe9, 0x99, 0x4d, 0xb6, 0x66, 0x16, 0x02, 0xd7, 0x64, 0x51, 0x13,
-	0x55, 0xb2, 0xec, 0x47, 0xd1, 0x95, 0x8f, 0xd1, 0x25, 0x14, 0x53, 0xa3, 0x94, 0xe2, 0x3d, 0x3b,
-	0xb8, 0x56, 0x75, 0x7e, 0x50, 0xe9, 0xb4, 0x2b, 0x91, 0x36, 0x71, 0x75, 0x3e, 0x92, 0x2d, 0xa7,
-	0x31, 0xd2, 0xaa, 0x0f, 0xc5, 0xd4, 0x40, 0xa6, 0x20, 0x67, 0xc7, 0xd4, 0xaa, 0x24, 0xc1, 0xa9,
-	0x99, 0xc3, 0xb7, 0x25, 0xd8, 0x56, 0x73, 0xf3, 0x4f, 0x60, 0xf6, 0x88, 0x75, 0xc6, 0x27, 0x27,
-	0xdf, 0xae, 0x6a, 0xc6, 0xf7, 0xab, 0x9a, 0xf1, 0xf3, 0xaa, 0x66, 0x9c, 0xdf, 0xeb, 0x32, 0xf1,
-	0x2e, 0x6c, 0xef, 0x3b, 0x5e, 0xdf, 0x26, 0x41, 0xd7, 0xf3, 0x03, 0xef, 0xbd, 0x7c, 0xd9, 0x73,
-	0x3a, 0xf6, 0xe0, 0xd0, 0xf6, 0x2f, 0xba, 0x51, 0x49, 0xa7, 0xc7, 0xe8, 0xe4, 0x0f, 0xd4, 0x5e,
-	0x94, 0xff, 0x96, 0xc3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x18, 0x8a, 0x2b, 0x33, 0xa2, 0x06,
-	0x00, 0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x23, 0xc5,
+	0x13, 0xd5, 0xd8, 0x71, 0x12, 0x97, 0xf3, 0x4b, 0x7e, 0x5b, 0x9b, 0x35, 0xd6, 0xac, 0x31, 0xde,
+	0xde, 0xb0, 0x6b, 0xbc, 0x4a, 0x46, 0x24, 0x08, 0xad, 0x22, 0x38, 0x24, 0x0b, 0x42, 0x91, 0x38,
+	0xa0, 0x01, 0x2e, 0xcb, 0xa9, 0x3d, 0x6e, 0x99, 0x26, 0xf6, 0xcc, 0xec, 0x74, 0x8f, 0xcd, 0xca,
+	0xf8, 0x02, 0x47, 0x2e, 0x48, 0xdc, 0xf9, 0x3c, 0x1c, 0x91, 0xf8, 0x02, 0x28, 0xe2, 0x43, 0x70,
+	0x44, 0xdd, 0x3d, 0xff, 0xed, 0x04, 0x38, 0xc5, 0x55, 0xfd, 0xe7, 0xbd, 0x57, 0x5d, 0xf5, 0x32,
+	0xd0, 0x15, 0x2c, 0x9a, 0xb3, 0xc8, 0xa1, 0x9e, 0x17, 0xc4, 0xbe, 0x4c, 0xff, 0x9e, 0x84, 0x51,
+	0x20, 0x03, 0xdc, 0x49, 0x42, 0xbb, 0x3b, 0x09, 0x82, 0xc9, 0x94, 0x39, 0x34, 0xe4, 0x0e, 0xf5,
+	0xfd, 0x40, 0x52, 0xc9, 0x03, 0x5f, 0x98, 0x6d, 0x64, 0x01, 0x0f, 0xbe, 0x0c, 0xc7, 0x54, 0xb2,
+	0xcf, 0xa8, 0x10, 0x8b, 0x20, 0x1a, 0xbb, 0xec, 0x55, 0xcc, 0x84, 0xc4, 0x3e, 0xb4, 0x7c, 0xb6,
+	0x48, 0xb3, 0x1d, 0xab, 0x6f, 0x0d, 0x9a, 0x6e, 0x31, 0x85, 0x03, 0x38, 0xf0, 0xe2, 0x28, 0x62,
+	0xbe, 0xcc, 0x76, 0xd5, 0xf4, 0xae, 0x6a, 0x1a, 0x11, 0xb6, 0x7c, 0x3a, 0x63, 0x9d, 0xba, 0x5e,
+	0xd6, 0xbf, 0x49, 0x07, 0xda, 0x55, 0x60, 0x11, 0x06, 0xbe, 0x60, 0xc4, 0x83, 0xd6, 0x0b, 0xea,
+	0x5f, 0xa5, 0x44, 0x6c, 0xd8, 0x8d, 0x98, 0x08, 0xe2, 0xc8, 0x63, 0x09, 0x8b, 0x2c, 0xc6, 0x36,
+	0x6c, 0x53, 0x4f, 0xc9, 0x49, 0x90, 0x93, 0x48, 0x91, 0x17, 0xf1, 0x28, 0x3b, 0x66, 0x70, 0x8b,
+	0x29, 0x72, 0x04, 0x7b, 0x06, 0xc4, 0x80, 0xe2, 0x21, 0x34, 0xe6, 0x74, 0x1a, 0xa7, 0x10, 0x26,
+	0x20, 0x4f, 0xe1, 0xde, 0x27, 0x4c, 0x5e, 0x98, 0x4a, 0xa6, 0x84, 0x52, 0x35, 0x56, 0x41, 0xcd,
+	0x0f, 0x16, 0xec, 0x24, 0xdb, 0x36, 0xad, 0x63, 0x07, 0x76, 0x98, 0x4f, 0x47, 0x53, 0x66, 0x6a,
+	0xb4, 0xeb, 0xa6, 0x21, 0x12, 0xd8, 0xf3, 0x68, 0x48, 0x47, 0x7c, 0xca, 0x25, 0x67, 0xa2, 0x53,
+	0xef, 0xd7, 0x07, 0x4d, 0xb7, 0x94, 0xc3, 0x27, 0xb0, 0x2d, 0x83, 0x6b, 0xe6, 0x8b, 0xce, 0x56,
+	0xbf, 0x3e, 0x68, 0x9d, 0xee, 0x9f, 0xa4, 0x6f, 0xfd, 0x85, 0x4a, 0xbb, 0xc9, 0x2a, 0x79, 0x1f,
+	0xf6, 0x12, 0x12, 0xe2, 0x53, 0x2e, 0x24, 0x3e, 0x81, 0x06, 0x97, 0x6c, 0x26, 0x3a, 0x96, 0x3e,
+	0xf6, 0xff, 0xec, 0x58, 0xaa, 0xc8, 0x2c, 0x93, 0x1f, 0x2d, 0x68, 0xe8, 0x9b, 0x70, 0x1f, 0x6a,
+	0x3c, 0x7d, 0xec, 0x1a, 0x1f, 0xab, 0xe2, 0x73, 0x21, 0x62, 0x36, 0xbe, 0x90, 0x9a, 0x78, 0xdd,
+	0xcd, 0x62, 0xec, 0x42, 0x93, 0x7d, 0x1b, 0xf2, 0x88, 0x89, 0x0b, 0xa9, 0x4b, 0x5c, 0x77, 0xf3,
+	0x84, 0x3a, 0x49, 0xe3, 0x31, 0x67, 0xbe, 0xc7, 0x34, 0xeb, 0xa6, 0x9b, 0xc5, 0xea, 0x64, 0xc4,
+	0xe6, 0xc1, 0xb5, 0xbe, 0xb6, 0x61, 0x4e, 0x66, 0x09, 0x72, 0x0a, 0xa0, 0xc9, 0x18, 0x0d, 0x47,
+	0x65, 0x0d, 0x55, 0xe9, 0x89, 0x82, 0x9f, 0x2c, 0xc0, 0x17, 0x11, 0xa3, 0x92, 0x99, 0xf4, 0xed,
+	0x4f, 0x55, 0xa0, 0x7d, 0xe5, 0x27, 0x9a, 0xf2, 0x44, 0x52, 0x80, 0x7a, 0x56, 0x80, 0x92, 0xc8,
+	0xad, 0xbb, 0x44, 0x36, 0xca, 0x22, 0xc9, 0x33, 0xb8, 0x5f, 0x62, 0x94, 0x37, 0x9a, 0x7e, 0xad,
+	0xb4, 0xd1, 0x74, 0x40, 0x9e, 0x03, 0x7e, 0xc4, 0xa6, 0xec, 0x5f, 0xd0, 0x37, 0x04, 0x6b, 0x29,
+	0x41, 0x72, 0x08, 0xa8, 0xea, 0x54, 0xee, 0x51, 0xd5, 0xb8, 0x2a, 0x6b, 0xea, 0x78, 0x57, 0xe3,
+	0x3e, 0x07, 0x74, 0x75, 0xe5, 0xff, 0x33, 0xf0, 0x09, 0xb4, 0xaf, 0x7c, 0x19, 0x05, 0x22, 0x64,
+	0x9e, 0x2c, 0x9d, 0xde, 0x2c, 0xf1, 0x17, 0x0b, 0x50, 0x6f, 0xcb, 0x4f, 0xa9, 0x51, 0x4d, 0x46,
+	0x78, 0x6e, 0xc0, 0x76, 0xdd, 0x24, 0x52, 0xf9, 0x88, 0x51, 0x91, 0x8f, 0xb6, 0x89, 0x36, 0x79,
+	0x09, 0xf6, 0x00, 0xb2, 0x79, 0x79, 0xad, 0x5f, 0xa9, 0xe9, 0x16, 0x32, 0xaa, 0x87, 0x0c, 0x21,
+	0xd5, 0x6b, 0x1b, 0x7a, 0xc8, 0x10, 0x3c, 0x80, 0xff, 0x7d, 0x3c, 0x0b, 0xe5, 0xeb, 0xf4, 0xa9,
+	0x4e, 0xff, 0xda, 0x81, 0xfd, 0xa4, 0xae, 0x9f, 0xb3, 0x68, 0xce, 0x3d, 0x86, 0x0b, 0xd8, 0x52,
+	0xb6, 0x81, 0x87, 0xd9, 0x15, 0x05, 0xab, 0xb2, 0x1f, 0x54, 0xb2, 0x89, 0xa1, 0x5d, 0x7e, 0xff,
+	0xfb, 0x9f, 0x3f, 0xd7, 0x3e, 0xc0, 0x73, 0xed, 0xc1, 0xf3, 0x77, 0x33, 0xc7, 0xf6, 0xa8, 0x7f,
+	0xcc, 0x9d, 0x65, 0x6a, 0x4a, 0x2b, 0x67, 0x69, 0xfc, 0x6b, 0xe5, 0x2c, 0x0b, 0x5e, 0xf5, 0xe1,
+	0x70, 0xb8, 0xc2, 0x39, 0xec, 0x97, 0xed, 0x12, 0x7b, 0x19, 0xd8, 0x46, 0x03, 0xb7, 0xdf, 0xba,
+	0x75, 0x3d, 0xa1, 0xf5, 0x58, 0xd3, 0x7a, 0xd3, 0xee, 0x54, 0x69, 0x85, 0xc9, 0xce, 0x73, 0x6b,
+	0x88, 0x5f, 0xc1, 0x5e, 0xa1, 0xbd, 0x04, 0x3e, 0xcc, 0x6e, 0x5d, 0xef, 0xba, 0x82, 0xfe, 0xa2,
+	0x0d, 0x91, 0x37, 0x34, 0xd0, 0x3d, 0x3c, 0xa8, 0x00, 0xe1, 0x4b, 0x80, 0xdc, 0x5e, 0xd1, 0xce,
+	0x4e, 0xaf, 0x79, 0xae, 0xbd, 0x66, 0x5d, 0xa4, 0xa7, 0x2f, 0xed, 0x60, 0xbb, 0xca, 0x7e, 0xa9,
+	0x5a, 0x62, 0x85, 0xaf, 0xa0, 0x55, 0x18, 0xbf, 0x02, 0xef, 0x75, 0x9b, 0xb0, 0xbb, 0x9b, 0x17,
+	0x93, 0x3a, 0x3d, 0xd5, 0x48, 0x8f, 0x48, 0x77, 0x33, 0x92, 0xa3, 0xbb, 0x47, 0xd5, 0x6a, 0x06,
+	0xad, 0xc2, 0x10, 0x17, 0x20, 0xd7, 0x47, 0xdb, 0x6e, 0x67, 0x8b, 0xa5, 0x9e, 0x23, 0xef, 0x68,
+	0xb0, 0xc7, 0xc3, 0x47, 0x77, 0x81, 0x39, 0x4b, 0x3e, 0x5e, 0xa1, 0x07, 0x90, 0xcf, 0x78, 0xa1,
+	0x7a, 0x6b, 0x83, 0x6f, 0xdf, 0x2f, 0x37, 0xbc, 0x79, 0x95, 0x23, 0x8d, 0xd4, 0xc3, 0x3b, 0x65,
+	0xe1, 0x02, 0x5a, 0x05, 0x7f, 0x28, 0x68, 0x5a, 0x77, 0x8d, 0x5b, 0x35, 0x9d, 0x69, 0xa4, 0x63,
+	0x32, 0xf8, 0x47, 0x4d, 0x8e, 0xf9, 0x2f, 0xa0, 0x8a, 0xf9, 0x1d, 0x1c, 0x54, 0xec, 0x05, 0xf3,
+	0x8e, 0xde, 0x6c, 0x3c, 0xf6, 0xc3, 0xb2, 0xce, 0x92, 0xd1, 0x90, 0x67, 0x9a, 0xc5, 0xdb, 0xa4,
+	0x5f, 0x65, 0x61, 0xe0, 0x79, 0xb6, 0xf9, 0xdc, 0x1a, 0x5e, 0x5e, 0xfe, 0x7a, 0xd3, 0xb3, 0x7e,
+	0xbb, 0xe9, 0x59, 0x7f, 0xdc, 0xf4, 0xac, 0x97, 0xef, 0x4d, 0xb8, 0xfc, 0x3a, 0x1e, 0x9d, 0x78,
+	0xc1, 0xcc, 0xa1, 0xd1, 0x24, 0x08, 0xa3, 0xe0, 0x1b, 0xfd, 0xe3, 0xd8, 0x1b, 0x3b, 0xf3, 0x33,
+	0x27, 0xbc, 0x9e, 0xa8, 0x4b, 0xbd, 0x29, 0x67, 0xf9, 0x77, 0xd8, 0x68, 0x5b, 0x7f, 0x61, 0x9d,
+	0xfd, 0x3d, 0x00, 0xac, 0xa7, 0x78, 0xbb, 0xa8, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -852,6 +1136,12 @@ type AccountServiceClient interface {
 	CreateToken(ctx context.Context, in *CreateTokenRequest, opts ...grpc.CallOption) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(ctx context.Context, in *DeleteTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// ListTokens returns the tokens of an account, including the revoked ones
+	ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*TokensList, error)
+	// RevokeToken revokes a token, which is kept in the tokens of the account
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// IntrospectToken returns the account, expiry, audience and status of a token
+	IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*TokenIntrospection, error)
 }
 
 type accountServiceClient struct {
@@ -916,6 +1206,33 @@ func (c *accountServiceClient) DeleteToken(ctx context.Context, in *DeleteTokenR
 	return out, nil
 }
 
+func (c *accountServiceClient) ListTokens(ctx context.Context, in *ListTokensRequest, opts ...grpc.CallOption) (*TokensList, error) {
+	out := new(TokensList)
+	err := c.cc.Invoke(ctx, "/account.AccountService/ListTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/account.AccountService/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*TokenIntrospection, error) {
+	out := new(TokenIntrospection)
+	err := c.cc.Invoke(ctx, "/account.AccountService/IntrospectToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 type AccountServiceServer interface {
 	// CanI checks if the current account has permission to perform an action
@@ -930,6 +1247,12 @@ type AccountServiceServer interface {
 	CreateToken(context.Context, *CreateTokenRequest) (*CreateTokenResponse, error)
 	// DeleteToken deletes a token
 	DeleteToken(context.Context, *DeleteTokenRequest) (*EmptyResponse, error)
+	// ListTokens returns the tokens of an account, including the revoked ones
+	ListTokens(context.Context, *ListTokensRequest) (*TokensList, error)
+	// RevokeToken revokes a token, which is kept in the tokens of the account
+	RevokeToken(context.Context, *RevokeTokenRequest) (*EmptyResponse, error)
+	// IntrospectToken returns the account, expiry, audience and status of a token
+	IntrospectToken(context.Context, *IntrospectTokenRequest) (*TokenIntrospection, error)
 }
 
 // UnimplementedAccountServiceServer can be embedded to have forward compatible implementations.
@@ -954,6 +1277,15 @@ func (*UnimplementedAccountServiceServer) CreateToken(ctx context.Context, req *
 func (*UnimplementedAccountServiceServer) DeleteToken(ctx context.Context, req *DeleteTokenRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteToken not implemented")
 }
+func (*UnimplementedAccountServiceServer) ListTokens(ctx context.Context, req *ListTokensRequest) (*TokensList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (*UnimplementedAccountServiceServer) RevokeToken(ctx context.Context, req *RevokeTokenRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (*UnimplementedAccountServiceServer) IntrospectToken(ctx context.Context, req *IntrospectTokenRequest) (*TokenIntrospection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntrospectToken not implemented")
+}
 
 func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
 	s.RegisterService(&_AccountService_serviceDesc, srv)
@@ -1067,6 +1399,60 @@ func _AccountService_DeleteToken_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/ListTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ListTokens(ctx, req.(*ListTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.AccountService/IntrospectToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).IntrospectToken(ctx, req.(*IntrospectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccountService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "account.AccountService",
 	HandlerType: (*AccountServiceServer)(nil),
@@ -1095,6 +1481,18 @@ var _AccountService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteToken",
 			Handler:    _AccountService_DeleteToken_Handler,
 		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _AccountService_ListTokens_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _AccountService_RevokeToken_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _AccountService_IntrospectToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/account/account.proto",
@@ -1423,6 +1821,20 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RevokedAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.RevokedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Audience) > 0 {
+		for iNdEx := len(m.Audience) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Audience[iNdEx])
+			copy(dAtA[i:], m.Audience[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Audience[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ExpiresAt != 0 {
 		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
 		i--
@@ -1508,6 +1920,20 @@ func (m *CreateTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Audience) > 0 {
+		for iNdEx := len(m.Audience) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Audience[iNdEx])
+			copy(dAtA[i:], m.Audience[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.Audience[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1632,7 +2058,7 @@ func (m *ListAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListTokensRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1642,7 +2068,186 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevokeTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntrospectTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntrospectTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntrospectTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenIntrospection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenIntrospection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenIntrospection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAccount(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Capability) > 0 {
+		i -= len(m.Capability)
+		copy(dAtA[i:], m.Capability)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Capability)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1827,6 +2432,15 @@ func (m *Token) Size() (n int) {
 	if m.ExpiresAt != 0 {
 		n += 1 + sovAccount(uint64(m.ExpiresAt))
 	}
+	if len(m.Audience) > 0 {
+		for _, s := range m.Audience {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.RevokedAt != 0 {
+		n += 1 + sovAccount(uint64(m.RevokedAt))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1868,6 +2482,15 @@ func (m *CreateTokenRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovAccount(uint64(m.ExpiresAt))
+	}
+	if len(m.Audience) > 0 {
+		for _, s := range m.Audience {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1922,6 +2545,89 @@ func (m *ListAccountRequest) Size() (n int) {
 	return n
 }
 
+func (m *ListTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevokeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IntrospectTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TokenIntrospection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Capability)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EmptyResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2804,24 +3510,75 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAccount(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAccount
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audience = append(m.Audience, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAt", wireType)
+			}
+			m.RevokedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -3023,6 +3780,57 @@ func (m *CreateTokenRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audience", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audience = append(m.Audience, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccount(dAtA[iNdEx:])
@@ -3294,6 +4102,490 @@ func (m *ListAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ListTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevokeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntrospectTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntrospectTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntrospectTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenIntrospection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenIntrospection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenIntrospection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Capability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &Token{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EmptyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -57,7 +57,17 @@ type ApplicationQuery struct {
 	// the application's namespace
 	AppNamespace *string `protobuf:"bytes,7,opt,name=appNamespace" json:"appNamespace,omitempty"`
 	// the project names to restrict returned list applications (legacy name for backwards-compatibility)
-	Project              []string `protobuf:"bytes,8,rep,name=project" json:"project,omitempty"`
+	Project []string `protobuf:"bytes,8,rep,name=project" json:"project,omitempty"`
+	// the maximum number of applications to return, the continue token of the response is set if more applications are available
+	Limit *int64 `protobuf:"varint,9,opt,name=limit" json:"limit,omitempty"`
+	// the continue token returned by a previous paginated list call
+	Continue *string `protobuf:"bytes,10,opt,name=continue" json:"continue,omitempty"`
+	// the field to sort returned list applications by, one of: name (default), syncStatus, health
+	SortBy *string `protobuf:"bytes,11,opt,name=sortBy" json:"sortBy,omitempty"`
+	// when set, only returns the application metadata, project, destination and summarized sync and health status
+	Minimal *bool `protobuf:"varint,12,opt,name=minimal" json:"minimal,omitempty"`
+	// the condition severities to restrict returned list to applications with a condition of one of these severities, any of: Info, Warning, Error
+	ConditionSeverities  []string `protobuf:"bytes,13,rep,name=conditionSeverities" json:"conditionSeverities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -152,6 +162,41 @@ func (m *ApplicationQuery) GetProject() []string {
 	return nil
 }
 
+func (m *ApplicationQuery) GetLimit() int64 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
+func (m *ApplicationQuery) GetContinue() string {
+	if m != nil && m.Continue != nil {
+		return *m.Continue
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetSortBy() string {
+	if m != nil && m.SortBy != nil {
+		return *m.SortBy
+	}
+	return ""
+}
+
+func (m *ApplicationQuery) GetMinimal() bool {
+	if m != nil && m.Minimal != nil {
+		return *m.Minimal
+	}
+	return false
+}
+
+func (m *ApplicationQuery) GetConditionSeverities() []string {
+	if m != nil {
+		return m.ConditionSeverities
+	}
+	return nil
+}
+
 type NodeQuery struct {
 	// the application's name
 	Name                 *string  `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
@@ -300,6 +345,99 @@ func (m *RevisionMetadataQuery) GetVersionId() int32 {
 	return 0
 }
 
+// RevisionComparisonQuery is a query for the commits and the changed files between two revisions of an application source
+type RevisionComparisonQuery struct {
+	// the application's name
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// the revision the comparison starts from, defaults to the synced revision of the source
+	Revision *string `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	// the revision the comparison ends at, defaults to the target revision of the source
+	TargetRevision *string `protobuf:"bytes,3,opt,name=targetRevision" json:"targetRevision,omitempty"`
+	// the application's namespace
+	AppNamespace *string `protobuf:"bytes,4,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,5,opt,name=project" json:"project,omitempty"`
+	// source index (for multi source apps)
+	SourceIndex          *int32   `protobuf:"varint,6,opt,name=sourceIndex" json:"sourceIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionComparisonQuery) Reset()         { *m = RevisionComparisonQuery{} }
+func (m *RevisionComparisonQuery) String() string { return proto.CompactTextString(m) }
+func (*RevisionComparisonQuery) ProtoMessage()    {}
+func (*RevisionComparisonQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{3}
+}
+func (m *RevisionComparisonQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevisionComparisonQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevisionComparisonQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevisionComparisonQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionComparisonQuery.Merge(m, src)
+}
+func (m *RevisionComparisonQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevisionComparisonQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionComparisonQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionComparisonQuery proto.InternalMessageInfo
+
+func (m *RevisionComparisonQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *RevisionComparisonQuery) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
+func (m *RevisionComparisonQuery) GetTargetRevision() string {
+	if m != nil && m.TargetRevision != nil {
+		return *m.TargetRevision
+	}
+	return ""
+}
+
+func (m *RevisionComparisonQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *RevisionComparisonQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *RevisionComparisonQuery) GetSourceIndex() int32 {
+	if m != nil && m.SourceIndex != nil {
+		return *m.SourceIndex
+	}
+	return 0
+}
+
 // ApplicationEventsQuery is a query for application resource events
 type ApplicationResourceEventsQuery struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -317,7 +455,7 @@ func (m *ApplicationResourceEventsQuery) Reset()         { *m = ApplicationResou
 func (m *ApplicationResourceEventsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceEventsQuery) ProtoMessage()    {}
 func (*ApplicationResourceEventsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{3}
+	return fileDescriptor_df6e82b174b5eaec, []int{4}
 }
 func (m *ApplicationResourceEventsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,13 +528,15 @@ func (m *ApplicationResourceEventsQuery) GetProject() string {
 
 // ManifestQuery is a query for manifest resources
 type ApplicationManifestQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Revision             *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
-	SourcePositions      []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
-	Revisions            []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
-	NoCache              *bool    `protobuf:"varint,7,opt,name=noCache" json:"noCache,omitempty"`
+	Name            *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Revision        *string  `protobuf:"bytes,2,opt,name=revision" json:"revision,omitempty"`
+	AppNamespace    *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project         *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	SourcePositions []int64  `protobuf:"varint,5,rep,name=sourcePositions" json:"sourcePositions,omitempty"`
+	Revisions       []string `protobuf:"bytes,6,rep,name=revisions" json:"revisions,omitempty"`
+	NoCache         *bool    `protobuf:"varint,7,opt,name=noCache" json:"noCache,omitempty"`
+	// versionId from historical data, to generate the manifests of the sources recorded in the history of the application
+	VersionId            *int32   `protobuf:"varint,8,opt,name=versionId" json:"versionId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -406,7 +546,7 @@ func (m *ApplicationManifestQuery) Reset()         { *m = ApplicationManifestQue
 func (m *ApplicationManifestQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQuery) ProtoMessage()    {}
 func (*ApplicationManifestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{4}
+	return fileDescriptor_df6e82b174b5eaec, []int{5}
 }
 func (m *ApplicationManifestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +624,13 @@ func (m *ApplicationManifestQuery) GetNoCache() bool {
 	return false
 }
 
+func (m *ApplicationManifestQuery) GetVersionId() int32 {
+	if m != nil && m.VersionId != nil {
+		return *m.VersionId
+	}
+	return 0
+}
+
 type FileChunk struct {
 	Chunk                []byte   `protobuf:"bytes,1,req,name=chunk" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -495,7 +642,7 @@ func (m *FileChunk) Reset()         { *m = FileChunk{} }
 func (m *FileChunk) String() string { return proto.CompactTextString(m) }
 func (*FileChunk) ProtoMessage()    {}
 func (*FileChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{5}
+	return fileDescriptor_df6e82b174b5eaec, []int{6}
 }
 func (m *FileChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +692,7 @@ func (m *ApplicationManifestQueryWithFiles) Reset()         { *m = ApplicationMa
 func (m *ApplicationManifestQueryWithFiles) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFiles) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFiles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{6}
+	return fileDescriptor_df6e82b174b5eaec, []int{7}
 }
 func (m *ApplicationManifestQueryWithFiles) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +765,7 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Reset() {
 func (m *ApplicationManifestQueryWithFilesWrapper) String() string { return proto.CompactTextString(m) }
 func (*ApplicationManifestQueryWithFilesWrapper) ProtoMessage()    {}
 func (*ApplicationManifestQueryWithFilesWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{7}
+	return fileDescriptor_df6e82b174b5eaec, []int{8}
 }
 func (m *ApplicationManifestQueryWithFilesWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -704,7 +851,7 @@ func (m *ApplicationResponse) Reset()         { *m = ApplicationResponse{} }
 func (m *ApplicationResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResponse) ProtoMessage()    {}
 func (*ApplicationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{8}
+	return fileDescriptor_df6e82b174b5eaec, []int{9}
 }
 func (m *ApplicationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +893,7 @@ func (m *ApplicationCreateRequest) Reset()         { *m = ApplicationCreateReque
 func (m *ApplicationCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationCreateRequest) ProtoMessage()    {}
 func (*ApplicationCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{9}
+	return fileDescriptor_df6e82b174b5eaec, []int{10}
 }
 func (m *ApplicationCreateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -809,7 +956,7 @@ func (m *ApplicationUpdateRequest) Reset()         { *m = ApplicationUpdateReque
 func (m *ApplicationUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateRequest) ProtoMessage()    {}
 func (*ApplicationUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{10}
+	return fileDescriptor_df6e82b174b5eaec, []int{11}
 }
 func (m *ApplicationUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +1021,7 @@ func (m *ApplicationDeleteRequest) Reset()         { *m = ApplicationDeleteReque
 func (m *ApplicationDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDeleteRequest) ProtoMessage()    {}
 func (*ApplicationDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{11}
+	return fileDescriptor_df6e82b174b5eaec, []int{12}
 }
 func (m *ApplicationDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1096,7 @@ func (m *SyncOptions) Reset()         { *m = SyncOptions{} }
 func (m *SyncOptions) String() string { return proto.CompactTextString(m) }
 func (*SyncOptions) ProtoMessage()    {}
 func (*SyncOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{12}
+	return fileDescriptor_df6e82b174b5eaec, []int{13}
 }
 func (m *SyncOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1157,7 @@ func (m *ApplicationSyncRequest) Reset()         { *m = ApplicationSyncRequest{}
 func (m *ApplicationSyncRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncRequest) ProtoMessage()    {}
 func (*ApplicationSyncRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{13}
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
 }
 func (m *ApplicationSyncRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1300,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1233,7 +1380,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1297,6 +1444,115 @@ func (m *ApplicationPatchRequest) GetProject() string {
 	return ""
 }
 
+// ApplicationPatchParametersRequest is a request to update the parameters of a single application source
+type ApplicationPatchParametersRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// source index (for multi source apps)
+	SourceIndex *int32 `protobuf:"varint,4,opt,name=sourceIndex" json:"sourceIndex,omitempty"`
+	// if set, the update is rejected unless it matches the current resource version of the application
+	ResourceVersion *string `protobuf:"bytes,5,opt,name=resourceVersion" json:"resourceVersion,omitempty"`
+	// Helm parameters to add or override
+	HelmParameters []*v1alpha1.HelmParameter `protobuf:"bytes,6,rep,name=helmParameters" json:"helmParameters,omitempty"`
+	// Kustomize images to add or override
+	KustomizeImages []string `protobuf:"bytes,7,rep,name=kustomizeImages" json:"kustomizeImages,omitempty"`
+	// config management plugin environment variables to add or override
+	PluginEnv            []*v1alpha1.EnvEntry `protobuf:"bytes,8,rep,name=pluginEnv" json:"pluginEnv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApplicationPatchParametersRequest) Reset()         { *m = ApplicationPatchParametersRequest{} }
+func (m *ApplicationPatchParametersRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPatchParametersRequest) ProtoMessage()    {}
+func (*ApplicationPatchParametersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
+}
+func (m *ApplicationPatchParametersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPatchParametersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPatchParametersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationPatchParametersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPatchParametersRequest.Merge(m, src)
+}
+func (m *ApplicationPatchParametersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPatchParametersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPatchParametersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPatchParametersRequest proto.InternalMessageInfo
+
+func (m *ApplicationPatchParametersRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationPatchParametersRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationPatchParametersRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationPatchParametersRequest) GetSourceIndex() int32 {
+	if m != nil && m.SourceIndex != nil {
+		return *m.SourceIndex
+	}
+	return 0
+}
+
+func (m *ApplicationPatchParametersRequest) GetResourceVersion() string {
+	if m != nil && m.ResourceVersion != nil {
+		return *m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *ApplicationPatchParametersRequest) GetHelmParameters() []*v1alpha1.HelmParameter {
+	if m != nil {
+		return m.HelmParameters
+	}
+	return nil
+}
+
+func (m *ApplicationPatchParametersRequest) GetKustomizeImages() []string {
+	if m != nil {
+		return m.KustomizeImages
+	}
+	return nil
+}
+
+func (m *ApplicationPatchParametersRequest) GetPluginEnv() []*v1alpha1.EnvEntry {
+	if m != nil {
+		return m.PluginEnv
+	}
+	return nil
+}
+
 type ApplicationRollbackRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	Id                   *int64   `protobuf:"varint,2,req,name=id" json:"id,omitempty"`
@@ -1313,7 +1569,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,7 +1658,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,7 +1763,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1882,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1737,7 +1993,7 @@ func (m *ResourceActionParameters) Reset()         { *m = ResourceActionParamete
 func (m *ResourceActionParameters) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionParameters) ProtoMessage()    {}
 func (*ResourceActionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ResourceActionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2059,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2171,7 @@ func (m *ResourceActionRunRequestV2) Reset()         { *m = ResourceActionRunReq
 func (m *ResourceActionRunRequestV2) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequestV2) ProtoMessage()    {}
 func (*ResourceActionRunRequestV2) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ResourceActionRunRequestV2) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,7 +2281,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2061,25 +2317,35 @@ func (m *ResourceActionsListResponse) GetActions() []*v1alpha1.ResourceAction {
 	return nil
 }
 
-type ApplicationResourceResponse struct {
-	Manifest             *string  `protobuf:"bytes,1,req,name=manifest" json:"manifest,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ResourceActionBulkRunRequest is a request to run a resource action on all the resources of an application
+// of the given kind, optionally filtered by namespace and labels.
+type ResourceActionBulkRunRequest struct {
+	Name                     *string                     `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace             *string                     `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project                  *string                     `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Group                    *string                     `protobuf:"bytes,4,opt,name=group" json:"group,omitempty"`
+	Kind                     *string                     `protobuf:"bytes,5,req,name=kind" json:"kind,omitempty"`
+	Namespace                *string                     `protobuf:"bytes,6,opt,name=namespace" json:"namespace,omitempty"`
+	LabelSelector            *string                     `protobuf:"bytes,7,opt,name=labelSelector" json:"labelSelector,omitempty"`
+	Action                   *string                     `protobuf:"bytes,8,req,name=action" json:"action,omitempty"`
+	ResourceActionParameters []*ResourceActionParameters `protobuf:"bytes,9,rep,name=resourceActionParameters" json:"resourceActionParameters,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
+	XXX_unrecognized         []byte                      `json:"-"`
+	XXX_sizecache            int32                       `json:"-"`
 }
 
-func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResourceResponse{} }
-func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
-func (*ApplicationResourceResponse) ProtoMessage()    {}
-func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+func (m *ResourceActionBulkRunRequest) Reset()         { *m = ResourceActionBulkRunRequest{} }
+func (m *ResourceActionBulkRunRequest) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionBulkRunRequest) ProtoMessage()    {}
+func (*ResourceActionBulkRunRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
-func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
+func (m *ResourceActionBulkRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceActionBulkRunRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationResourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceActionBulkRunRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2089,56 +2355,299 @@ func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ApplicationResourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationResourceResponse.Merge(m, src)
+func (m *ResourceActionBulkRunRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionBulkRunRequest.Merge(m, src)
 }
-func (m *ApplicationResourceResponse) XXX_Size() int {
+func (m *ResourceActionBulkRunRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationResourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationResourceResponse.DiscardUnknown(m)
+func (m *ResourceActionBulkRunRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionBulkRunRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationResourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_ResourceActionBulkRunRequest proto.InternalMessageInfo
 
-func (m *ApplicationResourceResponse) GetManifest() string {
-	if m != nil && m.Manifest != nil {
-		return *m.Manifest
+func (m *ResourceActionBulkRunRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
 	return ""
 }
 
-type ApplicationPodLogsQuery struct {
-	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
-	Namespace            *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
-	PodName              *string  `protobuf:"bytes,3,opt,name=podName" json:"podName,omitempty"`
-	Container            *string  `protobuf:"bytes,4,opt,name=container" json:"container,omitempty"`
-	SinceSeconds         *int64   `protobuf:"varint,5,opt,name=sinceSeconds" json:"sinceSeconds,omitempty"`
-	SinceTime            *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
-	TailLines            *int64   `protobuf:"varint,7,opt,name=tailLines" json:"tailLines,omitempty"`
-	Follow               *bool    `protobuf:"varint,8,opt,name=follow" json:"follow,omitempty"`
-	UntilTime            *string  `protobuf:"bytes,9,opt,name=untilTime" json:"untilTime,omitempty"`
-	Filter               *string  `protobuf:"bytes,10,opt,name=filter" json:"filter,omitempty"`
-	Kind                 *string  `protobuf:"bytes,11,opt,name=kind" json:"kind,omitempty"`
-	Group                *string  `protobuf:"bytes,12,opt,name=group" json:"group,omitempty"`
-	ResourceName         *string  `protobuf:"bytes,13,opt,name=resourceName" json:"resourceName,omitempty"`
-	Previous             *bool    `protobuf:"varint,14,opt,name=previous" json:"previous,omitempty"`
-	AppNamespace         *string  `protobuf:"bytes,15,opt,name=appNamespace" json:"appNamespace,omitempty"`
-	Project              *string  `protobuf:"bytes,16,opt,name=project" json:"project,omitempty"`
-	MatchCase            *bool    `protobuf:"varint,17,opt,name=matchCase" json:"matchCase,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ResourceActionBulkRunRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
 }
 
-func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery{} }
-func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
-func (*ApplicationPodLogsQuery) ProtoMessage()    {}
-func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+func (m *ResourceActionBulkRunRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
 }
-func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *ResourceActionBulkRunRequest) GetGroup() string {
+	if m != nil && m.Group != nil {
+		return *m.Group
+	}
+	return ""
+}
+
+func (m *ResourceActionBulkRunRequest) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceActionBulkRunRequest) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ResourceActionBulkRunRequest) GetLabelSelector() string {
+	if m != nil && m.LabelSelector != nil {
+		return *m.LabelSelector
+	}
+	return ""
+}
+
+func (m *ResourceActionBulkRunRequest) GetAction() string {
+	if m != nil && m.Action != nil {
+		return *m.Action
+	}
+	return ""
+}
+
+func (m *ResourceActionBulkRunRequest) GetResourceActionParameters() []*ResourceActionParameters {
+	if m != nil {
+		return m.ResourceActionParameters
+	}
+	return nil
+}
+
+// ResourceActionResult is the result of running a resource action on a single resource.
+type ResourceActionResult struct {
+	Group     *string `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
+	Version   *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	Kind      *string `protobuf:"bytes,3,opt,name=kind" json:"kind,omitempty"`
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	// error is the reason the action failed on the resource, empty if the action succeeded
+	Error                *string  `protobuf:"bytes,6,opt,name=error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResourceActionResult) Reset()         { *m = ResourceActionResult{} }
+func (m *ResourceActionResult) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionResult) ProtoMessage()    {}
+func (*ResourceActionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
+}
+func (m *ResourceActionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceActionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionResult.Merge(m, src)
+}
+func (m *ResourceActionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionResult proto.InternalMessageInfo
+
+func (m *ResourceActionResult) GetGroup() string {
+	if m != nil && m.Group != nil {
+		return *m.Group
+	}
+	return ""
+}
+
+func (m *ResourceActionResult) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return ""
+}
+
+func (m *ResourceActionResult) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
+	}
+	return ""
+}
+
+func (m *ResourceActionResult) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
+	}
+	return ""
+}
+
+func (m *ResourceActionResult) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ResourceActionResult) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
+
+type ResourceActionBulkRunResponse struct {
+	Results              []*ResourceActionResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ResourceActionBulkRunResponse) Reset()         { *m = ResourceActionBulkRunResponse{} }
+func (m *ResourceActionBulkRunResponse) String() string { return proto.CompactTextString(m) }
+func (*ResourceActionBulkRunResponse) ProtoMessage()    {}
+func (*ResourceActionBulkRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
+}
+func (m *ResourceActionBulkRunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourceActionBulkRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResourceActionBulkRunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResourceActionBulkRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceActionBulkRunResponse.Merge(m, src)
+}
+func (m *ResourceActionBulkRunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourceActionBulkRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceActionBulkRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourceActionBulkRunResponse proto.InternalMessageInfo
+
+func (m *ResourceActionBulkRunResponse) GetResults() []*ResourceActionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ApplicationResourceResponse struct {
+	Manifest             *string  `protobuf:"bytes,1,req,name=manifest" json:"manifest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResourceResponse{} }
+func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationResourceResponse) ProtoMessage()    {}
+func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
+}
+func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationResourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationResourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationResourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationResourceResponse.Merge(m, src)
+}
+func (m *ApplicationResourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationResourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationResourceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationResourceResponse proto.InternalMessageInfo
+
+func (m *ApplicationResourceResponse) GetManifest() string {
+	if m != nil && m.Manifest != nil {
+		return *m.Manifest
+	}
+	return ""
+}
+
+type ApplicationPodLogsQuery struct {
+	Name         *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	Namespace    *string  `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	PodName      *string  `protobuf:"bytes,3,opt,name=podName" json:"podName,omitempty"`
+	Container    *string  `protobuf:"bytes,4,opt,name=container" json:"container,omitempty"`
+	SinceSeconds *int64   `protobuf:"varint,5,opt,name=sinceSeconds" json:"sinceSeconds,omitempty"`
+	SinceTime    *v1.Time `protobuf:"bytes,6,opt,name=sinceTime" json:"sinceTime,omitempty"`
+	TailLines    *int64   `protobuf:"varint,7,opt,name=tailLines" json:"tailLines,omitempty"`
+	Follow       *bool    `protobuf:"varint,8,opt,name=follow" json:"follow,omitempty"`
+	UntilTime    *string  `protobuf:"bytes,9,opt,name=untilTime" json:"untilTime,omitempty"`
+	Filter       *string  `protobuf:"bytes,10,opt,name=filter" json:"filter,omitempty"`
+	Kind         *string  `protobuf:"bytes,11,opt,name=kind" json:"kind,omitempty"`
+	Group        *string  `protobuf:"bytes,12,opt,name=group" json:"group,omitempty"`
+	ResourceName *string  `protobuf:"bytes,13,opt,name=resourceName" json:"resourceName,omitempty"`
+	Previous     *bool    `protobuf:"varint,14,opt,name=previous" json:"previous,omitempty"`
+	AppNamespace *string  `protobuf:"bytes,15,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string  `protobuf:"bytes,16,opt,name=project" json:"project,omitempty"`
+	MatchCase    *bool    `protobuf:"varint,17,opt,name=matchCase" json:"matchCase,omitempty"`
+	// allContainers streams the logs of all the containers of the selected pods, including the init containers
+	AllContainers *bool `protobuf:"varint,18,opt,name=allContainers" json:"allContainers,omitempty"`
+	// regex interprets the filter as a regular expression
+	Regex                *bool    `protobuf:"varint,19,opt,name=regex" json:"regex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery{} }
+func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationPodLogsQuery) ProtoMessage()    {}
+func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
+}
+func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *ApplicationPodLogsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -2283,6 +2792,20 @@ func (m *ApplicationPodLogsQuery) GetMatchCase() bool {
 	return false
 }
 
+func (m *ApplicationPodLogsQuery) GetAllContainers() bool {
+	if m != nil && m.AllContainers != nil {
+		return *m.AllContainers
+	}
+	return false
+}
+
+func (m *ApplicationPodLogsQuery) GetRegex() bool {
+	if m != nil && m.Regex != nil {
+		return *m.Regex
+	}
+	return false
+}
+
 type LogEntry struct {
 	Content *string `protobuf:"bytes,1,req,name=content" json:"content,omitempty"`
 	// deprecated in favor of timeStampStr since meta.v1.Time don't support nano time
@@ -2290,6 +2813,7 @@ type LogEntry struct {
 	Last                 *bool    `protobuf:"varint,3,req,name=last" json:"last,omitempty"`
 	TimeStampStr         *string  `protobuf:"bytes,4,req,name=timeStampStr" json:"timeStampStr,omitempty"`
 	PodName              *string  `protobuf:"bytes,5,req,name=podName" json:"podName,omitempty"`
+	ContainerName        *string  `protobuf:"bytes,6,opt,name=containerName" json:"containerName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2299,7 +2823,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,6 +2887,13 @@ func (m *LogEntry) GetPodName() string {
 	return ""
 }
 
+func (m *LogEntry) GetContainerName() string {
+	if m != nil && m.ContainerName != nil {
+		return *m.ContainerName
+	}
+	return ""
+}
+
 type OperationTerminateRequest struct {
 	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
 	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
@@ -2376,7 +2907,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2439,7 +2970,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2489,20 +3020,88 @@ func (m *ApplicationSyncWindowsQuery) GetProject() string {
 	return ""
 }
 
+type ApplicationMetricsHistoryQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationMetricsHistoryQuery) Reset()         { *m = ApplicationMetricsHistoryQuery{} }
+func (m *ApplicationMetricsHistoryQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationMetricsHistoryQuery) ProtoMessage()    {}
+func (*ApplicationMetricsHistoryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
+}
+func (m *ApplicationMetricsHistoryQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationMetricsHistoryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationMetricsHistoryQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationMetricsHistoryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationMetricsHistoryQuery.Merge(m, src)
+}
+func (m *ApplicationMetricsHistoryQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationMetricsHistoryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationMetricsHistoryQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationMetricsHistoryQuery proto.InternalMessageInfo
+
+func (m *ApplicationMetricsHistoryQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationMetricsHistoryQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationMetricsHistoryQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
 type ApplicationSyncWindowsResponse struct {
-	ActiveWindows        []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
-	AssignedWindows      []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
-	CanSync              *bool                    `protobuf:"varint,3,req,name=canSync" json:"canSync,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ActiveWindows   []*ApplicationSyncWindow `protobuf:"bytes,1,rep,name=activeWindows" json:"activeWindows,omitempty"`
+	AssignedWindows []*ApplicationSyncWindow `protobuf:"bytes,2,rep,name=assignedWindows" json:"assignedWindows,omitempty"`
+	// CanSync is true if a manual sync is currently allowed
+	CanSync *bool `protobuf:"varint,3,req,name=canSync" json:"canSync,omitempty"`
+	// CanAutoSync is true if an automated sync is currently allowed
+	CanAutoSync *bool `protobuf:"varint,4,opt,name=canAutoSync" json:"canAutoSync,omitempty"`
+	// NextTransition is the earliest time at which one of the assigned windows becomes active or inactive
+	NextTransition       *v1.Time `protobuf:"bytes,5,opt,name=nextTransition" json:"nextTransition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncWindowsResponse{} }
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2552,21 +3151,41 @@ func (m *ApplicationSyncWindowsResponse) GetCanSync() bool {
 	return false
 }
 
-type ApplicationSyncWindow struct {
-	Kind                 *string  `protobuf:"bytes,1,req,name=kind" json:"kind,omitempty"`
-	Schedule             *string  `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
-	Duration             *string  `protobuf:"bytes,3,req,name=duration" json:"duration,omitempty"`
-	ManualSync           *bool    `protobuf:"varint,4,req,name=manualSync" json:"manualSync,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ApplicationSyncWindowsResponse) GetCanAutoSync() bool {
+	if m != nil && m.CanAutoSync != nil {
+		return *m.CanAutoSync
+	}
+	return false
 }
 
-func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
+func (m *ApplicationSyncWindowsResponse) GetNextTransition() *v1.Time {
+	if m != nil {
+		return m.NextTransition
+	}
+	return nil
+}
+
+type ApplicationSyncWindow struct {
+	Kind       *string `protobuf:"bytes,1,req,name=kind" json:"kind,omitempty"`
+	Schedule   *string `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
+	Duration   *string `protobuf:"bytes,3,req,name=duration" json:"duration,omitempty"`
+	ManualSync *bool   `protobuf:"varint,4,req,name=manualSync" json:"manualSync,omitempty"`
+	TimeZone   *string `protobuf:"bytes,5,opt,name=timeZone" json:"timeZone,omitempty"`
+	Active     *bool   `protobuf:"varint,6,opt,name=active" json:"active,omitempty"`
+	// NextTransition is the time at which the window becomes active, or inactive if it is active
+	NextTransition *v1.Time `protobuf:"bytes,7,opt,name=nextTransition" json:"nextTransition,omitempty"`
+	// Unlocked is true if the window requires an approval and is currently unlocked
+	Unlocked             *bool    `protobuf:"varint,8,opt,name=unlocked" json:"unlocked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2623,6 +3242,34 @@ func (m *ApplicationSyncWindow) GetManualSync() bool {
 	return false
 }
 
+func (m *ApplicationSyncWindow) GetTimeZone() string {
+	if m != nil && m.TimeZone != nil {
+		return *m.TimeZone
+	}
+	return ""
+}
+
+func (m *ApplicationSyncWindow) GetActive() bool {
+	if m != nil && m.Active != nil {
+		return *m.Active
+	}
+	return false
+}
+
+func (m *ApplicationSyncWindow) GetNextTransition() *v1.Time {
+	if m != nil {
+		return m.NextTransition
+	}
+	return nil
+}
+
+func (m *ApplicationSyncWindow) GetUnlocked() bool {
+	if m != nil && m.Unlocked != nil {
+		return *m.Unlocked
+	}
+	return false
+}
+
 type OperationTerminateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2633,7 +3280,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2680,7 +3327,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2765,6 +3412,82 @@ func (m *ResourcesQuery) GetProject() string {
 	return ""
 }
 
+// ApplicationDeletePreviewResponse lists the resources affected by a cascading deletion of an application
+type ApplicationDeletePreviewResponse struct {
+	// Resources are the live resources managed by the application which would be deleted
+	Resources []*v1alpha1.ResourceRef `protobuf:"bytes,1,rep,name=resources" json:"resources,omitempty"`
+	// Dependents are the live resources owned by the deleted resources, which would be garbage collected by Kubernetes
+	Dependents []*v1alpha1.ResourceRef `protobuf:"bytes,2,rep,name=dependents" json:"dependents,omitempty"`
+	// Retained are the live resources managed by the application which would be kept
+	Retained []*v1alpha1.ResourceRef `protobuf:"bytes,3,rep,name=retained" json:"retained,omitempty"`
+	// PropagationPolicy is the propagation policy the resources would be deleted with
+	PropagationPolicy    *string  `protobuf:"bytes,4,opt,name=propagationPolicy" json:"propagationPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDeletePreviewResponse) Reset()         { *m = ApplicationDeletePreviewResponse{} }
+func (m *ApplicationDeletePreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDeletePreviewResponse) ProtoMessage()    {}
+func (*ApplicationDeletePreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
+}
+func (m *ApplicationDeletePreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDeletePreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDeletePreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationDeletePreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDeletePreviewResponse.Merge(m, src)
+}
+func (m *ApplicationDeletePreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDeletePreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDeletePreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDeletePreviewResponse proto.InternalMessageInfo
+
+func (m *ApplicationDeletePreviewResponse) GetResources() []*v1alpha1.ResourceRef {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *ApplicationDeletePreviewResponse) GetDependents() []*v1alpha1.ResourceRef {
+	if m != nil {
+		return m.Dependents
+	}
+	return nil
+}
+
+func (m *ApplicationDeletePreviewResponse) GetRetained() []*v1alpha1.ResourceRef {
+	if m != nil {
+		return m.Retained
+	}
+	return nil
+}
+
+func (m *ApplicationDeletePreviewResponse) GetPropagationPolicy() string {
+	if m != nil && m.PropagationPolicy != nil {
+		return *m.PropagationPolicy
+	}
+	return ""
+}
+
 type ManagedResourcesResponse struct {
 	Items                []*v1alpha1.ResourceDiff `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -2776,7 +3499,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +3550,7 @@ func (m *ApplicationServerSideDiffQuery) Reset()         { *m = ApplicationServe
 func (m *ApplicationServerSideDiffQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffQuery) ProtoMessage()    {}
 func (*ApplicationServerSideDiffQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{41}
 }
 func (m *ApplicationServerSideDiffQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2903,7 +3626,7 @@ func (m *ApplicationServerSideDiffResponse) Reset()         { *m = ApplicationSe
 func (m *ApplicationServerSideDiffResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServerSideDiffResponse) ProtoMessage()    {}
 func (*ApplicationServerSideDiffResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{42}
 }
 func (m *ApplicationServerSideDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2960,7 +3683,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{43}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3028,7 +3751,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{44}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3800,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+	return fileDescriptor_df6e82b174b5eaec, []int{45}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3127,2366 +3850,2693 @@ func (m *ListAppLinksRequest) GetProject() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
-	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
-	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
-	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
-	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
-	proto.RegisterType((*FileChunk)(nil), "application.FileChunk")
-	proto.RegisterType((*ApplicationManifestQueryWithFiles)(nil), "application.ApplicationManifestQueryWithFiles")
-	proto.RegisterType((*ApplicationManifestQueryWithFilesWrapper)(nil), "application.ApplicationManifestQueryWithFilesWrapper")
-	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
-	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
-	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
-	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
-	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
-	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
-	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
-	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
-	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
-	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
-	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
-	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
-	proto.RegisterType((*ResourceActionParameters)(nil), "application.ResourceActionParameters")
-	proto.RegisterType((*ResourceActionRunRequest)(nil), "application.ResourceActionRunRequest")
-	proto.RegisterType((*ResourceActionRunRequestV2)(nil), "application.ResourceActionRunRequestV2")
-	proto.RegisterType((*ResourceActionsListResponse)(nil), "application.ResourceActionsListResponse")
-	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
-	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
-	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
-	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
-	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
-	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
-	proto.RegisterType((*ApplicationSyncWindow)(nil), "application.ApplicationSyncWindow")
-	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
-	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
-	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
-	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
-	proto.RegisterType((*ApplicationServerSideDiffResponse)(nil), "application.ApplicationServerSideDiffResponse")
-	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
-	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
-	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
+// ApplicationSnapshotQuery identifies the application whose snapshots are created or listed
+type ApplicationSnapshotQuery struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("server/application/application.proto", fileDescriptor_df6e82b174b5eaec)
+func (m *ApplicationSnapshotQuery) Reset()         { *m = ApplicationSnapshotQuery{} }
+func (m *ApplicationSnapshotQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotQuery) ProtoMessage()    {}
+func (*ApplicationSnapshotQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{46}
 }
-
-var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3018 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcf, 0x8f, 0x1c, 0x47,
-	0xf5, 0xff, 0xd6, 0xcc, 0xce, 0xee, 0xec, 0x1b, 0xaf, 0x7f, 0x54, 0x6c, 0x7f, 0x3b, 0xe3, 0x8d,
-	0xd9, 0xb4, 0xed, 0x78, 0xb3, 0xf6, 0xce, 0xd8, 0x13, 0x03, 0xc9, 0x26, 0x21, 0x38, 0x6b, 0xc7,
-	0x59, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xa6, 0xd9, 0x9e, 0xee,
-	0x76, 0x77, 0xcf, 0x84, 0x55, 0x88, 0x84, 0x82, 0x90, 0x38, 0xa0, 0x20, 0x20, 0x07, 0x0e, 0xfc,
-	0x4c, 0x14, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x42, 0xf0,
-	0x0f, 0x20, 0x0b, 0x71, 0xe0, 0x40, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x5d, 0xf3, 0xa3,
-	0x67, 0x96, 0x19, 0x88, 0x25, 0x4e, 0xee, 0x57, 0xd3, 0xfd, 0xde, 0xe7, 0xbd, 0x7a, 0xef, 0xd5,
-	0xab, 0xf7, 0xd6, 0x70, 0x3a, 0xa4, 0x41, 0x8f, 0x06, 0x75, 0xe2, 0xfb, 0x8e, 0x6d, 0x92, 0xc8,
-	0xf6, 0xdc, 0xec, 0x73, 0xcd, 0x0f, 0xbc, 0xc8, 0xc3, 0x95, 0xcc, 0x52, 0x75, 0xb9, 0xe5, 0x79,
-	0x2d, 0x87, 0xd6, 0x89, 0x6f, 0xd7, 0x89, 0xeb, 0x7a, 0x11, 0x5f, 0x0e, 0xe3, 0x57, 0xab, 0x97,
-	0x76, 0x1f, 0x0d, 0x6b, 0xb6, 0xc7, 0x7e, 0xed, 0x10, 0xb3, 0x6d, 0xbb, 0x34, 0xd8, 0xab, 0xfb,
-	0xbb, 0x2d, 0xb6, 0x10, 0xd6, 0x3b, 0x34, 0x22, 0xf5, 0xde, 0xc5, 0x7a, 0x8b, 0xba, 0x34, 0x20,
-	0x11, 0xb5, 0xc4, 0x57, 0xdb, 0x2d, 0x3b, 0x6a, 0x77, 0x5f, 0xaa, 0x99, 0x5e, 0xa7, 0x4e, 0x82,
-	0x96, 0xe7, 0x07, 0xde, 0x67, 0xf9, 0xc3, 0xba, 0x69, 0xd5, 0x7b, 0x8f, 0xa4, 0x0c, 0xb2, 0x38,
-	0x7b, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63, 0xb8, 0x05, 0xd4, 0xf7, 0x84, 0xde,
-	0xfc, 0xd1, 0x8e, 0xbc, 0x60, 0x2f, 0xf3, 0x28, 0xd8, 0x3c, 0x36, 0x86, 0x8d, 0x60, 0x41, 0x7b,
-	0xd4, 0x8d, 0x42, 0xf1, 0x4f, 0xfc, 0xa9, 0xfe, 0x1e, 0x82, 0xc3, 0x97, 0x53, 0xa8, 0x9f, 0xe8,
-	0xd2, 0x60, 0x0f, 0x63, 0x98, 0x73, 0x49, 0x87, 0x6a, 0x68, 0x05, 0xad, 0x2e, 0x1a, 0xfc, 0x19,
-	0x6b, 0xb0, 0x10, 0xd0, 0x66, 0x40, 0xc3, 0xb6, 0x56, 0xe0, 0xcb, 0x92, 0xc4, 0x55, 0x28, 0x33,
-	0x81, 0xd4, 0x8c, 0x42, 0xad, 0xb8, 0x52, 0x5c, 0x5d, 0x34, 0x12, 0x1a, 0xaf, 0xc2, 0xa1, 0x80,
-	0x86, 0x5e, 0x37, 0x30, 0xe9, 0x0b, 0x34, 0x08, 0x6d, 0xcf, 0xd5, 0xe6, 0xf8, 0xd7, 0xfd, 0xcb,
-	0x8c, 0x4b, 0x48, 0x1d, 0x6a, 0x46, 0x5e, 0xa0, 0x95, 0xf8, 0x2b, 0x09, 0xcd, 0xf0, 0x30, 0x9d,
-	0xb5, 0xf9, 0x18, 0x0f, 0x7b, 0xc6, 0x3a, 0x1c, 0x20, 0xbe, 0x7f, 0x83, 0x74, 0x68, 0xe8, 0x13,
-	0x93, 0x6a, 0x0b, 0xfc, 0x37, 0x65, 0x8d, 0x61, 0x16, 0x48, 0xb4, 0x32, 0x07, 0x26, 0x49, 0x7d,
-	0x13, 0x16, 0x6f, 0x78, 0x16, 0x1d, 0xad, 0x6e, 0x3f, 0xfb, 0xc2, 0x20, 0x7b, 0xfd, 0x1d, 0x04,
-	0xc7, 0x0c, 0xda, 0xb3, 0x19, 0xfe, 0xeb, 0x34, 0x22, 0x16, 0x89, 0x48, 0x3f, 0xc7, 0x42, 0xc2,
-	0xb1, 0x0a, 0xe5, 0x40, 0xbc, 0xac, 0x15, 0xf8, 0x7a, 0x42, 0x0f, 0x48, 0x2b, 0xe6, 0x2b, 0x13,
-	0x9b, 0x50, 0x92, 0x78, 0x05, 0x2a, 0xb1, 0x2d, 0xb7, 0x5c, 0x8b, 0x7e, 0x8e, 0x5b, 0xaf, 0x64,
-	0x64, 0x97, 0xf0, 0x32, 0x2c, 0xf6, 0x62, 0x3b, 0x6f, 0x59, 0xdc, 0x8a, 0x25, 0x23, 0x5d, 0xd0,
-	0xff, 0x86, 0xe0, 0x64, 0xc6, 0x07, 0x0c, 0xb1, 0x33, 0x57, 0xb9, 0x9f, 0x8c, 0x56, 0xe8, 0x3c,
-	0x1c, 0x91, 0x9b, 0xd8, 0x6f, 0xa7, 0xc1, 0x1f, 0x98, 0x8a, 0xd9, 0x45, 0xa9, 0x62, 0x76, 0x8d,
-	0x29, 0x22, 0xe9, 0xe7, 0xb7, 0xae, 0x08, 0x35, 0xb3, 0x4b, 0x03, 0x86, 0x2a, 0xe5, 0x1b, 0x6a,
-	0x5e, 0x31, 0x94, 0xfe, 0x77, 0x04, 0x5a, 0x46, 0xd1, 0xeb, 0xc4, 0xb5, 0x9b, 0x34, 0x8c, 0x26,
-	0xdd, 0x33, 0x34, 0xc3, 0x3d, 0x5b, 0x85, 0x43, 0xb1, 0x56, 0x37, 0x59, 0x28, 0xb3, 0xb4, 0xa4,
-	0x95, 0x56, 0x8a, 0xab, 0x45, 0xa3, 0x7f, 0x99, 0xed, 0x9d, 0x94, 0x19, 0x6a, 0xf3, 0xdc, 0x8d,
-	0xd3, 0x05, 0x26, 0xc1, 0xf5, 0x36, 0x89, 0xd9, 0x8e, 0x23, 0xa0, 0x6c, 0x48, 0x52, 0x7f, 0x10,
-	0x16, 0x9f, 0xb1, 0x1d, 0xba, 0xd9, 0xee, 0xba, 0xbb, 0xf8, 0x28, 0x94, 0x4c, 0xf6, 0xc0, 0xb5,
-	0x3b, 0x60, 0xc4, 0x84, 0xfe, 0x35, 0x04, 0x0f, 0x8e, 0xb2, 0xc7, 0x6d, 0x3b, 0x6a, 0xb3, 0xef,
-	0xc3, 0x51, 0x86, 0x31, 0xdb, 0xd4, 0xdc, 0x0d, 0xbb, 0x1d, 0xe9, 0xcc, 0x92, 0x9e, 0xce, 0x30,
-	0xfa, 0x8f, 0x10, 0xac, 0x8e, 0xc5, 0x74, 0x3b, 0x20, 0xbe, 0x4f, 0x03, 0xfc, 0x0c, 0x94, 0xee,
-	0xb0, 0x1f, 0x78, 0xe8, 0x56, 0x1a, 0xb5, 0x5a, 0xf6, 0x44, 0x18, 0xcb, 0xe5, 0xd9, 0xff, 0x33,
-	0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7, 0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3,
-	0xd7, 0x9e, 0x9e, 0x87, 0x39, 0x9f, 0x04, 0x91, 0x7e, 0x0c, 0xee, 0x53, 0x03, 0xc7, 0xf7, 0xdc,
-	0x90, 0xea, 0xbf, 0x54, 0xfd, 0x6c, 0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84,
-	0x77, 0x21, 0x7b, 0x48, 0x71, 0xab, 0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x85, 0xd7, 0x64, 0x0a, 0xe7,
-	0x0f, 0x9f, 0x36, 0xad, 0x5a, 0xef, 0x91, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0x73, 0x45, 0x41, 0x26,
-	0xcf, 0x95, 0xac, 0xaa, 0x46, 0x96, 0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35,
-	0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7, 0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0,
-	0xfa, 0xaf, 0x54, 0xf4, 0xcf, 0xfb, 0xd6, 0xfb, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a,
-	0x50, 0x51, 0xf5, 0xa0, 0x9f, 0xa9, 0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06,
-	0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49, 0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38,
-	0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b, 0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8,
-	0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec, 0xec, 0xb9, 0xe6, 0x73, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64,
-	0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87, 0x7c, 0x4c, 0xe8, 0xff, 0x2c, 0xc1, 0xf1, 0x8c, 0x6e, 0xec,
-	0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7, 0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10,
-	0x14, 0x13, 0xec, 0x07, 0x5d, 0x37, 0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac,
-	0x74, 0x69, 0xed, 0x71, 0xe0, 0x95, 0xc6, 0xc7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34,
-	0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76, 0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1,
-	0x33, 0xbd, 0xa0, 0xe7, 0x7c, 0x56, 0x76, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47,
-	0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9, 0x02, 0xfe, 0x24, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22,
-	0x07, 0xf3, 0xf4, 0x74, 0x60, 0xb6, 0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68,
-	0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0, 0xed, 0xfa, 0xf1, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a,
-	0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d, 0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46,
-	0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90, 0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77,
-	0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x5d, 0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40,
-	0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f, 0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59,
-	0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2, 0x77, 0x11, 0xfc, 0x7f, 0x46, 0xe6, 0x4d, 0x12, 0x99, 0xed,
-	0x3c, 0x65, 0x59, 0xfc, 0xb2, 0x77, 0xc4, 0xb9, 0x1c, 0x13, 0xcc, 0xaa, 0xfc, 0xe1, 0xd6, 0x9e,
-	0xcf, 0x00, 0xb2, 0x5f, 0xd2, 0x85, 0x29, 0xcb, 0xaa, 0x1f, 0x23, 0xa8, 0x66, 0x73, 0xb8, 0xe7,
-	0x38, 0x2f, 0x11, 0x73, 0x37, 0x0f, 0xe4, 0x41, 0x28, 0xd8, 0x16, 0x47, 0x58, 0x34, 0x0a, 0xb6,
-	0xb5, 0xcf, 0x64, 0xd4, 0x0f, 0x77, 0x3e, 0x1f, 0xee, 0x82, 0x0a, 0xf7, 0xbd, 0x3e, 0xb8, 0x32,
-	0x25, 0xe4, 0xc0, 0x5d, 0x86, 0x45, 0xb7, 0xaf, 0xc4, 0x4d, 0x17, 0x86, 0x94, 0xb6, 0x85, 0x81,
-	0xd2, 0x56, 0x83, 0x85, 0x5e, 0x72, 0x01, 0x62, 0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0x0a, 0xbc, 0xae,
-	0x2f, 0x8c, 0x1e, 0x13, 0x0c, 0xc5, 0xae, 0xed, 0xb2, 0x62, 0x9d, 0xa3, 0x60, 0xcf, 0xfb, 0xbf,
-	0xf2, 0x28, 0x6a, 0xff, 0xa4, 0x00, 0x1f, 0x18, 0xa2, 0xf6, 0x58, 0x7f, 0xba, 0x37, 0x74, 0x4f,
-	0xbc, 0x7a, 0x61, 0xa4, 0x57, 0x97, 0xc7, 0x79, 0xf5, 0x62, 0xbe, 0xbd, 0x40, 0xb5, 0xd7, 0x0f,
-	0x0b, 0xb0, 0x32, 0xc4, 0x5e, 0xe3, 0xcb, 0x89, 0x7b, 0xc6, 0x60, 0x4d, 0x2f, 0x30, 0xe5, 0xb5,
-	0x20, 0x26, 0x58, 0x9c, 0x79, 0x81, 0xdf, 0x26, 0x2e, 0xf7, 0x8e, 0xb2, 0x21, 0xa8, 0x29, 0x4d,
-	0x75, 0x05, 0x34, 0x69, 0x9e, 0xcb, 0x66, 0x9c, 0xa4, 0x02, 0xd2, 0xa1, 0x11, 0x0d, 0xc2, 0x51,
-	0x29, 0xaa, 0x47, 0x9c, 0x2e, 0x95, 0x29, 0x8a, 0x13, 0xfa, 0xeb, 0x85, 0x7e, 0x36, 0x46, 0xd7,
-	0xbd, 0xf7, 0x0d, 0x7d, 0x1c, 0xe6, 0x09, 0x47, 0x2b, 0x5c, 0x53, 0x50, 0x03, 0x26, 0x2d, 0xe7,
-	0x9b, 0x74, 0x51, 0x31, 0xe9, 0x46, 0x41, 0x43, 0xfa, 0xbb, 0x05, 0xa8, 0x8e, 0x32, 0xc8, 0x0b,
-	0x8d, 0xff, 0x35, 0x93, 0x60, 0x02, 0x5a, 0x30, 0xc2, 0xcb, 0x34, 0xe0, 0xc5, 0xd9, 0x19, 0xe5,
-	0xc4, 0x1e, 0xe5, 0x92, 0xc6, 0x48, 0x36, 0xfa, 0x97, 0x10, 0x9c, 0x50, 0x3f, 0x0b, 0xb7, 0xed,
-	0x30, 0x92, 0x17, 0x3b, 0xdc, 0x84, 0x85, 0x58, 0x95, 0xb8, 0x2c, 0xaf, 0x34, 0xb6, 0xa7, 0x2d,
-	0xd6, 0x94, 0xdd, 0x95, 0xcc, 0xf5, 0xc7, 0xe0, 0xc4, 0xd0, 0x13, 0x4a, 0xc0, 0xa8, 0x42, 0x59,
-	0x16, 0xa8, 0x62, 0xf7, 0x13, 0x5a, 0x7f, 0x6b, 0x4e, 0x2d, 0x17, 0x3c, 0x6b, 0xdb, 0x6b, 0xe5,
-	0x74, 0x71, 0xf2, 0x3d, 0x86, 0xed, 0x86, 0x67, 0x65, 0x1a, 0x36, 0x92, 0x64, 0xdf, 0x99, 0x9e,
-	0x1b, 0x11, 0xdb, 0xa5, 0x81, 0xa8, 0x68, 0xd2, 0x05, 0xb6, 0xd3, 0xa1, 0xed, 0x9a, 0x74, 0x87,
-	0x9a, 0x9e, 0x6b, 0x85, 0xdc, 0x65, 0x8a, 0x86, 0xb2, 0x86, 0x9f, 0x85, 0x45, 0x4e, 0xdf, 0xb2,
-	0x3b, 0xf1, 0x11, 0x5e, 0x69, 0xac, 0xd5, 0xe2, 0xa6, 0x6c, 0x2d, 0xdb, 0x94, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x11, 0xa9, 0xf5, 0x2e, 0xd6, 0xd8, 0x17, 0x46, 0xfa, 0x31, 0xc3, 0x12, 0x11, 0xdb, 0xd9,
-	0xb6, 0x5d, 0x7e, 0x69, 0x60, 0xa2, 0xd2, 0x05, 0xe6, 0x8d, 0x4d, 0xcf, 0x71, 0xbc, 0x97, 0x65,
-	0xce, 0x8b, 0x29, 0xf6, 0x55, 0xd7, 0x8d, 0x6c, 0x87, 0xcb, 0x8f, 0x7d, 0x2d, 0x5d, 0xe0, 0x5f,
-	0xd9, 0x4e, 0x44, 0x03, 0x91, 0xec, 0x04, 0x95, 0xf8, 0x7b, 0x25, 0x6e, 0x16, 0xca, 0x5c, 0x1b,
-	0x47, 0xc6, 0x81, 0x6c, 0x64, 0xf4, 0x47, 0xdb, 0xd2, 0x90, 0x8e, 0x17, 0xef, 0x9d, 0xd2, 0x9e,
-	0xed, 0x75, 0x59, 0x3d, 0xcc, 0xcb, 0x46, 0x49, 0x0f, 0x44, 0xcb, 0xa1, 0xfc, 0x68, 0x39, 0xac,
-	0x46, 0x0b, 0xbf, 0xd5, 0x44, 0x66, 0x7b, 0x93, 0x84, 0x54, 0x3b, 0xc2, 0x59, 0xa7, 0x0b, 0xfa,
-	0xaf, 0x11, 0x94, 0xb7, 0xbd, 0xd6, 0x55, 0x37, 0x0a, 0xf6, 0xf8, 0xfd, 0xd7, 0x73, 0x23, 0xea,
-	0x4a, 0x6f, 0x92, 0x24, 0xdb, 0xa2, 0xc8, 0xee, 0xd0, 0x9d, 0x88, 0x74, 0x7c, 0x51, 0x3d, 0xef,
-	0x6b, 0x8b, 0x92, 0x8f, 0x99, 0xd9, 0x1c, 0x12, 0x46, 0x3c, 0xe5, 0x94, 0x0d, 0xfe, 0xcc, 0x14,
-	0x4c, 0x5e, 0xd8, 0x89, 0x02, 0x91, 0x6f, 0x94, 0xb5, 0xac, 0x03, 0x96, 0x62, 0x6c, 0x82, 0xd4,
-	0x3b, 0x70, 0x7f, 0x72, 0xad, 0xbb, 0x45, 0x83, 0x8e, 0xed, 0x92, 0xfc, 0x73, 0x79, 0x82, 0x96,
-	0x6e, 0x4e, 0x57, 0xc1, 0x53, 0x42, 0x92, 0xdd, 0x92, 0x6e, 0xdb, 0xae, 0xe5, 0xbd, 0x9c, 0x13,
-	0x5a, 0xd3, 0x09, 0xfc, 0x93, 0xda, 0x95, 0xcd, 0x48, 0x4c, 0xf2, 0xc0, 0xb3, 0xb0, 0xc4, 0x32,
-	0x46, 0x8f, 0x8a, 0x1f, 0x44, 0x52, 0xd2, 0x47, 0xb5, 0xc1, 0x52, 0x1e, 0x86, 0xfa, 0x21, 0xde,
-	0x86, 0x43, 0x24, 0x0c, 0xed, 0x96, 0x4b, 0x2d, 0xc9, 0xab, 0x30, 0x31, 0xaf, 0xfe, 0x4f, 0xe3,
-	0x86, 0x0a, 0x7f, 0x43, 0xec, 0xb7, 0x24, 0xf5, 0x2f, 0x22, 0x38, 0x36, 0x94, 0x49, 0x12, 0x57,
-	0x28, 0x73, 0x8e, 0x54, 0xa1, 0x1c, 0x9a, 0x6d, 0x6a, 0x75, 0x1d, 0x59, 0x2a, 0x24, 0x34, 0xfb,
-	0xcd, 0xea, 0xc6, 0xbb, 0x2f, 0xce, 0xb1, 0x84, 0xc6, 0x27, 0x01, 0x3a, 0xc4, 0xed, 0x12, 0x87,
-	0x43, 0x98, 0xe3, 0x10, 0x32, 0x2b, 0xfa, 0x32, 0x54, 0x87, 0xb9, 0x8e, 0xe8, 0xde, 0xfd, 0x03,
-	0xc1, 0x41, 0x99, 0x72, 0xc5, 0xee, 0xae, 0xc2, 0xa1, 0x8c, 0x19, 0x6e, 0xa4, 0x1b, 0xdd, 0xbf,
-	0x3c, 0x26, 0x9d, 0x4a, 0x2f, 0x29, 0xaa, 0x83, 0x95, 0x9e, 0x32, 0x1a, 0x99, 0xf8, 0xc0, 0x45,
-	0x33, 0xba, 0x19, 0x7c, 0x1e, 0xb4, 0xeb, 0xc4, 0x25, 0x2d, 0x6a, 0x25, 0x6a, 0x27, 0x2e, 0xf6,
-	0x99, 0x6c, 0x1b, 0x6a, 0xea, 0xa6, 0x4f, 0x52, 0x44, 0xdb, 0xcd, 0xa6, 0x6c, 0x69, 0xbd, 0x51,
-	0x50, 0xfd, 0x9c, 0xcf, 0xaa, 0x76, 0x6c, 0x8b, 0xbf, 0x14, 0x9b, 0x5f, 0x83, 0x05, 0xa1, 0x8a,
-	0x4c, 0x50, 0x82, 0x9c, 0x2e, 0xc4, 0xb0, 0x0f, 0x4b, 0x8e, 0xdd, 0xa3, 0x89, 0xd6, 0xda, 0xdc,
-	0xcc, 0x95, 0x54, 0x05, 0x30, 0x47, 0x8a, 0x48, 0xd0, 0xa2, 0xd1, 0xf5, 0xa4, 0xe3, 0x54, 0xe2,
-	0x2d, 0x8e, 0xfe, 0x65, 0xfd, 0xfb, 0x6a, 0x6f, 0x5e, 0x35, 0xcb, 0x7f, 0x6f, 0x7b, 0x78, 0xad,
-	0xe1, 0x59, 0x76, 0xd3, 0xa6, 0xf1, 0x7d, 0xbd, 0x6c, 0x24, 0xb4, 0x1e, 0x40, 0x79, 0xdb, 0x76,
-	0x77, 0xb7, 0xdc, 0xa6, 0xc7, 0x9c, 0x35, 0xb2, 0x23, 0x47, 0xee, 0x50, 0x4c, 0xe0, 0xc3, 0x50,
-	0xec, 0x06, 0x8e, 0x08, 0x5e, 0xf6, 0x88, 0x57, 0xa0, 0x62, 0xd1, 0xd0, 0x0c, 0x6c, 0x5f, 0x84,
-	0x2e, 0x9f, 0xf1, 0x64, 0x96, 0x58, 0x08, 0xd9, 0xa6, 0xe7, 0x6e, 0x3a, 0x24, 0x0c, 0x65, 0x65,
-	0x91, 0x2c, 0xe8, 0x4f, 0xc0, 0x12, 0x93, 0x99, 0x7a, 0xe8, 0x39, 0xd5, 0x04, 0xc7, 0x14, 0xd5,
-	0x24, 0x3c, 0xe9, 0x6c, 0x04, 0xee, 0x63, 0x05, 0xdd, 0x65, 0xdf, 0x17, 0x4c, 0x26, 0xbc, 0x5d,
-	0x14, 0x87, 0x15, 0x46, 0x43, 0x07, 0x18, 0x8d, 0xbb, 0x67, 0x01, 0xf7, 0x6d, 0x9c, 0x6d, 0x52,
-	0xfc, 0x75, 0x04, 0x73, 0x4c, 0x34, 0x7e, 0x60, 0x54, 0x46, 0xe5, 0xbe, 0x5e, 0x9d, 0x5d, 0x77,
-	0x8a, 0x49, 0xd3, 0x97, 0x5f, 0xfb, 0xf3, 0x5f, 0xbf, 0x51, 0x38, 0x8e, 0x8f, 0xf2, 0x29, 0x78,
-	0xef, 0x62, 0x76, 0x2e, 0x1d, 0xe2, 0x2f, 0x20, 0xc0, 0xa2, 0xc0, 0xcd, 0x8c, 0xfc, 0xf0, 0xb9,
-	0x51, 0x10, 0x87, 0x8c, 0x06, 0xab, 0x47, 0x6a, 0x62, 0xa0, 0xcc, 0x17, 0xb9, 0xd0, 0x35, 0x2e,
-	0xf4, 0x34, 0xd6, 0x87, 0x09, 0xad, 0xbf, 0xc2, 0xac, 0xf8, 0xaa, 0x18, 0x43, 0xe3, 0x37, 0x11,
-	0x94, 0x6e, 0xf3, 0xcb, 0xfc, 0x18, 0xc3, 0xec, 0xcc, 0xcc, 0x30, 0x5c, 0x1c, 0x47, 0xab, 0x9f,
-	0xe2, 0x48, 0x1f, 0xc0, 0x27, 0x24, 0xd2, 0x30, 0x0a, 0x28, 0xe9, 0x28, 0x80, 0x2f, 0x20, 0xfc,
-	0x36, 0x82, 0xf9, 0x78, 0x8a, 0x83, 0xcf, 0x8c, 0x42, 0xa9, 0x4c, 0x79, 0xaa, 0xb3, 0x1b, 0x89,
-	0xe8, 0x0f, 0x73, 0x8c, 0xa7, 0xf4, 0xa1, 0x5b, 0xb8, 0xa1, 0x0c, 0x4c, 0xde, 0x40, 0x50, 0xbc,
-	0x46, 0xc7, 0xfa, 0xd8, 0x0c, 0xc1, 0x0d, 0x18, 0x70, 0xc8, 0x56, 0xe3, 0xb7, 0x10, 0xdc, 0x7f,
-	0x8d, 0x46, 0xc3, 0xab, 0x19, 0xbc, 0x3a, 0xbe, 0xc4, 0x10, 0xae, 0x76, 0x6e, 0x82, 0x37, 0x93,
-	0x63, 0xbc, 0xce, 0x91, 0x3d, 0x8c, 0xcf, 0xe6, 0x39, 0x61, 0xb8, 0xe7, 0x9a, 0x2f, 0x0b, 0x1c,
-	0xbf, 0x47, 0x70, 0xb8, 0x7f, 0x9c, 0x8f, 0xf5, 0xbe, 0x2b, 0xe5, 0x90, 0x69, 0x7f, 0xf5, 0xc6,
-	0xb4, 0x59, 0x57, 0x65, 0xaa, 0x5f, 0xe6, 0xc8, 0x1f, 0xc7, 0x8f, 0xe5, 0x21, 0x4f, 0x5a, 0xe2,
-	0xf5, 0x57, 0xe4, 0xe3, 0xab, 0xfc, 0xaf, 0x56, 0x38, 0xec, 0x3f, 0x20, 0x38, 0x2a, 0xf9, 0x6e,
-	0xb6, 0x49, 0x10, 0x5d, 0xa1, 0xec, 0x42, 0x14, 0x4e, 0xa4, 0xcf, 0x94, 0xa7, 0x48, 0x56, 0x9e,
-	0x7e, 0x95, 0xeb, 0xf2, 0x14, 0x7e, 0x72, 0xdf, 0xba, 0x98, 0x8c, 0x8d, 0x25, 0x60, 0xbf, 0x83,
-	0xe0, 0xe0, 0x35, 0x1a, 0x3d, 0xb7, 0xb9, 0xb5, 0xaf, 0x9d, 0x99, 0xd2, 0xd1, 0x33, 0xe2, 0xf4,
-	0x2b, 0x5c, 0x91, 0x8f, 0xe0, 0x27, 0xf6, 0xad, 0x88, 0x67, 0xda, 0xc9, 0xbe, 0xbc, 0x86, 0xe0,
-	0xc0, 0xb5, 0xcc, 0x31, 0x3f, 0x3a, 0x9d, 0x28, 0x23, 0xeb, 0xea, 0x72, 0x2d, 0xf3, 0x47, 0x3f,
-	0xf2, 0xa7, 0xc4, 0xd5, 0xd7, 0x39, 0xb6, 0xb3, 0xf8, 0x4c, 0x1e, 0xb6, 0x74, 0xa4, 0xf5, 0x26,
-	0x82, 0x63, 0x59, 0x10, 0xe9, 0xa8, 0xff, 0x83, 0xfb, 0x1b, 0xa0, 0x8b, 0x31, 0xfc, 0x18, 0x74,
-	0x0d, 0x8e, 0xee, 0xbc, 0x3e, 0x3c, 0x10, 0x3b, 0x03, 0x28, 0x36, 0xd0, 0xda, 0x2a, 0xc2, 0xbf,
-	0x41, 0x30, 0x1f, 0x4f, 0x77, 0x46, 0xdb, 0x48, 0x19, 0x4d, 0xcf, 0x32, 0xab, 0x09, 0xaf, 0xad,
-	0x5e, 0x18, 0x6e, 0xd0, 0xec, 0xf7, 0x72, 0x6b, 0x6b, 0xdc, 0xca, 0x6a, 0x3a, 0xfe, 0x39, 0x02,
-	0x48, 0x27, 0x54, 0xf8, 0xe1, 0x7c, 0x3d, 0x32, 0x53, 0xac, 0xea, 0x6c, 0x67, 0x54, 0x7a, 0x8d,
-	0xeb, 0xb3, 0x5a, 0x5d, 0xc9, 0xcd, 0x85, 0x3e, 0x35, 0x37, 0xe2, 0x69, 0xd6, 0xf7, 0x10, 0x94,
-	0xf8, 0x60, 0x00, 0x9f, 0x1e, 0x85, 0x39, 0x3b, 0x37, 0x98, 0xa5, 0xe9, 0x1f, 0xe2, 0x50, 0x57,
-	0x1a, 0x79, 0x07, 0xca, 0x06, 0x5a, 0xc3, 0x3d, 0x98, 0x8f, 0x5b, 0xf1, 0xa3, 0xdd, 0x43, 0x69,
-	0xd5, 0x57, 0x57, 0x72, 0x8a, 0x9a, 0xd8, 0x51, 0xc5, 0x59, 0xb6, 0x36, 0xee, 0x2c, 0x9b, 0x63,
-	0xc7, 0x0d, 0x3e, 0x95, 0x77, 0x18, 0xfd, 0x07, 0x0c, 0x73, 0x8e, 0xa3, 0x3b, 0xa3, 0xaf, 0x8c,
-	0x3b, 0xcf, 0x98, 0x75, 0xbe, 0x89, 0xe0, 0x70, 0xff, 0x9d, 0x0e, 0x9f, 0x18, 0xda, 0x1e, 0x15,
-	0x67, 0xab, 0x6a, 0xc5, 0x51, 0xf7, 0x41, 0xfd, 0xa3, 0x1c, 0xc5, 0x06, 0x7e, 0x74, 0x6c, 0x64,
-	0xdc, 0x90, 0x59, 0x87, 0x31, 0x5a, 0x4f, 0xc7, 0xed, 0x3f, 0x40, 0x70, 0x50, 0xbd, 0xcd, 0x8c,
-	0xae, 0x37, 0x87, 0x5c, 0x06, 0xab, 0xb5, 0xc9, 0x5e, 0x4e, 0x10, 0x7f, 0x98, 0x23, 0xbe, 0x88,
-	0xeb, 0x23, 0x11, 0xc7, 0x48, 0xe3, 0x3f, 0x92, 0x5c, 0x0f, 0x6d, 0x8b, 0xae, 0x5b, 0x0c, 0xd5,
-	0x2f, 0x10, 0x1c, 0x90, 0x06, 0xb8, 0x15, 0x50, 0x9a, 0x6f, 0xbf, 0xd9, 0x45, 0x2c, 0x93, 0xa5,
-	0x3f, 0xc1, 0x51, 0x7f, 0x08, 0x5f, 0x9a, 0xd0, 0xce, 0xd2, 0xbe, 0xeb, 0x11, 0x43, 0xfa, 0x5b,
-	0x04, 0x47, 0x6e, 0xc7, 0x01, 0xfa, 0x3e, 0xe1, 0xdf, 0xe4, 0xf8, 0x9f, 0xc4, 0x8f, 0xe7, 0x14,
-	0xd6, 0xe3, 0xd4, 0xb8, 0x80, 0xf0, 0x4f, 0x11, 0x94, 0xe5, 0x3c, 0x19, 0x9f, 0x1d, 0x19, 0xc1,
-	0xea, 0xc4, 0x79, 0x96, 0x51, 0x27, 0xaa, 0x48, 0xfd, 0x74, 0xee, 0xb1, 0x2f, 0xe4, 0xb3, 0xc8,
-	0x7b, 0x03, 0x01, 0x4e, 0x7a, 0x4a, 0x49, 0x97, 0x09, 0x3f, 0xa4, 0x88, 0x1a, 0xd9, 0xb8, 0xac,
-	0x9e, 0x1d, 0xfb, 0x9e, 0x7a, 0xe6, 0xaf, 0xe5, 0x9e, 0xf9, 0x5e, 0x22, 0xff, 0x75, 0x04, 0x95,
-	0x6b, 0x34, 0xb9, 0xe8, 0xe5, 0xd8, 0x52, 0x1d, 0x87, 0x57, 0x57, 0xc7, 0xbf, 0x28, 0x10, 0x9d,
-	0xe7, 0x88, 0x1e, 0xc2, 0xf9, 0xa6, 0x92, 0x00, 0xbe, 0x85, 0x60, 0xe9, 0x66, 0xd6, 0x45, 0xf1,
-	0xf9, 0x71, 0x92, 0x94, 0x23, 0x67, 0x72, 0x5c, 0x8f, 0x70, 0x5c, 0xeb, 0xfa, 0x44, 0xb8, 0x36,
-	0xc4, 0x64, 0xf9, 0x3b, 0x28, 0xee, 0x14, 0xf4, 0x4d, 0x83, 0xfe, 0x5d, 0xbb, 0xe5, 0x0c, 0x95,
-	0xf4, 0x4b, 0x1c, 0x5f, 0x0d, 0x9f, 0x9f, 0x04, 0x5f, 0x5d, 0x8c, 0x88, 0xf0, 0xb7, 0x11, 0x1c,
-	0xe1, 0xe3, 0xc0, 0x2c, 0x63, 0x9c, 0x37, 0x01, 0x4b, 0x87, 0x87, 0x13, 0x9c, 0x85, 0x4f, 0xc5,
-	0xf9, 0x47, 0xdf, 0x17, 0xa8, 0x0d, 0x31, 0xe8, 0xfb, 0x72, 0x01, 0xb1, 0xfd, 0xbd, 0x6f, 0x00,
-	0xdf, 0x0b, 0x8d, 0x3e, 0x03, 0x8e, 0x1e, 0x6f, 0x4e, 0x80, 0x71, 0x83, 0x63, 0xbc, 0xa4, 0xd7,
-	0xf7, 0x83, 0xb1, 0xde, 0x6b, 0xb0, 0x30, 0xfd, 0x2a, 0x82, 0x83, 0xb2, 0x3e, 0x10, 0xfe, 0xb7,
-	0x3e, 0x6e, 0x6b, 0xf7, 0x5b, 0x4f, 0x88, 0x80, 0x58, 0x9b, 0x2c, 0x20, 0xde, 0x46, 0xb0, 0x20,
-	0xa6, 0x75, 0x39, 0x55, 0x57, 0x66, 0x9c, 0x57, 0xed, 0x6b, 0x75, 0x89, 0x71, 0x8e, 0xfe, 0x29,
-	0x2e, 0xf6, 0x79, 0x9c, 0x6b, 0x16, 0xdf, 0xb3, 0xc2, 0xfa, 0x2b, 0x62, 0x96, 0xf2, 0x6a, 0xdd,
-	0xf1, 0x5a, 0xe1, 0x8b, 0x3a, 0xce, 0xad, 0x2d, 0xd8, 0x3b, 0x17, 0x10, 0x8e, 0x60, 0x91, 0xb9,
-	0x2f, 0xef, 0x9f, 0xe1, 0x95, 0xbe, 0x6e, 0xdb, 0x40, 0x6b, 0xad, 0x5a, 0x1d, 0xe8, 0xc7, 0xa5,
-	0xc5, 0x84, 0xe8, 0x6c, 0xe0, 0x07, 0x73, 0xc5, 0x72, 0x41, 0x5f, 0x41, 0x70, 0x24, 0x1b, 0x8f,
-	0xb1, 0xf8, 0x89, 0xa3, 0x31, 0x0f, 0x85, 0xb8, 0x9f, 0xe0, 0xb5, 0x89, 0xdc, 0x88, 0xc3, 0x79,
-	0xfa, 0x99, 0xdf, 0xdd, 0x3d, 0x89, 0xfe, 0x78, 0xf7, 0x24, 0xfa, 0xcb, 0xdd, 0x93, 0xe8, 0xc5,
-	0x47, 0x27, 0xfb, 0x4f, 0x21, 0xa6, 0x63, 0x53, 0x37, 0xca, 0xb2, 0xff, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x64, 0xec, 0x77, 0x47, 0xd6, 0x32, 0x00, 0x00,
+func (m *ApplicationSnapshotQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ApplicationServiceClient is the client API for ApplicationService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationServiceClient interface {
-	// List returns list of applications
-	List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error)
-	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*events.EventList, error)
-	// Watch returns stream of application change events
-	Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error)
-	// Create creates an application
-	Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Get returns an application by name
-	Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Get returns sync windows of the application
-	GetApplicationSyncWindows(ctx context.Context, in *ApplicationSyncWindowsQuery, opts ...grpc.CallOption) (*ApplicationSyncWindowsResponse, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the application
-	RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
-	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
-	RevisionChartDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error)
-	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
-	GetOCIMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error)
-	// GetManifests returns application manifests
-	GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error)
-	// GetManifestsWithFiles returns application manifests using provided files to generate them
-	GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error)
-	// Update updates an application
-	Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// UpdateSpec updates an application spec
-	UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error)
-	// Patch patch an application
-	Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// Delete deletes an application
-	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// Sync syncs an application to its target state
-	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// ManagedResources returns list of managed resources
-	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
-	// ServerSideDiff performs server-side diff calculation using dry-run apply
-	ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error)
-	// ResourceTree returns resource tree
-	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
-	// Watch returns stream of application resource tree
-	WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error)
-	// Rollback syncs an application to its target state
-	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
-	// TerminateOperation terminates the currently running operation
-	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
-	// GetResource returns single application resource
-	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
-	// PatchResource patch single application resource
-	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
-	// ListResourceActions returns list of resource actions
-	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
-	// RunResourceAction runs a resource action
-	//
-	// Deprecated: use RunResourceActionV2 instead. This version does not support resource action parameters but is
-	// maintained for backward compatibility. It will be removed in a future release.
-	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// RunResourceActionV2 runs a resource action with parameters
-	RunResourceActionV2(ctx context.Context, in *ResourceActionRunRequestV2, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// DeleteResource deletes a single application resource
-	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
-	// PodLogs returns stream of log entries for the specified pod. Pod
-	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
-	// ListLinks returns the list of all application deep links
-	ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error)
-	// ListResourceLinks returns the list of all resource deep links
-	ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error)
+func (m *ApplicationSnapshotQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type applicationServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ApplicationSnapshotQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotQuery.Merge(m, src)
+}
+func (m *ApplicationSnapshotQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotQuery.DiscardUnknown(m)
 }
 
-func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
-	return &applicationServiceClient{cc}
+var xxx_messageInfo_ApplicationSnapshotQuery proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
 }
 
-func (c *applicationServiceClient) List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
-	out := new(v1alpha1.ApplicationList)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*events.EventList, error) {
-	out := new(events.EventList)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/application.ApplicationService/Watch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &applicationServiceWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+// ApplicationSnapshot is a point-in-time copy of the live state of the resources managed by an application
+type ApplicationSnapshot struct {
+	Id        *string  `protobuf:"bytes,1,req,name=id" json:"id,omitempty"`
+	CreatedAt *v1.Time `protobuf:"bytes,2,opt,name=createdAt" json:"createdAt,omitempty"`
+	// createdBy is the user who created the snapshot
+	CreatedBy *string `protobuf:"bytes,3,opt,name=createdBy" json:"createdBy,omitempty"`
+	// revisions are the revisions the application was synced to when the snapshot was created
+	Revisions []string `protobuf:"bytes,4,rep,name=revisions" json:"revisions,omitempty"`
+	// resources are the resources captured by the snapshot
+	Resources            []*v1alpha1.ResourceRef `protobuf:"bytes,5,rep,name=resources" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ApplicationSnapshot) Reset()         { *m = ApplicationSnapshot{} }
+func (m *ApplicationSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshot) ProtoMessage()    {}
+func (*ApplicationSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{47}
+}
+func (m *ApplicationSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type ApplicationService_WatchClient interface {
-	Recv() (*v1alpha1.ApplicationWatchEvent, error)
-	grpc.ClientStream
+func (m *ApplicationSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshot.Merge(m, src)
 }
-
-type applicationServiceWatchClient struct {
-	grpc.ClientStream
+func (m *ApplicationSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshot.DiscardUnknown(m)
 }
 
-func (x *applicationServiceWatchClient) Recv() (*v1alpha1.ApplicationWatchEvent, error) {
-	m := new(v1alpha1.ApplicationWatchEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationSnapshot proto.InternalMessageInfo
+
+func (m *ApplicationSnapshot) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
 	}
-	return m, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Create", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshot) GetCreatedAt() *v1.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshot) GetCreatedBy() string {
+	if m != nil && m.CreatedBy != nil {
+		return *m.CreatedBy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) GetApplicationSyncWindows(ctx context.Context, in *ApplicationSyncWindowsQuery, opts ...grpc.CallOption) (*ApplicationSyncWindowsResponse, error) {
-	out := new(ApplicationSyncWindowsResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetApplicationSyncWindows", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshot) GetRevisions() []string {
+	if m != nil {
+		return m.Revisions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
-	out := new(v1alpha1.RevisionMetadata)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshot) GetResources() []*v1alpha1.ResourceRef {
+	if m != nil {
+		return m.Resources
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) RevisionChartDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error) {
-	out := new(v1alpha1.ChartDetails)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionChartDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ApplicationSnapshotList struct {
+	Items                []*ApplicationSnapshot `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (c *applicationServiceClient) GetOCIMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
-	out := new(v1alpha1.OCIMetadata)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetOCIMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotList) Reset()         { *m = ApplicationSnapshotList{} }
+func (m *ApplicationSnapshotList) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotList) ProtoMessage()    {}
+func (*ApplicationSnapshotList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{48}
+}
+func (m *ApplicationSnapshotList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshotList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ApplicationSnapshotList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotList.Merge(m, src)
+}
+func (m *ApplicationSnapshotList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotList.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
-	out := new(apiclient.ManifestResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetManifests", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationSnapshotList proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotList) GetItems() []*ApplicationSnapshot {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *applicationServiceClient) GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/application.ApplicationService/GetManifestsWithFiles", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &applicationServiceGetManifestsWithFilesClient{stream}
-	return x, nil
+// ApplicationSnapshotRestoreRequest is a request to re-apply the resources of a snapshot to the cluster
+type ApplicationSnapshotRestoreRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Id           *string `protobuf:"bytes,4,req,name=id" json:"id,omitempty"`
+	// if set, the results of the restore are computed without changing any resource
+	DryRun               *bool    `protobuf:"varint,5,opt,name=dryRun" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type ApplicationService_GetManifestsWithFilesClient interface {
-	Send(*ApplicationManifestQueryWithFilesWrapper) error
-	CloseAndRecv() (*apiclient.ManifestResponse, error)
-	grpc.ClientStream
+func (m *ApplicationSnapshotRestoreRequest) Reset()         { *m = ApplicationSnapshotRestoreRequest{} }
+func (m *ApplicationSnapshotRestoreRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotRestoreRequest) ProtoMessage()    {}
+func (*ApplicationSnapshotRestoreRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{49}
+}
+func (m *ApplicationSnapshotRestoreRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshotRestoreRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotRestoreRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSnapshotRestoreRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotRestoreRequest.Merge(m, src)
+}
+func (m *ApplicationSnapshotRestoreRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotRestoreRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotRestoreRequest.DiscardUnknown(m)
 }
 
-type applicationServiceGetManifestsWithFilesClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_ApplicationSnapshotRestoreRequest proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotRestoreRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
 }
 
-func (x *applicationServiceGetManifestsWithFilesClient) Send(m *ApplicationManifestQueryWithFilesWrapper) error {
-	return x.ClientStream.SendMsg(m)
+func (m *ApplicationSnapshotRestoreRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
 }
 
-func (x *applicationServiceGetManifestsWithFilesClient) CloseAndRecv() (*apiclient.ManifestResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
 	}
-	m := new(apiclient.ManifestResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *ApplicationSnapshotRestoreRequest) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
 	}
-	return m, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Update", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
 	}
-	return out, nil
+	return false
 }
 
-func (c *applicationServiceClient) UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
-	out := new(v1alpha1.ApplicationSpec)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ApplicationSnapshotRestoreResult is the result of restoring a single resource.
+type ApplicationSnapshotRestoreResult struct {
+	Group     *string `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
+	Version   *string `protobuf:"bytes,2,opt,name=version" json:"version,omitempty"`
+	Kind      *string `protobuf:"bytes,3,opt,name=kind" json:"kind,omitempty"`
+	Namespace *string `protobuf:"bytes,4,opt,name=namespace" json:"namespace,omitempty"`
+	Name      *string `protobuf:"bytes,5,opt,name=name" json:"name,omitempty"`
+	// operation is one of created, patched or unchanged
+	Operation *string `protobuf:"bytes,6,opt,name=operation" json:"operation,omitempty"`
+	// error is the reason the resource could not be restored, empty if the restore succeeded
+	Error                *string  `protobuf:"bytes,7,opt,name=error" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSnapshotRestoreResult) Reset()         { *m = ApplicationSnapshotRestoreResult{} }
+func (m *ApplicationSnapshotRestoreResult) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotRestoreResult) ProtoMessage()    {}
+func (*ApplicationSnapshotRestoreResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{50}
+}
+func (m *ApplicationSnapshotRestoreResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshotRestoreResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotRestoreResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ApplicationSnapshotRestoreResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotRestoreResult.Merge(m, src)
+}
+func (m *ApplicationSnapshotRestoreResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotRestoreResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotRestoreResult.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Patch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationSnapshotRestoreResult proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotRestoreResult) GetGroup() string {
+	if m != nil && m.Group != nil {
+		return *m.Group
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetVersion() string {
+	if m != nil && m.Version != nil {
+		return *m.Version
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Sync", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetKind() string {
+	if m != nil && m.Kind != nil {
+		return *m.Kind
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
-	out := new(ManagedResourcesResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetNamespace() string {
+	if m != nil && m.Namespace != nil {
+		return *m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error) {
-	out := new(ApplicationServerSideDiffResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ServerSideDiff", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
-	out := new(v1alpha1.ApplicationTree)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetOperation() string {
+	if m != nil && m.Operation != nil {
+		return *m.Operation
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[2], "/application.ApplicationService/WatchResourceTree", opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotRestoreResult) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
 	}
-	x := &applicationServiceWatchResourceTreeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+type ApplicationSnapshotRestoreResponse struct {
+	Results              []*ApplicationSnapshotRestoreResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ApplicationSnapshotRestoreResponse) Reset()         { *m = ApplicationSnapshotRestoreResponse{} }
+func (m *ApplicationSnapshotRestoreResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotRestoreResponse) ProtoMessage()    {}
+func (*ApplicationSnapshotRestoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{51}
+}
+func (m *ApplicationSnapshotRestoreResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshotRestoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotRestoreResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+}
+func (m *ApplicationSnapshotRestoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotRestoreResponse.Merge(m, src)
+}
+func (m *ApplicationSnapshotRestoreResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotRestoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotRestoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSnapshotRestoreResponse proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotRestoreResponse) GetResults() []*ApplicationSnapshotRestoreResult {
+	if m != nil {
+		return m.Results
 	}
-	return x, nil
+	return nil
 }
 
-type ApplicationService_WatchResourceTreeClient interface {
-	Recv() (*v1alpha1.ApplicationTree, error)
-	grpc.ClientStream
+type ApplicationSnapshotDeleteRequest struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Id                   *string  `protobuf:"bytes,4,req,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type applicationServiceWatchResourceTreeClient struct {
-	grpc.ClientStream
+func (m *ApplicationSnapshotDeleteRequest) Reset()         { *m = ApplicationSnapshotDeleteRequest{} }
+func (m *ApplicationSnapshotDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSnapshotDeleteRequest) ProtoMessage()    {}
+func (*ApplicationSnapshotDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{52}
+}
+func (m *ApplicationSnapshotDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSnapshotDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSnapshotDeleteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSnapshotDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSnapshotDeleteRequest.Merge(m, src)
+}
+func (m *ApplicationSnapshotDeleteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSnapshotDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSnapshotDeleteRequest.DiscardUnknown(m)
 }
 
-func (x *applicationServiceWatchResourceTreeClient) Recv() (*v1alpha1.ApplicationTree, error) {
-	m := new(v1alpha1.ApplicationTree)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationSnapshotDeleteRequest proto.InternalMessageInfo
+
+func (m *ApplicationSnapshotDeleteRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return m, nil
+	return ""
 }
 
-func (c *applicationServiceClient) Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
-	out := new(v1alpha1.Application)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/Rollback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotDeleteRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
-	out := new(OperationTerminateResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotDeleteRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
-	out := new(ApplicationResourceResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationSnapshotDeleteRequest) GetId() string {
+	if m != nil && m.Id != nil {
+		return *m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
-	out := new(ApplicationResourceResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+// ApplicationAgentStatusRequest is the status of an application reported by the agent of a pull mode cluster
+type ApplicationAgentStatusRequest struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// Cluster is the name of the cluster the agent runs in
+	Cluster *string `protobuf:"bytes,4,req,name=cluster" json:"cluster,omitempty"`
+	// Revision is the revision of the manifests the status was compared to
+	Revision *string `protobuf:"bytes,5,opt,name=revision" json:"revision,omitempty"`
+	// SyncStatus is one of Synced, OutOfSync or Unknown
+	SyncStatus *string                    `protobuf:"bytes,6,req,name=syncStatus" json:"syncStatus,omitempty"`
+	Health     *v1alpha1.HealthStatus     `protobuf:"bytes,7,opt,name=health" json:"health,omitempty"`
+	Resources  []*v1alpha1.ResourceStatus `protobuf:"bytes,8,rep,name=resources" json:"resources,omitempty"`
+	// Error is the error the agent encountered applying the manifests, if any
+	Error *string `protobuf:"bytes,9,opt,name=error" json:"error,omitempty"`
+	// OperationPhase is the phase of the requested operation the agent carried out, if any
+	OperationPhase *string `protobuf:"bytes,10,opt,name=operationPhase" json:"operationPhase,omitempty"`
+	// OperationMessage describes the outcome of the requested operation
+	OperationMessage *string `protobuf:"bytes,11,opt,name=operationMessage" json:"operationMessage,omitempty"`
+	// ResourcesDeleted is set once the agent deleted the resources of an application being deleted with its resources
+	ResourcesDeleted     *bool    `protobuf:"varint,12,opt,name=resourcesDeleted" json:"resourcesDeleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationAgentStatusRequest) Reset()         { *m = ApplicationAgentStatusRequest{} }
+func (m *ApplicationAgentStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationAgentStatusRequest) ProtoMessage()    {}
+func (*ApplicationAgentStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{53}
+}
+func (m *ApplicationAgentStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationAgentStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationAgentStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ApplicationAgentStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationAgentStatusRequest.Merge(m, src)
+}
+func (m *ApplicationAgentStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationAgentStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationAgentStatusRequest.DiscardUnknown(m)
 }
 
-func (c *applicationServiceClient) ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error) {
-	out := new(ResourceActionsListResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceActions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationAgentStatusRequest proto.InternalMessageInfo
+
+func (m *ApplicationAgentStatusRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
 	}
-	return out, nil
+	return ""
 }
 
-// Deprecated: Do not use.
-func (c *applicationServiceClient) RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) RunResourceActionV2(ctx context.Context, in *ResourceActionRunRequestV2, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceActionV2", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
-	out := new(ApplicationResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteResource", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetCluster() string {
+	if m != nil && m.Cluster != nil {
+		return *m.Cluster
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[3], "/application.ApplicationService/PodLogs", opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
 	}
-	x := &applicationServicePodLogsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *ApplicationAgentStatusRequest) GetSyncStatus() string {
+	if m != nil && m.SyncStatus != nil {
+		return *m.SyncStatus
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *ApplicationAgentStatusRequest) GetHealth() *v1alpha1.HealthStatus {
+	if m != nil {
+		return m.Health
 	}
-	return x, nil
+	return nil
 }
 
-type ApplicationService_PodLogsClient interface {
-	Recv() (*LogEntry, error)
-	grpc.ClientStream
+func (m *ApplicationAgentStatusRequest) GetResources() []*v1alpha1.ResourceStatus {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
 }
 
-type applicationServicePodLogsClient struct {
-	grpc.ClientStream
+func (m *ApplicationAgentStatusRequest) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
 }
 
-func (x *applicationServicePodLogsClient) Recv() (*LogEntry, error) {
-	m := new(LogEntry)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetOperationPhase() string {
+	if m != nil && m.OperationPhase != nil {
+		return *m.OperationPhase
 	}
-	return m, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
-	out := new(LinksResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListLinks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetOperationMessage() string {
+	if m != nil && m.OperationMessage != nil {
+		return *m.OperationMessage
 	}
-	return out, nil
+	return ""
 }
 
-func (c *applicationServiceClient) ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
-	out := new(LinksResponse)
-	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceLinks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationAgentStatusRequest) GetResourcesDeleted() bool {
+	if m != nil && m.ResourcesDeleted != nil {
+		return *m.ResourcesDeleted
 	}
-	return out, nil
+	return false
 }
 
-// ApplicationServiceServer is the server API for ApplicationService service.
-type ApplicationServiceServer interface {
-	// List returns list of applications
-	List(context.Context, *ApplicationQuery) (*v1alpha1.ApplicationList, error)
-	// ListResourceEvents returns a list of event resources
-	ListResourceEvents(context.Context, *ApplicationResourceEventsQuery) (*events.EventList, error)
-	// Watch returns stream of application change events
-	Watch(*ApplicationQuery, ApplicationService_WatchServer) error
-	// Create creates an application
-	Create(context.Context, *ApplicationCreateRequest) (*v1alpha1.Application, error)
-	// Get returns an application by name
-	Get(context.Context, *ApplicationQuery) (*v1alpha1.Application, error)
-	// Get returns sync windows of the application
-	GetApplicationSyncWindows(context.Context, *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the application
-	RevisionMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
-	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
-	RevisionChartDetails(context.Context, *RevisionMetadataQuery) (*v1alpha1.ChartDetails, error)
-	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
-	GetOCIMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error)
-	// GetManifests returns application manifests
-	GetManifests(context.Context, *ApplicationManifestQuery) (*apiclient.ManifestResponse, error)
-	// GetManifestsWithFiles returns application manifests using provided files to generate them
-	GetManifestsWithFiles(ApplicationService_GetManifestsWithFilesServer) error
-	// Update updates an application
-	Update(context.Context, *ApplicationUpdateRequest) (*v1alpha1.Application, error)
-	// UpdateSpec updates an application spec
-	UpdateSpec(context.Context, *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error)
-	// Patch patch an application
-	Patch(context.Context, *ApplicationPatchRequest) (*v1alpha1.Application, error)
-	// Delete deletes an application
-	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
-	// Sync syncs an application to its target state
-	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
-	// ManagedResources returns list of managed resources
-	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
-	// ServerSideDiff performs server-side diff calculation using dry-run apply
-	ServerSideDiff(context.Context, *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error)
-	// ResourceTree returns resource tree
-	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
-	// Watch returns stream of application resource tree
-	WatchResourceTree(*ResourcesQuery, ApplicationService_WatchResourceTreeServer) error
-	// Rollback syncs an application to its target state
-	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
-	// TerminateOperation terminates the currently running operation
-	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+func init() {
+	proto.RegisterType((*ApplicationQuery)(nil), "application.ApplicationQuery")
+	proto.RegisterType((*NodeQuery)(nil), "application.NodeQuery")
+	proto.RegisterType((*RevisionMetadataQuery)(nil), "application.RevisionMetadataQuery")
+	proto.RegisterType((*RevisionComparisonQuery)(nil), "application.RevisionComparisonQuery")
+	proto.RegisterType((*ApplicationResourceEventsQuery)(nil), "application.ApplicationResourceEventsQuery")
+	proto.RegisterType((*ApplicationManifestQuery)(nil), "application.ApplicationManifestQuery")
+	proto.RegisterType((*FileChunk)(nil), "application.FileChunk")
+	proto.RegisterType((*ApplicationManifestQueryWithFiles)(nil), "application.ApplicationManifestQueryWithFiles")
+	proto.RegisterType((*ApplicationManifestQueryWithFilesWrapper)(nil), "application.ApplicationManifestQueryWithFilesWrapper")
+	proto.RegisterType((*ApplicationResponse)(nil), "application.ApplicationResponse")
+	proto.RegisterType((*ApplicationCreateRequest)(nil), "application.ApplicationCreateRequest")
+	proto.RegisterType((*ApplicationUpdateRequest)(nil), "application.ApplicationUpdateRequest")
+	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
+	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
+	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
+	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
+	proto.RegisterType((*ApplicationPatchParametersRequest)(nil), "application.ApplicationPatchParametersRequest")
+	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
+	proto.RegisterType((*ApplicationResourceRequest)(nil), "application.ApplicationResourceRequest")
+	proto.RegisterType((*ApplicationResourcePatchRequest)(nil), "application.ApplicationResourcePatchRequest")
+	proto.RegisterType((*ApplicationResourceDeleteRequest)(nil), "application.ApplicationResourceDeleteRequest")
+	proto.RegisterType((*ResourceActionParameters)(nil), "application.ResourceActionParameters")
+	proto.RegisterType((*ResourceActionRunRequest)(nil), "application.ResourceActionRunRequest")
+	proto.RegisterType((*ResourceActionRunRequestV2)(nil), "application.ResourceActionRunRequestV2")
+	proto.RegisterType((*ResourceActionsListResponse)(nil), "application.ResourceActionsListResponse")
+	proto.RegisterType((*ResourceActionBulkRunRequest)(nil), "application.ResourceActionBulkRunRequest")
+	proto.RegisterType((*ResourceActionResult)(nil), "application.ResourceActionResult")
+	proto.RegisterType((*ResourceActionBulkRunResponse)(nil), "application.ResourceActionBulkRunResponse")
+	proto.RegisterType((*ApplicationResourceResponse)(nil), "application.ApplicationResourceResponse")
+	proto.RegisterType((*ApplicationPodLogsQuery)(nil), "application.ApplicationPodLogsQuery")
+	proto.RegisterType((*LogEntry)(nil), "application.LogEntry")
+	proto.RegisterType((*OperationTerminateRequest)(nil), "application.OperationTerminateRequest")
+	proto.RegisterType((*ApplicationSyncWindowsQuery)(nil), "application.ApplicationSyncWindowsQuery")
+	proto.RegisterType((*ApplicationMetricsHistoryQuery)(nil), "application.ApplicationMetricsHistoryQuery")
+	proto.RegisterType((*ApplicationSyncWindowsResponse)(nil), "application.ApplicationSyncWindowsResponse")
+	proto.RegisterType((*ApplicationSyncWindow)(nil), "application.ApplicationSyncWindow")
+	proto.RegisterType((*OperationTerminateResponse)(nil), "application.OperationTerminateResponse")
+	proto.RegisterType((*ResourcesQuery)(nil), "application.ResourcesQuery")
+	proto.RegisterType((*ApplicationDeletePreviewResponse)(nil), "application.ApplicationDeletePreviewResponse")
+	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
+	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
+	proto.RegisterType((*ApplicationServerSideDiffResponse)(nil), "application.ApplicationServerSideDiffResponse")
+	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
+	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
+	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
+	proto.RegisterType((*ApplicationSnapshotQuery)(nil), "application.ApplicationSnapshotQuery")
+	proto.RegisterType((*ApplicationSnapshot)(nil), "application.ApplicationSnapshot")
+	proto.RegisterType((*ApplicationSnapshotList)(nil), "application.ApplicationSnapshotList")
+	proto.RegisterType((*ApplicationSnapshotRestoreRequest)(nil), "application.ApplicationSnapshotRestoreRequest")
+	proto.RegisterType((*ApplicationSnapshotRestoreResult)(nil), "application.ApplicationSnapshotRestoreResult")
+	proto.RegisterType((*ApplicationSnapshotRestoreResponse)(nil), "application.ApplicationSnapshotRestoreResponse")
+	proto.RegisterType((*ApplicationSnapshotDeleteRequest)(nil), "application.ApplicationSnapshotDeleteRequest")
+	proto.RegisterType((*ApplicationAgentStatusRequest)(nil), "application.ApplicationAgentStatusRequest")
+}
+
+func init() {
+	proto.RegisterFile("server/application/application.proto", fileDescriptor_df6e82b174b5eaec)
+}
+
+var fileDescriptor_df6e82b174b5eaec = []byte{
+	// 4149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5c, 0x6f, 0x8c, 0x1c, 0x47,
+	0x56, 0xa7, 0x66, 0x76, 0x76, 0x67, 0x6a, 0xbd, 0x6b, 0xbb, 0xfc, 0x27, 0x7d, 0x93, 0x8d, 0x59,
+	0x77, 0x6c, 0x67, 0xb3, 0xf6, 0xce, 0xd8, 0x93, 0xe4, 0x2e, 0xd9, 0x24, 0x1c, 0xf6, 0xda, 0xb1,
+	0x7d, 0xd8, 0x8e, 0xaf, 0xd7, 0x89, 0x51, 0x40, 0x82, 0x72, 0x77, 0xed, 0x4c, 0xdf, 0xf6, 0x74,
+	0x77, 0xba, 0x6b, 0x26, 0x59, 0x42, 0x24, 0x74, 0x02, 0xe9, 0x44, 0xd0, 0x21, 0x20, 0x48, 0x20,
+	0x71, 0xfc, 0xc9, 0xe9, 0x00, 0xa1, 0x8b, 0x10, 0x12, 0x42, 0x48, 0x08, 0x21, 0x84, 0xee, 0x74,
+	0x08, 0x90, 0x40, 0x7c, 0xe2, 0x13, 0x28, 0x42, 0x7c, 0xe4, 0xbe, 0xf0, 0x19, 0xa1, 0xfa, 0xd7,
+	0xdd, 0xd5, 0xd3, 0xd3, 0x33, 0x7b, 0x33, 0x26, 0x91, 0xee, 0x93, 0xa7, 0xaa, 0xbb, 0x5f, 0xfd,
+	0xde, 0x9f, 0x7a, 0xf5, 0xea, 0xbd, 0xb7, 0x86, 0xe7, 0x62, 0x12, 0x0d, 0x49, 0xd4, 0xc6, 0x61,
+	0xe8, 0xb9, 0x36, 0xa6, 0x6e, 0xe0, 0x67, 0x7f, 0xb7, 0xc2, 0x28, 0xa0, 0x01, 0x5a, 0xce, 0x4c,
+	0x35, 0xd7, 0xba, 0x41, 0xd0, 0xf5, 0x48, 0x1b, 0x87, 0x6e, 0x1b, 0xfb, 0x7e, 0x40, 0xf9, 0x74,
+	0x2c, 0x5e, 0x6d, 0x3e, 0xbf, 0xff, 0x62, 0xdc, 0x72, 0x03, 0xf6, 0xb4, 0x8f, 0xed, 0x9e, 0xeb,
+	0x93, 0xe8, 0xa0, 0x1d, 0xee, 0x77, 0xd9, 0x44, 0xdc, 0xee, 0x13, 0x8a, 0xdb, 0xc3, 0x2b, 0xed,
+	0x2e, 0xf1, 0x49, 0x84, 0x29, 0x71, 0xe4, 0x57, 0x77, 0xba, 0x2e, 0xed, 0x0d, 0x1e, 0xb5, 0xec,
+	0xa0, 0xdf, 0xc6, 0x51, 0x37, 0x08, 0xa3, 0xe0, 0x2b, 0xfc, 0xc7, 0x96, 0xed, 0xb4, 0x87, 0xcf,
+	0xa5, 0x04, 0xb2, 0x38, 0x87, 0x57, 0xb0, 0x17, 0xf6, 0xf0, 0x28, 0xb5, 0x1b, 0x13, 0xa8, 0x45,
+	0x24, 0x0c, 0x24, 0xdf, 0xfc, 0xa7, 0x4b, 0x83, 0xe8, 0x20, 0xf3, 0x53, 0x92, 0x79, 0x69, 0x02,
+	0x19, 0x49, 0x82, 0x0c, 0x89, 0x4f, 0x63, 0xf9, 0x8f, 0xf8, 0xd4, 0xfc, 0xe5, 0x2a, 0x3c, 0x76,
+	0x35, 0x85, 0xfa, 0xe5, 0x01, 0x89, 0x0e, 0x10, 0x82, 0x0b, 0x3e, 0xee, 0x13, 0x03, 0xac, 0x83,
+	0x8d, 0x86, 0xc5, 0x7f, 0x23, 0x03, 0x2e, 0x45, 0x64, 0x2f, 0x22, 0x71, 0xcf, 0xa8, 0xf0, 0x69,
+	0x35, 0x44, 0x4d, 0x58, 0x67, 0x0b, 0x12, 0x9b, 0xc6, 0x46, 0x75, 0xbd, 0xba, 0xd1, 0xb0, 0x92,
+	0x31, 0xda, 0x80, 0x47, 0x23, 0x12, 0x07, 0x83, 0xc8, 0x26, 0x6f, 0x92, 0x28, 0x76, 0x03, 0xdf,
+	0x58, 0xe0, 0x5f, 0xe7, 0xa7, 0x19, 0x95, 0x98, 0x78, 0xc4, 0xa6, 0x41, 0x64, 0xd4, 0xf8, 0x2b,
+	0xc9, 0x98, 0xe1, 0x61, 0x3c, 0x1b, 0x8b, 0x02, 0x0f, 0xfb, 0x8d, 0x4c, 0x78, 0x04, 0x87, 0xe1,
+	0x3d, 0xdc, 0x27, 0x71, 0x88, 0x6d, 0x62, 0x2c, 0xf1, 0x67, 0xda, 0x1c, 0xc3, 0x2c, 0x91, 0x18,
+	0x75, 0x0e, 0x4c, 0x0d, 0xd1, 0x49, 0x58, 0xf3, 0xdc, 0xbe, 0x4b, 0x8d, 0xc6, 0x3a, 0xd8, 0xa8,
+	0x5a, 0x62, 0xc0, 0x30, 0xd8, 0x81, 0x4f, 0x5d, 0x7f, 0x40, 0x0c, 0x28, 0x30, 0xa8, 0x31, 0x3a,
+	0x0d, 0x17, 0xe3, 0x20, 0xa2, 0xd7, 0x0e, 0x8c, 0x65, 0xfe, 0x44, 0x8e, 0xd8, 0x1a, 0x7d, 0xd7,
+	0x77, 0xfb, 0xd8, 0x33, 0x8e, 0xac, 0x83, 0x8d, 0xba, 0xa5, 0x86, 0xe8, 0x32, 0x3c, 0x61, 0x07,
+	0xbe, 0xe3, 0x32, 0xb9, 0xee, 0x92, 0x21, 0x89, 0x5c, 0xea, 0x92, 0xd8, 0x58, 0xe1, 0x48, 0x8a,
+	0x1e, 0x99, 0x3b, 0xb0, 0x71, 0x2f, 0x70, 0xc8, 0x78, 0x25, 0xe4, 0x99, 0xae, 0x8c, 0x32, 0x6d,
+	0x7e, 0x07, 0xc0, 0x53, 0x16, 0x19, 0xba, 0x4c, 0xaa, 0x77, 0x09, 0xc5, 0x0e, 0xa6, 0x38, 0x4f,
+	0xb1, 0x92, 0x50, 0x6c, 0xc2, 0x7a, 0x24, 0x5f, 0x36, 0x2a, 0x7c, 0x3e, 0x19, 0x8f, 0xac, 0x56,
+	0x2d, 0x17, 0xb1, 0x50, 0xac, 0x1a, 0xa2, 0x75, 0xb8, 0x2c, 0x34, 0x7c, 0xdb, 0x77, 0xc8, 0xbb,
+	0x5c, 0xa7, 0x35, 0x2b, 0x3b, 0x85, 0xd6, 0x60, 0x63, 0x28, 0xb4, 0x7f, 0xdb, 0xe1, 0xba, 0xad,
+	0x59, 0xe9, 0x84, 0xf9, 0x2f, 0x00, 0x3e, 0xa1, 0xf8, 0xd8, 0x09, 0xfa, 0x21, 0x8e, 0xdc, 0x78,
+	0xd4, 0x40, 0xc7, 0x71, 0x02, 0x34, 0x4e, 0x2e, 0xc0, 0x55, 0x8a, 0xa3, 0x2e, 0xa1, 0x8a, 0xa0,
+	0xe4, 0x25, 0x37, 0x3b, 0xc2, 0xf1, 0x42, 0x39, 0xc7, 0xb5, 0x52, 0x8e, 0x17, 0x47, 0x38, 0x36,
+	0xff, 0x0b, 0xc0, 0x33, 0x99, 0xdd, 0x66, 0xc9, 0x3d, 0x70, 0x83, 0xef, 0xc8, 0xf1, 0xac, 0x5d,
+	0x82, 0xc7, 0xd5, 0x76, 0xc9, 0xeb, 0x7e, 0xf4, 0x01, 0x63, 0x22, 0x3b, 0xa9, 0xd4, 0x96, 0x9d,
+	0x63, 0x50, 0xd5, 0xf8, 0x8d, 0xdb, 0xd7, 0x25, 0x9f, 0xd9, 0xa9, 0x11, 0x51, 0xd4, 0xca, 0x45,
+	0xb1, 0xa8, 0x89, 0xc2, 0xfc, 0x5a, 0x05, 0x1a, 0x19, 0x46, 0xef, 0x62, 0xdf, 0xdd, 0x23, 0x31,
+	0xfd, 0xc1, 0xb4, 0x37, 0x9b, 0x1d, 0x6e, 0xc0, 0xa3, 0x82, 0xab, 0xfb, 0xcc, 0x69, 0xb2, 0x03,
+	0xc0, 0xa8, 0xad, 0x57, 0x37, 0xaa, 0x56, 0x7e, 0x9a, 0xd9, 0xa3, 0x5a, 0x33, 0x36, 0x16, 0xf9,
+	0x36, 0x4d, 0x27, 0xd8, 0x0a, 0x7e, 0xb0, 0x83, 0xed, 0x9e, 0xf0, 0x35, 0x75, 0x4b, 0x0d, 0x75,
+	0x3b, 0xae, 0xe7, 0xed, 0xf8, 0x2c, 0x6c, 0xbc, 0xe6, 0x7a, 0x64, 0xa7, 0x37, 0xf0, 0xf7, 0x99,
+	0xdf, 0xb1, 0xd9, 0x0f, 0xce, 0xfb, 0x11, 0x4b, 0x0c, 0xcc, 0x5f, 0x03, 0xf0, 0xec, 0x38, 0x69,
+	0x3d, 0x74, 0x69, 0x8f, 0x7d, 0x1f, 0x8f, 0x13, 0x9b, 0xdd, 0x23, 0xf6, 0x7e, 0x3c, 0xe8, 0xab,
+	0xed, 0xab, 0xc6, 0xb3, 0x89, 0xcd, 0xfc, 0x13, 0x00, 0x37, 0x26, 0x62, 0x7a, 0x18, 0xe1, 0x30,
+	0x24, 0x11, 0x7a, 0x0d, 0xd6, 0xde, 0x66, 0x0f, 0xb8, 0xb3, 0x5a, 0xee, 0xb4, 0x5a, 0xd9, 0x93,
+	0x79, 0x22, 0x95, 0x5b, 0x3f, 0x62, 0x89, 0xcf, 0x51, 0x4b, 0x89, 0xa7, 0xc2, 0xe9, 0x9c, 0xd6,
+	0xe8, 0x24, 0x52, 0x64, 0xef, 0xf3, 0xd7, 0xae, 0x2d, 0xc2, 0x85, 0x10, 0x47, 0xd4, 0x3c, 0x05,
+	0x4f, 0xe8, 0xdb, 0x2a, 0x0c, 0xfc, 0x98, 0x98, 0x7f, 0x05, 0x34, 0x2b, 0xdc, 0x89, 0x08, 0xa6,
+	0xc4, 0x22, 0x6f, 0x0f, 0x48, 0x4c, 0xd1, 0x3e, 0xcc, 0x06, 0x0b, 0x5c, 0xaa, 0xcb, 0x9d, 0xdb,
+	0xad, 0xf4, 0x28, 0x6d, 0xa9, 0xa3, 0x94, 0xff, 0xf8, 0x19, 0xdb, 0x69, 0x0d, 0x9f, 0x6b, 0x85,
+	0xfb, 0xdd, 0x16, 0x3b, 0xdf, 0x35, 0x64, 0xea, 0x7c, 0xcf, 0xb2, 0x6a, 0x65, 0xa9, 0xb3, 0xd3,
+	0x63, 0x10, 0xc6, 0x24, 0xa2, 0x9c, 0xb3, 0xba, 0x25, 0x47, 0x4c, 0x7f, 0x43, 0xec, 0xb9, 0x0e,
+	0xa6, 0x42, 0x3f, 0x75, 0x2b, 0x19, 0x9b, 0x7f, 0xad, 0xa3, 0x7f, 0x23, 0x74, 0x3e, 0x2d, 0xf4,
+	0x59, 0x94, 0x15, 0x1d, 0x65, 0xd6, 0x82, 0xaa, 0xba, 0x05, 0xfd, 0xb9, 0x8e, 0xff, 0x3a, 0xf1,
+	0x48, 0x8a, 0xbf, 0xc8, 0x98, 0x0d, 0xb8, 0x64, 0xe3, 0xd8, 0xc6, 0x8e, 0x5a, 0x45, 0x0d, 0x99,
+	0x03, 0x0c, 0xa3, 0x20, 0xc4, 0x5d, 0x4e, 0xe9, 0x7e, 0xe0, 0xb9, 0xf6, 0x81, 0x5c, 0x6e, 0xf4,
+	0xc1, 0x6c, 0x5e, 0xdc, 0x7c, 0x1a, 0x2e, 0xef, 0x1e, 0xf8, 0xf6, 0xeb, 0xa1, 0x70, 0x0a, 0x27,
+	0x61, 0xcd, 0xa5, 0xa4, 0x1f, 0x1b, 0x80, 0x3b, 0x04, 0x31, 0x30, 0xff, 0xb7, 0x06, 0x4f, 0x67,
+	0x78, 0x63, 0x1f, 0x94, 0x71, 0x56, 0xe6, 0xdd, 0x4e, 0xc3, 0x45, 0x27, 0x3a, 0xb0, 0x06, 0xbe,
+	0x34, 0x00, 0x39, 0x62, 0x0b, 0x87, 0xd1, 0xc0, 0x17, 0xf0, 0xeb, 0x96, 0x18, 0xa0, 0x3d, 0x58,
+	0x8f, 0x69, 0x84, 0x29, 0xe9, 0x1e, 0x70, 0xe0, 0xcb, 0x9d, 0x2f, 0xcd, 0xa6, 0x74, 0x06, 0x7d,
+	0x57, 0x52, 0xb4, 0x12, 0xda, 0xe8, 0x6d, 0xe6, 0x0b, 0x85, 0x83, 0x8c, 0x8d, 0xa5, 0xf5, 0xea,
+	0xc6, 0x72, 0x67, 0x77, 0xf6, 0x85, 0x5e, 0x0f, 0x49, 0xa4, 0x9d, 0x7c, 0x56, 0xba, 0x0a, 0x73,
+	0xa3, 0x7d, 0xe9, 0x1f, 0x62, 0x19, 0xaf, 0xa5, 0x13, 0xe8, 0x27, 0x61, 0xcd, 0xf5, 0xf7, 0x82,
+	0xd8, 0x68, 0x70, 0x30, 0xd7, 0x66, 0x03, 0x73, 0xdb, 0xdf, 0x0b, 0x2c, 0x41, 0x10, 0xbd, 0x0d,
+	0x57, 0x22, 0x42, 0xa3, 0x03, 0x25, 0x05, 0x1e, 0xfa, 0x2d, 0x77, 0x7e, 0x62, 0xb6, 0x15, 0xac,
+	0x2c, 0x49, 0x4b, 0x5f, 0x01, 0x6d, 0xc3, 0xe5, 0x38, 0xb5, 0x31, 0x1e, 0x51, 0x2e, 0x77, 0x0c,
+	0x8d, 0x50, 0xc6, 0x06, 0xad, 0xec, 0xcb, 0x23, 0xd6, 0x7d, 0xa4, 0xdc, 0xba, 0x57, 0x26, 0x9e,
+	0x86, 0xab, 0x53, 0x9c, 0x86, 0x47, 0x73, 0xa7, 0xa1, 0xf9, 0x7d, 0x00, 0xd7, 0x46, 0x9c, 0xd3,
+	0x6e, 0x48, 0x4a, 0xb7, 0x01, 0x86, 0x0b, 0x71, 0x48, 0x6c, 0x7e, 0x52, 0x2d, 0x77, 0xee, 0xce,
+	0xcd, 0x5b, 0xf1, 0x75, 0x39, 0xe9, 0x32, 0x87, 0x3a, 0xa3, 0x5f, 0xf8, 0x3d, 0x00, 0x9f, 0xc8,
+	0xac, 0x79, 0x1f, 0x53, 0xbb, 0x57, 0xc6, 0x2c, 0xdb, 0xbf, 0xec, 0x1d, 0x79, 0x2e, 0x8b, 0x01,
+	0x93, 0x2a, 0xff, 0xf1, 0xe0, 0x20, 0x64, 0x00, 0xd9, 0x93, 0x74, 0x62, 0xc6, 0xa0, 0xeb, 0xbb,
+	0x55, 0x78, 0x36, 0x8f, 0xf0, 0x3e, 0x8e, 0x70, 0x9f, 0x50, 0x12, 0xc5, 0x65, 0x58, 0xa7, 0xb8,
+	0x57, 0x8c, 0x77, 0xf4, 0xf9, 0xb8, 0x77, 0x61, 0x34, 0xd2, 0x2f, 0xb8, 0x06, 0xd6, 0x8a, 0xaf,
+	0x81, 0x31, 0x5c, 0xed, 0x11, 0xaf, 0x9f, 0xc2, 0xe6, 0x81, 0xd8, 0xcc, 0xbb, 0xf1, 0x56, 0x96,
+	0xa6, 0x95, 0x5b, 0x82, 0xc1, 0xdb, 0x1f, 0xc4, 0x34, 0xe8, 0xbb, 0x3f, 0x47, 0x6e, 0xf7, 0x71,
+	0x57, 0xba, 0xbc, 0x86, 0x95, 0x9f, 0x46, 0x0e, 0x6c, 0x84, 0xde, 0xa0, 0xeb, 0xfa, 0x37, 0xfc,
+	0x21, 0xf7, 0x51, 0xcb, 0x9d, 0xd7, 0x66, 0x43, 0x76, 0xc3, 0x1f, 0xde, 0xf0, 0x69, 0x74, 0x60,
+	0xa5, 0x84, 0xcd, 0x6f, 0x03, 0xd8, 0xcc, 0x1e, 0xc6, 0x81, 0xe7, 0x3d, 0xc2, 0xf6, 0x7e, 0x99,
+	0x06, 0x57, 0x61, 0xc5, 0x75, 0xb8, 0xa9, 0x55, 0xad, 0x8a, 0xeb, 0x1c, 0xf2, 0x54, 0xc9, 0xeb,
+	0x7f, 0xb1, 0x5c, 0xff, 0x4b, 0xba, 0xdd, 0xfd, 0x4f, 0x0e, 0xae, 0xf2, 0xed, 0x25, 0x70, 0xd7,
+	0x60, 0xc3, 0xcf, 0x59, 0x5b, 0x3a, 0x51, 0x70, 0x83, 0xa9, 0x8c, 0xdc, 0x60, 0x0c, 0xb8, 0x34,
+	0x4c, 0x32, 0x0a, 0xec, 0xb1, 0x1a, 0x32, 0x16, 0xbb, 0x51, 0x30, 0x08, 0xa5, 0x89, 0x89, 0x01,
+	0x43, 0xb1, 0xef, 0xfa, 0xec, 0x9e, 0xc9, 0x51, 0xb0, 0xdf, 0x87, 0xcf, 0x21, 0x68, 0x6c, 0x7f,
+	0x5c, 0x81, 0x3f, 0x5a, 0xc0, 0xf6, 0x44, 0xc7, 0xf0, 0xd9, 0xe0, 0x3d, 0x71, 0x4f, 0x4b, 0x63,
+	0xdd, 0x53, 0x7d, 0x92, 0x7b, 0x6a, 0x94, 0xcb, 0x0b, 0xea, 0xf2, 0xfa, 0xe3, 0x0a, 0x5c, 0x2f,
+	0x90, 0xd7, 0xe4, 0xb8, 0xf0, 0x33, 0x23, 0xb0, 0xbd, 0x20, 0xb2, 0xd5, 0xed, 0x4f, 0x0c, 0xd8,
+	0x3e, 0x0b, 0xa2, 0xb0, 0x87, 0x7d, 0x6e, 0x1d, 0x75, 0x4b, 0x8e, 0x66, 0x14, 0xd5, 0x75, 0x68,
+	0x28, 0xf1, 0x5c, 0xb5, 0x85, 0x2f, 0x4f, 0x9c, 0xd5, 0x98, 0xb3, 0x66, 0x88, 0xbd, 0x01, 0x51,
+	0x67, 0x0d, 0x1f, 0x98, 0x5f, 0xaf, 0xe4, 0xc9, 0x58, 0x03, 0xff, 0xb3, 0x2f, 0xe8, 0xd3, 0x70,
+	0x11, 0x73, 0xb4, 0xd2, 0x34, 0xe5, 0x68, 0x44, 0xa4, 0xf5, 0x72, 0x91, 0x36, 0x34, 0x91, 0x6e,
+	0x57, 0x0c, 0x60, 0x7e, 0xbf, 0x02, 0x9b, 0xe3, 0x04, 0xf2, 0x66, 0xe7, 0x87, 0x4d, 0x24, 0x08,
+	0x43, 0x23, 0x1a, 0x63, 0x65, 0x06, 0xe4, 0x67, 0xdb, 0x79, 0xed, 0xcc, 0x1a, 0x67, 0x92, 0xd6,
+	0x58, 0x32, 0xe6, 0x2f, 0x01, 0xf8, 0xa4, 0xfe, 0x59, 0x7c, 0xc7, 0x8d, 0xa9, 0xba, 0xa1, 0xa3,
+	0x3d, 0xb8, 0x24, 0x58, 0x11, 0xf7, 0xab, 0xe5, 0xce, 0x9d, 0x59, 0xa3, 0x6e, 0x4d, 0xbb, 0x8a,
+	0xb8, 0xf9, 0x6f, 0x15, 0xb8, 0xa6, 0x3f, 0xbb, 0x36, 0xf0, 0xf6, 0x27, 0x6c, 0x87, 0xd9, 0xa2,
+	0xa2, 0x44, 0xbb, 0x0b, 0x45, 0xda, 0xad, 0x65, 0xb4, 0xab, 0xd9, 0xd8, 0x62, 0xde, 0xc6, 0xce,
+	0xc1, 0x15, 0x0f, 0x3f, 0x22, 0xde, 0xae, 0xca, 0x8e, 0x8b, 0x53, 0x4a, 0x9f, 0xcc, 0x58, 0x48,
+	0x5d, 0xb3, 0x90, 0x32, 0x1d, 0x37, 0xe6, 0xa3, 0xe3, 0x8f, 0x00, 0x3c, 0x99, 0x93, 0x3b, 0x89,
+	0x07, 0x5e, 0x46, 0x02, 0x20, 0x2b, 0x81, 0xcc, 0x7e, 0x90, 0x85, 0x04, 0x39, 0x4c, 0x64, 0x23,
+	0x04, 0x59, 0x20, 0x9b, 0x85, 0xbc, 0x6c, 0x94, 0xd6, 0x6a, 0x99, 0x1c, 0xf9, 0x49, 0x58, 0x23,
+	0x51, 0x14, 0x44, 0x52, 0x92, 0x62, 0x60, 0xfe, 0x34, 0x7c, 0x6a, 0x8c, 0xfe, 0xa5, 0x25, 0xbe,
+	0xcc, 0xea, 0x1b, 0x0c, 0xb6, 0xb2, 0xc4, 0xb3, 0x25, 0x72, 0x11, 0x0c, 0x5a, 0xea, 0x0b, 0xf3,
+	0x25, 0xf8, 0x64, 0x61, 0x00, 0x24, 0x69, 0x37, 0x61, 0x5d, 0x5d, 0x64, 0xa5, 0x81, 0x25, 0x63,
+	0xf3, 0xdf, 0x17, 0xf4, 0x6b, 0x45, 0xe0, 0xdc, 0x09, 0xba, 0x25, 0xb9, 0xe0, 0x72, 0x87, 0xc4,
+	0xcc, 0x31, 0x70, 0x32, 0x69, 0x5f, 0x35, 0x64, 0xdf, 0xd9, 0x81, 0x4f, 0xb1, 0xeb, 0x93, 0x48,
+	0x09, 0x32, 0x99, 0x60, 0xa6, 0x1e, 0xbb, 0xbe, 0x4d, 0x76, 0x09, 0xab, 0x4b, 0xc4, 0x5c, 0xa0,
+	0x55, 0x4b, 0x9b, 0x43, 0xb7, 0x60, 0x83, 0x8f, 0x1f, 0xb8, 0x7d, 0x61, 0xa6, 0xcb, 0x9d, 0xcd,
+	0x96, 0x28, 0xa2, 0xb5, 0xb2, 0x45, 0xb4, 0x74, 0x8b, 0xf6, 0x09, 0xc5, 0xad, 0xe1, 0x95, 0x16,
+	0xfb, 0xc2, 0x4a, 0x3f, 0x66, 0x58, 0x28, 0x76, 0xbd, 0x3b, 0xae, 0xcf, 0x23, 0x6d, 0xb6, 0x54,
+	0x3a, 0xc1, 0x4c, 0x79, 0x2f, 0xf0, 0xbc, 0xe0, 0x1d, 0x75, 0xa4, 0x8a, 0x11, 0xfb, 0x6a, 0xe0,
+	0x53, 0xd7, 0xe3, 0xeb, 0x0b, 0x57, 0x96, 0x4e, 0xf0, 0xaf, 0x5c, 0x8f, 0x92, 0x48, 0x9e, 0xa5,
+	0x72, 0x94, 0x18, 0xd5, 0x72, 0xc6, 0xa8, 0x12, 0xc3, 0x3c, 0x92, 0x35, 0xcc, 0xbc, 0x33, 0x5f,
+	0x29, 0xc8, 0x9b, 0xf3, 0x5a, 0x17, 0x19, 0xba, 0xc1, 0x80, 0xdd, 0x9b, 0xf9, 0xf5, 0x52, 0x8d,
+	0x47, 0xdc, 0xc5, 0xd1, 0x72, 0x77, 0x71, 0x4c, 0x77, 0x17, 0x3c, 0xfb, 0x41, 0xed, 0xde, 0x0e,
+	0x8e, 0x89, 0x71, 0x9c, 0x93, 0x4e, 0x27, 0x98, 0x13, 0xc0, 0x9e, 0xb7, 0xa3, 0xf4, 0x15, 0x1b,
+	0x88, 0xbf, 0xa1, 0x4f, 0x32, 0xbe, 0x22, 0xd2, 0x25, 0xef, 0x1a, 0x27, 0xf8, 0x53, 0x31, 0x60,
+	0x45, 0x87, 0xfa, 0x9d, 0xa0, 0xcb, 0x6f, 0x19, 0x0c, 0x00, 0xd3, 0x3a, 0xf1, 0x95, 0x25, 0xaa,
+	0x21, 0x53, 0x2f, 0x75, 0xfb, 0x64, 0x97, 0xe2, 0x7e, 0x28, 0x6f, 0xe8, 0x87, 0x52, 0x6f, 0xf2,
+	0x31, 0x13, 0xb9, 0x87, 0x63, 0xca, 0x4f, 0xc3, 0xba, 0xc5, 0x7f, 0x33, 0xe1, 0x24, 0x2f, 0xec,
+	0xd2, 0x48, 0x1e, 0x85, 0xda, 0x5c, 0xd6, 0x78, 0x85, 0x7b, 0x54, 0x43, 0xc6, 0x7e, 0x62, 0xab,
+	0xf7, 0xb0, 0x34, 0xbf, 0x86, 0xa5, 0x4f, 0x9a, 0x7d, 0xf8, 0xb9, 0x24, 0xc1, 0xf4, 0x80, 0x44,
+	0x7d, 0xd7, 0xc7, 0xe5, 0x81, 0xe5, 0x4c, 0x0e, 0xde, 0x0c, 0xb4, 0x4d, 0xcf, 0xf2, 0x35, 0x0f,
+	0x5d, 0xdf, 0x09, 0xde, 0x29, 0xd9, 0xbc, 0xb3, 0x2d, 0x18, 0x69, 0xc5, 0xa3, 0xbb, 0x84, 0x46,
+	0xae, 0x1d, 0xdf, 0x72, 0x63, 0x56, 0x09, 0x7e, 0x5c, 0x6b, 0x7e, 0xaf, 0x02, 0xcf, 0x14, 0x73,
+	0x99, 0x78, 0xb7, 0x5b, 0x70, 0x85, 0x1d, 0x36, 0x43, 0x22, 0x1f, 0x48, 0xff, 0x69, 0x8e, 0x2b,
+	0x02, 0xa4, 0x34, 0x2c, 0xfd, 0x43, 0x74, 0x07, 0x1e, 0xc5, 0x71, 0xec, 0x76, 0x7d, 0xe2, 0x28,
+	0x5a, 0x95, 0xa9, 0x69, 0xe5, 0x3f, 0x15, 0xe9, 0x64, 0xfe, 0x86, 0xb4, 0x44, 0x35, 0x64, 0x09,
+	0x0b, 0x1b, 0xfb, 0x57, 0x07, 0x34, 0xe0, 0x4f, 0xc5, 0x55, 0x38, 0x3b, 0x85, 0x2c, 0xb8, 0xea,
+	0x93, 0x77, 0xe9, 0x83, 0x08, 0xfb, 0x22, 0x1f, 0x26, 0x93, 0xad, 0x87, 0xd9, 0x11, 0x39, 0x0a,
+	0xe6, 0x1f, 0x55, 0xe0, 0xa9, 0x42, 0xe8, 0x89, 0x8f, 0x02, 0x99, 0xa0, 0x80, 0xd5, 0xc3, 0xed,
+	0x1e, 0x71, 0x06, 0x9e, 0x8a, 0xea, 0x93, 0x31, 0x7b, 0xe6, 0x0c, 0x84, 0x9d, 0xcb, 0x90, 0x33,
+	0x19, 0xa3, 0x33, 0x10, 0xf6, 0xb1, 0x3f, 0xc0, 0x9e, 0x64, 0x8d, 0x31, 0x9e, 0x99, 0x61, 0xdf,
+	0xb2, 0x4d, 0xf7, 0x56, 0xe0, 0xab, 0x63, 0x33, 0x19, 0xab, 0x20, 0x62, 0x28, 0xf6, 0x57, 0xdd,
+	0x92, 0xa3, 0x02, 0x69, 0x2c, 0xcd, 0x2a, 0x0d, 0x86, 0x63, 0xe0, 0x7b, 0x81, 0xbd, 0x4f, 0x1c,
+	0xe9, 0xe7, 0x93, 0xb1, 0xb9, 0x06, 0x9b, 0x45, 0x1b, 0x59, 0x56, 0x75, 0xfe, 0x1b, 0xc0, 0x55,
+	0x75, 0xc4, 0xca, 0xbd, 0xb6, 0x01, 0x8f, 0x66, 0x0c, 0xe4, 0x5e, 0xba, 0x05, 0xf2, 0xd3, 0x13,
+	0x8e, 0x4f, 0xb5, 0x7f, 0xaa, 0x7a, 0xe3, 0xc3, 0x50, 0x6b, 0x5d, 0x98, 0x3a, 0x7e, 0x07, 0x73,
+	0x4a, 0x34, 0x7c, 0x50, 0xd5, 0x2e, 0xce, 0xe2, 0xc2, 0x7c, 0x9f, 0x1d, 0x3b, 0xe4, 0x9d, 0x64,
+	0x17, 0x76, 0xb3, 0x09, 0x7b, 0xb1, 0x03, 0x6f, 0xcf, 0x27, 0x96, 0xb6, 0xc8, 0x5e, 0x36, 0x4d,
+	0xef, 0x42, 0xe8, 0x90, 0x90, 0xf8, 0x0e, 0xf1, 0xa9, 0xda, 0x9f, 0x73, 0x5c, 0x29, 0x43, 0x1c,
+	0x11, 0x56, 0x36, 0xe1, 0x0e, 0xde, 0x31, 0xaa, 0xf3, 0x5e, 0x28, 0x21, 0x5d, 0x5c, 0x5d, 0x5a,
+	0x18, 0x53, 0x5d, 0x32, 0x7f, 0x1e, 0x1a, 0x77, 0xb1, 0x8f, 0xbb, 0xc4, 0x49, 0x8c, 0x30, 0x51,
+	0xc2, 0xcf, 0x66, 0x8b, 0x45, 0x33, 0x97, 0x66, 0x92, 0x0c, 0x89, 0xbb, 0xb7, 0xa7, 0x0a, 0x4f,
+	0x1f, 0xe6, 0xfc, 0x31, 0xef, 0xec, 0xd9, 0x75, 0x1d, 0xfe, 0x92, 0xd8, 0x0c, 0x06, 0x5c, 0x92,
+	0x86, 0xa5, 0x8e, 0x78, 0x39, 0x9c, 0xf1, 0x42, 0x13, 0xc2, 0x15, 0xcf, 0x1d, 0x92, 0x84, 0x6b,
+	0x63, 0x61, 0xee, 0x4c, 0xea, 0x0b, 0xb0, 0x6d, 0x2d, 0x1a, 0x34, 0xee, 0x26, 0x75, 0xa1, 0x9a,
+	0xc8, 0xcb, 0xe6, 0xa6, 0xcd, 0x3f, 0xd0, 0x2b, 0xe8, 0xba, 0x58, 0xfe, 0xff, 0xd4, 0xc3, 0x23,
+	0xfd, 0xc0, 0x71, 0xf7, 0x5c, 0x22, 0x92, 0xb1, 0x75, 0x2b, 0x19, 0x9b, 0x11, 0xac, 0xdf, 0x71,
+	0xfd, 0x7d, 0x56, 0x7a, 0x62, 0xae, 0x83, 0xba, 0xd4, 0x53, 0x1a, 0x12, 0x03, 0x74, 0x0c, 0x56,
+	0x07, 0x91, 0x27, 0xdd, 0x3d, 0xfb, 0xc9, 0x4e, 0x2a, 0x87, 0xc4, 0x76, 0xe4, 0x86, 0x34, 0xed,
+	0x5a, 0xc9, 0x4e, 0x31, 0x87, 0xe6, 0xda, 0x81, 0xbf, 0xe3, 0xe1, 0x38, 0x56, 0x71, 0x7d, 0x32,
+	0x61, 0xbe, 0x02, 0x57, 0xd8, 0x9a, 0xa9, 0x85, 0x5e, 0xd4, 0x45, 0x70, 0x4a, 0x63, 0x4d, 0xc1,
+	0x53, 0xc6, 0x86, 0xe1, 0x09, 0x76, 0x5b, 0xbf, 0x1a, 0x86, 0x92, 0xc8, 0x94, 0xa9, 0xa3, 0x6a,
+	0xd1, 0xb5, 0xa4, 0xb8, 0xcd, 0xc0, 0xd3, 0x6a, 0xc4, 0xbb, 0x3e, 0x0e, 0xe3, 0x5e, 0x40, 0x1f,
+	0x57, 0x34, 0xf3, 0x61, 0x05, 0x9e, 0x28, 0x58, 0x4e, 0x66, 0xcf, 0xc5, 0x3a, 0x2c, 0x7b, 0x7e,
+	0x0b, 0x36, 0x6c, 0xde, 0x2c, 0xe0, 0x5c, 0xa5, 0x46, 0xe5, 0xd0, 0x67, 0x5d, 0xfa, 0x31, 0xbf,
+	0x76, 0x89, 0xc1, 0x35, 0x55, 0xb1, 0x4e, 0x27, 0xf4, 0x1a, 0xdb, 0x42, 0xbe, 0xe3, 0x44, 0x73,
+	0xe9, 0xb5, 0xc7, 0xe7, 0xd2, 0xcd, 0x2f, 0xc3, 0x27, 0x0a, 0xa4, 0xc2, 0x54, 0x8f, 0x3e, 0xaf,
+	0xdb, 0xcb, 0xfa, 0xd8, 0x40, 0x4c, 0x7e, 0xa4, 0x4c, 0xe7, 0x1b, 0xb9, 0x0d, 0xa9, 0x1e, 0x13,
+	0x16, 0xad, 0x3e, 0xbe, 0xa0, 0x5c, 0x6a, 0x72, 0x21, 0xd1, 0x64, 0x5a, 0x07, 0xa9, 0x65, 0xeb,
+	0x20, 0xe6, 0x3f, 0x02, 0xb8, 0x5e, 0x86, 0xef, 0x53, 0x4c, 0x60, 0xac, 0xc1, 0x46, 0xa0, 0xa2,
+	0x1f, 0x95, 0x0e, 0x4a, 0x26, 0xd2, 0xf4, 0xc6, 0x52, 0x36, 0xbd, 0xd1, 0x87, 0x66, 0x29, 0x37,
+	0x62, 0xf3, 0xdf, 0xcc, 0xe7, 0x38, 0xb6, 0x26, 0xaa, 0x33, 0x2b, 0x8f, 0x34, 0xdf, 0xf1, 0x8b,
+	0xc5, 0xd2, 0x9b, 0x9c, 0xca, 0x9f, 0xab, 0x72, 0xcd, 0x8f, 0x17, 0xe0, 0x53, 0x19, 0x18, 0x57,
+	0xbb, 0xc4, 0xa7, 0xbb, 0x14, 0xd3, 0xc1, 0x63, 0x2c, 0x76, 0xb2, 0x5b, 0x85, 0x37, 0x88, 0x29,
+	0x51, 0x77, 0x58, 0x35, 0xd4, 0x9a, 0x3c, 0x6a, 0xb9, 0x26, 0x8f, 0x33, 0x10, 0xc6, 0xbc, 0xd1,
+	0x82, 0x81, 0x93, 0xa9, 0xdd, 0xcc, 0x0c, 0x7a, 0x04, 0x17, 0x7b, 0x04, 0x7b, 0xb4, 0x27, 0x23,
+	0xeb, 0x2f, 0xcd, 0x5a, 0xee, 0x64, 0xb4, 0xa4, 0x28, 0x24, 0x65, 0xf4, 0x95, 0xac, 0x3b, 0xa9,
+	0xcf, 0x33, 0xdb, 0x2a, 0x17, 0x4a, 0xc9, 0xa7, 0x56, 0xda, 0xc8, 0x58, 0x29, 0x6b, 0xc3, 0x4c,
+	0x0c, 0xf9, 0x7e, 0x8f, 0x25, 0x3a, 0x44, 0xae, 0x26, 0x37, 0x8b, 0x36, 0xe1, 0xb1, 0x64, 0xe6,
+	0x2e, 0x89, 0x63, 0xdc, 0x25, 0x32, 0x7f, 0x33, 0x32, 0xcf, 0xde, 0x4d, 0x96, 0x15, 0xf6, 0xe7,
+	0xc8, 0x46, 0xdc, 0x91, 0xf9, 0xce, 0xc7, 0x2f, 0x40, 0x94, 0x8b, 0x12, 0x5c, 0x9b, 0xa0, 0x5f,
+	0x07, 0x70, 0x81, 0x3b, 0xbb, 0xa7, 0xc6, 0x6d, 0x07, 0x7e, 0x1e, 0x35, 0xe7, 0xd7, 0xb0, 0xc0,
+	0x56, 0x33, 0xd7, 0xbe, 0xfa, 0xaf, 0xff, 0xf9, 0x1b, 0x95, 0xd3, 0xe8, 0x24, 0x6f, 0x50, 0x1f,
+	0x5e, 0xc9, 0xb6, 0x8c, 0xc7, 0xe8, 0x17, 0x00, 0x44, 0x32, 0x55, 0x9e, 0xe9, 0x11, 0x45, 0x17,
+	0xc7, 0x41, 0x2c, 0xe8, 0x25, 0x6d, 0x1e, 0x6f, 0xc9, 0x5e, 0x6f, 0x3e, 0xc9, 0x17, 0xdd, 0xe4,
+	0x8b, 0x9e, 0x43, 0x66, 0xd1, 0xa2, 0xed, 0xf7, 0xd8, 0x06, 0x79, 0x5f, 0x76, 0x88, 0xa3, 0x8f,
+	0x00, 0xac, 0x3d, 0xe4, 0x65, 0xc1, 0x09, 0x82, 0xd9, 0x9d, 0x9b, 0x60, 0xf8, 0x72, 0x1c, 0xad,
+	0xf9, 0x34, 0x47, 0xfa, 0x14, 0x7a, 0x52, 0x21, 0x8d, 0x69, 0x44, 0x70, 0x5f, 0x03, 0x7c, 0x19,
+	0xa0, 0x6f, 0x01, 0xb8, 0x28, 0x1a, 0xfb, 0xd0, 0xf9, 0x71, 0x28, 0xb5, 0xc6, 0xbf, 0xe6, 0xfc,
+	0xba, 0xe4, 0xcc, 0x67, 0x39, 0xc6, 0xa7, 0xcd, 0x42, 0x15, 0x6e, 0x6b, 0x3d, 0x74, 0x1f, 0x02,
+	0x58, 0xbd, 0x49, 0x26, 0xda, 0xd8, 0x1c, 0xc1, 0x8d, 0x08, 0xb0, 0x40, 0xd5, 0xe8, 0x9b, 0x00,
+	0x7e, 0xee, 0x26, 0xa1, 0xc5, 0x29, 0x1e, 0xb4, 0x31, 0x39, 0xef, 0x22, 0x4d, 0xed, 0xe2, 0x14,
+	0x6f, 0x26, 0x37, 0xf8, 0x36, 0x47, 0xf6, 0x2c, 0x7a, 0xa6, 0xcc, 0x08, 0x99, 0x77, 0x7c, 0x47,
+	0xe2, 0xf8, 0x7b, 0x00, 0x8f, 0xe5, 0x7b, 0xda, 0x91, 0x99, 0x4b, 0xd0, 0x17, 0xb4, 0xbc, 0x37,
+	0xef, 0xcd, 0xea, 0xe0, 0x74, 0xa2, 0xe6, 0x55, 0x8e, 0xfc, 0x65, 0xf4, 0x52, 0x19, 0xf2, 0x24,
+	0x82, 0x6b, 0xbf, 0xa7, 0x7e, 0xbe, 0xdf, 0xee, 0x4b, 0x12, 0xe8, 0xef, 0x00, 0x44, 0xa3, 0x7d,
+	0xed, 0xe8, 0x5c, 0x21, 0x37, 0xb9, 0xc6, 0xf7, 0xe6, 0xfd, 0xf9, 0xf0, 0x93, 0x92, 0x35, 0x5f,
+	0xe0, 0x1c, 0xb5, 0xd1, 0xd6, 0x74, 0x1c, 0xd9, 0xfc, 0x4b, 0x82, 0xfe, 0x89, 0x17, 0x7d, 0x24,
+	0xb5, 0x1e, 0x8e, 0xe8, 0x75, 0x42, 0xb1, 0xeb, 0xc5, 0x53, 0x69, 0x65, 0xc6, 0xd3, 0x2d, 0xbb,
+	0x9e, 0x79, 0x83, 0xe3, 0xff, 0x22, 0x7a, 0xf5, 0xd0, 0x1a, 0xb1, 0x19, 0x19, 0x47, 0xc2, 0xfe,
+	0x0e, 0x80, 0xab, 0x37, 0x09, 0x7d, 0x7d, 0xe7, 0xf6, 0xa1, 0xec, 0x6b, 0xc6, 0xed, 0x9a, 0x59,
+	0xce, 0xbc, 0xce, 0x19, 0xf9, 0x31, 0xf4, 0xca, 0xa1, 0x19, 0x09, 0x6c, 0x37, 0xb1, 0xae, 0xaf,
+	0x02, 0x78, 0xe4, 0x66, 0xe6, 0x66, 0x3c, 0xde, 0x29, 0x6a, 0xbd, 0xd8, 0xcd, 0xb5, 0x56, 0xe6,
+	0xaf, 0x8a, 0xd4, 0xa3, 0x64, 0xc3, 0x6e, 0x71, 0x6c, 0xcf, 0xa0, 0xf3, 0x65, 0xd8, 0xd2, 0x5e,
+	0xcd, 0x8f, 0x00, 0x3c, 0x95, 0x05, 0x91, 0xf6, 0xb0, 0xbf, 0x70, 0xb8, 0xce, 0x70, 0xd9, 0x5f,
+	0x3e, 0x01, 0x5d, 0x87, 0xa3, 0xbb, 0x64, 0x16, 0xbb, 0x93, 0xfe, 0x08, 0x8a, 0x6d, 0xb0, 0xb9,
+	0x01, 0xd0, 0xdf, 0x02, 0xb8, 0x28, 0xda, 0x16, 0xc7, 0xcb, 0x48, 0xeb, 0xb9, 0x9e, 0xa7, 0x6f,
+	0x96, 0x56, 0xdb, 0xbc, 0x5c, 0x2c, 0xd0, 0xec, 0xf7, 0x4a, 0xb5, 0x2d, 0x2e, 0x65, 0xfd, 0x50,
+	0xf9, 0x0b, 0x00, 0x61, 0xda, 0x7a, 0x89, 0x9e, 0x2d, 0xe7, 0x23, 0xd3, 0x9e, 0xd9, 0x9c, 0x6f,
+	0xf3, 0xa5, 0xd9, 0xe2, 0xfc, 0x6c, 0x34, 0xd7, 0x4b, 0x3d, 0x7a, 0x48, 0xec, 0x6d, 0xd1, 0xa6,
+	0xf9, 0xfb, 0x00, 0xd6, 0x78, 0xa3, 0x54, 0xce, 0xef, 0x8d, 0x69, 0xb0, 0x9c, 0xa7, 0xe8, 0x2f,
+	0x70, 0xa8, 0xeb, 0x9d, 0xb2, 0x63, 0x71, 0x1b, 0x6c, 0xa2, 0xbf, 0x01, 0xf0, 0x68, 0xae, 0x85,
+	0x12, 0xb5, 0x4a, 0xc1, 0x8e, 0xf4, 0x5a, 0xce, 0x13, 0xf6, 0x15, 0x0e, 0xfb, 0xa2, 0x79, 0xa1,
+	0x4c, 0xc2, 0x61, 0x82, 0x80, 0x71, 0x30, 0x84, 0x8b, 0x22, 0xf2, 0x1d, 0x6f, 0xe0, 0xda, 0x8d,
+	0xad, 0xb9, 0x5e, 0x12, 0x5c, 0x8a, 0xad, 0x26, 0x63, 0x8a, 0xcd, 0xd2, 0x98, 0xe2, 0xb7, 0x01,
+	0x5c, 0xd1, 0x92, 0xd4, 0xd3, 0xae, 0xbf, 0x55, 0xfe, 0x5a, 0x2e, 0xe5, 0xad, 0xf6, 0x3d, 0xda,
+	0x2c, 0x13, 0x89, 0xc3, 0x3f, 0xdd, 0x0a, 0x25, 0x92, 0x6f, 0x02, 0xb8, 0xc0, 0xeb, 0x20, 0x4f,
+	0x97, 0x05, 0x2c, 0x8f, 0x41, 0x7f, 0x17, 0x39, 0xd8, 0xf3, 0xe6, 0xfa, 0xa4, 0x98, 0x87, 0x69,
+	0xee, 0xb7, 0x00, 0x3c, 0x96, 0x4f, 0x32, 0xa3, 0x27, 0x0b, 0x1b, 0x12, 0x64, 0xfc, 0xa5, 0x4b,
+	0x78, 0x5c, 0x82, 0xda, 0xfc, 0x71, 0x8e, 0x62, 0x1b, 0xbd, 0x38, 0xd1, 0xef, 0xdc, 0x53, 0x3e,
+	0x9d, 0x11, 0xda, 0x4a, 0x6f, 0x76, 0x7f, 0x08, 0xe0, 0xaa, 0x9e, 0x5e, 0x1d, 0x7f, 0x27, 0x29,
+	0xc8, 0x4e, 0x37, 0x5b, 0xd3, 0xbd, 0x9c, 0x20, 0xfe, 0x02, 0x47, 0x7c, 0x05, 0xb5, 0xc7, 0x22,
+	0x16, 0x48, 0xc5, 0xdf, 0xb8, 0x6e, 0xc5, 0xae, 0x43, 0xb6, 0x1c, 0x86, 0xea, 0x2f, 0x01, 0x3c,
+	0xa2, 0x04, 0xf0, 0x20, 0x22, 0xa4, 0x5c, 0x7e, 0xf3, 0xf3, 0x87, 0x6c, 0x2d, 0xf3, 0x15, 0x8e,
+	0xfa, 0xf3, 0xe8, 0xf9, 0x29, 0xe5, 0xac, 0xe4, 0xbb, 0x45, 0x19, 0xd2, 0x7f, 0x00, 0x70, 0x55,
+	0x2f, 0xef, 0x8e, 0x97, 0x71, 0x41, 0x19, 0xb8, 0xf9, 0x70, 0x6e, 0xcc, 0xe8, 0xd4, 0xcd, 0xe7,
+	0x38, 0x5b, 0x5b, 0xe8, 0x62, 0x69, 0x1c, 0x20, 0xbe, 0xd9, 0xea, 0x49, 0xe8, 0xdf, 0x05, 0xf0,
+	0xf8, 0x43, 0xe1, 0xcc, 0x3f, 0x25, 0x6d, 0xec, 0x70, 0xd8, 0xaf, 0xa2, 0x97, 0x4b, 0xae, 0x92,
+	0x93, 0x94, 0x72, 0x19, 0xa0, 0x3f, 0x05, 0xb0, 0xae, 0x7a, 0xb1, 0xd1, 0x33, 0x63, 0x7d, 0xa5,
+	0xde, 0xad, 0x3d, 0x4f, 0x1f, 0x22, 0xef, 0x4d, 0xe6, 0xb9, 0xd2, 0x10, 0x51, 0xae, 0xcf, 0xfc,
+	0xc8, 0x87, 0x00, 0xa2, 0xa4, 0x80, 0x9a, 0x94, 0x54, 0xd1, 0x05, 0x6d, 0xa9, 0xb1, 0x3d, 0x13,
+	0xcd, 0x67, 0x26, 0xbe, 0xa7, 0xc7, 0x87, 0x9b, 0xa5, 0xf1, 0x61, 0x9a, 0xc3, 0xfc, 0x3a, 0x80,
+	0xcb, 0x37, 0x49, 0x92, 0xda, 0x28, 0x91, 0xa5, 0xde, 0x4a, 0xde, 0xdc, 0x98, 0xfc, 0xa2, 0x44,
+	0x74, 0x89, 0x23, 0xba, 0x80, 0xca, 0x45, 0xa5, 0x00, 0xfc, 0x0e, 0x80, 0x2b, 0xf7, 0xb3, 0x26,
+	0x8a, 0x2e, 0x4d, 0x5a, 0x49, 0x0b, 0x4f, 0xa6, 0xc7, 0x25, 0x77, 0x90, 0x39, 0x15, 0xae, 0x6d,
+	0xd9, 0x95, 0xfd, 0xbb, 0x40, 0x14, 0x62, 0x72, 0x9d, 0x94, 0x3f, 0xa8, 0xdc, 0x4a, 0x1a, 0x32,
+	0xcd, 0xe7, 0x39, 0xbe, 0x16, 0xba, 0x34, 0x0d, 0xbe, 0xb6, 0x6c, 0xaf, 0x44, 0xdf, 0x00, 0xf0,
+	0xb8, 0x68, 0xa6, 0xcb, 0x10, 0x46, 0x65, 0x9d, 0x85, 0x69, 0xeb, 0xe5, 0x14, 0x51, 0xc7, 0x17,
+	0x85, 0x37, 0x35, 0x0f, 0x05, 0x6a, 0x5b, 0xb6, 0x40, 0x7e, 0xad, 0x02, 0x98, 0x7e, 0x4f, 0x8c,
+	0xe0, 0x7b, 0xb3, 0x93, 0x13, 0xe0, 0xf8, 0xd6, 0xe0, 0x29, 0x30, 0x6e, 0x73, 0x8c, 0xcf, 0x9b,
+	0xed, 0xc3, 0x60, 0x6c, 0x0f, 0x3b, 0x6c, 0x9b, 0x7e, 0x9b, 0xfd, 0xc9, 0xfe, 0xc0, 0x1f, 0x6d,
+	0x50, 0xcc, 0x45, 0xf4, 0x65, 0x1d, 0xac, 0xcd, 0xcd, 0x69, 0x5e, 0x95, 0x60, 0xe5, 0xf1, 0x64,
+	0x5e, 0x39, 0x14, 0xd8, 0x47, 0x03, 0x8f, 0x7b, 0x95, 0x5f, 0x05, 0x70, 0x55, 0x05, 0x6e, 0x72,
+	0xbb, 0x6c, 0x4d, 0xb2, 0xc4, 0xc3, 0x06, 0x9a, 0x72, 0xff, 0x6e, 0x4e, 0xb7, 0x7f, 0xbf, 0x05,
+	0xe0, 0x92, 0xec, 0x9c, 0x2c, 0xb9, 0x50, 0x64, 0x5a, 0x2b, 0x9b, 0xb9, 0xc2, 0xa7, 0x6c, 0x8f,
+	0x33, 0x7f, 0x8a, 0x2f, 0xfb, 0x06, 0x2a, 0xd5, 0x62, 0x18, 0x38, 0x71, 0xfb, 0x3d, 0xd9, 0x9b,
+	0xf6, 0x7e, 0xdb, 0x0b, 0xba, 0xf1, 0x5b, 0x26, 0x2a, 0x0d, 0xec, 0xd8, 0x3b, 0x97, 0x01, 0xa2,
+	0xb0, 0xc1, 0x76, 0x1b, 0xaf, 0xa6, 0x22, 0x5d, 0x08, 0x05, 0x85, 0xd6, 0x66, 0x73, 0xa4, 0x3a,
+	0x9b, 0x46, 0x72, 0x32, 0xf5, 0x88, 0xce, 0x96, 0x2e, 0xcb, 0x17, 0xfa, 0x15, 0x00, 0x8f, 0x67,
+	0xdd, 0x87, 0x58, 0x7e, 0x6a, 0xe7, 0x51, 0x86, 0x62, 0xaa, 0x10, 0x3c, 0x31, 0x24, 0x01, 0xe7,
+	0x03, 0x00, 0x57, 0x45, 0x46, 0x36, 0xa9, 0xbf, 0x9e, 0x9f, 0x54, 0x87, 0x12, 0x4a, 0x9b, 0x58,
+	0x7d, 0x34, 0x2f, 0x73, 0x3c, 0x9b, 0x66, 0xe9, 0x41, 0x14, 0xcb, 0xb7, 0xf9, 0x25, 0xe9, 0x03,
+	0xc0, 0x4a, 0xe4, 0x31, 0x55, 0x24, 0xe2, 0x69, 0xc1, 0x9c, 0x9b, 0xf4, 0x1a, 0xcf, 0xb7, 0x4f,
+	0x95, 0x39, 0x49, 0x00, 0xa1, 0x3f, 0x03, 0xf0, 0xa8, 0xac, 0xb9, 0x25, 0xc2, 0x69, 0x4d, 0x5d,
+	0xa4, 0x13, 0xfa, 0x6a, 0x4f, 0xfd, 0xbe, 0x54, 0xe2, 0xab, 0x1c, 0xe3, 0x17, 0xcc, 0xce, 0x54,
+	0x18, 0xdb, 0xef, 0xb9, 0x0e, 0xd7, 0x29, 0xa3, 0xc1, 0x24, 0xf8, 0x9b, 0x89, 0x3b, 0x48, 0x20,
+	0x4f, 0xac, 0x2b, 0x1e, 0xd6, 0x1d, 0x48, 0x3b, 0xdb, 0xdc, 0x9c, 0x1e, 0x22, 0xbb, 0xc0, 0x1f,
+	0x17, 0x89, 0x8f, 0x4c, 0x61, 0x10, 0x6d, 0x8e, 0x5b, 0x6b, 0xb4, 0x7a, 0x38, 0xcf, 0xd0, 0x4d,
+	0x9d, 0xfb, 0x1b, 0x65, 0x0c, 0x60, 0x06, 0x61, 0x2b, 0xe6, 0x18, 0xb6, 0xc1, 0xe6, 0xb5, 0xd7,
+	0xbe, 0xf7, 0xc9, 0x19, 0xf0, 0xcf, 0x9f, 0x9c, 0x01, 0xff, 0xf1, 0xc9, 0x19, 0xf0, 0xd6, 0x8b,
+	0xd3, 0xfd, 0xd7, 0x43, 0xb6, 0xe7, 0x12, 0x9f, 0x66, 0xe9, 0xff, 0xdf, 0x00, 0x59, 0x93, 0x04,
+	0x17, 0x3c, 0x49, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	// List returns list of applications
+	List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error)
+	// ListResourceEvents returns a list of event resources
+	ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*events.EventList, error)
+	// Watch returns stream of application change events
+	Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error)
+	// Create creates an application
+	Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Get returns an application by name
+	Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// GetApplicationSyncWindows returns the sync windows of the application, with their evaluated state
+	GetApplicationSyncWindows(ctx context.Context, in *ApplicationSyncWindowsQuery, opts ...grpc.CallOption) (*ApplicationSyncWindowsResponse, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the application
+	RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits and the changed files between two revisions of the application, by default between the synced and the target revision
+	RevisionComparison(ctx context.Context, in *RevisionComparisonQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)
+	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
+	RevisionChartDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error)
+	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
+	GetOCIMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error)
+	// GetManifests returns application manifests
+	GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error)
+	// GetManifestsWithFiles returns application manifests using provided files to generate them
+	GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error)
+	// Update updates an application
+	Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// UpdateSpec updates an application spec
+	UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error)
+	// Patch patch an application
+	Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// PatchParameters updates the Helm parameters, Kustomize images or plugin environment of an application source
+	PatchParameters(ctx context.Context, in *ApplicationPatchParametersRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Delete deletes an application
+	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// DeletePreview returns the live resources which would be deleted by a cascading deletion of an application
+	DeletePreview(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationDeletePreviewResponse, error)
+	// Sync syncs an application to its target state
+	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// ManagedResources returns list of managed resources
+	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
+	// ServerSideDiff performs server-side diff calculation using dry-run apply
+	ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error)
+	// ResourceTree returns resource tree
+	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
+	// MetricsHistory returns the most recent reconciliation and sync measurements of an application
+	MetricsHistory(ctx context.Context, in *ApplicationMetricsHistoryQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error)
+	// Watch returns stream of application resource tree
+	WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error)
+	// Rollback syncs an application to its target state
+	Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// TerminateOperation terminates the currently running operation
+	TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error)
 	// GetResource returns single application resource
-	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
+	GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// PatchResource patch single application resource
-	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
+	PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error)
 	// ListResourceActions returns list of resource actions
-	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
+	ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error)
 	// RunResourceAction runs a resource action
 	//
 	// Deprecated: use RunResourceActionV2 instead. This version does not support resource action parameters but is
 	// maintained for backward compatibility. It will be removed in a future release.
-	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
+	RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// RunResourceActionV2 runs a resource action with parameters
-	RunResourceActionV2(context.Context, *ResourceActionRunRequestV2) (*ApplicationResponse, error)
+	RunResourceActionV2(ctx context.Context, in *ResourceActionRunRequestV2, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// RunResourceActionBulk runs a resource action on all the resources of an application matching the filters
+	RunResourceActionBulk(ctx context.Context, in *ResourceActionBulkRunRequest, opts ...grpc.CallOption) (*ResourceActionBulkRunResponse, error)
 	// DeleteResource deletes a single application resource
-	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
+	DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// PodLogs returns stream of log entries for the specified pod. Pod
-	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
+	PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error)
 	// ListLinks returns the list of all application deep links
-	ListLinks(context.Context, *ListAppLinksRequest) (*LinksResponse, error)
+	ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error)
 	// ListResourceLinks returns the list of all resource deep links
-	ListResourceLinks(context.Context, *ApplicationResourceRequest) (*LinksResponse, error)
+	ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error)
+	// CreateSnapshot stores a snapshot of the live state of the application resources in the snapshot store
+	CreateSnapshot(ctx context.Context, in *ApplicationSnapshotQuery, opts ...grpc.CallOption) (*ApplicationSnapshot, error)
+	// ListSnapshots returns the snapshots of an application, oldest first
+	ListSnapshots(ctx context.Context, in *ApplicationSnapshotQuery, opts ...grpc.CallOption) (*ApplicationSnapshotList, error)
+	// RestoreSnapshot re-applies the resources of a snapshot to the cluster
+	RestoreSnapshot(ctx context.Context, in *ApplicationSnapshotRestoreRequest, opts ...grpc.CallOption) (*ApplicationSnapshotRestoreResponse, error)
+	// DeleteSnapshot deletes a snapshot from the snapshot store
+	DeleteSnapshot(ctx context.Context, in *ApplicationSnapshotDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
+	// UpdateAgentStatus updates the status of an application deployed to a pull mode cluster by its agent
+	UpdateAgentStatus(ctx context.Context, in *ApplicationAgentStatusRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
 }
 
-// UnimplementedApplicationServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedApplicationServiceServer struct {
+type applicationServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (*UnimplementedApplicationServiceServer) List(ctx context.Context, req *ApplicationQuery) (*v1alpha1.ApplicationList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (*UnimplementedApplicationServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationResourceEventsQuery) (*events.EventList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvents not implemented")
+func NewApplicationServiceClient(cc *grpc.ClientConn) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
 }
-func (*UnimplementedApplicationServiceServer) Watch(req *ApplicationQuery, srv ApplicationService_WatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+
+func (c *applicationServiceClient) List(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationList, error) {
+	out := new(v1alpha1.ApplicationList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Create(ctx context.Context, req *ApplicationCreateRequest) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+
+func (c *applicationServiceClient) ListResourceEvents(ctx context.Context, in *ApplicationResourceEventsQuery, opts ...grpc.CallOption) (*events.EventList, error) {
+	out := new(events.EventList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Get(ctx context.Context, req *ApplicationQuery) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (c *applicationServiceClient) Watch(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (ApplicationService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[0], "/application.ApplicationService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (*UnimplementedApplicationServiceServer) GetApplicationSyncWindows(ctx context.Context, req *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationSyncWindows not implemented")
+
+type ApplicationService_WatchClient interface {
+	Recv() (*v1alpha1.ApplicationWatchEvent, error)
+	grpc.ClientStream
 }
-func (*UnimplementedApplicationServiceServer) RevisionMetadata(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevisionMetadata not implemented")
+
+type applicationServiceWatchClient struct {
+	grpc.ClientStream
 }
-func (*UnimplementedApplicationServiceServer) RevisionChartDetails(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.ChartDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevisionChartDetails not implemented")
+
+func (x *applicationServiceWatchClient) Recv() (*v1alpha1.ApplicationWatchEvent, error) {
+	m := new(v1alpha1.ApplicationWatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (*UnimplementedApplicationServiceServer) GetOCIMetadata(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOCIMetadata not implemented")
+
+func (c *applicationServiceClient) Create(ctx context.Context, in *ApplicationCreateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) GetManifests(ctx context.Context, req *ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetManifests not implemented")
+
+func (c *applicationServiceClient) Get(ctx context.Context, in *ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) GetManifestsWithFiles(srv ApplicationService_GetManifestsWithFilesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetManifestsWithFiles not implemented")
+
+func (c *applicationServiceClient) GetApplicationSyncWindows(ctx context.Context, in *ApplicationSyncWindowsQuery, opts ...grpc.CallOption) (*ApplicationSyncWindowsResponse, error) {
+	out := new(ApplicationSyncWindowsResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetApplicationSyncWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Update(ctx context.Context, req *ApplicationUpdateRequest) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+
+func (c *applicationServiceClient) RevisionMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
+	out := new(v1alpha1.RevisionMetadata)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) UpdateSpec(ctx context.Context, req *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpec not implemented")
+
+func (c *applicationServiceClient) RevisionComparison(ctx context.Context, in *RevisionComparisonQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error) {
+	out := new(v1alpha1.RevisionComparison)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionComparison", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Patch(ctx context.Context, req *ApplicationPatchRequest) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+
+func (c *applicationServiceClient) RevisionChartDetails(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error) {
+	out := new(v1alpha1.ChartDetails)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RevisionChartDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *ApplicationDeleteRequest) (*ApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (c *applicationServiceClient) GetOCIMetadata(ctx context.Context, in *RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
+	out := new(v1alpha1.OCIMetadata)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetOCIMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
-}
-func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
-}
-func (*UnimplementedApplicationServiceServer) ServerSideDiff(ctx context.Context, req *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServerSideDiff not implemented")
-}
-func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
-}
-func (*UnimplementedApplicationServiceServer) WatchResourceTree(req *ResourcesQuery, srv ApplicationService_WatchResourceTreeServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchResourceTree not implemented")
-}
-func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req *ApplicationRollbackRequest) (*v1alpha1.Application, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
-}
-func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
-}
-func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
-}
-func (*UnimplementedApplicationServiceServer) PatchResource(ctx context.Context, req *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PatchResource not implemented")
-}
-func (*UnimplementedApplicationServiceServer) ListResourceActions(ctx context.Context, req *ApplicationResourceRequest) (*ResourceActionsListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListResourceActions not implemented")
-}
-func (*UnimplementedApplicationServiceServer) RunResourceAction(ctx context.Context, req *ResourceActionRunRequest) (*ApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunResourceAction not implemented")
-}
-func (*UnimplementedApplicationServiceServer) RunResourceActionV2(ctx context.Context, req *ResourceActionRunRequestV2) (*ApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RunResourceActionV2 not implemented")
-}
-func (*UnimplementedApplicationServiceServer) DeleteResource(ctx context.Context, req *ApplicationResourceDeleteRequest) (*ApplicationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
+
+func (c *applicationServiceClient) GetManifests(ctx context.Context, in *ApplicationManifestQuery, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
+	out := new(apiclient.ManifestResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (*UnimplementedApplicationServiceServer) PodLogs(req *ApplicationPodLogsQuery, srv ApplicationService_PodLogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method PodLogs not implemented")
+
+func (c *applicationServiceClient) GetManifestsWithFiles(ctx context.Context, opts ...grpc.CallOption) (ApplicationService_GetManifestsWithFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[1], "/application.ApplicationService/GetManifestsWithFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &applicationServiceGetManifestsWithFilesClient{stream}
+	return x, nil
 }
-func (*UnimplementedApplicationServiceServer) ListLinks(ctx context.Context, req *ListAppLinksRequest) (*LinksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+
+type ApplicationService_GetManifestsWithFilesClient interface {
+	Send(*ApplicationManifestQueryWithFilesWrapper) error
+	CloseAndRecv() (*apiclient.ManifestResponse, error)
+	grpc.ClientStream
 }
-func (*UnimplementedApplicationServiceServer) ListResourceLinks(ctx context.Context, req *ApplicationResourceRequest) (*LinksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListResourceLinks not implemented")
+
+type applicationServiceGetManifestsWithFilesClient struct {
+	grpc.ClientStream
 }
 
-func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
-	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+func (x *applicationServiceGetManifestsWithFilesClient) Send(m *ApplicationManifestQueryWithFilesWrapper) error {
+	return x.ClientStream.SendMsg(m)
 }
 
-func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationQuery)
-	if err := dec(in); err != nil {
+func (x *applicationServiceGetManifestsWithFilesClient) CloseAndRecv() (*apiclient.ManifestResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).List(ctx, req.(*ApplicationQuery))
+	m := new(apiclient.ManifestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _ApplicationService_ListResourceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceEventsQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) Update(ctx context.Context, in *ApplicationUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Update", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListResourceEvents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, req.(*ApplicationResourceEventsQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ApplicationQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (c *applicationServiceClient) UpdateSpec(ctx context.Context, in *ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
+	out := new(v1alpha1.ApplicationSpec)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(ApplicationServiceServer).Watch(m, &applicationServiceWatchServer{stream})
+	return out, nil
 }
 
-type ApplicationService_WatchServer interface {
-	Send(*v1alpha1.ApplicationWatchEvent) error
-	grpc.ServerStream
+func (c *applicationServiceClient) Patch(ctx context.Context, in *ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Patch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type applicationServiceWatchServer struct {
-	grpc.ServerStream
+func (c *applicationServiceClient) PatchParameters(ctx context.Context, in *ApplicationPatchParametersRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *applicationServiceWatchServer) Send(m *v1alpha1.ApplicationWatchEvent) error {
-	return x.ServerStream.SendMsg(m)
+func (c *applicationServiceClient) Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationCreateRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) DeletePreview(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationDeletePreviewResponse, error) {
+	out := new(ApplicationDeletePreviewResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeletePreview", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Create(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Create",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Create(ctx, req.(*ApplicationCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Sync", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Get(ctx, req.(*ApplicationQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_GetApplicationSyncWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationSyncWindowsQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
+	out := new(ManagedResourcesResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetApplicationSyncWindows(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetApplicationSyncWindows",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetApplicationSyncWindows(ctx, req.(*ApplicationSyncWindowsQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_RevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevisionMetadataQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error) {
+	out := new(ApplicationServerSideDiffResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ServerSideDiff", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/RevisionMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, req.(*RevisionMetadataQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_RevisionChartDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevisionMetadataQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
+	out := new(v1alpha1.ApplicationTree)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RevisionChartDetails(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/RevisionChartDetails",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RevisionChartDetails(ctx, req.(*RevisionMetadataQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_GetOCIMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RevisionMetadataQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) MetricsHistory(ctx context.Context, in *ApplicationMetricsHistoryQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error) {
+	out := new(v1alpha1.ApplicationMetricsHistory)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/MetricsHistory", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetOCIMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetOCIMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetOCIMetadata(ctx, req.(*RevisionMetadataQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_GetManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationManifestQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) WatchResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (ApplicationService_WatchResourceTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[2], "/application.ApplicationService/WatchResourceTree", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetManifests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetManifests",
+	x := &applicationServiceWatchResourceTreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetManifests(ctx, req.(*ApplicationManifestQuery))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ApplicationService_GetManifestsWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ApplicationServiceServer).GetManifestsWithFiles(&applicationServiceGetManifestsWithFilesServer{stream})
+type ApplicationService_WatchResourceTreeClient interface {
+	Recv() (*v1alpha1.ApplicationTree, error)
+	grpc.ClientStream
 }
 
-type ApplicationService_GetManifestsWithFilesServer interface {
-	SendAndClose(*apiclient.ManifestResponse) error
-	Recv() (*ApplicationManifestQueryWithFilesWrapper, error)
-	grpc.ServerStream
+type applicationServiceWatchResourceTreeClient struct {
+	grpc.ClientStream
 }
 
-type applicationServiceGetManifestsWithFilesServer struct {
-	grpc.ServerStream
+func (x *applicationServiceWatchResourceTreeClient) Recv() (*v1alpha1.ApplicationTree, error) {
+	m := new(v1alpha1.ApplicationTree)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *applicationServiceGetManifestsWithFilesServer) SendAndClose(m *apiclient.ManifestResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (c *applicationServiceClient) Rollback(ctx context.Context, in *ApplicationRollbackRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *applicationServiceGetManifestsWithFilesServer) Recv() (*ApplicationManifestQueryWithFilesWrapper, error) {
-	m := new(ApplicationManifestQueryWithFilesWrapper)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func (c *applicationServiceClient) TerminateOperation(ctx context.Context, in *OperationTerminateRequest, opts ...grpc.CallOption) (*OperationTerminateResponse, error) {
+	out := new(OperationTerminateResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/TerminateOperation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationUpdateRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) GetResource(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
+	out := new(ApplicationResourceResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/GetResource", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Update(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Update",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Update(ctx, req.(*ApplicationUpdateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationUpdateSpecRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) PatchResource(ctx context.Context, in *ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*ApplicationResourceResponse, error) {
+	out := new(ApplicationResourceResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/PatchResource", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).UpdateSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/UpdateSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).UpdateSpec(ctx, req.(*ApplicationUpdateSpecRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationPatchRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ListResourceActions(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*ResourceActionsListResponse, error) {
+	out := new(ResourceActionsListResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceActions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Patch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Patch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Patch(ctx, req.(*ApplicationPatchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationDeleteRequest)
-	if err := dec(in); err != nil {
+// Deprecated: Do not use.
+func (c *applicationServiceClient) RunResourceAction(ctx context.Context, in *ResourceActionRunRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceAction", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Delete(ctx, req.(*ApplicationDeleteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationSyncRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) RunResourceActionV2(ctx context.Context, in *ResourceActionRunRequestV2, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceActionV2", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Sync(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Sync",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Sync(ctx, req.(*ApplicationSyncRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourcesQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) RunResourceActionBulk(ctx context.Context, in *ResourceActionBulkRunRequest, opts ...grpc.CallOption) (*ResourceActionBulkRunResponse, error) {
+	out := new(ResourceActionBulkRunResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RunResourceActionBulk", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ManagedResources(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ManagedResources",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ManagedResources(ctx, req.(*ResourcesQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_ServerSideDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationServerSideDiffQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) DeleteResource(ctx context.Context, in *ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteResource", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ServerSideDiff",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, req.(*ApplicationServerSideDiffQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourcesQuery)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) PodLogs(ctx context.Context, in *ApplicationPodLogsQuery, opts ...grpc.CallOption) (ApplicationService_PodLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApplicationService_serviceDesc.Streams[3], "/application.ApplicationService/PodLogs", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/ResourceTree",
+	x := &applicationServicePodLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ApplicationService_WatchResourceTree_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ResourcesQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ApplicationServiceServer).WatchResourceTree(m, &applicationServiceWatchResourceTreeServer{stream})
+type ApplicationService_PodLogsClient interface {
+	Recv() (*LogEntry, error)
+	grpc.ClientStream
 }
 
-type ApplicationService_WatchResourceTreeServer interface {
-	Send(*v1alpha1.ApplicationTree) error
-	grpc.ServerStream
+type applicationServicePodLogsClient struct {
+	grpc.ClientStream
 }
 
-type applicationServiceWatchResourceTreeServer struct {
-	grpc.ServerStream
+func (x *applicationServicePodLogsClient) Recv() (*LogEntry, error) {
+	m := new(LogEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (x *applicationServiceWatchResourceTreeServer) Send(m *v1alpha1.ApplicationTree) error {
-	return x.ServerStream.SendMsg(m)
+func (c *applicationServiceClient) ListLinks(ctx context.Context, in *ListAppLinksRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
+	out := new(LinksResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationRollbackRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ListResourceLinks(ctx context.Context, in *ApplicationResourceRequest, opts ...grpc.CallOption) (*LinksResponse, error) {
+	out := new(LinksResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListResourceLinks", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).Rollback(ctx, in)
+	return out, nil
+}
+
+func (c *applicationServiceClient) CreateSnapshot(ctx context.Context, in *ApplicationSnapshotQuery, opts ...grpc.CallOption) (*ApplicationSnapshot, error) {
+	out := new(ApplicationSnapshot)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/CreateSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/Rollback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).Rollback(ctx, req.(*ApplicationRollbackRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OperationTerminateRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) ListSnapshots(ctx context.Context, in *ApplicationSnapshotQuery, opts ...grpc.CallOption) (*ApplicationSnapshotList, error) {
+	out := new(ApplicationSnapshotList)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/ListSnapshots", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).TerminateOperation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/TerminateOperation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).TerminateOperation(ctx, req.(*OperationTerminateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceRequest)
-	if err := dec(in); err != nil {
+func (c *applicationServiceClient) RestoreSnapshot(ctx context.Context, in *ApplicationSnapshotRestoreRequest, opts ...grpc.CallOption) (*ApplicationSnapshotRestoreResponse, error) {
+	out := new(ApplicationSnapshotRestoreResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/RestoreSnapshot", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).GetResource(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/application.ApplicationService/GetResource",
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteSnapshot(ctx context.Context, in *ApplicationSnapshotDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error) {
+	out := new(ApplicationResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/DeleteSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).GetResource(ctx, req.(*ApplicationResourceRequest))
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateAgentStatus(ctx context.Context, in *ApplicationAgentStatusRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	out := new(v1alpha1.Application)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/UpdateAgentStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourcePatchRequest)
+// ApplicationServiceServer is the server API for ApplicationService service.
+type ApplicationServiceServer interface {
+	// List returns list of applications
+	List(context.Context, *ApplicationQuery) (*v1alpha1.ApplicationList, error)
+	// ListResourceEvents returns a list of event resources
+	ListResourceEvents(context.Context, *ApplicationResourceEventsQuery) (*events.EventList, error)
+	// Watch returns stream of application change events
+	Watch(*ApplicationQuery, ApplicationService_WatchServer) error
+	// Create creates an application
+	Create(context.Context, *ApplicationCreateRequest) (*v1alpha1.Application, error)
+	// Get returns an application by name
+	Get(context.Context, *ApplicationQuery) (*v1alpha1.Application, error)
+	// GetApplicationSyncWindows returns the sync windows of the application, with their evaluated state
+	GetApplicationSyncWindows(context.Context, *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the application
+	RevisionMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits and the changed files between two revisions of the application, by default between the synced and the target revision
+	RevisionComparison(context.Context, *RevisionComparisonQuery) (*v1alpha1.RevisionComparison, error)
+	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
+	RevisionChartDetails(context.Context, *RevisionMetadataQuery) (*v1alpha1.ChartDetails, error)
+	// Get the chart metadata (description, maintainers, home) for a specific revision of the application
+	GetOCIMetadata(context.Context, *RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error)
+	// GetManifests returns application manifests
+	GetManifests(context.Context, *ApplicationManifestQuery) (*apiclient.ManifestResponse, error)
+	// GetManifestsWithFiles returns application manifests using provided files to generate them
+	GetManifestsWithFiles(ApplicationService_GetManifestsWithFilesServer) error
+	// Update updates an application
+	Update(context.Context, *ApplicationUpdateRequest) (*v1alpha1.Application, error)
+	// UpdateSpec updates an application spec
+	UpdateSpec(context.Context, *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error)
+	// Patch patch an application
+	Patch(context.Context, *ApplicationPatchRequest) (*v1alpha1.Application, error)
+	// PatchParameters updates the Helm parameters, Kustomize images or plugin environment of an application source
+	PatchParameters(context.Context, *ApplicationPatchParametersRequest) (*v1alpha1.Application, error)
+	// Delete deletes an application
+	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
+	// DeletePreview returns the live resources which would be deleted by a cascading deletion of an application
+	DeletePreview(context.Context, *ApplicationDeleteRequest) (*ApplicationDeletePreviewResponse, error)
+	// Sync syncs an application to its target state
+	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	// ManagedResources returns list of managed resources
+	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
+	// ServerSideDiff performs server-side diff calculation using dry-run apply
+	ServerSideDiff(context.Context, *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error)
+	// ResourceTree returns resource tree
+	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
+	// MetricsHistory returns the most recent reconciliation and sync measurements of an application
+	MetricsHistory(context.Context, *ApplicationMetricsHistoryQuery) (*v1alpha1.ApplicationMetricsHistory, error)
+	// Watch returns stream of application resource tree
+	WatchResourceTree(*ResourcesQuery, ApplicationService_WatchResourceTreeServer) error
+	// Rollback syncs an application to its target state
+	Rollback(context.Context, *ApplicationRollbackRequest) (*v1alpha1.Application, error)
+	// TerminateOperation terminates the currently running operation
+	TerminateOperation(context.Context, *OperationTerminateRequest) (*OperationTerminateResponse, error)
+	// GetResource returns single application resource
+	GetResource(context.Context, *ApplicationResourceRequest) (*ApplicationResourceResponse, error)
+	// PatchResource patch single application resource
+	PatchResource(context.Context, *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error)
+	// ListResourceActions returns list of resource actions
+	ListResourceActions(context.Context, *ApplicationResourceRequest) (*ResourceActionsListResponse, error)
+	// RunResourceAction runs a resource action
+	//
+	// Deprecated: use RunResourceActionV2 instead. This version does not support resource action parameters but is
+	// maintained for backward compatibility. It will be removed in a future release.
+	RunResourceAction(context.Context, *ResourceActionRunRequest) (*ApplicationResponse, error)
+	// RunResourceActionV2 runs a resource action with parameters
+	RunResourceActionV2(context.Context, *ResourceActionRunRequestV2) (*ApplicationResponse, error)
+	// RunResourceActionBulk runs a resource action on all the resources of an application matching the filters
+	RunResourceActionBulk(context.Context, *ResourceActionBulkRunRequest) (*ResourceActionBulkRunResponse, error)
+	// DeleteResource deletes a single application resource
+	DeleteResource(context.Context, *ApplicationResourceDeleteRequest) (*ApplicationResponse, error)
+	// PodLogs returns stream of log entries for the specified pod. Pod
+	PodLogs(*ApplicationPodLogsQuery, ApplicationService_PodLogsServer) error
+	// ListLinks returns the list of all application deep links
+	ListLinks(context.Context, *ListAppLinksRequest) (*LinksResponse, error)
+	// ListResourceLinks returns the list of all resource deep links
+	ListResourceLinks(context.Context, *ApplicationResourceRequest) (*LinksResponse, error)
+	// CreateSnapshot stores a snapshot of the live state of the application resources in the snapshot store
+	CreateSnapshot(context.Context, *ApplicationSnapshotQuery) (*ApplicationSnapshot, error)
+	// ListSnapshots returns the snapshots of an application, oldest first
+	ListSnapshots(context.Context, *ApplicationSnapshotQuery) (*ApplicationSnapshotList, error)
+	// RestoreSnapshot re-applies the resources of a snapshot to the cluster
+	RestoreSnapshot(context.Context, *ApplicationSnapshotRestoreRequest) (*ApplicationSnapshotRestoreResponse, error)
+	// DeleteSnapshot deletes a snapshot from the snapshot store
+	DeleteSnapshot(context.Context, *ApplicationSnapshotDeleteRequest) (*ApplicationResponse, error)
+	// UpdateAgentStatus updates the status of an application deployed to a pull mode cluster by its agent
+	UpdateAgentStatus(context.Context, *ApplicationAgentStatusRequest) (*v1alpha1.Application, error)
+}
+
+// UnimplementedApplicationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationServiceServer struct {
+}
+
+func (*UnimplementedApplicationServiceServer) List(ctx context.Context, req *ApplicationQuery) (*v1alpha1.ApplicationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationResourceEventsQuery) (*events.EventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvents not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Watch(req *ApplicationQuery, srv ApplicationService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Create(ctx context.Context, req *ApplicationCreateRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Get(ctx context.Context, req *ApplicationQuery) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetApplicationSyncWindows(ctx context.Context, req *ApplicationSyncWindowsQuery) (*ApplicationSyncWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplicationSyncWindows not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RevisionMetadata(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.RevisionMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisionMetadata not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RevisionComparison(ctx context.Context, req *RevisionComparisonQuery) (*v1alpha1.RevisionComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisionComparison not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RevisionChartDetails(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.ChartDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevisionChartDetails not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetOCIMetadata(ctx context.Context, req *RevisionMetadataQuery) (*v1alpha1.OCIMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOCIMetadata not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetManifests(ctx context.Context, req *ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManifests not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetManifestsWithFiles(srv ApplicationService_GetManifestsWithFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetManifestsWithFiles not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Update(ctx context.Context, req *ApplicationUpdateRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedApplicationServiceServer) UpdateSpec(ctx context.Context, req *ApplicationUpdateSpecRequest) (*v1alpha1.ApplicationSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpec not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Patch(ctx context.Context, req *ApplicationPatchRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Patch not implemented")
+}
+func (*UnimplementedApplicationServiceServer) PatchParameters(ctx context.Context, req *ApplicationPatchParametersRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchParameters not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *ApplicationDeleteRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DeletePreview(ctx context.Context, req *ApplicationDeleteRequest) (*ApplicationDeletePreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePreview not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ServerSideDiff(ctx context.Context, req *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServerSideDiff not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
+}
+func (*UnimplementedApplicationServiceServer) MetricsHistory(ctx context.Context, req *ApplicationMetricsHistoryQuery) (*v1alpha1.ApplicationMetricsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsHistory not implemented")
+}
+func (*UnimplementedApplicationServiceServer) WatchResourceTree(req *ResourcesQuery, srv ApplicationService_WatchResourceTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResourceTree not implemented")
+}
+func (*UnimplementedApplicationServiceServer) Rollback(ctx context.Context, req *ApplicationRollbackRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (*UnimplementedApplicationServiceServer) TerminateOperation(ctx context.Context, req *OperationTerminateRequest) (*OperationTerminateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateOperation not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetResource(ctx context.Context, req *ApplicationResourceRequest) (*ApplicationResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResource not implemented")
+}
+func (*UnimplementedApplicationServiceServer) PatchResource(ctx context.Context, req *ApplicationResourcePatchRequest) (*ApplicationResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchResource not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListResourceActions(ctx context.Context, req *ApplicationResourceRequest) (*ResourceActionsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceActions not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RunResourceAction(ctx context.Context, req *ResourceActionRunRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunResourceAction not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RunResourceActionV2(ctx context.Context, req *ResourceActionRunRequestV2) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunResourceActionV2 not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RunResourceActionBulk(ctx context.Context, req *ResourceActionBulkRunRequest) (*ResourceActionBulkRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunResourceActionBulk not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DeleteResource(ctx context.Context, req *ApplicationResourceDeleteRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
+}
+func (*UnimplementedApplicationServiceServer) PodLogs(req *ApplicationPodLogsQuery, srv ApplicationService_PodLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PodLogs not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListLinks(ctx context.Context, req *ListAppLinksRequest) (*LinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListResourceLinks(ctx context.Context, req *ApplicationResourceRequest) (*LinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourceLinks not implemented")
+}
+func (*UnimplementedApplicationServiceServer) CreateSnapshot(ctx context.Context, req *ApplicationSnapshotQuery) (*ApplicationSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListSnapshots(ctx context.Context, req *ApplicationSnapshotQuery) (*ApplicationSnapshotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RestoreSnapshot(ctx context.Context, req *ApplicationSnapshotRestoreRequest) (*ApplicationSnapshotRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DeleteSnapshot(ctx context.Context, req *ApplicationSnapshotDeleteRequest) (*ApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (*UnimplementedApplicationServiceServer) UpdateAgentStatus(ctx context.Context, req *ApplicationAgentStatusRequest) (*v1alpha1.Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentStatus not implemented")
+}
+
+func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
+	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+}
+
+func _ApplicationService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).PatchResource(ctx, in)
+		return srv.(ApplicationServiceServer).List(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/PatchResource",
+		FullMethod: "/application.ApplicationService/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).PatchResource(ctx, req.(*ApplicationResourcePatchRequest))
+		return srv.(ApplicationServiceServer).List(ctx, req.(*ApplicationQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceRequest)
+func _ApplicationService_ListResourceEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceEventsQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListResourceActions(ctx, in)
+		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListResourceActions",
+		FullMethod: "/application.ApplicationService/ListResourceEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListResourceActions(ctx, req.(*ApplicationResourceRequest))
+		return srv.(ApplicationServiceServer).ListResourceEvents(ctx, req.(*ApplicationResourceEventsQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_RunResourceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceActionRunRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RunResourceAction(ctx, in)
+func _ApplicationService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).Watch(m, &applicationServiceWatchServer{stream})
+}
+
+type ApplicationService_WatchServer interface {
+	Send(*v1alpha1.ApplicationWatchEvent) error
+	grpc.ServerStream
+}
+
+type applicationServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceWatchServer) Send(m *v1alpha1.ApplicationWatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Create(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/RunResourceAction",
+		FullMethod: "/application.ApplicationService/Create",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RunResourceAction(ctx, req.(*ResourceActionRunRequest))
+		return srv.(ApplicationServiceServer).Create(ctx, req.(*ApplicationCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_RunResourceActionV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceActionRunRequestV2)
+func _ApplicationService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).RunResourceActionV2(ctx, in)
+		return srv.(ApplicationServiceServer).Get(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/RunResourceActionV2",
+		FullMethod: "/application.ApplicationService/Get",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).RunResourceActionV2(ctx, req.(*ResourceActionRunRequestV2))
+		return srv.(ApplicationServiceServer).Get(ctx, req.(*ApplicationQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceDeleteRequest)
+func _ApplicationService_GetApplicationSyncWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncWindowsQuery)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).DeleteResource(ctx, in)
+		return srv.(ApplicationServiceServer).GetApplicationSyncWindows(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/DeleteResource",
+		FullMethod: "/application.ApplicationService/GetApplicationSyncWindows",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).DeleteResource(ctx, req.(*ApplicationResourceDeleteRequest))
+		return srv.(ApplicationServiceServer).GetApplicationSyncWindows(ctx, req.(*ApplicationSyncWindowsQuery))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ApplicationPodLogsQuery)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ApplicationService_RevisionMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionMetadataQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ApplicationServiceServer).PodLogs(m, &applicationServicePodLogsServer{stream})
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionMetadata(ctx, req.(*RevisionMetadataQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type ApplicationService_PodLogsServer interface {
-	Send(*LogEntry) error
+func _ApplicationService_RevisionComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionComparisonQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionComparison",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionComparison(ctx, req.(*RevisionComparisonQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RevisionChartDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionMetadataQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RevisionChartDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RevisionChartDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RevisionChartDetails(ctx, req.(*RevisionMetadataQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetOCIMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionMetadataQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetOCIMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetOCIMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetOCIMetadata(ctx, req.(*RevisionMetadataQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationManifestQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetManifests(ctx, req.(*ApplicationManifestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetManifestsWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApplicationServiceServer).GetManifestsWithFiles(&applicationServiceGetManifestsWithFilesServer{stream})
+}
+
+type ApplicationService_GetManifestsWithFilesServer interface {
+	SendAndClose(*apiclient.ManifestResponse) error
+	Recv() (*ApplicationManifestQueryWithFilesWrapper, error)
 	grpc.ServerStream
 }
 
-type applicationServicePodLogsServer struct {
+type applicationServiceGetManifestsWithFilesServer struct {
 	grpc.ServerStream
 }
 
-func (x *applicationServicePodLogsServer) Send(m *LogEntry) error {
+func (x *applicationServiceGetManifestsWithFilesServer) SendAndClose(m *apiclient.ManifestResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ApplicationService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppLinksRequest)
+func (x *applicationServiceGetManifestsWithFilesServer) Recv() (*ApplicationManifestQueryWithFilesWrapper, error) {
+	m := new(ApplicationManifestQueryWithFilesWrapper)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApplicationService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUpdateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListLinks(ctx, in)
+		return srv.(ApplicationServiceServer).Update(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListLinks",
+		FullMethod: "/application.ApplicationService/Update",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListLinks(ctx, req.(*ListAppLinksRequest))
+		return srv.(ApplicationServiceServer).Update(ctx, req.(*ApplicationUpdateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApplicationService_ListResourceLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationResourceRequest)
+func _ApplicationService_UpdateSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationUpdateSpecRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, in)
+		return srv.(ApplicationServiceServer).UpdateSpec(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/application.ApplicationService/ListResourceLinks",
+		FullMethod: "/application.ApplicationService/UpdateSpec",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, req.(*ApplicationResourceRequest))
+		return srv.(ApplicationServiceServer).UpdateSpec(ctx, req.(*ApplicationUpdateSpecRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ApplicationService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "application.ApplicationService",
-	HandlerType: (*ApplicationServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _ApplicationService_List_Handler,
-		},
-		{
-			MethodName: "ListResourceEvents",
-			Handler:    _ApplicationService_ListResourceEvents_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _ApplicationService_Create_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _ApplicationService_Get_Handler,
-		},
-		{
-			MethodName: "GetApplicationSyncWindows",
-			Handler:    _ApplicationService_GetApplicationSyncWindows_Handler,
-		},
-		{
-			MethodName: "RevisionMetadata",
-			Handler:    _ApplicationService_RevisionMetadata_Handler,
-		},
-		{
-			MethodName: "RevisionChartDetails",
-			Handler:    _ApplicationService_RevisionChartDetails_Handler,
-		},
-		{
-			MethodName: "GetOCIMetadata",
-			Handler:    _ApplicationService_GetOCIMetadata_Handler,
-		},
-		{
-			MethodName: "GetManifests",
-			Handler:    _ApplicationService_GetManifests_Handler,
-		},
-		{
-			MethodName: "Update",
-			Handler:    _ApplicationService_Update_Handler,
-		},
-		{
-			MethodName: "UpdateSpec",
-			Handler:    _ApplicationService_UpdateSpec_Handler,
-		},
-		{
-			MethodName: "Patch",
-			Handler:    _ApplicationService_Patch_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ApplicationService_Delete_Handler,
-		},
-		{
-			MethodName: "Sync",
-			Handler:    _ApplicationService_Sync_Handler,
-		},
-		{
-			MethodName: "ManagedResources",
-			Handler:    _ApplicationService_ManagedResources_Handler,
-		},
-		{
-			MethodName: "ServerSideDiff",
-			Handler:    _ApplicationService_ServerSideDiff_Handler,
-		},
-		{
-			MethodName: "ResourceTree",
-			Handler:    _ApplicationService_ResourceTree_Handler,
-		},
-		{
-			MethodName: "Rollback",
-			Handler:    _ApplicationService_Rollback_Handler,
-		},
-		{
-			MethodName: "TerminateOperation",
-			Handler:    _ApplicationService_TerminateOperation_Handler,
-		},
-		{
-			MethodName: "GetResource",
-			Handler:    _ApplicationService_GetResource_Handler,
-		},
-		{
-			MethodName: "PatchResource",
-			Handler:    _ApplicationService_PatchResource_Handler,
-		},
-		{
-			MethodName: "ListResourceActions",
-			Handler:    _ApplicationService_ListResourceActions_Handler,
-		},
-		{
-			MethodName: "RunResourceAction",
-			Handler:    _ApplicationService_RunResourceAction_Handler,
-		},
-		{
-			MethodName: "RunResourceActionV2",
-			Handler:    _ApplicationService_RunResourceActionV2_Handler,
-		},
-		{
-			MethodName: "DeleteResource",
-			Handler:    _ApplicationService_DeleteResource_Handler,
-		},
-		{
-			MethodName: "ListLinks",
-			Handler:    _ApplicationService_ListLinks_Handler,
-		},
-		{
-			MethodName: "ListResourceLinks",
-			Handler:    _ApplicationService_ListResourceLinks_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Watch",
-			Handler:       _ApplicationService_Watch_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "GetManifestsWithFiles",
-			Handler:       _ApplicationService_GetManifestsWithFiles_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "WatchResourceTree",
-			Handler:       _ApplicationService_WatchResourceTree_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "PodLogs",
-			Handler:       _ApplicationService_PodLogs_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "server/application/application.proto",
-}
-
-func (m *ApplicationQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_Patch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPatchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Patch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Patch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Patch(ctx, req.(*ApplicationPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Project) > 0 {
-		for iNdEx := len(m.Project) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Project[iNdEx])
-			copy(dAtA[i:], m.Project[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Project[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
+func _ApplicationService_PatchParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPatchParametersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).PatchParameters(ctx, in)
 	}
-	if m.Repo != nil {
-		i -= len(*m.Repo)
-		copy(dAtA[i:], *m.Repo)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Repo)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/PatchParameters",
 	}
-	if m.Selector != nil {
-		i -= len(*m.Selector)
-		copy(dAtA[i:], *m.Selector)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Selector)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).PatchParameters(ctx, req.(*ApplicationPatchParametersRequest))
 	}
-	if m.ResourceVersion != nil {
-		i -= len(*m.ResourceVersion)
-		copy(dAtA[i:], *m.ResourceVersion)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceVersion)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Projects) > 0 {
-		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Projects[iNdEx])
-			copy(dAtA[i:], m.Projects[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Projects[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Delete(ctx, in)
 	}
-	if m.Refresh != nil {
-		i -= len(*m.Refresh)
-		copy(dAtA[i:], *m.Refresh)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Refresh)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Delete",
 	}
-	if m.Name != nil {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Delete(ctx, req.(*ApplicationDeleteRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_DeletePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationDeleteRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NodeQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NodeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeletePreview(ctx, in)
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DeletePreview",
 	}
-	if m.Name != nil {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeletePreview(ctx, req.(*ApplicationDeleteRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RevisionMetadataQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSyncRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RevisionMetadataQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Sync(ctx, req.(*ApplicationSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RevisionMetadataQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.VersionId != nil {
-		i = encodeVarintApplication(dAtA, i, uint64(*m.VersionId))
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ManagedResources(ctx, in)
 	}
-	if m.SourceIndex != nil {
-		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ManagedResources",
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ManagedResources(ctx, req.(*ResourcesQuery))
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ServerSideDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationServerSideDiffQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Revision == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
-	} else {
-		i -= len(*m.Revision)
-		copy(dAtA[i:], *m.Revision)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, in)
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ServerSideDiff",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ServerSideDiff(ctx, req.(*ApplicationServerSideDiffQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationResourceEventsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourcesQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationResourceEventsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationResourceEventsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, in)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ResourceTree",
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ResourceTree(ctx, req.(*ResourcesQuery))
 	}
-	if m.ResourceUID != nil {
-		i -= len(*m.ResourceUID)
-		copy(dAtA[i:], *m.ResourceUID)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceUID)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_MetricsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationMetricsHistoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ResourceName != nil {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).MetricsHistory(ctx, in)
 	}
-	if m.ResourceNamespace != nil {
-		i -= len(*m.ResourceNamespace)
-		copy(dAtA[i:], *m.ResourceNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceNamespace)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/MetricsHistory",
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).MetricsHistory(ctx, req.(*ApplicationMetricsHistoryQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _ApplicationService_WatchResourceTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResourcesQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(ApplicationServiceServer).WatchResourceTree(m, &applicationServiceWatchResourceTreeServer{stream})
 }
 
-func (m *ApplicationManifestQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ApplicationService_WatchResourceTreeServer interface {
+	Send(*v1alpha1.ApplicationTree) error
+	grpc.ServerStream
 }
 
-func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+type applicationServiceWatchResourceTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *applicationServiceWatchResourceTreeServer) Send(m *v1alpha1.ApplicationTree) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApplicationService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.NoCache != nil {
-		i--
-		if *m.NoCache {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Rollback(ctx, in)
 	}
-	if len(m.Revisions) > 0 {
-		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Revisions[iNdEx])
-			copy(dAtA[i:], m.Revisions[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Rollback",
 	}
-	if len(m.SourcePositions) > 0 {
-		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
-			i--
-			dAtA[i] = 0x28
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Rollback(ctx, req.(*ApplicationRollbackRequest))
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_TerminateOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationTerminateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).TerminateOperation(ctx, in)
 	}
-	if m.Revision != nil {
-		i -= len(*m.Revision)
-		copy(dAtA[i:], *m.Revision)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/TerminateOperation",
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).TerminateOperation(ctx, req.(*OperationTerminateRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FileChunk) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_GetResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FileChunk) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *FileChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetResource(ctx, in)
 	}
-	if m.Chunk == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
-	} else {
-		i -= len(m.Chunk)
-		copy(dAtA[i:], m.Chunk)
-		i = encodeVarintApplication(dAtA, i, uint64(len(m.Chunk)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/GetResource",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetResource(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFiles) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourcePatchRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).PatchResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/PatchResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).PatchResource(ctx, req.(*ApplicationResourcePatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFiles) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ApplicationService_ListResourceActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceActions(ctx, req.(*ApplicationResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x22
+func _ApplicationService_RunResourceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceActionRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RunResourceAction(ctx, in)
 	}
-	if m.Checksum == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
-	} else {
-		i -= len(*m.Checksum)
-		copy(dAtA[i:], *m.Checksum)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Checksum)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RunResourceAction",
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RunResourceAction(ctx, req.(*ResourceActionRunRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_RunResourceActionV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceActionRunRequestV2)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RunResourceActionV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RunResourceActionV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RunResourceActionV2(ctx, req.(*ResourceActionRunRequestV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ApplicationService_RunResourceActionBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceActionBulkRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RunResourceActionBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RunResourceActionBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RunResourceActionBulk(ctx, req.(*ResourceActionBulkRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ApplicationService_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Part != nil {
-		{
-			size := m.Part.Size()
-			i -= size
-			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteResource(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DeleteResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteResource(ctx, req.(*ApplicationResourceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper_Query) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _ApplicationService_PodLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationPodLogsQuery)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApplicationServiceServer).PodLogs(m, &applicationServicePodLogsServer{stream})
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Query != nil {
-		{
-			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type ApplicationService_PodLogsServer interface {
+	Send(*LogEntry) error
+	grpc.ServerStream
 }
-func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type applicationServicePodLogsServer struct {
+	grpc.ServerStream
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Chunk != nil {
-		{
-			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (x *applicationServicePodLogsServer) Send(m *LogEntry) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (m *ApplicationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+
+func _ApplicationService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppLinksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListLinks(ctx, req.(*ListAppLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_ListResourceLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationResourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Validate != nil {
-		i--
-		if *m.Validate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, in)
 	}
-	if m.Upsert != nil {
-		i--
-		if *m.Upsert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListResourceLinks",
 	}
-	if m.Application == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
-	} else {
-		{
-			size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListResourceLinks(ctx, req.(*ApplicationResourceRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationUpdateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSnapshotQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/CreateSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateSnapshot(ctx, req.(*ApplicationSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _ApplicationService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListSnapshots(ctx, in)
 	}
-	if m.Validate != nil {
-		i--
-		if *m.Validate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/ListSnapshots",
 	}
-	if m.Application == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
-	} else {
-		{
-			size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListSnapshots(ctx, req.(*ApplicationSnapshotQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSnapshotRestoreRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RestoreSnapshot(ctx, in)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/RestoreSnapshot",
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RestoreSnapshot(ctx, req.(*ApplicationSnapshotRestoreRequest))
 	}
-	if m.PropagationPolicy != nil {
-		i -= len(*m.PropagationPolicy)
-		copy(dAtA[i:], *m.PropagationPolicy)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PropagationPolicy)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSnapshotDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Cascade != nil {
-		i--
-		if *m.Cascade {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteSnapshot(ctx, in)
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/DeleteSnapshot",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteSnapshot(ctx, req.(*ApplicationSnapshotDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *SyncOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _ApplicationService_UpdateAgentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationAgentStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SyncOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *SyncOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateAgentStatus(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Items[iNdEx])
-			copy(dAtA[i:], m.Items[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Items[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/UpdateAgentStatus",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateAgentStatus(ctx, req.(*ApplicationAgentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationSyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+var _ApplicationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "application.ApplicationService",
+	HandlerType: (*ApplicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ApplicationService_List_Handler,
+		},
+		{
+			MethodName: "ListResourceEvents",
+			Handler:    _ApplicationService_ListResourceEvents_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ApplicationService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApplicationService_Get_Handler,
+		},
+		{
+			MethodName: "GetApplicationSyncWindows",
+			Handler:    _ApplicationService_GetApplicationSyncWindows_Handler,
+		},
+		{
+			MethodName: "RevisionMetadata",
+			Handler:    _ApplicationService_RevisionMetadata_Handler,
+		},
+		{
+			MethodName: "RevisionComparison",
+			Handler:    _ApplicationService_RevisionComparison_Handler,
+		},
+		{
+			MethodName: "RevisionChartDetails",
+			Handler:    _ApplicationService_RevisionChartDetails_Handler,
+		},
+		{
+			MethodName: "GetOCIMetadata",
+			Handler:    _ApplicationService_GetOCIMetadata_Handler,
+		},
+		{
+			MethodName: "GetManifests",
+			Handler:    _ApplicationService_GetManifests_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ApplicationService_Update_Handler,
+		},
+		{
+			MethodName: "UpdateSpec",
+			Handler:    _ApplicationService_UpdateSpec_Handler,
+		},
+		{
+			MethodName: "Patch",
+			Handler:    _ApplicationService_Patch_Handler,
+		},
+		{
+			MethodName: "PatchParameters",
+			Handler:    _ApplicationService_PatchParameters_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ApplicationService_Delete_Handler,
+		},
+		{
+			MethodName: "DeletePreview",
+			Handler:    _ApplicationService_DeletePreview_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _ApplicationService_Sync_Handler,
+		},
+		{
+			MethodName: "ManagedResources",
+			Handler:    _ApplicationService_ManagedResources_Handler,
+		},
+		{
+			MethodName: "ServerSideDiff",
+			Handler:    _ApplicationService_ServerSideDiff_Handler,
+		},
+		{
+			MethodName: "ResourceTree",
+			Handler:    _ApplicationService_ResourceTree_Handler,
+		},
+		{
+			MethodName: "MetricsHistory",
+			Handler:    _ApplicationService_MetricsHistory_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _ApplicationService_Rollback_Handler,
+		},
+		{
+			MethodName: "TerminateOperation",
+			Handler:    _ApplicationService_TerminateOperation_Handler,
+		},
+		{
+			MethodName: "GetResource",
+			Handler:    _ApplicationService_GetResource_Handler,
+		},
+		{
+			MethodName: "PatchResource",
+			Handler:    _ApplicationService_PatchResource_Handler,
+		},
+		{
+			MethodName: "ListResourceActions",
+			Handler:    _ApplicationService_ListResourceActions_Handler,
+		},
+		{
+			MethodName: "RunResourceAction",
+			Handler:    _ApplicationService_RunResourceAction_Handler,
+		},
+		{
+			MethodName: "RunResourceActionV2",
+			Handler:    _ApplicationService_RunResourceActionV2_Handler,
+		},
+		{
+			MethodName: "RunResourceActionBulk",
+			Handler:    _ApplicationService_RunResourceActionBulk_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _ApplicationService_DeleteResource_Handler,
+		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _ApplicationService_ListLinks_Handler,
+		},
+		{
+			MethodName: "ListResourceLinks",
+			Handler:    _ApplicationService_ListResourceLinks_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _ApplicationService_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _ApplicationService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _ApplicationService_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _ApplicationService_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "UpdateAgentStatus",
+			Handler:    _ApplicationService_UpdateAgentStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ApplicationService_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetManifestsWithFiles",
+			Handler:       _ApplicationService_GetManifestsWithFiles_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchResourceTree",
+			Handler:       _ApplicationService_WatchResourceTree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PodLogs",
+			Handler:       _ApplicationService_PodLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "server/application/application.proto",
+}
+
+func (m *ApplicationQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -5494,12 +6544,12 @@ func (m *ApplicationSyncRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5508,139 +6558,98 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Revisions) > 0 {
-		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Revisions[iNdEx])
-			copy(dAtA[i:], m.Revisions[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
-			i--
-			dAtA[i] = 0x7a
-		}
-	}
-	if len(m.SourcePositions) > 0 {
-		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
-			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
+	if len(m.ConditionSeverities) > 0 {
+		for iNdEx := len(m.ConditionSeverities) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConditionSeverities[iNdEx])
+			copy(dAtA[i:], m.ConditionSeverities[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.ConditionSeverities[iNdEx])))
 			i--
-			dAtA[i] = 0x70
+			dAtA[i] = 0x6a
 		}
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+	if m.Minimal != nil {
 		i--
-		dAtA[i] = 0x6a
-	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		if *m.Minimal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x60
 	}
-	if m.SyncOptions != nil {
-		{
-			size, err := m.SyncOptions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
+	if m.SortBy != nil {
+		i -= len(*m.SortBy)
+		copy(dAtA[i:], *m.SortBy)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.SortBy)))
 		i--
 		dAtA[i] = 0x5a
 	}
-	if m.RetryStrategy != nil {
-		{
-			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
+	if m.Continue != nil {
+		i -= len(*m.Continue)
+		copy(dAtA[i:], *m.Continue)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Continue)))
 		i--
 		dAtA[i] = 0x52
 	}
-	if len(m.Infos) > 0 {
-		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+	if m.Limit != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Limit))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Manifests) > 0 {
-		for iNdEx := len(m.Manifests) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Manifests[iNdEx])
-			copy(dAtA[i:], m.Manifests[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.Manifests[iNdEx])))
+	if len(m.Project) > 0 {
+		for iNdEx := len(m.Project) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Project[iNdEx])
+			copy(dAtA[i:], m.Project[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Project[iNdEx])))
 			i--
 			dAtA[i] = 0x42
 		}
 	}
-	if len(m.Resources) > 0 {
-		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Strategy != nil {
-		{
-			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
+	if m.Repo != nil {
+		i -= len(*m.Repo)
+		copy(dAtA[i:], *m.Repo)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Repo)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x32
 	}
-	if m.Prune != nil {
-		i--
-		if *m.Prune {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.Selector != nil {
+		i -= len(*m.Selector)
+		copy(dAtA[i:], *m.Selector)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Selector)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x2a
 	}
-	if m.DryRun != nil {
+	if m.ResourceVersion != nil {
+		i -= len(*m.ResourceVersion)
+		copy(dAtA[i:], *m.ResourceVersion)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceVersion)))
 		i--
-		if *m.DryRun {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		dAtA[i] = 0x22
+	}
+	if len(m.Projects) > 0 {
+		for iNdEx := len(m.Projects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Projects[iNdEx])
+			copy(dAtA[i:], m.Projects[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Projects[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x18
 	}
-	if m.Revision != nil {
-		i -= len(*m.Revision)
-		copy(dAtA[i:], *m.Revision)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+	if m.Refresh != nil {
+		i -= len(*m.Refresh)
+		copy(dAtA[i:], *m.Refresh)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Refresh)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
+	if m.Name != nil {
 		i -= len(*m.Name)
 		copy(dAtA[i:], *m.Name)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
@@ -5650,7 +6659,7 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *NodeQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5660,12 +6669,12 @@ func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5674,47 +6683,14 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x2a
-	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if m.Validate != nil {
-		i--
-		if *m.Validate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Spec == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
-	} else {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
+	if m.Name != nil {
 		i -= len(*m.Name)
 		copy(dAtA[i:], *m.Name)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
@@ -5724,7 +6700,7 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
+func (m *RevisionMetadataQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5734,12 +6710,12 @@ func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionMetadataQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevisionMetadataQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5748,35 +6724,36 @@ func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.VersionId != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.VersionId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SourceIndex != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x2a
-	}
-	if m.PatchType == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	} else {
-		i -= len(*m.PatchType)
-		copy(dAtA[i:], *m.PatchType)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Patch == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	if m.Revision == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
 	} else {
-		i -= len(*m.Patch)
-		copy(dAtA[i:], *m.Patch)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5792,7 +6769,7 @@ func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
+func (m *RevisionComparisonQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5802,12 +6779,12 @@ func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RevisionComparisonQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RevisionComparisonQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5816,46 +6793,38 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SourceIndex != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.Prune != nil {
-		i--
-		if *m.Prune {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.DryRun != nil {
-		i--
-		if *m.DryRun {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.TargetRevision != nil {
+		i -= len(*m.TargetRevision)
+		copy(dAtA[i:], *m.TargetRevision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TargetRevision)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.Id == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
-	} else {
-		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
@@ -5869,7 +6838,7 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationResourceRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResourceEventsQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5879,12 +6848,12 @@ func (m *ApplicationResourceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationResourceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResourceEventsQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationResourceEventsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5898,53 +6867,33 @@ func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x32
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Version == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	} else {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+	if m.ResourceUID != nil {
+		i -= len(*m.ResourceUID)
+		copy(dAtA[i:], *m.ResourceUID)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceUID)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.ResourceName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	} else {
+	if m.ResourceName != nil {
 		i -= len(*m.ResourceName)
 		copy(dAtA[i:], *m.ResourceName)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	if m.ResourceNamespace != nil {
+		i -= len(*m.ResourceNamespace)
+		copy(dAtA[i:], *m.ResourceNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceNamespace)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5960,7 +6909,7 @@ func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationManifestQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5970,12 +6919,12 @@ func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationResourcePatchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationResourcePatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5984,187 +6933,55 @@ func (m *ApplicationResourcePatchRequest) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+	if m.VersionId != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.VersionId))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x40
 	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+	if m.NoCache != nil {
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.PatchType == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	} else {
-		i -= len(*m.PatchType)
-		copy(dAtA[i:], *m.PatchType)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
+		if *m.NoCache {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.Patch == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	} else {
-		i -= len(*m.Patch)
-		copy(dAtA[i:], *m.Patch)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	} else {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ResourceName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	} else {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x38
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationResourceDeleteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationResourceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationResourceDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.SourcePositions) > 0 {
+		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
+			i--
+			dAtA[i] = 0x28
+		}
 	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x22
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x4a
-	}
-	if m.Orphan != nil {
-		i--
-		if *m.Orphan {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Force != nil {
-		i--
-		if *m.Force {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	} else {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ResourceName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	} else {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6180,7 +6997,7 @@ func (m *ApplicationResourceDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionParameters) Marshal() (dAtA []byte, err error) {
+func (m *FileChunk) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6190,12 +7007,12 @@ func (m *ResourceActionParameters) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionParameters) MarshalTo(dAtA []byte) (int, error) {
+func (m *FileChunk) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceActionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FileChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6204,28 +7021,19 @@ func (m *ResourceActionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
-	} else {
-		i -= len(*m.Value)
-		copy(dAtA[i:], *m.Value)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	if m.Chunk == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
 	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Chunk)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionRunRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationManifestQueryWithFiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6235,12 +7043,12 @@ func (m *ResourceActionRunRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionRunRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQueryWithFiles) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQueryWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6254,62 +7062,21 @@ func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x22
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.Action == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	} else {
-		i -= len(*m.Action)
-		copy(dAtA[i:], *m.Action)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Action)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	} else {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ResourceName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	} else {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	if m.Checksum == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
+	} else {
+		i -= len(*m.Checksum)
+		copy(dAtA[i:], *m.Checksum)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Checksum)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -6325,7 +7092,7 @@ func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionRunRequestV2) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationManifestQueryWithFilesWrapper) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6335,12 +7102,12 @@ func (m *ResourceActionRunRequestV2) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionRunRequestV2) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQueryWithFilesWrapper) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceActionRunRequestV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationManifestQueryWithFilesWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6349,97 +7116,61 @@ func (m *ResourceActionRunRequestV2) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ResourceActionParameters) > 0 {
-		for iNdEx := len(m.ResourceActionParameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResourceActionParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
+	if m.Part != nil {
+		{
+			size := m.Part.Size()
+			i -= size
+			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x52
 		}
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.Action == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	} else {
-		i -= len(*m.Action)
-		copy(dAtA[i:], *m.Action)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Action)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Version == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	} else {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.ResourceName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	} else {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationManifestQueryWithFilesWrapper_Query) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationManifestQueryWithFilesWrapper_Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Query != nil {
+		{
+			size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ResourceActionsListResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6449,12 +7180,12 @@ func (m *ResourceActionsListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceActionsListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourceActionsListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6463,24 +7194,10 @@ func (m *ResourceActionsListResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Actions) > 0 {
-		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationResourceResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationCreateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6490,12 +7207,12 @@ func (m *ApplicationResourceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationCreateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6504,19 +7221,44 @@ func (m *ApplicationResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Manifest == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
+	if m.Validate != nil {
+		i--
+		if *m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Upsert != nil {
+		i--
+		if *m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Application == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
 	} else {
-		i -= len(*m.Manifest)
-		copy(dAtA[i:], *m.Manifest)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Manifest)))
+		{
+			size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPodLogsQuery) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationUpdateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6526,12 +7268,12 @@ func (m *ApplicationPodLogsQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6540,131 +7282,94 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MatchCase != nil {
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		if *m.MatchCase {
+		dAtA[i] = 0x1a
+	}
+	if m.Validate != nil {
+		i--
+		if *m.Validate {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x10
+	}
+	if m.Application == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	} else {
+		{
+			size, err := m.Application.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x2a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x22
 	}
-	if m.Previous != nil {
+	if m.PropagationPolicy != nil {
+		i -= len(*m.PropagationPolicy)
+		copy(dAtA[i:], *m.PropagationPolicy)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PropagationPolicy)))
 		i--
-		if *m.Previous {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.ResourceName != nil {
-		i -= len(*m.ResourceName)
-		copy(dAtA[i:], *m.ResourceName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.Kind != nil {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.Filter != nil {
-		i -= len(*m.Filter)
-		copy(dAtA[i:], *m.Filter)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Filter)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.UntilTime != nil {
-		i -= len(*m.UntilTime)
-		copy(dAtA[i:], *m.UntilTime)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.UntilTime)))
-		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x1a
 	}
-	if m.Follow != nil {
+	if m.Cascade != nil {
 		i--
-		if *m.Follow {
+		if *m.Cascade {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x40
-	}
-	if m.TailLines != nil {
-		i = encodeVarintApplication(dAtA, i, uint64(*m.TailLines))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SinceTime != nil {
-		{
-			size, err := m.SinceTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SinceSeconds != nil {
-		i = encodeVarintApplication(dAtA, i, uint64(*m.SinceSeconds))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Container != nil {
-		i -= len(*m.Container)
-		copy(dAtA[i:], *m.Container)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Container)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PodName != nil {
-		i -= len(*m.PodName)
-		copy(dAtA[i:], *m.PodName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
@@ -6678,7 +7383,7 @@ func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+func (m *SyncOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6688,12 +7393,12 @@ func (m *LogEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SyncOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6702,63 +7407,19 @@ func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PodName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
-	} else {
-		i -= len(*m.PodName)
-		copy(dAtA[i:], *m.PodName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TimeStampStr == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
-	} else {
-		i -= len(*m.TimeStampStr)
-		copy(dAtA[i:], *m.TimeStampStr)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TimeStampStr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Last == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
-	} else {
-		i--
-		if *m.Last {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TimeStamp == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
-	} else {
-		{
-			size, err := m.TimeStamp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Items[iNdEx])
+			copy(dAtA[i:], m.Items[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Items[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Content == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
-	} else {
-		i -= len(*m.Content)
-		copy(dAtA[i:], *m.Content)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Content)))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationSyncRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6768,12 +7429,12 @@ func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationSyncRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6782,122 +7443,64 @@ func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.SourcePositions) > 0 {
+		for iNdEx := len(m.SourcePositions) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintApplication(dAtA, i, uint64(m.SourcePositions[iNdEx]))
+			i--
+			dAtA[i] = 0x70
+		}
 	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+	if m.SyncOptions != nil {
+		{
+			size, err := m.SyncOptions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x5a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CanSync == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	} else {
-		i--
-		if *m.CanSync {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x52
 	}
-	if len(m.AssignedWindows) > 0 {
-		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6905,13 +7508,22 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintApplication(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x4a
 		}
 	}
-	if len(m.ActiveWindows) > 0 {
-		for iNdEx := len(m.ActiveWindows) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Manifests) > 0 {
+		for iNdEx := len(m.Manifests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Manifests[iNdEx])
+			copy(dAtA[i:], m.Manifests[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Manifests[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ActiveWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6919,41 +7531,24 @@ func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintApplication(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x3a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationSyncWindow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationSyncWindow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Strategy != nil {
+		{
+			size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.ManualSync == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
-	} else {
+	if m.Prune != nil {
 		i--
-		if *m.ManualSync {
+		if *m.Prune {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -6961,37 +7556,36 @@ func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	if m.Duration == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
-	} else {
-		i -= len(*m.Duration)
-		copy(dAtA[i:], *m.Duration)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Duration)))
+	if m.DryRun != nil {
 		i--
-		dAtA[i] = 0x1a
+		if *m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Schedule == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
-	} else {
-		i -= len(*m.Schedule)
-		copy(dAtA[i:], *m.Schedule)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Schedule)))
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Kind == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7001,12 +7595,12 @@ func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OperationTerminateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationTerminateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7015,10 +7609,57 @@ func (m *OperationTerminateResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Validate != nil {
+		i--
+		if *m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Spec == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+	} else {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7028,12 +7669,12 @@ func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7047,63 +7688,46 @@ func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x32
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Kind != nil {
-		i -= len(*m.Kind)
-		copy(dAtA[i:], *m.Kind)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Group != nil {
-		i -= len(*m.Group)
-		copy(dAtA[i:], *m.Group)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
-		i--
 		dAtA[i] = 0x2a
 	}
-	if m.Version != nil {
-		i -= len(*m.Version)
-		copy(dAtA[i:], *m.Version)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Name != nil {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+	if m.PatchType == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	} else {
+		i -= len(*m.PatchType)
+		copy(dAtA[i:], *m.PatchType)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+	if m.Patch == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	} else {
+		i -= len(*m.Patch)
+		copy(dAtA[i:], *m.Patch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ApplicationName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
-		i -= len(*m.ApplicationName)
-		copy(dAtA[i:], *m.ApplicationName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ManagedResourcesResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationPatchParametersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7113,12 +7737,12 @@ func (m *ManagedResourcesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ManagedResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationPatchParametersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationPatchParametersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7127,10 +7751,10 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PluginEnv) > 0 {
+		for iNdEx := len(m.PluginEnv) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PluginEnv[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7138,49 +7762,22 @@ func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 				i = encodeVarintApplication(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x42
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationServerSideDiffQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationServerSideDiffQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationServerSideDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TargetManifests) > 0 {
-		for iNdEx := len(m.TargetManifests) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TargetManifests[iNdEx])
-			copy(dAtA[i:], m.TargetManifests[iNdEx])
-			i = encodeVarintApplication(dAtA, i, uint64(len(m.TargetManifests[iNdEx])))
+	if len(m.KustomizeImages) > 0 {
+		for iNdEx := len(m.KustomizeImages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.KustomizeImages[iNdEx])
+			copy(dAtA[i:], m.KustomizeImages[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.KustomizeImages[iNdEx])))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.LiveResources) > 0 {
-		for iNdEx := len(m.LiveResources) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.HelmParameters) > 0 {
+		for iNdEx := len(m.HelmParameters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LiveResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.HelmParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -7188,9 +7785,21 @@ func (m *ApplicationServerSideDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int,
 				i = encodeVarintApplication(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x32
 		}
 	}
+	if m.ResourceVersion != nil {
+		i -= len(*m.ResourceVersion)
+		copy(dAtA[i:], *m.ResourceVersion)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceVersion)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SourceIndex != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SourceIndex))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Project != nil {
 		i -= len(*m.Project)
 		copy(dAtA[i:], *m.Project)
@@ -7205,19 +7814,19 @@ func (m *ApplicationServerSideDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.AppName == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	} else {
-		i -= len(*m.AppName)
-		copy(dAtA[i:], *m.AppName)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppName)))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationServerSideDiffResponse) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7227,12 +7836,12 @@ func (m *ApplicationServerSideDiffResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationServerSideDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7241,36 +7850,60 @@ func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Modified == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
-	} else {
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		if *m.Modified {
+		dAtA[i] = 0x3a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Prune != nil {
+		i--
+		if *m.Prune {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	if m.DryRun != nil {
+		i--
+		if *m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResourceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7280,12 +7913,12 @@ func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResourceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationResourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7294,83 +7927,74 @@ func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.IconClass != nil {
-		i -= len(*m.IconClass)
-		copy(dAtA[i:], *m.IconClass)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x42
 	}
-	if m.Description != nil {
-		i -= len(*m.Description)
-		copy(dAtA[i:], *m.Description)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	if m.Url == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	} else {
-		i -= len(*m.Url)
-		copy(dAtA[i:], *m.Url)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
-	if m.Title == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
 	} else {
-		i -= len(*m.Title)
-		copy(dAtA[i:], *m.Title)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ResourceName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	} else {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplication(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationResourcePatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7380,12 +8004,12 @@ func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationResourcePatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationResourcePatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7399,14 +8023,73 @@ func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x52
 	}
-	if m.Namespace != nil {
-		i -= len(*m.Namespace)
-		copy(dAtA[i:], *m.Namespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
-		i--
-		dAtA[i] = 0x1a
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.PatchType == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	} else {
+		i -= len(*m.PatchType)
+		copy(dAtA[i:], *m.PatchType)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Patch == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	} else {
+		i -= len(*m.Patch)
+		copy(dAtA[i:], *m.Patch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	} else {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ResourceName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	} else {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
@@ -7420,1275 +8103,8118 @@ func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplication(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationResourceDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ApplicationResourceDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationResourceDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Refresh != nil {
-		l = len(*m.Refresh)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Orphan != nil {
+		i--
+		if *m.Orphan {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Force != nil {
+		i--
+		if *m.Force {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.ResourceVersion != nil {
-		l = len(*m.ResourceVersion)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Selector != nil {
-		l = len(*m.Selector)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Repo != nil {
-		l = len(*m.Repo)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Version == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	} else {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ResourceName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	} else {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Project) > 0 {
-		for _, s := range m.Project {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceActionParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Value == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
+	} else {
+		i -= len(*m.Value)
+		copy(dAtA[i:], *m.Value)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RevisionMetadataQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Revision != nil {
-		l = len(*m.Revision)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.SourceIndex != nil {
-		n += 1 + sovApplication(uint64(*m.SourceIndex))
-	}
-	if m.VersionId != nil {
-		n += 1 + sovApplication(uint64(*m.VersionId))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ResourceActionRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationResourceEventsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceNamespace != nil {
-		l = len(*m.ResourceNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceUID != nil {
-		l = len(*m.ResourceUID)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ResourceActionRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationManifestQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResourceActionRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Revision != nil {
-		l = len(*m.Revision)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x4a
 	}
 	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.SourcePositions) > 0 {
-		for _, e := range m.SourcePositions {
-			n += 1 + sovApplication(uint64(e))
-		}
+	if m.Action == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	} else {
+		i -= len(*m.Action)
+		copy(dAtA[i:], *m.Action)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Action)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.NoCache != nil {
-		n += 2
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Version == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	} else {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *FileChunk) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ResourceName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	} else {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Chunk != nil {
-		l = len(m.Chunk)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationManifestQueryWithFiles) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Checksum != nil {
-		l = len(*m.Checksum)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ResourceActionRunRequestV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Part != nil {
-		n += m.Part.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *ResourceActionRunRequestV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationManifestQueryWithFilesWrapper_Query) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResourceActionRunRequestV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Query != nil {
-		l = m.Query.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	return n
-}
-func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	if m.Chunk != nil {
-		l = m.Chunk.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	return n
-}
-func (m *ApplicationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ApplicationCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ResourceActionParameters) > 0 {
+		for iNdEx := len(m.ResourceActionParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceActionParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	var l int
-	_ = l
-	if m.Application != nil {
-		l = m.Application.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Upsert != nil {
-		n += 2
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Validate != nil {
-		n += 2
+	if m.Action == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	} else {
+		i -= len(*m.Action)
+		copy(dAtA[i:], *m.Action)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Action)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-
-func (m *ApplicationUpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Application != nil {
-		l = m.Application.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Version == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	} else {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Validate != nil {
-		n += 2
+	if m.ResourceName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	} else {
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Cascade != nil {
-		n += 2
-	}
-	if m.PropagationPolicy != nil {
-		l = len(*m.PropagationPolicy)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ResourceActionsListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SyncOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ResourceActionsListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionsListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, s := range m.Items {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceActionBulkRunRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionBulkRunRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionBulkRunRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Revision != nil {
-		l = len(*m.Revision)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.DryRun != nil {
-		n += 2
+	if len(m.ResourceActionParameters) > 0 {
+		for iNdEx := len(m.ResourceActionParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceActionParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if m.Prune != nil {
-		n += 2
+	if m.Action == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	} else {
+		i -= len(*m.Action)
+		copy(dAtA[i:], *m.Action)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Action)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Strategy != nil {
-		l = m.Strategy.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.LabelSelector != nil {
+		i -= len(*m.LabelSelector)
+		copy(dAtA[i:], *m.LabelSelector)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.LabelSelector)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.Resources) > 0 {
-		for _, e := range m.Resources {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if len(m.Manifests) > 0 {
-		for _, s := range m.Manifests {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if len(m.Infos) > 0 {
-		for _, e := range m.Infos {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if m.RetryStrategy != nil {
-		l = m.RetryStrategy.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.SyncOptions != nil {
-		l = m.SyncOptions.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if len(m.SourcePositions) > 0 {
-		for _, e := range m.SourcePositions {
-			n += 1 + sovApplication(uint64(e))
-		}
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUpdateSpecRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceActionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Spec != nil {
-		l = m.Spec.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Validate != nil {
-		n += 2
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ApplicationPatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
 	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Patch != nil {
-		l = len(*m.Patch)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PatchType != nil {
-		l = len(*m.PatchType)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Version != nil {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationRollbackRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourceActionBulkRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceActionBulkRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceActionBulkRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Id != nil {
-		n += 1 + sovApplication(uint64(*m.Id))
-	}
-	if m.DryRun != nil {
-		n += 2
-	}
-	if m.Prune != nil {
-		n += 2
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationResourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationResourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationResourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationResourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Manifest == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
+	} else {
+		i -= len(*m.Manifest)
+		copy(dAtA[i:], *m.Manifest)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Manifest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationResourcePatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationPodLogsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPodLogsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPodLogsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Patch != nil {
-		l = len(*m.Patch)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Regex != nil {
+		i--
+		if *m.Regex {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.PatchType != nil {
-		l = len(*m.PatchType)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.AllContainers != nil {
+		i--
+		if *m.AllContainers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.MatchCase != nil {
+		i--
+		if *m.MatchCase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
 	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ApplicationResourceDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Previous != nil {
+		i--
+		if *m.Previous {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
 	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.ResourceName)
+		copy(dAtA[i:], *m.ResourceName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ResourceName)))
+		i--
+		dAtA[i] = 0x6a
 	}
 	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x62
 	}
 	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Force != nil {
-		n += 2
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	if m.Orphan != nil {
-		n += 2
+	if m.Filter != nil {
+		i -= len(*m.Filter)
+		copy(dAtA[i:], *m.Filter)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Filter)))
+		i--
+		dAtA[i] = 0x52
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.UntilTime != nil {
+		i -= len(*m.UntilTime)
+		copy(dAtA[i:], *m.UntilTime)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.UntilTime)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Follow != nil {
+		i--
+		if *m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TailLines != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.TailLines))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *ResourceActionParameters) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.SinceTime != nil {
+		{
+			size, err := m.SinceTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.SinceSeconds != nil {
+		i = encodeVarintApplication(dAtA, i, uint64(*m.SinceSeconds))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Value != nil {
-		l = len(*m.Value)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Container != nil {
+		i -= len(*m.Container)
+		copy(dAtA[i:], *m.Container)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Container)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.PodName != nil {
+		i -= len(*m.PodName)
+		copy(dAtA[i:], *m.PodName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionRunRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ContainerName != nil {
+		i -= len(*m.ContainerName)
+		copy(dAtA[i:], *m.ContainerName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ContainerName)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.PodName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	} else {
+		i -= len(*m.PodName)
+		copy(dAtA[i:], *m.PodName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PodName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Action != nil {
-		l = len(*m.Action)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.TimeStampStr == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
+	} else {
+		i -= len(*m.TimeStampStr)
+		copy(dAtA[i:], *m.TimeStampStr)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TimeStampStr)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Last == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
+	} else {
+		i--
+		if *m.Last {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.TimeStamp == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
+	} else {
+		{
+			size, err := m.TimeStamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Content == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
+	} else {
+		i -= len(*m.Content)
+		copy(dAtA[i:], *m.Content)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Content)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionRunRequestV2) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OperationTerminateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OperationTerminateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperationTerminateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Action != nil {
-		l = len(*m.Action)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ResourceActionParameters) > 0 {
-		for _, e := range m.ResourceActionParameters {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourceActionsListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSyncWindowsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindowsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindowsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *ApplicationResourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Manifest != nil {
-		l = len(*m.Manifest)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPodLogsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationMetricsHistoryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationMetricsHistoryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationMetricsHistoryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.PodName != nil {
-		l = len(*m.PodName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Container != nil {
-		l = len(*m.Container)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.SinceSeconds != nil {
-		n += 1 + sovApplication(uint64(*m.SinceSeconds))
-	}
-	if m.SinceTime != nil {
-		l = m.SinceTime.Size()
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.TailLines != nil {
-		n += 1 + sovApplication(uint64(*m.TailLines))
-	}
-	if m.Follow != nil {
-		n += 2
-	}
-	if m.UntilTime != nil {
-		l = len(*m.UntilTime)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Filter != nil {
-		l = len(*m.Filter)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ResourceName != nil {
-		l = len(*m.ResourceName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Previous != nil {
-		n += 2
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.Project != nil {
-		l = len(*m.Project)
-		n += 2 + l + sovApplication(uint64(l))
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.MatchCase != nil {
-		n += 3
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LogEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSyncWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Content != nil {
-		l = len(*m.Content)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.TimeStamp != nil {
-		l = m.TimeStamp.Size()
-		n += 1 + l + sovApplication(uint64(l))
+	if m.NextTransition != nil {
+		{
+			size, err := m.NextTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Last != nil {
-		n += 2
+	if m.CanAutoSync != nil {
+		i--
+		if *m.CanAutoSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TimeStampStr != nil {
-		l = len(*m.TimeStampStr)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.CanSync == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
+	} else {
+		i--
+		if *m.CanSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PodName != nil {
-		l = len(*m.PodName)
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.AssignedWindows) > 0 {
+		for iNdEx := len(m.AssignedWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssignedWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ActiveWindows) > 0 {
+		for iNdEx := len(m.ActiveWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActiveWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *OperationTerminateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSyncWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSyncWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Unlocked != nil {
+		i--
+		if *m.Unlocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.NextTransition != nil {
+		{
+			size, err := m.NextTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Active != nil {
+		i--
+		if *m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *ApplicationSyncWindowsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.TimeZone != nil {
+		i -= len(*m.TimeZone)
+		copy(dAtA[i:], *m.TimeZone)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TimeZone)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.ManualSync == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+	} else {
+		i--
+		if *m.ManualSync {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Duration == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
+	} else {
+		i -= len(*m.Duration)
+		copy(dAtA[i:], *m.Duration)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Duration)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Schedule == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
+	} else {
+		i -= len(*m.Schedule)
+		copy(dAtA[i:], *m.Schedule)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Schedule)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Kind == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	} else {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSyncWindowsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ActiveWindows) > 0 {
-		for _, e := range m.ActiveWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if len(m.AssignedWindows) > 0 {
-		for _, e := range m.AssignedWindows {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if m.CanSync != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *OperationTerminateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSyncWindow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Schedule != nil {
-		l = len(*m.Schedule)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Duration != nil {
-		l = len(*m.Duration)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.ManualSync != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *OperationTerminateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OperationTerminateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *OperationTerminateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResourcesQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResourcesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcesQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ApplicationName != nil {
-		l = len(*m.ApplicationName)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.Version != nil {
-		l = len(*m.Version)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x32
 	}
 	if m.Group != nil {
-		l = len(*m.Group)
-		n += 1 + l + sovApplication(uint64(l))
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Kind != nil {
-		l = len(*m.Kind)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Version != nil {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ApplicationName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	} else {
+		i -= len(*m.ApplicationName)
+		copy(dAtA[i:], *m.ApplicationName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.ApplicationName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ManagedResourcesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ApplicationDeletePreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationServerSideDiffQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ApplicationDeletePreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDeletePreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AppName != nil {
-		l = len(*m.AppName)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.AppNamespace != nil {
-		l = len(*m.AppNamespace)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.PropagationPolicy != nil {
+		i -= len(*m.PropagationPolicy)
+		copy(dAtA[i:], *m.PropagationPolicy)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PropagationPolicy)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.LiveResources) > 0 {
-		for _, e := range m.LiveResources {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+	if len(m.Retained) > 0 {
+		for iNdEx := len(m.Retained) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retained[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.TargetManifests) > 0 {
-		for _, s := range m.TargetManifests {
-			l = len(s)
-			n += 1 + l + sovApplication(uint64(l))
+	if len(m.Dependents) > 0 {
+		for iNdEx := len(m.Dependents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationServerSideDiffResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ManagedResourcesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedResourcesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedResourcesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Modified != nil {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LinkInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationServerSideDiffQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationServerSideDiffQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationServerSideDiffQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Title != nil {
-		l = len(*m.Title)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Url != nil {
-		l = len(*m.Url)
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.TargetManifests) > 0 {
+		for iNdEx := len(m.TargetManifests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TargetManifests[iNdEx])
+			copy(dAtA[i:], m.TargetManifests[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.TargetManifests[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if m.Description != nil {
-		l = len(*m.Description)
-		n += 1 + l + sovApplication(uint64(l))
+	if len(m.LiveResources) > 0 {
+		for iNdEx := len(m.LiveResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiveResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.IconClass != nil {
-		l = len(*m.IconClass)
-		n += 1 + l + sovApplication(uint64(l))
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.AppName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	} else {
+		i -= len(*m.AppName)
+		copy(dAtA[i:], *m.AppName)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *LinksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationServerSideDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationServerSideDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovApplication(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Modified == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
+	} else {
+		i--
+		if *m.Modified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.IconClass != nil {
+		i -= len(*m.IconClass)
+		copy(dAtA[i:], *m.IconClass)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.IconClass)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Description != nil {
+		i -= len(*m.Description)
+		copy(dAtA[i:], *m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Url == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	} else {
+		i -= len(*m.Url)
+		copy(dAtA[i:], *m.Url)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Title == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	} else {
+		i -= len(*m.Title)
+		copy(dAtA[i:], *m.Title)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAppLinksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAppLinksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAppLinksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Revisions[iNdEx])
+			copy(dAtA[i:], m.Revisions[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Revisions[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.CreatedBy != nil {
+		i -= len(*m.CreatedBy)
+		copy(dAtA[i:], *m.CreatedBy)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.CreatedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotRestoreRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotRestoreRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotRestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DryRun != nil {
+		i--
+		if *m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotRestoreResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotRestoreResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotRestoreResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Operation != nil {
+		i -= len(*m.Operation)
+		copy(dAtA[i:], *m.Operation)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Operation)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Name != nil {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Kind != nil {
+		i -= len(*m.Kind)
+		copy(dAtA[i:], *m.Kind)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Kind)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != nil {
+		i -= len(*m.Version)
+		copy(dAtA[i:], *m.Version)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Group != nil {
+		i -= len(*m.Group)
+		copy(dAtA[i:], *m.Group)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotRestoreResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotRestoreResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotRestoreResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSnapshotDeleteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSnapshotDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSnapshotDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i -= len(*m.Id)
+		copy(dAtA[i:], *m.Id)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Id)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationAgentStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationAgentStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationAgentStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ResourcesDeleted != nil {
+		i--
+		if *m.ResourcesDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.OperationMessage != nil {
+		i -= len(*m.OperationMessage)
+		copy(dAtA[i:], *m.OperationMessage)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.OperationMessage)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.OperationPhase != nil {
+		i -= len(*m.OperationPhase)
+		copy(dAtA[i:], *m.OperationPhase)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.OperationPhase)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Error != nil {
+		i -= len(*m.Error)
+		copy(dAtA[i:], *m.Error)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SyncStatus == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("syncStatus")
+	} else {
+		i -= len(*m.SyncStatus)
+		copy(dAtA[i:], *m.SyncStatus)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.SyncStatus)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Cluster == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("cluster")
+	} else {
+		i -= len(*m.Cluster)
+		copy(dAtA[i:], *m.Cluster)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Cluster)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Refresh != nil {
+		l = len(*m.Refresh)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.ResourceVersion != nil {
+		l = len(*m.ResourceVersion)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Selector != nil {
+		l = len(*m.Selector)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Repo != nil {
+		l = len(*m.Repo)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Project) > 0 {
+		for _, s := range m.Project {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Limit != nil {
+		n += 1 + sovApplication(uint64(*m.Limit))
+	}
+	if m.Continue != nil {
+		l = len(*m.Continue)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SortBy != nil {
+		l = len(*m.SortBy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Minimal != nil {
+		n += 2
+	}
+	if len(m.ConditionSeverities) > 0 {
+		for _, s := range m.ConditionSeverities {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionMetadataQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SourceIndex != nil {
+		n += 1 + sovApplication(uint64(*m.SourceIndex))
+	}
+	if m.VersionId != nil {
+		n += 1 + sovApplication(uint64(*m.VersionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RevisionComparisonQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.TargetRevision != nil {
+		l = len(*m.TargetRevision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SourceIndex != nil {
+		n += 1 + sovApplication(uint64(*m.SourceIndex))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceEventsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceNamespace != nil {
+		l = len(*m.ResourceNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceUID != nil {
+		l = len(*m.ResourceUID)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.SourcePositions) > 0 {
+		for _, e := range m.SourcePositions {
+			n += 1 + sovApplication(uint64(e))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.NoCache != nil {
+		n += 2
+	}
+	if m.VersionId != nil {
+		n += 1 + sovApplication(uint64(*m.VersionId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FileChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = len(m.Chunk)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQueryWithFiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Checksum != nil {
+		l = len(*m.Checksum)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQueryWithFilesWrapper) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part != nil {
+		n += m.Part.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationManifestQueryWithFilesWrapper_Query) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Query != nil {
+		l = m.Query.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationManifestQueryWithFilesWrapper_Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Application != nil {
+		l = m.Application.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Upsert != nil {
+		n += 2
+	}
+	if m.Validate != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Application != nil {
+		l = m.Application.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Validate != nil {
+		n += 2
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Cascade != nil {
+		n += 2
+	}
+	if m.PropagationPolicy != nil {
+		l = len(*m.PropagationPolicy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, s := range m.Items {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRun != nil {
+		n += 2
+	}
+	if m.Prune != nil {
+		n += 2
+	}
+	if m.Strategy != nil {
+		l = m.Strategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.RetryStrategy != nil {
+		l = m.RetryStrategy.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SyncOptions != nil {
+		l = m.SyncOptions.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.SourcePositions) > 0 {
+		for _, e := range m.SourcePositions {
+			n += 1 + sovApplication(uint64(e))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationUpdateSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Validate != nil {
+		n += 2
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Patch != nil {
+		l = len(*m.Patch)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.PatchType != nil {
+		l = len(*m.PatchType)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPatchParametersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SourceIndex != nil {
+		n += 1 + sovApplication(uint64(*m.SourceIndex))
+	}
+	if m.ResourceVersion != nil {
+		l = len(*m.ResourceVersion)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.HelmParameters) > 0 {
+		for _, e := range m.HelmParameters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.KustomizeImages) > 0 {
+		for _, s := range m.KustomizeImages {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.PluginEnv) > 0 {
+		for _, e := range m.PluginEnv {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationRollbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		n += 1 + sovApplication(uint64(*m.Id))
+	}
+	if m.DryRun != nil {
+		n += 2
+	}
+	if m.Prune != nil {
+		n += 2
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourcePatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Patch != nil {
+		l = len(*m.Patch)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.PatchType != nil {
+		l = len(*m.PatchType)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Force != nil {
+		n += 2
+	}
+	if m.Orphan != nil {
+		n += 2
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Value != nil {
+		l = len(*m.Value)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Action != nil {
+		l = len(*m.Action)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionRunRequestV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Action != nil {
+		l = len(*m.Action)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.ResourceActionParameters) > 0 {
+		for _, e := range m.ResourceActionParameters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionsListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionBulkRunRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.LabelSelector != nil {
+		l = len(*m.LabelSelector)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Action != nil {
+		l = len(*m.Action)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.ResourceActionParameters) > 0 {
+		for _, e := range m.ResourceActionParameters {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourceActionBulkRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationResourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Manifest != nil {
+		l = len(*m.Manifest)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationPodLogsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.PodName != nil {
+		l = len(*m.PodName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Container != nil {
+		l = len(*m.Container)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SinceSeconds != nil {
+		n += 1 + sovApplication(uint64(*m.SinceSeconds))
+	}
+	if m.SinceTime != nil {
+		l = m.SinceTime.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.TailLines != nil {
+		n += 1 + sovApplication(uint64(*m.TailLines))
+	}
+	if m.Follow != nil {
+		n += 2
+	}
+	if m.UntilTime != nil {
+		l = len(*m.UntilTime)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Filter != nil {
+		l = len(*m.Filter)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourceName != nil {
+		l = len(*m.ResourceName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Previous != nil {
+		n += 2
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 2 + l + sovApplication(uint64(l))
+	}
+	if m.MatchCase != nil {
+		n += 3
+	}
+	if m.AllContainers != nil {
+		n += 3
+	}
+	if m.Regex != nil {
+		n += 3
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Content != nil {
+		l = len(*m.Content)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.TimeStamp != nil {
+		l = m.TimeStamp.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Last != nil {
+		n += 2
+	}
+	if m.TimeStampStr != nil {
+		l = len(*m.TimeStampStr)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.PodName != nil {
+		l = len(*m.PodName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ContainerName != nil {
+		l = len(*m.ContainerName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationTerminateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindowsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationMetricsHistoryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActiveWindows) > 0 {
+		for _, e := range m.ActiveWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.AssignedWindows) > 0 {
+		for _, e := range m.AssignedWindows {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.CanSync != nil {
+		n += 2
+	}
+	if m.CanAutoSync != nil {
+		n += 2
+	}
+	if m.NextTransition != nil {
+		l = m.NextTransition.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSyncWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Schedule != nil {
+		l = len(*m.Schedule)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Duration != nil {
+		l = len(*m.Duration)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ManualSync != nil {
+		n += 2
+	}
+	if m.TimeZone != nil {
+		l = len(*m.TimeZone)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Active != nil {
+		n += 2
+	}
+	if m.NextTransition != nil {
+		l = m.NextTransition.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Unlocked != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OperationTerminateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResourcesQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationName != nil {
+		l = len(*m.ApplicationName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationDeletePreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Dependents) > 0 {
+		for _, e := range m.Dependents {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Retained) > 0 {
+		for _, e := range m.Retained {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.PropagationPolicy != nil {
+		l = len(*m.PropagationPolicy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManagedResourcesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationServerSideDiffQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppName != nil {
+		l = len(*m.AppName)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.LiveResources) > 0 {
+		for _, e := range m.LiveResources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.TargetManifests) > 0 {
+		for _, s := range m.TargetManifests {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationServerSideDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Modified != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LinkInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Title != nil {
+		l = len(*m.Title)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Url != nil {
+		l = len(*m.Url)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Description != nil {
+		l = len(*m.Description)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.IconClass != nil {
+		l = len(*m.IconClass)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *LinksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListAppLinksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.CreatedBy != nil {
+		l = len(*m.CreatedBy)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotRestoreRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRun != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotRestoreResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = len(*m.Group)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Version != nil {
+		l = len(*m.Version)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Kind != nil {
+		l = len(*m.Kind)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Operation != nil {
+		l = len(*m.Operation)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotRestoreResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSnapshotDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Id != nil {
+		l = len(*m.Id)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationAgentStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Cluster != nil {
+		l = len(*m.Cluster)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.SyncStatus != nil {
+		l = len(*m.SyncStatus)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Error != nil {
+		l = len(*m.Error)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.OperationPhase != nil {
+		l = len(*m.OperationPhase)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.OperationMessage != nil {
+		l = len(*m.OperationMessage)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.ResourcesDeleted != nil {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApplication(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplication(x uint64) (n int) {
+	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Refresh = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Selector = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Repo = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = append(m.Project, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Limit = &v
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Continue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Continue = &s
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SortBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SortBy = &s
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minimal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Minimal = &b
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionSeverities", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionSeverities = append(m.ConditionSeverities, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VersionId = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionComparisonQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionComparisonQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionComparisonQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetRevision = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceEventsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceEventsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceName = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceUID = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SourcePositions = append(m.SourcePositions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplication
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SourcePositions) == 0 {
+					m.SourcePositions = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SourcePositions = append(m.SourcePositions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.NoCache = &b
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VersionId = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileChunk) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Checksum = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationManifestQueryWithFiles{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ApplicationManifestQueryWithFilesWrapper_Query{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FileChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ApplicationManifestQueryWithFilesWrapper_Chunk{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Application == nil {
+				m.Application = &v1alpha1.Application{}
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Upsert = &b
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Validate = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Application == nil {
+				m.Application = &v1alpha1.Application{}
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Validate = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Cascade = &b
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagationPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PropagationPolicy = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DryRun = &b
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Prune = &b
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Strategy == nil {
+				m.Strategy = &v1alpha1.SyncStrategy{}
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &v1alpha1.SyncOperationResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, &v1alpha1.Info{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &v1alpha1.RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncOptions == nil {
+				m.SyncOptions = &SyncOptions{}
+			}
+			if err := m.SyncOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SourcePositions = append(m.SourcePositions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplication
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SourcePositions) == 0 {
+					m.SourcePositions = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SourcePositions = append(m.SourcePositions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &v1alpha1.ApplicationSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Validate = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Patch = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PatchType = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPatchParametersRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPatchParametersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPatchParametersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SourceIndex = &v
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ResourceVersion = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HelmParameters = append(m.HelmParameters, &v1alpha1.HelmParameter{})
+			if err := m.HelmParameters[len(m.HelmParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeImages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KustomizeImages = append(m.KustomizeImages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PluginEnv", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PluginEnv = append(m.PluginEnv, &v1alpha1.EnvEntry{})
+			if err := m.PluginEnv[len(m.PluginEnv)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	return n
-}
 
-func (m *ListAppLinksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Name != nil {
-		l = len(*m.Name)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Namespace != nil {
-		l = len(*m.Namespace)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.Project != nil {
-		l = len(*m.Project)
-		n += 1 + l + sovApplication(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovApplication(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplication(x uint64) (n int) {
-	return sovApplication(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8711,10 +16237,10 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8750,9 +16276,73 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Name = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.DryRun = &b
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Prune = &b
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Refresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8781,11 +16371,102 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Refresh = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8813,11 +16494,13 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
-		case 4:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8846,11 +16529,11 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceVersion = &s
+			m.Namespace = &s
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8879,11 +16562,12 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Selector = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
-		case 6:
+			hasFields[0] |= uint64(0x00000002)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8912,11 +16596,12 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Repo = &s
+			m.Version = &s
 			iNdEx = postIndex
-		case 7:
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8945,11 +16630,11 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.Group = &s
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8977,62 +16662,13 @@ func (m *ApplicationQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = append(m.Project, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9061,11 +16697,11 @@ func (m *NodeQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9094,7 +16730,7 @@ func (m *NodeQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.Project = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9112,13 +16748,25 @@ func (m *NodeQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -9142,10 +16790,10 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevisionMetadataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9184,7 +16832,7 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9213,12 +16861,11 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Revision = &s
+			m.Namespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9247,11 +16894,12 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9280,109 +16928,12 @@ func (m *RevisionMetadataQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Version = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SourceIndex = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionId", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.VersionId = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("revision")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceEventsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceEventsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9411,12 +16962,11 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9445,11 +16995,12 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceNamespace = &s
+			m.Kind = &s
 			iNdEx = postIndex
-		case 3:
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9478,11 +17029,12 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Patch = &s
 			iNdEx = postIndex
-		case 4:
+			hasFields[0] |= uint64(0x00000010)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9511,9 +17063,10 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceUID = &s
+			m.PatchType = &s
 			iNdEx = postIndex
-		case 5:
+			hasFields[0] |= uint64(0x00000020)
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -9546,7 +17099,7 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -9598,13 +17151,28 @@ func (m *ApplicationResourceEventsQuery) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	}
+	if hasFields[0]&uint64(0x00000020) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -9628,10 +17196,10 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9670,7 +17238,7 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9699,11 +17267,11 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Revision = &s
+			m.Namespace = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9732,11 +17300,12 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9765,87 +17334,45 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Version = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
 		case 5:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SourcePositions = append(m.SourcePositions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApplication
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthApplication
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SourcePositions) == 0 {
-					m.SourcePositions = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SourcePositions = append(m.SourcePositions, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9873,11 +17400,13 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9895,7 +17424,94 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 				}
 			}
 			b := bool(v != 0)
-			m.NoCache = &b
+			m.Force = &b
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orphan", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Orphan = &b
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -9912,8 +17528,17 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
 	}
 
 	if iNdEx > l {
@@ -9921,7 +17546,7 @@ func (m *ApplicationManifestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileChunk) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -9945,17 +17570,17 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileChunk: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -9965,27 +17590,60 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
-			if m.Chunk == nil {
-				m.Chunk = []byte{}
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10003,7 +17661,10 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("chunk")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
 	}
 
 	if iNdEx > l {
@@ -10011,7 +17672,7 @@ func (m *FileChunk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -10035,10 +17696,10 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10077,7 +17738,7 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10106,12 +17767,11 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Checksum = &s
+			m.Namespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10140,11 +17800,12 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10173,70 +17834,14 @@ func (m *ApplicationManifestQueryWithFiles) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Version = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("checksum")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationManifestQueryWithFilesWrapper: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10246,170 +17851,64 @@ func (m *ApplicationManifestQueryWithFilesWrapper) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplicationManifestQueryWithFiles{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ApplicationManifestQueryWithFilesWrapper_Query{v}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &FileChunk{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Part = &ApplicationManifestQueryWithFilesWrapper_Chunk{v}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10419,34 +17918,31 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Application == nil {
-				m.Application = &v1alpha1.Application{}
-			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Action = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			hasFields[0] |= uint64(0x00000010)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10456,18 +17952,30 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Upsert = &b
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10477,13 +17985,25 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Validate = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -10501,7 +18021,19 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
 	}
 
 	if iNdEx > l {
@@ -10509,7 +18041,7 @@ func (m *ApplicationCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -10533,17 +18065,17 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionRunRequestV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionRunRequestV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10553,34 +18085,31 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Application == nil {
-				m.Application = &v1alpha1.Application{}
-			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10590,16 +18119,28 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Validate = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10628,66 +18169,46 @@ func (m *ApplicationUpdateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			hasFields[0] |= uint64(0x00000002)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("application")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDeleteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10716,14 +18237,13 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10733,16 +18253,29 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Cascade = &b
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PropagationPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10771,9 +18304,10 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.PropagationPolicy = &s
+			m.Action = &s
 			iNdEx = postIndex
-		case 4:
+			hasFields[0] |= uint64(0x00000010)
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -10806,7 +18340,7 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -10839,65 +18373,11 @@ func (m *ApplicationDeleteRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SyncOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceActionParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10907,23 +18387,25 @@ func (m *SyncOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, string(dAtA[iNdEx:postIndex]))
+			m.ResourceActionParameters = append(m.ResourceActionParameters, &ResourceActionParameters{})
+			if err := m.ResourceActionParameters[len(m.ResourceActionParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10941,14 +18423,28 @@ func (m *SyncOptions) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10971,17 +18467,17 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10991,106 +18487,83 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Actions = append(m.Actions, &v1alpha1.ResourceAction{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Revision = &s
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceActionBulkRunRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			b := bool(v != 0)
-			m.DryRun = &b
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			b := bool(v != 0)
-			m.Prune = &b
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceActionBulkRunRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceActionBulkRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11100,33 +18573,31 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &v1alpha1.SyncStrategy{}
-			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
 			iNdEx = postIndex
-		case 7:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11136,29 +18607,28 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, &v1alpha1.SyncOperationResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11186,13 +18656,14 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11202,31 +18673,30 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Infos = append(m.Infos, &v1alpha1.Info{})
-			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Group = &s
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11236,33 +18706,31 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &v1alpha1.RetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
 			iNdEx = postIndex
-		case 11:
+			hasFields[0] |= uint64(0x00000002)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11272,31 +18740,28 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncOptions == nil {
-				m.SyncOptions = &SyncOptions{}
-			}
-			if err := m.SyncOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
 			iNdEx = postIndex
-		case 12:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11325,11 +18790,11 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.LabelSelector = &s
 			iNdEx = postIndex
-		case 13:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11358,89 +18823,14 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Action = &s
 			iNdEx = postIndex
-		case 14:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SourcePositions = append(m.SourcePositions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApplication
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthApplication
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SourcePositions) == 0 {
-					m.SourcePositions = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SourcePositions = append(m.SourcePositions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
-			}
-		case 15:
+			hasFields[0] |= uint64(0x00000004)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceActionParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11450,23 +18840,25 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			m.ResourceActionParameters = append(m.ResourceActionParameters, &ResourceActionParameters{})
+			if err := m.ResourceActionParameters[len(m.ResourceActionParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11487,14 +18879,19 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ResourceActionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11517,15 +18914,15 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationUpdateSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11554,14 +18951,13 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Group = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11571,34 +18967,30 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &v1alpha1.ApplicationSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Version = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11608,16 +19000,28 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Validate = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Kind = &s
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11646,11 +19050,11 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.Namespace = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11679,7 +19083,40 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Name = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Error = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11697,20 +19134,13 @@ func (m *ApplicationUpdateSpecRequest) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ResourceActionBulkRunResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11733,17 +19163,17 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceActionBulkRunResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceActionBulkRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -11753,130 +19183,81 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Results = append(m.Results, &ResourceActionResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Patch = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.PatchType = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11905,8 +19286,9 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Manifest = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -11924,13 +19306,7 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
 	}
 
 	if iNdEx > l {
@@ -11938,7 +19314,7 @@ func (m *ApplicationPatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -11962,10 +19338,10 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationRollbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12003,10 +19379,10 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -12016,58 +19392,28 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Id = &v
-			hasFields[0] |= uint64(0x00000002)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			b := bool(v != 0)
-			m.DryRun = &b
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prune", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.Prune = &b
-		case 6:
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12096,11 +19442,11 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.PodName = &s
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12129,71 +19475,33 @@ func (m *ApplicationRollbackRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Container = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SinceSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -12203,29 +19511,72 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			if m.SinceTime == nil {
+				m.SinceTime = &v1.Time{}
+			}
+			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TailLines = &v
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Follow = &b
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12254,11 +19605,11 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.UntilTime = &s
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12287,12 +19638,11 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Filter = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12321,10 +19671,9 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 5:
+			m.Kind = &s
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
@@ -12357,9 +19706,9 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Group = &s
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12388,10 +19737,30 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.ResourceName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 7:
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Previous = &b
+		case 15:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -12424,7 +19793,7 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 8:
+		case 16:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -12457,6 +19826,69 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Project = &s
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchCase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.MatchCase = &b
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllContainers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.AllContainers = &b
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Regex = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -12476,22 +19908,13 @@ func (m *ApplicationResourceRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -12515,15 +19938,15 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourcePatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12552,14 +19975,14 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Content = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -12569,30 +19992,34 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			if m.TimeStamp == nil {
+				m.TimeStamp = &v1.Time{}
+			}
+			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -12602,29 +20029,17 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
+			b := bool(v != 0)
+			m.Last = &b
+			hasFields[0] |= uint64(0x00000004)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeStampStr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12653,12 +20068,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.TimeStampStr = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
+			hasFields[0] |= uint64(0x00000008)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12687,11 +20102,12 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.PodName = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000010)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12720,46 +20136,78 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.ContainerName = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
+	}
+	if hasFields[0]&uint64(0x00000010) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Patch = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PatchType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12788,10 +20236,10 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.PatchType = &s
+			m.Name = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000020)
-		case 9:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -12824,7 +20272,7 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -12876,28 +20324,13 @@ func (m *ApplicationResourcePatchRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	}
-	if hasFields[0]&uint64(0x00000020) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -12921,10 +20354,10 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12963,7 +20396,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12992,11 +20425,11 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13025,79 +20458,66 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationMetricsHistoryQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationMetricsHistoryQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationMetricsHistoryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13126,52 +20546,10 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Name = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Force = &b
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orphan", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Orphan = &b
-		case 9:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
@@ -13204,7 +20582,7 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -13256,22 +20634,13 @@ func (m *ApplicationResourceDeleteRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -13291,21 +20660,55 @@ func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionParameters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
+			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13315,31 +20718,74 @@ func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
+			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CanSync = &b
 			hasFields[0] |= uint64(0x00000001)
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanAutoSync", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.CanAutoSync = &b
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransition", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13349,26 +20795,28 @@ func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			if m.NextTransition == nil {
+				m.NextTransition = &v1.Time{}
+			}
+			if err := m.NextTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -13386,10 +20834,7 @@ func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("value")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
 	}
 
 	if iNdEx > l {
@@ -13397,7 +20842,7 @@ func (m *ResourceActionParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -13421,15 +20866,15 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionRunRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13458,12 +20903,12 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Kind = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13492,11 +20937,12 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.Schedule = &s
 			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13525,14 +20971,14 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Duration = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
+			hasFields[0] |= uint64(0x00000004)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13542,29 +20988,17 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
+			b := bool(v != 0)
+			m.ManualSync = &b
+			hasFields[0] |= uint64(0x00000008)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13593,13 +21027,13 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.TimeZone = &s
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13609,31 +21043,18 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
+			b := bool(v != 0)
+			m.Active = &b
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextTransition", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13643,31 +21064,33 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Action = &s
+			if m.NextTransition == nil {
+				m.NextTransition = &v1.Time{}
+			}
+			if err := m.NextTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocked", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -13677,58 +21100,76 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+			b := bool(v != 0)
+			m.Unlocked = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -13745,28 +21186,13 @@ func (m *ResourceActionRunRequest) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
+func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -13790,15 +21216,15 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionRunRequestV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionRunRequestV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13827,7 +21253,7 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.ApplicationName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
@@ -13865,7 +21291,7 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13894,9 +21320,8 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Name = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
@@ -13930,7 +21355,6 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Version = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
@@ -13997,10 +21421,9 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Kind = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14029,12 +21452,11 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Action = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14063,13 +21485,67 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.Project = &s
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDeletePreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDeletePreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDeletePreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14079,28 +21555,29 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Resources = append(m.Resources, &v1alpha1.ResourceRef{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceActionParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14127,82 +21604,50 @@ func (m *ResourceActionRunRequestV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceActionParameters = append(m.ResourceActionParameters, &ResourceActionParameters{})
-			if err := m.ResourceActionParameters[len(m.ResourceActionParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dependents = append(m.Dependents, &v1alpha1.ResourceRef{})
+			if err := m.Dependents[len(m.Dependents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retained", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("resourceName")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("version")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("action")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Retained = append(m.Retained, &v1alpha1.ResourceRef{})
+			if err := m.Retained[len(m.Retained)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceActionsListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PropagationPolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14212,25 +21657,24 @@ func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, &v1alpha1.ResourceAction{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PropagationPolicy = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14254,8 +21698,7 @@ func (m *ResourceActionsListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14278,17 +21721,17 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationResourceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14298,26 +21741,26 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Manifest = &s
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14334,16 +21777,13 @@ func (m *ApplicationResourceResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manifest")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -14367,15 +21807,15 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPodLogsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14404,12 +21844,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.AppName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14438,11 +21878,11 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14471,13 +21911,13 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.PodName = &s
+			m.Project = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14487,50 +21927,31 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Container = &s
+			m.LiveResources = append(m.LiveResources, &v1alpha1.ResourceDiff{})
+			if err := m.LiveResources[len(m.LiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SinceSeconds = &v
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SinceTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetManifests", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14540,74 +21961,84 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SinceTime == nil {
-				m.SinceTime = &v1.Time{}
-			}
-			if err := m.SinceTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetManifests = append(m.TargetManifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TailLines", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
 			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TailLines = &v
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			b := bool(v != 0)
-			m.Follow = &b
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UntilTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14617,30 +22048,31 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.UntilTime = &s
+			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14650,28 +22082,72 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
+			b := bool(v != 0)
+			m.Modified = &b
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Filter = &s
-			iNdEx = postIndex
-		case 11:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinkInfo) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14700,11 +22176,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Title = &s
 			iNdEx = postIndex
-		case 12:
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14733,11 +22210,12 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Url = &s
 			iNdEx = postIndex
-		case 13:
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14766,32 +22244,11 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ResourceName = &s
+			m.Description = &s
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Previous = &b
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14820,13 +22277,70 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.IconClass = &s
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LinksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -14836,46 +22350,26 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
-			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchCase", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Items = append(m.Items, &LinkInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := bool(v != 0)
-			m.MatchCase = &b
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -14892,16 +22386,13 @@ func (m *ApplicationPodLogsQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *LogEntry) Unmarshal(dAtA []byte) error {
+func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -14925,15 +22416,15 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAppLinksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAppLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14962,71 +22453,12 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Content = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeStamp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TimeStamp == nil {
-				m.TimeStamp = &v1.Time{}
-			}
-			if err := m.TimeStamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Last = &b
-			hasFields[0] |= uint64(0x00000004)
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeStampStr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15055,12 +22487,11 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.TimeStampStr = &s
+			m.Namespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000008)
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15089,9 +22520,8 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.PodName = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000010)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15101,27 +22531,15 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("content")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStamp")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("last")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("timeStampStr")
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if hasFields[0]&uint64(0x00000010) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("podName")
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
 
 	if iNdEx > l {
@@ -15129,7 +22547,7 @@ func (m *LogEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSnapshotQuery) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15153,10 +22571,10 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15284,7 +22702,7 @@ func (m *OperationTerminateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSnapshot) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15308,15 +22726,15 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15345,12 +22763,48 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Id = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15379,11 +22833,11 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.CreatedBy = &s
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15411,8 +22865,41 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &v1alpha1.ResourceRef{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15431,7 +22918,7 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
 	}
 
 	if iNdEx > l {
@@ -15439,8 +22926,7 @@ func (m *ApplicationSyncWindowsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ApplicationSnapshotList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15463,49 +22949,15 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveWindows", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ActiveWindows = append(m.ActiveWindows, &ApplicationSyncWindow{})
-			if err := m.ActiveWindows[len(m.ActiveWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedWindows", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15532,33 +22984,11 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedWindows = append(m.AssignedWindows, &ApplicationSyncWindow{})
-			if err := m.AssignedWindows[len(m.AssignedWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &ApplicationSnapshot{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSync", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.CanSync = &b
-			hasFields[0] |= uint64(0x00000001)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15575,16 +23005,13 @@ func (m *ApplicationSyncWindowsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("canSync")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSnapshotRestoreRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -15608,15 +23035,15 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSyncWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15645,12 +23072,12 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15679,12 +23106,11 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Schedule = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15713,14 +23139,13 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Duration = &s
+			m.Project = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000004)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualSync", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -15730,77 +23155,47 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ManualSync = &b
-			hasFields[0] |= uint64(0x00000008)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("kind")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("schedule")
-	}
-	if hasFields[0]&uint64(0x00000004) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("duration")
-	}
-	if hasFields[0]&uint64(0x00000008) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OperationTerminateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OperationTerminateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			b := bool(v != 0)
+			m.DryRun = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -15817,14 +23212,19 @@ func (m *OperationTerminateResponse) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
+func (m *ApplicationSnapshotRestoreResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15847,15 +23247,15 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourcesQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourcesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15884,12 +23284,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ApplicationName = &s
+			m.Group = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15918,11 +23317,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.Version = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15951,11 +23350,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Kind = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15984,11 +23383,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Version = &s
+			m.Namespace = &s
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16017,11 +23416,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Group = &s
+			m.Name = &s
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16050,44 +23449,11 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Kind = &s
+			m.Operation = &s
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16116,7 +23482,7 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Error = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16134,16 +23500,13 @@ func (m *ResourcesQuery) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("applicationName")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
 }
-func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSnapshotRestoreResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16166,15 +23529,15 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedResourcesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotRestoreResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16201,8 +23564,8 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &ApplicationSnapshotRestoreResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16228,7 +23591,7 @@ func (m *ManagedResourcesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSnapshotDeleteRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -16252,15 +23615,15 @@ func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSnapshotDeleteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationServerSideDiffQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSnapshotDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16289,7 +23652,7 @@ func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppName = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
@@ -16360,41 +23723,7 @@ func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiveResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiveResources = append(m.LiveResources, &v1alpha1.ResourceDiff{})
-			if err := m.LiveResources[len(m.LiveResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetManifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16415,126 +23744,17 @@ func (m *ApplicationServerSideDiffQuery) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TargetManifests = append(m.TargetManifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationServerSideDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, &v1alpha1.ResourceDiff{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Modified = &b
-			hasFields[0] |= uint64(0x00000001)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Id = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -16552,7 +23772,10 @@ func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("modified")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
 	}
 
 	if iNdEx > l {
@@ -16560,7 +23783,7 @@ func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LinkInfo) Unmarshal(dAtA []byte) error {
+func (m *ApplicationAgentStatusRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -16584,15 +23807,15 @@ func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationAgentStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationAgentStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16621,12 +23844,12 @@ func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Title = &s
+			m.Name = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16655,12 +23878,11 @@ func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Url = &s
+			m.AppNamespace = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000002)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16689,11 +23911,11 @@ func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Description = &s
+			m.Project = &s
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16722,68 +23944,79 @@ func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.IconClass = &s
+			m.Cluster = &s
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			hasFields[0] |= uint64(0x00000002)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("title")
-	}
-	if hasFields[0]&uint64(0x00000002) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("url")
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LinksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LinksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SyncStatus = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16810,66 +24043,50 @@ func (m *LinksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &LinkInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &v1alpha1.HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplication(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplication
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
-	var hasFields [1]uint64
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplication
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Resources = append(m.Resources, &v1alpha1.ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListAppLinksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAppLinksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16898,12 +24115,11 @@ func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.Error = &s
 			iNdEx = postIndex
-			hasFields[0] |= uint64(0x00000001)
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationPhase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16932,11 +24148,11 @@ func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
+			m.OperationPhase = &s
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationMessage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16965,8 +24181,29 @@ func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.OperationMessage = &s
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ResourcesDeleted = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -16986,6 +24223,12 @@ func (m *ListAppLinksRequest) Unmarshal(dAtA []byte) error {
 	if hasFields[0]&uint64(0x00000001) == 0 {
 		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
 	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("cluster")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("syncStatus")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
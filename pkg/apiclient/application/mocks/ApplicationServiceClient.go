@@ -129,6 +129,93 @@ func (_c *ApplicationServiceClient_Create_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// CreateSnapshot provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) CreateSnapshot(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption) (*application.ApplicationSnapshot, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSnapshot")
+	}
+
+	var r0 *application.ApplicationSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) (*application.ApplicationSnapshot, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) *application.ApplicationSnapshot); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*application.ApplicationSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type ApplicationServiceClient_CreateSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationSnapshotQuery
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) CreateSnapshot(ctx any, in any, opts ...any) *ApplicationServiceClient_CreateSnapshot_Call {
+	return &ApplicationServiceClient_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_CreateSnapshot_Call) Run(run func(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_CreateSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationSnapshotQuery
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationSnapshotQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_CreateSnapshot_Call) Return(applicationSnapshot *application.ApplicationSnapshot, err error) *ApplicationServiceClient_CreateSnapshot_Call {
+	_c.Call.Return(applicationSnapshot, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_CreateSnapshot_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption) (*application.ApplicationSnapshot, error)) *ApplicationServiceClient_CreateSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) Delete(ctx context.Context, in *application.ApplicationDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error) {
 	// grpc.CallOption
@@ -216,6 +303,93 @@ func (_c *ApplicationServiceClient_Delete_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// DeletePreview provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) DeletePreview(ctx context.Context, in *application.ApplicationDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationDeletePreviewResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePreview")
+	}
+
+	var r0 *application.ApplicationDeletePreviewResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationDeleteRequest, ...grpc.CallOption) (*application.ApplicationDeletePreviewResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationDeleteRequest, ...grpc.CallOption) *application.ApplicationDeletePreviewResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*application.ApplicationDeletePreviewResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationDeleteRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_DeletePreview_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePreview'
+type ApplicationServiceClient_DeletePreview_Call struct {
+	*mock.Call
+}
+
+// DeletePreview is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationDeleteRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) DeletePreview(ctx any, in any, opts ...any) *ApplicationServiceClient_DeletePreview_Call {
+	return &ApplicationServiceClient_DeletePreview_Call{Call: _e.mock.On("DeletePreview",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_DeletePreview_Call) Run(run func(ctx context.Context, in *application.ApplicationDeleteRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_DeletePreview_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationDeleteRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_DeletePreview_Call) Return(applicationDeletePreviewResponse *application.ApplicationDeletePreviewResponse, err error) *ApplicationServiceClient_DeletePreview_Call {
+	_c.Call.Return(applicationDeletePreviewResponse, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_DeletePreview_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationDeletePreviewResponse, error)) *ApplicationServiceClient_DeletePreview_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteResource provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) DeleteResource(ctx context.Context, in *application.ApplicationResourceDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error) {
 	// grpc.CallOption
@@ -303,6 +477,93 @@ func (_c *ApplicationServiceClient_DeleteResource_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// DeleteSnapshot provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) DeleteSnapshot(ctx context.Context, in *application.ApplicationSnapshotDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSnapshot")
+	}
+
+	var r0 *application.ApplicationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotDeleteRequest, ...grpc.CallOption) (*application.ApplicationResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotDeleteRequest, ...grpc.CallOption) *application.ApplicationResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*application.ApplicationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationSnapshotDeleteRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_DeleteSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSnapshot'
+type ApplicationServiceClient_DeleteSnapshot_Call struct {
+	*mock.Call
+}
+
+// DeleteSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationSnapshotDeleteRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) DeleteSnapshot(ctx any, in any, opts ...any) *ApplicationServiceClient_DeleteSnapshot_Call {
+	return &ApplicationServiceClient_DeleteSnapshot_Call{Call: _e.mock.On("DeleteSnapshot",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_DeleteSnapshot_Call) Run(run func(ctx context.Context, in *application.ApplicationSnapshotDeleteRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_DeleteSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationSnapshotDeleteRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationSnapshotDeleteRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_DeleteSnapshot_Call) Return(applicationResponse *application.ApplicationResponse, err error) *ApplicationServiceClient_DeleteSnapshot_Call {
+	_c.Call.Return(applicationResponse, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_DeleteSnapshot_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationSnapshotDeleteRequest, opts ...grpc.CallOption) (*application.ApplicationResponse, error)) *ApplicationServiceClient_DeleteSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) Get(ctx context.Context, in *application.ApplicationQuery, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
 	// grpc.CallOption
@@ -1254,8 +1515,8 @@ func (_c *ApplicationServiceClient_ListResourceLinks_Call) RunAndReturn(run func
 	return _c
 }
 
-// ManagedResources provides a mock function for the type ApplicationServiceClient
-func (_mock *ApplicationServiceClient) ManagedResources(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error) {
+// ListSnapshots provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) ListSnapshots(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption) (*application.ApplicationSnapshotList, error) {
 	// grpc.CallOption
 	_va := make([]any, len(opts))
 	for _i := range opts {
@@ -1267,22 +1528,22 @@ func (_mock *ApplicationServiceClient) ManagedResources(ctx context.Context, in
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ManagedResources")
+		panic("no return value specified for ListSnapshots")
 	}
 
-	var r0 *application.ManagedResourcesResponse
+	var r0 *application.ApplicationSnapshotList
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) (*application.ManagedResourcesResponse, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) (*application.ApplicationSnapshotList, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) *application.ManagedResourcesResponse); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) *application.ApplicationSnapshotList); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*application.ManagedResourcesResponse)
+			r0 = ret.Get(0).(*application.ApplicationSnapshotList)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationSnapshotQuery, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1290,29 +1551,29 @@ func (_mock *ApplicationServiceClient) ManagedResources(ctx context.Context, in
 	return r0, r1
 }
 
-// ApplicationServiceClient_ManagedResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManagedResources'
-type ApplicationServiceClient_ManagedResources_Call struct {
+// ApplicationServiceClient_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type ApplicationServiceClient_ListSnapshots_Call struct {
 	*mock.Call
 }
 
-// ManagedResources is a helper method to define mock.On call
+// ListSnapshots is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *application.ResourcesQuery
+//   - in *application.ApplicationSnapshotQuery
 //   - opts ...grpc.CallOption
-func (_e *ApplicationServiceClient_Expecter) ManagedResources(ctx any, in any, opts ...any) *ApplicationServiceClient_ManagedResources_Call {
-	return &ApplicationServiceClient_ManagedResources_Call{Call: _e.mock.On("ManagedResources",
+func (_e *ApplicationServiceClient_Expecter) ListSnapshots(ctx any, in any, opts ...any) *ApplicationServiceClient_ListSnapshots_Call {
+	return &ApplicationServiceClient_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots",
 		append([]any{ctx, in}, opts...)...)}
 }
 
-func (_c *ApplicationServiceClient_ManagedResources_Call) Run(run func(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_ManagedResources_Call {
+func (_c *ApplicationServiceClient_ListSnapshots_Call) Run(run func(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_ListSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *application.ResourcesQuery
+		var arg1 *application.ApplicationSnapshotQuery
 		if args[1] != nil {
-			arg1 = args[1].(*application.ResourcesQuery)
+			arg1 = args[1].(*application.ApplicationSnapshotQuery)
 		}
 		var arg2 []grpc.CallOption
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
@@ -1331,18 +1592,18 @@ func (_c *ApplicationServiceClient_ManagedResources_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *ApplicationServiceClient_ManagedResources_Call) Return(managedResourcesResponse *application.ManagedResourcesResponse, err error) *ApplicationServiceClient_ManagedResources_Call {
-	_c.Call.Return(managedResourcesResponse, err)
+func (_c *ApplicationServiceClient_ListSnapshots_Call) Return(applicationSnapshotList *application.ApplicationSnapshotList, err error) *ApplicationServiceClient_ListSnapshots_Call {
+	_c.Call.Return(applicationSnapshotList, err)
 	return _c
 }
 
-func (_c *ApplicationServiceClient_ManagedResources_Call) RunAndReturn(run func(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error)) *ApplicationServiceClient_ManagedResources_Call {
+func (_c *ApplicationServiceClient_ListSnapshots_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationSnapshotQuery, opts ...grpc.CallOption) (*application.ApplicationSnapshotList, error)) *ApplicationServiceClient_ListSnapshots_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Patch provides a mock function for the type ApplicationServiceClient
-func (_mock *ApplicationServiceClient) Patch(ctx context.Context, in *application.ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+// ManagedResources provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) ManagedResources(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error) {
 	// grpc.CallOption
 	_va := make([]any, len(opts))
 	for _i := range opts {
@@ -1354,22 +1615,22 @@ func (_mock *ApplicationServiceClient) Patch(ctx context.Context, in *applicatio
 	ret := _mock.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Patch")
+		panic("no return value specified for ManagedResources")
 	}
 
-	var r0 *v1alpha1.Application
+	var r0 *application.ManagedResourcesResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) (*v1alpha1.Application, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) (*application.ManagedResourcesResponse, error)); ok {
 		return returnFunc(ctx, in, opts...)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) *v1alpha1.Application); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) *application.ManagedResourcesResponse); ok {
 		r0 = returnFunc(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*v1alpha1.Application)
+			r0 = ret.Get(0).(*application.ManagedResourcesResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ResourcesQuery, ...grpc.CallOption) error); ok {
 		r1 = returnFunc(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1377,17 +1638,191 @@ func (_mock *ApplicationServiceClient) Patch(ctx context.Context, in *applicatio
 	return r0, r1
 }
 
-// ApplicationServiceClient_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
-type ApplicationServiceClient_Patch_Call struct {
+// ApplicationServiceClient_ManagedResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManagedResources'
+type ApplicationServiceClient_ManagedResources_Call struct {
 	*mock.Call
 }
 
-// Patch is a helper method to define mock.On call
+// ManagedResources is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *application.ApplicationPatchRequest
+//   - in *application.ResourcesQuery
 //   - opts ...grpc.CallOption
-func (_e *ApplicationServiceClient_Expecter) Patch(ctx any, in any, opts ...any) *ApplicationServiceClient_Patch_Call {
-	return &ApplicationServiceClient_Patch_Call{Call: _e.mock.On("Patch",
+func (_e *ApplicationServiceClient_Expecter) ManagedResources(ctx any, in any, opts ...any) *ApplicationServiceClient_ManagedResources_Call {
+	return &ApplicationServiceClient_ManagedResources_Call{Call: _e.mock.On("ManagedResources",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_ManagedResources_Call) Run(run func(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_ManagedResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ResourcesQuery
+		if args[1] != nil {
+			arg1 = args[1].(*application.ResourcesQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_ManagedResources_Call) Return(managedResourcesResponse *application.ManagedResourcesResponse, err error) *ApplicationServiceClient_ManagedResources_Call {
+	_c.Call.Return(managedResourcesResponse, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_ManagedResources_Call) RunAndReturn(run func(ctx context.Context, in *application.ResourcesQuery, opts ...grpc.CallOption) (*application.ManagedResourcesResponse, error)) *ApplicationServiceClient_ManagedResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MetricsHistory provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) MetricsHistory(ctx context.Context, in *application.ApplicationMetricsHistoryQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MetricsHistory")
+	}
+
+	var r0 *v1alpha1.ApplicationMetricsHistory
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationMetricsHistoryQuery, ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationMetricsHistoryQuery, ...grpc.CallOption) *v1alpha1.ApplicationMetricsHistory); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.ApplicationMetricsHistory)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationMetricsHistoryQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_MetricsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MetricsHistory'
+type ApplicationServiceClient_MetricsHistory_Call struct {
+	*mock.Call
+}
+
+// MetricsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationMetricsHistoryQuery
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) MetricsHistory(ctx any, in any, opts ...any) *ApplicationServiceClient_MetricsHistory_Call {
+	return &ApplicationServiceClient_MetricsHistory_Call{Call: _e.mock.On("MetricsHistory",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_MetricsHistory_Call) Run(run func(ctx context.Context, in *application.ApplicationMetricsHistoryQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_MetricsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationMetricsHistoryQuery
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationMetricsHistoryQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_MetricsHistory_Call) Return(applicationMetricsHistory *v1alpha1.ApplicationMetricsHistory, err error) *ApplicationServiceClient_MetricsHistory_Call {
+	_c.Call.Return(applicationMetricsHistory, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_MetricsHistory_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationMetricsHistoryQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationMetricsHistory, error)) *ApplicationServiceClient_MetricsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Patch provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) Patch(ctx context.Context, in *application.ApplicationPatchRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Patch")
+	}
+
+	var r0 *v1alpha1.Application
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) (*v1alpha1.Application, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) *v1alpha1.Application); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Application)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationPatchRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_Patch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Patch'
+type ApplicationServiceClient_Patch_Call struct {
+	*mock.Call
+}
+
+// Patch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationPatchRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) Patch(ctx any, in any, opts ...any) *ApplicationServiceClient_Patch_Call {
+	return &ApplicationServiceClient_Patch_Call{Call: _e.mock.On("Patch",
 		append([]any{ctx, in}, opts...)...)}
 }
 
@@ -1428,6 +1863,93 @@ func (_c *ApplicationServiceClient_Patch_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// PatchParameters provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) PatchParameters(ctx context.Context, in *application.ApplicationPatchParametersRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PatchParameters")
+	}
+
+	var r0 *v1alpha1.Application
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchParametersRequest, ...grpc.CallOption) (*v1alpha1.Application, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationPatchParametersRequest, ...grpc.CallOption) *v1alpha1.Application); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Application)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationPatchParametersRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_PatchParameters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PatchParameters'
+type ApplicationServiceClient_PatchParameters_Call struct {
+	*mock.Call
+}
+
+// PatchParameters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationPatchParametersRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) PatchParameters(ctx any, in any, opts ...any) *ApplicationServiceClient_PatchParameters_Call {
+	return &ApplicationServiceClient_PatchParameters_Call{Call: _e.mock.On("PatchParameters",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_PatchParameters_Call) Run(run func(ctx context.Context, in *application.ApplicationPatchParametersRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_PatchParameters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationPatchParametersRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationPatchParametersRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_PatchParameters_Call) Return(application1 *v1alpha1.Application, err error) *ApplicationServiceClient_PatchParameters_Call {
+	_c.Call.Return(application1, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_PatchParameters_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationPatchParametersRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)) *ApplicationServiceClient_PatchParameters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PatchResource provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) PatchResource(ctx context.Context, in *application.ApplicationResourcePatchRequest, opts ...grpc.CallOption) (*application.ApplicationResourceResponse, error) {
 	// grpc.CallOption
@@ -1689,6 +2211,93 @@ func (_c *ApplicationServiceClient_ResourceTree_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
+// RestoreSnapshot provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) RestoreSnapshot(ctx context.Context, in *application.ApplicationSnapshotRestoreRequest, opts ...grpc.CallOption) (*application.ApplicationSnapshotRestoreResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreSnapshot")
+	}
+
+	var r0 *application.ApplicationSnapshotRestoreResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotRestoreRequest, ...grpc.CallOption) (*application.ApplicationSnapshotRestoreResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationSnapshotRestoreRequest, ...grpc.CallOption) *application.ApplicationSnapshotRestoreResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*application.ApplicationSnapshotRestoreResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationSnapshotRestoreRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type ApplicationServiceClient_RestoreSnapshot_Call struct {
+	*mock.Call
+}
+
+// RestoreSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationSnapshotRestoreRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) RestoreSnapshot(ctx any, in any, opts ...any) *ApplicationServiceClient_RestoreSnapshot_Call {
+	return &ApplicationServiceClient_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_RestoreSnapshot_Call) Run(run func(ctx context.Context, in *application.ApplicationSnapshotRestoreRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_RestoreSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationSnapshotRestoreRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationSnapshotRestoreRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RestoreSnapshot_Call) Return(applicationSnapshotRestoreResponse *application.ApplicationSnapshotRestoreResponse, err error) *ApplicationServiceClient_RestoreSnapshot_Call {
+	_c.Call.Return(applicationSnapshotRestoreResponse, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RestoreSnapshot_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationSnapshotRestoreRequest, opts ...grpc.CallOption) (*application.ApplicationSnapshotRestoreResponse, error)) *ApplicationServiceClient_RestoreSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevisionChartDetails provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) RevisionChartDetails(ctx context.Context, in *application.RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.ChartDetails, error) {
 	// grpc.CallOption
@@ -1776,6 +2385,93 @@ func (_c *ApplicationServiceClient_RevisionChartDetails_Call) RunAndReturn(run f
 	return _c
 }
 
+// RevisionComparison provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) RevisionComparison(ctx context.Context, in *application.RevisionComparisonQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevisionComparison")
+	}
+
+	var r0 *v1alpha1.RevisionComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.RevisionComparisonQuery, ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.RevisionComparisonQuery, ...grpc.CallOption) *v1alpha1.RevisionComparison); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevisionComparison)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.RevisionComparisonQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_RevisionComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevisionComparison'
+type ApplicationServiceClient_RevisionComparison_Call struct {
+	*mock.Call
+}
+
+// RevisionComparison is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.RevisionComparisonQuery
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) RevisionComparison(ctx any, in any, opts ...any) *ApplicationServiceClient_RevisionComparison_Call {
+	return &ApplicationServiceClient_RevisionComparison_Call{Call: _e.mock.On("RevisionComparison",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_RevisionComparison_Call) Run(run func(ctx context.Context, in *application.RevisionComparisonQuery, opts ...grpc.CallOption)) *ApplicationServiceClient_RevisionComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.RevisionComparisonQuery
+		if args[1] != nil {
+			arg1 = args[1].(*application.RevisionComparisonQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RevisionComparison_Call) Return(chartDetails *v1alpha1.RevisionComparison, err error) *ApplicationServiceClient_RevisionComparison_Call {
+	_c.Call.Return(chartDetails, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RevisionComparison_Call) RunAndReturn(run func(ctx context.Context, in *application.RevisionComparisonQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)) *ApplicationServiceClient_RevisionComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RevisionMetadata provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) RevisionMetadata(ctx context.Context, in *application.RevisionMetadataQuery, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
 	// grpc.CallOption
@@ -2037,6 +2733,93 @@ func (_c *ApplicationServiceClient_RunResourceAction_Call) RunAndReturn(run func
 	return _c
 }
 
+// RunResourceActionBulk provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) RunResourceActionBulk(ctx context.Context, in *application.ResourceActionBulkRunRequest, opts ...grpc.CallOption) (*application.ResourceActionBulkRunResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunResourceActionBulk")
+	}
+
+	var r0 *application.ResourceActionBulkRunResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourceActionBulkRunRequest, ...grpc.CallOption) (*application.ResourceActionBulkRunResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ResourceActionBulkRunRequest, ...grpc.CallOption) *application.ResourceActionBulkRunResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*application.ResourceActionBulkRunResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ResourceActionBulkRunRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_RunResourceActionBulk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunResourceActionBulk'
+type ApplicationServiceClient_RunResourceActionBulk_Call struct {
+	*mock.Call
+}
+
+// RunResourceActionBulk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ResourceActionBulkRunRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) RunResourceActionBulk(ctx any, in any, opts ...any) *ApplicationServiceClient_RunResourceActionBulk_Call {
+	return &ApplicationServiceClient_RunResourceActionBulk_Call{Call: _e.mock.On("RunResourceActionBulk",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_RunResourceActionBulk_Call) Run(run func(ctx context.Context, in *application.ResourceActionBulkRunRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_RunResourceActionBulk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ResourceActionBulkRunRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ResourceActionBulkRunRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RunResourceActionBulk_Call) Return(resourceActionBulkRunResponse *application.ResourceActionBulkRunResponse, err error) *ApplicationServiceClient_RunResourceActionBulk_Call {
+	_c.Call.Return(resourceActionBulkRunResponse, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_RunResourceActionBulk_Call) RunAndReturn(run func(ctx context.Context, in *application.ResourceActionBulkRunRequest, opts ...grpc.CallOption) (*application.ResourceActionBulkRunResponse, error)) *ApplicationServiceClient_RunResourceActionBulk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunResourceActionV2 provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) RunResourceActionV2(ctx context.Context, in *application.ResourceActionRunRequestV2, opts ...grpc.CallOption) (*application.ApplicationResponse, error) {
 	// grpc.CallOption
@@ -2472,6 +3255,93 @@ func (_c *ApplicationServiceClient_Update_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// UpdateAgentStatus provides a mock function for the type ApplicationServiceClient
+func (_mock *ApplicationServiceClient) UpdateAgentStatus(ctx context.Context, in *application.ApplicationAgentStatusRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentStatus")
+	}
+
+	var r0 *v1alpha1.Application
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationAgentStatusRequest, ...grpc.CallOption) (*v1alpha1.Application, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *application.ApplicationAgentStatusRequest, ...grpc.CallOption) *v1alpha1.Application); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Application)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *application.ApplicationAgentStatusRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ApplicationServiceClient_UpdateAgentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAgentStatus'
+type ApplicationServiceClient_UpdateAgentStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateAgentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *application.ApplicationAgentStatusRequest
+//   - opts ...grpc.CallOption
+func (_e *ApplicationServiceClient_Expecter) UpdateAgentStatus(ctx any, in any, opts ...any) *ApplicationServiceClient_UpdateAgentStatus_Call {
+	return &ApplicationServiceClient_UpdateAgentStatus_Call{Call: _e.mock.On("UpdateAgentStatus",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ApplicationServiceClient_UpdateAgentStatus_Call) Run(run func(ctx context.Context, in *application.ApplicationAgentStatusRequest, opts ...grpc.CallOption)) *ApplicationServiceClient_UpdateAgentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *application.ApplicationAgentStatusRequest
+		if args[1] != nil {
+			arg1 = args[1].(*application.ApplicationAgentStatusRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ApplicationServiceClient_UpdateAgentStatus_Call) Return(applicationSpec *v1alpha1.Application, err error) *ApplicationServiceClient_UpdateAgentStatus_Call {
+	_c.Call.Return(applicationSpec, err)
+	return _c
+}
+
+func (_c *ApplicationServiceClient_UpdateAgentStatus_Call) RunAndReturn(run func(ctx context.Context, in *application.ApplicationAgentStatusRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)) *ApplicationServiceClient_UpdateAgentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateSpec provides a mock function for the type ApplicationServiceClient
 func (_mock *ApplicationServiceClient) UpdateSpec(ctx context.Context, in *application.ApplicationUpdateSpecRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSpec, error) {
 	// grpc.CallOption
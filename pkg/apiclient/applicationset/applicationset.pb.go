@@ -470,10 +470,12 @@ func (m *ApplicationSetTreeQuery) GetAppsetNamespace() string {
 // ApplicationSetGetQuery is a query for applicationset resources
 type ApplicationSetGenerateRequest struct {
 	// the applicationsets
-	ApplicationSet       *v1alpha1.ApplicationSet `protobuf:"bytes,1,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	ApplicationSet *v1alpha1.ApplicationSet `protobuf:"bytes,1,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
+	// dryRun compares the generated applications with the existing applications of the applicationset
+	DryRun               bool     `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationSetGenerateRequest) Reset()         { *m = ApplicationSetGenerateRequest{} }
@@ -516,12 +518,21 @@ func (m *ApplicationSetGenerateRequest) GetApplicationSet() *v1alpha1.Applicatio
 	return nil
 }
 
+func (m *ApplicationSetGenerateRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 // ApplicationSetGenerateResponse is a response for applicationset generate request
 type ApplicationSetGenerateResponse struct {
-	Applications         []*v1alpha1.Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Applications []*v1alpha1.Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	// diffs are the changes the applicationset controller would make to the applications, when dryRun is requested
+	Diffs                []*ApplicationSetApplicationDiff `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
 }
 
 func (m *ApplicationSetGenerateResponse) Reset()         { *m = ApplicationSetGenerateResponse{} }
@@ -564,6 +575,79 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+func (m *ApplicationSetGenerateResponse) GetDiffs() []*ApplicationSetApplicationDiff {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+// ApplicationSetApplicationDiff is a change the applicationset controller would make to an application
+type ApplicationSetApplicationDiff struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// action is one of create, update, delete or unchanged
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	// diff is the unified diff between the existing and the generated application
+	Diff                 string   `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetApplicationDiff) Reset()         { *m = ApplicationSetApplicationDiff{} }
+func (m *ApplicationSetApplicationDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetApplicationDiff) ProtoMessage()    {}
+func (*ApplicationSetApplicationDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetApplicationDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApplicationDiff.Merge(m, src)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApplicationDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApplicationDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApplicationDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetApplicationDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -574,6 +658,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetApplicationDiff)(nil), "applicationset.ApplicationSetApplicationDiff")
 }
 
 func init() {
@@ -581,57 +666,60 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcd, 0x6e, 0x13, 0x3b,
-	0x14, 0xc7, 0xe5, 0xa6, 0xcd, 0x4d, 0xdd, 0xea, 0x5e, 0x5d, 0x4b, 0xb7, 0xcd, 0x1d, 0x20, 0x44,
-	0x23, 0xb5, 0x0d, 0x2d, 0xf1, 0xd0, 0x96, 0x0d, 0x65, 0xc5, 0x97, 0xaa, 0x4a, 0x15, 0x82, 0x09,
-	0x6a, 0x25, 0x58, 0x20, 0x77, 0x72, 0x94, 0x0e, 0x4d, 0x66, 0x06, 0xdb, 0x89, 0x54, 0x55, 0xb0,
-	0x40, 0x62, 0xcd, 0x02, 0xc1, 0x03, 0xc0, 0x86, 0x35, 0xb0, 0x62, 0xc3, 0x82, 0x0d, 0x4b, 0x24,
-	0x5e, 0x00, 0x55, 0x3c, 0x08, 0xb2, 0x67, 0xf2, 0x31, 0x26, 0x1f, 0x95, 0x08, 0xac, 0x12, 0x7b,
-	0xec, 0x73, 0x7e, 0x3e, 0xe7, 0xf8, 0xef, 0x83, 0x97, 0x05, 0xf0, 0x16, 0x70, 0x87, 0x45, 0x51,
-	0xdd, 0xf7, 0x98, 0xf4, 0xc3, 0x40, 0x80, 0x34, 0x86, 0x34, 0xe2, 0xa1, 0x0c, 0xc9, 0xdf, 0xe9,
-	0x59, 0xeb, 0x74, 0x2d, 0x0c, 0x6b, 0x75, 0x70, 0x58, 0xe4, 0x3b, 0x2c, 0x08, 0x42, 0x19, 0x7f,
-	0x89, 0x57, 0x5b, 0xdb, 0x35, 0x5f, 0xee, 0x37, 0xf7, 0xa8, 0x17, 0x36, 0x1c, 0xc6, 0x6b, 0x61,
-	0xc4, 0xc3, 0x07, 0xfa, 0x4f, 0xd9, 0xab, 0x3a, 0xad, 0x75, 0x27, 0x3a, 0xa8, 0xa9, 0x9d, 0xa2,
-	0xd7, 0x97, 0xd3, 0x5a, 0x65, 0xf5, 0x68, 0x9f, 0xad, 0x3a, 0x35, 0x08, 0x80, 0x33, 0x09, 0xd5,
-	0xc4, 0xda, 0xa5, 0x11, 0xd6, 0x92, 0x63, 0x40, 0x0b, 0x02, 0x29, 0x92, 0x9f, 0x78, 0xab, 0xbd,
-	0x83, 0xe7, 0xae, 0x74, 0x5d, 0x54, 0x40, 0x6e, 0x82, 0xbc, 0xdd, 0x04, 0x7e, 0x48, 0x08, 0x9e,
-	0x0c, 0x58, 0x03, 0xf2, 0xa8, 0x88, 0x4a, 0xd3, 0xae, 0xfe, 0x4f, 0x4a, 0xf8, 0x1f, 0x16, 0x45,
-	0x02, 0xe4, 0x4d, 0xd6, 0x00, 0x11, 0x31, 0x0f, 0xf2, 0x13, 0xfa, 0xb3, 0x39, 0x6d, 0x1f, 0xe1,
-	0xf9, 0xb4, 0xdd, 0x6d, 0x5f, 0x24, 0x86, 0x2d, 0x9c, 0x53, 0x80, 0xe0, 0x49, 0x91, 0x47, 0xc5,
-	0x4c, 0x69, 0xda, 0xed, 0x8c, 0xd5, 0x37, 0x01, 0x75, 0xf0, 0x64, 0xc8, 0x13, 0xcb, 0x9d, 0x71,
-	0x3f, 0xe7, 0x99, 0xfe, 0xce, 0x3f, 0x20, 0x9c, 0x4f, 0x7b, 0xdf, 0x65, 0xd2, 0xdb, 0x1f, 0x7c,
-	0xae, 0x5e, 0xa4, 0x89, 0x21, 0x48, 0x99, 0xbe, 0x48, 0x95, 0x5e, 0xa4, 0xc9, 0x0e, 0x52, 0xef,
-	0xb4, 0x5a, 0xc9, 0x41, 0x84, 0x4d, 0xee, 0xc1, 0x0e, 0x70, 0xe1, 0x87, 0x41, 0x7e, 0x2a, 0x5e,
-	0x69, 0x4c, 0xdb, 0x6f, 0x90, 0x99, 0x12, 0x17, 0x44, 0xa4, 0x8a, 0x8a, 0xe4, 0xf1, 0x5f, 0x09,
-	0x56, 0x42, 0xdf, 0x1e, 0x12, 0x89, 0x8d, 0xfa, 0xd3, 0xd1, 0x9b, 0x59, 0xdb, 0xa6, 0xdd, 0xd2,
-	0xa0, 0xed, 0xd2, 0xd0, 0x7f, 0xee, 0x7b, 0x55, 0xda, 0x5a, 0xa7, 0xd1, 0x41, 0x8d, 0xaa, 0x42,
-	0xa3, 0x3d, 0xdb, 0x69, 0xbb, 0xd0, 0xa8, 0xc1, 0x61, 0xf8, 0xb0, 0x3f, 0x21, 0x7c, 0x2a, 0xbd,
-	0xe4, 0x1a, 0x07, 0x26, 0xc1, 0x85, 0x87, 0x4d, 0x10, 0xfd, 0xa8, 0xd0, 0xef, 0xa7, 0x22, 0x73,
-	0x38, 0xdb, 0x8c, 0x04, 0xf0, 0x38, 0x06, 0x39, 0x37, 0x19, 0xa9, 0xf9, 0x2a, 0x3f, 0x74, 0x9b,
-	0x81, 0x4e, 0x63, 0xce, 0x4d, 0x46, 0xf6, 0x3d, 0xf3, 0x10, 0xd7, 0xa1, 0x0e, 0xdd, 0x43, 0xfc,
-	0xda, 0x3d, 0xd8, 0x35, 0xef, 0xc1, 0x1d, 0x0e, 0x30, 0x8e, 0x0b, 0xf6, 0x02, 0xe1, 0x33, 0xe6,
-	0xcd, 0x8d, 0x55, 0xa1, 0x7f, 0xf4, 0x2b, 0x7f, 0x20, 0xfa, 0x15, 0x90, 0xf6, 0x33, 0x84, 0x0b,
-	0x83, 0xb8, 0x92, 0x32, 0x6e, 0xe0, 0xd9, 0xde, 0x94, 0x69, 0x11, 0x98, 0x59, 0xdb, 0x1a, 0x1b,
-	0x96, 0x9b, 0x32, 0xbf, 0xf6, 0x16, 0xe3, 0xff, 0xd2, 0x44, 0x15, 0xe0, 0x2d, 0xdf, 0x03, 0xf2,
-	0x1a, 0xe1, 0xcc, 0x26, 0x48, 0xb2, 0x48, 0x0d, 0x49, 0xef, 0x2f, 0x89, 0xd6, 0x58, 0x23, 0x67,
-	0x2f, 0x3e, 0xf9, 0xfa, 0xfd, 0xf9, 0x44, 0x91, 0x14, 0xf4, 0x1b, 0xd1, 0x5a, 0x35, 0xde, 0x15,
-	0xe1, 0x1c, 0xa9, 0x92, 0x78, 0x44, 0x5e, 0x22, 0x9c, 0x6b, 0xc7, 0x90, 0x94, 0x47, 0xa1, 0xa6,
-	0x6a, 0xc0, 0xa2, 0x27, 0x5d, 0x1e, 0xa7, 0xc6, 0x5e, 0xd1, 0x4c, 0x0b, 0x76, 0x71, 0x10, 0x53,
-	0xfb, 0xe9, 0xd9, 0x40, 0xcb, 0xe4, 0x15, 0xc2, 0x93, 0x4a, 0xd6, 0xc9, 0xd2, 0x70, 0x2f, 0x1d,
-	0xe9, 0xb7, 0x6e, 0x8d, 0x33, 0x80, 0xca, 0xac, 0x7d, 0x56, 0x03, 0xff, 0x4f, 0xe6, 0x07, 0x00,
-	0x93, 0xf7, 0x08, 0x67, 0x63, 0x55, 0x22, 0x2b, 0xc3, 0x31, 0x53, 0xda, 0x35, 0xe6, 0x5c, 0x3b,
-	0x1a, 0xf3, 0x9c, 0x3d, 0x08, 0x73, 0xc3, 0x14, 0xb1, 0xa7, 0x08, 0x67, 0x63, 0x1d, 0x1a, 0x85,
-	0x9d, 0x52, 0x2b, 0x6b, 0x44, 0x29, 0x77, 0x12, 0x9d, 0x14, 0xdf, 0xf2, 0xa8, 0xe2, 0xfb, 0x88,
-	0xf0, 0xac, 0x9b, 0xbc, 0x50, 0x4a, 0xba, 0x46, 0xe5, 0xba, 0x23, 0x6f, 0xe3, 0xcd, 0xb5, 0x32,
-	0x6b, 0x5f, 0xd4, 0xcc, 0x94, 0x9c, 0x1f, 0xce, 0xec, 0xb4, 0x5f, 0xd4, 0xb2, 0x54, 0xc0, 0x8f,
-	0x31, 0x51, 0x95, 0xd2, 0x3e, 0xc4, 0x0d, 0xdd, 0xfd, 0x9c, 0xf8, 0xca, 0xff, 0x4b, 0x93, 0x76,
-	0x49, 0xef, 0xd3, 0x25, 0x57, 0xd6, 0x18, 0x4b, 0x64, 0x61, 0x04, 0x46, 0xbc, 0x91, 0xbc, 0x43,
-	0x78, 0x4a, 0xb7, 0x1f, 0xa4, 0x34, 0xdc, 0x67, 0xb7, 0x47, 0xb1, 0x76, 0xc6, 0x19, 0x3b, 0x6d,
-	0x57, 0xe3, 0xff, 0x2c, 0x39, 0x42, 0x72, 0x60, 0x0d, 0xf3, 0x04, 0x17, 0xd0, 0xd5, 0xad, 0xcf,
-	0xc7, 0x05, 0xf4, 0xe5, 0xb8, 0x80, 0xbe, 0x1d, 0x17, 0xd0, 0xdd, 0xcb, 0x27, 0x6b, 0x57, 0xbd,
-	0xba, 0x0f, 0x81, 0xd9, 0x1f, 0xef, 0x65, 0x75, 0xa7, 0xb9, 0xfe, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0x10, 0xae, 0xd0, 0xd1, 0x4e, 0x0b, 0x00, 0x00,
+	// 848 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0x4f, 0x6b, 0x1b, 0x47,
+	0x14, 0xc0, 0x19, 0xc9, 0x56, 0xe5, 0xb1, 0x69, 0xe9, 0x40, 0x6d, 0x75, 0x5b, 0xab, 0x62, 0xc1,
+	0xb6, 0x6a, 0x57, 0xbb, 0xb5, 0xdc, 0x4b, 0xdd, 0x53, 0x6b, 0x17, 0x63, 0x30, 0xa5, 0x5d, 0x15,
+	0x1b, 0xda, 0x83, 0x19, 0xaf, 0x9e, 0xe4, 0xad, 0xa5, 0xdd, 0xed, 0xcc, 0x48, 0x60, 0x4c, 0x7b,
+	0x28, 0xf4, 0x13, 0x04, 0xf2, 0x01, 0x92, 0x4b, 0x2e, 0xb9, 0x24, 0x39, 0xe5, 0x92, 0x43, 0x2e,
+	0x39, 0x06, 0x92, 0x0f, 0x10, 0x4c, 0x3e, 0x48, 0x98, 0xd9, 0x59, 0x69, 0x77, 0xa3, 0x3f, 0x86,
+	0x28, 0x39, 0x69, 0xdf, 0xe8, 0xcd, 0x7b, 0xbf, 0x79, 0xef, 0xcd, 0x9b, 0x87, 0x37, 0x39, 0xb0,
+	0x3e, 0x30, 0x9b, 0x86, 0x61, 0xc7, 0x73, 0xa9, 0xf0, 0x02, 0x9f, 0x83, 0xc8, 0x88, 0x56, 0xc8,
+	0x02, 0x11, 0x90, 0x8f, 0xd3, 0xab, 0xc6, 0x97, 0xed, 0x20, 0x68, 0x77, 0xc0, 0xa6, 0xa1, 0x67,
+	0x53, 0xdf, 0x0f, 0x44, 0xf4, 0x4f, 0xa4, 0x6d, 0x1c, 0xb5, 0x3d, 0x71, 0xde, 0x3b, 0xb3, 0xdc,
+	0xa0, 0x6b, 0x53, 0xd6, 0x0e, 0x42, 0x16, 0xfc, 0xa5, 0x3e, 0x6a, 0x6e, 0xd3, 0xee, 0xef, 0xd8,
+	0xe1, 0x45, 0x5b, 0xee, 0xe4, 0x49, 0x5f, 0x76, 0x7f, 0x9b, 0x76, 0xc2, 0x73, 0xba, 0x6d, 0xb7,
+	0xc1, 0x07, 0x46, 0x05, 0x34, 0xb5, 0xb5, 0xef, 0xa7, 0x58, 0xd3, 0xc7, 0x80, 0x3e, 0xf8, 0x82,
+	0xeb, 0x9f, 0x68, 0xab, 0x79, 0x8c, 0x97, 0x7f, 0x1c, 0xba, 0x68, 0x80, 0x38, 0x00, 0xf1, 0x5b,
+	0x0f, 0xd8, 0x25, 0x21, 0x78, 0xce, 0xa7, 0x5d, 0x28, 0xa1, 0x0a, 0xaa, 0x2e, 0x38, 0xea, 0x9b,
+	0x54, 0xf1, 0x27, 0x34, 0x0c, 0x39, 0x88, 0x5f, 0x68, 0x17, 0x78, 0x48, 0x5d, 0x28, 0xe5, 0xd4,
+	0xdf, 0xd9, 0x65, 0xf3, 0x0a, 0xaf, 0xa4, 0xed, 0x1e, 0x79, 0x5c, 0x1b, 0x36, 0x70, 0x51, 0x02,
+	0x82, 0x2b, 0x78, 0x09, 0x55, 0xf2, 0xd5, 0x05, 0x67, 0x20, 0xcb, 0xff, 0x38, 0x74, 0xc0, 0x15,
+	0x01, 0xd3, 0x96, 0x07, 0xf2, 0x28, 0xe7, 0xf9, 0xd1, 0xce, 0x1f, 0x23, 0x5c, 0x4a, 0x7b, 0x3f,
+	0xa1, 0xc2, 0x3d, 0x1f, 0x7f, 0xae, 0x24, 0x52, 0x6e, 0x02, 0x52, 0x7e, 0x24, 0x52, 0x23, 0x89,
+	0x34, 0x37, 0x40, 0x4a, 0x2e, 0x4b, 0x4d, 0x06, 0x3c, 0xe8, 0x31, 0x17, 0x8e, 0x81, 0x71, 0x2f,
+	0xf0, 0x4b, 0xf3, 0x91, 0x66, 0x66, 0xd9, 0xbc, 0x87, 0xb2, 0x29, 0x71, 0x80, 0x87, 0xb2, 0xa8,
+	0x48, 0x09, 0x7f, 0xa4, 0xb1, 0x34, 0x7d, 0x2c, 0x12, 0x81, 0x33, 0xf5, 0xa7, 0xa2, 0xb7, 0x58,
+	0x3f, 0xb2, 0x86, 0xa5, 0x61, 0xc5, 0xa5, 0xa1, 0x3e, 0x4e, 0xdd, 0xa6, 0xd5, 0xdf, 0xb1, 0xc2,
+	0x8b, 0xb6, 0x25, 0x0b, 0xcd, 0x4a, 0x6c, 0xb7, 0xe2, 0x42, 0xb3, 0x32, 0x1c, 0x19, 0x1f, 0xe6,
+	0x53, 0x84, 0xbf, 0x48, 0xab, 0xec, 0x31, 0xa0, 0x02, 0x1c, 0xf8, 0xbb, 0x07, 0x7c, 0x14, 0x15,
+	0x7a, 0xff, 0x54, 0x64, 0x19, 0x17, 0x7a, 0x21, 0x07, 0x16, 0xc5, 0xa0, 0xe8, 0x68, 0x49, 0xae,
+	0x37, 0xd9, 0xa5, 0xd3, 0xf3, 0x55, 0x1a, 0x8b, 0x8e, 0x96, 0xcc, 0x3f, 0xb3, 0x87, 0xd8, 0x87,
+	0x0e, 0x0c, 0x0f, 0xf1, 0x6e, 0xf7, 0xe0, 0x24, 0x7b, 0x0f, 0x7e, 0x67, 0x00, 0xb3, 0xb8, 0x60,
+	0xf7, 0x11, 0x5e, 0xcd, 0xde, 0xdc, 0xa8, 0x2b, 0x8c, 0x8e, 0x7e, 0xe3, 0x03, 0x44, 0xbf, 0x01,
+	0xc9, 0x28, 0xe7, 0x52, 0x51, 0x7e, 0x89, 0x70, 0x79, 0x1c, 0xaf, 0x2e, 0xef, 0x2e, 0x5e, 0x4a,
+	0xa6, 0x52, 0x35, 0x87, 0xc5, 0xfa, 0xe1, 0xcc, 0x70, 0x9d, 0x94, 0x79, 0xb2, 0x87, 0xe7, 0x9b,
+	0x5e, 0xab, 0x15, 0xdd, 0xf8, 0xc5, 0x7a, 0xcd, 0xca, 0xf4, 0xf5, 0x34, 0x6d, 0x42, 0xda, 0xf7,
+	0x5a, 0x2d, 0x27, 0xda, 0x6b, 0x9e, 0xe2, 0xd5, 0x89, 0x7a, 0x23, 0xb3, 0xbc, 0x8c, 0x0b, 0xd4,
+	0x95, 0x1a, 0x3a, 0xb9, 0x5a, 0x92, 0xba, 0xd2, 0xaa, 0x6e, 0x33, 0xea, 0xbb, 0xfe, 0x00, 0xe3,
+	0xcf, 0xd2, 0x1e, 0x1a, 0xc0, 0xfa, 0x9e, 0x0b, 0xe4, 0x2e, 0xc2, 0xf9, 0x03, 0x10, 0x64, 0x7d,
+	0x32, 0x78, 0xdc, 0xd0, 0x8d, 0x99, 0xe6, 0xdd, 0x5c, 0xff, 0xef, 0xc5, 0xeb, 0x5b, 0xb9, 0x0a,
+	0x29, 0xab, 0x17, 0xae, 0xbf, 0x9d, 0x79, 0x15, 0xb9, 0x7d, 0x25, 0x8f, 0xfa, 0x0f, 0xb9, 0x8d,
+	0x70, 0x31, 0xce, 0x34, 0xa9, 0x4d, 0x43, 0x4d, 0x55, 0xb0, 0x61, 0xdd, 0x54, 0x3d, 0x2a, 0x20,
+	0x73, 0x4b, 0x31, 0xad, 0x99, 0x95, 0x71, 0x4c, 0xf1, 0xc3, 0xb9, 0x8b, 0x36, 0xc9, 0x1d, 0x84,
+	0xe7, 0xe4, 0xa3, 0x44, 0x36, 0x26, 0x7b, 0x19, 0x3c, 0x5c, 0xc6, 0xaf, 0xb3, 0x0c, 0xa0, 0x34,
+	0x6b, 0x7e, 0xa5, 0x80, 0x3f, 0x27, 0x2b, 0x63, 0x80, 0xc9, 0x23, 0x84, 0x0b, 0x51, 0x4f, 0x25,
+	0x5b, 0x93, 0x31, 0x53, 0x9d, 0x77, 0xc6, 0xb9, 0xb6, 0x15, 0xe6, 0xd7, 0xe6, 0x38, 0xcc, 0xdd,
+	0x6c, 0x0b, 0xfe, 0x1f, 0xe1, 0x42, 0xd4, 0x45, 0xa7, 0x61, 0xa7, 0x7a, 0xad, 0x31, 0xa5, 0x94,
+	0x07, 0x89, 0xd6, 0xc5, 0xb7, 0x39, 0xad, 0xf8, 0x9e, 0x20, 0xbc, 0xe4, 0xe8, 0xf7, 0x55, 0x36,
+	0xde, 0x69, 0xb9, 0x1e, 0x34, 0xe7, 0xd9, 0xe6, 0x5a, 0x9a, 0x35, 0xbf, 0x53, 0xcc, 0x16, 0xf9,
+	0x66, 0x32, 0xb3, 0x1d, 0xcf, 0x03, 0x35, 0x21, 0x81, 0xff, 0xc5, 0x44, 0x56, 0x4a, 0x7c, 0x88,
+	0x9f, 0xd5, 0xec, 0x76, 0xe3, 0x2b, 0xff, 0xa9, 0xa5, 0x87, 0x3d, 0xb5, 0x4f, 0x95, 0x5c, 0x4d,
+	0x61, 0x6c, 0x90, 0xb5, 0x29, 0x18, 0xd1, 0x46, 0xf2, 0x10, 0xe1, 0x79, 0x35, 0x3c, 0x91, 0xea,
+	0x64, 0x9f, 0xc3, 0x09, 0xcb, 0x38, 0x9e, 0x65, 0xec, 0x94, 0x5d, 0x85, 0xff, 0x76, 0xcb, 0xe1,
+	0x82, 0x01, 0xed, 0x66, 0x4f, 0xf0, 0x2d, 0xfa, 0xe9, 0xf0, 0xd9, 0x75, 0x19, 0x3d, 0xbf, 0x2e,
+	0xa3, 0x57, 0xd7, 0x65, 0xf4, 0xc7, 0x0f, 0x37, 0x1b, 0xb6, 0xdd, 0x8e, 0x07, 0x7e, 0x76, 0xba,
+	0x3f, 0x2b, 0xa8, 0x39, 0x79, 0xe7, 0xcd, 0x00, 0xfb, 0xd3, 0x57, 0x32, 0x0c, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1374,6 +1462,16 @@ func (m *ApplicationSetGenerateRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ApplicationSet != nil {
 		{
 			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
@@ -1413,6 +1511,20 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Diffs) > 0 {
+		for iNdEx := len(m.Diffs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diffs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Applications) > 0 {
 		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1430,6 +1542,54 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetApplicationDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetApplicationDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetApplicationDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Diff) > 0 {
+		i -= len(m.Diff)
+		copy(dAtA[i:], m.Diff)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Diff)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplicationset(v)
 	base := offset
@@ -1613,6 +1773,9 @@ func (m *ApplicationSetGenerateRequest) Size() (n int) {
 		l = m.ApplicationSet.Size()
 		n += 1 + l + sovApplicationset(uint64(l))
 	}
+	if m.DryRun {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1631,6 +1794,36 @@ func (m *ApplicationSetGenerateResponse) Size() (n int) {
 			n += 1 + l + sovApplicationset(uint64(l))
 		}
 	}
+	if len(m.Diffs) > 0 {
+		for _, e := range m.Diffs {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetApplicationDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Diff)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2657,6 +2850,26 @@ func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
@@ -2742,6 +2955,187 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diffs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diffs = append(m.Diffs, &ApplicationSetApplicationDiff{})
+			if err := m.Diffs[len(m.Diffs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetApplicationDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
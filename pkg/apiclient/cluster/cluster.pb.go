@@ -311,56 +311,467 @@ func (m *ClusterUpdateRequest) GetId() *ClusterID {
 	return nil
 }
 
+// ClusterDrainRequest is a request to drain the applications of a cluster before removing it
+type ClusterDrainRequest struct {
+	Id *ClusterID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// destinationServer is the server URL of the cluster the applications are migrated to
+	DestinationServer string `protobuf:"bytes,2,opt,name=destinationServer,proto3" json:"destinationServer,omitempty"`
+	// destinationName is the name of the cluster the applications are migrated to
+	DestinationName string `protobuf:"bytes,3,opt,name=destinationName,proto3" json:"destinationName,omitempty"`
+	// namespaces maps the destination namespaces of the migrated applications to their new destination namespaces
+	Namespaces map[string]string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// dryRun lists the applications which would be migrated, without cordoning the cluster or migrating them
+	DryRun               bool     `protobuf:"varint,5,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterDrainRequest) Reset()         { *m = ClusterDrainRequest{} }
+func (m *ClusterDrainRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterDrainRequest) ProtoMessage()    {}
+func (*ClusterDrainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{5}
+}
+func (m *ClusterDrainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterDrainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterDrainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterDrainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterDrainRequest.Merge(m, src)
+}
+func (m *ClusterDrainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterDrainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterDrainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterDrainRequest proto.InternalMessageInfo
+
+func (m *ClusterDrainRequest) GetId() *ClusterID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ClusterDrainRequest) GetDestinationServer() string {
+	if m != nil {
+		return m.DestinationServer
+	}
+	return ""
+}
+
+func (m *ClusterDrainRequest) GetDestinationName() string {
+	if m != nil {
+		return m.DestinationName
+	}
+	return ""
+}
+
+func (m *ClusterDrainRequest) GetNamespaces() map[string]string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *ClusterDrainRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+// ClusterDrainResponse lists the applications of a drained cluster
+type ClusterDrainResponse struct {
+	Cluster *v1alpha1.Cluster `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// applications are the qualified names of the applications which are still deployed to the cluster
+	Applications []string `protobuf:"bytes,2,rep,name=applications,proto3" json:"applications,omitempty"`
+	// migrated are the qualified names of the applications which were migrated to the destination cluster
+	Migrated             []string `protobuf:"bytes,3,rep,name=migrated,proto3" json:"migrated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterDrainResponse) Reset()         { *m = ClusterDrainResponse{} }
+func (m *ClusterDrainResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterDrainResponse) ProtoMessage()    {}
+func (*ClusterDrainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{6}
+}
+func (m *ClusterDrainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterDrainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterDrainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterDrainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterDrainResponse.Merge(m, src)
+}
+func (m *ClusterDrainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterDrainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterDrainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterDrainResponse proto.InternalMessageInfo
+
+func (m *ClusterDrainResponse) GetCluster() *v1alpha1.Cluster {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+func (m *ClusterDrainResponse) GetApplications() []string {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+func (m *ClusterDrainResponse) GetMigrated() []string {
+	if m != nil {
+		return m.Migrated
+	}
+	return nil
+}
+
+// ClusterInventoryQuery is a query for the inventory of the resources managed in clusters
+type ClusterInventoryQuery struct {
+	// id identifies the cluster to return the inventory of; the inventories of all clusters are returned if it is not set
+	Id                   *ClusterID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ClusterInventoryQuery) Reset()         { *m = ClusterInventoryQuery{} }
+func (m *ClusterInventoryQuery) String() string { return proto.CompactTextString(m) }
+func (*ClusterInventoryQuery) ProtoMessage()    {}
+func (*ClusterInventoryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{7}
+}
+func (m *ClusterInventoryQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInventoryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInventoryQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInventoryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInventoryQuery.Merge(m, src)
+}
+func (m *ClusterInventoryQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInventoryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInventoryQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInventoryQuery proto.InternalMessageInfo
+
+func (m *ClusterInventoryQuery) GetId() *ClusterID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+// ClusterInventoryItem holds the number of resources of a group kind managed in a cluster
+type ClusterInventoryItem struct {
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Kind  string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// count is the number of resources of the group kind managed in the cluster
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// applications is the number of applications managing resources of the group kind in the cluster
+	Applications         int64    `protobuf:"varint,4,opt,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterInventoryItem) Reset()         { *m = ClusterInventoryItem{} }
+func (m *ClusterInventoryItem) String() string { return proto.CompactTextString(m) }
+func (*ClusterInventoryItem) ProtoMessage()    {}
+func (*ClusterInventoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{8}
+}
+func (m *ClusterInventoryItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInventoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInventoryItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInventoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInventoryItem.Merge(m, src)
+}
+func (m *ClusterInventoryItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInventoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInventoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInventoryItem proto.InternalMessageInfo
+
+func (m *ClusterInventoryItem) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *ClusterInventoryItem) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ClusterInventoryItem) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ClusterInventoryItem) GetApplications() int64 {
+	if m != nil {
+		return m.Applications
+	}
+	return 0
+}
+
+// ClusterInventory holds the group kinds of the resources managed in a cluster
+type ClusterInventory struct {
+	Server               string                  `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	Name                 string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items                []*ClusterInventoryItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ClusterInventory) Reset()         { *m = ClusterInventory{} }
+func (m *ClusterInventory) String() string { return proto.CompactTextString(m) }
+func (*ClusterInventory) ProtoMessage()    {}
+func (*ClusterInventory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{9}
+}
+func (m *ClusterInventory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInventory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInventory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInventory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInventory.Merge(m, src)
+}
+func (m *ClusterInventory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInventory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInventory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInventory proto.InternalMessageInfo
+
+func (m *ClusterInventory) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *ClusterInventory) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterInventory) GetItems() []*ClusterInventoryItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// ClusterInventoryResponse holds the inventories of the resources managed in clusters
+type ClusterInventoryResponse struct {
+	Clusters             []*ClusterInventory `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ClusterInventoryResponse) Reset()         { *m = ClusterInventoryResponse{} }
+func (m *ClusterInventoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterInventoryResponse) ProtoMessage()    {}
+func (*ClusterInventoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6b5ba0b5aa57b32, []int{10}
+}
+func (m *ClusterInventoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInventoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInventoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInventoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInventoryResponse.Merge(m, src)
+}
+func (m *ClusterInventoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterInventoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInventoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInventoryResponse proto.InternalMessageInfo
+
+func (m *ClusterInventoryResponse) GetClusters() []*ClusterInventory {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ClusterID)(nil), "cluster.ClusterID")
 	proto.RegisterType((*ClusterQuery)(nil), "cluster.ClusterQuery")
 	proto.RegisterType((*ClusterResponse)(nil), "cluster.ClusterResponse")
 	proto.RegisterType((*ClusterCreateRequest)(nil), "cluster.ClusterCreateRequest")
 	proto.RegisterType((*ClusterUpdateRequest)(nil), "cluster.ClusterUpdateRequest")
+	proto.RegisterType((*ClusterDrainRequest)(nil), "cluster.ClusterDrainRequest")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.ClusterDrainRequest.NamespacesEntry")
+	proto.RegisterType((*ClusterDrainResponse)(nil), "cluster.ClusterDrainResponse")
+	proto.RegisterType((*ClusterInventoryQuery)(nil), "cluster.ClusterInventoryQuery")
+	proto.RegisterType((*ClusterInventoryItem)(nil), "cluster.ClusterInventoryItem")
+	proto.RegisterType((*ClusterInventory)(nil), "cluster.ClusterInventory")
+	proto.RegisterType((*ClusterInventoryResponse)(nil), "cluster.ClusterInventoryResponse")
 }
 
 func init() { proto.RegisterFile("server/cluster/cluster.proto", fileDescriptor_a6b5ba0b5aa57b32) }
 
 var fileDescriptor_a6b5ba0b5aa57b32 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe5, 0x6e, 0x74, 0xcc, 0x03, 0x06, 0xd6, 0x40, 0x51, 0xf7, 0x43, 0x25, 0x20, 0x28,
-	0x68, 0xb5, 0xd5, 0x76, 0x5c, 0xb8, 0xb1, 0x0e, 0x50, 0xa5, 0x5d, 0x08, 0xe2, 0xc2, 0x61, 0x93,
-	0x97, 0x3c, 0xa5, 0x66, 0x59, 0x62, 0x62, 0x27, 0xd2, 0x84, 0xb8, 0xec, 0xc4, 0x0d, 0x21, 0xae,
-	0x5c, 0xf9, 0x43, 0xb8, 0x21, 0x71, 0x41, 0xe2, 0x1f, 0x40, 0x15, 0x7f, 0x08, 0x8a, 0x93, 0xb4,
-	0xb4, 0xd3, 0xaa, 0x21, 0x15, 0x4e, 0xf5, 0x7b, 0xea, 0xf3, 0xf7, 0xf3, 0xbe, 0x7e, 0x8e, 0xf1,
-	0x9a, 0x82, 0x38, 0x85, 0x98, 0xb9, 0x41, 0xa2, 0xf4, 0xe8, 0x97, 0xca, 0x38, 0xd2, 0x11, 0x59,
-	0x28, 0xc2, 0xda, 0x9a, 0x1f, 0x45, 0x7e, 0x00, 0x8c, 0x4b, 0xc1, 0x78, 0x18, 0x46, 0x9a, 0x6b,
-	0x11, 0x85, 0x2a, 0xff, 0x5b, 0x6d, 0xd7, 0x17, 0xba, 0x9f, 0x1c, 0x50, 0x37, 0x3a, 0x62, 0x3c,
-	0xf6, 0x23, 0x19, 0x47, 0xaf, 0xcc, 0xa2, 0xe9, 0x7a, 0x2c, 0xed, 0x30, 0x79, 0xe8, 0x67, 0x95,
-	0x8a, 0x71, 0x29, 0x03, 0xe1, 0x9a, 0x5a, 0x96, 0xb6, 0x78, 0x20, 0xfb, 0xbc, 0xc5, 0x7c, 0x08,
-	0x21, 0xe6, 0x1a, 0xbc, 0x7c, 0x37, 0xfb, 0x01, 0x5e, 0xec, 0xe6, 0xb2, 0xbd, 0x1d, 0x42, 0xf0,
-	0xbc, 0x3e, 0x96, 0x60, 0xa1, 0x3a, 0x6a, 0x2c, 0x3a, 0x66, 0x4d, 0x56, 0xf0, 0x85, 0x94, 0x07,
-	0x09, 0x58, 0x15, 0x93, 0xcc, 0x03, 0x7b, 0x0f, 0x5f, 0x2a, 0xca, 0x9e, 0x25, 0x10, 0x1f, 0x93,
-	0x1b, 0xb8, 0x9a, 0xf7, 0x56, 0xd4, 0x16, 0x51, 0xb6, 0x63, 0xc8, 0x8f, 0xca, 0x62, 0xb3, 0x26,
-	0x36, 0xae, 0x08, 0xcf, 0x9a, 0xab, 0xa3, 0xc6, 0x52, 0x9b, 0xd0, 0xd2, 0x83, 0x21, 0x85, 0x53,
-	0x11, 0x9e, 0x7d, 0x0d, 0x2f, 0x17, 0x09, 0x07, 0x94, 0x8c, 0x42, 0x05, 0xf6, 0x7b, 0x84, 0x57,
-	0x8a, 0x5c, 0x37, 0x06, 0xae, 0xc1, 0x81, 0xd7, 0x09, 0x28, 0x4d, 0xf6, 0x71, 0xe9, 0x9c, 0x11,
-	0x5f, 0x6a, 0x3f, 0xa6, 0x23, 0x8b, 0x68, 0x69, 0x91, 0x59, 0xec, 0xbb, 0x1e, 0x4d, 0x3b, 0x54,
-	0x1e, 0xfa, 0x34, 0xb3, 0x88, 0xfe, 0x61, 0x11, 0x2d, 0x2d, 0x2a, 0x49, 0x9c, 0x72, 0xd7, 0xac,
-	0xb9, 0x44, 0x2a, 0x88, 0xb5, 0x69, 0xe3, 0xa2, 0x53, 0x44, 0xf6, 0x97, 0x11, 0xd1, 0x0b, 0xe9,
-	0xfd, 0x4f, 0xa2, 0xdb, 0xf8, 0x72, 0x62, 0x14, 0xbd, 0x27, 0x02, 0x02, 0x4f, 0x59, 0x95, 0xfa,
-	0x5c, 0x63, 0xd1, 0x19, 0x4f, 0x9e, 0xc7, 0xe8, 0xf6, 0xb7, 0x05, 0x7c, 0xa5, 0xc8, 0x3c, 0x87,
-	0x38, 0x15, 0x2e, 0x90, 0x13, 0x84, 0xe7, 0x77, 0x85, 0xd2, 0xe4, 0xfa, 0x64, 0x8d, 0x39, 0xeb,
-	0x5a, 0x6f, 0x26, 0xcd, 0x64, 0x0a, 0xb6, 0x75, 0xf2, 0xe3, 0xd7, 0xc7, 0x0a, 0x21, 0x57, 0xcd,
-	0xac, 0xa7, 0xad, 0xf2, 0x46, 0x28, 0xf2, 0x01, 0xe1, 0x6a, 0x7e, 0xcc, 0x64, 0x7d, 0x12, 0x63,
-	0xec, 0xf8, 0x6b, 0xb3, 0xf1, 0xd6, 0xbe, 0x69, 0x50, 0x56, 0xed, 0x53, 0x28, 0x0f, 0x87, 0xae,
-	0xbf, 0x43, 0x78, 0xee, 0x29, 0x9c, 0xe9, 0xcb, 0x8c, 0x40, 0x6e, 0x19, 0x90, 0x75, 0xb2, 0x3a,
-	0x09, 0xc2, 0xde, 0x08, 0x8f, 0x9a, 0xeb, 0xf7, 0x96, 0x7c, 0x42, 0xb8, 0x9a, 0xcf, 0xdc, 0x69,
-	0x7b, 0xc6, 0x66, 0x71, 0x56, 0x54, 0x9b, 0x86, 0xea, 0x4e, 0x6d, 0x1a, 0xd5, 0xc8, 0xa9, 0x3d,
-	0x5c, 0xdd, 0x81, 0x00, 0x34, 0x9c, 0xe5, 0x95, 0x35, 0x99, 0x1e, 0x5e, 0xf3, 0xa2, 0xfd, 0xfb,
-	0x53, 0xdb, 0x0f, 0x31, 0x76, 0xb2, 0xcf, 0x22, 0x3c, 0x4a, 0x74, 0xff, 0xef, 0x35, 0x98, 0xd1,
-	0xb8, 0x67, 0xdf, 0x9d, 0xa2, 0xc1, 0x62, 0x23, 0xd0, 0xe4, 0x99, 0xc2, 0x67, 0x84, 0x97, 0x7b,
-	0x61, 0xca, 0x03, 0x91, 0x59, 0xdb, 0xe5, 0x6e, 0x1f, 0xfe, 0xf1, 0x14, 0x6c, 0x19, 0x44, 0x6a,
-	0x6f, 0x4e, 0x43, 0x14, 0x43, 0xa4, 0xa6, 0x9b, 0x31, 0x6d, 0x6f, 0x7f, 0x1d, 0x6c, 0xa0, 0xef,
-	0x83, 0x0d, 0xf4, 0x73, 0xb0, 0x81, 0x5e, 0x6e, 0x9d, 0xef, 0xa5, 0x70, 0x03, 0x01, 0xa1, 0x2e,
-	0x05, 0x0e, 0xaa, 0xe6, 0x61, 0xe8, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x3f, 0x7b, 0x15,
-	0xad, 0x06, 0x00, 0x00,
+	// 909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x97, 0x77, 0x93, 0x25, 0xfb, 0x52, 0x48, 0x3a, 0xa4, 0xc8, 0xb8, 0x49, 0x94, 0x0c, 0x08,
+	0x96, 0x2a, 0xb1, 0x95, 0x4d, 0x2b, 0xa1, 0x20, 0x0e, 0x34, 0x29, 0x28, 0x52, 0x54, 0xa9, 0x46,
+	0x5c, 0x38, 0xb4, 0x9a, 0xda, 0x23, 0x67, 0x88, 0x77, 0x6c, 0x66, 0xc6, 0x2b, 0x2d, 0x88, 0x4b,
+	0x4f, 0xdc, 0x10, 0xe2, 0xca, 0x95, 0x4f, 0x80, 0x04, 0x67, 0x6e, 0x1c, 0x91, 0xf8, 0x02, 0x28,
+	0xe2, 0x83, 0xa0, 0x99, 0xb1, 0xbd, 0xbb, 0x5e, 0x76, 0x95, 0x4a, 0x49, 0x4f, 0x3b, 0xf3, 0x66,
+	0xde, 0xfc, 0x7e, 0xef, 0xf7, 0xfe, 0x78, 0x61, 0x53, 0x52, 0x31, 0xa4, 0x22, 0x88, 0xd2, 0x42,
+	0xaa, 0xf1, 0xaf, 0x9f, 0x8b, 0x4c, 0x65, 0xe8, 0xb5, 0x72, 0xeb, 0x6d, 0x26, 0x59, 0x96, 0xa4,
+	0x34, 0x20, 0x39, 0x0b, 0x08, 0xe7, 0x99, 0x22, 0x8a, 0x65, 0x5c, 0xda, 0x6b, 0xde, 0x59, 0xc2,
+	0xd4, 0x79, 0xf1, 0xdc, 0x8f, 0xb2, 0x41, 0x40, 0x44, 0x92, 0xe5, 0x22, 0xfb, 0xca, 0x2c, 0xf6,
+	0xa3, 0x38, 0x18, 0x1e, 0x06, 0xf9, 0x45, 0xa2, 0x3d, 0x65, 0x40, 0xf2, 0x3c, 0x65, 0x91, 0xf1,
+	0x0d, 0x86, 0x07, 0x24, 0xcd, 0xcf, 0xc9, 0x41, 0x90, 0x50, 0x4e, 0x05, 0x51, 0x34, 0xb6, 0xaf,
+	0xe1, 0x07, 0xd0, 0x3d, 0xb6, 0xb0, 0xa7, 0x27, 0x08, 0xc1, 0x92, 0x1a, 0xe5, 0xd4, 0x75, 0x76,
+	0x9c, 0x5e, 0x37, 0x34, 0x6b, 0xb4, 0x01, 0xcb, 0x43, 0x92, 0x16, 0xd4, 0x6d, 0x19, 0xa3, 0xdd,
+	0xe0, 0xa7, 0x70, 0xab, 0x74, 0x7b, 0x52, 0x50, 0x31, 0x42, 0x6f, 0x41, 0xc7, 0xc6, 0x56, 0xfa,
+	0x96, 0x3b, 0xfd, 0x22, 0x27, 0x83, 0xca, 0xd9, 0xac, 0x11, 0x86, 0x16, 0x8b, 0xdd, 0xf6, 0x8e,
+	0xd3, 0x5b, 0xed, 0x23, 0xbf, 0xd2, 0xa0, 0x66, 0x11, 0xb6, 0x58, 0x8c, 0x6f, 0xc3, 0x5a, 0x69,
+	0x08, 0xa9, 0xcc, 0x33, 0x2e, 0x29, 0xfe, 0xc1, 0x81, 0x8d, 0xd2, 0x76, 0x2c, 0x28, 0x51, 0x34,
+	0xa4, 0x5f, 0x17, 0x54, 0x2a, 0xf4, 0x0c, 0x2a, 0xe5, 0x0c, 0xf8, 0x6a, 0xff, 0x91, 0x3f, 0x96,
+	0xc8, 0xaf, 0x24, 0x32, 0x8b, 0x67, 0x51, 0xec, 0x0f, 0x0f, 0xfd, 0xfc, 0x22, 0xf1, 0xb5, 0x44,
+	0xfe, 0x84, 0x44, 0x7e, 0x25, 0x51, 0xc5, 0x24, 0xac, 0x5e, 0xd5, 0xc1, 0x15, 0xb9, 0xa4, 0x42,
+	0x99, 0x30, 0x56, 0xc2, 0x72, 0x87, 0xff, 0x18, 0x33, 0xfa, 0x22, 0x8f, 0x5f, 0x25, 0xa3, 0x77,
+	0xe1, 0xf5, 0xc2, 0x20, 0xc6, 0x9f, 0x32, 0x9a, 0xc6, 0xd2, 0x6d, 0xed, 0xb4, 0x7b, 0xdd, 0x70,
+	0xda, 0x78, 0x25, 0xa1, 0x7f, 0x6b, 0xc1, 0x9b, 0xa5, 0xe5, 0x44, 0x10, 0xc6, 0xab, 0x10, 0xac,
+	0xaf, 0xb3, 0xc8, 0x17, 0xed, 0xc1, 0xed, 0x98, 0x4a, 0xc5, 0xb8, 0xa1, 0xfb, 0xb9, 0xcd, 0xbf,
+	0xcd, 0xf4, 0xec, 0x01, 0xea, 0xc1, 0xda, 0x84, 0xf1, 0xb1, 0xae, 0x8a, 0xb6, 0xb9, 0xdb, 0x34,
+	0xa3, 0x33, 0x00, 0x5d, 0x28, 0x32, 0x27, 0x11, 0x95, 0xee, 0xd2, 0x4e, 0xbb, 0xb7, 0xda, 0xdf,
+	0x6b, 0x72, 0x98, 0x64, 0xeb, 0x3f, 0xae, 0xaf, 0x3f, 0xe2, 0x4a, 0x8c, 0xc2, 0x09, 0x7f, 0x9d,
+	0xbd, 0x58, 0x8c, 0xc2, 0x82, 0xbb, 0xcb, 0x36, 0x7b, 0x76, 0xe7, 0x7d, 0x0c, 0x6b, 0x0d, 0x37,
+	0xb4, 0x0e, 0xed, 0x0b, 0x3a, 0x2a, 0x4b, 0x58, 0x2f, 0xff, 0xbf, 0xfa, 0x8f, 0x5a, 0x1f, 0x3a,
+	0xf8, 0xf7, 0x71, 0xf2, 0x4b, 0x2a, 0xb6, 0x4e, 0x6f, 0x3e, 0xf9, 0x18, 0x6e, 0x4d, 0x5c, 0xac,
+	0x72, 0x3f, 0x65, 0x43, 0x1e, 0xac, 0x0c, 0x58, 0x62, 0x1a, 0xdd, 0x6d, 0x9b, 0xf3, 0x7a, 0x8f,
+	0x3f, 0x82, 0x3b, 0x55, 0x1e, 0xf9, 0x90, 0x72, 0x95, 0x89, 0x91, 0x6d, 0xe2, 0x2b, 0xe4, 0x1c,
+	0x7f, 0x03, 0x1b, 0x4d, 0xe7, 0x53, 0x45, 0x07, 0x5a, 0xa8, 0x44, 0x64, 0x45, 0x5e, 0x8a, 0x67,
+	0x37, 0xba, 0xfd, 0x2f, 0x18, 0x8f, 0xab, 0xf6, 0xd7, 0x6b, 0x7d, 0x33, 0xca, 0x0a, 0xae, 0x4c,
+	0xf6, 0xdb, 0xa1, 0xdd, 0xcc, 0x04, 0xb5, 0x64, 0x0e, 0xa7, 0x6c, 0x58, 0xc2, 0x7a, 0x13, 0xfb,
+	0xa5, 0x06, 0xcf, 0x21, 0x2c, 0x33, 0x45, 0x07, 0xd2, 0x28, 0xb2, 0xda, 0xdf, 0x9a, 0x09, 0x71,
+	0x32, 0xa2, 0xd0, 0xde, 0xc5, 0x4f, 0xc0, 0x6d, 0x1e, 0xd7, 0xa9, 0x7e, 0x00, 0x2b, 0xe5, 0x13,
+	0xd2, 0x75, 0xcc, 0x9b, 0x6f, 0xcf, 0x7d, 0x33, 0xac, 0xaf, 0xf6, 0x7f, 0xed, 0xc2, 0x1b, 0xe5,
+	0xb1, 0xee, 0x0d, 0x16, 0x51, 0xf4, 0xc2, 0x81, 0xa5, 0x33, 0x26, 0x15, 0xba, 0xd3, 0x7c, 0xc0,
+	0xa4, 0xc6, 0x3b, 0xbd, 0x96, 0x1a, 0xd2, 0x08, 0xd8, 0x7d, 0xf1, 0xf7, 0xbf, 0x3f, 0xb5, 0x10,
+	0x5a, 0x37, 0xdf, 0x97, 0xe1, 0x41, 0xf5, 0x15, 0x92, 0xe8, 0x47, 0x07, 0x3a, 0x76, 0xb4, 0xa2,
+	0x19, 0x6d, 0xa6, 0x46, 0xae, 0x77, 0x3d, 0x25, 0x8d, 0x77, 0x0d, 0x95, 0xbb, 0x78, 0x86, 0xca,
+	0x51, 0x5d, 0xec, 0xdf, 0x3b, 0xd0, 0xfe, 0x8c, 0xce, 0xd5, 0xe5, 0x9a, 0x88, 0xbc, 0x63, 0x88,
+	0x6c, 0xa1, 0xbb, 0x4d, 0x22, 0xc1, 0xb7, 0x2c, 0xf6, 0x4d, 0xd3, 0x7f, 0x87, 0x7e, 0x76, 0xa0,
+	0x63, 0xe7, 0xfc, 0xac, 0x3c, 0x53, 0xf3, 0xff, 0xba, 0x58, 0xed, 0x19, 0x56, 0xef, 0x79, 0x8b,
+	0x58, 0x8d, 0x95, 0x7a, 0x0a, 0x9d, 0x13, 0x9a, 0x52, 0x45, 0xe7, 0x69, 0xe5, 0x36, 0xcd, 0xf5,
+	0xa7, 0xb5, 0x0c, 0xff, 0xde, 0xc2, 0xf0, 0x39, 0x40, 0x98, 0x29, 0xa2, 0xe8, 0x27, 0x85, 0x3a,
+	0x7f, 0x79, 0x8c, 0xc0, 0x60, 0x7c, 0x80, 0xdf, 0x5f, 0x80, 0x11, 0x08, 0x03, 0xb0, 0x4f, 0x34,
+	0x82, 0x80, 0x65, 0x33, 0x58, 0xd1, 0xe6, 0xa2, 0xd1, 0xef, 0x6d, 0xcd, 0x39, 0x2d, 0x61, 0x4b,
+	0x0d, 0xf1, 0xee, 0x22, 0xd8, 0x58, 0xbb, 0x1c, 0x39, 0xf7, 0x90, 0x80, 0xee, 0x78, 0xb4, 0x6c,
+	0xcf, 0xed, 0x65, 0x1b, 0xeb, 0xee, 0xfc, 0x5e, 0xaf, 0xd0, 0xb1, 0x41, 0xdf, 0x44, 0x5e, 0x13,
+	0x7d, 0x9f, 0xd5, 0x30, 0xbf, 0x38, 0xb0, 0x76, 0xca, 0x87, 0x24, 0x65, 0xba, 0x84, 0x8e, 0x49,
+	0x74, 0x4e, 0x6f, 0xb8, 0xda, 0xef, 0x1b, 0x56, 0x3e, 0xde, 0x5b, 0xa4, 0x09, 0xab, 0x29, 0xed,
+	0x47, 0x9a, 0xd3, 0xc3, 0x87, 0x7f, 0x5e, 0x6e, 0x3b, 0x7f, 0x5d, 0x6e, 0x3b, 0xff, 0x5c, 0x6e,
+	0x3b, 0x5f, 0xde, 0xbf, 0xda, 0xbf, 0xd0, 0x28, 0x65, 0x94, 0xab, 0x0a, 0xe0, 0x79, 0xc7, 0xfc,
+	0xe9, 0x3c, 0xfc, 0x6f, 0x00, 0xaf, 0xa6, 0x41, 0x20, 0x09, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -387,6 +798,10 @@ type ClusterServiceClient interface {
 	Delete(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
 	// RotateAuth rotates the bearer token used for a cluster
 	RotateAuth(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*ClusterResponse, error)
+	// Drain cordons a cluster, so that no application is synced to it anymore, and migrates its applications to another cluster
+	Drain(ctx context.Context, in *ClusterDrainRequest, opts ...grpc.CallOption) (*ClusterDrainResponse, error)
+	// Inventory returns the group kinds and the number of the resources managed by applications in clusters
+	Inventory(ctx context.Context, in *ClusterInventoryQuery, opts ...grpc.CallOption) (*ClusterInventoryResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error)
 }
@@ -453,6 +868,24 @@ func (c *clusterServiceClient) RotateAuth(ctx context.Context, in *ClusterQuery,
 	return out, nil
 }
 
+func (c *clusterServiceClient) Drain(ctx context.Context, in *ClusterDrainRequest, opts ...grpc.CallOption) (*ClusterDrainResponse, error) {
+	out := new(ClusterDrainResponse)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) Inventory(ctx context.Context, in *ClusterInventoryQuery, opts ...grpc.CallOption) (*ClusterInventoryResponse, error) {
+	out := new(ClusterInventoryResponse)
+	err := c.cc.Invoke(ctx, "/cluster.ClusterService/Inventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) InvalidateCache(ctx context.Context, in *ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	out := new(v1alpha1.Cluster)
 	err := c.cc.Invoke(ctx, "/cluster.ClusterService/InvalidateCache", in, out, opts...)
@@ -476,6 +909,10 @@ type ClusterServiceServer interface {
 	Delete(context.Context, *ClusterQuery) (*ClusterResponse, error)
 	// RotateAuth rotates the bearer token used for a cluster
 	RotateAuth(context.Context, *ClusterQuery) (*ClusterResponse, error)
+	// Drain cordons a cluster, so that no application is synced to it anymore, and migrates its applications to another cluster
+	Drain(context.Context, *ClusterDrainRequest) (*ClusterDrainResponse, error)
+	// Inventory returns the group kinds and the number of the resources managed by applications in clusters
+	Inventory(context.Context, *ClusterInventoryQuery) (*ClusterInventoryResponse, error)
 	// InvalidateCache invalidates cluster cache
 	InvalidateCache(context.Context, *ClusterQuery) (*v1alpha1.Cluster, error)
 }
@@ -502,6 +939,12 @@ func (*UnimplementedClusterServiceServer) Delete(ctx context.Context, req *Clust
 func (*UnimplementedClusterServiceServer) RotateAuth(ctx context.Context, req *ClusterQuery) (*ClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RotateAuth not implemented")
 }
+func (*UnimplementedClusterServiceServer) Drain(ctx context.Context, req *ClusterDrainRequest) (*ClusterDrainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+func (*UnimplementedClusterServiceServer) Inventory(ctx context.Context, req *ClusterInventoryQuery) (*ClusterInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inventory not implemented")
+}
 func (*UnimplementedClusterServiceServer) InvalidateCache(ctx context.Context, req *ClusterQuery) (*v1alpha1.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCache not implemented")
 }
@@ -618,35 +1061,71 @@ func _ClusterService_RotateAuth_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterQuery)
+func _ClusterService_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterDrainRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterServiceServer).InvalidateCache(ctx, in)
+		return srv.(ClusterServiceServer).Drain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cluster.ClusterService/InvalidateCache",
+		FullMethod: "/cluster.ClusterService/Drain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServiceServer).InvalidateCache(ctx, req.(*ClusterQuery))
+		return srv.(ClusterServiceServer).Drain(ctx, req.(*ClusterDrainRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ClusterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cluster.ClusterService",
-	HandlerType: (*ClusterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "List",
-			Handler:    _ClusterService_List_Handler,
-		},
-		{
-			MethodName: "Create",
-			Handler:    _ClusterService_Create_Handler,
+func _ClusterService_Inventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterInventoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Inventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/Inventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Inventory(ctx, req.(*ClusterInventoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_InvalidateCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).InvalidateCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cluster.ClusterService/InvalidateCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).InvalidateCache(ctx, req.(*ClusterQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ClusterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cluster.ClusterService",
+	HandlerType: (*ClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ClusterService_List_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ClusterService_Create_Handler,
 		},
 		{
 			MethodName: "Get",
@@ -664,6 +1143,14 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RotateAuth",
 			Handler:    _ClusterService_RotateAuth_Handler,
 		},
+		{
+			MethodName: "Drain",
+			Handler:    _ClusterService_Drain_Handler,
+		},
+		{
+			MethodName: "Inventory",
+			Handler:    _ClusterService_Inventory_Handler,
+		},
 		{
 			MethodName: "InvalidateCache",
 			Handler:    _ClusterService_InvalidateCache_Handler,
@@ -903,101 +1390,426 @@ func (m *ClusterUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCluster(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClusterDrainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClusterID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ClusterDrainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterDrainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovCluster(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovCluster(uint64(l))
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Namespaces) > 0 {
+		for k := range m.Namespaces {
+			v := m.Namespaces[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCluster(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCluster(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCluster(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DestinationName) > 0 {
+		i -= len(m.DestinationName)
+		copy(dAtA[i:], m.DestinationName)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.DestinationName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DestinationServer) > 0 {
+		i -= len(m.DestinationServer)
+		copy(dAtA[i:], m.DestinationServer)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.DestinationServer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterDrainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterDrainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterDrainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Server)
-	if l > 0 {
-		n += 1 + l + sovCluster(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCluster(uint64(l))
+	if len(m.Migrated) > 0 {
+		for iNdEx := len(m.Migrated) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Migrated[iNdEx])
+			copy(dAtA[i:], m.Migrated[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.Migrated[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovCluster(uint64(l))
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintCluster(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Cluster != nil {
+		{
+			size, err := m.Cluster.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterInventoryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInventoryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInventoryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCluster(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterInventoryItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInventoryItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInventoryItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovCluster(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Upsert {
-		n += 2
+	if m.Applications != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.Applications))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Count != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterUpdateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterInventory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInventory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInventory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cluster != nil {
-		l = m.Cluster.Size()
-		n += 1 + l + sovCluster(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCluster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Server) > 0 {
+		i -= len(m.Server)
+		copy(dAtA[i:], m.Server)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Server)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterInventoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInventoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInventoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCluster(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCluster(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovCluster(uint64(l))
 	}
 	if len(m.UpdatedFields) > 0 {
 		for _, s := range m.UpdatedFields {
@@ -1012,16 +1824,744 @@ func (m *ClusterUpdateRequest) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
+	return n
+}
+
+func (m *ClusterDrainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.DestinationServer)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.DestinationName)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Namespaces) > 0 {
+		for k, v := range m.Namespaces {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCluster(uint64(len(k))) + 1 + len(v) + sovCluster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCluster(uint64(mapEntrySize))
+		}
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterDrainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cluster != nil {
+		l = m.Cluster.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if len(m.Migrated) > 0 {
+		for _, s := range m.Migrated {
+			l = len(s)
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInventoryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInventoryItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCluster(uint64(m.Count))
+	}
+	if m.Applications != 0 {
+		n += 1 + sovCluster(uint64(m.Applications))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInventory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInventoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCluster(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCluster(x uint64) (n int) {
+	return sovCluster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClusterID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ClusterID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cluster == nil {
+				m.Cluster = &v1alpha1.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cluster == nil {
+				m.Cluster = &v1alpha1.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedFields = append(m.UpdatedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ClusterID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovCluster(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCluster(x uint64) (n int) {
-	return sovCluster(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ClusterID) Unmarshal(dAtA []byte) error {
+func (m *ClusterDrainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,15 +2584,51 @@ func (m *ClusterID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterID: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterDrainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterDrainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ClusterID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1080,11 +2656,11 @@ func (m *ClusterID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.DestinationServer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1112,8 +2688,155 @@ func (m *ClusterID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.DestinationName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Namespaces == nil {
+				m.Namespaces = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCluster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Namespaces[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -1136,7 +2859,7 @@ func (m *ClusterID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
+func (m *ClusterDrainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,17 +2882,17 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterDrainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterDrainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -1179,27 +2902,31 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCluster
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCluster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			if m.Cluster == nil {
+				m.Cluster = &v1alpha1.Cluster{}
+			}
+			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1227,13 +2954,13 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -1243,27 +2970,23 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCluster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCluster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ClusterID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Migrated = append(m.Migrated, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1287,7 +3010,7 @@ func (m *ClusterQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *ClusterInventoryQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1310,12 +3033,48 @@ func (m *ClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInventoryQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInventoryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &ClusterID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -1338,7 +3097,7 @@ func (m *ClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterInventoryItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1361,17 +3120,17 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInventoryItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInventoryItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -1381,33 +3140,61 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCluster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCluster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &v1alpha1.Cluster{}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var v int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -1417,12 +3204,30 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			m.Applications = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Applications |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Upsert = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
@@ -1445,7 +3250,7 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
+func (m *ClusterInventory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1468,17 +3273,17 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterUpdateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInventory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInventory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -1488,31 +3293,27 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCluster
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCluster
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cluster == nil {
-				m.Cluster = &v1alpha1.Cluster{}
-			}
-			if err := m.Cluster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1540,11 +3341,11 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedFields = append(m.UpdatedFields, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1571,10 +3372,93 @@ func (m *ClusterUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ClusterID{}
+			m.Items = append(m.Items, &ClusterInventoryItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInventoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInventoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInventoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ClusterInventory{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
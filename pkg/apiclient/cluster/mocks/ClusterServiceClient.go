@@ -214,6 +214,93 @@ func (_c *ClusterServiceClient_Delete_Call) RunAndReturn(run func(ctx context.Co
 	return _c
 }
 
+// Drain provides a mock function for the type ClusterServiceClient
+func (_mock *ClusterServiceClient) Drain(ctx context.Context, in *cluster.ClusterDrainRequest, opts ...grpc.CallOption) (*cluster.ClusterDrainResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 *cluster.ClusterDrainResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterDrainRequest, ...grpc.CallOption) (*cluster.ClusterDrainResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterDrainRequest, ...grpc.CallOption) *cluster.ClusterDrainResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cluster.ClusterDrainResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cluster.ClusterDrainRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClusterServiceClient_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type ClusterServiceClient_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cluster.ClusterDrainRequest
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Drain(ctx any, in any, opts ...any) *ClusterServiceClient_Drain_Call {
+	return &ClusterServiceClient_Drain_Call{Call: _e.mock.On("Drain",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Drain_Call) Run(run func(ctx context.Context, in *cluster.ClusterDrainRequest, opts ...grpc.CallOption)) *ClusterServiceClient_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cluster.ClusterDrainRequest
+		if args[1] != nil {
+			arg1 = args[1].(*cluster.ClusterDrainRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Drain_Call) Return(clusterDrainResponse *cluster.ClusterDrainResponse, err error) *ClusterServiceClient_Drain_Call {
+	_c.Call.Return(clusterDrainResponse, err)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Drain_Call) RunAndReturn(run func(ctx context.Context, in *cluster.ClusterDrainRequest, opts ...grpc.CallOption) (*cluster.ClusterDrainResponse, error)) *ClusterServiceClient_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type ClusterServiceClient
 func (_mock *ClusterServiceClient) Get(ctx context.Context, in *cluster.ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.Cluster, error) {
 	// grpc.CallOption
@@ -388,6 +475,93 @@ func (_c *ClusterServiceClient_InvalidateCache_Call) RunAndReturn(run func(ctx c
 	return _c
 }
 
+// Inventory provides a mock function for the type ClusterServiceClient
+func (_mock *ClusterServiceClient) Inventory(ctx context.Context, in *cluster.ClusterInventoryQuery, opts ...grpc.CallOption) (*cluster.ClusterInventoryResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inventory")
+	}
+
+	var r0 *cluster.ClusterInventoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterInventoryQuery, ...grpc.CallOption) (*cluster.ClusterInventoryResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterInventoryQuery, ...grpc.CallOption) *cluster.ClusterInventoryResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cluster.ClusterInventoryResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cluster.ClusterInventoryQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClusterServiceClient_Inventory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inventory'
+type ClusterServiceClient_Inventory_Call struct {
+	*mock.Call
+}
+
+// Inventory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cluster.ClusterInventoryQuery
+//   - opts ...grpc.CallOption
+func (_e *ClusterServiceClient_Expecter) Inventory(ctx any, in any, opts ...any) *ClusterServiceClient_Inventory_Call {
+	return &ClusterServiceClient_Inventory_Call{Call: _e.mock.On("Inventory",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ClusterServiceClient_Inventory_Call) Run(run func(ctx context.Context, in *cluster.ClusterInventoryQuery, opts ...grpc.CallOption)) *ClusterServiceClient_Inventory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cluster.ClusterInventoryQuery
+		if args[1] != nil {
+			arg1 = args[1].(*cluster.ClusterInventoryQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceClient_Inventory_Call) Return(clusterInventoryResponse *cluster.ClusterInventoryResponse, err error) *ClusterServiceClient_Inventory_Call {
+	_c.Call.Return(clusterInventoryResponse, err)
+	return _c
+}
+
+func (_c *ClusterServiceClient_Inventory_Call) RunAndReturn(run func(ctx context.Context, in *cluster.ClusterInventoryQuery, opts ...grpc.CallOption) (*cluster.ClusterInventoryResponse, error)) *ClusterServiceClient_Inventory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // List provides a mock function for the type ClusterServiceClient
 func (_mock *ClusterServiceClient) List(ctx context.Context, in *cluster.ClusterQuery, opts ...grpc.CallOption) (*v1alpha1.ClusterList, error) {
 	// grpc.CallOption
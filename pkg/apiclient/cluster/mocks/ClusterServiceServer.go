@@ -175,6 +175,74 @@ func (_c *ClusterServiceServer_Delete_Call) RunAndReturn(run func(context1 conte
 	return _c
 }
 
+// Drain provides a mock function for the type ClusterServiceServer
+func (_mock *ClusterServiceServer) Drain(context1 context.Context, clusterDrainRequest *cluster.ClusterDrainRequest) (*cluster.ClusterDrainResponse, error) {
+	ret := _mock.Called(context1, clusterDrainRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 *cluster.ClusterDrainResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterDrainRequest) (*cluster.ClusterDrainResponse, error)); ok {
+		return returnFunc(context1, clusterDrainRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cluster.ClusterDrainRequest) *cluster.ClusterDrainResponse); ok {
+		r0 = returnFunc(context1, clusterDrainRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cluster.ClusterDrainResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cluster.ClusterDrainRequest) error); ok {
+		r1 = returnFunc(context1, clusterDrainRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ClusterServiceServer_Drain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Drain'
+type ClusterServiceServer_Drain_Call struct {
+	*mock.Call
+}
+
+// Drain is a helper method to define mock.On call
+//   - context1 context.Context
+//   - clusterDrainRequest *cluster.ClusterDrainRequest
+func (_e *ClusterServiceServer_Expecter) Drain(context1 any, clusterDrainRequest any) *ClusterServiceServer_Drain_Call {
+	return &ClusterServiceServer_Drain_Call{Call: _e.mock.On("Drain", context1, clusterDrainRequest)}
+}
+
+func (_c *ClusterServiceServer_Drain_Call) Run(run func(context1 context.Context, clusterDrainRequest *cluster.ClusterDrainRequest)) *ClusterServiceServer_Drain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cluster.ClusterDrainRequest
+		if args[1] != nil {
+			arg1 = args[1].(*cluster.ClusterDrainRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ClusterServiceServer_Drain_Call) Return(clusterDrainResponse *cluster.ClusterDrainResponse, err error) *ClusterServiceServer_Drain_Call {
+	_c.Call.Return(clusterDrainResponse, err)
+	return _c
+}
+
+func (_c *ClusterServiceServer_Drain_Call) RunAndReturn(run func(context1 context.Context, clusterDrainRequest *cluster.ClusterDrainRequest) (*cluster.ClusterDrainResponse, error)) *ClusterServiceServer_Drain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type ClusterServiceServer
 func (_mock *ClusterServiceServer) Get(context1 context.Context, clusterQuery *cluster.ClusterQuery) (*v1alpha1.Cluster, error) {
 	ret := _mock.Called(context1, clusterQuery)
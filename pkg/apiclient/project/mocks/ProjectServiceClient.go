@@ -999,6 +999,93 @@ func (_c *ProjectServiceClient_ListLinks_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// UnlockSyncWindow provides a mock function for the type ProjectServiceClient
+func (_mock *ProjectServiceClient) UnlockSyncWindow(ctx context.Context, in *project.SyncWindowUnlockRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockSyncWindow")
+	}
+
+	var r0 *v1alpha1.AppProject
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.SyncWindowUnlockRequest, ...grpc.CallOption) (*v1alpha1.AppProject, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *project.SyncWindowUnlockRequest, ...grpc.CallOption) *v1alpha1.AppProject); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.AppProject)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *project.SyncWindowUnlockRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ProjectServiceClient_UnlockSyncWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockSyncWindow'
+type ProjectServiceClient_UnlockSyncWindow_Call struct {
+	*mock.Call
+}
+
+// UnlockSyncWindow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *project.SyncWindowUnlockRequest
+//   - opts ...grpc.CallOption
+func (_e *ProjectServiceClient_Expecter) UnlockSyncWindow(ctx any, in any, opts ...any) *ProjectServiceClient_UnlockSyncWindow_Call {
+	return &ProjectServiceClient_UnlockSyncWindow_Call{Call: _e.mock.On("UnlockSyncWindow",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *ProjectServiceClient_UnlockSyncWindow_Call) Run(run func(ctx context.Context, in *project.SyncWindowUnlockRequest, opts ...grpc.CallOption)) *ProjectServiceClient_UnlockSyncWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *project.SyncWindowUnlockRequest
+		if args[1] != nil {
+			arg1 = args[1].(*project.SyncWindowUnlockRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *ProjectServiceClient_UnlockSyncWindow_Call) Return(appProject *v1alpha1.AppProject, err error) *ProjectServiceClient_UnlockSyncWindow_Call {
+	_c.Call.Return(appProject, err)
+	return _c
+}
+
+func (_c *ProjectServiceClient_UnlockSyncWindow_Call) RunAndReturn(run func(ctx context.Context, in *project.SyncWindowUnlockRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)) *ProjectServiceClient_UnlockSyncWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type ProjectServiceClient
 func (_mock *ProjectServiceClient) Update(ctx context.Context, in *project.ProjectUpdateRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
 	// grpc.CallOption
@@ -519,6 +519,80 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+// SyncWindowUnlockRequest unlocks a sync window which requires an approval
+type SyncWindowUnlockRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Id is the index of the sync window in the project
+	Id int32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Duration of the unlock, defaults to the duration of the sync window
+	Duration             string   `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowUnlockRequest) Reset()         { *m = SyncWindowUnlockRequest{} }
+func (m *SyncWindowUnlockRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowUnlockRequest) ProtoMessage()    {}
+func (*SyncWindowUnlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
+}
+func (m *SyncWindowUnlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowUnlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowUnlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowUnlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowUnlockRequest.Merge(m, src)
+}
+func (m *SyncWindowUnlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowUnlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowUnlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowUnlockRequest proto.InternalMessageInfo
+
+func (m *SyncWindowUnlockRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncWindowUnlockRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SyncWindowUnlockRequest) GetDuration() string {
+	if m != nil {
+		return m.Duration
+	}
+	return ""
+}
+
+func (m *SyncWindowUnlockRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -530,7 +604,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +654,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +722,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +768,7 @@ func init() {
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
+	proto.RegisterType((*SyncWindowUnlockRequest)(nil), "project.SyncWindowUnlockRequest")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
@@ -702,70 +777,75 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 997 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x97, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0x3b, 0xbb, 0xdb, 0x75, 0x43, 0xb7, 0x0d, 0x83,
-	0xb6, 0x8a, 0x0a, 0xb5, 0xd5, 0x06, 0x24, 0xfe, 0x9c, 0xd8, 0x6e, 0x15, 0x90, 0x7a, 0x00, 0x17,
-	0x04, 0xe2, 0xb0, 0xc8, 0xb1, 0x9f, 0xb2, 0x43, 0x1c, 0x7b, 0xf0, 0x4c, 0xb2, 0x0d, 0x51, 0x2f,
-	0x48, 0x80, 0xc4, 0x81, 0xc3, 0x72, 0xe2, 0x0b, 0xf0, 0x3d, 0xb8, 0x71, 0x44, 0xe2, 0x0b, 0xa0,
-	0x8a, 0x0f, 0x82, 0x3c, 0x1e, 0x3b, 0x71, 0x52, 0xc3, 0xa2, 0x06, 0x4e, 0x1e, 0x8f, 0xdf, 0xfc,
-	0x7e, 0xbf, 0xf7, 0x66, 0xe6, 0x37, 0x63, 0xd8, 0x16, 0x18, 0x0f, 0x31, 0xb6, 0x79, 0x1c, 0x7d,
-	0x81, 0x9e, 0xcc, 0x9e, 0x16, 0x8f, 0x23, 0x19, 0x91, 0x1b, 0xfa, 0xb5, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0xcb, 0x99, 0xed, 0x86, 0x61, 0x24, 0x5d, 0xc9, 0xa2, 0x50, 0xa4, 0x61, 0xf5,
-	0xd3, 0x2e, 0x93, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x1b, 0x77, 0xa3, 0x64, 0x94, 0x6a,
-	0x1c, 0x78, 0xbe, 0x3d, 0x6c, 0xd9, 0xbc, 0xd7, 0x4d, 0x46, 0x0a, 0xdb, 0xe5, 0x3c, 0x60, 0x9e,
-	0x1a, 0x6b, 0x0f, 0x0f, 0xdd, 0x80, 0x3f, 0x71, 0x0f, 0xed, 0x2e, 0x86, 0x18, 0xbb, 0x12, 0x7d,
-	0x8d, 0x76, 0xfc, 0x0f, 0x68, 0x5a, 0xf1, 0x34, 0xd6, 0x54, 0x5b, 0x83, 0xbc, 0xf5, 0x7c, 0x20,
-	0x38, 0xc4, 0x50, 0x0a, 0xfd, 0x48, 0x87, 0xd2, 0x67, 0x06, 0xdc, 0xf9, 0x20, 0xcd, 0xfb, 0x38,
-	0x46, 0x57, 0xa2, 0x83, 0x5f, 0x0e, 0x50, 0x48, 0xd2, 0x81, 0xac, 0x1e, 0xa6, 0xd1, 0x30, 0x9a,
-	0xab, 0x47, 0xef, 0x59, 0x13, 0x16, 0x2b, 0x63, 0x51, 0x8d, 0xcf, 0x3d, 0xdf, 0x1a, 0xb6, 0x2c,
-	0xde, 0xeb, 0x5a, 0x49, 0xe2, 0xd6, 0xb4, 0xc0, 0x2c, 0x71, 0xeb, 0x5d, 0xce, 0x35, 0x8f, 0x93,
-	0x01, 0x93, 0x4d, 0xa8, 0x0d, 0xb8, 0xc0, 0x58, 0x9a, 0x95, 0x86, 0xd1, 0xbc, 0xe9, 0xe8, 0x37,
-	0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x51, 0xd4, 0xc3, 0xf0, 0x11, 0x06, 0x38, 0x11, 0x66, 0x16, 0x85,
-	0xad, 0x4c, 0xe0, 0x08, 0x2c, 0xc5, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x6d, 0xb2, 0x01, 0x55,
-	0xe6, 0x4a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x27, 0x69, 0x92, 0x75, 0xa8, 0x30, 0xdf, 0x5c, 0x52,
-	0x31, 0x15, 0xe6, 0xd3, 0x9f, 0x8c, 0x22, 0x5b, 0xb1, 0x0c, 0xe5, 0x6c, 0x0d, 0x58, 0xf5, 0x51,
-	0x78, 0x31, 0xe3, 0x49, 0xa2, 0x9a, 0x74, 0xba, 0x2b, 0xd7, 0x53, 0x9d, 0xd2, 0xb3, 0x0d, 0x2b,
-	0x78, 0xce, 0x59, 0x8c, 0xe2, 0xfd, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x0e, 0xad, 0x6d, 0x39, 0xd7,
-	0xf6, 0x5a, 0x3e, 0x39, 0x4a, 0x9a, 0x83, 0x82, 0x47, 0xa1, 0x40, 0x72, 0x07, 0x96, 0x65, 0xd2,
-	0xa1, 0x35, 0xa5, 0x2f, 0x94, 0xc2, 0x9a, 0x8e, 0xfe, 0x70, 0x80, 0xf1, 0x28, 0xe1, 0x0f, 0xdd,
-	0x3e, 0xea, 0x20, 0xd5, 0xa6, 0x5f, 0xe5, 0x88, 0x1f, 0x73, 0xff, 0xff, 0x9d, 0x6e, 0xfa, 0x22,
-	0xbc, 0x70, 0xd2, 0xe7, 0x72, 0x94, 0xa5, 0x41, 0xf7, 0x60, 0xe3, 0x6c, 0x14, 0x7a, 0x9f, 0xb0,
-	0xd0, 0x8f, 0x9e, 0x8a, 0x72, 0xd1, 0x23, 0xb8, 0x3d, 0x15, 0x97, 0x57, 0xa1, 0x03, 0x37, 0x9e,
-	0xa6, 0x5d, 0xa6, 0xd1, 0xa8, 0x5e, 0x5f, 0xf3, 0x84, 0xc3, 0xc9, 0x80, 0xe9, 0x39, 0x6c, 0xb6,
-	0x83, 0xa8, 0xe3, 0x06, 0x3a, 0x9b, 0x09, 0xfb, 0x63, 0x58, 0x66, 0x12, 0xfb, 0x0b, 0xe2, 0x9e,
-	0xaa, 0x57, 0x0a, 0x4b, 0x7f, 0xa9, 0x82, 0xf9, 0x08, 0xa5, 0xcb, 0x02, 0xf4, 0xe7, 0xc8, 0x39,
-	0xac, 0x77, 0x0b, 0xb2, 0x16, 0xae, 0x62, 0x06, 0x7f, 0x7a, 0x81, 0x54, 0xfe, 0x2b, 0x3f, 0x08,
-	0x60, 0x2d, 0x46, 0x1e, 0x09, 0x26, 0xa3, 0x98, 0xa1, 0x30, 0xab, 0x8b, 0xc8, 0xc9, 0xc9, 0x10,
-	0x47, 0x4e, 0x01, 0x9d, 0xb8, 0x70, 0xd3, 0x0b, 0x06, 0x42, 0x62, 0x2c, 0xcc, 0x25, 0xc5, 0x74,
-	0x72, 0x3d, 0xa6, 0xe3, 0x14, 0xcd, 0xc9, 0x61, 0xe9, 0x01, 0xdc, 0x3b, 0x65, 0x42, 0xea, 0x44,
-	0x4f, 0x59, 0xd8, 0x13, 0xd9, 0x86, 0xbb, 0x62, 0x9d, 0x1f, 0x3d, 0x5b, 0x83, 0x75, 0x1d, 0x7b,
-	0x86, 0xf1, 0x90, 0x79, 0x48, 0xbe, 0x37, 0x60, 0x35, 0x75, 0x24, 0xe5, 0x00, 0x84, 0x5a, 0xd9,
-	0xa1, 0x55, 0xea, 0x59, 0xf5, 0xfb, 0x57, 0xc6, 0xe4, 0xbb, 0xee, 0xcd, 0xaf, 0x7f, 0xff, 0xf3,
-	0xc7, 0xca, 0x11, 0x3d, 0x50, 0x07, 0xdc, 0xf0, 0x30, 0x3b, 0x06, 0x85, 0x3d, 0xd6, 0xad, 0x0b,
-	0x3b, 0xf1, 0x2a, 0x61, 0x8f, 0x93, 0xc7, 0x85, 0xad, 0xdc, 0xe5, 0x6d, 0x63, 0x9f, 0x7c, 0x6b,
-	0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x27, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6, 0xb8, 0xf7, 0xdf,
-	0x51, 0x2a, 0xde, 0xd8, 0x6f, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xf2, 0x82, 0xfc, 0x60, 0x40,
-	0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0x56, 0x29, 0x7d, 0x49, 0x09, 0xbe, 0x4b,
-	0x37, 0x66, 0x05, 0x27, 0x95, 0xf9, 0xc6, 0x80, 0xa5, 0x64, 0xa6, 0xc9, 0xdd, 0x59, 0x39, 0xca,
-	0xd5, 0xea, 0xa7, 0x8b, 0x92, 0x91, 0x90, 0x50, 0x53, 0x49, 0x21, 0x64, 0x4e, 0x0a, 0x39, 0x07,
-	0xd2, 0x46, 0x39, 0x63, 0x1b, 0x65, 0xa2, 0x5e, 0xce, 0xbb, 0xcb, 0x7c, 0x86, 0x36, 0x15, 0x13,
-	0x25, 0x8d, 0xf9, 0x59, 0x4a, 0x56, 0xec, 0x85, 0xed, 0xeb, 0x91, 0xe4, 0x3b, 0x03, 0xaa, 0x6d,
-	0x2c, 0xe5, 0x5a, 0xdc, 0x3c, 0xec, 0x2a, 0x49, 0x5b, 0xe4, 0x5e, 0x89, 0x24, 0x32, 0x86, 0x5b,
-	0x6d, 0x94, 0x45, 0xd7, 0x2e, 0x93, 0xb5, 0x9b, 0x77, 0x5f, 0xed, 0xf2, 0xd4, 0x52, 0x6c, 0x4d,
-	0xb2, 0x57, 0x56, 0x80, 0xd4, 0x26, 0xf3, 0x09, 0xf8, 0xd9, 0x80, 0x5a, 0x7a, 0xb2, 0xce, 0xaf,
-	0xcc, 0xc2, 0x89, 0xbb, 0xc0, 0x8a, 0xb4, 0x94, 0xc6, 0x83, 0x7a, 0xb3, 0x74, 0x2b, 0x59, 0x7d,
-	0x94, 0xae, 0xef, 0x4a, 0xd7, 0x52, 0xa2, 0x93, 0x15, 0xfb, 0x29, 0xd4, 0xd2, 0x8d, 0x5a, 0x56,
-	0x9a, 0xb2, 0x8d, 0xab, 0xeb, 0xbf, 0x5f, 0x5a, 0xff, 0xc7, 0x00, 0xc9, 0x2a, 0x3d, 0x51, 0xd7,
-	0xcc, 0x32, 0xf4, 0x5b, 0x96, 0xbe, 0x86, 0xaa, 0x30, 0xb5, 0xaa, 0xf7, 0x14, 0x70, 0x83, 0xec,
-	0x94, 0x95, 0x3a, 0x1d, 0x41, 0xc6, 0x70, 0xbb, 0x8d, 0x72, 0xea, 0x42, 0x70, 0x26, 0x93, 0x72,
-	0x6f, 0xe5, 0x44, 0xb3, 0x77, 0x8a, 0xfa, 0xf6, 0x55, 0x9f, 0xf2, 0x84, 0x5e, 0x55, 0xbc, 0x0f,
-	0xc8, 0x2b, 0x65, 0xbc, 0x62, 0x14, 0x7a, 0xfa, 0x3e, 0x40, 0x38, 0xac, 0x24, 0x62, 0x95, 0x95,
-	0x93, 0x46, 0x8e, 0x5b, 0xe2, 0xf2, 0xf5, 0x7a, 0x61, 0xf2, 0xf4, 0x27, 0xcd, 0xfb, 0x40, 0xf1,
-	0xee, 0x92, 0xfb, 0x65, 0xbc, 0x41, 0x12, 0xfe, 0xf0, 0xe1, 0xaf, 0x97, 0x3b, 0xc6, 0x6f, 0x97,
-	0x3b, 0xc6, 0x1f, 0x97, 0x3b, 0xc6, 0x67, 0xaf, 0x3f, 0xdf, 0xdf, 0x87, 0x17, 0x30, 0x0c, 0xf3,
-	0x1f, 0x9c, 0x4e, 0x4d, 0x5d, 0xf6, 0x5b, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x27, 0x88,
-	0x4d, 0x01, 0x0d, 0x00, 0x00,
+	// 1075 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe5, 0x6c, 0x92, 0x36, 0x2f, 0x6d, 0x48, 0xa7, 0x69, 0xe2, 0x98, 0x34, 0x59, 0x06,
+	0x35, 0x5a, 0xa5, 0xc4, 0x26, 0x09, 0x20, 0x7e, 0x9c, 0x68, 0x1a, 0x05, 0xa4, 0x1c, 0xc0, 0xa1,
+	0x02, 0x71, 0x28, 0x72, 0xec, 0xa7, 0xed, 0xb0, 0x8e, 0xed, 0x7a, 0x66, 0xb7, 0x59, 0x56, 0xb9,
+	0x20, 0x01, 0x12, 0x07, 0x0e, 0x70, 0xe2, 0x1f, 0xe0, 0x4f, 0xe0, 0xce, 0x0d, 0x89, 0x0b, 0x12,
+	0xff, 0x00, 0x8a, 0xf8, 0x43, 0xd0, 0x8c, 0xc7, 0x5e, 0x3b, 0xbb, 0x2e, 0x45, 0x5d, 0x38, 0xed,
+	0x78, 0xf6, 0xcd, 0xf7, 0x7d, 0xde, 0x9b, 0xe7, 0x37, 0x63, 0x58, 0xe3, 0x98, 0xf6, 0x30, 0x75,
+	0x92, 0x34, 0xfe, 0x1c, 0x7d, 0x91, 0xff, 0xda, 0x49, 0x1a, 0x8b, 0x98, 0x5c, 0xd1, 0x8f, 0xd6,
+	0x5a, 0x3b, 0x8e, 0xdb, 0x21, 0x3a, 0x5e, 0xc2, 0x1c, 0x2f, 0x8a, 0x62, 0xe1, 0x09, 0x16, 0x47,
+	0x3c, 0x33, 0xb3, 0x8e, 0xda, 0x4c, 0x3c, 0xea, 0x9e, 0xd8, 0x7e, 0x7c, 0xea, 0x78, 0x69, 0x3b,
+	0x96, 0xab, 0xd4, 0x60, 0xdb, 0x0f, 0x9c, 0xde, 0x9e, 0x93, 0x74, 0xda, 0x72, 0x25, 0x77, 0xbc,
+	0x24, 0x09, 0x99, 0xaf, 0xd6, 0x3a, 0xbd, 0x1d, 0x2f, 0x4c, 0x1e, 0x79, 0x3b, 0x4e, 0x1b, 0x23,
+	0x4c, 0x3d, 0x81, 0x81, 0x56, 0xdb, 0xff, 0x07, 0x35, 0x4d, 0x5c, 0xd6, 0x2a, 0x8d, 0xb5, 0xc8,
+	0x5b, 0xcf, 0x26, 0x82, 0x3d, 0x8c, 0x04, 0xd7, 0x3f, 0xd9, 0x52, 0xfa, 0xbd, 0x01, 0x4b, 0x1f,
+	0x64, 0x71, 0xef, 0xa7, 0xe8, 0x09, 0x74, 0xf1, 0x71, 0x17, 0xb9, 0x20, 0x27, 0x90, 0xe7, 0xc3,
+	0x34, 0x9a, 0x46, 0x6b, 0x7e, 0xf7, 0x3d, 0x7b, 0xe8, 0xc5, 0xce, 0xbd, 0xa8, 0xc1, 0x67, 0x7e,
+	0x60, 0xf7, 0xf6, 0xec, 0xa4, 0xd3, 0xb6, 0x65, 0xe0, 0x76, 0x19, 0x30, 0x0f, 0xdc, 0x7e, 0x37,
+	0x49, 0xb4, 0x1f, 0x37, 0x17, 0x26, 0xcb, 0x30, 0xdb, 0x4d, 0x38, 0xa6, 0xc2, 0x9c, 0x6a, 0x1a,
+	0xad, 0xab, 0xae, 0x7e, 0xa2, 0x1d, 0x58, 0xd5, 0xb6, 0x1f, 0xc5, 0x1d, 0x8c, 0xee, 0x63, 0x88,
+	0x43, 0x30, 0xb3, 0x0a, 0x36, 0x37, 0x94, 0x23, 0x30, 0x9d, 0xc6, 0x21, 0x2a, 0xb1, 0x39, 0x57,
+	0x8d, 0xc9, 0x22, 0x34, 0x98, 0x27, 0xcc, 0x46, 0xd3, 0x68, 0x35, 0x5c, 0x39, 0x24, 0x0b, 0x30,
+	0xc5, 0x02, 0x73, 0x5a, 0xd9, 0x4c, 0xb1, 0x80, 0xfe, 0x68, 0x54, 0xbd, 0x55, 0xd3, 0x50, 0xef,
+	0xad, 0x09, 0xf3, 0x01, 0x72, 0x3f, 0x65, 0x89, 0x0c, 0x54, 0x3b, 0x2d, 0x4f, 0x15, 0x3c, 0x8d,
+	0x12, 0xcf, 0x1a, 0xcc, 0xe1, 0x59, 0xc2, 0x52, 0xe4, 0xef, 0x47, 0x0a, 0xa2, 0xe1, 0x0e, 0x27,
+	0x34, 0xdb, 0x4c, 0xc1, 0xf6, 0x0a, 0x2c, 0x95, 0xd1, 0x5c, 0xe4, 0x49, 0x1c, 0x71, 0x24, 0x4b,
+	0x30, 0x23, 0xe4, 0x84, 0x66, 0xca, 0x1e, 0x28, 0x85, 0x6b, 0xda, 0xfa, 0xc3, 0x2e, 0xa6, 0x7d,
+	0xe9, 0x3f, 0xf2, 0x4e, 0x51, 0x1b, 0xa9, 0x31, 0xfd, 0xa2, 0x50, 0x7c, 0x90, 0x04, 0xff, 0xef,
+	0x76, 0xd3, 0x17, 0xe0, 0xfa, 0xc1, 0x69, 0x22, 0xfa, 0x79, 0x18, 0x74, 0x13, 0x16, 0x8f, 0xfb,
+	0x91, 0xff, 0x31, 0x8b, 0x82, 0xf8, 0x09, 0xaf, 0x87, 0xee, 0xc3, 0xcd, 0x92, 0x5d, 0x91, 0x85,
+	0x13, 0xb8, 0xf2, 0x24, 0x9b, 0x32, 0x8d, 0x66, 0xe3, 0xf9, 0x99, 0x87, 0x3e, 0xdc, 0x5c, 0x98,
+	0x3e, 0x86, 0x95, 0xe1, 0xf4, 0x83, 0x28, 0x8c, 0xfd, 0x4e, 0x9e, 0xb2, 0x31, 0xa4, 0x7a, 0x03,
+	0x65, 0x2d, 0xcc, 0xc8, 0x0d, 0x24, 0x16, 0x5c, 0x0d, 0xba, 0xa9, 0xf2, 0xa3, 0xcb, 0xa0, 0x78,
+	0x96, 0xd5, 0x9f, 0xa2, 0xc7, 0xe3, 0x48, 0x17, 0xa3, 0x7e, 0xa2, 0x67, 0xb0, 0x7c, 0x18, 0xc6,
+	0x27, 0x5e, 0xa8, 0x13, 0x38, 0x0c, 0xf8, 0x21, 0xcc, 0x30, 0x81, 0xa7, 0x13, 0x0a, 0xb7, 0xb4,
+	0x45, 0x99, 0x2c, 0xfd, 0xa5, 0x01, 0xe6, 0x7d, 0x14, 0x1e, 0x0b, 0x31, 0x18, 0x71, 0x9e, 0xc0,
+	0x42, 0xbb, 0x82, 0x35, 0x71, 0x8a, 0x4b, 0xfa, 0xe5, 0x9a, 0x9c, 0xfa, 0xaf, 0x5a, 0x50, 0x08,
+	0xd7, 0x52, 0x4c, 0x62, 0xce, 0x44, 0x9c, 0x32, 0xe4, 0x66, 0x63, 0x12, 0x31, 0xb9, 0xb9, 0x62,
+	0xdf, 0xad, 0xa8, 0x13, 0x0f, 0xae, 0xfa, 0x61, 0x97, 0x0b, 0x4c, 0xb9, 0x39, 0xad, 0x3c, 0x1d,
+	0x3c, 0x9f, 0xa7, 0xfd, 0x4c, 0xcd, 0x2d, 0x64, 0xe9, 0x36, 0xac, 0x1c, 0x31, 0x2e, 0x74, 0xa0,
+	0x47, 0x2c, 0xea, 0xf0, 0xa7, 0x14, 0xec, 0xee, 0x6f, 0xd7, 0x61, 0x41, 0xdb, 0x1e, 0x63, 0xda,
+	0x63, 0x3e, 0x92, 0x6f, 0x0d, 0x98, 0xcf, 0x9a, 0xa0, 0x6a, 0x3a, 0x84, 0xda, 0xf9, 0x39, 0x59,
+	0xdb, 0x26, 0xad, 0xdb, 0x63, 0x6d, 0x8a, 0x17, 0xfd, 0xcd, 0x2f, 0xff, 0xf8, 0xeb, 0x87, 0xa9,
+	0x5d, 0xba, 0xad, 0xce, 0xd4, 0xde, 0x4e, 0x7e, 0xf2, 0x72, 0x67, 0xa0, 0x47, 0xe7, 0x8e, 0x6c,
+	0x8f, 0xdc, 0x19, 0xc8, 0x9f, 0x73, 0x47, 0x35, 0xb4, 0xb7, 0x8d, 0x2d, 0xf2, 0xb5, 0x01, 0xf3,
+	0x59, 0xff, 0x7f, 0x1a, 0x4c, 0xe5, 0x84, 0xb0, 0x96, 0x0b, 0x9b, 0x6a, 0xbb, 0x79, 0x47, 0x51,
+	0xbc, 0xbe, 0xb5, 0xf7, 0xaf, 0x28, 0x9c, 0x01, 0xf3, 0xc4, 0x39, 0xf9, 0xce, 0x80, 0xd9, 0x2c,
+	0x66, 0x32, 0x12, 0x6c, 0x35, 0x17, 0x13, 0xab, 0x52, 0xfa, 0xa2, 0x02, 0xbe, 0x45, 0x17, 0x2f,
+	0x03, 0xcb, 0xcc, 0x7c, 0x65, 0xc0, 0xb4, 0xdc, 0x69, 0x72, 0xeb, 0x32, 0x8e, 0x6a, 0xa4, 0xd6,
+	0xd1, 0xa4, 0x30, 0xa4, 0x13, 0x6a, 0x2a, 0x14, 0x42, 0x46, 0x50, 0xc8, 0x19, 0x90, 0x43, 0x14,
+	0x97, 0xda, 0x46, 0x1d, 0xd4, 0x4b, 0xc5, 0x74, 0x5d, 0x9f, 0xa1, 0x2d, 0xe5, 0x89, 0x92, 0xe6,
+	0xe8, 0x2e, 0xc9, 0x8a, 0x3d, 0x77, 0x02, 0xbd, 0x92, 0x7c, 0x63, 0x40, 0xe3, 0x10, 0x6b, 0x7d,
+	0x4d, 0x6e, 0x1f, 0x36, 0x14, 0xd2, 0x2a, 0x59, 0xa9, 0x41, 0x22, 0x03, 0xb8, 0x71, 0x88, 0xa2,
+	0xda, 0xb5, 0xeb, 0xb0, 0x36, 0x8a, 0xe9, 0xf1, 0x5d, 0x9e, 0xda, 0xca, 0x5b, 0x8b, 0x6c, 0xd6,
+	0x25, 0x20, 0x6b, 0x93, 0xc5, 0x06, 0xfc, 0x64, 0xc0, 0x6c, 0x76, 0x98, 0x8f, 0x56, 0x66, 0xe5,
+	0x90, 0x9f, 0x60, 0x46, 0xf6, 0x14, 0xe3, 0xb6, 0xd5, 0xaa, 0x7d, 0x95, 0xec, 0x53, 0x14, 0x5e,
+	0xe0, 0x09, 0xcf, 0x56, 0xd0, 0xb2, 0x62, 0x3f, 0x81, 0xd9, 0xec, 0x45, 0xad, 0x4b, 0x4d, 0xdd,
+	0x8b, 0xab, 0xf3, 0xbf, 0x55, 0x9b, 0xff, 0x87, 0x00, 0xb2, 0x4a, 0x0f, 0xd4, 0xcd, 0xb6, 0x4e,
+	0xfd, 0x86, 0xad, 0x6f, 0xbe, 0xca, 0x4c, 0x55, 0xf5, 0xa6, 0x12, 0x6e, 0x92, 0xf5, 0xba, 0x54,
+	0x67, 0x2b, 0xc8, 0x00, 0x6e, 0x1e, 0xa2, 0x28, 0xdd, 0x41, 0x8e, 0x85, 0x4c, 0xf7, 0x6a, 0xe1,
+	0xe8, 0xf2, 0x35, 0xc6, 0x5a, 0x1b, 0xf7, 0x57, 0x11, 0xd0, 0x5d, 0xe5, 0xf7, 0x0e, 0x79, 0xb9,
+	0xce, 0x2f, 0xef, 0x47, 0xbe, 0xbe, 0x82, 0x90, 0x9f, 0x0d, 0x58, 0xcc, 0x6e, 0x1e, 0x43, 0x29,
+	0xd2, 0x1c, 0xa3, 0x5f, 0xb9, 0x9e, 0x4c, 0x70, 0xb3, 0xdf, 0x50, 0xb4, 0xaf, 0xd2, 0xbb, 0x75,
+	0xb4, 0x9a, 0xd4, 0x19, 0xb0, 0xe0, 0xdc, 0xe9, 0x2a, 0x0a, 0xb9, 0xdf, 0x09, 0xcc, 0xc9, 0x2c,
+	0xab, 0x33, 0xa8, 0x04, 0x5c, 0x73, 0x3c, 0x59, 0x56, 0x05, 0x44, 0xff, 0xa5, 0x13, 0x76, 0x47,
+	0x21, 0x6c, 0x90, 0xdb, 0x75, 0x08, 0xa1, 0x34, 0xbf, 0x77, 0xef, 0xd7, 0x8b, 0x75, 0xe3, 0xf7,
+	0x8b, 0x75, 0xe3, 0xcf, 0x8b, 0x75, 0xe3, 0xd3, 0xd7, 0x9e, 0xed, 0x4b, 0xcd, 0x0f, 0x19, 0x46,
+	0xc5, 0xc7, 0xe0, 0xc9, 0xac, 0xfa, 0x30, 0xda, 0xfb, 0x7b, 0x00, 0x5c, 0x0e, 0xef, 0x91, 0x2d,
+	0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +882,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// UnlockSyncWindow allows the syncs during a sync window which requires an approval until the unlock expires
+	UnlockSyncWindow(ctx context.Context, in *SyncWindowUnlockRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +995,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) UnlockSyncWindow(ctx context.Context, in *SyncWindowUnlockRequest, opts ...grpc.CallOption) (*v1alpha1.AppProject, error) {
+	out := new(v1alpha1.AppProject)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/UnlockSyncWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +1037,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*events.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// UnlockSyncWindow allows the syncs during a sync window which requires an approval until the unlock expires
+	UnlockSyncWindow(context.Context, *SyncWindowUnlockRequest) (*v1alpha1.AppProject, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1080,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) UnlockSyncWindow(ctx context.Context, req *SyncWindowUnlockRequest) (*v1alpha1.AppProject, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockSyncWindow not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1289,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_UnlockSyncWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncWindowUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).UnlockSyncWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/UnlockSyncWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).UnlockSyncWindow(ctx, req.(*SyncWindowUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1373,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "UnlockSyncWindow",
+			Handler:    _ProjectService_UnlockSyncWindow_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
@@ -1639,6 +1757,59 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowUnlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowUnlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowUnlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Duration) > 0 {
+		i -= len(m.Duration)
+		copy(dAtA[i:], m.Duration)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Duration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GlobalProjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1977,6 +2148,33 @@ func (m *SyncWindowsResponse) Size() (n int) {
 	return n
 }
 
+func (m *SyncWindowUnlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovProject(uint64(m.Id))
+	}
+	l = len(m.Duration)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GlobalProjectsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2994,6 +3192,172 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWindowUnlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowUnlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowUnlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -82,7 +82,9 @@ func (m *RepoCredsQuery) GetUrl() string {
 }
 
 type RepoCredsDeleteRequest struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Project of the project scoped credential set
+	Project              string   `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -128,6 +130,13 @@ func (m *RepoCredsDeleteRequest) GetUrl() string {
 	return ""
 }
 
+func (m *RepoCredsDeleteRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
 // RepoCredsResponse is a response to most repository credentials requests
 type RepoCredsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -285,43 +294,44 @@ func init() {
 func init() { proto.RegisterFile("server/repocreds/repocreds.proto", fileDescriptor_b0b5fce4710a8821) }
 
 var fileDescriptor_b0b5fce4710a8821 = []byte{
-	// 571 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x99, 0x8a, 0xc5, 0x8e, 0x20, 0x6d, 0x0a, 0x6d, 0x37, 0x6d, 0xb7, 0x31, 0x62, 0x29,
-	0x4b, 0x3b, 0x61, 0x77, 0xc1, 0x83, 0x47, 0x5b, 0xf0, 0x60, 0x2f, 0xae, 0x88, 0x20, 0x88, 0x4c,
-	0xb3, 0x8f, 0x74, 0x6c, 0xcc, 0x8c, 0x33, 0x93, 0x94, 0x22, 0x22, 0x78, 0xf4, 0xe2, 0xc1, 0xbb,
-	0x77, 0xf1, 0xae, 0x77, 0x4f, 0x1e, 0x85, 0x7e, 0x01, 0x59, 0xfc, 0x20, 0x32, 0x93, 0xcd, 0x66,
-	0x97, 0x66, 0x65, 0x17, 0xd6, 0x7a, 0x7b, 0x49, 0x5e, 0xde, 0xfb, 0xfd, 0xff, 0xf3, 0x66, 0x06,
-	0x7b, 0x0a, 0x64, 0x06, 0x32, 0x90, 0x20, 0x78, 0x28, 0xa1, 0xab, 0xca, 0x88, 0x08, 0xc9, 0x35,
-	0x77, 0x16, 0x06, 0x2f, 0xdc, 0x8d, 0x88, 0xf3, 0x28, 0x86, 0x80, 0x0a, 0x16, 0xd0, 0x24, 0xe1,
-	0x9a, 0x6a, 0xc6, 0x93, 0x7e, 0xa2, 0x7b, 0x18, 0x31, 0x7d, 0x9c, 0x1e, 0x91, 0x90, 0xbf, 0x0c,
-	0xa8, 0x8c, 0xb8, 0x90, 0xfc, 0x85, 0x0d, 0xf6, 0xc2, 0x6e, 0x90, 0xb5, 0x03, 0x71, 0x12, 0x99,
-	0x3f, 0x55, 0x40, 0x85, 0x88, 0x59, 0x68, 0xff, 0x0d, 0xb2, 0x26, 0x8d, 0xc5, 0x31, 0x6d, 0x06,
-	0x11, 0x24, 0x20, 0xa9, 0x86, 0x6e, 0x5e, 0xcd, 0xf7, 0xf1, 0x8d, 0x0e, 0x08, 0xbe, 0x6f, 0x1a,
-	0x3f, 0x4c, 0x41, 0x9e, 0x39, 0x8b, 0xf8, 0x4a, 0x2a, 0xe3, 0x35, 0xe4, 0xa1, 0x9d, 0x85, 0x8e,
-	0x09, 0xfd, 0x06, 0x5e, 0x19, 0xe4, 0x1c, 0x40, 0x0c, 0x1a, 0x3a, 0xf0, 0x2a, 0x05, 0xa5, 0x2b,
-	0x72, 0x97, 0xf1, 0xd2, 0x20, 0xb7, 0x03, 0x4a, 0xf0, 0x44, 0x81, 0xff, 0x01, 0x0d, 0x55, 0xd8,
-	0x97, 0x40, 0xcb, 0x0a, 0xcf, 0xf0, 0x55, 0x2b, 0xda, 0xd6, 0xb8, 0xde, 0xba, 0x4f, 0x4a, 0x75,
-	0xa4, 0x50, 0x67, 0x83, 0xe7, 0x61, 0x97, 0x64, 0x6d, 0x22, 0x4e, 0x22, 0x62, 0xd4, 0x91, 0x21,
-	0x75, 0xa4, 0x50, 0x47, 0xca, 0xd6, 0x79, 0x55, 0x67, 0x05, 0xcf, 0xa7, 0x42, 0x81, 0xd4, 0x6b,
-	0x73, 0x1e, 0xda, 0xb9, 0xd6, 0xe9, 0x3f, 0xf9, 0xa7, 0x43, 0x40, 0x8f, 0x45, 0xf7, 0xd2, 0x80,
-	0x5a, 0xe7, 0x18, 0x2f, 0x0e, 0x5e, 0x3e, 0x02, 0x99, 0xb1, 0x10, 0x9c, 0x4f, 0x08, 0xd7, 0x0e,
-	0x99, 0xd2, 0xe6, 0x83, 0x62, 0x9a, 0xcb, 0x33, 0xf3, 0x19, 0x12, 0xcd, 0x68, 0xac, 0x9c, 0x1a,
-	0x29, 0x67, 0x65, 0x74, 0xad, 0xdc, 0x07, 0x33, 0xa2, 0x33, 0xcd, 0xfd, 0xda, 0xbb, 0xf3, 0xdf,
-	0x1f, 0xe7, 0x96, 0x9d, 0x25, 0x3b, 0x78, 0x59, 0xb3, 0x1c, 0x51, 0xe7, 0x33, 0xc2, 0x75, 0x93,
-	0xf3, 0x44, 0x32, 0xe3, 0xd4, 0xff, 0xa4, 0xdc, 0xb2, 0x94, 0x35, 0x67, 0xb5, 0xa0, 0x3c, 0x35,
-	0x4c, 0x7b, 0x25, 0xeb, 0x17, 0x84, 0xd7, 0x8b, 0x19, 0xab, 0x02, 0xbd, 0x59, 0x05, 0x3a, 0x32,
-	0x94, 0xee, 0xac, 0x16, 0xdd, 0xf7, 0x2c, 0xac, 0xeb, 0x5f, 0xb4, 0xf4, 0x6e, 0x7f, 0x40, 0xbf,
-	0x22, 0xec, 0xe5, 0xcd, 0xff, 0xe2, 0xed, 0x65, 0x22, 0x6f, 0x5b, 0x64, 0xcf, 0x1f, 0xe7, 0x6f,
-	0x01, 0xfe, 0x0d, 0xe1, 0xf5, 0x62, 0xe7, 0x4c, 0xcc, 0x3c, 0xb2, 0xd5, 0x66, 0xc7, 0xbc, 0x6b,
-	0x99, 0xb7, 0xdd, 0xcd, 0x0b, 0x36, 0x07, 0xaf, 0x73, 0x82, 0x54, 0xc6, 0x6f, 0x0a, 0xf2, 0xef,
-	0x08, 0x7b, 0x39, 0xc8, 0xb4, 0x96, 0xff, 0x23, 0xfc, 0x96, 0xc5, 0xdf, 0x75, 0x6f, 0x8d, 0xb1,
-	0xbc, 0x4a, 0xc4, 0x5b, 0xbc, 0x5e, 0x1c, 0xc5, 0x13, 0xe3, 0x8f, 0x9c, 0xdd, 0xee, 0x46, 0x55,
-	0xca, 0xe0, 0xc8, 0xee, 0x6f, 0xb3, 0xc6, 0x6a, 0x85, 0xa5, 0x86, 0xc3, 0x79, 0x8f, 0xb0, 0x97,
-	0x17, 0x9c, 0xd6, 0xc5, 0x69, 0x30, 0x6e, 0x5b, 0x8c, 0xad, 0xc6, 0xe6, 0x58, 0x6b, 0x0c, 0xcc,
-	0xbd, 0x83, 0x1f, 0xbd, 0x3a, 0xfa, 0xd9, 0xab, 0xa3, 0x5f, 0xbd, 0x3a, 0x7a, 0x7a, 0x67, 0xb2,
-	0x1b, 0x32, 0x8c, 0x19, 0x24, 0xba, 0x14, 0x76, 0x34, 0x6f, 0xaf, 0xc4, 0xf6, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x25, 0xb3, 0xca, 0x8a, 0xad, 0x07, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x99, 0x8a, 0xd5, 0x8e, 0x20, 0xed, 0x16, 0xda, 0x66, 0xd3, 0xa6, 0xeb, 0x8a, 0x45,
+	0x4a, 0x3b, 0x4b, 0x12, 0xf0, 0xe0, 0xd1, 0x06, 0x3c, 0xd8, 0x8b, 0x11, 0x11, 0x04, 0x91, 0xe9,
+	0xe6, 0xb1, 0x1d, 0xbb, 0xee, 0x8c, 0x33, 0xb3, 0x5b, 0x8a, 0x88, 0xe0, 0xd1, 0x8b, 0x07, 0xef,
+	0xde, 0xc5, 0xbb, 0xde, 0x3d, 0x79, 0x14, 0xfa, 0x05, 0x24, 0xf8, 0x41, 0x64, 0x66, 0xb3, 0xd9,
+	0x84, 0x24, 0x92, 0x40, 0xac, 0xa7, 0x7d, 0x3b, 0xf3, 0xe6, 0xbd, 0xdf, 0xfb, 0xcf, 0x9b, 0x19,
+	0xec, 0x29, 0x90, 0x19, 0xc8, 0x40, 0x82, 0xe0, 0xa1, 0x84, 0x8e, 0x2a, 0x2d, 0x22, 0x24, 0xd7,
+	0xdc, 0x59, 0xea, 0x0f, 0xb8, 0x9b, 0x11, 0xe7, 0x51, 0x0c, 0x01, 0x15, 0x2c, 0xa0, 0x49, 0xc2,
+	0x35, 0xd5, 0x8c, 0x27, 0x3d, 0x47, 0xf7, 0x30, 0x62, 0xfa, 0x38, 0x3d, 0x22, 0x21, 0x7f, 0x19,
+	0x50, 0x19, 0x71, 0x21, 0xf9, 0x0b, 0x6b, 0xec, 0x87, 0x9d, 0x20, 0x6b, 0x06, 0xe2, 0x24, 0x32,
+	0x2b, 0x55, 0x40, 0x85, 0x88, 0x59, 0x68, 0xd7, 0x06, 0x59, 0x9d, 0xc6, 0xe2, 0x98, 0xd6, 0x83,
+	0x08, 0x12, 0x90, 0x54, 0x43, 0x27, 0x8f, 0xe6, 0xfb, 0xf8, 0x7a, 0x1b, 0x04, 0x3f, 0x30, 0x89,
+	0x1f, 0xa6, 0x20, 0xcf, 0x9c, 0x65, 0x7c, 0x29, 0x95, 0xf1, 0x06, 0xf2, 0xd0, 0xed, 0xa5, 0xb6,
+	0x31, 0xfd, 0x16, 0x5e, 0xeb, 0xfb, 0xb4, 0x20, 0x06, 0x0d, 0x6d, 0x78, 0x95, 0x82, 0xd2, 0xa3,
+	0xbe, 0xce, 0x06, 0xbe, 0x62, 0x80, 0x20, 0xd4, 0x1b, 0x0b, 0x76, 0xb4, 0xf8, 0xf5, 0x57, 0xf1,
+	0x4a, 0x3f, 0x4a, 0x1b, 0x94, 0xe0, 0x89, 0x02, 0xff, 0x03, 0x1a, 0x88, 0x7d, 0x20, 0x81, 0x96,
+	0xb1, 0x9f, 0xe1, 0xcb, 0x56, 0x0e, 0x1b, 0xfd, 0x5a, 0xe3, 0x3e, 0x29, 0xeb, 0x26, 0x45, 0xdd,
+	0xd6, 0x78, 0x1e, 0x76, 0x48, 0xd6, 0x24, 0xe2, 0x24, 0x22, 0xa6, 0x6e, 0x32, 0x50, 0x37, 0x29,
+	0xea, 0x26, 0x65, 0xea, 0x3c, 0xaa, 0xb3, 0x86, 0x17, 0x53, 0xa1, 0x40, 0xe6, 0x9c, 0x57, 0xdb,
+	0xbd, 0x3f, 0xff, 0x74, 0x00, 0xe8, 0xb1, 0xe8, 0x5c, 0x18, 0x50, 0xe3, 0x1c, 0xe3, 0xe5, 0xfe,
+	0xe0, 0x23, 0x90, 0x19, 0x0b, 0xc1, 0xf9, 0x84, 0x70, 0xe5, 0x90, 0x29, 0x6d, 0x26, 0x14, 0xd3,
+	0x5c, 0x9e, 0x99, 0x69, 0x48, 0x34, 0xa3, 0xb1, 0x72, 0x2a, 0xa4, 0xec, 0xa2, 0xe1, 0x5d, 0x74,
+	0x1f, 0xcc, 0x89, 0xce, 0x24, 0xf7, 0x2b, 0xef, 0xce, 0x7f, 0x7f, 0x5c, 0x58, 0x75, 0x56, 0x6c,
+	0x4b, 0x66, 0xf5, 0xb2, 0x79, 0x9d, 0xcf, 0x08, 0xd7, 0x8c, 0xcf, 0x13, 0xc9, 0x34, 0xfc, 0x5f,
+	0xca, 0x6d, 0x4b, 0x59, 0x71, 0xd6, 0x0b, 0xca, 0x53, 0xc3, 0xb4, 0x5f, 0xb2, 0x7e, 0x41, 0xb8,
+	0x5a, 0xf4, 0xd8, 0x38, 0xd0, 0x1b, 0xe3, 0x40, 0x87, 0x9a, 0xd2, 0x9d, 0xd7, 0xa6, 0xfb, 0x9e,
+	0x85, 0x75, 0xfd, 0x51, 0x49, 0xef, 0xf6, 0x1a, 0xf4, 0x2b, 0xc2, 0x5e, 0x9e, 0xfc, 0x2f, 0xda,
+	0x5e, 0x24, 0xf2, 0x8e, 0x45, 0xf6, 0xfc, 0x49, 0xfa, 0x16, 0xe0, 0xdf, 0x10, 0xae, 0x16, 0x27,
+	0x67, 0x6a, 0xe6, 0xa1, 0xa3, 0x36, 0x3f, 0xe6, 0x3d, 0xcb, 0xbc, 0xe3, 0x6e, 0x8d, 0xc8, 0x1c,
+	0xbc, 0xb6, 0x1f, 0x92, 0xca, 0xf8, 0x4d, 0x41, 0xfe, 0x1d, 0x61, 0x2f, 0x07, 0x99, 0x55, 0xf2,
+	0x7f, 0x84, 0xdf, 0xb0, 0xf8, 0x7b, 0xee, 0xcd, 0x09, 0x92, 0x8f, 0x2b, 0xe2, 0x2d, 0xae, 0x16,
+	0x97, 0xf4, 0xd4, 0xf8, 0x43, 0xb7, 0xba, 0xbb, 0x39, 0xce, 0xa5, 0x7f, 0x65, 0xf7, 0x8e, 0xd9,
+	0xee, 0xfa, 0x18, 0x49, 0x0d, 0x87, 0xf3, 0x1e, 0x61, 0x2f, 0x0f, 0x38, 0xab, 0x8a, 0xb3, 0x60,
+	0xdc, 0xb2, 0x18, 0xdb, 0xbb, 0x5b, 0x13, 0xa5, 0x31, 0x30, 0xf7, 0x5a, 0x3f, 0xba, 0x35, 0xf4,
+	0xb3, 0x5b, 0x43, 0xbf, 0xba, 0x35, 0xf4, 0xf4, 0xce, 0x74, 0x6f, 0x67, 0x18, 0x33, 0x48, 0x74,
+	0x59, 0xd8, 0xd1, 0xa2, 0x7d, 0x2c, 0x9b, 0x7f, 0x06, 0x00, 0x97, 0x33, 0xc1, 0xf9, 0xc7, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -730,6 +740,13 @@ func (m *RepoCredsDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Project) > 0 {
+		i -= len(m.Project)
+		copy(dAtA[i:], m.Project)
+		i = encodeVarintRepocreds(dAtA, i, uint64(len(m.Project)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Url) > 0 {
 		i -= len(m.Url)
 		copy(dAtA[i:], m.Url)
@@ -892,6 +909,10 @@ func (m *RepoCredsDeleteRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepocreds(uint64(l))
 	}
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovRepocreds(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1095,6 +1116,38 @@ func (m *RepoCredsDeleteRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepocreds
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepocreds
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepocreds
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepocreds(dAtA[iNdEx:])
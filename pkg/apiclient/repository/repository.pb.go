@@ -409,10 +409,12 @@ type RepoAccessQuery struct {
 	// Azure Service Principal Tenant ID
 	AzureServicePrincipalTenantId string `protobuf:"bytes,25,opt,name=azureServicePrincipalTenantId,proto3" json:"azureServicePrincipalTenantId,omitempty"`
 	// Azure Active Directory Endpoint
-	AzureActiveDirectoryEndpoint string   `protobuf:"bytes,26,opt,name=azureActiveDirectoryEndpoint,proto3" json:"azureActiveDirectoryEndpoint,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{} `json:"-"`
-	XXX_unrecognized             []byte   `json:"-"`
-	XXX_sizecache                int32    `json:"-"`
+	AzureActiveDirectoryEndpoint string `protobuf:"bytes,26,opt,name=azureActiveDirectoryEndpoint,proto3" json:"azureActiveDirectoryEndpoint,omitempty"`
+	// Whether git-lfs support is enabled for the repo
+	EnableLfs            bool     `protobuf:"varint,27,opt,name=enableLfs,proto3" json:"enableLfs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RepoAccessQuery) Reset()         { *m = RepoAccessQuery{} }
@@ -623,6 +625,13 @@ func (m *RepoAccessQuery) GetAzureActiveDirectoryEndpoint() string {
 	return ""
 }
 
+func (m *RepoAccessQuery) GetEnableLfs() bool {
+	if m != nil {
+		return m.EnableLfs
+	}
+	return false
+}
+
 type RepoResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -793,99 +802,102 @@ func init() {
 }
 
 var fileDescriptor_8d38260443475705 = []byte{
-	// 1471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5f, 0x6f, 0x13, 0xc7,
-	0x16, 0xd7, 0x26, 0x24, 0x24, 0x13, 0x02, 0x66, 0x92, 0xc0, 0x62, 0x42, 0x08, 0x0b, 0x37, 0x0a,
-	0x11, 0xac, 0x49, 0xb8, 0x57, 0x17, 0x71, 0x75, 0x2b, 0x39, 0x7f, 0x0a, 0x56, 0xa3, 0x86, 0x1a,
-	0x28, 0x52, 0xd5, 0xaa, 0x9a, 0xac, 0x4f, 0xec, 0x25, 0x9b, 0xdd, 0x61, 0x66, 0x6c, 0x70, 0x11,
-	0x2f, 0x55, 0x55, 0x55, 0x6a, 0x5f, 0xaa, 0xaa, 0x55, 0xdf, 0xda, 0x87, 0x4a, 0x95, 0xda, 0xf7,
-	0x7e, 0x86, 0x3e, 0xb6, 0xea, 0x17, 0xa8, 0x50, 0x3f, 0x44, 0x1f, 0xab, 0x39, 0xb3, 0x5e, 0xaf,
-	0x13, 0x7b, 0x9d, 0x88, 0x90, 0xb7, 0x9d, 0x73, 0x8e, 0xcf, 0xef, 0x77, 0x7e, 0x73, 0xf6, 0xcc,
-	0x78, 0x89, 0x23, 0x41, 0x34, 0x40, 0x14, 0x04, 0xf0, 0x48, 0xfa, 0x2a, 0x12, 0xcd, 0xd4, 0xa3,
-	0xcb, 0x45, 0xa4, 0x22, 0x4a, 0xda, 0x96, 0xfc, 0x74, 0x35, 0x8a, 0xaa, 0x01, 0x14, 0x18, 0xf7,
-	0x0b, 0x2c, 0x0c, 0x23, 0xc5, 0x94, 0x1f, 0x85, 0xd2, 0x44, 0xe6, 0xd7, 0xab, 0xbe, 0xaa, 0xd5,
-	0x37, 0x5d, 0x2f, 0xda, 0x29, 0x30, 0x51, 0x8d, 0xb8, 0x88, 0x1e, 0xe3, 0xc3, 0x75, 0xaf, 0x52,
-	0x68, 0xdc, 0x2c, 0xf0, 0xed, 0xaa, 0xfe, 0xa5, 0x2c, 0x30, 0xce, 0x03, 0xdf, 0xc3, 0xdf, 0x16,
-	0x1a, 0x8b, 0x2c, 0xe0, 0x35, 0xb6, 0x58, 0xa8, 0x42, 0x08, 0x82, 0x29, 0xa8, 0xc4, 0xd9, 0xd6,
-	0xfa, 0x64, 0x43, 0x5a, 0x7d, 0xe9, 0x3b, 0x4d, 0x32, 0x5e, 0x06, 0x1e, 0x15, 0x39, 0x97, 0xef,
-	0xd4, 0x41, 0x34, 0x29, 0x25, 0xc7, 0x74, 0x90, 0x6d, 0xcd, 0x5a, 0xf3, 0xa3, 0x65, 0x7c, 0xa6,
-	0x79, 0x32, 0x22, 0xa0, 0xe1, 0x4b, 0x3f, 0x0a, 0xed, 0x01, 0xb4, 0x27, 0x6b, 0x6a, 0x93, 0xe3,
-	0x8c, 0xf3, 0xb7, 0xd9, 0x0e, 0xd8, 0x83, 0xe8, 0x6a, 0x2d, 0xe9, 0x0c, 0x21, 0x8c, 0xf3, 0x7b,
-	0x22, 0x7a, 0x0c, 0x9e, 0xb2, 0x8f, 0xa1, 0x33, 0x65, 0x71, 0x16, 0xc9, 0xf1, 0x22, 0xe7, 0xa5,
-	0x70, 0x2b, 0xd2, 0xa0, 0xaa, 0xc9, 0xa1, 0x05, 0xaa, 0x9f, 0xb5, 0x8d, 0x33, 0x55, 0x8b, 0x01,
-	0xf1, 0xd9, 0xf9, 0xdb, 0x22, 0x13, 0x31, 0xdd, 0x55, 0x50, 0xcc, 0x0f, 0x62, 0xd2, 0x55, 0x32,
-	0x2c, 0xa3, 0xba, 0xf0, 0x4c, 0x86, 0xb1, 0xa5, 0x0d, 0xb7, 0xad, 0x8e, 0xdb, 0x52, 0x07, 0x1f,
-	0x3e, 0xf4, 0x2a, 0x6e, 0xe3, 0xa6, 0xcb, 0xb7, 0xab, 0xae, 0xd6, 0xda, 0x4d, 0x69, 0xed, 0xb6,
-	0xb4, 0x76, 0x8b, 0x6d, 0xe3, 0x7d, 0x4c, 0x5b, 0x8e, 0xd3, 0xa7, 0xab, 0x1d, 0xc8, 0xaa, 0x76,
-	0x70, 0x77, 0xb5, 0x74, 0x96, 0x8c, 0x99, 0x1c, 0xa5, 0xb0, 0x02, 0xcf, 0x50, 0x8e, 0xa1, 0x72,
-	0xda, 0x44, 0xa7, 0xc9, 0x68, 0x03, 0x84, 0x16, 0xb5, 0x54, 0xb1, 0x87, 0xd0, 0xdf, 0x36, 0x38,
-	0xff, 0x27, 0xb9, 0xd6, 0x46, 0x95, 0x41, 0xf2, 0x28, 0x94, 0x40, 0xaf, 0x92, 0x21, 0x5f, 0xc1,
-	0x8e, 0xb4, 0xad, 0xd9, 0xc1, 0xf9, 0xb1, 0xa5, 0x09, 0x37, 0xb5, 0xbd, 0xb1, 0xb4, 0x65, 0x13,
-	0xe1, 0x78, 0x64, 0x54, 0xff, 0xbc, 0xf7, 0x1e, 0x3b, 0xe4, 0xc4, 0x56, 0xa4, 0x4b, 0x85, 0x2d,
-	0x01, 0xd2, 0xc8, 0x3e, 0x52, 0xee, 0xb0, 0xf5, 0xab, 0xd1, 0xf9, 0x7d, 0x84, 0x9c, 0x42, 0x92,
-	0x9e, 0x07, 0x32, 0xbb, 0x9f, 0xea, 0x12, 0x44, 0xd8, 0x96, 0x31, 0x59, 0x6b, 0x1f, 0x67, 0x52,
-	0x3e, 0x8d, 0x44, 0x25, 0x46, 0x48, 0xd6, 0xf4, 0x0a, 0x19, 0x97, 0xb2, 0x76, 0x4f, 0xf8, 0x0d,
-	0xa6, 0xe0, 0x2d, 0x68, 0xc6, 0x4d, 0xd5, 0x69, 0xd4, 0x19, 0xfc, 0x50, 0x82, 0x57, 0x17, 0x80,
-	0x32, 0x8e, 0x94, 0x93, 0x35, 0xbd, 0x46, 0x4e, 0xab, 0x40, 0xae, 0x04, 0x3e, 0x84, 0x6a, 0x05,
-	0x84, 0x5a, 0x65, 0x8a, 0xd9, 0xc3, 0x98, 0x65, 0xaf, 0x83, 0x2e, 0x90, 0x5c, 0x87, 0x51, 0x43,
-	0x1e, 0xc7, 0xe0, 0x3d, 0xf6, 0xa4, 0x85, 0x47, 0x3b, 0x5b, 0x18, 0x6b, 0x24, 0xc6, 0x86, 0xf5,
-	0x4d, 0x93, 0x51, 0x08, 0xd9, 0x66, 0x00, 0x1b, 0x9e, 0x6f, 0x8f, 0x21, 0xbd, 0xb6, 0x81, 0xde,
-	0x20, 0x13, 0xa6, 0x73, 0x8b, 0x5a, 0xd5, 0xa4, 0xce, 0x13, 0x98, 0xa0, 0x9b, 0x4b, 0xf7, 0x55,
-	0x62, 0x2e, 0xad, 0xda, 0xe3, 0xb3, 0xd6, 0xfc, 0x60, 0x39, 0x6d, 0xa2, 0xb7, 0xc8, 0xd9, 0xf6,
-	0x32, 0x94, 0x8a, 0x05, 0x01, 0xb6, 0x76, 0x69, 0xd5, 0x3e, 0x89, 0xd1, 0xbd, 0xdc, 0xf4, 0x0d,
-	0x92, 0x4f, 0x5c, 0x6b, 0xa1, 0x02, 0xc1, 0x85, 0x2f, 0x61, 0x99, 0x49, 0x78, 0x28, 0x02, 0xfb,
-	0x14, 0x92, 0xca, 0x88, 0xa0, 0x93, 0x64, 0x88, 0x8b, 0xe8, 0x59, 0xd3, 0xce, 0x61, 0xa8, 0x59,
-	0xe8, 0x77, 0x88, 0xc7, 0x2d, 0x74, 0xda, 0xbc, 0x43, 0xf1, 0x92, 0x2e, 0x91, 0xc9, 0xaa, 0xc7,
-	0xef, 0x83, 0x68, 0xf8, 0x1e, 0x14, 0x3d, 0x2f, 0xaa, 0x87, 0xa8, 0x39, 0xc5, 0xb0, 0xae, 0x3e,
-	0xea, 0x12, 0x8a, 0x3d, 0x7a, 0x57, 0x29, 0xbe, 0xcc, 0xa4, 0xef, 0x15, 0xeb, 0xaa, 0x66, 0x4f,
-	0xa0, 0xb0, 0x5d, 0x3c, 0xf4, 0x36, 0xb1, 0xeb, 0x12, 0x8a, 0x1f, 0xd5, 0x05, 0x3c, 0x8a, 0xc4,
-	0x76, 0x10, 0xb1, 0x4a, 0xa9, 0x02, 0xa1, 0xf2, 0x55, 0xd3, 0x9e, 0xc4, 0x5f, 0xf5, 0xf4, 0x6b,
-	0xad, 0x37, 0x81, 0x09, 0x10, 0x0f, 0xa2, 0x6d, 0x08, 0xed, 0x29, 0xa4, 0x95, 0x36, 0xe9, 0x0a,
-	0x5a, 0xbd, 0xb6, 0xe1, 0xf9, 0x6f, 0xb6, 0xe0, 0xed, 0x33, 0x98, 0xb9, 0xab, 0x8f, 0xae, 0x92,
-	0x0b, 0x4c, 0xc3, 0xc5, 0xb5, 0xdd, 0x13, 0x7e, 0xe8, 0xf9, 0x9c, 0x05, 0xa6, 0xbf, 0x4a, 0x15,
-	0xfb, 0x2c, 0xe2, 0x64, 0x07, 0xd1, 0x75, 0x72, 0x29, 0x23, 0xe0, 0x3e, 0x78, 0x02, 0x94, 0x6d,
-	0x63, 0xa6, 0xfe, 0x81, 0x3d, 0x39, 0x3d, 0x80, 0x90, 0x21, 0xa7, 0x73, 0x19, 0x9c, 0x5a, 0x41,
-	0x74, 0x99, 0x4c, 0x63, 0x40, 0xd1, 0x53, 0x7e, 0x03, 0x56, 0x7d, 0x01, 0x9e, 0x9e, 0x4d, 0x6b,
-	0x61, 0x85, 0x47, 0x7e, 0xa8, 0xec, 0x3c, 0x26, 0xc9, 0x8c, 0x71, 0x4e, 0x92, 0x13, 0x7a, 0xa4,
-	0xb4, 0x66, 0x9e, 0xf3, 0xa3, 0x45, 0x4e, 0x6b, 0xc3, 0x8a, 0x00, 0xa6, 0xa0, 0x0c, 0x4f, 0xea,
-	0x20, 0x15, 0x7d, 0x3f, 0x35, 0x65, 0xc6, 0x96, 0xee, 0xbe, 0xda, 0xf8, 0x2f, 0x27, 0x53, 0x34,
-	0x9e, 0x57, 0x67, 0xc8, 0x70, 0x9d, 0x4b, 0x10, 0x2a, 0x9e, 0x8a, 0xf1, 0x4a, 0xbf, 0xcb, 0x9e,
-	0x80, 0x8a, 0xdc, 0x08, 0x83, 0x26, 0x0e, 0xab, 0x91, 0x72, 0xdb, 0xe0, 0x3c, 0x31, 0x44, 0x1f,
-	0xf2, 0xca, 0x51, 0x11, 0x5d, 0xfa, 0xe4, 0xac, 0xc1, 0x34, 0xc6, 0x78, 0x5f, 0xe8, 0x17, 0x16,
-	0x39, 0xb6, 0xee, 0x4b, 0x45, 0xa7, 0xd2, 0x07, 0x44, 0x72, 0x1c, 0xe4, 0xd7, 0x0f, 0x8b, 0x85,
-	0x06, 0x71, 0x2e, 0x7e, 0xfc, 0xc7, 0x5f, 0x5f, 0x0d, 0x9c, 0xa1, 0x93, 0x78, 0x0d, 0x6a, 0x2c,
-	0xb6, 0xef, 0x1c, 0x3e, 0xc8, 0xcf, 0x06, 0x2c, 0xfa, 0xb9, 0x45, 0x06, 0xef, 0x40, 0x4f, 0x36,
-	0x87, 0xa6, 0x89, 0x73, 0x19, 0x99, 0x5c, 0xa0, 0xe7, 0xbb, 0x31, 0x29, 0x3c, 0xd7, 0xab, 0x17,
-	0xf4, 0x1b, 0x8b, 0x8c, 0xdc, 0x01, 0xf5, 0x48, 0xf8, 0x0a, 0x5e, 0x3f, 0xa5, 0xab, 0x48, 0xe9,
-	0x32, 0xbd, 0xd4, 0xa2, 0xf4, 0x54, 0xe3, 0x5e, 0xef, 0x46, 0xec, 0x6b, 0x8b, 0xe4, 0xb4, 0xa0,
-	0xe5, 0x94, 0xef, 0x68, 0x76, 0x70, 0x3a, 0x6b, 0x07, 0xe9, 0xf7, 0x16, 0x99, 0xd2, 0x61, 0xa8,
-	0xd8, 0xd1, 0x93, 0x73, 0x90, 0xdc, 0x34, 0xcd, 0xf7, 0x56, 0x90, 0x7e, 0x40, 0x46, 0x8c, 0x72,
-	0x5b, 0x3d, 0x49, 0xe5, 0x3a, 0xcd, 0x5b, 0xd2, 0x99, 0xc7, 0xc4, 0x0e, 0x9d, 0xcd, 0xe8, 0x96,
-	0x82, 0xd0, 0x29, 0x2b, 0x64, 0x4c, 0xa7, 0xdf, 0x58, 0x29, 0x3d, 0x60, 0xd5, 0x03, 0x20, 0x5c,
-	0x43, 0x84, 0x39, 0x7a, 0x25, 0x0b, 0x21, 0xf2, 0xfc, 0xeb, 0x4a, 0xa7, 0xdd, 0x31, 0x45, 0xe8,
-	0x0b, 0x1f, 0x3d, 0xb7, 0x1b, 0x22, 0xb9, 0xaf, 0xe7, 0xa7, 0xbb, 0xb9, 0x92, 0x69, 0xb9, 0xaf,
-	0xa2, 0x98, 0x86, 0xf8, 0xd2, 0x22, 0xe3, 0x77, 0x40, 0xb5, 0x6f, 0xd6, 0xf4, 0x62, 0x97, 0xcc,
-	0xe9, 0x5b, 0x77, 0xde, 0xe9, 0x1d, 0x90, 0x10, 0xf8, 0x1f, 0x12, 0xf8, 0x8f, 0x73, 0xa3, 0x3b,
-	0x01, 0x73, 0xff, 0xc5, 0x3c, 0x0f, 0xcb, 0xeb, 0x48, 0xa5, 0x62, 0x32, 0xdc, 0xb6, 0x16, 0x68,
-	0x03, 0x29, 0xdd, 0x85, 0x60, 0x67, 0xa5, 0xc6, 0x84, 0xea, 0x29, 0xf5, 0x4c, 0xda, 0xdc, 0x0e,
-	0x4f, 0x48, 0xb8, 0x48, 0x62, 0x9e, 0xce, 0x65, 0xa9, 0x50, 0x83, 0x60, 0xc7, 0x33, 0x30, 0xdf,
-	0x5a, 0x64, 0xd8, 0x9c, 0x2f, 0xf4, 0xc2, 0x6e, 0xc4, 0x8e, 0x73, 0xe7, 0x10, 0x27, 0xc3, 0xbf,
-	0x4c, 0x5f, 0x3b, 0x5d, 0x5f, 0xba, 0xdb, 0x38, 0xde, 0xf5, 0xf0, 0xfc, 0xce, 0x22, 0xb9, 0x16,
-	0x85, 0xd6, 0x6f, 0x8f, 0x8e, 0xa4, 0xd3, 0x9f, 0x24, 0xfd, 0xc9, 0x22, 0x53, 0x06, 0xbf, 0x73,
-	0x42, 0x1c, 0x21, 0xcd, 0xb8, 0xeb, 0x9d, 0x8c, 0x19, 0x11, 0x93, 0xfd, 0xc1, 0x22, 0xc3, 0xe6,
-	0x80, 0xde, 0xcb, 0xae, 0xe3, 0xe0, 0x3e, 0x44, 0x76, 0x8b, 0xa6, 0x1b, 0xf3, 0x19, 0xef, 0x24,
-	0x52, 0x79, 0xd1, 0xde, 0xf5, 0x9f, 0x2d, 0x92, 0x6b, 0xd1, 0xe9, 0x2d, 0xe7, 0xeb, 0x22, 0xec,
-	0x1e, 0x8c, 0x30, 0xfd, 0xc5, 0x22, 0x53, 0x86, 0x4b, 0xdf, 0x0e, 0x78, 0x5d, 0x94, 0xff, 0x8d,
-	0x94, 0xdd, 0xfc, 0x5c, 0xbf, 0x73, 0xb6, 0x83, 0x38, 0x23, 0xc3, 0xab, 0x10, 0x40, 0xef, 0x8b,
-	0x80, 0xbd, 0xdb, 0x9c, 0x8c, 0x98, 0x39, 0x73, 0xd7, 0x58, 0xc8, 0xba, 0x6b, 0xe8, 0x9d, 0xac,
-	0x91, 0x9c, 0x81, 0x48, 0xa9, 0x72, 0x60, 0xb0, 0xcb, 0xfb, 0x00, 0xa3, 0x92, 0x4c, 0x19, 0xa4,
-	0xdd, 0x9b, 0x70, 0x60, 0xb8, 0xf8, 0xd2, 0xb2, 0xb0, 0x8f, 0x4b, 0xcb, 0x73, 0x72, 0xf2, 0x5d,
-	0x16, 0xf8, 0x7a, 0x53, 0xcd, 0x47, 0x00, 0x7a, 0x7e, 0xcf, 0x21, 0xd1, 0xfe, 0x38, 0x90, 0x81,
-	0xb9, 0x84, 0x98, 0xd7, 0x9c, 0xcc, 0xb3, 0xb2, 0x11, 0x43, 0xc5, 0xdb, 0xf7, 0xa9, 0x45, 0x26,
-	0x5a, 0xe8, 0x58, 0xf4, 0xab, 0x51, 0xb8, 0x85, 0x14, 0x96, 0x9c, 0x85, 0xbe, 0x65, 0xef, 0x22,
-	0xb2, 0xbc, 0xf6, 0xeb, 0xcb, 0x19, 0xeb, 0xb7, 0x97, 0x33, 0xd6, 0x9f, 0x2f, 0x67, 0xac, 0xf7,
-	0xfe, 0xbb, 0xbf, 0xef, 0x7e, 0x1e, 0xfe, 0xff, 0x4a, 0x7d, 0xa1, 0xdb, 0x1c, 0xc6, 0x4f, 0x74,
-	0x37, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xff, 0x54, 0xba, 0x9b, 0x87, 0x14, 0x00, 0x00,
+	// 1511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x14, 0xc7,
+	0x12, 0xd6, 0xd8, 0xd8, 0xac, 0xdb, 0x18, 0xd6, 0x6d, 0x1b, 0x86, 0xc5, 0x18, 0x33, 0xf0, 0xfc,
+	0x8c, 0x05, 0xb3, 0xd8, 0x3c, 0xf4, 0x10, 0x4f, 0x2f, 0xd2, 0xfa, 0x47, 0x60, 0x15, 0x2b, 0x26,
+	0x0b, 0x04, 0x29, 0x4a, 0x14, 0xb5, 0x67, 0xca, 0xbb, 0x8d, 0xc7, 0x33, 0x4d, 0x77, 0xef, 0xc2,
+	0x06, 0x71, 0xc9, 0x21, 0x8a, 0x94, 0xe4, 0x10, 0x45, 0x89, 0x72, 0x4b, 0x0e, 0x91, 0x22, 0x25,
+	0xf7, 0xfc, 0x0d, 0x39, 0x46, 0xca, 0x3f, 0x10, 0xa1, 0xdc, 0x73, 0xcd, 0x31, 0xea, 0x9e, 0x9f,
+	0x6b, 0xef, 0xce, 0xda, 0xc2, 0xf8, 0x36, 0x5d, 0x55, 0x53, 0xdf, 0xd7, 0x5f, 0xd7, 0x54, 0xd7,
+	0x2e, 0xb2, 0x04, 0xf0, 0x16, 0xf0, 0x32, 0x07, 0x16, 0x08, 0x2a, 0x03, 0xde, 0xce, 0x3c, 0xda,
+	0x8c, 0x07, 0x32, 0xc0, 0x28, 0xb5, 0x94, 0xa6, 0xeb, 0x41, 0x50, 0xf7, 0xa0, 0x4c, 0x18, 0x2d,
+	0x13, 0xdf, 0x0f, 0x24, 0x91, 0x34, 0xf0, 0x45, 0x18, 0x59, 0x5a, 0xaf, 0x53, 0xd9, 0x68, 0x6e,
+	0xda, 0x4e, 0xb0, 0x53, 0x26, 0xbc, 0x1e, 0x30, 0x1e, 0x3c, 0xd6, 0x0f, 0xd7, 0x1c, 0xb7, 0xdc,
+	0xba, 0x51, 0x66, 0xdb, 0x75, 0xf5, 0xa6, 0x28, 0x13, 0xc6, 0x3c, 0xea, 0xe8, 0x77, 0xcb, 0xad,
+	0x45, 0xe2, 0xb1, 0x06, 0x59, 0x2c, 0xd7, 0xc1, 0x07, 0x4e, 0x24, 0xb8, 0x51, 0xb6, 0xb5, 0x3e,
+	0xd9, 0x34, 0xad, 0xbe, 0xf4, 0xad, 0x36, 0x1a, 0xab, 0x01, 0x0b, 0x2a, 0x8c, 0x89, 0x77, 0x9a,
+	0xc0, 0xdb, 0x18, 0xa3, 0x63, 0x2a, 0xc8, 0x34, 0x66, 0x8d, 0xf9, 0x91, 0x9a, 0x7e, 0xc6, 0x25,
+	0x54, 0xe0, 0xd0, 0xa2, 0x82, 0x06, 0xbe, 0x39, 0xa0, 0xed, 0xc9, 0x1a, 0x9b, 0xe8, 0x38, 0x61,
+	0xec, 0x6d, 0xb2, 0x03, 0xe6, 0xa0, 0x76, 0xc5, 0x4b, 0x3c, 0x83, 0x10, 0x61, 0xec, 0x1e, 0x0f,
+	0x1e, 0x83, 0x23, 0xcd, 0x63, 0xda, 0x99, 0xb1, 0x58, 0x8b, 0xe8, 0x78, 0x85, 0xb1, 0xaa, 0xbf,
+	0x15, 0x28, 0x50, 0xd9, 0x66, 0x10, 0x83, 0xaa, 0x67, 0x65, 0x63, 0x44, 0x36, 0x22, 0x40, 0xfd,
+	0x6c, 0xfd, 0x6d, 0xa0, 0x89, 0x88, 0xee, 0x2a, 0x48, 0x42, 0xbd, 0x88, 0x74, 0x1d, 0x0d, 0x8b,
+	0xa0, 0xc9, 0x9d, 0x30, 0xc3, 0xe8, 0xd2, 0x86, 0x9d, 0xaa, 0x63, 0xc7, 0xea, 0xe8, 0x87, 0x0f,
+	0x1d, 0xd7, 0x6e, 0xdd, 0xb0, 0xd9, 0x76, 0xdd, 0x56, 0x5a, 0xdb, 0x19, 0xad, 0xed, 0x58, 0x6b,
+	0xbb, 0x92, 0x1a, 0xef, 0xeb, 0xb4, 0xb5, 0x28, 0x7d, 0x76, 0xb7, 0x03, 0x79, 0xbb, 0x1d, 0xdc,
+	0xbd, 0x5b, 0x3c, 0x8b, 0x46, 0xc3, 0x1c, 0x55, 0xdf, 0x85, 0x67, 0x5a, 0x8e, 0xa1, 0x5a, 0xd6,
+	0x84, 0xa7, 0xd1, 0x48, 0x0b, 0xb8, 0x12, 0xb5, 0xea, 0x9a, 0x43, 0xda, 0x9f, 0x1a, 0xac, 0xff,
+	0xa3, 0x62, 0x7c, 0x50, 0x35, 0x10, 0x2c, 0xf0, 0x05, 0xe0, 0x2b, 0x68, 0x88, 0x4a, 0xd8, 0x11,
+	0xa6, 0x31, 0x3b, 0x38, 0x3f, 0xba, 0x34, 0x61, 0x67, 0x8e, 0x37, 0x92, 0xb6, 0x16, 0x46, 0x58,
+	0x0e, 0x1a, 0x51, 0xaf, 0xf7, 0x3e, 0x63, 0x0b, 0x9d, 0xd8, 0x0a, 0xd4, 0x56, 0x61, 0x8b, 0x83,
+	0x08, 0x65, 0x2f, 0xd4, 0x3a, 0x6c, 0xfd, 0xf6, 0x68, 0xfd, 0x55, 0x40, 0xa7, 0x34, 0x49, 0xc7,
+	0x01, 0x91, 0x5f, 0x4f, 0x4d, 0x01, 0xdc, 0x4f, 0x65, 0x4c, 0xd6, 0xca, 0xc7, 0x88, 0x10, 0x4f,
+	0x03, 0xee, 0x46, 0x08, 0xc9, 0x1a, 0x5f, 0x46, 0x63, 0x42, 0x34, 0xee, 0x71, 0xda, 0x22, 0x12,
+	0xde, 0x82, 0x76, 0x54, 0x54, 0x9d, 0x46, 0x95, 0x81, 0xfa, 0x02, 0x9c, 0x26, 0x07, 0x2d, 0x63,
+	0xa1, 0x96, 0xac, 0xf1, 0x55, 0x34, 0x2e, 0x3d, 0xb1, 0xe2, 0x51, 0xf0, 0xe5, 0x0a, 0x70, 0xb9,
+	0x4a, 0x24, 0x31, 0x87, 0x75, 0x96, 0xbd, 0x0e, 0xbc, 0x80, 0x8a, 0x1d, 0x46, 0x05, 0x79, 0x5c,
+	0x07, 0xef, 0xb1, 0x27, 0x25, 0x3c, 0xd2, 0x59, 0xc2, 0x7a, 0x8f, 0x28, 0xb4, 0xe9, 0xfd, 0x4d,
+	0xa3, 0x11, 0xf0, 0xc9, 0xa6, 0x07, 0x1b, 0x0e, 0x35, 0x47, 0x35, 0xbd, 0xd4, 0x80, 0xaf, 0xa3,
+	0x89, 0xb0, 0x72, 0x2b, 0x8c, 0xa5, 0x5b, 0x32, 0x4f, 0xe8, 0x04, 0xdd, 0x5c, 0xaa, 0xae, 0x12,
+	0x73, 0x75, 0xd5, 0x1c, 0x9b, 0x35, 0xe6, 0x07, 0x6b, 0x59, 0x13, 0xbe, 0x85, 0xce, 0xa4, 0x4b,
+	0x5f, 0x48, 0xe2, 0x79, 0xba, 0xb4, 0xab, 0xab, 0xe6, 0x49, 0x1d, 0xdd, 0xcb, 0x8d, 0xdf, 0x40,
+	0xa5, 0xc4, 0xb5, 0xe6, 0x4b, 0xe0, 0x8c, 0x53, 0x01, 0xcb, 0x44, 0xc0, 0x43, 0xee, 0x99, 0xa7,
+	0x34, 0xa9, 0x9c, 0x08, 0x3c, 0x89, 0x86, 0x18, 0x0f, 0x9e, 0xb5, 0xcd, 0xa2, 0x0e, 0x0d, 0x17,
+	0xea, 0x1b, 0x62, 0x51, 0x09, 0x8d, 0x87, 0xdf, 0x50, 0xb4, 0xc4, 0x4b, 0x68, 0xb2, 0xee, 0xb0,
+	0xfb, 0xc0, 0x5b, 0xd4, 0x81, 0x8a, 0xe3, 0x04, 0x4d, 0x5f, 0x6b, 0x8e, 0x75, 0x58, 0x57, 0x1f,
+	0xb6, 0x11, 0xd6, 0x35, 0x7a, 0x57, 0x4a, 0xb6, 0x4c, 0x04, 0x75, 0x2a, 0x4d, 0xd9, 0x30, 0x27,
+	0xb4, 0xb0, 0x5d, 0x3c, 0xf8, 0x36, 0x32, 0x9b, 0x02, 0x2a, 0x1f, 0x35, 0x39, 0x3c, 0x0a, 0xf8,
+	0xb6, 0x17, 0x10, 0xb7, 0xea, 0x82, 0x2f, 0xa9, 0x6c, 0x9b, 0x93, 0xfa, 0xad, 0x9e, 0x7e, 0xa5,
+	0xf5, 0x26, 0x10, 0x0e, 0xfc, 0x41, 0xb0, 0x0d, 0xbe, 0x39, 0xa5, 0x69, 0x65, 0x4d, 0x6a, 0x07,
+	0x71, 0xad, 0x6d, 0x38, 0xf4, 0xcd, 0x18, 0xde, 0x3c, 0xad, 0x33, 0x77, 0xf5, 0xe1, 0x55, 0x74,
+	0x9e, 0x28, 0xb8, 0x68, 0x6f, 0xf7, 0x38, 0xf5, 0x1d, 0xca, 0x88, 0x17, 0xd6, 0x57, 0xd5, 0x35,
+	0xcf, 0x68, 0x9c, 0xfc, 0x20, 0xbc, 0x8e, 0x2e, 0xe6, 0x04, 0xdc, 0x07, 0x87, 0x83, 0x34, 0x4d,
+	0x9d, 0xa9, 0x7f, 0x60, 0x4f, 0x4e, 0x0f, 0xc0, 0x27, 0x9a, 0xd3, 0xd9, 0x1c, 0x4e, 0x71, 0x10,
+	0x5e, 0x46, 0xd3, 0x3a, 0xa0, 0xe2, 0x48, 0xda, 0x82, 0x55, 0xca, 0xc1, 0x51, 0xbd, 0x69, 0xcd,
+	0x77, 0x59, 0x40, 0x7d, 0x69, 0x96, 0x74, 0x92, 0xdc, 0x98, 0xf4, 0x7b, 0x59, 0xdf, 0x12, 0xe6,
+	0xb9, 0xec, 0xf7, 0xb2, 0xbe, 0x25, 0xac, 0x93, 0xe8, 0x84, 0x6a, 0x38, 0x71, 0x47, 0xb4, 0x7e,
+	0x34, 0xd0, 0xb8, 0x32, 0xac, 0x70, 0x20, 0x12, 0x6a, 0xf0, 0xa4, 0x09, 0x42, 0xe2, 0xf7, 0x33,
+	0x3d, 0x68, 0x74, 0xe9, 0xee, 0xab, 0x5d, 0x0e, 0xb5, 0xa4, 0xc7, 0x46, 0xdd, 0xec, 0x34, 0x1a,
+	0x6e, 0x32, 0x01, 0x5c, 0x46, 0x3d, 0x33, 0x5a, 0x29, 0xe6, 0x0e, 0x07, 0x57, 0x6c, 0xf8, 0x5e,
+	0x5b, 0xb7, 0xb2, 0x42, 0x2d, 0x35, 0x58, 0x4f, 0x42, 0xa2, 0x0f, 0x99, 0x7b, 0x54, 0x44, 0x97,
+	0xbe, 0x30, 0xd1, 0x78, 0x6a, 0x8c, 0x4e, 0x0d, 0x7f, 0x6e, 0xa0, 0x63, 0xeb, 0x54, 0x48, 0x3c,
+	0x95, 0xbd, 0x3e, 0x92, 0xcb, 0xa2, 0xb4, 0x7e, 0x58, 0x2c, 0x14, 0x88, 0x75, 0xe1, 0xe3, 0xdf,
+	0xff, 0xfc, 0x6a, 0xe0, 0x34, 0x9e, 0xd4, 0x43, 0x52, 0x6b, 0x31, 0x9d, 0x48, 0x28, 0x88, 0x4f,
+	0x07, 0x0c, 0xfc, 0x99, 0x81, 0x06, 0xef, 0x40, 0x4f, 0x36, 0x87, 0xa6, 0x89, 0x75, 0x49, 0x33,
+	0x39, 0x8f, 0xcf, 0x75, 0x63, 0x52, 0x7e, 0xae, 0x56, 0x2f, 0xf0, 0x37, 0x06, 0x2a, 0xdc, 0x01,
+	0xf9, 0x88, 0x53, 0x09, 0xaf, 0x9f, 0xd2, 0x15, 0x4d, 0xe9, 0x12, 0xbe, 0x18, 0x53, 0x7a, 0xaa,
+	0x70, 0xaf, 0x75, 0x23, 0xf6, 0xb5, 0x81, 0x8a, 0x4a, 0xd0, 0x5a, 0xc6, 0x77, 0x34, 0x27, 0x38,
+	0x9d, 0x77, 0x82, 0xf8, 0x7b, 0x03, 0x4d, 0xa9, 0x30, 0xad, 0xd8, 0xd1, 0x93, 0xb3, 0x34, 0xb9,
+	0x69, 0x5c, 0xea, 0xad, 0x20, 0xfe, 0x00, 0x15, 0x42, 0xe5, 0xb6, 0x7a, 0x92, 0x2a, 0x76, 0x9a,
+	0xb7, 0x84, 0x35, 0xaf, 0x13, 0x5b, 0x78, 0x36, 0xa7, 0x5a, 0xca, 0x5c, 0xa5, 0x74, 0xd1, 0xa8,
+	0x4a, 0xbf, 0xb1, 0x52, 0x7d, 0x40, 0xea, 0x07, 0x40, 0xb8, 0xaa, 0x11, 0xe6, 0xf0, 0xe5, 0x3c,
+	0x84, 0xc0, 0xa1, 0xd7, 0xa4, 0x4a, 0xbb, 0x13, 0x6e, 0x42, 0x8d, 0x83, 0xf8, 0xec, 0x6e, 0x88,
+	0x64, 0x9a, 0x2f, 0x4d, 0x77, 0x73, 0x25, 0xdd, 0x72, 0x5f, 0x9b, 0x22, 0x0a, 0xe2, 0x4b, 0x03,
+	0x8d, 0xdd, 0x01, 0x99, 0xce, 0xdd, 0xf8, 0x42, 0x97, 0xcc, 0xd9, 0x99, 0xbc, 0x64, 0xf5, 0x0e,
+	0x48, 0x08, 0xfc, 0x4f, 0x13, 0xb8, 0x69, 0x5d, 0xef, 0x4e, 0x20, 0x9c, 0x8e, 0x75, 0x9e, 0x87,
+	0xb5, 0x75, 0x4d, 0xc5, 0x0d, 0x33, 0xdc, 0x36, 0x16, 0x70, 0x4b, 0x53, 0xba, 0x0b, 0xde, 0xce,
+	0x4a, 0x83, 0x70, 0xd9, 0x53, 0xea, 0x99, 0xac, 0x39, 0x0d, 0x4f, 0x48, 0xd8, 0x9a, 0xc4, 0x3c,
+	0x9e, 0xcb, 0x53, 0xa1, 0x01, 0xde, 0x8e, 0x13, 0xc2, 0x7c, 0x6b, 0xa0, 0xe1, 0xf0, 0x7e, 0xc1,
+	0xe7, 0x77, 0x23, 0x76, 0xdc, 0x3b, 0x87, 0xd8, 0x19, 0xfe, 0x15, 0xd6, 0xb5, 0xd5, 0xf5, 0xa3,
+	0xbb, 0xad, 0xdb, 0xbb, 0x6a, 0x9e, 0xdf, 0x19, 0xa8, 0x18, 0x53, 0x88, 0xdf, 0x3d, 0x3a, 0x92,
+	0x56, 0x7f, 0x92, 0xf8, 0x27, 0x03, 0x4d, 0x85, 0xf8, 0x9d, 0x1d, 0xe2, 0x08, 0x69, 0x46, 0x55,
+	0x6f, 0xe5, 0xf4, 0x88, 0x88, 0xec, 0x0f, 0x06, 0x1a, 0x0e, 0x2f, 0xe8, 0xbd, 0xec, 0x3a, 0x2e,
+	0xee, 0x43, 0x64, 0xb7, 0x18, 0x56, 0x63, 0x29, 0xe7, 0x9b, 0xd4, 0x54, 0x5e, 0xa4, 0xa7, 0xfe,
+	0xb3, 0x81, 0x8a, 0x31, 0x9d, 0xde, 0x72, 0xbe, 0x2e, 0xc2, 0xf6, 0xc1, 0x08, 0xe3, 0x5f, 0x0c,
+	0x34, 0x15, 0x72, 0xe9, 0x5b, 0x01, 0xaf, 0x8b, 0xf2, 0x7f, 0x34, 0x65, 0xbb, 0x34, 0xd7, 0xef,
+	0x9e, 0xed, 0x20, 0x4e, 0xd0, 0xf0, 0x2a, 0x78, 0xd0, 0x7b, 0x10, 0x30, 0x77, 0x9b, 0x93, 0x16,
+	0x33, 0x17, 0xce, 0x1a, 0x0b, 0x79, 0xb3, 0x86, 0x3a, 0xc9, 0x06, 0x2a, 0x86, 0x10, 0x19, 0x55,
+	0x0e, 0x0c, 0x76, 0x69, 0x1f, 0x60, 0x58, 0xa0, 0xa9, 0x10, 0x69, 0xf7, 0x21, 0x1c, 0x18, 0x2e,
+	0x1a, 0x5a, 0x16, 0xf6, 0x31, 0xb4, 0x3c, 0x47, 0x27, 0xdf, 0x25, 0x1e, 0x55, 0x87, 0x1a, 0xfe,
+	0x45, 0x80, 0xcf, 0xed, 0xb9, 0x24, 0xd2, 0xbf, 0x0e, 0x72, 0x30, 0x97, 0x34, 0xe6, 0x55, 0x2b,
+	0xf7, 0xae, 0x6c, 0x45, 0x50, 0xd1, 0xf1, 0x7d, 0x62, 0xa0, 0x42, 0x8c, 0x9e, 0x8f, 0x3b, 0x97,
+	0x75, 0xc6, 0xaf, 0x64, 0xa6, 0xe9, 0x98, 0xc5, 0x4d, 0xcd, 0xa2, 0x6c, 0xfd, 0x3b, 0x8f, 0x85,
+	0x4b, 0x49, 0xdd, 0x0f, 0x84, 0xa4, 0x8e, 0x48, 0x89, 0x4c, 0xc4, 0x59, 0xb5, 0xfa, 0xaf, 0xa6,
+	0xc5, 0x2d, 0xcd, 0x62, 0xc9, 0x5a, 0xe8, 0xab, 0xff, 0x2e, 0x45, 0x96, 0xd7, 0x7e, 0x7d, 0x39,
+	0x63, 0xfc, 0xf6, 0x72, 0xc6, 0xf8, 0xe3, 0xe5, 0x8c, 0xf1, 0xde, 0x7f, 0xf7, 0xf7, 0xf7, 0xa4,
+	0xa3, 0x7f, 0x26, 0xa6, 0x5b, 0x6d, 0x6f, 0x0e, 0xeb, 0x7f, 0x12, 0x6f, 0xfc, 0x33, 0x00, 0x16,
+	0xfa, 0xdf, 0xeb, 0x2e, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -938,6 +950,8 @@ type RepositoryServiceClient interface {
 	DeleteWriteRepository(ctx context.Context, in *RepoQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error)
+	// Validate checks the access to a repository with given parameters in stages and returns the result of each stage
+	Validate(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*apiclient.ValidateRepositoryResponse, error)
 	// ValidateWriteAccess validates write access to a repository with given parameters
 	ValidateWriteAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error)
 }
@@ -1134,6 +1148,15 @@ func (c *repositoryServiceClient) ValidateAccess(ctx context.Context, in *RepoAc
 	return out, nil
 }
 
+func (c *repositoryServiceClient) Validate(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*apiclient.ValidateRepositoryResponse, error) {
+	out := new(apiclient.ValidateRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepositoryService/Validate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repositoryServiceClient) ValidateWriteAccess(ctx context.Context, in *RepoAccessQuery, opts ...grpc.CallOption) (*RepoResponse, error) {
 	out := new(RepoResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepositoryService/ValidateWriteAccess", in, out, opts...)
@@ -1183,6 +1206,8 @@ type RepositoryServiceServer interface {
 	DeleteWriteRepository(context.Context, *RepoQuery) (*RepoResponse, error)
 	// ValidateAccess validates access to a repository with given parameters
 	ValidateAccess(context.Context, *RepoAccessQuery) (*RepoResponse, error)
+	// Validate checks the access to a repository with given parameters in stages and returns the result of each stage
+	Validate(context.Context, *RepoAccessQuery) (*apiclient.ValidateRepositoryResponse, error)
 	// ValidateWriteAccess validates write access to a repository with given parameters
 	ValidateWriteAccess(context.Context, *RepoAccessQuery) (*RepoResponse, error)
 }
@@ -1251,6 +1276,9 @@ func (*UnimplementedRepositoryServiceServer) DeleteWriteRepository(ctx context.C
 func (*UnimplementedRepositoryServiceServer) ValidateAccess(ctx context.Context, req *RepoAccessQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateAccess not implemented")
 }
+func (*UnimplementedRepositoryServiceServer) Validate(ctx context.Context, req *RepoAccessQuery) (*apiclient.ValidateRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
 func (*UnimplementedRepositoryServiceServer) ValidateWriteAccess(ctx context.Context, req *RepoAccessQuery) (*RepoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateWriteAccess not implemented")
 }
@@ -1619,6 +1647,24 @@ func _RepositoryService_ValidateAccess_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepositoryService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoAccessQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepositoryServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepositoryService/Validate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepositoryServiceServer).Validate(ctx, req.(*RepoAccessQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepositoryService_ValidateWriteAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoAccessQuery)
 	if err := dec(in); err != nil {
@@ -1721,6 +1767,10 @@ var _RepositoryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateAccess",
 			Handler:    _RepositoryService_ValidateAccess_Handler,
 		},
+		{
+			MethodName: "Validate",
+			Handler:    _RepositoryService_Validate_Handler,
+		},
 		{
 			MethodName: "ValidateWriteAccess",
 			Handler:    _RepositoryService_ValidateWriteAccess_Handler,
@@ -2005,6 +2055,18 @@ func (m *RepoAccessQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.EnableLfs {
+		i--
+		if m.EnableLfs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
 	if len(m.AzureActiveDirectoryEndpoint) > 0 {
 		i -= len(m.AzureActiveDirectoryEndpoint)
 		copy(dAtA[i:], m.AzureActiveDirectoryEndpoint)
@@ -2570,6 +2632,9 @@ func (m *RepoAccessQuery) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	if m.EnableLfs {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4078,6 +4143,26 @@ func (m *RepoAccessQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.AzureActiveDirectoryEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableLfs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableLfs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
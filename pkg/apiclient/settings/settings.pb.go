@@ -107,6 +107,7 @@ type Settings struct {
 	HydratorEnabled           bool                               `protobuf:"varint,28,opt,name=hydratorEnabled,proto3" json:"hydratorEnabled,omitempty"`
 	SyncWithReplaceAllowed    bool                               `protobuf:"varint,29,opt,name=syncWithReplaceAllowed,proto3" json:"syncWithReplaceAllowed,omitempty"`
 	UiLoginButtonText         string                             `protobuf:"bytes,30,opt,name=uiLoginButtonText,proto3" json:"uiLoginButtonText,omitempty"`
+	ApplicationColumns        []*ApplicationColumn               `protobuf:"bytes,31,rep,name=applicationColumns,proto3" json:"applicationColumns,omitempty"`
 	XXX_NoUnkeyedLiteral      struct{}                           `json:"-"`
 	XXX_unrecognized          []byte                             `json:"-"`
 	XXX_sizecache             int32                              `json:"-"`
@@ -355,6 +356,71 @@ func (m *Settings) GetUiLoginButtonText() string {
 	return ""
 }
 
+func (m *Settings) GetApplicationColumns() []*ApplicationColumn {
+	if m != nil {
+		return m.ApplicationColumns
+	}
+	return nil
+}
+
+// ApplicationColumn is an additional column shown when listing applications
+type ApplicationColumn struct {
+	// the header of the column
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the JSONPath of the label or status field shown in the column
+	JSONPath             string   `protobuf:"bytes,2,opt,name=jsonPath,proto3" json:"jsonPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationColumn) Reset()         { *m = ApplicationColumn{} }
+func (m *ApplicationColumn) String() string { return proto.CompactTextString(m) }
+func (*ApplicationColumn) ProtoMessage()    {}
+func (*ApplicationColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a480d494da040caa, []int{2}
+}
+func (m *ApplicationColumn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationColumn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationColumn.Merge(m, src)
+}
+func (m *ApplicationColumn) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationColumn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationColumn proto.InternalMessageInfo
+
+func (m *ApplicationColumn) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationColumn) GetJSONPath() string {
+	if m != nil {
+		return m.JSONPath
+	}
+	return ""
+}
+
 type GoogleAnalyticsConfig struct {
 	TrackingID           string   `protobuf:"bytes,1,opt,name=trackingID,proto3" json:"trackingID,omitempty"`
 	AnonymizeUsers       bool     `protobuf:"varint,2,opt,name=anonymizeUsers,proto3" json:"anonymizeUsers,omitempty"`
@@ -367,7 +433,7 @@ func (m *GoogleAnalyticsConfig) Reset()         { *m = GoogleAnalyticsConfig{} }
 func (m *GoogleAnalyticsConfig) String() string { return proto.CompactTextString(m) }
 func (*GoogleAnalyticsConfig) ProtoMessage()    {}
 func (*GoogleAnalyticsConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{2}
+	return fileDescriptor_a480d494da040caa, []int{3}
 }
 func (m *GoogleAnalyticsConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +487,7 @@ func (m *SettingsPluginsResponse) Reset()         { *m = SettingsPluginsResponse
 func (m *SettingsPluginsResponse) String() string { return proto.CompactTextString(m) }
 func (*SettingsPluginsResponse) ProtoMessage()    {}
 func (*SettingsPluginsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{3}
+	return fileDescriptor_a480d494da040caa, []int{4}
 }
 func (m *SettingsPluginsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +540,7 @@ func (m *Help) Reset()         { *m = Help{} }
 func (m *Help) String() string { return proto.CompactTextString(m) }
 func (*Help) ProtoMessage()    {}
 func (*Help) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{4}
+	return fileDescriptor_a480d494da040caa, []int{5}
 }
 func (m *Help) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +603,7 @@ func (m *Plugin) Reset()         { *m = Plugin{} }
 func (m *Plugin) String() string { return proto.CompactTextString(m) }
 func (*Plugin) ProtoMessage()    {}
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{5}
+	return fileDescriptor_a480d494da040caa, []int{6}
 }
 func (m *Plugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +650,7 @@ func (m *DexConfig) Reset()         { *m = DexConfig{} }
 func (m *DexConfig) String() string { return proto.CompactTextString(m) }
 func (*DexConfig) ProtoMessage()    {}
 func (*DexConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{6}
+	return fileDescriptor_a480d494da040caa, []int{7}
 }
 func (m *DexConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -632,7 +698,7 @@ func (m *Connector) Reset()         { *m = Connector{} }
 func (m *Connector) String() string { return proto.CompactTextString(m) }
 func (*Connector) ProtoMessage()    {}
 func (*Connector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{7}
+	return fileDescriptor_a480d494da040caa, []int{8}
 }
 func (m *Connector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +758,7 @@ func (m *OIDCConfig) Reset()         { *m = OIDCConfig{} }
 func (m *OIDCConfig) String() string { return proto.CompactTextString(m) }
 func (*OIDCConfig) ProtoMessage()    {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a480d494da040caa, []int{8}
+	return fileDescriptor_a480d494da040caa, []int{9}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,6 +840,7 @@ func init() {
 	proto.RegisterType((*SettingsQuery)(nil), "cluster.SettingsQuery")
 	proto.RegisterType((*Settings)(nil), "cluster.Settings")
 	proto.RegisterMapType((map[string]*v1alpha1.ResourceOverride)(nil), "cluster.Settings.ResourceOverridesEntry")
+	proto.RegisterType((*ApplicationColumn)(nil), "cluster.ApplicationColumn")
 	proto.RegisterType((*GoogleAnalyticsConfig)(nil), "cluster.GoogleAnalyticsConfig")
 	proto.RegisterType((*SettingsPluginsResponse)(nil), "cluster.SettingsPluginsResponse")
 	proto.RegisterType((*Help)(nil), "cluster.Help")
@@ -788,91 +855,94 @@ func init() {
 func init() { proto.RegisterFile("server/settings/settings.proto", fileDescriptor_a480d494da040caa) }
 
 var fileDescriptor_a480d494da040caa = []byte{
-	// 1335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x13, 0x86, 0x2c, 0xc7, 0x96, 0xc6, 0xb1, 0x65, 0x6f, 0x1c, 0x87, 0xd1, 0x9b, 0xd8, 0x7a, 0x75,
-	0x08, 0xf4, 0xbe, 0x68, 0xa9, 0xd8, 0x46, 0x3f, 0x10, 0x34, 0x68, 0x2d, 0x29, 0x48, 0xd4, 0x28,
-	0x89, 0xcb, 0xc4, 0x29, 0xd0, 0x4b, 0xb0, 0x26, 0xa7, 0x14, 0x6b, 0x6a, 0x97, 0xd8, 0x5d, 0x2a,
-	0x51, 0x8e, 0xfd, 0x01, 0xbd, 0xb4, 0xbf, 0xa6, 0xf7, 0xa2, 0x3d, 0x16, 0xe8, 0x5d, 0x28, 0x84,
-	0xfe, 0x90, 0x82, 0xcb, 0x0f, 0xd3, 0x94, 0x9c, 0x16, 0xc8, 0x6d, 0xf7, 0x79, 0xe6, 0x6b, 0x67,
-	0x67, 0x96, 0x43, 0xd8, 0x95, 0x28, 0xc6, 0x28, 0xda, 0x12, 0x95, 0xf2, 0x98, 0x2b, 0xb3, 0x85,
-	0x19, 0x08, 0xae, 0x38, 0x59, 0xb5, 0xfd, 0x50, 0x2a, 0x14, 0xf5, 0x6d, 0x97, 0xbb, 0x5c, 0x63,
-	0xed, 0x68, 0x15, 0xd3, 0xf5, 0x5b, 0x2e, 0xe7, 0xae, 0x8f, 0x6d, 0x1a, 0x78, 0x6d, 0xca, 0x18,
-	0x57, 0x54, 0x79, 0x9c, 0x25, 0xca, 0xf5, 0x81, 0xeb, 0xa9, 0x61, 0x78, 0x6a, 0xda, 0x7c, 0xd4,
-	0xa6, 0x42, 0xab, 0x7f, 0xa7, 0x17, 0x1f, 0xda, 0x4e, 0x7b, 0x7c, 0xd8, 0x0e, 0xce, 0xdc, 0x48,
-	0x53, 0xb6, 0x69, 0x10, 0xf8, 0x9e, 0xad, 0x75, 0xdb, 0xe3, 0x7d, 0xea, 0x07, 0x43, 0xba, 0xdf,
-	0x76, 0x91, 0xa1, 0xa0, 0x0a, 0x9d, 0xc4, 0xda, 0x17, 0xff, 0x60, 0xad, 0x78, 0x12, 0xee, 0x39,
-	0x76, 0xdb, 0xf6, 0xa9, 0x37, 0x4a, 0xe2, 0x69, 0xd6, 0x60, 0xfd, 0x79, 0xc2, 0x7e, 0x15, 0xa2,
-	0x98, 0x34, 0xa7, 0xeb, 0x50, 0x49, 0x11, 0x72, 0x13, 0xca, 0xa1, 0xf0, 0x8d, 0x52, 0xa3, 0xd4,
-	0xaa, 0x76, 0x56, 0x67, 0xd3, 0xbd, 0xf2, 0x89, 0x35, 0xb0, 0x22, 0x8c, 0xdc, 0x85, 0xaa, 0x83,
-	0x6f, 0xba, 0x9c, 0x7d, 0xeb, 0xb9, 0xc6, 0x52, 0xa3, 0xd4, 0x5a, 0x3b, 0x20, 0x66, 0x92, 0x19,
-	0xb3, 0x97, 0x32, 0xd6, 0xb9, 0x10, 0xe9, 0x02, 0x44, 0xfe, 0x13, 0x95, 0xb2, 0x56, 0xb9, 0x96,
-	0xa9, 0x3c, 0xeb, 0xf7, 0xba, 0x31, 0xd5, 0xd9, 0x98, 0x4d, 0xf7, 0xe0, 0x7c, 0x6f, 0xe5, 0xd4,
-	0x48, 0x03, 0xd6, 0x68, 0x10, 0x0c, 0xe8, 0x29, 0xfa, 0x8f, 0x71, 0x62, 0x2c, 0x47, 0x91, 0x59,
-	0x79, 0x88, 0xbc, 0x84, 0x2d, 0x81, 0x92, 0x87, 0xc2, 0xc6, 0x67, 0x63, 0x14, 0xc2, 0x73, 0x50,
-	0x1a, 0x57, 0x1a, 0xe5, 0xd6, 0xda, 0x41, 0x2b, 0xf3, 0x96, 0x9e, 0xd0, 0xb4, 0x8a, 0xa2, 0x0f,
-	0x98, 0x12, 0x13, 0x6b, 0xde, 0x04, 0x31, 0x81, 0x48, 0x45, 0x55, 0x28, 0x3b, 0xd4, 0x71, 0xf1,
-	0x01, 0xa3, 0xa7, 0x3e, 0x3a, 0xc6, 0x4a, 0xa3, 0xd4, 0xaa, 0x58, 0x0b, 0x18, 0xf2, 0x08, 0x6a,
-	0x71, 0x25, 0x1c, 0x31, 0xea, 0x4f, 0x94, 0x67, 0x4b, 0x63, 0x55, 0x9f, 0x79, 0x37, 0x8b, 0xe2,
-	0xe1, 0x45, 0x3e, 0x39, 0x6e, 0x51, 0x8d, 0xbc, 0x85, 0xcd, 0xb3, 0x50, 0x2a, 0x3e, 0xf2, 0xde,
-	0xe2, 0xb3, 0x40, 0x57, 0x93, 0x51, 0xd1, 0xa6, 0x9e, 0x9a, 0xe7, 0x05, 0x60, 0xa6, 0x05, 0xa0,
-	0x17, 0xaf, 0x6c, 0xc7, 0x1c, 0x1f, 0x9a, 0xc1, 0x99, 0x6b, 0x46, 0xe5, 0x64, 0xe6, 0xca, 0xc9,
-	0x4c, 0xcb, 0xc9, 0x7c, 0x5c, 0xb0, 0x6a, 0xcd, 0xf9, 0x21, 0xff, 0x85, 0xe5, 0x21, 0xfa, 0x81,
-	0x51, 0xd5, 0xfe, 0xd6, 0xb3, 0xd0, 0x1f, 0xa1, 0x1f, 0x58, 0x9a, 0x22, 0xff, 0x83, 0xd5, 0xc0,
-	0x0f, 0x5d, 0x8f, 0x49, 0x03, 0x74, 0x9a, 0x6b, 0x99, 0xd4, 0xb1, 0xc6, 0xad, 0x94, 0x8f, 0x72,
-	0x18, 0x4a, 0x14, 0x03, 0x1e, 0xed, 0x7a, 0x9e, 0x8c, 0x73, 0xb8, 0x16, 0xe7, 0x70, 0x9e, 0x21,
-	0x3f, 0x94, 0xe0, 0x86, 0xad, 0xb3, 0xf2, 0x84, 0x32, 0xea, 0xe2, 0x08, 0x99, 0x3a, 0x4e, 0x7c,
-	0x5d, 0xd5, 0xbe, 0x5e, 0xbc, 0x5f, 0x06, 0xba, 0x0b, 0x8d, 0x5b, 0x97, 0x39, 0x25, 0x1f, 0xc0,
-	0x56, 0x96, 0xa2, 0x97, 0x28, 0xa4, 0xbe, 0x8b, 0xf5, 0x46, 0xb9, 0x55, 0xb5, 0xe6, 0x09, 0x52,
-	0x87, 0x4a, 0xe8, 0x75, 0xa5, 0x3c, 0xb1, 0x06, 0xc6, 0x86, 0xae, 0xd4, 0x6c, 0x4f, 0x5a, 0x50,
-	0x0b, 0xbd, 0x0e, 0x65, 0x0c, 0x45, 0x97, 0x33, 0x85, 0x4c, 0x19, 0x35, 0x2d, 0x52, 0x84, 0xa3,
-	0x92, 0x4f, 0xa1, 0xc8, 0xd0, 0x66, 0x5c, 0xf2, 0x39, 0x28, 0xb2, 0x15, 0x50, 0x29, 0x5f, 0x73,
-	0xe1, 0x1c, 0x53, 0xa5, 0x50, 0x30, 0x63, 0x2b, 0xb6, 0x55, 0x80, 0xc9, 0x1d, 0xd8, 0x50, 0x82,
-	0xda, 0x67, 0x1e, 0x73, 0x9f, 0xa0, 0x1a, 0x72, 0xc7, 0x20, 0x5a, 0xb0, 0x80, 0x46, 0xe7, 0x4c,
-	0x1d, 0x1c, 0xa3, 0x18, 0x51, 0x16, 0xc5, 0x77, 0x4d, 0xdf, 0xd3, 0x3c, 0x41, 0xfe, 0x0f, 0x9b,
-	0x19, 0xc8, 0xa5, 0x17, 0xa5, 0xd8, 0xd8, 0xd6, 0x76, 0xe7, 0xf0, 0x42, 0x1b, 0x59, 0x9c, 0xab,
-	0x13, 0xe1, 0x1b, 0xd7, 0xb5, 0xf4, 0x02, 0x26, 0x3a, 0x3d, 0xbe, 0x41, 0x3b, 0xed, 0xb7, 0x1d,
-	0x1d, 0x43, 0x1e, 0x22, 0x77, 0xe1, 0x9a, 0xcd, 0x99, 0x12, 0xdc, 0xf7, 0x51, 0x3c, 0xa5, 0x23,
-	0x94, 0x01, 0xb5, 0xd1, 0xb8, 0xa1, 0x4d, 0x2e, 0xa2, 0xc8, 0x67, 0x70, 0x93, 0x06, 0x81, 0xec,
-	0xb3, 0x23, 0x36, 0xc9, 0xd0, 0xd4, 0x83, 0xa1, 0x3d, 0x5c, 0x2e, 0x40, 0x0e, 0x60, 0xdb, 0x1b,
-	0x05, 0x28, 0x24, 0x67, 0xba, 0x9a, 0x52, 0xc5, 0x9b, 0x5a, 0x71, 0x21, 0x17, 0xe5, 0xdd, 0x63,
-	0x52, 0x51, 0xdf, 0xd7, 0x70, 0xbf, 0x67, 0xd4, 0xe3, 0xbc, 0x5f, 0x44, 0xc9, 0x3d, 0xd8, 0xa0,
-	0x8e, 0xa3, 0x33, 0x45, 0xfd, 0x13, 0xe1, 0x4b, 0xe3, 0x3f, 0x51, 0x71, 0x75, 0xc8, 0x6c, 0xba,
-	0xb7, 0x71, 0x74, 0xce, 0x58, 0x03, 0x69, 0x15, 0x24, 0xa3, 0x2a, 0x18, 0x4e, 0x1c, 0x41, 0x15,
-	0x17, 0x69, 0x48, 0xb7, 0x74, 0x48, 0x45, 0x98, 0x7c, 0x0c, 0x3b, 0x72, 0xc2, 0xec, 0xaf, 0x3d,
-	0x35, 0xb4, 0x30, 0xf0, 0xa9, 0x8d, 0x47, 0xbe, 0xcf, 0x5f, 0xa3, 0x63, 0xdc, 0xd6, 0x0a, 0x97,
-	0xb0, 0x71, 0x55, 0xe8, 0x16, 0xed, 0x84, 0x4a, 0x71, 0xf6, 0x02, 0xdf, 0x28, 0x63, 0x57, 0x1f,
-	0x64, 0x9e, 0xa8, 0xff, 0x54, 0x82, 0x9d, 0xc5, 0xcf, 0x2b, 0xd9, 0x84, 0xf2, 0x19, 0x4e, 0xe2,
-	0xef, 0x8a, 0x15, 0x2d, 0x89, 0x03, 0x57, 0xc6, 0xd4, 0x0f, 0x31, 0xf9, 0x94, 0xbc, 0xe7, 0xc3,
-	0x56, 0x74, 0x6b, 0xc5, 0xc6, 0xef, 0x2d, 0x7d, 0x5a, 0x6a, 0xbe, 0x82, 0xeb, 0x0b, 0xdf, 0x5d,
-	0xb2, 0x0b, 0x90, 0x76, 0x41, 0xbf, 0x97, 0xc4, 0x96, 0x43, 0xa2, 0x3b, 0xa4, 0x8c, 0xb3, 0x49,
-	0xd4, 0xe2, 0x27, 0x12, 0x85, 0xd4, 0xb1, 0x56, 0xac, 0x02, 0xda, 0xec, 0xc1, 0x8d, 0xf4, 0xf3,
-	0x92, 0x3c, 0x1b, 0x16, 0xca, 0x80, 0x33, 0x89, 0xf9, 0xa7, 0xb2, 0xf4, 0xee, 0xa7, 0xb2, 0xf9,
-	0x73, 0x09, 0x96, 0xa3, 0x47, 0x96, 0x18, 0xb0, 0x6a, 0x0f, 0xa9, 0xee, 0x92, 0x38, 0xa6, 0x74,
-	0x1b, 0x3d, 0x2f, 0xd1, 0x52, 0xdf, 0xc2, 0x52, 0xfc, 0xbc, 0xa4, 0x7b, 0x72, 0x1f, 0xe0, 0xd4,
-	0x63, 0x54, 0x4c, 0x74, 0x11, 0x95, 0xb5, 0xb3, 0xdb, 0x17, 0x5e, 0x6f, 0xb3, 0x93, 0xf1, 0xf1,
-	0x37, 0x2f, 0xa7, 0x50, 0xbf, 0x0f, 0xb5, 0x02, 0xbd, 0xe0, 0xce, 0xb6, 0xf3, 0x77, 0x56, 0xcd,
-	0xe7, 0xf8, 0x16, 0xac, 0xc4, 0xe7, 0x21, 0x04, 0x96, 0x19, 0x1d, 0x61, 0xa2, 0xa6, 0xd7, 0xcd,
-	0xcf, 0xa1, 0x9a, 0x0d, 0x08, 0xe4, 0x00, 0xc0, 0xe6, 0x8c, 0xa1, 0xad, 0xb8, 0x48, 0xb3, 0x72,
-	0x3e, 0x48, 0x74, 0x53, 0xca, 0xca, 0x49, 0x35, 0x0f, 0xa1, 0x9a, 0x11, 0x8b, 0x3c, 0x44, 0x98,
-	0x9a, 0x04, 0x69, 0x60, 0x7a, 0xdd, 0xfc, 0xa5, 0x0c, 0xb9, 0xa1, 0x62, 0xa1, 0xda, 0x0e, 0xac,
-	0x78, 0x52, 0x86, 0x28, 0x12, 0xc5, 0x64, 0x47, 0x5a, 0x50, 0xb1, 0x7d, 0x0f, 0x99, 0xea, 0xf7,
-	0xf4, 0xdc, 0x52, 0xed, 0x5c, 0x9d, 0x4d, 0xf7, 0x2a, 0xdd, 0x04, 0xb3, 0x32, 0x96, 0xec, 0xc3,
-	0x9a, 0xed, 0x7b, 0x29, 0x11, 0x8f, 0x27, 0x9d, 0xda, 0x6c, 0xba, 0xb7, 0xd6, 0x1d, 0xf4, 0x33,
-	0xf9, 0xbc, 0x4c, 0xe4, 0x54, 0xda, 0x3c, 0x48, 0x86, 0x94, 0xaa, 0x95, 0xec, 0xc8, 0x2b, 0x58,
-	0xf7, 0x9c, 0x17, 0xfc, 0x0c, 0x59, 0x57, 0x0f, 0x6c, 0xc6, 0x8a, 0xce, 0xcd, 0x9d, 0x05, 0x13,
-	0x93, 0xd9, 0xcf, 0x0b, 0xea, 0xeb, 0xea, 0x6c, 0xcd, 0xa6, 0x7b, 0xeb, 0xfd, 0x5e, 0x0e, 0xb7,
-	0x2e, 0xda, 0x23, 0xf7, 0xc0, 0x40, 0xfd, 0x20, 0x1c, 0x3f, 0xee, 0x3e, 0x38, 0x0a, 0xd5, 0x10,
-	0x99, 0x4a, 0x3a, 0x49, 0x4f, 0x2a, 0x15, 0xeb, 0x52, 0xbe, 0x3e, 0x01, 0x32, 0xef, 0x73, 0x41,
-	0x89, 0x3c, 0xb9, 0xd8, 0xd6, 0x9f, 0xbc, 0xb3, 0xad, 0xe3, 0x69, 0xd5, 0xcc, 0xc6, 0xed, 0x68,
-	0xec, 0x33, 0xb5, 0xfd, 0x5c, 0x6d, 0x1d, 0xfc, 0x5a, 0x82, 0x5a, 0xda, 0x5f, 0xcf, 0x51, 0x8c,
-	0x3d, 0x1b, 0xc9, 0x97, 0x50, 0x7e, 0x88, 0x8a, 0xec, 0xcc, 0xcd, 0x77, 0x7a, 0xa6, 0xad, 0x6f,
-	0xcd, 0xe1, 0x4d, 0xe3, 0xfb, 0x3f, 0xfe, 0xfa, 0x71, 0x89, 0x90, 0x4d, 0x3d, 0xa7, 0x8f, 0xf7,
-	0xb3, 0x19, 0x99, 0x0c, 0x01, 0x1e, 0x62, 0xf6, 0xc1, 0xbf, 0xcc, 0x64, 0x63, 0x0e, 0x2f, 0xf4,
-	0x7a, 0xb3, 0xa1, 0x3d, 0xd4, 0x89, 0x51, 0xf4, 0xd0, 0x4e, 0x5a, 0xbc, 0xd3, 0xfd, 0x6d, 0xb6,
-	0x5b, 0xfa, 0x7d, 0xb6, 0x5b, 0xfa, 0x73, 0xb6, 0x5b, 0xfa, 0xe6, 0xa3, 0x7f, 0xf7, 0x67, 0x10,
-	0x97, 0x5a, 0x66, 0xec, 0x74, 0x45, 0xcf, 0xf1, 0x87, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x41,
-	0x01, 0x01, 0x5d, 0xb6, 0x0c, 0x00, 0x00,
+	// 1387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x96, 0xe3, 0x34, 0xb1, 0x4f, 0x9a, 0x38, 0x99, 0xa6, 0xe9, 0xd4, 0xb4, 0x89, 0xf1, 0x45,
+	0x65, 0x10, 0xac, 0x9b, 0x44, 0xfc, 0xa8, 0xa2, 0x82, 0xd8, 0xae, 0x5a, 0xb7, 0x69, 0x93, 0x4e,
+	0x9b, 0x22, 0x71, 0x53, 0x4d, 0x76, 0x07, 0x7b, 0x9b, 0xf5, 0xcc, 0x6a, 0x66, 0xd6, 0xad, 0x7b,
+	0xc9, 0x03, 0x70, 0x03, 0x4f, 0xc1, 0x23, 0x70, 0x8f, 0xe0, 0x12, 0x89, 0xfb, 0x08, 0x59, 0x3c,
+	0x08, 0xda, 0xd9, 0x9f, 0x6c, 0xd6, 0x9b, 0x82, 0x04, 0x77, 0x33, 0xe7, 0x3b, 0x7f, 0x73, 0xf6,
+	0x9b, 0x33, 0x67, 0x61, 0x53, 0x31, 0x39, 0x66, 0xb2, 0xad, 0x98, 0xd6, 0x2e, 0x1f, 0xa8, 0x74,
+	0x61, 0xf9, 0x52, 0x68, 0x81, 0x16, 0x6d, 0x2f, 0x50, 0x9a, 0xc9, 0xfa, 0xfa, 0x40, 0x0c, 0x84,
+	0x91, 0xb5, 0xc3, 0x55, 0x04, 0xd7, 0x6f, 0x0c, 0x84, 0x18, 0x78, 0xac, 0x4d, 0x7d, 0xb7, 0x4d,
+	0x39, 0x17, 0x9a, 0x6a, 0x57, 0xf0, 0xd8, 0xb8, 0xbe, 0x3f, 0x70, 0xf5, 0x30, 0x38, 0xb6, 0x6c,
+	0x31, 0x6a, 0x53, 0x69, 0xcc, 0x5f, 0x99, 0xc5, 0xc7, 0xb6, 0xd3, 0x1e, 0xef, 0xb6, 0xfd, 0x93,
+	0x41, 0x68, 0xa9, 0xda, 0xd4, 0xf7, 0x3d, 0xd7, 0x36, 0xb6, 0xed, 0xf1, 0x36, 0xf5, 0xfc, 0x21,
+	0xdd, 0x6e, 0x0f, 0x18, 0x67, 0x92, 0x6a, 0xe6, 0xc4, 0xde, 0xbe, 0xfa, 0x07, 0x6f, 0xf9, 0x93,
+	0x08, 0xd7, 0xb1, 0xdb, 0xb6, 0x47, 0xdd, 0x51, 0x9c, 0x4f, 0xb3, 0x06, 0xcb, 0xcf, 0x62, 0xf4,
+	0x69, 0xc0, 0xe4, 0xa4, 0xf9, 0xd3, 0x0a, 0x54, 0x12, 0x09, 0xba, 0x0e, 0xe5, 0x40, 0x7a, 0xb8,
+	0xd4, 0x28, 0xb5, 0xaa, 0x9d, 0xc5, 0xe9, 0xe9, 0x56, 0xf9, 0x88, 0xec, 0x93, 0x50, 0x86, 0x6e,
+	0x43, 0xd5, 0x61, 0x6f, 0xba, 0x82, 0x7f, 0xeb, 0x0e, 0xf0, 0x5c, 0xa3, 0xd4, 0x5a, 0xda, 0x41,
+	0x56, 0x5c, 0x19, 0xab, 0x97, 0x20, 0xe4, 0x4c, 0x09, 0x75, 0x01, 0xc2, 0xf8, 0xb1, 0x49, 0xd9,
+	0x98, 0x5c, 0x49, 0x4d, 0x0e, 0xfa, 0xbd, 0x6e, 0x04, 0x75, 0x56, 0xa6, 0xa7, 0x5b, 0x70, 0xb6,
+	0x27, 0x19, 0x33, 0xd4, 0x80, 0x25, 0xea, 0xfb, 0xfb, 0xf4, 0x98, 0x79, 0x8f, 0xd8, 0x04, 0xcf,
+	0x87, 0x99, 0x91, 0xac, 0x08, 0xbd, 0x80, 0x35, 0xc9, 0x94, 0x08, 0xa4, 0xcd, 0x0e, 0xc6, 0x4c,
+	0x4a, 0xd7, 0x61, 0x0a, 0x5f, 0x6a, 0x94, 0x5b, 0x4b, 0x3b, 0xad, 0x34, 0x5a, 0x72, 0x42, 0x8b,
+	0xe4, 0x55, 0xef, 0x71, 0x2d, 0x27, 0x64, 0xd6, 0x05, 0xb2, 0x00, 0x29, 0x4d, 0x75, 0xa0, 0x3a,
+	0xd4, 0x19, 0xb0, 0x7b, 0x9c, 0x1e, 0x7b, 0xcc, 0xc1, 0x0b, 0x8d, 0x52, 0xab, 0x42, 0x0a, 0x10,
+	0xf4, 0x00, 0x6a, 0x11, 0x13, 0xf6, 0x38, 0xf5, 0x26, 0xda, 0xb5, 0x15, 0x5e, 0x34, 0x67, 0xde,
+	0x4c, 0xb3, 0xb8, 0x7f, 0x1e, 0x8f, 0x8f, 0x9b, 0x37, 0x43, 0x6f, 0x61, 0xf5, 0x24, 0x50, 0x5a,
+	0x8c, 0xdc, 0xb7, 0xec, 0xc0, 0x37, 0x6c, 0xc2, 0x15, 0xe3, 0xea, 0x89, 0x75, 0x46, 0x00, 0x2b,
+	0x21, 0x80, 0x59, 0xbc, 0xb4, 0x1d, 0x6b, 0xbc, 0x6b, 0xf9, 0x27, 0x03, 0x2b, 0xa4, 0x93, 0x95,
+	0xa1, 0x93, 0x95, 0xd0, 0xc9, 0x7a, 0x94, 0xf3, 0x4a, 0x66, 0xe2, 0xa0, 0xf7, 0x61, 0x7e, 0xc8,
+	0x3c, 0x1f, 0x57, 0x4d, 0xbc, 0xe5, 0x34, 0xf5, 0x07, 0xcc, 0xf3, 0x89, 0x81, 0xd0, 0x07, 0xb0,
+	0xe8, 0x7b, 0xc1, 0xc0, 0xe5, 0x0a, 0x83, 0x29, 0x73, 0x2d, 0xd5, 0x3a, 0x34, 0x72, 0x92, 0xe0,
+	0x61, 0x0d, 0x03, 0xc5, 0xe4, 0xbe, 0x08, 0x77, 0x3d, 0x57, 0x45, 0x35, 0x5c, 0x8a, 0x6a, 0x38,
+	0x8b, 0xa0, 0xef, 0x4b, 0x70, 0xcd, 0x36, 0x55, 0x79, 0x4c, 0x39, 0x1d, 0xb0, 0x11, 0xe3, 0xfa,
+	0x30, 0x8e, 0x75, 0xd9, 0xc4, 0x7a, 0xfe, 0xdf, 0x2a, 0xd0, 0x2d, 0x74, 0x4e, 0x2e, 0x0a, 0x8a,
+	0x3e, 0x82, 0xb5, 0xb4, 0x44, 0x2f, 0x98, 0x54, 0xe6, 0x5b, 0x2c, 0x37, 0xca, 0xad, 0x2a, 0x99,
+	0x05, 0x50, 0x1d, 0x2a, 0x81, 0xdb, 0x55, 0xea, 0x88, 0xec, 0xe3, 0x15, 0xc3, 0xd4, 0x74, 0x8f,
+	0x5a, 0x50, 0x0b, 0xdc, 0x0e, 0xe5, 0x9c, 0xc9, 0xae, 0xe0, 0x9a, 0x71, 0x8d, 0x6b, 0x46, 0x25,
+	0x2f, 0x0e, 0x29, 0x9f, 0x88, 0x42, 0x47, 0xab, 0x11, 0xe5, 0x33, 0xa2, 0xd0, 0x97, 0x4f, 0x95,
+	0x7a, 0x2d, 0xa4, 0x73, 0x48, 0xb5, 0x66, 0x92, 0xe3, 0xb5, 0xc8, 0x57, 0x4e, 0x8c, 0x6e, 0xc1,
+	0x8a, 0x96, 0xd4, 0x3e, 0x71, 0xf9, 0xe0, 0x31, 0xd3, 0x43, 0xe1, 0x60, 0x64, 0x14, 0x73, 0xd2,
+	0xf0, 0x9c, 0x49, 0x80, 0x43, 0x26, 0x47, 0x94, 0x87, 0xf9, 0x5d, 0x31, 0xdf, 0x69, 0x16, 0x40,
+	0x1f, 0xc2, 0x6a, 0x2a, 0x14, 0xca, 0x0d, 0x4b, 0x8c, 0xd7, 0x8d, 0xdf, 0x19, 0x79, 0xee, 0x1a,
+	0x11, 0x21, 0xf4, 0x91, 0xf4, 0xf0, 0x55, 0xa3, 0x5d, 0x80, 0x84, 0xa7, 0x67, 0x6f, 0x98, 0x9d,
+	0xdc, 0xb7, 0x0d, 0x93, 0x43, 0x56, 0x84, 0x6e, 0xc3, 0x15, 0x5b, 0x70, 0x2d, 0x85, 0xe7, 0x31,
+	0xf9, 0x84, 0x8e, 0x98, 0xf2, 0xa9, 0xcd, 0xf0, 0x35, 0xe3, 0xb2, 0x08, 0x42, 0x5f, 0xc0, 0x75,
+	0xea, 0xfb, 0xaa, 0xcf, 0xf7, 0xf8, 0x24, 0x95, 0x26, 0x11, 0xb0, 0x89, 0x70, 0xb1, 0x02, 0xda,
+	0x81, 0x75, 0x77, 0xe4, 0x33, 0xa9, 0x04, 0x37, 0x6c, 0x4a, 0x0c, 0xaf, 0x1b, 0xc3, 0x42, 0x2c,
+	0xac, 0xbb, 0xcb, 0x95, 0xa6, 0x9e, 0x67, 0xc4, 0xfd, 0x1e, 0xae, 0x47, 0x75, 0x3f, 0x2f, 0x45,
+	0x77, 0x60, 0x85, 0x3a, 0x8e, 0xa9, 0x14, 0xf5, 0x8e, 0xa4, 0xa7, 0xf0, 0x7b, 0x21, 0xb9, 0x3a,
+	0x68, 0x7a, 0xba, 0xb5, 0xb2, 0x77, 0x86, 0x90, 0x7d, 0x45, 0x72, 0x9a, 0x21, 0x0b, 0x86, 0x13,
+	0x47, 0x52, 0x2d, 0x64, 0x92, 0xd2, 0x0d, 0x93, 0x52, 0x5e, 0x8c, 0x3e, 0x85, 0x0d, 0x35, 0xe1,
+	0xf6, 0xd7, 0xae, 0x1e, 0x12, 0xe6, 0x7b, 0xd4, 0x66, 0x7b, 0x9e, 0x27, 0x5e, 0x33, 0x07, 0xdf,
+	0x34, 0x06, 0x17, 0xa0, 0x11, 0x2b, 0xcc, 0x15, 0xed, 0x04, 0x5a, 0x0b, 0xfe, 0x9c, 0xbd, 0xd1,
+	0x78, 0xd3, 0x1c, 0x64, 0x16, 0x40, 0x0f, 0x01, 0x65, 0xee, 0x5c, 0x57, 0x78, 0xc1, 0x88, 0x2b,
+	0xbc, 0x65, 0xae, 0x6d, 0x3d, 0x6d, 0x11, 0x7b, 0x79, 0x15, 0x52, 0x60, 0x55, 0xff, 0xb1, 0x04,
+	0x1b, 0xc5, 0xad, 0x1a, 0xad, 0x42, 0xf9, 0x84, 0x4d, 0xa2, 0x37, 0x8a, 0x84, 0x4b, 0xe4, 0xc0,
+	0xa5, 0x31, 0xf5, 0x02, 0x86, 0xe7, 0xfe, 0x8f, 0x26, 0x99, 0x0f, 0x4b, 0x22, 0xe7, 0x77, 0xe6,
+	0x3e, 0x2f, 0x35, 0x9f, 0xc2, 0xda, 0x4c, 0xfe, 0x08, 0xc1, 0x3c, 0xa7, 0x23, 0x16, 0x67, 0x64,
+	0xd6, 0xa8, 0x05, 0x95, 0x57, 0x4a, 0xf0, 0x43, 0xaa, 0x87, 0x26, 0xab, 0x6a, 0xe7, 0xf2, 0xf4,
+	0x74, 0xab, 0xf2, 0xf0, 0xd9, 0xc1, 0x93, 0x50, 0x46, 0x52, 0xb4, 0xf9, 0x12, 0xae, 0x16, 0x3e,
+	0x0b, 0x68, 0x13, 0x20, 0xb9, 0xa4, 0xfd, 0x5e, 0xec, 0x3c, 0x23, 0x09, 0x29, 0x46, 0xb9, 0xe0,
+	0x93, 0xb0, 0x03, 0x1d, 0x29, 0x26, 0x95, 0x09, 0x54, 0x21, 0x39, 0x69, 0xb3, 0x07, 0xd7, 0x92,
+	0xd7, 0x2f, 0xee, 0x6a, 0x84, 0x29, 0x5f, 0x70, 0xc5, 0xb2, 0x9d, 0xbc, 0xf4, 0xee, 0x4e, 0xde,
+	0xfc, 0xb9, 0x04, 0xf3, 0xe1, 0x1b, 0x80, 0x30, 0x2c, 0xda, 0x43, 0x6a, 0x2e, 0x71, 0x94, 0x53,
+	0xb2, 0x0d, 0xbb, 0x5f, 0xb8, 0x34, 0x24, 0x99, 0x8b, 0xba, 0x5f, 0xb2, 0x47, 0x77, 0x01, 0x8e,
+	0x5d, 0x4e, 0xe5, 0xc4, 0x70, 0xbc, 0x6c, 0x82, 0xdd, 0x3c, 0xf7, 0xb8, 0x58, 0x9d, 0x14, 0x8f,
+	0x9e, 0xe4, 0x8c, 0x41, 0xfd, 0x2e, 0xd4, 0x72, 0x70, 0x01, 0x0d, 0xd6, 0xb3, 0x34, 0xa8, 0x66,
+	0x3f, 0xdb, 0x0d, 0x58, 0x88, 0xce, 0x53, 0xf4, 0xad, 0x9a, 0x5f, 0x42, 0x35, 0x9d, 0x5f, 0xd0,
+	0x0e, 0x80, 0x2d, 0x38, 0x67, 0xb6, 0x16, 0x32, 0xa9, 0xca, 0xd9, 0x9c, 0xd3, 0x4d, 0x20, 0x92,
+	0xd1, 0x6a, 0xee, 0x42, 0x35, 0x05, 0x0a, 0xd9, 0x80, 0x60, 0x5e, 0x4f, 0xfc, 0x24, 0x31, 0xb3,
+	0x6e, 0xfe, 0x52, 0x86, 0xcc, 0xcc, 0x53, 0x68, 0xb6, 0x01, 0x0b, 0xae, 0x52, 0x01, 0x93, 0xb1,
+	0x61, 0xbc, 0x0b, 0xc9, 0x65, 0x7b, 0x2e, 0xe3, 0xba, 0xdf, 0xc3, 0xe5, 0x33, 0x72, 0x75, 0x63,
+	0x19, 0x49, 0x51, 0xb4, 0x0d, 0x4b, 0xb6, 0xe7, 0x26, 0x40, 0x34, 0x3d, 0x75, 0x6a, 0xd3, 0xd3,
+	0xad, 0xa5, 0xee, 0x7e, 0x3f, 0xd5, 0xcf, 0xea, 0x84, 0x41, 0x95, 0x2d, 0xfc, 0x78, 0x86, 0xaa,
+	0x92, 0x78, 0x87, 0x5e, 0xc2, 0xb2, 0xeb, 0x3c, 0x17, 0x27, 0x8c, 0x77, 0xcd, 0x3c, 0x89, 0x17,
+	0x4c, 0x6d, 0x6e, 0x15, 0x0c, 0x74, 0x56, 0x3f, 0xab, 0x68, 0x3e, 0x57, 0x67, 0x6d, 0x7a, 0xba,
+	0xb5, 0xdc, 0xef, 0x65, 0xe4, 0xe4, 0xbc, 0x3f, 0x74, 0x07, 0x30, 0x33, 0xfd, 0xea, 0xf0, 0x51,
+	0xf7, 0xde, 0x5e, 0xa0, 0x87, 0x8c, 0xeb, 0xf8, 0xa2, 0x99, 0x41, 0xaa, 0x42, 0x2e, 0xc4, 0xeb,
+	0x13, 0x40, 0xb3, 0x31, 0x0b, 0x28, 0xf2, 0xf8, 0x7c, 0xa7, 0xf8, 0xec, 0x9d, 0x9d, 0x22, 0x1a,
+	0xa6, 0xad, 0xf4, 0x6f, 0x20, 0x9c, 0x4a, 0x2d, 0xe3, 0x3f, 0xc3, 0xad, 0x9d, 0x5f, 0x4b, 0x50,
+	0x4b, 0xee, 0xd7, 0x33, 0x26, 0xc7, 0xae, 0xcd, 0xd0, 0x43, 0x28, 0xdf, 0x67, 0x1a, 0x6d, 0xcc,
+	0x8c, 0x9f, 0x66, 0xe4, 0xae, 0xaf, 0xcd, 0xc8, 0x9b, 0xf8, 0xbb, 0x3f, 0xfe, 0xfa, 0x61, 0x0e,
+	0xa1, 0x55, 0xf3, 0x1b, 0x31, 0xde, 0x4e, 0x47, 0x78, 0x34, 0x04, 0xb8, 0xcf, 0xd2, 0x79, 0xe4,
+	0x22, 0x97, 0x8d, 0x19, 0x79, 0xee, 0xae, 0x37, 0x1b, 0x26, 0x42, 0x1d, 0xe1, 0x7c, 0x84, 0x76,
+	0x7c, 0xc5, 0x3b, 0xdd, 0xdf, 0xa6, 0x9b, 0xa5, 0xdf, 0xa7, 0x9b, 0xa5, 0x3f, 0xa7, 0x9b, 0xa5,
+	0x6f, 0x3e, 0xf9, 0x77, 0x3f, 0x2e, 0x11, 0xd5, 0x52, 0x67, 0xc7, 0x0b, 0xe6, 0x37, 0x63, 0xf7,
+	0xef, 0x01, 0x00, 0x59, 0x5e, 0x08, 0x57, 0x55, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1046,6 +1116,22 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ApplicationColumns) > 0 {
+		for iNdEx := len(m.ApplicationColumns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApplicationColumns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettings(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
 	if len(m.UiLoginButtonText) > 0 {
 		i -= len(m.UiLoginButtonText)
 		copy(dAtA[i:], m.UiLoginButtonText)
@@ -1375,6 +1461,47 @@ func (m *Settings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationColumn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JSONPath) > 0 {
+		i -= len(m.JSONPath)
+		copy(dAtA[i:], m.JSONPath)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.JSONPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSettings(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GoogleAnalyticsConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1894,6 +2021,32 @@ func (m *Settings) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovSettings(uint64(l))
 	}
+	if len(m.ApplicationColumns) > 0 {
+		for _, e := range m.ApplicationColumns {
+			l = e.Size()
+			n += 2 + l + sovSettings(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationColumn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
+	l = len(m.JSONPath)
+	if l > 0 {
+		n += 1 + l + sovSettings(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3140,6 +3293,155 @@ func (m *Settings) Unmarshal(dAtA []byte) error {
 			}
 			m.UiLoginButtonText = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationColumns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApplicationColumns = append(m.ApplicationColumns, &ApplicationColumn{})
+			if err := m.ApplicationColumns[len(m.ApplicationColumns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSettings(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSettings
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettings
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSettings
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettings
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettings(dAtA[iNdEx:])
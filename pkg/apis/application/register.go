@@ -24,4 +24,18 @@ const (
 	ApplicationSetShortName string = "appset"
 	ApplicationSetPlural    string = "applicationsets"
 	ApplicationSetFullName  string = ApplicationSetPlural + "." + Group
+
+	// Repository constants
+	RepositoryKind      string = "Repository"
+	RepositorySingular  string = "repository"
+	RepositoryPlural    string = "repositories"
+	RepositoryShortName string = "repo"
+	RepositoryFullName  string = RepositoryPlural + "." + Group
+
+	// Cluster constants
+	ClusterKind      string = "Cluster"
+	ClusterSingular  string = "cluster"
+	ClusterPlural    string = "clusters"
+	ClusterShortName string = "cluster"
+	ClusterFullName  string = ClusterPlural + "." + Group
 )
@@ -2,10 +2,13 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	globutil "github.com/gobwas/glob"
 	"github.com/google/go-cmp/cmp"
@@ -54,6 +57,65 @@ type AppProject struct {
 type AppProjectStatus struct {
 	// JWTTokensByRole contains a list of JWT tokens issued for a given role
 	JWTTokensByRole map[string]JWTTokens `json:"jwtTokensByRole,omitempty" protobuf:"bytes,1,opt,name=jwtTokensByRole"`
+	// SyncWindowUnlocks contains the unlocks of the sync windows of the project which require an approval
+	SyncWindowUnlocks []SyncWindowUnlock `json:"syncWindowUnlocks,omitempty" protobuf:"bytes,2,rep,name=syncWindowUnlocks"`
+}
+
+// SyncWindowUnlock is the approval of the syncs during a sync window which requires an approval
+type SyncWindowUnlock struct {
+	// WindowHash is the hash identity of the unlocked sync window
+	WindowHash string `json:"windowHash" protobuf:"bytes,1,opt,name=windowHash"`
+	// ExpiresAt is the time the unlock expires at
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,2,opt,name=expiresAt"`
+	// UnlockedBy is the user who unlocked the sync window
+	UnlockedBy string `json:"unlockedBy,omitempty" protobuf:"bytes,3,opt,name=unlockedBy"`
+	// Reason of the unlock, e.g. the reference of the approved change request
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+}
+
+// MatchingSyncWindows returns the sync windows of the project which apply to the application, without the windows
+// requiring an approval which are currently unlocked
+func (proj *AppProject) MatchingSyncWindows(app *Application) *SyncWindows {
+	return proj.Spec.SyncWindows.Matches(app).Unlocked(proj.Status.SyncWindowUnlocks, time.Now())
+}
+
+// UnlockSyncWindow unlocks the sync window with the given id, which must require an approval, for the given duration.
+// The duration defaults to the duration of the window. The expired unlocks are removed.
+func (proj *AppProject) UnlockSyncWindow(id int, duration time.Duration, user string, reason string) (*SyncWindowUnlock, error) {
+	if id < 0 || id >= len(proj.Spec.SyncWindows) {
+		return nil, fmt.Errorf("window with id '%d' not found", id)
+	}
+	window := proj.Spec.SyncWindows[id]
+	if !window.RequireApproval {
+		return nil, fmt.Errorf("window with id '%d' does not require an approval", id)
+	}
+	if duration <= 0 {
+		windowDuration, err := time.ParseDuration(window.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse duration '%s': %w", window.Duration, err)
+		}
+		duration = windowDuration
+	}
+	hash, err := window.HashIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	unlocks := make([]SyncWindowUnlock, 0, len(proj.Status.SyncWindowUnlocks)+1)
+	for _, unlock := range proj.Status.SyncWindowUnlocks {
+		if now.Before(unlock.ExpiresAt.Time) {
+			unlocks = append(unlocks, unlock)
+		}
+	}
+	unlock := SyncWindowUnlock{
+		WindowHash: strconv.FormatUint(hash, 10),
+		ExpiresAt:  metav1.NewTime(now.Add(duration)),
+		UnlockedBy: user,
+		Reason:     reason,
+	}
+	proj.Status.SyncWindowUnlocks = append(unlocks, unlock)
+	return &unlock, nil
 }
 
 // GetRoleByName returns the role in a project by the name with its index
@@ -177,6 +239,7 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - Role names must be unique and valid
 //   - Policies within a role must be unique and valid for the project/role
 //   - Groups within a role must be unique and have valid names
+//   - Allowed CIDRs must be valid and max token uses must not be negative
 //   - SyncWindows:
 //   - Each window must have a unique identity hash
 //   - Each window must validate successfully
@@ -184,6 +247,7 @@ func (proj *AppProject) ValidateJWTTokenID(roleName string, id string) error {
 //   - DestinationServiceAccounts:
 //   - Server and namespace fields must not contain invalid characters or "!"
 //   - Default service account must not be empty or contain disallowed characters
+//   - Allowed service accounts must not be empty and must compile as valid glob patterns
 //   - Server/namespace values must compile as valid glob patterns
 //   - Each (server/namespace) combination must be unique
 func (proj *AppProject) ValidateProject() error {
@@ -220,6 +284,15 @@ func (proj *AppProject) ValidateProject() error {
 		srcNamespaces[ns] = true
 	}
 
+	for _, m := range proj.Spec.DestinationMappings {
+		if (m.Server == "") == (m.Name == "") {
+			return status.Errorf(codes.InvalidArgument, "destination mapping must specify exactly one of server or name")
+		}
+		if (m.TargetServer == "") == (m.TargetName == "") {
+			return status.Errorf(codes.InvalidArgument, "destination mapping must specify exactly one of targetServer or targetName")
+		}
+	}
+
 	srcRepos := make(map[string]bool)
 	for _, src := range proj.Spec.SourceRepos {
 		if src == "!*" {
@@ -260,6 +333,14 @@ func (proj *AppProject) ValidateProject() error {
 			}
 			existingGroups[group] = true
 		}
+		for _, cidr := range role.AllowedCIDRs {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				return status.Errorf(codes.InvalidArgument, "allowed CIDR '%s' for role '%s' is invalid: %v", cidr, role.Name, err)
+			}
+		}
+		if role.MaxTokenUses < 0 {
+			return status.Errorf(codes.InvalidArgument, "max token uses for role '%s' must not be negative", role.Name)
+		}
 		roleNames[role.Name] = true
 	}
 
@@ -302,6 +383,15 @@ func (proj *AppProject) ValidateProject() error {
 			return status.Errorf(codes.InvalidArgument, "defaultServiceAccount has an invalid format, '%s'", destServiceAcct.DefaultServiceAccount)
 		}
 
+		for _, allowedServiceAcct := range destServiceAcct.AllowedServiceAccounts {
+			if strings.TrimSpace(allowedServiceAcct) == "" || strings.ContainsAny(allowedServiceAcct, "!/\\") {
+				return status.Errorf(codes.InvalidArgument, "allowedServiceAccounts has an invalid format, '%s'", allowedServiceAcct)
+			}
+			if _, err := globutil.Compile(allowedServiceAcct); err != nil {
+				return status.Errorf(codes.InvalidArgument, "allowedServiceAccounts has an invalid format, '%s'", allowedServiceAcct)
+			}
+		}
+
 		_, err := globutil.Compile(destServiceAcct.Server)
 		if err != nil {
 			return status.Errorf(codes.InvalidArgument, "server has an invalid format, '%s'", destServiceAcct.Server)
@@ -319,9 +409,122 @@ func (proj *AppProject) ValidateProject() error {
 		destServiceAccts[key] = true
 	}
 
+	operationWebhooks := make(map[string]bool)
+	for _, webhook := range proj.Spec.OperationWebhooks {
+		if webhook.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "operation webhook name cannot be empty")
+		}
+		if _, ok := operationWebhooks[webhook.Name]; ok {
+			return status.Errorf(codes.InvalidArgument, "operation webhook '%s' already added", webhook.Name)
+		}
+		webhookURL, err := url.Parse(webhook.URL)
+		if err != nil || (webhookURL.Scheme != "https" && webhookURL.Scheme != "http") || webhookURL.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "operation webhook '%s' has an invalid URL, '%s'", webhook.Name, webhook.URL)
+		}
+		if webhook.Secret != "" && !webhook.IsProjectSecretKey() {
+			return status.Errorf(codes.InvalidArgument, "operation webhook '%s' secret must reference a key of the argocd-secret starting with '%s', in the form of $%s<key>", webhook.Name, OperationWebhookSecretKeyPrefix, OperationWebhookSecretKeyPrefix)
+		}
+		operationWebhooks[webhook.Name] = true
+	}
+
+	if proj.Spec.MinSyncInterval != nil && proj.Spec.MinSyncInterval.Duration < 0 {
+		return status.Errorf(codes.InvalidArgument, "min sync interval cannot be negative")
+	}
+
+	if proj.Spec.MaxRefreshInterval != nil && proj.Spec.MaxRefreshInterval.Duration < 0 {
+		return status.Errorf(codes.InvalidArgument, "max refresh interval cannot be negative")
+	}
+
+	for i := range proj.Spec.HealthRollupRules {
+		if err := proj.Spec.HealthRollupRules[i].Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid health roll-up rule: %v", err)
+		}
+	}
+
+	if limit := proj.Spec.ReconcileRateLimit; limit != nil {
+		if limit.PerMinute <= 0 {
+			return status.Errorf(codes.InvalidArgument, "reconcile rate limit must allow at least one refresh per minute")
+		}
+		if limit.Burst < 0 {
+			return status.Errorf(codes.InvalidArgument, "reconcile rate limit burst cannot be negative")
+		}
+	}
+
+	if limits := proj.Spec.APIRateLimits; limits != nil {
+		operations := []string{"sync", "refresh", "get"}
+		for i, limit := range []*APIRateLimit{limits.Sync, limits.Refresh, limits.Get} {
+			if limit == nil {
+				continue
+			}
+			operation := operations[i]
+			if limit.PerMinute <= 0 {
+				return status.Errorf(codes.InvalidArgument, "%s API rate limit must allow at least one request per minute", operation)
+			}
+			if limit.Burst < 0 {
+				return status.Errorf(codes.InvalidArgument, "%s API rate limit burst cannot be negative", operation)
+			}
+		}
+	}
+
+	if ref := proj.Spec.IgnoreDifferencesRef; ref != nil && (ref.ConfigMapName == "" || ref.Key == "") {
+		return status.Errorf(codes.InvalidArgument, "ignore differences reference must specify a config map name and a key")
+	}
+
+	for _, manager := range proj.Spec.ManagedFieldsManagers {
+		if manager == "" {
+			return status.Errorf(codes.InvalidArgument, "managed fields manager cannot be empty")
+		}
+	}
+
+	for _, exclusion := range proj.Spec.DriftExclusions {
+		if exclusion.Kind == "" || exclusion.Expression == "" {
+			return status.Errorf(codes.InvalidArgument, "drift exclusion must specify a kind and an expression")
+		}
+	}
+
+	if policy := proj.Spec.NamespaceCreationPolicy; policy != nil {
+		switch policy.Mode {
+		case "", NamespaceCreationModeAllow, NamespaceCreationModeDeny:
+			if len(policy.Labels) > 0 || len(policy.Annotations) > 0 {
+				return status.Errorf(codes.InvalidArgument, "namespace creation policy labels and annotations require the mode '%s'", NamespaceCreationModeAllowWithRequiredLabels)
+			}
+		case NamespaceCreationModeAllowWithRequiredLabels:
+			if len(policy.Labels) == 0 && len(policy.Annotations) == 0 {
+				return status.Errorf(codes.InvalidArgument, "namespace creation policy with the mode '%s' must specify labels or annotations", NamespaceCreationModeAllowWithRequiredLabels)
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "namespace creation policy has an invalid mode '%s'", policy.Mode)
+		}
+	}
+
+	return nil
+}
+
+// ValidateOperationWebhookURLs returns an error if the URL of an operation webhook of the project does not match any of
+// the URL patterns the administrator allows project operation webhooks to call
+func (proj *AppProject) ValidateOperationWebhookURLs(allowedURLs []string) error {
+	for _, webhook := range proj.Spec.OperationWebhooks {
+		if !webhook.IsURLPermitted(allowedURLs) {
+			return status.Errorf(codes.InvalidArgument, "operation webhook '%s' URL '%s' is not permitted, it must match one of the URL patterns of 'operation.webhooks.allowedURLs' in argocd-cm", webhook.Name, webhook.URL)
+		}
+	}
 	return nil
 }
 
+// IsURLPermitted returns true if the URL of the webhook matches one of the given glob patterns
+func (w *OperationWebhook) IsURLPermitted(allowedURLs []string) bool {
+	return slices.ContainsFunc(allowedURLs, func(pattern string) bool {
+		return glob.Match(pattern, w.URL)
+	})
+}
+
+// IsProjectSecretKey returns true if the secret of the webhook references a key of the argocd-secret which project
+// operation webhooks may use, i.e. a key starting with OperationWebhookSecretKeyPrefix
+func (w *OperationWebhook) IsProjectSecretKey() bool {
+	key, ok := strings.CutPrefix(w.Secret, "$")
+	return ok && len(key) > len(OperationWebhookSecretKeyPrefix) && strings.HasPrefix(key, OperationWebhookSecretKeyPrefix)
+}
+
 // RoleGroupExists checks if a group exists in the role
 func RoleGroupExists(role *ProjectRole) bool {
 	return len(role.Groups) != 0
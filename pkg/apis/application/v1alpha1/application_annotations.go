@@ -4,6 +4,10 @@ const (
 	// AnnotationKeyRefresh is the annotation key which indicates that app needs to be refreshed. Removed by application controller after app is refreshed.
 	// Might take values 'normal'/'hard'. Value 'hard' means manifest cache and target cluster state cache should be invalidated before refresh.
 	AnnotationKeyRefresh string = "argocd.argoproj.io/refresh"
+	// AnnotationKeyRefreshPriority is the annotation key which indicates the priority of the refresh requested by the
+	// refresh annotation. Value 'interactive' means the refresh was requested by a user and is processed ahead of the
+	// periodic reconciliations. Removed by application controller together with the refresh annotation.
+	AnnotationKeyRefreshPriority string = "argocd.argoproj.io/refresh-priority"
 	// AnnotationKeyHydrate is the annotation key which indicates that app needs to be hydrated. Removed by application controller after app is hydrated.
 	AnnotationKeyHydrate string = "argocd.argoproj.io/hydrate"
 	// AnnotationKeyManifestGeneratePaths is an annotation that contains a list of semicolon-separated paths in the
@@ -13,4 +17,7 @@ const (
 	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
 	// AnnotationKeyManagedByURL contains the URL of the Argo CD instance managing the application
 	AnnotationKeyManagedByURL = "argocd.argoproj.io/managed-by-url"
+	// LabelKeySourceEnvironment is the label of an application whose value selects the .argocd-source.<environment>.yaml
+	// parameter override files, layered between the .argocd-source.yaml and the .argocd-source-<app>.yaml files
+	LabelKeySourceEnvironment = "argocd.argoproj.io/source-environment"
 )
@@ -6,6 +6,12 @@ const (
 	DefaultSyncRetryMaxDuration time.Duration = 180000000000 // 3m0s
 	DefaultSyncRetryDuration    time.Duration = 5000000000   // 5s
 	DefaultSyncRetryFactor                    = int64(2)
+	// DefaultSelfHealBackoffDuration, DefaultSelfHealBackoffFactor, DefaultSelfHealBackoffMaxDuration and
+	// DefaultSelfHealBackoffCooldown match the defaults of the controller-wide self-heal backoff
+	DefaultSelfHealBackoffDuration    time.Duration = 2 * time.Second
+	DefaultSelfHealBackoffFactor                    = int64(3)
+	DefaultSelfHealBackoffMaxDuration time.Duration = 5 * time.Minute
+	DefaultSelfHealBackoffCooldown    time.Duration = 330 * time.Second
 	// ResourcesFinalizerName is the finalizer value which we inject to finalize deletion of an application
 	ResourcesFinalizerName string = "resources-finalizer.argocd.argoproj.io"
 
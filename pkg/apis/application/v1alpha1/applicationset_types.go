@@ -79,6 +79,30 @@ type ApplicationSetSpec struct {
 	ApplyNestedSelectors         bool                            `json:"applyNestedSelectors,omitempty" protobuf:"bytes,8,name=applyNestedSelectors"`
 	IgnoreApplicationDifferences ApplicationSetIgnoreDifferences `json:"ignoreApplicationDifferences,omitempty" protobuf:"bytes,9,name=ignoreApplicationDifferences"`
 	TemplatePatch                *string                         `json:"templatePatch,omitempty" protobuf:"bytes,10,name=templatePatch"`
+	// TemplatePatches are applied in order to the generated applications whose parameters they match, after the templatePatch
+	TemplatePatches []ApplicationSetTemplatePatch `json:"templatePatches,omitempty" protobuf:"bytes,11,rep,name=templatePatches"`
+}
+
+// ApplicationSetTemplatePatchType is the type of a template patch
+type ApplicationSetTemplatePatchType string
+
+const (
+	// ApplicationSetTemplatePatchTypeStrategic is a strategic merge patch
+	ApplicationSetTemplatePatchTypeStrategic ApplicationSetTemplatePatchType = "strategic"
+	// ApplicationSetTemplatePatchTypeJSON is a JSON patch
+	ApplicationSetTemplatePatchTypeJSON ApplicationSetTemplatePatchType = "json"
+)
+
+// ApplicationSetTemplatePatch is a patch applied to the generated applications whose parameters match its selector
+type ApplicationSetTemplatePatch struct {
+	// Selector selects the generated applications the patch is applied to by their parameters, like the selector of a
+	// generator. The patch is applied to all generated applications when not set.
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,1,opt,name=selector"`
+	// Type is the type of the patch, either strategic (the default) for a strategic merge patch, or json for a JSON patch
+	// +kubebuilder:validation:Enum=strategic;json
+	Type ApplicationSetTemplatePatchType `json:"type,omitempty" protobuf:"bytes,2,opt,name=type,casttype=ApplicationSetTemplatePatchType"`
+	// Patch is the patch, rendered as a template with the parameters of the generated application
+	Patch string `json:"patch" protobuf:"bytes,3,opt,name=patch"`
 }
 
 type ApplicationPreservedFields struct {
@@ -407,6 +431,9 @@ type DuckTypeGenerator struct {
 	Template ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,5,name=template"`
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
+	// JSONPathParameters contains additional parameters passed to the template, by parameter name, which are extracted with
+	// a JSONPath expression (e.g. '{.hints.region}') from each item of the status list of the decision resource
+	JSONPathParameters map[string]string `json:"jsonPathParameters,omitempty" protobuf:"bytes,7,name=jsonPathParameters"`
 }
 
 type GitGenerator struct {
@@ -420,6 +447,14 @@ type GitGenerator struct {
 
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,8,name=values"`
+	// Schema is a JSON Schema which the files of the files generator are validated against. Files which do not match
+	// the schema fail the generator, and are listed in the fileErrors of the status of the ApplicationSet.
+	Schema *apiextensionsv1.JSON `json:"schema,omitempty" protobuf:"bytes,9,opt,name=schema"`
+	// OverrideFile is the name of a file which, when present in the directory of a generated application, is merged
+	// into the generated Application as a strategic merge patch, after the template patches. The directory of an
+	// application is the directory of the directories generator, or the directory of the file of the files generator.
+	// The project and the name of the Application cannot be overridden.
+	OverrideFile string `json:"overrideFile,omitempty" protobuf:"bytes,10,opt,name=overrideFile"`
 }
 
 type GitDirectoryGeneratorItem struct {
@@ -453,6 +488,9 @@ type SCMProviderGenerator struct {
 	// Values contains key/value pairs which are passed directly as parameters to the template
 	Values        map[string]string                  `json:"values,omitempty" protobuf:"bytes,11,name=values"`
 	AWSCodeCommit *SCMProviderGeneratorAWSCodeCommit `json:"awsCodeCommit,omitempty" protobuf:"bytes,12,opt,name=awsCodeCommit"`
+	// CredentialsURL is matched against the repository and repository credential template secrets of Argo CD, to look
+	// up the credentials of the SCM provider when it does not reference a secret itself.
+	CredentialsURL string `json:"credentialsURL,omitempty" protobuf:"bytes,13,opt,name=credentialsURL"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
@@ -630,6 +668,9 @@ type PullRequestGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
 	// ContinueOnRepoNotFoundError is a flag to continue the ApplicationSet Pull Request generator parameters generation even if the repository is not found.
 	ContinueOnRepoNotFoundError bool `json:"continueOnRepoNotFoundError,omitempty" protobuf:"varint,11,opt,name=continueOnRepoNotFoundError"`
+	// CredentialsURL is matched against the repository and repository credential template secrets of Argo CD, to look
+	// up the credentials of the SCM provider when it does not reference a secret itself.
+	CredentialsURL string `json:"credentialsURL,omitempty" protobuf:"bytes,12,opt,name=credentialsURL"`
 	// If you add a new SCM provider, update CustomApiUrl below.
 }
 
@@ -822,6 +863,19 @@ type ApplicationSetStatus struct {
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
 	// Health contains information about the applicationset's current health status based on the applicationset conditions
 	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	// FileErrors lists the files of the Git files generators which could not be parsed or do not match the schema of
+	// their generator
+	FileErrors []ApplicationSetFileError `json:"fileErrors,omitempty" protobuf:"bytes,6,rep,name=fileErrors"`
+}
+
+// ApplicationSetFileError is a file of a Git files generator which could not be used to generate parameters
+type ApplicationSetFileError struct {
+	// RepoURL is the URL of the repository of the file
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Path is the path of the file in the repository
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	// Message describes why the file could not be used
+	Message string `json:"message" protobuf:"bytes,3,opt,name=message"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -36,6 +36,9 @@ const (
 
 	// EnvK8sServerSideTimeout is the duration for the server side timeout for each API request
 	EnvK8sServerSideTimeout = "ARGOCD_K8S_SERVER_SIDE_TIMEOUT"
+
+	// EnvK8sCloudAuthTokenTimeout is the duration for requesting a token of a cluster that authenticates using a cloud workload identity
+	EnvK8sCloudAuthTokenTimeout = "ARGOCD_K8S_CLOUD_AUTH_TOKEN_TIMEOUT"
 )
 
 // Configuration variables associated with the Cluster API
@@ -64,4 +67,7 @@ var (
 
 	// K8sServerSideTimeout defines which server side timeout to send with each API request
 	K8sServerSideTimeout = env.ParseDurationFromEnv(EnvK8sServerSideTimeout, 0, 0, math.MaxInt32*time.Second)
+
+	// K8sCloudAuthTokenTimeout defines the maximum duration to wait for a token of a cluster that authenticates using a cloud workload identity
+	K8sCloudAuthTokenTimeout = env.ParseDurationFromEnv(EnvK8sCloudAuthTokenTimeout, time.Minute, 0, math.MaxInt32*time.Second)
 )
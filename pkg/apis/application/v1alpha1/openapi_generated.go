@@ -14,6 +14,7 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.APIRateLimit":                            schema_pkg_apis_application_v1alpha1_APIRateLimit(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AWSAuthConfig":                           schema_pkg_apis_application_v1alpha1_AWSAuthConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProject":                              schema_pkg_apis_application_v1alpha1_AppProject(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AppProjectList":                          schema_pkg_apis_application_v1alpha1_AppProjectList(ref),
@@ -23,12 +24,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition":                    schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination":                  schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount":    schema_pkg_apis_application_v1alpha1_ApplicationDestinationServiceAccount(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationStatus":            schema_pkg_apis_application_v1alpha1_ApplicationDestinationStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationList":                         schema_pkg_apis_application_v1alpha1_ApplicationList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMatchExpression":              schema_pkg_apis_application_v1alpha1_ApplicationMatchExpression(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationMetricsHistory":               schema_pkg_apis_application_v1alpha1_ApplicationMetricsHistory(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields":              schema_pkg_apis_application_v1alpha1_ApplicationPreservedFields(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSet":                          schema_pkg_apis_application_v1alpha1_ApplicationSet(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus":         schema_pkg_apis_application_v1alpha1_ApplicationSetApplicationStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition":                 schema_pkg_apis_application_v1alpha1_ApplicationSetCondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetFileError":                 schema_pkg_apis_application_v1alpha1_ApplicationSetFileError(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator":                 schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetList":                      schema_pkg_apis_application_v1alpha1_ApplicationSetList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetNestedGenerator":           schema_pkg_apis_application_v1alpha1_ApplicationSetNestedGenerator(ref),
@@ -41,6 +45,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy":                schema_pkg_apis_application_v1alpha1_ApplicationSetSyncPolicy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate":                  schema_pkg_apis_application_v1alpha1_ApplicationSetTemplate(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplateMeta":              schema_pkg_apis_application_v1alpha1_ApplicationSetTemplateMeta(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplatePatch":             schema_pkg_apis_application_v1alpha1_ApplicationSetTemplatePatch(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTerminalGenerator":         schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTree":                      schema_pkg_apis_application_v1alpha1_ApplicationSetTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource":                       schema_pkg_apis_application_v1alpha1_ApplicationSource(ref),
@@ -55,6 +60,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary":                      schema_pkg_apis_application_v1alpha1_ApplicationSummary(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationTree":                         schema_pkg_apis_application_v1alpha1_ApplicationTree(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationWatchEvent":                   schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AzureAuthConfig":                         schema_pkg_apis_application_v1alpha1_AzureAuthConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Backoff":                                 schema_pkg_apis_application_v1alpha1_Backoff(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BasicAuthBitbucketServer":                schema_pkg_apis_application_v1alpha1_BasicAuthBitbucketServer(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.BearerTokenBitbucket":                    schema_pkg_apis_application_v1alpha1_BearerTokenBitbucket(ref),
@@ -67,20 +73,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterInfo":                             schema_pkg_apis_application_v1alpha1_ClusterInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterList":                             schema_pkg_apis_application_v1alpha1_ClusterList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Command":                                 schema_pkg_apis_application_v1alpha1_Command(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CommitMetadata":                          schema_pkg_apis_application_v1alpha1_CommitMetadata(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ComparedTo":                              schema_pkg_apis_application_v1alpha1_ComparedTo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ComponentParameter":                      schema_pkg_apis_application_v1alpha1_ComponentParameter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigManagementPlugin":                  schema_pkg_apis_application_v1alpha1_ConfigManagementPlugin(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef":                         schema_pkg_apis_application_v1alpha1_ConfigMapKeyRef(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConnectionState":                         schema_pkg_apis_application_v1alpha1_ConnectionState(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DestinationMapping":                      schema_pkg_apis_application_v1alpha1_DestinationMapping(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DriftExclusion":                          schema_pkg_apis_application_v1alpha1_DriftExclusion(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DrySource":                               schema_pkg_apis_application_v1alpha1_DrySource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator":                       schema_pkg_apis_application_v1alpha1_DuckTypeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.EnvEntry":                                schema_pkg_apis_application_v1alpha1_EnvEntry(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig":                      schema_pkg_apis_application_v1alpha1_ExecProviderConfig(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GCPAuthConfig":                           schema_pkg_apis_application_v1alpha1_GCPAuthConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem":               schema_pkg_apis_application_v1alpha1_GitDirectoryGeneratorItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem":                    schema_pkg_apis_application_v1alpha1_GitFileGeneratorItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator":                            schema_pkg_apis_application_v1alpha1_GitGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKey":                          schema_pkg_apis_application_v1alpha1_GnuPGPublicKey(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GnuPGPublicKeyList":                      schema_pkg_apis_application_v1alpha1_GnuPGPublicKeyList(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthRollupRule":                        schema_pkg_apis_application_v1alpha1_HealthRollupRule(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus":                            schema_pkg_apis_application_v1alpha1_HealthStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmFileParameter":                       schema_pkg_apis_application_v1alpha1_HelmFileParameter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HelmOptions":                             schema_pkg_apis_application_v1alpha1_HelmOptions(ref),
@@ -89,6 +100,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HostResourceInfo":                        schema_pkg_apis_application_v1alpha1_HostResourceInfo(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateOperation":                        schema_pkg_apis_application_v1alpha1_HydrateOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HydrateTo":                               schema_pkg_apis_application_v1alpha1_HydrateTo(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdate":                             schema_pkg_apis_application_v1alpha1_ImageUpdate(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdatePolicy":                       schema_pkg_apis_application_v1alpha1_ImageUpdatePolicy(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdateStatus":                       schema_pkg_apis_application_v1alpha1_ImageUpdateStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info":                                    schema_pkg_apis_application_v1alpha1_Info(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.InfoItem":                                schema_pkg_apis_application_v1alpha1_InfoItem(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken":                                schema_pkg_apis_application_v1alpha1_JWTToken(ref),
@@ -103,13 +117,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.KustomizeSelector":                       schema_pkg_apis_application_v1alpha1_KustomizeSelector(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator":                           schema_pkg_apis_application_v1alpha1_ListGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata":                schema_pkg_apis_application_v1alpha1_ManagedNamespaceMetadata(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManifestGenerationLimits":                schema_pkg_apis_application_v1alpha1_ManifestGenerationLimits(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MatrixGenerator":                         schema_pkg_apis_application_v1alpha1_MatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.MergeGenerator":                          schema_pkg_apis_application_v1alpha1_MergeGenerator(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceCreationPolicy":                 schema_pkg_apis_application_v1alpha1_NamespaceCreationPolicy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMatrixGenerator":                   schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NestedMergeGenerator":                    schema_pkg_apis_application_v1alpha1_NestedMergeGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation":                               schema_pkg_apis_application_v1alpha1_Operation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator":                      schema_pkg_apis_application_v1alpha1_OperationInitiator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState":                          schema_pkg_apis_application_v1alpha1_OperationState(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationWebhook":                        schema_pkg_apis_application_v1alpha1_OperationWebhook(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OptionalArray":                           schema_pkg_apis_application_v1alpha1_OptionalArray(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OptionalMap":                             schema_pkg_apis_application_v1alpha1_OptionalMap(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourceKey":                     schema_pkg_apis_application_v1alpha1_OrphanedResourceKey(ref),
@@ -118,6 +135,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginConfigMapRef":                      schema_pkg_apis_application_v1alpha1_PluginConfigMapRef(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginGenerator":                         schema_pkg_apis_application_v1alpha1_PluginGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PluginInput":                             schema_pkg_apis_application_v1alpha1_PluginInput(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectAPIRateLimits":                    schema_pkg_apis_application_v1alpha1_ProjectAPIRateLimits(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectExecConfig":                       schema_pkg_apis_application_v1alpha1_ProjectExecConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole":                             schema_pkg_apis_application_v1alpha1_ProjectRole(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGenerator":                    schema_pkg_apis_application_v1alpha1_PullRequestGenerator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorAzureDevOps":         schema_pkg_apis_application_v1alpha1_PullRequestGeneratorAzureDevOps(ref),
@@ -127,6 +146,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitLab":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitLab(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGitea":               schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.PullRequestGeneratorGithub":              schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconcileRateLimit":                      schema_pkg_apis_application_v1alpha1_ReconcileRateLimit(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconciliationSample":                    schema_pkg_apis_application_v1alpha1_ReconciliationSample(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RefTarget":                               schema_pkg_apis_application_v1alpha1_RefTarget(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepoCreds":                               schema_pkg_apis_application_v1alpha1_RepoCreds(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepoCredsList":                           schema_pkg_apis_application_v1alpha1_RepoCredsList(ref),
@@ -134,10 +155,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryCertificate":                   schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryCertificateList":               schema_pkg_apis_application_v1alpha1_RepositoryCertificateList(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryList":                          schema_pkg_apis_application_v1alpha1_RepositoryList(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryStatus":                        schema_pkg_apis_application_v1alpha1_RepositoryStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceAction":                          schema_pkg_apis_application_v1alpha1_ResourceAction(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActionDefinition":                schema_pkg_apis_application_v1alpha1_ResourceActionDefinition(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActionParam":                     schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceActions":                         schema_pkg_apis_application_v1alpha1_ResourceActions(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceBudget":                          schema_pkg_apis_application_v1alpha1_ResourceBudget(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceDiff":                            schema_pkg_apis_application_v1alpha1_ResourceDiff(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences":               schema_pkg_apis_application_v1alpha1_ResourceIgnoreDifferences(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceNetworkingInfo":                  schema_pkg_apis_application_v1alpha1_ResourceNetworkingInfo(ref),
@@ -147,6 +170,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult":                          schema_pkg_apis_application_v1alpha1_ResourceResult(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus":                          schema_pkg_apis_application_v1alpha1_ResourceStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy":                           schema_pkg_apis_application_v1alpha1_RetryStrategy(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionComparison":                      schema_pkg_apis_application_v1alpha1_RevisionComparison(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory":                         schema_pkg_apis_application_v1alpha1_RevisionHistory(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionMetadata":                        schema_pkg_apis_application_v1alpha1_RevisionMetadata(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator":                    schema_pkg_apis_application_v1alpha1_SCMProviderGenerator(ref),
@@ -158,22 +182,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitea":               schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitea(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGithub":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGithub(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGeneratorGitlab":              schema_pkg_apis_application_v1alpha1_SCMProviderGeneratorGitlab(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealBackoff":                         schema_pkg_apis_application_v1alpha1_SelfHealBackoff(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealStatus":                          schema_pkg_apis_application_v1alpha1_SelfHealStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SecretRef":                               schema_pkg_apis_application_v1alpha1_SecretRef(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey":                            schema_pkg_apis_application_v1alpha1_SignatureKey(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator":                          schema_pkg_apis_application_v1alpha1_SourceHydrator(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus":                    schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.StuckResource":                           schema_pkg_apis_application_v1alpha1_StuckResource(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SubmodulePolicy":                         schema_pkg_apis_application_v1alpha1_SubmodulePolicy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SuccessfulHydrateOperation":              schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperation":                           schema_pkg_apis_application_v1alpha1_SyncOperation(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationDestinationResult":          schema_pkg_apis_application_v1alpha1_SyncOperationDestinationResult(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResource":                   schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult":                     schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy":                              schema_pkg_apis_application_v1alpha1_SyncPolicy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated":                     schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyScheduled":                     schema_pkg_apis_application_v1alpha1_SyncPolicyScheduled(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPrecondition":                        schema_pkg_apis_application_v1alpha1_SyncPrecondition(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSample":                              schema_pkg_apis_application_v1alpha1_SyncSample(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSource":                              schema_pkg_apis_application_v1alpha1_SyncSource(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus":                              schema_pkg_apis_application_v1alpha1_SyncStatus(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategy":                            schema_pkg_apis_application_v1alpha1_SyncStrategy(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyApply":                       schema_pkg_apis_application_v1alpha1_SyncStrategyApply(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStrategyHook":                        schema_pkg_apis_application_v1alpha1_SyncStrategyHook(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow":                              schema_pkg_apis_application_v1alpha1_SyncWindow(ref),
+		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowUnlock":                        schema_pkg_apis_application_v1alpha1_SyncWindowUnlock(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig":                         schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TagFilter":                               schema_pkg_apis_application_v1alpha1_TagFilter(ref),
 		"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.objectMeta":                              schema_pkg_apis_application_v1alpha1_objectMeta(ref),
@@ -181,6 +214,35 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_APIRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIRateLimit is a token bucket rate limit of API server requests",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"perMinute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerMinute is the number of requests per minute",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of requests which can exceed the rate. Defaults to PerMinute.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"perMinute"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_AWSAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -377,7 +439,7 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterResourceRestrictionItem"),
 									},
 								},
 							},
@@ -432,7 +494,7 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 					},
 					"signatureKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync",
+							Description: "SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync\n\nDeprecated: Use SourceIntegrity instead. SignatureKeys will be removed with the next major version.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -452,7 +514,7 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterResourceRestrictionItem"),
 									},
 								},
 							},
@@ -494,11 +556,151 @@ func schema_pkg_apis_application_v1alpha1_AppProjectSpec(ref common.ReferenceCal
 							},
 						},
 					},
+					"sourceIntegrity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceIntegrity represents a constraint on manifest sources integrity to be met before they can be used. Do not access directly, use EffectiveSourceIntegrity() for correct backwards compatibility handling.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceIntegrity"),
+						},
+					},
+					"operationWebhooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationWebhooks are called when the phase of an operation of an application in this project changes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationWebhook"),
+									},
+								},
+							},
+						},
+					},
+					"reconcilePriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcilePriority is the priority of the applications of this project in the reconciliation queues of the application controller. Applications of projects with a higher priority are reconciled first when the queues are saturated. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"reconcileRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReconcileRateLimit limits the rate at which the applications of this project are refreshed by the application controller",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconcileRateLimit"),
+						},
+					},
+					"ignoreDifferencesRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IgnoreDifferencesRef references a key of a ConfigMap of the Argo CD namespace holding a list of ignore differences, which are merged into the ignore differences of every application of this project. The ConfigMap must be labeled with app.kubernetes.io/part-of: argocd.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef"),
+						},
+					},
+					"namespaceCreationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceCreationPolicy controls whether the applications of this project can create their destination namespace with the CreateNamespace=true sync option. Defaults to allowing it.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceCreationPolicy"),
+						},
+					},
+					"exec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exec configures the web-based terminal of the applications of this project",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectExecConfig"),
+						},
+					},
+					"destinationMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DestinationMappings rewrite the destinations of the applications of this project, allowing them to be pointed at a replacement cluster without editing their specs. The first matching mapping wins.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DestinationMapping"),
+									},
+								},
+							},
+						},
+					},
+					"minSyncInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinSyncInterval is the minimum interval between the start of a sync of an application of this project and the start of the next automated sync of the application (default: 0, no minimum)",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxRefreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxRefreshInterval is the maximum refresh interval the applications of this project can set in their sync policy (default: 0, no maximum)",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"healthRollupRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthRollupRules control how the health of the resources of given group kinds contributes to the health of the applications of this project",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthRollupRule"),
+									},
+								},
+							},
+						},
+					},
+					"managedFieldsManagers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManagedFieldsManagers is a list of trusted field managers. Fields owned by those managers are ignored when diffing the resources of every application of this project, as if listed in the managedFieldsManagers of an ignore difference matching all group kinds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"driftExclusions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftExclusions exclude the drift of the resources of the applications of this project for which a CEL expression evaluates to true",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DriftExclusion"),
+									},
+								},
+							},
+						},
+					},
+					"apiRateLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIRateLimits limit the rate of the API server requests on the applications of this project",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectAPIRateLimits"),
+						},
+					},
+					"resourceBudgets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceBudgets limit the total CPU and memory requests of the applications of this project per destination. Syncs which would exceed a budget are rejected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceBudget"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationServiceAccount", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterResourceRestrictionItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConfigMapKeyRef", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DestinationMapping", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DriftExclusion", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthRollupRule", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.NamespaceCreationPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationWebhook", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OrphanedResourcesMonitorSettings", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectAPIRateLimits", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectExecConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ProjectRole", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconcileRateLimit", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceBudget", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SignatureKey", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceIntegrity", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindow", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
 	}
 }
 
@@ -524,11 +726,25 @@ func schema_pkg_apis_application_v1alpha1_AppProjectStatus(ref common.ReferenceC
 							},
 						},
 					},
+					"syncWindowUnlocks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWindowUnlocks contains the unlocks of the sync windows of the project which require an approval",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowUnlock"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTTokens"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTTokens", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncWindowUnlock"},
 	}
 }
 
@@ -614,6 +830,19 @@ func schema_pkg_apis_application_v1alpha1_ApplicationCondition(ref common.Refere
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"severity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severity is the severity of the condition, one of: Info, Warning, Error. Defaults to the suffix of the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt is the time after which a transient condition ages out and is removed from the application",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"type", "message"},
 			},
@@ -651,6 +880,13 @@ func schema_pkg_apis_application_v1alpha1_ApplicationDestination(ref common.Refe
 							Format:      "",
 						},
 					},
+					"serviceAccount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccount overrides the service account impersonated during the sync operations of an application, in the format <service_account> or <namespace>:<service_account>. It must be permitted by the destination service account of the project which matches the destination. Only used by applications.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -685,9 +921,68 @@ func schema_pkg_apis_application_v1alpha1_ApplicationDestinationServiceAccount(r
 							Format:      "",
 						},
 					},
+					"allowedServiceAccounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedServiceAccounts are glob patterns of the service accounts which applications of the destination may impersonate instead of the default service account, by setting spec.destination.serviceAccount",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationDestinationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationDestinationStatus contains the sync and health status of one of the destinations of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination is the destination the status applies to",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
+						},
+					},
+					"sync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sync is the sync status of the application's resources in the destination",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"health": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Health is the aggregated health status of the application's resources in the destination",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains the error which prevented the comparison of the destination, if any",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"destination"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"},
 	}
 }
 
@@ -778,6 +1073,49 @@ func schema_pkg_apis_application_v1alpha1_ApplicationMatchExpression(ref common.
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationMetricsHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationMetricsHistory holds the most recent reconciliation and sync measurements of an application. The history is kept in the shared cache and is only populated if the application controller is configured to record it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reconciliations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reconciliations holds the most recent reconciliation samples, oldest first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconciliationSample"),
+									},
+								},
+							},
+						},
+					},
+					"syncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Syncs holds the most recent sync samples, oldest first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSample"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ReconciliationSample", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncSample"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationPreservedFields(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -989,35 +1327,73 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetCondition(ref common.Ref
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ApplicationSetFileError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ApplicationSetGenerator represents a generator at the top level of an ApplicationSet.",
+				Description: "ApplicationSetFileError is a file of a Git files generator which could not be used to generate parameters",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"list": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator"),
-						},
-					},
-					"clusters": {
+					"repoURL": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator"),
+							Description: "RepoURL is the URL of the repository of the file",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"git": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator"),
+							Description: "Path is the path of the file in the repository",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"scmProvider": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator"),
+							Description: "Message describes why the file could not be used",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterDecisionResource": {
-						SchemaProps: spec.SchemaProps{
+				},
+				Required: []string{"repoURL", "path", "message"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ApplicationSetGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetGenerator represents a generator at the top level of an ApplicationSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"list": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ListGenerator"),
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ClusterGenerator"),
+						},
+					},
+					"git": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitGenerator"),
+						},
+					},
+					"scmProvider": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SCMProviderGenerator"),
+						},
+					},
+					"clusterDecisionResource": {
+						SchemaProps: spec.SchemaProps{
 							Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.DuckTypeGenerator"),
 						},
 					},
@@ -1368,12 +1744,26 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetSpec(ref common.Referenc
 							Format: "",
 						},
 					},
+					"templatePatches": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplatePatches are applied in order to the generated applications whose parameters they match, after the templatePatch",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplatePatch"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"generators", "template"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationPreservedFields", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetGenerator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetSyncPolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplatePatch"},
 	}
 }
 
@@ -1425,11 +1815,39 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetStatus(ref common.Refere
 							},
 						},
 					},
+					"resourcesCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourcesCount is the total number of resources managed by this application set. The count may be higher than actual number of items in the Resources field when the number of managed resources exceeds the limit imposed by the controller (to avoid making the status field too large).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"health": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Health contains information about the applicationset's current health status based on the applicationset conditions",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus"),
+						},
+					},
+					"fileErrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileErrors lists the files of the Git files generators which could not be parsed or do not match the schema of their generator",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetFileError"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetApplicationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetFileError", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus"},
 	}
 }
 
@@ -1583,6 +2001,43 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSetTemplateMeta(ref common.
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ApplicationSetTemplatePatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationSetTemplatePatch is a patch applied to the generated applications whose parameters match its selector",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector selects the generated applications the patch is applied to by their parameters, like the selector of a generator. The patch is applied to all generated applications when not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the patch, either strategic (the default) for a strategic merge patch, or json for a JSON patch",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"patch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patch is the patch, rendered as a template with the parameters of the generated application",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"patch"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ApplicationSetTerminalGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2329,12 +2784,46 @@ func schema_pkg_apis_application_v1alpha1_ApplicationSpec(ref common.ReferenceCa
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator"),
 						},
 					},
+					"destinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destinations is a list of target Kubernetes servers and namespaces to which the application's manifests are all deployed. If set, the first destination is the primary destination of the application and Destination is set to it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
+									},
+								},
+							},
+						},
+					},
+					"imageUpdatePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageUpdatePolicy configures the controller to update the container images of the application to the newest versions available in their registries.",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdatePolicy"),
+						},
+					},
+					"healthRollupRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthRollupRules control how the health of the resources of given group kinds contributes to the health of the application. They take precedence over the health roll-up rules of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthRollupRule"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"destination", "project"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthRollupRule", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdatePolicy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Info", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceIgnoreDifferences", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydrator", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicy"},
 	}
 }
 
@@ -2469,11 +2958,51 @@ func schema_pkg_apis_application_v1alpha1_ApplicationStatus(ref common.Reference
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus"),
 						},
 					},
+					"destinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destinations contains the sync and health status of each destination of an application with multiple destinations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationStatus"),
+									},
+								},
+							},
+						},
+					},
+					"nextScheduledSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextScheduledSync is the next time at which the application is automatically synced, if the automated syncs are restricted to a schedule",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"selfHeal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelfHeal contains the consecutive self-heal attempts of the application, if it has a self-heal backoff",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealStatus"),
+						},
+					},
+					"imageUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageUpdates contains the image references most recently applied by the image update policy of the application",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdateStatus"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationCondition", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestinationStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSummary", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.HealthStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdateStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RevisionHistory", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SourceHydratorStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -2607,6 +3136,26 @@ func schema_pkg_apis_application_v1alpha1_ApplicationWatchEvent(ref common.Refer
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_AzureAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureAuthConfig is an AKS Microsoft Entra ID authentication configuration. Access tokens are obtained using the workload identity federation of the Argo CD components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverApplicationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerApplicationID contains the optional application ID of the Microsoft Entra ID server application. Defaults to the application of AKS clusters with managed Microsoft Entra ID integration.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_Backoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2881,6 +3430,20 @@ func schema_pkg_apis_application_v1alpha1_Cluster(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"namespacedCache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates if the controller should list and watch only the namespaces of the applications deployed to the cluster instead of the whole cluster. This setting is used only if the list of namespaces is empty. Cluster level resources are ignored unless clusterResources is set.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cordoned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the cluster is cordoned, e.g. because it is being decommissioned. Applications deployed to a cordoned cluster are not synced anymore.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"server", "name", "config"},
 			},
@@ -2971,12 +3534,38 @@ func schema_pkg_apis_application_v1alpha1_ClusterConfig(ref common.ReferenceCall
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig"),
 						},
 					},
+					"disableCompression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableCompression bypasses automatic GZip compression requests to the server.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"proxyUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProxyURL is the URL to the proxy to be used for all requests send to the server",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gcpAuthConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCPAuthConfig contains GKE authentication configuration",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GCPAuthConfig"),
+						},
+					},
+					"azureAuthConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AzureAuthConfig contains AKS authentication configuration",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AzureAuthConfig"),
+						},
+					},
 				},
 				Required: []string{"tlsClientConfig"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AWSAuthConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AWSAuthConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.AzureAuthConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ExecProviderConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GCPAuthConfig", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.TLSClientConfig"},
 	}
 }
 
@@ -3167,6 +3756,61 @@ func schema_pkg_apis_application_v1alpha1_Command(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_CommitMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CommitMetadata contains metadata about a commit that is related in some way to another commit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Author is the author of the commit, i.e. `git show -s --format=%an <%ae>`. Must be formatted according to RFC 5322 (mail.Address.String()). Comes from the Argocd-reference-commit-author trailer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"date": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Date is the date of the commit, formatted as by `git show -s --format=%aI` (RFC 3339). It can also be an empty string if the date is unknown. Comes from the Argocd-reference-commit-date trailer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject is the commit message subject line, i.e. `git show -s --format=%s`. Comes from the Argocd-reference-commit-subject trailer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the commit message body minus the subject line, i.e. `git show -s --format=%b`. Comes from the Argocd-reference-commit-body trailer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sha": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SHA is the commit hash. Comes from the Argocd-reference-commit-sha trailer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"repoUrl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL of the repository where the commit is located. Comes from the Argocd-reference-commit-repourl trailer. This value is not validated and should not be used to construct UI links unless it is properly validated and/or sanitized first.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ComparedTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3365,38 +4009,122 @@ func schema_pkg_apis_application_v1alpha1_ConnectionState(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_DrySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_DestinationMapping(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DrySource specifies a location for dry \"don't repeat yourself\" manifest source information.",
+				Description: "DestinationMapping rewrites the destination cluster of the applications of a project, identified either by server URL or by name, to another cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"repoURL": {
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RepoURL is the URL to the git repository that contains the application manifests",
-							Default:     "",
+							Description: "Server is the URL of the cluster to rewrite. Either Server or Name must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"targetRevision": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetRevision defines the revision of the source to hydrate",
-							Default:     "",
+							Description: "Name is the name of the cluster to rewrite. Either Server or Name must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"targetServer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is a directory path within the Git repository where the manifests are located",
-							Default:     "",
+							Description: "TargetServer is the URL of the cluster the destination is rewritten to. Either TargetServer or TargetName must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
+					"targetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetName is the name of the cluster the destination is rewritten to. Either TargetServer or TargetName must be set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"effectiveFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EffectiveFrom is the time from which the mapping is applied. The mapping is applied immediately when not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_DriftExclusion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DriftExclusion excludes the drift of the resources of a group kind for which a CEL expression evaluates to true",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a CEL expression over the live and desired objects of a resource, available as the live and desired variables, which evaluates to true when the differences between them must be ignored",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "expression"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_DrySource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DrySource specifies a location for dry \"don't repeat yourself\" manifest source information.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"repoURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RepoURL is the URL to the git repository that contains the application manifests",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetRevision defines the revision of the source to hydrate",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is a directory path within the Git repository where the manifests are located",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 				Required: []string{"repoURL", "targetRevision", "path"},
 			},
 		},
@@ -3458,6 +4186,22 @@ func schema_pkg_apis_application_v1alpha1_DuckTypeGenerator(ref common.Reference
 							},
 						},
 					},
+					"jsonPathParameters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPathParameters contains additional parameters passed to the template, by parameter name, which are extracted with a JSONPath expression (e.g. '{.hints.region}') from each item of the status list of the decision resource",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"configMapRef"},
 			},
@@ -3562,6 +4306,34 @@ func schema_pkg_apis_application_v1alpha1_ExecProviderConfig(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_GCPAuthConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPAuthConfig is a GKE authentication configuration. Access tokens are obtained from the application default credentials of the Argo CD components, e.g. GKE Workload Identity.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes contains optional OAuth scopes of the requested access tokens. Defaults to the cloud-platform and userinfo.email scopes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_GitDirectoryGeneratorItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3688,12 +4460,25 @@ func schema_pkg_apis_application_v1alpha1_GitGenerator(ref common.ReferenceCallb
 							},
 						},
 					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is a JSON Schema which the files of the files generator are validated against. Files which do not match the schema fail the generator, and are listed in the fileErrors of the status of the ApplicationSet.",
+							Ref:         ref("k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"),
+						},
+					},
+					"overrideFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OverrideFile is the name of a file which, when present in the directory of a generated application, is merged into the generated Application as a strategic merge patch, after the template patches. The directory of an application is the directory of the directories generator, or the directory of the file of the files generator. The project and the name of the Application cannot be overridden.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"repoURL", "revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSetTemplate", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitDirectoryGeneratorItem", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.GitFileGeneratorItem", "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1.JSON"},
 	}
 }
 
@@ -3789,6 +4574,49 @@ func schema_pkg_apis_application_v1alpha1_GnuPGPublicKeyList(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_HealthRollupRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HealthRollupRule controls how the health of the resources of a group kind contributes to the health of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the API group of the resources, supporting glob patterns. Empty for the core group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the resources, supporting glob patterns",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is either Ignore or Warn. If empty, the health of the resources contributes to the health of the application, bounded by MaxStatus.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxStatus is the worst health status the resources can contribute to the application, e.g. Progressing for a degraded resource to only mark the application as progressing",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_HealthStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4076,138 +4904,277 @@ func schema_pkg_apis_application_v1alpha1_HydrateTo(ref common.ReferenceCallback
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ImageUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ImageUpdate configures automated updates of a single container image",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"value": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Image is the name of the image without tag or digest, e.g. ghcr.io/example/app",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "value"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_InfoItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "InfoItem contains arbitrary, human readable information about an application",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"strategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is a human readable title for this piece of information.",
+							Description: "Strategy is the strategy used to select the version to update to: semver (default), digest or latest",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"value": {
+					"constraint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Value is human readable content.",
+							Description: "Constraint restricts the versions to update to. It is a semantic version constraint for the semver strategy (any version if empty), the tag to track for the digest strategy (latest if empty) and a regular expression matching the tags for the latest strategy (any tag if empty).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_JWTToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "JWTToken holds the issuedAt and expiresAt values of a token",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"iat": {
+					"helmImageParameter": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Description: "HelmImageParameter is the Helm parameter which is set to the image name of Helm sources. Defaults to image.repository.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"exp": {
+					"helmTagParameter": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "HelmTagParameter is the Helm parameter which is set to the image tag of Helm sources. Defaults to image.tag.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"id": {
+					"sourceName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SourceName is the name of the source the image is updated in. It is required for applications with more than one source which is not a reference to the files of other sources.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"iat"},
+				Required: []string{"image"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_JWTTokens(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ImageUpdatePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JWTTokens represents a list of JWT tokens",
+				Description: "ImageUpdatePolicy configures automated updates of the container images of an application",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"items": {
+					"images": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Images is the list of images to keep up to date",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdate"),
 									},
 								},
 							},
 						},
 					},
+					"writeBack": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBack specifies where image updates are persisted: spec (default) or git",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"writeBackBranch": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WriteBackBranch is the branch to which image updates are committed with the git write-back method. Defaults to the target revision of the source.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"images"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ImageUpdate"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_JsonnetVar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_ImageUpdateStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JsonnetVar represents a variable to be passed to jsonnet during manifest generation",
+				Description: "ImageUpdateStatus contains the image reference most recently applied for an image of the image update policy",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Image is the name of the image, as given in the image update policy",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"reference": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
+							Description: "Reference is the tag or digest of the image which was applied",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastUpdated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUpdated is the time at which the reference was applied",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"image", "reference", "lastUpdated"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_Info(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_InfoItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InfoItem contains arbitrary, human readable information about an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is a human readable title for this piece of information.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is human readable content.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_JWTToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JWTToken holds the issuedAt and expiresAt values of a token",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"iat": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"exp": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"iat"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_JWTTokens(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JWTTokens represents a list of JWT tokens",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.JWTToken"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_JsonnetVar(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JsonnetVar represents a variable to be passed to jsonnet during manifest generation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
@@ -4563,6 +5530,40 @@ func schema_pkg_apis_application_v1alpha1_ManagedNamespaceMetadata(ref common.Re
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ManifestGenerationLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManifestGenerationLimits defines the resources that each manifest generation command is allowed to use. Empty values mean no limit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cpuTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUTime is the CPU time after which the command is terminated, e.g. 30s",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the maximum memory that the command can allocate, e.g. 512Mi",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout is the wall time after which the command is terminated, e.g. 2m",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_MatrixGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4647,6 +5648,58 @@ func schema_pkg_apis_application_v1alpha1_MergeGenerator(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_NamespaceCreationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceCreationPolicy controls whether the applications of a project can create their destination namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is either allow, deny or allowWithRequiredLabels. Defaults to allow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are the labels required on the created namespaces, which are added to the managed namespace metadata of the applications. Only used by the allowWithRequiredLabels mode.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are the annotations required on the created namespaces, which are added to the managed namespace metadata of the applications. Only used by the allowWithRequiredLabels mode.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_NestedMatrixGenerator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4759,6 +5812,13 @@ func schema_pkg_apis_application_v1alpha1_Operation(ref common.ReferenceCallback
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy"),
 						},
 					},
+					"correlationID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CorrelationID is the correlation ID of the request which initiated the operation, logged by the controller while processing the operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4848,53 +5908,119 @@ func schema_pkg_apis_application_v1alpha1_OperationState(ref common.ReferenceCal
 							Format:      "int64",
 						},
 					},
-				},
-				Required: []string{"operation", "phase", "startedAt"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_OptionalArray(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"array": {
+					"stuckResources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Array is the value of an array type parameter.",
+							Description: "StuckResources lists the resources which did not complete within the resource timeout of the sync operation",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.StuckResource"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"operation", "phase", "startedAt"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Operation", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.StuckResource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_OptionalMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_OperationWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "OperationWebhook is an HTTP endpoint which is notified about the phase transitions of application operations",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"map": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Map is the value of a map type parameter.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
+							Description: "Name is the unique name of the webhook",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address the events are posted to",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret references a key of the argocd-secret, in the form of $<key>, whose value is used to sign the events with HMAC-SHA256",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phases are the operation phases the webhook is called for. Defaults to the completed phases Succeeded, Failed and Error.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "url"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OptionalArray(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"array": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Array is the value of an array type parameter.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_OptionalMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"map": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Map is the value of a map type parameter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -5144,6 +6270,66 @@ func schema_pkg_apis_application_v1alpha1_PluginInput(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ProjectAPIRateLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectAPIRateLimits limits the rate of the API server requests on the applications of a project. The limits are shared by all users of the project and enforced by each API server replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sync limits the rate of the sync requests",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.APIRateLimit"),
+						},
+					},
+					"refresh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Refresh limits the rate of the refresh requests",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.APIRateLimit"),
+						},
+					},
+					"get": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Get limits the rate of the get requests, not including the refresh requests",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.APIRateLimit"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.APIRateLimit"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ProjectExecConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectExecConfig configures the web-based terminal of the applications of a project",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled enables or disables the web-based terminal for the applications of the project. The terminal can only be enabled if the exec.enabled setting of argocd-cm enables it globally.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"recordSessions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecordSessions requires the terminal sessions of the applications of the project to be recorded. Sessions are refused when no recording path is configured in argocd-cm.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ProjectRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5210,6 +6396,28 @@ func schema_pkg_apis_application_v1alpha1_ProjectRole(ref common.ReferenceCallba
 							},
 						},
 					},
+					"allowedCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedCIDRs restricts the client addresses from which the JWT tokens of this role are accepted. Tokens are accepted from any address when the list is empty",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"maxTokenUses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTokenUses limits the number of requests each JWT token of this role may authenticate. Zero means unlimited",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
@@ -5694,6 +6902,73 @@ func schema_pkg_apis_application_v1alpha1_PullRequestGeneratorGithub(ref common.
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ReconcileRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconcileRateLimit is a token bucket rate limit shared by the refreshes of all applications of a project",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"perMinute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PerMinute is the number of refreshes per minute",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of refreshes which can exceed the rate. Defaults to PerMinute.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"perMinute"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_ReconciliationSample(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReconciliationSample is a measurement of a single reconciliation of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time at which the reconciliation completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"durationMs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DurationMs is the duration of the reconciliation in milliseconds",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"outOfSyncResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OutOfSyncResources is the number of managed resources that were out of sync after the reconciliation",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"time", "durationMs", "outOfSyncResources"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_RefTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6060,82 +7335,186 @@ func schema_pkg_apis_application_v1alpha1_Repository(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"repo"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConnectionState"},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "A RepositoryCertificate is either SSH known hosts entry or TLS certificate",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"serverName": {
+					"useAzureWorkloadIdentity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerName specifies the DNS name of the server this certificate is intended for",
-							Default:     "",
+							Description: "UseAzureWorkloadIdentity specifies whether to use Azure Workload Identity for authentication",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"bearerToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BearerToken contains the bearer token used for Git BitBucket Data Center auth at the repo server",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certType": {
+					"insecureOCIForceHttp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertType specifies the type of the certificate - currently one of \"https\" or \"ssh\"",
-							Default:     "",
+							Description: "InsecureOCIForceHttp specifies whether the connection to the repository uses TLS at _all_. If true, no TLS. This flag is applicable for OCI repos only.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"depth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"webhookManifestCacheWarmDisabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookManifestCacheWarmDisabled disables manifest cache warming during webhook processing for this repository. When set, webhook handlers will only trigger reconciliation for affected applications and skip Redis cache operations for unaffected ones. Recommended for large monorepos with plain YAML manifests.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"azureServicePrincipalClientId": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AzureServicePrincipalClientId specifies the client ID of the Azure Service Principal used to access the repo",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certSubType": {
+					"azureServicePrincipalClientSecret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertSubType specifies the sub type of the cert, i.e. \"ssh-rsa\"",
-							Default:     "",
+							Description: "AzureServicePrincipalClientSecret specifies the client secret of the Azure Service Principal used to access the repo",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certData": {
+					"azureServicePrincipalTenantId": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertData contains the actual certificate data, dependent on the certificate type",
+							Description: "AzureServicePrincipalTenantId specifies the tenant ID of the Azure Service Principal used to access the repo",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
 						},
 					},
-					"certInfo": {
+					"azureActiveDirectoryEndpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertInfo will hold additional certificate info, depdendent on the certificate type (e.g. SSH fingerprint, X509 CommonName)",
-							Default:     "",
+							Description: "AzureActiveDirectoryEndpoint specifies the Azure Active Directory endpoint used for Service Principal authentication. If empty will default to https://login.microsoftonline.com",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"serverName", "certType", "certSubType", "certData", "certInfo"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_application_v1alpha1_RepositoryCertificateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RepositoryCertificateList is a collection of RepositoryCertificates",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"metadata": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]any{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Status contains the result of the latest background health check of the repository",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryStatus"),
 						},
 					},
-					"items": {
+					"sparseCheckout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SparseCheckout specifies whether the repository is fetched as a partial clone without file contents, and only the path and the value files of the application are checked out when generating its manifests. Recommended for large monorepos whose applications do not reference files outside of their path.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"manifestGenerationLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestGenerationLimits defines the resources that the tools and the config management plugins generating the manifests of the applications of the repository are allowed to use",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManifestGenerationLimits"),
+						},
+					},
+					"registryMirrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryMirrors maps OCI registries, optionally followed by a path prefix, to the mirrors from which the Helm charts of the repository and their OCI dependencies are pulled instead, e.g. `docker.io: registry.example.com/docker-hub`. Overrides the registry mirrors configured for the repo server.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"submodulePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubmodulePolicy defines how the submodules of the repository are checked out when generating manifests",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SubmodulePolicy"),
+						},
+					},
+				},
+				Required: []string{"repo"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ConnectionState", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManifestGenerationLimits", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RepositoryStatus", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SubmodulePolicy"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RepositoryCertificate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "A RepositoryCertificate is either SSH known hosts entry or TLS certificate",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerName specifies the DNS name of the server this certificate is intended for",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertType specifies the type of the certificate - currently one of \"https\" or \"ssh\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certSubType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertSubType specifies the sub type of the cert, i.e. \"ssh-rsa\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertData contains the actual certificate data, dependent on the certificate type",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"certInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertInfo will hold additional certificate info, depdendent on the certificate type (e.g. SSH fingerprint, X509 CommonName)",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"serverName", "certType", "certSubType", "certData", "certInfo"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RepositoryCertificateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RepositoryCertificateList is a collection of RepositoryCertificates",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]any{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Description: "List of certificates to be processed",
 							Type:        []string{"array"},
@@ -6192,6 +7571,63 @@ func schema_pkg_apis_application_v1alpha1_RepositoryList(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_RepositoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RepositoryStatus contains the result of the latest background health check of a repository",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is either Successful or Failed",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastCheckedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCheckedAt is the time of the latest health check",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSucceededAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSucceededAt is the time of the latest successful health check",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the error of the latest health check, if it failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedCheck is the check that failed: connection, tls, auth or lfs",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsURL is the URL of the repository credential template the credentials of the repository are inherited from",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ResourceAction(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6324,6 +7760,20 @@ func schema_pkg_apis_application_v1alpha1_ResourceActionParam(ref common.Referen
 							Format: "",
 						},
 					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -6363,6 +7813,41 @@ func schema_pkg_apis_application_v1alpha1_ResourceActions(ref common.ReferenceCa
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_ResourceBudget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceBudget limits the total CPU and memory requests declared by the workloads of the applications of a project which are deployed to the matching destinations",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination selects the applications the budget applies to. The server, name and namespace are glob patterns, an empty pattern matching any value.",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the maximum sum of the CPU requests of the applications",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the maximum sum of the memory requests of the applications",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"destination"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_ResourceDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6926,10 +8411,46 @@ func schema_pkg_apis_application_v1alpha1_ResourceResult(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"serverSideApplyManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerSideApplyManager is the field manager used to apply the resource with server-side apply. Empty if the resource was not applied server-side",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forceConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceConflicts indicates if the ownership of the fields managed by other field managers was forced when applying the resource with server-side apply",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"syncWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWave is the sync wave in which the resource was synced",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"runningSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunningSince is the time at which the sync started waiting for the resource to become healthy or for the hook to complete",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"stuck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stuck indicates the resource did not complete within the resource timeout of the sync operation",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"group", "version", "kind", "namespace", "name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7042,64 +8563,51 @@ func schema_pkg_apis_application_v1alpha1_RetryStrategy(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_application_v1alpha1_RevisionComparison(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RevisionHistory contains history information about a previous sync",
+				Description: "RevisionComparison contains the commits and the changed files between two revisions of a Git repository",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"revision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Revision holds the revision the sync was performed against",
+							Description: "Revision is the commit SHA the comparison starts from, typically the currently synced revision",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"deployedAt": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DeployedAt holds the time the sync operation completed",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ID is an auto incrementing identifier of the RevisionHistory",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"source": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Source is a reference to the application source used for the sync operation",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
-						},
-					},
-					"deployStartedAt": {
+					"targetRevision": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeployStartedAt holds the time the sync operation started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "TargetRevision is the commit SHA the comparison ends at, typically the revision which will be synced",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sources": {
+					"commits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sources is a reference to the application sources used for the sync operation",
+							Description: "Commits contains the commits reachable from the target revision but not from the revision, newest first",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]any{},
-										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CommitMetadata"),
 									},
 								},
 							},
 						},
 					},
-					"revisions": {
+					"commitsTruncated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Revisions holds the revision of each source in sources field the sync was performed against",
+							Description: "CommitsTruncated is true if there are more commits between the revisions than the ones returned",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"changedFiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChangedFiles contains the paths, relative to the root of the repository, of the files changed between the revisions",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -7112,12 +8620,90 @@ func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCa
 							},
 						},
 					},
-					"initiatedBy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "InitiatedBy contains information about who initiated the operations",
-							Default:     map[string]any{},
-							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator"),
-						},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.CommitMetadata"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_RevisionHistory(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RevisionHistory contains history information about a previous sync",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"revision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revision holds the revision the sync was performed against",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deployedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployedAt holds the time the sync operation completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is an auto incrementing identifier of the RevisionHistory",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is a reference to the application source used for the sync operation",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+						},
+					},
+					"deployStartedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployStartedAt holds the time the sync operation started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sources is a reference to the application sources used for the sync operation",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource"),
+									},
+								},
+							},
+						},
+					},
+					"revisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Revisions holds the revision of each source in sources field the sync was performed against",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"initiatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitiatedBy contains information about who initiated the operations",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.OperationInitiator"),
+						},
 					},
 				},
 				Required: []string{"deployedAt", "id"},
@@ -7770,6 +9356,82 @@ func schema_pkg_apis_application_v1alpha1_SecretRef(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SelfHealBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfHealBackoff is the backoff strategy between the consecutive self-heal attempts of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the amount to back off after the first self-heal attempt. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor is a factor to multiply the base duration after each self-heal attempt",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDuration is the maximum amount of time to back off between two self-heal attempts",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cooldown is the amount of time the application needs to stay synced after the last self-heal attempt before the attempts are reset",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the maximum number of consecutive self-heal attempts, after which self-heal stops until the attempts are reset (default: 0, no limit)",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SelfHealStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SelfHealStatus contains the consecutive self-heal attempts of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of consecutive self-heal attempts",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastAttemptedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastAttemptedAt is the time of the last self-heal attempt",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SignatureKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7855,6 +9517,146 @@ func schema_pkg_apis_application_v1alpha1_SourceHydratorStatus(ref common.Refere
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_StuckResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StuckResource describes a resource which did not complete within the resource timeout of a sync operation, i.e. a resource which could not be applied, did not become healthy or a hook which did not complete",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group specifies the API group of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies the API kind of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the target namespace of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name specifies the name of the resource",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncPhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncPhase indicates the particular phase of the sync in which the resource is stuck",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"syncWave": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncWave is the sync wave in which the resource is stuck",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes what the sync was waiting on when the resource was found stuck",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"since": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Since is the time since which the sync was waiting on the resource",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"skipped": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Skipped indicates the resource was skipped and the sync continued with the next resources",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"group", "kind", "namespace", "name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SubmodulePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubmodulePolicy defines how the submodules of a Git repository are checked out",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled skips the checkout of the submodules of the repository, even if submodules are enabled on the repo server",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"recursionDepth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RecursionDepth is the maximum nesting level of the submodules checked out, 1 checking out only the submodules of the repository itself. Zero means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"credentialMappings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialMappings maps the URLs of submodules to the URLs of the repositories or credential templates whose credentials are used to fetch them. The other submodules are fetched with the credentials of the repository.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials are the credentials of the submodules resolved from the credential mappings, by submodule URL. They are resolved when retrieving the repository and never persisted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Repository"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.Repository"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SuccessfulHydrateOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8012,6 +9814,58 @@ func schema_pkg_apis_application_v1alpha1_SyncOperation(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncOperationDestinationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncOperationDestinationResult holds the sync result of one of the destinations of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"destination": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destination is the destination which was synced",
+							Default:     map[string]any{},
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination"),
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase the sync of the destination completed with",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains the message the sync of the destination completed with",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the sync results of the resources of the destination",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"destination", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationDestination", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_SyncOperationResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8122,12 +9976,26 @@ func schema_pkg_apis_application_v1alpha1_SyncOperationResult(ref common.Referen
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"destinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Destinations contains the results of the destinations already synced by the operation, in sync order, for an application with multiple destinations. Resources only holds the results of the destination being synced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationDestinationResult"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"revision"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ApplicationSource", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ResourceResult", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncOperationDestinationResult"},
 	}
 }
 
@@ -8171,11 +10039,37 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata"),
 						},
 					},
+					"scheduled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scheduled restricts the automated syncs to the times of a schedule instead of syncing as soon as a drift is detected",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyScheduled"),
+						},
+					},
+					"preconditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Preconditions need to be met before the application is automatically synced",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]any{},
+										Ref:     ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPrecondition"),
+									},
+								},
+							},
+						},
+					},
+					"refreshInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RefreshInterval overrides the reconciliation timeout of the application controller for the application, bounded by the maximum refresh interval of its project",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated"},
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.ManagedNamespaceMetadata", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.RetryStrategy", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyAutomated", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPolicyScheduled", "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SyncPrecondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -8207,9 +10101,113 @@ func schema_pkg_apis_application_v1alpha1_SyncPolicyAutomated(ref common.Referen
 							Format:      "",
 						},
 					},
+					"selfHealBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SelfHealBackoff overrides the controller-wide backoff between the self-heal attempts of the application",
+							Ref:         ref("github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealBackoff"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1.SelfHealBackoff"},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPolicyScheduled(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPolicyScheduled controls the times at which an application is automatically synced",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the cron schedule of the automated syncs, e.g. `0 2 * * *` to sync nightly",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone of the schedule, e.g. `Europe/Paris` (default: UTC)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedule"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncPrecondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncPrecondition is a condition which needs to be met before an application is automatically synced",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a boolean CEL expression evaluated against the `app`, `project` and `cluster` objects, e.g. `cluster.labels.?maintenance.orValue(\"\") != \"true\"`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is reported in the condition of the application when the expression is not met",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"expression"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_application_v1alpha1_SyncSample(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncSample is a measurement of a single completed sync operation of an application",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time at which the sync operation finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"durationMs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DurationMs is the duration of the sync operation in milliseconds",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase the sync operation completed with",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"time", "durationMs", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8287,6 +10285,13 @@ func schema_pkg_apis_application_v1alpha1_SyncStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"pruneOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PruneOnly indicates the application is OutOfSync only because of resources which require pruning, and that the content of all the other resources matches the desired state",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"status"},
 			},
@@ -8464,12 +10469,63 @@ func schema_pkg_apis_application_v1alpha1_SyncWindow(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
+					"requireApproval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequireApproval blocks the syncs while the window is active until the window is unlocked. Only supported by deny windows.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
+func schema_pkg_apis_application_v1alpha1_SyncWindowUnlock(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SyncWindowUnlock is the approval of the syncs during a sync window which requires an approval",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"windowHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowHash is the hash identity of the unlocked sync window",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpiresAt is the time the unlock expires at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"unlockedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnlockedBy is the user who unlocked the sync window",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason of the unlock, e.g. the reference of the approved change request",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"windowHash", "expiresAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_application_v1alpha1_TLSClientConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9,6 +9,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/cert"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
@@ -65,6 +66,9 @@ type RepoCreds struct {
 	AzureServicePrincipalTenantId string `json:"azureServicePrincipalTenantId,omitempty" protobuf:"bytes,31,opt,name=azureServicePrincipalTenantId"`
 	// AzureActiveDirectoryEndpoint specifies the Azure Active Directory endpoint used for Service Principal authentication. If empty will default to https://login.microsoftonline.com
 	AzureActiveDirectoryEndpoint string `json:"azureActiveDirectoryEndpoint,omitempty" protobuf:"bytes,32,opt,name=azureActiveDirectoryEndpoint"`
+	// Project is the project the credentials are scoped to. Project scoped credentials are only inherited by the
+	// repositories and applications of the project.
+	Project string `json:"project,omitempty" protobuf:"bytes,33,opt,name=project"`
 }
 
 // Repository is a repository holding application configurations
@@ -136,6 +140,100 @@ type Repository struct {
 	AzureServicePrincipalTenantId string `json:"azureServicePrincipalTenantId,omitempty" protobuf:"bytes,31,opt,name=azureServicePrincipalTenantId"`
 	// AzureActiveDirectoryEndpoint specifies the Azure Active Directory endpoint used for Service Principal authentication. If empty will default to https://login.microsoftonline.com
 	AzureActiveDirectoryEndpoint string `json:"azureActiveDirectoryEndpoint,omitempty" protobuf:"bytes,32,opt,name=azureActiveDirectoryEndpoint"`
+	// Status contains the result of the latest background health check of the repository
+	Status *RepositoryStatus `json:"status,omitempty" protobuf:"bytes,33,opt,name=status"`
+	// SparseCheckout specifies whether the repository is fetched as a partial clone without file contents, and only the
+	// path and the value files of the application are checked out when generating its manifests. Recommended for large
+	// monorepos whose applications do not reference files outside of their path.
+	SparseCheckout bool `json:"sparseCheckout,omitempty" protobuf:"varint,34,opt,name=sparseCheckout"`
+	// ManifestGenerationLimits defines the resources that the tools and the config management plugins generating the
+	// manifests of the applications of the repository are allowed to use
+	ManifestGenerationLimits *ManifestGenerationLimits `json:"manifestGenerationLimits,omitempty" protobuf:"bytes,35,opt,name=manifestGenerationLimits"`
+	// RegistryMirrors maps OCI registries, optionally followed by a path prefix, to the mirrors from which the Helm
+	// charts of the repository and their OCI dependencies are pulled instead, e.g. `docker.io: registry.example.com/docker-hub`.
+	// Overrides the registry mirrors configured for the repo server.
+	RegistryMirrors map[string]string `json:"registryMirrors,omitempty" protobuf:"bytes,36,rep,name=registryMirrors"`
+	// SubmodulePolicy defines how the submodules of the repository are checked out when generating manifests
+	SubmodulePolicy *SubmodulePolicy `json:"submodulePolicy,omitempty" protobuf:"bytes,37,opt,name=submodulePolicy"`
+}
+
+// SubmodulePolicy defines how the submodules of a Git repository are checked out
+type SubmodulePolicy struct {
+	// Disabled skips the checkout of the submodules of the repository, even if submodules are enabled on the repo server
+	Disabled bool `json:"disabled,omitempty" protobuf:"varint,1,opt,name=disabled"`
+	// RecursionDepth is the maximum nesting level of the submodules checked out, 1 checking out only the submodules of
+	// the repository itself. Zero means no limit.
+	RecursionDepth int64 `json:"recursionDepth,omitempty" protobuf:"varint,2,opt,name=recursionDepth"`
+	// CredentialMappings maps the URLs of submodules to the URLs of the repositories or credential templates whose
+	// credentials are used to fetch them. The other submodules are fetched with the credentials of the repository.
+	CredentialMappings map[string]string `json:"credentialMappings,omitempty" protobuf:"bytes,3,rep,name=credentialMappings"`
+	// Credentials are the credentials of the submodules resolved from the credential mappings, by submodule URL. They
+	// are resolved when retrieving the repository and never persisted.
+	Credentials []*Repository `json:"credentials,omitempty" protobuf:"bytes,4,rep,name=credentials"`
+}
+
+// IsDisabled returns whether the checkout of the submodules is disabled
+func (p *SubmodulePolicy) IsDisabled() bool {
+	return p != nil && p.Disabled
+}
+
+// Sanitized returns a copy of the policy without the resolved credentials
+func (p *SubmodulePolicy) Sanitized() *SubmodulePolicy {
+	if p == nil {
+		return nil
+	}
+	return &SubmodulePolicy{Disabled: p.Disabled, RecursionDepth: p.RecursionDepth, CredentialMappings: p.CredentialMappings}
+}
+
+// ManifestGenerationLimits defines the resources that each manifest generation command is allowed to use. Empty values
+// mean no limit.
+type ManifestGenerationLimits struct {
+	// CPUTime is the CPU time after which the command is terminated, e.g. 30s
+	CPUTime string `json:"cpuTime,omitempty" protobuf:"bytes,1,opt,name=cpuTime"`
+	// Memory is the maximum memory that the command can allocate, e.g. 512Mi
+	Memory string `json:"memory,omitempty" protobuf:"bytes,2,opt,name=memory"`
+	// Timeout is the wall time after which the command is terminated, e.g. 2m
+	Timeout string `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+}
+
+// ResourceLimits parses the limits of manifest generation
+func (l *ManifestGenerationLimits) ResourceLimits() (executil.ResourceLimits, error) {
+	if l == nil {
+		return executil.ResourceLimits{}, nil
+	}
+	return executil.ParseResourceLimits(l.CPUTime, l.Memory, l.Timeout)
+}
+
+const (
+	// RepositoryCheckConnection is the check that the repository server can be reached
+	RepositoryCheckConnection = "connection"
+	// RepositoryCheckTLS is the check that the TLS certificate or SSH host key of the repository server is trusted
+	RepositoryCheckTLS = "tls"
+	// RepositoryCheckAuth is the check that the credentials of the repository are accepted
+	RepositoryCheckAuth = "auth"
+	// RepositoryCheckLFS is the check that the Git LFS server of the repository is available
+	RepositoryCheckLFS = "lfs"
+)
+
+// RepositoryStatus contains the result of the latest background health check of a repository
+type RepositoryStatus struct {
+	// Status is either Successful or Failed
+	Status ConnectionStatus `json:"status" protobuf:"bytes,1,opt,name=status"`
+	// LastCheckedAt is the time of the latest health check
+	LastCheckedAt *metav1.Time `json:"lastCheckedAt,omitempty" protobuf:"bytes,2,opt,name=lastCheckedAt"`
+	// LastSucceededAt is the time of the latest successful health check
+	LastSucceededAt *metav1.Time `json:"lastSucceededAt,omitempty" protobuf:"bytes,3,opt,name=lastSucceededAt"`
+	// LastError is the error of the latest health check, if it failed
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,4,opt,name=lastError"`
+	// FailedCheck is the check that failed: connection, tls, auth or lfs
+	FailedCheck string `json:"failedCheck,omitempty" protobuf:"bytes,5,opt,name=failedCheck"`
+	// CredentialsURL is the URL of the repository credential template the credentials of the repository are inherited from
+	CredentialsURL string `json:"credentialsURL,omitempty" protobuf:"bytes,6,opt,name=credentialsURL"`
+}
+
+// IsHealthy returns whether the latest health check of the repository did not fail
+func (s *RepositoryStatus) IsHealthy() bool {
+	return s == nil || s.Status != ConnectionStatusFailed
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -303,6 +401,23 @@ func (repo *Repository) GetGitCreds(store git.CredsStore) git.Creds {
 	return git.NopCreds{}
 }
 
+// GetGitSubmoduleOptions returns the options of the checkout of the submodules of a Git repository, including the
+// credentials of the submodules resolved from the credential mappings of its submodule policy
+func (repo *Repository) GetGitSubmoduleOptions(store git.CredsStore) git.SubmoduleOptions {
+	policy := repo.SubmodulePolicy
+	if policy == nil {
+		return git.SubmoduleOptions{}
+	}
+	opts := git.SubmoduleOptions{Disabled: policy.Disabled, Depth: policy.RecursionDepth}
+	for _, creds := range policy.Credentials {
+		if opts.Creds == nil {
+			opts.Creds = map[string]git.Creds{}
+		}
+		opts.Creds[git.NormalizeGitURL(creds.Repo)] = creds.GetGitCreds(store)
+	}
+	return opts
+}
+
 // GetHelmCreds returns the credentials from a repository configuration used to authenticate a Helm repository
 func (repo *Repository) GetHelmCreds() helm.Creds {
 	if repo.UseAzureWorkloadIdentity {
@@ -385,6 +500,10 @@ func (repo *Repository) CopySettingsFrom(source *Repository) {
 		repo.Insecure = source.Insecure
 		repo.InheritedCreds = source.InheritedCreds
 		repo.Depth = source.Depth
+		repo.SparseCheckout = source.SparseCheckout
+		repo.ManifestGenerationLimits = source.ManifestGenerationLimits
+		repo.RegistryMirrors = source.RegistryMirrors
+		repo.SubmodulePolicy = source.SubmodulePolicy.Sanitized()
 	}
 }
 
@@ -419,6 +538,10 @@ func (repo *Repository) Sanitized() *Repository {
 		AzureServicePrincipalClientId: repo.AzureServicePrincipalClientId,
 		AzureServicePrincipalTenantId: repo.AzureServicePrincipalTenantId,
 		Depth:                         repo.Depth,
+		SparseCheckout:                repo.SparseCheckout,
+		ManifestGenerationLimits:      repo.ManifestGenerationLimits,
+		RegistryMirrors:               repo.RegistryMirrors,
+		SubmodulePolicy:               repo.SubmodulePolicy.Sanitized(),
 	}
 }
 
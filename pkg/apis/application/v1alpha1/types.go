@@ -22,6 +22,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/Masterminds/semver/v3"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
@@ -31,6 +32,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -100,6 +102,18 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// Destinations is a list of target Kubernetes servers and namespaces to which the application's manifests are all
+	// deployed. If set, the first destination is the primary destination of the application and Destination is set to it.
+	Destinations []ApplicationDestination `json:"destinations,omitempty" protobuf:"bytes,10,rep,name=destinations"`
+
+	// ImageUpdatePolicy configures the controller to update the container images of the application to the newest
+	// versions available in their registries.
+	ImageUpdatePolicy *ImageUpdatePolicy `json:"imageUpdatePolicy,omitempty" protobuf:"bytes,11,opt,name=imageUpdatePolicy"`
+
+	// HealthRollupRules control how the health of the resources of given group kinds contributes to the health of the
+	// application. They take precedence over the health roll-up rules of the project.
+	HealthRollupRules []HealthRollupRule `json:"healthRollupRules,omitempty" protobuf:"bytes,12,rep,name=healthRollupRules"`
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -133,6 +147,15 @@ type ResourceIgnoreDifferences struct {
 	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty" protobuf:"bytes,7,opt,name=managedFieldsManagers"`
 }
 
+// DriftExclusion excludes the drift of the resources of a group kind for which a CEL expression evaluates to true
+type DriftExclusion struct {
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	Kind  string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Expression is a CEL expression over the live and desired objects of a resource, available as the live and desired
+	// variables, which evaluates to true when the differences between them must be ignored
+	Expression string `json:"expression" protobuf:"bytes,3,opt,name=expression"`
+}
+
 // EnvEntry represents an entry in the application's environment
 type EnvEntry struct {
 	// Name is the name of the variable, usually expressed in uppercase
@@ -291,6 +314,19 @@ func (spec *ApplicationSpec) GetSources() ApplicationSources {
 	return ApplicationSources{}
 }
 
+// HasMultipleDestinations returns true if the application's manifests are deployed to the list of destinations
+func (spec *ApplicationSpec) HasMultipleDestinations() bool {
+	return len(spec.Destinations) > 0
+}
+
+// GetDestinations returns the destinations of the application, the primary destination first
+func (spec *ApplicationSpec) GetDestinations() []ApplicationDestination {
+	if spec.HasMultipleDestinations() {
+		return spec.Destinations
+	}
+	return []ApplicationDestination{spec.Destination}
+}
+
 func (spec *ApplicationSpec) HasMultipleSources() bool {
 	return spec.SourceHydrator == nil && len(spec.Sources) > 0
 }
@@ -423,6 +459,116 @@ const (
 	ApplicationSourceTypePlugin    ApplicationSourceType = "Plugin"
 )
 
+// ImageUpdateStrategy is the strategy used to select the image version to update to
+// +kubebuilder:validation:Enum=semver;digest;latest
+type ImageUpdateStrategy string
+
+const (
+	// ImageUpdateStrategySemver updates to the highest semantic version tag matching the constraint
+	ImageUpdateStrategySemver ImageUpdateStrategy = "semver"
+	// ImageUpdateStrategyDigest updates to the current digest of the tag given as constraint
+	ImageUpdateStrategyDigest ImageUpdateStrategy = "digest"
+	// ImageUpdateStrategyLatest updates to the lexically greatest tag matching the regular expression given as constraint
+	ImageUpdateStrategyLatest ImageUpdateStrategy = "latest"
+)
+
+// ImageUpdateWriteBackMethod specifies where image updates are persisted
+// +kubebuilder:validation:Enum=spec;git
+type ImageUpdateWriteBackMethod string
+
+const (
+	// ImageUpdateWriteBackMethodSpec persists image updates in the source parameters of the Application spec
+	ImageUpdateWriteBackMethodSpec ImageUpdateWriteBackMethod = "spec"
+	// ImageUpdateWriteBackMethodGit commits image updates to the parameter override file of the application in Git
+	ImageUpdateWriteBackMethodGit ImageUpdateWriteBackMethod = "git"
+)
+
+// ImageUpdatePolicy configures automated updates of the container images of an application
+type ImageUpdatePolicy struct {
+	// Images is the list of images to keep up to date
+	Images []ImageUpdate `json:"images" protobuf:"bytes,1,rep,name=images"`
+	// WriteBack specifies where image updates are persisted: spec (default) or git
+	WriteBack ImageUpdateWriteBackMethod `json:"writeBack,omitempty" protobuf:"bytes,2,opt,name=writeBack,casttype=ImageUpdateWriteBackMethod"`
+	// WriteBackBranch is the branch to which image updates are committed with the git write-back method. Defaults to
+	// the target revision of the source.
+	WriteBackBranch string `json:"writeBackBranch,omitempty" protobuf:"bytes,3,opt,name=writeBackBranch"`
+}
+
+// GetWriteBack returns the write-back method of the policy, defaulting to spec.
+func (p *ImageUpdatePolicy) GetWriteBack() ImageUpdateWriteBackMethod {
+	if p.WriteBack == "" {
+		return ImageUpdateWriteBackMethodSpec
+	}
+	return p.WriteBack
+}
+
+// ImageUpdate configures automated updates of a single container image
+type ImageUpdate struct {
+	// Image is the name of the image without tag or digest, e.g. ghcr.io/example/app
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// Strategy is the strategy used to select the version to update to: semver (default), digest or latest
+	Strategy ImageUpdateStrategy `json:"strategy,omitempty" protobuf:"bytes,2,opt,name=strategy,casttype=ImageUpdateStrategy"`
+	// Constraint restricts the versions to update to. It is a semantic version constraint for the semver strategy
+	// (any version if empty), the tag to track for the digest strategy (latest if empty) and a regular expression
+	// matching the tags for the latest strategy (any tag if empty).
+	Constraint string `json:"constraint,omitempty" protobuf:"bytes,3,opt,name=constraint"`
+	// HelmImageParameter is the Helm parameter which is set to the image name of Helm sources. Defaults to
+	// image.repository.
+	HelmImageParameter string `json:"helmImageParameter,omitempty" protobuf:"bytes,4,opt,name=helmImageParameter"`
+	// HelmTagParameter is the Helm parameter which is set to the image tag of Helm sources. Defaults to image.tag.
+	HelmTagParameter string `json:"helmTagParameter,omitempty" protobuf:"bytes,5,opt,name=helmTagParameter"`
+	// SourceName is the name of the source the image is updated in. It is required for applications with more than one
+	// source which is not a reference to the files of other sources.
+	SourceName string `json:"sourceName,omitempty" protobuf:"bytes,6,opt,name=sourceName"`
+}
+
+// Validate checks that the images of the policy are unique and that their strategies and constraints are valid.
+func (p *ImageUpdatePolicy) Validate() error {
+	if len(p.Images) == 0 {
+		return errors.New("at least one image is required")
+	}
+	switch p.WriteBack {
+	case "", ImageUpdateWriteBackMethodSpec, ImageUpdateWriteBackMethodGit:
+	default:
+		return fmt.Errorf("unknown write-back method '%s'", p.WriteBack)
+	}
+	for i, image := range p.Images {
+		if image.Image == "" {
+			return errors.New("image name is required")
+		}
+		if strings.Contains(image.Image, "@") || strings.Contains(image.Image[strings.LastIndex(image.Image, "/")+1:], ":") {
+			return fmt.Errorf("image '%s' must not contain a tag or digest", image.Image)
+		}
+		if slices.ContainsFunc(p.Images[:i], func(other ImageUpdate) bool { return other.Image == image.Image }) {
+			return fmt.Errorf("image '%s' is listed more than once", image.Image)
+		}
+		switch image.GetStrategy() {
+		case ImageUpdateStrategySemver:
+			if image.Constraint != "" {
+				if _, err := semver.NewConstraint(image.Constraint); err != nil {
+					return fmt.Errorf("invalid semver constraint '%s' of image '%s': %w", image.Constraint, image.Image, err)
+				}
+			}
+		case ImageUpdateStrategyDigest:
+		case ImageUpdateStrategyLatest:
+			if _, err := regexp.Compile(image.Constraint); err != nil {
+				return fmt.Errorf("invalid tag pattern '%s' of image '%s': %w", image.Constraint, image.Image, err)
+			}
+		default:
+			return fmt.Errorf("unknown update strategy '%s' of image '%s'", image.Strategy, image.Image)
+		}
+	}
+	return nil
+}
+
+// GetStrategy returns the update strategy of the image, defaulting to semver.
+func (u *ImageUpdate) GetStrategy() ImageUpdateStrategy {
+	if u.Strategy == "" {
+		return ImageUpdateStrategySemver
+	}
+	return u.Strategy
+}
+
 // SourceHydrator specifies a dry "don't repeat yourself" source for manifests, a sync source from which to sync
 // hydrated manifests, and an optional hydrateTo location to act as a "staging" aread for hydrated manifests.
 type SourceHydrator struct {
@@ -545,6 +691,15 @@ const (
 	RefreshTypeHard   RefreshType = "hard"
 )
 
+// RefreshPriority specifies the priority of a refresh of a given application
+type RefreshPriority string
+
+const (
+	// RefreshPriorityInteractive is the priority of the refreshes requested by users, which are processed ahead of the
+	// periodic reconciliations
+	RefreshPriorityInteractive RefreshPriority = "interactive"
+)
+
 type HydrateType string
 
 const (
@@ -1200,6 +1355,9 @@ type ApplicationDestination struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// Name is an alternate way of specifying the target cluster by its symbolic name. This must be set if Server is not set.
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
+	// ServiceAccount overrides the service account impersonated during the sync operations of an application, in the format <service_account> or <namespace>:<service_account>.
+	// It must be permitted by the destination service account of the project which matches the destination. Only used by applications.
+	ServiceAccount string `json:"serviceAccount,omitempty" protobuf:"bytes,4,opt,name=serviceAccount"`
 }
 
 type ResourceHealthLocation string
@@ -1241,6 +1399,63 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// Destinations contains the sync and health status of each destination of an application with multiple destinations
+	Destinations []ApplicationDestinationStatus `json:"destinations,omitempty" protobuf:"bytes,15,rep,name=destinations"`
+	// NextScheduledSync is the next time at which the application is automatically synced, if the automated syncs are
+	// restricted to a schedule
+	NextScheduledSync *metav1.Time `json:"nextScheduledSync,omitempty" protobuf:"bytes,16,opt,name=nextScheduledSync"`
+	// SelfHeal contains the consecutive self-heal attempts of the application, if it has a self-heal backoff
+	SelfHeal *SelfHealStatus `json:"selfHeal,omitempty" protobuf:"bytes,17,opt,name=selfHeal"`
+	// ImageUpdates contains the image references most recently applied by the image update policy of the application
+	ImageUpdates []ImageUpdateStatus `json:"imageUpdates,omitempty" protobuf:"bytes,18,rep,name=imageUpdates"`
+}
+
+// ImageUpdateStatus contains the image reference most recently applied for an image of the image update policy
+type ImageUpdateStatus struct {
+	// Image is the name of the image, as given in the image update policy
+	Image string `json:"image" protobuf:"bytes,1,opt,name=image"`
+	// Reference is the tag or digest of the image which was applied
+	Reference string `json:"reference" protobuf:"bytes,2,opt,name=reference"`
+	// LastUpdated is the time at which the reference was applied
+	LastUpdated metav1.Time `json:"lastUpdated" protobuf:"bytes,3,opt,name=lastUpdated"`
+}
+
+// GetImageUpdate returns the status of the given image of the image update policy, or nil if it was never updated.
+func (status *ApplicationStatus) GetImageUpdate(image string) *ImageUpdateStatus {
+	for i := range status.ImageUpdates {
+		if status.ImageUpdates[i].Image == image {
+			return &status.ImageUpdates[i]
+		}
+	}
+	return nil
+}
+
+// SelfHealStatus contains the consecutive self-heal attempts of an application
+type SelfHealStatus struct {
+	// Attempts is the number of consecutive self-heal attempts
+	Attempts int64 `json:"attempts,omitempty" protobuf:"varint,1,opt,name=attempts"`
+	// LastAttemptedAt is the time of the last self-heal attempt
+	LastAttemptedAt *metav1.Time `json:"lastAttemptedAt,omitempty" protobuf:"bytes,2,opt,name=lastAttemptedAt"`
+}
+
+// GetAttempts returns the number of consecutive self-heal attempts, defaulting to 0 if nil.
+func (s *SelfHealStatus) GetAttempts() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.Attempts
+}
+
+// ApplicationDestinationStatus contains the sync and health status of one of the destinations of an application
+type ApplicationDestinationStatus struct {
+	// Destination is the destination the status applies to
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Sync is the sync status of the application's resources in the destination
+	Sync SyncStatusCode `json:"sync,omitempty" protobuf:"bytes,2,opt,name=sync,casttype=SyncStatusCode"`
+	// Health is the aggregated health status of the application's resources in the destination
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,3,opt,name=health"`
+	// Message contains the error which prevented the comparison of the destination, if any
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1354,6 +1569,9 @@ type Operation struct {
 	Info []*Info `json:"info,omitempty" protobuf:"bytes,3,name=info"`
 	// Retry controls the strategy to apply if a sync fails
 	Retry RetryStrategy `json:"retry,omitempty" protobuf:"bytes,4,opt,name=retry"`
+	// CorrelationID is the correlation ID of the request which initiated the operation, logged by the controller
+	// while processing the operation
+	CorrelationID string `json:"correlationID,omitempty" protobuf:"bytes,5,opt,name=correlationID"`
 }
 
 // DryRun returns true if an operation was requested to be performed in dry run mode
@@ -1463,6 +1681,31 @@ type OperationState struct {
 	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
 	// RetryCount contains time of operation retries
 	RetryCount int64 `json:"retryCount,omitempty" protobuf:"bytes,8,opt,name=retryCount"`
+	// StuckResources lists the resources which did not complete within the resource timeout of the sync operation
+	StuckResources []StuckResource `json:"stuckResources,omitempty" protobuf:"bytes,9,rep,name=stuckResources"`
+}
+
+// StuckResource describes a resource which did not complete within the resource timeout of a sync operation, i.e. a
+// resource which could not be applied, did not become healthy or a hook which did not complete
+type StuckResource struct {
+	// Group specifies the API group of the resource
+	Group string `json:"group" protobuf:"bytes,1,opt,name=group"`
+	// Kind specifies the API kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace specifies the target namespace of the resource
+	Namespace string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Name specifies the name of the resource
+	Name string `json:"name" protobuf:"bytes,4,opt,name=name"`
+	// SyncPhase indicates the particular phase of the sync in which the resource is stuck
+	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,5,opt,name=syncPhase"`
+	// SyncWave is the sync wave in which the resource is stuck
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"varint,6,opt,name=syncWave"`
+	// Message describes what the sync was waiting on when the resource was found stuck
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+	// Since is the time since which the sync was waiting on the resource
+	Since *metav1.Time `json:"since,omitempty" protobuf:"bytes,8,opt,name=since"`
+	// Skipped indicates the resource was skipped and the sync continued with the next resources
+	Skipped bool `json:"skipped,omitempty" protobuf:"varint,9,opt,name=skipped"`
 }
 
 type Info struct {
@@ -1509,6 +1752,15 @@ func (o SyncOptions) GetOptionValue(optionKey string) *string {
 	return nil
 }
 
+// GetServerSideApplyManager returns the field manager used by server-side apply, which can be customized with the
+// ServerSideApplyManager sync option
+func (o SyncOptions) GetServerSideApplyManager() string {
+	if manager := o.GetOptionValue(synccommon.SyncOptionServerSideApplyManager); manager != nil && *manager != "" {
+		return *manager
+	}
+	return common.ArgoCDSSAManager
+}
+
 type ManagedNamespaceMetadata struct {
 	Labels      map[string]string `json:"labels,omitempty" protobuf:"bytes,1,opt,name=labels"`
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,2,opt,name=annotations"`
@@ -1524,6 +1776,13 @@ type SyncPolicy struct {
 	Retry *RetryStrategy `json:"retry,omitempty" protobuf:"bytes,3,opt,name=retry"`
 	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,4,opt,name=managedNamespaceMetadata"`
+	// Scheduled restricts the automated syncs to the times of a schedule instead of syncing as soon as a drift is detected
+	Scheduled *SyncPolicyScheduled `json:"scheduled,omitempty" protobuf:"bytes,5,opt,name=scheduled"`
+	// Preconditions need to be met before the application is automatically synced
+	Preconditions []SyncPrecondition `json:"preconditions,omitempty" protobuf:"bytes,6,rep,name=preconditions"`
+	// RefreshInterval overrides the reconciliation timeout of the application controller for the application, bounded by the
+	// maximum refresh interval of its project
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty" protobuf:"bytes,7,opt,name=refreshInterval"`
 	// If you add a field here, be sure to update IsZero.
 }
 
@@ -1537,7 +1796,68 @@ func (p *SyncPolicy) IsAutomatedSyncEnabled() bool {
 
 // IsZero returns true if the sync policy is empty
 func (p *SyncPolicy) IsZero() bool {
-	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil)
+	return p == nil || (p.Automated == nil && len(p.SyncOptions) == 0 && p.Retry == nil && p.ManagedNamespaceMetadata == nil && p.Scheduled == nil && len(p.Preconditions) == 0 && p.RefreshInterval == nil)
+}
+
+// GetRefreshInterval returns the value of RefreshInterval, defaulting to 0 if nil.
+func (p *SyncPolicy) GetRefreshInterval() time.Duration {
+	if p == nil || p.RefreshInterval == nil || p.RefreshInterval.Duration < 0 {
+		return 0
+	}
+	return p.RefreshInterval.Duration
+}
+
+// SyncPrecondition is a condition which needs to be met before an application is automatically synced
+type SyncPrecondition struct {
+	// Expression is a boolean CEL expression evaluated against the `app`, `project` and `cluster` objects, e.g. `cluster.labels.?maintenance.orValue("") != "true"`
+	Expression string `json:"expression" protobuf:"bytes,1,opt,name=expression"`
+	// Message is reported in the condition of the application when the expression is not met
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+}
+
+// SyncPolicyScheduled controls the times at which an application is automatically synced
+type SyncPolicyScheduled struct {
+	// Schedule is the cron schedule of the automated syncs, e.g. `0 2 * * *` to sync nightly
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// TimeZone of the schedule, e.g. `Europe/Paris` (default: UTC)
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+}
+
+// Validate checks that the schedule and the time zone can be parsed
+func (s *SyncPolicyScheduled) Validate() error {
+	_, err := s.parse()
+	return err
+}
+
+// Next returns the first time of the schedule after the given time
+func (s *SyncPolicyScheduled) Next(t time.Time) (time.Time, error) {
+	schedule, err := s.parse()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return schedule.Next(t), nil
+}
+
+// IsScheduledAt returns true if the given time is one of the times of the schedule
+func (s *SyncPolicyScheduled) IsScheduledAt(t time.Time) bool {
+	next, err := s.Next(t.Add(-time.Second))
+	return err == nil && next.Equal(t)
+}
+
+func (s *SyncPolicyScheduled) parse() (cron.Schedule, error) {
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, err := specParser.Parse(s.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse schedule '%s': %w", s.Schedule, err)
+	}
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load time zone '%s': %w", s.TimeZone, err)
+	}
+	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = loc
+	}
+	return schedule, nil
 }
 
 // RetryStrategy contains information about the strategy to apply when a sync failed
@@ -1614,6 +1934,63 @@ type SyncPolicyAutomated struct {
 	AllowEmpty *bool `json:"allowEmpty,omitempty" protobuf:"bytes,3,opt,name=allowEmpty"`
 	// Enable allows apps to explicitly control automated sync
 	Enabled *bool `json:"enabled,omitempty" protobuf:"bytes,4,opt,name=enabled"`
+	// MinRevisionAge is the minimum age of a new revision, based on its commit date, before it is automatically synced (default: 0, no minimum)
+	MinRevisionAge *metav1.Duration `json:"minRevisionAge,omitempty" protobuf:"bytes,5,opt,name=minRevisionAge"`
+	// SelfHealBackoff overrides the controller-wide backoff between the self-heal attempts of the application
+	SelfHealBackoff *SelfHealBackoff `json:"selfHealBackoff,omitempty" protobuf:"bytes,6,opt,name=selfHealBackoff"`
+}
+
+// SelfHealBackoff is the backoff strategy between the consecutive self-heal attempts of an application
+type SelfHealBackoff struct {
+	// Duration is the amount to back off after the first self-heal attempt. Default unit is seconds, but could also be a duration (e.g. "2m", "1h")
+	Duration string `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration"`
+	// Factor is a factor to multiply the base duration after each self-heal attempt
+	Factor *int64 `json:"factor,omitempty" protobuf:"bytes,2,opt,name=factor"`
+	// MaxDuration is the maximum amount of time to back off between two self-heal attempts
+	MaxDuration string `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration"`
+	// Cooldown is the amount of time the application needs to stay synced after the last self-heal attempt before the attempts are reset
+	Cooldown string `json:"cooldown,omitempty" protobuf:"bytes,4,opt,name=cooldown"`
+	// MaxAttempts is the maximum number of consecutive self-heal attempts, after which self-heal stops until the attempts are reset (default: 0, no limit)
+	MaxAttempts int64 `json:"maxAttempts,omitempty" protobuf:"varint,5,opt,name=maxAttempts"`
+}
+
+// Delay returns the amount of time to wait after the given number of consecutive self-heal attempts before attempting
+// to self-heal again
+func (b *SelfHealBackoff) Delay(attempts int64) (time.Duration, error) {
+	if attempts <= 0 {
+		return 0, nil
+	}
+	maxDuration := DefaultSelfHealBackoffMaxDuration
+	duration := DefaultSelfHealBackoffDuration
+	factor := DefaultSelfHealBackoffFactor
+	var err error
+	if b.Duration != "" {
+		if duration, err = parseStringToDuration(b.Duration); err != nil {
+			return 0, err
+		}
+	}
+	if b.MaxDuration != "" {
+		if maxDuration, err = parseStringToDuration(b.MaxDuration); err != nil {
+			return 0, err
+		}
+	}
+	if b.Factor != nil {
+		factor = *b.Factor
+	}
+	delay := float64(duration) * math.Pow(float64(factor), float64(attempts-1))
+	if maxDuration > 0 {
+		delay = math.Min(float64(maxDuration), delay)
+	}
+	return time.Duration(delay), nil
+}
+
+// CooldownDuration returns the amount of time the application needs to stay synced before the self-heal attempts are
+// reset
+func (b *SelfHealBackoff) CooldownDuration() (time.Duration, error) {
+	if b.Cooldown == "" {
+		return DefaultSelfHealBackoffCooldown, nil
+	}
+	return parseStringToDuration(b.Cooldown)
 }
 
 // GetPrune returns the value of Prune, defaulting to false if nil.
@@ -1624,6 +2001,14 @@ func (a *SyncPolicyAutomated) GetPrune() bool {
 	return *a.Prune
 }
 
+// GetMinRevisionAge returns the value of MinRevisionAge, defaulting to 0 if nil.
+func (a *SyncPolicyAutomated) GetMinRevisionAge() time.Duration {
+	if a == nil || a.MinRevisionAge == nil || a.MinRevisionAge.Duration < 0 {
+		return 0
+	}
+	return a.MinRevisionAge.Duration
+}
+
 // GetSelfHeal returns the value of SelfHeal, defaulting to false if nil.
 func (a *SyncPolicyAutomated) GetSelfHeal() bool {
 	if a == nil || a.SelfHeal == nil {
@@ -1733,6 +2118,20 @@ type RevisionMetadata struct {
 	SourceIntegrityResult *SourceIntegrityCheckResult `json:"sourceIntegrityResult,omitempty" protobuf:"bytes,7,opt,name=sourceIntegrityResult"`
 }
 
+// RevisionComparison contains the commits and the changed files between two revisions of a Git repository
+type RevisionComparison struct {
+	// Revision is the commit SHA the comparison starts from, typically the currently synced revision
+	Revision string `json:"revision,omitempty" protobuf:"bytes,1,opt,name=revision"`
+	// TargetRevision is the commit SHA the comparison ends at, typically the revision which will be synced
+	TargetRevision string `json:"targetRevision,omitempty" protobuf:"bytes,2,opt,name=targetRevision"`
+	// Commits contains the commits reachable from the target revision but not from the revision, newest first
+	Commits []CommitMetadata `json:"commits,omitempty" protobuf:"bytes,3,rep,name=commits"`
+	// CommitsTruncated is true if there are more commits between the revisions than the ones returned
+	CommitsTruncated bool `json:"commitsTruncated,omitempty" protobuf:"varint,4,opt,name=commitsTruncated"`
+	// ChangedFiles contains the paths, relative to the root of the repository, of the files changed between the revisions
+	ChangedFiles []string `json:"changedFiles,omitempty" protobuf:"bytes,5,rep,name=changedFiles"`
+}
+
 // OCIMetadata contains metadata for a specific revision in an OCI repository
 type OCIMetadata struct {
 	CreatedAt   string `json:"createdAt,omitempty" protobuf:"bytes,1,opt,name=createdAt"`
@@ -1767,6 +2166,21 @@ type SyncOperationResult struct {
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,5,opt,name=revisions"`
 	// ManagedNamespaceMetadata contains the current sync state of managed namespace metadata
 	ManagedNamespaceMetadata *ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty" protobuf:"bytes,6,opt,name=managedNamespaceMetadata"`
+	// Destinations contains the results of the destinations already synced by the operation, in sync order, for an
+	// application with multiple destinations. Resources only holds the results of the destination being synced.
+	Destinations []SyncOperationDestinationResult `json:"destinations,omitempty" protobuf:"bytes,7,rep,name=destinations"`
+}
+
+// SyncOperationDestinationResult holds the sync result of one of the destinations of an application
+type SyncOperationDestinationResult struct {
+	// Destination is the destination which was synced
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// Phase is the phase the sync of the destination completed with
+	Phase synccommon.OperationPhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains the message the sync of the destination completed with
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Resources contains the sync results of the resources of the destination
+	Resources ResourceResults `json:"resources,omitempty" protobuf:"bytes,4,opt,name=resources"`
 }
 
 // ResourceResult holds the operation result details of a specific resource
@@ -1794,6 +2208,17 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// ServerSideApplyManager is the field manager used to apply the resource with server-side apply. Empty if the resource was not applied server-side
+	ServerSideApplyManager string `json:"serverSideApplyManager,omitempty" protobuf:"bytes,12,opt,name=serverSideApplyManager"`
+	// ForceConflicts indicates if the ownership of the fields managed by other field managers was forced when applying the resource with server-side apply
+	ForceConflicts bool `json:"forceConflicts,omitempty" protobuf:"varint,13,opt,name=forceConflicts"`
+	// SyncWave is the sync wave in which the resource was synced
+	SyncWave int64 `json:"syncWave,omitempty" protobuf:"varint,14,opt,name=syncWave"`
+	// RunningSince is the time at which the sync started waiting for the resource to become healthy or for the hook
+	// to complete
+	RunningSince *metav1.Time `json:"runningSince,omitempty" protobuf:"bytes,15,opt,name=runningSince"`
+	// Stuck indicates the resource did not complete within the resource timeout of the sync operation
+	Stuck bool `json:"stuck,omitempty" protobuf:"varint,16,opt,name=stuck"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1914,6 +2339,12 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionManagedNamespaceMetadataWarning indicates that the live metadata of the namespace managed with managedNamespaceMetadata has drifted
+	ApplicationConditionManagedNamespaceMetadataWarning = "ManagedNamespaceMetadataWarning"
+	// ApplicationConditionSyncPreconditionWarning indicates that an automated sync is held back by a sync precondition which is not met
+	ApplicationConditionSyncPreconditionWarning = "SyncPreconditionWarning"
+	// ApplicationConditionManifestGenerationLimitError indicates that the generation of the manifests of the application exceeded its resource limits
+	ApplicationConditionManifestGenerationLimitError = "ManifestGenerationLimitError"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -1924,6 +2355,29 @@ type ApplicationCondition struct {
 	Message string `json:"message" protobuf:"bytes,2,opt,name=message"`
 	// LastTransitionTime is the time the condition was last observed
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+	// Severity is the severity of the condition, one of: Info, Warning, Error. Defaults to the suffix of the type of the condition.
+	Severity ApplicationConditionSeverity `json:"severity,omitempty" protobuf:"bytes,4,opt,name=severity,casttype=ApplicationConditionSeverity"`
+	// ExpiresAt is the time after which a transient condition ages out and is removed from the application
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty" protobuf:"bytes,5,opt,name=expiresAt"`
+}
+
+// ApplicationConditionSeverity is the severity of an application condition
+type ApplicationConditionSeverity string
+
+const (
+	ApplicationConditionSeverityInfo    ApplicationConditionSeverity = "Info"
+	ApplicationConditionSeverityWarning ApplicationConditionSeverity = "Warning"
+	ApplicationConditionSeverityError   ApplicationConditionSeverity = "Error"
+)
+
+// ParseApplicationConditionSeverity returns the severity with the given case-insensitive name
+func ParseApplicationConditionSeverity(name string) (ApplicationConditionSeverity, error) {
+	for _, severity := range []ApplicationConditionSeverity{ApplicationConditionSeverityInfo, ApplicationConditionSeverityWarning, ApplicationConditionSeverityError} {
+		if strings.EqualFold(string(severity), name) {
+			return severity, nil
+		}
+	}
+	return "", fmt.Errorf("unknown condition severity '%s', must be one of: Info, Warning, Error", name)
 }
 
 // ComparedTo contains application source and target which was used for resources comparison
@@ -1948,6 +2402,9 @@ type SyncStatus struct {
 	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
 	// Revisions contains information about the revisions of multiple sources the comparison has been performed to
 	Revisions []string `json:"revisions,omitempty" protobuf:"bytes,4,opt,name=revisions"`
+	// PruneOnly indicates the application is OutOfSync only because of resources which require pruning, and that the
+	// content of all the other resources matches the desired state
+	PruneOnly bool `json:"pruneOnly,omitempty" protobuf:"varint,5,opt,name=pruneOnly"`
 }
 
 // AppHealthStatus contains information about the currently observed health state of an application
@@ -1972,6 +2429,82 @@ type HealthStatus struct {
 	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
 }
 
+// HealthRollupAction is the action of a health roll-up rule
+type HealthRollupAction string
+
+const (
+	// HealthRollupActionIgnore excludes the health of the resources from the health of the application
+	HealthRollupActionIgnore HealthRollupAction = "Ignore"
+	// HealthRollupActionWarn reports the unhealthy resources in the health message of the application without
+	// affecting its health status
+	HealthRollupActionWarn HealthRollupAction = "Warn"
+)
+
+// HealthRollupRule controls how the health of the resources of a group kind contributes to the health of an application
+type HealthRollupRule struct {
+	// Group is the API group of the resources, supporting glob patterns. Empty for the core group.
+	Group string `json:"group,omitempty" protobuf:"bytes,1,opt,name=group"`
+	// Kind is the kind of the resources, supporting glob patterns
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Action is either Ignore or Warn. If empty, the health of the resources contributes to the health of the
+	// application, bounded by MaxStatus.
+	// +kubebuilder:validation:Enum=Ignore;Warn
+	Action HealthRollupAction `json:"action,omitempty" protobuf:"bytes,3,opt,name=action,casttype=HealthRollupAction"`
+	// MaxStatus is the worst health status the resources can contribute to the application, e.g. Progressing for a
+	// degraded resource to only mark the application as progressing
+	MaxStatus health.HealthStatusCode `json:"maxStatus,omitempty" protobuf:"bytes,4,opt,name=maxStatus"`
+}
+
+// Matches returns true if the rule applies to the resources of the given group and kind
+func (r *HealthRollupRule) Matches(group, kind string) bool {
+	return isResourceInList(metav1.GroupKind{Group: group, Kind: kind}, []metav1.GroupKind{{Group: r.Group, Kind: r.Kind}})
+}
+
+// Validate checks the rule has a kind, a known action and a known maximum status
+func (r *HealthRollupRule) Validate() error {
+	if r.Kind == "" {
+		return errors.New("kind is required")
+	}
+	for _, pattern := range []string{r.Group, r.Kind} {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+		}
+	}
+	switch r.Action {
+	case "", HealthRollupActionIgnore, HealthRollupActionWarn:
+	default:
+		return fmt.Errorf("unknown action '%s'", r.Action)
+	}
+	switch r.MaxStatus {
+	case "", health.HealthStatusHealthy, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusDegraded, health.HealthStatusMissing, health.HealthStatusUnknown:
+	default:
+		return fmt.Errorf("unknown max status '%s'", r.MaxStatus)
+	}
+	if r.Action != "" && r.MaxStatus != "" {
+		return fmt.Errorf("max status cannot be set with the %s action", r.Action)
+	}
+	return nil
+}
+
+// GetHealthRollupRule returns the first health roll-up rule of the application, or else of the project, which applies
+// to the resources of the given group and kind, or nil if there is none
+func GetHealthRollupRule(app *Application, proj *AppProject, group, kind string) *HealthRollupRule {
+	for i := range app.Spec.HealthRollupRules {
+		if app.Spec.HealthRollupRules[i].Matches(group, kind) {
+			return &app.Spec.HealthRollupRules[i]
+		}
+	}
+	if proj == nil {
+		return nil
+	}
+	for i := range proj.Spec.HealthRollupRules {
+		if proj.Spec.HealthRollupRules[i].Matches(group, kind) {
+			return &proj.Spec.HealthRollupRules[i]
+		}
+	}
+	return nil
+}
+
 // InfoItem contains arbitrary, human readable information about an application
 type InfoItem struct {
 	// Name is a human readable title for this piece of information.
@@ -2101,6 +2634,36 @@ func (t *ApplicationTree) Normalize() {
 	})
 }
 
+// ApplicationMetricsHistory holds the most recent reconciliation and sync measurements of an application.
+// The history is kept in the shared cache and is only populated if the application controller is configured
+// to record it.
+type ApplicationMetricsHistory struct {
+	// Reconciliations holds the most recent reconciliation samples, oldest first
+	Reconciliations []ReconciliationSample `json:"reconciliations,omitempty" protobuf:"bytes,1,rep,name=reconciliations"`
+	// Syncs holds the most recent sync samples, oldest first
+	Syncs []SyncSample `json:"syncs,omitempty" protobuf:"bytes,2,rep,name=syncs"`
+}
+
+// ReconciliationSample is a measurement of a single reconciliation of an application
+type ReconciliationSample struct {
+	// Time is the time at which the reconciliation completed
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+	// DurationMs is the duration of the reconciliation in milliseconds
+	DurationMs int64 `json:"durationMs" protobuf:"varint,2,opt,name=durationMs"`
+	// OutOfSyncResources is the number of managed resources that were out of sync after the reconciliation
+	OutOfSyncResources int64 `json:"outOfSyncResources" protobuf:"varint,3,opt,name=outOfSyncResources"`
+}
+
+// SyncSample is a measurement of a single completed sync operation of an application
+type SyncSample struct {
+	// Time is the time at which the sync operation finished
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+	// DurationMs is the duration of the sync operation in milliseconds
+	DurationMs int64 `json:"durationMs" protobuf:"varint,2,opt,name=durationMs"`
+	// Phase is the phase the sync operation completed with
+	Phase synccommon.OperationPhase `json:"phase" protobuf:"bytes,3,opt,name=phase"`
+}
+
 // ApplicationSummary contains information about URLs and container images used by an application
 type ApplicationSummary struct {
 	// ExternalURLs holds all external URLs of application child resources.
@@ -2350,6 +2913,12 @@ type Cluster struct {
 	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,12,opt,name=labels"`
 	// Annotations for cluster secret metadata
 	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,13,opt,name=annotations"`
+	// Indicates if the controller should list and watch only the namespaces of the applications deployed to the cluster instead of the whole cluster.
+	// This setting is used only if the list of namespaces is empty. Cluster level resources are ignored unless clusterResources is set.
+	NamespacedCache bool `json:"namespacedCache,omitempty" protobuf:"varint,14,opt,name=namespacedCache"`
+	// Indicates that the cluster is cordoned, e.g. because it is being decommissioned. Applications deployed to a cordoned
+	// cluster are not synced anymore.
+	Cordoned bool `json:"cordoned,omitempty" protobuf:"varint,15,opt,name=cordoned"`
 
 	// The embedded metav1.ObjectMeta field is purely here to please the informer when converting from a v1.Secret to a Cluster.
 	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata
@@ -2368,10 +2937,14 @@ func (c *Cluster) Sanitized() *Cluster {
 		Labels:             c.Labels,
 		Annotations:        c.Annotations,
 		ClusterResources:   c.ClusterResources,
+		NamespacedCache:    c.NamespacedCache,
+		Cordoned:           c.Cordoned,
 		Info:               c.Info,
 		RefreshRequestedAt: c.RefreshRequestedAt,
 		Config: ClusterConfig{
 			AWSAuthConfig:      c.Config.AWSAuthConfig,
+			GCPAuthConfig:      c.Config.GCPAuthConfig,
+			AzureAuthConfig:    c.Config.AzureAuthConfig,
 			ProxyUrl:           c.Config.ProxyUrl,
 			DisableCompression: c.Config.DisableCompression,
 			TLSClientConfig: TLSClientConfig{
@@ -2387,6 +2960,12 @@ func (c *Cluster) Sanitized() *Cluster {
 	}
 }
 
+// IsPullMode returns true if the applications of the cluster are deployed by an agent running in the cluster, in which
+// case Argo CD does not connect to the cluster
+func (c *Cluster) IsPullMode() bool {
+	return c.Labels[common.LabelKeyClusterAgentMode] == common.LabelValueClusterAgentModePull
+}
+
 // Equals returns true if two cluster objects are considered to be equal
 func (c *Cluster) Equals(other *Cluster) bool {
 	if c.Server != other.Server {
@@ -2414,6 +2993,10 @@ func (c *Cluster) Equals(other *Cluster) bool {
 		return false
 	}
 
+	if c.NamespacedCache != other.NamespacedCache {
+		return false
+	}
+
 	if !maps.Equal(c.Annotations, other.Annotations) {
 		return false
 	}
@@ -2475,6 +3058,20 @@ type AWSAuthConfig struct {
 	Profile string `json:"profile,omitempty" protobuf:"bytes,3,opt,name=profile"`
 }
 
+// GCPAuthConfig is a GKE authentication configuration. Access tokens are obtained from the application default
+// credentials of the Argo CD components, e.g. GKE Workload Identity.
+type GCPAuthConfig struct {
+	// Scopes contains optional OAuth scopes of the requested access tokens. Defaults to the cloud-platform and userinfo.email scopes.
+	Scopes []string `json:"scopes,omitempty" protobuf:"bytes,1,rep,name=scopes"`
+}
+
+// AzureAuthConfig is an AKS Microsoft Entra ID authentication configuration. Access tokens are obtained using the
+// workload identity federation of the Argo CD components.
+type AzureAuthConfig struct {
+	// ServerApplicationID contains the optional application ID of the Microsoft Entra ID server application. Defaults to the application of AKS clusters with managed Microsoft Entra ID integration.
+	ServerApplicationID string `json:"serverApplicationID,omitempty" protobuf:"bytes,1,opt,name=serverApplicationID"`
+}
+
 // ExecProviderConfig is config used to call an external command to perform cluster authentication
 // See: https://godoc.org/k8s.io/client-go/tools/clientcmd/api#ExecConfig
 type ExecProviderConfig struct {
@@ -2535,6 +3132,12 @@ type ClusterConfig struct {
 
 	// ProxyURL is the URL to the proxy to be used for all requests send to the server
 	ProxyUrl string `json:"proxyUrl,omitempty" protobuf:"bytes,8,opt,name=proxyUrl"` //nolint:revive //FIXME(var-naming)
+
+	// GCPAuthConfig contains GKE authentication configuration
+	GCPAuthConfig *GCPAuthConfig `json:"gcpAuthConfig,omitempty" protobuf:"bytes,9,opt,name=gcpAuthConfig"`
+
+	// AzureAuthConfig contains AKS authentication configuration
+	AzureAuthConfig *AzureAuthConfig `json:"azureAuthConfig,omitempty" protobuf:"bytes,10,opt,name=azureAuthConfig"`
 }
 
 // TLSClientConfig contains settings to enable transport layer security
@@ -2691,10 +3294,41 @@ type ResourceAction struct {
 }
 
 // ResourceActionParam represents a parameter for a resource action.
-// It includes a name, value, type, and an optional default value for the parameter.
+// It includes a name, a type, an optional default value and the allowed values of enum parameters.
 type ResourceActionParam struct {
 	// Name is the name of the parameter.
 	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Type is the type of the parameter, one of string, int or enum. Defaults to string.
+	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// Default is the value of the parameter used when no value is provided.
+	Default string `json:"default,omitempty" protobuf:"bytes,4,opt,name=default"`
+	// Options are the allowed values of an enum parameter.
+	Options []string `json:"options,omitempty" protobuf:"bytes,5,rep,name=options"`
+}
+
+const (
+	// ResourceActionParamTypeString is the type of the parameters which accept any value
+	ResourceActionParamTypeString = "string"
+	// ResourceActionParamTypeInt is the type of the parameters which accept integer values
+	ResourceActionParamTypeInt = "int"
+	// ResourceActionParamTypeEnum is the type of the parameters which accept one of their options
+	ResourceActionParamTypeEnum = "enum"
+)
+
+// ValidateValue returns an error if the value is not valid for the type of the parameter. The values of parameters of
+// other types are not validated.
+func (p ResourceActionParam) ValidateValue(value string) error {
+	switch p.Type {
+	case ResourceActionParamTypeInt:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("parameter %q must be an integer, got %q", p.Name, value)
+		}
+	case ResourceActionParamTypeEnum:
+		if !slices.Contains(p.Options, value) {
+			return fmt.Errorf("parameter %q must be one of %s, got %q", p.Name, strings.Join(p.Options, ", "), value)
+		}
+	}
+	return nil
 }
 
 var validActions = map[string]bool{
@@ -2864,6 +3498,305 @@ type AppProjectSpec struct {
 	// SourceIntegrity represents a constraint on manifest sources integrity to be met before they can be used.
 	// Do not access directly, use EffectiveSourceIntegrity() for correct backwards compatibility handling.
 	SourceIntegrity *SourceIntegrity `json:"sourceIntegrity,omitempty" protobuf:"bytes,15,name=sourceIntegrity"`
+	// OperationWebhooks are called when the phase of an operation of an application in this project changes
+	OperationWebhooks []OperationWebhook `json:"operationWebhooks,omitempty" protobuf:"bytes,16,rep,name=operationWebhooks"`
+	// ReconcilePriority is the priority of the applications of this project in the reconciliation queues of the application controller.
+	// Applications of projects with a higher priority are reconciled first when the queues are saturated. Defaults to 0.
+	ReconcilePriority int32 `json:"reconcilePriority,omitempty" protobuf:"varint,17,opt,name=reconcilePriority"`
+	// ReconcileRateLimit limits the rate at which the applications of this project are refreshed by the application controller
+	ReconcileRateLimit *ReconcileRateLimit `json:"reconcileRateLimit,omitempty" protobuf:"bytes,18,opt,name=reconcileRateLimit"`
+	// IgnoreDifferencesRef references a key of a ConfigMap of the Argo CD namespace holding a list of ignore differences, which
+	// are merged into the ignore differences of every application of this project. The ConfigMap must be labeled with
+	// app.kubernetes.io/part-of: argocd.
+	IgnoreDifferencesRef *ConfigMapKeyRef `json:"ignoreDifferencesRef,omitempty" protobuf:"bytes,19,opt,name=ignoreDifferencesRef"`
+	// NamespaceCreationPolicy controls whether the applications of this project can create their destination namespace with the
+	// CreateNamespace=true sync option. Defaults to allowing it.
+	NamespaceCreationPolicy *NamespaceCreationPolicy `json:"namespaceCreationPolicy,omitempty" protobuf:"bytes,20,opt,name=namespaceCreationPolicy"`
+	// Exec configures the web-based terminal of the applications of this project
+	Exec *ProjectExecConfig `json:"exec,omitempty" protobuf:"bytes,21,opt,name=exec"`
+	// DestinationMappings rewrite the destinations of the applications of this project, allowing them to be pointed at a
+	// replacement cluster without editing their specs. The first matching mapping wins.
+	DestinationMappings []DestinationMapping `json:"destinationMappings,omitempty" protobuf:"bytes,22,rep,name=destinationMappings"`
+	// MinSyncInterval is the minimum interval between the start of a sync of an application of this project and the start of
+	// the next automated sync of the application (default: 0, no minimum)
+	MinSyncInterval *metav1.Duration `json:"minSyncInterval,omitempty" protobuf:"bytes,23,opt,name=minSyncInterval"`
+	// MaxRefreshInterval is the maximum refresh interval the applications of this project can set in their sync policy
+	// (default: 0, no maximum)
+	MaxRefreshInterval *metav1.Duration `json:"maxRefreshInterval,omitempty" protobuf:"bytes,24,opt,name=maxRefreshInterval"`
+	// HealthRollupRules control how the health of the resources of given group kinds contributes to the health of the
+	// applications of this project
+	HealthRollupRules []HealthRollupRule `json:"healthRollupRules,omitempty" protobuf:"bytes,25,rep,name=healthRollupRules"`
+	// ManagedFieldsManagers is a list of trusted field managers. Fields owned by those managers are ignored when diffing the
+	// resources of every application of this project, as if listed in the managedFieldsManagers of an ignore difference
+	// matching all group kinds.
+	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty" protobuf:"bytes,26,rep,name=managedFieldsManagers"`
+	// DriftExclusions exclude the drift of the resources of the applications of this project for which a CEL expression
+	// evaluates to true
+	DriftExclusions []DriftExclusion `json:"driftExclusions,omitempty" protobuf:"bytes,27,rep,name=driftExclusions"`
+	// APIRateLimits limit the rate of the API server requests on the applications of this project
+	APIRateLimits *ProjectAPIRateLimits `json:"apiRateLimits,omitempty" protobuf:"bytes,28,opt,name=apiRateLimits"`
+	// ResourceBudgets limit the total CPU and memory requests of the applications of this project per destination. Syncs which
+	// would exceed a budget are rejected.
+	ResourceBudgets []ResourceBudget `json:"resourceBudgets,omitempty" protobuf:"bytes,29,rep,name=resourceBudgets"`
+}
+
+// NamespaceCreationMode is the mode of a namespace creation policy
+type NamespaceCreationMode string
+
+const (
+	// NamespaceCreationModeAllow allows the applications to create their destination namespace
+	NamespaceCreationModeAllow NamespaceCreationMode = "allow"
+	// NamespaceCreationModeDeny forbids the applications to create their destination namespace
+	NamespaceCreationModeDeny NamespaceCreationMode = "deny"
+	// NamespaceCreationModeAllowWithRequiredLabels allows the applications to create their destination namespace, with the
+	// labels and annotations required by the policy
+	NamespaceCreationModeAllowWithRequiredLabels NamespaceCreationMode = "allowWithRequiredLabels"
+)
+
+// NamespaceCreationPolicy controls whether the applications of a project can create their destination namespace
+type NamespaceCreationPolicy struct {
+	// Mode is either allow, deny or allowWithRequiredLabels. Defaults to allow.
+	// +kubebuilder:validation:Enum=allow;deny;allowWithRequiredLabels
+	Mode NamespaceCreationMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=NamespaceCreationMode"`
+	// Labels are the labels required on the created namespaces, which are added to the managed namespace metadata of the
+	// applications. Only used by the allowWithRequiredLabels mode.
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,2,rep,name=labels"`
+	// Annotations are the annotations required on the created namespaces, which are added to the managed namespace metadata
+	// of the applications. Only used by the allowWithRequiredLabels mode.
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,3,rep,name=annotations"`
+}
+
+// IsNamespaceCreationPermitted returns true if the applications of the project can create their destination namespace
+func (proj *AppProject) IsNamespaceCreationPermitted() bool {
+	return proj.Spec.NamespaceCreationPolicy == nil || proj.Spec.NamespaceCreationPolicy.Mode != NamespaceCreationModeDeny
+}
+
+// GetMinSyncInterval returns the value of MinSyncInterval, defaulting to 0 if nil.
+func (proj *AppProject) GetMinSyncInterval() time.Duration {
+	if proj == nil || proj.Spec.MinSyncInterval == nil || proj.Spec.MinSyncInterval.Duration < 0 {
+		return 0
+	}
+	return proj.Spec.MinSyncInterval.Duration
+}
+
+// GetRefreshInterval returns the refresh interval of an application with the given sync policy, bounded by the maximum
+// refresh interval of the project. It returns 0 if the sync policy does not set a refresh interval.
+func (proj *AppProject) GetRefreshInterval(syncPolicy *SyncPolicy) time.Duration {
+	interval := syncPolicy.GetRefreshInterval()
+	if proj == nil || proj.Spec.MaxRefreshInterval == nil || proj.Spec.MaxRefreshInterval.Duration <= 0 {
+		return interval
+	}
+	return min(interval, proj.Spec.MaxRefreshInterval.Duration)
+}
+
+// GetManagedNamespaceMetadata returns the managed namespace metadata of the sync policy of an application, with the
+// labels and annotations required by the namespace creation policy of the project. The required labels and annotations
+// take precedence over the ones of the application.
+func (proj *AppProject) GetManagedNamespaceMetadata(syncPolicy *SyncPolicy) *ManagedNamespaceMetadata {
+	var metadata *ManagedNamespaceMetadata
+	if syncPolicy != nil {
+		metadata = syncPolicy.ManagedNamespaceMetadata
+	}
+	policy := proj.Spec.NamespaceCreationPolicy
+	if policy == nil || policy.Mode != NamespaceCreationModeAllowWithRequiredLabels || (len(policy.Labels) == 0 && len(policy.Annotations) == 0) {
+		return metadata
+	}
+	merged := &ManagedNamespaceMetadata{}
+	if metadata != nil {
+		merged = metadata.DeepCopy()
+	}
+	if len(policy.Labels) > 0 {
+		if merged.Labels == nil {
+			merged.Labels = make(map[string]string, len(policy.Labels))
+		}
+		maps.Copy(merged.Labels, policy.Labels)
+	}
+	if len(policy.Annotations) > 0 {
+		if merged.Annotations == nil {
+			merged.Annotations = make(map[string]string, len(policy.Annotations))
+		}
+		maps.Copy(merged.Annotations, policy.Annotations)
+	}
+	return merged
+}
+
+// ProjectExecConfig configures the web-based terminal of the applications of a project
+type ProjectExecConfig struct {
+	// Enabled enables or disables the web-based terminal for the applications of the project. The terminal can only be
+	// enabled if the exec.enabled setting of argocd-cm enables it globally.
+	Enabled *bool `json:"enabled,omitempty" protobuf:"varint,1,opt,name=enabled"`
+	// RecordSessions requires the terminal sessions of the applications of the project to be recorded. Sessions are refused
+	// when no recording path is configured in argocd-cm.
+	RecordSessions bool `json:"recordSessions,omitempty" protobuf:"varint,2,opt,name=recordSessions"`
+}
+
+// IsExecEnabled returns true if the web-based terminal is enabled for the applications of the project, given whether it is
+// enabled globally. The project can only disable a terminal which is enabled globally.
+func (proj *AppProject) IsExecEnabled(globallyEnabled bool) bool {
+	if !globallyEnabled || proj.Spec.Exec == nil || proj.Spec.Exec.Enabled == nil {
+		return globallyEnabled
+	}
+	return *proj.Spec.Exec.Enabled
+}
+
+// IsExecRecordingRequired returns true if the terminal sessions of the applications of the project must be recorded
+func (proj *AppProject) IsExecRecordingRequired() bool {
+	return proj.Spec.Exec != nil && proj.Spec.Exec.RecordSessions
+}
+
+// DestinationMapping rewrites the destination cluster of the applications of a project, identified either by server URL or
+// by name, to another cluster
+type DestinationMapping struct {
+	// Server is the URL of the cluster to rewrite. Either Server or Name must be set.
+	Server string `json:"server,omitempty" protobuf:"bytes,1,opt,name=server"`
+	// Name is the name of the cluster to rewrite. Either Server or Name must be set.
+	Name string `json:"name,omitempty" protobuf:"bytes,2,opt,name=name"`
+	// TargetServer is the URL of the cluster the destination is rewritten to. Either TargetServer or TargetName must be set.
+	TargetServer string `json:"targetServer,omitempty" protobuf:"bytes,3,opt,name=targetServer"`
+	// TargetName is the name of the cluster the destination is rewritten to. Either TargetServer or TargetName must be set.
+	TargetName string `json:"targetName,omitempty" protobuf:"bytes,4,opt,name=targetName"`
+	// EffectiveFrom is the time from which the mapping is applied. The mapping is applied immediately when not set.
+	EffectiveFrom *metav1.Time `json:"effectiveFrom,omitempty" protobuf:"bytes,5,opt,name=effectiveFrom"`
+}
+
+// Matches returns true if the mapping applies to the given destination at the given time
+func (m *DestinationMapping) Matches(dest ApplicationDestination, now time.Time) bool {
+	if m.EffectiveFrom != nil && now.Before(m.EffectiveFrom.Time) {
+		return false
+	}
+	if dest.Server != "" {
+		return m.Server != "" && m.Server == dest.Server
+	}
+	return m.Name != "" && m.Name == dest.Name
+}
+
+// MapDestination returns the given destination rewritten by the first destination mapping of the project applying to it
+// at the given time, or the destination unchanged if no mapping applies. The namespace of the destination is kept.
+func (proj *AppProject) MapDestination(dest ApplicationDestination, now time.Time) ApplicationDestination {
+	for i := range proj.Spec.DestinationMappings {
+		m := &proj.Spec.DestinationMappings[i]
+		if !m.Matches(dest, now) {
+			continue
+		}
+		mapped := ApplicationDestination{Namespace: dest.Namespace}
+		if m.TargetServer != "" {
+			mapped.Server = m.TargetServer
+		} else {
+			mapped.Name = m.TargetName
+		}
+		return mapped
+	}
+	return dest
+}
+
+// ReconcileRateLimit is a token bucket rate limit shared by the refreshes of all applications of a project
+type ReconcileRateLimit struct {
+	// PerMinute is the number of refreshes per minute
+	PerMinute int32 `json:"perMinute" protobuf:"varint,1,opt,name=perMinute"`
+	// Burst is the number of refreshes which can exceed the rate. Defaults to PerMinute.
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,2,opt,name=burst"`
+}
+
+// GetBurst returns the burst of the rate limit, which defaults to the number of refreshes per minute
+func (l *ReconcileRateLimit) GetBurst() int {
+	if l.Burst > 0 {
+		return int(l.Burst)
+	}
+	return int(l.PerMinute)
+}
+
+// ProjectAPIRateLimits limits the rate of the API server requests on the applications of a project. The limits are shared
+// by all users of the project and enforced by each API server replica.
+type ProjectAPIRateLimits struct {
+	// Sync limits the rate of the sync requests
+	Sync *APIRateLimit `json:"sync,omitempty" protobuf:"bytes,1,opt,name=sync"`
+	// Refresh limits the rate of the refresh requests
+	Refresh *APIRateLimit `json:"refresh,omitempty" protobuf:"bytes,2,opt,name=refresh"`
+	// Get limits the rate of the get requests, not including the refresh requests
+	Get *APIRateLimit `json:"get,omitempty" protobuf:"bytes,3,opt,name=get"`
+}
+
+// APIRateLimit is a token bucket rate limit of API server requests
+type APIRateLimit struct {
+	// PerMinute is the number of requests per minute
+	PerMinute int32 `json:"perMinute" protobuf:"varint,1,opt,name=perMinute"`
+	// Burst is the number of requests which can exceed the rate. Defaults to PerMinute.
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,2,opt,name=burst"`
+}
+
+// GetBurst returns the burst of the rate limit, which defaults to the number of requests per minute
+func (l *APIRateLimit) GetBurst() int {
+	if l.Burst > 0 {
+		return int(l.Burst)
+	}
+	return int(l.PerMinute)
+}
+
+// ResourceBudget limits the total CPU and memory requests declared by the workloads of the applications of a project which
+// are deployed to the matching destinations
+type ResourceBudget struct {
+	// Destination selects the applications the budget applies to. The server, name and namespace are glob patterns, an
+	// empty pattern matching any value.
+	Destination ApplicationDestination `json:"destination" protobuf:"bytes,1,opt,name=destination"`
+	// CPU is the maximum sum of the CPU requests of the applications
+	CPU *resource.Quantity `json:"cpu,omitempty" protobuf:"bytes,2,opt,name=cpu"`
+	// Memory is the maximum sum of the memory requests of the applications
+	Memory *resource.Quantity `json:"memory,omitempty" protobuf:"bytes,3,opt,name=memory"`
+}
+
+// Matches returns true if the budget applies to the applications deployed to the given destination
+func (b *ResourceBudget) Matches(dest ApplicationDestination) bool {
+	matches := func(pattern, val string) bool {
+		return pattern == "" || globMatch(pattern, val, false)
+	}
+	if !matches(b.Destination.Namespace, dest.Namespace) {
+		return false
+	}
+	if b.Destination.Server == "" && b.Destination.Name == "" {
+		return true
+	}
+	return (b.Destination.Server != "" && dest.Server != "" && matches(b.Destination.Server, dest.Server)) ||
+		(b.Destination.Name != "" && dest.Name != "" && matches(b.Destination.Name, dest.Name))
+}
+
+// DestinationString returns a human readable representation of the destination of the budget
+func (b *ResourceBudget) DestinationString() string {
+	cluster := b.Destination.Server
+	if cluster == "" {
+		cluster = b.Destination.Name
+	}
+	if cluster == "" {
+		cluster = "*"
+	}
+	namespace := b.Destination.Namespace
+	if namespace == "" {
+		namespace = "*"
+	}
+	return cluster + "/" + namespace
+}
+
+// OperationWebhookSecretKeyPrefix is the prefix of the keys of the argocd-secret which the operation webhooks of projects
+// may reference as their secret, so that they cannot use other secrets, e.g. the server signature key, to sign events
+const OperationWebhookSecretKeyPrefix = "webhook.operation."
+
+// OperationWebhook is an HTTP endpoint which is notified about the phase transitions of application operations
+type OperationWebhook struct {
+	// Name is the unique name of the webhook
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the address the events are posted to
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// Secret references a key of the argocd-secret, in the form of $<key>, whose value is used to sign the events with HMAC-SHA256
+	Secret string `json:"secret,omitempty" protobuf:"bytes,3,opt,name=secret"`
+	// Phases are the operation phases the webhook is called for. Defaults to the completed phases Succeeded, Failed and Error.
+	Phases []synccommon.OperationPhase `json:"phases,omitempty" protobuf:"bytes,4,rep,name=phases"`
+}
+
+// IsCalledFor returns true if the webhook is called when an operation transitions to the given phase
+func (w *OperationWebhook) IsCalledFor(phase synccommon.OperationPhase) bool {
+	if len(w.Phases) == 0 {
+		return phase.Completed()
+	}
+	return slices.Contains(w.Phases, phase)
 }
 
 // EffectiveSourceIntegrity incorporates the legacy SignatureKeys into SourceIntegrity, if possible
@@ -2945,8 +3878,14 @@ type SyncWindow struct {
 	// For deny windows: allows syncs that started before the deny window became active to continue running
 	// For allow windows: allows syncs that started during the allow window to continue after the window ends
 	SyncOverrun bool `json:"syncOverrun,omitempty" protobuf:"bytes,11,opt,name=syncOverrun"`
+	// RequireApproval blocks the syncs while the window is active until the window is unlocked. Only supported by deny windows.
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"bytes,12,opt,name=requireApproval"`
 }
 
+// maxSyncWindowTransitionOccurrences is the maximum number of overlapping occurrences of a sync window considered when
+// looking for the end of the window
+const maxSyncWindowTransitionOccurrences = 10000
+
 // HasWindows returns true if SyncWindows has one or more SyncWindow
 func (w *SyncWindows) HasWindows() bool {
 	return w != nil && len(*w) > 0
@@ -3042,20 +3981,21 @@ func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
-func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, syncOverrun bool) error {
+func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, syncOverrun bool, requireApproval bool) error {
 	if knd == "" || sch == "" || dur == "" {
 		return errors.New("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
 	}
 
 	window := &SyncWindow{
-		Kind:           knd,
-		Schedule:       sch,
-		Duration:       dur,
-		ManualSync:     ms,
-		TimeZone:       timeZone,
-		UseAndOperator: andOperator,
-		Description:    description,
-		SyncOverrun:    syncOverrun,
+		Kind:            knd,
+		Schedule:        sch,
+		Duration:        dur,
+		ManualSync:      ms,
+		TimeZone:        timeZone,
+		UseAndOperator:  andOperator,
+		Description:     description,
+		SyncOverrun:     syncOverrun,
+		RequireApproval: requireApproval,
 	}
 
 	if len(app) > 0 {
@@ -3170,6 +4110,24 @@ func (w *SyncWindows) Matches(app *Application) *SyncWindows {
 	return nil
 }
 
+// Unlocked returns the sync windows without the approval-gated windows which are unlocked at the given time
+func (w *SyncWindows) Unlocked(unlocks []SyncWindowUnlock, currentTime time.Time) *SyncWindows {
+	if !w.HasWindows() || len(unlocks) == 0 {
+		return w
+	}
+	var windows SyncWindows
+	for _, window := range *w {
+		if window.RequireApproval && window.isUnlocked(unlocks, currentTime) {
+			continue
+		}
+		windows = append(windows, window)
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	return &windows
+}
+
 // CanSync returns true if a sync window currently allows a sync. isManual indicates whether the sync has been triggered manually.
 // The operationStartTime parameter supports sync overrun functionality, which allows ongoing syncs to continue in two scenarios:
 //  1. When a deny window becomes active: If the operation started when sync was allowed and the deny window has syncOverrun enabled,
@@ -3390,6 +4348,46 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
 }
 
+// NextTransition returns the time at which the sync window will next become active, or inactive if it is currently
+// active. A zero time is returned if the window is always active.
+func (w SyncWindow) NextTransition() (time.Time, error) {
+	return w.nextTransition(time.Now())
+}
+
+func (w SyncWindow) nextTransition(currentTime time.Time) (time.Time, error) {
+	currentTime = currentTime.UTC()
+
+	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	schedule, sErr := specParser.Parse(w.Schedule)
+	if sErr != nil {
+		return time.Time{}, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	}
+	duration, dErr := time.ParseDuration(w.Duration)
+	if dErr != nil {
+		return time.Time{}, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	}
+
+	// The schedule is evaluated in the time zone of the sync window, and the result converted back
+	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+	now := currentTime.Add(timeZoneOffsetDuration)
+	start := schedule.Next(now.Add(-duration))
+	if !start.Before(now) {
+		return start.Add(-timeZoneOffsetDuration), nil
+	}
+
+	// The window is active until no occurrence of the schedule starts before the end of the previous one
+	end := start.Add(duration)
+	for range maxSyncWindowTransitionOccurrences {
+		next := schedule.Next(start)
+		if next.After(end) {
+			return end.Add(-timeZoneOffsetDuration), nil
+		}
+		start = next
+		end = next.Add(duration)
+	}
+	return time.Time{}, nil
+}
+
 // Update updates a sync window's settings with the given parameter
 func (w *SyncWindow) Update(s string, d string, a []string, n []string, c []string, tz string, description string) error {
 	if s == "" && d == "" && len(a) == 0 && len(n) == 0 && len(c) == 0 && description == "" {
@@ -3455,9 +4453,28 @@ func (w *SyncWindow) Validate() error {
 		return errors.New("description must not exceed 255 characters")
 	}
 
+	if w.RequireApproval && w.Kind != "deny" {
+		return errors.New("approval can only be required by deny windows")
+	}
+
 	return nil
 }
 
+// isUnlocked returns true if one of the unlocks of the window has not expired at the given time
+func (w *SyncWindow) isUnlocked(unlocks []SyncWindowUnlock, currentTime time.Time) bool {
+	hash, err := w.HashIdentity()
+	if err != nil {
+		return false
+	}
+	windowHash := strconv.FormatUint(hash, 10)
+	for _, unlock := range unlocks {
+		if unlock.WindowHash == windowHash && currentTime.Before(unlock.ExpiresAt.Time) {
+			return true
+		}
+	}
+	return false
+}
+
 func (w *SyncWindow) HashIdentity() (uint64, error) {
 	// Create a copy of the window with only the core identity fields
 	// Excluding ManualSync and Description as they are behavioral/metadata fields
@@ -3470,7 +4487,7 @@ func (w *SyncWindow) HashIdentity() (uint64, error) {
 		Clusters:       w.Clusters,
 		TimeZone:       w.TimeZone,
 		UseAndOperator: w.UseAndOperator,
-		// ManualSync and Description are excluded as they don't affect window identity
+		// ManualSync, Description, SyncOverrun and RequireApproval are excluded as they don't affect window identity
 	}
 
 	var windowBuffer bytes.Buffer
@@ -3505,6 +4522,32 @@ type ProjectRole struct {
 	JWTTokens []JWTToken `json:"jwtTokens,omitempty" protobuf:"bytes,4,rep,name=jwtTokens"`
 	// Groups are a list of OIDC group claims bound to this role
 	Groups []string `json:"groups,omitempty" protobuf:"bytes,5,rep,name=groups"`
+	// AllowedCIDRs restricts the client addresses from which the JWT tokens of this role are accepted. Tokens are
+	// accepted from any address when the list is empty
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty" protobuf:"bytes,6,rep,name=allowedCIDRs"`
+	// MaxTokenUses limits the number of requests each JWT token of this role may authenticate. Zero means unlimited
+	MaxTokenUses int64 `json:"maxTokenUses,omitempty" protobuf:"varint,7,opt,name=maxTokenUses"`
+}
+
+// IsAddressAllowed returns whether the role's tokens may be used from the given client address
+func (r *ProjectRole) IsAddressAllowed(addr string) (bool, error) {
+	if len(r.AllowedCIDRs) == 0 {
+		return true, nil
+	}
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false, nil
+	}
+	for _, cidr := range r.AllowedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return false, fmt.Errorf("invalid CIDR '%s' in role '%s': %w", cidr, r.Name, err)
+		}
+		if ipNet.Contains(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
 // JWTToken holds the issuedAt and expiresAt values of a token
@@ -3567,6 +4610,9 @@ type ApplicationDestinationServiceAccount struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
 	// DefaultServiceAccount to be used for impersonation during the sync operation
 	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+	// AllowedServiceAccounts are glob patterns of the service accounts which applications of the destination may impersonate instead of the default service account,
+	// by setting spec.destination.serviceAccount
+	AllowedServiceAccounts []string `json:"allowedServiceAccounts,omitempty" protobuf:"bytes,4,rep,name=allowedServiceAccounts"`
 }
 
 // CascadedDeletion indicates if the deletion finalizer is set and controller should delete the application and it's cascaded resources
@@ -3592,6 +4638,14 @@ func (app *Application) IsRefreshRequested() (RefreshType, bool) {
 	return refreshType, true
 }
 
+// IsInteractiveRefreshRequested returns whether a refresh requested by a user is pending for an application
+func (app *Application) IsInteractiveRefreshRequested() bool {
+	if _, ok := app.IsRefreshRequested(); !ok {
+		return false
+	}
+	return app.GetAnnotations()[AnnotationKeyRefreshPriority] == string(RefreshPriorityInteractive)
+}
+
 // IsHydrateRequested returns whether hydration has been requested for an application and the type of hydration
 func (app *Application) IsHydrateRequested() (bool, HydrateType) {
 	annotations := app.GetAnnotations()
@@ -3712,6 +4766,9 @@ func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition
 	now := metav1.Now()
 	for i := 0; i < len(status.Conditions); i++ {
 		condition := status.Conditions[i]
+		if condition.IsExpired(now.Time) {
+			continue
+		}
 		if _, ok := evaluatedTypes[condition.Type]; !ok {
 			if condition.LastTransitionTime == nil {
 				condition.LastTransitionTime = &now
@@ -3721,14 +4778,24 @@ func (status *ApplicationStatus) SetConditions(conditions []ApplicationCondition
 	}
 	for i := range conditions {
 		condition := conditions[i]
+		if condition.IsExpired(now.Time) {
+			continue
+		}
 		if condition.LastTransitionTime == nil {
 			condition.LastTransitionTime = &now
 		}
 		eci := findConditionIndexByType(status.Conditions, condition.Type)
 		if eci >= 0 && status.Conditions[eci].Message == condition.Message {
 			// If we already have a condition of this type, only update the timestamp if something
-			// has changed.
-			appConditions = append(appConditions, status.Conditions[eci])
+			// has changed. The severity and expiry of the condition are taken from the new condition,
+			// but the expiry is only extended once half of the remaining lifetime has passed, so that
+			// the status does not change on every reconciliation.
+			existing := status.Conditions[eci]
+			existing.Severity = condition.Severity
+			if condition.ExpiresAt == nil || existing.ExpiresAt == nil || condition.ExpiresAt.Sub(existing.ExpiresAt.Time) >= existing.ExpiresAt.Sub(now.Time) {
+				existing.ExpiresAt = condition.ExpiresAt
+			}
+			appConditions = append(appConditions, existing)
 		} else {
 			// Otherwise we use the new incoming condition with an updated timestamp:
 			appConditions = append(appConditions, condition)
@@ -3751,21 +4818,55 @@ func findConditionIndexByType(conditions []ApplicationCondition, t ApplicationCo
 	return -1
 }
 
-// GetConditions returns list of application error conditions
+// GetConditions returns list of application error conditions, excluding the expired ones
 func (status *ApplicationStatus) GetConditions(conditionTypes map[ApplicationConditionType]bool) []ApplicationCondition {
 	result := make([]ApplicationCondition, 0)
+	now := time.Now()
 	for i := range status.Conditions {
 		condition := status.Conditions[i]
-		if ok := conditionTypes[condition.Type]; ok {
+		if ok := conditionTypes[condition.Type]; ok && !condition.IsExpired(now) {
 			result = append(result, condition)
 		}
 	}
 	return result
 }
 
+// GetConditionsBySeverity returns the list of application conditions with one of the given severities, excluding the
+// expired ones
+func (status *ApplicationStatus) GetConditionsBySeverity(severities map[ApplicationConditionSeverity]bool) []ApplicationCondition {
+	result := make([]ApplicationCondition, 0)
+	now := time.Now()
+	for i := range status.Conditions {
+		condition := status.Conditions[i]
+		if ok := severities[condition.GetSeverity()]; ok && !condition.IsExpired(now) {
+			result = append(result, condition)
+		}
+	}
+	return result
+}
+
+// GetSeverity returns the severity of a condition, which defaults to the suffix of its type
+func (condition *ApplicationCondition) GetSeverity() ApplicationConditionSeverity {
+	switch {
+	case condition.Severity != "":
+		return condition.Severity
+	case strings.HasSuffix(condition.Type, "Error"):
+		return ApplicationConditionSeverityError
+	case strings.HasSuffix(condition.Type, "Warning"):
+		return ApplicationConditionSeverityWarning
+	default:
+		return ApplicationConditionSeverityInfo
+	}
+}
+
+// IsExpired returns true if a transient condition has aged out at the given time
+func (condition *ApplicationCondition) IsExpired(now time.Time) bool {
+	return condition.ExpiresAt != nil && !now.Before(condition.ExpiresAt.Time)
+}
+
 // IsError returns true if a condition indicates an error condition
 func (condition *ApplicationCondition) IsError() bool {
-	return strings.HasSuffix(condition.Type, "Error")
+	return condition.GetSeverity() == ApplicationConditionSeverityError
 }
 
 // Equals compares two instances of ApplicationSource and return true if instances are equal.
@@ -3928,10 +5029,11 @@ func SetK8SConfigDefaults(config *rest.Config) error {
 		return err
 	}
 
-	// set default tls config and remove auth/exec provides since we use it in a custom transport
+	// set default tls config and remove auth/exec provides and transport wrappers since we use them in a custom transport
 	config.TLSClientConfig = rest.TLSClientConfig{}
 	config.AuthProvider = nil
 	config.ExecProvider = nil
+	config.WrapTransport = nil
 
 	// Set server-side timeout
 	config.Timeout = K8sServerSideTimeout
@@ -3960,6 +5062,18 @@ func ParseProxyUrl(proxyUrl string) (*url.URL, error) { //nolint:revive //FIXME(
 	return u, nil
 }
 
+// CloudAuthRestConfigFunc returns a REST config for a cluster that authenticates using a cloud workload identity
+type CloudAuthRestConfigFunc func(c *Cluster, tlsClientConfig rest.TLSClientConfig) (*rest.Config, error)
+
+var cloudAuthRestConfig CloudAuthRestConfigFunc
+
+// SetCloudAuthRestConfigFunc sets the function creating the REST configs of the clusters that authenticate using a
+// cloud workload identity. The tokens are requested from the cloud providers outside of the API types. If no function is
+// set, the tokens are requested by running the argocd-k8s-auth exec provider.
+func SetCloudAuthRestConfigFunc(f CloudAuthRestConfigFunc) {
+	cloudAuthRestConfig = f
+}
+
 // RawRestConfig returns a go-client REST config from cluster that might be serialized into the file using kube.WriteKubeConfig method.
 func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 	var config *rest.Config
@@ -3997,23 +5111,13 @@ func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 			CAData:     c.Config.CAData,
 		}
 		switch {
-		case c.Config.AWSAuthConfig != nil:
-			args := []string{"aws", "--cluster-name", c.Config.AWSAuthConfig.ClusterName}
-			if c.Config.AWSAuthConfig.RoleARN != "" {
-				args = append(args, "--role-arn", c.Config.AWSAuthConfig.RoleARN)
-			}
-			if c.Config.AWSAuthConfig.Profile != "" {
-				args = append(args, "--profile", c.Config.AWSAuthConfig.Profile)
-			}
+		case c.Config.usesCloudAuth() && cloudAuthRestConfig != nil:
+			config, err = cloudAuthRestConfig(c, tlsClientConfig)
+		case c.Config.usesCloudAuth():
 			config = &rest.Config{
 				Host:            c.Server,
 				TLSClientConfig: tlsClientConfig,
-				ExecProvider: &api.ExecConfig{
-					APIVersion:      "client.authentication.k8s.io/v1beta1",
-					Command:         "argocd-k8s-auth",
-					Args:            args,
-					InteractiveMode: api.NeverExecInteractiveMode,
-				},
+				ExecProvider:    c.Config.cloudAuthExecConfig(),
 			}
 		case c.Config.ExecProviderConfig != nil:
 			var env []api.ExecEnvVar
@@ -4075,6 +5179,42 @@ func (c *Cluster) RawRestConfig() (*rest.Config, error) {
 	return config, nil
 }
 
+// usesCloudAuth returns whether the cluster authenticates using a cloud workload identity.
+func (c *ClusterConfig) usesCloudAuth() bool {
+	return c.AWSAuthConfig != nil || c.GCPAuthConfig != nil || c.AzureAuthConfig != nil
+}
+
+// cloudAuthExecConfig returns the argocd-k8s-auth exec provider requesting the tokens of a cluster that authenticates
+// using a cloud workload identity.
+func (c *ClusterConfig) cloudAuthExecConfig() *api.ExecConfig {
+	var args []string
+	var env []api.ExecEnvVar
+	switch {
+	case c.AWSAuthConfig != nil:
+		args = []string{"aws", "--cluster-name", c.AWSAuthConfig.ClusterName}
+		if c.AWSAuthConfig.RoleARN != "" {
+			args = append(args, "--role-arn", c.AWSAuthConfig.RoleARN)
+		}
+		if c.AWSAuthConfig.Profile != "" {
+			args = append(args, "--profile", c.AWSAuthConfig.Profile)
+		}
+	case c.GCPAuthConfig != nil:
+		args = []string{"gcp"}
+	default:
+		args = []string{"azure"}
+		if c.AzureAuthConfig.ServerApplicationID != "" {
+			env = append(env, api.ExecEnvVar{Name: "AAD_SERVER_APPLICATION_ID", Value: c.AzureAuthConfig.ServerApplicationID})
+		}
+	}
+	return &api.ExecConfig{
+		APIVersion:      "client.authentication.k8s.io/v1beta1",
+		Command:         "argocd-k8s-auth",
+		Args:            args,
+		Env:             env,
+		InteractiveMode: api.NeverExecInteractiveMode,
+	}
+}
+
 // RESTConfig returns a go-client REST config from cluster with tuned throttling and HTTP client settings.
 func (c *Cluster) RESTConfig() (*rest.Config, error) {
 	config, err := c.RawRestConfig()
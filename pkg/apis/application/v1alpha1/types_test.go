@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strconv"
 	"testing"
 	"time"
 
@@ -13,12 +14,16 @@ import (
 
 	argocdcommon "github.com/argoproj/argo-cd/v3/common"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
 )
 
 func TestAppProject_IsSourcePermitted(t *testing.T) {
@@ -2429,9 +2434,9 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false))
+				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false, false))
 			case "noError":
-				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false))
+				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, false, false))
 				require.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
@@ -2499,7 +2504,7 @@ func TestAppProject_EffectiveSourceIntegrity(t *testing.T) {
 
 func TestAppProjectSpecWindowWithDescription(t *testing.T) {
 	proj := newTestProjectWithSyncWindows()
-	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA", false))
+	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA", false, false))
 	require.Equal(t, "Ticket AAAAA", proj.Spec.SyncWindows[1].Description)
 
 	require.NoError(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", "Ticket BBBBB"))
@@ -3340,6 +3345,126 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		require.Error(t, window.Validate())
 	})
+	t.Run("RequireApprovalOnAllowWindow", func(t *testing.T) {
+		window := &SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", RequireApproval: true}
+		require.EqualError(t, window.Validate(), "approval can only be required by deny windows")
+		window.Kind = "deny"
+		require.NoError(t, window.Validate())
+	})
+}
+
+func TestSyncWindow_NextTransition(t *testing.T) {
+	day := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		window   SyncWindow
+		now      time.Time
+		expected time.Time
+	}{
+		{"BeforeWindow", SyncWindow{Schedule: "0 10 * * *", Duration: "2h"}, day(9, 0), day(10, 0)},
+		{"DuringWindow", SyncWindow{Schedule: "0 10 * * *", Duration: "2h"}, day(11, 0), day(12, 0)},
+		{"AfterWindow", SyncWindow{Schedule: "0 10 * * *", Duration: "2h"}, day(13, 0), day(10, 0).AddDate(0, 0, 1)},
+		{"ContiguousOccurrences", SyncWindow{Schedule: "0 10,11 * * *", Duration: "1h"}, day(10, 30), day(12, 0)},
+		{"AlwaysActive", SyncWindow{Schedule: "* * * * *", Duration: "1h"}, day(10, 30), time.Time{}},
+		{"TimeZone", SyncWindow{Schedule: "0 10 * * *", Duration: "1h", TimeZone: "Asia/Tokyo"}, day(0, 30), day(1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transition, err := tt.window.nextTransition(tt.now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, transition.UTC())
+		})
+	}
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		_, err := SyncWindow{Schedule: "* * *", Duration: "1h"}.nextTransition(day(0, 0))
+		require.Error(t, err)
+	})
+}
+
+func TestSyncWindows_Unlocked(t *testing.T) {
+	gated := &SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, RequireApproval: true}
+	other := &SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "2h", Applications: []string{"*"}}
+	hash, err := gated.HashIdentity()
+	require.NoError(t, err)
+	now := time.Now()
+	unlock := SyncWindowUnlock{WindowHash: strconv.FormatUint(hash, 10), ExpiresAt: metav1.NewTime(now.Add(time.Hour))}
+
+	t.Run("NoUnlocks", func(t *testing.T) {
+		windows := &SyncWindows{gated, other}
+		assert.Equal(t, windows, windows.Unlocked(nil, now))
+	})
+	t.Run("Unlocked", func(t *testing.T) {
+		windows := &SyncWindows{gated, other}
+		assert.Equal(t, &SyncWindows{other}, windows.Unlocked([]SyncWindowUnlock{unlock}, now))
+	})
+	t.Run("AllUnlocked", func(t *testing.T) {
+		windows := &SyncWindows{gated}
+		assert.Nil(t, windows.Unlocked([]SyncWindowUnlock{unlock}, now))
+	})
+	t.Run("Expired", func(t *testing.T) {
+		windows := &SyncWindows{gated, other}
+		assert.Equal(t, windows, windows.Unlocked([]SyncWindowUnlock{unlock}, now.Add(2*time.Hour)))
+	})
+	t.Run("WindowNotRequiringApproval", func(t *testing.T) {
+		otherHash, err := other.HashIdentity()
+		require.NoError(t, err)
+		otherUnlock := SyncWindowUnlock{WindowHash: strconv.FormatUint(otherHash, 10), ExpiresAt: metav1.NewTime(now.Add(time.Hour))}
+		windows := &SyncWindows{other}
+		assert.Equal(t, windows, windows.Unlocked([]SyncWindowUnlock{otherUnlock}, now))
+	})
+}
+
+func TestAppProject_UnlockSyncWindow(t *testing.T) {
+	newProject := func() *AppProject {
+		proj := newTestProject()
+		proj.Spec.SyncWindows = SyncWindows{
+			{Kind: "deny", Schedule: "* * * * *", Duration: "2h", Applications: []string{"*"}, RequireApproval: true},
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}},
+		}
+		return proj
+	}
+	t.Run("Unlocks", func(t *testing.T) {
+		proj := newProject()
+		app := newTestApp()
+		canSync, err := proj.MatchingSyncWindows(app).CanSync(false, nil)
+		require.NoError(t, err)
+		assert.False(t, canSync)
+
+		unlock, err := proj.UnlockSyncWindow(0, 0, "admin", "CHG-1234")
+		require.NoError(t, err)
+		assert.Equal(t, "admin", unlock.UnlockedBy)
+		assert.Equal(t, "CHG-1234", unlock.Reason)
+		assert.WithinDuration(t, time.Now().Add(2*time.Hour), unlock.ExpiresAt.Time, time.Minute)
+		assert.Equal(t, []SyncWindowUnlock{*unlock}, proj.Status.SyncWindowUnlocks)
+
+		assert.Equal(t, &SyncWindows{proj.Spec.SyncWindows[1]}, proj.MatchingSyncWindows(app))
+	})
+	t.Run("Duration", func(t *testing.T) {
+		proj := newProject()
+		unlock, err := proj.UnlockSyncWindow(0, 10*time.Minute, "admin", "")
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), unlock.ExpiresAt.Time, time.Minute)
+	})
+	t.Run("RemovesExpiredUnlocks", func(t *testing.T) {
+		proj := newProject()
+		proj.Status.SyncWindowUnlocks = []SyncWindowUnlock{{WindowHash: "1", ExpiresAt: metav1.NewTime(time.Now().Add(-time.Minute))}}
+		unlock, err := proj.UnlockSyncWindow(0, 0, "admin", "")
+		require.NoError(t, err)
+		assert.Equal(t, []SyncWindowUnlock{*unlock}, proj.Status.SyncWindowUnlocks)
+	})
+	t.Run("WindowNotFound", func(t *testing.T) {
+		proj := newProject()
+		_, err := proj.UnlockSyncWindow(2, 0, "admin", "")
+		require.EqualError(t, err, "window with id '2' not found")
+	})
+	t.Run("WindowNotRequiringApproval", func(t *testing.T) {
+		proj := newProject()
+		_, err := proj.UnlockSyncWindow(1, 0, "admin", "")
+		require.EqualError(t, err, "window with id '1' does not require an approval")
+		assert.Empty(t, proj.Status.SyncWindowUnlocks)
+	})
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -3353,6 +3478,80 @@ func TestApplicationStatus_GetConditions(t *testing.T) {
 		ApplicationConditionInvalidSpecError: true,
 	})
 	assert.Equal(t, []ApplicationCondition{{Type: ApplicationConditionInvalidSpecError}}, conditions)
+
+	status.Conditions[0].ExpiresAt = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	conditions = status.GetConditions(map[ApplicationConditionType]bool{
+		ApplicationConditionInvalidSpecError: true,
+	})
+	assert.Empty(t, conditions)
+}
+
+func TestApplicationStatus_GetConditionsBySeverity(t *testing.T) {
+	status := ApplicationStatus{
+		Conditions: []ApplicationCondition{
+			{Type: ApplicationConditionInvalidSpecError},
+			{Type: ApplicationConditionRepeatedResourceWarning},
+			{Type: ApplicationConditionSharedResourceWarning, Severity: ApplicationConditionSeverityError},
+			{Type: ApplicationConditionOrphanedResourceWarning, ExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)}},
+		},
+	}
+	conditions := status.GetConditionsBySeverity(map[ApplicationConditionSeverity]bool{ApplicationConditionSeverityError: true})
+	assert.Equal(t, []ApplicationCondition{status.Conditions[0], status.Conditions[2]}, conditions)
+	conditions = status.GetConditionsBySeverity(map[ApplicationConditionSeverity]bool{ApplicationConditionSeverityWarning: true})
+	assert.Equal(t, []ApplicationCondition{status.Conditions[1]}, conditions)
+	assert.Empty(t, status.GetConditionsBySeverity(map[ApplicationConditionSeverity]bool{ApplicationConditionSeverityInfo: true}))
+}
+
+func TestApplicationCondition_GetSeverity(t *testing.T) {
+	assert.Equal(t, ApplicationConditionSeverityError, (&ApplicationCondition{Type: ApplicationConditionSyncError}).GetSeverity())
+	assert.Equal(t, ApplicationConditionSeverityWarning, (&ApplicationCondition{Type: ApplicationConditionSharedResourceWarning}).GetSeverity())
+	assert.Equal(t, ApplicationConditionSeverityInfo, (&ApplicationCondition{Type: "Custom"}).GetSeverity())
+	assert.Equal(t, ApplicationConditionSeverityInfo, (&ApplicationCondition{Type: ApplicationConditionSyncError, Severity: ApplicationConditionSeverityInfo}).GetSeverity())
+	assert.False(t, (&ApplicationCondition{Type: ApplicationConditionSyncError, Severity: ApplicationConditionSeverityWarning}).IsError())
+}
+
+func TestParseApplicationConditionSeverity(t *testing.T) {
+	severity, err := ParseApplicationConditionSeverity("warning")
+	require.NoError(t, err)
+	assert.Equal(t, ApplicationConditionSeverityWarning, severity)
+	_, err = ParseApplicationConditionSeverity("fatal")
+	require.ErrorContains(t, err, "unknown condition severity 'fatal'")
+}
+
+func TestSetConditions_Expiry(t *testing.T) {
+	expired := &metav1.Time{Time: time.Now().Add(-time.Minute)}
+	later := &metav1.Time{Time: time.Now().Add(time.Hour)}
+	status := ApplicationStatus{
+		Conditions: []ApplicationCondition{
+			{Type: ApplicationConditionSharedResourceWarning, Message: "foo", ExpiresAt: expired},
+			{Type: ApplicationConditionOrphanedResourceWarning, Message: "bar", ExpiresAt: expired},
+		},
+	}
+	status.SetConditions([]ApplicationCondition{
+		{Type: ApplicationConditionOrphanedResourceWarning, Message: "bar", ExpiresAt: later, Severity: ApplicationConditionSeverityInfo},
+		{Type: ApplicationConditionRepeatedResourceWarning, Message: "baz", ExpiresAt: expired},
+	}, map[ApplicationConditionType]bool{
+		ApplicationConditionOrphanedResourceWarning: true,
+		ApplicationConditionRepeatedResourceWarning: true,
+	})
+	// the expired condition which is not evaluated ages out, the re-set condition is extended
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, ApplicationConditionOrphanedResourceWarning, status.Conditions[0].Type)
+	assert.Equal(t, later, status.Conditions[0].ExpiresAt)
+	assert.Equal(t, ApplicationConditionSeverityInfo, status.Conditions[0].Severity)
+
+	// the expiry is only extended once half of the remaining lifetime has passed
+	status.SetConditions([]ApplicationCondition{
+		{Type: ApplicationConditionOrphanedResourceWarning, Message: "bar", ExpiresAt: &metav1.Time{Time: later.Add(time.Minute)}},
+	}, map[ApplicationConditionType]bool{ApplicationConditionOrphanedResourceWarning: true})
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, later, status.Conditions[0].ExpiresAt)
+	evenLater := &metav1.Time{Time: later.Add(time.Hour)}
+	status.SetConditions([]ApplicationCondition{
+		{Type: ApplicationConditionOrphanedResourceWarning, Message: "bar", ExpiresAt: evenLater},
+	}, map[ApplicationConditionType]bool{ApplicationConditionOrphanedResourceWarning: true})
+	require.Len(t, status.Conditions, 1)
+	assert.Equal(t, evenLater, status.Conditions[0].ExpiresAt)
 }
 
 type projectBuilder struct {
@@ -3651,6 +3850,78 @@ func TestSyncPolicy_IsZero(t *testing.T) {
 	assert.False(t, (&SyncPolicy{Automated: &SyncPolicyAutomated{}}).IsZero())
 	assert.False(t, (&SyncPolicy{SyncOptions: SyncOptions{""}}).IsZero())
 	assert.False(t, (&SyncPolicy{Retry: &RetryStrategy{}}).IsZero())
+	assert.False(t, (&SyncPolicy{Scheduled: &SyncPolicyScheduled{}}).IsZero())
+}
+
+func TestSyncPolicyScheduled(t *testing.T) {
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, (&SyncPolicyScheduled{Schedule: "0 2 * * *"}).Validate())
+		require.NoError(t, (&SyncPolicyScheduled{Schedule: "0 2 * * *", TimeZone: "Europe/Paris"}).Validate())
+		require.ErrorContains(t, (&SyncPolicyScheduled{Schedule: "0 2 * *"}).Validate(), "cannot parse schedule")
+		require.ErrorContains(t, (&SyncPolicyScheduled{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}).Validate(), "cannot load time zone")
+	})
+	t.Run("Next", func(t *testing.T) {
+		now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+		next, err := (&SyncPolicyScheduled{Schedule: "0 2 * * *"}).Next(now)
+		require.NoError(t, err)
+		assert.True(t, next.Equal(time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)))
+
+		// 2am in Tokyo is 5pm UTC on the previous day
+		next, err = (&SyncPolicyScheduled{Schedule: "0 2 * * *", TimeZone: "Asia/Tokyo"}).Next(now)
+		require.NoError(t, err)
+		assert.True(t, next.Equal(time.Date(2026, 1, 15, 17, 0, 0, 0, time.UTC)))
+	})
+	t.Run("IsScheduledAt", func(t *testing.T) {
+		scheduled := &SyncPolicyScheduled{Schedule: "0 2 * * *"}
+		assert.True(t, scheduled.IsScheduledAt(time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)))
+		assert.False(t, scheduled.IsScheduledAt(time.Date(2026, 1, 16, 3, 0, 0, 0, time.UTC)))
+		assert.False(t, (&SyncPolicyScheduled{Schedule: "invalid"}).IsScheduledAt(time.Date(2026, 1, 16, 2, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestImageUpdatePolicy_Validate(t *testing.T) {
+	valid := func(images ...ImageUpdate) *ImageUpdatePolicy { return &ImageUpdatePolicy{Images: images} }
+	require.NoError(t, valid(ImageUpdate{Image: "ghcr.io/example/app", Constraint: "^1.2"}, ImageUpdate{Image: "registry:5000/other", Strategy: ImageUpdateStrategyLatest, Constraint: `^main-\d+$`}).Validate())
+	require.NoError(t, valid(ImageUpdate{Image: "ghcr.io/example/app", Strategy: ImageUpdateStrategyDigest, Constraint: "stable"}).Validate())
+	require.ErrorContains(t, valid().Validate(), "at least one image is required")
+	require.ErrorContains(t, valid(ImageUpdate{Image: "ghcr.io/example/app:1.0"}).Validate(), "must not contain a tag or digest")
+	require.ErrorContains(t, valid(ImageUpdate{Image: "ghcr.io/example/app"}, ImageUpdate{Image: "ghcr.io/example/app"}).Validate(), "listed more than once")
+	require.ErrorContains(t, valid(ImageUpdate{Image: "ghcr.io/example/app", Constraint: "not a constraint"}).Validate(), "invalid semver constraint")
+	require.ErrorContains(t, valid(ImageUpdate{Image: "ghcr.io/example/app", Strategy: ImageUpdateStrategyLatest, Constraint: "("}).Validate(), "invalid tag pattern")
+	require.ErrorContains(t, valid(ImageUpdate{Image: "ghcr.io/example/app", Strategy: "newest"}).Validate(), "unknown update strategy")
+	require.ErrorContains(t, (&ImageUpdatePolicy{Images: []ImageUpdate{{Image: "ghcr.io/example/app"}}, WriteBack: "s3"}).Validate(), "unknown write-back method")
+}
+
+func TestSelfHealBackoff_Delay(t *testing.T) {
+	defaults := &SelfHealBackoff{}
+	for attempts, expected := range []time.Duration{0, 2 * time.Second, 6 * time.Second, 18 * time.Second, 54 * time.Second, 162 * time.Second, 5 * time.Minute} {
+		delay, err := defaults.Delay(int64(attempts))
+		require.NoError(t, err)
+		assert.Equal(t, expected, delay)
+	}
+
+	custom := &SelfHealBackoff{Duration: "10s", Factor: new(int64(2)), MaxDuration: "30s"}
+	for attempts, expected := range []time.Duration{0, 10 * time.Second, 20 * time.Second, 30 * time.Second} {
+		delay, err := custom.Delay(int64(attempts))
+		require.NoError(t, err)
+		assert.Equal(t, expected, delay)
+	}
+
+	_, err := (&SelfHealBackoff{Duration: "soon"}).Delay(1)
+	require.Error(t, err)
+}
+
+func TestSelfHealBackoff_CooldownDuration(t *testing.T) {
+	cooldown, err := (&SelfHealBackoff{}).CooldownDuration()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultSelfHealBackoffCooldown, cooldown)
+
+	cooldown, err = (&SelfHealBackoff{Cooldown: "10m"}).CooldownDuration()
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, cooldown)
+
+	_, err = (&SelfHealBackoff{Cooldown: "later"}).CooldownDuration()
+	require.Error(t, err)
 }
 
 func TestSyncOptions_HasOption(t *testing.T) {
@@ -3672,6 +3943,28 @@ func TestSyncOptions_RemoveOption(t *testing.T) {
 	assert.Empty(t, options.RemoveOption("a=1").RemoveOption("a=1"))
 }
 
+func TestSyncOptions_GetServerSideApplyManager(t *testing.T) {
+	var nilOptions SyncOptions
+	assert.Equal(t, "argocd-controller", nilOptions.GetServerSideApplyManager())
+	assert.Equal(t, "argocd-controller", SyncOptions{"ServerSideApplyManager="}.GetServerSideApplyManager())
+	assert.Equal(t, "my-manager", SyncOptions{"ServerSideApply=true", "ServerSideApplyManager=my-manager"}.GetServerSideApplyManager())
+}
+
+func TestResourceActionParam_ValidateValue(t *testing.T) {
+	require.NoError(t, ResourceActionParam{Name: "message"}.ValidateValue("anything"))
+	require.NoError(t, ResourceActionParam{Name: "message", Type: ResourceActionParamTypeString}.ValidateValue("anything"))
+
+	replicas := ResourceActionParam{Name: "replicas", Type: ResourceActionParamTypeInt}
+	require.NoError(t, replicas.ValidateValue("3"))
+	require.EqualError(t, replicas.ValidateValue("three"), `parameter "replicas" must be an integer, got "three"`)
+
+	strategy := ResourceActionParam{Name: "strategy", Type: ResourceActionParamTypeEnum, Options: []string{"Recreate", "RollingUpdate"}}
+	require.NoError(t, strategy.ValidateValue("Recreate"))
+	require.EqualError(t, strategy.ValidateValue("BlueGreen"), `parameter "strategy" must be one of Recreate, RollingUpdate, got "BlueGreen"`)
+
+	require.NoError(t, ResourceActionParam{Name: "ratio", Type: "number"}.ValidateValue("0.5"))
+}
+
 func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(1))
 	assert.Len(t, RevisionHistories{{}}.Trunc(1), 1)
@@ -4909,6 +5202,304 @@ func TestAppProject_ValidateDestinationServiceAccount(t *testing.T) {
 	}
 }
 
+func TestAppProject_ValidateDestinationServiceAccount_AllowedServiceAccounts(t *testing.T) {
+	testData := []struct {
+		allowedServiceAccounts []string
+		expectedErrMsg         string
+	}{
+		{allowedServiceAccounts: []string{"deployer-*", "other-ns:admin-sa", "team-[ab]"}},
+		{allowedServiceAccounts: []string{""}, expectedErrMsg: "allowedServiceAccounts has an invalid format, ''"},
+		{allowedServiceAccounts: []string{"  "}, expectedErrMsg: "allowedServiceAccounts has an invalid format, '  '"},
+		{allowedServiceAccounts: []string{"!admin-sa"}, expectedErrMsg: "allowedServiceAccounts has an invalid format, '!admin-sa'"},
+		{allowedServiceAccounts: []string{"test/sa"}, expectedErrMsg: "allowedServiceAccounts has an invalid format, 'test/sa'"},
+		{allowedServiceAccounts: []string{"[[ech*"}, expectedErrMsg: "allowedServiceAccounts has an invalid format, '[[ech*'"},
+	}
+	for _, data := range testData {
+		proj := AppProject{
+			Spec: AppProjectSpec{
+				DestinationServiceAccounts: []ApplicationDestinationServiceAccount{
+					{
+						Server:                 "https://192.168.99.100:8443",
+						Namespace:              "test-ns",
+						DefaultServiceAccount:  "test-sa",
+						AllowedServiceAccounts: data.allowedServiceAccounts,
+					},
+				},
+			},
+		}
+		err := proj.ValidateProject()
+		if data.expectedErrMsg == "" {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, data.expectedErrMsg)
+		}
+	}
+}
+
+func TestAppProject_ValidateOperationWebhooks(t *testing.T) {
+	testData := []struct {
+		name           string
+		webhooks       []OperationWebhook
+		expectedErrMsg string
+	}{
+		{
+			name:     "valid",
+			webhooks: []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com", Secret: "$webhook.operation.tracker"}, {Name: "cmdb", URL: "http://cmdb.local/hooks"}},
+		},
+		{
+			name:           "empty name",
+			webhooks:       []OperationWebhook{{URL: "https://tracker.example.com"}},
+			expectedErrMsg: "operation webhook name cannot be empty",
+		},
+		{
+			name:           "duplicate name",
+			webhooks:       []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com"}, {Name: "tracker", URL: "https://other.example.com"}},
+			expectedErrMsg: "operation webhook 'tracker' already added",
+		},
+		{
+			name:           "invalid URL",
+			webhooks:       []OperationWebhook{{Name: "tracker", URL: "ftp://tracker.example.com"}},
+			expectedErrMsg: "operation webhook 'tracker' has an invalid URL, 'ftp://tracker.example.com'",
+		},
+		{
+			name:           "plain text secret",
+			webhooks:       []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com", Secret: "plain"}},
+			expectedErrMsg: "operation webhook 'tracker' secret must reference a key of the argocd-secret starting with 'webhook.operation.', in the form of $webhook.operation.<key>",
+		},
+		{
+			name:           "secret without operation webhook prefix",
+			webhooks:       []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com", Secret: "$server.secretkey"}},
+			expectedErrMsg: "operation webhook 'tracker' secret must reference a key of the argocd-secret starting with 'webhook.operation.'",
+		},
+		{
+			name:           "secret with only the operation webhook prefix",
+			webhooks:       []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com", Secret: "$webhook.operation."}},
+			expectedErrMsg: "operation webhook 'tracker' secret must reference a key of the argocd-secret starting with 'webhook.operation.'",
+		},
+	}
+	for _, data := range testData {
+		t.Run(data.name, func(t *testing.T) {
+			proj := newTestProject()
+			proj.Spec.OperationWebhooks = data.webhooks
+			err := proj.ValidateProject()
+			if data.expectedErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, data.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func TestAppProject_ValidateOperationWebhookURLs(t *testing.T) {
+	proj := newTestProject()
+	require.NoError(t, proj.ValidateOperationWebhookURLs(nil))
+
+	proj.Spec.OperationWebhooks = []OperationWebhook{{Name: "tracker", URL: "https://tracker.example.com/deployments"}}
+	require.NoError(t, proj.ValidateOperationWebhookURLs([]string{"https://tracker.example.com/*"}))
+	require.ErrorContains(t, proj.ValidateOperationWebhookURLs(nil), "operation webhook 'tracker' URL 'https://tracker.example.com/deployments' is not permitted")
+	require.ErrorContains(t, proj.ValidateOperationWebhookURLs([]string{"https://cmdb.example.com/*"}), "is not permitted")
+}
+
+func TestOperationWebhook_IsCalledFor(t *testing.T) {
+	webhook := OperationWebhook{}
+	assert.True(t, webhook.IsCalledFor(common.OperationSucceeded))
+	assert.True(t, webhook.IsCalledFor(common.OperationFailed))
+	assert.True(t, webhook.IsCalledFor(common.OperationError))
+	assert.False(t, webhook.IsCalledFor(common.OperationRunning))
+
+	webhook.Phases = []common.OperationPhase{common.OperationRunning}
+	assert.True(t, webhook.IsCalledFor(common.OperationRunning))
+	assert.False(t, webhook.IsCalledFor(common.OperationSucceeded))
+}
+
+func TestAppProject_ValidateReconcileRateLimit(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.ReconcileRateLimit = &ReconcileRateLimit{PerMinute: 30}
+	require.NoError(t, proj.ValidateProject())
+	assert.Equal(t, 30, proj.Spec.ReconcileRateLimit.GetBurst())
+
+	proj.Spec.ReconcileRateLimit.Burst = 5
+	require.NoError(t, proj.ValidateProject())
+	assert.Equal(t, 5, proj.Spec.ReconcileRateLimit.GetBurst())
+
+	proj.Spec.ReconcileRateLimit.Burst = -1
+	require.ErrorContains(t, proj.ValidateProject(), "reconcile rate limit burst cannot be negative")
+
+	proj.Spec.ReconcileRateLimit = &ReconcileRateLimit{}
+	require.ErrorContains(t, proj.ValidateProject(), "reconcile rate limit must allow at least one refresh per minute")
+}
+
+func TestAppProject_ValidateAPIRateLimits(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.APIRateLimits = &ProjectAPIRateLimits{Sync: &APIRateLimit{PerMinute: 10}, Get: &APIRateLimit{PerMinute: 600, Burst: 100}}
+	require.NoError(t, proj.ValidateProject())
+	assert.Equal(t, 10, proj.Spec.APIRateLimits.Sync.GetBurst())
+	assert.Equal(t, 100, proj.Spec.APIRateLimits.Get.GetBurst())
+
+	proj.Spec.APIRateLimits.Get.Burst = -1
+	require.ErrorContains(t, proj.ValidateProject(), "get API rate limit burst cannot be negative")
+
+	proj.Spec.APIRateLimits.Refresh = &APIRateLimit{}
+	require.ErrorContains(t, proj.ValidateProject(), "refresh API rate limit must allow at least one request per minute")
+}
+
+func TestAppProject_ValidateMinSyncInterval(t *testing.T) {
+	proj := newTestProject()
+	assert.Equal(t, time.Duration(0), proj.GetMinSyncInterval())
+
+	proj.Spec.MinSyncInterval = &metav1.Duration{Duration: 5 * time.Minute}
+	require.NoError(t, proj.ValidateProject())
+	assert.Equal(t, 5*time.Minute, proj.GetMinSyncInterval())
+
+	proj.Spec.MinSyncInterval = &metav1.Duration{Duration: -time.Minute}
+	require.ErrorContains(t, proj.ValidateProject(), "min sync interval cannot be negative")
+	assert.Equal(t, time.Duration(0), proj.GetMinSyncInterval())
+}
+
+func TestAppProject_GetRefreshInterval(t *testing.T) {
+	proj := newTestProject()
+	assert.Equal(t, time.Duration(0), proj.GetRefreshInterval(nil))
+
+	syncPolicy := &SyncPolicy{RefreshInterval: &metav1.Duration{Duration: time.Hour}}
+	assert.False(t, syncPolicy.IsZero())
+	assert.Equal(t, time.Hour, proj.GetRefreshInterval(syncPolicy))
+
+	proj.Spec.MaxRefreshInterval = &metav1.Duration{Duration: 30 * time.Minute}
+	require.NoError(t, proj.ValidateProject())
+	assert.Equal(t, 30*time.Minute, proj.GetRefreshInterval(syncPolicy))
+	assert.Equal(t, time.Duration(0), proj.GetRefreshInterval(&SyncPolicy{}))
+
+	proj.Spec.MaxRefreshInterval = &metav1.Duration{Duration: -time.Minute}
+	require.ErrorContains(t, proj.ValidateProject(), "max refresh interval cannot be negative")
+}
+
+func TestAppProject_ValidateHealthRollupRules(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.HealthRollupRules = []HealthRollupRule{
+		{Group: "batch", Kind: "Job", Action: HealthRollupActionIgnore},
+		{Group: "policy", Kind: "PodDisruptionBudget", Action: HealthRollupActionWarn},
+		{Group: "*", Kind: "*", MaxStatus: health.HealthStatusProgressing},
+	}
+	require.NoError(t, proj.ValidateProject())
+
+	tests := []struct {
+		rule          HealthRollupRule
+		expectedError string
+	}{
+		{HealthRollupRule{Group: "batch"}, "kind is required"},
+		{HealthRollupRule{Kind: "[Job"}, "invalid pattern '[Job'"},
+		{HealthRollupRule{Kind: "Job", Action: "Drop"}, "unknown action 'Drop'"},
+		{HealthRollupRule{Kind: "Job", MaxStatus: "Broken"}, "unknown max status 'Broken'"},
+		{HealthRollupRule{Kind: "Job", Action: HealthRollupActionWarn, MaxStatus: health.HealthStatusDegraded}, "max status cannot be set with the Warn action"},
+	}
+	for _, tt := range tests {
+		proj.Spec.HealthRollupRules = []HealthRollupRule{tt.rule}
+		require.ErrorContains(t, proj.ValidateProject(), "invalid health roll-up rule: "+tt.expectedError)
+	}
+}
+
+func TestGetHealthRollupRule(t *testing.T) {
+	app := &Application{Spec: ApplicationSpec{HealthRollupRules: []HealthRollupRule{
+		{Group: "batch", Kind: "Job", MaxStatus: health.HealthStatusProgressing},
+	}}}
+	proj := newTestProject()
+	proj.Spec.HealthRollupRules = []HealthRollupRule{
+		{Group: "batch", Kind: "*", Action: HealthRollupActionIgnore},
+		{Group: "policy", Kind: "PodDisruptionBudget", Action: HealthRollupActionWarn},
+	}
+
+	assert.Equal(t, &app.Spec.HealthRollupRules[0], GetHealthRollupRule(app, proj, "batch", "Job"))
+	assert.Equal(t, &proj.Spec.HealthRollupRules[0], GetHealthRollupRule(app, proj, "batch", "CronJob"))
+	assert.Equal(t, &proj.Spec.HealthRollupRules[1], GetHealthRollupRule(app, proj, "policy", "PodDisruptionBudget"))
+	assert.Nil(t, GetHealthRollupRule(app, proj, "apps", "Deployment"))
+	assert.Nil(t, GetHealthRollupRule(&Application{}, nil, "batch", "Job"))
+}
+
+func TestAppProject_ValidateIgnoreDifferencesRef(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.IgnoreDifferencesRef = &ConfigMapKeyRef{ConfigMapName: "platform-ignore-differences", Key: "ignoreDifferences"}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.IgnoreDifferencesRef.Key = ""
+	require.ErrorContains(t, proj.ValidateProject(), "ignore differences reference must specify a config map name and a key")
+
+	proj.Spec.IgnoreDifferencesRef = &ConfigMapKeyRef{Key: "ignoreDifferences"}
+	require.ErrorContains(t, proj.ValidateProject(), "ignore differences reference must specify a config map name and a key")
+}
+
+func TestAppProject_ValidateManagedFieldsManagers(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.ManagedFieldsManagers = []string{"kube-controller-manager"}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.ManagedFieldsManagers = append(proj.Spec.ManagedFieldsManagers, "")
+	require.ErrorContains(t, proj.ValidateProject(), "managed fields manager cannot be empty")
+}
+
+func TestAppProject_ValidateDriftExclusions(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.DriftExclusions = []DriftExclusion{{Group: "apps", Kind: "Deployment", Expression: "true"}}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.DriftExclusions[0].Expression = ""
+	require.ErrorContains(t, proj.ValidateProject(), "drift exclusion must specify a kind and an expression")
+}
+
+func TestAppProject_ValidateNamespaceCreationPolicy(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.NamespaceCreationPolicy = &NamespaceCreationPolicy{Mode: NamespaceCreationModeDeny}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.NamespaceCreationPolicy.Labels = map[string]string{"team": "team1"}
+	require.ErrorContains(t, proj.ValidateProject(), "namespace creation policy labels and annotations require the mode 'allowWithRequiredLabels'")
+
+	proj.Spec.NamespaceCreationPolicy.Mode = NamespaceCreationModeAllowWithRequiredLabels
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.NamespaceCreationPolicy.Labels = nil
+	require.ErrorContains(t, proj.ValidateProject(), "must specify labels or annotations")
+
+	proj.Spec.NamespaceCreationPolicy.Mode = "forbid"
+	require.ErrorContains(t, proj.ValidateProject(), "namespace creation policy has an invalid mode 'forbid'")
+}
+
+func TestAppProject_IsNamespaceCreationPermitted(t *testing.T) {
+	proj := newTestProject()
+	assert.True(t, proj.IsNamespaceCreationPermitted())
+
+	proj.Spec.NamespaceCreationPolicy = &NamespaceCreationPolicy{Mode: NamespaceCreationModeAllowWithRequiredLabels}
+	assert.True(t, proj.IsNamespaceCreationPermitted())
+
+	proj.Spec.NamespaceCreationPolicy.Mode = NamespaceCreationModeDeny
+	assert.False(t, proj.IsNamespaceCreationPermitted())
+}
+
+func TestAppProject_GetManagedNamespaceMetadata(t *testing.T) {
+	proj := newTestProject()
+	syncPolicy := &SyncPolicy{ManagedNamespaceMetadata: &ManagedNamespaceMetadata{
+		Labels:      map[string]string{"team": "app", "env": "dev"},
+		Annotations: map[string]string{"owner": "app"},
+	}}
+	assert.Same(t, syncPolicy.ManagedNamespaceMetadata, proj.GetManagedNamespaceMetadata(syncPolicy))
+	assert.Nil(t, proj.GetManagedNamespaceMetadata(nil))
+
+	proj.Spec.NamespaceCreationPolicy = &NamespaceCreationPolicy{
+		Mode:        NamespaceCreationModeAllowWithRequiredLabels,
+		Labels:      map[string]string{"team": "team1"},
+		Annotations: map[string]string{"cost-center": "1234"},
+	}
+	assert.Equal(t, &ManagedNamespaceMetadata{
+		Labels:      map[string]string{"team": "team1", "env": "dev"},
+		Annotations: map[string]string{"owner": "app", "cost-center": "1234"},
+	}, proj.GetManagedNamespaceMetadata(syncPolicy))
+	assert.Equal(t, "app", syncPolicy.ManagedNamespaceMetadata.Labels["team"])
+	assert.Equal(t, &ManagedNamespaceMetadata{
+		Labels:      map[string]string{"team": "team1"},
+		Annotations: map[string]string{"cost-center": "1234"},
+	}, proj.GetManagedNamespaceMetadata(nil))
+}
+
 func TestCluster_ParseProxyUrl(t *testing.T) {
 	testData := []struct {
 		url            string
@@ -4941,6 +5532,37 @@ func TestCluster_ParseProxyUrl(t *testing.T) {
 	}
 }
 
+func TestCluster_RawRestConfig_CloudAuth(t *testing.T) {
+	cluster := &Cluster{Server: "https://eks.example.com", Config: ClusterConfig{AWSAuthConfig: &AWSAuthConfig{ClusterName: "eks"}}}
+
+	t.Run("ExecProvider", func(t *testing.T) {
+		SetCloudAuthRestConfigFunc(nil)
+		config, err := cluster.RawRestConfig()
+		require.NoError(t, err)
+		require.NotNil(t, config.ExecProvider)
+		assert.Equal(t, "argocd-k8s-auth", config.ExecProvider.Command)
+		assert.Equal(t, []string{"aws", "--cluster-name", "eks"}, config.ExecProvider.Args)
+
+		azureCluster := &Cluster{Server: "https://aks.example.com", Config: ClusterConfig{AzureAuthConfig: &AzureAuthConfig{ServerApplicationID: "server-app"}}}
+		config, err = azureCluster.RawRestConfig()
+		require.NoError(t, err)
+		require.NotNil(t, config.ExecProvider)
+		assert.Equal(t, []string{"azure"}, config.ExecProvider.Args)
+		assert.Equal(t, []api.ExecEnvVar{{Name: "AAD_SERVER_APPLICATION_ID", Value: "server-app"}}, config.ExecProvider.Env)
+	})
+
+	t.Run("Supported", func(t *testing.T) {
+		SetCloudAuthRestConfigFunc(func(c *Cluster, tlsClientConfig rest.TLSClientConfig) (*rest.Config, error) {
+			return &rest.Config{Host: c.Server, BearerToken: "token", TLSClientConfig: tlsClientConfig}, nil
+		})
+		t.Cleanup(func() { SetCloudAuthRestConfigFunc(nil) })
+		config, err := cluster.RawRestConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "token", config.BearerToken)
+		assert.Equal(t, K8sServerSideTimeout, config.Timeout)
+	})
+}
+
 func TestSyncWindow_Hash(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -6230,3 +6852,110 @@ func TestGetDrySource_PreservesAllFields(t *testing.T) {
 		})
 	}
 }
+
+func TestAppProject_IsExecEnabled(t *testing.T) {
+	proj := newTestProject()
+	assert.True(t, proj.IsExecEnabled(true))
+	assert.False(t, proj.IsExecEnabled(false))
+	assert.False(t, proj.IsExecRecordingRequired())
+
+	proj.Spec.Exec = &ProjectExecConfig{RecordSessions: true}
+	assert.True(t, proj.IsExecEnabled(true))
+	assert.True(t, proj.IsExecRecordingRequired())
+
+	proj.Spec.Exec.Enabled = ptr.To(true)
+	assert.True(t, proj.IsExecEnabled(true))
+	assert.False(t, proj.IsExecEnabled(false))
+
+	proj.Spec.Exec.Enabled = ptr.To(false)
+	assert.False(t, proj.IsExecEnabled(true))
+}
+
+func TestAppProject_MapDestination(t *testing.T) {
+	now := time.Now()
+	proj := newTestProject()
+	proj.Spec.DestinationMappings = []DestinationMapping{
+		{Server: "https://old.example.com", TargetServer: "https://new.example.com"},
+		{Name: "old", TargetName: "new"},
+		{Name: "later", TargetName: "new", EffectiveFrom: &metav1.Time{Time: now.Add(time.Hour)}},
+	}
+
+	t.Run("ByServer", func(t *testing.T) {
+		dest := proj.MapDestination(ApplicationDestination{Server: "https://old.example.com", Namespace: "ns"}, now)
+		assert.Equal(t, ApplicationDestination{Server: "https://new.example.com", Namespace: "ns"}, dest)
+	})
+
+	t.Run("ByName", func(t *testing.T) {
+		dest := proj.MapDestination(ApplicationDestination{Name: "old", Namespace: "ns"}, now)
+		assert.Equal(t, ApplicationDestination{Name: "new", Namespace: "ns"}, dest)
+	})
+
+	t.Run("NotEffectiveYet", func(t *testing.T) {
+		dest := ApplicationDestination{Name: "later", Namespace: "ns"}
+		assert.Equal(t, dest, proj.MapDestination(dest, now))
+		assert.Equal(t, ApplicationDestination{Name: "new", Namespace: "ns"}, proj.MapDestination(dest, now.Add(2*time.Hour)))
+	})
+
+	t.Run("NoMatch", func(t *testing.T) {
+		dest := ApplicationDestination{Server: "https://other.example.com", Name: "old", Namespace: "ns"}
+		assert.Equal(t, dest, proj.MapDestination(dest, now))
+	})
+}
+
+func TestAppProject_ValidateDestinationMappings(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.DestinationMappings = []DestinationMapping{{Server: "https://old.example.com", TargetName: "new"}}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.DestinationMappings = []DestinationMapping{{Server: "https://old.example.com", Name: "old", TargetName: "new"}}
+	require.ErrorContains(t, proj.ValidateProject(), "exactly one of server or name")
+
+	proj.Spec.DestinationMappings = []DestinationMapping{{Name: "old"}}
+	require.ErrorContains(t, proj.ValidateProject(), "exactly one of targetServer or targetName")
+}
+
+func TestProjectRole_IsAddressAllowed(t *testing.T) {
+	role := ProjectRole{Name: "ci"}
+	allowed, err := role.IsAddressAllowed("203.0.113.7")
+	require.NoError(t, err)
+	assert.True(t, allowed)
+
+	role.AllowedCIDRs = []string{"10.0.0.0/8", "2001:db8::/32"}
+	for addr, expected := range map[string]bool{
+		"10.1.2.3":     true,
+		"2001:db8::1":  true,
+		"203.0.113.7":  false,
+		"":             false,
+		"not-an-ip":    false,
+		"192.168.0.10": false,
+	} {
+		allowed, err := role.IsAddressAllowed(addr)
+		require.NoError(t, err)
+		assert.Equal(t, expected, allowed, addr)
+	}
+
+	role.AllowedCIDRs = []string{"10.0.0.0"}
+	_, err = role.IsAddressAllowed("10.0.0.0")
+	require.ErrorContains(t, err, "invalid CIDR")
+}
+
+func TestAppProject_ValidateRoleSessionPolicies(t *testing.T) {
+	proj := newTestProject()
+	proj.Spec.Roles = []ProjectRole{{Name: "ci", AllowedCIDRs: []string{"10.0.0.0/8"}, MaxTokenUses: 100}}
+	require.NoError(t, proj.ValidateProject())
+
+	proj.Spec.Roles[0].AllowedCIDRs = []string{"10.0.0.1"}
+	require.ErrorContains(t, proj.ValidateProject(), "allowed CIDR '10.0.0.1' for role 'ci' is invalid")
+
+	proj.Spec.Roles[0].AllowedCIDRs = nil
+	proj.Spec.Roles[0].MaxTokenUses = -1
+	require.ErrorContains(t, proj.ValidateProject(), "must not be negative")
+}
+
+func TestResourceBudgetMatches(t *testing.T) {
+	budget := ResourceBudget{Destination: ApplicationDestination{Name: "prod-*"}}
+	assert.True(t, budget.Matches(ApplicationDestination{Server: "https://prod", Name: "prod-eu", Namespace: "default"}))
+	assert.False(t, budget.Matches(ApplicationDestination{Server: "https://staging", Name: "staging", Namespace: "default"}))
+	assert.Equal(t, "prod-*/*", budget.DestinationString())
+	assert.True(t, (&ResourceBudget{}).Matches(ApplicationDestination{Server: "https://any"}))
+}
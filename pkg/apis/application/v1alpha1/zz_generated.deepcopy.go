@@ -19,6 +19,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	common "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +27,22 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimit) DeepCopyInto(out *APIRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimit.
+func (in *APIRateLimit) DeepCopy() *APIRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSAuthConfig) DeepCopyInto(out *AWSAuthConfig) {
 	*out = *in
@@ -192,13 +209,86 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 	if in.DestinationServiceAccounts != nil {
 		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.SourceIntegrity != nil {
 		in, out := &in.SourceIntegrity, &out.SourceIntegrity
 		*out = new(SourceIntegrity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OperationWebhooks != nil {
+		in, out := &in.OperationWebhooks, &out.OperationWebhooks
+		*out = make([]OperationWebhook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ReconcileRateLimit != nil {
+		in, out := &in.ReconcileRateLimit, &out.ReconcileRateLimit
+		*out = new(ReconcileRateLimit)
+		**out = **in
+	}
+	if in.IgnoreDifferencesRef != nil {
+		in, out := &in.IgnoreDifferencesRef, &out.IgnoreDifferencesRef
+		*out = new(ConfigMapKeyRef)
+		**out = **in
+	}
+	if in.NamespaceCreationPolicy != nil {
+		in, out := &in.NamespaceCreationPolicy, &out.NamespaceCreationPolicy
+		*out = new(NamespaceCreationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(ProjectExecConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationMappings != nil {
+		in, out := &in.DestinationMappings, &out.DestinationMappings
+		*out = make([]DestinationMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinSyncInterval != nil {
+		in, out := &in.MinSyncInterval, &out.MinSyncInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRefreshInterval != nil {
+		in, out := &in.MaxRefreshInterval, &out.MaxRefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthRollupRules != nil {
+		in, out := &in.HealthRollupRules, &out.HealthRollupRules
+		*out = make([]HealthRollupRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedFieldsManagers != nil {
+		in, out := &in.ManagedFieldsManagers, &out.ManagedFieldsManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftExclusions != nil {
+		in, out := &in.DriftExclusions, &out.DriftExclusions
+		*out = make([]DriftExclusion, len(*in))
+		copy(*out, *in)
+	}
+	if in.APIRateLimits != nil {
+		in, out := &in.APIRateLimits, &out.APIRateLimits
+		*out = new(ProjectAPIRateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceBudgets != nil {
+		in, out := &in.ResourceBudgets, &out.ResourceBudgets
+		*out = make([]ResourceBudget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -222,6 +312,13 @@ func (in *AppProjectStatus) DeepCopyInto(out *AppProjectStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SyncWindowUnlocks != nil {
+		in, out := &in.SyncWindowUnlocks, &out.SyncWindowUnlocks
+		*out = make([]SyncWindowUnlock, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -275,6 +372,10 @@ func (in *ApplicationCondition) DeepCopyInto(out *ApplicationCondition) {
 		in, out := &in.LastTransitionTime, &out.LastTransitionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -307,6 +408,11 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
 	*out = *in
+	if in.AllowedServiceAccounts != nil {
+		in, out := &in.AllowedServiceAccounts, &out.AllowedServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -320,6 +426,23 @@ func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationStatus) DeepCopyInto(out *ApplicationDestinationStatus) {
+	*out = *in
+	out.Destination = in.Destination
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationStatus.
+func (in *ApplicationDestinationStatus) DeepCopy() *ApplicationDestinationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -374,6 +497,36 @@ func (in *ApplicationMatchExpression) DeepCopy() *ApplicationMatchExpression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationMetricsHistory) DeepCopyInto(out *ApplicationMetricsHistory) {
+	*out = *in
+	if in.Reconciliations != nil {
+		in, out := &in.Reconciliations, &out.Reconciliations
+		*out = make([]ReconciliationSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Syncs != nil {
+		in, out := &in.Syncs, &out.Syncs
+		*out = make([]SyncSample, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationMetricsHistory.
+func (in *ApplicationMetricsHistory) DeepCopy() *ApplicationMetricsHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationMetricsHistory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationPreservedFields) DeepCopyInto(out *ApplicationPreservedFields) {
 	*out = *in
@@ -473,6 +626,22 @@ func (in *ApplicationSetCondition) DeepCopy() *ApplicationSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetFileError) DeepCopyInto(out *ApplicationSetFileError) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetFileError.
+func (in *ApplicationSetFileError) DeepCopy() *ApplicationSetFileError {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetFileError)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 	*out = *in
@@ -802,6 +971,13 @@ func (in *ApplicationSetSpec) DeepCopyInto(out *ApplicationSetSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.TemplatePatches != nil {
+		in, out := &in.TemplatePatches, &out.TemplatePatches
+		*out = make([]ApplicationSetTemplatePatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -840,6 +1016,11 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 		}
 	}
 	in.Health.DeepCopyInto(&out.Health)
+	if in.FileErrors != nil {
+		in, out := &in.FileErrors, &out.FileErrors
+		*out = make([]ApplicationSetFileError, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -948,6 +1129,27 @@ func (in *ApplicationSetTemplateMeta) DeepCopy() *ApplicationSetTemplateMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetTemplatePatch) DeepCopyInto(out *ApplicationSetTemplatePatch) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetTemplatePatch.
+func (in *ApplicationSetTemplatePatch) DeepCopy() *ApplicationSetTemplatePatch {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetTemplatePatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTerminalGenerator) {
 	*out = *in
@@ -1400,6 +1602,21 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ApplicationDestination, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageUpdatePolicy != nil {
+		in, out := &in.ImageUpdatePolicy, &out.ImageUpdatePolicy
+		*out = new(ImageUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthRollupRules != nil {
+		in, out := &in.HealthRollupRules, &out.HealthRollupRules
+		*out = make([]HealthRollupRule, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1459,6 +1676,27 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]ApplicationDestinationStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.NextScheduledSync != nil {
+		in, out := &in.NextScheduledSync, &out.NextScheduledSync
+		*out = (*in).DeepCopy()
+	}
+	if in.SelfHeal != nil {
+		in, out := &in.SelfHeal, &out.SelfHeal
+		*out = new(SelfHealStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageUpdates != nil {
+		in, out := &in.ImageUpdates, &out.ImageUpdates
+		*out = make([]ImageUpdateStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1552,6 +1790,22 @@ func (in *ApplicationWatchEvent) DeepCopy() *ApplicationWatchEvent {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAuthConfig) DeepCopyInto(out *AzureAuthConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuthConfig.
+func (in *AzureAuthConfig) DeepCopy() *AzureAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Backoff) DeepCopyInto(out *Backoff) {
 	*out = *in
@@ -1739,6 +1993,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(ExecProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GCPAuthConfig != nil {
+		in, out := &in.GCPAuthConfig, &out.GCPAuthConfig
+		*out = new(GCPAuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureAuthConfig != nil {
+		in, out := &in.AzureAuthConfig, &out.AzureAuthConfig
+		*out = new(AzureAuthConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -1988,6 +2252,42 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DestinationMapping) DeepCopyInto(out *DestinationMapping) {
+	*out = *in
+	if in.EffectiveFrom != nil {
+		in, out := &in.EffectiveFrom, &out.EffectiveFrom
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationMapping.
+func (in *DestinationMapping) DeepCopy() *DestinationMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(DestinationMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftExclusion) DeepCopyInto(out *DriftExclusion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftExclusion.
+func (in *DriftExclusion) DeepCopy() *DriftExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
@@ -2041,6 +2341,13 @@ func (in *DuckTypeGenerator) DeepCopyInto(out *DuckTypeGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.JSONPathParameters != nil {
+		in, out := &in.JSONPathParameters, &out.JSONPathParameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -2129,6 +2436,27 @@ func (in *ExecProviderConfig) DeepCopy() *ExecProviderConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCPAuthConfig) DeepCopyInto(out *GCPAuthConfig) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPAuthConfig.
+func (in *GCPAuthConfig) DeepCopy() *GCPAuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GCPAuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitDirectoryGeneratorItem) DeepCopyInto(out *GitDirectoryGeneratorItem) {
 	*out = *in
@@ -2187,6 +2515,11 @@ func (in *GitGenerator) DeepCopyInto(out *GitGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2238,6 +2571,22 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRollupRule) DeepCopyInto(out *HealthRollupRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRollupRule.
+func (in *HealthRollupRule) DeepCopy() *HealthRollupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRollupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -2417,66 +2766,120 @@ func (in IgnoreDifferences) DeepCopy() IgnoreDifferences {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Info) DeepCopyInto(out *Info) {
+func (in *ImageUpdate) DeepCopyInto(out *ImageUpdate) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Info.
-func (in *Info) DeepCopy() *Info {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpdate.
+func (in *ImageUpdate) DeepCopy() *ImageUpdate {
 	if in == nil {
 		return nil
 	}
-	out := new(Info)
+	out := new(ImageUpdate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InfoItem) DeepCopyInto(out *InfoItem) {
+func (in *ImageUpdatePolicy) DeepCopyInto(out *ImageUpdatePolicy) {
 	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageUpdate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoItem.
-func (in *InfoItem) DeepCopy() *InfoItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpdatePolicy.
+func (in *ImageUpdatePolicy) DeepCopy() *ImageUpdatePolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(InfoItem)
+	out := new(ImageUpdatePolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTToken) DeepCopyInto(out *JWTToken) {
+func (in *ImageUpdateStatus) DeepCopyInto(out *ImageUpdateStatus) {
 	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTToken.
-func (in *JWTToken) DeepCopy() *JWTToken {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUpdateStatus.
+func (in *ImageUpdateStatus) DeepCopy() *ImageUpdateStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTToken)
+	out := new(ImageUpdateStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTTokens) DeepCopyInto(out *JWTTokens) {
+func (in *Info) DeepCopyInto(out *Info) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]JWTToken, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTTokens.
-func (in *JWTTokens) DeepCopy() *JWTTokens {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Info.
+func (in *Info) DeepCopy() *Info {
+	if in == nil {
+		return nil
+	}
+	out := new(Info)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfoItem) DeepCopyInto(out *InfoItem) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfoItem.
+func (in *InfoItem) DeepCopy() *InfoItem {
+	if in == nil {
+		return nil
+	}
+	out := new(InfoItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTToken) DeepCopyInto(out *JWTToken) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTToken.
+func (in *JWTToken) DeepCopy() *JWTToken {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTTokens) DeepCopyInto(out *JWTTokens) {
+	*out = *in
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JWTToken, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTTokens.
+func (in *JWTTokens) DeepCopy() *JWTTokens {
 	if in == nil {
 		return nil
 	}
@@ -2765,6 +3168,22 @@ func (in *ManagedNamespaceMetadata) DeepCopy() *ManagedNamespaceMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManifestGenerationLimits) DeepCopyInto(out *ManifestGenerationLimits) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManifestGenerationLimits.
+func (in *ManifestGenerationLimits) DeepCopy() *ManifestGenerationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ManifestGenerationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MatrixGenerator) DeepCopyInto(out *MatrixGenerator) {
 	*out = *in
@@ -2818,6 +3237,36 @@ func (in *MergeGenerator) DeepCopy() *MergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceCreationPolicy) DeepCopyInto(out *NamespaceCreationPolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceCreationPolicy.
+func (in *NamespaceCreationPolicy) DeepCopy() *NamespaceCreationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceCreationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NestedMatrixGenerator) DeepCopyInto(out *NestedMatrixGenerator) {
 	*out = *in
@@ -2949,6 +3398,13 @@ func (in *OperationState) DeepCopyInto(out *OperationState) {
 		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.StuckResources != nil {
+		in, out := &in.StuckResources, &out.StuckResources
+		*out = make([]StuckResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2962,6 +3418,27 @@ func (in *OperationState) DeepCopy() *OperationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationWebhook) DeepCopyInto(out *OperationWebhook) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]common.OperationPhase, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationWebhook.
+func (in *OperationWebhook) DeepCopy() *OperationWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionalArray) DeepCopyInto(out *OptionalArray) {
 	*out = *in
@@ -3171,6 +3648,58 @@ func (in PluginParameters) DeepCopy() PluginParameters {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectAPIRateLimits) DeepCopyInto(out *ProjectAPIRateLimits) {
+	*out = *in
+	if in.Sync != nil {
+		in, out := &in.Sync, &out.Sync
+		*out = new(APIRateLimit)
+		**out = **in
+	}
+	if in.Refresh != nil {
+		in, out := &in.Refresh, &out.Refresh
+		*out = new(APIRateLimit)
+		**out = **in
+	}
+	if in.Get != nil {
+		in, out := &in.Get, &out.Get
+		*out = new(APIRateLimit)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectAPIRateLimits.
+func (in *ProjectAPIRateLimits) DeepCopy() *ProjectAPIRateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectAPIRateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectExecConfig) DeepCopyInto(out *ProjectExecConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectExecConfig.
+func (in *ProjectExecConfig) DeepCopy() *ProjectExecConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectExecConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 	*out = *in
@@ -3189,6 +3718,11 @@ func (in *ProjectRole) DeepCopyInto(out *ProjectRole) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3465,6 +3999,39 @@ func (in *PullRequestGeneratorGithub) DeepCopy() *PullRequestGeneratorGithub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileRateLimit) DeepCopyInto(out *ReconcileRateLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileRateLimit.
+func (in *ReconcileRateLimit) DeepCopy() *ReconcileRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconciliationSample) DeepCopyInto(out *ReconciliationSample) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconciliationSample.
+func (in *ReconciliationSample) DeepCopy() *ReconciliationSample {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconciliationSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RefTarget) DeepCopyInto(out *RefTarget) {
 	*out = *in
@@ -3580,6 +4147,28 @@ func (in Repositories) DeepCopy() Repositories {
 func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
 	in.ConnectionState.DeepCopyInto(&out.ConnectionState)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(RepositoryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManifestGenerationLimits != nil {
+		in, out := &in.ManifestGenerationLimits, &out.ManifestGenerationLimits
+		*out = new(ManifestGenerationLimits)
+		**out = **in
+	}
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SubmodulePolicy != nil {
+		in, out := &in.SubmodulePolicy, &out.SubmodulePolicy
+		*out = new(SubmodulePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3666,13 +4255,39 @@ func (in *RepositoryList) DeepCopy() *RepositoryList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
+	*out = *in
+	if in.LastCheckedAt != nil {
+		in, out := &in.LastCheckedAt, &out.LastCheckedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSucceededAt != nil {
+		in, out := &in.LastSucceededAt, &out.LastSucceededAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryStatus.
+func (in *RepositoryStatus) DeepCopy() *RepositoryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAction) DeepCopyInto(out *ResourceAction) {
 	*out = *in
 	if in.Params != nil {
 		in, out := &in.Params, &out.Params
 		*out = make([]ResourceActionParam, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -3706,6 +4321,11 @@ func (in *ResourceActionDefinition) DeepCopy() *ResourceActionDefinition {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceActionParam) DeepCopyInto(out *ResourceActionParam) {
 	*out = *in
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3740,6 +4360,33 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceBudget) DeepCopyInto(out *ResourceBudget) {
+	*out = *in
+	out.Destination = in.Destination
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBudget.
+func (in *ResourceBudget) DeepCopy() *ResourceBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
@@ -3927,6 +4574,10 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RunningSince != nil {
+		in, out := &in.RunningSince, &out.RunningSince
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4008,6 +4659,32 @@ func (in *RetryStrategy) DeepCopy() *RetryStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionComparison) DeepCopyInto(out *RevisionComparison) {
+	*out = *in
+	if in.Commits != nil {
+		in, out := &in.Commits, &out.Commits
+		*out = make([]CommitMetadata, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChangedFiles != nil {
+		in, out := &in.ChangedFiles, &out.ChangedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionComparison.
+func (in *RevisionComparison) DeepCopy() *RevisionComparison {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionComparison)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in RevisionHistories) DeepCopyInto(out *RevisionHistories) {
 	{
@@ -4424,6 +5101,47 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHealBackoff) DeepCopyInto(out *SelfHealBackoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHealBackoff.
+func (in *SelfHealBackoff) DeepCopy() *SelfHealBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHealBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelfHealStatus) DeepCopyInto(out *SelfHealStatus) {
+	*out = *in
+	if in.LastAttemptedAt != nil {
+		in, out := &in.LastAttemptedAt, &out.LastAttemptedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelfHealStatus.
+func (in *SelfHealStatus) DeepCopy() *SelfHealStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SelfHealStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SignatureKey) DeepCopyInto(out *SignatureKey) {
 	*out = *in
@@ -4644,6 +5362,60 @@ func (in *SourceIntegrityGitPolicyRepo) DeepCopy() *SourceIntegrityGitPolicyRepo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StuckResource) DeepCopyInto(out *StuckResource) {
+	*out = *in
+	if in.Since != nil {
+		in, out := &in.Since, &out.Since
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StuckResource.
+func (in *StuckResource) DeepCopy() *StuckResource {
+	if in == nil {
+		return nil
+	}
+	out := new(StuckResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubmodulePolicy) DeepCopyInto(out *SubmodulePolicy) {
+	*out = *in
+	if in.CredentialMappings != nil {
+		in, out := &in.CredentialMappings, &out.CredentialMappings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make([]*Repository, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Repository)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubmodulePolicy.
+func (in *SubmodulePolicy) DeepCopy() *SubmodulePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SubmodulePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
@@ -4714,6 +5486,34 @@ func (in *SyncOperation) DeepCopy() *SyncOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncOperationDestinationResult) DeepCopyInto(out *SyncOperationDestinationResult) {
+	*out = *in
+	out.Destination = in.Destination
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(ResourceResults, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ResourceResult)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncOperationDestinationResult.
+func (in *SyncOperationDestinationResult) DeepCopy() *SyncOperationDestinationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncOperationDestinationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncOperationResource) DeepCopyInto(out *SyncOperationResource) {
 	*out = *in
@@ -4762,6 +5562,13 @@ func (in *SyncOperationResult) DeepCopyInto(out *SyncOperationResult) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Destinations != nil {
+		in, out := &in.Destinations, &out.Destinations
+		*out = make([]SyncOperationDestinationResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4818,6 +5625,21 @@ func (in *SyncPolicy) DeepCopyInto(out *SyncPolicy) {
 		*out = new(ManagedNamespaceMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Scheduled != nil {
+		in, out := &in.Scheduled, &out.Scheduled
+		*out = new(SyncPolicyScheduled)
+		**out = **in
+	}
+	if in.Preconditions != nil {
+		in, out := &in.Preconditions, &out.Preconditions
+		*out = make([]SyncPrecondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -4854,6 +5676,16 @@ func (in *SyncPolicyAutomated) DeepCopyInto(out *SyncPolicyAutomated) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MinRevisionAge != nil {
+		in, out := &in.MinRevisionAge, &out.MinRevisionAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SelfHealBackoff != nil {
+		in, out := &in.SelfHealBackoff, &out.SelfHealBackoff
+		*out = new(SelfHealBackoff)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4867,6 +5699,55 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPolicyScheduled) DeepCopyInto(out *SyncPolicyScheduled) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPolicyScheduled.
+func (in *SyncPolicyScheduled) DeepCopy() *SyncPolicyScheduled {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPolicyScheduled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncPrecondition) DeepCopyInto(out *SyncPrecondition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncPrecondition.
+func (in *SyncPrecondition) DeepCopy() *SyncPrecondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncPrecondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncSample) DeepCopyInto(out *SyncSample) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncSample.
+func (in *SyncSample) DeepCopy() *SyncSample {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncSample)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
@@ -4995,6 +5876,23 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowUnlock) DeepCopyInto(out *SyncWindowUnlock) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowUnlock.
+func (in *SyncWindowUnlock) DeepCopy() *SyncWindowUnlock {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowUnlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
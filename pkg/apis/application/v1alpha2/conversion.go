@@ -0,0 +1,253 @@
+package v1alpha2
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// AnnotationKeyV1alpha1Sources is set on v1alpha2 applications converted from v1alpha1 applications which list
+	// their only source in the sources field, so that they are converted back to the same v1alpha1 application
+	AnnotationKeyV1alpha1Sources = "argocd.argoproj.io/v1alpha1-sources"
+	// AnnotationKeyV1alpha1Destinations is set on v1alpha2 applications converted from v1alpha1 applications which
+	// list their only destination in the destinations field
+	AnnotationKeyV1alpha1Destinations = "argocd.argoproj.io/v1alpha1-destinations"
+
+	// prunePropagationPolicyKey is the key of the prune propagation policy sync option
+	prunePropagationPolicyKey = "PrunePropagationPolicy"
+)
+
+// boolSyncOptions maps the keys of the boolean v1alpha1 sync options to their fields, in the order in which the
+// options are converted back to v1alpha1
+var boolSyncOptions = []struct {
+	key   string
+	field func(o *SyncOptions) **bool
+}{
+	{"Validate", func(o *SyncOptions) **bool { return &o.Validate }},
+	{"CreateNamespace", func(o *SyncOptions) **bool { return &o.CreateNamespace }},
+	{"PruneLast", func(o *SyncOptions) **bool { return &o.PruneLast }},
+	{"ApplyOutOfSyncOnly", func(o *SyncOptions) **bool { return &o.ApplyOutOfSyncOnly }},
+	{"Replace", func(o *SyncOptions) **bool { return &o.Replace }},
+	{"ServerSideApply", func(o *SyncOptions) **bool { return &o.ServerSideApply }},
+	{"FailOnSharedResource", func(o *SyncOptions) **bool { return &o.FailOnSharedResource }},
+	{"RespectIgnoreDifferences", func(o *SyncOptions) **bool { return &o.RespectIgnoreDifferences }},
+}
+
+// FromV1alpha1 converts a v1alpha1 application to a v1alpha2 application. The source of a v1alpha1 application which
+// also has sources, and the destination of one which also has destinations, are ignored by v1alpha1 and are dropped.
+func FromV1alpha1(in *v1alpha1.Application) *Application {
+	in = in.DeepCopy()
+	out := &Application{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: in.ObjectMeta,
+		Status:     in.Status,
+		Operation:  in.Operation,
+	}
+	out.APIVersion = SchemeGroupVersion.String()
+
+	spec := &in.Spec
+	out.Spec = ApplicationSpec{
+		SourceHydrator:       spec.SourceHydrator,
+		Project:              spec.Project,
+		IgnoreDifferences:    spec.IgnoreDifferences,
+		Info:                 spec.Info,
+		RevisionHistoryLimit: spec.RevisionHistoryLimit,
+		ImageUpdatePolicy:    spec.ImageUpdatePolicy,
+		HealthRollupRules:    spec.HealthRollupRules,
+	}
+	switch {
+	case len(spec.Sources) > 0:
+		out.Spec.Sources = spec.Sources
+		if len(spec.Sources) == 1 {
+			setAnnotation(&out.ObjectMeta.Annotations, AnnotationKeyV1alpha1Sources)
+		}
+	case spec.Source != nil:
+		out.Spec.Sources = []v1alpha1.ApplicationSource{*spec.Source}
+	}
+	if len(spec.Destinations) > 0 {
+		out.Spec.Destinations = spec.Destinations
+		if len(spec.Destinations) == 1 {
+			setAnnotation(&out.ObjectMeta.Annotations, AnnotationKeyV1alpha1Destinations)
+		}
+	} else {
+		out.Spec.Destinations = []v1alpha1.ApplicationDestination{spec.Destination}
+	}
+	if policy := spec.SyncPolicy; policy != nil {
+		out.Spec.SyncPolicy = &SyncPolicy{
+			Automated:                policy.Automated,
+			SyncOptions:              SyncOptionsFromV1alpha1(policy.SyncOptions),
+			Retry:                    policy.Retry,
+			ManagedNamespaceMetadata: policy.ManagedNamespaceMetadata,
+			Scheduled:                policy.Scheduled,
+			Preconditions:            policy.Preconditions,
+			RefreshInterval:          policy.RefreshInterval,
+		}
+	}
+	return out
+}
+
+// ToV1alpha1 converts the application to a v1alpha1 application.
+func (app *Application) ToV1alpha1() *v1alpha1.Application {
+	in := app.DeepCopy()
+	out := &v1alpha1.Application{
+		TypeMeta:   in.TypeMeta,
+		ObjectMeta: in.ObjectMeta,
+		Status:     in.Status,
+		Operation:  in.Operation,
+	}
+	out.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	sourcesList := removeAnnotation(&out.ObjectMeta.Annotations, AnnotationKeyV1alpha1Sources)
+	destinationsList := removeAnnotation(&out.ObjectMeta.Annotations, AnnotationKeyV1alpha1Destinations)
+
+	spec := &in.Spec
+	out.Spec = v1alpha1.ApplicationSpec{
+		SourceHydrator:       spec.SourceHydrator,
+		Project:              spec.Project,
+		IgnoreDifferences:    spec.IgnoreDifferences,
+		Info:                 spec.Info,
+		RevisionHistoryLimit: spec.RevisionHistoryLimit,
+		ImageUpdatePolicy:    spec.ImageUpdatePolicy,
+		HealthRollupRules:    spec.HealthRollupRules,
+	}
+	if len(spec.Sources) == 1 && !sourcesList {
+		out.Spec.Source = &spec.Sources[0]
+	} else if len(spec.Sources) > 0 {
+		out.Spec.Sources = spec.Sources
+	}
+	if len(spec.Destinations) == 1 && !destinationsList {
+		out.Spec.Destination = spec.Destinations[0]
+	} else if len(spec.Destinations) > 0 {
+		out.Spec.Destinations = spec.Destinations
+	}
+	if policy := spec.SyncPolicy; policy != nil {
+		out.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
+			Automated:                policy.Automated,
+			SyncOptions:              policy.SyncOptions.ToV1alpha1(),
+			Retry:                    policy.Retry,
+			ManagedNamespaceMetadata: policy.ManagedNamespaceMetadata,
+			Scheduled:                policy.Scheduled,
+			Preconditions:            policy.Preconditions,
+			RefreshInterval:          policy.RefreshInterval,
+		}
+	}
+	return out
+}
+
+// SyncOptionsFromV1alpha1 converts v1alpha1 sync options to structured sync options. Options which have no field,
+// or which are repeated, are kept in the additional options.
+func SyncOptionsFromV1alpha1(opts v1alpha1.SyncOptions) *SyncOptions {
+	if len(opts) == 0 {
+		return nil
+	}
+	out := &SyncOptions{}
+	for _, opt := range opts {
+		if !out.set(opt) {
+			out.Additional = append(out.Additional, opt)
+		}
+	}
+	return out
+}
+
+// set sets the field of the given v1alpha1 sync option and returns whether the option has a field
+func (o *SyncOptions) set(opt string) bool {
+	key, value, ok := strings.Cut(opt, "=")
+	if !ok {
+		return false
+	}
+	if key == prunePropagationPolicyKey {
+		if o.PrunePropagationPolicy != "" || !slices.Contains([]string{"foreground", "background", "orphan"}, value) {
+			return false
+		}
+		o.PrunePropagationPolicy = value
+		return true
+	}
+	for _, option := range boolSyncOptions {
+		if option.key != key {
+			continue
+		}
+		field := option.field(o)
+		// only the canonical values are converted, so that the option is converted back to the same string
+		if *field != nil || (value != "true" && value != "false") {
+			return false
+		}
+		enabled := value == "true"
+		*field = &enabled
+		return true
+	}
+	return false
+}
+
+// ToV1alpha1 converts the sync options to v1alpha1 sync options.
+func (o *SyncOptions) ToV1alpha1() v1alpha1.SyncOptions {
+	if o == nil {
+		return nil
+	}
+	var out v1alpha1.SyncOptions
+	for _, option := range boolSyncOptions {
+		if value := *option.field(o); value != nil {
+			out = append(out, option.key+"="+strconv.FormatBool(*value))
+		}
+	}
+	if o.PrunePropagationPolicy != "" {
+		out = append(out, prunePropagationPolicyKey+"="+o.PrunePropagationPolicy)
+	}
+	return append(out, o.Additional...)
+}
+
+// Convert converts an application to the given API version, which is either argoproj.io/v1alpha1 or
+// argoproj.io/v1alpha2. Applications which already have the given API version are returned as is.
+func Convert(obj *unstructured.Unstructured, apiVersion string) (*unstructured.Unstructured, error) {
+	if obj.GetKind() != application.ApplicationKind {
+		return nil, fmt.Errorf("cannot convert kind %s", obj.GetKind())
+	}
+	if obj.GetAPIVersion() == apiVersion {
+		return obj, nil
+	}
+	var converted any
+	switch {
+	case obj.GetAPIVersion() == v1alpha1.SchemeGroupVersion.String() && apiVersion == SchemeGroupVersion.String():
+		var app v1alpha1.Application
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &app); err != nil {
+			return nil, fmt.Errorf("error decoding application: %w", err)
+		}
+		converted = FromV1alpha1(&app)
+	case obj.GetAPIVersion() == SchemeGroupVersion.String() && apiVersion == v1alpha1.SchemeGroupVersion.String():
+		var app Application
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &app); err != nil {
+			return nil, fmt.Errorf("error decoding application: %w", err)
+		}
+		converted = app.ToV1alpha1()
+	default:
+		return nil, fmt.Errorf("cannot convert %s to %s", obj.GetAPIVersion(), apiVersion)
+	}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(converted)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding application: %w", err)
+	}
+	return &unstructured.Unstructured{Object: data}, nil
+}
+
+func setAnnotation(annotations *map[string]string, key string) {
+	if *annotations == nil {
+		*annotations = map[string]string{}
+	}
+	(*annotations)[key] = "true"
+}
+
+// removeAnnotation removes the annotation and returns whether it was set
+func removeAnnotation(annotations *map[string]string, key string) bool {
+	_, ok := (*annotations)[key]
+	delete(*annotations, key)
+	if len(*annotations) == 0 {
+		*annotations = nil
+	}
+	return ok
+}
@@ -0,0 +1,165 @@
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newV1alpha1App(mutate func(spec *v1alpha1.ApplicationSpec)) *v1alpha1.Application {
+	app := &v1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd", Labels: map[string]string{"team": "a"}},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "HEAD"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+			SyncPolicy: &v1alpha1.SyncPolicy{
+				Automated:   &v1alpha1.SyncPolicyAutomated{Prune: ptr.To(true)},
+				SyncOptions: v1alpha1.SyncOptions{"CreateNamespace=true", "ServerSideApply=true", "PrunePropagationPolicy=foreground", "RetryDelay=5s"},
+			},
+			RevisionHistoryLimit: ptr.To(int64(5)),
+		},
+		Status: v1alpha1.ApplicationStatus{Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced}},
+	}
+	if mutate != nil {
+		mutate(&app.Spec)
+	}
+	return app
+}
+
+func TestRoundTrip(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mutate func(spec *v1alpha1.ApplicationSpec)
+	}{
+		{"SingleSource", nil},
+		{"MultipleSources", func(spec *v1alpha1.ApplicationSpec) {
+			spec.Sources = v1alpha1.ApplicationSources{*spec.Source, {RepoURL: "https://github.com/argoproj/argocd-example-apps", Ref: "values"}}
+			spec.Source = nil
+		}},
+		{"SourcesWithOneSource", func(spec *v1alpha1.ApplicationSpec) {
+			spec.Sources = v1alpha1.ApplicationSources{*spec.Source}
+			spec.Source = nil
+		}},
+		{"MultipleDestinations", func(spec *v1alpha1.ApplicationSpec) {
+			spec.Destinations = []v1alpha1.ApplicationDestination{spec.Destination, {Name: "spoke", Namespace: "guestbook"}}
+			spec.Destination = v1alpha1.ApplicationDestination{}
+		}},
+		{"DestinationsWithOneDestination", func(spec *v1alpha1.ApplicationSpec) {
+			spec.Destinations = []v1alpha1.ApplicationDestination{spec.Destination}
+			spec.Destination = v1alpha1.ApplicationDestination{}
+		}},
+		{"SourceHydrator", func(spec *v1alpha1.ApplicationSpec) {
+			spec.Source = nil
+			spec.SourceHydrator = &v1alpha1.SourceHydrator{
+				DrySource:  v1alpha1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook", TargetRevision: "HEAD"},
+				SyncSource: v1alpha1.SyncSource{TargetBranch: "env/prod", Path: "guestbook"},
+			}
+		}},
+		{"NoSyncPolicy", func(spec *v1alpha1.ApplicationSpec) {
+			spec.SyncPolicy = nil
+		}},
+		{"NonCanonicalSyncOptions", func(spec *v1alpha1.ApplicationSpec) {
+			spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"Validate=False", "PruneLast", "PrunePropagationPolicy=Foreground"}
+		}},
+		{"RepeatedSyncOptions", func(spec *v1alpha1.ApplicationSpec) {
+			spec.SyncPolicy.SyncOptions = v1alpha1.SyncOptions{"Replace=true", "Replace=false"}
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := newV1alpha1App(tc.mutate)
+			converted := FromV1alpha1(original)
+			assert.Equal(t, "argoproj.io/v1alpha2", converted.APIVersion)
+			assert.Equal(t, original.Status, converted.Status)
+
+			roundTripped := converted.ToV1alpha1()
+			assert.Equal(t, original, roundTripped)
+		})
+	}
+}
+
+func TestFromV1alpha1(t *testing.T) {
+	t.Run("SingleSource", func(t *testing.T) {
+		original := newV1alpha1App(nil)
+		app := FromV1alpha1(original)
+		assert.Equal(t, []v1alpha1.ApplicationSource{*original.Spec.Source}, app.Spec.Sources)
+		assert.Equal(t, []v1alpha1.ApplicationDestination{original.Spec.Destination}, app.Spec.Destinations)
+		assert.Empty(t, app.Annotations)
+
+		opts := app.Spec.SyncPolicy.SyncOptions
+		assert.Equal(t, ptr.To(true), opts.CreateNamespace)
+		assert.Equal(t, ptr.To(true), opts.ServerSideApply)
+		assert.Nil(t, opts.Validate)
+		assert.Equal(t, "foreground", opts.PrunePropagationPolicy)
+		assert.Equal(t, []string{"RetryDelay=5s"}, opts.Additional)
+	})
+
+	t.Run("SourceIgnoredWithSources", func(t *testing.T) {
+		original := newV1alpha1App(func(spec *v1alpha1.ApplicationSpec) {
+			spec.Sources = v1alpha1.ApplicationSources{{RepoURL: "https://example.com/a.git", Path: "a"}, {RepoURL: "https://example.com/b.git", Path: "b"}}
+		})
+		app := FromV1alpha1(original)
+		assert.Equal(t, []v1alpha1.ApplicationSource(original.Spec.Sources), app.Spec.Sources)
+	})
+
+	t.Run("SourcesWithOneSource", func(t *testing.T) {
+		app := FromV1alpha1(newV1alpha1App(func(spec *v1alpha1.ApplicationSpec) {
+			spec.Sources = v1alpha1.ApplicationSources{*spec.Source}
+			spec.Source = nil
+		}))
+		assert.Equal(t, "true", app.Annotations[AnnotationKeyV1alpha1Sources])
+		assert.Len(t, app.Spec.Sources, 1)
+	})
+}
+
+func TestSyncOptions(t *testing.T) {
+	assert.Nil(t, SyncOptionsFromV1alpha1(nil))
+	assert.Nil(t, (*SyncOptions)(nil).ToV1alpha1())
+
+	opts := &SyncOptions{
+		Additional:               []string{"RetryDelay=5s"},
+		PrunePropagationPolicy:   "orphan",
+		Validate:                 ptr.To(false),
+		RespectIgnoreDifferences: ptr.To(true),
+	}
+	assert.Equal(t, v1alpha1.SyncOptions{"Validate=false", "RespectIgnoreDifferences=true", "PrunePropagationPolicy=orphan", "RetryDelay=5s"}, opts.ToV1alpha1())
+	assert.Equal(t, opts, SyncOptionsFromV1alpha1(opts.ToV1alpha1()))
+}
+
+func TestConvert(t *testing.T) {
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(newV1alpha1App(nil))
+	require.NoError(t, err)
+	obj := &unstructured.Unstructured{Object: data}
+
+	converted, err := Convert(obj, "argoproj.io/v1alpha2")
+	require.NoError(t, err)
+	assert.Equal(t, "argoproj.io/v1alpha2", converted.GetAPIVersion())
+	sources, _, _ := unstructured.NestedSlice(converted.Object, "spec", "sources")
+	assert.Len(t, sources, 1)
+	_, found, _ := unstructured.NestedFieldNoCopy(converted.Object, "spec", "source")
+	assert.False(t, found)
+
+	back, err := Convert(converted, "argoproj.io/v1alpha1")
+	require.NoError(t, err)
+	assert.Equal(t, obj.Object, back.Object)
+
+	same, err := Convert(obj, "argoproj.io/v1alpha1")
+	require.NoError(t, err)
+	assert.Same(t, obj, same)
+
+	_, err = Convert(obj, "argoproj.io/v1beta1")
+	require.EqualError(t, err, "cannot convert argoproj.io/v1alpha1 to argoproj.io/v1beta1")
+
+	obj.SetKind("AppProject")
+	_, err = Convert(obj, "argoproj.io/v1alpha2")
+	require.EqualError(t, err, "cannot convert kind AppProject")
+}
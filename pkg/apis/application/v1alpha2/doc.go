@@ -0,0 +1,6 @@
+// Package v1alpha2 is a draft of the next version of the Application API. It cleans up the fields which accumulated
+// in v1alpha1, e.g. the source and sources fields are unified and the sync options are structured. v1alpha1 remains
+// the storage version, and objects are converted between the versions by the conversion webhook of the API server.
+// +groupName=argoproj.io
+// +k8s:deepcopy-gen=package,register
+package v1alpha2
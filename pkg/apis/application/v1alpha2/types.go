@@ -0,0 +1,102 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Application is a definition of Application resource. It only differs from the v1alpha1 Application in its spec;
+// the status and the operation are shared with v1alpha1.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=applications,shortName=app;apps
+// +kubebuilder:subresource:status
+type Application struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ApplicationSpec            `json:"spec"`
+	Status            v1alpha1.ApplicationStatus `json:"status,omitempty"`
+	Operation         *v1alpha1.Operation        `json:"operation,omitempty"`
+}
+
+// ApplicationList is list of Application resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Application `json:"items"`
+}
+
+// ApplicationSpec represents desired application state.
+type ApplicationSpec struct {
+	// Sources is the list of locations of the application's manifests or charts. It replaces the source and sources
+	// fields of v1alpha1: an application with a single source has a list of one source.
+	Sources []v1alpha1.ApplicationSource `json:"sources,omitempty"`
+	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
+	// The sources are ignored if the source hydrator is set.
+	SourceHydrator *v1alpha1.SourceHydrator `json:"sourceHydrator,omitempty"`
+	// Destinations is the list of target Kubernetes servers and namespaces, the primary destination first. It
+	// replaces the destination and destinations fields of v1alpha1.
+	Destinations []v1alpha1.ApplicationDestination `json:"destinations"`
+	// Project is a reference to the project this application belongs to.
+	// The empty string means that application belongs to the 'default' project.
+	Project string `json:"project"`
+	// SyncPolicy controls when and how a sync will be performed
+	SyncPolicy *SyncPolicy `json:"syncPolicy,omitempty"`
+	// IgnoreDifferences is a list of resources and their fields which should be ignored during comparison
+	IgnoreDifferences v1alpha1.IgnoreDifferences `json:"ignoreDifferences,omitempty"`
+	// Info contains a list of information (URLs, email addresses, and plain text) that relates to the application
+	Info []v1alpha1.Info `json:"info,omitempty"`
+	// RevisionHistoryLimit limits the number of items kept in the application's revision history, which is used for
+	// informational purposes as well as for rollbacks to previous versions.
+	RevisionHistoryLimit *int64 `json:"revisionHistoryLimit,omitempty"`
+	// ImageUpdatePolicy updates the images deployed by the application to the newest tags matching its constraints
+	ImageUpdatePolicy *v1alpha1.ImageUpdatePolicy `json:"imageUpdatePolicy,omitempty"`
+	// HealthRollupRules override how the health of the resources matched by each rule is rolled up into the health
+	// of the application
+	HealthRollupRules []v1alpha1.HealthRollupRule `json:"healthRollupRules,omitempty"`
+}
+
+// SyncPolicy controls when a sync will be performed in response to updates in git
+type SyncPolicy struct {
+	// Automated will keep an application synced to the target revision
+	Automated *v1alpha1.SyncPolicyAutomated `json:"automated,omitempty"`
+	// SyncOptions are the options applied to all syncs of the application
+	SyncOptions *SyncOptions `json:"syncOptions,omitempty"`
+	// Retry controls failed sync retry behavior
+	Retry *v1alpha1.RetryStrategy `json:"retry,omitempty"`
+	// ManagedNamespaceMetadata controls metadata in the given namespace (if CreateNamespace=true)
+	ManagedNamespaceMetadata *v1alpha1.ManagedNamespaceMetadata `json:"managedNamespaceMetadata,omitempty"`
+	// Scheduled will sync the application at the times configured in its schedules
+	Scheduled *v1alpha1.SyncPolicyScheduled `json:"scheduled,omitempty"`
+	// Preconditions are evaluated before every automated sync
+	Preconditions []v1alpha1.SyncPrecondition `json:"preconditions,omitempty"`
+	// RefreshInterval overrides the reconciliation timeout of the application controller for this application
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// SyncOptions are the options of the syncs of an application. They replace the list of key=value strings of
+// v1alpha1. Unset options use the default of the application controller.
+type SyncOptions struct {
+	// Validate validates the resources with kubectl --validate
+	Validate *bool `json:"validate,omitempty"`
+	// CreateNamespace creates the destination namespace if it does not exist
+	CreateNamespace *bool `json:"createNamespace,omitempty"`
+	// PruneLast prunes the resources after all other resources have been synced and are healthy
+	PruneLast *bool `json:"pruneLast,omitempty"`
+	// ApplyOutOfSyncOnly only applies the resources which are out of sync
+	ApplyOutOfSyncOnly *bool `json:"applyOutOfSyncOnly,omitempty"`
+	// Replace replaces the resources instead of applying them
+	Replace *bool `json:"replace,omitempty"`
+	// ServerSideApply applies the resources using server-side apply
+	ServerSideApply *bool `json:"serverSideApply,omitempty"`
+	// FailOnSharedResource fails the sync if a resource is already managed by another application
+	FailOnSharedResource *bool `json:"failOnSharedResource,omitempty"`
+	// RespectIgnoreDifferences does not apply the fields ignored by the ignoreDifferences of the application
+	RespectIgnoreDifferences *bool `json:"respectIgnoreDifferences,omitempty"`
+	// PrunePropagationPolicy is the propagation policy used to prune resources: foreground, background or orphan
+	// +kubebuilder:validation:Enum=foreground;background;orphan
+	PrunePropagationPolicy string `json:"prunePropagationPolicy,omitempty"`
+	// Additional holds the options which have no field, in the key=value format of v1alpha1
+	Additional []string `json:"additional,omitempty"`
+}
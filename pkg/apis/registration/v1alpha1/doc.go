@@ -0,0 +1,5 @@
+// Package v1alpha1 contains the declarative Repository and Cluster resources, which are reconciled into the
+// secrets that Argo CD uses to store repositories and clusters.
+// +groupName=argoproj.io
+// +k8s:deepcopy-gen=package,register
+package v1alpha1
@@ -0,0 +1,148 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// RegistrationConditionReady is set on Repository and Cluster resources once they have been stored in their
+	// backing secret.
+	RegistrationConditionReady = "Ready"
+
+	// RegistrationReasonRegistered indicates that the resource was written to its backing secret
+	RegistrationReasonRegistered = "Registered"
+	// RegistrationReasonInvalidSpec indicates that the resource failed validation
+	RegistrationReasonInvalidSpec = "InvalidSpec"
+	// RegistrationReasonSecretError indicates that the referenced credentials secret could not be read
+	RegistrationReasonSecretError = "SecretError"
+	// RegistrationReasonConflict indicates that the backing secret already exists and is not managed by the resource
+	RegistrationReasonConflict = "Conflict"
+)
+
+// Repository declares a repository that Argo CD can deploy applications from. It is reconciled into the same
+// labeled secret that `argocd repo add` creates. Credentials are never stored in the resource itself but read
+// from the secret referenced by spec.secretRef.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=repositories,shortName=repo;repos
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="URL",type=string,JSONPath=`.spec.url`
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+type Repository struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              RepositorySpec     `json:"spec"`
+	Status            RegistrationStatus `json:"status,omitempty"`
+}
+
+// RepositorySpec holds the non-sensitive settings of a repository.
+type RepositorySpec struct {
+	// URL is the URL of the repository
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url"`
+	// Type specifies the type of the repository. Defaults to git.
+	// +kubebuilder:validation:Enum=git;helm
+	Type string `json:"type,omitempty"`
+	// Name is the name to be used for a Helm repository
+	Name string `json:"name,omitempty"`
+	// Project is the name of the project the repository is scoped to
+	Project string `json:"project,omitempty"`
+	// Insecure specifies whether the connection to the repository ignores any errors when verifying TLS certificates or SSH host keys
+	Insecure bool `json:"insecure,omitempty"`
+	// EnableLFS specifies whether git-lfs support should be enabled for this repository
+	EnableLFS bool `json:"enableLfs,omitempty"`
+	// EnableOCI specifies whether helm-oci support should be enabled for this repository
+	EnableOCI bool `json:"enableOCI,omitempty"`
+	// ForceHTTPBasicAuth specifies whether Argo CD should attempt to force basic auth for HTTP connections
+	ForceHTTPBasicAuth bool `json:"forceHttpBasicAuth,omitempty"`
+	// Proxy specifies the HTTP/HTTPS proxy used to access the repository
+	Proxy string `json:"proxy,omitempty"`
+	// NoProxy specifies a list of targets where the proxy isn't used, applies only in cases where the proxy is applied
+	NoProxy string `json:"noProxy,omitempty"`
+	// GitHubAppID specifies the ID of the GitHub app used to access the repository
+	GitHubAppID int64 `json:"githubAppID,omitempty"`
+	// GitHubAppInstallationID specifies the installation ID of the GitHub App used to access the repository
+	GitHubAppInstallationID int64 `json:"githubAppInstallationID,omitempty"`
+	// GitHubAppEnterpriseBaseURL specifies the base URL of GitHub Enterprise installation. If empty will default to https://api.github.com
+	GitHubAppEnterpriseBaseURL string `json:"githubAppEnterpriseBaseUrl,omitempty"`
+	// UseAzureWorkloadIdentity specifies whether to use Azure Workload Identity for authentication
+	UseAzureWorkloadIdentity bool `json:"useAzureWorkloadIdentity,omitempty"`
+	// Depth specifies the depth for shallow clones. A value of 0 or omitting the field indicates a full clone.
+	// +kubebuilder:validation:Minimum=0
+	Depth int64 `json:"depth,omitempty"`
+	// SecretRef references a secret in the namespace of the resource that holds the credentials of the repository.
+	// The secret uses the same keys as a repository secret: username, password, bearerToken, sshPrivateKey,
+	// tlsClientCertData, tlsClientCertKey, githubAppPrivateKey and gcpServiceAccountKey.
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// RepositoryList is a list of Repository resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RepositoryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Repository `json:"items"`
+}
+
+// Cluster declares a cluster that Argo CD can deploy applications to. It is reconciled into the same labeled
+// secret that `argocd cluster add` creates. The connection settings and credentials are read from the secret
+// referenced by spec.secretRef.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusters
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server",type=string,JSONPath=`.spec.server`
+// +kubebuilder:printcolumn:name="Project",type=string,JSONPath=`.spec.project`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              ClusterSpec        `json:"spec"`
+	Status            RegistrationStatus `json:"status,omitempty"`
+}
+
+// ClusterSpec holds the non-sensitive settings of a cluster.
+type ClusterSpec struct {
+	// Server is the API server URL of the Kubernetes cluster
+	// +kubebuilder:validation:MinLength=1
+	Server string `json:"server"`
+	// Name of the cluster. If omitted, will use the server address
+	Name string `json:"name,omitempty"`
+	// Namespaces holds list of namespaces which are accessible in that cluster. Cluster level resources will be ignored if namespace list is not empty.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// ClusterResources specifies whether Argo CD can manage cluster-level resources on this cluster. This setting is used only if the list of managed namespaces is not empty.
+	ClusterResources bool `json:"clusterResources,omitempty"`
+	// Project is the name of the project the cluster is scoped to
+	Project string `json:"project,omitempty"`
+	// Shard contains optional shard number. Calculated on the fly by the application controller if not specified.
+	// +kubebuilder:validation:Minimum=0
+	Shard *int64 `json:"shard,omitempty"`
+	// Labels are copied to the cluster secret and can be used by the cluster generator of ApplicationSets
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are copied to the cluster secret and can be used by the cluster generator of ApplicationSets
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// SecretRef references a secret in the namespace of the resource that holds the connection settings of the
+	// cluster in its `config` key, using the same JSON format as a cluster secret. May be omitted for the
+	// in-cluster destination.
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// ClusterList is a list of Cluster resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Cluster `json:"items"`
+}
+
+// RegistrationStatus is the observed state of a Repository or Cluster resource.
+type RegistrationStatus struct {
+	// ObservedGeneration is the generation of the resource that was last reconciled
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// SecretName is the name of the secret the resource is stored in
+	SecretName string `json:"secretName,omitempty"`
+	// Conditions is a list of observed conditions of the resource
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
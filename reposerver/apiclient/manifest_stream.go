@@ -0,0 +1,102 @@
+package apiclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// manifestsHash returns the hash used for the checksum of the manifests of a streamed manifest response
+func manifestsHash() hash.Hash {
+	return sha256.New()
+}
+
+func writeManifest(h hash.Hash, manifest string) {
+	h.Write([]byte(manifest))
+	h.Write([]byte{0})
+}
+
+// SendManifestResponseChunks splits the manifest response in chunks of at most chunkSize bytes of manifests, and sends
+// them in order. A manifest larger than chunkSize is sent in its own chunk. The last chunk contains the response without
+// its manifests, and the checksum of all the manifests.
+func SendManifestResponseChunks(res *ManifestResponse, chunkSize int, send func(chunk *ManifestResponseChunk) error) error {
+	h := manifestsHash()
+	var manifests []string
+	size := 0
+	for _, manifest := range res.Manifests {
+		if len(manifests) > 0 && size+len(manifest) > chunkSize {
+			if err := send(&ManifestResponseChunk{Manifests: manifests}); err != nil {
+				return fmt.Errorf("error sending manifests: %w", err)
+			}
+			manifests = nil
+			size = 0
+		}
+		writeManifest(h, manifest)
+		manifests = append(manifests, manifest)
+		size += len(manifest)
+	}
+
+	response := *res
+	response.Manifests = nil
+	err := send(&ManifestResponseChunk{Manifests: manifests, Response: &response, Checksum: hex.EncodeToString(h.Sum(nil))})
+	if err != nil {
+		return fmt.Errorf("error sending manifest response: %w", err)
+	}
+	return nil
+}
+
+// ReceiveManifestResponse receives the chunks of a streamed manifest response, and verifies the checksum of its
+// manifests
+func ReceiveManifestResponse(stream RepoServerService_GenerateManifestStreamClient) (*ManifestResponse, error) {
+	h := manifestsHash()
+	var manifests []string
+	for {
+		chunk, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("manifest response stream ended before the last chunk")
+			}
+			return nil, err
+		}
+		for _, manifest := range chunk.Manifests {
+			writeManifest(h, manifest)
+		}
+		manifests = append(manifests, chunk.Manifests...)
+		if chunk.Response == nil {
+			continue
+		}
+		if checksum := hex.EncodeToString(h.Sum(nil)); checksum != chunk.Checksum {
+			return nil, fmt.Errorf("manifest response checksum mismatch: expected %s, got %s", chunk.Checksum, checksum)
+		}
+		res := chunk.Response
+		res.Manifests = manifests
+		return res, nil
+	}
+}
+
+// GenerateManifestStreamed generates the manifests with GenerateManifestStream, whose response is not limited by the
+// maximum size of a gRPC message. It falls back to GenerateManifest if the repo server does not implement it yet.
+func GenerateManifestStreamed(ctx context.Context, client RepoServerServiceClient, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.GenerateManifestStream(ctx, in, opts...)
+	if err == nil {
+		var res *ManifestResponse
+		res, err = ReceiveManifestResponse(stream)
+		if err == nil {
+			return res, nil
+		}
+	}
+	if status.Code(err) == codes.Unimplemented {
+		return client.GenerateManifest(ctx, in, opts...)
+	}
+	return nil, err
+}
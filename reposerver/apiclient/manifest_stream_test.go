@@ -0,0 +1,142 @@
+package apiclient_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
+)
+
+func sendManifestResponseChunks(t *testing.T, res *apiclient.ManifestResponse, chunkSize int) []*apiclient.ManifestResponseChunk {
+	t.Helper()
+	var chunks []*apiclient.ManifestResponseChunk
+	err := apiclient.SendManifestResponseChunks(res, chunkSize, func(chunk *apiclient.ManifestResponseChunk) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+	return chunks
+}
+
+func newManifestResponseStream(t *testing.T, chunks []*apiclient.ManifestResponseChunk) *mocks.RepoServerService_GenerateManifestStreamClient {
+	t.Helper()
+	stream := mocks.NewRepoServerService_GenerateManifestStreamClient(t)
+	for _, chunk := range chunks {
+		stream.EXPECT().Recv().Return(chunk, nil).Once()
+	}
+	stream.EXPECT().Recv().Return(nil, io.EOF).Maybe()
+	return stream
+}
+
+func TestSendManifestResponseChunks(t *testing.T) {
+	res := &apiclient.ManifestResponse{
+		Manifests:  []string{"aaaa", "bb", "cc", strings.Repeat("d", 10), "e"},
+		Namespace:  "default",
+		Revision:   "abc",
+		SourceType: "Directory",
+	}
+
+	chunks := sendManifestResponseChunks(t, res, 4)
+
+	require.Len(t, chunks, 4)
+	assert.Equal(t, []string{"aaaa"}, chunks[0].Manifests)
+	assert.Equal(t, []string{"bb", "cc"}, chunks[1].Manifests)
+	assert.Equal(t, []string{strings.Repeat("d", 10)}, chunks[2].Manifests)
+	assert.Equal(t, []string{"e"}, chunks[3].Manifests)
+	for _, chunk := range chunks[:3] {
+		assert.Nil(t, chunk.Response)
+		assert.Empty(t, chunk.Checksum)
+	}
+	require.NotNil(t, chunks[3].Response)
+	assert.Empty(t, chunks[3].Response.Manifests)
+	assert.Equal(t, "abc", chunks[3].Response.Revision)
+	assert.NotEmpty(t, chunks[3].Checksum)
+	// the response must not be modified
+	assert.Len(t, res.Manifests, 5)
+}
+
+func TestSendManifestResponseChunks_Error(t *testing.T) {
+	err := apiclient.SendManifestResponseChunks(&apiclient.ManifestResponse{Manifests: []string{"a", "b"}}, 1, func(_ *apiclient.ManifestResponseChunk) error {
+		return errors.New("connection closed")
+	})
+	require.ErrorContains(t, err, "connection closed")
+}
+
+func TestReceiveManifestResponse(t *testing.T) {
+	t.Run("Chunked", func(t *testing.T) {
+		res := &apiclient.ManifestResponse{Manifests: []string{"a", "b", "c"}, Revision: "abc"}
+		received, err := apiclient.ReceiveManifestResponse(newManifestResponseStream(t, sendManifestResponseChunks(t, res, 1)))
+		require.NoError(t, err)
+		assert.Equal(t, res, received)
+	})
+	t.Run("NoManifests", func(t *testing.T) {
+		res := &apiclient.ManifestResponse{Revision: "abc"}
+		received, err := apiclient.ReceiveManifestResponse(newManifestResponseStream(t, sendManifestResponseChunks(t, res, 1)))
+		require.NoError(t, err)
+		assert.Equal(t, "abc", received.Revision)
+		assert.Empty(t, received.Manifests)
+	})
+	t.Run("ChecksumMismatch", func(t *testing.T) {
+		chunks := sendManifestResponseChunks(t, &apiclient.ManifestResponse{Manifests: []string{"a", "b"}}, 1)
+		chunks[0].Manifests = []string{"x"}
+		_, err := apiclient.ReceiveManifestResponse(newManifestResponseStream(t, chunks))
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+	t.Run("MissingChunk", func(t *testing.T) {
+		chunks := sendManifestResponseChunks(t, &apiclient.ManifestResponse{Manifests: []string{"a", "b"}}, 1)
+		_, err := apiclient.ReceiveManifestResponse(newManifestResponseStream(t, chunks[1:]))
+		require.ErrorContains(t, err, "checksum mismatch")
+	})
+	t.Run("Truncated", func(t *testing.T) {
+		chunks := sendManifestResponseChunks(t, &apiclient.ManifestResponse{Manifests: []string{"a", "b"}}, 1)
+		_, err := apiclient.ReceiveManifestResponse(newManifestResponseStream(t, chunks[:1]))
+		require.ErrorContains(t, err, "ended before the last chunk")
+	})
+	t.Run("Error", func(t *testing.T) {
+		stream := mocks.NewRepoServerService_GenerateManifestStreamClient(t)
+		stream.EXPECT().Recv().Return(nil, status.Error(codes.NotFound, "no such path"))
+		_, err := apiclient.ReceiveManifestResponse(stream)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestGenerateManifestStreamed(t *testing.T) {
+	req := &apiclient.ManifestRequest{AppName: "guestbook"}
+	res := &apiclient.ManifestResponse{Manifests: []string{"a", "b"}, Revision: "abc"}
+
+	t.Run("Streamed", func(t *testing.T) {
+		client := mocks.NewRepoServerServiceClient(t)
+		client.EXPECT().GenerateManifestStream(mock.Anything, req).Return(newManifestResponseStream(t, sendManifestResponseChunks(t, res, 1)), nil)
+		received, err := apiclient.GenerateManifestStreamed(t.Context(), client, req)
+		require.NoError(t, err)
+		assert.Equal(t, res, received)
+	})
+	t.Run("FallbackWhenUnimplemented", func(t *testing.T) {
+		client := mocks.NewRepoServerServiceClient(t)
+		stream := mocks.NewRepoServerService_GenerateManifestStreamClient(t)
+		stream.EXPECT().Recv().Return(nil, status.Error(codes.Unimplemented, "unknown method GenerateManifestStream"))
+		client.EXPECT().GenerateManifestStream(mock.Anything, req).Return(stream, nil)
+		client.EXPECT().GenerateManifest(mock.Anything, req).Return(res, nil)
+		received, err := apiclient.GenerateManifestStreamed(t.Context(), client, req)
+		require.NoError(t, err)
+		assert.Equal(t, res, received)
+	})
+	t.Run("Error", func(t *testing.T) {
+		client := mocks.NewRepoServerServiceClient(t)
+		stream := mocks.NewRepoServerService_GenerateManifestStreamClient(t)
+		stream.EXPECT().Recv().Return(nil, status.Error(codes.ResourceExhausted, "out of memory"))
+		client.EXPECT().GenerateManifestStream(mock.Anything, req).Return(stream, nil)
+		_, err := apiclient.GenerateManifestStreamed(context.Background(), client, req)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
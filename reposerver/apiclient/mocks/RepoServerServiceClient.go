@@ -128,6 +128,93 @@ func (_c *RepoServerServiceClient_GenerateManifest_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// GenerateManifestStream provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GenerateManifestStream(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateManifestStream")
+	}
+
+	var r0 apiclient.RepoServerService_GenerateManifestStreamClient
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestStreamClient); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apiclient.RepoServerService_GenerateManifestStreamClient)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.ManifestRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GenerateManifestStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateManifestStream'
+type RepoServerServiceClient_GenerateManifestStream_Call struct {
+	*mock.Call
+}
+
+// GenerateManifestStream is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.ManifestRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GenerateManifestStream(ctx any, in any, opts ...any) *RepoServerServiceClient_GenerateManifestStream_Call {
+	return &RepoServerServiceClient_GenerateManifestStream_Call{Call: _e.mock.On("GenerateManifestStream",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) Run(run func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.ManifestRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.ManifestRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) Return(repoServerService_GenerateManifestStreamClient apiclient.RepoServerService_GenerateManifestStreamClient, err error) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Return(repoServerService_GenerateManifestStreamClient, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GenerateManifestStream_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestStreamClient, error)) *RepoServerServiceClient_GenerateManifestStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateManifestWithFiles provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestWithFilesClient, error) {
 	// grpc.CallOption
@@ -731,6 +818,93 @@ func (_c *RepoServerServiceClient_GetRevisionChartDetails_Call) RunAndReturn(run
 	return _c
 }
 
+// GetRevisionComparison provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) GetRevisionComparison(ctx context.Context, in *apiclient.RepoServerRevisionComparisonRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionComparison")
+	}
+
+	var r0 *v1alpha1.RevisionComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.RepoServerRevisionComparisonRequest, ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.RepoServerRevisionComparisonRequest, ...grpc.CallOption) *v1alpha1.RevisionComparison); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevisionComparison)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.RepoServerRevisionComparisonRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_GetRevisionComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionComparison'
+type RepoServerServiceClient_GetRevisionComparison_Call struct {
+	*mock.Call
+}
+
+// GetRevisionComparison is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.RepoServerRevisionComparisonRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) GetRevisionComparison(ctx any, in any, opts ...any) *RepoServerServiceClient_GetRevisionComparison_Call {
+	return &RepoServerServiceClient_GetRevisionComparison_Call{Call: _e.mock.On("GetRevisionComparison",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_GetRevisionComparison_Call) Run(run func(ctx context.Context, in *apiclient.RepoServerRevisionComparisonRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_GetRevisionComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.RepoServerRevisionComparisonRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.RepoServerRevisionComparisonRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetRevisionComparison_Call) Return(revisionComparison *v1alpha1.RevisionComparison, err error) *RepoServerServiceClient_GetRevisionComparison_Call {
+	_c.Call.Return(revisionComparison, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_GetRevisionComparison_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.RepoServerRevisionComparisonRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)) *RepoServerServiceClient_GetRevisionComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRevisionMetadata provides a mock function for the type RepoServerServiceClient
 func (_mock *RepoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *apiclient.RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error) {
 	// grpc.CallOption
@@ -1426,3 +1600,90 @@ func (_c *RepoServerServiceClient_UpdateRevisionForPaths_Call) RunAndReturn(run
 	_c.Call.Return(run)
 	return _c
 }
+
+// ValidateRepository provides a mock function for the type RepoServerServiceClient
+func (_mock *RepoServerServiceClient) ValidateRepository(ctx context.Context, in *apiclient.TestRepositoryRequest, opts ...grpc.CallOption) (*apiclient.ValidateRepositoryResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateRepository")
+	}
+
+	var r0 *apiclient.ValidateRepositoryResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.TestRepositoryRequest, ...grpc.CallOption) (*apiclient.ValidateRepositoryResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.TestRepositoryRequest, ...grpc.CallOption) *apiclient.ValidateRepositoryResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ValidateRepositoryResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.TestRepositoryRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerServiceClient_ValidateRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateRepository'
+type RepoServerServiceClient_ValidateRepository_Call struct {
+	*mock.Call
+}
+
+// ValidateRepository is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.TestRepositoryRequest
+//   - opts ...grpc.CallOption
+func (_e *RepoServerServiceClient_Expecter) ValidateRepository(ctx any, in any, opts ...any) *RepoServerServiceClient_ValidateRepository_Call {
+	return &RepoServerServiceClient_ValidateRepository_Call{Call: _e.mock.On("ValidateRepository",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *RepoServerServiceClient_ValidateRepository_Call) Run(run func(ctx context.Context, in *apiclient.TestRepositoryRequest, opts ...grpc.CallOption)) *RepoServerServiceClient_ValidateRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.TestRepositoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.TestRepositoryRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerServiceClient_ValidateRepository_Call) Return(validateRepositoryResponse *apiclient.ValidateRepositoryResponse, err error) *RepoServerServiceClient_ValidateRepository_Call {
+	_c.Call.Return(validateRepositoryResponse, err)
+	return _c
+}
+
+func (_c *RepoServerServiceClient_ValidateRepository_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.TestRepositoryRequest, opts ...grpc.CallOption) (*apiclient.ValidateRepositoryResponse, error)) *RepoServerServiceClient_ValidateRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
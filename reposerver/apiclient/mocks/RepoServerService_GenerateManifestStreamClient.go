@@ -0,0 +1,388 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+// NewRepoServerService_GenerateManifestStreamClient creates a new instance of RepoServerService_GenerateManifestStreamClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRepoServerService_GenerateManifestStreamClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RepoServerService_GenerateManifestStreamClient {
+	mock := &RepoServerService_GenerateManifestStreamClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// RepoServerService_GenerateManifestStreamClient is an autogenerated mock type for the RepoServerService_GenerateManifestStreamClient type
+type RepoServerService_GenerateManifestStreamClient struct {
+	mock.Mock
+}
+
+type RepoServerService_GenerateManifestStreamClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RepoServerService_GenerateManifestStreamClient) EXPECT() *RepoServerService_GenerateManifestStreamClient_Expecter {
+	return &RepoServerService_GenerateManifestStreamClient_Expecter{mock: &_m.Mock}
+}
+
+// CloseSend provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) CloseSend() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloseSend")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RepoServerService_GenerateManifestStreamClient_CloseSend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloseSend'
+type RepoServerService_GenerateManifestStreamClient_CloseSend_Call struct {
+	*mock.Call
+}
+
+// CloseSend is a helper method to define mock.On call
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) CloseSend() *RepoServerService_GenerateManifestStreamClient_CloseSend_Call {
+	return &RepoServerService_GenerateManifestStreamClient_CloseSend_Call{Call: _e.mock.On("CloseSend")}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_CloseSend_Call) Run(run func()) *RepoServerService_GenerateManifestStreamClient_CloseSend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_CloseSend_Call) Return(err error) *RepoServerService_GenerateManifestStreamClient_CloseSend_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_CloseSend_Call) RunAndReturn(run func() error) *RepoServerService_GenerateManifestStreamClient_CloseSend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Context provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) Context() context.Context {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Context")
+	}
+
+	var r0 context.Context
+	if returnFunc, ok := ret.Get(0).(func() context.Context); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(context.Context)
+		}
+	}
+	return r0
+}
+
+// RepoServerService_GenerateManifestStreamClient_Context_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Context'
+type RepoServerService_GenerateManifestStreamClient_Context_Call struct {
+	*mock.Call
+}
+
+// Context is a helper method to define mock.On call
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) Context() *RepoServerService_GenerateManifestStreamClient_Context_Call {
+	return &RepoServerService_GenerateManifestStreamClient_Context_Call{Call: _e.mock.On("Context")}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Context_Call) Run(run func()) *RepoServerService_GenerateManifestStreamClient_Context_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Context_Call) Return(context1 context.Context) *RepoServerService_GenerateManifestStreamClient_Context_Call {
+	_c.Call.Return(context1)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Context_Call) RunAndReturn(run func() context.Context) *RepoServerService_GenerateManifestStreamClient_Context_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Header provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) Header() (metadata.MD, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Header")
+	}
+
+	var r0 metadata.MD
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (metadata.MD, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() metadata.MD); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metadata.MD)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerService_GenerateManifestStreamClient_Header_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Header'
+type RepoServerService_GenerateManifestStreamClient_Header_Call struct {
+	*mock.Call
+}
+
+// Header is a helper method to define mock.On call
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) Header() *RepoServerService_GenerateManifestStreamClient_Header_Call {
+	return &RepoServerService_GenerateManifestStreamClient_Header_Call{Call: _e.mock.On("Header")}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Header_Call) Run(run func()) *RepoServerService_GenerateManifestStreamClient_Header_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Header_Call) Return(mD metadata.MD, err error) *RepoServerService_GenerateManifestStreamClient_Header_Call {
+	_c.Call.Return(mD, err)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Header_Call) RunAndReturn(run func() (metadata.MD, error)) *RepoServerService_GenerateManifestStreamClient_Header_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Recv provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) Recv() (*apiclient.ManifestResponseChunk, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recv")
+	}
+
+	var r0 *apiclient.ManifestResponseChunk
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*apiclient.ManifestResponseChunk, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *apiclient.ManifestResponseChunk); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.ManifestResponseChunk)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RepoServerService_GenerateManifestStreamClient_Recv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recv'
+type RepoServerService_GenerateManifestStreamClient_Recv_Call struct {
+	*mock.Call
+}
+
+// Recv is a helper method to define mock.On call
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) Recv() *RepoServerService_GenerateManifestStreamClient_Recv_Call {
+	return &RepoServerService_GenerateManifestStreamClient_Recv_Call{Call: _e.mock.On("Recv")}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Recv_Call) Run(run func()) *RepoServerService_GenerateManifestStreamClient_Recv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Recv_Call) Return(manifestResponseChunk *apiclient.ManifestResponseChunk, err error) *RepoServerService_GenerateManifestStreamClient_Recv_Call {
+	_c.Call.Return(manifestResponseChunk, err)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Recv_Call) RunAndReturn(run func() (*apiclient.ManifestResponseChunk, error)) *RepoServerService_GenerateManifestStreamClient_Recv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecvMsg provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) RecvMsg(m any) error {
+	ret := _mock.Called(m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecvMsg")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(any) error); ok {
+		r0 = returnFunc(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RepoServerService_GenerateManifestStreamClient_RecvMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecvMsg'
+type RepoServerService_GenerateManifestStreamClient_RecvMsg_Call struct {
+	*mock.Call
+}
+
+// RecvMsg is a helper method to define mock.On call
+//   - m any
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) RecvMsg(m any) *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call {
+	return &RepoServerService_GenerateManifestStreamClient_RecvMsg_Call{Call: _e.mock.On("RecvMsg", m)}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call) Run(run func(m any)) *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 any
+		if args[0] != nil {
+			arg0 = args[0].(any)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call) Return(err error) *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call) RunAndReturn(run func(m any) error) *RepoServerService_GenerateManifestStreamClient_RecvMsg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMsg provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) SendMsg(m any) error {
+	ret := _mock.Called(m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMsg")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(any) error); ok {
+		r0 = returnFunc(m)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// RepoServerService_GenerateManifestStreamClient_SendMsg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMsg'
+type RepoServerService_GenerateManifestStreamClient_SendMsg_Call struct {
+	*mock.Call
+}
+
+// SendMsg is a helper method to define mock.On call
+//   - m any
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) SendMsg(m any) *RepoServerService_GenerateManifestStreamClient_SendMsg_Call {
+	return &RepoServerService_GenerateManifestStreamClient_SendMsg_Call{Call: _e.mock.On("SendMsg", m)}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_SendMsg_Call) Run(run func(m any)) *RepoServerService_GenerateManifestStreamClient_SendMsg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 any
+		if args[0] != nil {
+			arg0 = args[0].(any)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_SendMsg_Call) Return(err error) *RepoServerService_GenerateManifestStreamClient_SendMsg_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_SendMsg_Call) RunAndReturn(run func(m any) error) *RepoServerService_GenerateManifestStreamClient_SendMsg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trailer provides a mock function for the type RepoServerService_GenerateManifestStreamClient
+func (_mock *RepoServerService_GenerateManifestStreamClient) Trailer() metadata.MD {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trailer")
+	}
+
+	var r0 metadata.MD
+	if returnFunc, ok := ret.Get(0).(func() metadata.MD); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metadata.MD)
+		}
+	}
+	return r0
+}
+
+// RepoServerService_GenerateManifestStreamClient_Trailer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trailer'
+type RepoServerService_GenerateManifestStreamClient_Trailer_Call struct {
+	*mock.Call
+}
+
+// Trailer is a helper method to define mock.On call
+func (_e *RepoServerService_GenerateManifestStreamClient_Expecter) Trailer() *RepoServerService_GenerateManifestStreamClient_Trailer_Call {
+	return &RepoServerService_GenerateManifestStreamClient_Trailer_Call{Call: _e.mock.On("Trailer")}
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Trailer_Call) Run(run func()) *RepoServerService_GenerateManifestStreamClient_Trailer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Trailer_Call) Return(mD metadata.MD) *RepoServerService_GenerateManifestStreamClient_Trailer_Call {
+	_c.Call.Return(mD)
+	return _c
+}
+
+func (_c *RepoServerService_GenerateManifestStreamClient_Trailer_Call) RunAndReturn(run func() metadata.MD) *RepoServerService_GenerateManifestStreamClient_Trailer_Call {
+	_c.Call.Return(run)
+	return _c
+}
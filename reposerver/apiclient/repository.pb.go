@@ -65,10 +65,12 @@ type ManifestRequest struct {
 	// Holds instance installation id
 	InstallationID string `protobuf:"bytes,27,opt,name=installationID,proto3" json:"installationID,omitempty"`
 	// Source integrity constrains to verify the sources before use
-	SourceIntegrity      *v1alpha1.SourceIntegrity `protobuf:"bytes,28,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	SourceIntegrity *v1alpha1.SourceIntegrity `protobuf:"bytes,28,opt,name=sourceIntegrity,proto3" json:"sourceIntegrity,omitempty"`
+	// Environment of the application, selecting the .argocd-source.<environment>.yaml parameter override file
+	SourceEnvironment    string   `protobuf:"bytes,29,opt,name=sourceEnvironment,proto3" json:"sourceEnvironment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -279,6 +281,13 @@ func (m *ManifestRequest) GetSourceIntegrity() *v1alpha1.SourceIntegrity {
 	return nil
 }
 
+func (m *ManifestRequest) GetSourceEnvironment() string {
+	if m != nil {
+		return m.SourceEnvironment
+	}
+	return ""
+}
+
 type ManifestRequestWithFiles struct {
 	// Types that are valid to be assigned to Part:
 	//	*ManifestRequestWithFiles_Request
@@ -581,6 +590,130 @@ func (m *TestRepositoryResponse) GetVerifiedRepository() bool {
 	return false
 }
 
+// RepositoryValidationStage is the result of a stage of the validation of a repository
+type RepositoryValidationStage struct {
+	// Name of the stage: dns, tcp, tls, auth, refs or lfs
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Status of the stage: Successful, Failed or Skipped
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Message describes the outcome of the stage
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Details lists additional information about the outcome, e.g. the certificates served by the server
+	Details              []string `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepositoryValidationStage) Reset()         { *m = RepositoryValidationStage{} }
+func (m *RepositoryValidationStage) String() string { return proto.CompactTextString(m) }
+func (*RepositoryValidationStage) ProtoMessage()    {}
+func (*RepositoryValidationStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{6}
+}
+func (m *RepositoryValidationStage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryValidationStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryValidationStage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepositoryValidationStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryValidationStage.Merge(m, src)
+}
+func (m *RepositoryValidationStage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryValidationStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryValidationStage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryValidationStage proto.InternalMessageInfo
+
+func (m *RepositoryValidationStage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepositoryValidationStage) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *RepositoryValidationStage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *RepositoryValidationStage) GetDetails() []string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+// ValidateRepositoryResponse contains the results of the stages of the validation of a repository
+type ValidateRepositoryResponse struct {
+	Stages               []*RepositoryValidationStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ValidateRepositoryResponse) Reset()         { *m = ValidateRepositoryResponse{} }
+func (m *ValidateRepositoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateRepositoryResponse) ProtoMessage()    {}
+func (*ValidateRepositoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{7}
+}
+func (m *ValidateRepositoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidateRepositoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidateRepositoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidateRepositoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateRepositoryResponse.Merge(m, src)
+}
+func (m *ValidateRepositoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidateRepositoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateRepositoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateRepositoryResponse proto.InternalMessageInfo
+
+func (m *ValidateRepositoryResponse) GetStages() []*RepositoryValidationStage {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
 // ResolveRevisionRequest
 type ResolveRevisionRequest struct {
 	Repo                 *v1alpha1.Repository  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -597,7 +730,7 @@ func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{}
 func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionRequest) ProtoMessage()    {}
 func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -675,7 +808,7 @@ func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse
 func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionResponse) ProtoMessage()    {}
 func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +872,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{10}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,6 +957,73 @@ func (m *ManifestResponse) GetSourceIntegrityResult() *v1alpha1.SourceIntegrityC
 	return nil
 }
 
+// ManifestResponseChunk is a part of a manifest response streamed by GenerateManifestStream
+type ManifestResponseChunk struct {
+	// manifests are the next manifests of the response
+	Manifests []string `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	// response is the response without its manifests, only set in the last chunk
+	Response *ManifestResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	// checksum is the hex encoded sha256 of all the manifests of the response, only set in the last chunk
+	Checksum             string   `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestResponseChunk) Reset()         { *m = ManifestResponseChunk{} }
+func (m *ManifestResponseChunk) String() string { return proto.CompactTextString(m) }
+func (*ManifestResponseChunk) ProtoMessage()    {}
+func (*ManifestResponseChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{11}
+}
+func (m *ManifestResponseChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestResponseChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestResponseChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestResponseChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestResponseChunk.Merge(m, src)
+}
+func (m *ManifestResponseChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestResponseChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestResponseChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestResponseChunk proto.InternalMessageInfo
+
+func (m *ManifestResponseChunk) GetManifests() []string {
+	if m != nil {
+		return m.Manifests
+	}
+	return nil
+}
+
+func (m *ManifestResponseChunk) GetResponse() *ManifestResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ManifestResponseChunk) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -835,7 +1035,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1084,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1141,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1203,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1250,7 @@ func (m *PluginInfo) Reset()         { *m = PluginInfo{} }
 func (m *PluginInfo) String() string { return proto.CompactTextString(m) }
 func (*PluginInfo) ProtoMessage()    {}
 func (*PluginInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *PluginInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,7 +1298,7 @@ func (m *PluginList) Reset()         { *m = PluginList{} }
 func (m *PluginList) String() string { return proto.CompactTextString(m) }
 func (*PluginList) ProtoMessage()    {}
 func (*PluginList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *PluginList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,27 +1336,29 @@ func (m *PluginList) GetItems() []*PluginInfo {
 
 // RepoServerAppDetailsQuery contains query information for app details request
 type RepoServerAppDetailsQuery struct {
-	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Source               *v1alpha1.ApplicationSource    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
-	Repos                []*v1alpha1.Repository         `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
-	KustomizeOptions     *v1alpha1.KustomizeOptions     `protobuf:"bytes,4,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
-	AppName              string                         `protobuf:"bytes,5,opt,name=appName,proto3" json:"appName,omitempty"`
-	NoCache              bool                           `protobuf:"varint,6,opt,name=noCache,proto3" json:"noCache,omitempty"`
-	NoRevisionCache      bool                           `protobuf:"varint,7,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	TrackingMethod       string                         `protobuf:"bytes,8,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	EnabledSourceTypes   map[string]bool                `protobuf:"bytes,9,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	HelmOptions          *v1alpha1.HelmOptions          `protobuf:"bytes,10,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
-	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,11,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Repo               *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Source             *v1alpha1.ApplicationSource    `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Repos              []*v1alpha1.Repository         `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	KustomizeOptions   *v1alpha1.KustomizeOptions     `protobuf:"bytes,4,opt,name=kustomizeOptions,proto3" json:"kustomizeOptions,omitempty"`
+	AppName            string                         `protobuf:"bytes,5,opt,name=appName,proto3" json:"appName,omitempty"`
+	NoCache            bool                           `protobuf:"varint,6,opt,name=noCache,proto3" json:"noCache,omitempty"`
+	NoRevisionCache    bool                           `protobuf:"varint,7,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	TrackingMethod     string                         `protobuf:"bytes,8,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	EnabledSourceTypes map[string]bool                `protobuf:"bytes,9,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions        *v1alpha1.HelmOptions          `protobuf:"bytes,10,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	RefSources         map[string]*v1alpha1.RefTarget `protobuf:"bytes,11,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Environment of the application, selecting the .argocd-source.<environment>.yaml parameter override file
+	SourceEnvironment    string   `protobuf:"bytes,12,opt,name=sourceEnvironment,proto3" json:"sourceEnvironment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQuery{} }
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1464,13 @@ func (m *RepoServerAppDetailsQuery) GetRefSources() map[string]*v1alpha1.RefTarg
 	return nil
 }
 
+func (m *RepoServerAppDetailsQuery) GetSourceEnvironment() string {
+	if m != nil {
+		return m.SourceEnvironment
+	}
+	return ""
+}
+
 // RepoAppDetailsResponse application details
 type RepoAppDetailsResponse struct {
 	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -1278,7 +1487,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1569,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1417,6 +1626,72 @@ func (m *RepoServerRevisionMetadataRequest) GetSourceIntegrity() *v1alpha1.Sourc
 	return nil
 }
 
+type RepoServerRevisionComparisonRequest struct {
+	// the repo
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// the revision the comparison starts from, typically the currently synced revision
+	Revision string `protobuf:"bytes,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// the revision the comparison ends at, typically the revision which will be synced
+	TargetRevision       string   `protobuf:"bytes,3,opt,name=targetRevision,proto3" json:"targetRevision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoServerRevisionComparisonRequest) Reset()         { *m = RepoServerRevisionComparisonRequest{} }
+func (m *RepoServerRevisionComparisonRequest) String() string { return proto.CompactTextString(m) }
+func (*RepoServerRevisionComparisonRequest) ProtoMessage()    {}
+func (*RepoServerRevisionComparisonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{21}
+}
+func (m *RepoServerRevisionComparisonRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoServerRevisionComparisonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoServerRevisionComparisonRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoServerRevisionComparisonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoServerRevisionComparisonRequest.Merge(m, src)
+}
+func (m *RepoServerRevisionComparisonRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoServerRevisionComparisonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoServerRevisionComparisonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoServerRevisionComparisonRequest proto.InternalMessageInfo
+
+func (m *RepoServerRevisionComparisonRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoServerRevisionComparisonRequest) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+func (m *RepoServerRevisionComparisonRequest) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
 type RepoServerRevisionChartDetailsRequest struct {
 	// the repo
 	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -1433,7 +1708,7 @@ func (m *RepoServerRevisionChartDetailsRequest) Reset()         { *m = RepoServe
 func (m *RepoServerRevisionChartDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionChartDetailsRequest) ProtoMessage()    {}
 func (*RepoServerRevisionChartDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
 func (m *RepoServerRevisionChartDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1502,7 +1777,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{19}
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1854,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{20}
+	return fileDescriptor_dd8723cfcc820480, []int{24}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1901,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{21}
+	return fileDescriptor_dd8723cfcc820480, []int{25}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1686,7 +1961,7 @@ func (m *ParameterAnnouncement) Reset()         { *m = ParameterAnnouncement{} }
 func (m *ParameterAnnouncement) String() string { return proto.CompactTextString(m) }
 func (*ParameterAnnouncement) ProtoMessage()    {}
 func (*ParameterAnnouncement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{22}
+	return fileDescriptor_dd8723cfcc820480, []int{26}
 }
 func (m *ParameterAnnouncement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1790,7 +2065,7 @@ func (m *PluginAppSpec) Reset()         { *m = PluginAppSpec{} }
 func (m *PluginAppSpec) String() string { return proto.CompactTextString(m) }
 func (*PluginAppSpec) ProtoMessage()    {}
 func (*PluginAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{23}
+	return fileDescriptor_dd8723cfcc820480, []int{27}
 }
 func (m *PluginAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +2112,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{24}
+	return fileDescriptor_dd8723cfcc820480, []int{28}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1885,7 +2160,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{25}
+	return fileDescriptor_dd8723cfcc820480, []int{29}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1939,7 +2214,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{26}
+	return fileDescriptor_dd8723cfcc820480, []int{30}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,7 +2270,7 @@ func (m *GitFilesRequest) Reset()         { *m = GitFilesRequest{} }
 func (m *GitFilesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitFilesRequest) ProtoMessage()    {}
 func (*GitFilesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{27}
+	return fileDescriptor_dd8723cfcc820480, []int{31}
 }
 func (m *GitFilesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2092,7 +2367,7 @@ func (m *GitFilesResponse) Reset()         { *m = GitFilesResponse{} }
 func (m *GitFilesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitFilesResponse) ProtoMessage()    {}
 func (*GitFilesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{28}
+	return fileDescriptor_dd8723cfcc820480, []int{32}
 }
 func (m *GitFilesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2146,7 +2421,7 @@ func (m *GitDirectoriesRequest) Reset()         { *m = GitDirectoriesRequest{} }
 func (m *GitDirectoriesRequest) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesRequest) ProtoMessage()    {}
 func (*GitDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{29}
+	return fileDescriptor_dd8723cfcc820480, []int{33}
 }
 func (m *GitDirectoriesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2229,7 +2504,7 @@ func (m *GitDirectoriesResponse) Reset()         { *m = GitDirectoriesResponse{}
 func (m *GitDirectoriesResponse) String() string { return proto.CompactTextString(m) }
 func (*GitDirectoriesResponse) ProtoMessage()    {}
 func (*GitDirectoriesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{30}
+	return fileDescriptor_dd8723cfcc820480, []int{34}
 }
 func (m *GitDirectoriesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,32 +2541,34 @@ func (m *GitDirectoriesResponse) GetPaths() []string {
 }
 
 type UpdateRevisionForPathsRequest struct {
-	Repo                 *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	AppLabelKey          string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
-	AppName              string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
-	Namespace            string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	ApplicationSource    *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
-	TrackingMethod       string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	RefSources           map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	KubeVersion          string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
-	ApiVersions          []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
-	HasMultipleSources   bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
-	SyncedRevision       string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
-	Revision             string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
-	Paths                []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
-	NoRevisionCache      bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	InstallationID       string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
-	SyncedRefSources     map[string]*v1alpha1.RefTarget `protobuf:"bytes,16,rep,name=syncedRefSources,proto3" json:"syncedRefSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+	Repo               *v1alpha1.Repository           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	AppLabelKey        string                         `protobuf:"bytes,2,opt,name=appLabelKey,proto3" json:"appLabelKey,omitempty"`
+	AppName            string                         `protobuf:"bytes,3,opt,name=appName,proto3" json:"appName,omitempty"`
+	Namespace          string                         `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	ApplicationSource  *v1alpha1.ApplicationSource    `protobuf:"bytes,5,opt,name=applicationSource,proto3" json:"applicationSource,omitempty"`
+	TrackingMethod     string                         `protobuf:"bytes,6,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	RefSources         map[string]*v1alpha1.RefTarget `protobuf:"bytes,7,rep,name=refSources,proto3" json:"refSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubeVersion        string                         `protobuf:"bytes,8,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
+	ApiVersions        []string                       `protobuf:"bytes,9,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
+	HasMultipleSources bool                           `protobuf:"varint,10,opt,name=hasMultipleSources,proto3" json:"hasMultipleSources,omitempty"`
+	SyncedRevision     string                         `protobuf:"bytes,11,opt,name=syncedRevision,proto3" json:"syncedRevision,omitempty"`
+	Revision           string                         `protobuf:"bytes,12,opt,name=revision,proto3" json:"revision,omitempty"`
+	Paths              []string                       `protobuf:"bytes,13,rep,name=paths,proto3" json:"paths,omitempty"`
+	NoRevisionCache    bool                           `protobuf:"varint,14,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	InstallationID     string                         `protobuf:"bytes,15,opt,name=installationID,proto3" json:"installationID,omitempty"`
+	SyncedRefSources   map[string]*v1alpha1.RefTarget `protobuf:"bytes,16,rep,name=syncedRefSources,proto3" json:"syncedRefSources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Environment of the application, selecting the .argocd-source.<environment>.yaml parameter override file
+	SourceEnvironment    string   `protobuf:"bytes,17,opt,name=sourceEnvironment,proto3" json:"sourceEnvironment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateRevisionForPathsRequest) Reset()         { *m = UpdateRevisionForPathsRequest{} }
 func (m *UpdateRevisionForPathsRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsRequest) ProtoMessage()    {}
 func (*UpdateRevisionForPathsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{31}
+	return fileDescriptor_dd8723cfcc820480, []int{35}
 }
 func (m *UpdateRevisionForPathsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2432,6 +2709,13 @@ func (m *UpdateRevisionForPathsRequest) GetSyncedRefSources() map[string]*v1alph
 	return nil
 }
 
+func (m *UpdateRevisionForPathsRequest) GetSourceEnvironment() string {
+	if m != nil {
+		return m.SourceEnvironment
+	}
+	return ""
+}
+
 type UpdateRevisionForPathsResponse struct {
 	// Changes indicates whether any changes were detected in the provided paths. If false, it means that the manifest
 	// cache was updated to the new revision. If true, it means that there are relevant changes in the repo files and
@@ -2447,7 +2731,7 @@ func (m *UpdateRevisionForPathsResponse) Reset()         { *m = UpdateRevisionFo
 func (m *UpdateRevisionForPathsResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateRevisionForPathsResponse) ProtoMessage()    {}
 func (*UpdateRevisionForPathsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{32}
+	return fileDescriptor_dd8723cfcc820480, []int{36}
 }
 func (m *UpdateRevisionForPathsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2499,9 +2783,12 @@ func init() {
 	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
 	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
 	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
+	proto.RegisterType((*RepositoryValidationStage)(nil), "repository.RepositoryValidationStage")
+	proto.RegisterType((*ValidateRepositoryResponse)(nil), "repository.ValidateRepositoryResponse")
 	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
 	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
 	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ManifestResponseChunk)(nil), "repository.ManifestResponseChunk")
 	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
 	proto.RegisterType((*Refs)(nil), "repository.Refs")
 	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
@@ -2515,6 +2802,7 @@ func init() {
 	proto.RegisterMapType((map[string]*v1alpha1.RefTarget)(nil), "repository.RepoServerAppDetailsQuery.RefSourcesEntry")
 	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
 	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
+	proto.RegisterType((*RepoServerRevisionComparisonRequest)(nil), "repository.RepoServerRevisionComparisonRequest")
 	proto.RegisterType((*RepoServerRevisionChartDetailsRequest)(nil), "repository.RepoServerRevisionChartDetailsRequest")
 	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
 	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
@@ -2541,165 +2829,178 @@ func init() {
 }
 
 var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 2514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x73, 0xdc, 0x48,
-	0xd5, 0xf3, 0x61, 0x7b, 0xe6, 0xd9, 0xb1, 0xc7, 0x9d, 0xd8, 0x51, 0x26, 0x89, 0xf1, 0x0a, 0x92,
-	0xca, 0x26, 0xbb, 0xe3, 0x4a, 0x52, 0xbb, 0x81, 0xec, 0xb2, 0x5b, 0x5e, 0x27, 0xb1, 0xbd, 0x89,
-	0x13, 0x23, 0x67, 0x17, 0x02, 0x01, 0xaa, 0xad, 0x69, 0x6b, 0xb4, 0xd6, 0x47, 0x47, 0x6a, 0x39,
-	0x4c, 0xaa, 0x38, 0x41, 0x71, 0xa2, 0x28, 0x4e, 0x7b, 0xe0, 0x7f, 0x50, 0xdc, 0xe0, 0x08, 0x17,
-	0xaa, 0xb6, 0xa8, 0xe2, 0x0c, 0x95, 0xbf, 0xc0, 0x9d, 0xa2, 0xfa, 0x43, 0x1a, 0x49, 0xa3, 0x19,
-	0x7b, 0x33, 0xce, 0x04, 0xb8, 0xd8, 0xea, 0xa7, 0xd7, 0xef, 0x3d, 0xbd, 0x7e, 0xdf, 0x3d, 0x70,
-	0x39, 0x20, 0xd4, 0x0f, 0x49, 0x70, 0x48, 0x82, 0x55, 0xf1, 0x68, 0x33, 0x3f, 0xe8, 0xa6, 0x1e,
-	0x5b, 0x34, 0xf0, 0x99, 0x8f, 0xa0, 0x07, 0x69, 0x3e, 0xb0, 0x6c, 0xd6, 0x89, 0xf6, 0x5a, 0xa6,
-	0xef, 0xae, 0xe2, 0xc0, 0xf2, 0x69, 0xe0, 0x7f, 0x21, 0x1e, 0xde, 0x35, 0xdb, 0xab, 0x87, 0x37,
-	0x57, 0xe9, 0x81, 0xb5, 0x8a, 0xa9, 0x1d, 0xae, 0x62, 0x4a, 0x1d, 0xdb, 0xc4, 0xcc, 0xf6, 0xbd,
-	0xd5, 0xc3, 0xeb, 0xd8, 0xa1, 0x1d, 0x7c, 0x7d, 0xd5, 0x22, 0x1e, 0x09, 0x30, 0x23, 0x6d, 0x49,
-	0xb9, 0x79, 0xde, 0xf2, 0x7d, 0xcb, 0x21, 0xab, 0x62, 0xb5, 0x17, 0xed, 0xaf, 0x12, 0x97, 0x32,
-	0xc5, 0x56, 0xff, 0xfb, 0x1c, 0xcc, 0x6f, 0x63, 0xcf, 0xde, 0x27, 0x21, 0x33, 0xc8, 0xb3, 0x88,
-	0x84, 0x0c, 0x3d, 0x85, 0x2a, 0x17, 0x46, 0x2b, 0xad, 0x94, 0xae, 0xcc, 0xdc, 0xd8, 0x6c, 0xf5,
-	0xa4, 0x69, 0xc5, 0xd2, 0x88, 0x87, 0x9f, 0x9a, 0xed, 0xd6, 0xe1, 0xcd, 0x16, 0x3d, 0xb0, 0x5a,
-	0x5c, 0x9a, 0x56, 0x4a, 0x9a, 0x56, 0x2c, 0x4d, 0xcb, 0x48, 0x3e, 0xcb, 0x10, 0x54, 0x51, 0x13,
-	0x6a, 0x01, 0x39, 0xb4, 0x43, 0xdb, 0xf7, 0xb4, 0xf2, 0x4a, 0xe9, 0x4a, 0xdd, 0x48, 0xd6, 0x48,
-	0x83, 0x69, 0xcf, 0x5f, 0xc7, 0x66, 0x87, 0x68, 0x95, 0x95, 0xd2, 0x95, 0x9a, 0x11, 0x2f, 0xd1,
-	0x0a, 0xcc, 0x60, 0x4a, 0x1f, 0xe0, 0x3d, 0xe2, 0xdc, 0x27, 0x5d, 0xad, 0x2a, 0x36, 0xa6, 0x41,
-	0x7c, 0x2f, 0xa6, 0xf4, 0x21, 0x76, 0x89, 0x36, 0x29, 0xde, 0xc6, 0x4b, 0x74, 0x01, 0xea, 0x1e,
-	0x76, 0x49, 0x48, 0xb1, 0x49, 0xb4, 0x9a, 0x78, 0xd7, 0x03, 0xa0, 0x9f, 0xc3, 0x42, 0x4a, 0xf0,
-	0x5d, 0x3f, 0x0a, 0x4c, 0xa2, 0x81, 0xf8, 0xf4, 0x47, 0xa3, 0x7d, 0xfa, 0x5a, 0x9e, 0xac, 0xd1,
-	0xcf, 0x09, 0xfd, 0x04, 0x26, 0xc5, 0xc9, 0x6b, 0x33, 0x2b, 0x95, 0x13, 0xd5, 0xb6, 0x24, 0x8b,
-	0x3c, 0x98, 0xa6, 0x4e, 0x64, 0xd9, 0x5e, 0xa8, 0xcd, 0x0a, 0x0e, 0x8f, 0x47, 0xe3, 0xb0, 0xee,
-	0x7b, 0xfb, 0xb6, 0xb5, 0x8d, 0x3d, 0x6c, 0x11, 0x97, 0x78, 0x6c, 0x47, 0x10, 0x37, 0x62, 0x26,
-	0xe8, 0x05, 0x34, 0x0e, 0xa2, 0x90, 0xf9, 0xae, 0xfd, 0x82, 0x3c, 0xa2, 0x7c, 0x6f, 0xa8, 0x9d,
-	0x12, 0xda, 0x7c, 0x38, 0x1a, 0xe3, 0xfb, 0x39, 0xaa, 0x46, 0x1f, 0x1f, 0x6e, 0x24, 0x07, 0xd1,
-	0x1e, 0xf9, 0x9c, 0x04, 0xc2, 0xba, 0xe6, 0xa4, 0x91, 0xa4, 0x40, 0xd2, 0x8c, 0x6c, 0xb5, 0x0a,
-	0xb5, 0xf9, 0x95, 0x8a, 0x34, 0xa3, 0x04, 0x84, 0xae, 0xc0, 0xfc, 0x21, 0x09, 0xec, 0xfd, 0xee,
-	0xae, 0x6d, 0x79, 0x98, 0x45, 0x01, 0xd1, 0x1a, 0xc2, 0x14, 0xf3, 0x60, 0xe4, 0xc2, 0xa9, 0x0e,
-	0x71, 0x5c, 0xae, 0xf2, 0xf5, 0x80, 0xb4, 0x43, 0x6d, 0x41, 0xe8, 0x77, 0x63, 0xf4, 0x13, 0x14,
-	0xe4, 0x8c, 0x2c, 0x75, 0x2e, 0x98, 0xe7, 0x1b, 0xca, 0x53, 0xa4, 0x8f, 0x20, 0x29, 0x58, 0x0e,
-	0x8c, 0x2e, 0xc3, 0x1c, 0x0b, 0xb0, 0x79, 0x60, 0x7b, 0xd6, 0x36, 0x61, 0x1d, 0xbf, 0xad, 0x9d,
-	0x16, 0x9a, 0xc8, 0x41, 0x91, 0x09, 0x88, 0x78, 0x78, 0xcf, 0x21, 0x6d, 0x69, 0x8b, 0x8f, 0xbb,
-	0x94, 0x84, 0xda, 0x19, 0xf1, 0x15, 0x37, 0x5b, 0xa9, 0x08, 0x95, 0x0b, 0x10, 0xad, 0xbb, 0x7d,
-	0xbb, 0xee, 0x7a, 0x2c, 0xe8, 0x1a, 0x05, 0xe4, 0xd0, 0x01, 0xcc, 0xf0, 0xef, 0x88, 0x4d, 0x61,
-	0x51, 0x98, 0xc2, 0xd6, 0x68, 0x3a, 0xda, 0xec, 0x11, 0x34, 0xd2, 0xd4, 0x51, 0x0b, 0x50, 0x07,
-	0x87, 0xdb, 0x91, 0xc3, 0x6c, 0xea, 0x10, 0x29, 0x46, 0xa8, 0x2d, 0x09, 0x35, 0x15, 0xbc, 0x41,
-	0xf7, 0x01, 0x02, 0xb2, 0x1f, 0xe3, 0x9d, 0x15, 0x5f, 0x7e, 0x6d, 0xd8, 0x97, 0x1b, 0x09, 0xb6,
-	0xfc, 0xe2, 0xd4, 0x76, 0xce, 0x9c, 0x7f, 0x06, 0x31, 0x99, 0xf2, 0x76, 0xe1, 0xd6, 0x9a, 0x30,
-	0xb1, 0x82, 0x37, 0xdc, 0x16, 0x15, 0x54, 0x04, 0xad, 0x73, 0xd2, 0x5a, 0x53, 0x20, 0xb4, 0x09,
-	0xdf, 0xc0, 0x9e, 0xe7, 0x33, 0xf1, 0xf9, 0xb1, 0x28, 0x1b, 0x2a, 0xbc, 0xef, 0x60, 0xd6, 0x09,
-	0xb5, 0xa6, 0xd8, 0x75, 0x14, 0x1a, 0x37, 0x09, 0xdb, 0x0b, 0x19, 0x76, 0x1c, 0x81, 0xb4, 0x75,
-	0x47, 0x3b, 0x2f, 0x4d, 0x22, 0x0b, 0x45, 0xcf, 0x61, 0x3e, 0x14, 0x22, 0x6e, 0x79, 0x8c, 0x58,
-	0x81, 0xcd, 0xba, 0xda, 0x05, 0x71, 0x62, 0xdb, 0xa3, 0x9d, 0xd8, 0x6e, 0x96, 0xa8, 0x91, 0xe7,
-	0xd2, 0xbc, 0x0b, 0x67, 0x07, 0x58, 0x15, 0x6a, 0x40, 0xe5, 0x80, 0x74, 0x45, 0x36, 0xaa, 0x1b,
-	0xfc, 0x11, 0x9d, 0x81, 0xc9, 0x43, 0xec, 0x44, 0x44, 0xe4, 0x8f, 0x9a, 0x21, 0x17, 0xb7, 0xcb,
-	0xdf, 0x2e, 0x35, 0x7f, 0x55, 0x82, 0xf9, 0xdc, 0x19, 0x15, 0xec, 0xff, 0x71, 0x7a, 0xff, 0x09,
-	0x78, 0xec, 0xfe, 0x63, 0x1c, 0x58, 0x84, 0xa5, 0x04, 0xd1, 0xff, 0x56, 0x02, 0x2d, 0x67, 0x3c,
-	0xdf, 0xb7, 0x59, 0xe7, 0x9e, 0xed, 0x90, 0x10, 0xdd, 0x82, 0xe9, 0x40, 0xc2, 0x54, 0x8e, 0x3d,
-	0x3f, 0xc4, 0xe6, 0x36, 0x27, 0x8c, 0x18, 0x1b, 0x7d, 0x04, 0x35, 0x97, 0x30, 0xdc, 0xc6, 0x0c,
-	0x2b, 0xd9, 0x57, 0x8a, 0x76, 0x72, 0x2e, 0xdb, 0x0a, 0x6f, 0x73, 0xc2, 0x48, 0xf6, 0xa0, 0xf7,
-	0x60, 0xd2, 0xec, 0x44, 0xde, 0x81, 0xc8, 0xae, 0x33, 0x37, 0x2e, 0x0e, 0xda, 0xbc, 0xce, 0x91,
-	0x36, 0x27, 0x0c, 0x89, 0xfd, 0xc9, 0x14, 0x54, 0x29, 0x0e, 0x98, 0x7e, 0x0f, 0xce, 0x14, 0xb1,
-	0xe0, 0x29, 0xdd, 0xec, 0x10, 0xf3, 0x20, 0x8c, 0x5c, 0xa5, 0xe6, 0x64, 0x8d, 0x10, 0x54, 0x43,
-	0xfb, 0x85, 0x54, 0x75, 0xc5, 0x10, 0xcf, 0xfa, 0xdb, 0xb0, 0xd0, 0xc7, 0x8d, 0x1f, 0xaa, 0x94,
-	0x8d, 0x53, 0x98, 0x55, 0xac, 0xf5, 0x08, 0x16, 0x1f, 0x0b, 0x5d, 0x24, 0x79, 0x6d, 0x1c, 0x45,
-	0x8a, 0xbe, 0x09, 0x4b, 0x79, 0xb6, 0x21, 0xf5, 0xbd, 0x90, 0x70, 0x2f, 0x17, 0x89, 0xc0, 0x26,
-	0xed, 0xde, 0x5b, 0x21, 0x45, 0xcd, 0x28, 0x78, 0xa3, 0xff, 0xb5, 0x0c, 0x4b, 0x06, 0x09, 0x7d,
-	0xe7, 0x90, 0xc4, 0x51, 0x7a, 0x3c, 0x75, 0xd6, 0x8f, 0xa0, 0x82, 0x29, 0x55, 0x66, 0xb2, 0x75,
-	0x62, 0x95, 0x8c, 0xc1, 0xa9, 0xa2, 0x77, 0x60, 0x01, 0xbb, 0x7b, 0xb6, 0x15, 0xf9, 0x51, 0x18,
-	0x7f, 0x96, 0x30, 0xaa, 0xba, 0xd1, 0xff, 0x82, 0x47, 0xba, 0xd8, 0xdf, 0xdb, 0xe4, 0x67, 0xa2,
-	0x78, 0xab, 0x18, 0x69, 0x50, 0x51, 0x72, 0x9b, 0x2c, 0x4c, 0x6e, 0xba, 0x09, 0x67, 0xfb, 0xd4,
-	0xa9, 0x8e, 0x26, 0x5d, 0x59, 0x96, 0x72, 0x95, 0x65, 0xa1, 0xc0, 0xe5, 0x01, 0x02, 0xeb, 0xff,
-	0x2a, 0x43, 0xa3, 0xe7, 0x86, 0x8a, 0xfc, 0x05, 0xa8, 0xbb, 0x0a, 0x16, 0x6a, 0x25, 0x11, 0xd6,
-	0x7b, 0x80, 0x6c, 0x91, 0x59, 0xce, 0x17, 0x99, 0x4b, 0x30, 0x25, 0x7b, 0x00, 0xa5, 0x24, 0xb5,
-	0xca, 0x88, 0x5c, 0xcd, 0x89, 0xbc, 0x0c, 0x10, 0x26, 0xb1, 0x50, 0x9b, 0x12, 0x6f, 0x53, 0x10,
-	0xa4, 0xc3, 0xac, 0x2c, 0x49, 0x0c, 0x12, 0x46, 0x0e, 0xd3, 0xa6, 0x05, 0x46, 0x06, 0x26, 0x3c,
-	0xd3, 0x77, 0x5d, 0xec, 0xb5, 0x43, 0xad, 0x26, 0x44, 0x4e, 0xd6, 0xe8, 0x37, 0x25, 0x58, 0xcc,
-	0x85, 0x61, 0x45, 0xa9, 0x2e, 0x6c, 0xe6, 0x07, 0x27, 0x1a, 0xf2, 0xd7, 0x79, 0x40, 0x90, 0xf4,
-	0x8d, 0x62, 0xb6, 0xba, 0x0f, 0xf3, 0x0f, 0x6c, 0xae, 0xf0, 0xfd, 0x70, 0x3c, 0x5e, 0xfe, 0x3e,
-	0x54, 0x39, 0x33, 0xae, 0xa5, 0xbd, 0x00, 0x7b, 0x66, 0x87, 0xc4, 0x07, 0x9b, 0xac, 0x79, 0xfc,
-	0x62, 0xd8, 0x0a, 0xb5, 0xb2, 0x80, 0x8b, 0x67, 0xfd, 0x0f, 0x65, 0x29, 0xe9, 0x1a, 0xa5, 0xe1,
-	0x9b, 0x6f, 0x9a, 0x8a, 0xcb, 0xb8, 0x4a, 0x7f, 0x19, 0x97, 0x13, 0xf9, 0xeb, 0x94, 0x71, 0x27,
-	0x94, 0x9f, 0xf5, 0x08, 0xa6, 0xd7, 0x28, 0xe5, 0x82, 0xa0, 0xeb, 0x50, 0xc5, 0x94, 0x4a, 0x85,
-	0xe7, 0x52, 0x91, 0x42, 0xe1, 0xff, 0x95, 0x48, 0x02, 0xb5, 0x79, 0x0b, 0xea, 0x09, 0xe8, 0x28,
-	0xb6, 0xf5, 0x34, 0xdb, 0x15, 0x00, 0xd9, 0xa7, 0x6c, 0x79, 0xfb, 0x3e, 0x3f, 0x52, 0xee, 0x99,
-	0x6a, 0xab, 0x78, 0xd6, 0x6f, 0xc7, 0x18, 0x42, 0xb6, 0x77, 0x60, 0xd2, 0x66, 0xc4, 0x8d, 0x85,
-	0x5b, 0x4a, 0x0b, 0xd7, 0x23, 0x64, 0x48, 0x24, 0xfd, 0xcf, 0x35, 0x38, 0xc7, 0x4f, 0x6c, 0x57,
-	0xf8, 0xf4, 0x1a, 0xa5, 0x77, 0x08, 0xc3, 0xb6, 0x13, 0x7e, 0x2f, 0x22, 0x41, 0xf7, 0x35, 0x1b,
-	0x86, 0x05, 0x53, 0xd2, 0x99, 0x54, 0xa0, 0x3f, 0xf1, 0x96, 0x55, 0x91, 0xef, 0xf5, 0xa9, 0x95,
-	0xd7, 0xd3, 0xa7, 0x16, 0xf5, 0x8d, 0xd5, 0x31, 0xf5, 0x8d, 0x83, 0x47, 0x07, 0xa9, 0x81, 0xc4,
-	0x54, 0x76, 0x20, 0x51, 0x90, 0xb1, 0xa6, 0x8f, 0xdb, 0x8e, 0xd5, 0x0a, 0xdb, 0x31, 0xb7, 0xd0,
-	0x8f, 0xeb, 0x42, 0xdd, 0xdf, 0x4d, 0x5b, 0xe0, 0x40, 0x5b, 0x1b, 0xa5, 0x31, 0x83, 0xd7, 0xda,
-	0x98, 0x7d, 0x96, 0x69, 0xb4, 0xe4, 0xa8, 0xe3, 0xbd, 0xe3, 0x7d, 0xd3, 0x90, 0x96, 0xeb, 0xff,
-	0xae, 0x6b, 0xf8, 0xa5, 0x28, 0x16, 0xa9, 0xdf, 0xd3, 0x41, 0x52, 0x7d, 0xf0, 0x3c, 0xc4, 0xeb,
-	0x00, 0x15, 0xb4, 0xf8, 0x33, 0xba, 0x06, 0x55, 0xae, 0x64, 0x55, 0xcd, 0x9f, 0x4d, 0xeb, 0x93,
-	0x9f, 0xc4, 0x1a, 0xa5, 0xbb, 0x94, 0x98, 0x86, 0x40, 0x42, 0xb7, 0xa1, 0x9e, 0x18, 0xbe, 0xf2,
-	0xac, 0x0b, 0xe9, 0x1d, 0x89, 0x9f, 0xc4, 0xdb, 0x7a, 0xe8, 0x7c, 0x6f, 0xdb, 0x0e, 0x88, 0x29,
-	0x6a, 0xdd, 0xc9, 0xfe, 0xbd, 0x77, 0xe2, 0x97, 0xc9, 0xde, 0x04, 0x1d, 0x5d, 0x87, 0x29, 0x39,
-	0x1b, 0x12, 0x1e, 0x34, 0x73, 0xe3, 0x5c, 0x7f, 0x30, 0x8d, 0x77, 0x29, 0x44, 0xfd, 0x8f, 0x65,
-	0x78, 0xab, 0x67, 0x10, 0xb1, 0x37, 0xc5, 0xed, 0xc6, 0x9b, 0xcf, 0xb8, 0x97, 0x61, 0x4e, 0xf4,
-	0x37, 0xbd, 0x11, 0x91, 0x9c, 0x56, 0xe6, 0xa0, 0x45, 0xdd, 0x74, 0x75, 0x1c, 0xdd, 0xb4, 0xfe,
-	0xfb, 0x12, 0x5c, 0xea, 0x57, 0xe0, 0x7a, 0x07, 0x07, 0x2c, 0xb1, 0xab, 0x71, 0x28, 0x31, 0xce,
-	0xb4, 0xe5, 0x5e, 0xa6, 0xcd, 0x28, 0xb6, 0x92, 0x55, 0xac, 0xfe, 0xa7, 0x32, 0xcc, 0xa4, 0x2c,
-	0xb7, 0x28, 0x53, 0xf3, 0xb2, 0x58, 0x38, 0x8c, 0x68, 0xa5, 0x45, 0x36, 0xaa, 0x1b, 0x29, 0x08,
-	0x3a, 0x00, 0xa0, 0x38, 0xc0, 0x2e, 0x61, 0x24, 0xe0, 0x29, 0x84, 0x87, 0x9a, 0xfb, 0xa3, 0x87,
-	0xb5, 0x9d, 0x98, 0xa6, 0x91, 0x22, 0xcf, 0xeb, 0x7a, 0xc1, 0x3a, 0x54, 0x89, 0x43, 0xad, 0xd0,
-	0x73, 0x98, 0xdb, 0xb7, 0x1d, 0xb2, 0xd3, 0x13, 0x64, 0x4a, 0x08, 0xf2, 0x68, 0x74, 0x41, 0xee,
-	0xa5, 0xe9, 0x1a, 0x39, 0x36, 0xfa, 0x55, 0x68, 0xe4, 0x1d, 0x99, 0x0b, 0x69, 0xbb, 0xd8, 0x4a,
-	0xb4, 0xa5, 0x56, 0x3a, 0x82, 0x46, 0xde, 0x71, 0xf5, 0x7f, 0x94, 0x61, 0x31, 0x21, 0xb7, 0xe6,
-	0x79, 0x7e, 0xe4, 0x99, 0x62, 0xce, 0x5b, 0x78, 0x16, 0x67, 0x60, 0x92, 0xd9, 0xcc, 0x49, 0x2a,
-	0x2e, 0xb1, 0xe0, 0x49, 0x93, 0xf9, 0xbe, 0xc3, 0x6c, 0xaa, 0x0e, 0x38, 0x5e, 0xca, 0xb3, 0x7f,
-	0x16, 0xd9, 0x01, 0x69, 0x0b, 0x4f, 0xa8, 0x19, 0xc9, 0x9a, 0xbf, 0xe3, 0xe5, 0x94, 0x68, 0x76,
-	0xa4, 0x32, 0x93, 0xb5, 0x70, 0x38, 0xdf, 0x71, 0x88, 0xc9, 0xd5, 0x91, 0x6a, 0x87, 0x72, 0x50,
-	0xd1, 0x66, 0xb1, 0xc0, 0xf6, 0x2c, 0xd5, 0x0c, 0xa9, 0x15, 0x97, 0x13, 0x07, 0x01, 0xee, 0xaa,
-	0x1e, 0x48, 0x2e, 0xd0, 0x87, 0x50, 0x71, 0x31, 0x55, 0x19, 0xf6, 0x6a, 0x26, 0x2c, 0x15, 0x69,
-	0xa0, 0xb5, 0x8d, 0xa9, 0x4c, 0x41, 0x7c, 0x5b, 0xf3, 0x7d, 0xa8, 0xc5, 0x80, 0xaf, 0x55, 0x8b,
-	0x7e, 0x01, 0xa7, 0x32, 0x51, 0x0f, 0x3d, 0x81, 0xa5, 0x9e, 0x45, 0xa5, 0x19, 0xaa, 0xea, 0xf3,
-	0xad, 0x23, 0x25, 0x33, 0x06, 0x10, 0xd0, 0x9f, 0xc1, 0x02, 0x37, 0x19, 0xe1, 0xf8, 0x63, 0xea,
-	0xa9, 0x3e, 0x80, 0x7a, 0xc2, 0xb2, 0xd0, 0x66, 0x9a, 0x50, 0x3b, 0x8c, 0xe7, 0xef, 0xb2, 0xa9,
-	0x4a, 0xd6, 0xfa, 0x1a, 0xa0, 0xb4, 0xbc, 0x2a, 0xf5, 0x5d, 0xcb, 0x56, 0xe3, 0x8b, 0xf9, 0x3c,
-	0x27, 0xd0, 0xe3, 0x62, 0xfc, 0xab, 0x0a, 0xcc, 0x6f, 0xd8, 0x62, 0xae, 0x34, 0xa6, 0x20, 0x77,
-	0x15, 0x1a, 0x61, 0xb4, 0xe7, 0xfa, 0xed, 0xc8, 0x21, 0xaa, 0x1a, 0x51, 0x25, 0x46, 0x1f, 0x7c,
-	0x58, 0xf0, 0xe3, 0xca, 0xa2, 0x98, 0x75, 0xd4, 0x1c, 0x40, 0x3c, 0xa3, 0x0f, 0xe1, 0xdc, 0x43,
-	0xf2, 0x5c, 0x7d, 0xcf, 0x86, 0xe3, 0xef, 0xed, 0xd9, 0x9e, 0x15, 0x33, 0x91, 0x13, 0x92, 0xc1,
-	0x08, 0x45, 0x35, 0xea, 0x54, 0x71, 0x8d, 0x9a, 0xcc, 0x12, 0xd6, 0x7d, 0xd7, 0xb5, 0x99, 0x2a,
-	0x65, 0x33, 0xb0, 0xa2, 0x6c, 0x56, 0x1b, 0x4b, 0x36, 0xfb, 0x45, 0x09, 0x1a, 0xbd, 0x23, 0x55,
-	0x46, 0x71, 0x4b, 0x3a, 0xaf, 0x34, 0x89, 0x4b, 0x69, 0x93, 0xc8, 0xa3, 0xbe, 0xba, 0xdf, 0xce,
-	0x66, 0x6a, 0xb3, 0x0a, 0x2c, 0x6e, 0xd8, 0x2c, 0x8e, 0x98, 0xf6, 0xff, 0x9a, 0x79, 0x15, 0x18,
-	0x43, 0xf5, 0x78, 0xc6, 0x30, 0x79, 0x3c, 0x63, 0x98, 0x1a, 0x8b, 0x31, 0xb4, 0x60, 0x29, 0x7f,
-	0x0a, 0xca, 0x22, 0xce, 0xc0, 0x24, 0x15, 0x77, 0x22, 0x72, 0x84, 0x23, 0x17, 0xfa, 0xbf, 0x6b,
-	0x70, 0xf1, 0x33, 0xda, 0xc6, 0x2c, 0x99, 0x17, 0xde, 0xf3, 0x03, 0x71, 0x29, 0x32, 0x9e, 0xe3,
-	0xcb, 0x5d, 0x5c, 0x97, 0x87, 0x5e, 0x5c, 0x57, 0x86, 0x5c, 0x5c, 0x57, 0x8f, 0x75, 0x71, 0x3d,
-	0x39, 0xb6, 0x8b, 0xeb, 0xfe, 0xb6, 0x76, 0xaa, 0xb0, 0xad, 0x7d, 0x92, 0x69, 0xfd, 0xa6, 0x85,
-	0xbf, 0x7e, 0x27, 0xed, 0xaf, 0x43, 0x4f, 0x67, 0xe8, 0x8d, 0x5b, 0xee, 0xbe, 0xb7, 0x76, 0xe4,
-	0x7d, 0x6f, 0xbd, 0xff, 0xbe, 0xb7, 0xf8, 0xca, 0x10, 0x06, 0x5e, 0x19, 0x5e, 0x86, 0xb9, 0xb0,
-	0xeb, 0x99, 0xa4, 0x9d, 0x4c, 0x91, 0x67, 0xe4, 0x67, 0x67, 0xa1, 0x19, 0x57, 0x9c, 0xcd, 0xb9,
-	0x62, 0x62, 0xa9, 0xa7, 0x52, 0x96, 0x5a, 0xe4, 0xa0, 0x73, 0x03, 0x27, 0x0a, 0xb9, 0xdb, 0xbc,
-	0xf9, 0xc2, 0xdb, 0xbc, 0x03, 0x68, 0xc4, 0x52, 0x25, 0x07, 0xd0, 0x10, 0x07, 0xf0, 0xf1, 0xf1,
-	0x0f, 0x60, 0x37, 0x47, 0x41, 0x1e, 0x43, 0x1f, 0xe1, 0xff, 0x9a, 0x26, 0xba, 0xf9, 0xeb, 0x12,
-	0x2c, 0x16, 0x0a, 0xfd, 0x66, 0x7a, 0xfa, 0xcf, 0x61, 0x79, 0x90, 0x82, 0x55, 0xe0, 0xd2, 0x60,
-	0xda, 0xec, 0x60, 0xcf, 0x12, 0xd3, 0x67, 0x31, 0x64, 0x52, 0xcb, 0x61, 0x4d, 0xe8, 0x8d, 0x2f,
-	0x67, 0x61, 0xa1, 0xd7, 0xe3, 0xf1, 0xbf, 0xb6, 0x49, 0xd0, 0x23, 0x68, 0xc4, 0x37, 0xbf, 0xf1,
-	0x05, 0x06, 0x1a, 0x76, 0xbb, 0xd8, 0xbc, 0x50, 0xfc, 0x52, 0x8a, 0xa6, 0x4f, 0x20, 0x13, 0xce,
-	0xe5, 0x09, 0xf6, 0x2e, 0x32, 0xbf, 0x35, 0x84, 0x72, 0x82, 0x75, 0x14, 0x8b, 0x2b, 0x25, 0xf4,
-	0x04, 0xe6, 0xb2, 0xd7, 0x6d, 0x28, 0x53, 0xf4, 0x16, 0xde, 0x00, 0x36, 0xf5, 0x61, 0x28, 0x89,
-	0xfc, 0x4f, 0xb9, 0x55, 0x66, 0xee, 0x8b, 0x90, 0x9e, 0x1d, 0x3c, 0x15, 0xdd, 0xcd, 0x35, 0xbf,
-	0x39, 0x14, 0x27, 0xa1, 0xfe, 0x01, 0xd4, 0xe2, 0x2b, 0x8b, 0xac, 0x9a, 0x73, 0x17, 0x19, 0xcd,
-	0x46, 0x96, 0xde, 0x7e, 0xa8, 0x4f, 0xa0, 0x8f, 0x60, 0x86, 0xa3, 0x3d, 0x5a, 0xdf, 0x7a, 0x8c,
-	0xad, 0x57, 0xda, 0x5f, 0x8b, 0x47, 0xfa, 0xfd, 0x9b, 0x53, 0x83, 0xfe, 0xe6, 0xe9, 0x82, 0xe1,
-	0xba, 0x3e, 0x81, 0x3e, 0x96, 0xfc, 0x77, 0xd4, 0x2f, 0x77, 0x96, 0x5a, 0xf2, 0x87, 0x62, 0xad,
-	0xf8, 0x87, 0x62, 0xad, 0xbb, 0x2e, 0x65, 0xdd, 0x66, 0xc1, 0xf4, 0x5b, 0x11, 0x78, 0x0a, 0xa7,
-	0x36, 0x08, 0xeb, 0x0d, 0xab, 0xd0, 0xa5, 0x63, 0x8d, 0xf4, 0x9a, 0x7a, 0x1e, 0xad, 0x7f, 0xde,
-	0xa5, 0x4f, 0xa0, 0x2f, 0x4b, 0x70, 0x7a, 0x83, 0xb0, 0xfc, 0xf8, 0x07, 0xbd, 0x5b, 0xcc, 0x64,
-	0xc0, 0x98, 0xa8, 0xf9, 0x70, 0x54, 0x9f, 0xce, 0x92, 0xd5, 0x27, 0xd0, 0x6f, 0x4b, 0x30, 0xb7,
-	0x41, 0xf8, 0xb9, 0x25, 0x32, 0x5d, 0x1f, 0x2e, 0x53, 0xc1, 0xe4, 0xa5, 0x39, 0xe2, 0xa8, 0x35,
-	0xc5, 0x5d, 0x9f, 0x40, 0xbf, 0x2b, 0xc1, 0xd9, 0x94, 0xae, 0xd2, 0xfc, 0x5e, 0x45, 0xb6, 0x4f,
-	0x47, 0xfc, 0x8d, 0x58, 0x8a, 0xa4, 0x3e, 0x81, 0x76, 0x84, 0x99, 0xf4, 0x1a, 0x3b, 0x74, 0xb1,
-	0xb0, 0x83, 0x4b, 0xb8, 0x2f, 0x0f, 0x7a, 0x9d, 0x98, 0xc6, 0xa7, 0x30, 0xb3, 0x41, 0x58, 0x5c,
-	0xe8, 0x67, 0x8d, 0x3f, 0xd7, 0xfc, 0x65, 0xa3, 0x4f, 0xbe, 0x37, 0x10, 0x46, 0xbc, 0x20, 0x69,
-	0xa5, 0x6a, 0xca, 0x6c, 0xf8, 0x29, 0xac, 0xfa, 0xb3, 0x46, 0x5c, 0x5c, 0x92, 0xea, 0x13, 0xe8,
-	0x19, 0x2c, 0x15, 0x47, 0x7f, 0xf4, 0xf6, 0xb1, 0x53, 0x70, 0xf3, 0xea, 0x71, 0x50, 0x63, 0x96,
-	0x9f, 0xac, 0xfd, 0xe5, 0xe5, 0x72, 0xe9, 0xab, 0x97, 0xcb, 0xa5, 0x7f, 0xbe, 0x5c, 0x2e, 0xfd,
-	0xf0, 0xe6, 0x11, 0xbf, 0x25, 0x4d, 0xfd, 0x3c, 0x15, 0x53, 0xdb, 0x74, 0x6c, 0xe2, 0xb1, 0xbd,
-	0x29, 0x11, 0x02, 0x6e, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x84, 0x58, 0x01, 0xdb, 0xbd, 0x2a,
-	0x00, 0x00,
+	// 2730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x1a, 0x4d, 0x73, 0x1b, 0x49,
+	0x55, 0x23, 0xc9, 0xb2, 0xf4, 0xec, 0xd8, 0x72, 0x6f, 0xec, 0x4c, 0x26, 0x89, 0x71, 0x66, 0x49,
+	0x2a, 0x9b, 0xec, 0xca, 0x24, 0xa9, 0xdd, 0x2c, 0xd9, 0xaf, 0xf2, 0x3a, 0x89, 0x9d, 0x4d, 0x9c,
+	0x98, 0x71, 0x36, 0x10, 0x36, 0x40, 0xb5, 0x47, 0xed, 0xd1, 0xac, 0xe7, 0x2b, 0x33, 0x2d, 0x07,
+	0x87, 0xe2, 0xb4, 0x14, 0x17, 0x28, 0x8a, 0x13, 0x07, 0x8a, 0x9f, 0xc0, 0x95, 0xe2, 0x06, 0x47,
+	0xb8, 0x50, 0xb5, 0xc5, 0x1f, 0x80, 0xca, 0x5f, 0xa0, 0xe0, 0x4c, 0xf5, 0xc7, 0x8c, 0x66, 0x46,
+	0x23, 0xd9, 0x1b, 0x39, 0xca, 0x52, 0x5c, 0x6c, 0xf5, 0x9b, 0xd7, 0xef, 0xbd, 0x7e, 0xfd, 0xfa,
+	0x7d, 0x75, 0xc3, 0xf9, 0x90, 0x04, 0x7e, 0x44, 0xc2, 0x3d, 0x12, 0x2e, 0xf3, 0x9f, 0x36, 0xf5,
+	0xc3, 0xfd, 0xd4, 0xcf, 0x56, 0x10, 0xfa, 0xd4, 0x47, 0xd0, 0x83, 0x68, 0x77, 0x2d, 0x9b, 0x76,
+	0xba, 0xdb, 0x2d, 0xd3, 0x77, 0x97, 0x71, 0x68, 0xf9, 0x41, 0xe8, 0x7f, 0xce, 0x7f, 0xbc, 0x65,
+	0xb6, 0x97, 0xf7, 0xae, 0x2e, 0x07, 0xbb, 0xd6, 0x32, 0x0e, 0xec, 0x68, 0x19, 0x07, 0x81, 0x63,
+	0x9b, 0x98, 0xda, 0xbe, 0xb7, 0xbc, 0x77, 0x19, 0x3b, 0x41, 0x07, 0x5f, 0x5e, 0xb6, 0x88, 0x47,
+	0x42, 0x4c, 0x49, 0x5b, 0x50, 0xd6, 0x4e, 0x59, 0xbe, 0x6f, 0x39, 0x64, 0x99, 0x8f, 0xb6, 0xbb,
+	0x3b, 0xcb, 0xc4, 0x0d, 0xa8, 0x64, 0xab, 0x7f, 0x31, 0x0b, 0xb3, 0x1b, 0xd8, 0xb3, 0x77, 0x48,
+	0x44, 0x0d, 0xf2, 0xa4, 0x4b, 0x22, 0x8a, 0x1e, 0x43, 0x95, 0x09, 0xa3, 0x2a, 0x4b, 0xca, 0x85,
+	0xa9, 0x2b, 0xeb, 0xad, 0x9e, 0x34, 0xad, 0x58, 0x1a, 0xfe, 0xe3, 0x47, 0x66, 0xbb, 0xb5, 0x77,
+	0xb5, 0x15, 0xec, 0x5a, 0x2d, 0x26, 0x4d, 0x2b, 0x25, 0x4d, 0x2b, 0x96, 0xa6, 0x65, 0x24, 0xcb,
+	0x32, 0x38, 0x55, 0xa4, 0x41, 0x3d, 0x24, 0x7b, 0x76, 0x64, 0xfb, 0x9e, 0x5a, 0x5e, 0x52, 0x2e,
+	0x34, 0x8c, 0x64, 0x8c, 0x54, 0x98, 0xf4, 0xfc, 0x55, 0x6c, 0x76, 0x88, 0x5a, 0x59, 0x52, 0x2e,
+	0xd4, 0x8d, 0x78, 0x88, 0x96, 0x60, 0x0a, 0x07, 0xc1, 0x5d, 0xbc, 0x4d, 0x9c, 0x3b, 0x64, 0x5f,
+	0xad, 0xf2, 0x89, 0x69, 0x10, 0x9b, 0x8b, 0x83, 0xe0, 0x1e, 0x76, 0x89, 0x3a, 0xc1, 0xbf, 0xc6,
+	0x43, 0x74, 0x1a, 0x1a, 0x1e, 0x76, 0x49, 0x14, 0x60, 0x93, 0xa8, 0x75, 0xfe, 0xad, 0x07, 0x40,
+	0x3f, 0x85, 0xb9, 0x94, 0xe0, 0x5b, 0x7e, 0x37, 0x34, 0x89, 0x0a, 0x7c, 0xe9, 0xf7, 0x47, 0x5b,
+	0xfa, 0x4a, 0x9e, 0xac, 0xd1, 0xcf, 0x09, 0xfd, 0x10, 0x26, 0xf8, 0xce, 0xab, 0x53, 0x4b, 0x95,
+	0x23, 0xd5, 0xb6, 0x20, 0x8b, 0x3c, 0x98, 0x0c, 0x9c, 0xae, 0x65, 0x7b, 0x91, 0x3a, 0xcd, 0x39,
+	0x3c, 0x18, 0x8d, 0xc3, 0xaa, 0xef, 0xed, 0xd8, 0xd6, 0x06, 0xf6, 0xb0, 0x45, 0x5c, 0xe2, 0xd1,
+	0x4d, 0x4e, 0xdc, 0x88, 0x99, 0xa0, 0x67, 0xd0, 0xdc, 0xed, 0x46, 0xd4, 0x77, 0xed, 0x67, 0xe4,
+	0x7e, 0xc0, 0xe6, 0x46, 0xea, 0x31, 0xae, 0xcd, 0x7b, 0xa3, 0x31, 0xbe, 0x93, 0xa3, 0x6a, 0xf4,
+	0xf1, 0x61, 0x46, 0xb2, 0xdb, 0xdd, 0x26, 0x0f, 0x49, 0xc8, 0xad, 0x6b, 0x46, 0x18, 0x49, 0x0a,
+	0x24, 0xcc, 0xc8, 0x96, 0xa3, 0x48, 0x9d, 0x5d, 0xaa, 0x08, 0x33, 0x4a, 0x40, 0xe8, 0x02, 0xcc,
+	0xee, 0x91, 0xd0, 0xde, 0xd9, 0xdf, 0xb2, 0x2d, 0x0f, 0xd3, 0x6e, 0x48, 0xd4, 0x26, 0x37, 0xc5,
+	0x3c, 0x18, 0xb9, 0x70, 0xac, 0x43, 0x1c, 0x97, 0xa9, 0x7c, 0x35, 0x24, 0xed, 0x48, 0x9d, 0xe3,
+	0xfa, 0x5d, 0x1b, 0x7d, 0x07, 0x39, 0x39, 0x23, 0x4b, 0x9d, 0x09, 0xe6, 0xf9, 0x86, 0x3c, 0x29,
+	0xe2, 0x8c, 0x20, 0x21, 0x58, 0x0e, 0x8c, 0xce, 0xc3, 0x0c, 0x0d, 0xb1, 0xb9, 0x6b, 0x7b, 0xd6,
+	0x06, 0xa1, 0x1d, 0xbf, 0xad, 0xbe, 0xc6, 0x35, 0x91, 0x83, 0x22, 0x13, 0x10, 0xf1, 0xf0, 0xb6,
+	0x43, 0xda, 0xc2, 0x16, 0x1f, 0xec, 0x07, 0x24, 0x52, 0x8f, 0xf3, 0x55, 0x5c, 0x6d, 0xa5, 0x3c,
+	0x54, 0xce, 0x41, 0xb4, 0x6e, 0xf6, 0xcd, 0xba, 0xe9, 0xd1, 0x70, 0xdf, 0x28, 0x20, 0x87, 0x76,
+	0x61, 0x8a, 0xad, 0x23, 0x36, 0x85, 0x79, 0x6e, 0x0a, 0xb7, 0x47, 0xd3, 0xd1, 0x7a, 0x8f, 0xa0,
+	0x91, 0xa6, 0x8e, 0x5a, 0x80, 0x3a, 0x38, 0xda, 0xe8, 0x3a, 0xd4, 0x0e, 0x1c, 0x22, 0xc4, 0x88,
+	0xd4, 0x05, 0xae, 0xa6, 0x82, 0x2f, 0xe8, 0x0e, 0x40, 0x48, 0x76, 0x62, 0xbc, 0x13, 0x7c, 0xe5,
+	0x97, 0x86, 0xad, 0xdc, 0x48, 0xb0, 0xc5, 0x8a, 0x53, 0xd3, 0x19, 0x73, 0xb6, 0x0c, 0x62, 0x52,
+	0x01, 0xe1, 0x67, 0x51, 0x55, 0xb9, 0x89, 0x15, 0x7c, 0x61, 0xb6, 0x28, 0xa1, 0xdc, 0x69, 0x9d,
+	0x14, 0xd6, 0x9a, 0x02, 0xa1, 0x75, 0xf8, 0x06, 0xf6, 0x3c, 0x9f, 0xf2, 0xe5, 0xc7, 0xa2, 0xac,
+	0x49, 0xf7, 0xbe, 0x89, 0x69, 0x27, 0x52, 0x35, 0x3e, 0xeb, 0x20, 0x34, 0x66, 0x12, 0xb6, 0x17,
+	0x51, 0xec, 0x38, 0x1c, 0xe9, 0xf6, 0x0d, 0xf5, 0x94, 0x30, 0x89, 0x2c, 0x14, 0x3d, 0x85, 0xd9,
+	0x88, 0x8b, 0x78, 0xdb, 0xa3, 0xc4, 0x0a, 0x6d, 0xba, 0xaf, 0x9e, 0xe6, 0x3b, 0xb6, 0x31, 0xda,
+	0x8e, 0x6d, 0x65, 0x89, 0x1a, 0x79, 0x2e, 0xe8, 0x4d, 0x98, 0x13, 0xa0, 0x9b, 0xde, 0x9e, 0x1d,
+	0xfa, 0x1e, 0x73, 0x2d, 0xea, 0x19, 0x2e, 0x63, 0xff, 0x07, 0xed, 0x26, 0x9c, 0x18, 0x60, 0x83,
+	0xa8, 0x09, 0x95, 0x5d, 0xb2, 0xcf, 0x63, 0x57, 0xc3, 0x60, 0x3f, 0xd1, 0x71, 0x98, 0xd8, 0xc3,
+	0x4e, 0x97, 0xf0, 0x68, 0x53, 0x37, 0xc4, 0xe0, 0x7a, 0xf9, 0x5d, 0x45, 0xfb, 0xb9, 0x02, 0xb3,
+	0xb9, 0x1d, 0x2d, 0x98, 0xff, 0x83, 0xf4, 0xfc, 0x23, 0x38, 0xdf, 0x3b, 0x0f, 0x70, 0x68, 0x11,
+	0x9a, 0x12, 0x44, 0xff, 0xbb, 0x02, 0x6a, 0xce, 0xd4, 0xbe, 0x6b, 0xd3, 0xce, 0x2d, 0xdb, 0x21,
+	0x11, 0xba, 0x06, 0x93, 0xa1, 0x80, 0xc9, 0x88, 0x7c, 0x6a, 0x88, 0x85, 0xae, 0x97, 0x8c, 0x18,
+	0x1b, 0x7d, 0x08, 0x75, 0x97, 0x50, 0xdc, 0xc6, 0x14, 0x4b, 0xd9, 0x97, 0x8a, 0x66, 0x32, 0x2e,
+	0x1b, 0x12, 0x6f, 0xbd, 0x64, 0x24, 0x73, 0xd0, 0xdb, 0x30, 0x61, 0x76, 0xba, 0xde, 0x2e, 0x8f,
+	0xc5, 0x53, 0x57, 0xce, 0x0c, 0x9a, 0xbc, 0xca, 0x90, 0xd6, 0x4b, 0x86, 0xc0, 0xfe, 0xb8, 0x06,
+	0xd5, 0x00, 0x87, 0x54, 0xbf, 0x05, 0xc7, 0x8b, 0x58, 0xb0, 0x04, 0xc0, 0xec, 0x10, 0x73, 0x37,
+	0xea, 0xba, 0x52, 0xcd, 0xc9, 0x18, 0x21, 0xa8, 0x46, 0xf6, 0x33, 0xa1, 0xea, 0x8a, 0xc1, 0x7f,
+	0xeb, 0x6f, 0xc0, 0x5c, 0x1f, 0x37, 0xb6, 0xa9, 0x42, 0x36, 0x46, 0x61, 0x5a, 0xb2, 0xd6, 0xbb,
+	0x30, 0xff, 0x80, 0xeb, 0x22, 0x89, 0x82, 0xe3, 0x48, 0x69, 0xf4, 0x75, 0x58, 0xc8, 0xb3, 0x8d,
+	0x02, 0xdf, 0x8b, 0x08, 0xf3, 0x09, 0x3c, 0x6c, 0xd8, 0xa4, 0xdd, 0xfb, 0xca, 0xa5, 0xa8, 0x1b,
+	0x05, 0x5f, 0xf4, 0x9f, 0xc0, 0xc9, 0xde, 0xe8, 0x21, 0x76, 0xec, 0xb6, 0xc8, 0x15, 0x28, 0xb6,
+	0x08, 0x53, 0x0e, 0x4b, 0x5b, 0xa4, 0xd2, 0xf8, 0x6f, 0xb4, 0x00, 0xb5, 0x88, 0x62, 0xda, 0x8d,
+	0x64, 0x2e, 0x25, 0x47, 0x2c, 0x1b, 0x72, 0x49, 0x14, 0x61, 0x4b, 0x64, 0x52, 0x0d, 0x23, 0x1e,
+	0xb2, 0x2f, 0x6d, 0x42, 0xb1, 0xed, 0x44, 0x6a, 0x95, 0xfb, 0xa6, 0x78, 0xa8, 0x7f, 0x06, 0x9a,
+	0x64, 0x49, 0x0a, 0x96, 0xf2, 0x01, 0xe7, 0x64, 0x91, 0x48, 0x55, 0xb8, 0x9f, 0x3c, 0x97, 0x36,
+	0x87, 0x81, 0x42, 0x1b, 0x72, 0x92, 0xfe, 0xb7, 0x32, 0x2c, 0x18, 0x24, 0xf2, 0x9d, 0x3d, 0x12,
+	0x47, 0xab, 0xf1, 0xe4, 0x9b, 0x9f, 0x41, 0x05, 0x07, 0x81, 0x5a, 0x3e, 0x8a, 0xc0, 0x93, 0xca,
+	0xe8, 0x0c, 0x46, 0x95, 0xb9, 0x2d, 0xec, 0x6e, 0xdb, 0x56, 0xd7, 0xef, 0x46, 0xf1, 0xb2, 0xa4,
+	0xc2, 0xfb, 0x3f, 0x30, 0x8f, 0x1f, 0xfb, 0xbd, 0x36, 0xf9, 0x31, 0x4f, 0x62, 0x2b, 0x46, 0x1a,
+	0x54, 0x14, 0xe4, 0x27, 0x0a, 0x83, 0xbc, 0x6e, 0xc2, 0x89, 0x3e, 0x75, 0xca, 0x9d, 0x4a, 0x67,
+	0xd8, 0x4a, 0x2e, 0xc3, 0x2e, 0x14, 0xb8, 0x3c, 0x40, 0x60, 0xfd, 0x5f, 0x65, 0x68, 0xf6, 0x1c,
+	0x8c, 0x24, 0x7f, 0x1a, 0x1a, 0xae, 0x84, 0x09, 0x5b, 0x68, 0x18, 0x3d, 0x40, 0x36, 0xd9, 0x2e,
+	0xe7, 0x93, 0x6d, 0x66, 0xae, 0xbc, 0x16, 0x92, 0x4a, 0x92, 0xa3, 0x8c, 0xc8, 0xd5, 0x9c, 0xc8,
+	0x8b, 0x00, 0x51, 0xe2, 0xe5, 0xd5, 0x1a, 0xff, 0x9a, 0x82, 0x20, 0x1d, 0xa6, 0x45, 0x6a, 0x66,
+	0x90, 0xa8, 0xeb, 0x50, 0x75, 0x92, 0x63, 0x64, 0x60, 0xdc, 0xe7, 0xf8, 0xae, 0x8b, 0xbd, 0x76,
+	0xa4, 0xd6, 0xb9, 0xc8, 0xc9, 0x18, 0xfd, 0x4a, 0x81, 0xf9, 0x5c, 0x38, 0x92, 0x94, 0x1a, 0xdc,
+	0x66, 0xbe, 0x77, 0xa4, 0xa1, 0x6f, 0x95, 0xb9, 0x3a, 0x41, 0xdf, 0x28, 0x66, 0xab, 0xff, 0x42,
+	0x81, 0xf9, 0xbc, 0xd6, 0x85, 0xd7, 0x1b, 0xae, 0xfa, 0x77, 0x99, 0x12, 0x05, 0xba, 0x34, 0xf7,
+	0xd3, 0xc5, 0x91, 0x42, 0xe0, 0x18, 0x09, 0x76, 0xc6, 0x25, 0x57, 0xb2, 0x2e, 0x59, 0xf7, 0x61,
+	0xf6, 0xae, 0xcd, 0x66, 0xed, 0x44, 0xe3, 0xf1, 0xa6, 0xef, 0x40, 0x95, 0x31, 0x63, 0x42, 0x6d,
+	0x87, 0xd8, 0x33, 0x3b, 0x24, 0x5e, 0x6b, 0x32, 0x66, 0xae, 0x90, 0x62, 0x8b, 0x39, 0x3d, 0x06,
+	0xe7, 0xbf, 0xf5, 0x3f, 0x96, 0x85, 0xa4, 0x2b, 0x41, 0x10, 0xbd, 0xfa, 0x52, 0xb6, 0x38, 0xb9,
+	0xae, 0xf4, 0x27, 0xd7, 0x39, 0x91, 0xbf, 0x4a, 0x72, 0x7d, 0x44, 0x79, 0x90, 0xde, 0x85, 0xc9,
+	0x95, 0x20, 0x60, 0x82, 0xa0, 0xcb, 0x50, 0xc5, 0x41, 0x10, 0xfb, 0xf8, 0x4c, 0xc8, 0x97, 0x28,
+	0xec, 0xbf, 0x14, 0x89, 0xa3, 0x6a, 0xd7, 0xa0, 0x91, 0x80, 0x0e, 0x62, 0xdb, 0x48, 0xb3, 0x5d,
+	0x02, 0x10, 0xd5, 0xe3, 0x6d, 0x6f, 0xc7, 0x2f, 0x8a, 0x6e, 0xfa, 0xf5, 0x18, 0x83, 0xcb, 0xf6,
+	0x26, 0x4c, 0xd8, 0x94, 0xb8, 0xb1, 0x70, 0x0b, 0x69, 0xe1, 0x7a, 0x84, 0x0c, 0x81, 0xa4, 0xff,
+	0xbb, 0x2e, 0x62, 0xe9, 0x16, 0xf7, 0x30, 0x2b, 0x41, 0x70, 0x43, 0x84, 0xb9, 0xef, 0x74, 0x49,
+	0xb8, 0xff, 0x92, 0x0d, 0xc3, 0x82, 0x9a, 0x38, 0xda, 0x6a, 0xf9, 0xe5, 0x34, 0x12, 0x6a, 0x51,
+	0xae, 0x7b, 0x50, 0x79, 0x39, 0xdd, 0x83, 0xa2, 0x6a, 0xbe, 0x3a, 0xa6, 0x6a, 0x7e, 0x70, 0x43,
+	0x27, 0xd5, 0x26, 0xaa, 0x65, 0xdb, 0x44, 0x05, 0xf1, 0x73, 0xf2, 0xb0, 0x45, 0x72, 0xbd, 0xb0,
+	0x48, 0x76, 0x0b, 0xcf, 0x71, 0x83, 0xab, 0xfb, 0x83, 0x7c, 0x0a, 0x54, 0x68, 0x6b, 0xa3, 0x94,
+	0xcb, 0xf0, 0x52, 0xcb, 0xe5, 0x4f, 0x33, 0xe5, 0xaf, 0x68, 0x40, 0xbd, 0x7d, 0xb8, 0x35, 0x0d,
+	0x2b, 0x84, 0x0b, 0x6b, 0xb9, 0xe9, 0xff, 0x97, 0x5a, 0xee, 0x67, 0x3c, 0xd1, 0x0d, 0xfc, 0x9e,
+	0xc6, 0x92, 0xcc, 0x89, 0x45, 0x2d, 0x96, 0xc3, 0x48, 0x17, 0xc7, 0x7e, 0xa3, 0x4b, 0x50, 0x65,
+	0x5b, 0x22, 0x6b, 0xac, 0x13, 0x69, 0xed, 0xb3, 0x7d, 0x5b, 0x09, 0x82, 0xad, 0x80, 0x98, 0x06,
+	0x47, 0x42, 0xd7, 0xa1, 0x91, 0x1c, 0x13, 0xb5, 0xda, 0x1f, 0xe2, 0x93, 0x53, 0x15, 0x4f, 0xeb,
+	0xa1, 0xb3, 0xb9, 0x6d, 0x3b, 0x24, 0x26, 0x43, 0x54, 0x27, 0xfa, 0xe7, 0xde, 0x88, 0x3f, 0x26,
+	0x73, 0x13, 0x74, 0x74, 0x19, 0x6a, 0xa2, 0xbf, 0xc7, 0xcf, 0xdb, 0xd4, 0x95, 0x93, 0xfd, 0xae,
+	0x37, 0x9e, 0x25, 0x11, 0xf5, 0x3f, 0x95, 0xe1, 0x6c, 0xcf, 0x7c, 0xe2, 0xb3, 0x17, 0x17, 0x81,
+	0xaf, 0x3e, 0x3e, 0x9f, 0x87, 0x19, 0x9e, 0xe2, 0xf4, 0xda, 0x7c, 0xa2, 0xe3, 0x9c, 0x83, 0x16,
+	0x75, 0x44, 0xaa, 0xe3, 0xe8, 0x88, 0xe8, 0x7f, 0x51, 0xe0, 0xf5, 0x7e, 0x05, 0xae, 0xfa, 0x6e,
+	0x80, 0x43, 0x3b, 0xf2, 0xbd, 0xaf, 0x85, 0x0a, 0xa9, 0x30, 0xff, 0x6c, 0xe5, 0x93, 0x83, 0xea,
+	0x7f, 0x50, 0xe0, 0x5c, 0xc1, 0x4a, 0x3a, 0x38, 0xa4, 0xc9, 0x09, 0x19, 0xc7, 0x5a, 0xe2, 0x0c,
+	0xa3, 0x9c, 0xaa, 0x9f, 0xd3, 0xeb, 0xab, 0x64, 0xd7, 0xa7, 0xff, 0xb9, 0x0c, 0x53, 0xa9, 0x33,
+	0x58, 0x58, 0x7f, 0x2f, 0x02, 0xf0, 0xa3, 0xcf, 0x5b, 0x35, 0x3c, 0x0a, 0x37, 0x8c, 0x14, 0x04,
+	0xed, 0x02, 0x04, 0x38, 0xc4, 0x2e, 0xa1, 0x24, 0x14, 0x05, 0xf7, 0xd4, 0x95, 0x3b, 0xa3, 0xbb,
+	0xf3, 0xcd, 0x98, 0xa6, 0x91, 0x22, 0xcf, 0xaa, 0x2b, 0xce, 0x3a, 0x92, 0x01, 0x53, 0x8e, 0xd0,
+	0x53, 0x98, 0xd9, 0xb1, 0x1d, 0xb2, 0xd9, 0x13, 0xa4, 0xb6, 0x54, 0x19, 0x3d, 0x2d, 0x61, 0x82,
+	0xdc, 0x4a, 0xd3, 0x35, 0x72, 0x6c, 0xf4, 0x8b, 0xd0, 0xcc, 0xbb, 0x24, 0x26, 0xa4, 0xed, 0x62,
+	0x2b, 0xd1, 0x96, 0x1c, 0xe9, 0x08, 0x9a, 0x79, 0x17, 0xa4, 0xff, 0xa3, 0x0c, 0xf3, 0x09, 0xb9,
+	0x15, 0xcf, 0xf3, 0xbb, 0x9e, 0xc9, 0x6f, 0x1d, 0x0a, 0xf7, 0xe2, 0x38, 0x4c, 0x50, 0x9b, 0x3a,
+	0x49, 0xa6, 0xc9, 0x07, 0x2c, 0x59, 0xa0, 0xbe, 0xef, 0x50, 0x3b, 0x88, 0x3b, 0x21, 0x72, 0x28,
+	0xf6, 0xfe, 0x49, 0xd7, 0x0e, 0x49, 0x9b, 0x9f, 0xe9, 0xba, 0x91, 0x8c, 0xd9, 0x37, 0x96, 0x46,
+	0xf2, 0x92, 0x53, 0x28, 0x33, 0x19, 0x73, 0xd7, 0xe1, 0x3b, 0x0e, 0x31, 0x99, 0x3a, 0x52, 0x45,
+	0x69, 0x0e, 0x2a, 0x7a, 0x33, 0xa1, 0xed, 0x59, 0xb2, 0x24, 0x95, 0x23, 0x26, 0x27, 0x0e, 0x43,
+	0xbc, 0x2f, 0x2b, 0x51, 0x31, 0x40, 0xef, 0x43, 0xc5, 0xc5, 0x81, 0xcc, 0x2c, 0x2e, 0x66, 0x1c,
+	0x6c, 0x91, 0x06, 0x5a, 0x1b, 0x38, 0x10, 0xa1, 0x97, 0x4d, 0xd3, 0xde, 0x81, 0x7a, 0x0c, 0xf8,
+	0x4a, 0x39, 0xf8, 0xe7, 0x70, 0x2c, 0xe3, 0xbf, 0xd1, 0x23, 0x58, 0xe8, 0x59, 0x54, 0x9a, 0xa1,
+	0xcc, 0xba, 0xcf, 0x1e, 0x28, 0x99, 0x31, 0x80, 0x80, 0xfe, 0x04, 0xe6, 0x98, 0xc9, 0xf0, 0x83,
+	0x3f, 0xa6, 0x5a, 0xf2, 0x3d, 0x68, 0x24, 0x2c, 0x0b, 0x6d, 0x46, 0x83, 0xfa, 0x5e, 0x7c, 0x1b,
+	0x24, 0x8a, 0xc9, 0x64, 0xac, 0xaf, 0x00, 0x4a, 0xcb, 0x2b, 0x83, 0xf8, 0xa5, 0x6c, 0x15, 0x32,
+	0x9f, 0x8f, 0xd8, 0x1c, 0x3d, 0x2e, 0x42, 0xbe, 0xac, 0xc0, 0xec, 0x9a, 0xcd, 0xfb, 0x96, 0x63,
+	0x72, 0x72, 0x17, 0xa1, 0x19, 0x75, 0xb7, 0x5d, 0xbf, 0xdd, 0x75, 0x88, 0xcc, 0xab, 0x64, 0xb2,
+	0xd4, 0x07, 0x1f, 0xe6, 0xfc, 0x98, 0xb2, 0x02, 0x4c, 0x3b, 0xb2, 0x1b, 0xc3, 0x7f, 0xa3, 0xf7,
+	0xe1, 0xe4, 0x3d, 0xf2, 0x54, 0xae, 0x67, 0xcd, 0xf1, 0xb7, 0xb7, 0x6d, 0xcf, 0x8a, 0x99, 0x88,
+	0x3e, 0xd5, 0x60, 0x84, 0xa2, 0xdc, 0xbc, 0x56, 0x9c, 0x9b, 0x27, 0x1d, 0x9d, 0x55, 0xdf, 0x75,
+	0x6d, 0x2a, 0x53, 0xf8, 0x0c, 0xac, 0x28, 0x2e, 0xd7, 0xc7, 0x12, 0x97, 0xbf, 0x50, 0xa0, 0xd9,
+	0xdb, 0x52, 0x69, 0x14, 0xd7, 0xc4, 0xe1, 0x2d, 0xe8, 0x8c, 0xe6, 0x51, 0x5f, 0xfc, 0xdc, 0x4e,
+	0x67, 0xb2, 0xcc, 0x0a, 0xcc, 0xaf, 0xd9, 0x34, 0xf6, 0x98, 0xf6, 0xff, 0x9a, 0x79, 0x15, 0x18,
+	0x43, 0xf5, 0x70, 0xc6, 0x30, 0x71, 0x38, 0x63, 0xa8, 0x8d, 0xc5, 0x18, 0x5a, 0xb0, 0x90, 0xdf,
+	0x05, 0x69, 0x11, 0xc7, 0x61, 0x22, 0xe0, 0x37, 0x74, 0xa2, 0x75, 0x25, 0x06, 0xfa, 0xef, 0x1b,
+	0x70, 0xe6, 0xd3, 0x40, 0x74, 0xd8, 0xc5, 0x22, 0x6f, 0xf9, 0x21, 0xbf, 0xa2, 0x1b, 0xcf, 0xf6,
+	0xe5, 0x9e, 0x51, 0x94, 0x87, 0x3e, 0xa3, 0xa8, 0x0c, 0x79, 0x46, 0x51, 0x3d, 0xd4, 0x33, 0x8a,
+	0x89, 0xb1, 0x3d, 0xa3, 0xe8, 0x2f, 0xe7, 0x6b, 0x85, 0xe5, 0xfc, 0xa3, 0x4c, 0xc9, 0x3b, 0xc9,
+	0xcf, 0xeb, 0xb7, 0xd3, 0xe7, 0x75, 0xe8, 0xee, 0x0c, 0x2d, 0x7b, 0x73, 0xaf, 0x0f, 0xea, 0x07,
+	0xbe, 0x3e, 0x68, 0xf4, 0xbf, 0x3e, 0x28, 0xbe, 0xc0, 0x86, 0x81, 0x17, 0xd8, 0xe7, 0x61, 0x26,
+	0xda, 0xf7, 0x4c, 0xd2, 0x8e, 0x05, 0x56, 0xa7, 0xc4, 0xb2, 0xb3, 0xd0, 0xcc, 0x51, 0x9c, 0xce,
+	0x1d, 0xc5, 0xc4, 0x52, 0x8f, 0xa5, 0x2c, 0xb5, 0xe8, 0x80, 0xce, 0x0c, 0xec, 0xa4, 0xe4, 0xee,
+	0x96, 0x67, 0x0b, 0xef, 0x96, 0x77, 0xa1, 0x19, 0x4b, 0x95, 0x6c, 0x40, 0x93, 0x6f, 0xc0, 0x47,
+	0x87, 0xdf, 0x80, 0xad, 0x1c, 0x05, 0xb1, 0x0d, 0x7d, 0x84, 0x8b, 0x7b, 0x10, 0x73, 0x83, 0x7a,
+	0x10, 0x5f, 0x97, 0xe6, 0x81, 0xf6, 0x4b, 0x05, 0xe6, 0x0b, 0x97, 0xf8, 0x6a, 0x7a, 0x19, 0x0f,
+	0x61, 0x71, 0xd0, 0x76, 0x48, 0x37, 0xa7, 0xc2, 0xa4, 0xd9, 0xc1, 0x9e, 0xb8, 0x16, 0xe4, 0xad,
+	0x38, 0x39, 0x1c, 0x56, 0x39, 0x5e, 0xf9, 0xcf, 0x0c, 0xcc, 0xf5, 0x2a, 0x42, 0xf6, 0xd7, 0x36,
+	0x09, 0xba, 0x0f, 0xcd, 0xf8, 0xd5, 0x42, 0x7c, 0x57, 0x81, 0x86, 0xdd, 0x75, 0x6b, 0x43, 0xaf,
+	0x37, 0xf4, 0x12, 0x7a, 0x0c, 0x0b, 0x79, 0x82, 0x5b, 0x34, 0x24, 0xd8, 0x1d, 0x4e, 0xf6, 0xec,
+	0x30, 0xb2, 0xfc, 0x22, 0x46, 0x2f, 0x7d, 0x4b, 0x41, 0x26, 0x9c, 0xcc, 0x53, 0xef, 0x5d, 0xda,
+	0x7f, 0x73, 0x08, 0x83, 0x04, 0xeb, 0xa0, 0x05, 0x5c, 0x50, 0xd0, 0x23, 0x98, 0xc9, 0x5e, 0x2d,
+	0xa3, 0x8c, 0x74, 0x85, 0xb7, 0xdd, 0x9a, 0x3e, 0x0c, 0x25, 0xd1, 0x0e, 0x06, 0xd4, 0x7f, 0xdd,
+	0x7b, 0x18, 0xf2, 0xe7, 0xd3, 0x28, 0x83, 0x6f, 0x8c, 0xf9, 0x06, 0xcc, 0xe6, 0x2e, 0x29, 0x91,
+	0x9e, 0xed, 0x2f, 0x16, 0x5d, 0x08, 0x6b, 0xaf, 0x0f, 0xc5, 0x49, 0xa8, 0xbf, 0x07, 0xf5, 0xf8,
+	0x66, 0x2a, 0xbb, 0xa1, 0xb9, 0xfb, 0x2a, 0xad, 0x99, 0xa5, 0xb7, 0x13, 0xe9, 0x25, 0xf4, 0x21,
+	0x4c, 0x31, 0xb4, 0xfb, 0xab, 0xb7, 0x1f, 0x60, 0xeb, 0x85, 0xe6, 0xd7, 0xe3, 0x9b, 0x9b, 0xfe,
+	0xc9, 0xa9, 0xfb, 0x1c, 0xed, 0xb5, 0x82, 0x3b, 0x14, 0xbd, 0x84, 0x3e, 0x12, 0xfc, 0x37, 0xe5,
+	0xb3, 0xb9, 0x85, 0x96, 0x78, 0xa5, 0xd9, 0x8a, 0x5f, 0x69, 0xb6, 0x6e, 0xb2, 0x57, 0x9a, 0x5a,
+	0xc1, 0x25, 0x87, 0x24, 0xf0, 0x18, 0x8e, 0xad, 0x11, 0xda, 0xeb, 0x32, 0xa2, 0x73, 0x87, 0xea,
+	0xdc, 0x6a, 0x7a, 0x1e, 0xad, 0xbf, 0x51, 0xa9, 0x97, 0xd0, 0x6f, 0x14, 0x78, 0x6d, 0x8d, 0xd0,
+	0x7c, 0xdf, 0x0e, 0xbd, 0x55, 0xcc, 0x64, 0x40, 0x7f, 0x4f, 0xbb, 0x37, 0xaa, 0x53, 0xca, 0x92,
+	0xd5, 0x4b, 0xe8, 0x77, 0x0a, 0xcc, 0xa7, 0x04, 0xeb, 0xf5, 0xc3, 0xd0, 0xf2, 0x70, 0xd1, 0xfa,
+	0x3a, 0x67, 0xda, 0xe6, 0xd1, 0x08, 0xd7, 0x23, 0xac, 0x97, 0xd0, 0xaf, 0x15, 0x98, 0x59, 0x23,
+	0xcc, 0xac, 0x12, 0x95, 0x5d, 0x3e, 0x40, 0xae, 0xfe, 0x3e, 0x98, 0x36, 0x62, 0xc3, 0x3f, 0xc5,
+	0x5d, 0x2f, 0xa1, 0xdf, 0x2a, 0x70, 0x22, 0xad, 0xb1, 0x14, 0xbf, 0x17, 0x91, 0xed, 0x93, 0x11,
+	0xdf, 0x8f, 0xa6, 0x48, 0xea, 0x25, 0xb4, 0xc9, 0xad, 0xb8, 0x57, 0x66, 0xa3, 0x33, 0x85, 0xf5,
+	0x74, 0xc2, 0x7d, 0x71, 0xd0, 0xe7, 0xc4, 0x72, 0x3f, 0x81, 0xa9, 0x35, 0x42, 0xe3, 0xb2, 0x2b,
+	0x7b, 0x36, 0x73, 0xa5, 0xb8, 0x76, 0xba, 0xf8, 0x63, 0xca, 0x7f, 0xcd, 0x09, 0x5a, 0xa9, 0x0c,
+	0x3f, 0xeb, 0x21, 0x0b, 0x6b, 0x30, 0x4d, 0x1f, 0x86, 0x92, 0x50, 0x7f, 0x02, 0x0b, 0xc5, 0xd1,
+	0x15, 0xbd, 0x71, 0xe8, 0x84, 0x48, 0xbb, 0x78, 0x18, 0xd4, 0x98, 0xe5, 0xc7, 0x2b, 0x7f, 0x7d,
+	0xbe, 0xa8, 0x7c, 0xf9, 0x7c, 0x51, 0xf9, 0xe7, 0xf3, 0x45, 0xe5, 0xfb, 0x57, 0x0f, 0x78, 0x67,
+	0x9e, 0x7a, 0xba, 0x8e, 0x03, 0xdb, 0x74, 0x6c, 0xe2, 0xd1, 0xed, 0x1a, 0xf7, 0x50, 0x57, 0xff,
+	0x3b, 0x00, 0xc8, 0xa0, 0x6d, 0x68, 0xd9, 0x2e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2716,10 +3017,15 @@ const _ = grpc.SupportPackageIsVersion4
 type RepoServerServiceClient interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// manifests in chunks so that the response is not limited by the maximum size of a gRPC message
+	GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error)
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error)
 	// Returns a bool val if the repository is valid and has proper access
 	TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error)
+	// ValidateRepository checks the access to a repository in stages and returns the result of each stage
+	ValidateRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*ValidateRepositoryResponse, error)
 	// Returns a valid revision
 	ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error)
 	// Returns a list of refs (e.g. branches and tags) in the repo
@@ -2734,6 +3040,8 @@ type RepoServerServiceClient interface {
 	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
 	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits and the changed files between two revisions of the repo
+	GetRevisionComparison(ctx context.Context, in *RepoServerRevisionComparisonRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the OCI image
 	GetOCIMetadata(ctx context.Context, in *RepoServerRevisionChartDetailsRequest, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error)
 	// Get the chart details (author, date, tags, message) for a specific revision of the repo
@@ -2765,27 +3073,59 @@ func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *Mani
 	return out, nil
 }
 
-func (c *repoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestWithFiles", opts...)
+func (c *repoServerServiceClient) GenerateManifestStream(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (RepoServerService_GenerateManifestStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestStream", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &repoServerServiceGenerateManifestWithFilesClient{stream}
+	x := &repoServerServiceGenerateManifestStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
 	return x, nil
 }
 
-type RepoServerService_GenerateManifestWithFilesClient interface {
-	Send(*ManifestRequestWithFiles) error
-	CloseAndRecv() (*ManifestResponse, error)
+type RepoServerService_GenerateManifestStreamClient interface {
+	Recv() (*ManifestResponseChunk, error)
 	grpc.ClientStream
 }
 
-type repoServerServiceGenerateManifestWithFilesClient struct {
+type repoServerServiceGenerateManifestStreamClient struct {
 	grpc.ClientStream
 }
 
-func (x *repoServerServiceGenerateManifestWithFilesClient) Send(m *ManifestRequestWithFiles) error {
-	return x.ClientStream.SendMsg(m)
+func (x *repoServerServiceGenerateManifestStreamClient) Recv() (*ManifestResponseChunk, error) {
+	m := new(ManifestResponseChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *repoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[1], "/repository.RepoServerService/GenerateManifestWithFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoServerServiceGenerateManifestWithFilesClient{stream}
+	return x, nil
+}
+
+type RepoServerService_GenerateManifestWithFilesClient interface {
+	Send(*ManifestRequestWithFiles) error
+	CloseAndRecv() (*ManifestResponse, error)
+	grpc.ClientStream
+}
+
+type repoServerServiceGenerateManifestWithFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoServerServiceGenerateManifestWithFilesClient) Send(m *ManifestRequestWithFiles) error {
+	return x.ClientStream.SendMsg(m)
 }
 
 func (x *repoServerServiceGenerateManifestWithFilesClient) CloseAndRecv() (*ManifestResponse, error) {
@@ -2808,6 +3148,15 @@ func (c *repoServerServiceClient) TestRepository(ctx context.Context, in *TestRe
 	return out, nil
 }
 
+func (c *repoServerServiceClient) ValidateRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*ValidateRepositoryResponse, error) {
+	out := new(ValidateRepositoryResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ValidateRepository", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repoServerServiceClient) ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error) {
 	out := new(ResolveRevisionResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ResolveRevision", in, out, opts...)
@@ -2871,6 +3220,15 @@ func (c *repoServerServiceClient) GetRevisionMetadata(ctx context.Context, in *R
 	return out, nil
 }
 
+func (c *repoServerServiceClient) GetRevisionComparison(ctx context.Context, in *RepoServerRevisionComparisonRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionComparison, error) {
+	out := new(v1alpha1.RevisionComparison)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetRevisionComparison", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *repoServerServiceClient) GetOCIMetadata(ctx context.Context, in *RepoServerRevisionChartDetailsRequest, opts ...grpc.CallOption) (*v1alpha1.OCIMetadata, error) {
 	out := new(v1alpha1.OCIMetadata)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/GetOCIMetadata", in, out, opts...)
@@ -2929,10 +3287,15 @@ func (c *repoServerServiceClient) UpdateRevisionForPaths(ctx context.Context, in
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
+	// GenerateManifestStream generates manifest for application in specified repo name and revision, and streams the
+	// manifests in chunks so that the response is not limited by the maximum size of a gRPC message
+	GenerateManifestStream(*ManifestRequest, RepoServerService_GenerateManifestStreamServer) error
 	// GenerateManifestWithFiles generates manifest for application using provided tarball of files
 	GenerateManifestWithFiles(RepoServerService_GenerateManifestWithFilesServer) error
 	// Returns a bool val if the repository is valid and has proper access
 	TestRepository(context.Context, *TestRepositoryRequest) (*TestRepositoryResponse, error)
+	// ValidateRepository checks the access to a repository in stages and returns the result of each stage
+	ValidateRepository(context.Context, *TestRepositoryRequest) (*ValidateRepositoryResponse, error)
 	// Returns a valid revision
 	ResolveRevision(context.Context, *ResolveRevisionRequest) (*ResolveRevisionResponse, error)
 	// Returns a list of refs (e.g. branches and tags) in the repo
@@ -2947,6 +3310,8 @@ type RepoServerServiceServer interface {
 	GetAppDetails(context.Context, *RepoServerAppDetailsQuery) (*RepoAppDetailsResponse, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
 	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
+	// Get the commits and the changed files between two revisions of the repo
+	GetRevisionComparison(context.Context, *RepoServerRevisionComparisonRequest) (*v1alpha1.RevisionComparison, error)
 	// Get the meta-data (author, date, tags, message) for a specific revision of the OCI image
 	GetOCIMetadata(context.Context, *RepoServerRevisionChartDetailsRequest) (*v1alpha1.OCIMetadata, error)
 	// Get the chart details (author, date, tags, message) for a specific revision of the repo
@@ -2968,12 +3333,18 @@ type UnimplementedRepoServerServiceServer struct {
 func (*UnimplementedRepoServerServiceServer) GenerateManifest(ctx context.Context, req *ManifestRequest) (*ManifestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateManifest not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GenerateManifestStream(req *ManifestRequest, srv RepoServerService_GenerateManifestStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManifestStream not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) GenerateManifestWithFiles(srv RepoServerService_GenerateManifestWithFilesServer) error {
 	return status.Errorf(codes.Unimplemented, "method GenerateManifestWithFiles not implemented")
 }
 func (*UnimplementedRepoServerServiceServer) TestRepository(ctx context.Context, req *TestRepositoryRequest) (*TestRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestRepository not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) ValidateRepository(ctx context.Context, req *TestRepositoryRequest) (*ValidateRepositoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRepository not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) ResolveRevision(ctx context.Context, req *ResolveRevisionRequest) (*ResolveRevisionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveRevision not implemented")
 }
@@ -2995,6 +3366,9 @@ func (*UnimplementedRepoServerServiceServer) GetAppDetails(ctx context.Context,
 func (*UnimplementedRepoServerServiceServer) GetRevisionMetadata(ctx context.Context, req *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRevisionMetadata not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GetRevisionComparison(ctx context.Context, req *RepoServerRevisionComparisonRequest) (*v1alpha1.RevisionComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevisionComparison not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) GetOCIMetadata(ctx context.Context, req *RepoServerRevisionChartDetailsRequest) (*v1alpha1.OCIMetadata, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOCIMetadata not implemented")
 }
@@ -3036,6 +3410,27 @@ func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GenerateManifestStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ManifestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RepoServerServiceServer).GenerateManifestStream(m, &repoServerServiceGenerateManifestStreamServer{stream})
+}
+
+type RepoServerService_GenerateManifestStreamServer interface {
+	Send(*ManifestResponseChunk) error
+	grpc.ServerStream
+}
+
+type repoServerServiceGenerateManifestStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoServerServiceGenerateManifestStreamServer) Send(m *ManifestResponseChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RepoServerService_GenerateManifestWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(RepoServerServiceServer).GenerateManifestWithFiles(&repoServerServiceGenerateManifestWithFilesServer{stream})
 }
@@ -3080,6 +3475,24 @@ func _RepoServerService_TestRepository_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_ValidateRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestRepositoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).ValidateRepository(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/ValidateRepository",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).ValidateRepository(ctx, req.(*TestRepositoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepoServerService_ResolveRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveRevisionRequest)
 	if err := dec(in); err != nil {
@@ -3206,6 +3619,24 @@ func _RepoServerService_GetRevisionMetadata_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GetRevisionComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepoServerRevisionComparisonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).GetRevisionComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/GetRevisionComparison",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).GetRevisionComparison(ctx, req.(*RepoServerRevisionComparisonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RepoServerService_GetOCIMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RepoServerRevisionChartDetailsRequest)
 	if err := dec(in); err != nil {
@@ -3326,6 +3757,10 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TestRepository",
 			Handler:    _RepoServerService_TestRepository_Handler,
 		},
+		{
+			MethodName: "ValidateRepository",
+			Handler:    _RepoServerService_ValidateRepository_Handler,
+		},
 		{
 			MethodName: "ResolveRevision",
 			Handler:    _RepoServerService_ResolveRevision_Handler,
@@ -3354,6 +3789,10 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRevisionMetadata",
 			Handler:    _RepoServerService_GetRevisionMetadata_Handler,
 		},
+		{
+			MethodName: "GetRevisionComparison",
+			Handler:    _RepoServerService_GetRevisionComparison_Handler,
+		},
 		{
 			MethodName: "GetOCIMetadata",
 			Handler:    _RepoServerService_GetOCIMetadata_Handler,
@@ -3380,6 +3819,11 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateManifestStream",
+			Handler:       _RepoServerService_GenerateManifestStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GenerateManifestWithFiles",
 			Handler:       _RepoServerService_GenerateManifestWithFiles_Handler,
@@ -3413,6 +3857,15 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SourceEnvironment) > 0 {
+		i -= len(m.SourceEnvironment)
+		copy(dAtA[i:], m.SourceEnvironment)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceEnvironment)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
 	if m.SourceIntegrity != nil {
 		{
 			size, err := m.SourceIntegrity.MarshalToSizedBuffer(dAtA[:i])
@@ -3961,6 +4414,104 @@ func (m *TestRepositoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RepositoryValidationStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryValidationStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryValidationStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Details) > 0 {
+		for iNdEx := len(m.Details) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Details[iNdEx])
+			copy(dAtA[i:], m.Details[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Details[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidateRepositoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidateRepositoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidateRepositoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResolveRevisionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4167,6 +4718,61 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestResponseChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestResponseChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestResponseChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Response != nil {
+		{
+			size, err := m.Response.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Manifests) > 0 {
+		for iNdEx := len(m.Manifests) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Manifests[iNdEx])
+			copy(dAtA[i:], m.Manifests[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Manifests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ListRefsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4464,6 +5070,13 @@ func (m *RepoServerAppDetailsQuery) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SourceEnvironment) > 0 {
+		i -= len(m.SourceEnvironment)
+		copy(dAtA[i:], m.SourceEnvironment)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceEnvironment)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.RefSources) > 0 {
 		for k := range m.RefSources {
 			v := m.RefSources[k]
@@ -4761,7 +5374,7 @@ func (m *RepoServerRevisionMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoServerRevisionChartDetailsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RepoServerRevisionComparisonRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4771,12 +5384,12 @@ func (m *RepoServerRevisionChartDetailsRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *RepoServerRevisionChartDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionComparisonRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionComparisonRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4785,18 +5398,18 @@ func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.TargetRevision) > 0 {
+		i -= len(m.TargetRevision)
+		copy(dAtA[i:], m.TargetRevision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.TargetRevision)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Revision) > 0 {
 		i -= len(m.Revision)
 		copy(dAtA[i:], m.Revision)
 		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
 		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
-		i--
 		dAtA[i] = 0x12
 	}
 	if m.Repo != nil {
@@ -4814,7 +5427,7 @@ func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
+func (m *RepoServerRevisionChartDetailsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4824,12 +5437,12 @@ func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionChartDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HelmAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RepoServerRevisionChartDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4838,24 +5451,77 @@ func (m *HelmAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FileParameters) > 0 {
-		for iNdEx := len(m.FileParameters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FileParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRepository(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Values) > 0 {
-		i -= len(m.Values)
-		copy(dAtA[i:], m.Values)
-		i = encodeVarintRepository(dAtA, i, uint64(len(m.Values)))
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HelmAppSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmAppSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmAppSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.FileParameters) > 0 {
+		for iNdEx := len(m.FileParameters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FileParameters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Values) > 0 {
+		i -= len(m.Values)
+		copy(dAtA[i:], m.Values)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Values)))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5527,6 +6193,15 @@ func (m *UpdateRevisionForPathsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SourceEnvironment) > 0 {
+		i -= len(m.SourceEnvironment)
+		copy(dAtA[i:], m.SourceEnvironment)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SourceEnvironment)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.SyncedRefSources) > 0 {
 		for k := range m.SyncedRefSources {
 			v := m.SyncedRefSources[k]
@@ -5882,6 +6557,10 @@ func (m *ManifestRequest) Size() (n int) {
 		l = m.SourceIntegrity.Size()
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	l = len(m.SourceEnvironment)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6005,6 +6684,54 @@ func (m *TestRepositoryResponse) Size() (n int) {
 	return n
 }
 
+func (m *RepositoryValidationStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if len(m.Details) > 0 {
+		for _, s := range m.Details {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidateRepositoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ResolveRevisionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6103,6 +6830,32 @@ func (m *ManifestResponse) Size() (n int) {
 	return n
 }
 
+func (m *ManifestResponseChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Manifests) > 0 {
+		for _, s := range m.Manifests {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ListRefsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6288,6 +7041,10 @@ func (m *RepoServerAppDetailsQuery) Size() (n int) {
 			n += mapEntrySize + 1 + sovRepository(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SourceEnvironment)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6353,6 +7110,30 @@ func (m *RepoServerRevisionMetadataRequest) Size() (n int) {
 	return n
 }
 
+func (m *RepoServerRevisionComparisonRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *RepoServerRevisionChartDetailsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6777,6 +7558,10 @@ func (m *UpdateRevisionForPathsRequest) Size() (n int) {
 			n += mapEntrySize + 2 + sovRepository(uint64(mapEntrySize))
 		}
 	}
+	l = len(m.SourceEnvironment)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7795,6 +8580,38 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEnvironment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceEnvironment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -8318,7 +9135,7 @@ func (m *TestRepositoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
+func (m *RepositoryValidationStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8341,17 +9158,17 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResolveRevisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryValidationStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResolveRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryValidationStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8361,33 +9178,29 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8397,31 +9210,27 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &v1alpha1.Application{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8449,13 +9258,13 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			m.SourceIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8465,25 +9274,297 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SourceIndex |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Details = append(m.Details, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidateRepositoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidateRepositoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidateRepositoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, &RepositoryValidationStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveRevisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &v1alpha1.Application{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIndex", wireType)
+			}
+			m.SourceIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
 				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
@@ -8818,7 +9899,158 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIntegrityResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceIntegrityResult == nil {
+				m.SourceIntegrityResult = &v1alpha1.SourceIntegrityCheckResult{}
+			}
+			if err := m.SourceIntegrityResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManifestResponseChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManifestResponseChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManifestResponseChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8846,13 +10078,13 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VerifyResult = string(dAtA[iNdEx:postIndex])
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commands", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8862,29 +10094,33 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
+			if m.Response == nil {
+				m.Response = &ManifestResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceIntegrityResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -8894,27 +10130,23 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SourceIntegrityResult == nil {
-				m.SourceIntegrityResult = &v1alpha1.SourceIntegrityCheckResult{}
-			}
-			if err := m.SourceIntegrityResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10275,6 +11507,38 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.RefSources[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEnvironment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceEnvironment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -10699,6 +11963,157 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RepoServerRevisionComparisonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerRevisionComparisonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerRevisionComparisonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RepoServerRevisionChartDetailsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13456,6 +14871,38 @@ func (m *UpdateRevisionForPathsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SyncedRefSources[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceEnvironment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceEnvironment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -16,6 +16,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
@@ -62,7 +63,33 @@ type CachedManifestResponse struct {
 	NumberOfCachedResponsesReturned int                         `json:"numberOfCachedResponsesReturned"`
 }
 
+// appDetailsCacheSchemaVersion is the version of the schema of the cached app details
+const appDetailsCacheSchemaVersion = "appdetails.1"
+
+// keySchemas holds the schemas of the values cached by the repo server. When the type of the values of a schema changes,
+// bump the version of that schema rather than common.CacheVersion so that the other repo server caches survive the
+// upgrade, and list the previous version if its values can still be decoded.
+var keySchemas = []cacheutil.KeySchema{
+	{Prefix: "mfst", Version: common.CacheVersion},
+	// app details used to be versioned with common.CacheVersion. Their values did not change when they got their own
+	// version, so the values cached using common.CacheVersion are still read, and also written for the replicas which
+	// are not upgraded yet.
+	{Prefix: "appdetails", Version: appDetailsCacheSchemaVersion, PreviousVersions: []string{common.CacheVersion}, DualWrite: true},
+	{Prefix: "ldir", Version: common.CacheVersion},
+	{Prefix: "git-refs", Version: common.CacheVersion},
+	{Prefix: "helm-index", Version: common.CacheVersion},
+	{Prefix: "oci-tags", Version: common.CacheVersion},
+	{Prefix: "revisionmetadata", Version: common.CacheVersion},
+	{Prefix: "chartdetails", Version: common.CacheVersion},
+	{Prefix: "ocimetadata", Version: common.CacheVersion},
+	{Prefix: "gitfiles", Version: common.CacheVersion},
+	{Prefix: "gitdirs", Version: common.CacheVersion},
+	{Prefix: "gitFilesChanges", Version: common.CacheVersion},
+	{Prefix: "repo-fetch-lock", Version: common.CacheVersion},
+}
+
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration, revisionCacheLockTimeout time.Duration) *Cache {
+	cache.RegisterKeySchemas(keySchemas...)
 	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration, revisionCacheLockTimeout}
 }
 
@@ -328,6 +355,45 @@ func (c *Cache) UnlockGitReferences(repo string, lockId string) error {
 	return err
 }
 
+func repoFetchLockKey(repo string, revision string) string {
+	return fmt.Sprintf("repo-fetch-lock|%s|%s", repo, revision)
+}
+
+// TryLockRepoFetch attempts to take the ownership of fetching the revision of the repository on behalf of all the repo
+// server replicas. Returns the lock ID of the current owner, which is lockId if the lock was acquired, or an empty
+// string if the revision is not locked.
+func (c *Cache) TryLockRepoFetch(repo string, revision string, lockId string, timeout time.Duration) (string, error) {
+	// DisableOverwrite makes sure that only one replica claims the ownership, the owner is then read back
+	err := c.cache.SetItem(repoFetchLockKey(repo, revision), lockId, &cacheutil.CacheActionOpts{
+		Expiration:       timeout,
+		DisableOverwrite: true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.GetRepoFetchLock(repo, revision)
+}
+
+// GetRepoFetchLock returns the lock ID of the owner of the fetch of the revision of the repository, or an empty string if
+// the revision is not locked
+func (c *Cache) GetRepoFetchLock(repo string, revision string) (string, error) {
+	var lockId string
+	err := c.cache.GetItem(repoFetchLockKey(repo, revision), &lockId)
+	if errors.Is(err, ErrCacheMiss) {
+		return "", nil
+	}
+	return lockId, err
+}
+
+// UnlockRepoFetch releases the ownership of the fetch of the revision of the repository if it is owned by lockId
+func (c *Cache) UnlockRepoFetch(repo string, revision string, lockId string) error {
+	owner, err := c.GetRepoFetchLock(repo, revision)
+	if err != nil || owner != lockId {
+		return err
+	}
+	return c.cache.SetItem(repoFetchLockKey(repo, revision), lockId, &cacheutil.CacheActionOpts{Delete: true})
+}
+
 // ManifestKey carries all fields required to build a manifests cache key.
 type ManifestKey struct {
 	Revision       string
@@ -343,6 +409,8 @@ type ManifestKey struct {
 	RefSourceCommitSHAs ResolvedRevisions
 	InstallationID      string
 	SourceIntegrity     *appv1.SourceIntegrity
+	// SourceEnvironment is the environment selecting the parameter override files merged into the source
+	SourceEnvironment string
 }
 
 func (d ManifestKey) String() string {
@@ -351,6 +419,9 @@ func (d ManifestKey) String() string {
 	if d.InstallationID != "" {
 		key = fmt.Sprintf("%s|%s", key, d.InstallationID)
 	}
+	if d.SourceEnvironment != "" {
+		key = fmt.Sprintf("%s|env:%s", key, d.SourceEnvironment)
+	}
 	return key
 }
 
@@ -446,20 +517,24 @@ func (c *Cache) DeleteManifests(manifestKey ManifestKey) error {
 		&cacheutil.CacheActionOpts{Delete: true})
 }
 
-func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions) string {
+func appDetailsCacheKey(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions, sourceEnvironment string) string {
 	if trackingMethod == "" {
 		trackingMethod = appv1.TrackingMethodLabel
 	}
-	return fmt.Sprintf("appdetails|%s|%d|%s", revision, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs), trackingMethod)
+	key := fmt.Sprintf("appdetails|%s|%d|%s", revision, appSourceKey(appSrc, srcRefs, refSourceCommitSHAs), trackingMethod)
+	if sourceEnvironment != "" {
+		key = fmt.Sprintf("%s|env:%s", key, sourceEnvironment)
+	}
+	return key
 }
 
-func (c *Cache) GetAppDetails(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, res *apiclient.RepoAppDetailsResponse, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions) error {
-	return c.cache.GetItem(appDetailsCacheKey(revision, appSrc, srcRefs, trackingMethod, refSourceCommitSHAs), res)
+func (c *Cache) GetAppDetails(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, res *apiclient.RepoAppDetailsResponse, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions, sourceEnvironment string) error {
+	return c.cache.GetItem(appDetailsCacheKey(revision, appSrc, srcRefs, trackingMethod, refSourceCommitSHAs, sourceEnvironment), res)
 }
 
-func (c *Cache) SetAppDetails(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, res *apiclient.RepoAppDetailsResponse, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions) error {
+func (c *Cache) SetAppDetails(revision string, appSrc *appv1.ApplicationSource, srcRefs appv1.RefTargetRevisionMapping, res *apiclient.RepoAppDetailsResponse, trackingMethod appv1.TrackingMethod, refSourceCommitSHAs ResolvedRevisions, sourceEnvironment string) error {
 	return c.cache.SetItem(
-		appDetailsCacheKey(revision, appSrc, srcRefs, trackingMethod, refSourceCommitSHAs),
+		appDetailsCacheKey(revision, appSrc, srcRefs, trackingMethod, refSourceCommitSHAs, sourceEnvironment),
 		res,
 		&cacheutil.CacheActionOpts{
 			Expiration: c.repoCacheExpiration,
@@ -483,6 +558,22 @@ func (c *Cache) SetRevisionMetadata(repoURL, revision string, item *appv1.Revisi
 		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
 }
 
+func revisionComparisonKey(repoURL, revision, targetRevision string) string {
+	return fmt.Sprintf("revisioncomparison|%s|%s|%s", repoURL, revision, targetRevision)
+}
+
+func (c *Cache) GetRevisionComparison(repoURL, revision, targetRevision string) (*appv1.RevisionComparison, error) {
+	item := &appv1.RevisionComparison{}
+	return item, c.cache.GetItem(revisionComparisonKey(repoURL, revision, targetRevision), item)
+}
+
+func (c *Cache) SetRevisionComparison(repoURL, revision, targetRevision string, item *appv1.RevisionComparison) error {
+	return c.cache.SetItem(
+		revisionComparisonKey(repoURL, revision, targetRevision),
+		item,
+		&cacheutil.CacheActionOpts{Expiration: c.repoCacheExpiration})
+}
+
 func revisionChartDetailsKey(repoURL, chart, revision string) string {
 	return fmt.Sprintf("chartdetails|%s|%s|%s", repoURL, chart, revision)
 }
@@ -566,6 +657,25 @@ func (c *Cache) GetGitFilesChanges(repoURL, revision, targetRevision string) ([]
 	return files, err
 }
 
+func repositoryStatusKey(repoURL, project string) string {
+	return fmt.Sprintf("repostatus|%s|%s", repoURL, project)
+}
+
+// GetRepositoryStatus returns the result of the latest health check of the given repository
+func (c *Cache) GetRepositoryStatus(repoURL, project string) (*appv1.RepositoryStatus, error) {
+	item := &appv1.RepositoryStatus{}
+	return item, c.cache.GetItem(repositoryStatusKey(repoURL, project), item)
+}
+
+// SetRepositoryStatus stores the result of the latest health check of the given repository. The status is deleted if
+// item is nil.
+func (c *Cache) SetRepositoryStatus(repoURL, project string, item *appv1.RepositoryStatus, expiration time.Duration) error {
+	return c.cache.SetItem(
+		repositoryStatusKey(repoURL, project),
+		item,
+		&cacheutil.CacheActionOpts{Expiration: expiration, Delete: item == nil})
+}
+
 func (cmr *CachedManifestResponse) shallowCopy() *CachedManifestResponse {
 	if cmr == nil {
 		return nil
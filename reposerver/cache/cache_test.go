@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/reposerver/cache/mocks"
@@ -61,6 +62,29 @@ func TestCache_GetRevisionMetadata(t *testing.T) {
 	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 1, ExternalGets: 4})
 }
 
+func TestCache_GetRevisionComparison(t *testing.T) {
+	t.Parallel()
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	cache := fixtures.cache
+	mockCache := fixtures.mockCache
+	// cache miss
+	_, err := cache.GetRevisionComparison("my-repo-url", "my-revision", "my-target-revision")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// populate cache
+	comparison := &v1alpha1.RevisionComparison{Revision: "my-revision", TargetRevision: "my-target-revision", ChangedFiles: []string{"README"}}
+	err = cache.SetRevisionComparison("my-repo-url", "my-revision", "my-target-revision", comparison)
+	require.NoError(t, err)
+	// cache miss
+	_, err = cache.GetRevisionComparison("my-repo-url", "my-target-revision", "my-revision")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache hit
+	value, err := cache.GetRevisionComparison("my-repo-url", "my-revision", "my-target-revision")
+	require.NoError(t, err)
+	assert.Equal(t, comparison, value)
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 1, ExternalGets: 3})
+}
+
 func TestCache_ListApps(t *testing.T) {
 	t.Parallel()
 	fixtures := newFixtures()
@@ -136,6 +160,12 @@ func TestCache_GetManifests(t *testing.T) {
 		err = cache.GetManifests(newManifestCacheKeyData("my-revision", &v1alpha1.ApplicationSource{}, "my-namespace", "my-app-label-key", "other-app-label-value", map[string]string{"my-referenced-source": "my-referenced-revision"}), value)
 		require.ErrorIs(t, err, ErrCacheMiss)
 	})
+	t.Run("expect cache miss because of changed source environment", func(t *testing.T) {
+		key := newManifestCacheKeyData("my-revision", &v1alpha1.ApplicationSource{}, "my-namespace", "my-app-label-key", "my-app-label-value", nil)
+		key.SourceEnvironment = "prod"
+		err = cache.GetManifests(key, value)
+		require.ErrorIs(t, err, ErrCacheMiss)
+	})
 	t.Run("expect cache hit", func(t *testing.T) {
 		err = cache.SetManifests(
 			newManifestCacheKeyData("my-revision1", &v1alpha1.ApplicationSource{}, "my-namespace", "my-app-label-key", "my-app-label-value", nil),
@@ -148,7 +178,7 @@ func TestCache_GetManifests(t *testing.T) {
 		assert.Equal(t, "my-source-type", value.ManifestResponse.SourceType)
 		assert.Equal(t, "my-revision1", value.ManifestResponse.Revision)
 	})
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 8})
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 9})
 }
 
 func TestCache_GetAppDetails(t *testing.T) {
@@ -160,22 +190,43 @@ func TestCache_GetAppDetails(t *testing.T) {
 	// cache miss
 	value := &apiclient.RepoAppDetailsResponse{}
 	emptyRefSources := map[string]*v1alpha1.RefTarget{}
-	err := cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil)
+	err := cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil, "")
 	require.ErrorIs(t, err, ErrCacheMiss)
 	res := &apiclient.RepoAppDetailsResponse{Type: "my-type"}
-	err = cache.SetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, res, "", nil)
+	err = cache.SetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, res, "", nil, "")
 	require.NoError(t, err)
 	// cache miss
-	err = cache.GetAppDetails("other-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil)
+	err = cache.GetAppDetails("other-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil, "")
+	require.ErrorIs(t, err, ErrCacheMiss)
+	// cache miss
+	err = cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{Path: "other-path"}, emptyRefSources, value, "", nil, "")
 	require.ErrorIs(t, err, ErrCacheMiss)
 	// cache miss
-	err = cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{Path: "other-path"}, emptyRefSources, value, "", nil)
+	err = cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil, "prod")
 	require.ErrorIs(t, err, ErrCacheMiss)
 	// cache hit
-	err = cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil)
+	err = cache.GetAppDetails("my-revision", &v1alpha1.ApplicationSource{}, emptyRefSources, value, "", nil, "")
 	require.NoError(t, err)
 	assert.Equal(t, &apiclient.RepoAppDetailsResponse{Type: "my-type"}, value)
-	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 1, ExternalGets: 4})
+	// each miss also looks up the previous schema version, and the value is written using both versions
+	mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 2, ExternalGets: 9})
+}
+
+func TestCache_GetAppDetailsPreviousSchemaVersion(t *testing.T) {
+	t.Parallel()
+	inMemCache := cacheutil.NewInMemoryCache(1 * time.Hour)
+	cache := NewCache(cacheutil.NewCache(inMemCache), 1*time.Minute, 1*time.Minute, 10*time.Second)
+	appSrc := &v1alpha1.ApplicationSource{}
+	key := appDetailsCacheKey("my-revision", appSrc, nil, "", nil, "")
+	require.NoError(t, inMemCache.Set(&cacheutil.Item{Key: key + "|" + common.CacheVersion, Object: &apiclient.RepoAppDetailsResponse{Type: "my-type"}}))
+
+	value := &apiclient.RepoAppDetailsResponse{}
+	require.NoError(t, cache.GetAppDetails("my-revision", appSrc, nil, value, "", nil, ""))
+	assert.Equal(t, &apiclient.RepoAppDetailsResponse{Type: "my-type"}, value)
+
+	migrated := &apiclient.RepoAppDetailsResponse{}
+	require.NoError(t, inMemCache.Get(key+"|"+appDetailsCacheSchemaVersion, migrated), "value is copied to the current version")
+	assert.Equal(t, value, migrated)
 }
 
 func TestAddCacheFlagsToCmd(t *testing.T) {
@@ -47,5 +47,8 @@ func NewGitClientEventHandlers(metricsServer *MetricsServer) git.EventHandlers {
 				metricsServer.ObserveGitRequestDuration(repo, GitRequestTypeLsRemote, time.Since(startTime))
 			}
 		},
+		OnLFSPull: func(repo string, stats git.LFSPullStats) {
+			metricsServer.ObserveGitLFSPull(repo, stats.IncludedFiles, stats.ExcludedFiles, stats.CacheSize)
+		},
 	}
 }
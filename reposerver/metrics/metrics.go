@@ -27,6 +27,9 @@ type MetricsServer struct {
 	ociTestRepoFailCounter        *prometheus.CounterVec
 	ociRequestCounter             *prometheus.CounterVec
 	ociRequestHistogram           *prometheus.HistogramVec
+	helmDependencyCacheCounter    *prometheus.CounterVec
+	gitLFSFilesCounter            *prometheus.CounterVec
+	gitLFSCacheSizeGauge          *prometheus.GaugeVec
 	PrometheusRegistry            *prometheus.Registry
 }
 
@@ -181,6 +184,33 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(ociRequestHistogram)
 
+	helmDependencyCacheCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_helm_dependency_cache_request_total",
+			Help: "Number of helm chart dependency cache lookups performed by repo server",
+		},
+		[]string{"hit"},
+	)
+	registry.MustRegister(helmDependencyCacheCounter)
+
+	gitLFSFilesCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_git_lfs_files_total",
+			Help: "Number of LFS files of the checked out revisions by repo server, by whether they were pulled",
+		},
+		[]string{"repo", "included"},
+	)
+	registry.MustRegister(gitLFSFilesCounter)
+
+	gitLFSCacheSizeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_git_lfs_cache_size_bytes",
+			Help: "Size in bytes of the LFS objects stored by repo server",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(gitLFSCacheSizeGauge)
+
 	return &MetricsServer{
 		handler:                       promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitFetchFailCounter:           gitFetchFailCounter,
@@ -198,6 +228,9 @@ func NewMetricsServer() *MetricsServer {
 		ociGetTagsFailCounter:         ociGetTagsFailCounter,
 		ociDigestMetadataCounter:      ociDigestMetadataCounter,
 		ociTestRepoFailCounter:        ociTestRepoFailCounter,
+		helmDependencyCacheCounter:    helmDependencyCacheCounter,
+		gitLFSFilesCounter:            gitLFSFilesCounter,
+		gitLFSCacheSizeGauge:          gitLFSCacheSizeGauge,
 		PrometheusRegistry:            registry,
 	}
 }
@@ -280,3 +313,16 @@ func (m *MetricsServer) IncOCIGetTagsFailCounter(repo string) {
 func (m *MetricsServer) IncOCITestRepoFailCounter(repo string) {
 	m.ociTestRepoFailCounter.WithLabelValues(repo).Inc()
 }
+
+// IncHelmDependencyCacheRequest increments the helm dependency cache lookups counter
+func (m *MetricsServer) IncHelmDependencyCacheRequest(hit bool) {
+	m.helmDependencyCacheCounter.WithLabelValues(strconv.FormatBool(hit)).Inc()
+}
+
+// ObserveGitLFSPull records the LFS files of a checkout limited to the LFS include paths and the size of the LFS objects
+// stored for the repository
+func (m *MetricsServer) ObserveGitLFSPull(repo string, includedFiles int, excludedFiles int, cacheSize int64) {
+	m.gitLFSFilesCounter.WithLabelValues(repo, "true").Add(float64(includedFiles))
+	m.gitLFSFilesCounter.WithLabelValues(repo, "false").Add(float64(excludedFiles))
+	m.gitLFSCacheSizeGauge.WithLabelValues(repo).Set(float64(cacheSize))
+}
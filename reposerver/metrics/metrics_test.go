@@ -45,3 +45,23 @@ func TestParallelismWaitMetricRegistered(t *testing.T) {
 	count := testutil.CollectAndCount(m.PrometheusRegistry, "argocd_repo_parallelism_wait_duration_seconds")
 	assert.Equal(t, 1, count)
 }
+
+func TestObserveGitLFSPull(t *testing.T) {
+	t.Parallel()
+	m := NewMetricsServer()
+
+	m.ObserveGitLFSPull("https://github.com/argoproj/argo-cd", 2, 3, 1024)
+	m.ObserveGitLFSPull("https://github.com/argoproj/argo-cd", 1, 3, 2048)
+
+	expected := `
+# HELP argocd_git_lfs_cache_size_bytes Size in bytes of the LFS objects stored by repo server
+# TYPE argocd_git_lfs_cache_size_bytes gauge
+argocd_git_lfs_cache_size_bytes{repo="https://github.com/argoproj/argo-cd"} 2048
+# HELP argocd_git_lfs_files_total Number of LFS files of the checked out revisions by repo server, by whether they were pulled
+# TYPE argocd_git_lfs_files_total counter
+argocd_git_lfs_files_total{included="false",repo="https://github.com/argoproj/argo-cd"} 6
+argocd_git_lfs_files_total{included="true",repo="https://github.com/argoproj/argo-cd"} 3
+`
+	err := testutil.GatherAndCompare(m.PrometheusRegistry, strings.NewReader(expected), "argocd_git_lfs_files_total", "argocd_git_lfs_cache_size_bytes")
+	require.NoError(t, err)
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/cache"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+const (
+	// observedRepositoryExpiration is the time after which a repository that was not requested anymore is no longer
+	// checked
+	observedRepositoryExpiration = 24 * time.Hour
+	// repositoryHealthCheckTimeout is the maximum duration of the health check of a single repository
+	repositoryHealthCheckTimeout = time.Minute
+	// repositoryHealthCheckParallelism is the number of repositories that are checked concurrently
+	repositoryHealthCheckParallelism = 10
+)
+
+// observedRepository is a repository the repo server was asked to access
+type observedRepository struct {
+	repo     *v1alpha1.Repository
+	lastSeen time.Time
+}
+
+// observedRepositories holds the repositories that are included in the background health checks, keyed by URL and
+// project. The repo server has no access to the repository secrets, so it checks the repositories (including their
+// credentials) it received with requests.
+type observedRepositories struct {
+	lock  sync.Mutex
+	repos map[string]*observedRepository
+}
+
+func newObservedRepositories() *observedRepositories {
+	return &observedRepositories{repos: map[string]*observedRepository{}}
+}
+
+// ObserveRepository records a repository the repo server was asked to access, so that it is included in the
+// background health checks. The latest credentials of the repository are used for the checks.
+func (s *Service) ObserveRepository(repo *v1alpha1.Repository) {
+	if repo == nil || repo.Repo == "" {
+		return
+	}
+	s.observedRepos.lock.Lock()
+	defer s.observedRepos.lock.Unlock()
+	s.observedRepos.repos[repo.Repo+"|"+repo.Project] = &observedRepository{repo: repo.DeepCopy(), lastSeen: s.now()}
+}
+
+// listObservedRepositories returns the repositories to check, and forgets the repositories that were not requested
+// anymore.
+func (s *Service) listObservedRepositories() []*v1alpha1.Repository {
+	s.observedRepos.lock.Lock()
+	defer s.observedRepos.lock.Unlock()
+	var repos []*v1alpha1.Repository
+	for key, observed := range s.observedRepos.repos {
+		if s.now().Sub(observed.lastSeen) > observedRepositoryExpiration {
+			delete(s.observedRepos.repos, key)
+			continue
+		}
+		repos = append(repos, observed.repo)
+	}
+	return repos
+}
+
+// RunRepositoryHealthChecks periodically checks the connectivity of the repositories the repo server was asked to
+// access within the last 24 hours, and stores the results in the cache. It returns once the context is done.
+func (s *Service) RunRepositoryHealthChecks(ctx context.Context, interval time.Duration) {
+	log.Infof("Checking the health of repositories every %v", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkRepositoriesHealth(ctx, interval)
+		}
+	}
+}
+
+// checkRepositoriesHealth checks the health of all observed repositories. Repositories that were checked within half
+// of the interval, e.g. by another repo server replica, are skipped.
+func (s *Service) checkRepositoriesHealth(ctx context.Context, interval time.Duration) {
+	sem := make(chan struct{}, repositoryHealthCheckParallelism)
+	var wg sync.WaitGroup
+	for _, repo := range s.listObservedRepositories() {
+		previous, err := s.cache.GetRepositoryStatus(repo.Repo, repo.Project)
+		if err != nil {
+			if !errors.Is(err, cache.ErrCacheMiss) {
+				log.Warnf("Failed to get status of repository %s: %v", repo.Repo, err)
+			}
+			previous = nil
+		} else if previous.LastCheckedAt != nil && s.now().Sub(previous.LastCheckedAt.Time) < interval/2 {
+			continue
+		}
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			status := s.checkRepositoryHealth(ctx, repo, previous)
+			if status.Status == v1alpha1.ConnectionStatusFailed {
+				log.WithFields(log.Fields{"repo": repo.Repo, "project": repo.Project, "check": status.FailedCheck}).Warnf("Repository health check failed: %s", status.LastError)
+			}
+			// keep the status for a few intervals, so that it expires once the repository is no longer checked
+			if err := s.cache.SetRepositoryStatus(repo.Repo, repo.Project, status, 3*interval); err != nil {
+				log.Warnf("Failed to store status of repository %s: %v", repo.Repo, err)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+// checkRepositoryHealth checks that the given repository can be accessed with its credentials and, if LFS is enabled,
+// that its LFS server is available.
+func (s *Service) checkRepositoryHealth(ctx context.Context, repo *v1alpha1.Repository, previous *v1alpha1.RepositoryStatus) *v1alpha1.RepositoryStatus {
+	ctx, cancel := context.WithTimeout(ctx, repositoryHealthCheckTimeout)
+	defer cancel()
+
+	now := metav1.NewTime(s.now())
+	status := &v1alpha1.RepositoryStatus{Status: v1alpha1.ConnectionStatusSuccessful, LastCheckedAt: &now, LastSucceededAt: &now}
+	failedCheck, err := s.checkRepository(ctx, repo)
+	if err != nil {
+		status.Status = v1alpha1.ConnectionStatusFailed
+		status.FailedCheck = failedCheck
+		status.LastError = err.Error()
+		status.LastSucceededAt = nil
+		if previous != nil {
+			status.LastSucceededAt = previous.LastSucceededAt
+		}
+	}
+	return status
+}
+
+// checkRepository runs the health checks of the given repository, and returns the check that failed
+func (s *Service) checkRepository(ctx context.Context, repo *v1alpha1.Repository) (string, error) {
+	if err := s.testRepository(ctx, repo.DeepCopy()); err != nil {
+		return failedRepositoryCheck(err), err
+	}
+	if repo.IsLFSEnabled() && (repo.Type == "" || repo.Type == "git") {
+		if err := git.TestLFS(ctx, repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.Proxy, repo.NoProxy); err != nil {
+			return v1alpha1.RepositoryCheckLFS, err
+		}
+	}
+	return "", nil
+}
+
+// failedRepositoryCheck returns the check that failed with the given error. The errors are classified by their
+// message, since git, Helm and OCI clients report them differently.
+func failedRepositoryCheck(err error) string {
+	msg := strings.ToLower(err.Error())
+	for _, tlsErr := range []string{"x509:", "tls:", "certificate", "knownhosts:", "host key"} {
+		if strings.Contains(msg, tlsErr) {
+			return v1alpha1.RepositoryCheckTLS
+		}
+	}
+	for _, authErr := range []string{"authentication required", "authorization failed", "unable to authenticate", "unauthorized", "forbidden", "permission denied", "invalid credentials", "invalid username or password"} {
+		if strings.Contains(msg, authErr) {
+			return v1alpha1.RepositoryCheckAuth
+		}
+	}
+	return v1alpha1.RepositoryCheckConnection
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/cache"
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v3/util/git"
+)
+
+func newHelmRepoServer(t *testing.T, status *int, tls bool) *httptest.Server {
+	t.Helper()
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(*status)
+		_, _ = w.Write([]byte("apiVersion: v1\nentries: {}\n"))
+	})
+	var server *httptest.Server
+	if tls {
+		server = httptest.NewTLSServer(handler)
+	} else {
+		server = httptest.NewServer(handler)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCheckRepositoriesHealth(t *testing.T) {
+	cacheMocks := newCacheMocks()
+	t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+	service := NewService(metrics.NewMetricsServer(), cacheMocks.cache, RepoServerInitConstants{HelmRegistryMaxIndexSize: 1024}, &git.NoopCredsStore{}, t.TempDir())
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	status := http.StatusOK
+	helmRepo := &v1alpha1.Repository{Type: "helm", Name: "helm", Repo: newHelmRepoServer(t, &status, false).URL, Project: "default"}
+	tlsRepo := &v1alpha1.Repository{Type: "helm", Name: "tls", Repo: newHelmRepoServer(t, &status, true).URL}
+	service.ObserveRepository(helmRepo)
+	service.ObserveRepository(tlsRepo)
+	service.ObserveRepository(&v1alpha1.Repository{})
+
+	getStatus := func(repo *v1alpha1.Repository) *v1alpha1.RepositoryStatus {
+		t.Helper()
+		repoStatus, err := cacheMocks.cache.GetRepositoryStatus(repo.Repo, repo.Project)
+		require.NoError(t, err)
+		return repoStatus
+	}
+
+	service.checkRepositoriesHealth(t.Context(), 10*time.Minute)
+	firstCheck := getStatus(helmRepo)
+	assert.Equal(t, v1alpha1.ConnectionStatusSuccessful, firstCheck.Status)
+	assert.Equal(t, now, firstCheck.LastCheckedAt.Time.UTC())
+	assert.Equal(t, now, firstCheck.LastSucceededAt.Time.UTC())
+	assert.Empty(t, firstCheck.LastError)
+
+	tlsStatus := getStatus(tlsRepo)
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, tlsStatus.Status)
+	assert.Equal(t, v1alpha1.RepositoryCheckTLS, tlsStatus.FailedCheck)
+	assert.Contains(t, tlsStatus.LastError, "x509")
+	assert.Nil(t, tlsStatus.LastSucceededAt)
+
+	// repositories that were checked recently, e.g. by another replica, are skipped
+	status = http.StatusUnauthorized
+	now = now.Add(4 * time.Minute)
+	service.checkRepositoriesHealth(t.Context(), 10*time.Minute)
+	assert.Equal(t, firstCheck, getStatus(helmRepo))
+
+	now = now.Add(6 * time.Minute)
+	service.checkRepositoriesHealth(t.Context(), 10*time.Minute)
+	secondCheck := getStatus(helmRepo)
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, secondCheck.Status)
+	assert.Equal(t, v1alpha1.RepositoryCheckAuth, secondCheck.FailedCheck)
+	assert.Contains(t, secondCheck.LastError, "401 Unauthorized")
+	assert.Equal(t, now, secondCheck.LastCheckedAt.Time.UTC())
+	assert.Equal(t, firstCheck.LastSucceededAt, secondCheck.LastSucceededAt, "the time of the last successful check is kept")
+
+	t.Run("ObservationExpired", func(t *testing.T) {
+		now = now.Add(observedRepositoryExpiration)
+		service.ObserveRepository(helmRepo)
+		now = now.Add(time.Minute)
+		repos := service.listObservedRepositories()
+		require.Len(t, repos, 1)
+		assert.Equal(t, helmRepo.Repo, repos[0].Repo)
+	})
+}
+
+func TestFailedRepositoryCheck(t *testing.T) {
+	tests := []struct {
+		err      string
+		expected string
+	}{
+		{"tls: failed to verify certificate: x509: certificate signed by unknown authority", v1alpha1.RepositoryCheckTLS},
+		{"ssh: handshake failed: knownhosts: key is unknown", v1alpha1.RepositoryCheckTLS},
+		{"unable to ls-remote HEAD on repository: authentication required: Invalid username or token", v1alpha1.RepositoryCheckAuth},
+		{"ssh: handshake failed: ssh: unable to authenticate, attempted methods [none publickey]", v1alpha1.RepositoryCheckAuth},
+		{"failed to get index: 403 Forbidden", v1alpha1.RepositoryCheckAuth},
+		{"dial tcp 127.0.0.1:1: connect: connection refused", v1alpha1.RepositoryCheckConnection},
+		{"repository not found", v1alpha1.RepositoryCheckConnection},
+	}
+	for _, tt := range tests {
+		t.Run(tt.err, func(t *testing.T) {
+			assert.Equal(t, tt.expected, failedRepositoryCheck(errors.New(tt.err)))
+		})
+	}
+}
+
+func TestObserveRepository(t *testing.T) {
+	service := NewService(metrics.NewMetricsServer(), newCacheMocks().cache, RepoServerInitConstants{}, &git.NoopCredsStore{}, t.TempDir())
+	repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps", Password: "old"}
+	service.ObserveRepository(repo)
+	service.ObserveRepository(&v1alpha1.Repository{Repo: repo.Repo, Password: "new"})
+	service.ObserveRepository(&v1alpha1.Repository{Repo: repo.Repo, Project: "other"})
+
+	repos := service.listObservedRepositories()
+	require.Len(t, repos, 2)
+	for _, r := range repos {
+		if r.Project == "" {
+			assert.Equal(t, "new", r.Password, "the latest credentials of a repository are checked")
+		}
+	}
+
+	_, err := service.cache.GetRepositoryStatus(repo.Repo, repo.Project)
+	assert.ErrorIs(t, err, cache.ErrCacheMiss)
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/pkg/v2/sync"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+// helmLockFiles are the lock files of the dependencies of a chart, for the v2 and v1 chart API versions
+var helmLockFiles = []string{"Chart.lock", "requirements.lock"}
+
+// helmLock is the lock file of the dependencies of a chart
+type helmLock struct {
+	Digest       string `json:"digest"`
+	Dependencies []struct {
+		Name       string `json:"name"`
+		Repository string `json:"repository"`
+		Version    string `json:"version"`
+	} `json:"dependencies"`
+}
+
+// helmDependencyCache is a content addressed cache of the dependencies of the helm charts, shared by all the
+// applications. The archives downloaded by `helm dependency build` are stored under the digest of the lock file of the
+// chart, so that the charts locking the same dependencies reuse them instead of downloading them again.
+type helmDependencyCache struct {
+	dir           string
+	lock          sync.KeyLock
+	metricsServer *metrics.MetricsServer
+}
+
+func newHelmDependencyCache(dir string, metricsServer *metrics.MetricsServer) *helmDependencyCache {
+	return &helmDependencyCache{dir: dir, lock: sync.NewKeyLock(), metricsServer: metricsServer}
+}
+
+// getKey returns the key of the dependencies of the chart, or false if they cannot be cached. The key includes the
+// credentials of the repositories, so that the dependencies are only shared by the applications which are allowed to
+// download them. Charts without a lock file or with local dependencies are not cached, since their lock file does not
+// identify the content of their dependencies.
+func (c *helmDependencyCache) getKey(appPath string, repos []helm.HelmRepository) (string, *helmLock, bool) {
+	var lock *helmLock
+	for _, name := range helmLockFiles {
+		data, err := os.ReadFile(filepath.Join(appPath, name))
+		if err != nil {
+			continue
+		}
+		lock = &helmLock{}
+		if err := yaml.Unmarshal(data, lock); err != nil {
+			log.Warnf("Failed to parse helm lock file %s: %v", filepath.Join(appPath, name), err)
+			return "", nil, false
+		}
+		break
+	}
+	if lock == nil || lock.Digest == "" || len(lock.Dependencies) == 0 {
+		return "", nil, false
+	}
+	for _, dep := range lock.Dependencies {
+		if dep.Repository == "" || strings.HasPrefix(dep.Repository, "file://") {
+			return "", nil, false
+		}
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(lock.Digest))
+	repos = slices.Clone(repos)
+	slices.SortFunc(repos, func(a, b helm.HelmRepository) int {
+		return strings.Compare(a.Repo, b.Repo)
+	})
+	for _, repo := range repos {
+		hash.Write([]byte{0})
+		hash.Write([]byte(repo.Repo))
+		if repo.Creds == nil {
+			continue
+		}
+		password, err := repo.GetPassword()
+		if err != nil {
+			return "", nil, false
+		}
+		for _, value := range [][]byte{[]byte(repo.GetUsername()), []byte(password), repo.GetCertData(), repo.GetKeyData()} {
+			hash.Write([]byte{0})
+			hash.Write(value)
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), lock, true
+}
+
+// dependencyBuild downloads the dependencies of the chart, or copies them from the cache if they were already
+// downloaded for another application. Concurrent builds of the same dependencies download them only once.
+func (c *helmDependencyCache) dependencyBuild(appPath string, repos []helm.HelmRepository, h helm.Helm) error {
+	key, lock, ok := c.getKey(appPath, repos)
+	if !ok {
+		return h.DependencyBuild()
+	}
+	c.lock.Lock(key)
+	defer c.lock.Unlock(key)
+
+	entryDir := filepath.Join(c.dir, key)
+	if _, err := os.Stat(entryDir); err == nil {
+		if err := copyHelmDependencies(entryDir, filepath.Join(appPath, "charts"), lock); err == nil {
+			c.metricsServer.IncHelmDependencyCacheRequest(true)
+			return nil
+		}
+		log.Warnf("Failed to restore cached helm dependencies of %s: %v", appPath, err)
+		_ = os.RemoveAll(entryDir)
+	}
+	c.metricsServer.IncHelmDependencyCacheRequest(false)
+
+	if err := h.DependencyBuild(); err != nil {
+		return err
+	}
+	if err := c.store(entryDir, filepath.Join(appPath, "charts"), lock); err != nil {
+		log.Warnf("Failed to cache helm dependencies of %s: %v", appPath, err)
+	}
+	return nil
+}
+
+// store copies the downloaded dependencies to the cache. The entry is first written to a temporary directory, so that
+// an entry is never partially written.
+func (c *helmDependencyCache) store(entryDir string, chartsDir string, lock *helmLock) error {
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+	tempDir, err := os.MkdirTemp(c.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(tempDir) }()
+	if err := copyHelmDependencies(chartsDir, tempDir, lock); err != nil {
+		return err
+	}
+	return os.Rename(tempDir, entryDir)
+}
+
+// copyHelmDependencies copies the archives of the locked dependencies from a directory to another
+func copyHelmDependencies(srcDir string, dstDir string, lock *helmLock) error {
+	if err := os.MkdirAll(dstDir, 0o755); err != nil {
+		return err
+	}
+	for _, dep := range lock.Dependencies {
+		name := fmt.Sprintf("%s-%s.tgz", dep.Name, dep.Version)
+		data, err := os.ReadFile(filepath.Join(srcDir, name))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("archive %s of dependency %s not found", name, dep.Name)
+			}
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dstDir, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+const testChartLock = `dependencies:
+- name: redis
+  repository: https://charts.example.com
+  version: 1.2.3
+- name: common
+  repository: oci://registry.example.com/charts
+  version: 2.0.0
+digest: sha256:0123456789abcdef
+generated: "2026-01-01T00:00:00Z"
+`
+
+// fakeDependencyBuildHelm writes the archives of the locked dependencies in the charts directory, as
+// `helm dependency build` does
+type fakeDependencyBuildHelm struct {
+	appPath string
+	mu      sync.Mutex
+	builds  int
+}
+
+func (h *fakeDependencyBuildHelm) Template(_ *helm.TemplateOpts) (string, string, error) {
+	return "", "", nil
+}
+
+func (h *fakeDependencyBuildHelm) GetParameters(_ []pathutil.ResolvedFilePath, _, _ string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (h *fakeDependencyBuildHelm) DependencyBuild() error {
+	h.mu.Lock()
+	h.builds++
+	h.mu.Unlock()
+	chartsDir := filepath.Join(h.appPath, "charts")
+	if err := os.MkdirAll(chartsDir, 0o755); err != nil {
+		return err
+	}
+	for _, name := range []string{"redis-1.2.3.tgz", "common-2.0.0.tgz"} {
+		if err := os.WriteFile(filepath.Join(chartsDir, name), []byte(name), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *fakeDependencyBuildHelm) Dispose() {}
+
+func newTestChart(t *testing.T, lockFile string, lock string) string {
+	t.Helper()
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte("name: my-chart\nversion: 0.1.0\n"), 0o644))
+	if lockFile != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(appPath, lockFile), []byte(lock), 0o644))
+	}
+	return appPath
+}
+
+func TestHelmDependencyCache_GetKey(t *testing.T) {
+	c := newHelmDependencyCache(t.TempDir(), metrics.NewMetricsServer())
+	repos := []helm.HelmRepository{{Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "pass"}}}
+
+	t.Run("Chart.lock", func(t *testing.T) {
+		key, lock, ok := c.getKey(newTestChart(t, "Chart.lock", testChartLock), repos)
+		require.True(t, ok)
+		assert.NotEmpty(t, key)
+		assert.Len(t, lock.Dependencies, 2)
+	})
+
+	t.Run("requirements.lock", func(t *testing.T) {
+		key, _, ok := c.getKey(newTestChart(t, "requirements.lock", testChartLock), repos)
+		require.True(t, ok)
+		chartLockKey, _, _ := c.getKey(newTestChart(t, "Chart.lock", testChartLock), repos)
+		assert.Equal(t, chartLockKey, key)
+	})
+
+	t.Run("NoLockFile", func(t *testing.T) {
+		_, _, ok := c.getKey(newTestChart(t, "", ""), repos)
+		assert.False(t, ok)
+	})
+
+	t.Run("NoDigest", func(t *testing.T) {
+		lock := strings.ReplaceAll(testChartLock, "digest: sha256:0123456789abcdef\n", "")
+		_, _, ok := c.getKey(newTestChart(t, "Chart.lock", lock), repos)
+		assert.False(t, ok)
+	})
+
+	t.Run("LocalDependency", func(t *testing.T) {
+		lock := strings.ReplaceAll(testChartLock, "https://charts.example.com", "file://../redis")
+		_, _, ok := c.getKey(newTestChart(t, "Chart.lock", lock), repos)
+		assert.False(t, ok)
+	})
+
+	t.Run("DifferentDigest", func(t *testing.T) {
+		key, _, _ := c.getKey(newTestChart(t, "Chart.lock", testChartLock), repos)
+		lock := strings.ReplaceAll(testChartLock, "0123456789abcdef", "fedcba9876543210")
+		otherKey, _, ok := c.getKey(newTestChart(t, "Chart.lock", lock), repos)
+		require.True(t, ok)
+		assert.NotEqual(t, key, otherKey)
+	})
+
+	t.Run("DifferentCredentials", func(t *testing.T) {
+		appPath := newTestChart(t, "Chart.lock", testChartLock)
+		key, _, _ := c.getKey(appPath, repos)
+		otherKey, _, ok := c.getKey(appPath, []helm.HelmRepository{{Repo: "https://charts.example.com", Creds: helm.HelmCreds{Username: "user", Password: "other"}}})
+		require.True(t, ok)
+		assert.NotEqual(t, key, otherKey)
+		anonymousKey, _, ok := c.getKey(appPath, []helm.HelmRepository{{Repo: "https://charts.example.com"}})
+		require.True(t, ok)
+		assert.NotEqual(t, key, anonymousKey)
+	})
+
+	t.Run("RepositoriesOrder", func(t *testing.T) {
+		appPath := newTestChart(t, "Chart.lock", testChartLock)
+		a := helm.HelmRepository{Repo: "https://a.example.com"}
+		b := helm.HelmRepository{Repo: "https://b.example.com"}
+		key, _, _ := c.getKey(appPath, []helm.HelmRepository{a, b})
+		otherKey, _, _ := c.getKey(appPath, []helm.HelmRepository{b, a})
+		assert.Equal(t, key, otherKey)
+	})
+}
+
+func TestHelmDependencyCache_DependencyBuild(t *testing.T) {
+	metricsServer := metrics.NewMetricsServer()
+	c := newHelmDependencyCache(filepath.Join(t.TempDir(), "cache"), metricsServer)
+
+	firstApp := newTestChart(t, "Chart.lock", testChartLock)
+	firstHelm := &fakeDependencyBuildHelm{appPath: firstApp}
+	require.NoError(t, c.dependencyBuild(firstApp, nil, firstHelm))
+	assert.Equal(t, 1, firstHelm.builds)
+
+	secondApp := newTestChart(t, "Chart.lock", testChartLock)
+	secondHelm := &fakeDependencyBuildHelm{appPath: secondApp}
+	require.NoError(t, c.dependencyBuild(secondApp, nil, secondHelm))
+	assert.Equal(t, 0, secondHelm.builds)
+	for _, name := range []string{"redis-1.2.3.tgz", "common-2.0.0.tgz"} {
+		data, err := os.ReadFile(filepath.Join(secondApp, "charts", name))
+		require.NoError(t, err)
+		assert.Equal(t, name, string(data))
+	}
+
+	expected := `
+# HELP argocd_helm_dependency_cache_request_total Number of helm chart dependency cache lookups performed by repo server
+# TYPE argocd_helm_dependency_cache_request_total counter
+argocd_helm_dependency_cache_request_total{hit="false"} 1
+argocd_helm_dependency_cache_request_total{hit="true"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(metricsServer.PrometheusRegistry, strings.NewReader(expected), "argocd_helm_dependency_cache_request_total"))
+}
+
+func TestHelmDependencyCache_DependencyBuildConcurrently(t *testing.T) {
+	c := newHelmDependencyCache(filepath.Join(t.TempDir(), "cache"), metrics.NewMetricsServer())
+
+	var wg sync.WaitGroup
+	helms := make([]*fakeDependencyBuildHelm, 5)
+	for i := range helms {
+		appPath := newTestChart(t, "Chart.lock", testChartLock)
+		helms[i] = &fakeDependencyBuildHelm{appPath: appPath}
+		wg.Go(func() {
+			assert.NoError(t, c.dependencyBuild(appPath, nil, helms[i]))
+		})
+	}
+	wg.Wait()
+
+	builds := 0
+	for _, h := range helms {
+		builds += h.builds
+		_, err := os.Stat(filepath.Join(h.appPath, "charts", "redis-1.2.3.tgz"))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, builds)
+}
+
+func TestHelmDependencyCache_DependencyBuildNotCacheable(t *testing.T) {
+	c := newHelmDependencyCache(filepath.Join(t.TempDir(), "cache"), metrics.NewMetricsServer())
+
+	for range 2 {
+		appPath := newTestChart(t, "", "")
+		h := &fakeDependencyBuildHelm{appPath: appPath}
+		require.NoError(t, c.dependencyBuild(appPath, nil, h))
+		assert.Equal(t, 1, h.builds)
+	}
+	_, err := os.Stat(c.dir)
+	assert.True(t, os.IsNotExist(err))
+}
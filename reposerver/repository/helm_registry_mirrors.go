@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+// helmDependency is a dependency of a chart. The fields and their order are the ones of the dependencies of the Helm
+// charts, since they are used to compute the digest of the lock file.
+type helmDependency struct {
+	Name         string   `json:"name"`
+	Version      string   `json:"version,omitempty"`
+	Repository   string   `json:"repository"`
+	Condition    string   `json:"condition,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	Enabled      bool     `json:"enabled,omitempty"`
+	ImportValues []any    `json:"import-values,omitempty"`
+	Alias        string   `json:"alias,omitempty"`
+}
+
+// hashHelmDependencies computes the digest of the lock file of a chart, the same way as `helm dependency update`
+func hashHelmDependencies(dependencies []*helmDependency, locked []*helmDependency) (string, error) {
+	data, err := json.Marshal([2][]*helmDependency{dependencies, locked})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data)), nil
+}
+
+// readHelmDependencies reads a Chart.yaml or Chart.lock file, and returns its content and its dependencies
+func readHelmDependencies(path string) (map[string]any, []*helmDependency, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	content := map[string]any{}
+	if err := yaml.Unmarshal(data, &content); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling %s: %w", path, err)
+	}
+	var deps struct {
+		Dependencies []*helmDependency `json:"dependencies"`
+	}
+	if err := yaml.Unmarshal(data, &deps); err != nil {
+		return nil, nil, fmt.Errorf("error unmarshalling the dependencies of %s: %w", path, err)
+	}
+	return content, deps.Dependencies, nil
+}
+
+func writeHelmDependencies(path string, content map[string]any, dependencies []*helmDependency) error {
+	content["dependencies"] = dependencies
+	data, err := yaml.Marshal(content)
+	if err != nil {
+		return fmt.Errorf("error marshalling %s: %w", path, err)
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// mirrorHelmDependencyRepositories replaces the registries of the OCI dependencies by their mirrors, and returns the
+// mirrored dependencies and whether any dependency has been mirrored
+func mirrorHelmDependencyRepositories(dependencies []*helmDependency, mirrors map[string]string) ([]*helmDependency, bool) {
+	mirrored := make([]*helmDependency, len(dependencies))
+	changed := false
+	for i, dep := range dependencies {
+		mirrored[i] = dep
+		if !strings.HasPrefix(dep.Repository, "oci://") {
+			continue
+		}
+		if repo := helm.MirrorOCIRepository(dep.Repository, mirrors); repo != dep.Repository {
+			mirroredDep := *dep
+			mirroredDep.Repository = repo
+			mirrored[i] = &mirroredDep
+			changed = true
+		}
+	}
+	return mirrored, changed
+}
+
+// mirrorHelmDependencies replaces the registries of the OCI dependencies of the chart by their mirrors, so that
+// `helm dependency build` downloads them from the mirrors. The digest of the lock file is updated to match the
+// mirrored dependencies. If the digest of the lock file cannot be verified, e.g. because the chart depends on aliases
+// of Helm repositories, the chart is not modified and the dependencies are downloaded from their registries.
+func mirrorHelmDependencies(appPath string, mirrors map[string]string) error {
+	if len(mirrors) == 0 {
+		return nil
+	}
+	chartPath := filepath.Join(appPath, "Chart.yaml")
+	chart, dependencies, err := readHelmDependencies(chartPath)
+	if err != nil {
+		return err
+	}
+	mirroredDependencies, changed := mirrorHelmDependencyRepositories(dependencies, mirrors)
+	if !changed {
+		return nil
+	}
+
+	lockPath := filepath.Join(appPath, "Chart.lock")
+	lock, locked, err := readHelmDependencies(lockPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if lock != nil {
+		digest, err := hashHelmDependencies(dependencies, locked)
+		if err != nil {
+			return err
+		}
+		if lockDigest, _ := lock["digest"].(string); lockDigest != digest {
+			log.Warnf("Not mirroring the dependencies of the helm chart %s: the digest of its lock file could not be verified", appPath)
+			return nil
+		}
+		mirroredLocked, _ := mirrorHelmDependencyRepositories(locked, mirrors)
+		lock["digest"], err = hashHelmDependencies(mirroredDependencies, mirroredLocked)
+		if err != nil {
+			return err
+		}
+		if err := writeHelmDependencies(lockPath, lock, mirroredLocked); err != nil {
+			return err
+		}
+	}
+	return writeHelmDependencies(chartPath, chart, mirroredDependencies)
+}
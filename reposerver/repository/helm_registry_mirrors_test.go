@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMirroredChart = `apiVersion: v2
+name: my-chart
+version: 0.1.0
+dependencies:
+- name: redis
+  version: 1.2.3
+  repository: oci://docker.io/bitnamicharts
+  condition: redis.enabled
+- name: nginx
+  version: 2.0.0
+  repository: https://charts.example.com
+`
+
+func writeTestMirroredChart(t *testing.T, lockDigest string) string {
+	t.Helper()
+	appPath := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.yaml"), []byte(testMirroredChart), 0o644))
+	if lockDigest == "" {
+		return appPath
+	}
+	lock := `dependencies:
+- name: redis
+  repository: oci://docker.io/bitnamicharts
+  version: 1.2.3
+- name: nginx
+  repository: https://charts.example.com
+  version: 2.0.0
+digest: ` + lockDigest + `
+generated: "2026-01-01T00:00:00Z"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "Chart.lock"), []byte(lock), 0o644))
+	return appPath
+}
+
+func TestHashHelmDependencies(t *testing.T) {
+	// the lock file has been generated by `helm dependency update`
+	appPath := "../../util/helm/testdata/dependency"
+	_, dependencies, err := readHelmDependencies(filepath.Join(appPath, "Chart.yaml"))
+	require.NoError(t, err)
+	lock, locked, err := readHelmDependencies(filepath.Join(appPath, "Chart.lock"))
+	require.NoError(t, err)
+
+	digest, err := hashHelmDependencies(dependencies, locked)
+	require.NoError(t, err)
+	assert.Equal(t, lock["digest"], digest)
+}
+
+func TestMirrorHelmDependencies(t *testing.T) {
+	mirrors := map[string]string{"docker.io": "registry.example.com/docker-hub"}
+
+	t.Run("with lock file", func(t *testing.T) {
+		appPath := writeTestMirroredChart(t, "")
+		_, dependencies, err := readHelmDependencies(filepath.Join(appPath, "Chart.yaml"))
+		require.NoError(t, err)
+		digest, err := hashHelmDependencies(dependencies, []*helmDependency{
+			{Name: "redis", Repository: "oci://docker.io/bitnamicharts", Version: "1.2.3"},
+			{Name: "nginx", Repository: "https://charts.example.com", Version: "2.0.0"},
+		})
+		require.NoError(t, err)
+		appPath = writeTestMirroredChart(t, digest)
+
+		require.NoError(t, mirrorHelmDependencies(appPath, mirrors))
+
+		chart, dependencies, err := readHelmDependencies(filepath.Join(appPath, "Chart.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "my-chart", chart["name"])
+		assert.Equal(t, []*helmDependency{
+			{Name: "redis", Version: "1.2.3", Repository: "oci://registry.example.com/docker-hub/bitnamicharts", Condition: "redis.enabled"},
+			{Name: "nginx", Version: "2.0.0", Repository: "https://charts.example.com"},
+		}, dependencies)
+		lock, locked, err := readHelmDependencies(filepath.Join(appPath, "Chart.lock"))
+		require.NoError(t, err)
+		assert.Equal(t, "oci://registry.example.com/docker-hub/bitnamicharts", locked[0].Repository)
+		assert.Equal(t, "https://charts.example.com", locked[1].Repository)
+		digest, err = hashHelmDependencies(dependencies, locked)
+		require.NoError(t, err)
+		assert.Equal(t, digest, lock["digest"])
+	})
+
+	t.Run("without lock file", func(t *testing.T) {
+		appPath := writeTestMirroredChart(t, "")
+
+		require.NoError(t, mirrorHelmDependencies(appPath, mirrors))
+
+		_, dependencies, err := readHelmDependencies(filepath.Join(appPath, "Chart.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "oci://registry.example.com/docker-hub/bitnamicharts", dependencies[0].Repository)
+		assert.NoFileExists(t, filepath.Join(appPath, "Chart.lock"))
+	})
+
+	t.Run("lock file digest not verified", func(t *testing.T) {
+		appPath := writeTestMirroredChart(t, "sha256:0123456789abcdef")
+
+		require.NoError(t, mirrorHelmDependencies(appPath, mirrors))
+
+		data, err := os.ReadFile(filepath.Join(appPath, "Chart.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, testMirroredChart, string(data))
+	})
+
+	t.Run("no mirrored dependency", func(t *testing.T) {
+		appPath := writeTestMirroredChart(t, "")
+
+		require.NoError(t, mirrorHelmDependencies(appPath, map[string]string{"ghcr.io": "registry.example.com/ghcr"}))
+
+		data, err := os.ReadFile(filepath.Join(appPath, "Chart.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, testMirroredChart, string(data))
+	})
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+const helmValuesSchemaFile = "values.schema.json"
+
+// HelmValuesSchemaViolation is a value of a Helm chart which does not match the values.schema.json file of the chart.
+type HelmValuesSchemaViolation struct {
+	// Path is the JSON pointer of the value, e.g. `/image/tag`
+	Path string
+	// Message describes why the value does not match the schema
+	Message string
+}
+
+// HelmValuesSchemaError is returned when the values of a Helm chart do not match the values.schema.json file of the
+// chart.
+type HelmValuesSchemaError struct {
+	Violations []HelmValuesSchemaViolation
+}
+
+func (e *HelmValuesSchemaError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("helm values do not match the values.schema.json file of the chart:")
+	for _, v := range e.Violations {
+		path := v.Path
+		if path == "" {
+			path = "/"
+		}
+		fmt.Fprintf(&sb, "\n- at '%s': %s", path, v.Message)
+	}
+	return sb.String()
+}
+
+// validateHelmValues validates the values of the chart merged with the values of the application (the value files, the
+// values, and the parameters) against the values.schema.json file of the chart, and returns a HelmValuesSchemaError
+// listing the violations. If the values cannot be merged the same way as Helm, e.g. because a value file is remote, the
+// validation is skipped and left to `helm template`.
+func validateHelmValues(appPath string, opts *helm.TemplateOpts) error {
+	if opts.SkipSchemaValidation {
+		return nil
+	}
+	schemaData, err := os.ReadFile(filepath.Join(appPath, helmValuesSchemaFile))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warnf("Skipping the validation of the helm values of %s: %v", appPath, err)
+		}
+		return nil
+	}
+	violations, err := helmValuesSchemaViolations(appPath, schemaData, opts)
+	if err != nil {
+		log.Warnf("Skipping the validation of the helm values of %s: %v", appPath, err)
+		return nil
+	}
+	if len(violations) > 0 {
+		return &HelmValuesSchemaError{Violations: violations}
+	}
+	return nil
+}
+
+func helmValuesSchemaViolations(appPath string, schemaData []byte, opts *helm.TemplateOpts) ([]HelmValuesSchemaViolation, error) {
+	schemaDoc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaData))
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling %s: %w", helmValuesSchemaFile, err)
+	}
+	compiler := jsonschema.NewCompiler()
+	// the schema must not reference other files or URLs
+	compiler.UseLoader(jsonschema.SchemeURLLoader{})
+	if err := compiler.AddResource(helmValuesSchemaFile, schemaDoc); err != nil {
+		return nil, fmt.Errorf("error adding %s: %w", helmValuesSchemaFile, err)
+	}
+	schema, err := compiler.Compile(helmValuesSchemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling %s: %w", helmValuesSchemaFile, err)
+	}
+
+	values, err := mergeHelmValues(appPath, opts)
+	if err != nil {
+		return nil, err
+	}
+	err = schema.Validate(values)
+	var validationErr *jsonschema.ValidationError
+	if !errors.As(err, &validationErr) {
+		return nil, err
+	}
+	var violations []HelmValuesSchemaViolation
+	collectHelmValuesSchemaViolations(validationErr.DetailedOutput(), &violations)
+	return violations, nil
+}
+
+func collectHelmValuesSchemaViolations(unit *jsonschema.OutputUnit, violations *[]HelmValuesSchemaViolation) {
+	if len(unit.Errors) == 0 {
+		if unit.Error != nil {
+			*violations = append(*violations, HelmValuesSchemaViolation{Path: unit.InstanceLocation, Message: unit.Error.String()})
+		}
+		return
+	}
+	for i := range unit.Errors {
+		collectHelmValuesSchemaViolations(&unit.Errors[i], violations)
+	}
+}
+
+// mergeHelmValues returns the values of the chart merged with the value files, the values and the parameters of the
+// application, in the same order as Helm
+func mergeHelmValues(appPath string, opts *helm.TemplateOpts) (map[string]any, error) {
+	values := map[string]any{}
+	valueFiles := []pathutil.ResolvedFilePath{pathutil.ResolvedFilePath(filepath.Join(appPath, "values.yaml"))}
+	valueFiles = append(valueFiles, opts.Values...)
+	if opts.ExtraValues != "" {
+		valueFiles = append(valueFiles, opts.ExtraValues)
+	}
+	for i, valueFile := range valueFiles {
+		data, err := readHelmValueFile(valueFile)
+		if err != nil {
+			if i == 0 && errors.Is(err, os.ErrNotExist) {
+				// the chart has no default values
+				continue
+			}
+			return nil, err
+		}
+		fileValues := map[string]any{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, fmt.Errorf("error unmarshalling helm value file: %w", err)
+		}
+		mergeHelmValueMaps(values, fileValues)
+	}
+
+	for name, value := range opts.Set {
+		if err := setHelmValue(values, name, parseHelmSetValue(value)); err != nil {
+			return nil, err
+		}
+	}
+	for name, value := range opts.SetString {
+		if err := setHelmValue(values, name, value); err != nil {
+			return nil, err
+		}
+	}
+	for name, valueFile := range opts.SetFile {
+		data, err := readHelmValueFile(valueFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := setHelmValue(values, name, string(data)); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func readHelmValueFile(valueFile pathutil.ResolvedFilePath) ([]byte, error) {
+	if u, err := url.Parse(string(valueFile)); err == nil && u.Scheme != "" && u.Host != "" {
+		return nil, fmt.Errorf("remote helm value file %s is not supported", valueFile)
+	}
+	return os.ReadFile(string(valueFile))
+}
+
+// mergeHelmValueMaps merges the values of src into dst. The maps are merged recursively, and a null value removes the
+// value from dst, like Helm does.
+func mergeHelmValueMaps(dst, src map[string]any) {
+	for k, v := range src {
+		if v == nil {
+			delete(dst, k)
+			continue
+		}
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeHelmValueMaps(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// parseHelmSetValue converts the value of a `--set` parameter to the type Helm infers for it
+func parseHelmSetValue(value string) any {
+	if strings.HasPrefix(value, "{") && strings.HasSuffix(value, "}") {
+		list := []any{}
+		if inner := value[1 : len(value)-1]; inner != "" {
+			for _, item := range strings.Split(inner, ",") {
+				list = append(list, parseHelmSetValue(item))
+			}
+		}
+		return list
+	}
+	switch {
+	case strings.EqualFold(value, "true"):
+		return true
+	case strings.EqualFold(value, "false"):
+		return false
+	case strings.EqualFold(value, "null"):
+		return nil
+	case value == "0":
+		return int64(0)
+	}
+	if value != "" && value[0] != '0' {
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return i
+		}
+	}
+	return value
+}
+
+// setHelmValue sets the value of a parameter whose name is a dot-separated path, e.g. `image.tag` or `args[0]`
+func setHelmValue(values map[string]any, name string, value any) error {
+	keys := splitHelmParameterName(name)
+	current := values
+	for i, key := range keys {
+		last := i == len(keys)-1
+		if strings.ContainsAny(key, "[]") {
+			// lists are set by index, which is not worth reproducing for the validation
+			return fmt.Errorf("helm parameter %s with a list index is not supported", name)
+		}
+		if last {
+			if value == nil {
+				delete(current, key)
+			} else {
+				current[key] = value
+			}
+			return nil
+		}
+		next, ok := current[key].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[key] = next
+		}
+		current = next
+	}
+	return nil
+}
+
+// splitHelmParameterName splits the name of a parameter on the dots which are not escaped with a backslash
+func splitHelmParameterName(name string) []string {
+	var keys []string
+	var key strings.Builder
+	for i := 0; i < len(name); i++ {
+		switch {
+		case name[i] == '\\' && i+1 < len(name) && name[i+1] == '.':
+			key.WriteByte('.')
+			i++
+		case name[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(name[i])
+		}
+	}
+	return append(keys, key.String())
+}
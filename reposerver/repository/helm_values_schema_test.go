@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
+)
+
+func TestValidateHelmValues(t *testing.T) {
+	appPath := "./testdata/helm-values-schema"
+
+	t.Run("valid values", func(t *testing.T) {
+		err := validateHelmValues(appPath, &helm.TemplateOpts{
+			Set:       map[string]string{"replicaCount": "3"},
+			SetString: map[string]string{"image.tag": "1.0"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("value file violations", func(t *testing.T) {
+		err := validateHelmValues(appPath, &helm.TemplateOpts{
+			Values: []pathutil.ResolvedFilePath{pathutil.ResolvedFilePath(filepath.Join(appPath, "invalid-values.yaml"))},
+		})
+		var schemaErr *HelmValuesSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		paths := []string{}
+		for _, v := range schemaErr.Violations {
+			paths = append(paths, v.Path)
+		}
+		assert.ElementsMatch(t, []string{"/replicaCount", "/image/tag"}, paths)
+	})
+
+	t.Run("parameter violations", func(t *testing.T) {
+		err := validateHelmValues(appPath, &helm.TemplateOpts{
+			Set: map[string]string{"replicaCount": "many"},
+		})
+		var schemaErr *HelmValuesSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		require.Len(t, schemaErr.Violations, 1)
+		assert.Equal(t, "/replicaCount", schemaErr.Violations[0].Path)
+	})
+
+	t.Run("null value removes the default value", func(t *testing.T) {
+		extraValues := filepath.Join(t.TempDir(), "values.yaml")
+		require.NoError(t, os.WriteFile(extraValues, []byte("image: null\n"), 0o644))
+		err := validateHelmValues(appPath, &helm.TemplateOpts{ExtraValues: pathutil.ResolvedFilePath(extraValues)})
+		var schemaErr *HelmValuesSchemaError
+		require.ErrorAs(t, err, &schemaErr)
+		assert.Contains(t, schemaErr.Error(), "at '/': missing property 'image'")
+	})
+
+	t.Run("skip schema validation", func(t *testing.T) {
+		err := validateHelmValues(appPath, &helm.TemplateOpts{
+			Set:                  map[string]string{"replicaCount": "many"},
+			SkipSchemaValidation: true,
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("remote value file", func(t *testing.T) {
+		err := validateHelmValues(appPath, &helm.TemplateOpts{
+			Values: []pathutil.ResolvedFilePath{"https://example.com/values.yaml"},
+			Set:    map[string]string{"replicaCount": "many"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("schema with remote reference", func(t *testing.T) {
+		err := validateHelmValues("./testdata/broken-schema-verification", &helm.TemplateOpts{})
+		require.NoError(t, err)
+	})
+
+	t.Run("no schema", func(t *testing.T) {
+		err := validateHelmValues("./testdata/simple-chart", &helm.TemplateOpts{})
+		require.NoError(t, err)
+	})
+}
+
+func TestParseHelmSetValue(t *testing.T) {
+	assert.Equal(t, true, parseHelmSetValue("true"))
+	assert.Equal(t, false, parseHelmSetValue("FALSE"))
+	assert.Nil(t, parseHelmSetValue("null"))
+	assert.Equal(t, int64(0), parseHelmSetValue("0"))
+	assert.Equal(t, int64(42), parseHelmSetValue("42"))
+	assert.Equal(t, "042", parseHelmSetValue("042"))
+	assert.Equal(t, "1.5", parseHelmSetValue("1.5"))
+	assert.Equal(t, []any{"a", int64(1)}, parseHelmSetValue("{a,1}"))
+}
+
+func TestSetHelmValue(t *testing.T) {
+	values := map[string]any{"image": map[string]any{"repository": "nginx"}}
+	require.NoError(t, setHelmValue(values, "image.tag", "stable"))
+	require.NoError(t, setHelmValue(values, `annotations.example\.com/name`, "value"))
+	assert.Equal(t, map[string]any{
+		"image":       map[string]any{"repository": "nginx", "tag": "stable"},
+		"annotations": map[string]any{"example.com/name": "value"},
+	}, values)
+
+	require.NoError(t, setHelmValue(values, "image", nil))
+	assert.NotContains(t, values, "image")
+
+	require.Error(t, setHelmValue(values, "args[0]", "value"))
+}
@@ -1,13 +1,22 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
+// repoFetchLockPollInterval is the interval at which a replica waiting for the fetch of a revision by another replica
+// checks whether the result of its operation has been cached
+var repoFetchLockPollInterval = time.Second
+
 func NewRepositoryLock() *repositoryLock {
 	return &repositoryLock{stateByKey: map[string]*repositoryState{}}
 }
@@ -84,3 +93,46 @@ type repositoryState struct {
 	processCount    int
 	allowConcurrent bool
 }
+
+// lockRepoFetch coordinates the repo server replicas, so that only one of them fetches a revision of a repository at a
+// time. While another replica owns the fetch, cached is polled so that its result is reused once it is cached. Returns
+// whether cached found the result, and the function releasing the ownership of the fetch. The lock is an optimization,
+// so the operation proceeds without it if the cache is unavailable or the owner does not finish in time.
+func (s *Service) lockRepoFetch(ctx context.Context, repoURL string, revision string, cached func() (bool, error)) (bool, func(), error) {
+	noop := func() {}
+	timeout := s.initConstants.RepoFetchLockTimeout
+	if timeout <= 0 {
+		return false, noop, nil
+	}
+	lockId := uuid.NewString()
+	waitUntil := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if ok, err := cached(); ok {
+				log.Debugf("Reusing the result of the fetch of %s at revision %s by another replica", repoURL, revision)
+				return true, noop, err
+			}
+		}
+		owner, err := s.cache.TryLockRepoFetch(repoURL, revision, lockId, timeout)
+		if err != nil {
+			log.Warnf("Failed to lock the fetch of %s at revision %s: %v", repoURL, revision, err)
+			return false, noop, nil
+		}
+		if owner == lockId {
+			return false, func() {
+				if err := s.cache.UnlockRepoFetch(repoURL, revision, lockId); err != nil {
+					log.Warnf("Failed to unlock the fetch of %s at revision %s: %v", repoURL, revision, err)
+				}
+			}, nil
+		}
+		if !time.Now().Before(waitUntil) {
+			log.Debugf("Timed out waiting for another replica to fetch %s at revision %s", repoURL, revision)
+			return false, noop, nil
+		}
+		select {
+		case <-ctx.Done():
+			return false, noop, ctx.Err()
+		case <-time.After(repoFetchLockPollInterval):
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
@@ -199,3 +202,97 @@ func TestLock_CleanForNonConcurrent(t *testing.T) {
 	assert.False(t, initClean)
 	utilio.Close(closer)
 }
+
+func TestLockRepoFetch(t *testing.T) {
+	repoFetchLockPollInterval = 10 * time.Millisecond
+	const repoURL = "https://github.com/argoproj/argo-cd"
+	newReplica := func(cacheMocks *repoCacheMocks) *Service {
+		return &Service{cache: cacheMocks.cache, initConstants: RepoServerInitConstants{RepoFetchLockTimeout: time.Minute}}
+	}
+	notCached := func() (bool, error) { return false, nil }
+
+	t.Run("Disabled", func(t *testing.T) {
+		cacheMocks := newCacheMocks()
+		t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+		service := newReplica(cacheMocks)
+		service.initConstants.RepoFetchLockTimeout = 0
+		found, unlock, err := service.lockRepoFetch(t.Context(), repoURL, "sha", notCached)
+		require.NoError(t, err)
+		assert.False(t, found)
+		unlock()
+		owner, err := cacheMocks.cache.GetRepoFetchLock(repoURL, "sha")
+		require.NoError(t, err)
+		assert.Empty(t, owner)
+	})
+
+	t.Run("ReuseResultOfOwner", func(t *testing.T) {
+		cacheMocks := newCacheMocks()
+		t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+		owner, waiter := newReplica(cacheMocks), newReplica(cacheMocks)
+
+		found, unlock, err := owner.lockRepoFetch(t.Context(), repoURL, "sha", notCached)
+		require.NoError(t, err)
+		require.False(t, found)
+
+		var cached atomic.Bool
+		polled := make(chan struct{}, 1)
+		done := make(chan bool)
+		go func() {
+			found, _, _ := waiter.lockRepoFetch(t.Context(), repoURL, "sha", func() (bool, error) {
+				select {
+				case polled <- struct{}{}:
+				default:
+				}
+				return cached.Load(), nil
+			})
+			done <- found
+		}()
+		// the waiter polls the cache once it found the revision locked
+		<-polled
+		cached.Store(true)
+		assert.True(t, <-done)
+		unlock()
+	})
+
+	t.Run("AcquireAfterRelease", func(t *testing.T) {
+		cacheMocks := newCacheMocks()
+		t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+		owner, waiter := newReplica(cacheMocks), newReplica(cacheMocks)
+
+		_, unlock, err := owner.lockRepoFetch(t.Context(), repoURL, "sha", notCached)
+		require.NoError(t, err)
+		// other revisions are not locked
+		_, unlockOther, err := waiter.lockRepoFetch(t.Context(), repoURL, "other-sha", notCached)
+		require.NoError(t, err)
+		unlockOther()
+
+		done := make(chan func())
+		go func() {
+			_, unlock, _ := waiter.lockRepoFetch(t.Context(), repoURL, "sha", notCached)
+			done <- unlock
+		}()
+		unlock()
+		unlockWaiter := <-done
+		lockId, err := cacheMocks.cache.GetRepoFetchLock(repoURL, "sha")
+		require.NoError(t, err)
+		assert.NotEmpty(t, lockId)
+		unlockWaiter()
+		lockId, err = cacheMocks.cache.GetRepoFetchLock(repoURL, "sha")
+		require.NoError(t, err)
+		assert.Empty(t, lockId)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		cacheMocks := newCacheMocks()
+		t.Cleanup(cacheMocks.mockCache.StopRedisCallback)
+		owner, waiter := newReplica(cacheMocks), newReplica(cacheMocks)
+
+		_, unlock, err := owner.lockRepoFetch(t.Context(), repoURL, "sha", notCached)
+		require.NoError(t, err)
+		defer unlock()
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		_, _, err = waiter.lockRepoFetch(ctx, repoURL, "sha", notCached)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	gosync "sync"
@@ -61,6 +62,7 @@ import (
 	apppathutil "github.com/argoproj/argo-cd/v3/util/app/path"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/cmp"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
@@ -78,10 +80,12 @@ import (
 const (
 	cachedManifestGenerationPrefix = "Manifest generation error (cached)"
 	helmDepUpMarkerFile            = ".argocd-helm-dep-up"
-	repoSourceFile                 = ".argocd-source.yaml"
-	appSourceFile                  = ".argocd-source-%s.yaml"
 	ociPrefix                      = "oci://"
 	skipFileRenderingMarker        = "+argocd:skip-file-rendering"
+	// manifestResponseChunkSize is the maximum size of the manifests sent in each chunk of GenerateManifestStream
+	manifestResponseChunkSize = 1024 * 1024
+	// revisionComparisonMaxCommits is the maximum number of commits returned by GetRevisionComparison
+	revisionComparisonMaxCommits = 250
 )
 
 var ErrExceededMaxCombinedManifestFileSize = errors.New("exceeded max combined manifest file size")
@@ -94,6 +98,7 @@ type Service struct {
 	rootDir                   string
 	gitRepoPaths              utilio.TempPaths
 	chartPaths                utilio.TempPaths
+	helmDependencyCache       *helmDependencyCache
 	ociPaths                  utilio.TempPaths
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
@@ -106,6 +111,8 @@ type Service struct {
 	initConstants             RepoServerInitConstants
 	// stores cached symlink validation results
 	symlinksState *gocache.Cache
+	// repositories included in the background health checks
+	observedRepos *observedRepositories
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
 }
@@ -128,10 +135,21 @@ type RepoServerInitConstants struct {
 	DisableOCIManifestMaxExtractedSize           bool
 	DisableHelmManifestMaxExtractedSize          bool
 	IncludeHiddenDirectories                     bool
+	EnableHelmDependencyCache                    bool
 	CMPUseManifestGeneratePaths                  bool
 	EnableBuiltinGitConfig                       bool
 	HelmUserAgent                                string
 	HelmChartCacheExpiration                     time.Duration // Cache expiration for repo
+	HelmRegistryMirrors                          map[string]string
+	// RepoFetchLockTimeout is the maximum duration a replica owns the fetch of a revision of a repository on behalf of
+	// the other replicas. Zero disables the coordination of the replicas.
+	RepoFetchLockTimeout time.Duration
+	// LFSFetchAll fetches the LFS objects of all refs of the repositories instead of the ones within the paths of the
+	// applications
+	LFSFetchAll bool
+	// StrictSourceOverrides fails the manifest generation of the applications with invalid parameter override files,
+	// instead of ignoring the validation errors
+	StrictSourceOverrides bool
 }
 
 var manifestGenerateLock = sync.NewKeyLock()
@@ -146,6 +164,10 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	var helmDepCache *helmDependencyCache
+	if initConstants.EnableHelmDependencyCache {
+		helmDepCache = newHelmDependencyCache(filepath.Join(rootDir, uuid.NewString()), metricsServer)
+	}
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -161,15 +183,17 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 			opts = append(opts, helm.WithHelmChartCacheExpiration(initConstants.HelmChartCacheExpiration))
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, noProxy, opts...)
 		},
-		initConstants:      initConstants,
-		now:                time.Now,
-		gitCredsStore:      gitCredsStore,
-		gitRepoPaths:       gitRandomizedPaths,
-		chartPaths:         helmRandomizedPaths,
-		ociPaths:           ociRandomizedPaths,
-		gitRepoInitializer: directoryPermissionInitializer,
-		rootDir:            rootDir,
-		symlinksState:      gocache.New(12*time.Hour, time.Hour),
+		initConstants:       initConstants,
+		now:                 time.Now,
+		gitCredsStore:       gitCredsStore,
+		gitRepoPaths:        gitRandomizedPaths,
+		chartPaths:          helmRandomizedPaths,
+		ociPaths:            ociRandomizedPaths,
+		helmDependencyCache: helmDepCache,
+		gitRepoInitializer:  directoryPermissionInitializer,
+		rootDir:             rootDir,
+		symlinksState:       gocache.New(12*time.Hour, time.Hour),
+		observedRepos:       newObservedRepositories(),
 	}
 }
 
@@ -363,6 +387,8 @@ func (s *Service) runRepoOperation(
 	var ociClient oci.Client
 	var gitClient git.Client
 	var helmClient helm.Client
+	var sparsePaths []string
+	var lfsPaths []string
 	var err error
 	gitClientOpts := git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache)
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
@@ -374,7 +400,13 @@ func (s *Service) runRepoOperation(
 	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(ctx, repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 	default:
-		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts, git.WithTagPrefix(source.TagPrefix))
+		if repo.SparseCheckout {
+			sparsePaths = sparseCheckoutPaths(source)
+		}
+		if repo.EnableLFS && !s.initConstants.LFSFetchAll {
+			lfsPaths = sparseCheckoutPaths(source)
+		}
+		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts, git.WithTagPrefix(source.TagPrefix), git.WithSparseCheckout(sparsePaths), git.WithLFSIncludePaths(lfsPaths))
 	}
 
 	if err != nil {
@@ -392,6 +424,16 @@ func (s *Service) runRepoOperation(
 		}
 	}
 
+	if !source.IsOCI() && !source.IsHelm() && !settings.noCache {
+		found, unlock, err := s.lockRepoFetch(ctx, repo.Repo, revision, func() (bool, error) {
+			return cacheFn(revision, repoRefs, false)
+		})
+		if found || err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
 	s.metricsServer.IncPendingRepoRequest(repo.Repo)
 	defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
 
@@ -480,7 +522,15 @@ func (s *Service) runRepoOperation(
 			return &operationContext{chartPath, "", nil}, nil
 		})
 	}
-	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
+	// a sparse checkout, or a checkout limited to the LFS files of the application, only contains the paths of the
+	// application, so it is not shared with the operations requiring other paths of the same revision
+	checkoutState := revision
+	if len(sparsePaths) > 0 {
+		checkoutState = revision + ":" + strings.Join(sparsePaths, ",")
+	} else if len(lfsPaths) > 0 {
+		checkoutState = revision + ":" + strings.Join(lfsPaths, ",")
+	}
+	closer, err := s.repoLock.Lock(gitClient.Root(), checkoutState, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
 		return s.checkoutRevision(ctx, gitClient, revision, s.initConstants.SubmoduleEnabled, repo.Depth, clean)
 	})
 	if err != nil {
@@ -490,7 +540,7 @@ func (s *Service) runRepoOperation(
 	defer utilio.Close(closer)
 
 	if !s.initConstants.AllowOutOfBoundsSymlinks {
-		err := s.checkOutOfBoundsSymlinks(gitClient.Root(), revision, settings.noCache, ".git")
+		err := s.checkOutOfBoundsSymlinks(gitClient.Root(), checkoutState, settings.noCache, ".git")
 		if err != nil {
 			oobError := &apppathutil.OutOfBoundsSymlinkError{}
 			if errors.As(err, &oobError) {
@@ -729,9 +779,28 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 	if errors.As(err, &globNoMatch) {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	var limitErr *executil.ResourceLimitExceededError
+	if errors.As(err, &limitErr) || status.Code(err) == codes.ResourceExhausted {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	var schemaErr *HelmValuesSchemaError
+	var overrideErr *SourceOverrideError
+	if errors.As(err, &schemaErr) || errors.As(err, &overrideErr) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	return res, err
 }
 
+// GenerateManifestStream generates the manifests like GenerateManifest, but streams the response in chunks so that it
+// is not limited by the maximum size of a gRPC message
+func (s *Service) GenerateManifestStream(q *apiclient.ManifestRequest, stream apiclient.RepoServerService_GenerateManifestStreamServer) error {
+	res, err := s.GenerateManifest(stream.Context(), q)
+	if err != nil {
+		return err
+	}
+	return apiclient.SendManifestResponseChunks(res, manifestResponseChunkSize, stream.Send)
+}
+
 func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
 	workDir, err := files.CreateTempDir("")
 	if err != nil {
@@ -958,7 +1027,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 			}
 		}
 
-		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths))
+		manifestGenResult, err = GenerateManifests(ctx, opContext.appPath, repoRoot, commitSHA, q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, s.gitRepoPaths, WithCMPTarDoneChannel(ch.tarDoneCh), WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs), WithCMPUseManifestGeneratePaths(s.initConstants.CMPUseManifestGeneratePaths), withHelmDependencyCache(s.helmDependencyCache), WithHelmRegistryMirrors(s.helmRegistryMirrors(q.Repo)), WithStrictSourceOverrides(s.initConstants.StrictSourceOverrides))
 	}
 	refSourceCommitSHAs := make(map[string]string)
 	if len(repoRefs) > 0 {
@@ -1044,6 +1113,7 @@ func getManifestCacheKey(revision string, appSource *v1alpha1.ApplicationSource,
 		RefSourceCommitSHAs: refSourceCommitSHAs,
 		InstallationID:      q.InstallationID,
 		SourceIntegrity:     q.SourceIntegrity,
+		SourceEnvironment:   q.SourceEnvironment,
 	}
 }
 
@@ -1248,7 +1318,9 @@ func sanitizeRepoName(repoName string) string {
 // if multiple threads are trying to run it.
 // Multiple goroutines might process same helm app in one repo concurrently when repo server process multiple
 // manifest generation requests of the same commit.
-func runHelmBuild(appPath string, h helm.Helm) error {
+// If the dependency cache is not nil, the dependencies already downloaded for another application are reused.
+// The OCI dependencies are downloaded from the mirrors of their registries, if any.
+func runHelmBuild(appPath string, h helm.Helm, helmRepos []helm.HelmRepository, depCache *helmDependencyCache, registryMirrors map[string]string) error {
 	manifestGenerateLock.Lock(appPath)
 	defer manifestGenerateLock.Unlock(appPath)
 
@@ -1263,7 +1335,15 @@ func runHelmBuild(appPath string, h helm.Helm) error {
 		return err
 	}
 
-	err = h.DependencyBuild()
+	if err := mirrorHelmDependencies(appPath, registryMirrors); err != nil {
+		return fmt.Errorf("error mirroring helm chart dependencies: %w", err)
+	}
+
+	if depCache != nil {
+		err = depCache.dependencyBuild(appPath, helmRepos, h)
+	} else {
+		err = h.DependencyBuild()
+	}
 	if err != nil {
 		return fmt.Errorf("error building helm chart dependencies: %w", err)
 	}
@@ -1289,7 +1369,7 @@ func parseKubeVersion(version string) (string, error) {
 	return kubeVersion.String(), nil
 }
 
-func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths) ([]*unstructured.Unstructured, string, error) {
+func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclient.ManifestRequest, isLocal bool, gitRepoPaths utilio.TempPaths, depCache *helmDependencyCache, registryMirrors map[string]string, limits executil.ResourceLimits) ([]*unstructured.Unstructured, string, error) {
 	// We use the app name as Helm's release name property, which must not
 	// contain any underscore characters and must not exceed 53 characters.
 	// We are not interested in the fully qualified application name while
@@ -1309,6 +1389,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 		Set:         map[string]string{},
 		SetString:   map[string]string{},
 		SetFile:     map[string]pathutil.ResolvedFilePath{},
+		Limits:      limits,
 	}
 
 	appHelm := q.ApplicationSource.Helm
@@ -1389,6 +1470,10 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	for i, j := range templateOpts.SetString {
 		templateOpts.SetString[i] = env.Envsubst(j)
 	}
+	// validate the values before templating, so that the violations of the schema are reported as such
+	if err := validateHelmValues(appPath, templateOpts); err != nil {
+		return nil, "", err
+	}
 
 	var proxy string
 	if q.Repo != nil {
@@ -1399,8 +1484,15 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 	if err != nil {
 		return nil, "", fmt.Errorf("error getting helm repos: %w", err)
 	}
+	// the OCI dependencies are downloaded from the mirrors of their registries
+	buildRepos := slices.Clone(helmRepos)
+	for i := range buildRepos {
+		if buildRepos[i].EnableOci {
+			buildRepos[i].Repo = helm.MirrorOCIRepository(buildRepos[i].Repo, registryMirrors)
+		}
+	}
 
-	h, err := helm.NewHelmApp(appPath, helmRepos, isLocal, version, proxy, q.Repo.NoProxy, passCredentials, q.Repo.Insecure)
+	h, err := helm.NewHelmApp(appPath, buildRepos, isLocal, version, proxy, q.Repo.NoProxy, passCredentials, q.Repo.Insecure)
 	if err != nil {
 		return nil, "", fmt.Errorf("error initializing helm app object: %w", err)
 	}
@@ -1413,7 +1505,7 @@ func helmTemplate(appPath string, repoRoot string, env *v1alpha1.Env, q *apiclie
 			return nil, "", err
 		}
 
-		err = runHelmBuild(appPath, h)
+		err = runHelmBuild(appPath, h, buildRepos, depCache, registryMirrors)
 		if err != nil {
 			var reposNotPermitted []string
 			// We do a sanity check here to give a nicer error message in case any of the Helm repositories are not permitted by
@@ -1707,6 +1799,9 @@ type (
 		cmpTarDoneCh                chan<- bool
 		cmpTarExcludedGlobs         []string
 		cmpUseManifestGeneratePaths bool
+		helmDependencyCache         *helmDependencyCache
+		helmRegistryMirrors         map[string]string
+		strictSourceOverrides       bool
 	}
 )
 
@@ -1743,6 +1838,30 @@ func WithCMPUseManifestGeneratePaths(enabled bool) GenerateManifestOpt {
 	}
 }
 
+// withHelmDependencyCache defines the cache of the helm chart dependencies shared
+// between applications. The dependencies are downloaded for each application if nil.
+func withHelmDependencyCache(depCache *helmDependencyCache) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmDependencyCache = depCache
+	}
+}
+
+// WithStrictSourceOverrides enables or disables the failure of the manifest
+// generation when a parameter override file of the application is invalid.
+func WithStrictSourceOverrides(enabled bool) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.strictSourceOverrides = enabled
+	}
+}
+
+// WithHelmRegistryMirrors defines the mirrors of the OCI registries from which the
+// dependencies of the helm charts are downloaded, see helm.MirrorOCIRepository.
+func WithHelmRegistryMirrors(mirrors map[string]string) GenerateManifestOpt {
+	return func(o *generateManifestOpt) {
+		o.helmRegistryMirrors = mirrors
+	}
+}
+
 // GenerateManifests generates manifests from a path. Overrides are applied as a side effect on the given ApplicationSource.
 func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string, q *apiclient.ManifestRequest, isLocal bool, gitCredsStore git.CredsStore, maxCombinedManifestQuantity resource.Quantity, gitRepoPaths utilio.TempPaths, opts ...GenerateManifestOpt) (_ *apiclient.ManifestResponse, retErr error) {
 	ctx, span := tracer.Start(ctx, "reposerver.GenerateManifests")
@@ -1755,14 +1874,19 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 
 	env := newEnv(q, revision)
 
-	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, repoRoot, q.AppName, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs, env.Environ())
+	appSourceType, err := GetAppSourceType(ctx, q.ApplicationSource, appPath, repoRoot, q.AppName, q.SourceEnvironment, opt.strictSourceOverrides, q.EnabledSourceTypes, opt.cmpTarExcludedGlobs, env.Environ())
 	if err != nil {
 		return nil, fmt.Errorf("error getting app source type: %w", err)
 	}
 	span.SetAttributes(attribute.String("argocd.app.source_type", string(appSourceType)))
 	repoURL := ""
+	var limits executil.ResourceLimits
 	if q.Repo != nil {
 		repoURL = q.Repo.Repo
+		limits, err = q.Repo.ManifestGenerationLimits.ResourceLimits()
+		if err != nil {
+			return nil, fmt.Errorf("invalid manifest generation limits of repository %s: %w", repoURL, err)
+		}
 	}
 
 	var commands []string
@@ -1770,7 +1894,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 	switch appSourceType {
 	case v1alpha1.ApplicationSourceTypeHelm:
 		var command string
-		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths)
+		targetObjs, command, err = helmTemplate(appPath, repoRoot, env, q, isLocal, gitRepoPaths, opt.helmDependencyCache, opt.helmRegistryMirrors, limits)
 		commands = append(commands, command)
 	case v1alpha1.ApplicationSourceTypeKustomize:
 		var kustomizeBinary string
@@ -1787,6 +1911,7 @@ func GenerateManifests(ctx context.Context, appPath, repoRoot, revision string,
 		targetObjs, _, commands, err = k.Build(q.ApplicationSource.Kustomize, q.KustomizeOptions, env, &kustomize.BuildOpts{
 			KubeVersion: kubeVersion,
 			APIVersions: q.ApplicationSource.GetAPIVersionsOrDefault(q.ApiVersions),
+			Limits:      limits,
 		})
 		if err != nil {
 			return nil, err
@@ -1898,13 +2023,16 @@ func newEnvRepoQuery(q *apiclient.RepoServerAppDetailsQuery, revision string) *v
 // the Git repo into the given ApplicationSource objects.
 //
 // If .argocd-source.yaml exists at application's path in repository, it will
-// be read and merged. If appName is not the empty string, and a file named
-// .argocd-source-<appName>.yaml exists, it will also be read and merged.
-func mergeSourceParameters(source *v1alpha1.ApplicationSource, path, appName string) error {
-	repoFilePath := filepath.Join(path, repoSourceFile)
-	overrides := []string{repoFilePath}
-	if appName != "" {
-		overrides = append(overrides, filepath.Join(path, fmt.Sprintf(appSourceFile, appName)))
+// be read and merged. If environment is not the empty string, and a file named
+// .argocd-source.<environment>.yaml exists, it will be read and merged next. If
+// appName is not the empty string, and a file named .argocd-source-<appName>.yaml
+// exists, it will also be read and merged. Each file is validated against the
+// schema of the ApplicationSource before being merged. The validation errors
+// fail the merge in strict mode, and are only logged otherwise.
+func mergeSourceParameters(source *v1alpha1.ApplicationSource, path, appName, environment string, strict bool) error {
+	overrides, err := sourceOverrideFiles(path, appName, environment)
+	if err != nil {
+		return err
 	}
 
 	merged := *source.DeepCopy()
@@ -1931,7 +2059,18 @@ func mergeSourceParameters(source *v1alpha1.ApplicationSource, path, appName str
 		}
 		patch, err = yaml.YAMLToJSON(patch)
 		if err != nil {
-			return fmt.Errorf("%s: %w", filename, err)
+			return &SourceOverrideError{File: filepath.Base(filename), Err: err}
+		}
+		if string(patch) == "null" {
+			// the file is empty
+			continue
+		}
+		if err := validateSourceOverride(patch); err != nil {
+			overrideErr := &SourceOverrideError{File: filepath.Base(filename), Err: err}
+			if strict {
+				return overrideErr
+			}
+			log.WithField("path", path).Warn(overrideErr.Error())
 		}
 		data, err = jsonpatch.MergePatch(data, patch)
 		if err != nil {
@@ -1955,8 +2094,8 @@ func mergeSourceParameters(source *v1alpha1.ApplicationSource, path, appName str
 
 // GetAppSourceType returns explicit application source type or examines a directory and determines its application source type.
 // Overrides are applied as a side effect on the given source.
-func GetAppSourceType(ctx context.Context, source *v1alpha1.ApplicationSource, appPath, repoPath, appName string, enableGenerateManifests map[string]bool, tarExcludedGlobs []string, env []string) (v1alpha1.ApplicationSourceType, error) {
-	err := mergeSourceParameters(source, appPath, appName)
+func GetAppSourceType(ctx context.Context, source *v1alpha1.ApplicationSource, appPath, repoPath, appName, sourceEnvironment string, strictSourceOverrides bool, enableGenerateManifests map[string]bool, tarExcludedGlobs []string, env []string) (v1alpha1.ApplicationSourceType, error) {
+	err := mergeSourceParameters(source, appPath, appName, sourceEnvironment, strictSourceOverrides)
 	if err != nil {
 		return "", fmt.Errorf("error while parsing source parameters: %w", err)
 	}
@@ -2386,7 +2525,15 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 	}
 
 	// generate manifests using commands provided in plugin config file in detected cmp-server sidecar
-	cmpManifests, err := generateManifestsCMP(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs)
+	var limits *pluginclient.ManifestGenerationLimits
+	if q.Repo != nil && q.Repo.ManifestGenerationLimits != nil {
+		limits = &pluginclient.ManifestGenerationLimits{
+			CpuTime: q.Repo.ManifestGenerationLimits.CPUTime,
+			Memory:  q.Repo.ManifestGenerationLimits.Memory,
+			Timeout: q.Repo.ManifestGenerationLimits.Timeout,
+		}
+	}
+	cmpManifests, err := generateManifestsCMP(ctx, appPath, rootPath, env, cmpClient, tarDoneCh, tarExcludedGlobs, limits)
 	if err != nil {
 		return nil, fmt.Errorf("error generating manifests in cmp: %w", err)
 	}
@@ -2409,13 +2556,14 @@ func runConfigManagementPluginSidecars(ctx context.Context, appPath, repoPath, p
 // generateManifestsCMP will send the appPath files to the cmp-server over a gRPC stream.
 // The cmp-server will generate the manifests. Returns a response object with the generated
 // manifests.
-func generateManifestsCMP(ctx context.Context, appPath, rootPath string, env []string, cmpClient pluginclient.ConfigManagementPluginServiceClient, tarDoneCh chan<- bool, tarExcludedGlobs []string) (*pluginclient.ManifestResponse, error) {
+func generateManifestsCMP(ctx context.Context, appPath, rootPath string, env []string, cmpClient pluginclient.ConfigManagementPluginServiceClient, tarDoneCh chan<- bool, tarExcludedGlobs []string, limits *pluginclient.ManifestGenerationLimits) (*pluginclient.ManifestResponse, error) {
 	generateManifestStream, err := cmpClient.GenerateManifest(ctx, grpc_retry.Disable())
 	if err != nil {
 		return nil, fmt.Errorf("error getting generateManifestStream: %w", err)
 	}
 	opts := []cmp.SenderOption{
 		cmp.WithTarDoneChan(tarDoneCh),
+		cmp.WithLimits(limits),
 	}
 
 	// Use the request context (ctx) rather than stream.Context() to avoid prematurely sending into a stream whose
@@ -2440,7 +2588,7 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 
 		env := newEnvRepoQuery(q, revision)
 
-		appSourceType, err := GetAppSourceType(ctx, q.Source, opContext.appPath, repoRoot, q.AppName, q.EnabledSourceTypes, s.initConstants.CMPTarExcludedGlobs, env.Environ())
+		appSourceType, err := GetAppSourceType(ctx, q.Source, opContext.appPath, repoRoot, q.AppName, q.SourceEnvironment, s.initConstants.StrictSourceOverrides, q.EnabledSourceTypes, s.initConstants.CMPTarExcludedGlobs, env.Environ())
 		if err != nil {
 			return err
 		}
@@ -2461,7 +2609,7 @@ func (s *Service) GetAppDetails(ctx context.Context, q *apiclient.RepoServerAppD
 				return fmt.Errorf("failed to populate plugin app details: %w", err)
 			}
 		}
-		_ = s.cache.SetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil)
+		_ = s.cache.SetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil, q.SourceEnvironment)
 		return nil
 	}
 
@@ -2482,7 +2630,8 @@ func toUserInputStatusError(err error) error {
 	if _, ok := status.FromError(err); ok && status.Code(err) != codes.Unknown {
 		return err
 	}
-	if errors.Is(err, apppathutil.ErrAppPathDoesNotExist) {
+	var overrideErr *SourceOverrideError
+	if errors.Is(err, apppathutil.ErrAppPathDoesNotExist) || errors.As(err, &overrideErr) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 	return err
@@ -2490,7 +2639,7 @@ func toUserInputStatusError(err error) error {
 
 func (s *Service) createGetAppDetailsCacheHandler(res *apiclient.RepoAppDetailsResponse, q *apiclient.RepoServerAppDetailsQuery) func(revision string, _ cache.ResolvedRevisions, _ bool) (bool, error) {
 	return func(revision string, _ cache.ResolvedRevisions, _ bool) (bool, error) {
-		err := s.cache.GetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil)
+		err := s.cache.GetAppDetails(revision, q.Source, q.RefSources, res, v1alpha1.TrackingMethod(q.TrackingMethod), nil, q.SourceEnvironment)
 		if err == nil {
 			log.Infof("app details cache hit: %s/%s", revision, q.Source.Path)
 			return true, nil
@@ -2808,6 +2957,85 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	return metadata, nil
 }
 
+// GetRevisionComparison returns the commits and the changed files between two revisions of a git repository
+func (s *Service) GetRevisionComparison(ctx context.Context, q *apiclient.RepoServerRevisionComparisonRequest) (*v1alpha1.RevisionComparison, error) {
+	if q.Repo == nil {
+		return nil, status.Error(codes.InvalidArgument, "must pass a valid repo")
+	}
+	repo := q.Repo.Normalize()
+	if repo.Type != "git" {
+		return nil, status.Errorf(codes.InvalidArgument, "revisions of %s repositories cannot be compared", repo.Type)
+	}
+	if q.Revision == "" || q.TargetRevision == "" {
+		return nil, status.Error(codes.InvalidArgument, "both revisions must be set")
+	}
+
+	gitClient, targetRevision, err := s.newClientResolveRevision(repo, q.TargetRevision)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to resolve git revision %s: %v", q.TargetRevision, err)
+	}
+	revision, err := gitClient.LsRemote(q.Revision)
+	if err != nil {
+		s.metricsServer.IncGitLsRemoteFail(gitClient.Root(), q.Revision)
+		return nil, status.Errorf(codes.Internal, "unable to resolve git revision %s: %v", q.Revision, err)
+	}
+
+	comparison, err := s.cache.GetRevisionComparison(repo.Repo, revision, targetRevision)
+	if err == nil {
+		log.Infof("revision comparison cache hit: %s/%s..%s", repo.Repo, revision, targetRevision)
+		return comparison, nil
+	}
+	if errors.Is(err, cache.ErrCacheMiss) {
+		log.Infof("revision comparison cache miss: %s/%s..%s", repo.Repo, revision, targetRevision)
+	} else {
+		log.Warnf("revision comparison cache error %s/%s..%s: %v", repo.Repo, revision, targetRevision, err)
+	}
+
+	comparison = &v1alpha1.RevisionComparison{Revision: revision, TargetRevision: targetRevision}
+	if revision != targetRevision {
+		s.metricsServer.IncPendingRepoRequest(repo.Repo)
+		defer s.metricsServer.DecPendingRepoRequest(repo.Repo)
+
+		closer, err := s.repoLock.Lock(gitClient.Root(), targetRevision, true, func(clean bool) (goio.Closer, error) {
+			return s.checkoutRevision(ctx, gitClient, targetRevision, false, repo.Depth, clean)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error acquiring repo lock: %w", err)
+		}
+		defer utilio.Close(closer)
+
+		if err := s.fetch(ctx, gitClient, []string{revision}, repo.Depth); err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to fetch git repo %s with revision %s: %v", repo.Repo, revision, err)
+		}
+
+		// one more commit than returned is listed to know whether the commits are truncated
+		commits, err := gitClient.CommitsBetween(ctx, revision, targetRevision, revisionComparisonMaxCommits+1)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to list commits for repo %s from revision %s to revision %s: %v", repo.Repo, revision, targetRevision, err)
+		}
+		if len(commits) > revisionComparisonMaxCommits {
+			commits = commits[:revisionComparisonMaxCommits]
+			comparison.CommitsTruncated = true
+		}
+		for _, commit := range commits {
+			comparison.Commits = append(comparison.Commits, v1alpha1.CommitMetadata{
+				Author:  commit.Author.String(),
+				Date:    commit.Date,
+				Subject: commit.Subject,
+				Body:    commit.Body,
+				SHA:     commit.SHA,
+			})
+		}
+
+		comparison.ChangedFiles, err = gitClient.ChangedFiles(ctx, revision, targetRevision)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to get changed files for repo %s from revision %s to revision %s: %v", repo.Repo, revision, targetRevision, err)
+		}
+	}
+	_ = s.cache.SetRevisionComparison(repo.Repo, revision, targetRevision, comparison)
+	return comparison, nil
+}
+
 func (s *Service) GetOCIMetadata(ctx context.Context, q *apiclient.RepoServerRevisionChartDetailsRequest) (*v1alpha1.OCIMetadata, error) {
 	metadata, err := s.cache.GetOCIMetadata(q.Repo.Repo, q.Revision)
 	if err == nil {
@@ -2898,7 +3126,8 @@ func (s *Service) newClient(repo *v1alpha1.Repository, opts ...git.ClientOpts) (
 	}
 	opts = append(opts,
 		git.WithEventHandlers(metrics.NewGitClientEventHandlers(s.metricsServer)),
-		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig))
+		git.WithBuiltinGitConfig(s.initConstants.EnableBuiltinGitConfig),
+		git.WithSubmoduleOptions(repo.GetGitSubmoduleOptions(s.gitCredsStore)))
 	return s.newGitClient(repo.Repo, repoPath, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.EnableLFS, repo.Proxy, repo.NoProxy, opts...)
 }
 
@@ -2931,9 +3160,18 @@ func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha
 	return ociClient, digest, nil
 }
 
+// helmRegistryMirrors returns the mirrors of the OCI registries used for the charts of the repository and their
+// dependencies. The mirrors configured for the repository override the ones configured for the repo server.
+func (s *Service) helmRegistryMirrors(repo *v1alpha1.Repository) map[string]string {
+	if repo == nil {
+		return s.initConstants.HelmRegistryMirrors
+	}
+	return helm.MergeRegistryMirrors(s.initConstants.HelmRegistryMirrors, repo.RegistryMirrors)
+}
+
 func (s *Service) newHelmClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string, chart string, noRevisionCache bool) (helm.Client, string, error) {
 	enableOCI := repo.EnableOCI || helm.IsHelmOciRepo(repo.Repo)
-	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths)}
+	opts := []helm.ClientOpts{helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths), helm.WithRegistryMirrors(s.helmRegistryMirrors(repo))}
 	if repo.InsecureOCIForceHttp {
 		opts = append(opts, helm.WithPlainHTTP())
 	}
@@ -2992,6 +3230,39 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 	})
 }
 
+// sparseCheckoutPaths returns the directories of the repository needed to generate the manifests of the source: its
+// path and the directories of its helm value files. It returns nil if the whole repository is needed. The paths limit
+// both sparse checkouts and the LFS objects pulled for the source.
+func sparseCheckoutPaths(source *v1alpha1.ApplicationSource) []string {
+	appPath := filepath.Clean(source.Path)
+	if appPath == "." || appPath == ".." || strings.HasPrefix(appPath, "../") || filepath.IsAbs(appPath) {
+		return nil
+	}
+	paths := []string{appPath}
+	if source.Helm != nil {
+		for _, valueFile := range source.Helm.ValueFiles {
+			// value files from referenced sources or remote URLs are not part of the repository
+			if strings.HasPrefix(valueFile, "$") || strings.Contains(valueFile, "://") {
+				continue
+			}
+			dir := filepath.Dir(filepath.Join(appPath, valueFile))
+			if filepath.IsAbs(valueFile) {
+				// absolute value files are relative to the repository root
+				dir = filepath.Dir(filepath.Clean(strings.TrimPrefix(valueFile, "/")))
+			}
+			if strings.ContainsAny(dir, "*?[{") {
+				return nil
+			}
+			// the files of the root directory are always checked out
+			if dir == "." || dir == ".." || strings.HasPrefix(dir, "../") {
+				continue
+			}
+			paths = append(paths, dir)
+		}
+	}
+	return paths
+}
+
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
 func (s *Service) checkoutRevision(ctx context.Context, gitClient git.Client, revision string, submoduleEnabled bool, depth int64, clean bool) (_ goio.Closer, retErr error) {
@@ -3122,7 +3393,16 @@ func (s *Service) GetHelmCharts(ctx context.Context, q *apiclient.HelmChartsRequ
 }
 
 func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
-	repo := q.Repo
+	apiResp := &apiclient.TestRepositoryResponse{VerifiedRepository: false}
+	err := s.testRepository(ctx, q.Repo)
+	if err != nil {
+		return apiResp, err
+	}
+	return apiResp, nil
+}
+
+// testRepository tests if the given repository exists and is accessible with its credentials
+func (s *Service) testRepository(ctx context.Context, repo *v1alpha1.Repository) error {
 	// per Type doc, "git" should be assumed if empty or absent
 	if repo.Type == "" {
 		repo.Type = "git"
@@ -3145,7 +3425,7 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 				if !helm.IsHelmOciRepo(repo.Repo) {
 					return errors.New("OCI Helm repository URL should include hostname and port only")
 				}
-				clientOpts := []helm.ClientOpts{}
+				clientOpts := []helm.ClientOpts{helm.WithRegistryMirrors(s.helmRegistryMirrors(repo))}
 				if repo.InsecureOCIForceHttp {
 					clientOpts = append(clientOpts, helm.WithPlainHTTP())
 				}
@@ -3156,16 +3436,15 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 			return err
 		},
 	}
-	apiResp := &apiclient.TestRepositoryResponse{VerifiedRepository: false}
 	check, ok := checks[repo.Type]
 	if !ok {
-		return apiResp, fmt.Errorf("unsupported repository type %q", repo.Type)
+		return fmt.Errorf("unsupported repository type %q", repo.Type)
 	}
 	err := check()
 	if err != nil {
-		return apiResp, fmt.Errorf("error testing repository connectivity: %w", err)
+		return fmt.Errorf("error testing repository connectivity: %w", err)
 	}
-	return apiResp, nil
+	return nil
 }
 
 // ResolveRevision resolves the revision/ambiguousRevision specified in the ResolveRevisionRequest request into a concrete revision.
@@ -3618,6 +3897,7 @@ func getManifestCacheKeyFromUpdateRevisionRequest(request *apiclient.UpdateRevis
 		AppName:             request.AppName,
 		RefSourceCommitSHAs: refSourceCommitSHAs,
 		InstallationID:      request.InstallationID,
+		SourceEnvironment:   request.SourceEnvironment,
 	}
 }
 
@@ -33,6 +33,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	appsv1 "k8s.io/api/apps/v1"
@@ -280,6 +281,50 @@ func TestGenerateYamlManifestInDir(t *testing.T) {
 	assert.Len(t, res2.Manifests, 3)
 }
 
+type fakeGenerateManifestStreamServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	chunks []*apiclient.ManifestResponseChunk
+}
+
+func (s *fakeGenerateManifestStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeGenerateManifestStreamServer) Send(chunk *apiclient.ManifestResponseChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestGenerateManifestStream(t *testing.T) {
+	service := newService(t, "./testdata/concatenated")
+
+	q := apiclient.ManifestRequest{
+		Repo:               &v1alpha1.Repository{},
+		ApplicationSource:  &v1alpha1.ApplicationSource{Path: "."},
+		ProjectName:        "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	expected, err := service.GenerateManifest(t.Context(), &q)
+	require.NoError(t, err)
+
+	stream := &fakeGenerateManifestStreamServer{ctx: t.Context()}
+	err = service.GenerateManifestStream(&q, stream)
+	require.NoError(t, err)
+
+	require.NotEmpty(t, stream.chunks)
+	last := stream.chunks[len(stream.chunks)-1]
+	require.NotNil(t, last.Response)
+	assert.NotEmpty(t, last.Checksum)
+	var manifests []string
+	for _, chunk := range stream.chunks {
+		manifests = append(manifests, chunk.Manifests...)
+	}
+	assert.Equal(t, expected.Manifests, manifests)
+	assert.Equal(t, expected.Revision, last.Response.Revision)
+}
+
 func Test_GenerateManifest_KustomizeWithVersionOverride(t *testing.T) {
 	t.Parallel()
 
@@ -1701,15 +1746,15 @@ func TestGenerateNullList(t *testing.T) {
 }
 
 func TestIdentifyAppSourceTypeByAppDirWithKustomizations(t *testing.T) {
-	sourceType, err := GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/kustomization_yaml", "./testdata", "testapp", map[string]bool{}, []string{}, []string{})
+	sourceType, err := GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/kustomization_yaml", "./testdata", "testapp", "", false, map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, v1alpha1.ApplicationSourceTypeKustomize, sourceType)
 
-	sourceType, err = GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/kustomization_yml", "./testdata", "testapp", map[string]bool{}, []string{}, []string{})
+	sourceType, err = GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/kustomization_yml", "./testdata", "testapp", "", false, map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, v1alpha1.ApplicationSourceTypeKustomize, sourceType)
 
-	sourceType, err = GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/Kustomization", "./testdata", "testapp", map[string]bool{}, []string{}, []string{})
+	sourceType, err = GetAppSourceType(t.Context(), &v1alpha1.ApplicationSource{}, "./testdata/Kustomization", "./testdata", "testapp", "", false, map[string]bool{}, []string{}, []string{})
 	require.NoError(t, err)
 	assert.Equal(t, v1alpha1.ApplicationSourceTypeKustomize, sourceType)
 }
@@ -1753,6 +1798,7 @@ func TestListApps(t *testing.T) {
 		"helm-with-local-dependency":        "Helm",
 		"simple-chart":                      "Helm",
 		"broken-schema-verification":        "Helm",
+		"helm-values-schema":                "Helm",
 	}
 	assert.Equal(t, expectedApps, res.Apps)
 }
@@ -3335,6 +3381,41 @@ func TestInit(t *testing.T) {
 
 // TestCheckoutRevisionCanGetNonstandardRefs shows that we can fetch a revision that points to a non-standard ref. In
 // other words, we haven't regressed and caused this issue again: https://github.com/argoproj/argo-cd/issues/4935
+func Test_sparseCheckoutPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   *v1alpha1.ApplicationSource
+		expected []string
+	}{
+		{name: "RootPath", source: &v1alpha1.ApplicationSource{Path: "."}, expected: nil},
+		{name: "EmptyPath", source: &v1alpha1.ApplicationSource{}, expected: nil},
+		{name: "PathOutsideOfRepository", source: &v1alpha1.ApplicationSource{Path: "../other"}, expected: nil},
+		{name: "Path", source: &v1alpha1.ApplicationSource{Path: "apps/guestbook/"}, expected: []string{"apps/guestbook"}},
+		{
+			name: "ValueFiles",
+			source: &v1alpha1.ApplicationSource{Path: "apps/guestbook", Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{
+				"values.yaml",
+				"../../envs/prod/values.yaml",
+				"/shared/values.yaml",
+				"../../root-values.yaml",
+				"$values/envs/prod/values.yaml",
+				"https://example.com/values.yaml",
+			}}},
+			expected: []string{"apps/guestbook", "apps/guestbook", "envs/prod", "shared"},
+		},
+		{
+			name:     "GlobValueFiles",
+			source:   &v1alpha1.ApplicationSource{Path: "apps/guestbook", Helm: &v1alpha1.ApplicationSourceHelm{ValueFiles: []string{"../../envs/*/values.yaml"}}},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sparseCheckoutPaths(tt.source))
+		})
+	}
+}
+
 func TestCheckoutRevisionCanGetNonstandardRefs(t *testing.T) {
 	rootPath := t.TempDir()
 
@@ -5494,6 +5575,120 @@ func TestUpdateRevisionForPaths_CallerMustPersistResolvedRevision(t *testing.T)
 	assert.Equal(t, resolvedRevision, resp3.Revision)
 }
 
+func TestGetRevisionComparison(t *testing.T) {
+	revision := "1e67a504d03def3a6a1125d934cb511680f72555"
+	targetRevision := "632039659e542ed7de0c170a4fcc1c571b288fc0"
+
+	t.Run("Commits and changed files", func(t *testing.T) {
+		commits := []git.CommitMetadata{
+			{SHA: targetRevision, Author: mail.Address{Name: "Jane Doe", Address: "jane@example.com"}, Date: "2024-01-02T00:00:00Z", Subject: "Second change"},
+			{SHA: "9a0f3b0e2b63b5b0ab3f8f1f4d0e1d2c3b4a5968", Author: mail.Address{Name: "John Doe", Address: "john@example.com"}, Date: "2024-01-01T00:00:00Z", Subject: "First change", Body: "Details"},
+		}
+		s, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+			gitClient.EXPECT().Init().Return(nil)
+			gitClient.EXPECT().IsRevisionPresent(mock.Anything, mock.Anything).Return(false)
+			gitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			gitClient.EXPECT().Checkout(mock.Anything, targetRevision, mock.Anything, mock.Anything).Return("", nil)
+			gitClient.EXPECT().LsRemote("main").Return(targetRevision, nil)
+			gitClient.EXPECT().LsRemote(revision).Return(revision, nil)
+			gitClient.EXPECT().Root().Return("")
+			gitClient.EXPECT().CommitsBetween(mock.Anything, revision, targetRevision, revisionComparisonMaxCommits+1).Once().Return(commits, nil)
+			gitClient.EXPECT().ChangedFiles(mock.Anything, revision, targetRevision).Once().Return([]string{"app/deployment.yaml"}, nil)
+			paths.EXPECT().GetPath(mock.Anything).Return(".", nil)
+			paths.EXPECT().GetPathIfExists(mock.Anything).Return(".")
+		}, ".")
+
+		request := &apiclient.RepoServerRevisionComparisonRequest{
+			Repo:           &v1alpha1.Repository{Repo: "a-url.com"},
+			Revision:       revision,
+			TargetRevision: "main",
+		}
+		expected := &v1alpha1.RevisionComparison{
+			Revision:       revision,
+			TargetRevision: targetRevision,
+			Commits: []v1alpha1.CommitMetadata{
+				{SHA: targetRevision, Author: `"Jane Doe" <jane@example.com>`, Date: "2024-01-02T00:00:00Z", Subject: "Second change"},
+				{SHA: "9a0f3b0e2b63b5b0ab3f8f1f4d0e1d2c3b4a5968", Author: `"John Doe" <john@example.com>`, Date: "2024-01-01T00:00:00Z", Subject: "First change", Body: "Details"},
+			},
+			ChangedFiles: []string{"app/deployment.yaml"},
+		}
+
+		comparison, err := s.GetRevisionComparison(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, expected, comparison)
+
+		// the second comparison is served from the cache
+		comparison, err = s.GetRevisionComparison(t.Context(), request)
+		require.NoError(t, err)
+		assert.Equal(t, expected, comparison)
+	})
+
+	t.Run("Truncated commits", func(t *testing.T) {
+		commits := make([]git.CommitMetadata, revisionComparisonMaxCommits+1)
+		s, _, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+			gitClient.EXPECT().Init().Return(nil)
+			gitClient.EXPECT().IsRevisionPresent(mock.Anything, mock.Anything).Return(false)
+			gitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			gitClient.EXPECT().Checkout(mock.Anything, targetRevision, mock.Anything, mock.Anything).Return("", nil)
+			gitClient.EXPECT().LsRemote(targetRevision).Return(targetRevision, nil)
+			gitClient.EXPECT().LsRemote(revision).Return(revision, nil)
+			gitClient.EXPECT().Root().Return("")
+			gitClient.EXPECT().CommitsBetween(mock.Anything, revision, targetRevision, mock.Anything).Return(commits, nil)
+			gitClient.EXPECT().ChangedFiles(mock.Anything, revision, targetRevision).Return([]string{}, nil)
+			paths.EXPECT().GetPath(mock.Anything).Return(".", nil)
+			paths.EXPECT().GetPathIfExists(mock.Anything).Return(".")
+		}, ".")
+
+		comparison, err := s.GetRevisionComparison(t.Context(), &apiclient.RepoServerRevisionComparisonRequest{
+			Repo:           &v1alpha1.Repository{Repo: "a-url.com", Type: "git"},
+			Revision:       revision,
+			TargetRevision: targetRevision,
+		})
+		require.NoError(t, err)
+		assert.Len(t, comparison.Commits, revisionComparisonMaxCommits)
+		assert.True(t, comparison.CommitsTruncated)
+	})
+
+	t.Run("Same revision", func(t *testing.T) {
+		s, gitClient, _ := newServiceWithOpt(t, func(gitClient *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, paths *iomocks.TempPaths) {
+			gitClient.EXPECT().Init().Return(nil)
+			gitClient.EXPECT().LsRemote(mock.Anything).Return(targetRevision, nil)
+			gitClient.EXPECT().Root().Return("")
+			paths.EXPECT().GetPath(mock.Anything).Return(".", nil)
+			paths.EXPECT().GetPathIfExists(mock.Anything).Return(".")
+		}, ".")
+
+		comparison, err := s.GetRevisionComparison(t.Context(), &apiclient.RepoServerRevisionComparisonRequest{
+			Repo:           &v1alpha1.Repository{Repo: "a-url.com"},
+			Revision:       "HEAD",
+			TargetRevision: "main",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &v1alpha1.RevisionComparison{Revision: targetRevision, TargetRevision: targetRevision}, comparison)
+		gitClient.AssertNotCalled(t, "CommitsBetween", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Invalid requests", func(t *testing.T) {
+		s, _, _ := newServiceWithOpt(t, func(_ *gitmocks.Client, _ *helmmocks.Client, _ *ocimocks.Client, _ *iomocks.TempPaths) {}, ".")
+
+		_, err := s.GetRevisionComparison(t.Context(), &apiclient.RepoServerRevisionComparisonRequest{Revision: revision, TargetRevision: targetRevision})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.GetRevisionComparison(t.Context(), &apiclient.RepoServerRevisionComparisonRequest{
+			Repo:           &v1alpha1.Repository{Repo: "a-url.com", Type: "helm"},
+			Revision:       "1.0.0",
+			TargetRevision: "1.1.0",
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.GetRevisionComparison(t.Context(), &apiclient.RepoServerRevisionComparisonRequest{
+			Repo:     &v1alpha1.Repository{Repo: "a-url.com"},
+			Revision: revision,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func Test_getRepoSanitizerRegex(t *testing.T) {
 	r := getRepoSanitizerRegex("/tmp/_argocd-repo")
 	msg := r.ReplaceAllString("error message containing /tmp/_argocd-repo/SENSITIVE and other stuff", "<path to cached source>")
@@ -5962,6 +6157,29 @@ func Test_SkipSchemaValidation(t *testing.T) {
 	})
 }
 
+func TestGenerateManifest_HelmValuesSchemaViolations(t *testing.T) {
+	service := newService(t, "testdata/helm-values-schema")
+
+	q := apiclient.ManifestRequest{
+		AppName: "test-app",
+		Repo:    &v1alpha1.Repository{},
+		ApplicationSource: &v1alpha1.ApplicationSource{
+			Path: ".",
+			Helm: &v1alpha1.ApplicationSourceHelm{
+				ValueFiles: []string{"invalid-values.yaml"},
+			},
+		},
+	}
+
+	_, err := service.GenerateManifest(t.Context(), &q)
+
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.ErrorContains(t, err, "helm values do not match the values.schema.json file of the chart")
+	assert.ErrorContains(t, err, "at '/replicaCount'")
+	assert.ErrorContains(t, err, "at '/image/tag'")
+}
+
 func TestGenerateManifest_OCISourceSkipsGitClient(t *testing.T) {
 	svc := newService(t, t.TempDir())
 
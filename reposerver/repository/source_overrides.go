@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	repoSourceFile        = ".argocd-source.yaml"
+	appSourceFile         = ".argocd-source-%s.yaml"
+	environmentSourceFile = ".argocd-source.%s.yaml"
+)
+
+// sourceFieldsNotOverridable are the fields of an application source which select the manifests of the source, and
+// therefore cannot be set by the parameter override files found with the manifests
+var sourceFieldsNotOverridable = []string{"repoURL", "path", "targetRevision", "chart"}
+
+// SourceOverrideError is returned when a parameter override file of an application source is invalid.
+type SourceOverrideError struct {
+	// File is the name of the override file
+	File string
+	Err  error
+}
+
+func (e *SourceOverrideError) Error() string {
+	return fmt.Sprintf("invalid parameter override file %s: %v", e.File, e.Err)
+}
+
+func (e *SourceOverrideError) Unwrap() error {
+	return e.Err
+}
+
+// sourceOverrideFiles returns the parameter override files of an application in the directory of its source, from
+// the least to the most specific: the file of the directory, the file of the environment of the application and the
+// file of the application
+func sourceOverrideFiles(path, appName, environment string) ([]string, error) {
+	files := []string{filepath.Join(path, repoSourceFile)}
+	if environment != "" {
+		if strings.ContainsAny(environment, `/\`) || environment == "." || environment == ".." {
+			return nil, fmt.Errorf("invalid source environment %q", environment)
+		}
+		files = append(files, filepath.Join(path, fmt.Sprintf(environmentSourceFile, environment)))
+	}
+	if appName != "" {
+		files = append(files, filepath.Join(path, fmt.Sprintf(appSourceFile, appName)))
+	}
+	return files, nil
+}
+
+// validateSourceOverride validates the JSON merge patch of a parameter override file against the schema of the
+// application source: the patch must be an object, only contain known fields with values of the expected types, and
+// not set the fields selecting the manifests of the source
+func validateSourceOverride(patch []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &fields); err != nil {
+		return errors.New("the file must contain an object")
+	}
+	for name := range fields {
+		if slices.Contains(sourceFieldsNotOverridable, name) {
+			return fmt.Errorf("field %q cannot be overridden", name)
+		}
+	}
+	decoder := json.NewDecoder(bytes.NewReader(patch))
+	decoder.DisallowUnknownFields()
+	var source v1alpha1.ApplicationSource
+	return decoder.Decode(&source)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func writeSourceOverrides(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	return dir
+}
+
+func TestMergeSourceParameters(t *testing.T) {
+	t.Run("layers the files from the least to the most specific", func(t *testing.T) {
+		dir := writeSourceOverrides(t, map[string]string{
+			".argocd-source.yaml":           "helm:\n  releaseName: base\n  valueFiles: [values.yaml]\n",
+			".argocd-source.prod.yaml":      "helm:\n  releaseName: prod\n  valueFiles: [values-prod.yaml]\n",
+			".argocd-source.staging.yaml":   "helm:\n  releaseName: staging\n",
+			".argocd-source-my-app.yaml":    "helm:\n  releaseName: my-app\n",
+			".argocd-source-other-app.yaml": "helm:\n  valueFiles: [values-other.yaml]\n",
+		})
+		source := &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app"}
+		require.NoError(t, mergeSourceParameters(source, dir, "my-app", "prod", true))
+		assert.Equal(t, "my-app", source.Helm.ReleaseName)
+		assert.Equal(t, []string{"values-prod.yaml"}, source.Helm.ValueFiles)
+		assert.Equal(t, "app", source.Path)
+	})
+
+	t.Run("without environment", func(t *testing.T) {
+		dir := writeSourceOverrides(t, map[string]string{
+			".argocd-source.yaml":      "helm:\n  releaseName: base\n",
+			".argocd-source.prod.yaml": "helm:\n  releaseName: prod\n",
+		})
+		source := &v1alpha1.ApplicationSource{}
+		require.NoError(t, mergeSourceParameters(source, dir, "my-app", "", true))
+		assert.Equal(t, "base", source.Helm.ReleaseName)
+	})
+
+	t.Run("missing and empty files are ignored", func(t *testing.T) {
+		dir := writeSourceOverrides(t, map[string]string{".argocd-source.yaml": ""})
+		source := &v1alpha1.ApplicationSource{Path: "app"}
+		require.NoError(t, mergeSourceParameters(source, dir, "my-app", "prod", true))
+		assert.Equal(t, &v1alpha1.ApplicationSource{Path: "app"}, source)
+	})
+
+	t.Run("invalid environment", func(t *testing.T) {
+		for _, environment := range []string{"..", ".", "../prod", `prod\x`} {
+			err := mergeSourceParameters(&v1alpha1.ApplicationSource{}, t.TempDir(), "my-app", environment, true)
+			require.ErrorContains(t, err, "invalid source environment")
+		}
+	})
+
+	t.Run("invalid fields are ignored when not strict", func(t *testing.T) {
+		dir := writeSourceOverrides(t, map[string]string{
+			".argocd-source.prod.yaml": "targetRevision: main\nhelm:\n  releaseName: prod\n  releaseNme: typo\n",
+		})
+		source := &v1alpha1.ApplicationSource{TargetRevision: "HEAD"}
+		require.NoError(t, mergeSourceParameters(source, dir, "my-app", "prod", false))
+		assert.Equal(t, "prod", source.Helm.ReleaseName)
+		assert.Equal(t, "HEAD", source.TargetRevision)
+	})
+
+	for name, tc := range map[string]struct {
+		content string
+		err     string
+	}{
+		"unknown field":         {content: "helm:\n  releaseNme: my-app\n", err: `unknown field "releaseNme"`},
+		"wrong type":            {content: "helm:\n  valueFiles: values.yaml\n", err: "cannot unmarshal string"},
+		"not an object":         {content: "- helm\n", err: "the file must contain an object"},
+		"not overridable field": {content: "targetRevision: main\n", err: `field "targetRevision" cannot be overridden`},
+		"malformed yaml":        {content: "helm: [\n", err: "yaml"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dir := writeSourceOverrides(t, map[string]string{".argocd-source.prod.yaml": tc.content})
+			err := mergeSourceParameters(&v1alpha1.ApplicationSource{}, dir, "my-app", "prod", true)
+			var overrideErr *SourceOverrideError
+			require.ErrorAs(t, err, &overrideErr)
+			assert.Equal(t, ".argocd-source.prod.yaml", overrideErr.File)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
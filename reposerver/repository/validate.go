@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	certutil "github.com/argoproj/argo-cd/v3/util/cert"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	validationStageDNS  = "dns"
+	validationStageTCP  = "tcp"
+	validationStageTLS  = "tls"
+	validationStageAuth = "auth"
+	validationStageRefs = "refs"
+	validationStageLFS  = "lfs"
+
+	// validationStatusSkipped is the status of the stages that do not apply to the repository or follow a failed stage
+	validationStatusSkipped = "Skipped"
+)
+
+// repositoryValidation collects the results of the stages of the validation of a repository
+type repositoryValidation struct {
+	stages []*apiclient.RepositoryValidationStage
+	failed bool
+}
+
+// run runs the check of a stage, unless a previous stage failed
+func (v *repositoryValidation) run(name string, check func() (string, []string, error)) {
+	if v.failed {
+		v.skip(name, "skipped, since a previous stage failed")
+		return
+	}
+	message, details, err := check()
+	stage := &apiclient.RepositoryValidationStage{Name: name, Status: v1alpha1.ConnectionStatusSuccessful, Message: message, Details: details}
+	if err != nil {
+		stage.Status = v1alpha1.ConnectionStatusFailed
+		stage.Message = err.Error()
+		v.failed = true
+	}
+	v.stages = append(v.stages, stage)
+}
+
+func (v *repositoryValidation) add(name, status, message string) {
+	v.stages = append(v.stages, &apiclient.RepositoryValidationStage{Name: name, Status: status, Message: message})
+}
+
+func (v *repositoryValidation) skip(name, message string) {
+	v.add(name, validationStatusSkipped, message)
+}
+
+// ValidateRepository checks the access to a repository in stages: the resolution of its host name, the connection to
+// its server, the TLS certificate chain served by the server, the credentials, the listing of the refs and the LFS
+// server. Unlike TestRepository, a failed stage is reported in the response rather than as an error.
+func (s *Service) ValidateRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.ValidateRepositoryResponse, error) {
+	if q.Repo == nil || q.Repo.Repo == "" {
+		return nil, status.Error(codes.InvalidArgument, "repository is required")
+	}
+	repo := q.Repo.DeepCopy()
+	// per Type doc, "git" should be assumed if empty or absent
+	if repo.Type == "" {
+		repo.Type = "git"
+	}
+	ctx, cancel := context.WithTimeout(ctx, repositoryHealthCheckTimeout)
+	defer cancel()
+
+	v := &repositoryValidation{}
+	s.validateConnection(ctx, v, repo)
+	if repo.Type == "git" {
+		s.validateGitAccess(v, repo)
+	} else {
+		v.run(validationStageAuth, func() (string, []string, error) {
+			if err := s.testRepository(ctx, repo); err != nil {
+				return "", nil, err
+			}
+			return accessMessage(repo), nil, nil
+		})
+		v.skip(validationStageRefs, "only applicable to Git repositories")
+	}
+	if repo.Type == "git" && repo.IsLFSEnabled() {
+		v.run(validationStageLFS, func() (string, []string, error) {
+			if err := git.TestLFS(ctx, repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.Proxy, repo.NoProxy); err != nil {
+				return "", nil, err
+			}
+			return "LFS server is available", nil, nil
+		})
+	} else {
+		v.skip(validationStageLFS, "LFS is not enabled")
+	}
+	return &apiclient.ValidateRepositoryResponse{Stages: v.stages}, nil
+}
+
+// validateConnection runs the DNS, TCP and TLS stages
+func (s *Service) validateConnection(ctx context.Context, v *repositoryValidation, repo *v1alpha1.Repository) {
+	if repo.Proxy != "" {
+		for _, name := range []string{validationStageDNS, validationStageTCP, validationStageTLS} {
+			v.skip(name, "the repository is accessed through a proxy")
+		}
+		return
+	}
+	host, port, useTLS, err := repositoryEndpoint(repo)
+	if err != nil {
+		v.run(validationStageDNS, func() (string, []string, error) { return "", nil, err })
+	} else {
+		v.run(validationStageDNS, func() (string, []string, error) {
+			addrs, err := net.DefaultResolver.LookupHost(ctx, host)
+			if err != nil {
+				return "", nil, fmt.Errorf("unable to resolve %s: %w", host, err)
+			}
+			return "resolved " + host, addrs, nil
+		})
+	}
+	addr := net.JoinHostPort(host, port)
+	v.run(validationStageTCP, func() (string, []string, error) {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return "", nil, fmt.Errorf("unable to connect to %s: %w", addr, err)
+		}
+		defer utilio.Close(conn)
+		return "connected to " + addr, nil, nil
+	})
+	if !useTLS {
+		v.skip(validationStageTLS, "the repository is not accessed over TLS")
+		return
+	}
+	v.run(validationStageTLS, func() (string, []string, error) {
+		return validateTLS(ctx, repo, host, addr)
+	})
+}
+
+// validateTLS verifies the certificate chain served at the given address like the clients of the repo server do, and
+// returns the served certificates as details
+func validateTLS(ctx context.Context, repo *v1alpha1.Repository, host, addr string) (string, []string, error) {
+	// the chain is verified below, so that the served certificates can be reported even if the verification fails
+	dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: true}} //nolint:gosec
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return "", nil, fmt.Errorf("TLS handshake with %s failed: %w", addr, err)
+	}
+	defer utilio.Close(conn)
+	certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(certs) == 0 {
+		return "", nil, errors.New("the server did not serve any certificate")
+	}
+	details := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		details = append(details, fmt.Sprintf("subject=%s issuer=%s notAfter=%s", cert.Subject, cert.Issuer, cert.NotAfter.UTC().Format("2006-01-02")))
+	}
+	if repo.IsInsecure() {
+		return "certificate verification is disabled for the repository", details, nil
+	}
+	opts := x509.VerifyOptions{DNSName: host, Intermediates: x509.NewCertPool()}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	// custom certificates replace the system roots, see git.GetRepoHTTPClient
+	if pemData, err := certutil.GetCertificateForConnect(host); err == nil && len(pemData) > 0 {
+		opts.Roots = certutil.GetCertPoolFromPEMData(pemData)
+	}
+	if _, err := certs[0].Verify(opts); err != nil {
+		return "", details, fmt.Errorf("certificate verification failed: %w", err)
+	}
+	return "certificate chain verified", details, nil
+}
+
+// validateGitAccess runs the auth and refs stages of a Git repository, which both list the refs of the repository
+func (s *Service) validateGitAccess(v *repositoryValidation, repo *v1alpha1.Repository) {
+	if v.failed {
+		v.skip(validationStageAuth, "skipped, since a previous stage failed")
+		v.skip(validationStageRefs, "skipped, since a previous stage failed")
+		return
+	}
+	var refs *git.Refs
+	client, err := git.NewClient(repo.Repo, repo.GetGitCreds(s.gitCredsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
+	if err == nil {
+		refs, err = client.LsRefs()
+	}
+	switch {
+	case err == nil:
+		v.add(validationStageAuth, v1alpha1.ConnectionStatusSuccessful, accessMessage(repo))
+		v.add(validationStageRefs, v1alpha1.ConnectionStatusSuccessful, fmt.Sprintf("listed %d branches and %d tags", len(refs.Branches), len(refs.Tags)))
+	case failedRepositoryCheck(err) == v1alpha1.RepositoryCheckConnection:
+		v.add(validationStageAuth, v1alpha1.ConnectionStatusUnknown, "unable to verify the credentials, since the refs could not be listed")
+		v.run(validationStageRefs, func() (string, []string, error) { return "", nil, err })
+	default:
+		v.run(validationStageAuth, func() (string, []string, error) { return "", nil, err })
+		v.skip(validationStageRefs, "skipped, since a previous stage failed")
+	}
+}
+
+func accessMessage(repo *v1alpha1.Repository) string {
+	if repo.HasCredentials() {
+		return "credentials accepted"
+	}
+	return "anonymous access allowed"
+}
+
+// repositoryEndpoint returns the host and port of the server of a repository, and whether it is accessed over TLS
+func repositoryEndpoint(repo *v1alpha1.Repository) (string, string, bool, error) {
+	if isSSH, _ := git.IsSSHURL(repo.Repo); isSSH {
+		host, port, err := net.SplitHostPort(git.SSHHostWithPort(repo.Repo))
+		if err != nil {
+			return "", "", false, fmt.Errorf("unable to determine the host of %q", repo.Repo)
+		}
+		return host, port, false, nil
+	}
+	rawURL := repo.Repo
+	// OCI repositories are referenced without scheme or with the oci scheme
+	if repo.Type == "oci" || (repo.Type == "helm" && repo.EnableOCI) {
+		scheme := "https://"
+		if repo.InsecureOCIForceHttp {
+			scheme = "http://"
+		}
+		rawURL = scheme + strings.TrimPrefix(rawURL, "oci://")
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Hostname() == "" {
+		return "", "", false, fmt.Errorf("unable to determine the host of %q", repo.Repo)
+	}
+	useTLS := u.Scheme == "https"
+	port := u.Port()
+	switch {
+	case port != "":
+	case useTLS:
+		port = "443"
+	default:
+		port = "80"
+	}
+	return u.Hostname(), port, useTLS, nil
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRepositoryEndpoint(t *testing.T) {
+	tests := []struct {
+		repo   v1alpha1.Repository
+		host   string
+		port   string
+		useTLS bool
+	}{
+		{repo: v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd.git"}, host: "github.com", port: "443", useTLS: true},
+		{repo: v1alpha1.Repository{Repo: "http://git.example.com:8080/repo.git"}, host: "git.example.com", port: "8080"},
+		{repo: v1alpha1.Repository{Repo: "git@github.com:argoproj/argo-cd.git"}, host: "github.com", port: "22"},
+		{repo: v1alpha1.Repository{Repo: "ssh://git@git.example.com:2222/repo.git"}, host: "git.example.com", port: "2222"},
+		{repo: v1alpha1.Repository{Repo: "registry.example.com/charts", Type: "helm", EnableOCI: true}, host: "registry.example.com", port: "443", useTLS: true},
+		{repo: v1alpha1.Repository{Repo: "oci://localhost:5000/app", Type: "oci", InsecureOCIForceHttp: true}, host: "localhost", port: "5000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.repo.Repo, func(t *testing.T) {
+			host, port, useTLS, err := repositoryEndpoint(&tt.repo)
+			require.NoError(t, err)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.port, port)
+			assert.Equal(t, tt.useTLS, useTLS)
+		})
+	}
+
+	_, _, _, err := repositoryEndpoint(&v1alpha1.Repository{Repo: "file:///tmp/repo"})
+	assert.Error(t, err)
+}
+
+func TestValidateTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+	addr := strings.TrimPrefix(server.URL, "https://")
+
+	// the certificate of the test server is not trusted
+	_, details, err := validateTLS(t.Context(), &v1alpha1.Repository{Repo: server.URL}, "127.0.0.1", addr)
+	require.ErrorContains(t, err, "certificate verification failed")
+	require.Len(t, details, 1)
+	assert.Contains(t, details[0], "issuer=O=Acme Co")
+
+	message, details, err := validateTLS(t.Context(), &v1alpha1.Repository{Repo: server.URL, Insecure: true}, "127.0.0.1", addr)
+	require.NoError(t, err)
+	assert.Equal(t, "certificate verification is disabled for the repository", message)
+	assert.Len(t, details, 1)
+}
+
+func TestRepositoryValidation(t *testing.T) {
+	v := &repositoryValidation{}
+	v.run(validationStageDNS, func() (string, []string, error) { return "resolved", []string{"192.0.2.1"}, nil })
+	v.run(validationStageTCP, func() (string, []string, error) { return "", nil, errors.New("connection refused") })
+	v.run(validationStageTLS, func() (string, []string, error) {
+		t.Fatal("stages following a failed stage must not run")
+		return "", nil, nil
+	})
+
+	require.Len(t, v.stages, 3)
+	assert.Equal(t, v1alpha1.ConnectionStatusSuccessful, v.stages[0].Status)
+	assert.Equal(t, []string{"192.0.2.1"}, v.stages[0].Details)
+	assert.Equal(t, v1alpha1.ConnectionStatusFailed, v.stages[1].Status)
+	assert.Equal(t, "connection refused", v.stages[1].Message)
+	assert.Equal(t, validationStatusSkipped, v.stages[2].Status)
+}
@@ -1,11 +1,13 @@
 package reposerver
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
@@ -21,6 +23,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/common"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	reposervercache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/reposerver/metrics"
@@ -42,6 +45,9 @@ type ArgoCDRepoServer struct {
 	healthCheckClientCert *tls.Certificate
 }
 
+// testRepositoryMethod is the full gRPC method name of TestRepository
+const testRepositoryMethod = "/repository.RepoServerService/TestRepository"
+
 // The hostnames to generate self-signed certificates with
 var tlsHostList = []string{"localhost", "reposerver"}
 
@@ -91,17 +97,25 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 	serverMetrics := grpc_prometheus.NewServerMetrics(serverMetricsOptions...)
 	metricsServer.PrometheusRegistry.MustRegister(serverMetrics)
 
+	repoService := repository.NewService(metricsServer, cache, initConstants, gitCredsStore, filepath.Join(os.TempDir(), "_argocd-repo"))
+	if err := repoService.Init(); err != nil {
+		return nil, fmt.Errorf("failed to initialize the repo service: %w", err)
+	}
+
 	serverLog := log.NewEntry(log.StandardLogger())
 	streamInterceptors := []grpc.StreamServerInterceptor{
-		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		grpc_util.CorrelationIDStreamServerInterceptor(),
+		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
 		serverMetrics.StreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 	}
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
-		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog)),
+		grpc_util.CorrelationIDUnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(serverLog), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
 		serverMetrics.UnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(serverLog))),
 		grpc_util.ErrorSanitizerUnaryServerInterceptor(),
+		observeRepositoryUnaryServerInterceptor(repoService),
 	}
 
 	serverOpts := []grpc.ServerOption{
@@ -122,10 +136,6 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 	if tlsConfig != nil {
 		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
 	}
-	repoService := repository.NewService(metricsServer, cache, initConstants, gitCredsStore, filepath.Join(os.TempDir(), "_argocd-repo"))
-	if err := repoService.Init(); err != nil {
-		return nil, fmt.Errorf("failed to initialize the repo service: %w", err)
-	}
 
 	return &ArgoCDRepoServer{
 		opts:                  serverOpts,
@@ -135,6 +145,22 @@ func NewServer(metricsServer *metrics.MetricsServer, cache *reposervercache.Cach
 	}, nil
 }
 
+// observeRepositoryUnaryServerInterceptor includes the repositories of requests in the background health checks.
+// Repositories that are only tested are not included, since they might not have been saved.
+func observeRepositoryUnaryServerInterceptor(repoService *repository.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if r, ok := req.(interface{ GetRepo() *v1alpha1.Repository }); ok && info.FullMethod != testRepositoryMethod {
+			repoService.ObserveRepository(r.GetRepo())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// RunRepositoryHealthChecks periodically checks the health of the repositories until the context is done
+func (a *ArgoCDRepoServer) RunRepositoryHealthChecks(ctx context.Context, interval time.Duration) {
+	a.repoService.RunRepositoryHealthChecks(ctx, interval)
+}
+
 // CreateGRPC creates new configured grpc server
 func (a *ArgoCDRepoServer) CreateGRPC() *grpc.Server {
 	server := grpc.NewServer(a.opts...)
@@ -23,7 +23,8 @@ actions["scale"] = {
     ["iconClass"] = "fa fa-fw fa-plus-circle",
     ["params"] = {
         {
-            ["name"] = "replicas"
+            ["name"] = "replicas",
+            ["type"] = "int"
         }
     },
 }
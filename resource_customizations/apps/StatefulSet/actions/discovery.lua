@@ -8,7 +8,8 @@ actions["scale"] = {
     ["iconClass"] = "fa fa-fw fa-plus-circle",
     ["params"] = {
         {
-            ["name"] = "replicas"
+            ["name"] = "replicas",
+            ["type"] = "int"
         }
     },
 }
@@ -22,6 +22,7 @@ actions["paused-replicas"] = {
     ["params"] = {
         {
             ["name"] = "replicas",
+            ["type"] = "int",
             ["default"] = currentPausedReplicas or "0"
         }
     },
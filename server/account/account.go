@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -18,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	jwtutil "github.com/argoproj/argo-cd/v3/util/jwt"
 	"github.com/argoproj/argo-cd/v3/util/password"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -158,7 +160,7 @@ func toAPIAccount(name string, a settings.Account) *account.Account {
 	}
 	var tokens []*account.Token
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		tokens = append(tokens, toAPIToken(t))
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
@@ -171,6 +173,10 @@ func toAPIAccount(name string, a settings.Account) *account.Account {
 	}
 }
 
+func toAPIToken(t settings.Token) *account.Token {
+	return &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, Audience: t.Audience, RevokedAt: t.RevokedAt}
+}
+
 func (s *Server) ensureHasAccountPermission(ctx context.Context, action string, account string) error {
 	id := session.GetUserIdentifier(ctx)
 
@@ -219,6 +225,15 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
 		return nil, fmt.Errorf("permission denied to create token for account %s: %w", r.Name, err)
 	}
+	if r.ExpiresIn > 0 && r.ExpiresAt > 0 {
+		return nil, status.Error(codes.InvalidArgument, "only one of expiresIn and expiresAt can be specified")
+	}
+	if r.ExpiresAt > 0 && r.ExpiresAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "expiresAt must be in the future")
+	}
+	if slices.ContainsFunc(r.Audience, func(aud string) bool { return strings.TrimSpace(aud) == "" }) {
+		return nil, status.Error(codes.InvalidArgument, "audience cannot contain empty values")
+	}
 
 	id := r.Id
 	if id == "" {
@@ -239,20 +254,25 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 		}
 
 		now := time.Now()
+		expiresIn := r.ExpiresIn
+		if r.ExpiresAt > 0 {
+			expiresIn = r.ExpiresAt - now.Unix()
+		}
 		var err error
-		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id)
+		tokenString, err = s.sessionMgr.CreateWithAudience(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), expiresIn, id, r.Audience)
 		if err != nil {
 			return err
 		}
 
 		var expiresAt int64
-		if r.ExpiresIn > 0 {
-			expiresAt = now.Add(time.Duration(r.ExpiresIn) * time.Second).Unix()
+		if expiresIn > 0 {
+			expiresAt = now.Add(time.Duration(expiresIn) * time.Second).Unix()
 		}
 		account.Tokens = append(account.Tokens, settings.Token{
 			ID:        id,
 			IssuedAt:  now.Unix(),
 			ExpiresAt: expiresAt,
+			Audience:  r.Audience,
 		})
 		return nil
 	})
@@ -280,3 +300,82 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+// ListTokens returns the tokens of an account, including the revoked ones
+func (s *Server) ListTokens(ctx context.Context, r *account.ListTokensRequest) (*account.TokensList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to list tokens of account %s: %w", r.Name, err)
+	}
+	a, err := s.settingsMgr.GetAccount(r.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %s: %w", r.Name, err)
+	}
+	return &account.TokensList{Items: toAPIAccount(r.Name, *a).Tokens}, nil
+}
+
+// RevokeToken revokes a token. Unlike deleted tokens, revoked tokens are kept in the tokens of the account.
+func (s *Server) RevokeToken(ctx context.Context, r *account.RevokeTokenRequest) (*account.EmptyResponse, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionUpdate, r.Name); err != nil {
+		return nil, fmt.Errorf("permission denied to revoke token of account %s: %w", r.Name, err)
+	}
+
+	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+		index := account.TokenIndex(r.Id)
+		if index == -1 {
+			return status.Errorf(codes.NotFound, "token with id '%s' does not exist", r.Id)
+		}
+		if account.Tokens[index].RevokedAt == 0 {
+			account.Tokens[index].RevokedAt = time.Now().Unix()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke token of account %s: %w", r.Name, err)
+	}
+	return &account.EmptyResponse{}, nil
+}
+
+// IntrospectToken returns the account, expiry, audience and status of a token issued to a local account. The token is
+// active if it is accepted by this Argo CD instance.
+func (s *Server) IntrospectToken(ctx context.Context, r *account.IntrospectTokenRequest) (*account.TokenIntrospection, error) {
+	var claims jwt.MapClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(r.Token, &claims); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse token: %v", err)
+	}
+	if jwtutil.StringField(claims, "iss") != session.SessionManagerClaimsIssuer {
+		return nil, status.Error(codes.InvalidArgument, "only tokens issued by Argo CD can be introspected")
+	}
+	subject := jwtutil.StringField(claims, "sub")
+	if _, _, ok := rbacpolicy.GetProjectRoleFromSubject(subject); ok {
+		return nil, status.Error(codes.InvalidArgument, "only tokens of local accounts can be introspected")
+	}
+	name, capability := session.GetSubjectAccountAndCapability(subject)
+	if err := s.ensureHasAccountPermission(ctx, rbac.ActionGet, name); err != nil {
+		return nil, fmt.Errorf("permission denied to introspect token of account %s: %w", name, err)
+	}
+
+	res := &account.TokenIntrospection{Name: name, Capability: string(capability)}
+	id := jwtutil.StringField(claims, "jti")
+	if a, err := s.settingsMgr.GetAccount(name); err == nil && a.TokenIndex(id) > -1 {
+		res.Token = toAPIToken(a.Tokens[a.TokenIndex(id)])
+	} else {
+		// tokens of the login capability are not stored in the account
+		res.Token = &account.Token{Id: id}
+		if iat, err := jwtutil.IssuedAtTime(claims); err == nil {
+			res.Token.IssuedAt = iat.Unix()
+		}
+		if exp, err := jwtutil.ExpirationTime(claims); err == nil {
+			res.Token.ExpiresAt = exp.Unix()
+		}
+		if audience, err := claims.GetAudience(); err == nil {
+			res.Token.Audience = audience
+		}
+	}
+
+	if _, _, err := s.sessionMgr.Parse(r.Token); err != nil {
+		res.Reason = err.Error()
+	} else {
+		res.Active = true
+	}
+	return res, nil
+}
@@ -155,6 +155,25 @@ func TestUpdatePassword_AdminUpdatesAnotherUser(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestUpdatePassword_KubernetesUserUpdatesAnotherUser(t *testing.T) {
+	t.Parallel()
+	accountServer, sessionServer := newTestAccountServer(t, t.Context(), func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.anotherUser"] = "login"
+	})
+	//nolint:staticcheck
+	ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{
+		"iss": sessionutil.KubernetesClaimsIssuer,
+		"sub": "kubernetes-admin",
+		"iat": time.Now().Unix(),
+	})
+
+	_, err := accountServer.UpdatePassword(ctx, &account.UpdatePasswordRequest{NewPassword: "newpassword", Name: "anotherUser"})
+	require.NoError(t, err)
+
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "anotherUser", Password: "newpassword"})
+	require.NoError(t, err)
+}
+
 func TestUpdatePassword_DoesNotHavePermissions(t *testing.T) {
 	t.Parallel()
 	enforcer := func(_ jwt.Claims, _ ...any) bool {
@@ -326,6 +345,152 @@ func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	assert.Empty(t, acc.Tokens)
 }
 
+func TestCreateToken_ExpiresAtAndAudience(t *testing.T) {
+	t.Parallel()
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	expiresAt := time.Now().Add(time.Hour).Unix()
+	res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "test", ExpiresAt: expiresAt, Audience: []string{"https://argocd.example.com"}})
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(res.Token, &claims)
+	require.NoError(t, err)
+	audience, err := claims.GetAudience()
+	require.NoError(t, err)
+	assert.Equal(t, jwt.ClaimStrings{"https://argocd.example.com"}, audience)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.InDelta(t, expiresAt, acc.Tokens[0].ExpiresAt, 1)
+	assert.Equal(t, []string{"https://argocd.example.com"}, acc.Tokens[0].Audience)
+
+	t.Run("Both expiresIn and expiresAt", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresIn: 60, ExpiresAt: expiresAt})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("expiresAt in the past", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", ExpiresAt: time.Now().Add(-time.Hour).Unix()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Empty audience", func(t *testing.T) {
+		_, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Audience: []string{""}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListTokens(t *testing.T) {
+	t.Parallel()
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, secret *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		secret.Data["accounts.account1.tokens"] = []byte(`[{"id":"123","iat":1583789194},{"id":"456","iat":1583789195,"revokedAt":1583789196}]`)
+	})
+
+	tokens, err := accountServer.ListTokens(ctx, &account.ListTokensRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, tokens.Items, 2)
+	assert.Equal(t, "456", tokens.Items[0].Id)
+	assert.Equal(t, int64(1583789196), tokens.Items[0].RevokedAt)
+	assert.Equal(t, "123", tokens.Items[1].Id)
+	assert.Zero(t, tokens.Items[1].RevokedAt)
+}
+
+func TestRevokeToken(t *testing.T) {
+	t.Parallel()
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "test"})
+	require.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(res.Token)
+	require.NoError(t, err)
+
+	_, err = accountServer.RevokeToken(ctx, &account.RevokeTokenRequest{Name: "account1", Id: "test"})
+	require.NoError(t, err)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	revokedAt := acc.Tokens[0].RevokedAt
+	assert.NotZero(t, revokedAt)
+
+	_, _, err = accountServer.sessionMgr.Parse(res.Token)
+	require.ErrorContains(t, err, "token with id test of account account1 is revoked")
+
+	// revoking a token again keeps the time it was first revoked
+	_, err = accountServer.RevokeToken(ctx, &account.RevokeTokenRequest{Name: "account1", Id: "test"})
+	require.NoError(t, err)
+	acc, err = accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	assert.Equal(t, revokedAt, acc.Tokens[0].RevokedAt)
+
+	_, err = accountServer.RevokeToken(ctx, &account.RevokeTokenRequest{Name: "account1", Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestIntrospectToken(t *testing.T) {
+	t.Parallel()
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServer(t, ctx, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+		cm.Data["url"] = "https://argocd.example.com"
+	})
+
+	res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Id: "test", Audience: []string{"https://argocd.example.com"}})
+	require.NoError(t, err)
+
+	introspection, err := accountServer.IntrospectToken(ctx, &account.IntrospectTokenRequest{Token: res.Token})
+	require.NoError(t, err)
+	assert.True(t, introspection.Active)
+	assert.Empty(t, introspection.Reason)
+	assert.Equal(t, "account1", introspection.Name)
+	assert.Equal(t, string(settings.AccountCapabilityApiKey), introspection.Capability)
+	require.NotNil(t, introspection.Token)
+	assert.Equal(t, "test", introspection.Token.Id)
+	assert.Equal(t, []string{"https://argocd.example.com"}, introspection.Token.Audience)
+
+	_, err = accountServer.RevokeToken(ctx, &account.RevokeTokenRequest{Name: "account1", Id: "test"})
+	require.NoError(t, err)
+	introspection, err = accountServer.IntrospectToken(ctx, &account.IntrospectTokenRequest{Token: res.Token})
+	require.NoError(t, err)
+	assert.False(t, introspection.Active)
+	assert.Contains(t, introspection.Reason, "is revoked")
+	assert.NotZero(t, introspection.Token.RevokedAt)
+
+	t.Run("Invalid token", func(t *testing.T) {
+		_, err := accountServer.IntrospectToken(ctx, &account.IntrospectTokenRequest{Token: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("Token of another issuer", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{Issuer: "https://dex.example.com", Subject: "account1"}).SignedString([]byte("test"))
+		require.NoError(t, err)
+		_, err = accountServer.IntrospectToken(ctx, &account.IntrospectTokenRequest{Token: token})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestIntrospectToken_DoesNotHavePermissions(t *testing.T) {
+	t.Parallel()
+	ctx := adminContext(t.Context())
+	accountServer, _ := newTestAccountServerExt(t, ctx, func(_ jwt.Claims, _ ...any) bool {
+		return false
+	}, func(cm *corev1.ConfigMap, _ *corev1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	token, err := accountServer.sessionMgr.Create("account1:apiKey", 0, "test")
+	require.NoError(t, err)
+	_, err = accountServer.IntrospectToken(ctx, &account.IntrospectTokenRequest{Token: token})
+	assert.ErrorContains(t, err, "permission denied")
+}
+
 func TestCanI_GetLogsAllow(t *testing.T) {
 	t.Parallel()
 	accountServer, _ := newTestAccountServer(t, t.Context(), func(_ *corev1.ConfigMap, _ *corev1.Secret) {
@@ -0,0 +1,192 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// Path is the path the validating admission webhook is served at
+	Path = "/api/admission/validate"
+
+	// maxRequestSize is the maximum size of an admission review
+	maxRequestSize = 3 * 1024 * 1024
+)
+
+// errInvalid is returned when an object fails validation, and is reported to the client as the reason the object
+// is rejected. All other errors are returned to the API server, which then applies the failure policy of the webhook.
+type errInvalid struct {
+	message string
+}
+
+func (e *errInvalid) Error() string {
+	return e.message
+}
+
+func invalidf(format string, args ...any) error {
+	return &errInvalid{message: fmt.Sprintf(format, args...)}
+}
+
+// Handler serves the validating admission webhook for applications and projects. It rejects objects which would
+// otherwise only be reported as invalid by the application controller, so that the errors are returned to the client
+// creating or updating the object.
+type Handler struct {
+	namespace             string
+	applicationNamespaces []string
+	projLister            applisters.AppProjectLister
+	settingsMgr           *settings.SettingsManager
+	db                    db.ArgoDB
+}
+
+// NewHandler returns a handler for the validating admission webhook.
+func NewHandler(namespace string, applicationNamespaces []string, projLister applisters.AppProjectLister, settingsMgr *settings.SettingsManager, argoDB db.ArgoDB) *Handler {
+	return &Handler{
+		namespace:             namespace,
+		applicationNamespaces: applicationNamespaces,
+		projLister:            projLister,
+		settingsMgr:           settingsMgr,
+		db:                    argoDB,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	var review admissionv1.AdmissionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil || review.Request == nil {
+		http.Error(w, "Invalid admission review", http.StatusBadRequest)
+		return
+	}
+
+	resp := &admissionv1.AdmissionResponse{UID: review.Request.UID, Allowed: true}
+	if err := h.validate(r.Context(), review.Request); err != nil {
+		var invalidErr *errInvalid
+		if !errors.As(err, &invalidErr) {
+			log.Errorf("Failed to validate %s %s/%s: %v", review.Request.Kind.Kind, review.Request.Namespace, review.Request.Name, err)
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		resp.Allowed = false
+		resp.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonInvalid,
+			Code:    http.StatusUnprocessableEntity,
+			Message: invalidErr.message,
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&admissionv1.AdmissionReview{TypeMeta: review.TypeMeta, Response: resp})
+}
+
+// validate returns an error if the object of the request is invalid
+func (h *Handler) validate(ctx context.Context, req *admissionv1.AdmissionRequest) error {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return nil
+	}
+	switch req.Kind.Kind {
+	case application.ApplicationKind:
+		if !security.IsNamespaceEnabled(req.Namespace, h.namespace, h.applicationNamespaces) {
+			return nil
+		}
+		var app, oldApp v1alpha1.Application
+		if err := decodeStrict(req.Object.Raw, &app); err != nil {
+			return invalidf("application %s is invalid: %v", req.Name, err)
+		}
+		// only changes of the spec and of the managed-by-url annotation are validated, so that updates of the status
+		// or of other annotations, such as the removal of the refresh annotation, are admitted for applications which
+		// were admitted before
+		if req.Operation == admissionv1.Update && json.Unmarshal(req.OldObject.Raw, &oldApp) == nil &&
+			reflect.DeepEqual(app.Spec, oldApp.Spec) &&
+			app.Annotations[v1alpha1.AnnotationKeyManagedByURL] == oldApp.Annotations[v1alpha1.AnnotationKeyManagedByURL] {
+			return nil
+		}
+		return h.validateApplication(ctx, &app)
+	case application.AppProjectKind:
+		if req.Namespace != h.namespace {
+			return nil
+		}
+		var proj v1alpha1.AppProject
+		if err := decodeStrict(req.Object.Raw, &proj); err != nil {
+			return invalidf("project %s is invalid: %v", req.Name, err)
+		}
+		if err := proj.ValidateProject(); err != nil {
+			return invalidf("project %s is invalid: %v", proj.Name, err)
+		}
+		if len(proj.Spec.OperationWebhooks) > 0 {
+			allowedURLs, err := h.settingsMgr.GetOperationWebhookAllowedURLs()
+			if err != nil {
+				return fmt.Errorf("error getting the URLs permitted for operation webhooks: %w", err)
+			}
+			if err := proj.ValidateOperationWebhookURLs(allowedURLs); err != nil {
+				return invalidf("project %s is invalid: %v", proj.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateApplication performs the checks the API server performs when an application is created or updated through
+// the Argo CD API, except for the validation of the repository, which requires the repo server
+func (h *Handler) validateApplication(ctx context.Context, app *v1alpha1.Application) error {
+	if app.Spec.HasMultipleSources() {
+		sourceNames := make(map[string]bool)
+		for _, source := range app.Spec.Sources {
+			if source.Name != "" && sourceNames[source.Name] {
+				return invalidf("application %s has duplicate source name: %s", app.Name, source.Name)
+			}
+			sourceNames[source.Name] = true
+		}
+	}
+	// the primary destination of an application with multiple destinations is validated as its destination
+	if app.Spec.HasMultipleDestinations() {
+		app.Spec.Destination = app.Spec.Destinations[0]
+	}
+
+	proj, err := argo.GetAppProject(ctx, app, h.projLister, h.namespace, h.settingsMgr, h.db)
+	if err != nil {
+		var notAllowedErr *argo.ErrApplicationNotAllowedToUseProject
+		if errors.As(err, &notAllowedErr) || apierrors.IsNotFound(err) {
+			return invalidf("application %s is invalid: %v", app.Name, err)
+		}
+		return err
+	}
+
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, h.db)
+	if err != nil {
+		return fmt.Errorf("error validating project permissions: %w", err)
+	}
+	conditions = append(conditions, argo.ValidateDestinationServiceAccount(ctx, app, proj, h.db)...)
+	conditions = append(conditions, argo.ValidateManagedByURL(app)...)
+	if len(conditions) > 0 {
+		return invalidf("application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
+	}
+	return nil
+}
+
+// decodeStrict decodes the object and returns an error if it has fields which are not part of its schema
+func decodeStrict(data []byte, obj any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(obj)
+}
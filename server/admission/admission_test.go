@@ -0,0 +1,248 @@
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const testNamespace = "argocd"
+
+func newTestHandler(t *testing.T) *Handler {
+	t.Helper()
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data:       map[string][]byte{"server.secretkey": []byte("test")},
+		},
+	)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(&v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"https://github.com/argoproj/*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "https://kubernetes.default.svc", Namespace: "guestbook"}},
+		},
+	}))
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	return NewHandler(testNamespace, []string{"team-*"}, applisters.NewAppProjectLister(indexer), settingsMgr, db.NewDB(testNamespace, settingsMgr, kubeClient))
+}
+
+func newTestApp(mutate func(app *v1alpha1.Application)) *v1alpha1.Application {
+	app := &v1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     "default",
+			Source:      &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps", Path: "guestbook"},
+			Destination: v1alpha1.ApplicationDestination{Server: "https://kubernetes.default.svc", Namespace: "guestbook"},
+		},
+	}
+	if mutate != nil {
+		mutate(app)
+	}
+	return app
+}
+
+func marshal(t *testing.T, obj any) runtime.RawExtension {
+	t.Helper()
+	data, err := json.Marshal(obj)
+	require.NoError(t, err)
+	return runtime.RawExtension{Raw: data}
+}
+
+func review(t *testing.T, h http.Handler, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	t.Helper()
+	req.UID = "test-uid"
+	data, err := json.Marshal(&admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request:  req,
+	})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, bytes.NewReader(data)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp admissionv1.AdmissionReview
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "AdmissionReview", resp.Kind)
+	require.NotNil(t, resp.Response)
+	assert.Equal(t, req.UID, resp.Response.UID)
+	return resp.Response
+}
+
+func appRequest(t *testing.T, op admissionv1.Operation, app *v1alpha1.Application) *admissionv1.AdmissionRequest {
+	t.Helper()
+	return &admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"},
+		Namespace: app.Namespace,
+		Name:      app.Name,
+		Operation: op,
+		Object:    marshal(t, app),
+	}
+}
+
+func TestHandler_Application(t *testing.T) {
+	h := newTestHandler(t)
+
+	t.Run("Valid", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(nil)))
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("SourceNotPermitted", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source.RepoURL = "https://example.com/other.git"
+		})))
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, metav1.StatusReasonInvalid, resp.Result.Reason)
+		assert.Contains(t, resp.Result.Message, "application repo https://example.com/other.git is not permitted in project 'default'")
+	})
+
+	t.Run("DestinationNotPermitted", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Destination.Namespace = "kube-system"
+		})))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, "do not match any of the allowed destinations in project 'default'")
+	})
+
+	t.Run("MissingProject", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "missing"
+		})))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, `app project "missing"`)
+	})
+
+	t.Run("DuplicateSourceName", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(func(app *v1alpha1.Application) {
+			source := *app.Spec.Source
+			source.Name = "app"
+			app.Spec.Source = nil
+			app.Spec.Sources = v1alpha1.ApplicationSources{source, source}
+		})))
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, "duplicate source name: app")
+	})
+
+	t.Run("UnknownField", func(t *testing.T) {
+		req := appRequest(t, admissionv1.Create, newTestApp(nil))
+		req.Object.Raw = bytes.Replace(req.Object.Raw, []byte(`"project":`), []byte(`"projekt":"default","project":`), 1)
+		resp := review(t, h, req)
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, `unknown field "projekt"`)
+	})
+
+	t.Run("StatusUpdate", func(t *testing.T) {
+		invalid := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "missing"
+		})
+		req := appRequest(t, admissionv1.Update, invalid)
+		req.OldObject = marshal(t, invalid)
+		resp := review(t, h, req)
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("RefreshAnnotationRemoved", func(t *testing.T) {
+		invalid := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "missing"
+		})
+		old := invalid.DeepCopy()
+		old.Annotations = map[string]string{v1alpha1.AnnotationKeyRefresh: string(v1alpha1.RefreshTypeNormal)}
+		req := appRequest(t, admissionv1.Update, invalid)
+		req.OldObject = marshal(t, old)
+		resp := review(t, h, req)
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("ManagedByURLUpdate", func(t *testing.T) {
+		app := newTestApp(func(app *v1alpha1.Application) {
+			app.Annotations = map[string]string{v1alpha1.AnnotationKeyManagedByURL: "javascript:alert(1)"}
+		})
+		req := appRequest(t, admissionv1.Update, app)
+		req.OldObject = marshal(t, newTestApp(nil))
+		resp := review(t, h, req)
+		assert.False(t, resp.Allowed)
+		assert.Contains(t, resp.Result.Message, "invalid managed-by URL")
+	})
+
+	t.Run("NamespaceNotEnabled", func(t *testing.T) {
+		resp := review(t, h, appRequest(t, admissionv1.Create, newTestApp(func(app *v1alpha1.Application) {
+			app.Namespace = "other"
+			app.Spec.Project = "missing"
+		})))
+		assert.True(t, resp.Allowed)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		req := appRequest(t, admissionv1.Delete, newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Project = "missing"
+		}))
+		resp := review(t, h, req)
+		assert.True(t, resp.Allowed)
+	})
+}
+
+func TestHandler_AppProject(t *testing.T) {
+	h := newTestHandler(t)
+	projRequest := func(proj *v1alpha1.AppProject) *admissionv1.AdmissionRequest {
+		return &admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "AppProject"},
+			Namespace: proj.Namespace,
+			Name:      proj.Name,
+			Operation: admissionv1.Create,
+			Object:    marshal(t, proj),
+		}
+	}
+
+	valid := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: testNamespace},
+		Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+	}
+	assert.True(t, review(t, h, projRequest(valid)).Allowed)
+
+	invalid := valid.DeepCopy()
+	invalid.Spec.Roles = []v1alpha1.ProjectRole{{Name: "admin"}, {Name: "admin"}}
+	resp := review(t, h, projRequest(invalid))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "project team is invalid")
+
+	webhookNotPermitted := valid.DeepCopy()
+	webhookNotPermitted.Spec.OperationWebhooks = []v1alpha1.OperationWebhook{{Name: "metadata", URL: "http://169.254.169.254/latest"}}
+	resp = review(t, h, projRequest(webhookNotPermitted))
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, "operation webhook 'metadata' URL 'http://169.254.169.254/latest' is not permitted")
+}
+
+func TestHandler_InvalidRequest(t *testing.T) {
+	h := newTestHandler(t)
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, bytes.NewReader([]byte(`{}`))))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,63 @@
+package admission
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha2"
+)
+
+// ConversionPath is the path the conversion webhook of the application CRD is served at
+const ConversionPath = "/api/admission/convert"
+
+// ServeConversion serves the conversion webhook, which converts applications between the v1alpha1 and v1alpha2 API
+// versions.
+func ServeConversion(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	var review apiextensionsv1.ConversionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil || review.Request == nil {
+		http.Error(w, "Invalid conversion review", http.StatusBadRequest)
+		return
+	}
+
+	resp := &apiextensionsv1.ConversionResponse{UID: review.Request.UID, Result: metav1.Status{Status: metav1.StatusSuccess}}
+	objects, err := convertObjects(review.Request.Objects, review.Request.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+	} else {
+		resp.ConvertedObjects = objects
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&apiextensionsv1.ConversionReview{TypeMeta: review.TypeMeta, Response: resp})
+}
+
+// convertObjects converts the objects of a conversion review to the given API version
+func convertObjects(objects []runtime.RawExtension, apiVersion string) ([]runtime.RawExtension, error) {
+	converted := make([]runtime.RawExtension, 0, len(objects))
+	for _, raw := range objects {
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(raw.Raw); err != nil {
+			return nil, fmt.Errorf("error decoding object: %w", err)
+		}
+		out, err := v1alpha2.Convert(&obj, apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error converting %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+		}
+		data, err := out.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("error encoding object: %w", err)
+		}
+		converted = append(converted, runtime.RawExtension{Raw: data})
+	}
+	return converted, nil
+}
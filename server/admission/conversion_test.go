@@ -0,0 +1,56 @@
+package admission
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha2"
+)
+
+func TestServeConversion(t *testing.T) {
+	app := newTestApp(nil)
+	data, err := json.Marshal(&apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               "test-uid",
+			DesiredAPIVersion: "argoproj.io/v1alpha2",
+			Objects:           []runtime.RawExtension{marshal(t, app)},
+		},
+	})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	ServeConversion(rec, httptest.NewRequest(http.MethodPost, ConversionPath, bytes.NewReader(data)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var review apiextensionsv1.ConversionReview
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &review))
+	require.NotNil(t, review.Response)
+	assert.Equal(t, "test-uid", string(review.Response.UID))
+	assert.Equal(t, metav1.StatusSuccess, review.Response.Result.Status)
+	require.Len(t, review.Response.ConvertedObjects, 1)
+
+	var converted v1alpha2.Application
+	require.NoError(t, json.Unmarshal(review.Response.ConvertedObjects[0].Raw, &converted))
+	assert.Equal(t, "argoproj.io/v1alpha2", converted.APIVersion)
+	assert.Equal(t, []v1alpha1.ApplicationSource{*app.Spec.Source}, converted.Spec.Sources)
+
+	review.Request = &apiextensionsv1.ConversionRequest{UID: "test-uid", DesiredAPIVersion: "argoproj.io/v1beta1", Objects: []runtime.RawExtension{marshal(t, app)}}
+	review.Response = nil
+	data, err = json.Marshal(&review)
+	require.NoError(t, err)
+	rec = httptest.NewRecorder()
+	ServeConversion(rec, httptest.NewRequest(http.MethodPost, ConversionPath, bytes.NewReader(data)))
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &review))
+	assert.Equal(t, metav1.StatusFailure, review.Response.Result.Status)
+	assert.Contains(t, review.Response.Result.Message, "cannot convert argoproj.io/v1alpha1 to argoproj.io/v1beta1")
+}
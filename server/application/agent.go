@@ -0,0 +1,137 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/server/cluster"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// UpdateAgentStatus updates the status of an application deployed to a pull mode cluster by its agent. The application
+// controller does not reconcile these applications, so the status reported by the agent is stored as is. Rather than
+// the permission to update the application, the caller needs the permission to report the status of the applications
+// of the destination cluster, so that the agent of a cluster cannot report the status of the applications of others.
+func (s *Server) UpdateAgentStatus(ctx context.Context, q *application.ApplicationAgentStatusRequest) (*v1alpha1.Application, error) {
+	appName := q.GetName()
+	appNs := s.appNamespaceOrDefault(q.GetAppNamespace())
+	a, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionGet, q.GetProject(), appNs, appName, "")
+	if err != nil {
+		return nil, err
+	}
+	syncStatus := v1alpha1.SyncStatusCode(q.GetSyncStatus())
+	switch syncStatus {
+	case v1alpha1.SyncStatusCodeSynced, v1alpha1.SyncStatusCodeOutOfSync, v1alpha1.SyncStatusCodeUnknown:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sync status %q", syncStatus)
+	}
+	operationPhase := synccommon.OperationPhase(q.GetOperationPhase())
+	switch operationPhase {
+	case "", synccommon.OperationSucceeded, synccommon.OperationFailed:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operation phase %q", operationPhase)
+	}
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, proj, s.db)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "error getting destination cluster: %v", err)
+	}
+	if !destCluster.IsPullMode() || destCluster.Name != q.GetCluster() {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %s is not deployed to the pull mode cluster %q", appName, q.GetCluster())
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionReport, cluster.CreateClusterRBACObject(destCluster.Project, destCluster.Server)); err != nil {
+		return nil, err
+	}
+
+	for range 10 {
+		setAgentStatus(a, q, syncStatus)
+		if operationPhase != "" && a.Operation != nil {
+			setAgentOperationState(a, q, operationPhase)
+		}
+		if q.GetResourcesDeleted() && a.DeletionTimestamp != nil {
+			a.UnSetCascadedDeletion()
+		}
+		updated, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Update(ctx, a, metav1.UpdateOptions{})
+		if err == nil {
+			return updated, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		log.Warnf("failed to update agent status of app %q due to update conflict. retrying again...", appName)
+		time.Sleep(100 * time.Millisecond)
+		a, err = s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, appName, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application by name: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update agent status of app. Too many conflicts")
+}
+
+// setAgentStatus sets the status of the application to the status reported by the agent
+func setAgentStatus(a *v1alpha1.Application, q *application.ApplicationAgentStatusRequest, syncStatus v1alpha1.SyncStatusCode) {
+	now := metav1.Now()
+	a.Status.Sync = v1alpha1.SyncStatus{
+		Status:   syncStatus,
+		Revision: q.GetRevision(),
+		ComparedTo: v1alpha1.ComparedTo{
+			Destination: a.Spec.Destination,
+		},
+	}
+	if a.Spec.HasMultipleSources() {
+		a.Status.Sync.ComparedTo.Sources = a.Spec.Sources
+	} else {
+		a.Status.Sync.ComparedTo.Source = a.Spec.GetSource()
+	}
+	if health := q.GetHealth(); health != nil {
+		if a.Status.Health.Status != health.Status {
+			a.Status.Health.LastTransitionTime = &now
+		}
+		a.Status.Health.Status = health.Status
+		a.Status.Health.Message = health.Message
+	}
+	a.Status.Resources = make([]v1alpha1.ResourceStatus, 0, len(q.GetResources()))
+	for _, res := range q.GetResources() {
+		if res != nil {
+			a.Status.Resources = append(a.Status.Resources, *res)
+		}
+	}
+	a.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationInline
+	a.Status.ReconciledAt = &now
+
+	var conditions []v1alpha1.ApplicationCondition
+	if q.GetError() != "" {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionSyncError, Message: q.GetError(), LastTransitionTime: &now})
+	}
+	a.Status.SetConditions(conditions, map[v1alpha1.ApplicationConditionType]bool{v1alpha1.ApplicationConditionSyncError: true})
+}
+
+// setAgentOperationState completes the requested operation of the application with the outcome reported by the agent
+func setAgentOperationState(a *v1alpha1.Application, q *application.ApplicationAgentStatusRequest, phase synccommon.OperationPhase) {
+	now := metav1.Now()
+	syncResult := &v1alpha1.SyncOperationResult{Revision: q.GetRevision()}
+	if a.Spec.HasMultipleSources() {
+		syncResult.Sources = a.Spec.Sources
+	} else {
+		syncResult.Source = a.Spec.GetSource()
+	}
+	a.Status.OperationState = &v1alpha1.OperationState{
+		Operation:  *a.Operation,
+		Phase:      phase,
+		Message:    q.GetOperationMessage(),
+		SyncResult: syncResult,
+		StartedAt:  now,
+		FinishedAt: &now,
+	}
+	a.Operation = nil
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+func TestUpdateAgentStatus(t *testing.T) {
+	pullApp := newTestAppWithDestName(func(app *v1alpha1.Application) {
+		app.Name = "pull-app"
+		app.Spec.Destination.Name = "spoke"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionSyncError, Message: "previous error"}}
+	})
+	operationApp := newTestAppWithDestName(func(app *v1alpha1.Application) {
+		app.Name = "operation-app"
+		app.Spec.Destination.Name = "spoke"
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "abc123"}}
+	})
+	deletingApp := newTestAppWithDestName(func(app *v1alpha1.Application) {
+		app.Name = "deleting-app"
+		app.Spec.Destination.Name = "spoke"
+		app.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		app.Finalizers = []string{v1alpha1.ResourcesFinalizerName}
+	})
+	pushApp := newTestApp()
+	appServer := newTestAppServer(t, pullApp, operationApp, deletingApp, pushApp)
+	_, err := appServer.db.CreateCluster(t.Context(), &v1alpha1.Cluster{
+		Server: "https://spoke.agent.invalid",
+		Name:   "spoke",
+		Labels: map[string]string{common.LabelKeyClusterAgentMode: common.LabelValueClusterAgentModePull},
+	})
+	require.NoError(t, err)
+
+	newRequest := func(app *v1alpha1.Application, cluster string, syncStatus v1alpha1.SyncStatusCode) *application.ApplicationAgentStatusRequest {
+		return &application.ApplicationAgentStatusRequest{
+			Name:       ptr.To(app.Name),
+			Cluster:    ptr.To(cluster),
+			SyncStatus: ptr.To(string(syncStatus)),
+			Revision:   ptr.To("abc123"),
+			Health:     &v1alpha1.HealthStatus{Status: health.HealthStatusProgressing},
+			Resources: []*v1alpha1.ResourceStatus{{
+				Version: "v1",
+				Kind:    "ConfigMap",
+				Name:    "my-config",
+				Status:  v1alpha1.SyncStatusCodeSynced,
+				Health:  &v1alpha1.HealthStatus{Status: health.HealthStatusHealthy},
+			}},
+		}
+	}
+
+	t.Run("Synced", func(t *testing.T) {
+		updated, err := appServer.UpdateAgentStatus(t.Context(), newRequest(pullApp, "spoke", v1alpha1.SyncStatusCodeSynced))
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.SyncStatusCodeSynced, updated.Status.Sync.Status)
+		assert.Equal(t, "abc123", updated.Status.Sync.Revision)
+		assert.Equal(t, pullApp.Spec.Destination, updated.Status.Sync.ComparedTo.Destination)
+		assert.Equal(t, health.HealthStatusProgressing, updated.Status.Health.Status)
+		require.Len(t, updated.Status.Resources, 1)
+		assert.Equal(t, "my-config", updated.Status.Resources[0].Name)
+		assert.NotNil(t, updated.Status.ReconciledAt)
+		assert.Empty(t, updated.Status.Conditions)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		req := newRequest(pullApp, "spoke", v1alpha1.SyncStatusCodeUnknown)
+		req.Error = ptr.To("ConfigMap/my-config: forbidden")
+		updated, err := appServer.UpdateAgentStatus(t.Context(), req)
+		require.NoError(t, err)
+		require.Len(t, updated.Status.Conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionSyncError, updated.Status.Conditions[0].Type)
+		assert.Equal(t, "ConfigMap/my-config: forbidden", updated.Status.Conditions[0].Message)
+	})
+
+	t.Run("Operation", func(t *testing.T) {
+		req := newRequest(operationApp, "spoke", v1alpha1.SyncStatusCodeSynced)
+		req.OperationPhase = ptr.To(string(synccommon.OperationSucceeded))
+		req.OperationMessage = ptr.To("successfully synced")
+		updated, err := appServer.UpdateAgentStatus(t.Context(), req)
+		require.NoError(t, err)
+		assert.Nil(t, updated.Operation)
+		require.NotNil(t, updated.Status.OperationState)
+		assert.Equal(t, synccommon.OperationSucceeded, updated.Status.OperationState.Phase)
+		assert.Equal(t, "successfully synced", updated.Status.OperationState.Message)
+		assert.Equal(t, "abc123", updated.Status.OperationState.Operation.Sync.Revision)
+		assert.Equal(t, "abc123", updated.Status.OperationState.SyncResult.Revision)
+	})
+
+	t.Run("InvalidOperationPhase", func(t *testing.T) {
+		req := newRequest(operationApp, "spoke", v1alpha1.SyncStatusCodeSynced)
+		req.OperationPhase = ptr.To(string(synccommon.OperationRunning))
+		_, err := appServer.UpdateAgentStatus(t.Context(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("ResourcesDeleted", func(t *testing.T) {
+		req := newRequest(deletingApp, "spoke", v1alpha1.SyncStatusCodeUnknown)
+		req.ResourcesDeleted = ptr.To(true)
+		updated, err := appServer.UpdateAgentStatus(t.Context(), req)
+		require.NoError(t, err)
+		assert.NotContains(t, updated.Finalizers, v1alpha1.ResourcesFinalizerName)
+	})
+
+	t.Run("InvalidSyncStatus", func(t *testing.T) {
+		_, err := appServer.UpdateAgentStatus(t.Context(), newRequest(pullApp, "spoke", "Deleted"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("OtherCluster", func(t *testing.T) {
+		_, err := appServer.UpdateAgentStatus(t.Context(), newRequest(pullApp, "other", v1alpha1.SyncStatusCodeSynced))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("NotPullMode", func(t *testing.T) {
+		_, err := appServer.UpdateAgentStatus(t.Context(), newRequest(pushApp, "fake-cluster", v1alpha1.SyncStatusCodeSynced))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("ReportPermission", func(t *testing.T) {
+		agentServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(`p, role:agent, applications, *, */*, allow
+p, role:agent, clusters, report, https://other.agent.invalid, allow`)
+			enf.SetDefaultRole("role:agent")
+		}, map[string]string{}, pullApp.DeepCopy())
+		_, err := agentServer.db.CreateCluster(t.Context(), &v1alpha1.Cluster{
+			Server: "https://spoke.agent.invalid",
+			Name:   "spoke",
+			Labels: map[string]string{common.LabelKeyClusterAgentMode: common.LabelValueClusterAgentModePull},
+		})
+		require.NoError(t, err)
+		_, err = agentServer.UpdateAgentStatus(t.Context(), newRequest(pullApp, "spoke", v1alpha1.SyncStatusCodeSynced))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "updating the application does not allow reporting its status")
+	})
+}
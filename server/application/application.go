@@ -54,7 +54,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/deeplinks"
 	serverevents "github.com/argoproj/argo-cd/v3/server/events"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/app/writeback"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/askpass"
 	"github.com/argoproj/argo-cd/v3/util/collections"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/env"
@@ -62,6 +64,7 @@ import (
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/objectstore"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/session"
@@ -106,6 +109,12 @@ type Server struct {
 	projInformer           cache.SharedIndexInformer
 	enabledNamespaces      []string
 	syncWithReplaceAllowed bool
+	readOnly               bool
+	parameterWriter        writeback.Writer
+	snapshotStore          func(ctx context.Context) (objectstore.Store, error)
+	refreshLimiter         *refreshRateLimiter
+	apiLimiter             *apiRateLimiter
+	metricsRegistry        MetricsRegistry
 }
 
 // NewServer returns a new instance of the Application service
@@ -127,6 +136,8 @@ func NewServer(
 	enabledNamespaces []string,
 	enableK8sEvent []string,
 	syncWithReplaceAllowed bool,
+	readOnly bool,
+	metricsRegistry MetricsRegistry,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	if appBroadcaster == nil {
 		appBroadcaster = broadcast.NewHandler[v1alpha1.Application, v1alpha1.ApplicationWatchEvent](
@@ -160,7 +171,13 @@ func NewServer(
 		projInformer:           projInformer,
 		enabledNamespaces:      enabledNamespaces,
 		syncWithReplaceAllowed: syncWithReplaceAllowed,
+		readOnly:               readOnly,
+		parameterWriter:        writeback.NewGitWriter(askpass.APIServerSocketPath),
+		refreshLimiter:         newRefreshRateLimiter(),
+		apiLimiter:             newAPIRateLimiter(),
+		metricsRegistry:        metricsRegistry,
 	}
+	s.snapshotStore = s.newSnapshotStore
 	return s, s.getAppResources
 }
 
@@ -268,8 +285,11 @@ func (s *Server) getApplicationEnforceRBACInformer(ctx context.Context, action,
 
 // getApplicationEnforceRBACClient uses a client to get an Application. If the app does not exist, permission is denied,
 // or any other error occurs when getting the app, we return a permission denied error to obscure any sensitive
-// information.
+// information. Read-only replicas use the informer instead, so that they do not send any request to the Kubernetes API.
 func (s *Server) getApplicationEnforceRBACClient(ctx context.Context, action, project, namespace, name, resourceVersion string) (*v1alpha1.Application, *v1alpha1.AppProject, error) {
+	if s.readOnly {
+		return s.getApplicationEnforceRBACInformer(ctx, action, project, namespace, name)
+	}
 	namespaceOrDefault := s.appNamespaceOrDefault(namespace)
 	return s.getAppEnforceRBAC(ctx, action, project, namespaceOrDefault, name, func() (*v1alpha1.Application, error) {
 		if !s.isNamespaceEnabled(namespaceOrDefault) {
@@ -313,7 +333,18 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 	// Filter applications by source repo URL
 	filteredApps = argo.FilterByRepoP(filteredApps, q.GetRepo())
 
-	newItems := make([]v1alpha1.Application, 0)
+	// Filter applications by condition severities
+	severities := make([]v1alpha1.ApplicationConditionSeverity, 0, len(q.GetConditionSeverities()))
+	for _, name := range q.GetConditionSeverities() {
+		severity, err := v1alpha1.ParseApplicationConditionSeverity(name)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		severities = append(severities, severity)
+	}
+	filteredApps = argo.FilterByConditionSeveritiesP(filteredApps, severities)
+
+	permittedApps := make([]*v1alpha1.Application, 0, len(filteredApps))
 	for _, a := range filteredApps {
 		// Skip any application that is neither in the control plane's namespace
 		// nor in the list of enabled namespaces.
@@ -321,24 +352,36 @@ func (s *Server) List(ctx context.Context, q *application.ApplicationQuery) (*v1
 			continue
 		}
 		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
-			// Create a deep copy to ensure all metadata fields including annotations are preserved
-			appCopy := a.DeepCopy()
-			// Explicitly copy annotations in case DeepCopy does not preserve them
-			if a.Annotations != nil {
-				appCopy.Annotations = a.Annotations
-			}
-			newItems = append(newItems, *appCopy)
+			permittedApps = append(permittedApps, a)
 		}
 	}
 
-	// Sort found applications by name
-	sort.Slice(newItems, func(i, j int) bool {
-		return newItems[i].Name < newItems[j].Name
-	})
+	// Sort found applications and only keep the requested page
+	pageApps, continueToken, remaining, err := paginateApps(permittedApps, q)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	newItems := make([]v1alpha1.Application, 0, len(pageApps))
+	for _, a := range pageApps {
+		if q.GetMinimal() {
+			newItems = append(newItems, minimalApp(a))
+			continue
+		}
+		// Create a deep copy to ensure all metadata fields including annotations are preserved
+		appCopy := a.DeepCopy()
+		// Explicitly copy annotations in case DeepCopy does not preserve them
+		if a.Annotations != nil {
+			appCopy.Annotations = a.Annotations
+		}
+		newItems = append(newItems, *appCopy)
+	}
 
 	appList := v1alpha1.ApplicationList{
 		ListMeta: metav1.ListMeta{
-			ResourceVersion: s.appInformer.LastSyncResourceVersion(),
+			ResourceVersion:    s.appInformer.LastSyncResourceVersion(),
+			Continue:           continueToken,
+			RemainingItemCount: remaining,
 		},
 		Items: newItems,
 	}
@@ -526,34 +569,13 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		sources := make([]v1alpha1.ApplicationSource, 0)
-		appSpec := a.Spec
-		if a.Spec.HasMultipleSources() {
-			numOfSources := int64(len(a.Spec.GetSources()))
-			for i, pos := range q.SourcePositions {
-				if pos <= 0 || pos > numOfSources {
-					return errors.New("source position is out of range")
-				}
-				appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
-			}
-			sources = appSpec.GetSources()
-		} else {
-			// For sourceHydrator applications, use the dry source to generate manifests
-			var source v1alpha1.ApplicationSource
-			if a.Spec.SourceHydrator != nil {
-				source = a.Spec.SourceHydrator.GetDrySource()
-			} else {
-				source = a.Spec.GetSource()
-			}
-
-			if q.GetRevision() != "" {
-				source.TargetRevision = q.GetRevision()
-			}
-			sources = append(sources, source)
+		sources, hasMultipleSources, err := getManifestSources(a, q)
+		if err != nil {
+			return err
 		}
 
 		// Store the map of all sources having ref field into a map for applications with sources field
-		refSources, err := argo.GetRefSources(ctx, sources, appSpec.Project, s.db.GetRepository, []string{})
+		refSources, err := argo.GetRefSources(ctx, sources, a.Spec.Project, s.db.GetRepository, []string{})
 		if err != nil {
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
@@ -595,6 +617,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				Revision:                        source.TargetRevision,
 				AppLabelKey:                     appInstanceLabelKey,
 				AppName:                         a.InstanceName(s.ns),
+				SourceEnvironment:               a.Labels[v1alpha1.LabelKeySourceEnvironment],
 				Namespace:                       a.Spec.Destination.Namespace,
 				ApplicationSource:               &source,
 				Repos:                           repos,
@@ -607,7 +630,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 				EnabledSourceTypes:              enableGenerateManifests,
 				ProjectName:                     proj.Name,
 				ProjectSourceRepos:              proj.Spec.SourceRepos,
-				HasMultipleSources:              a.Spec.HasMultipleSources(),
+				HasMultipleSources:              hasMultipleSources,
 				RefSources:                      refSources,
 				AnnotationManifestGeneratePaths: a.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
 				InstallationID:                  installationID,
@@ -717,6 +740,7 @@ func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_Get
 			Revision:                        source.TargetRevision,
 			AppLabelKey:                     appInstanceLabelKey,
 			AppName:                         a.InstanceName(s.ns),
+			SourceEnvironment:               a.Labels[v1alpha1.LabelKeySourceEnvironment],
 			Namespace:                       a.Spec.Destination.Namespace,
 			ApplicationSource:               &source,
 			Repos:                           helmRepos,
@@ -799,10 +823,19 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 	}
 
 	if q.Refresh == nil {
+		if err := s.enforceAPIRateLimit(proj, apiOperationGet); err != nil {
+			return nil, err
+		}
 		s.inferResourcesStatusHealth(a)
 		return a.DeepCopy(), nil
 	}
 
+	if s.readOnly {
+		return nil, status.Error(codes.FailedPrecondition, "the API server is a read-only replica, send the refresh request to a read-write replica")
+	}
+	if err := s.enforceAPIRateLimit(proj, apiOperationRefresh); err != nil {
+		return nil, err
+	}
 	refreshType := v1alpha1.RefreshTypeNormal
 	if *q.Refresh == string(v1alpha1.RefreshTypeHard) {
 		refreshType = v1alpha1.RefreshTypeHard
@@ -825,7 +858,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 		hydrateType = &ht
 	}
 
-	app, err := argo.RefreshApp(appIf, appName, refreshType, hydrateType)
+	app, err := argo.RefreshAppWithPriority(appIf, appName, refreshType, s.getRefreshPriority(ctx), hydrateType)
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing the app: %w", err)
 	}
@@ -858,6 +891,7 @@ func (s *Server) Get(ctx context.Context, q *application.ApplicationQuery) (*v1a
 				Repo:               repo,
 				Source:             &source,
 				AppName:            appName,
+				SourceEnvironment:  app.Labels[v1alpha1.LabelKeySourceEnvironment],
 				KustomizeOptions:   kustomizeSettings,
 				Repos:              helmRepos,
 				NoCache:            true,
@@ -976,6 +1010,10 @@ func (s *Server) validateAndUpdateApp(ctx context.Context, newApp *v1alpha1.Appl
 		return nil, fmt.Errorf("error validating and normalizing app: %w", err)
 	}
 
+	if err = s.writeBackParameters(ctx, app, newApp); err != nil {
+		return nil, err
+	}
+
 	a, err := s.updateApp(ctx, app, newApp, merge)
 	if err != nil {
 		return nil, fmt.Errorf("error updating application: %w", err)
@@ -1133,6 +1171,123 @@ func (s *Server) Patch(ctx context.Context, q *application.ApplicationPatchReque
 	return s.validateAndUpdateApp(ctx, newApp, false, true, rbac.ActionUpdate, q.GetProject())
 }
 
+// PatchParameters updates the Helm parameters, Kustomize images or plugin environment of a single application source.
+// Unlike Patch, the change is re-applied on top of the latest application spec if a concurrent update occurs, unless
+// the caller pinned the request to a resource version.
+func (s *Server) PatchParameters(ctx context.Context, q *application.ApplicationPatchParametersRequest) (*v1alpha1.Application, error) {
+	if len(q.GetHelmParameters()) == 0 && len(q.GetKustomizeImages()) == 0 && len(q.GetPluginEnv()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one Helm parameter, Kustomize image or plugin env entry must be specified")
+	}
+
+	app, proj, err := s.getApplicationEnforceRBACClient(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	s.projectLock.RLock(app.Spec.GetProject())
+	defer s.projectLock.RUnlock(app.Spec.GetProject())
+
+	for range 10 {
+		if q.GetResourceVersion() != "" && q.GetResourceVersion() != app.ResourceVersion {
+			return nil, status.Errorf(codes.FailedPrecondition, "application %s has resource version %s, expected %s", app.QualifiedName(), app.ResourceVersion, q.GetResourceVersion())
+		}
+
+		newApp := app.DeepCopy()
+		source, err := getSourceForParameterPatch(&newApp.Spec, int(q.GetSourceIndex()))
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := patchSourceParameters(source, q); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := s.validateAndNormalizeApp(ctx, newApp, proj, true); err != nil {
+			return nil, fmt.Errorf("error validating and normalizing app: %w", err)
+		}
+
+		res, err := s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Update(ctx, newApp, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppEvent(ctx, res, argo.EventReasonResourceUpdated, fmt.Sprintf("updated parameters of source %d", q.GetSourceIndex()))
+			s.waitSync(res)
+			return res, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating application: %w", err)
+		}
+		if q.GetResourceVersion() != "" {
+			return nil, status.Errorf(codes.Aborted, "application %s was modified concurrently: %v", app.QualifiedName(), err)
+		}
+
+		app, err = s.appclientset.ArgoprojV1alpha1().Applications(app.Namespace).Get(ctx, app.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting application: %w", err)
+		}
+		// the permissions were only enforced against the project the application belonged to when it was first read
+		if app.Spec.GetProject() != proj.Name {
+			return nil, status.Errorf(codes.Aborted, "application %s was moved to project %s concurrently", app.QualifiedName(), app.Spec.GetProject())
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update application parameters. Too many conflicts")
+}
+
+// getSourceForParameterPatch returns a pointer to the source at the given index of the application spec, so it can be
+// modified in place.
+func getSourceForParameterPatch(spec *v1alpha1.ApplicationSpec, sourceIndex int) (*v1alpha1.ApplicationSource, error) {
+	switch {
+	case spec.SourceHydrator != nil:
+		return nil, errors.New("parameters of applications using the source hydrator cannot be patched")
+	case spec.HasMultipleSources():
+		if sourceIndex < 0 || sourceIndex >= len(spec.Sources) {
+			return nil, fmt.Errorf("source index %d is out of range, application has %d sources", sourceIndex, len(spec.Sources))
+		}
+		return &spec.Sources[sourceIndex], nil
+	case spec.Source == nil:
+		return nil, errors.New("application has no source")
+	case sourceIndex != 0:
+		return nil, fmt.Errorf("source index %d is out of range, application has a single source", sourceIndex)
+	default:
+		return spec.Source, nil
+	}
+}
+
+// patchSourceParameters merges the Helm parameters, Kustomize images and plugin env entries of the request into the
+// given source. Entries with the same name as an existing one replace it.
+func patchSourceParameters(source *v1alpha1.ApplicationSource, q *application.ApplicationPatchParametersRequest) error {
+	if len(q.GetHelmParameters()) > 0 {
+		if source.Helm == nil {
+			source.Helm = &v1alpha1.ApplicationSourceHelm{}
+		}
+		for _, p := range q.GetHelmParameters() {
+			if p == nil || p.Name == "" {
+				return errors.New("helm parameter name must not be empty")
+			}
+			source.Helm.AddParameter(*p)
+		}
+	}
+	if len(q.GetKustomizeImages()) > 0 {
+		if source.Kustomize == nil {
+			source.Kustomize = &v1alpha1.ApplicationSourceKustomize{}
+		}
+		for _, image := range q.GetKustomizeImages() {
+			if image == "" {
+				return errors.New("kustomize image must not be empty")
+			}
+			source.Kustomize.MergeImage(v1alpha1.KustomizeImage(image))
+		}
+	}
+	if len(q.GetPluginEnv()) > 0 {
+		if source.Plugin == nil {
+			source.Plugin = &v1alpha1.ApplicationSourcePlugin{}
+		}
+		for _, e := range q.GetPluginEnv() {
+			if e == nil || e.Name == "" {
+				return errors.New("plugin env entry name must not be empty")
+			}
+			source.Plugin.AddEnvEntry(e)
+		}
+	}
+	return nil
+}
+
 func (s *Server) getAppProject(ctx context.Context, a *v1alpha1.Application, logCtx *log.Entry) (*v1alpha1.AppProject, error) {
 	proj, err := argo.GetAppProject(ctx, a, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
 	if err == nil {
@@ -1224,6 +1379,106 @@ func (s *Server) Delete(ctx context.Context, q *application.ApplicationDeleteReq
 	return &application.ApplicationResponse{}, nil
 }
 
+// DeletePreview returns the live resources which would be deleted by a cascading deletion of an application, without
+// deleting anything
+func (s *Server) DeletePreview(ctx context.Context, q *application.ApplicationDeleteRequest) (*application.ApplicationDeletePreviewResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Cascade != nil && !*q.Cascade && q.GetPropagationPolicy() != "" {
+		return nil, status.Error(codes.InvalidArgument, "cannot set propagation policy when cascading is disabled")
+	}
+	cascade := q.Cascade == nil || *q.Cascade
+
+	res := &application.ApplicationDeletePreviewResponse{}
+	if cascade {
+		policyFinalizer := getPropagationPolicyFinalizer(q.GetPropagationPolicy())
+		if policyFinalizer == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid propagation policy: %s", q.GetPropagationPolicy())
+		}
+		if policyFinalizer == v1alpha1.BackgroundPropagationPolicyFinalizer {
+			res.PropagationPolicy = new(backgroundPropagationPolicy)
+		} else {
+			res.PropagationPolicy = new(foregroundPropagationPolicy)
+		}
+	}
+
+	items := make([]*v1alpha1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.InstanceName(s.ns), &items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app managed resources: %w", err)
+	}
+
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, proj, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+	getProjectClusters := func(project string) ([]*v1alpha1.Cluster, error) {
+		return s.db.GetProjectClusters(ctx, project)
+	}
+
+	// keys of the resources which would be deleted, either directly or by the garbage collector
+	deleted := map[kube.ResourceKey]bool{}
+	for _, item := range items {
+		if item.LiveState == "" || item.LiveState == "null" {
+			continue
+		}
+		liveObj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(item.LiveState), liveObj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling live state of %s/%s: %w", item.Kind, item.Name, err)
+		}
+		ref := &v1alpha1.ResourceRef{
+			Group:     item.Group,
+			Version:   liveObj.GroupVersionKind().Version,
+			Kind:      item.Kind,
+			Namespace: item.Namespace,
+			Name:      item.Name,
+			UID:       string(liveObj.GetUID()),
+		}
+		permitted, err := proj.IsLiveResourcePermitted(liveObj, destCluster, getProjectClusters)
+		if err != nil {
+			return nil, fmt.Errorf("error checking if resource %s/%s is permitted: %w", item.Kind, item.Name, err)
+		}
+		if !cascade || !permitted || !argo.ShouldBeDeletedOnCascade(a, liveObj) {
+			res.Retained = append(res.Retained, ref)
+			continue
+		}
+		res.Resources = append(res.Resources, ref)
+		deleted[kube.NewResourceKey(ref.Group, ref.Kind, ref.Namespace, ref.Name)] = true
+	}
+
+	if len(deleted) > 0 {
+		tree, err := s.getAppResources(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		// walk the resource tree down from the deleted resources until no more dependents are found
+		for found := true; found; {
+			found = false
+			for _, node := range tree.Nodes {
+				key := kube.NewResourceKey(node.Group, node.Kind, node.Namespace, node.Name)
+				if deleted[key] {
+					continue
+				}
+				for _, parent := range node.ParentRefs {
+					if deleted[kube.NewResourceKey(parent.Group, parent.Kind, parent.Namespace, parent.Name)] {
+						res.Dependents = append(res.Dependents, new(node.ResourceRef))
+						deleted[key] = true
+						found = true
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
 func (s *Server) isApplicationPermitted(selector labels.Selector, minVersion int, claims any, appName, appNs string, projects map[string]bool, a v1alpha1.Application) bool {
 	if len(projects) > 0 && !projects[a.Spec.GetProject()] {
 		return false
@@ -1335,6 +1590,11 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		}
 	}
 
+	// the primary destination of an application with multiple destinations is validated as its destination
+	if app.Spec.HasMultipleDestinations() {
+		app.Spec.Destination = app.Spec.Destinations[0]
+	}
+
 	appNs := s.appNamespaceOrDefault(app.Namespace)
 	currApp, err := s.appclientset.ArgoprojV1alpha1().Applications(appNs).Get(ctx, app.Name, metav1.GetOptions{})
 	if err != nil {
@@ -1389,6 +1649,11 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(conditions))
 	}
 
+	serviceAccountConditions := argo.ValidateDestinationServiceAccount(ctx, app, proj, s.db)
+	if len(serviceAccountConditions) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application spec for %s is invalid: %s", app.Name, argo.FormatAppConditions(serviceAccountConditions))
+	}
+
 	// Validate managed-by-url annotation
 	managedByURLConditions := argo.ValidateManagedByURL(app)
 	if len(managedByURLConditions) > 0 {
@@ -1400,7 +1665,7 @@ func (s *Server) validateAndNormalizeApp(ctx context.Context, app *v1alpha1.Appl
 }
 
 func (s *Server) getApplicationClusterConfig(ctx context.Context, a *v1alpha1.Application, p *v1alpha1.AppProject) (*rest.Config, error) {
-	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	cluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, p, s.db)
 	if err != nil {
 		return nil, fmt.Errorf("error validating destination: %w", err)
 	}
@@ -1450,8 +1715,9 @@ func (s *Server) getCachedAppState(ctx context.Context, a *v1alpha1.Application,
 	err := getFromCache()
 	if err != nil && errors.Is(err, servercache.ErrCacheMiss) {
 		conditions := a.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-			v1alpha1.ApplicationConditionComparisonError:  true,
-			v1alpha1.ApplicationConditionInvalidSpecError: true,
+			v1alpha1.ApplicationConditionComparisonError:              true,
+			v1alpha1.ApplicationConditionManifestGenerationLimitError: true,
+			v1alpha1.ApplicationConditionInvalidSpecError:             true,
 		})
 		if len(conditions) > 0 {
 			return errors.New(argo.FormatAppConditions(conditions))
@@ -1645,6 +1911,19 @@ func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery
 	return s.getAppResources(ctx, a)
 }
 
+func (s *Server) MetricsHistory(ctx context.Context, q *application.ApplicationMetricsHistoryQuery) (*v1alpha1.ApplicationMetricsHistory, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var history v1alpha1.ApplicationMetricsHistory
+	if err := s.cache.GetAppMetricsHistory(a.InstanceName(s.ns), &history); err != nil {
+		return nil, fmt.Errorf("error getting cached app metrics history: %w", err)
+	}
+	return &history, nil
+}
+
 func (s *Server) WatchResourceTree(q *application.ResourcesQuery, ws application.ApplicationService_WatchResourceTreeServer) error {
 	_, _, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetApplicationName())
 	if err != nil {
@@ -1693,6 +1972,59 @@ func (s *Server) RevisionMetadata(ctx context.Context, q *application.RevisionMe
 	})
 }
 
+// RevisionComparison returns the commits and the changed files between two revisions of an application source, as
+// fetched from the reposerver. The revisions default to the synced and the target revision of the source.
+func (s *Server) RevisionComparison(ctx context.Context, q *application.RevisionComparisonQuery) (*v1alpha1.RevisionComparison, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := getAppSourceBySourceIndexAndVersionId(a, q.SourceIndex, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app source by source index: %w", err)
+	}
+	if source.IsHelm() || source.IsOCI() {
+		return nil, status.Errorf(codes.InvalidArgument, "revisions of the source %s cannot be compared, only git sources are supported", source.RepoURL)
+	}
+
+	revision := q.GetRevision()
+	if revision == "" {
+		revision = a.Status.Sync.Revision
+		if a.Spec.HasMultipleSources() {
+			revision = ""
+			if sourceIndex := int(q.GetSourceIndex()); sourceIndex < len(a.Status.Sync.Revisions) {
+				revision = a.Status.Sync.Revisions[sourceIndex]
+			}
+		}
+		if revision == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "application %s has not been synced yet, a revision must be specified", a.Name)
+		}
+	}
+	targetRevision := q.GetTargetRevision()
+	if targetRevision == "" {
+		targetRevision = source.TargetRevision
+	}
+	if targetRevision == "" {
+		targetRevision = "HEAD"
+	}
+
+	repo, err := s.db.GetRepository(ctx, source.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository by URL: %w", err)
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(conn)
+	return repoClient.GetRevisionComparison(ctx, &apiclient.RepoServerRevisionComparisonRequest{
+		Repo:           repo,
+		Revision:       revision,
+		TargetRevision: targetRevision,
+	})
+}
+
 // RevisionChartDetails returns the helm chart metadata, as fetched from the reposerver
 func (s *Server) RevisionChartDetails(ctx context.Context, q *application.RevisionMetadataQuery) (*v1alpha1.ChartDetails, error) {
 	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
@@ -1822,6 +2154,66 @@ func getSourcesByVersionId(a *v1alpha1.Application, versionId int64) ([]v1alpha1
 	return []v1alpha1.ApplicationSource{h.Source}, nil
 }
 
+// getManifestSources returns the sources to generate the manifests of the application from, and whether the application
+// has multiple sources. These are the sources currently configured for the app, unless the query specifies a version ID,
+// in which case they are the sources recorded in the revision history for that version ID, at the revisions they were
+// synced to. The revisions of the query override the target revisions of the sources at the given positions, or of
+// the single source of the app.
+func getManifestSources(a *v1alpha1.Application, q *application.ApplicationManifestQuery) ([]v1alpha1.ApplicationSource, bool, error) {
+	var sources []v1alpha1.ApplicationSource
+	hasMultipleSources := a.Spec.HasMultipleSources()
+	switch {
+	case q.VersionId != nil:
+		h, ok := getRevisionHistoryByVersionId(a.Status.History, int64(q.GetVersionId()))
+		if !ok {
+			return nil, false, fmt.Errorf("revision history not found for version ID %d", q.GetVersionId())
+		}
+		hasMultipleSources = len(h.Sources) > 0
+		if hasMultipleSources {
+			sources = h.Sources.DeepCopy()
+			for i := range sources {
+				if i < len(h.Revisions) && h.Revisions[i] != "" {
+					sources[i].TargetRevision = h.Revisions[i]
+				}
+			}
+		} else {
+			source := h.Source.DeepCopy()
+			if h.Revision != "" {
+				source.TargetRevision = h.Revision
+			}
+			sources = append(sources, *source)
+		}
+	case hasMultipleSources:
+		sources = a.Spec.Sources.DeepCopy()
+	case a.Spec.SourceHydrator != nil:
+		// For sourceHydrator applications, use the dry source to generate manifests
+		sources = append(sources, a.Spec.SourceHydrator.GetDrySource())
+	default:
+		sources = append(sources, a.Spec.GetSource())
+	}
+
+	if !hasMultipleSources {
+		if q.GetRevision() != "" {
+			sources[0].TargetRevision = q.GetRevision()
+		}
+		return sources, false, nil
+	}
+	for i, pos := range q.SourcePositions {
+		if pos <= 0 || pos > int64(len(sources)) {
+			return nil, false, errors.New("source position is out of range")
+		}
+		revision := q.GetRevision()
+		if i < len(q.Revisions) {
+			revision = q.Revisions[i]
+		}
+		if revision == "" {
+			return nil, false, fmt.Errorf("no revision specified for source position %d", pos)
+		}
+		sources[pos-1].TargetRevision = revision
+	}
+	return sources, true, nil
+}
+
 func isMatchingResource(q *application.ResourcesQuery, key kube.ResourceKey) bool {
 	return (q.GetName() == "" || q.GetName() == key.Name) &&
 		(q.GetNamespace() == "" || q.GetNamespace() == key.Namespace) &&
@@ -1877,14 +2269,13 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 		untilTime = &untilTimeVal
 	}
 
-	literal := ""
-	inverse := false
-	if q.GetFilter() != "" {
-		literal = *q.Filter
-		if literal[0] == '!' {
-			literal = literal[1:]
-			inverse = true
-		}
+	if q.GetAllContainers() && q.GetContainer() != "" {
+		return status.Error(codes.InvalidArgument, "container and allContainers are mutually exclusive")
+	}
+
+	matchesFilter, err := newLogFilter(q.GetFilter(), q.GetMatchCase(), q.GetRegex())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	a, p, err := s.getApplicationEnforceRBACInformer(ws.Context(), rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
@@ -1929,35 +2320,44 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	var streams []chan logEntry
 
 	for _, pod := range pods {
-		stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
-			Container:    q.GetContainer(),
-			Follow:       q.GetFollow(),
-			Timestamps:   true,
-			SinceSeconds: sinceSeconds,
-			SinceTime:    q.GetSinceTime(),
-			TailLines:    tailLines,
-			Previous:     q.GetPrevious(),
-		}).Stream(ws.Context())
-		podName := pod.Name
-		logStream := make(chan logEntry)
-		if err == nil {
-			defer utilio.Close(stream)
+		containers := []string{q.GetContainer()}
+		if q.GetAllContainers() {
+			containers, err = getPodContainers(ws.Context(), kubeClientset, pod.Namespace, pod.Name)
+			if err != nil {
+				return fmt.Errorf("error getting containers of pod %s: %w", pod.Name, err)
+			}
 		}
+		for _, container := range containers {
+			stream, err := kubeClientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container:    container,
+				Follow:       q.GetFollow(),
+				Timestamps:   true,
+				SinceSeconds: sinceSeconds,
+				SinceTime:    q.GetSinceTime(),
+				TailLines:    tailLines,
+				Previous:     q.GetPrevious(),
+			}).Stream(ws.Context())
+			podName := pod.Name
+			logStream := make(chan logEntry)
+			if err == nil {
+				defer utilio.Close(stream)
+			}
 
-		streams = append(streams, logStream)
-		go func() {
-			// if k8s failed to start steaming logs (typically because Pod is not ready yet)
-			// then the error should be shown in the UI so that user know the reason
-			if err != nil {
-				select {
-				case logStream <- logEntry{line: err.Error()}:
-				case <-ws.Context().Done():
+			streams = append(streams, logStream)
+			go func() {
+				// if k8s failed to start steaming logs (typically because Pod is not ready yet)
+				// then the error should be shown in the UI so that user know the reason
+				if err != nil {
+					select {
+					case logStream <- logEntry{line: err.Error(), podName: podName, containerName: container}:
+					case <-ws.Context().Done():
+					}
+				} else {
+					parseLogsStream(ws.Context(), podName, container, stream, logStream)
 				}
-			} else {
-				parseLogsStream(ws.Context(), podName, stream, logStream)
-			}
-			close(logStream)
-		}()
+				close(logStream)
+			}()
+		}
 	}
 
 	logStream := mergeLogStreams(ws.Context(), streams, time.Millisecond*100)
@@ -1972,36 +2372,29 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 				done <- entry.err
 				return
 			}
-			if q.Filter != nil {
-				var lineContainsFilter bool
-				if q.GetMatchCase() {
-					lineContainsFilter = strings.Contains(entry.line, literal)
-				} else {
-					lineContainsFilter = strings.Contains(strings.ToLower(entry.line), strings.ToLower(literal))
-				}
-
-				if (inverse && lineContainsFilter) || (!inverse && !lineContainsFilter) {
-					continue
-				}
+			if !matchesFilter(entry.line) {
+				continue
 			}
 			ts := metav1.NewTime(entry.timeStamp)
 			if untilTime != nil && entry.timeStamp.After(untilTime.Time) {
 				done <- ws.Send(&application.LogEntry{
-					Last:         new(true),
-					PodName:      &entry.podName,
-					Content:      &entry.line,
-					TimeStampStr: new(entry.timeStamp.Format(time.RFC3339Nano)),
-					TimeStamp:    &ts,
+					Last:          new(true),
+					PodName:       &entry.podName,
+					ContainerName: &entry.containerName,
+					Content:       &entry.line,
+					TimeStampStr:  new(entry.timeStamp.Format(time.RFC3339Nano)),
+					TimeStamp:     &ts,
 				})
 				return
 			}
 			sentCount++
 			if err := ws.Send(&application.LogEntry{
-				PodName:      &entry.podName,
-				Content:      &entry.line,
-				TimeStampStr: new(entry.timeStamp.Format(time.RFC3339Nano)),
-				TimeStamp:    &ts,
-				Last:         new(false),
+				PodName:       &entry.podName,
+				ContainerName: &entry.containerName,
+				Content:       &entry.line,
+				TimeStampStr:  new(entry.timeStamp.Format(time.RFC3339Nano)),
+				TimeStamp:     &ts,
+				Last:          new(false),
 			}); err != nil {
 				done <- err
 				break
@@ -2027,6 +2420,22 @@ func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.
 	}
 }
 
+// getPodContainers returns the names of the init containers and containers of the given pod
+func getPodContainers(ctx context.Context, kubeClientset kubernetes.Interface, namespace string, name string) ([]string, error) {
+	pod, err := kubeClientset.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	containers := make([]string, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, container := range pod.Spec.InitContainers {
+		containers = append(containers, container.Name)
+	}
+	for _, container := range pod.Spec.Containers {
+		containers = append(containers, container.Name)
+	}
+	return containers, nil
+}
+
 // from all of the treeNodes, get the pod who meets the criteria or whose parents meets the criteria
 func getSelectedPods(treeNodes []v1alpha1.ResourceNode, q *application.ApplicationPodLogsQuery) []v1alpha1.ResourceNode {
 	var pods []v1alpha1.ResourceNode
@@ -2090,7 +2499,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true, nil)
+	canSync, err := proj.MatchingSyncWindows(a).CanSync(true, nil)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
@@ -2101,6 +2510,9 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionSync, a.RBACName(s.ns)); err != nil {
 		return nil, err
 	}
+	if err := s.enforceAPIRateLimit(proj, apiOperationSync); err != nil {
+		return nil, err
+	}
 
 	if syncReq.Manifests != nil {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionOverride, a.RBACName(s.ns)); err != nil {
@@ -2113,6 +2525,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	if a.DeletionTimestamp != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "application is deleting")
 	}
+	for _, dest := range a.Spec.GetDestinations() {
+		if destCluster, err := argo.GetMappedDestinationCluster(ctx, dest, proj, s.db); err == nil && destCluster.Cordoned {
+			return nil, status.Errorf(codes.FailedPrecondition, "cannot sync: cluster '%s' is cordoned", destCluster.Server)
+		}
+	}
 
 	revision, displayRevision, sourceRevisions, displayRevisions, err := s.resolveSourceRevisions(ctx, a, syncReq)
 	if err != nil {
@@ -2177,7 +2594,7 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 	appName := syncReq.GetName()
 	appNs := s.appNamespaceOrDefault(syncReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, err = argo.SetAppOperation(ctx, appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -2317,7 +2734,7 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, err = argo.SetAppOperation(ctx, appIf, appName, &op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -2380,7 +2797,7 @@ func (s *Server) getObjectsForDeepLinks(ctx context.Context, app *v1alpha1.Appli
 		return s.db.GetProjectClusters(ctx, project)
 	}
 
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, app.Spec.Destination, proj, s.db)
 	if err != nil {
 		log.WithFields(applog.GetAppLogFields(app)).
 			WithFields(map[string]any{
@@ -2677,47 +3094,120 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		return nil, err
 	}
 
-	liveObjBytes, err := json.Marshal(liveObj)
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling live object: %w", err)
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
 
+	err = s.runResourceAction(ctx, resourceOverrides, liveObj, res, a, config, q.GetAction(), q.GetResourceActionParameters())
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationResponse{}, nil
+}
+
+// RunResourceActionBulk runs a resource action on all the resources of the application tree matching the group, kind,
+// namespace and label selector of the request. The action is run on each resource independently: the failure of the
+// action on a resource does not prevent it from running on the other resources, and is reported in the results.
+func (s *Server) RunResourceActionBulk(ctx context.Context, q *application.ResourceActionBulkRunRequest) (*application.ResourceActionBulkRunResponse, error) {
+	actionRequest := fmt.Sprintf("%s/%s/%s/%s", rbac.ActionAction, q.GetGroup(), q.GetKind(), q.GetAction())
+	a, p, err := s.getApplicationEnforceRBACInformer(ctx, actionRequest, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	selector, err := labels.Parse(q.GetLabelSelector())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label selector %q: %v", q.GetLabelSelector(), err)
+	}
+
+	tree, err := s.getAppResources(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a, p)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
 	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
 	if err != nil {
 		return nil, fmt.Errorf("error getting resource overrides: %w", err)
 	}
 
+	results := []*application.ResourceActionResult{}
+	for i := range tree.Nodes {
+		res := &tree.Nodes[i]
+		if res.UID == "" || res.Group != q.GetGroup() || res.Kind != q.GetKind() || (q.GetNamespace() != "" && res.Namespace != q.GetNamespace()) {
+			continue
+		}
+		liveObj, err := s.kubectl.GetResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace)
+		if err == nil && !selector.Matches(labels.Set(liveObj.GetLabels())) {
+			continue
+		}
+		if err != nil {
+			err = fmt.Errorf("error getting resource: %w", err)
+		} else {
+			err = s.runResourceAction(ctx, resourceOverrides, liveObj, res, a, config, q.GetAction(), q.GetResourceActionParameters())
+		}
+		result := &application.ResourceActionResult{
+			Group:     new(res.Group),
+			Version:   new(res.Version),
+			Kind:      new(res.Kind),
+			Namespace: new(res.Namespace),
+			Name:      new(res.Name),
+		}
+		if err != nil {
+			result.Error = new(err.Error())
+		}
+		results = append(results, result)
+	}
+	return &application.ResourceActionBulkRunResponse{Results: results}, nil
+}
+
+// runResourceAction runs the action on the live object, after verifying that the application is permitted to manage
+// all the resources impacted by the action. res is nil if the action is run on the application itself.
+func (s *Server) runResourceAction(ctx context.Context, resourceOverrides map[string]v1alpha1.ResourceOverride, liveObj *unstructured.Unstructured, res *v1alpha1.ResourceNode, a *v1alpha1.Application, config *rest.Config, actionName string, params []*application.ResourceActionParameters) error {
+	liveObjBytes, err := json.Marshal(liveObj)
+	if err != nil {
+		return fmt.Errorf("error marshaling live object: %w", err)
+	}
+
 	luaVM := lua.VM{
 		ResourceOverrides: resourceOverrides,
 	}
-	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
+	action, err := luaVM.GetResourceAction(liveObj, actionName)
 	if err != nil {
-		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+		return fmt.Errorf("error getting Lua resource action: %w", err)
 	}
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+	resourceActionParameters, err := luaVM.ResolveResourceActionParameters(liveObj, actionName, params)
 	if err != nil {
-		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+		return status.Errorf(codes.InvalidArgument, "invalid parameters of resource action %s: %v", actionName, err)
+	}
+
+	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, resourceActionParameters)
+	if err != nil {
+		return fmt.Errorf("error executing Lua resource action: %w", err)
 	}
 
 	var app *v1alpha1.Application
 	// Only bother getting the app if we know we're going to need it for a resource permission check.
 	if len(newObjects) > 0 {
 		// No need for an RBAC check, we checked above that the user is allowed to run this action.
-		app, err = s.appLister.Applications(s.appNamespaceOrDefault(q.GetAppNamespace())).Get(q.GetName())
+		app, err = s.appLister.Applications(a.Namespace).Get(a.Name)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	proj, err := s.getAppProject(ctx, a, log.WithFields(applog.GetAppLogFields(a)))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, s.db)
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, app.Spec.Destination, proj, s.db)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// First, make sure all the returned resources are permitted, for each operation.
@@ -2729,13 +3219,13 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		newObj := impactedResource.UnstructuredObj
 		err := s.verifyResourcePermitted(ctx, destCluster, proj, newObj)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if impactedResource.K8SOperation == lua.CreateOperation {
 			createOptions := metav1.CreateOptions{DryRun: []string{"All"}}
 			_, err := s.kubectl.CreateResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), newObj, createOptions)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
@@ -2749,7 +3239,7 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		newObj := impactedResource.UnstructuredObj
 		newObjBytes, err := json.Marshal(newObj)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling new object: %w", err)
+			return fmt.Errorf("error marshaling new object: %w", err)
 		}
 
 		switch impactedResource.K8SOperation {
@@ -2757,23 +3247,23 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 		case lua.PatchOperation:
 			_, err := s.patchResource(ctx, config, liveObjBytes, newObjBytes, newObj)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		case lua.CreateOperation:
 			_, err := s.createResource(ctx, config, newObj)
 			if err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
 
 	if res == nil {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, "ran action "+actionName)
 	} else {
-		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
-		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+q.GetAction())
+		s.logAppEvent(ctx, a, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", actionName, res.Group, res.Kind, res.Name))
+		s.logResourceEvent(ctx, res, argo.EventReasonResourceActionRan, "ran action "+actionName)
 	}
-	return &application.ApplicationResponse{}, nil
+	return nil
 }
 
 func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObjBytes, newObjBytes []byte, newObj *unstructured.Unstructured) (*application.ApplicationResponse, error) {
@@ -2885,7 +3375,12 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	}
 
 	windows := proj.Spec.SyncWindows.Matches(a)
-	sync, err := windows.CanSync(true, nil)
+	lockedWindows := proj.MatchingSyncWindows(a)
+	sync, err := lockedWindows.CanSync(true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	autoSync, err := lockedWindows.CanSync(false, nil)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
@@ -2894,10 +3389,20 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
 	}
+	convertedActiveWindows, _, err := convertSyncWindows(activeWindows, lockedWindows)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	assignedWindows, nextTransition, err := convertSyncWindows(windows, lockedWindows)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
 	res := &application.ApplicationSyncWindowsResponse{
-		ActiveWindows:   convertSyncWindows(activeWindows),
-		AssignedWindows: convertSyncWindows(windows),
+		ActiveWindows:   convertedActiveWindows,
+		AssignedWindows: assignedWindows,
 		CanSync:         &sync,
+		CanAutoSync:     &autoSync,
+		NextTransition:  nextTransition,
 	}
 
 	return res, nil
@@ -2926,23 +3431,43 @@ func (s *Server) inferResourcesStatusHealth(app *v1alpha1.Application) {
 	}
 }
 
-func convertSyncWindows(w *v1alpha1.SyncWindows) []*application.ApplicationSyncWindow {
-	if w != nil {
-		var windows []*application.ApplicationSyncWindow
-		for _, w := range *w {
-			nw := &application.ApplicationSyncWindow{
-				Kind:       &w.Kind,
-				Schedule:   &w.Schedule,
-				Duration:   &w.Duration,
-				ManualSync: &w.ManualSync,
-			}
-			windows = append(windows, nw)
+// convertSyncWindows converts the sync windows along with their current state, and returns the earliest time at which
+// one of them becomes active or inactive. The windows which require an approval and are missing from lockedWindows are
+// reported as unlocked.
+func convertSyncWindows(w *v1alpha1.SyncWindows, lockedWindows *v1alpha1.SyncWindows) ([]*application.ApplicationSyncWindow, *metav1.Time, error) {
+	if !w.HasWindows() {
+		return nil, nil, nil
+	}
+	var windows []*application.ApplicationSyncWindow
+	var nextTransition *metav1.Time
+	for _, w := range *w {
+		active, err := w.Active()
+		if err != nil {
+			return nil, nil, err
+		}
+		transition, err := w.NextTransition()
+		if err != nil {
+			return nil, nil, err
 		}
-		if len(windows) > 0 {
-			return windows
+		unlocked := w.RequireApproval && (!lockedWindows.HasWindows() || !slices.Contains(*lockedWindows, w))
+		nw := &application.ApplicationSyncWindow{
+			Kind:       &w.Kind,
+			Schedule:   &w.Schedule,
+			Duration:   &w.Duration,
+			ManualSync: &w.ManualSync,
+			TimeZone:   &w.TimeZone,
+			Active:     &active,
+			Unlocked:   &unlocked,
+		}
+		if !transition.IsZero() {
+			nw.NextTransition = &metav1.Time{Time: transition}
+			if nextTransition == nil || transition.Before(nextTransition.Time) {
+				nextTransition = nw.NextTransition
+			}
 		}
+		windows = append(windows, nw)
 	}
-	return nil
+	return windows, nextTransition, nil
 }
 
 func getPropagationPolicyFinalizer(policy string) string {
@@ -2981,7 +3506,7 @@ func getProjectsFromApplicationQuery(q application.ApplicationQuery) []string {
 // ServerSideDiff gets the destination cluster and creates a server-side dry run applier and performs the diff
 // It returns the diff result in the form of a list of ResourceDiffs.
 func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationServerSideDiffQuery) (*application.ApplicationServerSideDiffResponse, error) {
-	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetAppName())
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetAppName())
 	if err != nil {
 		return nil, fmt.Errorf("error getting application: %w", err)
 	}
@@ -3001,7 +3526,7 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 	maps.Copy(overrides, resourceOverrides)
 
 	// Get cluster connection for server-side dry run
-	cluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	cluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, proj, s.db)
 	if err != nil {
 		return nil, fmt.Errorf("error getting destination cluster: %w", err)
 	}
@@ -3032,13 +3557,28 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 		return nil, fmt.Errorf("error getting app instance label key: %w", err)
 	}
 
+	var syncOptions v1alpha1.SyncOptions
+	if a.Spec.SyncPolicy != nil {
+		syncOptions = a.Spec.SyncPolicy.SyncOptions
+	}
+
+	ignoreDifferences, err := argo.GetIgnoreDifferences(&a.Spec, proj, s.settingsMgr)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ignore differences: %w", err)
+	}
+	driftExclusions, err := argo.GetDriftExclusions(proj, s.settingsMgr)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build diff config like the CLI does, but with server-side diff enabled
 	ignoreAggregatedRoles := false
 	diffConfig, err := argodiff.NewDiffConfigBuilder().
-		WithDiffSettings(a.Spec.IgnoreDifferences, overrides, ignoreAggregatedRoles, normalizers.IgnoreNormalizerOpts{}).
+		WithDiffSettings(ignoreDifferences, overrides, ignoreAggregatedRoles, normalizers.IgnoreNormalizerOpts{}).
+		WithDriftExclusions(driftExclusions).
 		WithTracking(appLabelKey, argoSettings.TrackingMethod).
 		WithNoCache().
-		WithManager(argocommon.ArgoCDSSAManager).
+		WithManager(syncOptions.GetServerSideApplyManager()).
 		WithServerSideDiff(true).
 		WithServerSideDryRunner(dryRunner).
 		WithGVKParser(gvkParser).
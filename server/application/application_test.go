@@ -42,6 +42,7 @@ import (
 	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 	k8scache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
@@ -311,6 +312,8 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		[]string{},
 		testEnableEventList,
 		true,
+		false,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -495,6 +498,8 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		[]string{},
 		testEnableEventList,
 		true,
+		false,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -940,6 +945,17 @@ func TestNoAppEnumeration(t *testing.T) {
 		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
 	})
 
+	t.Run("MetricsHistory", func(t *testing.T) {
+		_, err := appServer.MetricsHistory(adminCtx, &application.ApplicationMetricsHistoryQuery{Name: new("test")})
+		require.NoError(t, err)
+		_, err = appServer.MetricsHistory(noRoleCtx, &application.ApplicationMetricsHistoryQuery{Name: new("test")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.MetricsHistory(adminCtx, &application.ApplicationMetricsHistoryQuery{Name: new("doest-not-exist")})
+		require.EqualError(t, err, common.PermissionDeniedAPIError.Error(), "error message must be _only_ the permission error, to avoid leaking information about app existence")
+		_, err = appServer.MetricsHistory(adminCtx, &application.ApplicationMetricsHistoryQuery{Name: new("doest-not-exist"), Project: new("test")})
+		assert.EqualError(t, err, "rpc error: code = NotFound desc = applications.argoproj.io \"doest-not-exist\" not found", "when the request specifies a project, we can return the standard k8s error message")
+	})
+
 	t.Run("RevisionMetadata", func(t *testing.T) {
 		_, err := appServer.RevisionMetadata(adminCtx, &application.RevisionMetadataQuery{Name: new("test")})
 		require.NoError(t, err)
@@ -1345,6 +1361,102 @@ func TestListApps(t *testing.T) {
 	assert.Equal(t, []string{"abc", "bcd", "def"}, names)
 }
 
+func TestListAppsByConditionSeverities(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionSyncError, Message: "failed"}}
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+		app.Status.Conditions = []v1alpha1.ApplicationCondition{{Type: v1alpha1.ApplicationConditionSharedResourceWarning, Message: "shared"}}
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "def"
+	}))
+
+	listNames := func(severities ...string) []string {
+		res, err := appServer.List(t.Context(), &application.ApplicationQuery{ConditionSeverities: severities})
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"abc"}, listNames("error"))
+	assert.Equal(t, []string{"abc", "bcd"}, listNames("Error", "Warning"))
+	assert.Equal(t, []string{"abc", "bcd", "def"}, listNames())
+
+	_, err := appServer.List(t.Context(), &application.ApplicationQuery{ConditionSeverities: []string{"fatal"}})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListAppsPaginated(t *testing.T) {
+	appServer := newTestAppServer(t, newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "bcd"
+		app.Status.Health.Status = health.HealthStatusDegraded
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "abc"
+		app.Status.Health.Status = health.HealthStatusHealthy
+	}), newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "def"
+		app.Status.Health.Status = health.HealthStatusDegraded
+	}))
+
+	listNames := func(t *testing.T, q *application.ApplicationQuery) ([]string, *v1alpha1.ApplicationList) {
+		t.Helper()
+		res, err := appServer.List(t.Context(), q)
+		require.NoError(t, err)
+		var names []string
+		for i := range res.Items {
+			names = append(names, res.Items[i].Name)
+		}
+		return names, res
+	}
+
+	t.Run("Pages through all apps", func(t *testing.T) {
+		names, res := listNames(t, &application.ApplicationQuery{Limit: new(int64(2))})
+		assert.Equal(t, []string{"abc", "bcd"}, names)
+		require.NotEmpty(t, res.Continue)
+		require.NotNil(t, res.RemainingItemCount)
+		assert.Equal(t, int64(1), *res.RemainingItemCount)
+
+		names, res = listNames(t, &application.ApplicationQuery{Limit: new(int64(2)), Continue: new(res.Continue)})
+		assert.Equal(t, []string{"def"}, names)
+		assert.Empty(t, res.Continue)
+		assert.Nil(t, res.RemainingItemCount)
+	})
+
+	t.Run("Sorts by health", func(t *testing.T) {
+		names, res := listNames(t, &application.ApplicationQuery{SortBy: new("health"), Limit: new(int64(1))})
+		assert.Equal(t, []string{"bcd"}, names)
+
+		names, _ = listNames(t, &application.ApplicationQuery{SortBy: new("health"), Continue: new(res.Continue)})
+		assert.Equal(t, []string{"def", "abc"}, names)
+	})
+
+	t.Run("Returns minimal apps", func(t *testing.T) {
+		_, res := listNames(t, &application.ApplicationQuery{Minimal: new(true)})
+		require.Len(t, res.Items, 3)
+		app := res.Items[0]
+		assert.Equal(t, "abc", app.Name)
+		assert.Equal(t, "https://cluster-api.example.com", app.Spec.Destination.Server)
+		assert.Equal(t, health.HealthStatusHealthy, app.Status.Health.Status)
+		assert.Nil(t, app.Spec.Source)
+	})
+
+	t.Run("Rejects invalid queries", func(t *testing.T) {
+		_, err := appServer.List(t.Context(), &application.ApplicationQuery{SortBy: new("size")})
+		require.ErrorContains(t, err, "unsupported sort field")
+
+		_, err = appServer.List(t.Context(), &application.ApplicationQuery{Continue: new("not-a-token")})
+		require.ErrorContains(t, err, "invalid continue token")
+
+		_, res := listNames(t, &application.ApplicationQuery{Limit: new(int64(1))})
+		_, err = appServer.List(t.Context(), &application.ApplicationQuery{SortBy: new("health"), Continue: new(res.Continue)})
+		require.ErrorContains(t, err, "continue token was issued for sort field")
+	})
+}
+
 func TestCoupleAppsListApps(t *testing.T) {
 	var objects []runtime.Object
 	ctx := t.Context()
@@ -1922,6 +2034,80 @@ func TestDeleteAppAlreadyDeleting(t *testing.T) {
 	assert.True(t, deleted, "delete call should still be issued so the RPC stays idempotent")
 }
 
+func TestDeleteAppPreview(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+
+	newLiveState := func(apiVersion, kind, name string, annotations map[string]string) string {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(testNamespace)
+		obj.SetName(name)
+		obj.SetAnnotations(annotations)
+		data, err := json.Marshal(obj)
+		require.NoError(t, err)
+		return string(data)
+	}
+	appStateCache := appstate.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	require.NoError(t, appStateCache.SetAppManagedResources(testApp.Name, []*v1alpha1.ResourceDiff{{
+		Group: "apps", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook",
+		LiveState: newLiveState("apps/v1", "Deployment", "guestbook", nil),
+	}, {
+		Kind: "ConfigMap", Namespace: testNamespace, Name: "guestbook-config",
+		LiveState: newLiveState("v1", "ConfigMap", "guestbook-config", map[string]string{"argocd.argoproj.io/sync-options": "Delete=false"}),
+	}, {
+		Kind: "Service", Namespace: testNamespace, Name: "guestbook-missing", LiveState: "null",
+	}}))
+	deploymentRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "guestbook"}
+	replicaSetRef := v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: testNamespace, Name: "guestbook-5d8f"}
+	podRef := v1alpha1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: testNamespace, Name: "guestbook-5d8f-x2k4"}
+	require.NoError(t, appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: []v1alpha1.ResourceNode{
+		{ResourceRef: deploymentRef},
+		{ResourceRef: podRef, ParentRefs: []v1alpha1.ResourceRef{replicaSetRef}},
+		{ResourceRef: replicaSetRef, ParentRefs: []v1alpha1.ResourceRef{deploymentRef}},
+	}}))
+	appServer.cache = servercache.NewCache(appStateCache, time.Hour, time.Hour)
+
+	t.Run("cascade", func(t *testing.T) {
+		preview, err := appServer.DeletePreview(t.Context(), &application.ApplicationDeleteRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.Equal(t, foregroundPropagationPolicy, preview.GetPropagationPolicy())
+		require.Len(t, preview.Resources, 1)
+		assert.Equal(t, "guestbook", preview.Resources[0].Name)
+		assert.Equal(t, "v1", preview.Resources[0].Version)
+		assert.ElementsMatch(t, []*v1alpha1.ResourceRef{&podRef, &replicaSetRef}, preview.Dependents)
+		require.Len(t, preview.Retained, 1)
+		assert.Equal(t, "guestbook-config", preview.Retained[0].Name)
+	})
+
+	t.Run("background propagation policy", func(t *testing.T) {
+		preview, err := appServer.DeletePreview(t.Context(), &application.ApplicationDeleteRequest{Name: &testApp.Name, PropagationPolicy: new(backgroundPropagationPolicy)})
+		require.NoError(t, err)
+		assert.Equal(t, backgroundPropagationPolicy, preview.GetPropagationPolicy())
+		assert.Len(t, preview.Resources, 1)
+	})
+
+	t.Run("no cascade", func(t *testing.T) {
+		preview, err := appServer.DeletePreview(t.Context(), &application.ApplicationDeleteRequest{Name: &testApp.Name, Cascade: new(false)})
+		require.NoError(t, err)
+		assert.Empty(t, preview.GetPropagationPolicy())
+		assert.Empty(t, preview.Resources)
+		assert.Empty(t, preview.Dependents)
+		assert.Len(t, preview.Retained, 2)
+	})
+
+	t.Run("invalid propagation policy", func(t *testing.T) {
+		_, err := appServer.DeletePreview(t.Context(), &application.ApplicationDeleteRequest{Name: &testApp.Name, PropagationPolicy: new("orphan")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("propagation policy without cascade", func(t *testing.T) {
+		_, err := appServer.DeletePreview(t.Context(), &application.ApplicationDeleteRequest{Name: &testApp.Name, Cascade: new(false), PropagationPolicy: new(backgroundPropagationPolicy)})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestDeleteResourcesRBAC(t *testing.T) {
 	ctx := t.Context()
 	//nolint:staticcheck
@@ -2813,6 +2999,96 @@ func TestGetManifests_SourceHydrator(t *testing.T) {
 	mockRepoServiceClient.AssertExpectations(t)
 }
 
+func TestGetManifests_HistoricalSources(t *testing.T) {
+	testApp := newMultiSourceTestApp()
+	testApp.Spec.Sources[0].Path = "current"
+	testApp.Status.History = []v1alpha1.RevisionHistory{{
+		ID:        3,
+		Revisions: []string{"abc", "def"},
+		Sources: v1alpha1.ApplicationSources{
+			{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "historical", TargetRevision: "HEAD"},
+			{RepoURL: "https://helm.elastic.co", Chart: "elasticsearch", TargetRevision: "7.7.0"},
+		},
+	}}
+	appServer := newTestAppServer(t, testApp)
+
+	mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+		return mr.HasMultipleSources && mr.ApplicationSource.Path == "historical" && mr.Revision == "abc"
+	})).Return(&apiclient.ManifestResponse{}, nil).Once()
+	mockRepoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+		return mr.HasMultipleSources && mr.ApplicationSource.Chart == "elasticsearch" && mr.Revision == "7.8.0"
+	})).Return(&apiclient.ManifestResponse{}, nil).Once()
+	appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+
+	_, err := appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+		Name:            &testApp.Name,
+		VersionId:       new(int32(3)),
+		Revisions:       []string{"7.8.0"},
+		SourcePositions: []int64{2},
+	})
+	require.NoError(t, err)
+
+	_, err = appServer.GetManifests(t.Context(), &application.ApplicationManifestQuery{
+		Name:      &testApp.Name,
+		VersionId: new(int32(4)),
+	})
+	require.ErrorContains(t, err, "revision history not found for version ID 4")
+}
+
+func TestGetManifestSources(t *testing.T) {
+	multiSourceApp := newMultiSourceTestApp()
+	multiSourceApp.Status.History = []v1alpha1.RevisionHistory{{
+		ID:        1,
+		Revisions: []string{"abc", ""},
+		Sources:   multiSourceApp.Spec.Sources.DeepCopy(),
+	}}
+	singleSourceApp := newTestApp()
+	singleSourceApp.Status.History = []v1alpha1.RevisionHistory{{
+		ID:       0,
+		Revision: "abc",
+		Source:   *singleSourceApp.Spec.Source.DeepCopy(),
+	}}
+
+	t.Run("CurrentSources", func(t *testing.T) {
+		sources, hasMultipleSources, err := getManifestSources(multiSourceApp, &application.ApplicationManifestQuery{
+			Revision:        new("def"),
+			SourcePositions: []int64{1},
+		})
+		require.NoError(t, err)
+		assert.True(t, hasMultipleSources)
+		assert.Equal(t, "def", sources[0].TargetRevision)
+		assert.Equal(t, "appbranch2", sources[1].TargetRevision)
+		// the sources of the app itself are left untouched
+		assert.Equal(t, "appbranch1", multiSourceApp.Spec.Sources[0].TargetRevision)
+	})
+	t.Run("HistoricalSources", func(t *testing.T) {
+		sources, hasMultipleSources, err := getManifestSources(multiSourceApp, &application.ApplicationManifestQuery{VersionId: new(int32(1))})
+		require.NoError(t, err)
+		assert.True(t, hasMultipleSources)
+		assert.Equal(t, "abc", sources[0].TargetRevision)
+		assert.Equal(t, "appbranch2", sources[1].TargetRevision)
+	})
+	t.Run("HistoricalSingleSource", func(t *testing.T) {
+		sources, hasMultipleSources, err := getManifestSources(singleSourceApp, &application.ApplicationManifestQuery{VersionId: new(int32(0))})
+		require.NoError(t, err)
+		assert.False(t, hasMultipleSources)
+		require.Len(t, sources, 1)
+		assert.Equal(t, "abc", sources[0].TargetRevision)
+	})
+	t.Run("PositionOutOfRange", func(t *testing.T) {
+		_, _, err := getManifestSources(multiSourceApp, &application.ApplicationManifestQuery{
+			Revisions:       []string{"abc"},
+			SourcePositions: []int64{3},
+		})
+		require.ErrorContains(t, err, "source position is out of range")
+	})
+	t.Run("MissingRevision", func(t *testing.T) {
+		_, _, err := getManifestSources(multiSourceApp, &application.ApplicationManifestQuery{SourcePositions: []int64{1}})
+		require.ErrorContains(t, err, "no revision specified for source position 1")
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
@@ -2971,6 +3247,125 @@ func TestAppMergePatch(t *testing.T) {
 	assert.Equal(t, "foo", app.Spec.Source.Path)
 }
 
+func TestAppPatchParameters(t *testing.T) {
+	ctx := t.Context()
+	//nolint:staticcheck
+	ctx = context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: "admin"})
+
+	t.Run("HelmParameters", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source.Helm = &v1alpha1.ApplicationSourceHelm{Parameters: []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v1"}, {Name: "replicas", Value: "2"}}}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:           &testApp.Name,
+			HelmParameters: []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}, {Name: "debug", Value: "true"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}, {Name: "replicas", Value: "2"}, {Name: "debug", Value: "true"}}, app.Spec.Source.Helm.Parameters)
+		assert.Equal(t, "some/path", app.Spec.Source.Path)
+	})
+
+	t.Run("KustomizeImagesOfMultiSourceApp", func(t *testing.T) {
+		testApp := newMultiSourceTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:            &testApp.Name,
+			SourceIndex:     new(int32(1)),
+			KustomizeImages: []string{"guestbook=guestbook:v2"},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, app.Spec.Sources[0].Kustomize)
+		assert.Equal(t, v1alpha1.KustomizeImages{"guestbook=guestbook:v2"}, app.Spec.Sources[1].Kustomize.Images)
+
+		_, err = appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:            &testApp.Name,
+			SourceIndex:     new(int32(2)),
+			KustomizeImages: []string{"guestbook=guestbook:v3"},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("PluginEnv", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source.Plugin = &v1alpha1.ApplicationSourcePlugin{Env: v1alpha1.Env{{Name: "FOO", Value: "bar"}}}
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		app, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:      &testApp.Name,
+			PluginEnv: []*v1alpha1.EnvEntry{{Name: "FOO", Value: "baz"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.Env{{Name: "FOO", Value: "baz"}}, app.Spec.Source.Plugin.Env)
+	})
+
+	t.Run("ResourceVersionMismatch", func(t *testing.T) {
+		testApp := newTestApp(func(app *v1alpha1.Application) {
+			app.ResourceVersion = "1"
+		})
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:            &testApp.Name,
+			ResourceVersion: new("2"),
+			HelmParameters:  []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		app, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:            &testApp.Name,
+			ResourceVersion: new("1"),
+			HelmParameters:  []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}}, app.Spec.Source.Helm.Parameters)
+	})
+
+	t.Run("ProjectChangedConcurrently", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		fakeAppCs := appServer.appclientset.(*deepCopyAppClientset).GetUnderlyingClientSet().(*apps.Clientset)
+		conflicted := false
+		fakeAppCs.PrependReactor("update", "applications", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if conflicted {
+				return false, nil, nil
+			}
+			conflicted = true
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, testApp.Name, errors.New("modified"))
+		})
+		fakeAppCs.PrependReactor("get", "applications", func(_ kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if !conflicted {
+				return false, nil, nil
+			}
+			movedApp := testApp.DeepCopy()
+			movedApp.Spec.Project = "proj-maint"
+			return true, movedApp, nil
+		})
+
+		_, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{
+			Name:           &testApp.Name,
+			HelmParameters: []*v1alpha1.HelmParameter{{Name: "image.tag", Value: "v2"}},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.True(t, conflicted)
+	})
+
+	t.Run("NothingToPatch", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.PatchParameters(ctx, &application.ApplicationPatchParametersRequest{Name: &testApp.Name})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 	t.Run("Active", func(t *testing.T) {
 		testApp := newTestApp()
@@ -2999,6 +3394,49 @@ func TestServer_GetApplicationSyncWindowsState(t *testing.T) {
 		require.ErrorContains(t, err, "not exist")
 		assert.Nil(t, active)
 	})
+	t.Run("EvaluatedState", func(t *testing.T) {
+		freeze := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, RequireApproval: true}
+		yearly := &v1alpha1.SyncWindow{Kind: "deny", Schedule: "0 0 1 1 *", Duration: "1m", Applications: []string{"*"}, ManualSync: true}
+		proj := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "proj-freeze", Namespace: "default"},
+			Spec: v1alpha1.AppProjectSpec{
+				SourceRepos:  []string{"*"},
+				Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SyncWindows:  v1alpha1.SyncWindows{freeze, yearly},
+			},
+		}
+		testApp := newTestApp()
+		testApp.Spec.Project = proj.Name
+		appServer := newTestAppServer(t, testApp, proj)
+
+		state, err := appServer.GetApplicationSyncWindows(t.Context(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+		require.NoError(t, err)
+		assert.False(t, state.GetCanSync())
+		assert.False(t, state.GetCanAutoSync())
+		require.Len(t, state.AssignedWindows, 2)
+		assert.True(t, state.AssignedWindows[0].GetActive())
+		assert.False(t, state.AssignedWindows[0].GetUnlocked())
+		// the window is always active
+		assert.Nil(t, state.AssignedWindows[0].NextTransition)
+		assert.False(t, state.AssignedWindows[1].GetActive())
+		nextYear := time.Date(time.Now().UTC().Year()+1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		require.NotNil(t, state.AssignedWindows[1].NextTransition)
+		assert.Equal(t, nextYear, state.AssignedWindows[1].NextTransition.UTC())
+		require.NotNil(t, state.NextTransition)
+		assert.Equal(t, nextYear, state.NextTransition.UTC())
+
+		_, err = proj.UnlockSyncWindow(0, time.Hour, "admin", "")
+		require.NoError(t, err)
+		_, err = appServer.appclientset.ArgoprojV1alpha1().AppProjects(proj.Namespace).Update(t.Context(), proj, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			state, err := appServer.GetApplicationSyncWindows(t.Context(), &application.ApplicationSyncWindowsQuery{Name: &testApp.Name})
+			require.NoError(c, err)
+			assert.True(c, state.GetCanSync())
+			assert.True(c, state.GetCanAutoSync())
+			assert.True(c, state.AssignedWindows[0].GetUnlocked())
+		}, 5*time.Second, 10*time.Millisecond)
+	})
 }
 
 func TestGetCachedAppState(t *testing.T) {
@@ -3213,6 +3651,17 @@ func TestMaxPodLogsRender(t *testing.T) {
 	})
 }
 
+func TestPodLogsInvalidArguments(t *testing.T) {
+	appServer, adminCtx := createAppServerWithMaxLodLogs(t, 1)
+
+	err := appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: new("test"), Container: new("main"), AllContainers: new(true)}, &TestPodLogsServer{ctx: adminCtx})
+	assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = container and allContainers are mutually exclusive")
+
+	err = appServer.PodLogs(&application.ApplicationPodLogsQuery{Name: new("test"), Filter: new("(unclosed"), Regex: new(true)}, &TestPodLogsServer{ctx: adminCtx})
+	statusCode, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+}
+
 // createAppServerWithMaxLodLogs creates a new app server with given number of pods and resources
 func createAppServerWithMaxLodLogs(t *testing.T, podNumber int, maxPodLogsToRender ...int64) (*Server, context.Context) {
 	t.Helper()
@@ -3311,6 +3760,22 @@ func TestGetAppRefresh_NormalRefresh(t *testing.T) {
 	}
 }
 
+func TestGetApp_ReadOnly(t *testing.T) {
+	testApp := newTestApp()
+	appServer := newTestAppServer(t, testApp)
+	appServer.readOnly = true
+
+	app, err := appServer.Get(t.Context(), &application.ApplicationQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Equal(t, testApp.Name, app.Name)
+
+	_, err = appServer.Get(t.Context(), &application.ApplicationQuery{
+		Name:    &testApp.Name,
+		Refresh: new(string(v1alpha1.RefreshTypeNormal)),
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
 func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	ctx, cancel := context.WithCancel(t.Context())
 	defer cancel()
@@ -3724,6 +4189,114 @@ func TestRunOldStyleResourceAction(t *testing.T) {
 	})
 }
 
+func TestRunResourceActionBulk(t *testing.T) {
+	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
+	appStateCache := appstate.NewCache(cacheClient, time.Minute)
+
+	newDeployment := func(name string, labels map[string]string) *unstructured.Unstructured {
+		return kube.MustToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Labels: labels},
+		})
+	}
+	newNode := func(kind string, name string, uid string) v1alpha1.ResourceNode {
+		return v1alpha1.ResourceNode{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Version: "v1", Kind: kind, Name: name, Namespace: testNamespace, UID: uid}}
+	}
+	nodes := []v1alpha1.ResourceNode{
+		newNode("Deployment", "web", "1"),
+		newNode("Deployment", "worker", "2"),
+		newNode("StatefulSet", "db", "3"),
+		newNode("Deployment", "missing", ""),
+	}
+
+	newServer := func(t *testing.T) (*Server, *v1alpha1.Application) {
+		t.Helper()
+		testApp := newTestApp()
+		testApp.Status.ResourceHealthSource = v1alpha1.ResourceHealthLocationAppTree
+		appServer := newTestAppServer(t, testApp, newDeployment("web", map[string]string{"tier": "frontend"}), newDeployment("worker", map[string]string{"tier": "backend"}))
+		appServer.cache = servercache.NewCache(appStateCache, time.Minute, time.Minute)
+		require.NoError(t, appStateCache.SetAppResourcesTree(testApp.Name, &v1alpha1.ApplicationTree{Nodes: nodes}))
+		return appServer, testApp
+	}
+
+	t.Run("AllResourcesOfKind", func(t *testing.T) {
+		appServer, testApp := newServer(t)
+		resp, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionBulkRunRequest{
+			Name:         &testApp.Name,
+			AppNamespace: &testApp.Namespace,
+			Group:        new("apps"),
+			Kind:         new("Deployment"),
+			Action:       new("restart"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "web", resp.Results[0].GetName())
+		assert.Empty(t, resp.Results[0].GetError())
+		assert.Equal(t, "worker", resp.Results[1].GetName())
+		assert.Empty(t, resp.Results[1].GetError())
+	})
+
+	t.Run("LabelSelector", func(t *testing.T) {
+		appServer, testApp := newServer(t)
+		resp, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionBulkRunRequest{
+			Name:          &testApp.Name,
+			AppNamespace:  &testApp.Namespace,
+			Group:         new("apps"),
+			Kind:          new("Deployment"),
+			LabelSelector: new("tier=backend"),
+			Action:        new("restart"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		assert.Equal(t, "worker", resp.Results[0].GetName())
+	})
+
+	t.Run("FailuresAreReportedPerResource", func(t *testing.T) {
+		appServer, testApp := newServer(t)
+		resp, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionBulkRunRequest{
+			Name:         &testApp.Name,
+			AppNamespace: &testApp.Namespace,
+			Group:        new("apps"),
+			Kind:         new("Deployment"),
+			Action:       new("scale"),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		for _, result := range resp.Results {
+			assert.Contains(t, result.GetError(), `parameter "replicas" is required`)
+		}
+	})
+
+	t.Run("InvalidLabelSelector", func(t *testing.T) {
+		appServer, testApp := newServer(t)
+		_, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionBulkRunRequest{
+			Name:          &testApp.Name,
+			AppNamespace:  &testApp.Namespace,
+			Group:         new("apps"),
+			Kind:          new("Deployment"),
+			LabelSelector: new("tier in (frontend"),
+			Action:        new("restart"),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		testApp := newTestApp()
+		appServer := newTestAppServerWithEnforcerConfigure(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		}, map[string]string{}, testApp)
+		_, err := appServer.RunResourceActionBulk(t.Context(), &application.ResourceActionBulkRunRequest{
+			Name:         &testApp.Name,
+			AppNamespace: &testApp.Namespace,
+			Group:        new("apps"),
+			Kind:         new("Deployment"),
+			Action:       new("restart"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
 func TestIsApplicationPermitted(t *testing.T) {
 	t.Run("Incorrect project", func(t *testing.T) {
 		testApp := newTestApp()
@@ -4744,7 +5317,7 @@ func TestServerSideDiff(t *testing.T) {
 		// Create an applier that will fail if called
 		applierCalled := false
 		mockApplier := &kubetest.MockKubeApplier{
-			ApplyResourceFunc: func(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _, _, _ bool, _ string) (string, error) {
+			ApplyResourceFunc: func(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _, _, _, _ bool, _ string) (string, error) {
 				applierCalled = true
 				return "", errors.New("applier should not be called for new objects")
 			},
@@ -5238,3 +5811,101 @@ func TestGetUnstructuredLiveResourceOrAppWithImpersonation(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "system:serviceaccount:"+test.FakeDestNamespace+":test-sa", config.Impersonate.UserName)
 }
+
+func TestRevisionComparison(t *testing.T) {
+	syncedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "synced-app"
+		app.Status.Sync.Revision = "abc"
+	})
+	notSyncedApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Name = "not-synced-app"
+	})
+	multiSourceApp := newMultiSourceTestApp(func(app *v1alpha1.Application) {
+		app.Status.Sync.Revisions = []string{"abc", "def"}
+	})
+
+	newAppServer := func(t *testing.T, revision, targetRevision string) *Server {
+		t.Helper()
+		appServer := newTestAppServer(t, syncedApp, notSyncedApp, multiSourceApp)
+		mockRepoServiceClient := mocks.NewRepoServerServiceClient(t)
+		if revision != "" {
+			mockRepoServiceClient.EXPECT().GetRevisionComparison(mock.Anything, mock.MatchedBy(func(req *apiclient.RepoServerRevisionComparisonRequest) bool {
+				return req.Repo != nil && req.Revision == revision && req.TargetRevision == targetRevision
+			})).Return(&v1alpha1.RevisionComparison{Revision: revision, TargetRevision: targetRevision}, nil)
+		}
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: mockRepoServiceClient}
+		return appServer
+	}
+
+	t.Run("Defaults to the synced and the target revision", func(t *testing.T) {
+		appServer := newAppServer(t, "abc", "HEAD")
+		comparison, err := appServer.RevisionComparison(t.Context(), &application.RevisionComparisonQuery{Name: ptr.To(syncedApp.Name)})
+		require.NoError(t, err)
+		assert.Equal(t, "abc", comparison.Revision)
+	})
+	t.Run("Explicit revisions", func(t *testing.T) {
+		appServer := newAppServer(t, "v1.0.0", "v1.1.0")
+		_, err := appServer.RevisionComparison(t.Context(), &application.RevisionComparisonQuery{
+			Name:           ptr.To(syncedApp.Name),
+			Revision:       ptr.To("v1.0.0"),
+			TargetRevision: ptr.To("v1.1.0"),
+		})
+		require.NoError(t, err)
+	})
+	t.Run("Multiple sources", func(t *testing.T) {
+		appServer := newAppServer(t, "def", "appbranch2")
+		_, err := appServer.RevisionComparison(t.Context(), &application.RevisionComparisonQuery{
+			Name:        ptr.To(multiSourceApp.Name),
+			SourceIndex: ptr.To(int32(1)),
+		})
+		require.NoError(t, err)
+	})
+	t.Run("Not synced", func(t *testing.T) {
+		appServer := newAppServer(t, "", "")
+		_, err := appServer.RevisionComparison(t.Context(), &application.RevisionComparisonQuery{Name: ptr.To(notSyncedApp.Name)})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("Invalid source index", func(t *testing.T) {
+		appServer := newAppServer(t, "", "")
+		_, err := appServer.RevisionComparison(t.Context(), &application.RevisionComparisonQuery{
+			Name:        ptr.To(syncedApp.Name),
+			SourceIndex: ptr.To(int32(1)),
+		})
+		require.ErrorContains(t, err, "source index 1 not found")
+	})
+}
+
+func TestDestinationMappings(t *testing.T) {
+	mappedProj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "mapped-proj", Namespace: "default"},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			DestinationMappings: []v1alpha1.DestinationMapping{
+				{Server: "https://cluster-api.example.com", TargetServer: "https://mapped-cluster-api.example.com"},
+			},
+		},
+	}
+	app := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = mappedProj.Name
+	})
+	appServer := newTestAppServer(t, app, mappedProj)
+	_, err := appServer.db.CreateCluster(t.Context(), &v1alpha1.Cluster{
+		Server:   "https://mapped-cluster-api.example.com",
+		Name:     "mapped-cluster",
+		Cordoned: true,
+	})
+	require.NoError(t, err)
+
+	t.Run("ClusterConfig", func(t *testing.T) {
+		config, err := appServer.getApplicationClusterConfig(t.Context(), app, mappedProj)
+		require.NoError(t, err)
+		assert.Equal(t, "https://mapped-cluster-api.example.com", config.Host)
+	})
+
+	t.Run("Sync", func(t *testing.T) {
+		_, err := appServer.Sync(t.Context(), &application.ApplicationSyncRequest{Name: &app.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.ErrorContains(t, err, "cluster 'https://mapped-cluster-api.example.com' is cordoned")
+	})
+}
@@ -0,0 +1,165 @@
+package application
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Supported values of ApplicationQuery.SortBy
+const (
+	listSortByName       = "name"
+	listSortBySyncStatus = "syncStatus"
+	listSortByHealth     = "health"
+)
+
+// listContinueToken is the decoded form of the continue token returned by a paginated List call. It holds the sort
+// key of the last returned application, so the next page starts right after it even if applications were created or
+// deleted in the meantime.
+type listContinueToken struct {
+	SortBy    string `json:"sortBy"`
+	Key       string `json:"key"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func encodeListContinueToken(token listContinueToken) (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeListContinueToken(value string, sortBy string) (*listContinueToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid continue token: %w", err)
+	}
+	token := &listContinueToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("invalid continue token: %w", err)
+	}
+	if token.SortBy != sortBy {
+		return nil, fmt.Errorf("continue token was issued for sort field %q but %q was requested", token.SortBy, sortBy)
+	}
+	return token, nil
+}
+
+func getListSortBy(q *application.ApplicationQuery) (string, error) {
+	switch sortBy := q.GetSortBy(); sortBy {
+	case "", listSortByName:
+		return listSortByName, nil
+	case listSortBySyncStatus, listSortByHealth:
+		return sortBy, nil
+	default:
+		return "", fmt.Errorf("unsupported sort field %q, must be one of: %s, %s, %s", sortBy, listSortByName, listSortBySyncStatus, listSortByHealth)
+	}
+}
+
+func appSortKey(app *v1alpha1.Application, sortBy string) string {
+	switch sortBy {
+	case listSortBySyncStatus:
+		return string(app.Status.Sync.Status)
+	case listSortByHealth:
+		return string(app.Status.Health.Status)
+	default:
+		return app.Name
+	}
+}
+
+func compareAppToToken(app *v1alpha1.Application, token *listContinueToken) int {
+	return cmp.Or(
+		cmp.Compare(appSortKey(app, token.SortBy), token.Key),
+		cmp.Compare(app.Name, token.Name),
+		cmp.Compare(app.Namespace, token.Namespace),
+	)
+}
+
+// paginateApps sorts the given applications and returns the page requested by the limit and continue token of the
+// query, along with the continue token of the next page and the number of remaining applications, if any.
+func paginateApps(apps []*v1alpha1.Application, q *application.ApplicationQuery) ([]*v1alpha1.Application, string, *int64, error) {
+	sortBy, err := getListSortBy(q)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	if q.GetLimit() < 0 {
+		return nil, "", nil, errors.New("limit must not be negative")
+	}
+	slices.SortFunc(apps, func(a, b *v1alpha1.Application) int {
+		return cmp.Or(
+			cmp.Compare(appSortKey(a, sortBy), appSortKey(b, sortBy)),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Namespace, b.Namespace),
+		)
+	})
+
+	if q.GetContinue() != "" {
+		token, err := decodeListContinueToken(q.GetContinue(), sortBy)
+		if err != nil {
+			return nil, "", nil, err
+		}
+		start, _ := slices.BinarySearchFunc(apps, token, compareAppToToken)
+		// skip the application the token was issued for if it still exists
+		if start < len(apps) && compareAppToToken(apps[start], token) == 0 {
+			start++
+		}
+		apps = apps[start:]
+	}
+
+	limit := int(q.GetLimit())
+	if limit == 0 || limit >= len(apps) {
+		return apps, "", nil, nil
+	}
+	last := apps[limit-1]
+	next, err := encodeListContinueToken(listContinueToken{
+		SortBy:    sortBy,
+		Key:       appSortKey(last, sortBy),
+		Namespace: last.Namespace,
+		Name:      last.Name,
+	})
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("error creating continue token: %w", err)
+	}
+	return apps[:limit], next, new(int64(len(apps) - limit)), nil
+}
+
+// minimalApp returns a lightweight projection of the application, holding only its metadata, project, destination
+// and summarized sync and health status.
+func minimalApp(app *v1alpha1.Application) v1alpha1.Application {
+	return v1alpha1.Application{
+		TypeMeta: app.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              app.Name,
+			Namespace:         app.Namespace,
+			UID:               app.UID,
+			ResourceVersion:   app.ResourceVersion,
+			CreationTimestamp: app.CreationTimestamp,
+			DeletionTimestamp: app.DeletionTimestamp,
+			Labels:            maps.Clone(app.Labels),
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Project:     app.Spec.Project,
+			Destination: app.Spec.Destination,
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync: v1alpha1.SyncStatus{
+				Status:    app.Status.Sync.Status,
+				Revision:  app.Status.Sync.Revision,
+				Revisions: slices.Clone(app.Status.Sync.Revisions),
+			},
+			Health: v1alpha1.AppHealthStatus{
+				Status: app.Status.Health.Status,
+			},
+		},
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"bufio"
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -12,15 +14,16 @@ import (
 )
 
 type logEntry struct {
-	line      string
-	timeStamp time.Time
-	podName   string
-	err       error
+	line          string
+	timeStamp     time.Time
+	podName       string
+	containerName string
+	err           error
 }
 
 // parseLogsStream converts given ReadCloser into channel that emits log entries.
 // It stops early if ctx is cancelled, avoiding goroutine leaks when the caller disconnects.
-func parseLogsStream(ctx context.Context, podName string, stream io.ReadCloser, ch chan logEntry) {
+func parseLogsStream(ctx context.Context, podName string, containerName string, stream io.ReadCloser, ch chan logEntry) {
 	bufReader := bufio.NewReader(stream)
 	eof := false
 	for !eof {
@@ -54,7 +57,7 @@ func parseLogsStream(ctx context.Context, podName string, stream io.ReadCloser,
 		lines := strings.Join(parts[1:], " ")
 		for line := range strings.SplitSeq(lines, "\r") {
 			select {
-			case ch <- logEntry{line: line, timeStamp: logTime, podName: podName}:
+			case ch <- logEntry{line: line, timeStamp: logTime, podName: podName, containerName: containerName}:
 			case <-ctx.Done():
 				return
 			}
@@ -62,6 +65,43 @@ func parseLogsStream(ctx context.Context, podName string, stream io.ReadCloser,
 	}
 }
 
+// newLogFilter returns a function matching the log lines against the given filter. The filter is either a literal or,
+// if regex is true, a regular expression. A filter starting with '!' matches the lines which do not match the rest of it.
+func newLogFilter(filter string, matchCase bool, regex bool) (func(line string) bool, error) {
+	if filter == "" {
+		return func(string) bool { return true }, nil
+	}
+	inverse := false
+	if filter[0] == '!' {
+		filter = filter[1:]
+		inverse = true
+	}
+	var matches func(line string) bool
+	switch {
+	case regex:
+		if !matchCase {
+			filter = "(?i)" + filter
+		}
+		re, err := regexp.Compile(filter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter regular expression: %w", err)
+		}
+		matches = re.MatchString
+	case matchCase:
+		matches = func(line string) bool {
+			return strings.Contains(line, filter)
+		}
+	default:
+		lowerFilter := strings.ToLower(filter)
+		matches = func(line string) bool {
+			return strings.Contains(strings.ToLower(line), lowerFilter)
+		}
+	}
+	return func(line string) bool {
+		return matches(line) != inverse
+	}, nil
+}
+
 // mergeLogStreams merge two stream of logs and ensures that merged logs are sorted by timestamp.
 // The implementation uses merge sort: method reads next log entry from each stream if one of streams is empty
 // it waits for no longer than specified duration and then merges available entries.
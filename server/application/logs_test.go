@@ -18,7 +18,7 @@ func TestParseLogsStream_Successful(t *testing.T) {
 
 	res := make(chan logEntry)
 	go func() {
-		parseLogsStream(t.Context(), "test", r, res)
+		parseLogsStream(t.Context(), "test", "main", r, res)
 		close(res)
 	}()
 
@@ -31,18 +31,55 @@ func TestParseLogsStream_Successful(t *testing.T) {
 	}
 
 	assert.Equal(t, []logEntry{
-		{timeStamp: expectedTimestamp, podName: "test", line: "hello"},
-		{timeStamp: expectedTimestamp, podName: "test", line: "world"},
+		{timeStamp: expectedTimestamp, podName: "test", containerName: "main", line: "hello"},
+		{timeStamp: expectedTimestamp, podName: "test", containerName: "main", line: "world"},
 	}, entries)
 }
 
+func TestNewLogFilter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		filter    string
+		matchCase bool
+		regex     bool
+		matches   []string
+		skips     []string
+	}{
+		{name: "empty", filter: "", matches: []string{"anything", ""}},
+		{name: "literal", filter: "error", matches: []string{"an ERROR occurred", "error"}, skips: []string{"all good"}},
+		{name: "literal match case", filter: "error", matchCase: true, matches: []string{"error"}, skips: []string{"ERROR"}},
+		{name: "inverse literal", filter: "!error", matches: []string{"all good"}, skips: []string{"an error occurred"}},
+		{name: "regex", filter: "warn(ing)?|error", regex: true, matches: []string{"WARNING: disk", "error"}, skips: []string{"info"}},
+		{name: "regex match case", filter: "^ERROR", regex: true, matchCase: true, matches: []string{"ERROR: boom"}, skips: []string{"error: boom", "an ERROR"}},
+		{name: "inverse regex", filter: "!^level=(debug|trace)", regex: true, matches: []string{"level=info"}, skips: []string{"level=debug msg", "LEVEL=TRACE"}},
+		{name: "regex characters in literal", filter: "a.b", matches: []string{"a.b"}, skips: []string{"axb"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			matches, err := newLogFilter(tt.filter, tt.matchCase, tt.regex)
+			require.NoError(t, err)
+			for _, line := range tt.matches {
+				assert.True(t, matches(line), line)
+			}
+			for _, line := range tt.skips {
+				assert.False(t, matches(line), line)
+			}
+		})
+	}
+
+	_, err := newLogFilter("(unclosed", false, true)
+	require.ErrorContains(t, err, "invalid filter regular expression")
+}
+
 func TestParseLogsStream_ParsingError(t *testing.T) {
 	t.Parallel()
 	r := io.NopCloser(strings.NewReader(`hello world`))
 
 	res := make(chan logEntry)
 	go func() {
-		parseLogsStream(t.Context(), "test", r, res)
+		parseLogsStream(t.Context(), "test", "", r, res)
 		close(res)
 	}()
 
@@ -59,14 +96,14 @@ func TestMergeLogStreams(t *testing.T) {
 	t.Parallel()
 	first := make(chan logEntry)
 	go func() {
-		parseLogsStream(t.Context(), "first", io.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1
+		parseLogsStream(t.Context(), "first", "", io.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1
 2021-02-09T00:00:03Z 3`)), first)
 		close(first)
 	}()
 
 	second := make(chan logEntry)
 	go func() {
-		parseLogsStream(t.Context(), "second", io.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2
+		parseLogsStream(t.Context(), "second", "", io.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2
 2021-02-09T00:00:04Z 4`)), second)
 		close(second)
 	}()
@@ -87,13 +124,13 @@ func TestMergeLogStreams_RaceCondition(t *testing.T) {
 		second := make(chan logEntry)
 
 		go func() {
-			parseLogsStream(t.Context(), "first", io.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1`)), first)
+			parseLogsStream(t.Context(), "first", "", io.NopCloser(strings.NewReader(`2021-02-09T00:00:01Z 1`)), first)
 			time.Sleep(time.Duration(i%3) * time.Millisecond)
 			close(first)
 		}()
 
 		go func() {
-			parseLogsStream(t.Context(), "second", io.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2`)), second)
+			parseLogsStream(t.Context(), "second", "", io.NopCloser(strings.NewReader(`2021-02-09T00:00:02Z 2`)), second)
 			time.Sleep(time.Duration((i+1)%3) * time.Millisecond)
 			close(second)
 		}()
@@ -121,7 +158,7 @@ func TestMergeLogStreams_ContextCancellation(t *testing.T) {
 
 	ch := make(chan logEntry)
 	go func() {
-		parseLogsStream(ctx, "test", pr, ch)
+		parseLogsStream(ctx, "test", "", pr, ch)
 		close(ch)
 	}()
 
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	apiOperationSync    = "sync"
+	apiOperationRefresh = "refresh"
+	apiOperationGet     = "get"
+)
+
+// MetricsRegistry collects the metrics of the application server
+type MetricsRegistry interface {
+	IncAPIRateLimitedRequest(project string, operation string)
+}
+
+type apiRateLimiterKey struct {
+	project   string
+	operation string
+}
+
+// apiRateLimiterPruneInterval is the interval at which the limiters which are not limiting any request are removed
+const apiRateLimiterPruneInterval = time.Minute
+
+type apiRateLimiterEntry struct {
+	limit   v1alpha1.APIRateLimit
+	limiter *rate.Limiter
+}
+
+// apiRateLimiter limits the rate of the API requests on the applications of each project, as configured in the API
+// rate limits of the projects
+type apiRateLimiter struct {
+	lock       sync.Mutex
+	limiters   map[apiRateLimiterKey]*apiRateLimiterEntry
+	lastPruned time.Time
+	now        func() time.Time
+}
+
+func newAPIRateLimiter() *apiRateLimiter {
+	return &apiRateLimiter{limiters: map[apiRateLimiterKey]*apiRateLimiterEntry{}, lastPruned: time.Now(), now: time.Now}
+}
+
+// allow returns whether a request of the given operation in the given project is allowed under the given limit
+func (l *apiRateLimiter) allow(project, operation string, limit v1alpha1.APIRateLimit) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	now := l.now()
+	if now.Sub(l.lastPruned) >= apiRateLimiterPruneInterval {
+		l.prune(now)
+	}
+	key := apiRateLimiterKey{project: project, operation: operation}
+	entry, ok := l.limiters[key]
+	if !ok || entry.limit != limit {
+		entry = &apiRateLimiterEntry{limit: limit, limiter: rate.NewLimiter(rate.Limit(float64(limit.PerMinute)/60), limit.GetBurst())}
+		l.limiters[key] = entry
+	}
+	return entry.limiter.AllowN(now, 1)
+}
+
+// prune removes the limiters whose bucket is full again, e.g. those of deleted projects or removed limits. Removing
+// them is lossless, since a new limiter starts with a full bucket.
+func (l *apiRateLimiter) prune(now time.Time) {
+	for key, entry := range l.limiters {
+		if entry.limiter.TokensAt(now) >= float64(entry.limiter.Burst()) {
+			delete(l.limiters, key)
+		}
+	}
+	l.lastPruned = now
+}
+
+// getAPIRateLimit returns the rate limit of the given operation in the project, or nil if the operation is not limited
+func getAPIRateLimit(proj *v1alpha1.AppProject, operation string) *v1alpha1.APIRateLimit {
+	limits := proj.Spec.APIRateLimits
+	if limits == nil {
+		return nil
+	}
+	switch operation {
+	case apiOperationSync:
+		return limits.Sync
+	case apiOperationRefresh:
+		return limits.Refresh
+	case apiOperationGet:
+		return limits.Get
+	}
+	return nil
+}
+
+// enforceAPIRateLimit returns a ResourceExhausted error, which is served as 429 Too Many Requests over HTTP, if the
+// request of the given operation exceeds the API rate limit of the project
+func (s *Server) enforceAPIRateLimit(proj *v1alpha1.AppProject, operation string) error {
+	limit := getAPIRateLimit(proj, operation)
+	if limit == nil || limit.PerMinute <= 0 {
+		return nil
+	}
+	if s.apiLimiter.allow(proj.Name, operation, *limit) {
+		return nil
+	}
+	log.WithFields(log.Fields{"project": proj.Name, "operation": operation}).Info("API rate limit exceeded")
+	if s.metricsRegistry != nil {
+		s.metricsRegistry.IncAPIRateLimitedRequest(proj.Name, operation)
+	}
+	return status.Errorf(codes.ResourceExhausted, "%s requests of project '%s' exceed the limit of %d per minute, retry later", operation, proj.Name, limit.PerMinute)
+}
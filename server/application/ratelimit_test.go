@@ -0,0 +1,92 @@
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeMetricsRegistry struct {
+	rateLimited map[string]int
+}
+
+func (r *fakeMetricsRegistry) IncAPIRateLimitedRequest(project string, operation string) {
+	r.rateLimited[project+"/"+operation]++
+}
+
+func TestAPIRateLimiter(t *testing.T) {
+	limiter := newAPIRateLimiter()
+	limit := v1alpha1.APIRateLimit{PerMinute: 1, Burst: 2}
+
+	assert.True(t, limiter.allow("team-a", apiOperationSync, limit))
+	assert.True(t, limiter.allow("team-a", apiOperationSync, limit))
+	assert.False(t, limiter.allow("team-a", apiOperationSync, limit))
+	// the limit is per project and operation
+	assert.True(t, limiter.allow("team-a", apiOperationGet, limit))
+	assert.True(t, limiter.allow("team-b", apiOperationSync, limit))
+
+	// the limiter is reset when the limit changes
+	assert.True(t, limiter.allow("team-a", apiOperationSync, v1alpha1.APIRateLimit{PerMinute: 1}))
+	assert.False(t, limiter.allow("team-a", apiOperationSync, v1alpha1.APIRateLimit{PerMinute: 1}))
+}
+
+func TestAPIRateLimiterPrune(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	limiter := newAPIRateLimiter()
+	limiter.now = func() time.Time { return now }
+	limiter.lastPruned = now
+	limit := v1alpha1.APIRateLimit{PerMinute: 1, Burst: 2}
+
+	assert.True(t, limiter.allow("team-a", apiOperationSync, limit))
+	assert.True(t, limiter.allow("team-a", apiOperationSync, limit))
+	assert.True(t, limiter.allow("deleted", apiOperationSync, limit))
+	assert.Len(t, limiter.limiters, 2)
+
+	// after a minute, the bucket of the deleted project is full again and its limiter is removed, while the bucket of
+	// team-a still limits its requests
+	now = now.Add(apiRateLimiterPruneInterval)
+	assert.True(t, limiter.allow("team-a", apiOperationSync, limit))
+	assert.Len(t, limiter.limiters, 1)
+	assert.False(t, limiter.allow("team-a", apiOperationSync, limit))
+
+	// once every bucket is full again, all the limiters are removed
+	now = now.Add(10 * apiRateLimiterPruneInterval)
+	assert.True(t, limiter.allow("team-b", apiOperationGet, limit))
+	assert.Len(t, limiter.limiters, 1)
+}
+
+func TestEnforceAPIRateLimit(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "limited", Namespace: testNamespace},
+		Spec: v1alpha1.AppProjectSpec{
+			SourceRepos:   []string{"*"},
+			Destinations:  []v1alpha1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			APIRateLimits: &v1alpha1.ProjectAPIRateLimits{Get: &v1alpha1.APIRateLimit{PerMinute: 1}},
+		},
+	}
+	app := newTestApp(func(app *v1alpha1.Application) {
+		app.Spec.Project = proj.Name
+	})
+	appServer := newTestAppServer(t, proj, app)
+	metricsRegistry := &fakeMetricsRegistry{rateLimited: map[string]int{}}
+	appServer.metricsRegistry = metricsRegistry
+
+	_, err := appServer.Get(t.Context(), &application.ApplicationQuery{Name: &app.Name})
+	require.NoError(t, err)
+	_, err = appServer.Get(t.Context(), &application.ApplicationQuery{Name: &app.Name})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.ErrorContains(t, err, "get requests of project 'limited' exceed the limit of 1 per minute")
+	assert.Equal(t, map[string]int{"limited/get": 1}, metricsRegistry.rateLimited)
+
+	// operations without a limit are not limited
+	require.NoError(t, appServer.enforceAPIRateLimit(proj, apiOperationSync))
+	require.NoError(t, appServer.enforceAPIRateLimit(proj, apiOperationSync))
+}
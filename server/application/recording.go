@@ -0,0 +1,444 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/objectstore"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// recordingExtension is the file extension of the terminal session recordings
+	recordingExtension = ".cast"
+	// recordingContentType is the content type of the terminal session recordings
+	recordingContentType = "application/x-asciicast"
+	// recordingUploadTimeout is the timeout of the upload of a recording to the object store once the session ended
+	recordingUploadTimeout = time.Minute
+)
+
+// RecordingStore stores the terminal session recordings of applications
+type RecordingStore interface {
+	// Create returns a writer for a new recording of the application. The recording is stored once the writer is
+	// closed.
+	Create(ctx context.Context, a *appv1.Application, name string) (io.WriteCloser, error)
+	// List returns the recordings of the application, the most recent first
+	List(ctx context.Context, a *appv1.Application) ([]SessionRecording, error)
+	// Open returns the content of a recording of the application, or an error wrapping os.ErrNotExist if it does not
+	// exist
+	Open(ctx context.Context, a *appv1.Application, name string) (io.ReadCloser, error)
+}
+
+// RecordingStoreFunc returns the store the terminal session recordings are written to, or nil if session recording is
+// not configured
+type RecordingStoreFunc func(ctx context.Context) (RecordingStore, error)
+
+// NewRecordingStoreFunc returns a function returning the recording store configured in argocd-cm: the object store
+// configured by exec.recording.store if any, otherwise the directory configured by exec.recording.path
+func NewRecordingStoreFunc(settingsMgr *settings.SettingsManager) RecordingStoreFunc {
+	return func(ctx context.Context) (RecordingStore, error) {
+		storeSettings, err := settingsMgr.GetExecRecordingStoreSettings()
+		if err != nil {
+			return nil, fmt.Errorf("error getting recording store settings: %w", err)
+		}
+		if storeSettings != nil {
+			store, err := objectstore.NewS3Store(ctx, objectstore.S3Config{
+				Bucket:          storeSettings.Bucket,
+				Prefix:          storeSettings.Prefix,
+				Endpoint:        storeSettings.Endpoint,
+				Region:          storeSettings.Region,
+				AccessKeyID:     storeSettings.AccessKeyID,
+				SecretAccessKey: storeSettings.SecretAccessKey,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error creating recording store: %w", err)
+			}
+			return &objectRecordingStore{store: store}, nil
+		}
+		argocdSettings, err := settingsMgr.GetSettings()
+		if err != nil {
+			return nil, fmt.Errorf("error getting settings: %w", err)
+		}
+		if argocdSettings.ExecRecordingPath == "" {
+			return nil, nil
+		}
+		return &dirRecordingStore{dir: argocdSettings.ExecRecordingPath}, nil
+	}
+}
+
+// dirRecordingStore stores the recordings in a directory of the API server, under <namespace>/<name>/ for each
+// application
+type dirRecordingStore struct {
+	dir string
+}
+
+func (s *dirRecordingStore) appDir(a *appv1.Application) string {
+	return filepath.Join(s.dir, a.Namespace, a.Name)
+}
+
+func (s *dirRecordingStore) Create(_ context.Context, a *appv1.Application, name string) (io.WriteCloser, error) {
+	appDir := s.appDir(a)
+	if err := os.MkdirAll(appDir, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating recording directory: %w", err)
+	}
+	file, err := os.OpenFile(filepath.Join(appDir, name), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording file: %w", err)
+	}
+	return file, nil
+}
+
+func (s *dirRecordingStore) List(_ context.Context, a *appv1.Application) ([]SessionRecording, error) {
+	return listRecordings(s.appDir(a))
+}
+
+func (s *dirRecordingStore) Open(_ context.Context, a *appv1.Application, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.appDir(a), name))
+}
+
+// objectRecordingStore stores the recordings in an object store, with the <namespace>/<name>/ key prefix for each
+// application
+type objectRecordingStore struct {
+	store objectstore.Store
+}
+
+func recordingPrefix(a *appv1.Application) string {
+	return path.Join(a.Namespace, a.Name) + "/"
+}
+
+func (s *objectRecordingStore) Create(ctx context.Context, a *appv1.Application, name string) (io.WriteCloser, error) {
+	// the recording is uploaded once the session ended, even if the request was canceled
+	return &objectRecordingWriter{ctx: context.WithoutCancel(ctx), store: s.store, key: recordingPrefix(a) + name}, nil
+}
+
+func (s *objectRecordingStore) List(ctx context.Context, a *appv1.Application) ([]SessionRecording, error) {
+	keys, err := s.store.List(ctx, recordingPrefix(a))
+	if err != nil {
+		return nil, err
+	}
+	recordings := make([]SessionRecording, 0, len(keys))
+	for _, key := range keys {
+		name := path.Base(key)
+		if key != recordingPrefix(a)+name || !isValidRecordingName(name) {
+			continue
+		}
+		recordings = append(recordings, SessionRecording{Name: name, StartedAt: recordingStartTime(name, time.Time{})})
+	}
+	sortRecordings(recordings)
+	return recordings, nil
+}
+
+func (s *objectRecordingStore) Open(ctx context.Context, a *appv1.Application, name string) (io.ReadCloser, error) {
+	data, err := s.store.Get(ctx, recordingPrefix(a)+name)
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// objectRecordingWriter buffers a recording in memory, and uploads it to the object store when it is closed
+type objectRecordingWriter struct {
+	bytes.Buffer
+	ctx   context.Context
+	store objectstore.Store
+	key   string
+}
+
+func (w *objectRecordingWriter) Close() error {
+	ctx, cancel := context.WithTimeout(w.ctx, recordingUploadTimeout)
+	defer cancel()
+	return w.store.Put(ctx, w.key, w.Bytes())
+}
+
+// asciicastHeader is the header of an asciicast v2 recording
+type asciicastHeader struct {
+	Version   int    `json:"version"`
+	Width     uint16 `json:"width"`
+	Height    uint16 `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+	Title     string `json:"title,omitempty"`
+}
+
+// sessionRecorder records a terminal session in the asciicast v2 format, which can be replayed with asciinema.
+// All methods are no-ops on a nil recorder.
+type sessionRecorder struct {
+	lock  sync.Mutex
+	name  string
+	file  io.WriteCloser
+	start time.Time
+}
+
+// newSessionRecorder creates the recording of a terminal session of the given application in the given store
+func newSessionRecorder(ctx context.Context, store RecordingStore, a *appv1.Application, user, podNamespace, podName, container string) (*sessionRecorder, error) {
+	start := time.Now()
+	name := fmt.Sprintf("%d-%s-%s%s", start.UnixNano(), podName, container, recordingExtension)
+	file, err := store.Create(ctx, a, name)
+	if err != nil {
+		return nil, err
+	}
+	recorder := &sessionRecorder{name: name, file: file, start: start}
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     80,
+		Height:    24,
+		Timestamp: start.Unix(),
+		Title:     fmt.Sprintf("%s@%s/%s/%s", user, podNamespace, podName, container),
+	})
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if err := recorder.writeLine(header); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return recorder, nil
+}
+
+func (r *sessionRecorder) writeLine(line []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err := r.file.Write(append(line, '\n'))
+	return err
+}
+
+func (r *sessionRecorder) recordEvent(code string, data string) error {
+	if r == nil {
+		return nil
+	}
+	event, err := json.Marshal([]any{time.Since(r.start).Seconds(), code, data})
+	if err != nil {
+		return err
+	}
+	return r.writeLine(event)
+}
+
+// recordOutput records the output of the terminal
+func (r *sessionRecorder) recordOutput(p []byte) error {
+	return r.recordEvent("o", string(p))
+}
+
+// recordInput records the input of the user
+func (r *sessionRecorder) recordInput(data string) error {
+	return r.recordEvent("i", data)
+}
+
+// recordResize records a resize of the terminal
+func (r *sessionRecorder) recordResize(cols, rows uint16) error {
+	return r.recordEvent("r", fmt.Sprintf("%dx%d", cols, rows))
+}
+
+// Close closes the recording
+func (r *sessionRecorder) Close() error {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.file.Close()
+}
+
+// SessionRecording describes a recorded terminal session
+type SessionRecording struct {
+	Name string `json:"name"`
+	// Size is the size of the recording in bytes. It is not known for the recordings kept in an object store.
+	Size      int64     `json:"size,omitempty"`
+	StartedAt time.Time `json:"startedAt"`
+}
+
+type recordingHandler struct {
+	appLister         applisters.ApplicationLister
+	namespace         string
+	enabledNamespaces []string
+	getRecordingStore RecordingStoreFunc
+	terminalOptions   *TerminalOptions
+}
+
+// NewRecordingHandler returns a handler listing and replaying the recorded terminal sessions of an application.
+func NewRecordingHandler(appLister applisters.ApplicationLister, namespace string, enabledNamespaces []string, getRecordingStore RecordingStoreFunc, terminalOptions *TerminalOptions) *recordingHandler {
+	return &recordingHandler{
+		appLister:         appLister,
+		namespace:         namespace,
+		enabledNamespaces: enabledNamespaces,
+		getRecordingStore: getRecordingStore,
+		terminalOptions:   terminalOptions,
+	}
+}
+
+// ServeHTTP lists the recorded terminal sessions of an application, or returns the recording given by the recording
+// query parameter
+func (s *recordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	app := q.Get("appName")
+	project := q.Get("projectName")
+	appNamespace := q.Get("appNamespace")
+	recording := q.Get("recording")
+
+	if app == "" || project == "" {
+		http.Error(w, "Missing required parameters", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidAppName(app) {
+		http.Error(w, "App name is not valid", http.StatusBadRequest)
+		return
+	}
+	if !argo.IsValidProjectName(project) {
+		http.Error(w, "Project name is not valid", http.StatusBadRequest)
+		return
+	}
+	if appNamespace != "" && !argo.IsValidNamespaceName(appNamespace) {
+		http.Error(w, "App namespace name is not valid", http.StatusBadRequest)
+		return
+	}
+	if recording != "" && !isValidRecordingName(recording) {
+		http.Error(w, "Recording name is not valid", http.StatusBadRequest)
+		return
+	}
+
+	ns := appNamespace
+	if ns == "" {
+		ns = s.namespace
+	}
+
+	if !security.IsNamespaceEnabled(ns, s.namespace, s.enabledNamespaces) {
+		http.Error(w, security.NamespaceNotPermittedError(ns).Error(), http.StatusForbidden)
+		return
+	}
+
+	ctx := r.Context()
+
+	appRBACName := security.RBACName(s.namespace, project, appNamespace, app)
+	if err := s.terminalOptions.Enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, appRBACName); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := s.terminalOptions.Enf.EnforceErr(ctx.Value("claims"), rbac.ResourceExec, rbac.ActionReplay, appRBACName); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	a, err := s.appLister.Applications(ns).Get(app)
+	if err != nil {
+		http.Error(w, "App not found", http.StatusNotFound)
+		return
+	}
+	if a.Spec.Project != project {
+		http.Error(w, "The wrong project was specified for the app", http.StatusBadRequest)
+		return
+	}
+
+	store, err := s.getRecordingStore(ctx)
+	if err != nil {
+		log.Errorf("error getting the recording store: %s", err)
+		http.Error(w, "Failed to get the recording store", http.StatusInternalServerError)
+		return
+	}
+	if store == nil {
+		http.Error(w, "Session recording is not configured", http.StatusNotFound)
+		return
+	}
+
+	if recording == "" {
+		recordings, err := store.List(ctx, a)
+		if err != nil {
+			log.Errorf("error listing the recordings of app %q: %s", app, err)
+			http.Error(w, "Failed to list recordings", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(recordings); err != nil {
+			log.Errorf("error writing the recordings of app %q: %s", app, err)
+		}
+		return
+	}
+
+	file, err := store.Open(ctx, a, recording)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			http.Error(w, "Recording not found", http.StatusNotFound)
+			return
+		}
+		log.Errorf("error opening recording %q of app %q: %s", recording, app, err)
+		http.Error(w, "Failed to open recording", http.StatusInternalServerError)
+		return
+	}
+	defer file.Close()
+	w.Header().Set("Content-Type", recordingContentType)
+	if _, err := io.Copy(w, file); err != nil {
+		log.Errorf("error writing recording %q of app %q: %s", recording, app, err)
+	}
+}
+
+// isValidRecordingName returns whether the given name is the name of a recording file, without any path
+func isValidRecordingName(name string) bool {
+	return filepath.Base(name) == name && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, recordingExtension)
+}
+
+// listRecordings returns the recordings of the given directory, the most recent first
+func listRecordings(dir string) ([]SessionRecording, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return []SessionRecording{}, nil
+		}
+		return nil, err
+	}
+	recordings := make([]SessionRecording, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !isValidRecordingName(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+		recordings = append(recordings, SessionRecording{
+			Name:      entry.Name(),
+			Size:      info.Size(),
+			StartedAt: recordingStartTime(entry.Name(), info.ModTime()),
+		})
+	}
+	sortRecordings(recordings)
+	return recordings, nil
+}
+
+// sortRecordings sorts the given recordings, the most recent first
+func sortRecordings(recordings []SessionRecording) {
+	slices.SortFunc(recordings, func(a, b SessionRecording) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+}
+
+// recordingStartTime returns the start time encoded in the name of a recording, or the given fallback
+func recordingStartTime(name string, fallback time.Time) time.Time {
+	prefix, _, _ := strings.Cut(name, "-")
+	var nanos int64
+	if _, err := fmt.Sscanf(prefix, "%d", &nanos); err != nil {
+		return fallback
+	}
+	return time.Unix(0, nanos).UTC()
+}
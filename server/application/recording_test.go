@@ -0,0 +1,174 @@
+package application
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+)
+
+func TestSessionRecorder(t *testing.T) {
+	dir := t.TempDir()
+	app := &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace}}
+
+	recorder, err := newSessionRecorder(t.Context(), &dirRecordingStore{dir: dir}, app, "alice", "guestbook", "guestbook-pod", "main")
+	require.NoError(t, err)
+	require.NoError(t, recorder.recordResize(120, 40))
+	require.NoError(t, recorder.recordInput("ls\r"))
+	require.NoError(t, recorder.recordOutput([]byte("file\r\n")))
+	require.NoError(t, recorder.Close())
+
+	file, err := os.Open(filepath.Join(dir, testNamespace, "guestbook", recorder.name))
+	require.NoError(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+
+	require.True(t, scanner.Scan())
+	var header asciicastHeader
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	assert.Equal(t, 2, header.Version)
+	assert.Equal(t, "alice@guestbook/guestbook-pod/main", header.Title)
+
+	var events [][]any
+	for scanner.Scan() {
+		var event []any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.Len(t, events, 3)
+	assert.Equal(t, []any{"r", "120x40"}, events[0][1:])
+	assert.Equal(t, []any{"i", "ls\r"}, events[1][1:])
+	assert.Equal(t, []any{"o", "file\r\n"}, events[2][1:])
+
+	var nilRecorder *sessionRecorder
+	require.NoError(t, nilRecorder.recordOutput([]byte("ignored")))
+	require.NoError(t, nilRecorder.Close())
+}
+
+func TestObjectRecordingStore(t *testing.T) {
+	objects := &fakeObjectStore{objects: map[string][]byte{}}
+	store := &objectRecordingStore{store: objects}
+	app := &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace}}
+	other := &appv1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-other", Namespace: testNamespace}}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	recorder, err := newSessionRecorder(ctx, store, app, "alice", "guestbook", "guestbook-pod", "main")
+	require.NoError(t, err)
+	require.NoError(t, recorder.recordOutput([]byte("file\r\n")))
+	assert.Empty(t, objects.objects)
+	// the recording is stored when the session ends, even though the request is already canceled
+	cancel()
+	require.NoError(t, recorder.Close())
+	require.Contains(t, objects.objects, testNamespace+"/guestbook/"+recorder.name)
+
+	objects.objects[testNamespace+"/guestbook/1700000000000000000-pod-main.cast"] = []byte("first\n")
+	objects.objects[testNamespace+"/guestbook-other/1800000000000000000-pod-main.cast"] = []byte("other\n")
+	recordings, err := store.List(t.Context(), app)
+	require.NoError(t, err)
+	require.Len(t, recordings, 2)
+	assert.Equal(t, recorder.name, recordings[0].Name)
+	assert.Equal(t, "1700000000000000000-pod-main.cast", recordings[1].Name)
+	assert.Equal(t, time.Unix(0, 1700000000000000000).UTC(), recordings[1].StartedAt)
+
+	file, err := store.Open(t.Context(), app, "1700000000000000000-pod-main.cast")
+	require.NoError(t, err)
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "first\n", string(data))
+
+	_, err = store.Open(t.Context(), other, "1700000000000000000-pod-main.cast")
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestIsValidRecordingName(t *testing.T) {
+	assert.True(t, isValidRecordingName("1700000000000000000-pod-main.cast"))
+	assert.False(t, isValidRecordingName("1700000000000000000-pod-main.log"))
+	assert.False(t, isValidRecordingName("../other/1700000000000000000-pod-main.cast"))
+	assert.False(t, isValidRecordingName(".cast"))
+}
+
+func TestRecordingHandler(t *testing.T) {
+	dir := t.TempDir()
+	app := &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec:       appv1.ApplicationSpec{Project: "default"},
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, indexer.Add(app))
+
+	appDir := filepath.Join(dir, testNamespace, "guestbook")
+	require.NoError(t, os.MkdirAll(appDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "1700000000000000000-pod-main.cast"), []byte("first\n"), 0o640))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "1800000000000000000-pod-main.cast"), []byte("second\n"), 0o640))
+
+	newHandler := func(allowed bool) *recordingHandler {
+		enf := newEnforcer()
+		enf.SetClaimsEnforcerFunc(func(_ jwt.Claims, _ ...any) bool {
+			return allowed
+		})
+		getRecordingStore := func(context.Context) (RecordingStore, error) {
+			return &dirRecordingStore{dir: dir}, nil
+		}
+		return NewRecordingHandler(applisters.NewApplicationLister(indexer), testNamespace, nil, getRecordingStore, &TerminalOptions{Enf: enf})
+	}
+	serve := func(handler *recordingHandler, query string) *httptest.ResponseRecorder {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"auditor"}})
+		request := httptest.NewRequestWithContext(ctx, http.MethodGet, "https://argocd.example.com/terminal/recordings?"+query, http.NoBody)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	t.Run("list", func(t *testing.T) {
+		response := serve(newHandler(true), "appName=guestbook&projectName=default")
+		require.Equal(t, http.StatusOK, response.Code)
+		var recordings []SessionRecording
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &recordings))
+		require.Len(t, recordings, 2)
+		assert.Equal(t, "1800000000000000000-pod-main.cast", recordings[0].Name)
+		assert.Equal(t, "1700000000000000000-pod-main.cast", recordings[1].Name)
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		response := serve(newHandler(true), "appName=guestbook&projectName=default&recording=1700000000000000000-pod-main.cast")
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, recordingContentType, response.Header().Get("Content-Type"))
+		assert.Equal(t, "first\n", response.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		response := serve(newHandler(true), "appName=guestbook&projectName=default&recording=1900000000000000000-pod-main.cast")
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("invalid recording", func(t *testing.T) {
+		response := serve(newHandler(true), "appName=guestbook&projectName=default&recording=../../secret.cast")
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("wrong project", func(t *testing.T) {
+		response := serve(newHandler(true), "appName=guestbook&projectName=other")
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("permission denied", func(t *testing.T) {
+		response := serve(newHandler(false), "appName=guestbook&projectName=default")
+		assert.Equal(t, http.StatusUnauthorized, response.Code)
+	})
+}
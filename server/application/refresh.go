@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+// maxIdleRefreshLimiters is the number of per user limiters above which the limiters of idle users are dropped
+const maxIdleRefreshLimiters = 1000
+
+// refreshRateLimiter limits the rate of the refreshes each user can request ahead of the periodic reconciliations
+type refreshRateLimiter struct {
+	lock     sync.Mutex
+	limit    v1alpha1.ReconcileRateLimit
+	limiters map[string]*rate.Limiter
+}
+
+func newRefreshRateLimiter() *refreshRateLimiter {
+	return &refreshRateLimiter{limiters: map[string]*rate.Limiter{}}
+}
+
+// allow returns whether the user is allowed to request an interactive refresh under the given limit
+func (l *refreshRateLimiter) allow(user string, limit v1alpha1.ReconcileRateLimit) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if l.limit != limit {
+		l.limit = limit
+		clear(l.limiters)
+	}
+	limiter, ok := l.limiters[user]
+	if !ok {
+		if len(l.limiters) >= maxIdleRefreshLimiters {
+			l.dropIdleLimiters()
+		}
+		limiter = rate.NewLimiter(rate.Limit(float64(limit.PerMinute)/60), limit.GetBurst())
+		l.limiters[user] = limiter
+	}
+	return limiter.Allow()
+}
+
+// dropIdleLimiters drops the limiters whose bucket is full, which behave like new limiters
+func (l *refreshRateLimiter) dropIdleLimiters() {
+	for user, limiter := range l.limiters {
+		if limiter.Tokens() >= float64(limiter.Burst()) {
+			delete(l.limiters, user)
+		}
+	}
+}
+
+// getRefreshPriority returns the priority of a refresh requested by the user of the context. Refreshes are processed
+// ahead of the periodic reconciliations, unless the user exceeded the interactive refresh rate limit.
+func (s *Server) getRefreshPriority(ctx context.Context) v1alpha1.RefreshPriority {
+	limit, err := s.settingsMgr.GetInteractiveRefreshRateLimit()
+	if err != nil {
+		log.Warnf("Failed to get the interactive refresh rate limit: %v", err)
+		return ""
+	}
+	if limit == nil {
+		return ""
+	}
+	user := session.GetUserIdentifier(ctx)
+	if !s.refreshLimiter.allow(user, *limit) {
+		log.WithField("user", user).Info("Interactive refresh rate limit exceeded, refreshing with normal priority")
+		return ""
+	}
+	return v1alpha1.RefreshPriorityInteractive
+}
@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+func TestRefreshRateLimiter(t *testing.T) {
+	limiter := newRefreshRateLimiter()
+	limit := v1alpha1.ReconcileRateLimit{PerMinute: 1, Burst: 2}
+
+	assert.True(t, limiter.allow("alice", limit))
+	assert.True(t, limiter.allow("alice", limit))
+	assert.False(t, limiter.allow("alice", limit))
+	// the limit is per user
+	assert.True(t, limiter.allow("bob", limit))
+
+	// the limiters are reset when the limit changes
+	assert.True(t, limiter.allow("alice", v1alpha1.ReconcileRateLimit{PerMinute: 1}))
+	assert.False(t, limiter.allow("alice", v1alpha1.ReconcileRateLimit{PerMinute: 1}))
+}
+
+func TestGetRefreshPriority(t *testing.T) {
+	//nolint:staticcheck
+	aliceCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "alice"})
+	//nolint:staticcheck
+	bobCtx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"sub": "bob"})
+	noopEnforcer := func(_ *rbac.Enforcer) {}
+
+	t.Run("RateLimited", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(t, noopEnforcer, map[string]string{"application.refresh.interactive.perMinute": "1"})
+		assert.Equal(t, v1alpha1.RefreshPriorityInteractive, appServer.getRefreshPriority(aliceCtx))
+		assert.Equal(t, v1alpha1.RefreshPriority(""), appServer.getRefreshPriority(aliceCtx))
+		assert.Equal(t, v1alpha1.RefreshPriorityInteractive, appServer.getRefreshPriority(bobCtx))
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(t, noopEnforcer, map[string]string{"application.refresh.interactive.perMinute": "0"})
+		assert.Equal(t, v1alpha1.RefreshPriority(""), appServer.getRefreshPriority(aliceCtx))
+	})
+}
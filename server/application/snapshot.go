@@ -0,0 +1,328 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	jsonpatch "github.com/evanphx/json-patch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/objectstore"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+const (
+	snapshotOperationCreated   = "created"
+	snapshotOperationPatched   = "patched"
+	snapshotOperationUnchanged = "unchanged"
+)
+
+// snapshotIDRegex matches the IDs generated by newSnapshotID
+var snapshotIDRegex = regexp.MustCompile(`^\d{8}-\d{6}-[a-z0-9]{5}$`)
+
+// snapshot is the document stored in the snapshot store for each snapshot of an application
+type snapshot struct {
+	ID          string                       `json:"id"`
+	CreatedAt   metav1.Time                  `json:"createdAt"`
+	CreatedBy   string                       `json:"createdBy,omitempty"`
+	Application string                       `json:"application"`
+	Project     string                       `json:"project"`
+	Revisions   []string                     `json:"revisions,omitempty"`
+	Resources   []*unstructured.Unstructured `json:"resources"`
+}
+
+// newSnapshotID returns a snapshot ID which sorts in creation order
+func newSnapshotID(now time.Time) string {
+	return now.UTC().Format("20060102-150405") + "-" + rand.String(5)
+}
+
+// snapshotPrefix returns the prefix of the keys of the snapshots of the application in the snapshot store
+func snapshotPrefix(a *v1alpha1.Application) string {
+	return path.Join(a.Namespace, a.Name) + "/"
+}
+
+func snapshotKey(a *v1alpha1.Application, id string) string {
+	return snapshotPrefix(a) + id + ".json"
+}
+
+// newSnapshotStore returns the snapshot store configured in argocd-cm
+func (s *Server) newSnapshotStore(ctx context.Context) (objectstore.Store, error) {
+	storeSettings, err := s.settingsMgr.GetSnapshotStoreSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting snapshot store settings: %w", err)
+	}
+	if storeSettings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "application snapshots are disabled: snapshots.store is not configured")
+	}
+	return objectstore.NewS3Store(ctx, objectstore.S3Config{
+		Bucket:          storeSettings.Bucket,
+		Prefix:          storeSettings.Prefix,
+		Endpoint:        storeSettings.Endpoint,
+		Region:          storeSettings.Region,
+		AccessKeyID:     storeSettings.AccessKeyID,
+		SecretAccessKey: storeSettings.SecretAccessKey,
+	})
+}
+
+// stripLiveState removes the status and the fields which are set by the API server from the object, so that it can be
+// created again or compared with another copy of the same resource
+func stripLiveState(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range []string{"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink", "deletionTimestamp", "deletionGracePeriodSeconds", "ownerReferences"} {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+	return obj
+}
+
+func (snap *snapshot) toAPI() *application.ApplicationSnapshot {
+	res := &application.ApplicationSnapshot{
+		Id:        new(snap.ID),
+		CreatedAt: &snap.CreatedAt,
+		CreatedBy: new(snap.CreatedBy),
+		Revisions: snap.Revisions,
+	}
+	for _, obj := range snap.Resources {
+		gvk := obj.GroupVersionKind()
+		res.Resources = append(res.Resources, &v1alpha1.ResourceRef{
+			Group:     gvk.Group,
+			Version:   gvk.Version,
+			Kind:      gvk.Kind,
+			Namespace: obj.GetNamespace(),
+			Name:      obj.GetName(),
+		})
+	}
+	return res
+}
+
+// CreateSnapshot stores the live state of the resources managed by the application in the snapshot store. Secrets
+// are not included in snapshots, so that their data is never copied outside the cluster.
+func (s *Server) CreateSnapshot(ctx context.Context, q *application.ApplicationSnapshotQuery) (*application.ApplicationSnapshot, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	store, err := s.snapshotStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a, proj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+
+	now := time.Now()
+	snap := &snapshot{
+		ID:          newSnapshotID(now),
+		CreatedAt:   metav1.NewTime(now.UTC().Truncate(time.Second)),
+		CreatedBy:   session.Username(ctx),
+		Application: a.QualifiedName(),
+		Project:     a.Spec.Project,
+		Revisions:   a.Status.Sync.Revisions,
+		Resources:   []*unstructured.Unstructured{},
+	}
+	if a.Status.Sync.Revision != "" {
+		snap.Revisions = []string{a.Status.Sync.Revision}
+	}
+	for i := range a.Status.Resources {
+		res := &a.Status.Resources[i]
+		if res.Hook || (res.Group == "" && res.Kind == kube.SecretKind) {
+			continue
+		}
+		obj, err := s.kubectl.GetResource(ctx, config, res.GroupVersionKind(), res.Name, res.Namespace)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error getting resource %s/%s/%s: %w", res.Group, res.Kind, res.Name, err)
+		}
+		snap.Resources = append(snap.Resources, stripLiveState(obj))
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling snapshot: %w", err)
+	}
+	if err := store.Put(ctx, snapshotKey(a, snap.ID), data); err != nil {
+		return nil, fmt.Errorf("error storing snapshot: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceCreated, fmt.Sprintf("created snapshot %s of %d resources", snap.ID, len(snap.Resources)))
+	return snap.toAPI(), nil
+}
+
+// ListSnapshots returns the snapshots of the application, oldest first
+func (s *Server) ListSnapshots(ctx context.Context, q *application.ApplicationSnapshotQuery) (*application.ApplicationSnapshotList, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	store, err := s.snapshotStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := store.List(ctx, snapshotPrefix(a))
+	if err != nil {
+		return nil, fmt.Errorf("error listing snapshots: %w", err)
+	}
+	res := &application.ApplicationSnapshotList{Items: []*application.ApplicationSnapshot{}}
+	for _, key := range keys {
+		id := strings.TrimSuffix(strings.TrimPrefix(key, snapshotPrefix(a)), ".json")
+		if !snapshotIDRegex.MatchString(id) {
+			continue
+		}
+		snap, err := getSnapshot(ctx, store, a, id)
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, snap.toAPI())
+	}
+	return res, nil
+}
+
+func getSnapshot(ctx context.Context, store objectstore.Store, a *v1alpha1.Application, id string) (*snapshot, error) {
+	if !snapshotIDRegex.MatchString(id) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid snapshot ID %q", id)
+	}
+	data, err := store.Get(ctx, snapshotKey(a, id))
+	if err != nil {
+		if errors.Is(err, objectstore.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "snapshot %s of application %s not found", id, a.QualifiedName())
+		}
+		return nil, fmt.Errorf("error getting snapshot %s: %w", id, err)
+	}
+	var snap snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling snapshot %s: %w", id, err)
+	}
+	return &snap, nil
+}
+
+// RestoreSnapshot re-applies the resources of a snapshot: missing resources are created and the other ones are patched
+// back to their state in the snapshot. Resources created after the snapshot are left untouched. Automated sync with
+// self-heal reverts the restored resources to the target state of the application, so it should be disabled first.
+func (s *Server) RestoreSnapshot(ctx context.Context, q *application.ApplicationSnapshotRestoreRequest) (*application.ApplicationSnapshotRestoreResponse, error) {
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionSync, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	store, err := s.snapshotStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := getSnapshot(ctx, store, a, q.GetId())
+	if err != nil {
+		return nil, err
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a, proj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, proj, s.db)
+	if err != nil {
+		return nil, fmt.Errorf("error getting destination cluster: %w", err)
+	}
+
+	results := []*application.ApplicationSnapshotRestoreResult{}
+	for _, obj := range snap.Resources {
+		gvk := obj.GroupVersionKind()
+		operation, err := s.restoreResource(ctx, config, destCluster, proj, obj, q.GetDryRun())
+		result := &application.ApplicationSnapshotRestoreResult{
+			Group:     new(gvk.Group),
+			Version:   new(gvk.Version),
+			Kind:      new(gvk.Kind),
+			Namespace: new(obj.GetNamespace()),
+			Name:      new(obj.GetName()),
+			Operation: new(operation),
+		}
+		if err != nil {
+			result.Error = new(err.Error())
+		}
+		results = append(results, result)
+	}
+	if !q.GetDryRun() {
+		s.logAppEvent(ctx, a, argo.EventReasonResourceUpdated, "restored snapshot "+snap.ID)
+	}
+	return &application.ApplicationSnapshotRestoreResponse{Results: results}, nil
+}
+
+// restoreResource creates or patches the live resource so that it matches the snapshot, and returns the operation
+func (s *Server) restoreResource(ctx context.Context, config *rest.Config, destCluster *v1alpha1.Cluster, proj *v1alpha1.AppProject, obj *unstructured.Unstructured, dryRun bool) (string, error) {
+	if err := s.verifyResourcePermitted(ctx, destCluster, proj, obj); err != nil {
+		return "", err
+	}
+	gvk := obj.GroupVersionKind()
+	liveObj, err := s.kubectl.GetResource(ctx, config, gvk, obj.GetName(), obj.GetNamespace())
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("error getting resource: %w", err)
+		}
+		createOptions := metav1.CreateOptions{}
+		if dryRun {
+			createOptions.DryRun = []string{metav1.DryRunAll}
+		}
+		if _, err := s.kubectl.CreateResource(ctx, config, gvk, obj.GetName(), obj.GetNamespace(), obj, createOptions); err != nil {
+			return "", fmt.Errorf("error creating resource: %w", err)
+		}
+		return snapshotOperationCreated, nil
+	}
+
+	liveObjBytes, err := json.Marshal(stripLiveState(liveObj))
+	if err != nil {
+		return "", fmt.Errorf("error marshaling live object: %w", err)
+	}
+	objBytes, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling snapshot object: %w", err)
+	}
+	patch, err := jsonpatch.CreateMergePatch(liveObjBytes, objBytes)
+	if err != nil {
+		return "", fmt.Errorf("error calculating merge patch: %w", err)
+	}
+	if string(patch) == "{}" {
+		return snapshotOperationUnchanged, nil
+	}
+	if !dryRun {
+		if _, err := s.kubectl.PatchResource(ctx, config, gvk, obj.GetName(), obj.GetNamespace(), types.MergePatchType, patch); err != nil {
+			return "", fmt.Errorf("error patching resource: %w", err)
+		}
+	}
+	return snapshotOperationPatched, nil
+}
+
+// DeleteSnapshot deletes a snapshot of the application from the snapshot store
+func (s *Server) DeleteSnapshot(ctx context.Context, q *application.ApplicationSnapshotDeleteRequest) (*application.ApplicationResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if !snapshotIDRegex.MatchString(q.GetId()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid snapshot ID %q", q.GetId())
+	}
+	store, err := s.snapshotStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := store.Delete(ctx, snapshotKey(a, q.GetId())); err != nil {
+		return nil, fmt.Errorf("error deleting snapshot: %w", err)
+	}
+	s.logAppEvent(ctx, a, argo.EventReasonResourceDeleted, "deleted snapshot "+q.GetId())
+	return &application.ApplicationResponse{}, nil
+}
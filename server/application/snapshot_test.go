@@ -0,0 +1,165 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/objectstore"
+)
+
+type fakeObjectStore struct {
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) Put(_ context.Context, key string, data []byte) error {
+	s.objects[key] = data
+	return nil
+}
+
+func (s *fakeObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, objectstore.ErrNotFound
+	}
+	return data, nil
+}
+
+func (s *fakeObjectStore) List(_ context.Context, prefix string) ([]string, error) {
+	var keys []string
+	for key := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func (s *fakeObjectStore) Delete(_ context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func TestSnapshots(t *testing.T) {
+	newDeployment := func(replicas int32) *unstructured.Unstructured {
+		return kube.MustToUnstructured(&appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: testNamespace, UID: "1", ResourceVersion: "10"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{Replicas: replicas},
+		})
+	}
+	configMap := kube.MustToUnstructured(&corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: testNamespace, UID: "2"},
+		Data:       map[string]string{"key": "value"},
+	})
+	secret := kube.MustToUnstructured(&corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: testNamespace, UID: "3"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	})
+	live := map[string]*unstructured.Unstructured{"Deployment": newDeployment(1), "ConfigMap": configMap, "Secret": secret}
+
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Status.Sync.Revision = "abc123"
+		app.Status.Resources = []v1alpha1.ResourceStatus{
+			{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "web"},
+			{Version: "v1", Kind: "ConfigMap", Namespace: testNamespace, Name: "config"},
+			{Version: "v1", Kind: "Secret", Namespace: testNamespace, Name: "creds"},
+			{Version: "v1", Kind: "Service", Namespace: testNamespace, Name: "missing"},
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	appServer.kubectl = (&kubetest.MockKubectlCmd{}).WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, _ string) (*unstructured.Unstructured, error) {
+		if obj, ok := live[gvk.Kind]; ok {
+			return obj, nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: strings.ToLower(gvk.Kind) + "s"}, name)
+	})
+
+	_, err := appServer.ListSnapshots(t.Context(), &application.ApplicationSnapshotQuery{Name: &testApp.Name})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	store := &fakeObjectStore{objects: map[string][]byte{}}
+	appServer.snapshotStore = func(context.Context) (objectstore.Store, error) {
+		return store, nil
+	}
+
+	snapshot, err := appServer.CreateSnapshot(t.Context(), &application.ApplicationSnapshotQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Regexp(t, snapshotIDRegex, snapshot.GetId())
+	assert.Equal(t, []string{"abc123"}, snapshot.Revisions)
+	assert.Equal(t, []*v1alpha1.ResourceRef{
+		{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: testNamespace, Name: "web"},
+		{Version: "v1", Kind: "ConfigMap", Namespace: testNamespace, Name: "config"},
+	}, snapshot.Resources)
+	require.Contains(t, store.objects, testApp.Namespace+"/"+testApp.Name+"/"+snapshot.GetId()+".json")
+	stored := string(store.objects[testApp.Namespace+"/"+testApp.Name+"/"+snapshot.GetId()+".json"])
+	assert.NotContains(t, stored, "resourceVersion")
+	assert.NotContains(t, stored, "status")
+
+	snapshots, err := appServer.ListSnapshots(t.Context(), &application.ApplicationSnapshotQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	require.Len(t, snapshots.Items, 1)
+	assert.Equal(t, snapshot.GetId(), snapshots.Items[0].GetId())
+
+	t.Run("Restore", func(t *testing.T) {
+		live["Deployment"] = newDeployment(3)
+		delete(live, "ConfigMap")
+		t.Cleanup(func() {
+			live["Deployment"] = newDeployment(1)
+			live["ConfigMap"] = configMap
+		})
+
+		resp, err := appServer.RestoreSnapshot(t.Context(), &application.ApplicationSnapshotRestoreRequest{Name: &testApp.Name, Id: snapshot.Id, DryRun: new(true)})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		assert.Equal(t, "web", resp.Results[0].GetName())
+		assert.Equal(t, snapshotOperationPatched, resp.Results[0].GetOperation())
+		assert.Empty(t, resp.Results[0].GetError())
+		assert.Equal(t, "config", resp.Results[1].GetName())
+		assert.Equal(t, snapshotOperationCreated, resp.Results[1].GetOperation())
+		assert.Empty(t, resp.Results[1].GetError())
+	})
+
+	t.Run("RestoreUnchanged", func(t *testing.T) {
+		resp, err := appServer.RestoreSnapshot(t.Context(), &application.ApplicationSnapshotRestoreRequest{Name: &testApp.Name, Id: snapshot.Id})
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 2)
+		for _, result := range resp.Results {
+			assert.Equal(t, snapshotOperationUnchanged, result.GetOperation())
+		}
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		_, err := appServer.RestoreSnapshot(t.Context(), &application.ApplicationSnapshotRestoreRequest{Name: &testApp.Name, Id: new("../other-app/20260101-000000-abcde")})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = appServer.RestoreSnapshot(t.Context(), &application.ApplicationSnapshotRestoreRequest{Name: &testApp.Name, Id: new("20260101-000000-abcde")})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	_, err = appServer.DeleteSnapshot(t.Context(), &application.ApplicationSnapshotDeleteRequest{Name: &testApp.Name, Id: snapshot.Id})
+	require.NoError(t, err)
+	snapshots, err = appServer.ListSnapshots(t.Context(), &application.ApplicationSnapshotQuery{Name: &testApp.Name})
+	require.NoError(t, err)
+	assert.Empty(t, snapshots.Items)
+}
@@ -31,6 +31,7 @@ import (
 
 type terminalHandler struct {
 	appLister         applisters.ApplicationLister
+	projLister        applisters.AppProjectNamespaceLister
 	db                db.ArgoDB
 	appResourceTreeFn func(ctx context.Context, app *appv1.Application) (*appv1.ApplicationTree, error)
 	allowedShells     []string
@@ -46,9 +47,10 @@ type TerminalOptions struct {
 }
 
 // NewHandler returns a new terminal handler.
-func NewHandler(appLister applisters.ApplicationLister, namespace string, enabledNamespaces []string, db db.ArgoDB, appResourceTree AppResourceTreeFn, allowedShells []string, sessionManager *util_session.SessionManager, terminalOptions *TerminalOptions) *terminalHandler {
+func NewHandler(appLister applisters.ApplicationLister, projLister applisters.AppProjectNamespaceLister, namespace string, enabledNamespaces []string, db db.ArgoDB, appResourceTree AppResourceTreeFn, allowedShells []string, sessionManager *util_session.SessionManager, terminalOptions *TerminalOptions) *terminalHandler {
 	return &terminalHandler{
 		appLister:         appLister,
+		projLister:        projLister,
 		db:                db,
 		appResourceTreeFn: appResourceTree,
 		allowedShells:     allowedShells,
@@ -60,7 +62,11 @@ func NewHandler(appLister applisters.ApplicationLister, namespace string, enable
 }
 
 func (s *terminalHandler) getApplicationClusterRawConfig(ctx context.Context, a *appv1.Application) (*rest.Config, error) {
-	destCluster, err := argo.GetDestinationCluster(ctx, a.Spec.Destination, s.db)
+	proj, err := s.getProject(a.Spec.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, a.Spec.Destination, proj, s.db)
 	if err != nil {
 		return nil, err
 	}
@@ -74,8 +80,9 @@ func (s *terminalHandler) getApplicationClusterRawConfig(ctx context.Context, a
 type GetSettingsFunc func() (*settings.ArgoCDSettings, error)
 
 // WithFeatureFlagMiddleware is an HTTP middleware to verify if the terminal
-// feature is enabled before invoking the main handler
-func (s *terminalHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc) http.Handler {
+// feature is enabled for the project of the application before invoking the
+// main handler, and whether the session must be recorded
+func (s *terminalHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc, getRecordingStore RecordingStoreFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		argocdSettings, err := getSettings()
 		if err != nil {
@@ -83,15 +90,66 @@ func (s *terminalHandler) WithFeatureFlagMiddleware(getSettings GetSettingsFunc)
 			http.Error(w, "Failed to get settings", http.StatusBadRequest)
 			return
 		}
-		if !argocdSettings.ExecEnabled {
+		proj, err := s.getProject(r.URL.Query().Get("projectName"))
+		if err != nil {
+			log.Errorf("error executing WithFeatureFlagMiddleware: error getting project: %s", err)
+			http.Error(w, "Failed to get project", http.StatusInternalServerError)
+			return
+		}
+		if !isExecEnabled(argocdSettings, proj) {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
-		s.ServeHTTP(w, r)
+		var recordings RecordingStore
+		if argocdSettings.ExecRecordingEnabled || (proj != nil && proj.IsExecRecordingRequired()) {
+			recordings, err = getRecordingStore(r.Context())
+			if err != nil {
+				log.Errorf("error executing WithFeatureFlagMiddleware: error getting the recording store: %s", err)
+				http.Error(w, "Failed to get the recording store", http.StatusInternalServerError)
+				return
+			}
+			if recordings == nil {
+				log.Warn("Refusing terminal session: sessions must be recorded but no recording store is configured")
+				http.Error(w, "Terminal sessions must be recorded but session recording is not configured", http.StatusForbidden)
+				return
+			}
+		}
+		s.serveHTTP(w, r, recordings)
 	})
 }
 
+// getProject returns the project with the given name, or nil if it does not exist
+func (s *terminalHandler) getProject(name string) (*appv1.AppProject, error) {
+	if s.projLister == nil || name == "" {
+		return nil, nil
+	}
+	proj, err := s.projLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return proj, nil
+}
+
+// isExecEnabled returns whether the terminal is enabled for the applications of the given project. The terminal is
+// disabled for all projects when it is disabled globally, and the global setting applies when the project does not
+// exist.
+func isExecEnabled(argocdSettings *settings.ArgoCDSettings, proj *appv1.AppProject) bool {
+	if proj == nil {
+		return argocdSettings.ExecEnabled
+	}
+	return proj.IsExecEnabled(argocdSettings.ExecEnabled)
+}
+
+// ServeHTTP serves a terminal session without recording it
 func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.serveHTTP(w, r, nil)
+}
+
+// serveHTTP serves a terminal session, which is recorded to the given store unless it is nil
+func (s *terminalHandler) serveHTTP(w http.ResponseWriter, r *http.Request, recordings RecordingStore) {
 	q := r.URL.Query()
 
 	podName := q.Get("pod")
@@ -216,9 +274,25 @@ func (s *terminalHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var recorder *sessionRecorder
+	if recordings != nil {
+		recorder, err = newSessionRecorder(ctx, recordings, a, util_session.Username(ctx), namespace, podName, container)
+		if err != nil {
+			fieldLog.Errorf("error creating terminal session recording: %s", err)
+			http.Error(w, "Cannot record terminal session", http.StatusInternalServerError)
+			return
+		}
+		fieldLog = fieldLog.WithField("recording", recorder.name)
+		defer func() {
+			if err := recorder.Close(); err != nil {
+				fieldLog.Errorf("error storing terminal session recording: %s", err)
+			}
+		}()
+	}
+
 	fieldLog.Info("terminal session starting")
 
-	session, err := newTerminalSession(ctx, w, r, nil, s.sessionManager, appRBACName, s.terminalOptions)
+	session, err := newTerminalSession(ctx, w, r, nil, s.sessionManager, appRBACName, s.terminalOptions, recorder)
 	if err != nil {
 		http.Error(w, "Failed to start terminal session", http.StatusBadRequest)
 		return
@@ -1,6 +1,8 @@
 package application
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -9,12 +11,17 @@ import (
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
 
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 func TestPodExists(t *testing.T) {
@@ -339,3 +346,55 @@ func TestTerminalHandler_ServeHTTP_disallowed_namespace(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, response.StatusCode)
 	assert.Equal(t, security.NamespaceNotPermittedError("disallowed").Error()+"\n", recorder.Body.String())
 }
+
+func TestTerminalHandler_WithFeatureFlagMiddleware(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	projects := []*appv1.AppProject{
+		{ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: testNamespace}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "enabled", Namespace: testNamespace}, Spec: appv1.AppProjectSpec{Exec: &appv1.ProjectExecConfig{Enabled: ptr.To(true)}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "disabled", Namespace: testNamespace}, Spec: appv1.AppProjectSpec{Exec: &appv1.ProjectExecConfig{Enabled: ptr.To(false)}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "recorded", Namespace: testNamespace}, Spec: appv1.AppProjectSpec{Exec: &appv1.ProjectExecConfig{RecordSessions: true}}},
+	}
+	for _, proj := range projects {
+		require.NoError(t, indexer.Add(proj))
+	}
+	handler := NewHandler(nil, applisters.NewAppProjectLister(indexer).AppProjects(testNamespace), testNamespace, nil, nil, nil, nil, nil, nil)
+
+	tests := []struct {
+		name           string
+		project        string
+		settings       settings.ArgoCDSettings
+		expectedStatus int
+	}{
+		// requests which pass the middleware are rejected by the handler because of the missing parameters
+		{name: "globally disabled", project: "default", expectedStatus: http.StatusNotFound},
+		{name: "globally enabled", project: "default", settings: settings.ArgoCDSettings{ExecEnabled: true}, expectedStatus: http.StatusBadRequest},
+		{name: "enabled by the project", project: "enabled", settings: settings.ArgoCDSettings{ExecEnabled: true}, expectedStatus: http.StatusBadRequest},
+		{name: "globally disabled and enabled by the project", project: "enabled", expectedStatus: http.StatusNotFound},
+		{name: "disabled by the project", project: "disabled", settings: settings.ArgoCDSettings{ExecEnabled: true}, expectedStatus: http.StatusNotFound},
+		{name: "unknown project", project: "unknown", settings: settings.ArgoCDSettings{ExecEnabled: true}, expectedStatus: http.StatusBadRequest},
+		{name: "recording required but not configured", project: "recorded", settings: settings.ArgoCDSettings{ExecEnabled: true}, expectedStatus: http.StatusForbidden},
+		{name: "recording required and configured", project: "recorded", settings: settings.ArgoCDSettings{ExecEnabled: true, ExecRecordingPath: t.TempDir()}, expectedStatus: http.StatusBadRequest},
+		{name: "recording store failure", project: "recorded", settings: settings.ArgoCDSettings{ExecEnabled: true, ExecRecordingPath: "error"}, expectedStatus: http.StatusInternalServerError},
+		{name: "recording enabled but not configured", project: "default", settings: settings.ArgoCDSettings{ExecEnabled: true, ExecRecordingEnabled: true}, expectedStatus: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			middleware := handler.WithFeatureFlagMiddleware(func() (*settings.ArgoCDSettings, error) {
+				return &tt.settings, nil
+			}, func(context.Context) (RecordingStore, error) {
+				switch tt.settings.ExecRecordingPath {
+				case "":
+					return nil, nil
+				case "error":
+					return nil, errors.New("invalid recording store settings")
+				}
+				return &dirRecordingStore{dir: tt.settings.ExecRecordingPath}, nil
+			})
+			request := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "https://argocd.example.com/terminal?projectName="+tt.project, http.NoBody)
+			recorder := httptest.NewRecorder()
+			middleware.ServeHTTP(recorder, request)
+			assert.Equal(t, tt.expectedStatus, recorder.Result().StatusCode)
+		})
+	}
+}
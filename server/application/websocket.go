@@ -44,6 +44,7 @@ type terminalSession struct {
 	token          *string
 	appRBACName    string
 	terminalOpts   *TerminalOptions
+	recorder       *sessionRecorder
 }
 
 // getToken get auth token from web socket request
@@ -53,7 +54,7 @@ func getToken(r *http.Request) (string, error) {
 }
 
 // newTerminalSession create terminalSession
-func newTerminalSession(ctx context.Context, w http.ResponseWriter, r *http.Request, responseHeader http.Header, sessionManager *util_session.SessionManager, appRBACName string, terminalOpts *TerminalOptions) (*terminalSession, error) {
+func newTerminalSession(ctx context.Context, w http.ResponseWriter, r *http.Request, responseHeader http.Header, sessionManager *util_session.SessionManager, appRBACName string, terminalOpts *TerminalOptions, recorder *sessionRecorder) (*terminalSession, error) {
 	token, err := getToken(r)
 	if err != nil {
 		return nil, err
@@ -72,6 +73,7 @@ func newTerminalSession(ctx context.Context, w http.ResponseWriter, r *http.Requ
 		token:          &token,
 		appRBACName:    appRBACName,
 		terminalOpts:   terminalOpts,
+		recorder:       recorder,
 	}
 	return session, nil
 }
@@ -201,8 +203,16 @@ func (t *terminalSession) Read(p []byte) (int, error) {
 	}
 	switch msg.Operation {
 	case "stdin":
+		if err := t.recorder.recordInput(msg.Data); err != nil {
+			log.Errorf("record input err: %v", err)
+			return copy(p, EndOfTransmission), err
+		}
 		return copy(p, msg.Data), nil
 	case "resize":
+		if err := t.recorder.recordResize(msg.Cols, msg.Rows); err != nil {
+			log.Errorf("record resize err: %v", err)
+			return copy(p, EndOfTransmission), err
+		}
 		t.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}
 		return 0, nil
 	default:
@@ -223,6 +233,10 @@ func (t *terminalSession) Ping() error {
 
 // Write called from remote command whenever there is any output
 func (t *terminalSession) Write(p []byte) (int, error) {
+	if err := t.recorder.recordOutput(p); err != nil {
+		log.Errorf("record output err: %v", err)
+		return 0, err
+	}
 	msg, err := json.Marshal(TerminalMessage{
 		Operation: "stdout",
 		Data:      string(p),
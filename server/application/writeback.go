@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	argocommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/app/writeback"
+	"github.com/argoproj/argo-cd/v3/util/session"
+)
+
+// writeBackParameters commits the parameter changes between the current and the updated application to the
+// parameter override file of the application in Git, if the application opted into Git write-back, and reverts
+// them in the updated application so that its spec keeps the parameters it had before.
+func (s *Server) writeBackParameters(ctx context.Context, app *v1alpha1.Application, newApp *v1alpha1.Application) error {
+	if app.Annotations[argocommon.AnnotationKeyWriteBackMethod] != argocommon.AnnotationValueWriteBackMethodGit {
+		return nil
+	}
+	if app.Spec.HasMultipleSources() || newApp.Spec.HasMultipleSources() || app.Spec.Source == nil || newApp.Spec.Source == nil {
+		return nil
+	}
+	live, desired := app.Spec.Source, newApp.Spec.Source
+	if live.IsHelm() || live.IsOCI() || live.RepoURL != desired.RepoURL || live.Path != desired.Path || live.TargetRevision != desired.TargetRevision {
+		// parameters are only written back for unchanged Git sources
+		return nil
+	}
+	if writeback.ParametersEqual(live, desired) {
+		return nil
+	}
+
+	branch := app.Annotations[argocommon.AnnotationKeyWriteBackBranch]
+	if branch == "" {
+		branch = live.TargetRevision
+	}
+	if branch == "" || branch == "HEAD" {
+		return status.Errorf(codes.InvalidArgument, "cannot write back parameters of application %s: the target revision is not a branch, set the %s annotation", app.Name, argocommon.AnnotationKeyWriteBackBranch)
+	}
+
+	repo, err := s.db.GetRepository(ctx, live.RepoURL, app.Spec.Project)
+	if err != nil {
+		return fmt.Errorf("error getting repository: %w", err)
+	}
+	path := writeback.OverrideFilePath(live.Path, app.InstanceName(s.ns))
+	message := fmt.Sprintf("Update parameters of application %s", app.QualifiedName())
+	if user := session.Username(ctx); user != "" {
+		message += " by " + user
+	}
+	err = s.parameterWriter.WriteFile(ctx, repo, branch, path, message, func(existing []byte) ([]byte, error) {
+		return writeback.MergeParameterOverrides(live, desired, existing)
+	})
+	if err != nil {
+		return fmt.Errorf("error writing back parameters of application %s: %w", app.Name, err)
+	}
+
+	desired.Helm = live.Helm
+	desired.Kustomize = live.Kustomize
+	desired.Directory = live.Directory
+	desired.Plugin = live.Plugin
+	if newApp.Annotations == nil {
+		newApp.Annotations = map[string]string{}
+	}
+	newApp.Annotations[v1alpha1.AnnotationKeyRefresh] = string(v1alpha1.RefreshTypeNormal)
+	return nil
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	argocommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeParameterWriter struct {
+	repo    string
+	branch  string
+	path    string
+	message string
+	content []byte
+}
+
+func (w *fakeParameterWriter) WriteFile(_ context.Context, repo *v1alpha1.Repository, branch, path, message string, update func(existing []byte) ([]byte, error)) error {
+	content, err := update(w.content)
+	if err != nil {
+		return err
+	}
+	w.repo, w.branch, w.path, w.message, w.content = repo.Repo, branch, path, message, content
+	return nil
+}
+
+func TestUpdateAppSpec_WriteBackParameters(t *testing.T) {
+	testApp := newTestApp(func(app *v1alpha1.Application) {
+		app.Annotations = map[string]string{
+			argocommon.AnnotationKeyWriteBackMethod: argocommon.AnnotationValueWriteBackMethodGit,
+		}
+	})
+	appServer := newTestAppServer(t, testApp)
+	writer := &fakeParameterWriter{}
+	appServer.parameterWriter = writer
+
+	spec := testApp.Spec.DeepCopy()
+	spec.Source.Directory = &v1alpha1.ApplicationSourceDirectory{Recurse: true}
+	_, err := appServer.UpdateSpec(t.Context(), &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+	require.ErrorContains(t, err, "the target revision is not a branch")
+
+	testApp.Annotations[argocommon.AnnotationKeyWriteBackBranch] = "main"
+	_, err = appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Update(t.Context(), testApp, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	updated, err := appServer.UpdateSpec(t.Context(), &application.ApplicationUpdateSpecRequest{Name: &testApp.Name, Spec: spec})
+	require.NoError(t, err)
+	assert.Nil(t, updated.Source.Directory)
+	assert.Equal(t, "https://github.com/argoproj/argocd-example-apps.git", writer.repo)
+	assert.Equal(t, "main", writer.branch)
+	assert.Equal(t, "some/path/.argocd-source-test-app.yaml", writer.path)
+	assert.Equal(t, "Update parameters of application default/test-app", writer.message)
+	assert.YAMLEq(t, "directory:\n  recurse: true\n", string(writer.content))
+
+	app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(v1alpha1.RefreshTypeNormal), app.Annotations[v1alpha1.AnnotationKeyRefresh])
+}
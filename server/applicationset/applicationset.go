@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/argoproj/pkg/v2/sync"
+	"github.com/pmezard/go-difflib/difflib"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,7 +26,9 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
+	appsetcontrollers "github.com/argoproj/argo-cd/v3/applicationset/controllers"
 	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
@@ -41,6 +44,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/broadcast"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v3/util/collections"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/github_app"
@@ -371,7 +375,7 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, s.EnableGitHubAPIMetrics, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, s.clusterInformer)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, s.ns, argoCDService, s.dynamicClient, scmConfig, s.clusterInformer, nil)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -499,9 +503,135 @@ func (s *Server) Generate(ctx context.Context, q *applicationset.ApplicationSetG
 	for i := range apps {
 		res.Applications = append(res.Applications, &apps[i])
 	}
+	if q.GetDryRun() {
+		res.Diffs, err = s.diffApplicationSetApps(ctx, namespace, appset, apps)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing the generated Applications with the existing ones: %w", err)
+		}
+	}
 	return res, nil
 }
 
+// diffApplicationSetApps compares the generated applications with the applications owned by the existing application set
+// of the same name, the way the applicationset controller does, and returns the changes it would make
+func (s *Server) diffApplicationSetApps(ctx context.Context, namespace string, appset *v1alpha1.ApplicationSet, generated []v1alpha1.Application) ([]*applicationset.ApplicationSetApplicationDiff, error) {
+	live := map[string]*v1alpha1.Application{}
+	if _, err := s.appsetLister.ApplicationSets(namespace).Get(appset.Name); err == nil {
+		// the applications of an existing application set are only compared if the user can get it
+		if _, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionGet, namespace, appset.Name); err != nil {
+			return nil, err
+		}
+		apps, err := s.appclientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error listing Applications: %w", err)
+		}
+		for i := range apps.Items {
+			owner := metav1.GetControllerOf(&apps.Items[i])
+			if owner != nil && owner.Kind == v1alpha1.ApplicationSetSchemaGroupVersionKind.Kind && owner.Name == appset.Name {
+				live[apps.Items[i].Name] = &apps.Items[i]
+			}
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+
+	diffConfig, err := appsetutils.BuildIgnoreDiffConfig(appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ignore diff config: %w", err)
+	}
+
+	var diffs []*applicationset.ApplicationSetApplicationDiff
+	for i := range generated {
+		generatedApp := generated[i].DeepCopy()
+		generatedApp.Spec = *argo.NormalizeApplicationSpec(&generatedApp.Spec)
+		liveApp, ok := live[generatedApp.Name]
+		if !ok {
+			diff, err := applicationDiff(nil, generatedApp)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, &applicationset.ApplicationSetApplicationDiff{Name: generatedApp.Name, Action: "create", Diff: diff})
+			continue
+		}
+		delete(live, generatedApp.Name)
+
+		desiredApp := liveApp.DeepCopy()
+		desiredApp.Spec = generatedApp.Spec
+		appsetcontrollers.PreserveApplicationFields(*appset, liveApp, generatedApp, nil, nil)
+		desiredApp.Annotations = generatedApp.Annotations
+		desiredApp.Labels = generatedApp.Labels
+		desiredApp.Finalizers = generatedApp.Finalizers
+		normalizedLive, normalizedDesired, err := appsetutils.NormalizeApplicationsForDiff(diffConfig, liveApp, desiredApp)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := applicationDiff(normalizedLive, normalizedDesired)
+		if err != nil {
+			return nil, err
+		}
+		action := "update"
+		if diff == "" {
+			action = "unchanged"
+		}
+		diffs = append(diffs, &applicationset.ApplicationSetApplicationDiff{Name: generatedApp.Name, Action: action, Diff: diff})
+	}
+	for _, liveApp := range live {
+		diff, err := applicationDiff(liveApp, nil)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, &applicationset.ApplicationSetApplicationDiff{Name: liveApp.Name, Action: "delete", Diff: diff})
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs, nil
+}
+
+// applicationDiff returns the unified diff between the YAML of the fields of the applications managed by the
+// applicationset controller. A nil application is compared as an empty document.
+func applicationDiff(live, desired *v1alpha1.Application) (string, error) {
+	liveYAML, err := managedApplicationYAML(live)
+	if err != nil {
+		return "", err
+	}
+	desiredYAML, err := managedApplicationYAML(desired)
+	if err != nil {
+		return "", err
+	}
+	if liveYAML == desiredYAML {
+		return "", nil
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(desiredYAML),
+		FromFile: "live",
+		ToFile:   "generated",
+		Context:  3,
+	})
+}
+
+func managedApplicationYAML(app *v1alpha1.Application) (string, error) {
+	if app == nil {
+		return "", nil
+	}
+	managed := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        app.Name,
+			Namespace:   app.Namespace,
+			Labels:      app.Labels,
+			Annotations: app.Annotations,
+			Finalizers:  app.Finalizers,
+		},
+		Spec: app.Spec,
+	}
+	out, err := yaml.Marshal(managed)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling Application %s: %w", app.Name, err)
+	}
+	return string(out), nil
+}
+
 func (s *Server) buildApplicationSetTree(a *v1alpha1.ApplicationSet) (*v1alpha1.ApplicationSetTree, error) {
 	var tree v1alpha1.ApplicationSetTree
 
@@ -893,3 +893,87 @@ func TestAppSet_Generate_Cluster(t *testing.T) {
 		assert.EqualError(t, err, "namespace 'NOT-ALLOWED' is not permitted")
 	})
 }
+
+func TestAppSet_Generate_DryRun(t *testing.T) {
+	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Template.Name = "{{name}}"
+		appset.Spec.Generators = []appsv1.ApplicationSetGenerator{
+			{
+				Clusters: &appsv1.ClusterGenerator{},
+			},
+		}
+	})
+	ownedApp := func(name string, opts ...func(app *appsv1.Application)) *appsv1.Application {
+		app := &appsv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  testNamespace,
+				Finalizers: []string{appsv1.ResourcesFinalizerName},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: appsv1.ApplicationSetSchemaGroupVersionKind.GroupVersion().String(),
+					Kind:       appsv1.ApplicationSetSchemaGroupVersionKind.Kind,
+					Name:       "AppSet1",
+					Controller: new(true),
+				}},
+			},
+			Spec: appsv1.ApplicationSpec{Project: "default"},
+		}
+		for _, opt := range opts {
+			opt(app)
+		}
+		return app
+	}
+
+	t.Run("Existing ApplicationSet", func(t *testing.T) {
+		inCluster := ownedApp("in-cluster", func(app *appsv1.Application) {
+			app.Labels = map[string]string{"foo": "bar"}
+			app.Annotations = map[string]string{appsv1.AnnotationKeyRefresh: string(appsv1.RefreshTypeNormal)}
+		})
+		unowned := ownedApp("unowned", func(app *appsv1.Application) {
+			app.OwnerReferences = nil
+		})
+		appSetServer := newTestAppSetServer(t, appSet1, inCluster, ownedApp("stale"), unowned)
+
+		res, err := appSetServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet1, DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, res.Applications, 2)
+		require.Len(t, res.Diffs, 3)
+
+		assert.Equal(t, "fake-cluster", res.Diffs[0].Name)
+		assert.Equal(t, "create", res.Diffs[0].Action)
+		assert.Contains(t, res.Diffs[0].Diff, "+  name: fake-cluster")
+
+		assert.Equal(t, "in-cluster", res.Diffs[1].Name)
+		assert.Equal(t, "update", res.Diffs[1].Action)
+		assert.Contains(t, res.Diffs[1].Diff, "-    foo: bar")
+		assert.NotContains(t, res.Diffs[1].Diff, "-    "+appsv1.AnnotationKeyRefresh)
+
+		assert.Equal(t, "stale", res.Diffs[2].Name)
+		assert.Equal(t, "delete", res.Diffs[2].Action)
+		assert.Contains(t, res.Diffs[2].Diff, "-  name: stale")
+	})
+
+	t.Run("Unchanged applications", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, appSet1, ownedApp("in-cluster"), ownedApp("fake-cluster"))
+
+		res, err := appSetServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet1, DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 2)
+		for _, diff := range res.Diffs {
+			assert.Equal(t, "unchanged", diff.Action)
+			assert.Empty(t, diff.Diff)
+		}
+	})
+
+	t.Run("New ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, ownedApp("in-cluster"))
+
+		res, err := appSetServer.Generate(t.Context(), &applicationset.ApplicationSetGenerateRequest{ApplicationSet: appSet1, DryRun: true})
+		require.NoError(t, err)
+		require.Len(t, res.Diffs, 2)
+		for _, diff := range res.Diffs {
+			assert.Equal(t, "create", diff.Action)
+		}
+	})
+}
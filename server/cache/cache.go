@@ -59,6 +59,10 @@ func (c *Cache) OnAppResourcesTreeChanged(ctx context.Context, appName string, c
 	return c.cache.OnAppResourcesTreeChanged(ctx, appName, callback)
 }
 
+func (c *Cache) GetAppMetricsHistory(appName string, res *appv1.ApplicationMetricsHistory) error {
+	return c.cache.GetAppMetricsHistory(appName, res)
+}
+
 func (c *Cache) GetAppManagedResources(appName string, res *[]*appv1.ResourceDiff) error {
 	return c.cache.GetAppManagedResources(appName, res)
 }
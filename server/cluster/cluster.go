@@ -4,41 +4,59 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/clusterauth"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 // Server provides a Cluster service
 type Server struct {
-	db      db.ArgoDB
-	enf     *rbac.Enforcer
-	cache   *servercache.Cache
-	kubectl kube.Kubectl
+	db           db.ArgoDB
+	enf          *rbac.Enforcer
+	cache        *servercache.Cache
+	kubectl      kube.Kubectl
+	appclientset appclientset.Interface
+	appLister    applisters.ApplicationLister
+	projLister   applisters.AppProjectLister
+	settingsMgr  *settings.SettingsManager
+	ns           string
 }
 
 // NewServer returns a new instance of the Cluster service
-func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl) *Server {
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer, cache *servercache.Cache, kubectl kube.Kubectl, appclientset appclientset.Interface, appLister applisters.ApplicationLister, projLister applisters.AppProjectLister, settingsMgr *settings.SettingsManager, namespace string) *Server {
 	return &Server{
-		db:      db,
-		enf:     enf,
-		cache:   cache,
-		kubectl: kubectl,
+		db:           db,
+		enf:          enf,
+		cache:        cache,
+		kubectl:      kubectl,
+		appclientset: appclientset,
+		appLister:    appLister,
+		projLister:   projLister,
+		settingsMgr:  settingsMgr,
+		ns:           namespace,
 	}
 }
 
@@ -282,6 +300,12 @@ var clusterFieldsByPath = map[string]func(updated *appv1.Cluster, existing *appv
 	"clusterResources": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.ClusterResources = existing.ClusterResources
 	},
+	"namespacedCache": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.NamespacedCache = existing.NamespacedCache
+	},
+	"cordoned": func(updated *appv1.Cluster, existing *appv1.Cluster) {
+		updated.Cordoned = existing.Cordoned
+	},
 	"labels": func(updated *appv1.Cluster, existing *appv1.Cluster) {
 		updated.Labels = existing.Labels
 	},
@@ -354,6 +378,17 @@ func (s *Server) Delete(ctx context.Context, q *cluster.ClusterQuery) (*cluster.
 		return nil, fmt.Errorf("failed to get cluster with permissions check: %w", err)
 	}
 
+	if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionDelete, CreateClusterRBACObject(c.Project, c.Server)) {
+		return nil, common.PermissionDeniedAPIError
+	}
+	apps, err := s.getClusterApplications(c)
+	if err != nil {
+		return nil, err
+	}
+	if len(apps) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster '%s' is still the destination of %d application(s), drain the cluster before removing it", c.Server, len(apps))
+	}
+
 	if q.Name != "" {
 		servers, err := s.db.GetClusterServersByName(ctx, q.Name)
 		if err != nil {
@@ -382,6 +417,223 @@ func enforceAndDelete(ctx context.Context, s *Server, server, project string) er
 	return s.db.DeleteCluster(ctx, server)
 }
 
+// isClusterDestination returns whether the given destination refers to the given cluster
+func isClusterDestination(dest appv1.ApplicationDestination, c *appv1.Cluster) bool {
+	if dest.Server != "" {
+		return strings.TrimRight(dest.Server, "/") == c.Server
+	}
+	return dest.Name != "" && dest.Name == c.Name
+}
+
+// getClusterApplications returns the applications with a destination on the given cluster, sorted by qualified name
+func (s *Server) getClusterApplications(c *appv1.Cluster) ([]*appv1.Application, error) {
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	var res []*appv1.Application
+	for _, a := range apps {
+		if slices.ContainsFunc(a.Spec.GetDestinations(), func(dest appv1.ApplicationDestination) bool {
+			return isClusterDestination(dest, c)
+		}) {
+			res = append(res, a)
+		}
+	}
+	slices.SortFunc(res, func(a, b *appv1.Application) int {
+		return strings.Compare(a.QualifiedName(), b.QualifiedName())
+	})
+	return res, nil
+}
+
+// Inventory returns the group kinds and the number of the resources managed by applications in clusters. The resources
+// are the ones reported in the status of the applications, and only the primary destination of the applications with
+// multiple destinations is taken into account.
+func (s *Server) Inventory(ctx context.Context, q *cluster.ClusterInventoryQuery) (*cluster.ClusterInventoryResponse, error) {
+	clusterList, err := s.db.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	clusters, err := filterClustersByID(clusterList.Items, q.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error filtering clusters by id: %w", err)
+	}
+	apps, err := s.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+
+	res := &cluster.ClusterInventoryResponse{}
+	for i := range clusters {
+		c := &clusters[i]
+		if !s.enf.Enforce(ctx.Value("claims"), rbac.ResourceClusters, rbac.ActionGet, CreateClusterRBACObject(c.Project, c.Server)) {
+			continue
+		}
+		res.Clusters = append(res.Clusters, getClusterInventory(c, apps))
+	}
+	return res, nil
+}
+
+// getClusterInventory returns the inventory of the resources managed in the given cluster by the given applications,
+// sorted by group and kind
+func getClusterInventory(c *appv1.Cluster, apps []*appv1.Application) *cluster.ClusterInventory {
+	items := map[schema.GroupKind]*cluster.ClusterInventoryItem{}
+	for _, a := range apps {
+		if !isClusterDestination(a.Spec.Destination, c) {
+			continue
+		}
+		appGroupKinds := sets.New[schema.GroupKind]()
+		for _, r := range a.Status.Resources {
+			if r.Health != nil && r.Health.Status == health.HealthStatusMissing {
+				continue
+			}
+			gk := schema.GroupKind{Group: r.Group, Kind: r.Kind}
+			item, ok := items[gk]
+			if !ok {
+				item = &cluster.ClusterInventoryItem{Group: r.Group, Kind: r.Kind}
+				items[gk] = item
+			}
+			item.Count++
+			if !appGroupKinds.Has(gk) {
+				appGroupKinds.Insert(gk)
+				item.Applications++
+			}
+		}
+	}
+	inventory := &cluster.ClusterInventory{Server: c.Server, Name: c.Name}
+	for _, item := range items {
+		inventory.Items = append(inventory.Items, item)
+	}
+	slices.SortFunc(inventory.Items, func(a, b *cluster.ClusterInventoryItem) int {
+		if a.Group != b.Group {
+			return strings.Compare(a.Group, b.Group)
+		}
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	return inventory
+}
+
+// migrateDestination returns the destination migrated to the given cluster, with its namespace mapped by the given
+// namespaces mapping
+func migrateDestination(dest appv1.ApplicationDestination, target *appv1.Cluster, namespaces map[string]string) appv1.ApplicationDestination {
+	migrated := appv1.ApplicationDestination{Server: target.Server, Namespace: dest.Namespace}
+	if dest.Server == "" {
+		migrated = appv1.ApplicationDestination{Name: target.Name, Namespace: dest.Namespace}
+	}
+	if ns, ok := namespaces[dest.Namespace]; ok {
+		migrated.Namespace = ns
+	}
+	return migrated
+}
+
+// migrateApplication returns a copy of the application with its destinations on the given cluster migrated to the
+// target cluster
+func migrateApplication(app *appv1.Application, c *appv1.Cluster, target *appv1.Cluster, namespaces map[string]string) *appv1.Application {
+	migrated := app.DeepCopy()
+	if migrated.Spec.HasMultipleDestinations() {
+		for i, dest := range migrated.Spec.Destinations {
+			if isClusterDestination(dest, c) {
+				migrated.Spec.Destinations[i] = migrateDestination(dest, target, namespaces)
+			}
+		}
+		migrated.Spec.Destination = migrated.Spec.Destinations[0]
+	} else {
+		migrated.Spec.Destination = migrateDestination(migrated.Spec.Destination, target, namespaces)
+	}
+	return migrated
+}
+
+// validateMigratedApplication validates the migrated application against its project, as it would be on an update of
+// the application
+func (s *Server) validateMigratedApplication(ctx context.Context, app *appv1.Application) error {
+	proj, err := argo.GetAppProject(ctx, app, s.projLister, s.ns, s.settingsMgr, s.db)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to get the project of application '%s': %v", app.QualifiedName(), err)
+	}
+	conditions, err := argo.ValidatePermissions(ctx, &app.Spec, proj, s.db)
+	if err != nil {
+		return fmt.Errorf("error validating project permissions of application '%s': %w", app.QualifiedName(), err)
+	}
+	conditions = append(conditions, argo.ValidateDestinationServiceAccount(ctx, app, proj, s.db)...)
+	if len(conditions) > 0 {
+		return status.Errorf(codes.InvalidArgument, "application '%s' cannot be migrated: %s", app.QualifiedName(), argo.FormatAppConditions(conditions))
+	}
+	return nil
+}
+
+// Drain cordons a cluster, so that no application is synced to it anymore, and migrates the applications deployed to
+// the cluster to the destination cluster of the request, if any
+func (s *Server) Drain(ctx context.Context, q *cluster.ClusterDrainRequest) (*cluster.ClusterDrainResponse, error) {
+	c, err := s.getClusterAndVerifyAccess(ctx, &cluster.ClusterQuery{Id: q.Id}, rbac.ActionUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify access for draining cluster: %w", err)
+	}
+
+	var target *appv1.Cluster
+	if q.DestinationServer != "" || q.DestinationName != "" {
+		target, err = s.getClusterAndVerifyAccess(ctx, &cluster.ClusterQuery{Server: q.DestinationServer, Name: q.DestinationName}, rbac.ActionGet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify access to destination cluster: %w", err)
+		}
+		if target.Server == c.Server {
+			return nil, status.Error(codes.InvalidArgument, "the destination cluster must differ from the drained cluster")
+		}
+		if target.Cordoned {
+			return nil, status.Errorf(codes.InvalidArgument, "the destination cluster '%s' is cordoned", target.Server)
+		}
+	}
+
+	apps, err := s.getClusterApplications(c)
+	if err != nil {
+		return nil, err
+	}
+	// check the permissions on all the applications and validate them against their projects before migrating any of
+	// them
+	var migrated []*appv1.Application
+	if target != nil {
+		for _, a := range apps {
+			if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionUpdate, a.RBACName(s.ns)); err != nil {
+				return nil, err
+			}
+			m := migrateApplication(a, c, target, q.Namespaces)
+			if err := s.validateMigratedApplication(ctx, m); err != nil {
+				return nil, err
+			}
+			migrated = append(migrated, m)
+		}
+	}
+
+	if !c.Cordoned && !q.DryRun {
+		c.Cordoned = true
+		if c, err = s.db.UpdateCluster(ctx, c); err != nil {
+			return nil, fmt.Errorf("failed to cordon cluster: %w", err)
+		}
+		log.WithField("cluster", c.Server).Info("Cordoned cluster")
+	}
+
+	res := &cluster.ClusterDrainResponse{Cluster: s.toAPIResponse(c)}
+	if target == nil {
+		for _, a := range apps {
+			if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, a.RBACName(s.ns)) {
+				res.Applications = append(res.Applications, a.QualifiedName())
+			}
+		}
+		return res, nil
+	}
+	for _, a := range migrated {
+		if !q.DryRun {
+			if _, err := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace).Update(ctx, a, metav1.UpdateOptions{}); err != nil {
+				if len(res.Migrated) > 0 {
+					return nil, fmt.Errorf("failed to migrate application '%s', the applications %s were already migrated: %w", a.QualifiedName(), strings.Join(res.Migrated, ", "), err)
+				}
+				return nil, fmt.Errorf("failed to migrate application '%s': %w", a.QualifiedName(), err)
+			}
+			log.WithField("cluster", c.Server).Infof("Migrated application '%s' to cluster '%s'", a.QualifiedName(), target.Server)
+		}
+		res.Migrated = append(res.Migrated, a.QualifiedName())
+	}
+	return res, nil
+}
+
 // RotateAuth rotates the bearer token used for a cluster
 func (s *Server) RotateAuth(ctx context.Context, q *cluster.ClusterQuery) (*cluster.ClusterResponse, error) {
 	clust, err := s.getClusterWith403IfNotExist(ctx, q)
@@ -3,25 +3,33 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	apps "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/deeplinks"
 	"github.com/argoproj/argo-cd/v3/test"
@@ -113,6 +121,32 @@ func newServerInMemoryCache() *servercache.Cache {
 	)
 }
 
+func newTestServer(db db.ArgoDB, enf *rbac.Enforcer, objects ...*appv1.Application) *Server {
+	defaultProj := &appv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec: appv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []appv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+		},
+	}
+	return newTestServerWithProjects(db, enf, []*appv1.AppProject{defaultProj}, objects...)
+}
+
+func newTestServerWithProjects(db db.ArgoDB, enf *rbac.Enforcer, projects []*appv1.AppProject, objects ...*appv1.Application) *Server {
+	appClientset := apps.NewSimpleClientset()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, a := range objects {
+		_, _ = appClientset.ArgoprojV1alpha1().Applications(a.Namespace).Create(context.Background(), a, metav1.CreateOptions{})
+		_ = indexer.Add(a)
+	}
+	projIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, p := range projects {
+		_ = projIndexer.Add(p)
+	}
+	settingsMgr := settings.NewSettingsManager(context.Background(), fake.NewClientset(test.NewFakeConfigMap(), test.NewFakeSecret()), test.FakeArgoCDNamespace)
+	return NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{}, appClientset, applisters.NewApplicationLister(indexer), applisters.NewAppProjectLister(projIndexer), settingsMgr, test.FakeArgoCDNamespace)
+}
+
 func newNoopEnforcer() *rbac.Enforcer {
 	enf := rbac.NewEnforcer(fake.NewClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
 	enf.EnableEnforce(false)
@@ -208,7 +242,7 @@ func TestUpdateCluster_RejectInvalidParams(t *testing.T) {
 	_ = enf.SetBuiltinPolicy(`p, role:test, clusters, *, https://127.0.0.1, allow
 p, role:test, clusters, *, allowed-project/*, allow`)
 	enf.SetDefaultRole("role:test")
-	server := NewServer(db, enf, newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, enf)
 
 	for _, c := range testCases {
 		cc := c
@@ -238,7 +272,7 @@ func TestGetCluster_UrlEncodedName(t *testing.T) {
 
 	db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	localCluster, err := server.Get(t.Context(), &cluster.ClusterQuery{
 		Id: &cluster.ClusterID{
@@ -268,7 +302,7 @@ func TestGetCluster_NameWithUrlEncodingButShouldNotBeUnescaped(t *testing.T) {
 
 	db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	localCluster, err := server.Get(t.Context(), &cluster.ClusterQuery{
 		Id: &cluster.ClusterID{
@@ -298,7 +332,7 @@ func TestGetCluster_CannotSetCADataAndInsecureTrue(t *testing.T) {
 	}
 	clientset := getClientset(nil, testNamespace)
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	t.Run("Create Fails When CAData is Set and Insecure is True", func(t *testing.T) {
 		_, err := server.Create(t.Context(), &cluster.ClusterCreateRequest{
@@ -340,7 +374,7 @@ func TestUpdateCluster_NoFieldsPaths(t *testing.T) {
 		return true
 	})).Return(&appv1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	_, err := server.Update(t.Context(), &cluster.ClusterUpdateRequest{
 		Cluster: &appv1.Cluster{
@@ -368,7 +402,7 @@ func TestUpdateCluster_FieldsPathSet(t *testing.T) {
 		return true
 	})).Return(&appv1.Cluster{}, nil)
 
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	_, err := server.Update(t.Context(), &cluster.ClusterUpdateRequest{
 		Cluster: &appv1.Cluster{
@@ -453,7 +487,7 @@ func TestDeleteClusterByName(t *testing.T) {
 		},
 	})
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	t.Run("Delete Fails When Deleting by Unknown Name", func(t *testing.T) {
 		_, err := server.Delete(t.Context(), &cluster.ClusterQuery{
@@ -474,6 +508,221 @@ func TestDeleteClusterByName(t *testing.T) {
 	})
 }
 
+func newClusterSecret(namespace, name, server string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name + "-secret",
+			Namespace: namespace,
+			Labels: map[string]string{
+				common.LabelKeySecretType: common.LabelValueSecretTypeCluster,
+			},
+		},
+		Data: map[string][]byte{
+			"name":   []byte(name),
+			"server": []byte(server),
+			"config": []byte("{}"),
+		},
+	}
+}
+
+func newDestinationApp(name string, dest appv1.ApplicationDestination) *appv1.Application {
+	return &appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace},
+		Spec: appv1.ApplicationSpec{
+			Project:     "default",
+			Source:      &appv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+			Destination: dest,
+		},
+	}
+}
+
+func TestDeleteCluster_WithApplications(t *testing.T) {
+	testNamespace := "default"
+	clientset := getClientset(nil, testNamespace, newClusterSecret(testNamespace, "old", "https://old-cluster"))
+	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+	server := newTestServer(db, newNoopEnforcer(), newDestinationApp("guestbook", appv1.ApplicationDestination{Name: "old", Namespace: "guestbook"}))
+
+	_, err := server.Delete(t.Context(), &cluster.ClusterQuery{Server: "https://old-cluster"})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.ErrorContains(t, err, "is still the destination of 1 application(s)")
+
+	_, err = db.GetCluster(t.Context(), "https://old-cluster")
+	require.NoError(t, err)
+}
+
+func TestDrainCluster(t *testing.T) {
+	testNamespace := "default"
+	newServer := func(t *testing.T) (*Server, db.ArgoDB) {
+		t.Helper()
+		clientset := getClientset(nil, testNamespace,
+			newClusterSecret(testNamespace, "old", "https://old-cluster"),
+			newClusterSecret(testNamespace, "new", "https://new-cluster"),
+		)
+		db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		return newTestServer(db, newNoopEnforcer(),
+			newDestinationApp("by-server", appv1.ApplicationDestination{Server: "https://old-cluster", Namespace: "guestbook"}),
+			newDestinationApp("by-name", appv1.ApplicationDestination{Name: "old", Namespace: "default"}),
+			newDestinationApp("other", appv1.ApplicationDestination{Server: "https://new-cluster", Namespace: "guestbook"}),
+		), db
+	}
+
+	t.Run("cordon", func(t *testing.T) {
+		server, db := newServer(t)
+		res, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{Id: &cluster.ClusterID{Value: "https://old-cluster"}})
+		require.NoError(t, err)
+		assert.True(t, res.Cluster.Cordoned)
+		assert.Equal(t, []string{test.FakeArgoCDNamespace + "/by-name", test.FakeArgoCDNamespace + "/by-server"}, res.Applications)
+		assert.Empty(t, res.Migrated)
+
+		assert.Eventually(t, func() bool {
+			c, err := db.GetCluster(t.Context(), "https://old-cluster")
+			return err == nil && c.Cordoned
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		server, db := newServer(t)
+		res, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{Id: &cluster.ClusterID{Value: "https://old-cluster"}, DestinationName: "new", DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{test.FakeArgoCDNamespace + "/by-name", test.FakeArgoCDNamespace + "/by-server"}, res.Migrated)
+
+		c, err := db.GetCluster(t.Context(), "https://old-cluster")
+		require.NoError(t, err)
+		assert.False(t, c.Cordoned)
+		app, err := server.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "by-server", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "https://old-cluster", app.Spec.Destination.Server)
+	})
+
+	t.Run("migrate", func(t *testing.T) {
+		server, _ := newServer(t)
+		res, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{
+			Id:                &cluster.ClusterID{Value: "https://old-cluster"},
+			DestinationServer: "https://new-cluster",
+			Namespaces:        map[string]string{"guestbook": "guestbook-new"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{test.FakeArgoCDNamespace + "/by-name", test.FakeArgoCDNamespace + "/by-server"}, res.Migrated)
+
+		app, err := server.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "by-server", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ApplicationDestination{Server: "https://new-cluster", Namespace: "guestbook-new"}, app.Spec.Destination)
+		app, err = server.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "by-name", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, appv1.ApplicationDestination{Name: "new", Namespace: "default"}, app.Spec.Destination)
+	})
+
+	t.Run("same destination cluster", func(t *testing.T) {
+		server, _ := newServer(t)
+		_, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{Id: &cluster.ClusterID{Value: "https://old-cluster"}, DestinationName: "old"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("destination not permitted by project", func(t *testing.T) {
+		clientset := getClientset(nil, testNamespace,
+			newClusterSecret(testNamespace, "old", "https://old-cluster"),
+			newClusterSecret(testNamespace, "new", "https://new-cluster"),
+		)
+		db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+		restricted := newDestinationApp("restricted", appv1.ApplicationDestination{Server: "https://old-cluster", Namespace: "guestbook"})
+		restricted.Spec.Project = "restricted"
+		server := newTestServerWithProjects(db, newNoopEnforcer(), []*appv1.AppProject{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+				Spec: appv1.AppProjectSpec{
+					SourceRepos:  []string{"*"},
+					Destinations: []appv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: test.FakeArgoCDNamespace},
+				Spec: appv1.AppProjectSpec{
+					SourceRepos:  []string{"*"},
+					Destinations: []appv1.ApplicationDestination{{Server: "https://old-cluster", Namespace: "*"}},
+				},
+			},
+		},
+			newDestinationApp("by-server", appv1.ApplicationDestination{Server: "https://old-cluster", Namespace: "guestbook"}),
+			restricted,
+		)
+
+		_, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{Id: &cluster.ClusterID{Value: "https://old-cluster"}, DestinationServer: "https://new-cluster"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ErrorContains(t, err, "application '"+test.FakeArgoCDNamespace+"/restricted' cannot be migrated")
+
+		c, err := db.GetCluster(t.Context(), "https://old-cluster")
+		require.NoError(t, err)
+		assert.False(t, c.Cordoned)
+		for _, name := range []string{"by-server", "restricted"} {
+			app, err := server.appclientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, "https://old-cluster", app.Spec.Destination.Server)
+		}
+	})
+
+	t.Run("failed migration", func(t *testing.T) {
+		server, _ := newServer(t)
+		server.appclientset.(*apps.Clientset).PrependReactor("update", "applications", func(action kubetesting.Action) (bool, runtime.Object, error) {
+			if action.(kubetesting.UpdateAction).GetObject().(*appv1.Application).Name == "by-server" {
+				return true, nil, errors.New("conflict")
+			}
+			return false, nil, nil
+		})
+		_, err := server.Drain(t.Context(), &cluster.ClusterDrainRequest{Id: &cluster.ClusterID{Value: "https://old-cluster"}, DestinationName: "new"})
+		assert.ErrorContains(t, err, "failed to migrate application '"+test.FakeArgoCDNamespace+"/by-server', the applications "+test.FakeArgoCDNamespace+"/by-name were already migrated")
+	})
+}
+
+func TestClusterInventory(t *testing.T) {
+	testNamespace := "default"
+	clientset := getClientset(nil, testNamespace,
+		newClusterSecret(testNamespace, "old", "https://old-cluster"),
+		newClusterSecret(testNamespace, "new", "https://new-cluster"),
+	)
+	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+	withResources := func(app *appv1.Application, resources ...appv1.ResourceStatus) *appv1.Application {
+		app.Status.Resources = resources
+		return app
+	}
+	server := newTestServer(db, newNoopEnforcer(),
+		withResources(newDestinationApp("by-server", appv1.ApplicationDestination{Server: "https://old-cluster", Namespace: "guestbook"}),
+			appv1.ResourceStatus{Group: "apps", Kind: "Deployment", Name: "guestbook-ui"},
+			appv1.ResourceStatus{Kind: "Service", Name: "guestbook-ui"},
+			appv1.ResourceStatus{Kind: "ConfigMap", Name: "guestbook-1"},
+			appv1.ResourceStatus{Kind: "ConfigMap", Name: "guestbook-2"},
+		),
+		withResources(newDestinationApp("by-name", appv1.ApplicationDestination{Name: "old", Namespace: "default"}),
+			appv1.ResourceStatus{Kind: "ConfigMap", Name: "config"},
+			appv1.ResourceStatus{Group: "apps", Kind: "StatefulSet", Name: "missing", Health: &appv1.HealthStatus{Status: health.HealthStatusMissing}},
+		),
+		withResources(newDestinationApp("other", appv1.ApplicationDestination{Server: "https://new-cluster", Namespace: "guestbook"}),
+			appv1.ResourceStatus{Kind: "Secret", Name: "secret"},
+		),
+	)
+
+	res, err := server.Inventory(t.Context(), &cluster.ClusterInventoryQuery{Id: &cluster.ClusterID{Type: "name", Value: "old"}})
+	require.NoError(t, err)
+	require.Len(t, res.Clusters, 1)
+	assert.Equal(t, "https://old-cluster", res.Clusters[0].Server)
+	assert.Equal(t, "old", res.Clusters[0].Name)
+	assert.Equal(t, []*cluster.ClusterInventoryItem{
+		{Group: "", Kind: "ConfigMap", Count: 3, Applications: 2},
+		{Group: "", Kind: "Service", Count: 1, Applications: 1},
+		{Group: "apps", Kind: "Deployment", Count: 1, Applications: 1},
+	}, res.Clusters[0].Items)
+
+	res, err = server.Inventory(t.Context(), &cluster.ClusterInventoryQuery{})
+	require.NoError(t, err)
+	inventories := map[string]*cluster.ClusterInventory{}
+	for _, c := range res.Clusters {
+		inventories[c.Server] = c
+	}
+	require.Contains(t, inventories, "https://new-cluster")
+	assert.Equal(t, []*cluster.ClusterInventoryItem{{Group: "", Kind: "Secret", Count: 1, Applications: 1}}, inventories["https://new-cluster"].Items)
+	require.Contains(t, inventories, "https://old-cluster")
+	assert.Len(t, inventories["https://old-cluster"].Items, 3)
+}
+
 func TestRotateAuth(t *testing.T) {
 	testNamespace := "kube-system"
 	token := "eyJhbGciOiJSUzI1NiIsImtpZCI6IiJ9.eyJpc3MiOiJrdWJlcm5ldGVzL3NlcnZpY2VhY2NvdW50Iiwia3ViZXJuZXRlcy5pby9zZXJ2aWNlYWNjb3VudC9uYW1lc3BhY2UiOiJrdWJlLXN5c3RlbSIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VjcmV0Lm5hbWUiOiJhcmdvY2QtbWFuYWdlci10b2tlbi10ajc5ciIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VydmljZS1hY2NvdW50Lm5hbWUiOiJhcmdvY2QtbWFuYWdlciIsImt1YmVybmV0ZXMuaW8vc2VydmljZWFjY291bnQvc2VydmljZS1hY2NvdW50LnVpZCI6IjkxZGQzN2NmLThkOTItMTFlOS1hMDkxLWQ2NWYyYWU3ZmE4ZCIsInN1YiI6InN5c3RlbTpzZXJ2aWNlYWNjb3VudDprdWJlLXN5c3RlbTphcmdvY2QtbWFuYWdlciJ9.ytZjt2pDV8-A7DBMR06zQ3wt9cuVEfq262TQw7sdra-KRpDpMPnziMhc8bkwvgW-LGhTWUh5iu1y-1QhEx6mtbCt7vQArlBRxfvM5ys6ClFkplzq5c2TtZ7EzGSD0Up7tdxuG9dvR6TGXYdfFcG779yCdZo2H48sz5OSJfdEriduMEY1iL5suZd3ebOoVi1fGflmqFEkZX6SvxkoArl5mtNP6TvZ1eTcn64xh4ws152hxio42E-eSnl_CET4tpB5vgP5BVlSKW2xB7w2GJxqdETA5LJRI_OilY77dTOp8cMr_Ck3EOeda3zHfh4Okflg8rZFEeAuJYahQNeAILLkcA"
@@ -530,7 +779,7 @@ func TestRotateAuth(t *testing.T) {
 		})
 
 	db := db.NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
-	server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newNoopEnforcer())
 
 	t.Run("RotateAuth by Unknown Name", func(t *testing.T) {
 		_, err := server.RotateAuth(t.Context(), &cluster.ClusterQuery{
@@ -612,7 +861,7 @@ func TestListCluster(t *testing.T) {
 
 	db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 
-	s := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	s := newTestServer(db, newNoopEnforcer())
 
 	tests := []struct {
 		name    string
@@ -713,7 +962,7 @@ func TestGetClusterAndVerifyAccess(t *testing.T) {
 
 		db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 
-		server := NewServer(db, newNoopEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		server := newTestServer(db, newNoopEnforcer())
 		localCluster, err := server.getClusterAndVerifyAccess(t.Context(), &cluster.ClusterQuery{
 			Name: "test/not-exists",
 		}, rbac.ActionGet)
@@ -739,7 +988,7 @@ func TestGetClusterAndVerifyAccess(t *testing.T) {
 
 		db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 
-		server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+		server := newTestServer(db, newEnforcer())
 		localCluster, err := server.getClusterAndVerifyAccess(t.Context(), &cluster.ClusterQuery{
 			Name: "test/ing",
 		}, rbac.ActionGet)
@@ -767,7 +1016,7 @@ func TestNoClusterEnumeration(t *testing.T) {
 	db.EXPECT().ListClusters(mock.Anything).Return(&mockClusterList, nil)
 	db.EXPECT().GetCluster(mock.Anything, mock.Anything).Return(&mockCluster, nil)
 
-	server := NewServer(db, newEnforcer(), newServerInMemoryCache(), &kubetest.MockKubectlCmd{})
+	server := newTestServer(db, newEnforcer())
 
 	t.Run("Get", func(t *testing.T) {
 		_, err := server.Get(t.Context(), &cluster.ClusterQuery{
@@ -0,0 +1,260 @@
+package clusterregistration
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// URLPrefix is the path the registration endpoints are served under
+	URLPrefix = "/api/cluster-registration/v1"
+	// RegisterPath is the path agents submit registration requests to
+	RegisterPath = URLPrefix + "/register"
+	// RotateTokenPath is the path agents of approved clusters submit new tokens to
+	RotateTokenPath = URLPrefix + "/token"
+
+	// minKeyLength is the minimum length of the key an agent authenticates with
+	minKeyLength = 32
+	// maxRequestSize is the maximum size of a request body
+	maxRequestSize = 1024 * 1024
+)
+
+// RegisterRequest is submitted by an agent to request the registration of its cluster. Agents repeat the request
+// to poll for its status until it is approved or denied.
+type RegisterRequest struct {
+	// Name is the name the cluster is registered with
+	Name string `json:"name"`
+	// Server is the URL of the API server of the cluster, as reachable from Argo CD
+	Server string `json:"server"`
+	// CAData holds the PEM-encoded CA bundle of the API server
+	CAData []byte `json:"caData,omitempty"`
+	// BearerToken is the token of the service account Argo CD uses to access the cluster
+	BearerToken string `json:"bearerToken"`
+	// Key is a random secret generated by the agent, which authenticates all further requests for the cluster
+	Key string `json:"key"`
+}
+
+// RotateTokenRequest is submitted by an agent to replace the token of an approved cluster.
+type RotateTokenRequest struct {
+	// Name is the name the cluster is registered with
+	Name string `json:"name"`
+	// Key is the key the registration request was submitted with
+	Key string `json:"key"`
+	// BearerToken is the new token of the service account Argo CD uses to access the cluster
+	BearerToken string `json:"bearerToken"`
+}
+
+// Response is returned for all requests that were accepted.
+type Response struct {
+	// Status is the status of the registration request
+	Status string `json:"status"`
+	// Message describes why a request was denied
+	Message string `json:"message,omitempty"`
+}
+
+// Handler serves the endpoints used by cluster agents. Registration requests are authenticated with the bootstrap
+// token configured in the cluster.registration.token key of argocd-secret, and are rejected if no token is configured.
+// Token rotations are authenticated with the key of the registration request.
+type Handler struct {
+	store       *Store
+	db          db.ArgoDB
+	settingsMgr *settings.SettingsManager
+	now         func() time.Time
+}
+
+// NewHandler returns a handler for the registration endpoints.
+func NewHandler(namespace string, kubeClient kubernetes.Interface, argoDB db.ArgoDB, settingsMgr *settings.SettingsManager) *Handler {
+	return &Handler{
+		store:       NewStore(kubeClient, namespace),
+		db:          argoDB,
+		settingsMgr: settingsMgr,
+		now:         time.Now,
+	}
+}
+
+// httpError is an error that is returned to the agent with the given status code.
+type httpError struct {
+	code    int
+	message string
+}
+
+func (e *httpError) Error() string {
+	return e.message
+}
+
+func errorf(code int, format string, args ...any) error {
+	return &httpError{code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	argoSettings, err := h.settingsMgr.GetSettings()
+	if err != nil {
+		log.Errorf("Failed to get settings: %v", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestSize)
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+
+	var resp *Response
+	switch r.URL.Path {
+	case RegisterPath:
+		if argoSettings.ClusterRegistrationToken == "" {
+			http.Error(w, "Cluster registration is disabled", http.StatusNotFound)
+			return
+		}
+		token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(argoSettings.ClusterRegistrationToken)) != 1 {
+			log.WithField(common.SecurityField, common.SecurityMedium).Warn("Cluster registration request with invalid bootstrap token")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var req RegisterRequest
+		if err = decoder.Decode(&req); err != nil {
+			err = errorf(http.StatusBadRequest, "invalid request: %v", err)
+			break
+		}
+		resp, err = h.register(r, &req)
+	case RotateTokenPath:
+		var req RotateTokenRequest
+		if err = decoder.Decode(&req); err != nil {
+			err = errorf(http.StatusBadRequest, "invalid request: %v", err)
+			break
+		}
+		resp, err = h.rotateToken(r, &req)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if err != nil {
+		var httpErr *httpError
+		if errors.As(err, &httpErr) {
+			http.Error(w, httpErr.message, httpErr.code)
+			return
+		}
+		log.Errorf("Failed to process cluster registration request: %v", err)
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (h *Handler) register(r *http.Request, req *RegisterRequest) (*Response, error) {
+	if errs := validation.IsDNS1123Label(req.Name); len(errs) > 0 {
+		return nil, errorf(http.StatusBadRequest, "invalid name %q: %s", req.Name, strings.Join(errs, ", "))
+	}
+	if u, err := url.ParseRequestURI(req.Server); err != nil || u.Scheme != "https" {
+		return nil, errorf(http.StatusBadRequest, "invalid server %q: must be an https URL", req.Server)
+	}
+	if req.BearerToken == "" {
+		return nil, errorf(http.StatusBadRequest, "bearerToken is required")
+	}
+	if len(req.Key) < minKeyLength {
+		return nil, errorf(http.StatusBadRequest, "key must be at least %d characters", minKeyLength)
+	}
+
+	ctx := r.Context()
+	logCtx := log.WithFields(log.Fields{"cluster": req.Name, "server": req.Server})
+	reg, err := h.store.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		err = h.store.Create(ctx, &Registration{
+			Name:        req.Name,
+			Server:      req.Server,
+			CAData:      req.CAData,
+			BearerToken: req.BearerToken,
+			KeyHash:     HashKey(req.Key),
+			Status:      StatusPending,
+			RequestedAt: h.now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		logCtx.Info("Cluster registration requested")
+		return &Response{Status: StatusPending}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !reg.VerifyKey(req.Key) {
+		logCtx.WithField(common.SecurityField, common.SecurityMedium).Warn("Cluster registration request with invalid key")
+		return nil, errorf(http.StatusConflict, "a registration request for cluster %q already exists", req.Name)
+	}
+	if reg.Status == StatusPending {
+		// the server and CA of a request must not change, so that the cluster added on approval is the one the
+		// administrator reviewed, while the token may be rotated until the request is approved
+		if reg.Server != req.Server || !bytes.Equal(reg.CAData, req.CAData) {
+			logCtx.WithField(common.SecurityField, common.SecurityMedium).Warn("Cluster registration request with a different server or CA")
+			return nil, errorf(http.StatusConflict, "the server and CA of the registration request for cluster %q cannot be changed, delete the request to submit a new one", req.Name)
+		}
+		if reg.BearerToken != req.BearerToken {
+			reg.BearerToken = req.BearerToken
+			if err := h.store.Update(ctx, reg); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &Response{Status: reg.Status, Message: reg.Message}, nil
+}
+
+func (h *Handler) rotateToken(r *http.Request, req *RotateTokenRequest) (*Response, error) {
+	if req.BearerToken == "" {
+		return nil, errorf(http.StatusBadRequest, "bearerToken is required")
+	}
+	ctx := r.Context()
+	reg, err := h.store.Get(ctx, req.Name)
+	if errors.Is(err, ErrNotFound) {
+		return nil, errorf(http.StatusNotFound, "registration request for cluster %q not found", req.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !reg.VerifyKey(req.Key) {
+		log.WithField(common.SecurityField, common.SecurityMedium).Warnf("Token rotation for cluster %q with invalid key", req.Name)
+		return nil, errorf(http.StatusForbidden, "invalid key")
+	}
+	if reg.Status != StatusApproved {
+		return nil, errorf(http.StatusConflict, "registration request for cluster %q is %s", req.Name, reg.Status)
+	}
+
+	cluster, err := h.db.GetCluster(ctx, reg.Server)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster %q: %w", reg.Server, err)
+	}
+	if cluster.Labels[LabelKeyRegistration] != reg.Name {
+		return nil, errorf(http.StatusConflict, "cluster %q is not managed by the registration request", reg.Server)
+	}
+	cluster.Config.BearerToken = req.BearerToken
+	if _, err := h.db.UpdateCluster(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("error updating cluster %q: %w", reg.Server, err)
+	}
+	now := h.now()
+	reg.RotatedAt = &now
+	if err := h.store.Update(ctx, reg); err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"cluster": reg.Name, "server": reg.Server}).Info("Cluster token rotated")
+	return &Response{Status: reg.Status}, nil
+}
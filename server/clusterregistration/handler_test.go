@@ -0,0 +1,199 @@
+package clusterregistration
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	testNamespace      = "argocd"
+	testBootstrapToken = "bootstrap-token"
+	testKey            = "0123456789abcdef0123456789abcdef"
+)
+
+func newTestHandler(t *testing.T, bootstrapToken string) (*Handler, *fake.Clientset) {
+	t.Helper()
+	kubeClient := fake.NewClientset(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDConfigMapName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDSecretName, Namespace: testNamespace, Labels: map[string]string{"app.kubernetes.io/part-of": "argocd"}},
+			Data: map[string][]byte{
+				"server.secretkey":           []byte("test"),
+				"cluster.registration.token": []byte(bootstrapToken),
+			},
+		},
+	)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, testNamespace)
+	h := NewHandler(testNamespace, kubeClient, db.NewDB(testNamespace, settingsMgr, kubeClient), settingsMgr)
+	h.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	return h, kubeClient
+}
+
+func post(t *testing.T, h http.Handler, path, bearerToken string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	data, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(data))
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeResponse(t *testing.T, rec *httptest.ResponseRecorder) Response {
+	t.Helper()
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestHandler_RegisterApproveRotate(t *testing.T) {
+	h, _ := newTestHandler(t, testBootstrapToken)
+	registerReq := &RegisterRequest{Name: "spoke", Server: "https://spoke.example.com", CAData: []byte("ca"), BearerToken: "token-1", Key: testKey}
+
+	resp := decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+	assert.Equal(t, StatusPending, resp.Status)
+
+	// polling with a new token updates the pending request
+	registerReq.BearerToken = "token-2"
+	resp = decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+	assert.Equal(t, StatusPending, resp.Status)
+	reg, err := h.store.Get(t.Context(), "spoke")
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", reg.BearerToken)
+
+	// the server and CA of a pending request cannot be changed
+	rec := post(t, h, RegisterPath, testBootstrapToken, &RegisterRequest{Name: "spoke", Server: "https://other.example.com", CAData: []byte("ca"), BearerToken: "token-2", Key: testKey})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	rec = post(t, h, RegisterPath, testBootstrapToken, &RegisterRequest{Name: "spoke", Server: "https://spoke.example.com", CAData: []byte("other-ca"), BearerToken: "token-2", Key: testKey})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	reg, err = h.store.Get(t.Context(), "spoke")
+	require.NoError(t, err)
+	assert.Equal(t, "https://spoke.example.com", reg.Server)
+	assert.Equal(t, []byte("ca"), reg.CAData)
+
+	// rotating the token of a pending request is not allowed
+	rec = post(t, h, RotateTokenPath, "", &RotateTokenRequest{Name: "spoke", Key: testKey, BearerToken: "token-3"})
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	cluster, err := h.store.Approve(t.Context(), h.db, "spoke")
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", cluster.Config.BearerToken)
+	assert.Equal(t, []byte("ca"), cluster.Config.CAData)
+	assert.Equal(t, "spoke", cluster.Labels[LabelKeyRegistration])
+
+	resp = decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+	assert.Equal(t, StatusApproved, resp.Status)
+	reg, err = h.store.Get(t.Context(), "spoke")
+	require.NoError(t, err)
+	assert.Empty(t, reg.BearerToken, "the token is only kept in the cluster secret")
+
+	resp = decodeResponse(t, post(t, h, RotateTokenPath, "", &RotateTokenRequest{Name: "spoke", Key: testKey, BearerToken: "token-3"}))
+	assert.Equal(t, StatusApproved, resp.Status)
+	cluster, err = h.db.GetCluster(t.Context(), "https://spoke.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", cluster.Config.BearerToken)
+	reg, err = h.store.Get(t.Context(), "spoke")
+	require.NoError(t, err)
+	require.NotNil(t, reg.RotatedAt)
+	assert.Equal(t, h.now(), *reg.RotatedAt)
+
+	t.Run("ReRegister", func(t *testing.T) {
+		require.NoError(t, h.store.Delete(t.Context(), "spoke"))
+		registerReq.BearerToken = "token-4"
+		resp := decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+		assert.Equal(t, StatusPending, resp.Status)
+
+		cluster, err := h.store.Approve(t.Context(), h.db, "spoke")
+		require.NoError(t, err)
+		assert.Equal(t, "token-4", cluster.Config.BearerToken)
+	})
+}
+
+func TestHandler_Deny(t *testing.T) {
+	h, _ := newTestHandler(t, testBootstrapToken)
+	registerReq := &RegisterRequest{Name: "spoke", Server: "https://spoke.example.com", BearerToken: "token", Key: testKey}
+	decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+
+	require.NoError(t, h.store.Deny(t.Context(), "spoke", "unknown cluster"))
+	resp := decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, registerReq))
+	assert.Equal(t, Response{Status: StatusDenied, Message: "unknown cluster"}, resp)
+
+	_, err := h.store.Approve(t.Context(), h.db, "spoke")
+	require.EqualError(t, err, `registration request "spoke" is Denied`)
+}
+
+func TestHandler_Errors(t *testing.T) {
+	h, _ := newTestHandler(t, testBootstrapToken)
+	valid := RegisterRequest{Name: "spoke", Server: "https://spoke.example.com", BearerToken: "token", Key: testKey}
+	decodeResponse(t, post(t, h, RegisterPath, testBootstrapToken, &valid))
+
+	tests := []struct {
+		name  string
+		path  string
+		token string
+		body  any
+		code  int
+	}{
+		{"MissingBootstrapToken", RegisterPath, "", &valid, http.StatusUnauthorized},
+		{"InvalidBootstrapToken", RegisterPath, "invalid", &valid, http.StatusUnauthorized},
+		{"InvalidName", RegisterPath, testBootstrapToken, &RegisterRequest{Name: "Spoke_1", Server: valid.Server, BearerToken: "token", Key: testKey}, http.StatusBadRequest},
+		{"InsecureServer", RegisterPath, testBootstrapToken, &RegisterRequest{Name: "other", Server: "http://spoke.example.com", BearerToken: "token", Key: testKey}, http.StatusBadRequest},
+		{"ShortKey", RegisterPath, testBootstrapToken, &RegisterRequest{Name: "other", Server: valid.Server, BearerToken: "token", Key: "short"}, http.StatusBadRequest},
+		{"UnknownField", RegisterPath, testBootstrapToken, map[string]string{"name": "other", "unknown": "field"}, http.StatusBadRequest},
+		{"DifferentKey", RegisterPath, testBootstrapToken, &RegisterRequest{Name: "spoke", Server: valid.Server, BearerToken: "token", Key: testKey + "0"}, http.StatusConflict},
+		{"RotateUnknown", RotateTokenPath, "", &RotateTokenRequest{Name: "other", Key: testKey, BearerToken: "token"}, http.StatusNotFound},
+		{"RotateInvalidKey", RotateTokenPath, "", &RotateTokenRequest{Name: "spoke", Key: testKey + "0", BearerToken: "token"}, http.StatusForbidden},
+		{"UnknownPath", URLPrefix + "/unknown", "", &valid, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := post(t, h, tt.path, tt.token, tt.body)
+			assert.Equal(t, tt.code, rec.Code, rec.Body.String())
+		})
+	}
+
+	t.Run("MethodNotAllowed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, RegisterPath, http.NoBody))
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
+
+func TestHandler_Disabled(t *testing.T) {
+	h, kubeClient := newTestHandler(t, "")
+	rec := post(t, h, RegisterPath, "", &RegisterRequest{Name: "spoke", Server: "https://spoke.example.com", BearerToken: "token", Key: testKey})
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	secrets, err := kubeClient.CoreV1().Secrets(testNamespace).List(t.Context(), metav1.ListOptions{LabelSelector: common.LabelKeySecretType})
+	require.NoError(t, err)
+	assert.Empty(t, secrets.Items)
+
+	t.Run("RotateToken", func(t *testing.T) {
+		require.NoError(t, h.store.Create(t.Context(), &Registration{Name: "spoke", Server: "https://spoke.example.com", BearerToken: "token-1", KeyHash: HashKey(testKey), Status: StatusPending}))
+		_, err := h.store.Approve(t.Context(), h.db, "spoke")
+		require.NoError(t, err)
+
+		resp := decodeResponse(t, post(t, h, RotateTokenPath, "", &RotateTokenRequest{Name: "spoke", Key: testKey, BearerToken: "token-2"}))
+		assert.Equal(t, StatusApproved, resp.Status, "approved clusters rotate their token while registration is disabled")
+	})
+}
@@ -0,0 +1,261 @@
+// Package clusterregistration implements the hub side of the cluster registration handshake. An agent running in a
+// spoke cluster submits a registration request containing the address of its API server and a service account
+// token. Once an administrator approves the request, the cluster is added to Argo CD, and the agent keeps the token
+// of the cluster rotated without anyone having to hold a kubeconfig of the spoke cluster.
+package clusterregistration
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+)
+
+const (
+	// StatusPending indicates that a registration request awaits approval
+	StatusPending = "Pending"
+	// StatusApproved indicates that the cluster of a registration request was added to Argo CD
+	StatusApproved = "Approved"
+	// StatusDenied indicates that a registration request was denied
+	StatusDenied = "Denied"
+
+	// LabelKeyRegistration is set on the cluster secrets that were created by approving a registration request
+	LabelKeyRegistration = "argocd.argoproj.io/cluster-registration"
+
+	secretNamePrefix = "cluster-registration-"
+)
+
+// ErrNotFound is returned when a registration request does not exist
+var ErrNotFound = errors.New("registration request not found")
+
+// Registration is a registration request submitted by a cluster agent. It is stored in a secret in the Argo CD
+// namespace.
+type Registration struct {
+	// Name is the name the cluster is registered with
+	Name string
+	// Server is the URL of the API server of the cluster
+	Server string
+	// CAData holds the PEM-encoded CA bundle of the API server
+	CAData []byte
+	// BearerToken is the token of the service account the agent created for Argo CD. It is only kept until the
+	// request is approved, after which it is stored in the cluster secret.
+	BearerToken string
+	// KeyHash is the SHA-256 hash of the key that authenticates the agent
+	KeyHash string
+	// Status is one of Pending, Approved or Denied
+	Status string
+	// Message describes why a request was denied
+	Message string
+	// RequestedAt is the time the request was first submitted
+	RequestedAt time.Time
+	// RotatedAt is the time the token of the cluster was last rotated
+	RotatedAt *time.Time
+}
+
+// HashKey returns the hash of an agent key that is stored in the registration request.
+func HashKey(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(h[:])
+}
+
+// VerifyKey returns whether the key matches the key the request was submitted with.
+func (r *Registration) VerifyKey(key string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashKey(key)), []byte(r.KeyHash)) == 1
+}
+
+// Store persists registration requests in labeled secrets.
+type Store struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+// NewStore returns a store for registration requests in the given namespace.
+func NewStore(kubeClient kubernetes.Interface, namespace string) *Store {
+	return &Store{kubeClient: kubeClient, namespace: namespace}
+}
+
+// Get returns the registration request of the cluster with the given name.
+func (s *Store) Get(ctx context.Context, name string) (*Registration, error) {
+	secret, err := s.kubeClient.CoreV1().Secrets(s.namespace).Get(ctx, secretNamePrefix+name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("error getting registration request %q: %w", name, err)
+	}
+	return secretToRegistration(secret)
+}
+
+// List returns all registration requests.
+func (s *Store) List(ctx context.Context) ([]*Registration, error) {
+	secrets, err := s.kubeClient.CoreV1().Secrets(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: common.LabelKeySecretType + "=" + common.LabelValueSecretTypeClusterRegistration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing registration requests: %w", err)
+	}
+	registrations := make([]*Registration, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		reg, err := secretToRegistration(&secrets.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		registrations = append(registrations, reg)
+	}
+	return registrations, nil
+}
+
+// Create stores a new registration request.
+func (s *Store) Create(ctx context.Context, reg *Registration) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNamePrefix + reg.Name}}
+	registrationToSecret(reg, secret)
+	if _, err := s.kubeClient.CoreV1().Secrets(s.namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("error creating registration request %q: %w", reg.Name, err)
+	}
+	return nil
+}
+
+// Update stores the changes of an existing registration request.
+func (s *Store) Update(ctx context.Context, reg *Registration) error {
+	secret, err := s.kubeClient.CoreV1().Secrets(s.namespace).Get(ctx, secretNamePrefix+reg.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting registration request %q: %w", reg.Name, err)
+	}
+	registrationToSecret(reg, secret)
+	if _, err := s.kubeClient.CoreV1().Secrets(s.namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("error updating registration request %q: %w", reg.Name, err)
+	}
+	return nil
+}
+
+// Delete removes a registration request. The cluster of an approved request is not removed.
+func (s *Store) Delete(ctx context.Context, name string) error {
+	err := s.kubeClient.CoreV1().Secrets(s.namespace).Delete(ctx, secretNamePrefix+name, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Approve adds the cluster of a pending registration request to Argo CD.
+func (s *Store) Approve(ctx context.Context, argoDB db.ArgoDB, name string) (*v1alpha1.Cluster, error) {
+	reg, err := s.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if reg.Status != StatusPending {
+		return nil, fmt.Errorf("registration request %q is %s", name, reg.Status)
+	}
+	cluster, err := argoDB.CreateCluster(ctx, &v1alpha1.Cluster{
+		Server: reg.Server,
+		Name:   reg.Name,
+		Config: v1alpha1.ClusterConfig{
+			BearerToken:     reg.BearerToken,
+			TLSClientConfig: v1alpha1.TLSClientConfig{CAData: reg.CAData},
+		},
+		Labels: map[string]string{LabelKeyRegistration: reg.Name},
+	})
+	if status.Code(err) == codes.AlreadyExists {
+		// the request was deleted and submitted again, so take over the cluster if it was added by the same registration
+		cluster, err = argoDB.GetCluster(ctx, reg.Server)
+		if err != nil {
+			return nil, fmt.Errorf("error getting cluster %q: %w", reg.Server, err)
+		}
+		if cluster.Labels[LabelKeyRegistration] != reg.Name {
+			return nil, fmt.Errorf("cluster %q already exists and was not added by registration request %q", reg.Server, name)
+		}
+		cluster.Config.BearerToken = reg.BearerToken
+		cluster.Config.CAData = reg.CAData
+		cluster, err = argoDB.UpdateCluster(ctx, cluster)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error adding cluster %q: %w", reg.Server, err)
+	}
+	reg.Status = StatusApproved
+	reg.BearerToken = ""
+	return cluster, s.Update(ctx, reg)
+}
+
+// Deny rejects a pending registration request.
+func (s *Store) Deny(ctx context.Context, name, message string) error {
+	reg, err := s.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if reg.Status != StatusPending {
+		return fmt.Errorf("registration request %q is %s", name, reg.Status)
+	}
+	reg.Status = StatusDenied
+	reg.Message = message
+	reg.BearerToken = ""
+	return s.Update(ctx, reg)
+}
+
+func secretToRegistration(secret *corev1.Secret) (*Registration, error) {
+	reg := &Registration{
+		Name:        string(secret.Data["name"]),
+		Server:      string(secret.Data["server"]),
+		CAData:      secret.Data["caData"],
+		BearerToken: string(secret.Data["bearerToken"]),
+		KeyHash:     string(secret.Data["keyHash"]),
+		Status:      string(secret.Data["status"]),
+		Message:     string(secret.Data["message"]),
+	}
+	requestedAt, err := time.Parse(time.RFC3339, string(secret.Data["requestedAt"]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid registration request %q: %w", secret.Name, err)
+	}
+	reg.RequestedAt = requestedAt
+	if rotatedAt, ok := secret.Data["rotatedAt"]; ok {
+		t, err := time.Parse(time.RFC3339, string(rotatedAt))
+		if err != nil {
+			return nil, fmt.Errorf("invalid registration request %q: %w", secret.Name, err)
+		}
+		reg.RotatedAt = &t
+	}
+	return reg, nil
+}
+
+func registrationToSecret(reg *Registration, secret *corev1.Secret) {
+	secret.Data = map[string][]byte{
+		"name":        []byte(reg.Name),
+		"server":      []byte(reg.Server),
+		"keyHash":     []byte(reg.KeyHash),
+		"status":      []byte(reg.Status),
+		"requestedAt": []byte(reg.RequestedAt.UTC().Format(time.RFC3339)),
+	}
+	if len(reg.CAData) > 0 {
+		secret.Data["caData"] = reg.CAData
+	}
+	if reg.BearerToken != "" {
+		secret.Data["bearerToken"] = []byte(reg.BearerToken)
+	}
+	if reg.Message != "" {
+		secret.Data["message"] = []byte(reg.Message)
+	}
+	if reg.RotatedAt != nil {
+		secret.Data["rotatedAt"] = []byte(reg.RotatedAt.UTC().Format(time.RFC3339))
+	}
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[common.LabelKeySecretType] = common.LabelValueSecretTypeClusterRegistration
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[common.AnnotationKeyManagedBy] = common.AnnotationValueManagedByArgoCD
+}
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/felixge/httpsnoop"
@@ -30,6 +31,9 @@ import (
 
 const (
 	URLPrefix                    = "/extensions"
+	RegistryURLPrefix            = "/api/v1/extensions"
+	DefaultHealthCheckTimeout    = 2 * time.Second
+	HealthCheckResultTTL         = 10 * time.Second
 	DefaultConnectionTimeout     = 2 * time.Second
 	DefaultKeepAlive             = 15 * time.Second
 	DefaultIdleConnectionTimeout = 60 * time.Second
@@ -156,6 +160,18 @@ type ExtensionConfig struct {
 	// the extension route. Mandatory field.
 	Name    string        `yaml:"name"`
 	Backend BackendConfig `yaml:"backend"`
+
+	// UI if provided, declares the UI bundle associated with this
+	// extension. It is only used for reporting purposes in the
+	// extension registry API.
+	UI *UIConfig `yaml:"ui,omitempty"`
+}
+
+// UIConfig defines the UI bundle declared by one extension.
+type UIConfig struct {
+	// Version is the declared version of the UI bundle that is
+	// expected to be served alongside this extension.
+	Version string `yaml:"version"`
 }
 
 // BackendConfig defines the backend service configurations that will
@@ -166,6 +182,24 @@ type ExtensionConfig struct {
 type BackendConfig struct {
 	ProxyConfig
 	Services []ServiceConfig `yaml:"services"`
+
+	// HealthCheck if provided, enables health probes against all
+	// backend services of this extension.
+	HealthCheck *HealthCheckConfig `yaml:"healthCheck,omitempty"`
+}
+
+// HealthCheckConfig defines how the API server probes the backend
+// services of an extension to determine their health.
+type HealthCheckConfig struct {
+	// Path is the HTTP path that will be requested in every backend
+	// service URL. Responses with 2xx or 3xx status codes are considered
+	// healthy. Mandatory field if a health check is provided.
+	Path string `yaml:"path"`
+
+	// Timeout is the maximum amount of time the API server will wait
+	// for the health probe response.
+	// Default: 2 seconds
+	Timeout time.Duration `yaml:"timeout"`
 }
 
 // ServiceConfig provides the configuration for a backend service.
@@ -357,9 +391,15 @@ type Manager struct {
 	project     ProjectGetter
 	cluster     argo.ClusterGetter
 	rbac        RbacEnforcer
-	registry    ExtensionRegistry
 	metricsReg  ExtensionMetricsRegistry
 	userGetter  UserGetter
+
+	// lock guards the registry, configs and healthResults fields which
+	// are replaced when the extension configurations are updated.
+	lock          sync.RWMutex
+	registry      ExtensionRegistry
+	configs       []ExtensionConfig
+	healthResults map[healthResultKey]healthResult
 }
 
 // ExtensionMetricsRegistry exposes operations to update http metrics in the Argo CD
@@ -459,9 +499,17 @@ func parseAndValidateConfig(s *settings.ArgoCDSettings) (*ExtensionConfigs, erro
 			if err != nil {
 				return nil, fmt.Errorf("invalid parsed backend extension config for extension %s: %w", extName, err)
 			}
+			uiConfig := struct {
+				UI *UIConfig `yaml:"ui,omitempty"`
+			}{}
+			err = yaml.Unmarshal(parsedExtConfigBytes, &uiConfig)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parsed ui extension config for extension %s: %w", extName, err)
+			}
 			ext := ExtensionConfig{
 				Name:    extName,
 				Backend: backendConfig,
+				UI:      uiConfig.UI,
 			}
 			configs.Extensions = append(configs.Extensions, ext)
 		}
@@ -491,6 +539,9 @@ func validateConfigs(configs *ExtensionConfigs) error {
 		if svcTotal == 0 {
 			return fmt.Errorf("no backend service configured for extension %s", ext.Name)
 		}
+		if ext.Backend.HealthCheck != nil && ext.Backend.HealthCheck.Path == "" {
+			return errors.New("extensions.backend.healthCheck.path must be configured when health check is provided")
+		}
 		for _, svc := range ext.Backend.Services {
 			if svc.URL == "" {
 				return errors.New("extensions.backend.services.url must be configured")
@@ -617,7 +668,11 @@ func (m *Manager) UpdateExtensionRegistry(s *settings.ArgoCDSettings) error {
 		}
 		extReg[ext.Name] = proxyReg
 	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	m.registry = extReg
+	m.configs = extConfigs.Extensions
+	m.healthResults = map[healthResultKey]healthResult{}
 	return nil
 }
 
@@ -710,7 +765,7 @@ func (m *Manager) authorize(ctx context.Context, rr *RequestResources, extName s
 	if proj == nil {
 		return nil, fmt.Errorf("invalid project provided in the %q header", HeaderArgoCDProjectName)
 	}
-	destCluster, err := argo.GetDestinationCluster(ctx, app.Spec.Destination, m.cluster)
+	destCluster, err := argo.GetMappedDestinationCluster(ctx, app.Spec.Destination, proj, m.cluster)
 	if err != nil {
 		return nil, fmt.Errorf("error getting destination cluster: %w", err)
 	}
@@ -750,6 +805,8 @@ func findProxy(registry ProxyRegistry, extName string, dest v1alpha1.Application
 // ProxyRegistry returns the proxy registry associated for the given
 // extension name.
 func (m *Manager) ProxyRegistry(name string) (ProxyRegistry, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
 	pReg, found := m.registry[name]
 	return pReg, found
 }
@@ -0,0 +1,245 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// HealthStatus defines the health of an extension backend service as
+// reported by the extension registry API.
+type HealthStatus string
+
+const (
+	// HealthStatusHealthy means that the last health probe succeeded.
+	HealthStatusHealthy HealthStatus = "Healthy"
+	// HealthStatusUnhealthy means that the last health probe failed.
+	HealthStatusUnhealthy HealthStatus = "Unhealthy"
+	// HealthStatusUnknown means that no health check is configured for
+	// the extension.
+	HealthStatusUnknown HealthStatus = "Unknown"
+)
+
+// ExtensionInfo describes one registered proxy extension.
+type ExtensionInfo struct {
+	// Name is the name of the extension as defined in the configuration.
+	Name string `json:"name"`
+	// UIVersion is the declared version of the UI bundle associated
+	// with the extension.
+	UIVersion string `json:"uiVersion,omitempty"`
+	// Health is the aggregated health of all backend services. The
+	// extension is only healthy if all its backends are healthy.
+	Health HealthStatus `json:"health"`
+	// Backends is the list of backend services configured for the
+	// extension.
+	Backends []BackendInfo `json:"backends"`
+}
+
+// BackendInfo describes one backend service of a proxy extension.
+type BackendInfo struct {
+	// URL is the backend service URL with credentials redacted.
+	URL           string       `json:"url"`
+	ClusterName   string       `json:"clusterName,omitempty"`
+	ClusterServer string       `json:"clusterServer,omitempty"`
+	Health        HealthStatus `json:"health"`
+	// Message provides details about the health probe failure.
+	Message string `json:"message,omitempty"`
+}
+
+// ExtensionList is the response of the extension registry list API.
+type ExtensionList struct {
+	Items []ExtensionInfo `json:"items"`
+}
+
+// RegistryHandler returns a handler func responsible for reporting the
+// configured proxy extensions along with the health of their backends.
+// The following endpoints are served:
+//   - GET /api/v1/extensions: list all extensions the user can invoke
+//   - GET /api/v1/extensions/<name>: describe the given extension
+func (m *Manager) RegistryHandler() func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if m.rbac == nil {
+			m.log.Error("rbac enforcer not set in extension manager")
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+			return
+		}
+		m.lock.RLock()
+		configs := m.configs
+		m.lock.RUnlock()
+		extName := strings.Trim(strings.TrimPrefix(r.URL.Path, RegistryURLPrefix), "/")
+		if extName == "" {
+			items := []ExtensionInfo{}
+			for _, ext := range configs {
+				if err := m.rbac.EnforceErr(r.Context().Value("claims"), rbac.ResourceExtensions, rbac.ActionInvoke, ext.Name); err != nil {
+					continue
+				}
+				items = append(items, m.describeExtension(r.Context(), ext))
+			}
+			writeJSON(w, ExtensionList{Items: items})
+			return
+		}
+
+		idx := slices.IndexFunc(configs, func(ext ExtensionConfig) bool {
+			return ext.Name == extName
+		})
+		if idx < 0 {
+			http.Error(w, "Extension not found", http.StatusNotFound)
+			return
+		}
+		if err := m.rbac.EnforceErr(r.Context().Value("claims"), rbac.ResourceExtensions, rbac.ActionInvoke, extName); err != nil {
+			m.log.Infof("unauthorized extension registry request: %s", err)
+			http.Error(w, "Unauthorized extension request", http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, m.describeExtension(r.Context(), configs[idx]))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, "Internal error", http.StatusInternalServerError)
+	}
+}
+
+// healthResultKey identifies the health probe of one backend service of
+// an extension.
+type healthResultKey struct {
+	extension  string
+	serviceURL string
+}
+
+// healthResult is the outcome of a health probe, reused until it
+// expires.
+type healthResult struct {
+	health    HealthStatus
+	message   string
+	expiresAt time.Time
+}
+
+// describeExtension builds the ExtensionInfo for the given extension,
+// probing all its backend services concurrently if a health check is
+// configured and no recent result is available.
+func (m *Manager) describeExtension(ctx context.Context, ext ExtensionConfig) ExtensionInfo {
+	info := ExtensionInfo{
+		Name:     ext.Name,
+		Health:   HealthStatusUnknown,
+		Backends: make([]BackendInfo, len(ext.Backend.Services)),
+	}
+	if ext.UI != nil {
+		info.UIVersion = ext.UI.Version
+	}
+	var wg sync.WaitGroup
+	for i, svc := range ext.Backend.Services {
+		backend := BackendInfo{
+			URL:    redactURL(svc.URL),
+			Health: HealthStatusUnknown,
+		}
+		if svc.Cluster != nil {
+			backend.ClusterName = svc.Cluster.Name
+			backend.ClusterServer = svc.Cluster.Server
+		}
+		info.Backends[i] = backend
+		if ext.Backend.HealthCheck == nil {
+			continue
+		}
+		wg.Go(func() {
+			info.Backends[i].Health, info.Backends[i].Message = m.probeBackendCached(ctx, ext, svc.URL)
+		})
+	}
+	wg.Wait()
+
+	if ext.Backend.HealthCheck != nil {
+		info.Health = HealthStatusHealthy
+		for _, backend := range info.Backends {
+			if backend.Health != HealthStatusHealthy {
+				info.Health = HealthStatusUnhealthy
+				break
+			}
+		}
+	}
+	return info
+}
+
+// probeBackendCached returns the health of the given backend service of
+// the extension, probing it only if the previous result is older than
+// HealthCheckResultTTL.
+func (m *Manager) probeBackendCached(ctx context.Context, ext ExtensionConfig, serviceURL string) (HealthStatus, string) {
+	key := healthResultKey{extension: ext.Name, serviceURL: serviceURL}
+	m.lock.RLock()
+	// results of probes completing after a configuration update are
+	// stored in the replaced map
+	results := m.healthResults
+	result, ok := results[key]
+	m.lock.RUnlock()
+	if ok && time.Now().Before(result.expiresAt) {
+		return result.health, result.message
+	}
+
+	health, message := probeBackend(ctx, serviceURL, ext.Backend.HealthCheck, ext.Backend.ProxyConfig)
+	// a probe aborted because the client went away says nothing about
+	// the backend
+	if ctx.Err() != nil {
+		return health, message
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if results != nil {
+		results[key] = healthResult{health: health, message: message, expiresAt: time.Now().Add(HealthCheckResultTTL)}
+	}
+	return health, message
+}
+
+// probeBackend will send a GET request to the health check path of the
+// given backend service URL and return its health status along with a
+// message in case of failures.
+func probeBackend(ctx context.Context, serviceURL string, hc *HealthCheckConfig, config ProxyConfig) (HealthStatus, string) {
+	timeout := hc.Timeout
+	if timeout == 0 {
+		timeout = DefaultHealthCheckTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	probeURL, err := url.JoinPath(serviceURL, hc.Path)
+	if err != nil {
+		return HealthStatusUnhealthy, fmt.Sprintf("invalid health check URL: %s", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probeURL, http.NoBody)
+	if err != nil {
+		return HealthStatusUnhealthy, fmt.Sprintf("error creating health check request: %s", err)
+	}
+	client := &http.Client{Transport: newTransport(config)}
+	defer client.CloseIdleConnections()
+	resp, err := client.Do(req)
+	if err != nil {
+		return HealthStatusUnhealthy, fmt.Sprintf("health check request failed: %s", err)
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return HealthStatusUnhealthy, fmt.Sprintf("health check returned status code %d", resp.StatusCode)
+	}
+	return HealthStatusHealthy, ""
+}
+
+// redactURL removes the user credentials from the given URL if present.
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
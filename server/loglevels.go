@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	utillog "github.com/argoproj/argo-cd/v3/util/log"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// LogLevelsPath is the path of the API endpoint managing the log level
+// overrides of the API server.
+const LogLevelsPath = "/api/v1/loglevels"
+
+// LogLevels is the response of the log levels API.
+type LogLevels struct {
+	Level     string                  `json:"level"`
+	Overrides []utillog.LevelOverride `json:"overrides"`
+}
+
+// newLogLevelsHandler returns the handler of the log levels API, which
+// reports the log level overrides on GET requests, sets an override on PUT
+// requests and deletes one on DELETE requests. The requests are expected to
+// be authenticated, and are authorized with the 'loglevels' RBAC resource for
+// the field of the overrides.
+func newLogLevelsHandler(enf *rbac.Enforcer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := r.Context().Value("claims")
+		switch r.Method {
+		case http.MethodGet:
+			overrides := []utillog.LevelOverride{}
+			for _, o := range utillog.GetLevelOverrides() {
+				if enf.Enforce(claims, rbac.ResourceLogLevels, rbac.ActionGet, o.Field) {
+					overrides = append(overrides, o)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(LogLevels{Level: log.GetLevel().String(), Overrides: overrides})
+		case http.MethodPut:
+			if !strings.HasPrefix(strings.ToLower(r.Header.Get("Content-Type")), "application/json") {
+				http.Error(w, "Invalid content type", http.StatusUnsupportedMediaType)
+				return
+			}
+			var override utillog.LevelOverride
+			if err := json.NewDecoder(r.Body).Decode(&override); err != nil {
+				http.Error(w, "Invalid log level override", http.StatusBadRequest)
+				return
+			}
+			if !enf.Enforce(claims, rbac.ResourceLogLevels, rbac.ActionUpdate, override.Field) {
+				http.Error(w, "Permission denied", http.StatusForbidden)
+				return
+			}
+			if err := utillog.SetLevelOverride(override); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			log.WithFields(log.Fields{"field": override.Field, "value": override.Value}).Infof("Log level overridden to %s", override.Level)
+			w.WriteHeader(http.StatusNoContent)
+		case http.MethodDelete:
+			field, value := r.URL.Query().Get("field"), r.URL.Query().Get("value")
+			if !enf.Enforce(claims, rbac.ResourceLogLevels, rbac.ActionUpdate, field) {
+				http.Error(w, "Permission denied", http.StatusForbidden)
+				return
+			}
+			utillog.DeleteLevelOverride(field, value)
+			log.WithFields(log.Fields{"field": field, "value": value}).Info("Log level override deleted")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
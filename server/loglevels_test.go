@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/assets"
+	utillog "github.com/argoproj/argo-cd/v3/util/log"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+func TestLogLevelsHandler(t *testing.T) {
+	enf := rbac.NewEnforcer(fake.NewClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	enf.SetClaimsEnforcerFunc(rbacpolicy.NewRBACPolicyEnforcer(enf, test.NewFakeProjLister()).EnforceClaims)
+	require.NoError(t, enf.SetUserPolicy("g, reader, role:readonly\np, operator, loglevels, update, application, allow"))
+	handler := newLogLevelsHandler(enf)
+	t.Cleanup(func() {
+		utillog.DeleteLevelOverride("application", "guestbook")
+	})
+
+	serve := func(sub string, method string, target string, body string) *httptest.ResponseRecorder {
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", jwt.MapClaims{"sub": sub})
+		req := httptest.NewRequest(method, target, strings.NewReader(body)).WithContext(ctx)
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	override := `{"field":"application","value":"guestbook","level":"debug"}`
+	assert.Equal(t, http.StatusForbidden, serve("reader", http.MethodPut, LogLevelsPath, override).Code)
+	assert.Equal(t, http.StatusForbidden, serve("operator", http.MethodPut, LogLevelsPath, `{"field":"project","value":"default","level":"debug"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, serve("operator", http.MethodPut, LogLevelsPath, `{"field":"application","value":"guestbook","level":"verbose"}`).Code)
+	assert.Equal(t, http.StatusNoContent, serve("operator", http.MethodPut, LogLevelsPath, override).Code)
+
+	rec := serve("reader", http.MethodGet, LogLevelsPath, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var levels LogLevels
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &levels))
+	assert.Equal(t, []utillog.LevelOverride{{Field: "application", Value: "guestbook", Level: "debug"}}, levels.Overrides)
+
+	assert.Equal(t, http.StatusForbidden, serve("reader", http.MethodDelete, LogLevelsPath+"?field=application&value=guestbook", "").Code)
+	assert.Equal(t, http.StatusNoContent, serve("operator", http.MethodDelete, LogLevelsPath+"?field=application&value=guestbook", "").Code)
+	assert.Empty(t, utillog.GetLevelOverrides())
+}
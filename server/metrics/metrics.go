@@ -21,6 +21,7 @@ type MetricsServer struct {
 	extensionRequestCounter  *prometheus.CounterVec
 	extensionRequestDuration *prometheus.HistogramVec
 	loginRequestCounter      *prometheus.CounterVec
+	apiRateLimitedCounter    *prometheus.CounterVec
 	PrometheusRegistry       *prometheus.Registry
 }
 
@@ -62,6 +63,13 @@ var (
 		},
 		[]string{"status"},
 	)
+	apiRateLimitedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_api_rate_limited_request_total",
+			Help: "Number of API requests rejected because they exceeded the API rate limits of a project.",
+		},
+		[]string{"project", "operation"},
+	)
 	argoVersion = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "argocd_info",
@@ -88,6 +96,7 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 	registry.MustRegister(extensionRequestCounter)
 	registry.MustRegister(extensionRequestDuration)
 	registry.MustRegister(loginRequestCounter)
+	registry.MustRegister(apiRateLimitedCounter)
 	registry.MustRegister(argoVersion)
 
 	kubectl.RegisterWithClientGo()
@@ -103,6 +112,7 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 		extensionRequestCounter:  extensionRequestCounter,
 		extensionRequestDuration: extensionRequestDuration,
 		loginRequestCounter:      loginRequestCounter,
+		apiRateLimitedCounter:    apiRateLimitedCounter,
 		PrometheusRegistry:       registry,
 	}
 }
@@ -129,3 +139,9 @@ func (m *MetricsServer) ObserveExtensionRequestDuration(extension string, durati
 func (m *MetricsServer) IncLoginRequestCounter(status string) {
 	m.loginRequestCounter.WithLabelValues(status).Inc()
 }
+
+// IncAPIRateLimitedRequest increments the counter of the requests rejected because they exceeded the API rate limit of
+// the given operation in the given project
+func (m *MetricsServer) IncAPIRateLimitedRequest(project string, operation string) {
+	m.apiRateLimitedCounter.WithLabelValues(project, operation).Inc()
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -78,9 +79,27 @@ func validateProject(proj *v1alpha1.AppProject) error {
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "policy syntax error: %s", err.Error())
 	}
+	for _, exclusion := range proj.Spec.DriftExclusions {
+		if _, err := settings.CompileDriftExclusion(exclusion); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid drift exclusion: %s", err.Error())
+		}
+	}
 	return nil
 }
 
+// validateOperationWebhookURLs checks the URLs of the operation webhooks of the project against the URL patterns the
+// administrator permits in argocd-cm
+func (s *Server) validateOperationWebhookURLs(proj *v1alpha1.AppProject) error {
+	if len(proj.Spec.OperationWebhooks) == 0 {
+		return nil
+	}
+	allowedURLs, err := s.settingsMgr.GetOperationWebhookAllowedURLs()
+	if err != nil {
+		return fmt.Errorf("error getting the URLs permitted for operation webhooks: %w", err)
+	}
+	return proj.ValidateOperationWebhookURLs(allowedURLs)
+}
+
 // CreateToken creates a new token to access a project
 func (s *Server) CreateToken(ctx context.Context, q *project.ProjectTokenCreateRequest) (*project.ProjectTokenResponse, error) {
 	var resp *project.ProjectTokenResponse
@@ -261,6 +280,9 @@ func (s *Server) Create(ctx context.Context, q *project.ProjectCreateRequest) (*
 	if err != nil {
 		return nil, fmt.Errorf("error validating project: %w", err)
 	}
+	if err := s.validateOperationWebhookURLs(q.Project); err != nil {
+		return nil, err
+	}
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Create(ctx, q.Project, metav1.CreateOptions{})
 	if apierrors.IsAlreadyExists(err) {
 		existing, getErr := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Project.Name, metav1.GetOptions{})
@@ -370,6 +392,9 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 	if err != nil {
 		return nil, err
 	}
+	if err := s.validateOperationWebhookURLs(q.Project); err != nil {
+		return nil, err
+	}
 	s.projectLock.Lock(q.Project.Name)
 	defer s.projectLock.Unlock(q.Project.Name)
 
@@ -454,6 +479,9 @@ func (s *Server) Update(ctx context.Context, q *project.ProjectUpdateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "as a result of project update %s", strings.Join(parts, " and "))
 	}
 
+	// the sync windows can only be unlocked with UnlockSyncWindow, which enforces its own permission
+	q.Project.Status.SyncWindowUnlocks = oldProj.Status.SyncWindowUnlocks
+
 	res, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, q.Project, metav1.UpdateOptions{})
 	if err == nil {
 		s.logEvent(ctx, res, argo.EventReasonResourceUpdated, "updated project")
@@ -547,6 +575,51 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// UnlockSyncWindow allows the syncs during a sync window which requires an approval until the unlock expires
+func (s *Server) UnlockSyncWindow(ctx context.Context, q *project.SyncWindowUnlockRequest) (*v1alpha1.AppProject, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionUnlock, q.Name); err != nil {
+		return nil, err
+	}
+	var duration time.Duration
+	if q.Duration != "" {
+		var err error
+		duration, err = time.ParseDuration(q.Duration)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid duration '%s': %v", q.Duration, err)
+		}
+		if duration <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "duration '%s' must be positive", q.Duration)
+		}
+	}
+
+	s.projectLock.Lock(q.Name)
+	defer s.projectLock.Unlock(q.Name)
+
+	var res *v1alpha1.AppProject
+	var unlock *v1alpha1.SyncWindowUnlock
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		unlock, err = proj.UnlockSyncWindow(int(q.Id), duration, session.Username(ctx), q.Reason)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		res, err = s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Update(ctx, proj, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("unlocked sync window %d until %s", q.Id, unlock.ExpiresAt.UTC().Format(time.RFC3339))
+	if q.Reason != "" {
+		message += ": " + q.Reason
+	}
+	s.logEvent(ctx, res, argo.EventReasonResourceUpdated, message)
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
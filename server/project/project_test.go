@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -341,6 +342,30 @@ func TestProjectServer(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
 	})
 
+	t.Run("TestUpdateProjectInvalidDriftExclusion", func(t *testing.T) {
+		proj := existingProj.DeepCopy()
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		proj.Spec.DriftExclusions = []v1alpha1.DriftExclusion{{Group: "apps", Kind: "Deployment", Expression: "live.spec.replicas >"}}
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: proj})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), `invalid drift exclusion: invalid expression "live.spec.replicas >"`)
+	})
+
+	t.Run("TestUpdateProjectOperationWebhookURLNotPermitted", func(t *testing.T) {
+		proj := existingProj.DeepCopy()
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(proj), enforcer, sync.NewKeyLock(), nil, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		proj.Spec.OperationWebhooks = []v1alpha1.OperationWebhook{{Name: "metadata", URL: "http://169.254.169.254/latest"}}
+		_, err := projectServer.Update(t.Context(), &project.ProjectUpdateRequest{Project: proj})
+		statusCode, _ := status.FromError(err)
+		assert.Equal(t, codes.InvalidArgument, statusCode.Code())
+		assert.Contains(t, statusCode.Message(), "operation webhook 'metadata' URL 'http://169.254.169.254/latest' is not permitted")
+	})
+
 	t.Run("TestDeleteProjectReferencedByApp", func(t *testing.T) {
 		existingApp := v1alpha1.Application{
 			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
@@ -772,6 +797,54 @@ p, role:admin, projects, update, *, allow`)
 		require.NoError(t, err)
 		assert.Len(t, res.Spec.SyncWindows, 1)
 	})
+
+	t.Run("TestUnlockSyncWindow", func(t *testing.T) {
+		enforcer := newEnforcer(kubeclientset)
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, RequireApproval: true},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+
+		res, err := projectServer.UnlockSyncWindow(ctx, &project.SyncWindowUnlockRequest{Name: projectWithSyncWindows.Name, Id: 0, Duration: "30m", Reason: "CHG-1234"})
+		require.NoError(t, err)
+		require.Len(t, res.Status.SyncWindowUnlocks, 1)
+		assert.Equal(t, "CHG-1234", res.Status.SyncWindowUnlocks[0].Reason)
+		assert.WithinDuration(t, time.Now().Add(30*time.Minute), res.Status.SyncWindowUnlocks[0].ExpiresAt.Time, time.Minute)
+
+		// the unlocks are kept when the project is updated
+		updated := projectWithSyncWindows.DeepCopy()
+		updated.Spec.Description = "updated"
+		res, err = projectServer.Update(ctx, &project.ProjectUpdateRequest{Project: updated})
+		require.NoError(t, err)
+		assert.Len(t, res.Status.SyncWindowUnlocks, 1)
+
+		_, err = projectServer.UnlockSyncWindow(ctx, &project.SyncWindowUnlockRequest{Name: projectWithSyncWindows.Name, Id: 1})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = window with id '1' not found")
+
+		_, err = projectServer.UnlockSyncWindow(ctx, &project.SyncWindowUnlockRequest{Name: projectWithSyncWindows.Name, Id: 0, Duration: "-1h"})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = duration '-1h' must be positive")
+	})
+
+	t.Run("TestUnlockSyncWindowDenied", func(t *testing.T) {
+		enforcer := newEnforcer(kubeclientset)
+		_ = enforcer.SetBuiltinPolicy(`p, role:admin, projects, update, *, allow`)
+		enforcer.SetClaimsEnforcerFunc(nil)
+		//nolint:staticcheck
+		ctx := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}})
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "deny", Schedule: "* * * * *", Duration: "1h", Applications: []string{"*"}, RequireApproval: true},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		_, err := projectServer.UnlockSyncWindow(ctx, &project.SyncWindowUnlockRequest{Name: projectWithSyncWindows.Name, Id: 0})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: projects, unlock, test")
+	})
 }
 
 func newEnforcer(kubeclientset *fake.Clientset) *rbac.Enforcer {
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyMethodPrefixes are the prefixes of the names of the methods which do not mutate any state
+var readOnlyMethodPrefixes = []string{"Get", "List", "Watch"}
+
+// readOnlyMethods are the names of the methods which do not mutate any state, but do not have a read-only prefix
+var readOnlyMethods = map[string]bool{
+	"CanI":                 true,
+	"DeletePreview":        true,
+	"Inventory":            true,
+	"IntrospectToken":      true,
+	"ManagedResources":     true,
+	"MetricsHistory":       true,
+	"PodLogs":              true,
+	"ResourceTree":         true,
+	"RevisionChartDetails": true,
+	"RevisionComparison":   true,
+	"RevisionMetadata":     true,
+	"ServerSideDiff":       true,
+	"Version":              true,
+}
+
+// readOnlyFullMethods are the full names of the methods which are served by read-only replicas, although they do not
+// have a read-only name, so that users can log in to and out of the read-only replicas
+var readOnlyFullMethods = map[string]bool{
+	"/session.SessionService/Create":      true,
+	"/session.SessionService/Delete":      true,
+	"/session.SessionService/GetUserInfo": true,
+}
+
+// errReadOnly is returned for the methods which mutate state when the API server runs in read-only mode
+var errReadOnly = status.Error(codes.FailedPrecondition, "the API server is a read-only replica, send the request to a read-write replica")
+
+// isReadOnlyMethod returns whether the given full gRPC method name, e.g. /application.ApplicationService/Get, does not
+// mutate any state and can be served by a read-only replica
+func isReadOnlyMethod(fullMethod string) bool {
+	if strings.HasPrefix(fullMethod, "/grpc.health.") || strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return true
+	}
+	if readOnlyFullMethods[fullMethod] {
+		return true
+	}
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	if readOnlyMethods[name] {
+		return true
+	}
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readOnlyUnaryServerInterceptor rejects the unary calls of the methods which mutate state
+func readOnlyUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isReadOnlyMethod(info.FullMethod) {
+			return nil, errReadOnly
+		}
+		return handler(ctx, req)
+	}
+}
+
+// readOnlyStreamServerInterceptor rejects the streaming calls of the methods which mutate state
+func readOnlyStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !isReadOnlyMethod(info.FullMethod) {
+			return errReadOnly
+		}
+		return handler(srv, ss)
+	}
+}
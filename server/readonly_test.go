@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsReadOnlyMethod(t *testing.T) {
+	for _, method := range []string{
+		"/application.ApplicationService/Get",
+		"/application.ApplicationService/List",
+		"/application.ApplicationService/Watch",
+		"/application.ApplicationService/ResourceTree",
+		"/application.ApplicationService/PodLogs",
+		"/cluster.ClusterService/Inventory",
+		"/session.SessionService/Create",
+		"/session.SessionService/Delete",
+		"/session.SessionService/GetUserInfo",
+		"/account.AccountService/IntrospectToken",
+		"/grpc.health.v1.Health/Check",
+	} {
+		assert.True(t, isReadOnlyMethod(method), method)
+	}
+	for _, method := range []string{
+		"/application.ApplicationService/Sync",
+		"/application.ApplicationService/Delete",
+		"/application.ApplicationService/TerminateOperation",
+		"/project.ProjectService/UnlockSyncWindow",
+		"/account.AccountService/RevokeToken",
+		"/account.AccountService/CreateToken",
+	} {
+		assert.False(t, isReadOnlyMethod(method), method)
+	}
+}
+
+func TestReadOnlyUnaryServerInterceptor(t *testing.T) {
+	interceptor := readOnlyUnaryServerInterceptor()
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "ok", nil
+	}
+
+	res, err := interceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Get"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", res)
+
+	_, err = interceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, handler)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -32,6 +32,15 @@ func NewServer(
 	}
 }
 
+// createRBACObject returns the RBAC object of a credential set, which is prefixed with the project of project scoped
+// credential sets like the one of project scoped repositories.
+func createRBACObject(project string, url string) string {
+	if project != "" {
+		return project + "/" + url
+	}
+	return url
+}
+
 // ListRepositoryCredentials returns a list of all configured repository credential sets
 func (s *Server) ListRepositoryCredentials(ctx context.Context, _ *repocredspkg.RepoCredsQuery) (*appsv1.RepoCredsList, error) {
 	urls, err := s.db.ListRepositoryCredentials(ctx)
@@ -55,6 +64,21 @@ func (s *Server) ListRepositoryCredentials(ctx context.Context, _ *repocredspkg.
 			}
 		}
 	}
+	projectCreds, err := s.db.ListProjectRepositoryCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, creds := range projectCreds {
+		if s.enf.Enforce(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionGet, createRBACObject(creds.Project, creds.URL)) {
+			items = append(items, appsv1.RepoCreds{
+				URL:       creds.URL,
+				Username:  creds.Username,
+				Type:      creds.Type,
+				EnableOCI: creds.EnableOCI,
+				Project:   creds.Project,
+			})
+		}
+	}
 	return &appsv1.RepoCredsList{Items: items}, nil
 }
 
@@ -89,7 +113,7 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, q.Creds.URL); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Creds.Project, q.Creds.URL)); err != nil {
 		return nil, err
 	}
 
@@ -102,7 +126,7 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 	_, err := s.db.CreateRepositoryCredentials(ctx, r)
 	if status.Convert(err).Code() == codes.AlreadyExists {
 		// act idempotent if existing spec matches new spec
-		existing, getErr := s.db.GetRepositoryCredentials(ctx, r.URL)
+		existing, getErr := s.db.GetProjectRepositoryCredentials(ctx, r.URL, r.Project)
 		if getErr != nil {
 			return nil, status.Errorf(codes.Internal, "unable to check existing repository credentials details: %v", getErr)
 		}
@@ -116,7 +140,7 @@ func (s *Server) CreateRepositoryCredentials(ctx context.Context, q *repocredspk
 			return nil, status.Error(codes.InvalidArgument, argo.GenerateSpecIsDifferentErrorMessage("repository credentials", existing, r))
 		}
 	}
-	return &appsv1.RepoCreds{URL: r.URL}, err
+	return &appsv1.RepoCreds{URL: r.URL, Project: r.Project}, err
 }
 
 // CreateWriteRepositoryCredentials creates a new credential set in the configuration
@@ -159,11 +183,11 @@ func (s *Server) UpdateRepositoryCredentials(ctx context.Context, q *repocredspk
 	if q.Creds == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "missing payload in request")
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, q.Creds.URL); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionUpdate, createRBACObject(q.Creds.Project, q.Creds.URL)); err != nil {
 		return nil, err
 	}
 	_, err := s.db.UpdateRepositoryCredentials(ctx, q.Creds)
-	return &appsv1.RepoCreds{URL: q.Creds.URL}, err
+	return &appsv1.RepoCreds{URL: q.Creds.URL, Project: q.Creds.Project}, err
 }
 
 // UpdateWriteRepositoryCredentials updates a repository credential set
@@ -180,11 +204,11 @@ func (s *Server) UpdateWriteRepositoryCredentials(ctx context.Context, q *repocr
 
 // DeleteRepositoryCredentials removes a credential set from the configuration
 func (s *Server) DeleteRepositoryCredentials(ctx context.Context, q *repocredspkg.RepoCredsDeleteRequest) (*repocredspkg.RepoCredsResponse, error) {
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionDelete, q.Url); err != nil {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionDelete, createRBACObject(q.Project, q.Url)); err != nil {
 		return nil, err
 	}
 
-	err := s.db.DeleteRepositoryCredentials(ctx, q.Url)
+	err := s.db.DeleteProjectRepositoryCredentials(ctx, q.Url, q.Project)
 	return &repocredspkg.RepoCredsResponse{}, err
 }
 
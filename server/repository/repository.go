@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
 	"reflect"
 	"sort"
@@ -20,6 +21,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	repocache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -36,6 +38,7 @@ type Server struct {
 	repoClientset   apiclient.Clientset
 	enf             *rbac.Enforcer
 	cache           *servercache.Cache
+	repoCache       *repocache.Cache
 	appLister       applisters.ApplicationLister
 	projLister      cache.SharedIndexInformer
 	settings        *settings.SettingsManager
@@ -49,6 +52,7 @@ func NewServer(
 	db db.ArgoDB,
 	enf *rbac.Enforcer,
 	cache *servercache.Cache,
+	repoCache *repocache.Cache,
 	appLister applisters.ApplicationLister,
 	projLister cache.SharedIndexInformer,
 	namespace string,
@@ -60,6 +64,7 @@ func NewServer(
 		repoClientset:   repoClientset,
 		enf:             enf,
 		cache:           cache,
+		repoCache:       repoCache,
 		appLister:       appLister,
 		projLister:      projLister,
 		namespace:       namespace,
@@ -91,6 +96,18 @@ func createRBACObject(project string, repo string) string {
 	return repo
 }
 
+// getRepositoryCredentials returns the credential template inherited by a repository of the given project. The
+// templates scoped to the project take precedence over the global ones.
+func (s *Server) getRepositoryCredentials(ctx context.Context, url string, project string) (*v1alpha1.RepoCreds, error) {
+	if project != "" {
+		creds, err := s.db.GetProjectRepositoryCredentials(ctx, url, project)
+		if err != nil || creds != nil {
+			return creds, err
+		}
+	}
+	return s.db.GetRepositoryCredentials(ctx, url)
+}
+
 // Get the connection state for a given repository URL by connecting to the
 // repo and evaluate the results. Unless forceRefresh is set to true, the
 // result may be retrieved out of the cache.
@@ -126,6 +143,27 @@ func (s *Server) getConnectionState(ctx context.Context, url string, project str
 	return connectionState
 }
 
+// getRepositoryStatus returns the result of the latest background health check of the given repository by the repo
+// server, if any.
+func (s *Server) getRepositoryStatus(ctx context.Context, repo *v1alpha1.Repository) *v1alpha1.RepositoryStatus {
+	if s.repoCache == nil {
+		return nil
+	}
+	repoStatus, err := s.repoCache.GetRepositoryStatus(repo.Repo, repo.Project)
+	if err != nil {
+		if !stderrors.Is(err, repocache.ErrCacheMiss) {
+			log.Warnf("getRepositoryStatus cache get error %s: %v", repo.Repo, err)
+		}
+		return nil
+	}
+	if repo.InheritedCreds {
+		if creds, err := s.getRepositoryCredentials(ctx, repo.Repo, repo.Project); err == nil && creds != nil {
+			repoStatus.CredentialsURL = creds.URL
+		}
+	}
+	return repoStatus
+}
+
 // List returns list of repositories
 //
 // Deprecated: Use ListRepositories instead
@@ -212,7 +250,8 @@ func (s *Server) ListWriteRepositories(ctx context.Context, q *repositorypkg.Rep
 	return &v1alpha1.RepositoryList{Items: items}, nil
 }
 
-// prepareRepoList returns a list of all configured repositories and the state of their connections. It
+// prepareRepoList returns a list of all configured repositories, the state of their connections and the results of
+// their latest health checks. It
 // normalizes, sanitizes, and filters out repositories that the user does not have access to in the specified project.
 // It also sorts the repositories by project and repo name.
 func (s *Server) prepareRepoList(ctx context.Context, resourceType string, repos []*v1alpha1.Repository, forceRefresh bool) (v1alpha1.Repositories, error) {
@@ -225,6 +264,10 @@ func (s *Server) prepareRepoList(ctx context.Context, resourceType string, repos
 	})
 	err := kube.RunAllAsync(len(items), func(i int) error {
 		items[i].ConnectionState = s.getConnectionState(ctx, items[i].Repo, items[i].Project, forceRefresh)
+		// the repo server only checks the repositories it reads from
+		if resourceType == rbac.ResourceRepositories {
+			items[i].Status = s.getRepositoryStatus(ctx, items[i])
+		}
 		return nil
 	})
 	if err != nil {
@@ -685,6 +728,38 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		return nil, err
 	}
 
+	repo, err := s.getAccessQueryRepository(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	err = s.testRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	return &repositorypkg.RepoResponse{}, nil
+}
+
+// Validate checks the access to a repository with the given URL and credentials in stages, and returns the result of
+// each stage.
+func (s *Server) Validate(ctx context.Context, q *repositorypkg.RepoAccessQuery) (*apiclient.ValidateRepositoryResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceRepositories, rbac.ActionCreate, createRBACObject(q.Project, q.Repo)); err != nil {
+		return nil, err
+	}
+
+	repo, err := s.getAccessQueryRepository(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	conn, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to repo-server: %w", err)
+	}
+	defer utilio.Close(conn)
+	return repoClient.ValidateRepository(ctx, &apiclient.TestRepositoryRequest{Repo: repo})
+}
+
+// getAccessQueryRepository returns the repository described by the given query
+func (s *Server) getAccessQueryRepository(ctx context.Context, q *repositorypkg.RepoAccessQuery) (*v1alpha1.Repository, error) {
 	repo := &v1alpha1.Repository{
 		Repo:                              q.Repo,
 		Type:                              q.Type,
@@ -709,12 +784,13 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 		AzureServicePrincipalClientSecret: q.AzureServicePrincipalClientSecret,
 		AzureServicePrincipalTenantId:     q.AzureServicePrincipalTenantId,
 		AzureActiveDirectoryEndpoint:      q.AzureActiveDirectoryEndpoint,
+		EnableLFS:                         q.EnableLfs,
 	}
 
 	// If repo does not have credentials, check if there are credentials stored
 	// for it and if yes, copy them
 	if !repo.HasCredentials() {
-		repoCreds, err := s.db.GetRepositoryCredentials(ctx, q.Repo)
+		repoCreds, err := s.getRepositoryCredentials(ctx, q.Repo, q.Project)
 		if err != nil {
 			return nil, err
 		}
@@ -722,11 +798,7 @@ func (s *Server) ValidateAccess(ctx context.Context, q *repositorypkg.RepoAccess
 			repo.CopyCredentialsFrom(repoCreds)
 		}
 	}
-	err := s.testRepo(ctx, repo)
-	if err != nil {
-		return nil, err
-	}
-	return &repositorypkg.RepoResponse{}, nil
+	return repo, nil
 }
 
 // ValidateWriteAccess checks whether write access to a repository is possible with the
@@ -265,7 +265,7 @@ func TestRepositoryServer(t *testing.T) {
 		repoServerClient := mocks.RepoServerServiceClient{}
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: &repoServerClient}
 
-		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		url := "https://test"
 		repo, _ := s.getRepo(t.Context(), url, "")
 		assert.Equal(t, repo.Repo, url)
@@ -276,7 +276,7 @@ func TestRepositoryServer(t *testing.T) {
 		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
 
-		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		url := "https://test"
 		_, err := s.ValidateAccess(t.Context(), &repository.RepoAccessQuery{
 			Repo: url,
@@ -284,12 +284,29 @@ func TestRepositoryServer(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Test_validate", func(t *testing.T) {
+		stages := []*apiclient.RepositoryValidationStage{{Name: "dns", Status: "Successful"}}
+		repoServerClient := &mocks.RepoServerServiceClient{}
+		repoServerClient.EXPECT().ValidateRepository(mock.Anything, mock.MatchedBy(func(q *apiclient.TestRepositoryRequest) bool {
+			return q.Repo.Repo == "https://test" && q.Repo.EnableLFS
+		})).Return(&apiclient.ValidateRepositoryResponse{Stages: stages}, nil)
+		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
+
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, nil, appLister, projInformer, testNamespace, settingsMgr, false)
+		resp, err := s.Validate(t.Context(), &repository.RepoAccessQuery{
+			Repo:      "https://test",
+			EnableLfs: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, stages, resp.Stages)
+	})
+
 	t.Run("Test_validateWriteAccess", func(t *testing.T) {
 		repoServerClient := &mocks.RepoServerServiceClient{}
 		repoServerClient.EXPECT().TestRepository(mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
 		repoServerClientset := mocks.Clientset{RepoServerServiceClient: repoServerClient}
 
-		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, appLister, projInformer, testNamespace, settingsMgr, true)
+		s := NewServer(&repoServerClientset, argoDB, enforcer, nil, nil, appLister, projInformer, testNamespace, settingsMgr, true)
 		url := "https://test"
 		_, err := s.ValidateWriteAccess(t.Context(), &repository.RepoAccessQuery{
 			Repo: url,
@@ -308,7 +325,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(&appsv1.Repository{Repo: url}, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -333,7 +350,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(testRepo, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -354,7 +371,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(nil, errors.New("some error"))
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -373,7 +390,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(&appsv1.Repository{Repo: url}, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(false, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -392,7 +409,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(&appsv1.Repository{Repo: url, Username: "test", Password: "it's a secret"}, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -415,7 +432,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(&appsv1.Repository{Repo: url, Username: "test"}, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -437,7 +454,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "").Return(&appsv1.Repository{Repo: url}, nil)
 		db.EXPECT().RepositoryExists(mock.Anything, url, "").Return(true, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.Get(t.Context(), &repository.RepoQuery{
 			Repo: url,
 		})
@@ -458,7 +475,7 @@ func TestRepositoryServer(t *testing.T) {
 			Project: "proj",
 		}, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
 			Repo: &appsv1.Repository{
 				Repo:     "test",
@@ -487,7 +504,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().CreateRepository(mock.Anything, mock.Anything).Return(nil, status.Errorf(codes.AlreadyExists, "repository already exists"))
 		db.EXPECT().UpdateRepository(mock.Anything, mock.Anything).Return(r, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		repo, err := s.CreateRepository(t.Context(), &repository.RepoCreateRequest{
 			Repo:   r,
 			Upsert: true,
@@ -510,7 +527,7 @@ func TestRepositoryServer(t *testing.T) {
 		db.EXPECT().ListHelmRepositories(mock.Anything).Return(nil, nil)
 		db.EXPECT().ListRepositories(mock.Anything).Return([]*appsv1.Repository{&fakeRepo, &fakeRepo}, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projInformer, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projInformer, testNamespace, settingsMgr, false)
 		resp, err := s.ListRepositories(t.Context(), &repository.RepoQuery{})
 		require.NoError(t, err)
 		assert.Len(t, resp.Items, 2)
@@ -532,7 +549,7 @@ func TestRepositoryServerListApps(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "default").Return(&appsv1.Repository{Repo: url}, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.ListApps(t.Context(), &repository.RepoAppsQuery{
 			Repo:       "https://test",
 			Revision:   "HEAD",
@@ -561,7 +578,7 @@ func TestRepositoryServerListApps(t *testing.T) {
 			},
 		}, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.ListApps(t.Context(), &repository.RepoAppsQuery{
 			Repo:       "https://test",
 			Revision:   "HEAD",
@@ -592,7 +609,7 @@ func TestRepositoryServerListApps(t *testing.T) {
 			},
 		}, nil)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.ListApps(t.Context(), &repository.RepoAppsQuery{
 			Repo:       "https://test",
 			Revision:   "HEAD",
@@ -619,7 +636,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "default").Return(&appsv1.Repository{Repo: url}, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source: &appsv1.ApplicationSource{
 				RepoURL: url,
@@ -642,7 +659,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "default").Return(&appsv1.Repository{Repo: url}, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source: &appsv1.ApplicationSource{
 				RepoURL: url,
@@ -664,7 +681,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "default").Return(&appsv1.Repository{Repo: url}, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source: &appsv1.ApplicationSource{
 				RepoURL: url,
@@ -690,7 +707,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		repoServerClient.EXPECT().GetAppDetails(mock.Anything, mock.Anything).Return(&expectedResp, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source: &appsv1.ApplicationSource{
 				RepoURL: url,
@@ -715,7 +732,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		repoServerClient.EXPECT().GetAppDetails(mock.Anything, mock.Anything).Return(&expectedResp, nil)
 		appLister, projLister := newAppAndProjLister(defaultProjNoSources)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source: &appsv1.ApplicationSource{
 				RepoURL: url,
@@ -741,7 +758,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		repoServerClient.EXPECT().GetAppDetails(mock.Anything, mock.Anything).Return(&expectedResp, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj, guestbookApp)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:     guestbookApp.Spec.GetSourcePtrByIndex(0),
 			AppName:    "guestbook",
@@ -766,7 +783,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		repoServerClient.EXPECT().GetAppDetails(mock.Anything, mock.Anything).Return(&expectedResp, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj, multiSourceApp001)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		sources := multiSourceApp001.Spec.GetSources()
 		assert.Len(t, sources, 2)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
@@ -807,7 +824,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		repoServerClient.EXPECT().GetAppDetails(mock.Anything, mock.MatchedBy(func(req *apiclient.RepoServerAppDetailsQuery) bool { return req.Source.RepoURL == url1 })).Return(&expectedResp1, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj, multiSourceApp002)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		sources := multiSourceApp002.Spec.GetSources()
 		assert.Len(t, sources, 2)
 
@@ -839,7 +856,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		db.EXPECT().GetRepository(mock.Anything, url, "mismatch").Return(&appsv1.Repository{Repo: url}, nil)
 		appLister, projLister := newAppAndProjLister(defaultProj, guestbookApp)
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:     guestbookApp.Spec.GetSourcePtrByIndex(0),
 			AppName:    "guestbook",
@@ -860,7 +877,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		differentSource := guestbookApp.Spec.Source.DeepCopy()
 		differentSource.Helm.ValueFiles = []string{"/etc/passwd"}
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:     differentSource,
 			AppName:    "guestbook",
@@ -886,7 +903,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		previousSource := guestbookApp.Status.History[0].Source.DeepCopy()
 		previousSource.TargetRevision = guestbookApp.Status.History[0].Revision
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:     previousSource,
 			AppName:    "guestbook",
@@ -915,7 +932,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		differentSource := multiSourceApp001.Spec.Sources[0].DeepCopy()
 		differentSource.Helm.ValueFiles = []string{"/etc/passwd"}
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:      differentSource,
 			AppName:     multiSourceApp001AppName,
@@ -943,7 +960,7 @@ func TestRepositoryServerGetAppDetails(t *testing.T) {
 		previousSource := multiSourceApp001.Status.History[0].Sources[0].DeepCopy()
 		previousSource.TargetRevision = multiSourceApp001.Status.History[0].Revisions[0]
 
-		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+		s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 		resp, err := s.GetAppDetails(t.Context(), &repository.RepoAppDetailsQuery{
 			Source:      previousSource,
 			AppName:     multiSourceApp001AppName,
@@ -1161,7 +1178,7 @@ func TestDeleteRepository(t *testing.T) {
 			db.EXPECT().GetRepository(mock.Anything, repo, "default").Return(&appsv1.Repository{Repo: repo, Project: "default"}, nil)
 			appLister, projLister := newAppAndProjLister(defaultProj)
 
-			s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, appLister, projLister, testNamespace, settingsMgr, false)
+			s := NewServer(&repoServerClientset, db, enforcer, newFixtures().Cache, nil, appLister, projLister, testNamespace, settingsMgr, false)
 			resp, err := s.DeleteRepository(t.Context(), &repository.RepoQuery{Repo: repo, AppProject: "default"})
 			require.NoError(t, err)
 			assert.Equal(t, repository.RepoResponse{}, *resp)
@@ -3,6 +3,7 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto/subtle"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -57,6 +58,7 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
@@ -92,12 +94,14 @@ import (
 	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	repocache "github.com/argoproj/argo-cd/v3/reposerver/cache"
 	"github.com/argoproj/argo-cd/v3/server/account"
+	"github.com/argoproj/argo-cd/v3/server/admission"
 	"github.com/argoproj/argo-cd/v3/server/application"
 	"github.com/argoproj/argo-cd/v3/server/applicationset"
 	"github.com/argoproj/argo-cd/v3/server/badge"
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/certificate"
 	"github.com/argoproj/argo-cd/v3/server/cluster"
+	"github.com/argoproj/argo-cd/v3/server/clusterregistration"
 	"github.com/argoproj/argo-cd/v3/server/extension"
 	"github.com/argoproj/argo-cd/v3/server/gpgkey"
 	"github.com/argoproj/argo-cd/v3/server/logout"
@@ -128,6 +132,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	settings_notif "github.com/argoproj/argo-cd/v3/util/notification/settings"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
+	"github.com/argoproj/argo-cd/v3/util/rand"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/security"
 	util_session "github.com/argoproj/argo-cd/v3/util/session"
@@ -195,6 +200,7 @@ type ArgoCDServer struct {
 	settingsMgr     *settings_util.SettingsManager
 	enf             *rbac.Enforcer
 	projInformer    cache.SharedIndexInformer
+	projLister      applisters.AppProjectNamespaceLister
 	policyEnforcer  *rbacpolicy.RBACPolicyEnforcer
 	clusterInformer *settings_util.ClusterInformer
 	appInformer     cache.SharedIndexInformer
@@ -204,17 +210,21 @@ type ArgoCDServer struct {
 	db              db.ArgoDB
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
-	stopCh             chan os.Signal
-	userStateStorage   util_session.UserStateStorage
-	indexDataInit      gosync.Once
-	indexData          []byte
-	indexDataErr       error
-	staticAssets       http.FileSystem
-	apiFactory         api.Factory
-	secretInformer     cache.SharedIndexInformer
-	configMapInformer  cache.SharedIndexInformer
-	serviceSet         *ArgoCDServiceSet
-	extensionManager   *extension.Manager
+	stopCh            chan os.Signal
+	userStateStorage  util_session.UserStateStorage
+	indexDataInit     gosync.Once
+	indexData         []byte
+	indexDataErr      error
+	staticAssets      http.FileSystem
+	apiFactory        api.Factory
+	secretInformer    cache.SharedIndexInformer
+	configMapInformer cache.SharedIndexInformer
+	serviceSet        *ArgoCDServiceSet
+	extensionManager  *extension.Manager
+	// gatewayToken identifies requests which were translated by the grpc-gateway of this server
+	gatewayToken string
+	// trustedProxies are the networks of the proxies whose x-forwarded-for entries are trusted
+	trustedProxies     []*net.IPNet
 	Shutdown           func()
 	terminateRequested atomic.Bool
 	available          atomic.Bool
@@ -253,6 +263,20 @@ type ArgoCDServerOpts struct {
 	EnableK8sEvent          []string
 	HydratorEnabled         bool
 	SyncWithReplaceAllowed  bool
+	// ReadOnly makes the API server a read-only replica, which only serves the API methods which do not mutate any state
+	ReadOnly bool
+	// EnableAdmissionWebhook serves the validating admission webhook for applications and projects
+	EnableAdmissionWebhook bool
+	// AdmissionWebhookPort is the port the admission and conversion webhooks are served on. They are not served on the
+	// port of the API, so that they are only reachable by the Kubernetes API server and not through the ingress of the
+	// API.
+	AdmissionWebhookPort int
+	// TrustedProxyCIDRs are the networks of the reverse proxies in front of the API server. The x-forwarded-for
+	// entries added by these proxies are used to determine the address of the client.
+	TrustedProxyCIDRs []string
+	// CoreClaims are the claims of every request when authentication is disabled. In core mode, they identify the
+	// Kubernetes user of the CLI which started the API server.
+	CoreClaims jwt.Claims
 }
 
 type ApplicationSetOpts struct {
@@ -313,8 +337,10 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	settingsMgr := settings_util.NewSettingsManager(ctx, opts.KubeClientset, opts.Namespace)
 	settings, err := settingsMgr.InitializeSettings(opts.Insecure)
 	errorsutil.CheckError(err)
-	err = initializeDefaultProject(opts)
-	errorsutil.CheckError(err)
+	if !opts.ReadOnly {
+		err = initializeDefaultProject(opts)
+		errorsutil.CheckError(err)
+	}
 
 	clusterInformer, err := settings_util.NewClusterInformer(opts.KubeClientset, opts.Namespace)
 	errorsutil.CheckError(err)
@@ -347,6 +373,9 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 	}
 
 	userStateStorage := util_session.NewUserStateStorage(opts.RedisClient)
+	if opts.RedisClient == nil {
+		userStateStorage = util_session.NewKubeUserStateStorage(opts.KubeClientset, opts.Namespace)
+	}
 	sessionMgr := util_session.NewSessionManager(settingsMgr, projLister, opts.DexServerAddr, opts.DexTLSConfig, userStateStorage)
 	enf := rbac.NewEnforcer(opts.KubeClientset, opts.Namespace, common.ArgoCDRBACConfigMapName, nil)
 	enf.EnableEnforce(!opts.DisableAuth)
@@ -391,6 +420,15 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		log.Error("API Server Shutdown function called but server is not started yet.")
 	}
 
+	trustedProxies := make([]*net.IPNet, 0, len(opts.TrustedProxyCIDRs))
+	for _, cidr := range opts.TrustedProxyCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		errorsutil.CheckError(err)
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+	gatewayToken, err := rand.String(32)
+	errorsutil.CheckError(err)
+
 	a := &ArgoCDServer{
 		ArgoCDServerOpts:   opts,
 		ApplicationSetOpts: appsetOpts,
@@ -400,6 +438,7 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		settingsMgr:        settingsMgr,
 		enf:                enf,
 		projInformer:       projInformer,
+		projLister:         projLister,
 		appInformer:        appInformer,
 		appLister:          appLister,
 		appsetInformer:     appsetInformer,
@@ -413,6 +452,8 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts, appsetOpts Applicatio
 		secretInformer:     secretInformer,
 		configMapInformer:  configMapInformer,
 		extensionManager:   em,
+		gatewayToken:       gatewayToken,
+		trustedProxies:     trustedProxies,
 		Shutdown:           noopShutdown,
 		stopCh:             make(chan os.Signal, 1),
 	}
@@ -449,8 +490,10 @@ func (server *ArgoCDServer) healthCheck(r *http.Request) error {
 }
 
 type Listeners struct {
-	Main        net.Listener
-	Metrics     net.Listener
+	Main    net.Listener
+	Metrics net.Listener
+	// Admission is the listener of the admission and conversion webhooks, if they are enabled
+	Admission   net.Listener
 	GatewayConn *grpc.ClientConn
 }
 
@@ -467,6 +510,12 @@ func (l *Listeners) Close() error {
 		}
 		l.Metrics = nil
 	}
+	if l.Admission != nil {
+		if err := l.Admission.Close(); err != nil {
+			return err
+		}
+		l.Admission = nil
+	}
 	if l.GatewayConn != nil {
 		if err := l.GatewayConn.Close(); err != nil {
 			return err
@@ -531,6 +580,15 @@ func (server *ArgoCDServer) Listen() (*Listeners, error) {
 		utilio.Close(mainLn)
 		return nil, err
 	}
+	var admissionLn net.Listener
+	if server.EnableAdmissionWebhook {
+		admissionLn, err = startListener(server.ListenHost, server.AdmissionWebhookPort)
+		if err != nil {
+			utilio.Close(mainLn)
+			utilio.Close(metricsLn)
+			return nil, err
+		}
+	}
 	var dOpts []grpc.DialOption
 	dOpts = append(dOpts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(apiclient.MaxGRPCMessageSize)))
 	dOpts = append(dOpts, grpc.WithUserAgent(fmt.Sprintf("%s/%s", common.ArgoCDUserAgentName, common.GetVersion().Version)))
@@ -555,9 +613,12 @@ func (server *ArgoCDServer) Listen() (*Listeners, error) {
 	if err != nil {
 		utilio.Close(mainLn)
 		utilio.Close(metricsLn)
+		if admissionLn != nil {
+			utilio.Close(admissionLn)
+		}
 		return nil, err
 	}
-	return &Listeners{Main: mainLn, Metrics: metricsLn, GatewayConn: conn}, nil
+	return &Listeners{Main: mainLn, Metrics: metricsLn, Admission: admissionLn, GatewayConn: conn}, nil
 }
 
 // Init starts informers used by the API server
@@ -587,7 +648,11 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 		cacheutil.CollectMetrics(server.RedisClient, metricsServ, server.userStateStorage.GetLockObject())
 	}
 	// OIDC config needs to be refreshed at each server restart
-	ssoClientApp, err := oidc.NewClientApp(server.settings, server.DexServerAddr, server.DexTLSConfig, server.BaseHRef, cacheutil.NewRedisCache(server.RedisClient, server.settings.UserInfoCacheExpiration(), cacheutil.RedisCompressionNone))
+	var userInfoCache cacheutil.CacheClient = cacheutil.NewInMemoryCache(server.settings.UserInfoCacheExpiration())
+	if server.RedisClient != nil {
+		userInfoCache = cacheutil.NewRedisCache(server.RedisClient, server.settings.UserInfoCacheExpiration(), cacheutil.RedisCompressionNone)
+	}
+	ssoClientApp, err := oidc.NewClientApp(server.settings, server.DexServerAddr, server.DexTLSConfig, server.BaseHRef, userInfoCache)
 	errorsutil.CheckError(err)
 	server.ssoClientApp = ssoClientApp
 
@@ -595,7 +660,7 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	// reads those hooks. If this is called first, there may be a data race.
 	server.userStateStorage.Init(ctx)
 
-	svcSet := newArgoCDServiceSet(server)
+	svcSet := newArgoCDServiceSet(server, metricsServ)
 	if server.sessionMgr != nil {
 		server.sessionMgr.CollectMetrics(metricsServ)
 	}
@@ -673,6 +738,16 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	go server.rbacPolicyLoader(ctx)
 	go func() { server.checkServeErr("tcpm", tcpm.Serve()) }()
 	go func() { server.checkServeErr("metrics", metricsServ.Serve(listeners.Metrics)) }()
+	var admissionS *http.Server
+	if listeners.Admission != nil {
+		admissionS = server.newAdmissionServer()
+		admissionL := listeners.Admission
+		if server.useTLS() {
+			admissionL = tls.NewListener(admissionL, admissionS.TLSConfig)
+		}
+		log.Infof("argocd %s serving the admission webhooks on port %d", common.GetVersion(), server.AdmissionWebhookPort)
+		go func() { server.checkServeErr("admissionS", admissionS.Serve(admissionL)) }()
+	}
 	if !cache.WaitForCacheSync(ctx.Done(), server.projInformer.HasSynced, server.appInformer.HasSynced, server.clusterInformer.HasSynced) {
 		log.Fatal("Timed out waiting for project cache to sync")
 	}
@@ -715,6 +790,16 @@ func (server *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 			}
 		})
 
+		if admissionS != nil {
+			// Shutdown admission webhook server
+			wg.Go(func() {
+				err := admissionS.Shutdown(shutdownCtx)
+				if err != nil {
+					log.Errorf("Error shutting down admission webhook server: %s", err)
+				}
+			})
+		}
+
 		if server.useTLS() {
 			// Shutdown tls server
 			wg.Go(func() {
@@ -911,6 +996,29 @@ func (server *ArgoCDServer) useTLS() bool {
 	return true
 }
 
+// newAdmissionServer returns the server of the validating admission webhook for applications and projects, and of the
+// conversion webhook for applications, which are called by the Kubernetes API server. They are served on their own
+// port, which is not exposed with the API.
+func (server *ArgoCDServer) newAdmissionServer() *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(admission.Path, admission.NewHandler(server.Namespace, server.ApplicationNamespaces, applisters.NewAppProjectLister(server.projInformer.GetIndexer()), server.settingsMgr, server.db))
+	mux.HandleFunc(admission.ConversionPath, admission.ServeConversion)
+	tlsConfig := &tls.Config{
+		GetCertificate: func(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return server.settings.Certificate, nil
+		},
+	}
+	if server.TLSConfigCustomizer != nil {
+		server.TLSConfigCustomizer(tlsConfig)
+	}
+	return &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", server.ListenHost, server.AdmissionWebhookPort),
+		Handler:           mux,
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
+
 func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registry) (*grpc.Server, application.AppResourceTreeFn) {
 	var serverMetricsOptions []grpc_prometheus.ServerMetricsOption
 	if enableGRPCTimeHistogram {
@@ -937,6 +1045,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		"/cluster.ClusterService/Update":                               true,
 		"/session.SessionService/Create":                               true,
 		"/account.AccountService/UpdatePassword":                       true,
+		"/account.AccountService/IntrospectToken":                      true,
 		"/gpgkey.GPGKeyService/CreateGnuPGPublicKey":                   true,
 		"/repository.RepositoryService/Create":                         true,
 		"/repository.RepositoryService/Update":                         true,
@@ -956,9 +1065,22 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
-	sOpts = append(sOpts, grpc.ChainStreamInterceptor(
-		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(server.log)),
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		grpc_util.CorrelationIDStreamServerInterceptor(),
+		logging.StreamServerInterceptor(grpc_util.InterceptorLogger(server.log), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
 		serverMetrics.StreamServerInterceptor(),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		bug21955WorkaroundInterceptor,
+		grpc_util.CorrelationIDUnaryServerInterceptor(),
+		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(server.log), logging.WithFieldsFromContext(grpc_util.CorrelationIDLoggingFields)),
+		serverMetrics.UnaryServerInterceptor(),
+	}
+	if server.ReadOnly {
+		streamInterceptors = append(streamInterceptors, readOnlyStreamServerInterceptor())
+		unaryInterceptors = append(unaryInterceptors, readOnlyUnaryServerInterceptor())
+	}
+	sOpts = append(sOpts, grpc.ChainStreamInterceptor(append(streamInterceptors,
 		grpc_auth.StreamServerInterceptor(server.Authenticate),
 		grpc_util.UserAgentStreamServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadStreamServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
@@ -967,11 +1089,8 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		grpc_util.ErrorCodeK8sStreamServerInterceptor(),
 		grpc_util.ErrorCodeGitStreamServerInterceptor(),
 		recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(server.log))),
-	))
-	sOpts = append(sOpts, grpc.ChainUnaryInterceptor(
-		bug21955WorkaroundInterceptor,
-		logging.UnaryServerInterceptor(grpc_util.InterceptorLogger(server.log)),
-		serverMetrics.UnaryServerInterceptor(),
+	)...))
+	sOpts = append(sOpts, grpc.ChainUnaryInterceptor(append(unaryInterceptors,
 		grpc_auth.UnaryServerInterceptor(server.Authenticate),
 		grpc_util.UserAgentUnaryServerInterceptor(common.ArgoCDUserAgentName, clientConstraint),
 		grpc_util.PayloadUnaryServerInterceptor(server.log, true, func(_ context.Context, c interceptors.CallMeta) bool {
@@ -980,7 +1099,7 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 		grpc_util.ErrorCodeK8sUnaryServerInterceptor(),
 		grpc_util.ErrorCodeGitUnaryServerInterceptor(),
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpc_util.LoggerRecoveryHandler(server.log))),
-	))
+	)...))
 	sOpts = append(sOpts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	grpcS := grpc.NewServer(sOpts...)
 
@@ -1024,10 +1143,10 @@ type ArgoCDServiceSet struct {
 	VersionService        *version.Server
 }
 
-func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
+func newArgoCDServiceSet(a *ArgoCDServer, metricsServ *metrics.MetricsServer) *ArgoCDServiceSet {
 	kubectl := kubeutil.NewKubectl()
-	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl)
-	repoService := repository.NewServer(a.RepoClientset, a.db, a.enf, a.Cache, a.appLister, a.projInformer, a.Namespace, a.settingsMgr, a.HydratorEnabled)
+	clusterService := cluster.NewServer(a.db, a.enf, a.Cache, kubectl, a.AppClientset, a.appLister, applisters.NewAppProjectLister(a.projInformer.GetIndexer()), a.settingsMgr, a.Namespace)
+	repoService := repository.NewServer(a.RepoClientset, a.db, a.enf, a.Cache, a.RepoServerCache, a.appLister, a.projInformer, a.Namespace, a.settingsMgr, a.HydratorEnabled)
 	repoCredsService := repocreds.NewServer(a.db, a.enf)
 	var loginRateLimiter func() (utilio.Closer, error)
 	if maxConcurrentLoginRequestsCount > 0 {
@@ -1053,6 +1172,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ApplicationNamespaces,
 		a.EnableK8sEvent,
 		a.SyncWithReplaceAllowed,
+		a.ReadOnly,
+		metricsServ,
 	)
 
 	applicationSetService := applicationset.NewServer(
@@ -1193,7 +1314,10 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	// we use our own Marshaler
 	gwMuxOpts := runtime.WithMarshalerOption(runtime.MIMEWildcard, new(grpc_util.JSONMarshaler))
 	gwCookieOpts := runtime.WithForwardResponseOption(server.translateGrpcCookieHeader)
-	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts)
+	gwTokenOpts := runtime.WithMetadata(func(_ context.Context, _ *http.Request) metadata.MD {
+		return metadata.Pairs(gatewayTokenMetadataKey, server.gatewayToken)
+	})
+	gwmux := runtime.NewServeMux(gwMuxOpts, gwCookieOpts, gwTokenOpts)
 
 	var handler http.Handler = gwmux
 	if server.EnableGZip {
@@ -1219,14 +1343,25 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 
 	terminalOpts := application.TerminalOptions{DisableAuth: server.DisableAuth, Enf: server.enf}
 
-	terminal := application.NewHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settings.ExecShells, server.sessionMgr, &terminalOpts).
-		WithFeatureFlagMiddleware(server.settingsMgr.GetSettings)
-	th := util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, terminal)
-	mux.Handle("/terminal", th)
+	// The terminal executes commands in the pods of the applications, and is therefore not served by read-only replicas
+	if !server.ReadOnly {
+		terminal := application.NewHandler(server.appLister, server.projLister, server.Namespace, server.ApplicationNamespaces, server.db, appResourceTreeFn, server.settings.ExecShells, server.sessionMgr, &terminalOpts).
+			WithFeatureFlagMiddleware(server.settingsMgr.GetSettings, application.NewRecordingStoreFunc(server.settingsMgr))
+		th := util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, server.trustedProxies, terminal)
+		mux.Handle("/terminal", th)
+	}
+
+	recordings := application.NewRecordingHandler(server.appLister, server.Namespace, server.ApplicationNamespaces, application.NewRecordingStoreFunc(server.settingsMgr), &terminalOpts)
+	rh := util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, server.trustedProxies, recordings)
+	mux.Handle("/terminal/recordings", rh)
+
+	// The log level overrides only apply to this replica of the API server
+	mux.Handle(LogLevelsPath, util_session.WithAuthMiddleware(server.DisableAuth, server.settings.IsSSOConfigured(), server.ssoClientApp, server.sessionMgr, server.trustedProxies, newLogLevelsHandler(server.enf)))
 
 	// Proxy extension is currently an alpha feature and is disabled
-	// by default.
-	if server.EnableProxyExtension {
+	// by default. Read-only replicas do not serve it, since the
+	// extension backends may mutate state.
+	if server.EnableProxyExtension && !server.ReadOnly {
 		// API server won't panic if extensions fail to register. In
 		// this case an error log will be sent and no extension route
 		// will be added in mux.
@@ -1254,11 +1389,18 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	// Dex reverse proxy and OAuth2 login/callback
 	server.registerDexHandlers(mux)
 
-	// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
-	argoDB := db.NewDB(server.Namespace, server.settingsMgr, server.KubeClientset)
-	acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.WebhookRefreshWorkers, server.AppClientset, server.appLister, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize(), server.settingsMgr.GetWebhookRefreshJitter(), server.settingsMgr.GetWebhookRefreshJitterThreshold())
+	// The webhook and cluster registration handlers refresh applications and register clusters, and are therefore not
+	// served by read-only replicas
+	if !server.ReadOnly {
+		// Webhook handler for git events (Note: cache timeouts are hardcoded because API server does not write to cache and not really using them)
+		argoDB := db.NewDB(server.Namespace, server.settingsMgr, server.KubeClientset)
+		acdWebhookHandler := webhook.NewHandler(server.Namespace, server.ApplicationNamespaces, server.WebhookParallelism, server.WebhookRefreshWorkers, server.AppClientset, server.appLister, server.settings, server.settingsMgr, server.RepoServerCache, server.Cache, argoDB, server.settingsMgr.GetMaxWebhookPayloadSize(), server.settingsMgr.GetWebhookRefreshJitter(), server.settingsMgr.GetWebhookRefreshJitterThreshold())
+
+		mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
 
-	mux.HandleFunc("/api/webhook", acdWebhookHandler.Handler)
+		// Registration endpoints for cluster agents, which authenticate using the bootstrap token or their own key
+		mux.Handle(clusterregistration.URLPrefix+"/", clusterregistration.NewHandler(server.Namespace, server.KubeClientset, argoDB, server.settingsMgr))
+	}
 
 	// Serve cli binaries directly from API server
 	registerDownloadHandlers(mux, "/download")
@@ -1303,10 +1445,15 @@ func enforceContentTypes(handler http.Handler, types []string) http.Handler {
 func registerExtensions(mux *http.ServeMux, a *ArgoCDServer, metricsReg HTTPMetricsRegistry) {
 	a.log.Info("Registering extensions...")
 	extHandler := http.HandlerFunc(a.extensionManager.CallExtension())
-	authMiddleware := a.sessionMgr.AuthMiddlewareFunc(a.DisableAuth, a.settings.IsSSOConfigured(), a.ssoClientApp)
+	authMiddleware := a.sessionMgr.AuthMiddlewareFunc(a.DisableAuth, a.settings.IsSSOConfigured(), a.ssoClientApp, a.trustedProxies)
 	// auth middleware ensures that requests to all extensions are authenticated first
 	mux.Handle(extension.URLPrefix+"/", otelhttp.NewHandler(authMiddleware(extHandler), "server.ArgoCDServer/extensions"))
 
+	// the extension registry API reports configured extensions and their health
+	registryHandler := otelhttp.NewHandler(authMiddleware(http.HandlerFunc(a.extensionManager.RegistryHandler())), "server.ArgoCDServer/extensionsRegistry")
+	mux.Handle(extension.RegistryURLPrefix, registryHandler)
+	mux.Handle(extension.RegistryURLPrefix+"/", registryHandler)
+
 	a.extensionManager.AddMetricsRegistry(metricsReg)
 
 	err := a.extensionManager.RegisterExtensions()
@@ -1539,6 +1686,10 @@ func (server *ArgoCDServer) Authenticate(ctx context.Context) (context.Context,
 	ctx, span = tracer.Start(ctx, "server.ArgoCDServer.Authenticate")
 	defer span.End()
 	if server.DisableAuth {
+		if server.CoreClaims != nil {
+			//nolint:staticcheck
+			ctx = context.WithValue(ctx, "claims", server.CoreClaims)
+		}
 		return ctx, nil
 	}
 	claims, newToken, claimsErr := server.getClaims(ctx)
@@ -1590,6 +1741,7 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 		span.SetStatus(otel_codes.Error, ErrNoSession.Error())
 		return nil, "", ErrNoSession
 	}
+	ctx = util_session.ContextWithClientAddress(ctx, getClientAddress(ctx, md, server.gatewayToken, server.trustedProxies))
 	// A valid argocd-issued token is automatically refreshed here prior to expiration.
 	// OIDC tokens will be verified and reactively refreshed here if the ID token has expired.
 	claims, newToken, err := server.sessionMgr.VerifyToken(ctx, tokenString)
@@ -1617,6 +1769,11 @@ func (server *ArgoCDServer) getClaims(ctx context.Context) (jwt.Claims, string,
 		span.SetStatus(otel_codes.Error, err.Error())
 		return claims, "", status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
 	}
+	// the token is verified once per request here, so this is where its uses are counted
+	if err := server.sessionMgr.CountTokenUse(ctx, claims); err != nil {
+		span.SetStatus(otel_codes.Error, err.Error())
+		return nil, "", status.Errorf(codes.Unauthenticated, "invalid session: %v", err)
+	}
 
 	finalClaims := claims
 	if oidcConfig != nil || server.settings.IsDexConfigured() {
@@ -1672,6 +1829,40 @@ func getToken(md metadata.MD) string {
 	return ""
 }
 
+// gatewayTokenMetadataKey is the metadata key of the token which the grpc-gateway adds to the requests it translates
+const gatewayTokenMetadataKey = "x-argocd-gateway-token"
+
+// getClientAddress returns the address of the client that sent a gRPC request. The x-forwarded-for entries are only
+// trusted when they were added by the grpc-gateway of this server, which appends the address of the HTTP client and
+// identifies itself with gatewayToken, or by one of the trustedProxies.
+func getClientAddress(ctx context.Context, md metadata.MD, gatewayToken string, trustedProxies []*net.IPNet) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	remoteAddr := p.Addr.String()
+	forwarded := md.Get("x-forwarded-for")
+	if len(forwarded) > 0 && isGatewayRequest(md, gatewayToken) {
+		// the gateway received the request from the HTTP client whose address it appended as the last entry
+		entries := strings.Split(strings.Join(forwarded, ","), ",")
+		remoteAddr, forwarded = strings.TrimSpace(entries[len(entries)-1]), entries[:len(entries)-1]
+	}
+	return util_session.ClientAddress(remoteAddr, forwarded, trustedProxies)
+}
+
+// isGatewayRequest returns whether the request was translated by the grpc-gateway of this server.
+func isGatewayRequest(md metadata.MD, gatewayToken string) bool {
+	if gatewayToken == "" {
+		return false
+	}
+	for _, token := range md.Get(gatewayTokenMetadataKey) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(gatewayToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
 type handlerSwitcher struct {
 	handler              http.Handler
 	urlToHandler         map[string]http.Handler
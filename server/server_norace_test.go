@@ -13,11 +13,17 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/password"
 )
 
 func TestUserAgent(t *testing.T) {
@@ -180,3 +186,58 @@ func Test_StaticHeaders(t *testing.T) {
 		require.NoError(t, resp.Body.Close())
 	}
 }
+
+func TestReadOnlyLogin(t *testing.T) {
+	// !race:
+	// Same as TestUserAgent
+
+	s, closer := fakeServer(t)
+	defer closer()
+	s.ReadOnly = true
+	s.DisableAuth = false
+	hashedPassword, err := password.HashPassword("password")
+	require.NoError(t, err)
+	secret := test.NewFakeSecret()
+	secret.Data["admin.password"] = []byte(hashedPassword)
+	_, err = s.KubeClientset.CoreV1().Secrets(test.FakeArgoCDNamespace).Update(t.Context(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	cancelInformer := test.StartInformer(s.projInformer)
+	defer cancelInformer()
+	lns, err := s.Listen()
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	s.Init(ctx)
+	go s.Run(ctx, lns)
+	defer time.Sleep(3 * time.Second)
+
+	err = test.WaitForPortListen(fmt.Sprintf("127.0.0.1:%d", s.ListenPort), 10*time.Second)
+	require.NoError(t, err)
+
+	opts := apiclient.ClientOptions{ServerAddr: fmt.Sprintf("localhost:%d", s.ListenPort), PlainText: true}
+	clnt, err := apiclient.NewClient(&opts)
+	require.NoError(t, err)
+	conn, sessionClnt := clnt.NewSessionClientOrDie()
+	defer conn.Close()
+	session, err := sessionClnt.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "password"})
+	require.NoError(t, err)
+	require.NotEmpty(t, session.Token)
+
+	opts.AuthToken = session.Token
+	clnt, err = apiclient.NewClient(&opts)
+	require.NoError(t, err)
+	conn, sessionClnt = clnt.NewSessionClientOrDie()
+	defer conn.Close()
+	userInfo, err := sessionClnt.GetUserInfo(ctx, &sessionpkg.GetUserInfoRequest{})
+	require.NoError(t, err)
+	assert.True(t, userInfo.LoggedIn)
+	assert.Equal(t, "admin", userInfo.Username)
+	_, err = sessionClnt.Delete(ctx, &sessionpkg.SessionDeleteRequest{})
+	require.NoError(t, err)
+
+	// the methods which mutate state are still rejected
+	conn, accountClnt := clnt.NewAccountClientOrDie()
+	defer conn.Close()
+	_, err = accountClnt.CreateToken(ctx, &accountpkg.CreateTokenRequest{Name: "admin"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -22,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
@@ -44,6 +46,7 @@ import (
 	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/oidc"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
+	util_session "github.com/argoproj/argo-cd/v3/util/session"
 	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 	testutil "github.com/argoproj/argo-cd/v3/util/test"
 )
@@ -641,6 +644,37 @@ func TestAuthenticate(t *testing.T) {
 	}
 }
 
+func TestAuthenticateCountsTokenUseOnce(t *testing.T) {
+	proj := &v1alpha1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: test.FakeArgoCDNamespace},
+		Spec:       v1alpha1.AppProjectSpec{Roles: []v1alpha1.ProjectRole{{Name: "ci", MaxTokenUses: 10}}},
+		Status: v1alpha1.AppProjectStatus{JWTTokensByRole: map[string]v1alpha1.JWTTokens{
+			"ci": {Items: []v1alpha1.JWTToken{{ID: "abc", IssuedAt: time.Now().Unix()}}},
+		}},
+	}
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+	argocd := NewServer(t.Context(), ArgoCDServerOpts{
+		Namespace:     test.FakeArgoCDNamespace,
+		KubeClientset: fake.NewClientset(test.NewFakeConfigMap(), test.NewFakeSecret()),
+		AppClientset:  apps.NewSimpleClientset(proj),
+		RepoClientset: &mocks.Clientset{RepoServerServiceClient: &mocks.RepoServerServiceClient{}},
+		RedisClient:   redis,
+	}, ApplicationSetOpts{})
+	cancel := test.StartInformer(argocd.projInformer)
+	defer cancel()
+
+	token, err := argocd.sessionMgr.Create("proj:default:ci", 0, "abc")
+	require.NoError(t, err)
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(apiclient.MetaDataTokenKey, token))
+	_, err = argocd.Authenticate(ctx)
+	require.NoError(t, err)
+
+	uses, err := redis.Get(t.Context(), "token-uses|abc").Int64()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), uses)
+}
+
 func dexMockHandler(t *testing.T, url string) func(http.ResponseWriter, *http.Request) {
 	t.Helper()
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -1176,6 +1210,25 @@ func TestAuthenticate_no_request_metadata(t *testing.T) {
 	}
 }
 
+func TestAuthenticate_disabled_auth(t *testing.T) {
+	t.Parallel()
+
+	argocd, _ := getTestServer(t, false, true, true, settings_util.OIDCConfig{})
+	argocd.DisableAuth = true
+
+	ctx, err := argocd.Authenticate(t.Context())
+	require.NoError(t, err)
+	assert.Nil(t, ctx.Value("claims"))
+
+	// in core mode, requests are served as the Kubernetes user of the CLI
+	coreClaims := jwt.MapClaims{"iss": util_session.KubernetesClaimsIssuer, "sub": "kubernetes-admin"}
+	argocd.CoreClaims = coreClaims
+	ctx, err = argocd.Authenticate(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, coreClaims, ctx.Value("claims"))
+	assert.Equal(t, "kubernetes-admin", util_session.Username(ctx))
+}
+
 func TestAuthenticate_no_SSO(t *testing.T) {
 	t.Parallel()
 
@@ -1352,6 +1405,44 @@ func Test_getToken(t *testing.T) {
 	})
 }
 
+func Test_getClientAddress(t *testing.T) {
+	ctxFrom := func(addr string) context.Context {
+		return peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	}
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	trustedProxies := []*net.IPNet{proxies}
+	t.Run("NoPeer", func(t *testing.T) {
+		assert.Empty(t, getClientAddress(t.Context(), metadata.MD{}, "token", trustedProxies))
+	})
+	t.Run("Direct", func(t *testing.T) {
+		md := metadata.New(map[string]string{"x-forwarded-for": "10.0.0.1"})
+		assert.Equal(t, "203.0.113.7", getClientAddress(ctxFrom("203.0.113.7"), md, "token", trustedProxies))
+	})
+	t.Run("Loopback", func(t *testing.T) {
+		md := metadata.New(map[string]string{"x-forwarded-for": "203.0.113.7"})
+		assert.Equal(t, "127.0.0.1", getClientAddress(ctxFrom("127.0.0.1"), md, "token", trustedProxies))
+		md = metadata.New(map[string]string{"x-forwarded-for": "203.0.113.7", gatewayTokenMetadataKey: "invalid"})
+		assert.Equal(t, "127.0.0.1", getClientAddress(ctxFrom("127.0.0.1"), md, "token", trustedProxies))
+	})
+	t.Run("Gateway", func(t *testing.T) {
+		md := metadata.New(map[string]string{"x-forwarded-for": "198.51.100.1, 203.0.113.7", gatewayTokenMetadataKey: "token"})
+		assert.Equal(t, "203.0.113.7", getClientAddress(ctxFrom("127.0.0.1"), md, "token", trustedProxies))
+		md = metadata.New(map[string]string{gatewayTokenMetadataKey: "token"})
+		assert.Equal(t, "127.0.0.1", getClientAddress(ctxFrom("127.0.0.1"), md, "token", trustedProxies))
+		md = metadata.New(map[string]string{"x-forwarded-for": "203.0.113.7", gatewayTokenMetadataKey: ""})
+		assert.Equal(t, "127.0.0.1", getClientAddress(ctxFrom("127.0.0.1"), md, "", trustedProxies))
+	})
+	t.Run("TrustedProxy", func(t *testing.T) {
+		md := metadata.New(map[string]string{"x-forwarded-for": "198.51.100.1, 203.0.113.7, 10.0.0.2"})
+		assert.Equal(t, "203.0.113.7", getClientAddress(ctxFrom("10.0.0.1"), md, "token", trustedProxies))
+		md = metadata.New(map[string]string{"x-forwarded-for": "198.51.100.1, 203.0.113.7, 10.0.0.2", gatewayTokenMetadataKey: "token"})
+		assert.Equal(t, "203.0.113.7", getClientAddress(ctxFrom("127.0.0.1"), md, "token", trustedProxies))
+		md = metadata.New(map[string]string{"x-forwarded-for": "10.0.0.3"})
+		assert.Equal(t, "10.0.0.3", getClientAddress(ctxFrom("10.0.0.1"), md, "token", trustedProxies))
+	})
+}
+
 func TestTranslateGrpcCookieHeader(t *testing.T) {
 	argoCDOpts := ArgoCDServerOpts{
 		Namespace:     test.FakeArgoCDNamespace,
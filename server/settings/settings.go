@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	log "github.com/sirupsen/logrus"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -133,6 +134,14 @@ func (s *Server) Get(ctx context.Context, _ *settingspkg.SettingsQuery) (*settin
 		set.UiBannerPosition = argoCDSettings.UiBannerPosition
 		set.ControllerNamespace = s.mgr.GetNamespace()
 		set.ResourceOverrides = overrides
+		// invalid application columns must not prevent the UI from loading its settings, so they are only logged
+		columns, err := s.mgr.GetApplicationColumns()
+		if err != nil {
+			log.Warnf("Failed to get application columns: %v", err)
+		}
+		for _, column := range columns {
+			set.ApplicationColumns = append(set.ApplicationColumns, &settingspkg.ApplicationColumn{Name: column.Name, JSONPath: column.JSONPath})
+		}
 	}
 	if sessionmgr.LoggedIn(ctx) {
 		set.PasswordPattern = argoCDSettings.PasswordPattern
@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -131,4 +132,30 @@ func TestSettingsServer(t *testing.T) {
 		assert.NotNil(t, resp.ResourceOverrides)
 		assert.NotEmpty(t, resp.ResourceOverrides["*/*"])
 	})
+
+	t.Run("TestGetApplicationColumnsLoggedIn", func(t *testing.T) {
+		t.Parallel()
+		//nolint:staticcheck // it's ok to use built-in type string as key for value for testing purposes
+		loggedInContext := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"iss": "qux", "sub": "foo", "email": "bar", "groups": []string{"baz"}})
+		settingsServer := newServer(map[string]string{
+			"application.columns": "- name: Team\n  jsonPath: .metadata.labels.team\n",
+		})
+		resp, err := settingsServer.Get(loggedInContext, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []*settingspkg.ApplicationColumn{{Name: "Team", JSONPath: ".metadata.labels.team"}}, resp.ApplicationColumns)
+	})
+
+	t.Run("TestGetInvalidApplicationColumnsLoggedIn", func(t *testing.T) {
+		t.Parallel()
+		//nolint:staticcheck // it's ok to use built-in type string as key for value for testing purposes
+		loggedInContext := context.WithValue(t.Context(), "claims", &jwt.MapClaims{"iss": "qux", "sub": "foo", "email": "bar", "groups": []string{"baz"}})
+		settingsServer := newServer(map[string]string{
+			"application.columns": "- name: Team\n  jsonPath: .spec.project\n",
+			"ui.loginButtonText":  "Sign in with SSO",
+		})
+		resp, err := settingsServer.Get(loggedInContext, nil)
+		require.NoError(t, err)
+		assert.Empty(t, resp.ApplicationColumns)
+		assert.Equal(t, "Sign in with SSO", resp.UiLoginButtonText)
+	})
 }
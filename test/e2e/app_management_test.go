@@ -554,7 +554,7 @@ func TestTrackAppStateAndSyncApp(t *testing.T) {
 		Expect(HealthIs(health.HealthStatusHealthy)).
 		Expect(Success(fmt.Sprintf("Service     %s  guestbook-ui  Synced ", ctx.DeploymentNamespace()))).
 		Expect(Success(fmt.Sprintf("apps   Deployment  %s  guestbook-ui  Synced", ctx.DeploymentNamespace()))).
-		Expect(Event(argo.EventReasonResourceUpdated, "sync")).
+		Expect(Event(argo.EventReasonSyncStatusChanged, "sync")).
 		And(func(app *Application) {
 			assert.NotNil(t, app.Status.OperationState.SyncResult)
 		})
@@ -295,6 +295,11 @@ export const ApplicationStatusPanel = ({application, showDiff, showOperation, sh
                 <div className='application-status-panel__item-name' style={{marginBottom: '0.5em'}}>
                     {application.spec.syncPolicy?.automated && application.spec.syncPolicy.automated.enabled !== false ? 'Auto sync is enabled.' : 'Auto sync is not enabled.'}
                 </div>
+                {application.status.sync.pruneOnly && (
+                    <div className='application-status-panel__item-name' style={{marginBottom: '0.5em'}}>
+                        Only resources requiring pruning are out of sync.
+                    </div>
+                )}
                 {application.status &&
                     application.status.sync &&
                     (hasMultipleSources
@@ -402,6 +407,12 @@ export const ApplicationStatusPanel = ({application, showDiff, showOperation, sh
                                 <div className='application-status-panel__item-value' style={{margin: 'auto 0'}}>
                                     <ApplicationSyncWindowStatusIcon project={application.spec.project} state={data} />
                                 </div>
+                                {data.nextTransition && (
+                                    <div className='application-status-panel__item-name'>
+                                        Next Transition: <br />
+                                        <Timestamp date={data.nextTransition} />
+                                    </div>
+                                )}
                             </div>
                         )}
                     </React.Fragment>
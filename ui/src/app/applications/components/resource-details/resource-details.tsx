@@ -302,7 +302,7 @@ export const ResourceDetails = (props: ResourceDetailsProps) => {
                         }
 
                         const settings = await services.authService.settings();
-                        const execEnabled = settings.execEnabled;
+                        const execEnabled = await AppUtils.isExecEnabled(settings, application);
                         const logsAllowed = await services.accounts.canI('logs', 'get', AppUtils.appRBACName(application));
                         const execAllowed = execEnabled && (await services.accounts.canI('exec', 'create', AppUtils.appRBACName(application)));
                         const links = await services.applications.getResourceLinks(application.metadata.name, application.metadata.namespace, selectedNode).catch((): null => null);
@@ -1,4 +1,4 @@
-import {models, DataLoader, FormField, MenuItem, NotificationType, Tooltip, HelpIcon} from 'argo-ui';
+import {models, DataLoader, FormField, FormSelect, MenuItem, NotificationType, Tooltip, HelpIcon} from 'argo-ui';
 import {ActionButton} from 'argo-ui/v2';
 import classNames from 'classnames';
 import * as React from 'react';
@@ -657,7 +657,11 @@ export async function getResourceActionsMenuItems(resource: ResourceTreeNode, me
                                 {action.params &&
                                     action.params.map((param, index) => (
                                         <div className='argo-form-row' key={index}>
-                                            <FormField label={param.name} field={param.name} formApi={api} component={Text} />
+                                            {param.type === 'enum' ? (
+                                                <FormField label={param.name} field={param.name} formApi={api} component={FormSelect} componentProps={{options: param.options || []}} />
+                                            ) : (
+                                                <FormField label={param.name} field={param.name} formApi={api} component={Text} />
+                                            )}
                                         </div>
                                     ))}
                             </div>
@@ -774,7 +778,7 @@ function getActionItems(
         ? services.authService
               .settings()
               .then(async settings => {
-                  const execAllowed = settings.execEnabled && (await services.accounts.canI('exec', 'create', appRBACName(application)));
+                  const execAllowed = (await isExecEnabled(settings, application)) && (await services.accounts.canI('exec', 'create', appRBACName(application)));
                   if (isPod && execAllowed) {
                       return [
                           {
@@ -1433,7 +1437,9 @@ export const getPodReadinessGatesState = (pod: appModels.State): {nonExistingCon
 };
 
 export function getConditionCategory(condition: appModels.ApplicationCondition): 'error' | 'warning' | 'info' {
-    if (condition.type.endsWith('Error')) {
+    if (condition.severity) {
+        return condition.severity.toLowerCase() as 'error' | 'warning' | 'info';
+    } else if (condition.type.endsWith('Error')) {
         return 'error';
     } else if (condition.type.endsWith('Warning')) {
         return 'warning';
@@ -1846,6 +1852,15 @@ export function appQualifiedName(app: appModels.AbstractApplication, nsEnabled:
     return (nsEnabled ? app.metadata.namespace + '/' : '') + app.metadata.name;
 }
 
+/**
+ * Returns whether the web-based terminal is enabled for the application, the setting of its project taking precedence
+ * over the global setting. Falls back to the global setting when the project cannot be read.
+ **/
+export async function isExecEnabled(settings: appModels.AuthSettings, app: appModels.Application): Promise<boolean> {
+    const project = await services.projects.get(app.spec.project).catch((): appModels.Project => null);
+    return project?.spec?.exec?.enabled ?? settings.execEnabled;
+}
+
 /**
  * Constructs the RBAC subresource name for canI() checks.
  **/
@@ -75,6 +75,8 @@ export const ClusterDetails = (props: RouteComponentProps<{server: string}> & {o
                                     title: 'CREDENTIALS TYPE',
                                     view:
                                         (cluster.config.awsAuthConfig && `IAM AUTH (cluster name: ${cluster.config.awsAuthConfig.clusterName})`) ||
+                                        (cluster.config.gcpAuthConfig && 'GCP Workload Identity') ||
+                                        (cluster.config.azureAuthConfig && 'Azure Workload Identity') ||
                                         (cluster.config.execProviderConfig && `External provider (command: ${cluster.config.execProviderConfig.command})`) ||
                                         'Token/Basic Auth'
                                 },
@@ -29,6 +29,12 @@ export function getCredentialType(cluster: models.Cluster): string {
     if (cluster.config?.awsAuthConfig) {
         return 'IAM Auth';
     }
+    if (cluster.config?.gcpAuthConfig) {
+        return 'GCP Workload Identity';
+    }
+    if (cluster.config?.azureAuthConfig) {
+        return 'Azure Workload Identity';
+    }
     if (cluster.config?.execProviderConfig) {
         return 'External Provider';
     }
@@ -25,6 +25,7 @@ import {RepoDetails} from '../repo-details/repo-details';
 import {useQuery} from '../../../shared/hooks/query';
 import {useListSort} from '../../../shared/hooks/use-list-sort';
 import {FlexTopBar} from '../../../shared/components';
+import {COLORS} from '../../../shared/components/colors';
 import {useSidebarTarget} from '../../../sidebar/sidebar';
 import {
     filterRepos,
@@ -919,6 +920,7 @@ export const ReposList = ({match, location}: RouteComponentProps) => {
                                                             const repoUrl = getRepoUrl(repo);
                                                             const repoKey = `${isTemplate(repo) ? 'template' : 'repo'}-${isWrite(repo) ? 'write' : 'read'}-${repoUrl}`;
                                                             const connectionState = getConnectionState(repo);
+                                                            const repoStatus = repo.readRepo?.status;
                                                             return (
                                                                 <div className='argo-table-list__row' key={repoKey} onClick={() => displayEditSliding(repo)}>
                                                                     <div className='row'>
@@ -954,6 +956,14 @@ export const ReposList = ({match, location}: RouteComponentProps) => {
                                                                             ) : (
                                                                                 <span>-</span>
                                                                             )}
+                                                                            {repoStatus?.status === 'Failed' && (
+                                                                                <>
+                                                                                    {' '}
+                                                                                    <Tooltip content={`Health check (${repoStatus.failedCheck}) failed: ${repoStatus.lastError}`}>
+                                                                                        <i className='fa fa-exclamation-triangle' style={{color: COLORS.connection_status.failed}} />
+                                                                                    </Tooltip>
+                                                                                </>
+                                                                            )}
                                                                             {!isTemplate(repo) && (
                                                                                 <ActionMenu
                                                                                     items={[
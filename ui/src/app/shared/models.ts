@@ -112,6 +112,23 @@ export interface RevisionMetadata {
     signatureInfo?: string;
 }
 
+export interface CommitMetadata {
+    author?: string;
+    date?: string;
+    subject?: string;
+    body?: string;
+    sha?: string;
+    repoUrl?: string;
+}
+
+export interface RevisionComparison {
+    revision?: string;
+    targetRevision?: string;
+    commits?: CommitMetadata[];
+    commitsTruncated?: boolean;
+    changedFiles?: string[];
+}
+
 export interface OCIMetadata {
     createdAt: string;
     authors: string;
@@ -131,6 +148,14 @@ export interface SyncOperationResult {
     resources: ResourceResult[];
     revision: string;
     revisions: string[];
+    destinations?: SyncOperationDestinationResult[];
+}
+
+export interface SyncOperationDestinationResult {
+    destination: ApplicationDestination;
+    phase: OperationPhase;
+    message?: string;
+    resources?: ResourceResult[];
 }
 
 export type ResultCode = 'Synced' | 'SyncFailed' | 'Pruned' | 'PruneSkipped';
@@ -152,6 +177,7 @@ export interface ResourceResult {
     message: string;
     hookType: HookType;
     hookPhase: OperationPhase;
+    syncWave?: number;
     images?: string[];
 }
 
@@ -332,16 +358,37 @@ export interface ApplicationSourceDirectory {
     exclude?: string;
 }
 
+export interface SelfHealBackoff {
+    duration?: string;
+    factor?: number;
+    maxDuration?: string;
+    cooldown?: string;
+    maxAttempts?: number;
+}
+
 export interface Automated {
     prune: boolean;
     selfHeal: boolean;
     enabled: boolean;
+    selfHealBackoff?: SelfHealBackoff;
+}
+
+export interface SyncPolicyScheduled {
+    schedule: string;
+    timeZone?: string;
+}
+
+export interface SyncPrecondition {
+    expression: string;
+    message?: string;
 }
 
 export interface SyncPolicy {
     automated?: Automated;
     syncOptions?: string[];
     retry?: RetryStrategy;
+    scheduled?: SyncPolicyScheduled;
+    preconditions?: SyncPrecondition[];
 }
 
 export interface Info {
@@ -355,10 +402,26 @@ export interface ApplicationSpec {
     sources: ApplicationSource[];
     sourceHydrator?: SourceHydrator;
     destination: ApplicationDestination;
+    destinations?: ApplicationDestination[];
     syncPolicy?: SyncPolicy;
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    imageUpdatePolicy?: ImageUpdatePolicy;
+}
+
+export interface ImageUpdatePolicy {
+    images: ImageUpdate[];
+    writeBack?: 'spec' | 'git';
+    writeBackBranch?: string;
+}
+
+export interface ImageUpdate {
+    image: string;
+    strategy?: 'semver' | 'digest' | 'latest';
+    constraint?: string;
+    helmImageParameter?: string;
+    helmTagParameter?: string;
 }
 
 export interface ResourceIgnoreDifferences {
@@ -515,12 +578,15 @@ export interface SyncStatus {
     status: SyncStatusCode;
     revision: string;
     revisions: string[];
+    pruneOnly?: boolean;
 }
 
 export interface ApplicationCondition {
     type: string;
     message: string;
     lastTransitionTime: string;
+    severity?: 'Info' | 'Warning' | 'Error';
+    expiresAt?: models.Time;
 }
 
 export interface ApplicationSummary {
@@ -539,6 +605,28 @@ export interface ApplicationStatus {
     operationState?: OperationState;
     summary?: ApplicationSummary;
     sourceHydrator?: SourceHydratorStatus;
+    destinations?: ApplicationDestinationStatus[];
+    nextScheduledSync?: models.Time;
+    selfHeal?: SelfHealStatus;
+    imageUpdates?: ImageUpdateStatus[];
+}
+
+export interface SelfHealStatus {
+    attempts?: number;
+    lastAttemptedAt?: models.Time;
+}
+
+export interface ImageUpdateStatus {
+    image: string;
+    reference: string;
+    lastUpdated: models.Time;
+}
+
+export interface ApplicationDestinationStatus {
+    destination: ApplicationDestination;
+    sync: SyncStatusCode;
+    health: HealthStatusCode;
+    message?: string;
 }
 
 export interface SourceHydratorStatus {
@@ -575,8 +663,16 @@ export const HydrateOperationPhases = {
 export interface JwtTokens {
     items: JwtToken[];
 }
+export interface SyncWindowUnlock {
+    windowHash: string;
+    expiresAt: models.Time;
+    unlockedBy?: string;
+    reason?: string;
+}
+
 export interface AppProjectStatus {
     jwtTokensByRole: {[name: string]: JwtTokens};
+    syncWindowUnlocks?: SyncWindowUnlock[];
 }
 
 export interface LogEntry {
@@ -587,6 +683,7 @@ export interface LogEntry {
     last: boolean;
     timeStampStr: string;
     podName: string;
+    containerName?: string;
 }
 
 // describes plugin settings
@@ -692,6 +789,16 @@ export interface Repository {
     azureServicePrincipalClientSecret?: string;
     azureServicePrincipalTenantId?: string;
     azureActiveDirectoryEndpoint?: string;
+    status?: RepositoryStatus;
+}
+
+export interface RepositoryStatus {
+    status: ConnectionStatus;
+    lastCheckedAt?: models.Time;
+    lastSucceededAt?: models.Time;
+    lastError?: string;
+    failedCheck?: string;
+    credentialsURL?: string;
 }
 
 export interface RepositoryList extends ItemsList<Repository> {}
@@ -715,6 +822,12 @@ export interface Cluster {
         awsAuthConfig?: {
             clusterName: string;
         };
+        gcpAuthConfig?: {
+            scopes?: string[];
+        };
+        azureAuthConfig?: {
+            serverApplicationID?: string;
+        };
         execProviderConfig?: {
             command: string;
         };
@@ -854,6 +967,8 @@ export interface ProjectRole {
     policies: string[];
     name: string;
     groups: string[];
+    allowedCIDRs?: string[];
+    maxTokenUses?: number;
 }
 
 export interface JwtToken {
@@ -891,6 +1006,18 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    ignoreDifferencesRef?: {configMapName: string; key: string};
+    namespaceCreationPolicy?: {mode?: 'allow' | 'deny' | 'allowWithRequiredLabels'; labels?: {[name: string]: string}; annotations?: {[name: string]: string}};
+    exec?: {enabled?: boolean; recordSessions?: boolean};
+    destinationMappings?: DestinationMapping[];
+}
+
+export interface DestinationMapping {
+    server?: string;
+    name?: string;
+    targetServer?: string;
+    targetName?: string;
+    effectiveFrom?: models.Time;
 }
 
 export type SyncWindows = SyncWindow[];
@@ -907,6 +1034,7 @@ export interface SyncWindow {
     andOperator: boolean;
     description: string;
     syncOverrun: boolean;
+    requireApproval?: boolean;
 }
 
 export interface Project {
@@ -940,6 +1068,16 @@ export interface ResourceActionParam {
     value: string;
     type: string;
     default: string;
+    options?: string[];
+}
+
+export interface ResourceActionResult {
+    group: string;
+    version: string;
+    kind: string;
+    namespace: string;
+    name: string;
+    error?: string;
 }
 
 export interface ResourceAction {
@@ -954,10 +1092,23 @@ export interface SyncWindowsState {
     windows: SyncWindow[];
 }
 
+export interface ApplicationSyncWindow {
+    kind: string;
+    schedule: string;
+    duration: string;
+    manualSync: boolean;
+    timeZone?: string;
+    active?: boolean;
+    nextTransition?: models.Time;
+    unlocked?: boolean;
+}
+
 export interface ApplicationSyncWindowState {
-    activeWindows: SyncWindow[];
-    assignedWindows: SyncWindow[];
+    activeWindows: ApplicationSyncWindow[];
+    assignedWindows: ApplicationSyncWindow[];
     canSync: boolean;
+    canAutoSync?: boolean;
+    nextTransition?: models.Time;
 }
 
 export interface VersionMessage {
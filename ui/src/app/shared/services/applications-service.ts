@@ -101,6 +101,20 @@ export class ApplicationsService {
         return r.then(res => res.body as models.RevisionMetadata);
     }
 
+    public revisionComparison(name: string, appNamespace: string, revision: string | null, targetRevision: string | null, sourceIndex: number | null): Promise<models.RevisionComparison> {
+        let r = requests.get(`/applications/${name}/revisions/compare`).query({appNamespace});
+        if (revision) {
+            r = r.query({revision});
+        }
+        if (targetRevision) {
+            r = r.query({targetRevision});
+        }
+        if (sourceIndex !== null) {
+            r = r.query({sourceIndex});
+        }
+        return r.then(res => res.body as models.RevisionComparison);
+    }
+
     public revisionChartDetails(name: string, appNamespace: string, revision: string, sourceIndex: number, versionId: number | null): Promise<models.ChartDetails> {
         let r = requests.get(`/applications/${name}/revisions/${revision || 'HEAD'}/chartdetails`).query({appNamespace});
         if (sourceIndex !== null) {
@@ -435,6 +449,29 @@ export class ApplicationsService {
             .then(res => (res.body.actions as models.ResourceAction[]) || []);
     }
 
+    public runResourceActionBulk(
+        name: string,
+        appNamespace: string,
+        filter: {group: string; kind: string; namespace?: string; labelSelector?: string},
+        action: string,
+        resourceActionParameters: models.ResourceActionParam[]
+    ): Promise<models.ResourceActionResult[]> {
+        return requests
+            .post(`/applications/${name}/resource/actions/bulk`)
+            .send(
+                JSON.stringify({
+                    appNamespace,
+                    group: filter.group,
+                    kind: filter.kind,
+                    namespace: filter.namespace,
+                    labelSelector: filter.labelSelector,
+                    resourceActionParameters,
+                    action
+                })
+            )
+            .then(res => (res.body.results as models.ResourceActionResult[]) || []);
+    }
+
     public patchResource(name: string, appNamespace: string, resource: models.ResourceNode, patch: string, patchType: string): Promise<models.State> {
         return requests
             .post(`/applications/${name}/resource`)
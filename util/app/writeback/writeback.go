@@ -0,0 +1,279 @@
+// Package writeback persists Application parameter changes to the parameter override file of the application in
+// Git instead of the Application spec.
+package writeback
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/askpass"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+)
+
+// appSourceFile is the name of the per-application parameter override file read by the repo-server
+const appSourceFile = ".argocd-source-%s.yaml"
+
+// Writer commits a change to a single file of a Git repository.
+type Writer interface {
+	// WriteFile checks out the branch, replaces the file at path with the result of update, which receives the current
+	// content of the file (nil if it does not exist), and commits and pushes the change.
+	WriteFile(ctx context.Context, repo *v1alpha1.Repository, branch, path, message string, update func(existing []byte) ([]byte, error)) error
+}
+
+type gitWriter struct {
+	askPassSocketPath string
+	startOnce         sync.Once
+	creds             git.CredsStore
+	startErr          error
+}
+
+// NewGitWriter returns a Writer which clones the repository with git. HTTPS credentials are provided to git by an
+// askpass server listening on the given socket path.
+func NewGitWriter(askPassSocketPath string) Writer {
+	return &gitWriter{askPassSocketPath: askPassSocketPath}
+}
+
+// credsStore lazily starts the askpass server which provides HTTPS credentials to git, since only few installations
+// use write-back.
+func (w *gitWriter) credsStore() (git.CredsStore, error) {
+	w.startOnce.Do(func() {
+		askPassServer := askpass.NewServer(w.askPassSocketPath)
+		w.startErr = askPassServer.Run()
+		w.creds = askPassServer
+	})
+	if w.startErr != nil {
+		return nil, fmt.Errorf("failed to start askpass server: %w", w.startErr)
+	}
+	return w.creds, nil
+}
+
+func (w *gitWriter) WriteFile(ctx context.Context, repo *v1alpha1.Repository, branch, path, message string, update func(existing []byte) ([]byte, error)) error {
+	credsStore, err := w.credsStore()
+	if err != nil {
+		return err
+	}
+	dirPath, err := files.CreateTempDir("")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dirPath); err != nil {
+			log.WithError(err).Error("failed to cleanup temp dir")
+		}
+	}()
+
+	gitClient, err := git.NewClientExt(repo.Repo, dirPath, repo.GetGitCreds(credsStore), repo.IsInsecure(), repo.IsLFSEnabled(), repo.Proxy, repo.NoProxy)
+	if err != nil {
+		return fmt.Errorf("failed to create git client: %w", err)
+	}
+	if err = gitClient.Init(); err != nil {
+		return fmt.Errorf("failed to init git client: %w", err)
+	}
+	if err = gitClient.Fetch(ctx, "", 0); err != nil {
+		return fmt.Errorf("failed to fetch repo: %w", err)
+	}
+	if out, err := gitClient.Checkout(ctx, branch, false, true); err != nil {
+		return fmt.Errorf("failed to checkout branch %s: %s: %w", branch, out, err)
+	}
+	if _, err = gitClient.SetAuthor(ctx, "Argo CD", "argo-cd@example.com"); err != nil {
+		return fmt.Errorf("failed to set author: %w", err)
+	}
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer utilio.Close(root)
+
+	existing, err := root.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	data, err := update(existing)
+	if err != nil {
+		return err
+	}
+	if err = root.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+	if err = root.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if out, err := gitClient.CommitAndPush(ctx, branch, message); err != nil {
+		return fmt.Errorf("failed to commit and push: %s: %w", out, err)
+	}
+	return nil
+}
+
+// OverrideFilePath returns the path of the parameter override file of the application with the given instance name
+// for a source at the given path.
+func OverrideFilePath(sourcePath, appInstanceName string) string {
+	return filepath.Join(sourcePath, fmt.Sprintf(appSourceFile, appInstanceName))
+}
+
+// ParametersEqual returns whether the parameters which can be written back are the same in both sources.
+func ParametersEqual(a, b *v1alpha1.ApplicationSource) bool {
+	return reflect.DeepEqual(parameterSections(a), parameterSections(b))
+}
+
+// parameterSections returns the parts of the source which the repo-server reads from parameter override files.
+func parameterSections(source *v1alpha1.ApplicationSource) v1alpha1.ApplicationSource {
+	return v1alpha1.ApplicationSource{
+		Helm:      source.Helm,
+		Kustomize: source.Kustomize,
+		Directory: source.Directory,
+		Plugin:    source.Plugin,
+	}
+}
+
+// MergeParameterOverrides returns the new content of a parameter override file. The changes between the live and
+// the desired parameters are applied on top of the parameters currently in effect, i.e. the live parameters with the
+// existing override file merged in, so that changes previously written back are preserved.
+func MergeParameterOverrides(live, desired *v1alpha1.ApplicationSource, existing []byte) ([]byte, error) {
+	liveJSON, err := json.Marshal(parameterSections(live))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling live parameters: %w", err)
+	}
+	desiredJSON, err := json.Marshal(parameterSections(desired))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling desired parameters: %w", err)
+	}
+	currentJSON := liveJSON
+	if len(existing) > 0 {
+		patch, err := yaml.YAMLToJSON(existing)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing existing parameter overrides: %w", err)
+		}
+		currentJSON, err = jsonpatch.MergePatch(liveJSON, patch)
+		if err != nil {
+			return nil, fmt.Errorf("error applying existing parameter overrides: %w", err)
+		}
+	}
+
+	var liveObj, desiredObj, currentObj any
+	for _, v := range []struct {
+		data []byte
+		obj  *any
+	}{{liveJSON, &liveObj}, {desiredJSON, &desiredObj}, {currentJSON, &currentObj}} {
+		if err := json.Unmarshal(v.data, v.obj); err != nil {
+			return nil, fmt.Errorf("error unmarshaling parameters: %w", err)
+		}
+	}
+	resultJSON, err := json.Marshal(mergeParameterValues(liveObj, desiredObj, currentObj))
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling parameters: %w", err)
+	}
+	overrides, err := jsonpatch.CreateMergePatch(liveJSON, resultJSON)
+	if err != nil {
+		return nil, fmt.Errorf("error creating parameter overrides: %w", err)
+	}
+	return yaml.JSONToYAML(overrides)
+}
+
+// mergeParameterValues applies the change from base to desired to current. Objects are merged by key and lists of
+// objects with a name, such as Helm parameters, by name. Any other changed value replaces the current one.
+func mergeParameterValues(base, desired, current any) any {
+	if reflect.DeepEqual(base, desired) {
+		return current
+	}
+	desiredMap, ok := desired.(map[string]any)
+	if baseMap, isMap := base.(map[string]any); ok && (isMap || base == nil) {
+		result := map[string]any{}
+		if currentMap, ok := current.(map[string]any); ok {
+			maps.Copy(result, currentMap)
+		}
+		for k, v := range desiredMap {
+			if !reflect.DeepEqual(baseMap[k], v) {
+				result[k] = mergeParameterValues(baseMap[k], v, result[k])
+			}
+		}
+		for k, v := range baseMap {
+			if _, ok := desiredMap[k]; ok {
+				continue
+			}
+			// an omitted list of named items only removes the items of the base
+			if items, ok := namedItems(v); ok && len(items) > 0 {
+				if merged, ok := mergeParameterValues(v, nil, result[k]).([]any); ok && len(merged) > 0 {
+					result[k] = merged
+					continue
+				}
+			}
+			delete(result, k)
+		}
+		return result
+	}
+	desiredItems, ok := namedItems(desired)
+	if !ok {
+		return desired
+	}
+	baseItems, ok := namedItems(base)
+	if !ok {
+		return desired
+	}
+	currentList, _ := current.([]any)
+	if _, ok := namedItems(current); !ok {
+		currentList, _ = base.([]any)
+	}
+	result := make([]any, 0, len(currentList))
+	seen := map[string]bool{}
+	for _, item := range currentList {
+		name := item.(map[string]any)["name"].(string)
+		seen[name] = true
+		desiredItem, inDesired := desiredItems[name]
+		baseItem, inBase := baseItems[name]
+		switch {
+		case inBase && !inDesired:
+			continue
+		case inDesired && !reflect.DeepEqual(baseItem, desiredItem):
+			result = append(result, desiredItem)
+		default:
+			result = append(result, item)
+		}
+	}
+	desiredList, _ := desired.([]any)
+	for _, item := range desiredList {
+		name := item.(map[string]any)["name"].(string)
+		if !seen[name] && !reflect.DeepEqual(baseItems[name], item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// namedItems indexes a list whose elements are all objects with a name by that name. A nil value is an empty list.
+func namedItems(value any) (map[string]any, bool) {
+	if value == nil {
+		return map[string]any{}, true
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, false
+	}
+	items := make(map[string]any, len(list))
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok {
+			return nil, false
+		}
+		items[name] = item
+	}
+	return items, true
+}
@@ -0,0 +1,54 @@
+package writeback
+
+import (
+	"encoding/json"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// effectiveSource applies the override file to the source the same way the repo-server does
+func effectiveSource(t *testing.T, source v1alpha1.ApplicationSource, overrides []byte) v1alpha1.ApplicationSource {
+	t.Helper()
+	data, err := json.Marshal(source)
+	require.NoError(t, err)
+	patch, err := yaml.YAMLToJSON(overrides)
+	require.NoError(t, err)
+	data, err = jsonpatch.MergePatch(data, patch)
+	require.NoError(t, err)
+	var merged v1alpha1.ApplicationSource
+	require.NoError(t, json.Unmarshal(data, &merged))
+	return merged
+}
+
+func helmSource(params ...v1alpha1.HelmParameter) v1alpha1.ApplicationSource {
+	return v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app", Helm: &v1alpha1.ApplicationSourceHelm{Parameters: params, ReleaseName: "release"}}
+}
+
+func TestMergeParameterOverrides(t *testing.T) {
+	live := helmSource(v1alpha1.HelmParameter{Name: "a", Value: "1"})
+
+	desired := helmSource(v1alpha1.HelmParameter{Name: "a", Value: "1"}, v1alpha1.HelmParameter{Name: "b", Value: "2"})
+	overrides, err := MergeParameterOverrides(&live, &desired, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}}, effectiveSource(t, live, overrides).Helm.Parameters)
+
+	// changes are computed from the live spec, which does not contain parameters written back before
+	desired = helmSource(v1alpha1.HelmParameter{Name: "a", Value: "10"}, v1alpha1.HelmParameter{Name: "c", Value: "3"})
+	overrides, err = MergeParameterOverrides(&live, &desired, overrides)
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "a", Value: "10"}, {Name: "b", Value: "2"}, {Name: "c", Value: "3"}}, effectiveSource(t, live, overrides).Helm.Parameters)
+
+	desired = helmSource()
+	desired.Helm.ReleaseName = "renamed"
+	overrides, err = MergeParameterOverrides(&live, &desired, overrides)
+	require.NoError(t, err)
+	effective := effectiveSource(t, live, overrides)
+	assert.Equal(t, []v1alpha1.HelmParameter{{Name: "b", Value: "2"}, {Name: "c", Value: "3"}}, effective.Helm.Parameters)
+	assert.Equal(t, "renamed", effective.Helm.ReleaseName)
+}
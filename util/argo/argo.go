@@ -8,11 +8,13 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/google/cel-go/cel"
 	"github.com/r3labs/diff/v3"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -22,16 +24,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
 	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	celutil "github.com/argoproj/argo-cd/v3/util/cel"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/settings"
+	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
 )
 
 const (
@@ -237,8 +244,31 @@ func FilterByNameP(apps []*argoappv1.Application, name string) []*argoappv1.Appl
 	return items
 }
 
+// FilterByConditionSeveritiesP returns the application pointers with a condition of one of the given severities
+func FilterByConditionSeveritiesP(apps []*argoappv1.Application, severities []argoappv1.ApplicationConditionSeverity) []*argoappv1.Application {
+	if len(severities) == 0 {
+		return apps
+	}
+	severitiesMap := make(map[argoappv1.ApplicationConditionSeverity]bool, len(severities))
+	for _, severity := range severities {
+		severitiesMap[severity] = true
+	}
+	items := []*argoappv1.Application{}
+	for i := range apps {
+		if len(apps[i].Status.GetConditionsBySeverity(severitiesMap)) > 0 {
+			items = append(items, apps[i])
+		}
+	}
+	return items
+}
+
 // RefreshApp updates the refresh annotation of an application to coerce the controller to process it
 func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, hydrateType *argoappv1.HydrateType) (*argoappv1.Application, error) {
+	return RefreshAppWithPriority(appIf, name, refreshType, "", hydrateType)
+}
+
+// RefreshAppWithPriority is like RefreshApp, but also sets the priority of the refresh unless it is empty
+func RefreshAppWithPriority(appIf v1alpha1.ApplicationInterface, name string, refreshType argoappv1.RefreshType, priority argoappv1.RefreshPriority, hydrateType *argoappv1.HydrateType) (*argoappv1.Application, error) {
 	metadata := map[string]any{
 		"metadata": map[string]any{
 			"annotations": map[string]string{
@@ -246,6 +276,9 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 			},
 		},
 	}
+	if priority != "" {
+		metadata["metadata"].(map[string]any)["annotations"].(map[string]string)[argoappv1.AnnotationKeyRefreshPriority] = string(priority)
+	}
 	if hydrateType != nil {
 		metadata["metadata"].(map[string]any)["annotations"].(map[string]string)[argoappv1.AnnotationKeyHydrate] = string(*hydrateType)
 	}
@@ -692,15 +725,85 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
-	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
+	if spec.SyncPolicy != nil && spec.SyncPolicy.Scheduled != nil {
+		if err := spec.SyncPolicy.Scheduled.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid scheduled sync policy: %v", err),
+			})
+		}
+	}
+	for i := range spec.HealthRollupRules {
+		if err := spec.HealthRollupRules[i].Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid health roll-up rule: %v", err),
+			})
+		}
+	}
+	if spec.ImageUpdatePolicy != nil {
+		source := spec.GetSource()
+		if err := spec.ImageUpdatePolicy.Validate(); err != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("invalid image update policy: %v", err),
+			})
+		} else if spec.SourceHydrator != nil {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "invalid image update policy: image updates are not supported with a source hydrator",
+			})
+		} else if spec.ImageUpdatePolicy.GetWriteBack() == argoappv1.ImageUpdateWriteBackMethodGit && (spec.HasMultipleSources() || source.IsHelm() || source.IsOCI()) {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "invalid image update policy: git write-back requires a single Git source",
+			})
+		}
+	}
+	if spec.SyncPolicy != nil {
+		for _, precondition := range spec.SyncPolicy.Preconditions {
+			if _, err := CompileSyncPrecondition(precondition.Expression); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("invalid sync precondition '%s': %v", precondition.Expression, err),
+				})
+			}
+		}
+	}
+
+	destinations := spec.GetDestinations()
+	for i, dest := range destinations {
+		if slices.Contains(destinations[:i], dest) {
+			server := dest.Server
+			if dest.Name != "" {
+				server = dest.Name
+			}
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("application destination server '%s' and namespace '%s' is listed more than once", server, dest.Namespace),
+			})
+			continue
+		}
+		destConditions, err := validateDestinationPermissions(ctx, dest, proj, db)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, destConditions...)
+	}
+	return conditions, nil
+}
+
+// validateDestinationPermissions validates that the destination cluster exists and that the destination is permitted by
+// the project
+func validateDestinationPermissions(ctx context.Context, dest argoappv1.ApplicationDestination, proj *argoappv1.AppProject, db db.ArgoDB) ([]argoappv1.ApplicationCondition, error) {
+	destCluster, err := GetDestinationCluster(ctx, dest, db)
 	if err != nil {
-		conditions = append(conditions, argoappv1.ApplicationCondition{
+		return []argoappv1.ApplicationCondition{{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
 			Message: err.Error(),
-		})
-		return conditions, nil
+		}}, nil
 	}
-	permitted, err := proj.IsDestinationPermitted(destCluster, spec.Destination.Namespace, func(project string) ([]*argoappv1.Cluster, error) {
+	permitted, err := proj.IsDestinationPermitted(destCluster, dest.Namespace, func(project string) ([]*argoappv1.Cluster, error) {
 		return db.GetProjectClusters(ctx, project)
 	})
 	if err != nil {
@@ -708,15 +811,36 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 	}
 	if !permitted {
 		server := destCluster.Server
-		if spec.Destination.Name != "" {
+		if dest.Name != "" {
 			server = destCluster.Name
 		}
+		return []argoappv1.ApplicationCondition{{
+			Type:    argoappv1.ApplicationConditionInvalidSpecError,
+			Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", server, dest.Namespace, proj.Name),
+		}}, nil
+	}
+	return nil, nil
+}
+
+// ValidateDestinationServiceAccount validates that the service account selected by the destination of the application
+// is permitted by the destination service accounts of the project
+func ValidateDestinationServiceAccount(ctx context.Context, app *argoappv1.Application, proj *argoappv1.AppProject, db db.ArgoDB) []argoappv1.ApplicationCondition {
+	conditions := []argoappv1.ApplicationCondition{}
+	if app.Spec.Destination.ServiceAccount == "" {
+		return conditions
+	}
+	destCluster, err := GetDestinationCluster(ctx, app.Spec.Destination, db)
+	if err != nil {
+		// invalid destinations are reported by ValidatePermissions
+		return conditions
+	}
+	if _, err := settings.DeriveServiceAccountToImpersonate(proj, app, destCluster); err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
-			Message: fmt.Sprintf("application destination server '%s' and namespace '%s' do not match any of the allowed destinations in project '%s'", server, spec.Destination.Namespace, proj.Name),
+			Message: err.Error(),
 		})
 	}
-	return conditions, nil
+	return conditions
 }
 
 // APIResourcesToStrings converts list of API Resources list into string list
@@ -803,6 +927,64 @@ func GetAppProject(ctx context.Context, app *argoappv1.Application, projLister a
 	return proj, nil
 }
 
+// GetIgnoreDifferences returns the ignore differences of the application, preceded by the ignore differences referenced
+// by its project and by an ignore difference matching all group kinds for the managed fields managers trusted by its
+// project
+func GetIgnoreDifferences(spec *argoappv1.ApplicationSpec, proj *argoappv1.AppProject, settingsMgr *settings.SettingsManager) (argoappv1.IgnoreDifferences, error) {
+	var ignoreDifferences argoappv1.IgnoreDifferences
+	if len(proj.Spec.ManagedFieldsManagers) > 0 {
+		ignoreDifferences = append(ignoreDifferences, argoappv1.ResourceIgnoreDifferences{
+			Group:                 "*",
+			Kind:                  "*",
+			ManagedFieldsManagers: proj.Spec.ManagedFieldsManagers,
+		})
+	}
+	ref := proj.Spec.IgnoreDifferencesRef
+	if ref == nil {
+		if len(ignoreDifferences) == 0 {
+			return spec.IgnoreDifferences, nil
+		}
+		return append(ignoreDifferences, spec.IgnoreDifferences...), nil
+	}
+	cm, err := settingsMgr.GetConfigMapByName(ref.ConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ignore differences config map %q of project %q: %w", ref.ConfigMapName, proj.Name, err)
+	}
+	data, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in ignore differences config map %q of project %q", ref.Key, ref.ConfigMapName, proj.Name)
+	}
+	var refIgnoreDifferences argoappv1.IgnoreDifferences
+	if err := yaml.Unmarshal([]byte(data), &refIgnoreDifferences); err != nil {
+		return nil, fmt.Errorf("error unmarshaling ignore differences of config map %q of project %q: %w", ref.ConfigMapName, proj.Name, err)
+	}
+	ignoreDifferences = append(ignoreDifferences, refIgnoreDifferences...)
+	return append(ignoreDifferences, spec.IgnoreDifferences...), nil
+}
+
+var syncPreconditionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return celutil.NewEnv("app", "application", "project", "cluster")
+})
+
+// CompileSyncPrecondition compiles the CEL expression of a sync precondition, which is evaluated against the app (or
+// application), project and cluster variables, returning an error if it is invalid or does not evaluate to a boolean
+func CompileSyncPrecondition(expression string) (cel.Program, error) {
+	env, err := syncPreconditionEnv()
+	if err != nil {
+		return nil, err
+	}
+	return celutil.CompileBool(env, expression)
+}
+
+// GetDriftExclusions returns the drift exclusions configured in argocd-cm followed by the drift exclusions of the project
+func GetDriftExclusions(proj *argoappv1.AppProject, settingsMgr *settings.SettingsManager) ([]argoappv1.DriftExclusion, error) {
+	exclusions, err := settingsMgr.GetDriftExclusions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting drift exclusions: %w", err)
+	}
+	return append(exclusions, proj.Spec.DriftExclusions...), nil
+}
+
 // verifyGenerateManifests verifies a repo path can generate manifests
 func verifyGenerateManifests(
 	ctx context.Context,
@@ -892,6 +1074,7 @@ func verifyGenerateManifests(
 			Repos:                           repos,
 			Revision:                        source.TargetRevision,
 			AppName:                         app.Name,
+			SourceEnvironment:               app.Labels[argoappv1.LabelKeySourceEnvironment],
 			Namespace:                       app.Spec.Destination.Namespace,
 			ApplicationSource:               &source,
 			AppLabelKey:                     appLabelKey,
@@ -936,10 +1119,15 @@ func verifyGenerateManifests(
 	return conditions
 }
 
-// SetAppOperation updates an application with the specified operation, retrying conflict errors
-func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, error) {
+// SetAppOperation updates an application with the specified operation, retrying conflict errors. The trace context of
+// ctx is stored in the annotations of the application so that the controller continues the trace of the operation, and
+// the correlation ID of ctx is stored in the operation so that the controller logs it while processing the operation.
+func SetAppOperation(ctx context.Context, appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, error) {
+	if op.CorrelationID == "" {
+		op.CorrelationID = logutils.CorrelationIDFromContext(ctx)
+	}
 	for {
-		a, err := appIf.Get(context.Background(), appName, metav1.GetOptions{})
+		a, err := appIf.Get(ctx, appName, metav1.GetOptions{})
 		if err != nil {
 			return nil, fmt.Errorf("error getting application %q: %w", appName, err)
 		}
@@ -949,7 +1137,11 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 		}
 		a.Operation = op
 		a.Status.OperationState = nil
-		a, err = appIf.Update(context.Background(), a, metav1.UpdateOptions{})
+		if a.Annotations == nil {
+			a.Annotations = map[string]string{}
+		}
+		traceutil.InjectIntoAnnotations(ctx, a.Annotations, argocommon.AnnotationKeyTraceContextPrefix)
+		a, err = appIf.Update(ctx, a, metav1.UpdateOptions{})
 		if op.Sync == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "Operation unspecified")
 		}
@@ -1022,6 +1214,10 @@ func NormalizeApplicationSpec(spec *argoappv1.ApplicationSpec) *argoappv1.Applic
 	if spec.SyncPolicy.IsZero() {
 		spec.SyncPolicy = nil
 	}
+	if spec.HasMultipleDestinations() {
+		// the first destination is the primary destination of the application
+		spec.Destination = spec.Destinations[0]
+	}
 	if len(spec.Sources) > 0 {
 		for _, source := range spec.Sources {
 			NormalizeSource(&source)
@@ -1130,6 +1326,16 @@ func GetDestinationCluster(ctx context.Context, destination argoappv1.Applicatio
 	return cluster, nil
 }
 
+// GetMappedDestinationCluster returns the cluster of the given destination once rewritten by the destination mappings of
+// the given project, i.e. the cluster the resources of the application are currently deployed to. The destination is
+// used unchanged if the project is nil.
+func GetMappedDestinationCluster(ctx context.Context, destination argoappv1.ApplicationDestination, proj *argoappv1.AppProject, db ClusterGetter) (*argoappv1.Cluster, error) {
+	if proj != nil {
+		destination = proj.MapDestination(destination, time.Now())
+	}
+	return GetDestinationCluster(ctx, destination, db)
+}
+
 func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) []*argoappv1.AppProject {
 	gps, err := settingsManager.GetGlobalProjectsSettings()
 	globalProjects := []*argoappv1.AppProject{}
@@ -1175,13 +1381,16 @@ func GetGlobalProjects(proj *argoappv1.AppProject, projLister applicationsv1.App
 }
 
 func GetAppVirtualProject(proj *argoappv1.AppProject, projLister applicationsv1.AppProjectLister, settingsManager *settings.SettingsManager) (*argoappv1.AppProject, error) {
-	virtualProj := proj.DeepCopy()
-	globalProjects := GetGlobalProjects(proj, projLister, settingsManager)
+	return MergeGlobalProjects(proj, GetGlobalProjects(proj, projLister, settingsManager)), nil
+}
 
+// MergeGlobalProjects returns a copy of the project which also holds the restrictions of the given global projects
+func MergeGlobalProjects(proj *argoappv1.AppProject, globalProjects []*argoappv1.AppProject) *argoappv1.AppProject {
+	virtualProj := proj.DeepCopy()
 	for _, gp := range globalProjects {
 		virtualProj = mergeVirtualProject(virtualProj, gp)
 	}
-	return virtualProj, nil
+	return virtualProj
 }
 
 func mergeVirtualProject(proj *argoappv1.AppProject, globalProj *argoappv1.AppProject) *argoappv1.AppProject {
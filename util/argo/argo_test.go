@@ -32,6 +32,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/test"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
+	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -89,6 +90,96 @@ func TestGetAppProjectWithNoProjDefined(t *testing.T) {
 	assert.Equal(t, proj.Name, projName)
 }
 
+func TestGetIgnoreDifferences(t *testing.T) {
+	t.Parallel()
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "platform-ignore-differences",
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"ignoreDifferences": `- group: autoscaling
+  kind: HorizontalPodAutoscaler
+  jsonPointers:
+  - /spec/replicas
+`,
+			"invalid": "not a list",
+		},
+	}
+	settingsMgr := settings.NewSettingsManager(t.Context(), fake.NewClientset(&cm), test.FakeArgoCDNamespace)
+	spec := &argoappv1.ApplicationSpec{IgnoreDifferences: argoappv1.IgnoreDifferences{
+		{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+	}}
+	newProj := func(ref *argoappv1.ConfigMapKeyRef) *argoappv1.AppProject {
+		return &argoappv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			Spec:       argoappv1.AppProjectSpec{IgnoreDifferencesRef: ref},
+		}
+	}
+
+	t.Run("NoReference", func(t *testing.T) {
+		t.Parallel()
+		ignoreDifferences, err := GetIgnoreDifferences(spec, newProj(nil), settingsMgr)
+		require.NoError(t, err)
+		assert.Equal(t, spec.IgnoreDifferences, ignoreDifferences)
+	})
+
+	t.Run("Merged", func(t *testing.T) {
+		t.Parallel()
+		ignoreDifferences, err := GetIgnoreDifferences(spec, newProj(&argoappv1.ConfigMapKeyRef{ConfigMapName: cm.Name, Key: "ignoreDifferences"}), settingsMgr)
+		require.NoError(t, err)
+		assert.Equal(t, argoappv1.IgnoreDifferences{
+			{Group: "autoscaling", Kind: "HorizontalPodAutoscaler", JSONPointers: []string{"/spec/replicas"}},
+			{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+		}, ignoreDifferences)
+		assert.Len(t, spec.IgnoreDifferences, 1)
+	})
+
+	t.Run("MissingConfigMap", func(t *testing.T) {
+		t.Parallel()
+		_, err := GetIgnoreDifferences(spec, newProj(&argoappv1.ConfigMapKeyRef{ConfigMapName: "missing", Key: "ignoreDifferences"}), settingsMgr)
+		require.ErrorContains(t, err, `error getting ignore differences config map "missing" of project "default"`)
+	})
+
+	t.Run("MissingKey", func(t *testing.T) {
+		t.Parallel()
+		_, err := GetIgnoreDifferences(spec, newProj(&argoappv1.ConfigMapKeyRef{ConfigMapName: cm.Name, Key: "missing"}), settingsMgr)
+		require.EqualError(t, err, `key "missing" not found in ignore differences config map "platform-ignore-differences" of project "default"`)
+	})
+
+	t.Run("InvalidData", func(t *testing.T) {
+		t.Parallel()
+		_, err := GetIgnoreDifferences(spec, newProj(&argoappv1.ConfigMapKeyRef{ConfigMapName: cm.Name, Key: "invalid"}), settingsMgr)
+		require.ErrorContains(t, err, `error unmarshaling ignore differences of config map "platform-ignore-differences" of project "default"`)
+	})
+
+	t.Run("ManagedFieldsManagers", func(t *testing.T) {
+		t.Parallel()
+		proj := newProj(&argoappv1.ConfigMapKeyRef{ConfigMapName: cm.Name, Key: "ignoreDifferences"})
+		proj.Spec.ManagedFieldsManagers = []string{"kube-controller-manager", "vpa-recommender"}
+		ignoreDifferences, err := GetIgnoreDifferences(spec, proj, settingsMgr)
+		require.NoError(t, err)
+		assert.Equal(t, argoappv1.IgnoreDifferences{
+			{Group: "*", Kind: "*", ManagedFieldsManagers: []string{"kube-controller-manager", "vpa-recommender"}},
+			{Group: "autoscaling", Kind: "HorizontalPodAutoscaler", JSONPointers: []string{"/spec/replicas"}},
+			{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+		}, ignoreDifferences)
+
+		proj = newProj(nil)
+		proj.Spec.ManagedFieldsManagers = []string{"kube-controller-manager"}
+		ignoreDifferences, err = GetIgnoreDifferences(spec, proj, settingsMgr)
+		require.NoError(t, err)
+		assert.Equal(t, argoappv1.IgnoreDifferences{
+			{Group: "*", Kind: "*", ManagedFieldsManagers: []string{"kube-controller-manager"}},
+			{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+		}, ignoreDifferences)
+		assert.Len(t, spec.IgnoreDifferences, 1)
+	})
+}
+
 func TestIncludeResource(t *testing.T) {
 	t.Parallel()
 	// Resource filters format - GROUP:KIND:NAMESPACE/NAME or GROUP:KIND:NAME
@@ -921,6 +1012,108 @@ func TestValidatePermissions(t *testing.T) {
 		assert.Contains(t, conditions[0].Message, "application repo http://some/where is not permitted")
 	})
 
+	t.Run("Invalid sync schedule result in condition", func(t *testing.T) {
+		t.Parallel()
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{
+				Scheduled: &argoappv1.SyncPolicyScheduled{Schedule: "every night"},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "invalid scheduled sync policy")
+	})
+
+	t.Run("Invalid sync precondition result in condition", func(t *testing.T) {
+		t.Parallel()
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			SyncPolicy: &argoappv1.SyncPolicy{
+				Preconditions: []argoappv1.SyncPrecondition{{Expression: `cluster.labels.?maintenance.orValue("") != "true"`}, {Expression: `cluster.name ==`}},
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "invalid sync precondition 'cluster.name =='")
+	})
+
+	t.Run("Git write-back of image updates for Helm repository result in condition", func(t *testing.T) {
+		t.Parallel()
+		spec := argoappv1.ApplicationSpec{
+			Source: &argoappv1.ApplicationSource{
+				RepoURL:        "http://some/where",
+				Chart:          "somechart",
+				TargetRevision: "1.4.1",
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+			ImageUpdatePolicy: &argoappv1.ImageUpdatePolicy{
+				Images:    []argoappv1.ImageUpdate{{Image: "ghcr.io/example/app"}},
+				WriteBack: argoappv1.ImageUpdateWriteBackMethodGit,
+			},
+		}
+		proj := argoappv1.AppProject{
+			Spec: argoappv1.AppProjectSpec{
+				Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+				SourceRepos:  []string{"*"},
+			},
+		}
+		cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, spec.Destination.Server).Return(cluster, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "git write-back requires a single Git source")
+
+		spec.ImageUpdatePolicy.WriteBack = argoappv1.ImageUpdateWriteBackMethodSpec
+		conditions, err = ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
 	t.Run("Application destination is not permitted in project", func(t *testing.T) {
 		t.Parallel()
 		spec := argoappv1.ApplicationSpec{
@@ -1160,12 +1353,63 @@ func TestValidatePermissions_SourceHydratorSyncSourceRepo(t *testing.T) {
 	})
 }
 
+func TestValidateDestinationServiceAccount(t *testing.T) {
+	t.Parallel()
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			DestinationServiceAccounts: []argoappv1.ApplicationDestinationServiceAccount{{
+				Server:                 "*",
+				Namespace:              "default",
+				DefaultServiceAccount:  "default-sa",
+				AllowedServiceAccounts: []string{"deployer-*"},
+			}},
+		},
+	}
+	cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+	newApp := func(serviceAccount string) *argoappv1.Application {
+		return &argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+			Spec: argoappv1.ApplicationSpec{
+				Destination: argoappv1.ApplicationDestination{
+					Server:         "https://127.0.0.1:6443",
+					Namespace:      "default",
+					ServiceAccount: serviceAccount,
+				},
+			},
+		}
+	}
+
+	t.Run("No service account", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		assert.Empty(t, ValidateDestinationServiceAccount(t.Context(), newApp(""), proj, db))
+	})
+
+	t.Run("Allowed service account", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, cluster.Server).Return(cluster, nil).Maybe()
+		assert.Empty(t, ValidateDestinationServiceAccount(t.Context(), newApp("deployer-web"), proj, db))
+	})
+
+	t.Run("Service account not permitted", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, cluster.Server).Return(cluster, nil).Maybe()
+		conditions := ValidateDestinationServiceAccount(t.Context(), newApp("admin-sa"), proj, db)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "service account 'admin-sa' is not permitted")
+	})
+}
+
 func TestSetAppOperations(t *testing.T) {
 	t.Parallel()
 	t.Run("Application not existing", func(t *testing.T) {
 		t.Parallel()
 		appIf := appclientset.NewSimpleClientset().ArgoprojV1alpha1().Applications("default")
-		app, err := SetAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+		app, err := SetAppOperation(t.Context(), appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
 		require.Error(t, err)
 		assert.Nil(t, app)
 	})
@@ -1180,7 +1424,7 @@ func TestSetAppOperations(t *testing.T) {
 			Operation: &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}},
 		}
 		appIf := appclientset.NewSimpleClientset(&a).ArgoprojV1alpha1().Applications("default")
-		app, err := SetAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+		app, err := SetAppOperation(t.Context(), appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
 		require.ErrorContains(t, err, "operation is already in progress")
 		assert.Nil(t, app)
 	})
@@ -1194,7 +1438,7 @@ func TestSetAppOperations(t *testing.T) {
 			},
 		}
 		appIf := appclientset.NewSimpleClientset(&a).ArgoprojV1alpha1().Applications("default")
-		app, err := SetAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: nil})
+		app, err := SetAppOperation(t.Context(), appIf, "someapp", &argoappv1.Operation{Sync: nil})
 		require.ErrorContains(t, err, "Operation unspecified")
 		assert.Nil(t, app)
 	})
@@ -1208,10 +1452,25 @@ func TestSetAppOperations(t *testing.T) {
 			},
 		}
 		appIf := appclientset.NewSimpleClientset(&a).ArgoprojV1alpha1().Applications("default")
-		app, err := SetAppOperation(appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+		app, err := SetAppOperation(t.Context(), appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
 		require.NoError(t, err)
 		assert.NotNil(t, app)
 	})
+
+	t.Run("Correlation ID of the request", func(t *testing.T) {
+		t.Parallel()
+		a := argoappv1.Application{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "someapp",
+				Namespace: "default",
+			},
+		}
+		appIf := appclientset.NewSimpleClientset(&a).ArgoprojV1alpha1().Applications("default")
+		ctx := logutils.ContextWithCorrelationID(t.Context(), "request-id")
+		app, err := SetAppOperation(ctx, appIf, "someapp", &argoappv1.Operation{Sync: &argoappv1.SyncOperation{Revision: "aaa"}})
+		require.NoError(t, err)
+		assert.Equal(t, "request-id", app.Operation.CorrelationID)
+	})
 }
 
 func TestGetDestinationCluster(t *testing.T) {
@@ -1854,6 +2113,75 @@ func Test_GetRefSources(t *testing.T) {
 	})
 }
 
+func TestValidatePermissionsMultipleDestinations(t *testing.T) {
+	t.Parallel()
+	newSpec := func(destinations ...argoappv1.ApplicationDestination) *argoappv1.ApplicationSpec {
+		return &argoappv1.ApplicationSpec{
+			Source:       &argoappv1.ApplicationSource{RepoURL: "http://some/where", Path: "."},
+			Destinations: destinations,
+		}
+	}
+	proj := &argoappv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: argoappv1.AppProjectSpec{
+			SourceRepos:  []string{"*"},
+			Destinations: []argoappv1.ApplicationDestination{{Server: "https://127.0.0.1:6443", Namespace: "team-*"}},
+		},
+	}
+	cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+
+	t.Run("All destinations are permitted", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, cluster.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), newSpec(
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "team-a"},
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "team-b"},
+		), proj, db)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+
+	t.Run("One of the destinations is not permitted", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, cluster.Server).Return(cluster, nil)
+		db.EXPECT().GetProjectClusters(mock.Anything, "default").Return(nil, nil).Maybe()
+		conditions, err := ValidatePermissions(t.Context(), newSpec(
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "team-a"},
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "kube-system"},
+		), proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "application destination server 'https://127.0.0.1:6443' and namespace 'kube-system' do not match any of the allowed destinations in project 'default'", conditions[0].Message)
+	})
+
+	t.Run("Duplicated destination", func(t *testing.T) {
+		t.Parallel()
+		db := &dbmocks.ArgoDB{}
+		db.EXPECT().GetCluster(mock.Anything, cluster.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), newSpec(
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "team-a"},
+			argoappv1.ApplicationDestination{Server: cluster.Server, Namespace: "team-a"},
+		), proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "application destination server 'https://127.0.0.1:6443' and namespace 'team-a' is listed more than once", conditions[0].Message)
+	})
+}
+
+func TestNormalizeApplicationSpecMultipleDestinations(t *testing.T) {
+	t.Parallel()
+	spec := NormalizeApplicationSpec(&argoappv1.ApplicationSpec{
+		Destination: argoappv1.ApplicationDestination{Server: "https://stale", Namespace: "stale"},
+		Destinations: []argoappv1.ApplicationDestination{
+			{Server: "https://127.0.0.1:6443", Namespace: "team-a"},
+			{Server: "https://127.0.0.1:6443", Namespace: "team-b"},
+		},
+	})
+	assert.Equal(t, spec.Destinations[0], spec.Destination)
+}
+
 func TestValidatePermissionsMultipleSources(t *testing.T) {
 	t.Parallel()
 	t.Run("Empty Repo URL result in condition", func(t *testing.T) {
@@ -27,6 +27,9 @@ type AuditLogger struct {
 type EventInfo struct {
 	Type   string
 	Reason string
+	// Annotations holds structured details of the event, such as the sync revision or the affected resource, which are
+	// added to the annotations of the created event.
+	Annotations map[string]string
 }
 
 type ObjectRef struct {
@@ -44,13 +47,62 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+
+	// Reasons of the events emitted by the application controller during the sync of an application
+	EventReasonSyncStarted         = "SyncStarted"
+	EventReasonSyncSucceeded       = "SyncSucceeded"
+	EventReasonSyncFailed          = "SyncFailed"
+	EventReasonHookFailed          = "HookFailed"
+	EventReasonPruneSkipped        = "PruneSkipped"
+	EventReasonResourceSyncFailed  = "ResourceSyncFailed"
+	EventReasonSyncStatusChanged   = "SyncStatusChanged"
+	EventReasonHealthStatusChanged = "HealthStatusChanged"
+	EventReasonSyncThrottled       = "SyncThrottled"
+
+	// EventReasonImageUpdated is the reason of the events emitted when the image update policy of an application
+	// updated its images
+	EventReasonImageUpdated = "ImageUpdated"
+)
+
+// Annotations of the events emitted by the application controller during the sync of an application
+const (
+	EventAnnotationRevision       = "revision"
+	EventAnnotationOperationPhase = "operation-phase"
+	EventAnnotationInitiatedBy    = "initiated-by"
+	EventAnnotationResource       = "resource"
+	EventAnnotationSyncPhase      = "sync-phase"
+	EventAnnotationSyncWave       = "sync-wave"
+	EventAnnotationHookType       = "hook-type"
+	EventAnnotationPreviousStatus = "previous-status"
+	EventAnnotationStatus         = "status"
 )
 
+// legacyEventReasons maps the sync event reasons to the more general reasons they were previously reported with, so
+// that the events remain enabled when only the general reason is listed in the enabled events.
+var legacyEventReasons = map[string]string{
+	EventReasonSyncStarted:         EventReasonOperationStarted,
+	EventReasonSyncSucceeded:       EventReasonOperationCompleted,
+	EventReasonSyncFailed:          EventReasonOperationCompleted,
+	EventReasonHookFailed:          EventReasonOperationCompleted,
+	EventReasonPruneSkipped:        EventReasonOperationCompleted,
+	EventReasonResourceSyncFailed:  EventReasonOperationCompleted,
+	EventReasonSyncStatusChanged:   EventReasonResourceUpdated,
+	EventReasonHealthStatusChanged: EventReasonResourceUpdated,
+}
+
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string, eventLabels map[string]string) {
 	logCtx := log.WithFields(log.Fields{
 		"type":   info.Type,
 		"reason": info.Reason,
 	})
+	if len(info.Annotations) > 0 && logFields == nil {
+		logFields = make(map[string]string)
+	}
+	for field, val := range info.Annotations {
+		if _, ok := logFields[field]; !ok {
+			logFields[field] = val
+		}
+	}
 	for field, val := range logFields {
 		logCtx = logCtx.WithField(field, val)
 	}
@@ -113,7 +165,11 @@ func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, i
 }
 
 func (l *AuditLogger) enableK8SEventLog(info EventInfo) bool {
-	return l.enableEventLog["all"] || l.enableEventLog[info.Reason]
+	if l.enableEventLog["all"] || l.enableEventLog[info.Reason] {
+		return true
+	}
+	legacyReason, ok := legacyEventReasons[info.Reason]
+	return ok && l.enableEventLog[legacyReason]
 }
 
 func (l *AuditLogger) LogAppEvent(app *v1alpha1.Application, info EventInfo, message, user string, eventLabels map[string]string) {
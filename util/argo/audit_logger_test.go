@@ -169,6 +169,56 @@ func TestLogAppEvent(t *testing.T) {
 	assert.Empty(t, output)
 }
 
+func TestLogAppEvent_Annotations(t *testing.T) {
+	t.Parallel()
+	fakeClient := fake.NewClientset()
+	logger := NewAuditLogger(fakeClient, _argocdNs, _somecomponent, []string{EventReasonOperationCompleted})
+
+	app := argoappv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testapp",
+			Namespace: _argocdNs,
+		},
+		Spec: argoappv1.ApplicationSpec{
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		},
+	}
+
+	ei := EventInfo{
+		Reason: EventReasonHookFailed,
+		Type:   corev1.EventTypeWarning,
+		Annotations: map[string]string{
+			EventAnnotationResource: "batch/Job/testns/migrate",
+			EventAnnotationSyncWave: "-1",
+			"dest-server":           "https://overridden",
+		},
+	}
+	output := captureLogEntries(func() {
+		logger.LogAppEvent(&app, ei, "PreSync hook batch/Job/testns/migrate failed", "", nil)
+	})
+	assert.Contains(t, output, "sync-wave=-1")
+
+	// The sync event reasons are enabled by the reason they were previously reported with
+	events, err := fakeClient.CoreV1().Events(_argocdNs).List(t.Context(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, events.Items, 1)
+
+	event := events.Items[0]
+	assert.Equal(t, EventReasonHookFailed, event.Reason)
+	assert.Equal(t, "batch/Job/testns/migrate", event.Annotations[EventAnnotationResource])
+	assert.Equal(t, "-1", event.Annotations[EventAnnotationSyncWave])
+	assert.Equal(t, "https://127.0.0.1:6443", event.Annotations["dest-server"])
+
+	ei.Reason = EventReasonSyncStatusChanged
+	output = captureLogEntries(func() {
+		logger.LogAppEvent(&app, ei, "Updated sync status: Synced -> OutOfSync", "", nil)
+	})
+	assert.Empty(t, output)
+}
+
 func TestLogResourceEvent(t *testing.T) {
 	t.Parallel()
 	logger := NewAuditLogger(fake.NewClientset(), _argocdNs, _somecomponent, testEnableEventLog)
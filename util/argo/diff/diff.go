@@ -54,6 +54,12 @@ func (b *DiffConfigBuilder) WithDiffSettings(id []v1alpha1.ResourceIgnoreDiffere
 	return b
 }
 
+// WithDriftExclusions sets the drift exclusions in the diff config.
+func (b *DiffConfigBuilder) WithDriftExclusions(exclusions []v1alpha1.DriftExclusion) *DiffConfigBuilder {
+	b.diffConfig.driftExclusions = exclusions
+	return b
+}
+
 // WithTrackingMethod sets the tracking in the diff config.
 func (b *DiffConfigBuilder) WithTracking(appLabelKey, trackingMethod string) *DiffConfigBuilder {
 	b.diffConfig.appLabelKey = appLabelKey
@@ -167,6 +173,9 @@ type DiffConfig interface {
 	IgnoreMutationWebhook() bool
 
 	IgnoreNormalizerOpts() normalizers.IgnoreNormalizerOpts
+	// DriftExclusions returns the drift exclusions whose CEL expressions are evaluated
+	// for the modified resources.
+	DriftExclusions() []v1alpha1.DriftExclusion
 }
 
 // diffConfig defines the configurations used while applying diffs.
@@ -187,6 +196,7 @@ type diffConfig struct {
 	serverSideDryRunner   diff.ServerSideDryRunner
 	ignoreMutationWebhook bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	driftExclusions       []v1alpha1.DriftExclusion
 }
 
 func (c *diffConfig) Ignores() []v1alpha1.ResourceIgnoreDifferences {
@@ -253,6 +263,10 @@ func (c *diffConfig) IgnoreNormalizerOpts() normalizers.IgnoreNormalizerOpts {
 	return c.ignoreNormalizerOpts
 }
 
+func (c *diffConfig) DriftExclusions() []v1alpha1.DriftExclusion {
+	return c.driftExclusions
+}
+
 // Validate will check the current state of this diffConfig and return
 // error if it finds any required configuration missing.
 func (c *diffConfig) Validate() error {
@@ -309,6 +323,8 @@ func StateDiffs(ctx context.Context, lives, configs []*unstructured.Unstructured
 		return nil, fmt.Errorf("failed to create diff normalizer: %w", err)
 	}
 
+	driftExclusions := compileDriftExclusions(diffConfig.DriftExclusions())
+
 	diffOpts := []diff.Option{
 		diff.WithNormalizer(diffNormalizer),
 		diff.IgnoreAggregatedRoles(diffConfig.IgnoreAggregatedRoles()),
@@ -330,12 +346,14 @@ func StateDiffs(ctx context.Context, lives, configs []*unstructured.Unstructured
 		if err != nil {
 			return nil, fmt.Errorf("failed to calculate diff from cache: %w", err)
 		}
+		excludeDrift(cached, lives, configs, driftExclusions)
 		return cached, nil
 	}
 	array, err := diff.DiffArray(ctx, normResults.Targets, normResults.Lives, diffOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate diff: %w", err)
 	}
+	excludeDrift(array, lives, configs, driftExclusions)
 	return array, nil
 }
 
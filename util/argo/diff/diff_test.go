@@ -156,6 +156,73 @@ func TestStateDiff(t *testing.T) {
 	}
 }
 
+func TestStateDiff_DriftExclusions(t *testing.T) {
+	t.Parallel()
+	stateDiff := func(t *testing.T, exclusions ...v1alpha1.DriftExclusion) (bool, error) {
+		t.Helper()
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, true, normalizers.IgnoreNormalizerOpts{}).
+			WithDriftExclusions(exclusions).
+			WithNoCache().
+			Build()
+		require.NoError(t, err)
+		live := testutil.YamlToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+		desired := testutil.YamlToUnstructured(testdata.DesiredDeploymentYaml)
+		result, err := argo.StateDiff(t.Context(), live, desired, diffConfig)
+		return result.Modified, err
+	}
+
+	t.Run("Excluded", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t, v1alpha1.DriftExclusion{Group: "apps", Kind: "Deployment", Expression: "live.spec.replicas < desired.spec.replicas"})
+		require.NoError(t, err)
+		assert.False(t, modified)
+	})
+
+	t.Run("ExcludedWithMacros", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t, v1alpha1.DriftExclusion{
+			Group:      "apps",
+			Kind:       "Deployment",
+			Expression: "live.spec.template.spec.containers.filter(c, c.name != 'istio-proxy').map(c, c.image) == desired.spec.template.spec.containers.map(c, c.image)",
+		})
+		require.NoError(t, err)
+		assert.False(t, modified)
+	})
+
+	t.Run("ExpressionIsFalse", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t, v1alpha1.DriftExclusion{Group: "*", Kind: "*", Expression: "live.spec.replicas == desired.spec.replicas"})
+		require.NoError(t, err)
+		assert.True(t, modified)
+	})
+
+	t.Run("KindDoesNotMatch", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t, v1alpha1.DriftExclusion{Group: "apps", Kind: "StatefulSet", Expression: "true"})
+		require.NoError(t, err)
+		assert.True(t, modified)
+	})
+
+	t.Run("InvalidExpressionIsSkipped", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t,
+			v1alpha1.DriftExclusion{Kind: "*", Expression: "live.spec.replicas >"},
+			v1alpha1.DriftExclusion{Kind: "*", Expression: "1 + 1"},
+			v1alpha1.DriftExclusion{Group: "apps", Kind: "Deployment", Expression: "live.spec.replicas < desired.spec.replicas"},
+		)
+		require.NoError(t, err)
+		assert.False(t, modified)
+	})
+
+	t.Run("EvaluationErrorDoesNotMatch", func(t *testing.T) {
+		t.Parallel()
+		modified, err := stateDiff(t, v1alpha1.DriftExclusion{Group: "apps", Kind: "*", Expression: "live.spec.missing"})
+		require.NoError(t, err)
+		assert.True(t, modified)
+	})
+}
+
 func TestDiffConfigBuilder(t *testing.T) {
 	t.Parallel()
 	type fixture struct {
@@ -0,0 +1,69 @@
+package diff
+
+import (
+	"github.com/google/cel-go/cel"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	celutil "github.com/argoproj/argo-cd/v3/util/cel"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+)
+
+// driftExclusion is a drift exclusion with a compiled expression
+type driftExclusion struct {
+	v1alpha1.DriftExclusion
+	program cel.Program
+}
+
+// compileDriftExclusions compiles the given drift exclusions. The invalid ones are logged and skipped, so that a single
+// invalid entry does not prevent the comparison of the application.
+func compileDriftExclusions(exclusions []v1alpha1.DriftExclusion) []driftExclusion {
+	compiled := make([]driftExclusion, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		program, err := settings.CompileDriftExclusion(exclusion)
+		if err != nil {
+			log.Warnf("Skipping invalid drift exclusion for %s/%s: %v", exclusion.Group, exclusion.Kind, err)
+			continue
+		}
+		compiled = append(compiled, driftExclusion{DriftExclusion: exclusion, program: program})
+	}
+	return compiled
+}
+
+// matches returns whether the drift exclusion applies to the given resource. An expression which fails to evaluate is
+// logged and considered not to match.
+func (e *driftExclusion) matches(live, desired *unstructured.Unstructured) bool {
+	gvk := desired.GroupVersionKind()
+	if !glob.Match(e.Group, gvk.Group) || !glob.Match(e.Kind, gvk.Kind) {
+		return false
+	}
+	excluded, err := celutil.EvalBool(e.program, map[string]any{"live": live.Object, "desired": desired.Object})
+	if err != nil {
+		log.Warnf("Error evaluating drift exclusion expression %q for %s %s: %v", e.Expression, gvk.Kind, desired.GetName(), err)
+		return false
+	}
+	return excluded
+}
+
+// excludeDrift marks the modified resources for which a drift exclusion matches as not modified
+func excludeDrift(results *diff.DiffResultList, lives, configs []*unstructured.Unstructured, exclusions []driftExclusion) {
+	if len(exclusions) == 0 {
+		return
+	}
+	results.Modified = false
+	for i := range results.Diffs {
+		if results.Diffs[i].Modified && lives[i] != nil && configs[i] != nil {
+			for j := range exclusions {
+				if exclusions[j].matches(lives[i], configs[i]) {
+					results.Diffs[i].Modified = false
+					break
+				}
+			}
+		}
+		results.Modified = results.Modified || results.Diffs[i].Modified
+	}
+}
@@ -13,6 +13,10 @@ const (
 	AKSPASS_SOCKET_PATH_ENV = "ARGOCD_ASK_PASS_SOCK"
 	// CommitServerSocketPath is the path to the socket used by the commit server to communicate with the askpass server
 	CommitServerSocketPath = "/tmp/commit-server-ask-pass.sock"
+	// APIServerSocketPath is the path to the socket used by the API server to communicate with the askpass server
+	APIServerSocketPath = "/tmp/api-server-ask-pass.sock"
+	// ControllerSocketPath is the path to the socket used by the application controller to communicate with the askpass server
+	ControllerSocketPath = "/tmp/controller-ask-pass.sock"
 )
 
 func init() {
@@ -2,6 +2,7 @@ package appstate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"time"
@@ -16,6 +17,10 @@ import (
 var (
 	ErrCacheMiss  = cacheutil.ErrCacheMiss
 	treeShardSize = env.ParseInt64FromEnv("ARGOCD_APPLICATION_TREE_SHARD_SIZE", 0, 0, 1000)
+	// metricsHistorySize is the number of reconciliation and sync samples kept per application. Zero disables the history.
+	metricsHistorySize = env.ParseNumFromEnv("ARGOCD_APPLICATION_METRICS_HISTORY_SIZE", 0, 0, 1000)
+	// metricsHistoryExpiration is how long the history of an application is kept after its last sample
+	metricsHistoryExpiration = env.ParseDurationFromEnv("ARGOCD_APPLICATION_METRICS_HISTORY_EXPIRATION", 7*24*time.Hour, 0, 90*24*time.Hour)
 )
 
 const (
@@ -129,3 +134,59 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func appReconciliationHistoryKey(appName string) string {
+	return "app|reconciliation-history|" + appName
+}
+
+func appSyncHistoryKey(appName string) string {
+	return "app|sync-history|" + appName
+}
+
+// appendToHistory appends the sample to the history stored under the given key and drops the oldest samples
+// exceeding the configured history size. Reconciliation and sync samples are kept under separate keys since they
+// are recorded by different workers of the controller.
+func appendToHistory[T any](c *Cache, key string, sample T) error {
+	if metricsHistorySize == 0 {
+		return nil
+	}
+	var samples []T
+	if err := c.GetItem(key, &samples); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+	samples = append(samples, sample)
+	if len(samples) > metricsHistorySize {
+		samples = samples[len(samples)-metricsHistorySize:]
+	}
+	return c.SetItem(key, samples, metricsHistoryExpiration, false)
+}
+
+// AddAppReconciliationSample records a reconciliation of the application in its metrics history
+func (c *Cache) AddAppReconciliationSample(appName string, sample appv1.ReconciliationSample) error {
+	return appendToHistory(c, appReconciliationHistoryKey(appName), sample)
+}
+
+// AddAppSyncSample records a completed sync operation of the application in its metrics history
+func (c *Cache) AddAppSyncSample(appName string, sample appv1.SyncSample) error {
+	return appendToHistory(c, appSyncHistoryKey(appName), sample)
+}
+
+// GetAppMetricsHistory returns the metrics history of the application. An empty history is returned if no samples
+// have been recorded.
+func (c *Cache) GetAppMetricsHistory(appName string, res *appv1.ApplicationMetricsHistory) error {
+	if err := c.GetItem(appReconciliationHistoryKey(appName), &res.Reconciliations); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+	if err := c.GetItem(appSyncHistoryKey(appName), &res.Syncs); err != nil && !errors.Is(err, ErrCacheMiss) {
+		return err
+	}
+	return nil
+}
+
+// DeleteAppMetricsHistory removes the metrics history of the application
+func (c *Cache) DeleteAppMetricsHistory(appName string) error {
+	if err := c.SetItem(appReconciliationHistoryKey(appName), []appv1.ReconciliationSample{}, metricsHistoryExpiration, true); err != nil {
+		return err
+	}
+	return c.SetItem(appSyncHistoryKey(appName), []appv1.SyncSample{}, metricsHistoryExpiration, true)
+}
@@ -86,3 +86,37 @@ func TestAddCacheFlagsToCmd(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1*time.Hour, cache.appStateCacheExpiration)
 }
+
+func TestCache_AppMetricsHistory(t *testing.T) {
+	cache := newFixtures().Cache
+	defer func(size int) { metricsHistorySize = size }(metricsHistorySize)
+
+	// history is disabled by default
+	require.NoError(t, cache.AddAppReconciliationSample("my-appname", ReconciliationSample{DurationMs: 1}))
+	history := &ApplicationMetricsHistory{}
+	require.NoError(t, cache.GetAppMetricsHistory("my-appname", history))
+	assert.Empty(t, history.Reconciliations)
+
+	metricsHistorySize = 2
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, cache.AddAppReconciliationSample("my-appname", ReconciliationSample{DurationMs: i, OutOfSyncResources: i}))
+	}
+	require.NoError(t, cache.AddAppSyncSample("my-appname", SyncSample{DurationMs: 10, Phase: "Succeeded"}))
+
+	history = &ApplicationMetricsHistory{}
+	require.NoError(t, cache.GetAppMetricsHistory("my-appname", history))
+	// only the most recent samples are kept, oldest first
+	assert.Equal(t, []ReconciliationSample{{DurationMs: 2, OutOfSyncResources: 2}, {DurationMs: 3, OutOfSyncResources: 3}}, history.Reconciliations)
+	assert.Equal(t, []SyncSample{{DurationMs: 10, Phase: "Succeeded"}}, history.Syncs)
+
+	history = &ApplicationMetricsHistory{}
+	require.NoError(t, cache.GetAppMetricsHistory("other-appname", history))
+	assert.Empty(t, history.Reconciliations)
+	assert.Empty(t, history.Syncs)
+
+	require.NoError(t, cache.DeleteAppMetricsHistory("my-appname"))
+	history = &ApplicationMetricsHistory{}
+	require.NoError(t, cache.GetAppMetricsHistory("my-appname", history))
+	assert.Empty(t, history.Reconciliations)
+	assert.Empty(t, history.Syncs)
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -38,13 +40,22 @@ const (
 	envRedisCredsDirPath = "REDIS_CREDS_DIR_PATH"
 )
 
+const (
+	// CacheBackendRedis stores the cached data in Redis
+	CacheBackendRedis = "redis"
+	// CacheBackendMemory stores the cached data in the memory of the process, optionally replicated to peers
+	CacheBackendMemory = "memory"
+	// envCachePeerToken is an env variable name which stores the token authenticating the cache peers
+	envCachePeerToken = "ARGOCD_CACHE_PEER_TOKEN"
+)
+
 const (
 	// CLIFlagRedisCompress is a cli flag name to define the redis compression setting for data sent to redis
 	CLIFlagRedisCompress = "redis-compress"
 )
 
 func NewCache(client CacheClient) *Cache {
-	return &Cache{client}
+	return &Cache{client: client, schemaMigration: schemaMigrationFromEnv()}
 }
 
 func buildRedisClient(redisAddress, password, username string, redisDB, maxRetries int, tlsConfig *tls.Config) *redis.Client {
@@ -219,6 +230,12 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 	redisUseTLS := false
 	insecureRedis := false
 	compressionStr := ""
+	cacheBackend := ""
+	cachePeers := make([]string, 0)
+	cachePeerListenAddress := ""
+	cachePeerTLSCertificate := ""
+	cachePeerTLSKey := ""
+	cachePeerCACertificate := ""
 	opt := mergeOptions(opts...)
 	var defaultCacheExpiration time.Duration
 
@@ -262,12 +279,37 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 	redisCACertificateSrc := getFlagVal(cmd, opt, "redis-ca-certificate", cmd.Flags().GetString)
 	cmd.Flags().StringVar(&compressionStr, opt.FlagPrefix+CLIFlagRedisCompress, env.StringFromEnv(opt.getEnvPrefix()+"REDIS_COMPRESSION", string(RedisCompressionGZip)), "Enable compression for data sent to Redis with the required compression algorithm. (possible values: gzip, none)")
 	compressionStrSrc := getFlagVal(cmd, opt, CLIFlagRedisCompress, cmd.Flags().GetString)
+	cmd.Flags().StringVar(&cacheBackend, opt.FlagPrefix+"cache-backend", env.StringFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_BACKEND", CacheBackendRedis), "Cache backend. (possible values: redis, memory)")
+	cacheBackendSrc := getFlagVal(cmd, opt, "cache-backend", cmd.Flags().GetString)
+	cmd.Flags().StringArrayVar(&cachePeers, opt.FlagPrefix+"cache-peers", env.StringsFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_PEERS", []string{}, ","), "URLs of the peers the memory cache backend replicates its updates to (e.g. https://argocd-repo-server-1.argocd-repo-server:8085).")
+	cmd.Flags().StringVar(&cachePeerListenAddress, opt.FlagPrefix+"cache-peer-listen-address", env.StringFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_PEER_LISTEN_ADDRESS", ""), "Address on which the memory cache backend receives the updates of its peers (e.g. :8090).")
+	cmd.Flags().StringVar(&cachePeerTLSCertificate, opt.FlagPrefix+"cache-peer-tls-certificate", env.StringFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_PEER_TLS_CERTIFICATE", ""), "Path to the certificate served to the cache peers (e.g. /etc/certs/cache-peer/tls.crt). If not specified, the updates of the peers are received over plain HTTP.")
+	cmd.Flags().StringVar(&cachePeerTLSKey, opt.FlagPrefix+"cache-peer-tls-key", env.StringFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_PEER_TLS_KEY", ""), "Path to the key of the certificate served to the cache peers (e.g. /etc/certs/cache-peer/tls.key).")
+	cmd.Flags().StringVar(&cachePeerCACertificate, opt.FlagPrefix+"cache-peer-ca-certificate", env.StringFromEnv("ARGOCD_"+opt.getEnvPrefix()+"CACHE_PEER_CA_CERTIFICATE", ""), "Path to the CA certificate of the cache peers served over HTTPS (e.g. /etc/certs/cache-peer/ca.crt). If not specified, system trusted CAs will be used for peer certificate validation.")
 	return func() (*Cache, error) {
+		defaultCacheExpiration := defaultCacheExpirationSrc()
+		switch cacheBackend := cacheBackendSrc(); cacheBackend {
+		case CacheBackendRedis:
+		case CacheBackendMemory:
+			// the replication to the peers stops with the command, e.g. on shutdown of the component
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+			return newPeerCacheFromFlags(ctx, defaultCacheExpiration, peerCacheConfig{
+				peers:          cachePeers,
+				listenAddress:  cachePeerListenAddress,
+				tlsCertificate: cachePeerTLSCertificate,
+				tlsKey:         cachePeerTLSKey,
+				caCertificate:  cachePeerCACertificate,
+			})
+		default:
+			return nil, fmt.Errorf("unsupported cache backend %q (possible values: %s, %s)", cacheBackend, CacheBackendRedis, CacheBackendMemory)
+		}
 		redisAddress := redisAddressSrc()
 		redisDB := redisDBSrc()
 		sentinelAddresses := sentinelAddressesSrc()
 		sentinelMaster := sentinelMasterSrc()
-		defaultCacheExpiration := defaultCacheExpirationSrc()
 		redisUseTLS := redisUseTLSSrc()
 		redisClientCertificate := redisClientCertificateSrc()
 		redisClientKey := redisClientKeySrc()
@@ -333,9 +375,95 @@ func AddCacheFlagsToCmd(cmd *cobra.Command, opts ...Options) func() (*Cache, err
 	}
 }
 
+// peerCacheConfig holds the flags configuring the replication of the memory cache to peers
+type peerCacheConfig struct {
+	// peers are the URLs of the peers the updates are sent to
+	peers []string
+	// listenAddress is the address on which the updates of the peers are received
+	listenAddress string
+	// tlsCertificate and tlsKey are the paths of the certificate served to the peers
+	tlsCertificate string
+	tlsKey         string
+	// caCertificate is the path of the CA certificate of the peers
+	caCertificate string
+}
+
+// newPeerCacheFromFlags returns a cache backed by an in-memory cache which replicates its updates to the configured peers
+// and receives their updates on the configured address, until the given context is done
+func newPeerCacheFromFlags(ctx context.Context, expiration time.Duration, cfg peerCacheConfig) (*Cache, error) {
+	token := os.Getenv(envCachePeerToken)
+	if token == "" && (len(cfg.peers) > 0 || cfg.listenAddress != "") {
+		return nil, fmt.Errorf("%s must be set to replicate the memory cache to peers", envCachePeerToken)
+	}
+	if (cfg.tlsCertificate == "") != (cfg.tlsKey == "") {
+		return nil, errors.New("both the certificate and the key served to the cache peers must be set")
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.caCertificate != "" {
+		peerCA, err := certutil.ParseTLSCertificatesFromPath(cfg.caCertificate)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = certutil.GetCertPoolFromPEMData(peerCA)
+	}
+	for _, peer := range cfg.peers {
+		if !strings.HasPrefix(peer, "https://") {
+			log.WithField("peer", peer).Warn("Cache peer is not served over HTTPS, its token and updates are sent in plain text")
+		}
+	}
+	client := NewPeerCache(expiration, cfg.peers, token, tlsConfig)
+	if cfg.listenAddress != "" {
+		mux := http.NewServeMux()
+		mux.Handle(PeerUpdatesPath, client)
+		server := &http.Server{Addr: cfg.listenAddress, Handler: mux, ReadHeaderTimeout: peerRequestTimeout}
+		if cfg.tlsCertificate != "" {
+			cert, err := tls.LoadX509KeyPair(cfg.tlsCertificate, cfg.tlsKey)
+			if err != nil {
+				return nil, fmt.Errorf("error loading the certificate served to the cache peers: %w", err)
+			}
+			server.TLSConfig = &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+		} else {
+			log.Warn("Cache peer listener is not served over HTTPS, the token and updates of the peers are received in plain text")
+		}
+		ln, err := (&net.ListenConfig{}).Listen(ctx, "tcp", cfg.listenAddress)
+		if err != nil {
+			return nil, fmt.Errorf("error listening for cache peer updates: %w", err)
+		}
+		go servePeerUpdates(ctx, server, ln)
+	}
+	go client.Run(ctx)
+	return NewCache(client), nil
+}
+
+// servePeerUpdates serves the updates of the peers on the given listener until the given context is done
+func servePeerUpdates(ctx context.Context, server *http.Server, ln net.Listener) {
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), peerRequestTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Warnf("Failed to shut down cache peer listener: %v", err)
+		}
+	}()
+	log.Infof("Receiving cache peer updates on %s", ln.Addr())
+	var err error
+	if server.TLSConfig != nil {
+		err = server.ServeTLS(ln, "", "")
+	} else {
+		err = server.Serve(ln)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Errorf("Cache peer listener stopped: %v", err)
+	}
+}
+
 // Cache provides strongly types methods to store and retrieve values from shared cache
 type Cache struct {
 	client CacheClient
+	// schemas holds the registered key schemas by key prefix
+	schemas map[string]KeySchema
+	// schemaMigration enables reading and writing values stored using previous versions of the key schemas
+	schemaMigration bool
 }
 
 func (c *Cache) GetClient() CacheClient {
@@ -347,14 +475,14 @@ func (c *Cache) SetClient(client CacheClient) {
 }
 
 func (c *Cache) RenameItem(oldKey string, newKey string, expiration time.Duration) error {
-	return c.client.Rename(fmt.Sprintf("%s|%s", oldKey, common.CacheVersion), fmt.Sprintf("%s|%s", newKey, common.CacheVersion), expiration)
+	return c.client.Rename(c.generateFullKey(oldKey), c.generateFullKey(newKey), expiration)
 }
 
 func (c *Cache) generateFullKey(key string) string {
 	if key == "" {
 		log.Debug("Cache key is empty, this will result in key collisions if there is more than one empty key")
 	}
-	return fmt.Sprintf("%s|%s", key, common.CacheVersion)
+	return fmt.Sprintf("%s|%s", key, c.keySchema(key).Version)
 }
 
 // Sets or deletes an item in cache
@@ -368,18 +496,33 @@ func (c *Cache) SetItem(key string, item any, opts *CacheActionOpts) error {
 	fullKey := c.generateFullKey(key)
 	client := c.GetClient()
 	if opts.Delete {
+		// values stored using previous schema versions are deleted too, so that they are not migrated back
+		for _, version := range c.previousVersions(c.keySchema(key)) {
+			if err := client.Delete(fmt.Sprintf("%s|%s", key, version)); err != nil {
+				return err
+			}
+		}
 		return client.Delete(fullKey)
 	}
+	for _, dualWriteKey := range c.dualWriteKeys(key) {
+		if err := client.Set(&Item{Key: dualWriteKey, Object: item, CacheActionOpts: *opts}); err != nil {
+			return err
+		}
+	}
 	return client.Set(&Item{Key: fullKey, Object: item, CacheActionOpts: *opts})
 }
 
 func (c *Cache) GetItem(key string, item any) error {
-	key = c.generateFullKey(key)
+	fullKey := c.generateFullKey(key)
 	if item == nil {
-		return fmt.Errorf("cannot get item into a nil for key %s", key)
+		return fmt.Errorf("cannot get item into a nil for key %s", fullKey)
 	}
 	client := c.GetClient()
-	return client.Get(key, item)
+	err := client.Get(fullKey, item)
+	if errors.Is(err, ErrCacheMiss) {
+		return c.getPreviousVersionItem(key, item)
+	}
+	return err
 }
 
 func (c *Cache) OnUpdated(ctx context.Context, key string, callback func() error) error {
@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// exportFormatVersion is the version of the format written by Cache.Export
+const exportFormatVersion = "v1"
+
+// ExportedItem is an item of the cache copied verbatim, so that it can be imported into another cache which uses the
+// same key prefix layout and compression
+type ExportedItem struct {
+	// Key is the key of the item as stored by the cache client, without the configured key prefix
+	Key string `json:"key"`
+	// Value is the encoded value of the item
+	Value []byte `json:"value"`
+	// ExpiresAt is the time the item expires, nil if it never expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// ItemExporter is implemented by cache clients which are able to copy their items to another cache
+type ItemExporter interface {
+	// ExportItems calls the callback with every stored item
+	ExportItems(ctx context.Context, callback func(item ExportedItem) error) error
+	// ImportItem stores the item, unless it already exists and overwrite is false. Returns whether the item was stored.
+	ImportItem(ctx context.Context, item ExportedItem, overwrite bool) (bool, error)
+}
+
+// exportHeader is the first line of an export, used to reject exports written in an unknown format
+type exportHeader struct {
+	Format string `json:"format"`
+}
+
+// ImportResult holds the number of items processed by Cache.Import
+type ImportResult struct {
+	// Imported is the number of stored items
+	Imported int
+	// Existing is the number of items which were not stored because they already exist
+	Existing int
+	// Expired is the number of items which were not stored because they expired since the export
+	Expired int
+}
+
+func (c *Cache) itemExporter() (ItemExporter, error) {
+	exporter, ok := c.GetClient().(ItemExporter)
+	if !ok {
+		return nil, fmt.Errorf("cache client %T does not support exporting items", c.GetClient())
+	}
+	return exporter, nil
+}
+
+// Export writes the stored items whose key starts with one of the given prefixes, or all the items if no prefix is
+// given, to the writer as JSON lines, and returns the number of exported items
+func (c *Cache) Export(ctx context.Context, w io.Writer, prefixes []string) (int, error) {
+	exporter, err := c.itemExporter()
+	if err != nil {
+		return 0, err
+	}
+	encoder := json.NewEncoder(w)
+	if err := encoder.Encode(exportHeader{Format: exportFormatVersion}); err != nil {
+		return 0, fmt.Errorf("failed to write export header: %w", err)
+	}
+	count := 0
+	err = exporter.ExportItems(ctx, func(item ExportedItem) error {
+		prefix, _ := parseVersionedKey(item.Key)
+		if len(prefixes) > 0 && !slices.Contains(prefixes, prefix) {
+			return nil
+		}
+		if err := encoder.Encode(item); err != nil {
+			return fmt.Errorf("failed to write cache item %s: %w", item.Key, err)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Import stores the items of an export written by Export. Items which expired since the export are skipped, and
+// existing items are only replaced if overwrite is true.
+func (c *Cache) Import(ctx context.Context, r io.Reader, overwrite bool) (ImportResult, error) {
+	var result ImportResult
+	exporter, err := c.itemExporter()
+	if err != nil {
+		return result, err
+	}
+	decoder := json.NewDecoder(bufio.NewReader(r))
+	var header exportHeader
+	if err := decoder.Decode(&header); err != nil {
+		return result, fmt.Errorf("failed to read export header: %w", err)
+	}
+	if header.Format != exportFormatVersion {
+		return result, fmt.Errorf("unsupported export format %q", header.Format)
+	}
+	for {
+		var item ExportedItem
+		err := decoder.Decode(&item)
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to read cache item: %w", err)
+		}
+		if strings.TrimSpace(item.Key) == "" {
+			return result, fmt.Errorf("cache item %d has no key", result.Imported+result.Existing+result.Expired+1)
+		}
+		if item.ExpiresAt != nil && !item.ExpiresAt.After(time.Now()) {
+			result.Expired++
+			continue
+		}
+		stored, err := exporter.ImportItem(ctx, item, overwrite)
+		if err != nil {
+			return result, fmt.Errorf("failed to import cache item %s: %w", item.Key, err)
+		}
+		if stored {
+			result.Imported++
+		} else {
+			result.Existing++
+		}
+	}
+}
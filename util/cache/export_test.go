@@ -0,0 +1,75 @@
+package cache
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache_ExportImport(t *testing.T) {
+	source, err := miniredis.Run()
+	require.NoError(t, err)
+	defer source.Close()
+	target, err := miniredis.Run()
+	require.NoError(t, err)
+	defer target.Close()
+
+	sourceClient := redis.NewClient(&redis.Options{Addr: source.Addr()})
+	sourceCache := NewCache(NewRedisCache(sourceClient, time.Hour, RedisCompressionGZip))
+	require.NoError(t, sourceCache.SetItem("mfst|a|1", "manifests", &CacheActionOpts{}))
+	require.NoError(t, sourceCache.SetItem("app|managed-resources|guestbook", "resources", &CacheActionOpts{Expiration: time.Minute}))
+	require.NoError(t, sourceCache.SetItem("gitdirs|a|1", "dirs", &CacheActionOpts{}))
+	require.NoError(t, sourceClient.LPush(t.Context(), "not-a-string", "item").Err())
+
+	var export bytes.Buffer
+	count, err := sourceCache.Export(t.Context(), &export, []string{"mfst", "app"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.True(t, strings.HasPrefix(export.String(), `{"format":"v1"}`))
+
+	targetCache := NewCache(NewRedisCache(redis.NewClient(&redis.Options{Addr: target.Addr()}), time.Hour, RedisCompressionGZip))
+	require.NoError(t, targetCache.SetItem("mfst|a|1", "existing", &CacheActionOpts{}))
+
+	t.Run("KeepExisting", func(t *testing.T) {
+		result, err := targetCache.Import(t.Context(), bytes.NewReader(export.Bytes()), false)
+		require.NoError(t, err)
+		assert.Equal(t, ImportResult{Imported: 1, Existing: 1}, result)
+
+		var val string
+		require.NoError(t, targetCache.GetItem("mfst|a|1", &val))
+		assert.Equal(t, "existing", val)
+		require.NoError(t, targetCache.GetItem("app|managed-resources|guestbook", &val))
+		assert.Equal(t, "resources", val)
+		assert.InDelta(t, time.Minute.Seconds(), target.TTL(targetCache.generateFullKey("app|managed-resources|guestbook")+".gz").Seconds(), 5)
+		require.ErrorIs(t, targetCache.GetItem("gitdirs|a|1", &val), ErrCacheMiss)
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		result, err := targetCache.Import(t.Context(), bytes.NewReader(export.Bytes()), true)
+		require.NoError(t, err)
+		assert.Equal(t, ImportResult{Imported: 2}, result)
+
+		var val string
+		require.NoError(t, targetCache.GetItem("mfst|a|1", &val))
+		assert.Equal(t, "manifests", val)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		result, err := targetCache.Import(t.Context(), strings.NewReader(`{"format":"v1"}
+{"key":"mfst|b|1","value":"","expiresAt":"2020-01-01T00:00:00Z"}
+`), true)
+		require.NoError(t, err)
+		assert.Equal(t, ImportResult{Expired: 1}, result)
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		_, err := targetCache.Import(t.Context(), strings.NewReader(`{"format":"v0"}`), true)
+		require.ErrorContains(t, err, "unsupported export format")
+	})
+}
@@ -97,6 +97,25 @@ func (i *InMemoryCache) NotifyUpdated(_ string) error {
 	return nil
 }
 
+// compile-time validation of adherence of the KeyScanner contract
+var _ KeyScanner = &InMemoryCache{}
+
+func (i *InMemoryCache) ScanKeys(_ context.Context, callback func(key string) error) error {
+	for key := range i.memCache.Items() {
+		if err := callback(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *InMemoryCache) DeleteKeys(_ context.Context, keys ...string) error {
+	for _, key := range keys {
+		i.memCache.Delete(key)
+	}
+	return nil
+}
+
 // Items return a list of items in the cache; requires passing a constructor function
 // so that the items can be decoded from gob format.
 func (i *InMemoryCache) Items(createNewObject func() any) (map[string]any, error) {
@@ -115,3 +134,54 @@ func (i *InMemoryCache) Items(createNewObject func() any) (map[string]any, error
 
 	return result, nil
 }
+
+// compile-time validation of adherence of the ItemExporter contract
+var _ ItemExporter = &InMemoryCache{}
+
+func (i *InMemoryCache) ExportItems(_ context.Context, callback func(item ExportedItem) error) error {
+	for key, value := range i.memCache.Items() {
+		if err := callback(newExportedItem(key, value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (i *InMemoryCache) ImportItem(_ context.Context, item ExportedItem, overwrite bool) (bool, error) {
+	expiration := gocache.NoExpiration
+	if item.ExpiresAt != nil {
+		expiration = time.Until(*item.ExpiresAt)
+		if expiration <= 0 {
+			return false, nil
+		}
+	}
+	buf := bytes.NewBuffer(item.Value)
+	if !overwrite {
+		return i.memCache.Add(item.Key, *buf, expiration) == nil, nil
+	}
+	i.memCache.Set(item.Key, *buf, expiration)
+	return true, nil
+}
+
+// exportItem returns the item with the given key as stored by the cache
+func (i *InMemoryCache) exportItem(key string) (ExportedItem, bool) {
+	value, expiresAt, found := i.memCache.GetWithExpiration(key)
+	if !found {
+		return ExportedItem{}, false
+	}
+	var expiration int64
+	if !expiresAt.IsZero() {
+		expiration = expiresAt.UnixNano()
+	}
+	return newExportedItem(key, gocache.Item{Object: value, Expiration: expiration}), true
+}
+
+func newExportedItem(key string, value gocache.Item) ExportedItem {
+	buf := value.Object.(bytes.Buffer)
+	item := ExportedItem{Key: key, Value: buf.Bytes()}
+	if value.Expiration > 0 {
+		expiresAt := time.Unix(0, value.Expiration)
+		item.ExpiresAt = &expiresAt
+	}
+	return item
+}
@@ -0,0 +1,275 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// PeerUpdatesPath is the path of the endpoint receiving the updates of the peers
+	PeerUpdatesPath = "/cache/v1/updates"
+	// peerUpdatesQueueSize is the number of updates which are queued before updates are dropped
+	peerUpdatesQueueSize = 10000
+	// peerUpdatesBatchSize is the maximum number of updates sent to the peers in a single request
+	peerUpdatesBatchSize = 100
+	// peerRequestTimeout is the timeout of the requests sent to the peers
+	peerRequestTimeout = 10 * time.Second
+	// maxPeerRequestSize is the maximum size of the body of the requests received from the peers
+	maxPeerRequestSize = 256 * 1024 * 1024
+)
+
+// peerUpdate is an update of an item of the cache replicated to the peers
+type peerUpdate struct {
+	// Delete is true if the item was deleted
+	Delete bool `json:"delete,omitempty"`
+	// Notify is true if the update only notifies the subscribers of the item that it was updated
+	Notify bool `json:"notify,omitempty"`
+	// Item is the updated item, only holding the key of deleted items
+	Item ExportedItem `json:"item"`
+}
+
+// compile-time validation of adherence of the CacheClient contract
+var _ CacheClient = &PeerCache{}
+
+// PeerCache is an in-memory cache which replicates its updates to peers on a best effort basis, so that the components
+// of an installation without Redis share their cached data. The updates are sent asynchronously and dropped if the
+// peers cannot keep up, which only costs cache misses. The update notifications are sent to the peers the same way, after
+// the updates queued before them.
+type PeerCache struct {
+	*InMemoryCache
+	peers   []string
+	token   string
+	client  *http.Client
+	updates chan peerUpdate
+
+	lock sync.Mutex
+	// subscribers holds the channels signaled on updates of an item by key
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+// NewPeerCache returns an in-memory cache replicating its updates to the given peer URLs, authenticating with the given
+// token. The given TLS config, if any, is used to verify the peers served over HTTPS. Run must be called to send the
+// updates.
+func NewPeerCache(expiration time.Duration, peers []string, token string, tlsConfig *tls.Config) *PeerCache {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+	return &PeerCache{
+		InMemoryCache: NewInMemoryCache(expiration),
+		peers:         peers,
+		token:         token,
+		client:        &http.Client{Timeout: peerRequestTimeout, Transport: transport},
+		updates:       make(chan peerUpdate, peerUpdatesQueueSize),
+		subscribers:   map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+func (c *PeerCache) Set(item *Item) error {
+	if err := c.InMemoryCache.Set(item); err != nil {
+		return err
+	}
+	c.replicate(item.Key)
+	return nil
+}
+
+func (c *PeerCache) Rename(oldKey string, newKey string, expiration time.Duration) error {
+	if err := c.InMemoryCache.Rename(oldKey, newKey, expiration); err != nil {
+		return err
+	}
+	c.replicate(newKey)
+	c.enqueue(peerUpdate{Delete: true, Item: ExportedItem{Key: oldKey}})
+	return nil
+}
+
+func (c *PeerCache) Delete(key string) error {
+	if err := c.InMemoryCache.Delete(key); err != nil {
+		return err
+	}
+	c.enqueue(peerUpdate{Delete: true, Item: ExportedItem{Key: key}})
+	return nil
+}
+
+func (c *PeerCache) DeleteKeys(ctx context.Context, keys ...string) error {
+	if err := c.InMemoryCache.DeleteKeys(ctx, keys...); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		c.enqueue(peerUpdate{Delete: true, Item: ExportedItem{Key: key}})
+	}
+	return nil
+}
+
+func (c *PeerCache) ImportItem(ctx context.Context, item ExportedItem, overwrite bool) (bool, error) {
+	imported, err := c.InMemoryCache.ImportItem(ctx, item, overwrite)
+	if imported {
+		c.replicate(item.Key)
+	}
+	return imported, err
+}
+
+// OnUpdated calls the callback every time the item with the given key is notified as updated, either locally or by a
+// peer, until the context is done
+func (c *PeerCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
+	ch := c.subscribe(key)
+	defer c.unsubscribe(key, ch)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ch:
+			if err := callback(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// NotifyUpdated notifies the local subscribers and the peers that the item with the given key was updated
+func (c *PeerCache) NotifyUpdated(key string) error {
+	c.notify(key)
+	c.enqueue(peerUpdate{Notify: true, Item: ExportedItem{Key: key}})
+	return nil
+}
+
+func (c *PeerCache) subscribe(key string) chan struct{} {
+	// a single pending signal is enough since the subscribers reload the item anyway
+	ch := make(chan struct{}, 1)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.subscribers[key] == nil {
+		c.subscribers[key] = map[chan struct{}]struct{}{}
+	}
+	c.subscribers[key][ch] = struct{}{}
+	return ch
+}
+
+func (c *PeerCache) unsubscribe(key string, ch chan struct{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.subscribers[key], ch)
+	if len(c.subscribers[key]) == 0 {
+		delete(c.subscribers, key)
+	}
+}
+
+// notify signals the local subscribers of the item with the given key without blocking
+func (c *PeerCache) notify(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for ch := range c.subscribers[key] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// replicate queues the item with the given key, as currently stored, to be sent to the peers
+func (c *PeerCache) replicate(key string) {
+	if item, ok := c.exportItem(key); ok {
+		c.enqueue(peerUpdate{Item: item})
+	}
+}
+
+func (c *PeerCache) enqueue(update peerUpdate) {
+	if len(c.peers) == 0 {
+		return
+	}
+	select {
+	case c.updates <- update:
+	default:
+		log.WithField("key", update.Item.Key).Warn("Cache peer updates queue is full, dropping update")
+	}
+}
+
+// Run sends the queued updates to the peers until the context is done
+func (c *PeerCache) Run(ctx context.Context) {
+	for {
+		var batch []peerUpdate
+		select {
+		case <-ctx.Done():
+			return
+		case update := <-c.updates:
+			batch = append(batch, update)
+		}
+	collect:
+		for len(batch) < peerUpdatesBatchSize {
+			select {
+			case update := <-c.updates:
+				batch = append(batch, update)
+			default:
+				break collect
+			}
+		}
+		for _, peer := range c.peers {
+			if err := c.send(ctx, peer, batch); err != nil {
+				log.WithField("peer", peer).Warnf("Failed to send %d cache updates to peer: %v", len(batch), err)
+			}
+		}
+	}
+}
+
+func (c *PeerCache) send(ctx context.Context, peer string, batch []peerUpdate) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("error marshaling updates: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(peer, "/")+PeerUpdatesPath, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// ServeHTTP applies the updates sent by a peer, without replicating them any further
+func (c *PeerCache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if c.token == "" || subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+c.token)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	var updates []peerUpdate
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPeerRequestSize)).Decode(&updates); err != nil {
+		http.Error(w, fmt.Sprintf("invalid updates: %v", err), http.StatusBadRequest)
+		return
+	}
+	for _, update := range updates {
+		if update.Notify {
+			c.notify(update.Item.Key)
+			continue
+		}
+		if update.Delete {
+			_ = c.InMemoryCache.Delete(update.Item.Key)
+			continue
+		}
+		if _, err := c.InMemoryCache.ImportItem(r.Context(), update.Item, true); err != nil {
+			http.Error(w, fmt.Sprintf("failed to apply update of %s: %v", update.Item.Key, err), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
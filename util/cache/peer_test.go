@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerCache_Replication(t *testing.T) {
+	target := NewPeerCache(time.Hour, nil, "token", nil)
+	server := httptest.NewServer(target)
+	defer server.Close()
+
+	source := NewPeerCache(time.Hour, []string{server.URL}, "token", nil)
+	go source.Run(t.Context())
+
+	require.NoError(t, source.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, source.Set(&Item{Key: "other-key", Object: &foo{Bar: "baz"}, CacheActionOpts: CacheActionOpts{Expiration: time.Minute}}))
+	assert.Eventually(t, func() bool {
+		obj := &foo{}
+		return target.Get("my-key", obj) == nil && obj.Bar == "bar"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	item, ok := target.exportItem("other-key")
+	require.True(t, ok)
+	require.NotNil(t, item.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), *item.ExpiresAt, 5*time.Second)
+
+	require.NoError(t, source.Rename("my-key", "renamed-key", time.Hour))
+	require.NoError(t, source.Delete("other-key"))
+	assert.Eventually(t, func() bool {
+		obj := &foo{}
+		return target.Get("my-key", obj) == ErrCacheMiss &&
+			target.Get("other-key", obj) == ErrCacheMiss &&
+			target.Get("renamed-key", obj) == nil && obj.Bar == "bar"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPeerCache_NotifyUpdated(t *testing.T) {
+	target := NewPeerCache(time.Hour, nil, "token", nil)
+	server := httptest.NewServer(target)
+	defer server.Close()
+
+	source := NewPeerCache(time.Hour, []string{server.URL}, "token", nil)
+	go source.Run(t.Context())
+
+	notified := make(chan foo, 1)
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() {
+		done <- target.OnUpdated(ctx, "my-key", func() error {
+			obj := foo{}
+			if err := target.Get("my-key", &obj); err != nil {
+				return err
+			}
+			notified <- obj
+			return nil
+		})
+	}()
+	// the subscription is registered asynchronously
+	assert.Eventually(t, func() bool {
+		target.lock.Lock()
+		defer target.lock.Unlock()
+		return len(target.subscribers["my-key"]) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, source.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}}))
+	require.NoError(t, source.NotifyUpdated("my-key"))
+	select {
+	case obj := <-notified:
+		assert.Equal(t, "bar", obj.Bar)
+	case <-time.After(5 * time.Second):
+		t.Fatal("update notification was not received")
+	}
+
+	cancel()
+	require.NoError(t, <-done)
+	assert.Empty(t, target.subscribers)
+}
+
+func TestPeerCache_ReplicationOverTLS(t *testing.T) {
+	target := NewPeerCache(time.Hour, nil, "token", nil)
+	server := httptest.NewTLSServer(target)
+	defer server.Close()
+
+	t.Run("UntrustedPeer", func(t *testing.T) {
+		source := NewPeerCache(time.Hour, []string{server.URL}, "token", &tls.Config{})
+		require.Error(t, source.send(t.Context(), server.URL, []peerUpdate{{Item: ExportedItem{Key: "my-key"}}}))
+	})
+
+	t.Run("TrustedPeer", func(t *testing.T) {
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(server.Certificate())
+		source := NewPeerCache(time.Hour, []string{server.URL}, "token", &tls.Config{RootCAs: rootCAs})
+		go source.Run(t.Context())
+
+		require.NoError(t, source.Set(&Item{Key: "my-key", Object: &foo{Bar: "bar"}}))
+		assert.Eventually(t, func() bool {
+			obj := &foo{}
+			return target.Get("my-key", obj) == nil && obj.Bar == "bar"
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestServePeerUpdates(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &http.Server{Handler: NewPeerCache(time.Hour, nil, "token", nil), ReadHeaderTimeout: peerRequestTimeout}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		servePeerUpdates(ctx, server, ln)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("cache peer listener was not shut down")
+	}
+	_, err = (&net.Dialer{}).DialContext(t.Context(), "tcp", ln.Addr().String())
+	assert.Error(t, err)
+}
+
+func TestNewPeerCacheFromFlags(t *testing.T) {
+	t.Setenv(envCachePeerToken, "token")
+
+	_, err := newPeerCacheFromFlags(t.Context(), time.Hour, peerCacheConfig{listenAddress: "127.0.0.1:0", tlsCertificate: "tls.crt"})
+	require.ErrorContains(t, err, "both the certificate and the key")
+
+	t.Setenv(envCachePeerToken, "")
+	_, err = newPeerCacheFromFlags(t.Context(), time.Hour, peerCacheConfig{peers: []string{"https://peer"}})
+	require.ErrorContains(t, err, envCachePeerToken)
+}
+
+func TestPeerCache_ServeHTTP(t *testing.T) {
+	cache := NewPeerCache(time.Hour, nil, "token", nil)
+	body := `[{"item":{"key":"my-key","value":"dmFsdWU="}}]`
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, PeerUpdatesPath, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer invalid")
+		w := httptest.NewRecorder()
+		cache.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		_, ok := cache.exportItem("my-key")
+		assert.False(t, ok)
+	})
+
+	t.Run("ValidToken", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, PeerUpdatesPath, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer token")
+		w := httptest.NewRecorder()
+		cache.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		item, ok := cache.exportItem("my-key")
+		require.True(t, ok)
+		assert.Equal(t, "value", string(item.Value))
+		assert.Nil(t, item.ExpiresAt)
+	})
+}
+
+func TestInMemoryCache_ImportItem(t *testing.T) {
+	cache := NewInMemoryCache(time.Hour)
+	expired := time.Now().Add(-time.Minute)
+
+	imported, err := cache.ImportItem(t.Context(), ExportedItem{Key: "expired", Value: []byte("value"), ExpiresAt: &expired}, true)
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	imported, err = cache.ImportItem(t.Context(), ExportedItem{Key: "my-key", Value: []byte("value")}, false)
+	require.NoError(t, err)
+	assert.True(t, imported)
+	imported, err = cache.ImportItem(t.Context(), ExportedItem{Key: "my-key", Value: []byte("other")}, false)
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	var items []ExportedItem
+	require.NoError(t, cache.ExportItems(t.Context(), func(item ExportedItem) error {
+		items = append(items, item)
+		return nil
+	}))
+	assert.Equal(t, []ExportedItem{{Key: "my-key", Value: []byte("value")}}, items)
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +31,10 @@ var (
 const (
 	// envRedisKeyPrefix is an env variable name which stores the prefix for redis keys
 	envRedisKeyPrefix = "ARGOCD_REDIS_KEY_PREFIX"
+	// redisScanBatchSize is the number of keys requested per SCAN call when listing keys
+	redisScanBatchSize = 1000
+	// redisDeleteBatchSize is the maximum number of keys deleted per DEL call
+	redisDeleteBatchSize = 500
 )
 
 func CompressionTypeFromString(s string) (RedisCompressionType, error) {
@@ -158,6 +163,119 @@ func (r *redisCache) Delete(key string) error {
 	return r.cache.Delete(context.TODO(), r.getKey(key))
 }
 
+// compile-time validation of adherence of the KeyScanner contract
+var _ KeyScanner = &redisCache{}
+
+// ScanKeys calls the callback with every key stored under the configured key prefix, regardless of its compression
+func (r *redisCache) ScanKeys(ctx context.Context, callback func(key string) error) error {
+	iter := r.client.Scan(ctx, 0, r.prefix+"*", redisScanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		key := strings.TrimSuffix(strings.TrimPrefix(iter.Val(), r.prefix), ".gz")
+		if err := callback(key); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// DeleteKeys deletes the given keys, regardless of the compression they were stored with
+func (r *redisCache) DeleteKeys(ctx context.Context, keys ...string) error {
+	for batch := range slices.Chunk(keys, redisDeleteBatchSize) {
+		redisKeys := make([]string, 0, 2*len(batch))
+		for _, key := range batch {
+			redisKeys = append(redisKeys, r.prefix+key, r.prefix+key+".gz")
+		}
+		if err := r.client.Del(ctx, redisKeys...).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compile-time validation of adherence of the ItemExporter contract
+var _ ItemExporter = &redisCache{}
+
+// ExportItems calls the callback with the raw value and expiration of every string stored under the configured key
+// prefix. Keys are exported with their compression suffix, since the value is only readable with the same compression.
+func (r *redisCache) ExportItems(ctx context.Context, callback func(item ExportedItem) error) error {
+	iter := r.client.Scan(ctx, 0, r.prefix+"*", redisScanBatchSize).Iterator()
+	batch := make([]string, 0, redisScanBatchSize)
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == redisScanBatchSize {
+			if err := r.exportBatch(ctx, batch, callback); err != nil {
+				return err
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	return r.exportBatch(ctx, batch, callback)
+}
+
+func (r *redisCache) exportBatch(ctx context.Context, redisKeys []string, callback func(item ExportedItem) error) error {
+	if len(redisKeys) == 0 {
+		return nil
+	}
+	now := time.Now()
+	pipe := r.client.Pipeline()
+	getCmds := make([]*redis.StringCmd, len(redisKeys))
+	ttlCmds := make([]*redis.DurationCmd, len(redisKeys))
+	for i, key := range redisKeys {
+		getCmds[i] = pipe.Get(ctx, key)
+		ttlCmds[i] = pipe.PTTL(ctx, key)
+	}
+	// errors are checked per command below, since keys may expire or hold other types than strings
+	_, _ = pipe.Exec(ctx)
+	for i, key := range redisKeys {
+		value, err := getCmds[i].Bytes()
+		if errors.Is(err, redis.Nil) || (err != nil && strings.HasPrefix(err.Error(), "WRONGTYPE")) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get key %s: %w", key, err)
+		}
+		ttl, err := ttlCmds[i].Result()
+		if err != nil {
+			return fmt.Errorf("failed to get expiration of key %s: %w", key, err)
+		}
+		item := ExportedItem{Key: strings.TrimPrefix(key, r.prefix), Value: value}
+		switch {
+		case ttl == -2:
+			// the key expired after it was read
+			continue
+		case ttl > 0:
+			expiresAt := now.Add(ttl).UTC()
+			item.ExpiresAt = &expiresAt
+		}
+		if err := callback(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ImportItem stores the raw value of an exported item under the configured key prefix
+func (r *redisCache) ImportItem(ctx context.Context, item ExportedItem, overwrite bool) (bool, error) {
+	args := redis.SetArgs{}
+	if !overwrite {
+		args.Mode = "NX"
+	}
+	if item.ExpiresAt != nil {
+		args.ExpireAt = *item.ExpiresAt
+	}
+	err := r.client.SetArgs(ctx, r.prefix+item.Key, item.Value, args).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (r *redisCache) OnUpdated(ctx context.Context, key string, callback func() error) error {
 	pubsub := r.client.Subscribe(ctx, key)
 	defer utilio.Close(pubsub)
@@ -235,7 +353,11 @@ func (redisHook) ProcessPipelineHook(_ redis.ProcessPipelineHook) redis.ProcessP
 
 // CollectMetrics add transport wrapper that pushes metrics into the specified metrics registry
 // Lock should be shared between functions that can add/process a Redis hook.
+// Nothing is collected if the client is nil, i.e. if the embedded cache backend is used.
 func CollectMetrics(client *redis.Client, registry MetricsRegistry, lock *sync.RWMutex) {
+	if client == nil {
+		return
+	}
 	if lock != nil {
 		lock.Lock()
 		defer lock.Unlock()
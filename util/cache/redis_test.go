@@ -163,6 +163,31 @@ func TestRedisSetCacheCompressed(t *testing.T) {
 	assert.Equal(t, testValue, result)
 }
 
+func TestRedisScanKeys(t *testing.T) {
+	prefix := "argocd-dev:"
+	t.Setenv("ARGOCD_REDIS_KEY_PREFIX", prefix)
+	mr, err := miniredis.Run()
+	require.NoError(t, err)
+	defer mr.Close()
+
+	redisClient := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	compressed := NewRedisCache(redisClient, 10*time.Second, RedisCompressionGZip).(*redisCache)
+	uncompressed := NewRedisCache(redisClient, 10*time.Second, RedisCompressionNone).(*redisCache)
+	require.NoError(t, compressed.Set(&Item{Key: "foo|1", Object: "bar"}))
+	require.NoError(t, uncompressed.Set(&Item{Key: "baz|1", Object: "bar"}))
+	require.NoError(t, redisClient.Set(t.Context(), "other-instance", "bar", 0).Err())
+
+	var keys []string
+	require.NoError(t, compressed.ScanKeys(t.Context(), func(key string) error {
+		keys = append(keys, key)
+		return nil
+	}))
+	assert.ElementsMatch(t, []string{"foo|1", "baz|1"}, keys)
+
+	require.NoError(t, uncompressed.DeleteKeys(t.Context(), keys...))
+	assert.Equal(t, []string{"other-instance"}, mr.Keys())
+}
+
 func TestRedisMetrics(t *testing.T) {
 	mr, err := miniredis.Run()
 	if err != nil {
@@ -0,0 +1,188 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+const (
+	// envCacheSchemaMigration is an env variable name which controls whether values cached using previous versions of
+	// a key schema are read and written during upgrades
+	envCacheSchemaMigration = "ARGOCD_CACHE_SCHEMA_MIGRATION_ENABLED"
+)
+
+// KeySchema describes the version of the values cached under keys with the given prefix. The version is appended to
+// every key, so bumping the version of a single schema only invalidates the values of that schema instead of the
+// whole cache.
+type KeySchema struct {
+	// Prefix is the part of the key preceding the first '|' separator, e.g. "mfst"
+	Prefix string
+	// Version must be bumped whenever the type of the cached values changes
+	Version string
+	// PreviousVersions lists previous versions of the schema whose values can still be decoded into the current type.
+	// On a cache miss, values stored using these versions are read and copied to the current version, so that an
+	// upgrade does not invalidate them.
+	PreviousVersions []string
+	// DualWrite writes and deletes values using the previous versions as well, so that replicas still running the
+	// previous release keep sharing the cache with upgraded replicas during a rolling upgrade.
+	DualWrite bool
+}
+
+// KeyScanner is implemented by cache clients which are able to enumerate the keys they store
+type KeyScanner interface {
+	// ScanKeys calls the callback with the key of every stored item, as passed to Set
+	ScanKeys(ctx context.Context, callback func(key string) error) error
+	// DeleteKeys deletes the items stored under the given keys
+	DeleteKeys(ctx context.Context, keys ...string) error
+}
+
+// KeyVersionCount holds the number of items cached under keys with the given prefix and schema version
+type KeyVersionCount struct {
+	Prefix  string
+	Version string
+	Count   int
+}
+
+// RegisterKeySchemas registers the schemas of the values stored in the cache. Keys which do not match any registered
+// schema are versioned using common.CacheVersion. Must be called before the cache is used.
+func (c *Cache) RegisterKeySchemas(schemas ...KeySchema) {
+	if c.schemas == nil {
+		c.schemas = map[string]KeySchema{}
+	}
+	for _, schema := range schemas {
+		c.schemas[schema.Prefix] = schema
+	}
+}
+
+func (c *Cache) keySchema(key string) KeySchema {
+	prefix, _, _ := strings.Cut(key, "|")
+	if schema, ok := c.schemas[prefix]; ok {
+		return schema
+	}
+	return KeySchema{Prefix: prefix, Version: common.CacheVersion}
+}
+
+// previousVersions returns the previous versions of the schema of the given key which are read during migrations
+func (c *Cache) previousVersions(schema KeySchema) []string {
+	if !c.schemaMigration {
+		return nil
+	}
+	return schema.PreviousVersions
+}
+
+// getPreviousVersionItem looks up an item stored using a previous version of its schema and, if found, copies it to
+// the current version.
+func (c *Cache) getPreviousVersionItem(key string, item any) error {
+	schema := c.keySchema(key)
+	client := c.GetClient()
+	for _, version := range c.previousVersions(schema) {
+		err := client.Get(fmt.Sprintf("%s|%s", key, version), item)
+		if errors.Is(err, ErrCacheMiss) {
+			continue
+		}
+		if err != nil {
+			log.Debugf("Failed to read cache key %s using schema version %s: %v", key, version, err)
+			continue
+		}
+		if err := client.Set(&Item{Key: fmt.Sprintf("%s|%s", key, schema.Version), Object: item}); err != nil {
+			log.Warnf("Failed to migrate cache key %s from schema version %s to %s: %v", key, version, schema.Version, err)
+		}
+		return nil
+	}
+	return ErrCacheMiss
+}
+
+// dualWriteKeys returns the keys of the previous schema versions which are written alongside the given key
+func (c *Cache) dualWriteKeys(key string) []string {
+	schema := c.keySchema(key)
+	if !schema.DualWrite {
+		return nil
+	}
+	var keys []string
+	for _, version := range c.previousVersions(schema) {
+		keys = append(keys, fmt.Sprintf("%s|%s", key, version))
+	}
+	return keys
+}
+
+func (c *Cache) keyScanner() (KeyScanner, error) {
+	scanner, ok := c.GetClient().(KeyScanner)
+	if !ok {
+		return nil, fmt.Errorf("cache client %T does not support listing keys", c.GetClient())
+	}
+	return scanner, nil
+}
+
+// parseVersionedKey splits a stored key into its prefix and the schema version it was stored with. Keys which were
+// not stored using a schema version are returned with an empty version.
+func parseVersionedKey(key string) (prefix string, version string) {
+	prefix, _, _ = strings.Cut(key, "|")
+	if i := strings.LastIndex(key, "|"); i >= 0 {
+		version = key[i+1:]
+	}
+	return prefix, version
+}
+
+// CountKeysByVersion returns the number of stored items per key prefix and schema version
+func (c *Cache) CountKeysByVersion(ctx context.Context) ([]KeyVersionCount, error) {
+	scanner, err := c.keyScanner()
+	if err != nil {
+		return nil, err
+	}
+	counts := map[KeyVersionCount]int{}
+	err = scanner.ScanKeys(ctx, func(key string) error {
+		prefix, version := parseVersionedKey(key)
+		counts[KeyVersionCount{Prefix: prefix, Version: version}]++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]KeyVersionCount, 0, len(counts))
+	for k, count := range counts {
+		k.Count = count
+		result = append(result, k)
+	}
+	return result, nil
+}
+
+// FlushKeysByVersion deletes the items stored using the given schema version, optionally limited to keys with the
+// given prefix, and returns the number of deleted items
+func (c *Cache) FlushKeysByVersion(ctx context.Context, version string, prefix string) (int, error) {
+	if version == "" {
+		return 0, errors.New("schema version must not be empty")
+	}
+	scanner, err := c.keyScanner()
+	if err != nil {
+		return 0, err
+	}
+	var keys []string
+	err = scanner.ScanKeys(ctx, func(key string) error {
+		keyPrefix, keyVersion := parseVersionedKey(key)
+		if keyVersion == version && (prefix == "" || keyPrefix == prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(keys) == 0 {
+		return 0, nil
+	}
+	if err := scanner.DeleteKeys(ctx, keys...); err != nil {
+		return 0, err
+	}
+	return len(keys), nil
+}
+
+func schemaMigrationFromEnv() bool {
+	return env.ParseBoolFromEnv(envCacheSchemaMigration, true)
+}
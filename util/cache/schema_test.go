@@ -0,0 +1,99 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func TestCache_KeySchemaVersion(t *testing.T) {
+	cache := NewCache(NewInMemoryCache(time.Hour))
+	cache.RegisterKeySchemas(KeySchema{Prefix: "mfst", Version: "2"})
+
+	assert.Equal(t, "mfst|app|rev|2", cache.generateFullKey("mfst|app|rev"))
+	assert.Equal(t, "other|app|"+common.CacheVersion, cache.generateFullKey("other|app"))
+}
+
+func TestCache_KeySchemaMigration(t *testing.T) {
+	client := NewInMemoryCache(time.Hour)
+	cache := NewCache(client)
+	cache.schemaMigration = true
+	cache.RegisterKeySchemas(KeySchema{Prefix: "mfst", Version: "2", PreviousVersions: []string{"1"}})
+	require.NoError(t, client.Set(&Item{Key: "mfst|app|1", Object: "old"}))
+
+	t.Run("ReadsPreviousVersion", func(t *testing.T) {
+		var val string
+		require.NoError(t, cache.GetItem("mfst|app", &val))
+		assert.Equal(t, "old", val)
+
+		require.NoError(t, client.Get("mfst|app|2", &val), "value is copied to the current version")
+		assert.Equal(t, "old", val)
+	})
+
+	t.Run("CurrentVersionTakesPrecedence", func(t *testing.T) {
+		require.NoError(t, cache.SetItem("mfst|app", "new", nil))
+		var val string
+		require.NoError(t, cache.GetItem("mfst|app", &val))
+		assert.Equal(t, "new", val)
+
+		require.NoError(t, client.Get("mfst|app|1", &val), "previous version is not written without dual write")
+		assert.Equal(t, "old", val)
+	})
+
+	t.Run("DeleteRemovesAllVersions", func(t *testing.T) {
+		require.NoError(t, cache.SetItem("mfst|app", "", &CacheActionOpts{Delete: true}))
+		var val string
+		require.ErrorIs(t, cache.GetItem("mfst|app", &val), ErrCacheMiss)
+		require.ErrorIs(t, client.Get("mfst|app|1", &val), ErrCacheMiss)
+	})
+
+	t.Run("DualWrite", func(t *testing.T) {
+		cache.RegisterKeySchemas(KeySchema{Prefix: "mfst", Version: "2", PreviousVersions: []string{"1"}, DualWrite: true})
+		require.NoError(t, cache.SetItem("mfst|app", "new", nil))
+		var val string
+		require.NoError(t, client.Get("mfst|app|1", &val))
+		assert.Equal(t, "new", val)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		cache.schemaMigration = false
+		require.NoError(t, client.Set(&Item{Key: "mfst|other|1", Object: "old"}))
+		var val string
+		require.ErrorIs(t, cache.GetItem("mfst|other", &val), ErrCacheMiss)
+	})
+}
+
+func TestCache_KeysByVersion(t *testing.T) {
+	client := NewInMemoryCache(time.Hour)
+	cache := NewCache(client)
+	for _, key := range []string{"mfst|a|1", "mfst|b|1", "mfst|a|2", "gitdirs|a|1", "unversioned"} {
+		require.NoError(t, client.Set(&Item{Key: key, Object: "val"}))
+	}
+
+	counts, err := cache.CountKeysByVersion(t.Context())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []KeyVersionCount{
+		{Prefix: "mfst", Version: "1", Count: 2},
+		{Prefix: "mfst", Version: "2", Count: 1},
+		{Prefix: "gitdirs", Version: "1", Count: 1},
+		{Prefix: "unversioned", Version: "", Count: 1},
+	}, counts)
+
+	deleted, err := cache.FlushKeysByVersion(t.Context(), "1", "mfst")
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	deleted, err = cache.FlushKeysByVersion(t.Context(), "1", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	var val string
+	require.NoError(t, client.Get("mfst|a|2", &val))
+
+	_, err = cache.FlushKeysByVersion(t.Context(), "", "")
+	require.Error(t, err)
+}
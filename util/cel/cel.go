@@ -0,0 +1,54 @@
+package cel
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+)
+
+// costLimit limits the cost of the evaluation of an expression, so that an expensive expression configured by a user
+// cannot stall the component evaluating it
+const costLimit = 1000000
+
+// NewEnv returns a CEL environment declaring the given dynamically typed variables. Optional field selection, e.g.
+// `labels.?env.orValue("")`, is enabled so that expressions can handle missing map keys.
+func NewEnv(variables ...string) (*cel.Env, error) {
+	opts := []cel.EnvOption{cel.OptionalTypes()}
+	for _, variable := range variables {
+		opts = append(opts, cel.Variable(variable, cel.DynType))
+	}
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CEL environment: %w", err)
+	}
+	return env, nil
+}
+
+// CompileBool compiles an expression which must evaluate to a boolean, returning an error if it is invalid
+func CompileBool(env *cel.Env, expression string) (cel.Program, error) {
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", expression, issues.Err())
+	}
+	if outputType := ast.OutputType(); outputType != cel.BoolType && outputType != cel.DynType {
+		return nil, fmt.Errorf("expression %q must evaluate to a boolean, not %s", expression, outputType)
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("error creating program of expression %q: %w", expression, err)
+	}
+	return program, nil
+}
+
+// EvalBool evaluates a program compiled with CompileBool against the given variables
+func EvalBool(program cel.Program, vars map[string]any) (bool, error) {
+	out, _, err := program.Eval(vars)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a boolean, not %T", out.Value())
+	}
+	return result, nil
+}
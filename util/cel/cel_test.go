@@ -0,0 +1,34 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileBool(t *testing.T) {
+	env, err := NewEnv("obj")
+	require.NoError(t, err)
+
+	program, err := CompileBool(env, `obj.labels.?env.orValue("") == "prod"`)
+	require.NoError(t, err)
+	result, err := EvalBool(program, map[string]any{"obj": map[string]any{"labels": map[string]string{"env": "prod"}}})
+	require.NoError(t, err)
+	assert.True(t, result)
+	result, err = EvalBool(program, map[string]any{"obj": map[string]any{"labels": map[string]string{}}})
+	require.NoError(t, err)
+	assert.False(t, result)
+
+	_, err = CompileBool(env, `obj.name ==`)
+	require.ErrorContains(t, err, `invalid expression "obj.name =="`)
+	_, err = CompileBool(env, `1 + 1`)
+	require.ErrorContains(t, err, `expression "1 + 1" must evaluate to a boolean, not int`)
+	_, err = CompileBool(env, `other == 1`)
+	require.ErrorContains(t, err, "undeclared reference to 'other'")
+
+	program, err = CompileBool(env, `obj.name`)
+	require.NoError(t, err)
+	_, err = EvalBool(program, map[string]any{"obj": map[string]any{"name": "guestbook"}})
+	require.ErrorContains(t, err, "must evaluate to a boolean, not string")
+}
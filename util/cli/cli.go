@@ -194,7 +194,7 @@ func SetLogFormat(logFormat string) {
 		log.Fatalf("Unknown log format '%s'", logFormat)
 	}
 
-	log.SetFormatter(utillog.CreateFormatter(logFormat))
+	log.SetFormatter(utillog.WithLevelOverrides(utillog.WithRedaction(utillog.CreateFormatter(logFormat))))
 }
 
 // SetLogLevel parses and sets a logrus log level
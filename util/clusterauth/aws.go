@@ -0,0 +1,99 @@
+package clusterauth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+
+	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
+)
+
+const (
+	awsClusterIDHeader = "x-k8s-aws-id"
+	// The sts GetCallerIdentity request is valid for 15 minutes regardless of this parameters value after it has been
+	// signed, but we set this unused parameter to 60 for legacy reasons (we check for a value between 0 and 60 on the
+	// server side in 0.3.0 or earlier).  IT IS IGNORED.  If we can get STS to support x-amz-expires, then we should
+	// set this parameter to the actual expiration, and make it configurable.
+	awsRequestPresignParam = 60
+	// AWSPresignedURLExpiration is the actual token expiration (presigned STS urls are valid for 15 minutes after
+	// timestamp in x-amz-date).
+	AWSPresignedURLExpiration = 15 * time.Minute
+	awsTokenPrefix            = "k8s-aws-v1."
+)
+
+// GetAWSSignedRequest presigns an STS GetCallerIdentity request for the given EKS cluster using the default AWS
+// credential provider chain, e.g. IRSA or EKS Pod Identity.
+// The implementation is "inspired" by https://github.com/kubernetes-sigs/aws-iam-authenticator/blob/e61f537662b64092ed83cb76e600e023f627f628/pkg/token/token.go#L316
+func GetAWSSignedRequest(ctx context.Context, clusterName, roleARN string, profile string) (string, error) {
+	cfg, err := loadAWSConfig(ctx, profile)
+	if err != nil {
+		return "", err
+	}
+	return getAWSSignedRequestWithConfig(ctx, clusterName, roleARN, cfg)
+}
+
+// AWSToken converts a presigned request into a token accepted by EKS.
+func AWSToken(presignedURL string) string {
+	return awsTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL))
+}
+
+// NewAWSClusterTokenSource returns a source of tokens for the given EKS cluster.
+func NewAWSClusterTokenSource(clusterName, roleARN, profile string) ClusterTokenSource {
+	return func(ctx context.Context) (*workloadidentity.Token, error) {
+		signed, err := GetAWSSignedRequest(ctx, clusterName, roleARN, profile)
+		if err != nil {
+			return nil, err
+		}
+		// Expire the token 1 minute before the presigned URL expires for some cushion
+		return &workloadidentity.Token{AccessToken: AWSToken(signed), ExpiresOn: time.Now().Add(AWSPresignedURLExpiration - time.Minute)}, nil
+	}
+}
+
+func loadAWSConfig(ctx context.Context, profile string) (aws.Config, error) {
+	var opts []func(*config.LoadOptions) error
+	if profile != "" {
+		opts = append(opts, config.WithSharedConfigProfile(profile))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return aws.Config{}, fmt.Errorf("error loading AWS configuration: %w", err)
+	}
+	return cfg, nil
+}
+
+// getAWSSignedRequestWithConfig presigns GetCallerIdentity using the given config. Used by GetAWSSignedRequest and by
+// tests that inject a config with static credentials to exercise the roleARN path without real AWS credentials.
+func getAWSSignedRequestWithConfig(ctx context.Context, clusterName, roleARN string, cfg aws.Config) (string, error) {
+	// Use PresignOptions.ClientOptions + SetHeaderValue (same as aws-iam-authenticator) so the
+	// canonical request matches what EKS sends when validating. Build middleware can produce
+	// a different canonical form and thus an invalid signature for EKS.
+	// See kubernetes-sigs/aws-iam-authenticator pkg/token/token.go GetWithSTS().
+	client := sts.NewFromConfig(cfg)
+	if roleARN != "" {
+		appCreds := stscreds.NewAssumeRoleProvider(client, roleARN)
+		cfg.Credentials = aws.NewCredentialsCache(appCreds)
+		client = sts.NewFromConfig(cfg)
+	}
+
+	presignClient := sts.NewPresignClient(client)
+	presigned, err := presignClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(presignOptions *sts.PresignOptions) {
+			presignOptions.ClientOptions = append(presignOptions.ClientOptions, func(stsOptions *sts.Options) {
+				stsOptions.APIOptions = append(stsOptions.APIOptions,
+					smithyhttp.SetHeaderValue(awsClusterIDHeader, clusterName),
+					smithyhttp.SetHeaderValue("X-Amz-Expires", strconv.Itoa(awsRequestPresignParam)))
+			})
+		})
+	if err != nil {
+		return "", fmt.Errorf("error presigning AWS request: %w", err)
+	}
+	return presigned.URL, nil
+}
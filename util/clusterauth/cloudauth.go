@@ -0,0 +1,168 @@
+package clusterauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
+)
+
+const (
+	// DefaultAzureServerApplicationID is the application ID of the Microsoft Entra ID server application of AKS
+	// clusters with managed Microsoft Entra ID integration.
+	DefaultAzureServerApplicationID = "6dae42f8-4368-4678-94ff-3960e28e3630"
+
+	// clusterTokenMinValidity is the minimum remaining lifetime of the tokens returned by GetClusterToken. Tokens are
+	// written into temporary kubeconfig files for kubectl operations, so they must remain valid for some time.
+	clusterTokenMinValidity = 5 * time.Minute
+)
+
+// ClusterTokenSource returns a new token used to authenticate to the API server of a cluster.
+type ClusterTokenSource func(ctx context.Context) (*workloadidentity.Token, error)
+
+// azureTokenProvider is shared by all clusters, so that the credential is only initialized once
+var azureTokenProvider = sync.OnceValue(workloadidentity.NewWorkloadIdentityTokenProvider)
+
+// NewAzureClusterTokenSource returns a source of tokens for AKS clusters, obtained using workload identity federation.
+// DefaultAzureServerApplicationID is used if no server application ID is given.
+func NewAzureClusterTokenSource(serverApplicationID string) ClusterTokenSource {
+	if serverApplicationID == "" {
+		serverApplicationID = DefaultAzureServerApplicationID
+	}
+	return func(_ context.Context) (*workloadidentity.Token, error) {
+		return azureTokenProvider().GetToken(serverApplicationID + "/.default")
+	}
+}
+
+// clusterTokenCache caches tokens across REST configs, so that all clients of a cluster share a token and the
+// identity provider is only asked for a new token shortly before the current one expires.
+type clusterTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]*workloadidentity.Token
+	group  singleflight.Group
+	now    func() time.Time
+}
+
+var clusterTokens = &clusterTokenCache{tokens: map[string]*workloadidentity.Token{}, now: time.Now}
+
+// GetClusterToken returns the cached token for the given key. A new token is requested from the source if there is
+// no cached token or the cached token expires within five minutes.
+func GetClusterToken(ctx context.Context, key string, source ClusterTokenSource) (*workloadidentity.Token, error) {
+	return clusterTokens.get(ctx, key, source)
+}
+
+// InvalidateClusterToken removes the given token from the cache, e.g. because the API server rejected it.
+func InvalidateClusterToken(key string, accessToken string) {
+	clusterTokens.invalidate(key, accessToken)
+}
+
+func (c *clusterTokenCache) get(ctx context.Context, key string, source ClusterTokenSource) (*workloadidentity.Token, error) {
+	c.mu.Lock()
+	token, ok := c.tokens[key]
+	c.mu.Unlock()
+	if ok && token.ExpiresOn.Sub(c.now()) > clusterTokenMinValidity {
+		return token, nil
+	}
+	res, err, _ := c.group.Do(key, func() (any, error) {
+		token, err := source(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.tokens[key] = token
+		c.mu.Unlock()
+		return token, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*workloadidentity.Token), nil
+}
+
+func (c *clusterTokenCache) invalidate(key string, accessToken string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if token, ok := c.tokens[key]; ok && token.AccessToken == accessToken {
+		delete(c.tokens, key)
+	}
+}
+
+// clusterTokenRoundTripper authenticates requests with the cached token of a cluster
+type clusterTokenRoundTripper struct {
+	key    string
+	source ClusterTokenSource
+	rt     http.RoundTripper
+}
+
+// NewClusterTokenRoundTripper returns a round tripper that authenticates requests with the cached token for the given
+// key, so that long-lived clients pick up new tokens without being recreated.
+func NewClusterTokenRoundTripper(rt http.RoundTripper, key string, source ClusterTokenSource) http.RoundTripper {
+	return &clusterTokenRoundTripper{key: key, source: source, rt: rt}
+}
+
+func (rt *clusterTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := GetClusterToken(req.Context(), rt.key, rt.source)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	resp, err := rt.rt.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		// the token might have been revoked, so request a new one for the next request
+		InvalidateClusterToken(rt.key, token.AccessToken)
+	}
+	return resp, err
+}
+
+func (rt *clusterTokenRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
+
+// CloudAuthRestConfig returns a REST config for a cluster that authenticates using a cloud workload identity. It is
+// registered with v1alpha1.SetCloudAuthRestConfigFunc by the components which connect to clusters, so that the API
+// types neither depend on the cloud SDKs nor request tokens themselves.
+func CloudAuthRestConfig(c *v1alpha1.Cluster, tlsClientConfig rest.TLSClientConfig) (*rest.Config, error) {
+	key, source := cloudAuthTokenSource(c)
+	ctx, cancel := context.WithTimeout(context.Background(), v1alpha1.K8sCloudAuthTokenTimeout)
+	defer cancel()
+	token, err := GetClusterToken(ctx, key, source)
+	if err != nil {
+		return nil, fmt.Errorf("error getting token: %w", err)
+	}
+	// The token is written into kubeconfig files for kubectl operations, while clients created from the config always
+	// use the latest token of the cluster, so they do not need to be recreated.
+	config := &rest.Config{
+		Host:            c.Server,
+		BearerToken:     token.AccessToken,
+		TLSClientConfig: tlsClientConfig,
+	}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return NewClusterTokenRoundTripper(rt, key, source)
+	})
+	return config, nil
+}
+
+// cloudAuthTokenSource returns the source of the tokens of a cluster that authenticates using a cloud workload
+// identity, and the key its tokens are cached with.
+func cloudAuthTokenSource(c *v1alpha1.Cluster) (string, ClusterTokenSource) {
+	switch {
+	case c.Config.AWSAuthConfig != nil:
+		key := strings.Join([]string{"aws", c.Server, c.Config.AWSAuthConfig.ClusterName, c.Config.AWSAuthConfig.RoleARN, c.Config.AWSAuthConfig.Profile}, "|")
+		return key, NewAWSClusterTokenSource(c.Config.AWSAuthConfig.ClusterName, c.Config.AWSAuthConfig.RoleARN, c.Config.AWSAuthConfig.Profile)
+	case c.Config.GCPAuthConfig != nil:
+		key := strings.Join(append([]string{"gcp", c.Server}, c.Config.GCPAuthConfig.Scopes...), "|")
+		return key, NewGCPClusterTokenSource(c.Config.GCPAuthConfig.Scopes)
+	default:
+		key := strings.Join([]string{"azure", c.Server, c.Config.AzureAuthConfig.ServerApplicationID}, "|")
+		return key, NewAzureClusterTokenSource(c.Config.AzureAuthConfig.ServerApplicationID)
+	}
+}
@@ -0,0 +1,124 @@
+package clusterauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
+)
+
+func newCountingTokenSource(now func() time.Time, validity time.Duration) (ClusterTokenSource, *int) {
+	calls := 0
+	return func(_ context.Context) (*workloadidentity.Token, error) {
+		calls++
+		return &workloadidentity.Token{AccessToken: fmt.Sprintf("token-%d", calls), ExpiresOn: now().Add(validity)}, nil
+	}, &calls
+}
+
+func TestClusterTokenCache(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	cache := &clusterTokenCache{tokens: map[string]*workloadidentity.Token{}, now: func() time.Time { return now }}
+	source, calls := newCountingTokenSource(cache.now, 15*time.Minute)
+
+	token, err := cache.get(t.Context(), "key", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+
+	now = now.Add(9 * time.Minute)
+	token, err = cache.get(t.Context(), "key", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken, "the cached token is valid for more than five minutes")
+
+	now = now.Add(time.Minute)
+	token, err = cache.get(t.Context(), "key", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken, "a new token is requested five minutes before the cached token expires")
+
+	token, err = cache.get(t.Context(), "other", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-3", token.AccessToken)
+
+	cache.invalidate("key", "token-1")
+	token, err = cache.get(t.Context(), "key", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken, "a stale token does not invalidate the current token")
+
+	cache.invalidate("key", "token-2")
+	token, err = cache.get(t.Context(), "key", source)
+	require.NoError(t, err)
+	assert.Equal(t, "token-4", token.AccessToken)
+	assert.Equal(t, 4, *calls)
+
+	t.Run("Error", func(t *testing.T) {
+		_, err := cache.get(t.Context(), "error", func(_ context.Context) (*workloadidentity.Token, error) {
+			return nil, errors.New("no credentials")
+		})
+		require.EqualError(t, err, "no credentials")
+	})
+}
+
+func TestClusterTokenRoundTripper(t *testing.T) {
+	var authHeaders []string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders = append(authHeaders, r.Header.Get("Authorization"))
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+
+	key := "test|" + t.Name()
+	source, calls := newCountingTokenSource(time.Now, time.Hour)
+	client := &http.Client{Transport: NewClusterTokenRoundTripper(http.DefaultTransport, key, source)}
+	get := func() {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, http.NoBody)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+
+	get()
+	get()
+	status = http.StatusUnauthorized
+	get()
+	status = http.StatusOK
+	get()
+
+	assert.Equal(t, []string{"Bearer token-1", "Bearer token-1", "Bearer token-1", "Bearer token-2"}, authHeaders)
+	assert.Equal(t, 2, *calls, "a new token is requested after the API server rejected the cached token")
+}
+
+func TestCloudAuthRestConfig(t *testing.T) {
+	clusters := []*v1alpha1.Cluster{
+		{Server: "https://eks.example.com", Config: v1alpha1.ClusterConfig{AWSAuthConfig: &v1alpha1.AWSAuthConfig{ClusterName: "eks", RoleARN: "arn:aws:iam::123456789012:role/argocd"}}},
+		{Server: "https://gke.example.com", Config: v1alpha1.ClusterConfig{GCPAuthConfig: &v1alpha1.GCPAuthConfig{}}},
+		{Server: "https://aks.example.com", Config: v1alpha1.ClusterConfig{AzureAuthConfig: &v1alpha1.AzureAuthConfig{}}},
+	}
+	for _, cluster := range clusters {
+		t.Run(cluster.Server, func(t *testing.T) {
+			// prime the cache, so that no token is requested from the cloud provider
+			key, _ := cloudAuthTokenSource(cluster)
+			_, err := GetClusterToken(t.Context(), key, func(_ context.Context) (*workloadidentity.Token, error) {
+				return &workloadidentity.Token{AccessToken: "token-" + cluster.Server, ExpiresOn: time.Now().Add(time.Hour)}, nil
+			})
+			require.NoError(t, err)
+
+			config, err := CloudAuthRestConfig(cluster, rest.TLSClientConfig{ServerName: "example.com"})
+			require.NoError(t, err)
+			assert.Equal(t, cluster.Server, config.Host)
+			assert.Equal(t, "token-"+cluster.Server, config.BearerToken)
+			assert.Equal(t, "example.com", config.ServerName)
+			assert.NotNil(t, config.WrapTransport)
+		})
+	}
+}
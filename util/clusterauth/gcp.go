@@ -0,0 +1,42 @@
+package clusterauth
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+
+	"github.com/argoproj/argo-cd/v3/util/workloadidentity"
+)
+
+// DefaultGCPScopes:
+//   - cloud-platform is the base scope to authenticate to GCP.
+//   - userinfo.email is used to authenticate to GKE APIs with gserviceaccount
+//     email instead of numeric uniqueID.
+//
+// https://github.com/kubernetes/client-go/blob/be758edd136e61a1bffadf1c0235fceb8aee8e9e/plugin/pkg/client/auth/gcp/gcp.go#L59
+var DefaultGCPScopes = []string{
+	"https://www.googleapis.com/auth/cloud-platform",
+	"https://www.googleapis.com/auth/userinfo.email",
+}
+
+// NewGCPClusterTokenSource returns a source of tokens for GKE clusters, obtained from the application default
+// credentials, e.g. GKE Workload Identity. DefaultGCPScopes are requested if no scopes are given.
+func NewGCPClusterTokenSource(scopes []string) ClusterTokenSource {
+	if len(scopes) == 0 {
+		scopes = DefaultGCPScopes
+	}
+	return func(ctx context.Context) (*workloadidentity.Token, error) {
+		// Preferred way to retrieve GCP credentials
+		// https://github.com/golang/oauth2/blob/9780585627b5122c8cc9c6a378ac9861507e7551/google/doc.go#L54-L68
+		cred, err := google.FindDefaultCredentials(ctx, scopes...)
+		if err != nil {
+			return nil, fmt.Errorf("error finding GCP credentials: %w", err)
+		}
+		token, err := cred.TokenSource.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error getting GCP access token: %w", err)
+		}
+		return &workloadidentity.Token{AccessToken: token.AccessToken, ExpiresOn: token.Expiry}, nil
+	}
+}
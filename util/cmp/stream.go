@@ -66,6 +66,7 @@ type SenderOption func(*senderOption)
 type senderOption struct {
 	chunkSize   int
 	tarDoneChan chan<- bool
+	limits      *pluginclient.ManifestGenerationLimits
 }
 
 func newSenderOption(opts ...SenderOption) *senderOption {
@@ -84,6 +85,13 @@ func WithTarDoneChan(ch chan<- bool) SenderOption {
 	}
 }
 
+// WithLimits sends the resource limits of the repository of the application to the plugin
+func WithLimits(limits *pluginclient.ManifestGenerationLimits) SenderOption {
+	return func(opt *senderOption) {
+		opt.limits = limits
+	}
+}
+
 // SendRepoStream will compress the files under the given rootPath and send
 // them using the plugin stream sender.
 func SendRepoStream(ctx context.Context, appPath, rootPath string, sender StreamSender, env []string, excludedGlobs []string, opts ...SenderOption) error {
@@ -135,6 +143,9 @@ func GetCompressedRepoAndMetadata(rootPath string, appPath string, env []string,
 	}
 	// send metadata first
 	mr := appMetadataRequest(filepath.Base(appPath), appRelPath, env, checksum, fi.Size())
+	if opt != nil {
+		mr.GetMetadata().Limits = opt.limits
+	}
 	return tgz, mr, err
 }
 
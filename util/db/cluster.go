@@ -119,7 +119,7 @@ func (db *db) CreateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		},
 	}
 
-	err = clusterToSecret(c, clusterSecret)
+	err = ClusterToSecret(c, clusterSecret)
 	if err != nil {
 		return nil, err
 	}
@@ -340,7 +340,7 @@ func (db *db) UpdateCluster(ctx context.Context, c *appv1.Cluster) (*appv1.Clust
 		}
 		return nil, err
 	}
-	if err := clusterToSecret(c, clusterSecret); err != nil {
+	if err := ClusterToSecret(c, clusterSecret); err != nil {
 		return nil, err
 	}
 
@@ -371,8 +371,8 @@ func (db *db) DeleteCluster(ctx context.Context, server string) error {
 	return db.settingsMgr.ResyncInformers()
 }
 
-// clusterToSecret converts a cluster object to string data for serialization to a secret
-func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
+// ClusterToSecret converts a cluster object to string data for serialization to a secret
+func ClusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	data := make(map[string][]byte)
 	data["server"] = []byte(strings.TrimRight(c.Server, "/"))
 	if c.Name == "" {
@@ -394,6 +394,12 @@ func clusterToSecret(c *appv1.Cluster, secret *corev1.Secret) error {
 	if c.ClusterResources {
 		data["clusterResources"] = []byte("true")
 	}
+	if c.NamespacedCache {
+		data["namespacedCache"] = []byte("true")
+	}
+	if c.Cordoned {
+		data["cordoned"] = []byte("true")
+	}
 	if c.Project != "" {
 		data["project"] = []byte(c.Project)
 	}
@@ -472,6 +478,8 @@ func SecretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		Name:               string(s.Data["name"]),
 		Namespaces:         namespaces,
 		ClusterResources:   string(s.Data["clusterResources"]) == "true",
+		NamespacedCache:    string(s.Data["namespacedCache"]) == "true",
+		Cordoned:           string(s.Data["cordoned"]) == "true",
 		Config:             config,
 		RefreshRequestedAt: refreshRequestedAt,
 		Shard:              shard,
@@ -106,7 +106,7 @@ func TestClusterToSecret(t *testing.T) {
 		Namespaces:  []string{"default"},
 	}
 	s := &corev1.Secret{}
-	err := clusterToSecret(cluster, s)
+	err := ClusterToSecret(cluster, s)
 	require.NoError(t, err)
 
 	assert.Equal(t, []byte(cluster.Server), s.Data["server"])
@@ -115,6 +115,29 @@ func TestClusterToSecret(t *testing.T) {
 	assert.Equal(t, []byte("default"), s.Data["namespaces"])
 	assert.Equal(t, cluster.Annotations, s.Annotations)
 	assert.Equal(t, cluster.Labels, s.Labels)
+	assert.NotContains(t, s.Data, "namespacedCache")
+}
+
+func TestClusterToSecret_NamespacedCache(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "server", Name: "test", NamespacedCache: true}
+	s := &corev1.Secret{}
+	require.NoError(t, ClusterToSecret(cluster, s))
+	assert.Equal(t, []byte("true"), s.Data["namespacedCache"])
+
+	converted, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.True(t, converted.NamespacedCache)
+}
+
+func TestClusterToSecret_Cordoned(t *testing.T) {
+	cluster := &v1alpha1.Cluster{Server: "server", Name: "test", Cordoned: true}
+	s := &corev1.Secret{}
+	require.NoError(t, ClusterToSecret(cluster, s))
+	assert.Equal(t, []byte("true"), s.Data["cordoned"])
+
+	converted, err := SecretToCluster(s)
+	require.NoError(t, err)
+	assert.True(t, converted.Cordoned)
 }
 
 func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
@@ -127,7 +150,7 @@ func TestClusterToSecret_LastAppliedConfigurationRejected(t *testing.T) {
 		Namespaces:  []string{"default"},
 	}
 	s := &corev1.Secret{}
-	err := clusterToSecret(cluster, s)
+	err := ClusterToSecret(cluster, s)
 	require.Error(t, err)
 	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
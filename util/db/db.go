@@ -84,6 +84,12 @@ type ArgoDB interface {
 	UpdateRepositoryCredentials(ctx context.Context, r *appv1.RepoCreds) (*appv1.RepoCreds, error)
 	// DeleteRepositoryCredentials deletes a repository credential set from config
 	DeleteRepositoryCredentials(ctx context.Context, name string) error
+	// ListProjectRepositoryCredentials list all project scoped repo credential sets
+	ListProjectRepositoryCredentials(ctx context.Context) ([]*appv1.RepoCreds, error)
+	// GetProjectRepositoryCredentials gets the repo credentials of the given project for given URL
+	GetProjectRepositoryCredentials(ctx context.Context, name, project string) (*appv1.RepoCreds, error)
+	// DeleteProjectRepositoryCredentials deletes a project scoped repository credential set
+	DeleteProjectRepositoryCredentials(ctx context.Context, name, project string) error
 
 	// ListWriteRepositoryCredentials list all repo write credential sets URL patterns
 	ListWriteRepositoryCredentials(ctx context.Context) ([]string, error)
@@ -643,6 +643,69 @@ func (_c *ArgoDB_DeleteGPGPublicKey_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// DeleteProjectRepositoryCredentials provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) DeleteProjectRepositoryCredentials(ctx context.Context, name string, project string) error {
+	ret := _mock.Called(ctx, name, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProjectRepositoryCredentials")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, project)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArgoDB_DeleteProjectRepositoryCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProjectRepositoryCredentials'
+type ArgoDB_DeleteProjectRepositoryCredentials_Call struct {
+	*mock.Call
+}
+
+// DeleteProjectRepositoryCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - project string
+func (_e *ArgoDB_Expecter) DeleteProjectRepositoryCredentials(ctx any, name any, project any) *ArgoDB_DeleteProjectRepositoryCredentials_Call {
+	return &ArgoDB_DeleteProjectRepositoryCredentials_Call{Call: _e.mock.On("DeleteProjectRepositoryCredentials", ctx, name, project)}
+}
+
+func (_c *ArgoDB_DeleteProjectRepositoryCredentials_Call) Run(run func(ctx context.Context, name string, project string)) *ArgoDB_DeleteProjectRepositoryCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_DeleteProjectRepositoryCredentials_Call) Return(err error) *ArgoDB_DeleteProjectRepositoryCredentials_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArgoDB_DeleteProjectRepositoryCredentials_Call) RunAndReturn(run func(ctx context.Context, name string, project string) error) *ArgoDB_DeleteProjectRepositoryCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteRepository provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) DeleteRepository(ctx context.Context, name string, project string) error {
 	ret := _mock.Called(ctx, name, project)
@@ -1317,6 +1380,80 @@ func (_c *ArgoDB_GetProjectRepositories_Call) RunAndReturn(run func(project stri
 	return _c
 }
 
+// GetProjectRepositoryCredentials provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) GetProjectRepositoryCredentials(ctx context.Context, name string, project string) (*v1alpha1.RepoCreds, error) {
+	ret := _mock.Called(ctx, name, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectRepositoryCredentials")
+	}
+
+	var r0 *v1alpha1.RepoCreds
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*v1alpha1.RepoCreds, error)); ok {
+		return returnFunc(ctx, name, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *v1alpha1.RepoCreds); ok {
+		r0 = returnFunc(ctx, name, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RepoCreds)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, name, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_GetProjectRepositoryCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectRepositoryCredentials'
+type ArgoDB_GetProjectRepositoryCredentials_Call struct {
+	*mock.Call
+}
+
+// GetProjectRepositoryCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - project string
+func (_e *ArgoDB_Expecter) GetProjectRepositoryCredentials(ctx any, name any, project any) *ArgoDB_GetProjectRepositoryCredentials_Call {
+	return &ArgoDB_GetProjectRepositoryCredentials_Call{Call: _e.mock.On("GetProjectRepositoryCredentials", ctx, name, project)}
+}
+
+func (_c *ArgoDB_GetProjectRepositoryCredentials_Call) Run(run func(ctx context.Context, name string, project string)) *ArgoDB_GetProjectRepositoryCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_GetProjectRepositoryCredentials_Call) Return(repoCreds *v1alpha1.RepoCreds, err error) *ArgoDB_GetProjectRepositoryCredentials_Call {
+	_c.Call.Return(repoCreds, err)
+	return _c
+}
+
+func (_c *ArgoDB_GetProjectRepositoryCredentials_Call) RunAndReturn(run func(ctx context.Context, name string, project string) (*v1alpha1.RepoCreds, error)) *ArgoDB_GetProjectRepositoryCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProjectWriteRepositories provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) GetProjectWriteRepositories(project string) ([]*v1alpha1.Repository, error) {
 	ret := _mock.Called(project)
@@ -1911,6 +2048,68 @@ func (_c *ArgoDB_ListOCIRepositories_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// ListProjectRepositoryCredentials provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) ListProjectRepositoryCredentials(ctx context.Context) ([]*v1alpha1.RepoCreds, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProjectRepositoryCredentials")
+	}
+
+	var r0 []*v1alpha1.RepoCreds
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]*v1alpha1.RepoCreds, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []*v1alpha1.RepoCreds); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1alpha1.RepoCreds)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_ListProjectRepositoryCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjectRepositoryCredentials'
+type ArgoDB_ListProjectRepositoryCredentials_Call struct {
+	*mock.Call
+}
+
+// ListProjectRepositoryCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ArgoDB_Expecter) ListProjectRepositoryCredentials(ctx any) *ArgoDB_ListProjectRepositoryCredentials_Call {
+	return &ArgoDB_ListProjectRepositoryCredentials_Call{Call: _e.mock.On("ListProjectRepositoryCredentials", ctx)}
+}
+
+func (_c *ArgoDB_ListProjectRepositoryCredentials_Call) Run(run func(ctx context.Context)) *ArgoDB_ListProjectRepositoryCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_ListProjectRepositoryCredentials_Call) Return(repoCredss []*v1alpha1.RepoCreds, err error) *ArgoDB_ListProjectRepositoryCredentials_Call {
+	_c.Call.Return(repoCredss, err)
+	return _c
+}
+
+func (_c *ArgoDB_ListProjectRepositoryCredentials_Call) RunAndReturn(run func(ctx context.Context) ([]*v1alpha1.RepoCreds, error)) *ArgoDB_ListProjectRepositoryCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListRepoCertificates provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) ListRepoCertificates(ctx context.Context, selector *db.CertificateListSelector) (*v1alpha1.RepositoryCertificateList, error) {
 	ret := _mock.Called(ctx, selector)
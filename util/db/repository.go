@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"maps"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -45,11 +47,12 @@ type repositoryBackend interface {
 	RepositoryExists(ctx context.Context, repoURL, project string, allowFallback bool) (bool, error)
 
 	CreateRepoCreds(ctx context.Context, r *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error)
-	GetRepoCreds(ctx context.Context, repoURL string) (*v1alpha1.RepoCreds, error)
+	GetRepoCreds(ctx context.Context, repoURL, project string) (*v1alpha1.RepoCreds, error)
 	ListRepoCreds(ctx context.Context) ([]string, error)
+	ListProjectRepoCreds(ctx context.Context) ([]*v1alpha1.RepoCreds, error)
 	UpdateRepoCreds(ctx context.Context, r *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error)
-	DeleteRepoCreds(ctx context.Context, name string) error
-	RepoCredsExists(ctx context.Context, repoURL string) (bool, error)
+	DeleteRepoCreds(ctx context.Context, name, project string) error
+	RepoCredsExists(ctx context.Context, repoURL, project string) (bool, error)
 
 	GetAllHelmRepoCreds(ctx context.Context) ([]*v1alpha1.RepoCreds, error)
 	GetAllOCIRepoCreds(ctx context.Context) ([]*v1alpha1.RepoCreds, error)
@@ -90,10 +93,14 @@ func (db *db) GetRepository(ctx context.Context, repoURL, project string) (*v1al
 		return repository, fmt.Errorf("unable to get repository %q: %w", git.SanitizeRepoURL(repoURL), err)
 	}
 
-	if err := db.enrichCredsToRepo(ctx, repository); err != nil {
+	if err := db.enrichCredsToRepo(ctx, repository, project); err != nil {
 		return repository, fmt.Errorf("unable to enrich repository %q info with credentials: %w", git.SanitizeRepoURL(repoURL), err)
 	}
 
+	if err := db.enrichSubmoduleCredsToRepo(ctx, repository, project); err != nil {
+		return repository, fmt.Errorf("unable to enrich repository %q info with submodule credentials: %w", git.SanitizeRepoURL(repoURL), err)
+	}
+
 	return repository, err
 }
 
@@ -256,7 +263,7 @@ func (db *db) DeleteWriteRepository(ctx context.Context, repoURL, project string
 	return secretsBackend.DeleteRepository(ctx, repoURL, project)
 }
 
-// ListRepositoryCredentials returns a list of URLs that contain repo credential sets
+// ListRepositoryCredentials returns a list of URLs that contain repo credential sets, excluding the project scoped ones
 func (db *db) ListRepositoryCredentials(ctx context.Context) ([]string, error) {
 	secretRepoCreds, err := db.repoBackend().ListRepoCreds(ctx)
 	if err != nil {
@@ -266,6 +273,15 @@ func (db *db) ListRepositoryCredentials(ctx context.Context) ([]string, error) {
 	return secretRepoCreds, nil
 }
 
+// ListProjectRepositoryCredentials returns the project scoped repo credential sets
+func (db *db) ListProjectRepositoryCredentials(ctx context.Context) ([]*v1alpha1.RepoCreds, error) {
+	repoCreds, err := db.repoBackend().ListProjectRepoCreds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project repo creds: %w", err)
+	}
+	return repoCreds, nil
+}
+
 // ListWriteRepositoryCredentials returns a list of URLs that contain repo write credential sets
 func (db *db) ListWriteRepositoryCredentials(ctx context.Context) ([]string, error) {
 	secretRepoCreds, err := db.repoWriteBackend().ListRepoCreds(ctx)
@@ -277,12 +293,17 @@ func (db *db) ListWriteRepositoryCredentials(ctx context.Context) ([]string, err
 
 // GetRepositoryCredentials retrieves a repository credential set
 func (db *db) GetRepositoryCredentials(ctx context.Context, repoURL string) (*v1alpha1.RepoCreds, error) {
+	return db.GetProjectRepositoryCredentials(ctx, repoURL, "")
+}
+
+// GetProjectRepositoryCredentials retrieves a repository credential set scoped to the given project
+func (db *db) GetProjectRepositoryCredentials(ctx context.Context, repoURL, project string) (*v1alpha1.RepoCreds, error) {
 	secretsBackend := db.repoBackend()
-	exists, err := secretsBackend.RepoCredsExists(ctx, repoURL)
+	exists, err := secretsBackend.RepoCredsExists(ctx, repoURL, project)
 	if err != nil {
 		return nil, fmt.Errorf("unable to check if repository credentials for %q exists from secrets backend: %w", git.SanitizeRepoURL(repoURL), err)
 	} else if exists {
-		creds, err := secretsBackend.GetRepoCreds(ctx, repoURL)
+		creds, err := secretsBackend.GetRepoCreds(ctx, repoURL, project)
 		if err != nil {
 			return nil, fmt.Errorf("unable to get repository credentials for %q from secrets backend: %w", git.SanitizeRepoURL(repoURL), err)
 		}
@@ -295,7 +316,7 @@ func (db *db) GetRepositoryCredentials(ctx context.Context, repoURL string) (*v1
 // GetWriteRepositoryCredentials retrieves a repository write credential set
 func (db *db) GetWriteRepositoryCredentials(ctx context.Context, repoURL string) (*v1alpha1.RepoCreds, error) {
 	secretBackend := db.repoWriteBackend()
-	creds, err := secretBackend.GetRepoCreds(ctx, repoURL)
+	creds, err := secretBackend.GetRepoCreds(ctx, repoURL, "")
 	if err != nil {
 		if creds == nil {
 			return nil, fmt.Errorf("unable to check if repo write credentials for %q exists from secrets backend: %w", git.SanitizeRepoURL(repoURL), err)
@@ -348,7 +369,7 @@ func (db *db) CreateRepositoryCredentials(ctx context.Context, r *v1alpha1.RepoC
 // CreateWriteRepositoryCredentials creates a repository write credential set
 func (db *db) CreateWriteRepositoryCredentials(ctx context.Context, r *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error) {
 	secretBackend := db.repoWriteBackend()
-	secretExists, err := secretBackend.RepoCredsExists(ctx, r.URL)
+	secretExists, err := secretBackend.RepoCredsExists(ctx, r.URL, r.Project)
 	if err != nil {
 		return nil, err
 	}
@@ -363,7 +384,7 @@ func (db *db) CreateWriteRepositoryCredentials(ctx context.Context, r *v1alpha1.
 // UpdateRepositoryCredentials updates a repository credential set
 func (db *db) UpdateRepositoryCredentials(ctx context.Context, r *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error) {
 	secretsBackend := db.repoBackend()
-	exists, err := secretsBackend.RepoCredsExists(ctx, r.URL)
+	exists, err := secretsBackend.RepoCredsExists(ctx, r.URL, r.Project)
 	if err != nil {
 		return nil, err
 	} else if exists {
@@ -376,7 +397,7 @@ func (db *db) UpdateRepositoryCredentials(ctx context.Context, r *v1alpha1.RepoC
 // UpdateWriteRepositoryCredentials updates a repository write credential set
 func (db *db) UpdateWriteRepositoryCredentials(ctx context.Context, r *v1alpha1.RepoCreds) (*v1alpha1.RepoCreds, error) {
 	secretBackend := db.repoWriteBackend()
-	exists, err := secretBackend.RepoCredsExists(ctx, r.URL)
+	exists, err := secretBackend.RepoCredsExists(ctx, r.URL, r.Project)
 	if err != nil {
 		return nil, err
 	}
@@ -391,12 +412,17 @@ func (db *db) UpdateWriteRepositoryCredentials(ctx context.Context, r *v1alpha1.
 // DeleteRepositoryCredentials deletes a repository credential set from config, and
 // also all the secrets which actually contained the credentials.
 func (db *db) DeleteRepositoryCredentials(ctx context.Context, name string) error {
+	return db.DeleteProjectRepositoryCredentials(ctx, name, "")
+}
+
+// DeleteProjectRepositoryCredentials deletes a repository credential set scoped to the given project
+func (db *db) DeleteProjectRepositoryCredentials(ctx context.Context, name, project string) error {
 	secretsBackend := db.repoBackend()
-	exists, err := secretsBackend.RepoCredsExists(ctx, name)
+	exists, err := secretsBackend.RepoCredsExists(ctx, name, project)
 	if err != nil {
 		return err
 	} else if exists {
-		return secretsBackend.DeleteRepoCreds(ctx, name)
+		return secretsBackend.DeleteRepoCreds(ctx, name, project)
 	}
 
 	return status.Errorf(codes.NotFound, "repository credentials '%s' not found", name)
@@ -406,18 +432,18 @@ func (db *db) DeleteRepositoryCredentials(ctx context.Context, name string) erro
 // also all the secrets which actually contained the credentials.
 func (db *db) DeleteWriteRepositoryCredentials(ctx context.Context, name string) error {
 	secretBackend := db.repoWriteBackend()
-	exists, err := secretBackend.RepoCredsExists(ctx, name)
+	exists, err := secretBackend.RepoCredsExists(ctx, name, "")
 	if err != nil {
 		return err
 	} else if exists {
-		return secretBackend.DeleteRepoCreds(ctx, name)
+		return secretBackend.DeleteRepoCreds(ctx, name, "")
 	}
 	return status.Errorf(codes.NotFound, "write repository credentials '%s' not found", name)
 }
 
 func (db *db) enrichCredsToRepos(ctx context.Context, repositories []*v1alpha1.Repository) error {
 	for _, repository := range repositories {
-		if err := db.enrichCredsToRepo(ctx, repository); err != nil {
+		if err := db.enrichCredsToRepo(ctx, repository, repository.Project); err != nil {
 			return err
 		}
 	}
@@ -432,11 +458,23 @@ func (db *db) repoWriteBackend() repositoryBackend {
 	return &secretsRepositoryBackend{db: db, writeCreds: true}
 }
 
-func (db *db) enrichCredsToRepo(ctx context.Context, repository *v1alpha1.Repository) error {
+// enrichCredsToRepo copies the credentials of the matching credential template to a repository without credentials.
+// The templates scoped to the given project take precedence over the global ones.
+func (db *db) enrichCredsToRepo(ctx context.Context, repository *v1alpha1.Repository, project string) error {
 	if !repository.HasCredentials() {
-		creds, err := db.GetRepositoryCredentials(ctx, repository.Repo)
-		if err != nil {
-			return fmt.Errorf("failed to get repository credentials for %q: %w", repository.Repo, err)
+		var creds *v1alpha1.RepoCreds
+		var err error
+		if project != "" {
+			creds, err = db.GetProjectRepositoryCredentials(ctx, repository.Repo, project)
+			if err != nil {
+				return fmt.Errorf("failed to get project %q repository credentials for %q: %w", project, repository.Repo, err)
+			}
+		}
+		if creds == nil {
+			creds, err = db.GetRepositoryCredentials(ctx, repository.Repo)
+			if err != nil {
+				return fmt.Errorf("failed to get repository credentials for %q: %w", repository.Repo, err)
+			}
 		}
 		if creds != nil {
 			repository.CopyCredentialsFrom(creds)
@@ -449,6 +487,34 @@ func (db *db) enrichCredsToRepo(ctx context.Context, repository *v1alpha1.Reposi
 	return nil
 }
 
+// enrichSubmoduleCredsToRepo resolves the credentials of the submodules mapped by the submodule policy of a repository
+// to the credentials of other repositories or credential templates
+func (db *db) enrichSubmoduleCredsToRepo(ctx context.Context, repository *v1alpha1.Repository, project string) error {
+	policy := repository.SubmodulePolicy
+	if policy == nil || len(policy.CredentialMappings) == 0 {
+		return nil
+	}
+	policy.Credentials = nil
+	for _, submoduleURL := range slices.Sorted(maps.Keys(policy.CredentialMappings)) {
+		credsURL := policy.CredentialMappings[submoduleURL]
+		credsRepo, err := db.getRepository(ctx, credsURL, project)
+		if err != nil {
+			return err
+		}
+		if err := db.enrichCredsToRepo(ctx, credsRepo, project); err != nil {
+			return err
+		}
+		if !credsRepo.HasCredentials() {
+			log.Warnf("No credentials found for %s, to which submodule %s of %s is mapped", git.SanitizeRepoURL(credsURL), git.SanitizeRepoURL(submoduleURL), git.SanitizeRepoURL(repository.Repo))
+			continue
+		}
+		creds := &v1alpha1.Repository{Repo: submoduleURL, Insecure: credsRepo.Insecure, Proxy: credsRepo.Proxy, NoProxy: credsRepo.NoProxy}
+		creds.CopyCredentialsFromRepo(credsRepo)
+		policy.Credentials = append(policy.Credentials, creds)
+	}
+	return nil
+}
+
 func (db *db) enrichWriteCredsToRepo(ctx context.Context, repository *v1alpha1.Repository) error {
 	if !repository.HasCredentials() {
 		creds, err := db.GetWriteRepositoryCredentials(ctx, repository.Repo)
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -183,7 +184,7 @@ func (s *secretsRepositoryBackend) CreateRepoCreds(ctx context.Context, repoCred
 	if s.writeCreds {
 		secretPrefix = credWriteSecretPrefix
 	}
-	secName := RepoURLToSecretName(secretPrefix, repoCreds.URL, "")
+	secName := RepoURLToSecretName(secretPrefix, repoCreds.URL, repoCreds.Project)
 
 	repoCredsSecret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -204,8 +205,8 @@ func (s *secretsRepositoryBackend) CreateRepoCreds(ctx context.Context, repoCred
 	return repoCreds, s.db.settingsMgr.ResyncInformers()
 }
 
-func (s *secretsRepositoryBackend) GetRepoCreds(_ context.Context, repoURL string) (*appsv1.RepoCreds, error) {
-	secret, err := s.getRepoCredsSecret(repoURL)
+func (s *secretsRepositoryBackend) GetRepoCreds(_ context.Context, repoURL, project string) (*appsv1.RepoCreds, error) {
+	secret, err := s.getRepoCredsSecret(repoURL, project)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return nil, nil
@@ -226,14 +227,39 @@ func (s *secretsRepositoryBackend) ListRepoCreds(_ context.Context) ([]string, e
 	}
 
 	for _, secret := range secrets {
+		if string(secret.Data["project"]) != "" {
+			continue
+		}
 		repoURLs = append(repoURLs, string(secret.Data["url"]))
 	}
 
 	return repoURLs, nil
 }
 
+func (s *secretsRepositoryBackend) ListProjectRepoCreds(_ context.Context) ([]*appsv1.RepoCreds, error) {
+	var repoCreds []*appsv1.RepoCreds
+
+	secrets, err := s.db.listSecretsByType(s.getRepoCredSecretType())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range secrets {
+		if string(secret.Data["project"]) == "" {
+			continue
+		}
+		creds, err := s.secretToRepoCred(secret)
+		if err != nil {
+			return nil, err
+		}
+		repoCreds = append(repoCreds, creds)
+	}
+
+	return repoCreds, nil
+}
+
 func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCreds *appsv1.RepoCreds) (*appsv1.RepoCreds, error) {
-	repoCredsSecret, err := s.getRepoCredsSecret(repoCreds.URL)
+	repoCredsSecret, err := s.getRepoCredsSecret(repoCreds.URL, repoCreds.Project)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return s.CreateRepoCreds(ctx, repoCreds)
@@ -256,8 +282,8 @@ func (s *secretsRepositoryBackend) UpdateRepoCreds(ctx context.Context, repoCred
 	return updatedRepoCreds, s.db.settingsMgr.ResyncInformers()
 }
 
-func (s *secretsRepositoryBackend) DeleteRepoCreds(ctx context.Context, name string) error {
-	secret, err := s.getRepoCredsSecret(name)
+func (s *secretsRepositoryBackend) DeleteRepoCreds(ctx context.Context, name, project string) error {
+	secret, err := s.getRepoCredsSecret(name, project)
 	if err != nil {
 		return err
 	}
@@ -269,8 +295,8 @@ func (s *secretsRepositoryBackend) DeleteRepoCreds(ctx context.Context, name str
 	return s.db.settingsMgr.ResyncInformers()
 }
 
-func (s *secretsRepositoryBackend) RepoCredsExists(_ context.Context, repoURL string) (bool, error) {
-	_, err := s.getRepoCredsSecret(repoURL)
+func (s *secretsRepositoryBackend) RepoCredsExists(_ context.Context, repoURL, project string) (bool, error) {
+	_, err := s.getRepoCredsSecret(repoURL, project)
 	if err != nil {
 		if status.Code(err) == codes.NotFound {
 			return false, nil
@@ -291,7 +317,8 @@ func (s *secretsRepositoryBackend) GetAllHelmRepoCreds(_ context.Context) ([]*ap
 	}
 
 	for _, secret := range secrets {
-		if strings.EqualFold(string(secret.Data["type"]), "helm") {
+		// project scoped credentials are only used by the repositories of their project
+		if strings.EqualFold(string(secret.Data["type"]), "helm") && string(secret.Data["project"]) == "" {
 			repoCreds, err := s.secretToRepoCred(secret)
 			if err != nil {
 				return nil, err
@@ -313,7 +340,8 @@ func (s *secretsRepositoryBackend) GetAllOCIRepoCreds(_ context.Context) ([]*app
 	}
 
 	for _, secret := range secrets {
-		if strings.EqualFold(string(secret.Data["type"]), "oci") {
+		// project scoped credentials are only used by the repositories of their project
+		if strings.EqualFold(string(secret.Data["type"]), "oci") && string(secret.Data["project"]) == "" {
 			repoCreds, err := s.secretToRepoCred(secret)
 			if err != nil {
 				return nil, err
@@ -417,9 +445,56 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 	}
 	repository.WebhookManifestCacheWarmDisabled = webhookManifestCacheWarmDisabled
 
+	sparseCheckout, err := boolOrFalse(secret, "sparseCheckout")
+	if err != nil {
+		return repository, err
+	}
+	repository.SparseCheckout = sparseCheckout
+
+	registryMirrors, err := stringMapOrNil(secret, "registryMirrors")
+	if err != nil {
+		return repository, err
+	}
+	repository.RegistryMirrors = registryMirrors
+
+	limits := appsv1.ManifestGenerationLimits{
+		CPUTime: string(secretCopy.Data["manifestGenerationCPUTime"]),
+		Memory:  string(secretCopy.Data["manifestGenerationMemory"]),
+		Timeout: string(secretCopy.Data["manifestGenerationTimeout"]),
+	}
+	if limits != (appsv1.ManifestGenerationLimits{}) {
+		repository.ManifestGenerationLimits = &limits
+	}
+
+	submodulesDisabled, err := boolOrFalse(secret, "submodulesDisabled")
+	if err != nil {
+		return repository, err
+	}
+	submoduleRecursionDepth, err := intOrZero(secret, "submoduleRecursionDepth")
+	if err != nil {
+		return repository, err
+	}
+	submoduleCredentialMappings, err := stringMapOrNil(secret, "submoduleCredentialMappings")
+	if err != nil {
+		return repository, err
+	}
+	if submodulesDisabled || submoduleRecursionDepth != 0 || submoduleCredentialMappings != nil {
+		repository.SubmodulePolicy = &appsv1.SubmodulePolicy{
+			Disabled:           submodulesDisabled,
+			RecursionDepth:     submoduleRecursionDepth,
+			CredentialMappings: submoduleCredentialMappings,
+		}
+	}
+
 	return repository, nil
 }
 
+// RepositoryToSecret updates the given secret with the data from the repository object, using the same format as
+// the repository secrets created through the API. It does not add any name or namespace metadata.
+func RepositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) *corev1.Secret {
+	return (&secretsRepositoryBackend{}).repositoryToSecret(repository, secret)
+}
+
 // repositoryToSecret updates the given secret with the data from the repository object. It adds the appropriate
 // labels/annotations, but it does not add any name or namespace metadata.
 func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Repository, secret *corev1.Secret) *corev1.Secret {
@@ -455,6 +530,22 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretBool(secretCopy, "useAzureWorkloadIdentity", repository.UseAzureWorkloadIdentity)
 	updateSecretInt(secretCopy, "depth", repository.Depth)
 	updateSecretBool(secretCopy, "webhookManifestCacheWarmDisabled", repository.WebhookManifestCacheWarmDisabled)
+	updateSecretBool(secretCopy, "sparseCheckout", repository.SparseCheckout)
+	updateSecretStringMap(secretCopy, "registryMirrors", repository.RegistryMirrors)
+	var limits appsv1.ManifestGenerationLimits
+	if repository.ManifestGenerationLimits != nil {
+		limits = *repository.ManifestGenerationLimits
+	}
+	updateSecretString(secretCopy, "manifestGenerationCPUTime", limits.CPUTime)
+	updateSecretString(secretCopy, "manifestGenerationMemory", limits.Memory)
+	updateSecretString(secretCopy, "manifestGenerationTimeout", limits.Timeout)
+	var submodulePolicy appsv1.SubmodulePolicy
+	if repository.SubmodulePolicy != nil {
+		submodulePolicy = *repository.SubmodulePolicy
+	}
+	updateSecretBool(secretCopy, "submodulesDisabled", submodulePolicy.Disabled)
+	updateSecretInt(secretCopy, "submoduleRecursionDepth", submodulePolicy.RecursionDepth)
+	updateSecretStringMap(secretCopy, "submoduleCredentialMappings", submodulePolicy.CredentialMappings)
 	updateSecretString(secretCopy, "azureServicePrincipalClientId", repository.AzureServicePrincipalClientId)
 	updateSecretString(secretCopy, "azureServicePrincipalClientSecret", repository.AzureServicePrincipalClientSecret)
 	updateSecretString(secretCopy, "azureServicePrincipalTenantId", repository.AzureServicePrincipalTenantId)
@@ -485,6 +576,7 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		AzureServicePrincipalClientSecret: string(secretCopy.Data["azureServicePrincipalClientSecret"]),
 		AzureServicePrincipalTenantId:     string(secretCopy.Data["azureServicePrincipalTenantID"]),
 		AzureActiveDirectoryEndpoint:      string(secretCopy.Data["azureActiveDirectoryEndpoint"]),
+		Project:                           string(secretCopy.Data["project"]),
 	}
 
 	enableOCI, err := boolOrFalse(secretCopy, "enableOCI")
@@ -556,6 +648,7 @@ func (s *secretsRepositoryBackend) repoCredsToSecret(repoCreds *appsv1.RepoCreds
 	updateSecretString(secretCopy, "azureServicePrincipalClientSecret", repoCreds.AzureServicePrincipalClientSecret)
 	updateSecretString(secretCopy, "azureServicePrincipalTenantID", repoCreds.AzureServicePrincipalTenantId)
 	updateSecretString(secretCopy, "azureActiveDirectoryEndpoint", repoCreds.AzureActiveDirectoryEndpoint)
+	updateSecretString(secretCopy, "project", repoCreds.Project)
 	addSecretMetadata(secretCopy, s.getRepoCredSecretType())
 
 	return secretCopy
@@ -598,11 +691,16 @@ func (s *secretsRepositoryBackend) getRepositorySecret(repoURL, project string,
 	return nil, status.Errorf(codes.NotFound, "repository %q not found", git.SanitizeRepoURL(repoURL))
 }
 
-func (s *secretsRepositoryBackend) getRepoCredsSecret(repoURL string) (*corev1.Secret, error) {
+// getRepoCredsSecret returns the credential template of the given project with the longest URL prefix of the repository
+// URL. The templates of all projects are ignored if the project is empty.
+func (s *secretsRepositoryBackend) getRepoCredsSecret(repoURL, project string) (*corev1.Secret, error) {
 	secrets, err := s.db.listSecretsByType(s.getRepoCredSecretType())
 	if err != nil {
 		return nil, err
 	}
+	secrets = slices.DeleteFunc(secrets, func(secret *corev1.Secret) bool {
+		return string(secret.Data["project"]) != project
+	})
 
 	index := s.getRepositoryCredentialIndex(secrets, repoURL)
 	if index < 0 {
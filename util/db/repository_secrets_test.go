@@ -670,14 +670,14 @@ func TestSecretsRepositoryBackend_GetRepoCreds(t *testing.T) {
 		settingsMgr:   settings.NewSettingsManager(t.Context(), clientset, testNamespace),
 	}}
 
-	repoCred, err := testee.GetRepoCreds(t.Context(), "git@github.com:argoproj")
+	repoCred, err := testee.GetRepoCreds(t.Context(), "git@github.com:argoproj", "")
 	require.NoError(t, err)
 	require.NotNil(t, repoCred)
 	assert.Equal(t, "git@github.com:argoproj", repoCred.URL)
 	assert.Equal(t, "someUsername", repoCred.Username)
 	assert.Equal(t, "somePassword", repoCred.Password)
 
-	repoCred, err = testee.GetRepoCreds(t.Context(), "git@gitlab.com")
+	repoCred, err = testee.GetRepoCreds(t.Context(), "git@gitlab.com", "")
 	require.NoError(t, err)
 	assert.NotNil(t, repoCred)
 	assert.Equal(t, "git@gitlab.com", repoCred.URL)
@@ -857,13 +857,13 @@ func TestSecretsRepositoryBackend_DeleteRepoCreds(t *testing.T) {
 		settingsMgr:   settings.NewSettingsManager(t.Context(), clientset, testNamespace),
 	}}
 
-	err := testee.DeleteRepoCreds(t.Context(), "git@github.com:argoproj")
+	err := testee.DeleteRepoCreds(t.Context(), "git@github.com:argoproj", "")
 	require.NoError(t, err)
 
 	_, err = clientset.CoreV1().Secrets(testNamespace).Get(t.Context(), managedSecretName, metav1.GetOptions{})
 	require.Error(t, err)
 
-	err = testee.DeleteRepoCreds(t.Context(), "git@gitlab.com")
+	err = testee.DeleteRepoCreds(t.Context(), "git@gitlab.com", "")
 	require.NoError(t, err)
 
 	secret, err := clientset.CoreV1().Secrets(testNamespace).Get(t.Context(), "user-managed", metav1.GetOptions{})
@@ -1239,6 +1239,10 @@ func TestRepositoryToSecret(t *testing.T) {
 		UseAzureWorkloadIdentity:         true,
 		Depth:                            1,
 		WebhookManifestCacheWarmDisabled: true,
+		SparseCheckout:                   true,
+		ManifestGenerationLimits:         &appsv1.ManifestGenerationLimits{CPUTime: "30s", Memory: "512Mi", Timeout: "2m"},
+		SubmodulePolicy:                  &appsv1.SubmodulePolicy{Disabled: true, RecursionDepth: 2, CredentialMappings: map[string]string{"https://example.com/b.git": "https://example.com", "https://example.com/a.git": "https://example.com"}},
+		RegistryMirrors:                  map[string]string{"ghcr.io": "registry.example.com/ghcr", "docker.io": "registry.example.com/docker-hub"},
 	}
 	s = testee.repositoryToSecret(repo, s)
 	assert.Equal(t, []byte(repo.Name), s.Data["name"])
@@ -1266,10 +1270,30 @@ func TestRepositoryToSecret(t *testing.T) {
 	assert.Equal(t, []byte(strconv.FormatBool(repo.UseAzureWorkloadIdentity)), s.Data["useAzureWorkloadIdentity"])
 	assert.Equal(t, []byte(strconv.FormatInt(repo.Depth, 10)), s.Data["depth"])
 	assert.Equal(t, []byte(strconv.FormatBool(repo.WebhookManifestCacheWarmDisabled)), s.Data["webhookManifestCacheWarmDisabled"])
+	assert.Equal(t, []byte(strconv.FormatBool(repo.SparseCheckout)), s.Data["sparseCheckout"])
+	assert.Equal(t, []byte("30s"), s.Data["manifestGenerationCPUTime"])
+	assert.Equal(t, []byte("512Mi"), s.Data["manifestGenerationMemory"])
+	assert.Equal(t, []byte("2m"), s.Data["manifestGenerationTimeout"])
+	assert.Equal(t, []byte("true"), s.Data["submodulesDisabled"])
+	assert.Equal(t, []byte("2"), s.Data["submoduleRecursionDepth"])
+	assert.Equal(t, []byte("https://example.com/a.git=https://example.com,https://example.com/b.git=https://example.com"), s.Data["submoduleCredentialMappings"])
+	assert.Equal(t, []byte("docker.io=registry.example.com/docker-hub,ghcr.io=registry.example.com/ghcr"), s.Data["registryMirrors"])
 	assert.Equal(t, map[string]string{common.AnnotationKeyManagedBy: common.AnnotationValueManagedByArgoCD}, s.Annotations)
 	assert.Equal(t, map[string]string{common.LabelKeySecretType: common.LabelValueSecretTypeRepository}, s.Labels)
 }
 
+func TestSecretToRepository_RegistryMirrors(t *testing.T) {
+	repo, err := secretToRepository(&corev1.Secret{Data: map[string][]byte{
+		"url":             []byte("registry-1.docker.io/bitnamicharts"),
+		"registryMirrors": []byte("docker.io=registry.example.com/docker-hub, ghcr.io = registry.example.com/ghcr"),
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"docker.io": "registry.example.com/docker-hub", "ghcr.io": "registry.example.com/ghcr"}, repo.RegistryMirrors)
+
+	_, err = secretToRepository(&corev1.Secret{Data: map[string][]byte{"registryMirrors": []byte("docker.io")}})
+	require.ErrorContains(t, err, "invalid key-value pair")
+}
+
 func TestCreateReadAndWriteRepoCredsSecretForSameURL(t *testing.T) {
 	clientset := getClientset()
 	settingsMgr := settings.NewSettingsManager(t.Context(), clientset, testNamespace)
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -307,6 +309,82 @@ func TestDb_GetRepositoryCredentials(t *testing.T) {
 	assert.Nil(t, repoCreds)
 }
 
+func TestDb_ProjectRepositoryCredentials(t *testing.T) {
+	t.Parallel()
+	clientset := getClientset()
+	testee := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+	_, err := testee.CreateRepositoryCredentials(t.Context(), &appsv1.RepoCreds{URL: "https://github.com/argoproj", Username: "global", Password: "global"})
+	require.NoError(t, err)
+	_, err = testee.CreateRepositoryCredentials(t.Context(), &appsv1.RepoCreds{URL: "https://github.com/argoproj", Username: "tenant", Password: "tenant", Project: "tenant"})
+	require.NoError(t, err)
+
+	urls, err := testee.ListRepositoryCredentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://github.com/argoproj"}, urls)
+	projectCreds, err := testee.ListProjectRepositoryCredentials(t.Context())
+	require.NoError(t, err)
+	require.Len(t, projectCreds, 1)
+	assert.Equal(t, "tenant", projectCreds[0].Project)
+
+	creds, err := testee.GetRepositoryCredentials(t.Context(), "https://github.com/argoproj/argo-cd")
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+	assert.Equal(t, "global", creds.Username)
+	creds, err = testee.GetProjectRepositoryCredentials(t.Context(), "https://github.com/argoproj/argo-cd", "tenant")
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+	assert.Equal(t, "tenant", creds.Username)
+
+	// the repositories of the project inherit the credentials of the project, the others the global ones
+	repo, err := testee.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "tenant")
+	require.NoError(t, err)
+	assert.Equal(t, "tenant", repo.Username)
+	assert.True(t, repo.InheritedCreds)
+	repo, err = testee.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "other")
+	require.NoError(t, err)
+	assert.Equal(t, "global", repo.Username)
+
+	require.NoError(t, testee.DeleteProjectRepositoryCredentials(t.Context(), "https://github.com/argoproj", "tenant"))
+	repo, err = testee.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "tenant")
+	require.NoError(t, err)
+	assert.Equal(t, "global", repo.Username)
+	err = testee.DeleteProjectRepositoryCredentials(t.Context(), "https://github.com/argoproj", "tenant")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDb_GetRepository_SubmoduleCredentials(t *testing.T) {
+	t.Parallel()
+	clientset := getClientset()
+	testee := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+	_, err := testee.CreateRepositoryCredentials(t.Context(), &appsv1.RepoCreds{URL: "https://gitlab.example.com/vendor", Username: "vendor", Password: "vendor"})
+	require.NoError(t, err)
+	_, err = testee.CreateRepository(t.Context(), &appsv1.Repository{
+		Repo:     "https://github.com/argoproj/argo-cd",
+		Username: "argo",
+		Password: "argo",
+		SubmodulePolicy: &appsv1.SubmodulePolicy{
+			RecursionDepth: 1,
+			CredentialMappings: map[string]string{
+				"https://gitlab.example.com/vendor/lib.git": "https://gitlab.example.com/vendor",
+				"https://example.com/unknown.git":           "https://example.com/unknown",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	repo, err := testee.GetRepository(t.Context(), "https://github.com/argoproj/argo-cd", "")
+	require.NoError(t, err)
+	require.NotNil(t, repo.SubmodulePolicy)
+	assert.Equal(t, int64(1), repo.SubmodulePolicy.RecursionDepth)
+	require.Len(t, repo.SubmodulePolicy.Credentials, 1, "the submodules mapped to unknown credentials use the credentials of the repository")
+	assert.Equal(t, "https://gitlab.example.com/vendor/lib.git", repo.SubmodulePolicy.Credentials[0].Repo)
+	assert.Equal(t, "vendor", repo.SubmodulePolicy.Credentials[0].Username)
+	assert.Equal(t, "vendor", repo.SubmodulePolicy.Credentials[0].Password)
+	assert.Nil(t, repo.Sanitized().SubmodulePolicy.Credentials)
+}
+
 func TestRepoURLToSecretName(t *testing.T) {
 	t.Parallel()
 	tables := []struct {
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"maps"
 	"net/netip"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -64,6 +66,23 @@ func intOrZero(secret *corev1.Secret, key string) (int64, error) {
 	return strconv.ParseInt(string(val), 10, 64)
 }
 
+// stringMapOrNil parses a map stored as comma separated key=value pairs, e.g. `key1=value1,key2=value2`
+func stringMapOrNil(secret *corev1.Secret, key string) (map[string]string, error) {
+	val := strings.TrimSpace(string(secret.Data[key]))
+	if val == "" {
+		return nil, nil
+	}
+	parsed := make(map[string]string)
+	for pair := range strings.SplitSeq(val, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid key-value pair %q in %s", pair, key)
+		}
+		parsed[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return parsed, nil
+}
+
 func updateSecretBool(secret *corev1.Secret, key string, value bool) {
 	if _, present := secret.Data[key]; present || value {
 		secret.Data[key] = []byte(strconv.FormatBool(value))
@@ -76,6 +95,14 @@ func updateSecretInt(secret *corev1.Secret, key string, value int64) {
 	}
 }
 
+func updateSecretStringMap(secret *corev1.Secret, key string, value map[string]string) {
+	pairs := make([]string, 0, len(value))
+	for _, k := range slices.Sorted(maps.Keys(value)) {
+		pairs = append(pairs, k+"="+value[k])
+	}
+	updateSecretString(secret, key, strings.Join(pairs, ","))
+}
+
 func updateSecretString(secret *corev1.Secret, key, value string) {
 	if _, present := secret.Data[key]; present || value != "" {
 		secret.Data[key] = []byte(value)
@@ -35,6 +35,8 @@ type ExecRunOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr determines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// Limits defines the resources that the command is allowed to use
+	Limits ResourceLimits
 }
 
 func init() {
@@ -63,7 +65,7 @@ func RunWithRedactor(cmd *exec.Cmd, redactor func(text string) string) (string,
 }
 
 func RunWithExecRunOpts(cmd *exec.Cmd, opts ExecRunOpts) (string, error) {
-	cmdOpts := CmdOpts{Timeout: timeout, FatalTimeout: fatalTimeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging, CaptureStderr: opts.CaptureStderr}
+	cmdOpts := CmdOpts{Timeout: timeout, FatalTimeout: fatalTimeout, Redactor: opts.Redactor, TimeoutBehavior: opts.TimeoutBehavior, SkipErrorLogging: opts.SkipErrorLogging, CaptureStderr: opts.CaptureStderr, Limits: opts.Limits}
 	span := tracing.NewLoggingTracer(log.NewLogrusLogger(log.NewWithCurrentConfig())).StartSpan(fmt.Sprintf("exec %v", cmd.Args[0]))
 	span.SetBaggageItem("dir", cmd.Dir)
 	if cmdOpts.Redactor != nil {
@@ -116,6 +118,10 @@ func (ce *CmdError) Error() string {
 	return res
 }
 
+func (ce *CmdError) Unwrap() error {
+	return ce.Cause
+}
+
 func (ce *CmdError) String() string {
 	return ce.Error()
 }
@@ -146,6 +152,8 @@ type CmdOpts struct {
 	SkipErrorLogging bool
 	// CaptureStderr defines whether to capture stderr in addition to stdout
 	CaptureStderr bool
+	// Limits defines the resources that the command is allowed to use
+	Limits ResourceLimits
 }
 
 var DefaultCmdOpts = CmdOpts{
@@ -194,6 +202,11 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if err := SetResourceLimits(cmd, opts.Limits); err != nil {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+		return "", err
+	}
 
 	done := make(chan error)
 	go func() { done <- cmd.Wait() }()
@@ -206,6 +219,10 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 		timeout = opts.Timeout
 	}
 
+	if opts.Limits.Timeout > 0 {
+		timeout = opts.Limits.Timeout
+	}
+
 	if opts.FatalTimeout != time.Duration(0) {
 		fatalTimeout = opts.FatalTimeout
 	}
@@ -257,7 +274,11 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 			output += stderr.String()
 		}
 		logCtx.WithFields(logrus.Fields{"duration": time.Since(start)}).Debug(redactor(output))
-		err = newCmdError(redactor(args), fmt.Errorf("timeout after %v", timeout), "")
+		var cause error = fmt.Errorf("timeout after %v", timeout)
+		if opts.Limits.Timeout > 0 {
+			cause = newResourceLimitExceededError(cmd, "timeout of %v", timeout)
+		}
+		err = newCmdError(redactor(args), cause, "")
 		logCtx.Error(err.Error())
 		return strings.TrimSuffix(output, "\n"), err
 	case err := <-done:
@@ -267,7 +288,11 @@ func RunCommandExt(cmd *exec.Cmd, opts CmdOpts) (string, error) {
 				output += stderr.String()
 			}
 			logCtx.WithFields(logrus.Fields{"duration": time.Since(start)}).Debug(redactor(output))
-			err := newCmdError(redactor(args), errors.New(redactor(err.Error())), strings.TrimSpace(redactor(stderr.String())))
+			cause := errors.New(redactor(err.Error()))
+			if limitErr := ResourceLimitExceeded(cmd, opts.Limits, stderr.String()); limitErr != nil {
+				cause = limitErr
+			}
+			err := newCmdError(redactor(args), cause, strings.TrimSpace(redactor(stderr.String())))
 			if !opts.SkipErrorLogging {
 				logCtx.Error(err.Error())
 			}
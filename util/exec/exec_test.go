@@ -226,3 +226,33 @@ func TestRunWithExecRunOptsCaptureStderr(t *testing.T) {
 	assert.Equal(t, "hello world\nmy-error", output)
 	assert.NoError(t, err)
 }
+
+func TestRunCommandTimeoutLimit(t *testing.T) {
+	_, err := RunCommand("sh", CmdOpts{Timeout: time.Minute, Limits: ResourceLimits{Timeout: 200 * time.Millisecond}}, "-c", "sleep 2")
+	require.EqualError(t, err, "`sh -c sleep 2` failed `sh` exceeded its timeout of 200ms")
+	var limitErr *ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "timeout of 200ms", limitErr.Limit)
+}
+
+func TestParseResourceLimits(t *testing.T) {
+	limits, err := ParseResourceLimits("30s", "512Mi", "2m")
+	require.NoError(t, err)
+	assert.Equal(t, ResourceLimits{CPUTime: 30 * time.Second, Memory: 512 * 1024 * 1024, Timeout: 2 * time.Minute}, limits)
+
+	limits, err = ParseResourceLimits("", "", "")
+	require.NoError(t, err)
+	assert.True(t, limits.IsZero())
+
+	_, err = ParseResourceLimits("30", "", "")
+	require.ErrorContains(t, err, "invalid cpu time limit")
+	_, err = ParseResourceLimits("", "lots", "")
+	require.ErrorContains(t, err, "invalid memory limit")
+}
+
+func TestResourceLimits_Merge(t *testing.T) {
+	a := ResourceLimits{CPUTime: 30 * time.Second, Timeout: time.Minute}
+	b := ResourceLimits{CPUTime: 10 * time.Second, Memory: 1024, Timeout: 2 * time.Minute}
+	assert.Equal(t, ResourceLimits{CPUTime: 10 * time.Second, Memory: 1024, Timeout: time.Minute}, a.Merge(b))
+	assert.Equal(t, a, a.Merge(ResourceLimits{}))
+}
@@ -0,0 +1,114 @@
+package exec
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// ResourceLimits defines the resources that a command is allowed to use. Zero values mean no limit.
+type ResourceLimits struct {
+	// CPUTime is the CPU time after which the command is terminated, rounded up to the second
+	CPUTime time.Duration
+	// Memory is the maximum size in bytes of the data segment of the command, which includes its heap
+	Memory int64
+	// Timeout is the wall time after which the command is terminated. It overrides the default timeout.
+	Timeout time.Duration
+}
+
+// IsZero returns true if no limit is set
+func (l ResourceLimits) IsZero() bool {
+	return l.CPUTime <= 0 && l.Memory <= 0 && l.Timeout <= 0
+}
+
+// ParseResourceLimits parses the CPU time and the timeout as durations (e.g. 30s) and the memory as a quantity
+// (e.g. 512Mi). Empty values mean no limit.
+func ParseResourceLimits(cpuTime string, memory string, timeout string) (ResourceLimits, error) {
+	var limits ResourceLimits
+	var err error
+	if cpuTime != "" {
+		if limits.CPUTime, err = time.ParseDuration(cpuTime); err != nil {
+			return limits, fmt.Errorf("invalid cpu time limit %q: %w", cpuTime, err)
+		}
+	}
+	if memory != "" {
+		quantity, err := resource.ParseQuantity(memory)
+		if err != nil {
+			return limits, fmt.Errorf("invalid memory limit %q: %w", memory, err)
+		}
+		limits.Memory = quantity.Value()
+	}
+	if timeout != "" {
+		if limits.Timeout, err = time.ParseDuration(timeout); err != nil {
+			return limits, fmt.Errorf("invalid timeout limit %q: %w", timeout, err)
+		}
+	}
+	return limits, nil
+}
+
+// Merge returns the strictest of both limits
+func (l ResourceLimits) Merge(other ResourceLimits) ResourceLimits {
+	return ResourceLimits{
+		CPUTime: minLimit(l.CPUTime, other.CPUTime),
+		Memory:  minLimit(l.Memory, other.Memory),
+		Timeout: minLimit(l.Timeout, other.Timeout),
+	}
+}
+
+func minLimit[T time.Duration | int64](a T, b T) T {
+	if a <= 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// SetResourceLimits sets the CPU and memory limits of a started command. The limits are only supported on Linux, and are
+// inherited by the processes that the command starts afterwards.
+func SetResourceLimits(cmd *exec.Cmd, limits ResourceLimits) error {
+	if err := setResourceLimits(cmd.Process.Pid, limits); err != nil {
+		return fmt.Errorf("failed to set the resource limits of %s: %w", cmd.Args[0], err)
+	}
+	return nil
+}
+
+// ResourceLimitExceededError is the cause of the error of a command which was terminated because it exceeded one of
+// its resource limits
+type ResourceLimitExceededError struct {
+	// Command is the name of the executable
+	Command string
+	// Limit describes the exceeded limit
+	Limit string
+}
+
+func (e *ResourceLimitExceededError) Error() string {
+	return fmt.Sprintf("`%s` exceeded its %s", e.Command, e.Limit)
+}
+
+func newResourceLimitExceededError(cmd *exec.Cmd, format string, args ...any) *ResourceLimitExceededError {
+	return &ResourceLimitExceededError{Command: filepath.Base(cmd.Path), Limit: fmt.Sprintf(format, args...)}
+}
+
+// ResourceLimitExceeded returns the limit that the failed command exceeded, or nil if it failed for another reason
+func ResourceLimitExceeded(cmd *exec.Cmd, limits ResourceLimits, stderr string) *ResourceLimitExceededError {
+	if limits.CPUTime > 0 && cmd.ProcessState != nil {
+		if cmd.ProcessState.UserTime()+cmd.ProcessState.SystemTime() >= cpuTimeLimit(limits.CPUTime) || cpuTimeLimitSignaled(cmd.ProcessState) {
+			return newResourceLimitExceededError(cmd, "cpu time limit of %v", cpuTimeLimit(limits.CPUTime))
+		}
+	}
+	if limits.Memory > 0 {
+		stderr = strings.ToLower(stderr)
+		if strings.Contains(stderr, "out of memory") || strings.Contains(stderr, "cannot allocate memory") || (cmd.ProcessState != nil && memoryLimitSignaled(cmd.ProcessState)) {
+			return newResourceLimitExceededError(cmd, "memory limit of %s", resource.NewQuantity(limits.Memory, resource.BinarySI).String())
+		}
+	}
+	return nil
+}
+
+// cpuTimeLimit returns the CPU time limit rounded up to the second, which is the granularity of RLIMIT_CPU
+func cpuTimeLimit(cpuTime time.Duration) time.Duration {
+	return (cpuTime + time.Second - 1).Truncate(time.Second)
+}
@@ -0,0 +1,39 @@
+package exec
+
+import (
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// setResourceLimits sets the CPU and memory limits of the started process. The limits are inherited by the processes
+// that it starts. The process is sent SIGXCPU once it has used its CPU time, and SIGKILL one second later.
+func setResourceLimits(pid int, limits ResourceLimits) error {
+	if limits.CPUTime > 0 {
+		secs := uint64(cpuTimeLimit(limits.CPUTime).Seconds())
+		if err := unix.Prlimit(pid, unix.RLIMIT_CPU, &unix.Rlimit{Cur: secs, Max: secs + 1}, nil); err != nil {
+			return os.NewSyscallError("prlimit", err)
+		}
+	}
+	if limits.Memory > 0 {
+		memory := uint64(limits.Memory)
+		if err := unix.Prlimit(pid, unix.RLIMIT_DATA, &unix.Rlimit{Cur: memory, Max: memory}, nil); err != nil {
+			return os.NewSyscallError("prlimit", err)
+		}
+	}
+	return nil
+}
+
+// cpuTimeLimitSignaled returns true if the process was terminated by the signal sent when exceeding RLIMIT_CPU
+func cpuTimeLimitSignaled(state *os.ProcessState) bool {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGXCPU
+}
+
+// memoryLimitSignaled returns true if the process was terminated by the signal usually raised when failing to allocate
+// memory beyond RLIMIT_DATA
+func memoryLimitSignaled(state *os.ProcessState) bool {
+	status, ok := state.Sys().(syscall.WaitStatus)
+	return ok && status.Signaled() && status.Signal() == syscall.SIGSEGV
+}
@@ -0,0 +1,33 @@
+package exec
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunCommandCPUTimeLimit(t *testing.T) {
+	_, err := RunCommand("sh", CmdOpts{Limits: ResourceLimits{CPUTime: 500 * time.Millisecond}}, "-c", "while :; do :; done")
+	var limitErr *ResourceLimitExceededError
+	require.ErrorAs(t, err, &limitErr)
+	assert.Equal(t, "sh", limitErr.Command)
+	assert.Equal(t, "cpu time limit of 1s", limitErr.Limit)
+}
+
+func TestRunCommandMemoryLimit(t *testing.T) {
+	// the limit is set once the process is started, so the shell waits before reading it
+	output, err := RunCommand("sh", CmdOpts{Limits: ResourceLimits{Memory: 256 * 1024 * 1024}}, "-c", "sleep 0.2 && ulimit -d")
+	require.NoError(t, err)
+	assert.Equal(t, "262144", output)
+}
+
+func TestResourceLimitExceeded(t *testing.T) {
+	_, err := RunCommand("sh", CmdOpts{Limits: ResourceLimits{Memory: 1024 * 1024 * 1024}}, "-c", "echo 'fatal error: runtime: out of memory' >&2 && exit 2")
+	require.EqualError(t, err, "`sh -c echo 'fatal error: runtime: out of memory' >&2 && exit 2` failed `sh` exceeded its memory limit of 1Gi: fatal error: runtime: out of memory")
+
+	_, err = RunCommand("sh", CmdOpts{}, "-c", "echo 'fatal error: runtime: out of memory' >&2 && exit 2")
+	var limitErr *ResourceLimitExceededError
+	require.NotErrorAs(t, err, &limitErr)
+}
@@ -0,0 +1,20 @@
+//go:build !linux
+
+package exec
+
+import (
+	"os"
+)
+
+// setResourceLimits is a no-op since the CPU and memory limits are only supported on Linux
+func setResourceLimits(_ int, _ ResourceLimits) error {
+	return nil
+}
+
+func cpuTimeLimitSignaled(_ *os.ProcessState) bool {
+	return false
+}
+
+func memoryLimitSignaled(_ *os.ProcessState) bool {
+	return false
+}
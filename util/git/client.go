@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"maps"
 	"math"
 	"net/http"
 	"net/mail"
@@ -17,6 +19,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -148,6 +151,7 @@ type Client interface {
 	// If deep==false, examines the revision only. Checking the annotated tag signature if the revision is an annotated tag, commit signature otherwise.
 	LsSignatures(ctx context.Context, revision string, deep bool) ([]RevisionSignatureInfo, string, error)
 	ChangedFiles(ctx context.Context, revision string, targetRevision string) ([]string, error)
+	CommitsBetween(ctx context.Context, revision string, targetRevision string, maxCount int) ([]CommitMetadata, error)
 	IsRevisionPresent(ctx context.Context, revision string) bool
 	// SetAuthor sets the author name and email in the git configuration.
 	SetAuthor(ctx context.Context, name, email string) (string, error)
@@ -172,6 +176,17 @@ type EventHandlers struct {
 	OnLsRemote func(repo string) func()
 	OnFetch    func(repo string) func()
 	OnPush     func(repo string) func()
+	OnLFSPull  func(repo string, stats LFSPullStats)
+}
+
+// LFSPullStats describes the LFS objects pulled for a checkout limited to the LFS include paths
+type LFSPullStats struct {
+	// IncludedFiles is the number of LFS files of the checked out revision within the include paths
+	IncludedFiles int
+	// ExcludedFiles is the number of LFS files of the checked out revision outside of the include paths
+	ExcludedFiles int
+	// CacheSize is the size in bytes of the LFS objects stored for the repository
+	CacheSize int64
 }
 
 // nativeGitClient implements Client interface using git CLI
@@ -201,6 +216,26 @@ type nativeGitClient struct {
 	// tagPrefix filters git tags to only those with this prefix when resolving semver constraints.
 	// The prefix is stripped before comparison and re-added to the resolved tag name.
 	tagPrefix string
+	// sparseCheckoutPaths are the directories checked out from a partial clone of the repository. The whole
+	// repository is fetched and checked out if empty.
+	sparseCheckoutPaths []string
+	// lfsIncludePaths are the directories whose LFS objects are pulled when checking out a revision. The LFS objects of
+	// all refs are fetched if empty.
+	lfsIncludePaths []string
+	// submodules controls the checkout of the submodules of the repository
+	submodules SubmoduleOptions
+}
+
+// SubmoduleOptions controls the checkout of the submodules of a repository
+type SubmoduleOptions struct {
+	// Disabled skips the checkout of the submodules
+	Disabled bool
+	// Depth is the maximum nesting level of the submodules checked out, 1 checking out only the submodules of the
+	// repository itself. Zero means no limit.
+	Depth int64
+	// Creds are the credentials of the submodules by normalized URL. The other submodules are fetched with the
+	// credentials of the repository.
+	Creds map[string]Creds
 }
 
 type runOpts struct {
@@ -272,6 +307,29 @@ func WithTagPrefix(prefix string) ClientOpts {
 	}
 }
 
+// WithSparseCheckout fetches the repository as a partial clone without the file contents, and checks out only the
+// given directories, relative to the repository root. The file contents are fetched on demand during the checkout.
+func WithSparseCheckout(paths []string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.sparseCheckoutPaths = paths
+	}
+}
+
+// WithLFSIncludePaths limits the LFS objects of the repository to the ones of the given directories, relative to the
+// repository root, and of the checked out revision. The LFS files outside of the directories are left as pointers.
+func WithLFSIncludePaths(paths []string) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.lfsIncludePaths = paths
+	}
+}
+
+// WithSubmoduleOptions controls the checkout of the submodules of the repository
+func WithSubmoduleOptions(opts SubmoduleOptions) ClientOpts {
+	return func(c *nativeGitClient) {
+		c.submodules = opts
+	}
+}
+
 func NewClient(rawRepoURL string, creds Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
 	r := regexp.MustCompile(`([/:])`)
 	normalizedGitURL := NormalizeGitURL(rawRepoURL)
@@ -580,6 +638,9 @@ func (m *nativeGitClient) fetch(ctx context.Context, revision string, depth int6
 	} else {
 		args = append(args, "--tags")
 	}
+	if len(m.sparseCheckoutPaths) > 0 {
+		args = append(args, "--filter=blob:none")
+	}
 	args = append(args, "--force", "--prune")
 	return m.runCredentialedCmd(ctx, args...)
 }
@@ -684,8 +745,9 @@ func (m *nativeGitClient) Fetch(ctx context.Context, revision string, depth int6
 		return err
 	}
 
-	// When we have LFS support enabled, check for large files and fetch them too.
-	if m.IsLFSEnabled() {
+	// When we have LFS support enabled, check for large files and fetch them too. The LFS objects limited to the
+	// include paths are pulled when checking out the revision.
+	if m.IsLFSEnabled() && len(m.lfsIncludePaths) == 0 {
 		largeFiles, err := m.LsLargeFiles(ctx)
 		if err == nil && len(largeFiles) > 0 {
 			err = m.runCredentialedCmd(ctx, "lfs", "fetch", "--all")
@@ -757,20 +819,153 @@ func (m *nativeGitClient) LsLargeFiles(ctx context.Context) ([]string, error) {
 	return ss, nil
 }
 
+// pullLFS fetches and checks out the LFS objects of the checked out revision within the include paths
+func (m *nativeGitClient) pullLFS(ctx context.Context, largeFiles []string) error {
+	// the exclude patterns configured for the repository would take precedence over the include paths
+	if err := m.runCredentialedCmd(ctx, "lfs", "pull", "--include="+lfsIncludePatterns(m.lfsIncludePaths), "--exclude="); err != nil {
+		return err
+	}
+	if m.OnLFSPull != nil {
+		stats := LFSPullStats{CacheSize: dirSize(filepath.Join(m.root, ".git", "lfs", "objects"))}
+		for _, file := range largeFiles {
+			if file == "" {
+				continue
+			}
+			if isInPaths(file, m.lfsIncludePaths) {
+				stats.IncludedFiles++
+			} else {
+				stats.ExcludedFiles++
+			}
+		}
+		m.OnLFSPull(m.repoURL, stats)
+	}
+	return nil
+}
+
+// lfsIncludePatterns returns the git lfs include patterns matching the files within the directories
+func lfsIncludePatterns(dirs []string) string {
+	patterns := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		patterns = append(patterns, strings.TrimSuffix(dir, "/")+"/**")
+	}
+	return strings.Join(patterns, ",")
+}
+
+// isInPaths returns whether the file is within one of the directories
+func isInPaths(file string, dirs []string) bool {
+	for _, dir := range dirs {
+		dir = strings.TrimSuffix(dir, "/")
+		if file == dir || strings.HasPrefix(file, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// dirSize returns the total size of the files within the directory, ignoring the files that cannot be read
+func dirSize(dir string) int64 {
+	var size int64
+	_ = filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
+
 // Submodule embed other repositories into this repository
 func (m *nativeGitClient) Submodule(ctx context.Context) error {
+	if m.submodules.Depth > 0 || len(m.submodules.Creds) > 0 {
+		return m.updateSubmodules(ctx, ".", 1)
+	}
 	if err := m.runCredentialedCmd(ctx, "submodule", "sync", "--recursive"); err != nil {
 		return err
 	}
 	return m.runCredentialedCmd(ctx, "submodule", "update", "--init", "--recursive")
 }
 
+// updateSubmodules checks out the submodules of the repository or submodule in the directory, relative to the
+// repository root, each with its own credentials, and their nested submodules up to the maximum depth
+func (m *nativeGitClient) updateSubmodules(ctx context.Context, dir string, level int64) error {
+	if _, err := os.Stat(filepath.Join(m.root, dir, ".gitmodules")); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := m.runCmd(ctx, "-C", dir, "submodule", "sync"); err != nil {
+		return err
+	}
+	if _, err := m.runCmd(ctx, "-C", dir, "submodule", "init"); err != nil {
+		return err
+	}
+	out, err := m.runCmd(ctx, "-C", dir, "config", "--file", ".gitmodules", "--list", "--null")
+	if err != nil {
+		return err
+	}
+	paths := parseSubmoduleConfig(out, "path")
+	// the URLs of the initialized submodules are resolved against the URL of the repository
+	out, err = m.runCmd(ctx, "-C", dir, "config", "--local", "--list", "--null")
+	if err != nil {
+		return err
+	}
+	urls := parseSubmoduleConfig(out, "url")
+	names := slices.Sorted(maps.Keys(paths))
+	for _, name := range names {
+		submoduleURL, ok := urls[name]
+		if !ok {
+			// inactive submodules are not initialized
+			continue
+		}
+		creds := m.creds
+		if submoduleCreds, ok := m.submodules.Creds[NormalizeGitURL(submoduleURL)]; ok {
+			creds = submoduleCreds
+		}
+		if err := m.runCredentialedCmdWithCreds(ctx, creds, "-C", dir, "submodule", "update", "--", paths[name]); err != nil {
+			return fmt.Errorf("failed to update submodule %s: %w", filepath.Join(dir, paths[name]), err)
+		}
+		if m.submodules.Depth == 0 || level < m.submodules.Depth {
+			if err := m.updateSubmodules(ctx, filepath.Join(dir, paths[name]), level+1); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseSubmoduleConfig returns the values of the given key of the submodules by name from the output of git config
+// --list --null
+func parseSubmoduleConfig(out string, key string) map[string]string {
+	values := map[string]string{}
+	for _, entry := range strings.Split(out, "\000") {
+		name, value, ok := strings.Cut(entry, "\n")
+		if !ok || !strings.HasPrefix(name, "submodule.") || !strings.HasSuffix(name, "."+key) {
+			continue
+		}
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "submodule."), "."+key)
+		if name != "" {
+			values[name] = value
+		}
+	}
+	return values
+}
+
 // Checkout checks out the specified revision
 func (m *nativeGitClient) Checkout(ctx context.Context, revision string, submoduleEnabled bool, cleanState bool) (string, error) {
 	if revision == "" || revision == "HEAD" {
 		revision = "origin/HEAD"
 	}
-	if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
+	sparse, err := m.configureSparseCheckout(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to configure sparse checkout: %w", err)
+	}
+	if sparse {
+		// the contents of the files missing from a partial clone are fetched during the checkout
+		if err := m.runCredentialedCmd(ctx, "checkout", "--force", revision); err != nil {
+			return "", fmt.Errorf("failed to checkout %s: %w", revision, err)
+		}
+	} else if out, err := m.runCmd(ctx, "checkout", "--force", revision); err != nil {
 		return out, fmt.Errorf("failed to checkout %s: %w", revision, err)
 	}
 	// We must populate LFS content by using lfs checkout, if we have at least
@@ -781,13 +976,17 @@ func (m *nativeGitClient) Checkout(ctx context.Context, revision string, submodu
 			return "", fmt.Errorf("failed to list LFS files: %w", err)
 		}
 		if len(largeFiles) > 0 {
-			if out, err := m.runCmd(ctx, "lfs", "checkout"); err != nil {
+			if len(m.lfsIncludePaths) > 0 {
+				if err := m.pullLFS(ctx, largeFiles); err != nil {
+					return "", fmt.Errorf("failed to pull LFS files: %w", err)
+				}
+			} else if out, err := m.runCmd(ctx, "lfs", "checkout"); err != nil {
 				return out, fmt.Errorf("failed to checkout LFS files: %w", err)
 			}
 		}
 	}
 	if _, err := os.Stat(m.root + "/.gitmodules"); !os.IsNotExist(err) {
-		if submoduleEnabled {
+		if submoduleEnabled && !m.submodules.Disabled {
 			if err := m.Submodule(ctx); err != nil {
 				return "", fmt.Errorf("failed to update submodules: %w", err)
 			}
@@ -806,6 +1005,77 @@ func (m *nativeGitClient) Checkout(ctx context.Context, revision string, submodu
 	return "", nil
 }
 
+// configureSparseCheckout writes the sparse checkout patterns of the client, and returns true if the repository has
+// been checked out sparsely by this or another client. The patterns are written to the repository configuration
+// instead of using `git sparse-checkout`, which enables a repository extension that go-git does not support.
+// A repository which has been checked out sparsely is reset to the full pattern when the client checks out the
+// whole repository, since the files excluded by a previous checkout are otherwise not restored.
+func (m *nativeGitClient) configureSparseCheckout(ctx context.Context) (bool, error) {
+	patternsFile := filepath.Join(m.root, ".git", "info", "sparse-checkout")
+	_, err := os.Stat(patternsFile)
+	if err != nil && !os.IsNotExist(err) {
+		return false, err
+	}
+	configured := err == nil
+	if len(m.sparseCheckoutPaths) == 0 {
+		if !configured {
+			return false, nil
+		}
+		return true, os.WriteFile(patternsFile, []byte("/*\n"), 0o644)
+	}
+	if !configured {
+		if _, err := m.runCmd(ctx, "config", "core.sparseCheckout", "true"); err != nil {
+			return false, err
+		}
+		if _, err := m.runCmd(ctx, "config", "core.sparseCheckoutCone", "true"); err != nil {
+			return false, err
+		}
+		if err := os.MkdirAll(filepath.Dir(patternsFile), 0o755); err != nil {
+			return false, err
+		}
+	}
+	return true, os.WriteFile(patternsFile, []byte(sparseCheckoutPatterns(m.sparseCheckoutPaths)), 0o644)
+}
+
+// sparseCheckoutPatterns returns the cone mode patterns including the files of the root directory and the given
+// directories recursively
+func sparseCheckoutPatterns(paths []string) string {
+	var dirs []string
+	for _, p := range paths {
+		p = strings.Trim(filepath.ToSlash(filepath.Clean(p)), "/")
+		if p == "." || p == "" {
+			return "/*\n"
+		}
+		dirs = append(dirs, p)
+	}
+	slices.Sort(dirs)
+	dirs = slices.Compact(dirs)
+	// the subdirectories of a directory checked out recursively are skipped
+	var recursiveDirs []string
+	for _, dir := range dirs {
+		if !slices.ContainsFunc(recursiveDirs, func(recursiveDir string) bool {
+			return strings.HasPrefix(dir, recursiveDir+"/")
+		}) {
+			recursiveDirs = append(recursiveDirs, dir)
+		}
+	}
+
+	patterns := []string{"/*", "!/*/"}
+	parents := map[string]bool{}
+	for _, dir := range recursiveDirs {
+		parts := strings.Split(dir, "/")
+		for i := 1; i < len(parts); i++ {
+			parent := "/" + strings.Join(parts[:i], "/") + "/"
+			if !parents[parent] {
+				parents[parent] = true
+				patterns = append(patterns, parent, "!"+parent+"*/")
+			}
+		}
+		patterns = append(patterns, "/"+dir+"/")
+	}
+	return strings.Join(patterns, "\n") + "\n"
+}
+
 func (m *nativeGitClient) getRefs() ([]*plumbing.Reference, error) {
 	myLockUUID, err := uuid.NewRandom()
 	myLockId := ""
@@ -1504,6 +1774,49 @@ func (m *nativeGitClient) ChangedFiles(ctx context.Context, revision string, tar
 	return files, nil
 }
 
+// CommitsBetween returns the commits reachable from targetRevision but not from revision, newest first. If maxCount is
+// positive, at most maxCount commits are returned.
+func (m *nativeGitClient) CommitsBetween(ctx context.Context, revision string, targetRevision string, maxCount int) ([]CommitMetadata, error) {
+	if revision == targetRevision {
+		return []CommitMetadata{}, nil
+	}
+
+	if !IsCommitSHA(revision) || !IsCommitSHA(targetRevision) {
+		return nil, errors.New("invalid revision provided, must be SHA")
+	}
+
+	// fields are separated by NUL and commits by the record separator, as commit messages may contain new lines
+	args := []string{"log", "--format=%H%x00%an%x00%ae%x00%aI%x00%s%x00%b%x1e"}
+	if maxCount > 0 {
+		args = append(args, fmt.Sprintf("--max-count=%d", maxCount))
+	}
+	args = append(args, fmt.Sprintf("%s..%s", revision, targetRevision), "--")
+	out, err := m.runCmd(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits %s..%s: %w", revision, targetRevision, err)
+	}
+
+	commits := []CommitMetadata{}
+	for record := range strings.SplitSeq(out, "\x1e") {
+		record = strings.TrimLeft(record, "\n")
+		if record == "" {
+			continue
+		}
+		fields := strings.SplitN(record, "\x00", 6)
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("expected 6 fields, got %d", len(fields))
+		}
+		commits = append(commits, CommitMetadata{
+			SHA:     fields[0],
+			Author:  mail.Address{Name: fields[1], Address: fields[2]},
+			Date:    fields[3],
+			Subject: fields[4],
+			Body:    strings.TrimSpace(fields[5]),
+		})
+	}
+	return commits, nil
+}
+
 // config runs a git config command.
 func (m *nativeGitClient) config(ctx context.Context, args ...string) (string, error) {
 	args = append([]string{"config"}, args...)
@@ -1760,7 +2073,12 @@ func (m *nativeGitClient) runCmd(ctx context.Context, args ...string) (string, e
 
 // runCredentialedCmd is a convenience function to run a git command with username/password credentials
 func (m *nativeGitClient) runCredentialedCmd(ctx context.Context, args ...string) error {
-	closer, environ, err := m.creds.Environ()
+	return m.runCredentialedCmdWithCreds(ctx, m.creds, args...)
+}
+
+// runCredentialedCmdWithCreds runs a git command with the given credentials instead of the ones of the repository
+func (m *nativeGitClient) runCredentialedCmdWithCreds(ctx context.Context, creds Creds, args ...string) error {
+	closer, environ, err := creds.Environ()
 	if err != nil {
 		return err
 	}
@@ -22,6 +22,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/ssh"
 
+	gogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
@@ -371,6 +372,94 @@ func Test_nativeGitClient_Fetch_Prune(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_sparseCheckoutPatterns(t *testing.T) {
+	tests := []struct {
+		name     string
+		paths    []string
+		expected string
+	}{
+		{name: "Root", paths: []string{"."}, expected: "/*\n"},
+		{name: "RootAmongOthers", paths: []string{"apps/a", ""}, expected: "/*\n"},
+		{name: "TopLevelDirectory", paths: []string{"apps"}, expected: "/*\n!/*/\n/apps/\n"},
+		{name: "NestedDirectories", paths: []string{"apps/a/", "apps/b", "values"}, expected: "/*\n!/*/\n/apps/\n!/apps/*/\n/apps/a/\n/apps/b/\n/values/\n"},
+		{name: "SubdirectoryOfOtherDirectory", paths: []string{"apps/a/overlays", "apps-b", "apps"}, expected: "/*\n!/*/\n/apps/\n/apps-b/\n"},
+		{name: "Duplicates", paths: []string{"apps/a", "./apps/a"}, expected: "/*\n!/*/\n/apps/\n!/apps/*/\n/apps/a/\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, sparseCheckoutPatterns(tt.paths))
+		})
+	}
+}
+
+func Test_lfsIncludePatterns(t *testing.T) {
+	assert.Equal(t, "apps/a/**", lfsIncludePatterns([]string{"apps/a"}))
+	assert.Equal(t, "apps/a/**,values/**", lfsIncludePatterns([]string{"apps/a/", "values"}))
+}
+
+func Test_isInPaths(t *testing.T) {
+	dirs := []string{"apps/a", "values/"}
+	assert.True(t, isInPaths("apps/a/chart.tgz", dirs))
+	assert.True(t, isInPaths("apps/a/files/data.bin", dirs))
+	assert.True(t, isInPaths("values/prod.bin", dirs))
+	assert.False(t, isInPaths("apps/b/chart.tgz", dirs))
+	assert.False(t, isInPaths("apps/ab/chart.tgz", dirs))
+	assert.False(t, isInPaths("data.bin", dirs))
+}
+
+func Test_dirSize(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 10), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "ab", "cd"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ab", "cd", "abcd"), make([]byte, 32), 0o644))
+	assert.Equal(t, int64(42), dirSize(dir))
+	assert.Equal(t, int64(0), dirSize(filepath.Join(dir, "missing")))
+}
+
+func Test_nativeGitClient_SparseCheckout(t *testing.T) {
+	ctx := t.Context()
+	remoteDir := t.TempDir()
+	require.NoError(t, runCmd(ctx, remoteDir, "git", "init"))
+	require.NoError(t, runCmd(ctx, remoteDir, "git", "config", "uploadpack.allowFilter", "true"))
+	for _, file := range []string{"README.md", "apps/a/app.yaml", "apps/b/app.yaml", "values/values.yaml"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(remoteDir, filepath.Dir(file)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(remoteDir, file), []byte(file), 0o644))
+	}
+	require.NoError(t, runCmd(ctx, remoteDir, "git", "add", "."))
+	require.NoError(t, runCmd(ctx, remoteDir, "git", "commit", "-m", "Initial commit"))
+	out, err := outputCmd(ctx, remoteDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	revision := strings.TrimSpace(string(out))
+
+	root := t.TempDir()
+	client, err := NewClientExt("file://"+remoteDir, root, NopCreds{}, true, false, "", "", WithSparseCheckout([]string{"apps/a", "values"}))
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	require.NoError(t, client.Fetch(ctx, "", 0))
+	_, err = client.Checkout(ctx, revision, false, true)
+	require.NoError(t, err)
+
+	out, err = outputCmd(ctx, root, "git", "config", "--get", "remote.origin.promisor")
+	require.NoError(t, err)
+	assert.Equal(t, "true", strings.TrimSpace(string(out)))
+	assert.FileExists(t, filepath.Join(root, "README.md"))
+	assert.FileExists(t, filepath.Join(root, "apps/a/app.yaml"))
+	assert.FileExists(t, filepath.Join(root, "values/values.yaml"))
+	assert.NoFileExists(t, filepath.Join(root, "apps/b/app.yaml"))
+
+	// the partial clone can still be opened with go-git
+	_, err = gogit.PlainOpen(root)
+	require.NoError(t, err)
+
+	// another client checking out the whole repository restores the excluded files
+	client, err = NewClientExt("file://"+remoteDir, root, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	require.NoError(t, client.Init())
+	_, err = client.Checkout(ctx, revision, false, true)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(root, "apps/b/app.yaml"))
+}
+
 func Test_IsAnnotatedTag(t *testing.T) {
 	tempDir := t.TempDir()
 	ctx := t.Context()
@@ -490,6 +579,64 @@ func Test_ChangedFiles(t *testing.T) {
 	assert.ElementsMatch(t, []string{"README"}, changedFiles)
 }
 
+func Test_CommitsBetween(t *testing.T) {
+	tempDir := t.TempDir()
+	ctx := t.Context()
+
+	client, err := NewClientExt("file://"+tempDir, tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = runCmd(ctx, client.Root(), "git", "commit", "-m", "Initial commit", "--allow-empty")
+	require.NoError(t, err)
+
+	previousSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+
+	err = runCmd(ctx, client.Root(), "git", "commit", "-m", "First change", "--allow-empty")
+	require.NoError(t, err)
+
+	err = runCmd(ctx, client.Root(), "git", "-c", "user.name=Jane Doe", "-c", "user.email=jane@example.com", "commit", "-m", "Second change", "-m", "With a body\n\nspanning paragraphs", "--allow-empty", "--author", "Jane Doe <jane@example.com>")
+	require.NoError(t, err)
+
+	commitSHA, err := client.LsRemote("HEAD")
+	require.NoError(t, err)
+
+	// Not SHAs, error
+	_, err = client.CommitsBetween(ctx, previousSHA, "HEAD", 0)
+	require.Error(t, err)
+
+	// Same commit, no commits
+	commits, err := client.CommitsBetween(ctx, commitSHA, commitSHA, 0)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+
+	// Different commits, newest first
+	commits, err = client.CommitsBetween(ctx, previousSHA, commitSHA, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, commitSHA, commits[0].SHA)
+	assert.Equal(t, "Second change", commits[0].Subject)
+	assert.Equal(t, "With a body\n\nspanning paragraphs", commits[0].Body)
+	assert.Equal(t, mail.Address{Name: "Jane Doe", Address: "jane@example.com"}, commits[0].Author)
+	assert.NotEmpty(t, commits[0].Date)
+	assert.Equal(t, "First change", commits[1].Subject)
+	assert.Empty(t, commits[1].Body)
+
+	// Limited number of commits
+	commits, err = client.CommitsBetween(ctx, previousSHA, commitSHA, 1)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, commitSHA, commits[0].SHA)
+
+	// Reversed revisions, no commits
+	commits, err = client.CommitsBetween(ctx, commitSHA, previousSHA, 0)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
 func Test_SemverTags(t *testing.T) {
 	tempDir := t.TempDir()
 	ctx := t.Context()
@@ -870,6 +1017,75 @@ func Test_nativeGitClient_Submodule(t *testing.T) {
 	assert.Equal(t, bar+"baz\n", string(result))
 }
 
+// countingCreds counts the commands run with the credentials
+type countingCreds struct {
+	NopCreds
+	count int
+}
+
+func (c *countingCreds) Environ() (io.Closer, []string, error) {
+	c.count++
+	return NopCloser{}, nil, nil
+}
+
+func Test_nativeGitClient_Submodule_Options(t *testing.T) {
+	ctx := t.Context()
+	t.Setenv("GIT_ALLOW_PROTOCOL", "file")
+	tempDir := t.TempDir()
+	newRepo := func(name string) string {
+		dir := filepath.Join(tempDir, name)
+		require.NoError(t, os.Mkdir(dir, 0o755))
+		require.NoError(t, runCmd(ctx, dir, "git", "init"))
+		return dir
+	}
+	// foo embeds bar, which embeds baz
+	baz := newRepo("baz")
+	require.NoError(t, runCmd(ctx, baz, "git", "commit", "-m", "Initial commit", "--allow-empty"))
+	bar := newRepo("bar")
+	require.NoError(t, runCmd(ctx, bar, "git", "-c", "protocol.file.allow=always", "submodule", "add", baz))
+	require.NoError(t, runCmd(ctx, bar, "git", "commit", "-m", "Initial commit"))
+	foo := newRepo("foo")
+	require.NoError(t, runCmd(ctx, foo, "git", "-c", "protocol.file.allow=always", "submodule", "add", bar))
+	require.NoError(t, runCmd(ctx, foo, "git", "commit", "-m", "Initial commit"))
+
+	checkout := func(t *testing.T, opts SubmoduleOptions) string {
+		t.Helper()
+		client, err := NewClientExt("file://"+foo, t.TempDir(), NopCreds{}, true, false, "", "", WithSubmoduleOptions(opts))
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+		require.NoError(t, client.Fetch(ctx, "", 0))
+		commitSHA, err := client.LsRemote("HEAD")
+		require.NoError(t, err)
+		_, err = client.Checkout(ctx, commitSHA, true, true)
+		require.NoError(t, err)
+		return client.Root()
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		root := checkout(t, SubmoduleOptions{Disabled: true})
+		assert.NoFileExists(t, filepath.Join(root, "bar", ".gitmodules"))
+	})
+	t.Run("Depth", func(t *testing.T) {
+		root := checkout(t, SubmoduleOptions{Depth: 1})
+		assert.FileExists(t, filepath.Join(root, "bar", ".gitmodules"))
+		assert.NoFileExists(t, filepath.Join(root, "bar", "baz", ".git"))
+	})
+	t.Run("Creds", func(t *testing.T) {
+		creds := &countingCreds{}
+		root := checkout(t, SubmoduleOptions{Creds: map[string]Creds{NormalizeGitURL(baz): creds}})
+		assert.FileExists(t, filepath.Join(root, "bar", ".gitmodules"))
+		assert.FileExists(t, filepath.Join(root, "bar", "baz", ".git"))
+		assert.Equal(t, 1, creds.count)
+	})
+}
+
+func Test_parseSubmoduleConfig(t *testing.T) {
+	out := "core.bare\nfalse\000submodule.bar.url\n/tmp/bar\000submodule.bar.path\nbar\000submodule.libs/baz.url\n../baz.git\000"
+	assert.Equal(t, map[string]string{"bar": "/tmp/bar", "libs/baz": "../baz.git"}, parseSubmoduleConfig(out, "url"))
+	assert.Equal(t, map[string]string{"bar": "bar"}, parseSubmoduleConfig(out, "path"))
+	assert.Empty(t, parseSubmoduleConfig("", "url"))
+}
+
 func TestNewClient_invalidSSHURL(t *testing.T) {
 	client, err := NewClient("ssh://bitbucket.org:org/repo", NopCreds{}, false, false, "", "")
 	assert.Nil(t, client)
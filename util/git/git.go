@@ -1,15 +1,21 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"net/url"
+	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
 // EnsurePrefix idempotently ensures that a base string has a given prefix.
@@ -144,6 +150,56 @@ func TestRepo(repo string, creds Creds, insecure bool, enableLfs bool, proxy str
 	return nil
 }
 
+// TestLFS tests if the Git LFS server of a repo is available and accepts the given credentials. The LFS server of
+// SSH repos is not tested, since git-lfs resolves its URL and credentials by running git-lfs-authenticate over SSH.
+func TestLFS(ctx context.Context, repo string, creds Creds, insecure bool, proxy string, noProxy string) error {
+	if _, err := exec.LookPath("git-lfs"); err != nil {
+		return fmt.Errorf("git-lfs is not installed: %w", err)
+	}
+	if !IsHTTPSURL(repo) && !IsHTTPURL(repo) {
+		return nil
+	}
+	return testLFSServer(ctx, repo, creds, insecure, proxy, noProxy)
+}
+
+// testLFSServer requests an empty batch of objects from the LFS server of the given HTTP(S) repo
+func testLFSServer(ctx context.Context, repo string, creds Creds, insecure bool, proxy string, noProxy string) error {
+	auth, err := newAuth(repo, creds)
+	if err != nil {
+		return fmt.Errorf("unable to get credentials: %w", err)
+	}
+	// https://github.com/git-lfs/git-lfs/blob/main/docs/api/server-discovery.md
+	endpoint := strings.TrimSuffix(repo, "/")
+	if !strings.HasSuffix(endpoint, ".git") {
+		endpoint += ".git"
+	}
+	endpoint += "/info/lfs/objects/batch"
+	// https://github.com/git-lfs/git-lfs/blob/main/docs/api/batch.md
+	body := `{"operation":"download","transfers":["basic"],"objects":[]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.git-lfs+json")
+	req.Header.Set("Content-Type", "application/vnd.git-lfs+json")
+	if httpAuth, ok := auth.(githttp.AuthMethod); ok {
+		httpAuth.SetAuth(req)
+	}
+	resp, err := GetRepoHTTPClient(repo, insecure, creds, proxy, noProxy).Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to connect to LFS server: %w", err)
+	}
+	defer utilio.Close(resp.Body)
+	// servers may reject the empty list of objects, which still proves that the LFS server is available
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("LFS server rejected the credentials: %s", resp.Status)
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode >= http.StatusInternalServerError:
+		return fmt.Errorf("LFS server is not available: %s", resp.Status)
+	}
+	return nil
+}
+
 // IsShortRef determines if the supplied revision is a short ref (e.g. "master" instead of "refs/heads/master").
 // ref.Name().Short() is an expensive call to be performed in a loop over all refs in a repository, so we want to avoid calling it if we can determine up front that the supplied revision is not a short ref.
 // The intention is to optimize for a case where the full ref string is supplied, as comparing the full ref string is cheaper than calling Short() on every ref in the loop.
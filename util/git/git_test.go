@@ -3,6 +3,7 @@ package git
 import (
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
@@ -397,6 +398,38 @@ func TestLFSClient(t *testing.T) {
 	}
 }
 
+func TestLFSServer(t *testing.T) {
+	status := http.StatusOK
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	creds := NewHTTPSCreds("user", "password", "", "", "", false, &NoopCredsStore{}, false)
+
+	require.NoError(t, testLFSServer(t.Context(), server.URL+"/org/repo", creds, false, "", ""))
+	require.Len(t, requests, 1)
+	assert.Equal(t, "/org/repo.git/info/lfs/objects/batch", requests[0].URL.Path)
+	assert.Equal(t, "application/vnd.git-lfs+json", requests[0].Header.Get("Accept"))
+	username, password, ok := requests[0].BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "user", username)
+	assert.Equal(t, "password", password)
+
+	require.NoError(t, testLFSServer(t.Context(), server.URL+"/org/repo.git", creds, false, "", ""))
+	assert.Equal(t, "/org/repo.git/info/lfs/objects/batch", requests[1].URL.Path)
+
+	status = http.StatusUnprocessableEntity
+	require.NoError(t, testLFSServer(t.Context(), server.URL+"/org/repo", creds, false, "", ""), "the LFS server may reject an empty batch")
+
+	status = http.StatusUnauthorized
+	require.ErrorContains(t, testLFSServer(t.Context(), server.URL+"/org/repo", creds, false, "", ""), "LFS server rejected the credentials: 401 Unauthorized")
+
+	status = http.StatusNotFound
+	require.ErrorContains(t, testLFSServer(t.Context(), server.URL+"/org/repo", creds, false, "", ""), "LFS server is not available: 404 Not Found")
+}
+
 func TestVerifyCommitSignature(t *testing.T) {
 	setupGitEnv(t)
 	p := t.TempDir()
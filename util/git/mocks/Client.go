@@ -541,6 +541,86 @@ func (_c *Client_CommitSHA_Call) RunAndReturn(run func(ctx context.Context) (str
 	return _c
 }
 
+// CommitsBetween provides a mock function for the type Client
+func (_mock *Client) CommitsBetween(ctx context.Context, revision string, targetRevision string, maxCount int) ([]git.CommitMetadata, error) {
+	ret := _mock.Called(ctx, revision, targetRevision, maxCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitsBetween")
+	}
+
+	var r0 []git.CommitMetadata
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) ([]git.CommitMetadata, error)); ok {
+		return returnFunc(ctx, revision, targetRevision, maxCount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, int) []git.CommitMetadata); ok {
+		r0 = returnFunc(ctx, revision, targetRevision, maxCount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]git.CommitMetadata)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = returnFunc(ctx, revision, targetRevision, maxCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_CommitsBetween_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitsBetween'
+type Client_CommitsBetween_Call struct {
+	*mock.Call
+}
+
+// CommitsBetween is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision string
+//   - targetRevision string
+//   - maxCount int
+func (_e *Client_Expecter) CommitsBetween(ctx any, revision any, targetRevision any, maxCount any) *Client_CommitsBetween_Call {
+	return &Client_CommitsBetween_Call{Call: _e.mock.On("CommitsBetween", ctx, revision, targetRevision, maxCount)}
+}
+
+func (_c *Client_CommitsBetween_Call) Run(run func(ctx context.Context, revision string, targetRevision string, maxCount int)) *Client_CommitsBetween_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_CommitsBetween_Call) Return(commitMetadatas []git.CommitMetadata, err error) *Client_CommitsBetween_Call {
+	_c.Call.Return(commitMetadatas, err)
+	return _c
+}
+
+func (_c *Client_CommitsBetween_Call) RunAndReturn(run func(ctx context.Context, revision string, targetRevision string, maxCount int) ([]git.CommitMetadata, error)) *Client_CommitsBetween_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type Client
 func (_mock *Client) Fetch(ctx context.Context, revision string, depth int64) error {
 	ret := _mock.Called(ctx, revision, depth)
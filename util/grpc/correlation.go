@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	utillog "github.com/argoproj/argo-cd/v3/util/log"
+)
+
+// correlationIDFromIncomingContext returns a context holding the correlation
+// ID received in the gRPC metadata, generating a new one if none or an
+// invalid one was sent.
+func correlationIDFromIncomingContext(ctx context.Context) (context.Context, string) {
+	if id := utillog.CorrelationIDFromContext(ctx); id != "" {
+		return ctx, id
+	}
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(utillog.CorrelationIDHeader); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	if !utillog.IsValidCorrelationID(id) {
+		id = utillog.NewCorrelationID()
+	}
+	return utillog.ContextWithCorrelationID(ctx, id), id
+}
+
+// CorrelationIDUnaryServerInterceptor makes sure every unary call has a
+// correlation ID in its context, and returns it to the caller in the
+// response header.
+func CorrelationIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := correlationIDFromIncomingContext(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(utillog.CorrelationIDHeader, id))
+		return handler(ctx, req)
+	}
+}
+
+// CorrelationIDStreamServerInterceptor makes sure every streaming call has a
+// correlation ID in its context, and returns it to the caller in the
+// response header.
+func CorrelationIDStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := correlationIDFromIncomingContext(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(utillog.CorrelationIDHeader, id))
+		return handler(srv, &correlatedServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type correlatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *correlatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// outgoingCorrelationContext appends the correlation ID held by the given
+// context to the outgoing gRPC metadata.
+func outgoingCorrelationContext(ctx context.Context) context.Context {
+	id := utillog.CorrelationIDFromContext(ctx)
+	if id == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, utillog.CorrelationIDHeader, id)
+}
+
+// CorrelationIDUnaryClientInterceptor propagates the correlation ID held by
+// the call context to the server.
+func CorrelationIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingCorrelationContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// CorrelationIDStreamClientInterceptor propagates the correlation ID held by
+// the call context to the server.
+func CorrelationIDStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingCorrelationContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// CorrelationIDLoggingFields returns the logging fields for the correlation
+// ID held by the given context. It is meant to be used with
+// logging.WithFieldsFromContext so the gRPC call logs include it.
+func CorrelationIDLoggingFields(ctx context.Context) logging.Fields {
+	if id := utillog.CorrelationIDFromContext(ctx); id != "" {
+		return logging.Fields{utillog.CorrelationIDField, id}
+	}
+	return nil
+}
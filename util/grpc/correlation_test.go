@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	utillog "github.com/argoproj/argo-cd/v3/util/log"
+)
+
+func TestCorrelationIDUnaryServerInterceptor(t *testing.T) {
+	interceptor := CorrelationIDUnaryServerInterceptor()
+	handlerID := func(ctx context.Context) string {
+		var id string
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+			id = utillog.CorrelationIDFromContext(ctx)
+			return nil, nil
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	t.Run("uses the correlation ID received in metadata", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(utillog.CorrelationIDHeader, "some-id"))
+		assert.Equal(t, "some-id", handlerID(ctx))
+	})
+	t.Run("generates a correlation ID if none was received", func(t *testing.T) {
+		assert.NotEmpty(t, handlerID(t.Context()))
+	})
+	t.Run("uses a UUID received in metadata", func(t *testing.T) {
+		id := utillog.NewCorrelationID()
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(utillog.CorrelationIDHeader, id))
+		assert.Equal(t, id, handlerID(ctx))
+	})
+	for _, invalid := range []string{"some id", "some-id\nlevel=error", "<script>", strings.Repeat("a", 65)} {
+		t.Run("replaces the invalid correlation ID "+invalid, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(utillog.CorrelationIDHeader, invalid))
+			id := handlerID(ctx)
+			assert.NotEqual(t, invalid, id)
+			assert.True(t, utillog.IsValidCorrelationID(id))
+		})
+	}
+}
+
+func TestCorrelationIDUnaryClientInterceptor(t *testing.T) {
+	interceptor := CorrelationIDUnaryClientInterceptor()
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := utillog.ContextWithCorrelationID(t.Context(), "some-id")
+	require.NoError(t, interceptor(ctx, "/some.Service/Method", nil, nil, nil, invoker))
+	assert.Equal(t, []string{"some-id"}, md.Get(utillog.CorrelationIDHeader))
+
+	md = nil
+	require.NoError(t, interceptor(t.Context(), "/some.Service/Method", nil, nil, nil, invoker))
+	assert.Empty(t, md.Get(utillog.CorrelationIDHeader))
+}
+
+func TestCorrelationIDLoggingFields(t *testing.T) {
+	assert.Nil(t, CorrelationIDLoggingFields(t.Context()))
+	ctx := utillog.ContextWithCorrelationID(t.Context(), "some-id")
+	assert.Equal(t, []any{utillog.CorrelationIDField, "some-id"}, []any(CorrelationIDLoggingFields(ctx)))
+}
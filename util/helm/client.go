@@ -95,6 +95,14 @@ func WithPlainHTTP() ClientOpts {
 	}
 }
 
+// WithRegistryMirrors configures the client to pull the charts of an OCI repository from the mirror of its registry,
+// see MirrorOCIRepository. The credentials of the repository are used to access the mirror.
+func WithRegistryMirrors(mirrors map[string]string) ClientOpts {
+	return func(c *nativeHelmChart) {
+		c.registryMirrors = mirrors
+	}
+}
+
 func NewClient(repoURL string, creds Creds, enableOci bool, proxy string, noProxy string, opts ...ClientOpts) Client {
 	return NewClientWithLock(repoURL, creds, globalLock, enableOci, proxy, noProxy, opts...)
 }
@@ -112,6 +120,9 @@ func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, enabl
 	for i := range opts {
 		opts[i](c)
 	}
+	if c.enableOci {
+		c.repoURL = MirrorOCIRepository(c.repoURL, c.registryMirrors)
+	}
 	return c
 }
 
@@ -134,8 +145,9 @@ type nativeHelmChart struct {
 	indexCache               indexCache
 	proxy                    string
 	noProxy                  string
-	customUserAgent          string        // Custom User-Agent string (optional)
-	helmChartCacheExpiration time.Duration // Cache expiration for chart cache
+	customUserAgent          string            // Custom User-Agent string (optional)
+	registryMirrors          map[string]string // Mirrors of the OCI registries (optional)
+	helmChartCacheExpiration time.Duration     // Cache expiration for chart cache
 }
 
 // getUserAgent returns the User-Agent string to use for HTTP requests.
@@ -63,6 +63,11 @@ var redactor = func(text string) string {
 }
 
 func (c Cmd) run(ctx context.Context, args ...string) (string, string, error) {
+	return c.runWithLimits(ctx, executil.ResourceLimits{}, args...)
+}
+
+// runWithLimits runs helm, terminating it if it exceeds the given resource limits
+func (c Cmd) runWithLimits(ctx context.Context, limits executil.ResourceLimits, args ...string) (string, string, error) {
 	cmd := exec.CommandContext(ctx, "helm", args...)
 	cmd.Dir = c.WorkDir
 	cmd.Env = os.Environ()
@@ -77,7 +82,13 @@ func (c Cmd) run(ctx context.Context, args ...string) (string, string, error) {
 	cmd.Env = proxy.UpsertEnv(cmd, c.proxy, c.noProxy)
 	fullCommand := executil.GetCommandArgsToLog(cmd)
 
-	out, err := c.runWithRedactor(cmd, redactor)
+	var out string
+	var err error
+	if limits.IsZero() {
+		out, err = c.runWithRedactor(cmd, redactor)
+	} else {
+		out, err = executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Redactor: redactor, Limits: limits})
+	}
 	if err != nil {
 		return out, fullCommand, fmt.Errorf("failed running helm: %w", err)
 	}
@@ -384,6 +395,8 @@ type TemplateOpts struct {
 	SkipCrds             bool
 	SkipSchemaValidation bool
 	SkipTests            bool
+	// Limits defines the resources that `helm template` is allowed to use
+	Limits executil.ResourceLimits
 }
 
 func cleanSetParameters(val string) string {
@@ -459,8 +472,13 @@ func (c *Cmd) template(chartPath string, opts *TemplateOpts) (string, string, er
 		args = append(args, "--skip-tests")
 	}
 
-	out, command, err := c.run(context.Background(), args...)
+	out, command, err := c.runWithLimits(context.Background(), opts.Limits, args...)
 	if err != nil {
+		// the output of a command exceeding its limits is not relevant, so only the exceeded limit is reported
+		var limitErr *executil.ResourceLimitExceededError
+		if errors.As(err, &limitErr) {
+			return "", command, fmt.Errorf("failed running helm template: %w", limitErr)
+		}
 		msg := err.Error()
 		if strings.Contains(msg, "--api-versions") {
 			log.Debug(msg)
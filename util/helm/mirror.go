@@ -0,0 +1,52 @@
+package helm
+
+import (
+	"maps"
+	"strings"
+)
+
+// MirrorOCIRepository returns the OCI repository with its registry replaced by the mirror configured for it, or the
+// repository itself if no mirror is configured. The keys of the mirrors are registry hosts, optionally followed by a
+// path prefix (e.g. `docker.io` or `docker.io/bitnamicharts`), and the values are the registry hosts and path prefixes
+// used instead of them (e.g. `registry.example.com/docker-hub`). The longest matching key is used. The `oci://` prefix
+// of the repository is preserved.
+func MirrorOCIRepository(repo string, mirrors map[string]string) string {
+	ref, hasScheme := strings.CutPrefix(repo, "oci://")
+	var prefix, mirror string
+	for registry, registryMirror := range mirrors {
+		registry = trimOCIReference(registry)
+		if registry == "" || len(registry) <= len(prefix) {
+			continue
+		}
+		if ref == registry || strings.HasPrefix(ref, registry+"/") {
+			prefix = registry
+			mirror = trimOCIReference(registryMirror)
+		}
+	}
+	if prefix == "" || mirror == "" {
+		return repo
+	}
+	mirrored := mirror + strings.TrimPrefix(ref, prefix)
+	if hasScheme {
+		return "oci://" + mirrored
+	}
+	return mirrored
+}
+
+// MergeRegistryMirrors returns the registry mirrors configured globally, overridden by the ones configured for a
+// repository.
+func MergeRegistryMirrors(global map[string]string, repo map[string]string) map[string]string {
+	if len(repo) == 0 {
+		return global
+	}
+	merged := maps.Clone(global)
+	if merged == nil {
+		merged = make(map[string]string, len(repo))
+	}
+	maps.Copy(merged, repo)
+	return merged
+}
+
+func trimOCIReference(ref string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(ref, "oci://"), "/")
+}
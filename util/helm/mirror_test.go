@@ -0,0 +1,54 @@
+package helm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirrorOCIRepository(t *testing.T) {
+	mirrors := map[string]string{
+		"docker.io":               "registry.example.com/docker-hub",
+		"docker.io/bitnamicharts": "bitnami.example.com/",
+		"oci://ghcr.io/":          "oci://registry.example.com/ghcr",
+	}
+	testCases := []struct {
+		name     string
+		repo     string
+		expected string
+	}{
+		{name: "registry", repo: "docker.io/argoproj", expected: "registry.example.com/docker-hub/argoproj"},
+		{name: "registry only", repo: "docker.io", expected: "registry.example.com/docker-hub"},
+		{name: "longest prefix", repo: "docker.io/bitnamicharts", expected: "bitnami.example.com"},
+		{name: "oci scheme", repo: "oci://docker.io/argoproj", expected: "oci://registry.example.com/docker-hub/argoproj"},
+		{name: "normalized keys", repo: "ghcr.io/argoproj/argo-helm", expected: "registry.example.com/ghcr/argoproj/argo-helm"},
+		{name: "no mirror", repo: "quay.io/argoproj", expected: "quay.io/argoproj"},
+		{name: "host prefix", repo: "docker.io.example.com/argoproj", expected: "docker.io.example.com/argoproj"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, MirrorOCIRepository(tc.repo, mirrors))
+		})
+	}
+
+	assert.Equal(t, "docker.io/argoproj", MirrorOCIRepository("docker.io/argoproj", nil))
+}
+
+func TestMergeRegistryMirrors(t *testing.T) {
+	global := map[string]string{"docker.io": "global.example.com", "ghcr.io": "global.example.com/ghcr"}
+
+	assert.Equal(t, global, MergeRegistryMirrors(global, nil))
+	assert.Equal(t, map[string]string{"docker.io": "repo.example.com"}, MergeRegistryMirrors(nil, map[string]string{"docker.io": "repo.example.com"}))
+	assert.Equal(t, map[string]string{"docker.io": "repo.example.com", "ghcr.io": "global.example.com/ghcr"}, MergeRegistryMirrors(global, map[string]string{"docker.io": "repo.example.com"}))
+	assert.Equal(t, "global.example.com", global["docker.io"])
+}
+
+func TestWithRegistryMirrors(t *testing.T) {
+	mirrors := map[string]string{"docker.io": "registry.example.com/docker-hub"}
+
+	c := NewClient("docker.io/bitnamicharts", HelmCreds{}, true, "", "", WithRegistryMirrors(mirrors))
+	assert.Equal(t, "registry.example.com/docker-hub/bitnamicharts", c.(*nativeHelmChart).repoURL)
+
+	c = NewClient("https://docker.io/bitnamicharts", HelmCreds{}, false, "", "", WithRegistryMirrors(mirrors))
+	assert.Equal(t, "https://docker.io/bitnamicharts", c.(*nativeHelmChart).repoURL)
+}
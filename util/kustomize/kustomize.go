@@ -36,6 +36,8 @@ type Image = string
 type BuildOpts struct {
 	KubeVersion string
 	APIVersions []string
+	// Limits defines the resources that `kustomize build` is allowed to use
+	Limits executil.ResourceLimits
 }
 
 // Kustomize provides wrapper functionality around the `kustomize` command.
@@ -391,7 +393,11 @@ func (k *kustomize) Build(opts *v1alpha1.ApplicationSourceKustomize, kustomizeOp
 	cmd.Env = proxy.UpsertEnv(cmd, k.proxy, k.noProxy)
 	cmd.Dir = k.repoRoot
 	commands = append(commands, executil.GetCommandArgsToLog(cmd))
-	out, err := executil.Run(cmd)
+	var limits executil.ResourceLimits
+	if buildOpts != nil {
+		limits = buildOpts.Limits
+	}
+	out, err := executil.RunWithExecRunOpts(cmd, executil.ExecRunOpts{Limits: limits})
 	if err != nil {
 		return nil, nil, nil, err
 	}
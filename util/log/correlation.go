@@ -0,0 +1,62 @@
+package log
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// CorrelationIDField is the log field holding the correlation ID which
+	// ties together the logs of an API request, the repo-server calls it
+	// triggers and the controller operation processing it.
+	CorrelationIDField = "correlation_id"
+	// CorrelationIDHeader is the gRPC metadata and HTTP header key used to
+	// propagate the correlation ID between components.
+	CorrelationIDHeader = "x-argocd-correlation-id"
+)
+
+// correlationIDRegexp matches the correlation IDs accepted from clients: UUIDs, or other short IDs made of letters,
+// digits and dashes. The IDs are stored in the operations of applications and written to logs, so any other value is
+// replaced by a new ID.
+var correlationIDRegexp = regexp.MustCompile(`^[A-Za-z0-9-]{1,64}$`)
+
+type correlationIDKey struct{}
+
+// NewCorrelationID generates a new random correlation ID.
+func NewCorrelationID() string {
+	return uuid.NewString()
+}
+
+// IsValidCorrelationID returns whether the given correlation ID, received
+// from a client, can be used as is.
+func IsValidCorrelationID(id string) bool {
+	return correlationIDRegexp.MatchString(id)
+}
+
+// ContextWithCorrelationID returns a copy of the given context holding the
+// given correlation ID.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationIDFromContext returns the correlation ID held by the given
+// context, or an empty string if there is none.
+func CorrelationIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// WithCorrelationID adds the correlation ID held by the given context to
+// the given log entry, if any.
+func WithCorrelationID(ctx context.Context, entry *logrus.Entry) *logrus.Entry {
+	if id := CorrelationIDFromContext(ctx); id != "" {
+		return entry.WithField(CorrelationIDField, id)
+	}
+	return entry
+}
@@ -0,0 +1,123 @@
+package log
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+)
+
+// LevelOverride overrides the log level of the entries logged by a component
+// of the process, identified by the value of one of the fields of its
+// entries, e.g. the entries of a single application with the 'application'
+// field. The override is not persisted and only applies until the process is
+// restarted.
+type LevelOverride struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+	Level string `json:"level"`
+}
+
+type levelOverride struct {
+	LevelOverride
+	level logrus.Level
+}
+
+var (
+	levelOverridesLock sync.Mutex
+	// levelOverrides holds the current overrides, which are replaced as a
+	// whole on updates so that formatters can read them without locking
+	levelOverrides atomic.Pointer[[]levelOverride]
+)
+
+// GetLevelOverrides returns the log level overrides of the process.
+func GetLevelOverrides() []LevelOverride {
+	overrides := []LevelOverride{}
+	if current := levelOverrides.Load(); current != nil {
+		for _, o := range *current {
+			overrides = append(overrides, o.LevelOverride)
+		}
+	}
+	return overrides
+}
+
+// SetLevelOverride adds the given log level override, or replaces the
+// override of the same field value.
+func SetLevelOverride(override LevelOverride) error {
+	if override.Field == "" || override.Value == "" {
+		return errors.New("the field and value of the log level override are required")
+	}
+	level, err := logrus.ParseLevel(override.Level)
+	if err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	override.Level = level.String()
+
+	levelOverridesLock.Lock()
+	defer levelOverridesLock.Unlock()
+	overrides := deleteLevelOverride(override.Field, override.Value)
+	overrides = append(overrides, levelOverride{LevelOverride: override, level: level})
+	updateLevelOverrides(overrides)
+	return nil
+}
+
+// DeleteLevelOverride deletes the log level override of the given field
+// value, if any.
+func DeleteLevelOverride(field, value string) {
+	levelOverridesLock.Lock()
+	defer levelOverridesLock.Unlock()
+	updateLevelOverrides(deleteLevelOverride(field, value))
+}
+
+func deleteLevelOverride(field, value string) []levelOverride {
+	var overrides []levelOverride
+	if current := levelOverrides.Load(); current != nil {
+		overrides = slices.DeleteFunc(slices.Clone(*current), func(o levelOverride) bool {
+			return o.Field == field && o.Value == value
+		})
+	}
+	return overrides
+}
+
+// updateLevelOverrides stores the given overrides, and sets the level of the
+// standard logger to the most verbose level of the overrides, so that the
+// entries of the overridden components reach the formatter, which filters out
+// the other entries above the level of the process.
+func updateLevelOverrides(overrides []levelOverride) {
+	levelOverrides.Store(&overrides)
+	level := createLogLevel()
+	for _, o := range overrides {
+		level = max(level, o.level)
+	}
+	logrus.SetLevel(level)
+}
+
+// LevelOverridesFormatter is a logrus formatter which drops the entries
+// above the log level of the process, unless the level of their component is
+// overridden, before delegating to the wrapped formatter.
+type LevelOverridesFormatter struct {
+	logrus.Formatter
+}
+
+// WithLevelOverrides wraps the given formatter in a LevelOverridesFormatter.
+func WithLevelOverrides(formatter logrus.Formatter) logrus.Formatter {
+	return &LevelOverridesFormatter{Formatter: formatter}
+}
+
+// Format formats the given entry, or returns nothing if the entry is
+// filtered out.
+func (f *LevelOverridesFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	current := levelOverrides.Load()
+	if current == nil || len(*current) == 0 || entry.Level <= createLogLevel() {
+		return f.Formatter.Format(entry)
+	}
+	for _, o := range *current {
+		if value, ok := entry.Data[o.Field]; ok && entry.Level <= o.level && fmt.Sprint(value) == o.Value {
+			return f.Formatter.Format(entry)
+		}
+	}
+	return nil, nil
+}
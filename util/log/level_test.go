@@ -0,0 +1,43 @@
+package log
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func TestLevelOverrides(t *testing.T) {
+	t.Setenv(common.EnvLogLevel, logrus.InfoLevel.String())
+	level := logrus.GetLevel()
+	t.Cleanup(func() {
+		levelOverrides.Store(nil)
+		logrus.SetLevel(level)
+	})
+	formatter := WithLevelOverrides(&logrus.TextFormatter{DisableTimestamp: true})
+
+	require.Error(t, SetLevelOverride(LevelOverride{Field: "application", Value: "guestbook", Level: "verbose"}))
+	require.Error(t, SetLevelOverride(LevelOverride{Field: "application", Level: "debug"}))
+
+	require.NoError(t, SetLevelOverride(LevelOverride{Field: "application", Value: "guestbook", Level: "DEBUG"}))
+	assert.Equal(t, []LevelOverride{{Field: "application", Value: "guestbook", Level: "debug"}}, GetLevelOverrides())
+	assert.Equal(t, logrus.DebugLevel, logrus.GetLevel())
+
+	format := func(level logrus.Level, data logrus.Fields) string {
+		out, err := formatter.Format(&logrus.Entry{Level: level, Message: "msg", Data: data})
+		require.NoError(t, err)
+		return string(out)
+	}
+	assert.NotEmpty(t, format(logrus.DebugLevel, logrus.Fields{"application": "guestbook"}))
+	assert.NotEmpty(t, format(logrus.InfoLevel, logrus.Fields{"application": "other"}))
+	assert.Empty(t, format(logrus.DebugLevel, logrus.Fields{"application": "other"}))
+	assert.Empty(t, format(logrus.DebugLevel, nil))
+	assert.Empty(t, format(logrus.TraceLevel, logrus.Fields{"application": "guestbook"}))
+
+	DeleteLevelOverride("application", "guestbook")
+	assert.Empty(t, GetLevelOverrides())
+	assert.Equal(t, logrus.InfoLevel, logrus.GetLevel())
+}
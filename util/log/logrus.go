@@ -26,7 +26,7 @@ func NewLogrusLogger(fieldLogger logrus.FieldLogger) logr.Logger {
 // NewWithCurrentConfig create logrus logger by using current configuration
 func NewWithCurrentConfig() *logrus.Logger {
 	l := logrus.New()
-	l.SetFormatter(CreateFormatter(os.Getenv(common.EnvLogFormat)))
+	l.SetFormatter(WithRedaction(CreateFormatter(os.Getenv(common.EnvLogFormat))))
 	l.SetLevel(createLogLevel())
 	return l
 }
@@ -38,6 +38,7 @@ func CreateFormatter(logFormat string) logrus.Formatter {
 	case JsonFormat:
 		formatType = &logrus.JSONFormatter{
 			TimestampFormat: checkTimestampFormat(),
+			FieldMap:        checkJSONFieldMap(),
 		}
 	case TextFormat:
 		formatType = &logrus.TextFormatter{
@@ -48,6 +49,7 @@ func CreateFormatter(logFormat string) logrus.Formatter {
 	default:
 		formatType = &logrus.JSONFormatter{
 			TimestampFormat: checkTimestampFormat(),
+			FieldMap:        checkJSONFieldMap(),
 		}
 	}
 
@@ -73,3 +75,34 @@ func checkEnableFullTimestamp() bool {
 func checkTimestampFormat() string {
 	return os.Getenv(common.EnvLogFormatTimestamp)
 }
+
+// checkJSONFieldMap parses the JSON field map from the environment, which
+// allows renaming the default fields of JSON logs to match the schema
+// expected by log aggregators. Unknown keys and malformed entries are ignored.
+func checkJSONFieldMap() logrus.FieldMap {
+	var fieldMap logrus.FieldMap
+	for _, entry := range strings.Split(os.Getenv(common.EnvLogFormatJSONFieldMap), ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || value == "" {
+			continue
+		}
+		if fieldMap == nil {
+			fieldMap = logrus.FieldMap{}
+		}
+		switch key {
+		case logrus.FieldKeyTime:
+			fieldMap[logrus.FieldKeyTime] = value
+		case logrus.FieldKeyMsg:
+			fieldMap[logrus.FieldKeyMsg] = value
+		case logrus.FieldKeyLevel:
+			fieldMap[logrus.FieldKeyLevel] = value
+		case logrus.FieldKeyFunc:
+			fieldMap[logrus.FieldKeyFunc] = value
+		case logrus.FieldKeyFile:
+			fieldMap[logrus.FieldKeyFile] = value
+		case logrus.FieldKeyLogrusError:
+			fieldMap[logrus.FieldKeyLogrusError] = value
+		}
+	}
+	return fieldMap
+}
@@ -51,3 +51,12 @@ func TestCreateFormatter(t *testing.T) {
 		assert.Equal(t, &logrus.JSONFormatter{}, result)
 	})
 }
+
+func TestCreateFormatterJSONFieldMap(t *testing.T) {
+	t.Setenv(common.EnvLogFormatJSONFieldMap, "msg=message, level=severity,unknown=value,malformed")
+	result := CreateFormatter("json")
+	assert.Equal(t, &logrus.JSONFormatter{FieldMap: logrus.FieldMap{
+		logrus.FieldKeyMsg:   "message",
+		logrus.FieldKeyLevel: "severity",
+	}}, result)
+}
@@ -0,0 +1,87 @@
+package log
+
+import (
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+// RedactedValue is the value that replaces sensitive log fields.
+const RedactedValue = "******"
+
+// defaultRedactedFields are the names of log fields that are known to hold
+// sensitive information. Field names are compared case-insensitively.
+var defaultRedactedFields = []string{
+	"password",
+	"token",
+	"bearerToken",
+	"authToken",
+	"refreshToken",
+	"secret",
+	"clientSecret",
+	"sshPrivateKey",
+	"tlsClientCertKey",
+	"githubAppPrivateKey",
+	"azureServicePrincipalClientSecret",
+	"authorization",
+}
+
+// RedactingFormatter is a logrus formatter which redacts the values of
+// sensitive fields before delegating to the wrapped formatter.
+type RedactingFormatter struct {
+	logrus.Formatter
+	fields map[string]bool
+}
+
+// NewRedactingFormatter returns a formatter which redacts the default known
+// secret fields and the given additional fields before formatting entries
+// with the given formatter.
+func NewRedactingFormatter(formatter logrus.Formatter, additionalFields ...string) *RedactingFormatter {
+	fields := make(map[string]bool, len(defaultRedactedFields)+len(additionalFields))
+	for _, field := range slices.Concat(defaultRedactedFields, additionalFields) {
+		fields[strings.ToLower(field)] = true
+	}
+	return &RedactingFormatter{Formatter: formatter, fields: fields}
+}
+
+// WithRedaction wraps the given formatter in a RedactingFormatter, redacting
+// the default known secret fields along with the fields configured in the
+// ARGOCD_LOG_REDACT_FIELDS environment variable.
+func WithRedaction(formatter logrus.Formatter) logrus.Formatter {
+	var fields []string
+	for _, field := range strings.Split(os.Getenv(common.EnvLogRedactFields), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			fields = append(fields, field)
+		}
+	}
+	return NewRedactingFormatter(formatter, fields...)
+}
+
+// Format redacts the sensitive fields of the given entry and formats it.
+func (f *RedactingFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	var data logrus.Fields
+	for k, v := range entry.Data {
+		if !f.fields[strings.ToLower(k)] {
+			continue
+		}
+		if data == nil {
+			// the entry data may be shared with other entries, so it is copied
+			// before being modified
+			data = maps.Clone(entry.Data)
+		}
+		if v != nil && v != "" {
+			data[k] = RedactedValue
+		}
+	}
+	if data == nil {
+		return f.Formatter.Format(entry)
+	}
+	redacted := *entry
+	redacted.Data = data
+	return f.Formatter.Format(&redacted)
+}
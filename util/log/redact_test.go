@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func TestRedactingFormatter(t *testing.T) {
+	t.Setenv(common.EnvLogRedactFields, "apiKey, ")
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(WithRedaction(&logrus.JSONFormatter{}))
+
+	entry := logger.WithFields(logrus.Fields{
+		"password":    "my-password",
+		"BearerToken": "my-token",
+		"apikey":      "my-key",
+		"token":       "",
+		"application": "guestbook",
+	})
+	entry.Info("some message")
+
+	out := buf.String()
+	assert.NotContains(t, out, "my-password")
+	assert.NotContains(t, out, "my-token")
+	assert.NotContains(t, out, "my-key")
+	assert.Contains(t, out, `"password":"******"`)
+	assert.Contains(t, out, `"token":""`)
+	assert.Contains(t, out, `"application":"guestbook"`)
+	// the original entry must not be modified
+	require.Equal(t, "my-password", entry.Data["password"])
+}
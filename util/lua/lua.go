@@ -527,6 +527,58 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]strin
 	return discoveryScripts, nil
 }
 
+// ResolveResourceActionParameters validates the parameters of an action against the parameters declared for the action
+// by the discovery scripts of the resource, and sets the default values of the declared parameters which are not
+// provided. The parameters of actions which are not returned by the discovery scripts are not validated.
+func (vm VM) ResolveResourceActionParameters(obj *unstructured.Unstructured, actionName string, params []*applicationpkg.ResourceActionParameters) ([]*applicationpkg.ResourceActionParameters, error) {
+	discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
+	}
+	if len(discoveryScripts) == 0 {
+		return params, nil
+	}
+	availableActions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	if err != nil {
+		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
+	}
+	for _, action := range availableActions {
+		if action.Name == actionName {
+			return resolveResourceActionParameters(action.Params, params)
+		}
+	}
+	return params, nil
+}
+
+func resolveResourceActionParameters(declared []appv1.ResourceActionParam, params []*applicationpkg.ResourceActionParameters) ([]*applicationpkg.ResourceActionParameters, error) {
+	values := make(map[string]string, len(params))
+	for _, param := range params {
+		if _, ok := values[param.GetName()]; ok {
+			return nil, fmt.Errorf("parameter %q is provided more than once", param.GetName())
+		}
+		if !slices.ContainsFunc(declared, func(p appv1.ResourceActionParam) bool { return p.Name == param.GetName() }) {
+			return nil, fmt.Errorf("unknown parameter %q", param.GetName())
+		}
+		values[param.GetName()] = param.GetValue()
+	}
+
+	resolved := make([]*applicationpkg.ResourceActionParameters, 0, len(declared))
+	for _, p := range declared {
+		value, ok := values[p.Name]
+		if !ok || value == "" {
+			if p.Default == "" {
+				return nil, fmt.Errorf("parameter %q is required", p.Name)
+			}
+			value = p.Default
+		}
+		if err := p.ValidateValue(value); err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, &applicationpkg.ResourceActionParameters{Name: new(p.Name), Value: new(value)})
+	}
+	return resolved, nil
+}
+
 // GetResourceAction attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
 	key := GetConfigMapKey(obj.GroupVersionKind())
@@ -420,6 +420,7 @@ func TestExecuteResourceActionDiscovery(t *testing.T) {
 			Name: "scale",
 			Params: []appv1.ResourceActionParam{{
 				Name: "replicas",
+				Type: "number",
 			}},
 		},
 	}
@@ -442,6 +443,7 @@ func TestExecuteResourceActionDiscoveryWithDuplicationActions(t *testing.T) {
 			Name: "scale",
 			Params: []appv1.ResourceActionParam{{
 				Name: "replicas",
+				Type: "number",
 			}},
 		},
 		{
@@ -1096,6 +1098,55 @@ func TestExecuteResourceActionWithParams(t *testing.T) {
 	})
 }
 
+func TestResolveResourceActionParameters(t *testing.T) {
+	t.Parallel()
+	declared := []appv1.ResourceActionParam{
+		{Name: "replicas", Type: appv1.ResourceActionParamTypeInt},
+		{Name: "strategy", Type: appv1.ResourceActionParamTypeEnum, Options: []string{"Recreate", "RollingUpdate"}, Default: "RollingUpdate"},
+	}
+	param := func(name string, value string) *applicationpkg.ResourceActionParameters {
+		return &applicationpkg.ResourceActionParameters{Name: new(name), Value: new(value)}
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+		resolved, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("replicas", "3")})
+		require.NoError(t, err)
+		assert.Equal(t, []*applicationpkg.ResourceActionParameters{param("replicas", "3"), param("strategy", "RollingUpdate")}, resolved)
+	})
+
+	t.Run("DeclaredOrder", func(t *testing.T) {
+		t.Parallel()
+		resolved, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("strategy", "Recreate"), param("replicas", "3")})
+		require.NoError(t, err)
+		assert.Equal(t, []*applicationpkg.ResourceActionParameters{param("replicas", "3"), param("strategy", "Recreate")}, resolved)
+	})
+
+	t.Run("Required", func(t *testing.T) {
+		t.Parallel()
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("replicas", "")})
+		require.EqualError(t, err, `parameter "replicas" is required`)
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		t.Parallel()
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("replicas", "3"), param("image", "nginx")})
+		require.EqualError(t, err, `unknown parameter "image"`)
+	})
+
+	t.Run("Duplicated", func(t *testing.T) {
+		t.Parallel()
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("replicas", "3"), param("replicas", "4")})
+		require.EqualError(t, err, `parameter "replicas" is provided more than once`)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+		_, err := resolveResourceActionParameters(declared, []*applicationpkg.ResourceActionParameters{param("replicas", "3"), param("strategy", "BlueGreen")})
+		require.EqualError(t, err, `parameter "strategy" must be one of Recreate, RollingUpdate, got "BlueGreen"`)
+	})
+}
+
 func createMockResource(kind string, name string, replicas int) *unstructured.Unstructured {
 	return StrToUnstructured(fmt.Sprintf(`
     apiVersion: apps/v1
@@ -261,3 +261,169 @@ func (_c *Service_GetCommitMetadata_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetRevisionComparison provides a mock function for the type Service
+func (_mock *Service) GetRevisionComparison(ctx context.Context, repoURL string, revision string, targetRevision string, project string) (*v1alpha1.RevisionComparison, error) {
+	ret := _mock.Called(ctx, repoURL, revision, targetRevision, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionComparison")
+	}
+
+	var r0 *v1alpha1.RevisionComparison
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) (*v1alpha1.RevisionComparison, error)); ok {
+		return returnFunc(ctx, repoURL, revision, targetRevision, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string, string) *v1alpha1.RevisionComparison); ok {
+		r0 = returnFunc(ctx, repoURL, revision, targetRevision, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.RevisionComparison)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, revision, targetRevision, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetRevisionComparison_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionComparison'
+type Service_GetRevisionComparison_Call struct {
+	*mock.Call
+}
+
+// GetRevisionComparison is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoURL string
+//   - revision string
+//   - targetRevision string
+//   - project string
+func (_e *Service_Expecter) GetRevisionComparison(ctx any, repoURL any, revision any, targetRevision any, project any) *Service_GetRevisionComparison_Call {
+	return &Service_GetRevisionComparison_Call{Call: _e.mock.On("GetRevisionComparison", ctx, repoURL, revision, targetRevision, project)}
+}
+
+func (_c *Service_GetRevisionComparison_Call) Run(run func(ctx context.Context, repoURL string, revision string, targetRevision string, project string)) *Service_GetRevisionComparison_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_GetRevisionComparison_Call) Return(revisionComparison *v1alpha1.RevisionComparison, err error) *Service_GetRevisionComparison_Call {
+	_c.Call.Return(revisionComparison, err)
+	return _c
+}
+
+func (_c *Service_GetRevisionComparison_Call) RunAndReturn(run func(ctx context.Context, repoURL string, revision string, targetRevision string, project string) (*v1alpha1.RevisionComparison, error)) *Service_GetRevisionComparison_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisionImages provides a mock function for the type Service
+func (_mock *Service) GetRevisionImages(ctx context.Context, app *v1alpha1.Application, source *v1alpha1.ApplicationSource, revision string) ([]string, error) {
+	ret := _mock.Called(ctx, app, source, revision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisionImages")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, *v1alpha1.ApplicationSource, string) ([]string, error)); ok {
+		return returnFunc(ctx, app, source, revision)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.Application, *v1alpha1.ApplicationSource, string) []string); ok {
+		r0 = returnFunc(ctx, app, source, revision)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.Application, *v1alpha1.ApplicationSource, string) error); ok {
+		r1 = returnFunc(ctx, app, source, revision)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Service_GetRevisionImages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisionImages'
+type Service_GetRevisionImages_Call struct {
+	*mock.Call
+}
+
+// GetRevisionImages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - app *v1alpha1.Application
+//   - source *v1alpha1.ApplicationSource
+//   - revision string
+func (_e *Service_Expecter) GetRevisionImages(ctx any, app any, source any, revision any) *Service_GetRevisionImages_Call {
+	return &Service_GetRevisionImages_Call{Call: _e.mock.On("GetRevisionImages", ctx, app, source, revision)}
+}
+
+func (_c *Service_GetRevisionImages_Call) Run(run func(ctx context.Context, app *v1alpha1.Application, source *v1alpha1.ApplicationSource, revision string)) *Service_GetRevisionImages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.Application
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.Application)
+		}
+		var arg2 *v1alpha1.ApplicationSource
+		if args[2] != nil {
+			arg2 = args[2].(*v1alpha1.ApplicationSource)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Service_GetRevisionImages_Call) Return(strings []string, err error) *Service_GetRevisionImages_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Service_GetRevisionImages_Call) RunAndReturn(run func(ctx context.Context, app *v1alpha1.Application, source *v1alpha1.ApplicationSource, revision string) ([]string, error)) *Service_GetRevisionImages_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/shared"
 
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -23,6 +25,8 @@ type Service interface {
 	GetCommitMetadata(ctx context.Context, repoURL string, commitSHA string, project string) (*shared.CommitMetadata, error)
 	GetAppDetails(ctx context.Context, app *v1alpha1.Application) (*shared.AppDetail, error)
 	GetAppProject(ctx context.Context, projectName string, namespace string) (*unstructured.Unstructured, error)
+	GetRevisionImages(ctx context.Context, app *v1alpha1.Application, source *v1alpha1.ApplicationSource, revision string) ([]string, error)
+	GetRevisionComparison(ctx context.Context, repoURL string, revision string, targetRevision string, project string) (*v1alpha1.RevisionComparison, error)
 }
 
 func NewArgoCDService(clientset kubernetes.Interface, dynamicClient dynamic.Interface, namespace string, repoClientset apiclient.Clientset) (*argoCDService, error) {
@@ -73,6 +77,20 @@ func (svc *argoCDService) GetCommitMetadata(ctx context.Context, repoURL string,
 	}, nil
 }
 
+// GetRevisionComparison returns the commits and the changed files between two revisions of the given repository
+func (svc *argoCDService) GetRevisionComparison(ctx context.Context, repoURL string, revision string, targetRevision string, project string) (*v1alpha1.RevisionComparison, error) {
+	argocdDB := db.NewDB(svc.namespace, svc.settingsMgr, svc.clientset)
+	repo, err := argocdDB.GetRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, err
+	}
+	return svc.repoServerClient.GetRevisionComparison(ctx, &apiclient.RepoServerRevisionComparisonRequest{
+		Repo:           repo,
+		Revision:       revision,
+		TargetRevision: targetRevision,
+	})
+}
+
 func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Application) (*shared.AppDetail, error) {
 	appSource := app.Spec.GetSourcePtrByIndex(0)
 
@@ -94,12 +112,13 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Appli
 		return nil, err
 	}
 	appDetail, err := svc.repoServerClient.GetAppDetails(ctx, &apiclient.RepoServerAppDetailsQuery{
-		AppName:          app.Name,
-		Repo:             repo,
-		Source:           appSource,
-		Repos:            helmRepos,
-		KustomizeOptions: kustomizeOptions,
-		HelmOptions:      helmOptions,
+		AppName:           app.Name,
+		SourceEnvironment: app.Labels[v1alpha1.LabelKeySourceEnvironment],
+		Repo:              repo,
+		Source:            appSource,
+		Repos:             helmRepos,
+		KustomizeOptions:  kustomizeOptions,
+		HelmOptions:       helmOptions,
 	})
 	if err != nil {
 		return nil, err
@@ -125,6 +144,51 @@ func (svc *argoCDService) GetAppDetails(ctx context.Context, app *v1alpha1.Appli
 	}, nil
 }
 
+// GetRevisionImages returns the images of the manifests generated from the given source at the given revision
+func (svc *argoCDService) GetRevisionImages(ctx context.Context, app *v1alpha1.Application, source *v1alpha1.ApplicationSource, revision string) ([]string, error) {
+	argocdDB := db.NewDB(svc.namespace, svc.settingsMgr, svc.clientset)
+	repo, err := argocdDB.GetRepository(ctx, source.RepoURL, app.Spec.Project)
+	if err != nil {
+		return nil, err
+	}
+	helmRepos, err := argocdDB.ListHelmRepositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kustomizeOptions, err := svc.settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, err
+	}
+	helmOptions, err := svc.settingsMgr.GetHelmSettings()
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := svc.repoServerClient.GenerateManifest(ctx, &apiclient.ManifestRequest{
+		Repo:              repo,
+		Revision:          revision,
+		AppName:           app.InstanceName(svc.namespace),
+		SourceEnvironment: app.Labels[v1alpha1.LabelKeySourceEnvironment],
+		Namespace:         app.Spec.Destination.Namespace,
+		ApplicationSource: source,
+		Repos:             helmRepos,
+		KustomizeOptions:  kustomizeOptions,
+		HelmOptions:       helmOptions,
+		ProjectName:       app.Spec.Project,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var images []string
+	for _, manifest := range manifests.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal([]byte(manifest), &obj.Object); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		images = append(images, kube.GetResourceImages(obj)...)
+	}
+	return images, nil
+}
+
 func (svc *argoCDService) GetAppProject(ctx context.Context, projectName string, namespace string) (*unstructured.Unstructured, error) {
 	if projectName == "" {
 		projectName = "default"
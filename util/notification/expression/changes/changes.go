@@ -0,0 +1,213 @@
+package changes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+)
+
+// ImageChange is the change of the tags of an image between the previous and the current deployment of an application
+type ImageChange struct {
+	// Name of the image, without tag or digest
+	Name string
+	// From is the tag or digest of the image in the previous deployment, empty if the image has been added
+	From string
+	// To is the tag or digest of the image in the current deployment, empty if the image has been removed
+	To string
+}
+
+func (c ImageChange) String() string {
+	switch {
+	case c.From == "":
+		return fmt.Sprintf("%s %s (added)", c.Name, c.To)
+	case c.To == "":
+		return fmt.Sprintf("%s %s (removed)", c.Name, c.From)
+	default:
+		return fmt.Sprintf("%s %s→%s", c.Name, c.From, c.To)
+	}
+}
+
+func NewExprs(argocdService service.Service, app *unstructured.Unstructured) map[string]any {
+	return map[string]any{
+		"DiffSummary": func() string {
+			application, err := getApplication(app)
+			if err != nil {
+				panic(err)
+			}
+			return diffSummary(application)
+		},
+		"ChangedImages": func() []ImageChange {
+			application, err := getApplication(app)
+			if err != nil {
+				panic(err)
+			}
+			changes, err := changedImages(application, argocdService)
+			if err != nil {
+				panic(err)
+			}
+			return changes
+		},
+	}
+}
+
+func getApplication(obj *unstructured.Unstructured) (*v1alpha1.Application, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	application := &v1alpha1.Application{}
+	if err := json.Unmarshal(data, application); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// diffSummary summarizes the resources changed by the last sync per kind, e.g. "3 Deployments, 1 Service changed".
+// Resources applied without changes and hooks are not counted.
+func diffSummary(app *v1alpha1.Application) string {
+	if app.Status.OperationState == nil || app.Status.OperationState.SyncResult == nil {
+		return ""
+	}
+	counts := map[string]int{}
+	for _, res := range app.Status.OperationState.SyncResult.Resources {
+		if res.HookType != "" || !isChanged(res) {
+			continue
+		}
+		counts[res.Kind]++
+	}
+	if len(counts) == 0 {
+		return ""
+	}
+	kinds := make([]string, 0, len(counts))
+	for kind := range counts {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		if counts[kinds[i]] != counts[kinds[j]] {
+			return counts[kinds[i]] > counts[kinds[j]]
+		}
+		return kinds[i] < kinds[j]
+	})
+	parts := make([]string, len(kinds))
+	for i, kind := range kinds {
+		if counts[kind] == 1 {
+			parts[i] = "1 " + kind
+		} else {
+			parts[i] = fmt.Sprintf("%d %s", counts[kind], pluralize(kind))
+		}
+	}
+	return strings.Join(parts, ", ") + " changed"
+}
+
+func isChanged(res *v1alpha1.ResourceResult) bool {
+	switch res.Status {
+	case synccommon.ResultCodePruned:
+		return true
+	case synccommon.ResultCodeSynced:
+		return res.Message != "unchanged" && !strings.HasSuffix(res.Message, " unchanged")
+	default:
+		return false
+	}
+}
+
+func pluralize(kind string) string {
+	lower := strings.ToLower(kind)
+	switch {
+	case strings.HasSuffix(lower, "s"), strings.HasSuffix(lower, "x"), strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return kind + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return kind[:len(kind)-1] + "ies"
+	default:
+		return kind + "s"
+	}
+}
+
+// changedImages compares the images synced by the last sync with the images of the manifests of the previous
+// deployment in the history. Only single source applications are supported.
+func changedImages(app *v1alpha1.Application, argocdService service.Service) ([]ImageChange, error) {
+	if app.Status.OperationState == nil || app.Status.OperationState.SyncResult == nil || app.Spec.HasMultipleSources() {
+		return nil, nil
+	}
+	syncResult := app.Status.OperationState.SyncResult
+	var current []string
+	for _, res := range syncResult.Resources {
+		if res.HookType == "" {
+			current = append(current, res.Images...)
+		}
+	}
+
+	var previous *v1alpha1.RevisionHistory
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		if app.Status.History[i].Revision != syncResult.Revision && app.Status.History[i].Source.RepoURL != "" {
+			previous = &app.Status.History[i]
+			break
+		}
+	}
+	if previous == nil {
+		return nil, nil
+	}
+	previousImages, err := argocdService.GetRevisionImages(context.Background(), app, &previous.Source, previous.Revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get images of revision %s: %w", previous.Revision, err)
+	}
+	return diffImages(previousImages, current), nil
+}
+
+func diffImages(previous []string, current []string) []ImageChange {
+	previousTags := imageTags(previous)
+	currentTags := imageTags(current)
+	var changes []ImageChange
+	for name, to := range currentTags {
+		from, ok := previousTags[name]
+		if !ok {
+			changes = append(changes, ImageChange{Name: name, To: strings.Join(to, ",")})
+		} else if !slices.Equal(from, to) {
+			changes = append(changes, ImageChange{Name: name, From: strings.Join(from, ","), To: strings.Join(to, ",")})
+		}
+	}
+	for name, from := range previousTags {
+		if _, ok := currentTags[name]; !ok {
+			changes = append(changes, ImageChange{Name: name, From: strings.Join(from, ",")})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// imageTags returns the sorted, unique tags per image name
+func imageTags(images []string) map[string][]string {
+	tags := map[string][]string{}
+	for _, image := range images {
+		name, tag := splitImage(image)
+		if !slices.Contains(tags[name], tag) {
+			tags[name] = append(tags[name], tag)
+		}
+	}
+	for name := range tags {
+		slices.Sort(tags[name])
+	}
+	return tags
+}
+
+// splitImage splits an image reference into the name and the tag, or the digest if the reference has no tag
+func splitImage(image string) (string, string) {
+	name, digest, _ := strings.Cut(image, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		return name[:i], name[i+1:]
+	}
+	if digest != "" {
+		return name, digest
+	}
+	return name, "latest"
+}
@@ -0,0 +1,111 @@
+package changes
+
+import (
+	"testing"
+
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+)
+
+func newTestApp(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+	app := &v1alpha1.Application{
+		Spec: v1alpha1.ApplicationSpec{
+			Project: "default",
+			Source:  &v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			History: v1alpha1.RevisionHistories{
+				{ID: 1, Revision: "abc", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"}},
+				{ID: 2, Revision: "def", Source: v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"}},
+			},
+			OperationState: &v1alpha1.OperationState{
+				SyncResult: &v1alpha1.SyncOperationResult{
+					Revision: "def",
+					Resources: v1alpha1.ResourceResults{
+						{Kind: "Deployment", Name: "web", Status: synccommon.ResultCodeSynced, Message: "deployment.apps/web configured", Images: []string{"nginx:1.26"}},
+						{Kind: "Deployment", Name: "api", Status: synccommon.ResultCodeSynced, Message: "deployment.apps/api configured", Images: []string{"ghcr.io/example/api:v2", "busybox"}},
+						{Kind: "Deployment", Name: "worker", Status: synccommon.ResultCodeSynced, Message: "deployment.apps/worker unchanged", Images: []string{"ghcr.io/example/worker:v1"}},
+						{Kind: "Service", Name: "web", Status: synccommon.ResultCodeSynced, Message: "service/web created"},
+						{Kind: "NetworkPolicy", Name: "web", Status: synccommon.ResultCodePruned, Message: "pruned"},
+						{Kind: "Job", Name: "migrate", Status: synccommon.ResultCodeSynced, HookType: synccommon.HookTypePreSync, Images: []string{"migrate:v2"}},
+					},
+				},
+			},
+		},
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(app)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func TestDiffSummary(t *testing.T) {
+	t.Parallel()
+	summary := NewExprs(nil, newTestApp(t))["DiffSummary"].(func() string)
+	assert.Equal(t, "2 Deployments, 1 NetworkPolicy, 1 Service changed", summary())
+}
+
+func TestDiffSummary_NoSyncResult(t *testing.T) {
+	t.Parallel()
+	summary := NewExprs(nil, &unstructured.Unstructured{Object: map[string]any{}})["DiffSummary"].(func() string)
+	assert.Empty(t, summary())
+}
+
+func TestChangedImages(t *testing.T) {
+	t.Parallel()
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetRevisionImages(mock.Anything, mock.Anything, mock.MatchedBy(func(source *v1alpha1.ApplicationSource) bool {
+		return source.Path == "guestbook"
+	}), "abc").Return([]string{"nginx:1.25", "ghcr.io/example/api:v1", "ghcr.io/example/worker:v1", "redis:7"}, nil)
+
+	changedImages := NewExprs(argocdService, newTestApp(t))["ChangedImages"].(func() []ImageChange)
+	changes := changedImages()
+	assert.Equal(t, []ImageChange{
+		{Name: "busybox", To: "latest"},
+		{Name: "ghcr.io/example/api", From: "v1", To: "v2"},
+		{Name: "nginx", From: "1.25", To: "1.26"},
+		{Name: "redis", From: "7"},
+	}, changes)
+	assert.Equal(t, "nginx 1.25→1.26", changes[2].String())
+	assert.Equal(t, "busybox latest (added)", changes[0].String())
+	assert.Equal(t, "redis 7 (removed)", changes[3].String())
+}
+
+func TestChangedImages_NoPreviousDeployment(t *testing.T) {
+	t.Parallel()
+	app := newTestApp(t)
+	unstructured.RemoveNestedField(app.Object, "status", "history")
+	changedImages := NewExprs(mocks.NewService(t), app)["ChangedImages"].(func() []ImageChange)
+	assert.Empty(t, changedImages())
+}
+
+func TestSplitImage(t *testing.T) {
+	t.Parallel()
+	for image, expected := range map[string][2]string{
+		"nginx":                        {"nginx", "latest"},
+		"nginx:1.25":                   {"nginx", "1.25"},
+		"localhost:5000/nginx":         {"localhost:5000/nginx", "latest"},
+		"localhost:5000/nginx:1.25":    {"localhost:5000/nginx", "1.25"},
+		"nginx@sha256:abc":             {"nginx", "sha256:abc"},
+		"nginx:1.25@sha256:abc":        {"nginx", "1.25"},
+		"ghcr.io/example/api:v1.2.3-a": {"ghcr.io/example/api", "v1.2.3-a"},
+	} {
+		name, tag := splitImage(image)
+		assert.Equal(t, expected, [2]string{name, tag}, image)
+	}
+}
+
+func TestPluralize(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "Deployments", pluralize("Deployment"))
+	assert.Equal(t, "Ingresses", pluralize("Ingress"))
+	assert.Equal(t, "NetworkPolicies", pluralize("NetworkPolicy"))
+	assert.Equal(t, "Gateways", pluralize("Gateway"))
+}
@@ -7,6 +7,7 @@ import (
 
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 
+	"github.com/argoproj/argo-cd/v3/util/notification/expression/changes"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/repo"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/strings"
 	"github.com/argoproj/argo-cd/v3/util/notification/expression/time"
@@ -31,6 +32,7 @@ func Spawn(app *unstructured.Unstructured, argocdService service.Service, vars m
 	}
 	maps.Copy(clone, helpers)
 	clone["repo"] = repo.NewExprs(argocdService, app)
+	clone["changes"] = changes.NewExprs(argocdService, app)
 
 	return clone
 }
@@ -12,6 +12,7 @@ func TestExpr(t *testing.T) {
 		"time",
 		"repo",
 		"strings",
+		"changes",
 	}
 
 	for _, ns := range namespaces {
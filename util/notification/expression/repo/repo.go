@@ -46,21 +46,29 @@ func getAppDetails(un *unstructured.Unstructured, argocdService service.Service)
 	return appDetail, nil
 }
 
-func getCommitMetadata(commitSHA string, app *unstructured.Unstructured, argocdService service.Service) (*shared.CommitMetadata, error) {
+func getSourceRepoURLAndProject(app *unstructured.Unstructured) (string, string, error) {
 	repoURL, ok, err := unstructured.NestedString(app.Object, "spec", "source", "repoURL")
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 	if !ok {
 		panic(errors.New("failed to get application source repo URL"))
 	}
 	project, ok, err := unstructured.NestedString(app.Object, "spec", "project")
 	if err != nil {
-		return nil, err
+		return "", "", err
 	}
 	if !ok {
 		panic(errors.New("failed to get application project"))
 	}
+	return repoURL, project, nil
+}
+
+func getCommitMetadata(commitSHA string, app *unstructured.Unstructured, argocdService service.Service) (*shared.CommitMetadata, error) {
+	repoURL, project, err := getSourceRepoURLAndProject(app)
+	if err != nil {
+		return nil, err
+	}
 
 	meta, err := argocdService.GetCommitMetadata(context.Background(), repoURL, commitSHA, project)
 	if err != nil {
@@ -69,6 +77,14 @@ func getCommitMetadata(commitSHA string, app *unstructured.Unstructured, argocdS
 	return meta, nil
 }
 
+func compareRevisions(revision string, targetRevision string, app *unstructured.Unstructured, argocdService service.Service) (*v1alpha1.RevisionComparison, error) {
+	repoURL, project, err := getSourceRepoURLAndProject(app)
+	if err != nil {
+		return nil, err
+	}
+	return argocdService.GetRevisionComparison(context.Background(), repoURL, revision, targetRevision, project)
+}
+
 func FullNameByRepoURL(rawURL string) string {
 	parsed, err := giturls.Parse(rawURL)
 	if err != nil {
@@ -106,6 +122,14 @@ func NewExprs(argocdService service.Service, app *unstructured.Unstructured) map
 
 			return *meta
 		},
+		"CompareRevisions": func(revision string, targetRevision string) any {
+			comparison, err := compareRevisions(revision, targetRevision, app, argocdService)
+			if err != nil {
+				panic(err)
+			}
+
+			return *comparison
+		},
 		"GetAppDetails": func() any {
 			appDetails, err := getAppDetails(app, argocdService)
 			if err != nil {
@@ -0,0 +1,35 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/argocd/mocks"
+)
+
+func TestCompareRevisions(t *testing.T) {
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"spec": map[string]any{
+			"project": "my-project",
+			"source": map[string]any{
+				"repoURL": "https://github.com/argoproj/argocd-example-apps.git",
+			},
+		},
+	}}
+	comparison := &v1alpha1.RevisionComparison{
+		Revision:       "abc",
+		TargetRevision: "def",
+		Commits:        []v1alpha1.CommitMetadata{{SHA: "def", Subject: "Update guestbook"}},
+		ChangedFiles:   []string{"guestbook/deployment.yaml"},
+	}
+	argocdService := mocks.NewService(t)
+	argocdService.EXPECT().GetRevisionComparison(mock.Anything, "https://github.com/argoproj/argocd-example-apps.git", "abc", "HEAD", "my-project").Return(comparison, nil)
+
+	compareRevisions := NewExprs(argocdService, app)["CompareRevisions"].(func(string, string) any)
+
+	assert.Equal(t, *comparison, compareRevisions("abc", "HEAD"))
+}
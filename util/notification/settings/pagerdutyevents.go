@@ -0,0 +1,149 @@
+package settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// pagerDutyEventsServiceType is the type of the PagerDuty Events v2 service provided by Argo CD
+	pagerDutyEventsServiceType = "pagerdutyevents"
+
+	pagerDutyEventActionTrigger     = "trigger"
+	pagerDutyEventActionAcknowledge = "acknowledge"
+	pagerDutyEventActionResolve     = "resolve"
+)
+
+var secretKeyPattern = regexp.MustCompile(`[$][\w-]+`)
+
+// PagerDutyEventsOptions are the options of the PagerDuty Events v2 service
+type PagerDutyEventsOptions struct {
+	// RoutingKeys maps the recipients to the routing keys of the PagerDuty integrations
+	RoutingKeys map[string]string `json:"routingKeys"`
+}
+
+type pagerDutyEventsService struct {
+	opts        PagerDutyEventsOptions
+	manageEvent func(ctx context.Context, event pagerduty.V2Event) (*pagerduty.V2EventResponse, error)
+}
+
+// NewPagerDutyEventsService returns a PagerDuty Events v2 service, which derives the deduplication keys of the events
+// from the source and class of the pagerdutyv2 template, and resolves incidents for templates with the resolve severity
+func NewPagerDutyEventsService(opts PagerDutyEventsOptions) services.NotificationService {
+	return &pagerDutyEventsService{opts: opts, manageEvent: pagerduty.ManageEventWithContext}
+}
+
+func (s *pagerDutyEventsService) Send(notification services.Notification, dest services.Destination) error {
+	routingKey, ok := s.opts.RoutingKeys[dest.Recipient]
+	if !ok {
+		return fmt.Errorf("no routing key configured for recipient %s", dest.Recipient)
+	}
+	if notification.PagerDutyV2 == nil {
+		return errors.New("no config found for pagerdutyv2")
+	}
+
+	event := buildPagerDutyEvent(routingKey, notification.PagerDutyV2)
+	if event.DedupKey == "" {
+		return errors.New("deduplication key is empty, the pagerdutyv2 template requires a dedupKey or a source")
+	}
+	response, err := s.manageEvent(context.Background(), event)
+	if err != nil {
+		return fmt.Errorf("failed to send PagerDuty event: %w", err)
+	}
+	log.Debugf("PagerDuty %s event sent for %s. Status: %v, Message: %v", event.Action, event.DedupKey, response.Status, response.Message)
+	return nil
+}
+
+func buildPagerDutyEvent(routingKey string, n *services.PagerDutyV2Notification) pagerduty.V2Event {
+	event := pagerduty.V2Event{
+		RoutingKey: routingKey,
+		Action:     pagerDutyEventActionTrigger,
+		DedupKey:   pagerDutyDedupKey(n),
+		Client:     "ArgoCD",
+		ClientURL:  n.URL,
+	}
+	switch severity := strings.ToLower(strings.TrimSpace(n.Severity)); severity {
+	case pagerDutyEventActionResolve, pagerDutyEventActionAcknowledge:
+		// resolve and acknowledge events only refer to the incident of the deduplication key
+		event.Action = severity
+	default:
+		event.Payload = &pagerduty.V2Payload{
+			Summary:   n.Summary,
+			Severity:  severity,
+			Source:    n.Source,
+			Component: n.Component,
+			Group:     n.Group,
+			Class:     n.Class,
+		}
+	}
+	return event
+}
+
+// pagerDutyDedupKey returns the deduplication key of the notification. Unless the template sets it explicitly, the key
+// is derived from the source, which identifies the application, and the class, which identifies the condition.
+func pagerDutyDedupKey(n *services.PagerDutyV2Notification) string {
+	if key := strings.TrimSpace(n.DedupKey); key != "" {
+		return key
+	}
+	source := strings.TrimSpace(n.Source)
+	if source == "" {
+		return ""
+	}
+	if class := strings.TrimSpace(n.Class); class != "" {
+		return source + "/" + class
+	}
+	return source
+}
+
+// applyPagerDutyEventsServices registers the PagerDuty Events v2 services configured in the config map, which are not
+// known to the notifications engine
+func applyPagerDutyEventsServices(cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Secret) error {
+	for k, v := range configMap.Data {
+		parts := strings.Split(k, ".")
+		if len(parts) < 2 || parts[0] != "service" || parts[1] != pagerDutyEventsServiceType {
+			continue
+		}
+		name := parts[len(parts)-1]
+		if len(parts) > 3 {
+			return fmt.Errorf("invalid service key; expected 'service.%s(.<name>)' but got '%s'", pagerDutyEventsServiceType, k)
+		}
+		var opts PagerDutyEventsOptions
+		if err := yaml.Unmarshal([]byte(v), &opts); err != nil {
+			return fmt.Errorf("failed to unmarshal service %s: %w", k, err)
+		}
+		for recipient, routingKey := range opts.RoutingKeys {
+			opts.RoutingKeys[recipient] = replaceSecretKeys(routingKey, secret)
+		}
+		if cfg.Services == nil {
+			cfg.Services = map[string]api.ServiceFactory{}
+		}
+		cfg.Services[name] = func() (services.NotificationService, error) {
+			return NewPagerDutyEventsService(opts), nil
+		}
+	}
+	return nil
+}
+
+// replaceSecretKeys replaces the $<key> references in the value with the values of the secret, the same way the
+// notifications engine does for the services it knows
+func replaceSecretKeys(val string, secret *corev1.Secret) string {
+	if secret == nil {
+		return val
+	}
+	return secretKeyPattern.ReplaceAllStringFunc(val, func(key string) string {
+		if secretVal, ok := secret.Data[key[1:]]; ok {
+			return string(secretVal)
+		}
+		return key
+	})
+}
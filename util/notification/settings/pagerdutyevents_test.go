@@ -0,0 +1,129 @@
+package settings
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func newTestPagerDutyEventsService(events *[]pagerduty.V2Event) *pagerDutyEventsService {
+	return &pagerDutyEventsService{
+		opts: PagerDutyEventsOptions{RoutingKeys: map[string]string{"my-service": "routing-key"}},
+		manageEvent: func(_ context.Context, event pagerduty.V2Event) (*pagerduty.V2EventResponse, error) {
+			*events = append(*events, event)
+			return &pagerduty.V2EventResponse{Status: "success"}, nil
+		},
+	}
+}
+
+func TestPagerDutyEventsService_Send(t *testing.T) {
+	t.Run("Trigger", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{PagerDutyV2: &services.PagerDutyV2Notification{
+			Summary:  "Application guestbook has degraded.",
+			Severity: "error",
+			Source:   "argocd/guestbook",
+			Class:    "health-degraded",
+			URL:      "https://argocd.example.com/applications/guestbook",
+		}}, services.Destination{Service: "pagerduty", Recipient: "my-service"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "trigger", events[0].Action)
+		assert.Equal(t, "routing-key", events[0].RoutingKey)
+		assert.Equal(t, "argocd/guestbook/health-degraded", events[0].DedupKey)
+		assert.Equal(t, "https://argocd.example.com/applications/guestbook", events[0].ClientURL)
+		require.NotNil(t, events[0].Payload)
+		assert.Equal(t, "error", events[0].Payload.Severity)
+		assert.Equal(t, "argocd/guestbook", events[0].Payload.Source)
+	})
+
+	t.Run("Resolve", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{PagerDutyV2: &services.PagerDutyV2Notification{
+			Summary:  "Application guestbook is healthy.",
+			Severity: "resolve",
+			Source:   "argocd/guestbook",
+			Class:    "health-degraded",
+		}}, services.Destination{Service: "pagerduty", Recipient: "my-service"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "resolve", events[0].Action)
+		assert.Equal(t, "argocd/guestbook/health-degraded", events[0].DedupKey)
+		assert.Nil(t, events[0].Payload)
+	})
+
+	t.Run("ExplicitDedupKey", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{PagerDutyV2: &services.PagerDutyV2Notification{
+			Severity: "critical",
+			Source:   "argocd/guestbook",
+			Class:    "sync-failed",
+			DedupKey: "guestbook",
+		}}, services.Destination{Service: "pagerduty", Recipient: "my-service"})
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "guestbook", events[0].DedupKey)
+	})
+
+	t.Run("UnknownRecipient", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{PagerDutyV2: &services.PagerDutyV2Notification{
+			Severity: "error",
+			Source:   "argocd/guestbook",
+		}}, services.Destination{Service: "pagerduty", Recipient: "other-service"})
+		require.ErrorContains(t, err, "no routing key configured for recipient other-service")
+		assert.Empty(t, events)
+	})
+
+	t.Run("MissingTemplate", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{}, services.Destination{Service: "pagerduty", Recipient: "my-service"})
+		require.ErrorContains(t, err, "no config found for pagerdutyv2")
+		assert.Empty(t, events)
+	})
+
+	t.Run("MissingDedupKey", func(t *testing.T) {
+		var events []pagerduty.V2Event
+		err := newTestPagerDutyEventsService(&events).Send(services.Notification{PagerDutyV2: &services.PagerDutyV2Notification{
+			Severity: "error",
+		}}, services.Destination{Service: "pagerduty", Recipient: "my-service"})
+		require.ErrorContains(t, err, "deduplication key is empty")
+		assert.Empty(t, events)
+	})
+}
+
+func TestApplyPagerDutyEventsServices(t *testing.T) {
+	cfg := &api.Config{Services: map[string]api.ServiceFactory{}}
+	configMap := &corev1.ConfigMap{Data: map[string]string{
+		"service.pagerdutyevents":          "routingKeys:\n  my-service: $pagerduty-key\n",
+		"service.pagerdutyevents.platform": "routingKeys:\n  platform: plain-key\n",
+		"service.pagerdutyv2":              "serviceKeys:\n  my-service: key\n",
+	}}
+	secret := &corev1.Secret{Data: map[string][]byte{"pagerduty-key": []byte("secret-key")}}
+
+	require.NoError(t, applyPagerDutyEventsServices(cfg, configMap, secret))
+	require.Contains(t, cfg.Services, "pagerdutyevents")
+	require.Contains(t, cfg.Services, "platform")
+	assert.NotContains(t, cfg.Services, "pagerdutyv2")
+
+	svc, err := cfg.Services["pagerdutyevents"]()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"my-service": "secret-key"}, svc.(*pagerDutyEventsService).opts.RoutingKeys)
+
+	svc, err = cfg.Services["platform"]()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"platform": "plain-key"}, svc.(*pagerDutyEventsService).opts.RoutingKeys)
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		err := applyPagerDutyEventsServices(&api.Config{}, &corev1.ConfigMap{Data: map[string]string{
+			"service.pagerdutyevents.a.b": "routingKeys: {}",
+		}}, secret)
+		require.ErrorContains(t, err, "invalid service key")
+	})
+}
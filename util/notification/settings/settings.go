@@ -59,6 +59,9 @@ func getContext(cfg *api.Config, configMap *corev1.ConfigMap, secret *corev1.Sec
 	if err := ApplyLegacyConfig(cfg, context, configMap, secret); err != nil {
 		return nil, err
 	}
+	if err := applyPagerDutyEventsServices(cfg, configMap, secret); err != nil {
+		return nil, err
+	}
 	return context, nil
 }
 
@@ -0,0 +1,236 @@
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+)
+
+const (
+	defaultS3Region  = "us-east-1"
+	s3RequestTimeout = 30 * time.Second
+)
+
+// S3Config configures a bucket of an S3 compatible object store, such as AWS S3, MinIO or Google Cloud Storage.
+type S3Config struct {
+	// Bucket is the name of the bucket
+	Bucket string
+	// Prefix is prepended to the keys of all objects
+	Prefix string
+	// Endpoint is the URL of the store. Defaults to the AWS S3 endpoint of the region.
+	Endpoint string
+	// Region is the region of the bucket, used to sign requests. Defaults to us-east-1.
+	Region string
+	// AccessKeyID and SecretAccessKey are static credentials. The default AWS credential chain, e.g. IRSA, is used if
+	// they are empty.
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type s3Store struct {
+	client      *http.Client
+	endpoint    *url.URL
+	bucket      string
+	prefix      string
+	region      string
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+}
+
+// NewS3Store returns a Store which accesses the bucket with path-style requests signed with AWS Signature Version 4.
+func NewS3Store(ctx context.Context, cfg S3Config) (Store, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("bucket is required")
+	}
+	region := cfg.Region
+	if region == "" {
+		region = defaultS3Region
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	if endpointURL.Scheme != "http" && endpointURL.Scheme != "https" {
+		return nil, fmt.Errorf("invalid endpoint %q: the scheme must be http or https", endpoint)
+	}
+
+	var provider aws.CredentialsProvider
+	if cfg.AccessKeyID != "" {
+		provider = credentials.NewStaticCredentialsProvider(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	} else {
+		awsConfig, err := config.LoadDefaultConfig(ctx, config.WithRegion(region))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load AWS credentials: %w", err)
+		}
+		provider = awsConfig.Credentials
+	}
+	if provider == nil {
+		return nil, errors.New("no credentials configured")
+	}
+
+	return &s3Store{
+		client:      &http.Client{Timeout: s3RequestTimeout, Transport: http.DefaultTransport},
+		endpoint:    endpointURL,
+		bucket:      cfg.Bucket,
+		prefix:      strings.Trim(cfg.Prefix, "/"),
+		region:      region,
+		credentials: aws.NewCredentialsCache(provider),
+		signer:      v4.NewSigner(),
+	}, nil
+}
+
+func (s *s3Store) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
+
+// objectURL returns the path-style URL of the object with the given full key, or of the bucket if the key is empty
+func (s *s3Store) objectURL(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	segments := []string{strings.TrimSuffix(u.Path, "/"), url.PathEscape(s.bucket)}
+	if key != "" {
+		for segment := range strings.SplitSeq(key, "/") {
+			segments = append(segments, url.PathEscape(segment))
+		}
+	}
+	u.RawPath = strings.Join(segments, "/")
+	u.Path, _ = url.PathUnescape(u.RawPath)
+	u.RawQuery = query.Encode()
+	return &u
+}
+
+func (s *s3Store) do(ctx context.Context, method string, u *url.URL, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	sum := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(sum[:])
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	creds, err := s.credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve credentials: %w", err)
+	}
+	err = s.signer.SignHTTP(ctx, creds, req, payloadHash, "s3", s.region, time.Now(), func(o *v4.SignerOptions) {
+		// S3 expects the path to be escaped exactly once
+		o.DisableURIPathEscaping = true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign request: %w", err)
+	}
+	return s.client.Do(req)
+}
+
+// unexpectedStatus returns an error describing a failed request, including the beginning of the error response
+func unexpectedStatus(method, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	return fmt.Errorf("%s %q: unexpected status %s: %s", method, key, resp.Status, strings.TrimSpace(string(body)))
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, s.objectURL(s.objectKey(key), nil), data)
+	if err != nil {
+		return fmt.Errorf("failed to put object %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return unexpectedStatus(http.MethodPut, key, resp)
+	}
+	return nil
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, s.objectURL(s.objectKey(key), nil), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, unexpectedStatus(http.MethodGet, key, resp)
+	}
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	fullPrefix := s.objectKey(prefix)
+	var keys []string
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {fullPrefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.do(ctx, http.MethodGet, s.objectURL("", query), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects with prefix %q: %w", prefix, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			err = unexpectedStatus(http.MethodGet, prefix, resp)
+			resp.Body.Close()
+			return nil, err
+		}
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode object list: %w", err)
+		}
+		for _, object := range result.Contents {
+			key := object.Key
+			if s.prefix != "" {
+				key = strings.TrimPrefix(key, s.prefix+"/")
+			}
+			keys = append(keys, key)
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return keys, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.objectURL(s.objectKey(key), nil), nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete object %q: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return unexpectedStatus(http.MethodDelete, key, resp)
+	}
+	return nil
+}
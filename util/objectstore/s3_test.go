@@ -0,0 +1,109 @@
+package objectstore
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeS3Server serves a minimal S3 API for the bucket "snapshots", with two keys per list page
+func newFakeS3Server(t *testing.T) *httptest.Server {
+	t.Helper()
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if bucket != "snapshots" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch {
+		case r.Method == http.MethodPut:
+			data, _ := io.ReadAll(r.Body)
+			objects[key] = data
+		case r.Method == http.MethodGet && key == "":
+			var keys []string
+			for k := range objects {
+				if strings.HasPrefix(k, r.URL.Query().Get("prefix")) && k > r.URL.Query().Get("continuation-token") {
+					keys = append(keys, k)
+				}
+			}
+			slices.Sort(keys)
+			result := listBucketResult{}
+			if len(keys) > 2 {
+				keys = keys[:2]
+				result.IsTruncated = true
+				result.NextContinuationToken = keys[1]
+			}
+			for _, k := range keys {
+				result.Contents = append(result.Contents, struct {
+					Key string `xml:"Key"`
+				}{Key: k})
+			}
+			_ = xml.NewEncoder(w).Encode(result)
+		case r.Method == http.MethodGet:
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case r.Method == http.MethodDelete:
+			delete(objects, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestS3Store(t *testing.T) {
+	server := newFakeS3Server(t)
+	store, err := NewS3Store(t.Context(), S3Config{Bucket: "snapshots", Prefix: "/argocd/", Endpoint: server.URL, AccessKeyID: "access", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+
+	for _, key := range []string{"apps/a/1.json", "apps/a/2.json", "apps/a/3.json", "apps/b/1.json"} {
+		require.NoError(t, store.Put(t.Context(), key, []byte(key)))
+	}
+	data, err := store.Get(t.Context(), "apps/a/2.json")
+	require.NoError(t, err)
+	assert.Equal(t, "apps/a/2.json", string(data))
+
+	_, err = store.Get(t.Context(), "apps/a/4.json")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	keys, err := store.List(t.Context(), "apps/a/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/a/1.json", "apps/a/2.json", "apps/a/3.json"}, keys)
+
+	require.NoError(t, store.Delete(t.Context(), "apps/a/1.json"))
+	require.NoError(t, store.Delete(t.Context(), "apps/a/1.json"))
+	keys, err = store.List(t.Context(), "apps/")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apps/a/2.json", "apps/a/3.json", "apps/b/1.json"}, keys)
+
+	store, err = NewS3Store(t.Context(), S3Config{Bucket: "other", Endpoint: server.URL, AccessKeyID: "access", SecretAccessKey: "secret"})
+	require.NoError(t, err)
+	require.ErrorContains(t, store.Put(t.Context(), "key", nil), "unexpected status 404")
+}
+
+func TestNewS3Store(t *testing.T) {
+	_, err := NewS3Store(t.Context(), S3Config{})
+	require.ErrorContains(t, err, "bucket is required")
+	_, err = NewS3Store(t.Context(), S3Config{Bucket: "snapshots", Endpoint: "ftp://example.com", AccessKeyID: "access"})
+	require.ErrorContains(t, err, "the scheme must be http or https")
+}
@@ -0,0 +1,23 @@
+// Package objectstore provides access to the object stores used to keep data outside of the cluster, such as
+// application snapshots.
+package objectstore
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotFound is returned when an object does not exist in the store.
+var ErrNotFound = errors.New("object not found")
+
+// Store is a flat key-value object store.
+type Store interface {
+	// Put creates or replaces the object with the given key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns the content of the object with the given key, or ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// List returns the keys of the objects whose key starts with the given prefix, in lexical order.
+	List(ctx context.Context, prefix string) ([]string, error)
+	// Delete deletes the object with the given key. Deleting an object which does not exist is not an error.
+	Delete(ctx context.Context, key string) error
+}
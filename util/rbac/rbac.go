@@ -40,6 +40,10 @@ const (
 	GlobMatchMode             = "glob"
 	RegexMatchMode            = "regex"
 
+	// ConfigMapAnonymousProjectsKey is the comma-separated list of the projects whose applications anonymous users may
+	// read. When set, anonymous users are only granted this access rather than the default role.
+	ConfigMapAnonymousProjectsKey = "policy.anonymous.projects"
+
 	defaultRBACSyncPeriod = 10 * time.Minute
 )
 
@@ -69,6 +73,7 @@ const (
 	ResourceLogs              = "logs"
 	ResourceExec              = "exec"
 	ResourceExtensions        = "extensions"
+	ResourceLogLevels         = "loglevels"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -79,6 +84,9 @@ const (
 	ActionOverride = "override"
 	ActionAction   = "action"
 	ActionInvoke   = "invoke"
+	ActionUnlock   = "unlock"
+	ActionReplay   = "replay"
+	ActionReport   = "report"
 )
 
 var (
@@ -96,6 +104,7 @@ var (
 		ResourceLogs,
 		ResourceExec,
 		ResourceExtensions,
+		ResourceLogLevels,
 	}
 	Actions = []string{
 		ActionGet,
@@ -106,6 +115,9 @@ var (
 		ActionOverride,
 		ActionAction,
 		ActionInvoke,
+		ActionUnlock,
+		ActionReplay,
+		ActionReport,
 	}
 )
 
@@ -137,6 +149,7 @@ type Enforcer struct {
 	model              model.Model
 	defaultRole        string
 	matchMode          string
+	anonymousProjects  []string
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
@@ -319,6 +332,14 @@ func (e *Enforcer) SetDefaultRole(roleName string) {
 	e.defaultRole = roleName
 }
 
+// SetAnonymousProjects restricts the access of anonymous users to reading the given projects and their applications.
+// Anonymous users fall back to the default role when no project is given.
+func (e *Enforcer) SetAnonymousProjects(projects []string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.anonymousProjects = projects
+}
+
 // SetClaimsEnforcerFunc sets a claims enforce function during enforcement. The claims enforce function
 // can extract claims from JWT token and do the proper enforcement based on user, group or any information
 // available in the input parameter list
@@ -328,23 +349,55 @@ func (e *Enforcer) SetClaimsEnforcerFunc(claimsEnforcer ClaimsEnforcerFunc) {
 	e.claimsEnforcerFunc = claimsEnforcer
 }
 
-// snapshotEnforceState returns the defaultRole and claimsEnforcerFunc fields under the
-// Enforcer's lock so enforcement is not racy with concurrent updates — defaultRole from
-// the informer's syncUpdate path, and claimsEnforcerFunc from SetClaimsEnforcerFunc.
-func (e *Enforcer) snapshotEnforceState() (string, ClaimsEnforcerFunc) {
+// snapshotEnforceState returns the defaultRole, claimsEnforcerFunc and anonymousProjects fields
+// under the Enforcer's lock so enforcement is not racy with concurrent updates — defaultRole and
+// anonymousProjects from the informer's syncUpdate path, and claimsEnforcerFunc from SetClaimsEnforcerFunc.
+func (e *Enforcer) snapshotEnforceState() (string, ClaimsEnforcerFunc, []string) {
 	e.lock.Lock()
 	defer e.lock.Unlock()
-	return e.defaultRole, e.claimsEnforcerFunc
+	return e.defaultRole, e.claimsEnforcerFunc, e.anonymousProjects
 }
 
 // Enforce is a wrapper around casbin.Enforce to additionally enforce a default role and a custom
-// claims function
+// claims function. The empty string subject identifies anonymous users, which are restricted to
+// the anonymous projects when any is set.
 func (e *Enforcer) Enforce(rvals ...any) bool {
 	enf := e.getCasbinEnforcer("", "")
-	defaultRole, claimsEnforcerFunc := e.snapshotEnforceState()
+	defaultRole, claimsEnforcerFunc, anonymousProjects := e.snapshotEnforceState()
+	if sub, ok := firstString(rvals); ok && sub == "" && len(anonymousProjects) > 0 {
+		return enforceAnonymous(anonymousProjects, rvals[1:]...)
+	}
 	return enforce(enf, defaultRole, claimsEnforcerFunc, rvals...)
 }
 
+func firstString(rvals []any) (string, bool) {
+	if len(rvals) == 0 {
+		return "", false
+	}
+	s, ok := rvals[0].(string)
+	return s, ok
+}
+
+// enforceAnonymous allows anonymous users to get the given projects and their applications
+func enforceAnonymous(projects []string, rvals ...any) bool {
+	if len(rvals) != 3 || rvals[1] != ActionGet {
+		return false
+	}
+	obj, ok := rvals[2].(string)
+	if !ok {
+		return false
+	}
+	switch rvals[0] {
+	case ResourceProjects:
+		return glob.MatchStringInList(projects, obj, glob.GLOB)
+	case ResourceApplications:
+		// application objects are <project>/<name> or <project>/<namespace>/<name>
+		project, _, ok := strings.Cut(obj, "/")
+		return ok && glob.MatchStringInList(projects, project, glob.GLOB)
+	}
+	return false
+}
+
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
 func (e *Enforcer) EnforceErr(rvals ...any) error {
 	if !e.Enforce(rvals...) {
@@ -392,7 +445,7 @@ func (e *Enforcer) CreateEnforcerWithRuntimePolicy(project string, policy string
 
 // EnforceWithCustomEnforcer wraps enforce with an custom enforcer
 func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...any) bool {
-	defaultRole, claimsEnforcerFunc := e.snapshotEnforceState()
+	defaultRole, claimsEnforcerFunc, _ := e.snapshotEnforceState()
 	return enforce(enf, defaultRole, claimsEnforcerFunc, rvals...)
 }
 
@@ -551,6 +604,7 @@ func PolicyCSV(data map[string]string) string {
 func (e *Enforcer) syncUpdate(cm *corev1.ConfigMap, onUpdated func(cm *corev1.ConfigMap) error) error {
 	e.SetDefaultRole(cm.Data[ConfigMapPolicyDefaultKey])
 	e.SetMatchMode(cm.Data[ConfigMapMatchModeKey])
+	e.SetAnonymousProjects(parseAnonymousProjects(cm.Data[ConfigMapAnonymousProjectsKey]))
 	policyCSV := PolicyCSV(cm.Data)
 	if err := onUpdated(cm); err != nil {
 		return fmt.Errorf("error running policy update callback: %w", err)
@@ -558,6 +612,16 @@ func (e *Enforcer) syncUpdate(cm *corev1.ConfigMap, onUpdated func(cm *corev1.Co
 	return e.SetUserPolicy(policyCSV)
 }
 
+func parseAnonymousProjects(value string) []string {
+	var projects []string
+	for project := range strings.SplitSeq(value, ",") {
+		if project = strings.TrimSpace(project); project != "" {
+			projects = append(projects, project)
+		}
+	}
+	return projects
+}
+
 // ValidatePolicy verifies a policy string is acceptable to casbin
 func ValidatePolicy(policy string) error {
 	casbinEnforcer, err := newEnforcerSafe(globMatchFunc, newBuiltInModel(), newAdapter("", "", policy))
@@ -191,6 +191,34 @@ func TestDefaultRole(t *testing.T) {
 	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
 }
 
+// TestAnonymousProjects tests the restriction of anonymous users to reading the anonymous projects
+func TestAnonymousProjects(t *testing.T) {
+	kubeclientset := fake.NewClientset()
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyDefaultKey] = "role:readonly"
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	require.NoError(t, enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+
+	// anonymous users get the default role when no anonymous project is set
+	assert.True(t, enf.Enforce("", "applications", "get", "foo/bar"))
+	assert.True(t, enf.Enforce("", "clusters", "get", "https://kubernetes.default.svc"))
+
+	cm.Data[ConfigMapAnonymousProjectsKey] = "status-page, public-*"
+	require.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+
+	assert.True(t, enf.Enforce("", "applications", "get", "status-page/bar"))
+	assert.True(t, enf.Enforce("", "applications", "get", "public-docs/argocd/bar"))
+	assert.True(t, enf.Enforce("", "projects", "get", "status-page"))
+	assert.False(t, enf.Enforce("", "applications", "sync", "status-page/bar"))
+	assert.False(t, enf.Enforce("", "applications", "get", "foo/bar"))
+	assert.False(t, enf.Enforce("", "projects", "get", "foo"))
+	assert.False(t, enf.Enforce("", "logs", "get", "status-page/bar"))
+	assert.False(t, enf.Enforce("", "clusters", "get", "https://kubernetes.default.svc"))
+	// authenticated users keep the default role
+	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+}
+
 // TestConcurrentEnforceAndSyncUpdate exercises the same access pattern that produced
 // a -race failure on the 3.2 branch: gRPC handler goroutines calling Enforce while the
 // RBAC configmap informer goroutine drives SetDefaultRole via syncUpdate, alongside
@@ -28,6 +28,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/rbacpolicy"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/dex"
 	"github.com/argoproj/argo-cd/v3/util/env"
@@ -66,7 +67,9 @@ type MetricsRegistry interface {
 const (
 	// SessionManagerClaimsIssuer fills the "iss" field of the token.
 	SessionManagerClaimsIssuer = "argocd"
-	AuthErrorCtxKey            = "auth-error"
+	// KubernetesClaimsIssuer fills the "iss" field of the claims of the Kubernetes user of the CLI in core mode.
+	KubernetesClaimsIssuer = "kubernetes"
+	AuthErrorCtxKey        = "auth-error"
 
 	// invalidLoginError, for security purposes, doesn't say whether the username or password was invalid.  This does not mitigate the potential for timing attacks to determine which is which.
 	invalidLoginError           = "Invalid username or password"
@@ -173,6 +176,12 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateWithAudience(subject, secondsBeforeExpiry, id, nil)
+}
+
+// CreateWithAudience creates a new token like Create, which is only accepted by the Argo CD instances whose URL is in the
+// given audience. The token is accepted by every instance if the audience is empty.
+func (mgr *SessionManager) CreateWithAudience(subject string, secondsBeforeExpiry int64, id string, audience []string) (string, error) {
 	now := time.Now().UTC()
 	claims := jwt.RegisteredClaims{
 		IssuedAt:  jwt.NewNumericDate(now),
@@ -180,6 +189,7 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 		NotBefore: jwt.NewNumericDate(now),
 		Subject:   subject,
 		ID:        id,
+		Audience:  audience,
 	}
 	if secondsBeforeExpiry > 0 {
 		expires := now.Add(time.Duration(secondsBeforeExpiry) * time.Second)
@@ -228,8 +238,59 @@ func GetSubjectAccountAndCapability(subject string) (string, settings.AccountCap
 	return subject, capability
 }
 
+type clientAddressKey struct{}
+
+// ContextWithClientAddress returns a copy of ctx that carries the network address of the client that sent the request.
+func ContextWithClientAddress(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, clientAddressKey{}, addr)
+}
+
+// ClientAddressFromContext returns the client address stored in ctx by ContextWithClientAddress, or an empty string.
+func ClientAddressFromContext(ctx context.Context) string {
+	addr, _ := ctx.Value(clientAddressKey{}).(string)
+	return addr
+}
+
+// ClientAddress returns the address of the client that sent a request received from remoteAddr with the given
+// X-Forwarded-For header values. Starting with remoteAddr, the entries are walked from right to left for as long as the
+// address is that of one of the trusted proxies, so that the entries which the client may have forged are ignored.
+func ClientAddress(remoteAddr string, forwardedFor []string, trustedProxies []*net.IPNet) string {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	var entries []string
+	for _, value := range forwardedFor {
+		for _, entry := range strings.Split(value, ",") {
+			entries = append(entries, strings.TrimSpace(entry))
+		}
+	}
+	for len(entries) > 0 && isTrustedProxy(host, trustedProxies) {
+		host, entries = entries[len(entries)-1], entries[:len(entries)-1]
+	}
+	return host
+}
+
+// isTrustedProxy returns whether the address belongs to one of the trusted proxy networks.
+func isTrustedProxy(addr string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // Parse tries to parse the provided string and returns the token claims for local login.
 func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error) {
+	return mgr.parse(context.Background(), tokenString)
+}
+
+func (mgr *SessionManager) parse(ctx context.Context, tokenString string) (jwt.Claims, string, error) {
 	// Parse takes the token string and a function for looking up the key. The latter is especially
 	// useful if you use multiple keys for your application.  The standard is to use 'kid' in the
 	// head of the token to identify which key to use, but the parsed token (head and claims) is provided
@@ -267,6 +328,9 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 		if err != nil {
 			return nil, "", err
 		}
+		if err = mgr.verifyProjectRoleAddress(ctx, proj, role); err != nil {
+			return nil, "", err
+		}
 
 		return token.Claims, "", nil
 	}
@@ -292,8 +356,20 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	}
 	if mgr.storage.IsTokenRevoked(id) {
 		return nil, "", errors.New("token is revoked, please re-login")
-	} else if capability == settings.AccountCapabilityApiKey && account.TokenIndex(id) == -1 {
-		return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
+	} else if capability == settings.AccountCapabilityApiKey {
+		index := account.TokenIndex(id)
+		if index == -1 {
+			return nil, "", fmt.Errorf("account %s does not have token with id %s", subject, id)
+		}
+		if account.Tokens[index].RevokedAt > 0 {
+			return nil, "", fmt.Errorf("token with id %s of account %s is revoked", id, subject)
+		}
+	}
+
+	if audience, err := claims.GetAudience(); err != nil {
+		return nil, "", fmt.Errorf("invalid audience claim: %w", err)
+	} else if len(audience) > 0 && !argoCDSettings.AcceptsAudience(audience) {
+		return nil, "", fmt.Errorf("token audience %v does not include the URL of this Argo CD instance", []string(audience))
 	}
 
 	if account.PasswordMtime != nil && issuedAt.Before(*account.PasswordMtime) {
@@ -318,6 +394,67 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	return token.Claims, newToken, nil
 }
 
+// verifyProjectRoleAddress enforces the client address allowlist configured on a project role for a token issued to
+// that role.
+func (mgr *SessionManager) verifyProjectRoleAddress(ctx context.Context, proj *appv1.AppProject, roleName string) error {
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return err
+	}
+	if len(role.AllowedCIDRs) > 0 {
+		addr := ClientAddressFromContext(ctx)
+		allowed, err := role.IsAddressAllowed(addr)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return fmt.Errorf("token for role '%s' of project '%s' is not allowed from address '%s'", role.Name, proj.Name, addr)
+		}
+	}
+	return nil
+}
+
+// CountTokenUse counts a use of the token with the given verified claims, and enforces the usage limit configured on the
+// project role the token was issued to, if any. It must be called once per authenticated request, unlike VerifyToken
+// which may verify the token of a request several times.
+func (mgr *SessionManager) CountTokenUse(ctx context.Context, claims jwt.Claims) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	projName, roleName, ok := rbacpolicy.GetProjectRoleFromSubject(jwtutil.GetUserIdentifier(mapClaims))
+	if !ok {
+		return nil
+	}
+	proj, err := mgr.projectsLister.Get(projName)
+	if err != nil {
+		return err
+	}
+	role, _, err := proj.GetRoleByName(roleName)
+	if err != nil {
+		return err
+	}
+	if role.MaxTokenUses <= 0 {
+		return nil
+	}
+	id := jwtutil.StringField(mapClaims, "jti")
+	if id == "" {
+		return fmt.Errorf("token for role '%s' of project '%s' does not have a unique identifier (jti claim) and its uses cannot be counted", role.Name, proj.Name)
+	}
+	var expiringAt time.Duration
+	if exp, err := jwtutil.ExpirationTime(mapClaims); err == nil {
+		expiringAt = time.Until(exp)
+	}
+	uses, err := mgr.storage.IncrementTokenUses(ctx, id, expiringAt)
+	if err != nil {
+		return fmt.Errorf("failed to count uses of token: %w", err)
+	}
+	if uses > role.MaxTokenUses {
+		return fmt.Errorf("token for role '%s' of project '%s' exceeded its maximum of %d uses", role.Name, proj.Name, role.MaxTokenUses)
+	}
+	return nil
+}
+
 // GetLoginFailures retrieves the login failure information from the cache. Any modifications to the LoginAttemps map must be done in a thread-safe manner.
 func (mgr *SessionManager) GetLoginFailures() map[string]LoginAttempts {
 	// Get failures from the cache
@@ -497,9 +634,9 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 
 // AuthMiddlewareFunc returns a function that can be used as an
 // authentication middleware for HTTP requests.
-func (mgr *SessionManager) AuthMiddlewareFunc(disabled bool, isSSOConfigured bool, ssoClientApp *oidcutil.ClientApp) func(http.Handler) http.Handler {
+func (mgr *SessionManager) AuthMiddlewareFunc(disabled bool, isSSOConfigured bool, ssoClientApp *oidcutil.ClientApp, trustedProxies []*net.IPNet) func(http.Handler) http.Handler {
 	return func(h http.Handler) http.Handler {
-		return WithAuthMiddleware(disabled, isSSOConfigured, ssoClientApp, mgr, h)
+		return WithAuthMiddleware(disabled, isSSOConfigured, ssoClientApp, mgr, trustedProxies, h)
 	}
 }
 
@@ -509,10 +646,18 @@ type TokenVerifier interface {
 	VerifyToken(ctx context.Context, token string) (jwt.Claims, string, error)
 }
 
+// TokenUseCounter is implemented by the token verifiers which count the
+// uses of the tokens of the authenticated requests
+type TokenUseCounter interface {
+	CountTokenUse(ctx context.Context, claims jwt.Claims) error
+}
+
 // WithAuthMiddleware is an HTTP middleware used to ensure incoming
 // requests are authenticated before invoking the target handler. If
 // disabled is true, it will just invoke the next handler in the chain.
-func WithAuthMiddleware(disabled bool, isSSOConfigured bool, ssoClientApp *oidcutil.ClientApp, authn TokenVerifier, next http.Handler) http.Handler {
+// The X-Forwarded-For entries of the trustedProxies are used to determine
+// the address of the client.
+func WithAuthMiddleware(disabled bool, isSSOConfigured bool, ssoClientApp *oidcutil.ClientApp, authn TokenVerifier, trustedProxies []*net.IPNet, next http.Handler) http.Handler {
 	if disabled {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			next.ServeHTTP(w, r)
@@ -527,11 +672,18 @@ func WithAuthMiddleware(disabled bool, isSSOConfigured bool, ssoClientApp *oidcu
 			http.Error(w, "Auth cookie not found", http.StatusBadRequest)
 			return
 		}
+		ctx = ContextWithClientAddress(ctx, ClientAddress(r.RemoteAddr, r.Header.Values("X-Forwarded-For"), trustedProxies))
 		claims, _, err := authn.VerifyToken(ctx, tokenString)
 		if err != nil {
 			http.Error(w, "Invalid token", http.StatusUnauthorized)
 			return
 		}
+		if counter, ok := authn.(TokenUseCounter); ok {
+			if err := counter.CountTokenUse(ctx, claims); err != nil {
+				http.Error(w, "Invalid token", http.StatusUnauthorized)
+				return
+			}
+		}
 
 		finalClaims := claims
 		if isSSOConfigured {
@@ -567,7 +719,7 @@ func (mgr *SessionManager) VerifyToken(ctx context.Context, tokenString string)
 	switch issuer {
 	case SessionManagerClaimsIssuer:
 		// Argo CD signed token
-		return mgr.Parse(tokenString)
+		return mgr.parse(ctx, tokenString)
 	default:
 		// IDP signed token
 		prov, err := mgr.provider()
@@ -9,6 +9,7 @@ import (
 	"io"
 	"maps"
 	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
@@ -235,6 +236,41 @@ func TestSessionManager_AdminToken_LoginCapabilityDisabled(t *testing.T) {
 	assert.ErrorContains(t, err, "account admin does not have 'apiKey' capability")
 }
 
+func TestSessionManager_AccountToken_Revoked(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(map[string]string{
+		"accounts.ci": "apiKey",
+	}, map[string][]byte{
+		"accounts.ci.tokens": []byte(`[{"id":"abc","iat":1583789194,"revokedAt":1583789195}]`),
+	}), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	token, err := mgr.Create("ci:apiKey", 0, "abc")
+	require.NoError(t, err)
+
+	_, _, err = mgr.Parse(token)
+	assert.ErrorContains(t, err, "token with id abc of account ci is revoked")
+}
+
+func TestSessionManager_AccountToken_Audience(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClientWithConfig(map[string]string{
+		"url":         "https://argocd.example.com",
+		"accounts.ci": "apiKey",
+	}, map[string][]byte{
+		"accounts.ci.tokens": []byte(`[{"id":"abc","iat":1583789194}]`),
+	}), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	token, err := mgr.CreateWithAudience("ci:apiKey", 0, "abc", []string{"https://argocd.example.com/"})
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+
+	token, err = mgr.CreateWithAudience("ci:apiKey", 0, "abc", []string{"https://other.example.com"})
+	require.NoError(t, err)
+	_, _, err = mgr.Parse(token)
+	assert.ErrorContains(t, err, "does not include the URL of this Argo CD instance")
+}
+
 func TestSessionManager_ProjectToken(t *testing.T) {
 	settingsMgr := settings.NewSettingsManager(t.Context(), getKubeClient(t, "pass", true), "argocd")
 
@@ -282,6 +318,84 @@ func TestSessionManager_ProjectToken(t *testing.T) {
 		_, _, err = mgr.Parse(jwtToken)
 		assert.ErrorContains(t, err, "does not exist in project 'default'")
 	})
+
+	t.Run("Address Not Allowed", func(t *testing.T) {
+		proj := appv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "argocd",
+			},
+			Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "test", AllowedCIDRs: []string{"10.0.0.0/8"}}}},
+			Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+				"test": {
+					Items: []appv1.JWTToken{{ID: "abc", IssuedAt: time.Now().Unix(), ExpiresAt: 0}},
+				},
+			}},
+		}
+		mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(nil))
+
+		jwtToken, err := mgr.Create("proj:default:test", 100, "abc")
+		require.NoError(t, err)
+
+		_, _, err = mgr.VerifyToken(ContextWithClientAddress(t.Context(), "10.1.2.3"), jwtToken)
+		require.NoError(t, err)
+
+		_, _, err = mgr.VerifyToken(ContextWithClientAddress(t.Context(), "203.0.113.7"), jwtToken)
+		require.ErrorContains(t, err, "is not allowed from address '203.0.113.7'")
+
+		_, _, err = mgr.Parse(jwtToken)
+		assert.ErrorContains(t, err, "is not allowed from address ''")
+	})
+
+	t.Run("Max Token Uses Exceeded", func(t *testing.T) {
+		redisClient, closer := test.NewInMemoryRedis()
+		defer closer()
+
+		proj := appv1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "default",
+				Namespace: "argocd",
+			},
+			Spec: appv1.AppProjectSpec{Roles: []appv1.ProjectRole{{Name: "test", MaxTokenUses: 2}}},
+			Status: appv1.AppProjectStatus{JWTTokensByRole: map[string]appv1.JWTTokens{
+				"test": {
+					Items: []appv1.JWTToken{{ID: "abc", IssuedAt: time.Now().Unix(), ExpiresAt: 0}},
+				},
+			}},
+		}
+		mgr := newSessionManager(settingsMgr, getProjLister(&proj), NewUserStateStorage(redisClient))
+
+		jwtToken, err := mgr.Create("proj:default:test", 100, "abc")
+		require.NoError(t, err)
+
+		claims, _, err := mgr.Parse(jwtToken)
+		require.NoError(t, err)
+		// verifying the token does not count as a use
+		_, _, err = mgr.Parse(jwtToken)
+		require.NoError(t, err)
+
+		for range 2 {
+			require.NoError(t, mgr.CountTokenUse(t.Context(), claims))
+		}
+		require.ErrorContains(t, mgr.CountTokenUse(t.Context(), claims), "exceeded its maximum of 2 uses")
+
+		ttl, err := redisClient.TTL(t.Context(), tokenUsesPrefix+"abc").Result()
+		require.NoError(t, err)
+		assert.Positive(t, ttl)
+	})
+}
+
+func TestClientAddress(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	trustedProxies := []*net.IPNet{proxies}
+
+	assert.Equal(t, "203.0.113.7", ClientAddress("203.0.113.7:40000", []string{"10.0.0.2"}, trustedProxies), "entries of untrusted peers are ignored")
+	assert.Equal(t, "10.0.0.1", ClientAddress("10.0.0.1:40000", nil, trustedProxies))
+	assert.Equal(t, "203.0.113.7", ClientAddress("10.0.0.1:40000", []string{"198.51.100.1, 203.0.113.7", "10.0.0.2"}, trustedProxies), "forged entries are ignored")
+	assert.Equal(t, "10.0.0.3", ClientAddress("10.0.0.1:40000", []string{"10.0.0.3"}, trustedProxies))
+	assert.Equal(t, "10.0.0.1", ClientAddress("10.0.0.1:40000", []string{"203.0.113.7"}, nil))
+	assert.Equal(t, "::1", ClientAddress("::1", nil, trustedProxies))
 }
 
 type tokenVerifierMock struct {
@@ -296,6 +410,45 @@ func (tm *tokenVerifierMock) VerifyToken(_ context.Context, _ string) (jwt.Claim
 	return tm.claims, "", tm.err
 }
 
+type clientAddressVerifier struct {
+	addr string
+}
+
+func (v *clientAddressVerifier) VerifyToken(ctx context.Context, _ string) (jwt.Claims, string, error) {
+	v.addr = ClientAddressFromContext(ctx)
+	return jwt.MapClaims{}, "", nil
+}
+
+func TestSessionManager_WithAuthMiddlewareClientAddress(t *testing.T) {
+	_, loopback, err := net.ParseCIDR("127.0.0.0/8")
+	require.NoError(t, err)
+	verifier := &clientAddressVerifier{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+
+	for _, tc := range []struct {
+		name           string
+		trustedProxies []*net.IPNet
+		expected       string
+	}{
+		{name: "UntrustedPeer", expected: "127.0.0.1"},
+		{name: "TrustedProxy", trustedProxies: []*net.IPNet{loopback}, expected: "203.0.113.7"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(WithAuthMiddleware(false, false, nil, verifier, tc.trustedProxies, handler))
+			defer ts.Close()
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL, http.NoBody)
+			require.NoError(t, err)
+			req.Header.Add("Cookie", "argocd.token=123456")
+			req.Header.Add("X-Forwarded-For", "203.0.113.7")
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tc.expected, verifier.addr)
+		})
+	}
+}
+
 func TestSessionManager_WithAuthMiddleware(t *testing.T) {
 	handlerFunc := func() func(http.ResponseWriter, *http.Request) {
 		return func(w http.ResponseWriter, r *http.Request) {
@@ -463,7 +616,7 @@ userInfoPath: /`,
 					require.NoError(t, err, "failed setting item to in-memory cache")
 				}
 			}
-			ts := httptest.NewServer(WithAuthMiddleware(tc.authDisabled, tc.ssoEnabled, clientApp, tm, mux))
+			ts := httptest.NewServer(WithAuthMiddleware(tc.authDisabled, tc.ssoEnabled, clientApp, tm, nil, mux))
 			defer ts.Close()
 			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, ts.URL, http.NoBody)
 			require.NoErrorf(t, err, "error creating request: %s", err)
@@ -2,24 +2,42 @@ package session
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
 const (
 	revokedTokenPrefix = "revoked-token|"
+	tokenUsesPrefix    = "token-uses|"
 	newRevokedTokenKey = "new-revoked-token"
 )
 
+// errTokenUsesWithoutRedis is returned when the uses of a token are counted without Redis, since the uses could not be
+// counted across the replicas of the API server and would be reset by a restart
+var errTokenUsesWithoutRedis = errors.New("the uses of tokens can only be limited when Redis is configured")
+
 type userStateStorage struct {
-	attempts            map[string]LoginAttempts
-	redis               *redis.Client
+	attempts map[string]LoginAttempts
+	// redis is nil if the embedded cache backend is used, in which case the revoked tokens are persisted in the
+	// argocd-revoked-tokens ConfigMap
+	redis *redis.Client
+	// kubeClient and namespace locate the ConfigMap of the revoked tokens when no Redis is configured
+	kubeClient          kubernetes.Interface
+	namespace           string
 	revokedTokens       map[string]bool
 	recentRevokedTokens map[string]bool
 	lock                sync.RWMutex
@@ -38,10 +56,26 @@ func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 	}
 }
 
-// Init sets up watches on the revoked tokens and starts a ticker to periodically resync the revoked tokens from Redis.
+// NewKubeUserStateStorage returns the storage of the user state of installations without Redis. The revoked tokens
+// are persisted in the argocd-revoked-tokens ConfigMap of the given namespace, so that they are shared by the replicas
+// of the API server and survive restarts. Limiting the uses of tokens is not supported.
+func NewKubeUserStateStorage(kubeClient kubernetes.Interface, namespace string) *userStateStorage {
+	storage := NewUserStateStorage(nil)
+	storage.kubeClient = kubeClient
+	storage.namespace = namespace
+	return storage
+}
+
+// Init sets up watches on the revoked tokens and starts a ticker to periodically resync the revoked tokens from Redis,
+// or from the ConfigMap of the revoked tokens if no Redis is configured.
 // Don't call this until after setting up all hooks on the Redis client, or you might encounter race conditions.
 func (storage *userStateStorage) Init(ctx context.Context) {
-	go storage.watchRevokedTokens(ctx)
+	if storage.redis == nil && storage.kubeClient == nil {
+		return
+	}
+	if storage.redis != nil {
+		go storage.watchRevokedTokens(ctx)
+	}
 	ticker := time.NewTicker(storage.resyncDuration)
 	go func() {
 		storage.loadRevokedTokensSafe()
@@ -83,6 +117,29 @@ func (storage *userStateStorage) loadRevokedTokensSafe() {
 }
 
 func (storage *userStateStorage) loadRevokedTokens() error {
+	var revokedTokens map[string]bool
+	var err error
+	if storage.redis != nil {
+		revokedTokens, err = storage.getRedisRevokedTokens()
+	} else {
+		revokedTokens, err = storage.getConfigMapRevokedTokens(context.Background())
+	}
+	if err != nil {
+		return err
+	}
+
+	storage.lock.Lock()
+	defer storage.lock.Unlock()
+	storage.revokedTokens = revokedTokens
+	for recentRevokedToken := range storage.recentRevokedTokens {
+		storage.revokedTokens[recentRevokedToken] = true
+	}
+	storage.recentRevokedTokens = map[string]bool{}
+
+	return nil
+}
+
+func (storage *userStateStorage) getRedisRevokedTokens() (map[string]bool, error) {
 	redisRevokedTokens := map[string]bool{}
 	iterator := storage.redis.Scan(context.Background(), 0, revokedTokenPrefix+"*", 10000).Iterator()
 	for iterator.Next(context.Background()) {
@@ -96,18 +153,80 @@ func (storage *userStateStorage) loadRevokedTokens() error {
 		redisRevokedTokens[parts[1]] = true
 	}
 	if iterator.Err() != nil {
-		return iterator.Err()
+		return nil, iterator.Err()
 	}
+	return redisRevokedTokens, nil
+}
 
-	storage.lock.Lock()
-	defer storage.lock.Unlock()
-	storage.revokedTokens = redisRevokedTokens
-	for recentRevokedToken := range storage.recentRevokedTokens {
-		storage.revokedTokens[recentRevokedToken] = true
+// getConfigMapRevokedTokens returns the revoked tokens of the ConfigMap which have not expired yet
+func (storage *userStateStorage) getConfigMapRevokedTokens(ctx context.Context) (map[string]bool, error) {
+	revokedTokens := map[string]bool{}
+	cm, err := storage.kubeClient.CoreV1().ConfigMaps(storage.namespace).Get(ctx, common.ArgoCDRevokedTokensConfigMapName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return revokedTokens, nil
 	}
-	storage.recentRevokedTokens = map[string]bool{}
+	if err != nil {
+		return nil, fmt.Errorf("error getting configmap %s: %w", common.ArgoCDRevokedTokensConfigMapName, err)
+	}
+	now := time.Now()
+	for id, expiresAt := range cm.Data {
+		if !isRevocationExpired(expiresAt, now) {
+			revokedTokens[id] = true
+		}
+	}
+	return revokedTokens, nil
+}
 
-	return nil
+// isRevocationExpired returns whether the revocation of a token persisted in the ConfigMap expired. Revocations
+// without expiry time never expire.
+func isRevocationExpired(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	t, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && now.After(t)
+}
+
+// persistRevokedToken adds the token to the ConfigMap of the revoked tokens and removes the expired revocations
+func (storage *userStateStorage) persistRevokedToken(ctx context.Context, id string, expiringAt time.Duration) error {
+	expiresAt := ""
+	if expiringAt > 0 {
+		expiresAt = time.Now().Add(expiringAt).UTC().Format(time.RFC3339)
+	}
+	configMaps := storage.kubeClient.CoreV1().ConfigMaps(storage.namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, common.ArgoCDRevokedTokensConfigMapName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      common.ArgoCDRevokedTokensConfigMapName,
+					Namespace: storage.namespace,
+					Labels:    map[string]string{"app.kubernetes.io/part-of": "argocd"},
+				},
+				Data: map[string]string{id: expiresAt},
+			}
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			if apierrors.IsAlreadyExists(err) {
+				return apierrors.NewConflict(corev1.Resource("configmaps"), cm.Name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		now := time.Now()
+		for revokedID, revokedUntil := range cm.Data {
+			if isRevocationExpired(revokedUntil, now) {
+				delete(cm.Data, revokedID)
+			}
+		}
+		cm.Data[id] = expiresAt
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
 }
 
 func (storage *userStateStorage) GetLoginAttempts() map[string]LoginAttempts {
@@ -124,6 +243,12 @@ func (storage *userStateStorage) RevokeToken(ctx context.Context, id string, exp
 	storage.revokedTokens[id] = true
 	storage.recentRevokedTokens[id] = true
 	storage.lock.Unlock()
+	if storage.redis == nil {
+		if storage.kubeClient == nil {
+			return errors.New("revoked tokens can only be persisted when Redis or the Kubernetes client is configured")
+		}
+		return storage.persistRevokedToken(ctx, id, expiringAt)
+	}
 	if err := storage.redis.Set(ctx, revokedTokenPrefix+id, "", expiringAt).Err(); err != nil {
 		return err
 	}
@@ -136,6 +261,23 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) IncrementTokenUses(ctx context.Context, id string, expiringAt time.Duration) (int64, error) {
+	key := tokenUsesPrefix + id
+	if storage.redis == nil {
+		return 0, errTokenUsesWithoutRedis
+	}
+	uses, err := storage.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	if uses == 1 && expiringAt > 0 {
+		if err := storage.redis.Expire(ctx, key, expiringAt).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return uses, nil
+}
+
 func (storage *userStateStorage) GetLockObject() *sync.RWMutex {
 	return &storage.lock
 }
@@ -150,6 +292,8 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// IncrementTokenUses increments and returns the number of times given token was used (the counter expires after specified timeout)
+	IncrementTokenUses(ctx context.Context, id string, expiringAt time.Duration) (int64, error)
 	// GetLockObject returns a lock used by the storage
 	GetLockObject() *sync.RWMutex
 }
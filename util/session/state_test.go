@@ -5,10 +5,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/test"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
@@ -28,3 +32,46 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_WithoutRedis(t *testing.T) {
+	t.Parallel()
+	kubeClient := fake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDRevokedTokensConfigMapName, Namespace: "argocd"},
+		Data: map[string]string{
+			"expired":   time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			"persisted": time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		},
+	})
+	storage := NewKubeUserStateStorage(kubeClient, "argocd")
+	require.NoError(t, storage.loadRevokedTokens())
+	assert.True(t, storage.IsTokenRevoked("persisted"))
+	assert.False(t, storage.IsTokenRevoked("expired"))
+
+	require.NoError(t, storage.RevokeToken(t.Context(), "abc", time.Hour))
+	assert.True(t, storage.IsTokenRevoked("abc"))
+	assert.False(t, storage.IsTokenRevoked("def"))
+
+	// another replica, or the same one after a restart, sees the revocation
+	replica := NewKubeUserStateStorage(kubeClient, "argocd")
+	require.NoError(t, replica.loadRevokedTokens())
+	assert.True(t, replica.IsTokenRevoked("abc"))
+	assert.True(t, replica.IsTokenRevoked("persisted"))
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDRevokedTokensConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, cm.Data, "expired")
+
+	_, err = storage.IncrementTokenUses(t.Context(), "abc", time.Hour)
+	require.ErrorIs(t, err, errTokenUsesWithoutRedis)
+}
+
+func TestUserStateStorage_WithoutRedis_CreatesConfigMap(t *testing.T) {
+	t.Parallel()
+	kubeClient := fake.NewClientset()
+	storage := NewKubeUserStateStorage(kubeClient, "argocd")
+	require.NoError(t, storage.RevokeToken(t.Context(), "abc", 0))
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDRevokedTokensConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"abc": ""}, cm.Data)
+}
@@ -47,6 +47,10 @@ type Token struct {
 	ID        string `json:"id"`
 	IssuedAt  int64  `json:"iat"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	// Audience lists the URLs of the Argo CD instances which accept the token
+	Audience []string `json:"aud,omitempty"`
+	// RevokedAt is the time the token was revoked. Revoked tokens are kept to be listed with the tokens of the account.
+	RevokedAt int64 `json:"revokedAt,omitempty"`
 }
 
 // Account holds local account information
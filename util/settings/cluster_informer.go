@@ -264,6 +264,8 @@ func secretToCluster(s *corev1.Secret) (*appv1.Cluster, error) {
 		Name:               string(s.Data["name"]),
 		Namespaces:         namespaces,
 		ClusterResources:   string(s.Data["clusterResources"]) == "true",
+		NamespacedCache:    string(s.Data["namespacedCache"]) == "true",
+		Cordoned:           string(s.Data["cordoned"]) == "true",
 		Config:             config,
 		RefreshRequestedAt: refreshRequestedAt,
 		Shard:              shard,
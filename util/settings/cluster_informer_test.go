@@ -811,6 +811,8 @@ func TestClusterInformer_ComplexConfig(t *testing.T) {
 			"name":             []byte("complex"),
 			"config":           configJSON,
 			"clusterResources": []byte("true"),
+			"namespacedCache":  []byte("true"),
+			"cordoned":         []byte("true"),
 			"project":          []byte("default"),
 		},
 	}
@@ -840,6 +842,8 @@ func TestClusterInformer_ComplexConfig(t *testing.T) {
 	assert.Equal(t, []string{"version"}, cluster.Config.ExecProviderConfig.Args)
 
 	assert.True(t, cluster.ClusterResources)
+	assert.True(t, cluster.NamespacedCache)
+	assert.True(t, cluster.Cordoned)
 	assert.Equal(t, "default", cluster.Project)
 }
 
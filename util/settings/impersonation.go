@@ -37,14 +37,47 @@ func DeriveServiceAccountToImpersonate(project *v1alpha1.AppProject, application
 		if dstServerMatched && dstNamespaceMatched {
 			if strings.Trim(item.DefaultServiceAccount, " ") == "" || strings.ContainsAny(item.DefaultServiceAccount, serviceAccountDisallowedCharSet) {
 				return "", fmt.Errorf("default service account contains invalid chars '%s'", item.DefaultServiceAccount)
-			} else if strings.Contains(item.DefaultServiceAccount, ":") {
-				// service account is specified along with its namespace.
-				return "system:serviceaccount:" + item.DefaultServiceAccount, nil
 			}
-			// service account needs to be prefixed with a namespace
-			return fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, item.DefaultServiceAccount), nil
+			if application.Spec.Destination.ServiceAccount != "" {
+				return deriveApplicationServiceAccount(item, application.Spec.Destination.ServiceAccount, serviceAccountNamespace)
+			}
+			return "system:serviceaccount:" + qualifyServiceAccount(item.DefaultServiceAccount, serviceAccountNamespace), nil
 		}
 	}
+	if application.Spec.Destination.ServiceAccount != "" {
+		return "", fmt.Errorf("service account '%s' is not permitted, no destination service account of project '%s' matches destination server %s and namespace %s", application.Spec.Destination.ServiceAccount, project.Name, destCluster.Server, application.Spec.Destination.Namespace)
+	}
 	// if there is no match found in the AppProject.Spec.DestinationServiceAccounts, return empty string
 	return "", nil
 }
+
+// deriveApplicationServiceAccount returns the service account selected by the application if it is the default service
+// account or one of the allowed service accounts of the matching destination service account
+func deriveApplicationServiceAccount(item v1alpha1.ApplicationDestinationServiceAccount, serviceAccount string, serviceAccountNamespace string) (string, error) {
+	if strings.TrimSpace(serviceAccount) == "" || strings.ContainsAny(serviceAccount, serviceAccountDisallowedCharSet) {
+		return "", fmt.Errorf("service account contains invalid chars '%s'", serviceAccount)
+	}
+	qualified := qualifyServiceAccount(serviceAccount, serviceAccountNamespace)
+	if qualified == qualifyServiceAccount(item.DefaultServiceAccount, serviceAccountNamespace) {
+		return "system:serviceaccount:" + qualified, nil
+	}
+	for _, pattern := range item.AllowedServiceAccounts {
+		matched, err := glob.MatchWithError(qualifyServiceAccount(pattern, serviceAccountNamespace), qualified)
+		if err != nil {
+			return "", fmt.Errorf("invalid glob pattern for allowed service account: %w", err)
+		}
+		if matched {
+			return "system:serviceaccount:" + qualified, nil
+		}
+	}
+	return "", fmt.Errorf("service account '%s' is not permitted by the destination service account of server %s and namespace %s", serviceAccount, item.Server, item.Namespace)
+}
+
+// qualifyServiceAccount prefixes the service account with the given namespace unless it is specified along with its
+// namespace
+func qualifyServiceAccount(serviceAccount string, namespace string) string {
+	if strings.Contains(serviceAccount, ":") {
+		return serviceAccount
+	}
+	return fmt.Sprintf("%s:%s", namespace, serviceAccount)
+}
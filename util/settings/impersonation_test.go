@@ -277,4 +277,74 @@ func TestDeriveServiceAccountToImpersonate(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, "system:serviceaccount:dest-ns:first-sa", user)
 	})
+
+	t.Run("ApplicationServiceAccountOverride", func(t *testing.T) {
+		t.Parallel()
+		project := &v1alpha1.AppProject{
+			Spec: v1alpha1.AppProjectSpec{
+				DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+					{Server: "*", Namespace: "dest-ns", DefaultServiceAccount: "default-sa", AllowedServiceAccounts: []string{"deployer-*", "shared:admin-sa"}},
+				},
+			},
+		}
+		cluster := &v1alpha1.Cluster{Server: "https://cluster-api.example.com"}
+		newApp := func(serviceAccount string) *v1alpha1.Application {
+			return &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{
+					Destination: v1alpha1.ApplicationDestination{
+						Server:         "https://cluster-api.example.com",
+						Namespace:      "dest-ns",
+						ServiceAccount: serviceAccount,
+					},
+				},
+			}
+		}
+
+		for serviceAccount, expected := range map[string]string{
+			"default-sa":         "system:serviceaccount:dest-ns:default-sa",
+			"dest-ns:default-sa": "system:serviceaccount:dest-ns:default-sa",
+			"deployer-web":       "system:serviceaccount:dest-ns:deployer-web",
+			"shared:admin-sa":    "system:serviceaccount:shared:admin-sa",
+		} {
+			user, err := DeriveServiceAccountToImpersonate(project, newApp(serviceAccount), cluster)
+			require.NoError(t, err)
+			assert.Equal(t, expected, user)
+		}
+
+		for _, serviceAccount := range []string{"admin-sa", "other-ns:deployer-web"} {
+			user, err := DeriveServiceAccountToImpersonate(project, newApp(serviceAccount), cluster)
+			assert.Empty(t, user)
+			assert.ErrorContains(t, err, "is not permitted by the destination service account")
+		}
+
+		user, err := DeriveServiceAccountToImpersonate(project, newApp("deployer-*"), cluster)
+		assert.Empty(t, user)
+		assert.ErrorContains(t, err, "service account contains invalid chars")
+	})
+
+	t.Run("ApplicationServiceAccountWithoutMatchingDestination", func(t *testing.T) {
+		t.Parallel()
+		project := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-project"},
+			Spec: v1alpha1.AppProjectSpec{
+				DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{
+					{Server: "*", Namespace: "other-ns", DefaultServiceAccount: "default-sa"},
+				},
+			},
+		}
+		app := &v1alpha1.Application{
+			Spec: v1alpha1.ApplicationSpec{
+				Destination: v1alpha1.ApplicationDestination{
+					Server:         "https://cluster-api.example.com",
+					Namespace:      "dest-ns",
+					ServiceAccount: "deployer-sa",
+				},
+			},
+		}
+		cluster := &v1alpha1.Cluster{Server: "https://cluster-api.example.com"}
+
+		user, err := DeriveServiceAccountToImpersonate(project, app, cluster)
+		assert.Empty(t, user)
+		assert.ErrorContains(t, err, "no destination service account of project 'test-project' matches")
+	})
 }
@@ -31,15 +31,18 @@ import (
 	"k8s.io/client-go/kubernetes"
 	v1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/jsonpath"
 	"sigs.k8s.io/yaml"
 
 	enginecache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
 	timeutil "github.com/argoproj/pkg/v2/time"
+	"github.com/google/cel-go/cel"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/server/settings/oidc"
 	"github.com/argoproj/argo-cd/v3/util"
+	celutil "github.com/argoproj/argo-cd/v3/util/cel"
 	"github.com/argoproj/argo-cd/v3/util/crypto"
 	"github.com/argoproj/argo-cd/v3/util/kube"
 	"github.com/argoproj/argo-cd/v3/util/password"
@@ -120,6 +123,10 @@ type ArgoCDSettings struct {
 	WebhookAzureDevOpsUsername string `json:"webhookAzureDevOpsUsername,omitempty"`
 	// WebhookAzureDevOpsPassword holds the password for authenticating Azure DevOps webhook events
 	WebhookAzureDevOpsPassword string `json:"webhookAzureDevOpsPassword,omitempty"`
+	// WebhookAWSCodeCommitTopicARNs holds the comma separated ARNs of the SNS topics AWS CodeCommit webhook events are accepted from
+	WebhookAWSCodeCommitTopicARNs string `json:"webhookAWSCodeCommitTopicARNs,omitempty"`
+	// ClusterRegistrationToken holds the bootstrap token cluster agents use to submit registration requests
+	ClusterRegistrationToken string `json:"clusterRegistrationToken,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// KustomizeBuildOptions is a string of kustomize build parameters
@@ -152,6 +159,10 @@ type ArgoCDSettings struct {
 	ExecEnabled bool `json:"execEnabled"`
 	// ExecShells restricts which shells are allowed for `exec` and in which order they are tried
 	ExecShells []string `json:"execShells"`
+	// ExecRecordingEnabled indicates whether all `exec` sessions are recorded, regardless of the project settings
+	ExecRecordingEnabled bool `json:"execRecordingEnabled"`
+	// ExecRecordingPath is the directory the `exec` session recordings are written to
+	ExecRecordingPath string `json:"execRecordingPath,omitempty"`
 	// TrackingMethod defines the resource tracking method to be used
 	TrackingMethod string `json:"application.resourceTrackingMethod,omitempty"`
 	// OIDCTLSInsecureSkipVerify determines whether certificate verification is skipped when verifying tokens with the
@@ -182,6 +193,15 @@ type GlobalProjectSettings struct {
 	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
+// ApplicationColumn is an additional column shown when listing applications
+type ApplicationColumn struct {
+	// Name is the header of the column
+	Name string `json:"name"`
+	// JSONPath is the path of the value shown in the column, e.g. .metadata.labels.team. It must refer to a label or a
+	// status field of the application.
+	JSONPath string `json:"jsonPath"`
+}
+
 // Help settings
 type Help struct {
 	// the URL for getting chat help, this will typically be your Slack channel for support
@@ -463,18 +483,34 @@ const (
 	settingsWebhookAzureDevOpsUsernameKey = "webhook.azuredevops.username"
 	// settingsWebhookAzureDevOpsPasswordKey is the key for Azure DevOps webhook password
 	settingsWebhookAzureDevOpsPasswordKey = "webhook.azuredevops.password"
+	// settingsWebhookAWSCodeCommitTopicARNsKey is the key for the SNS topic ARNs of AWS CodeCommit webhook events
+	settingsWebhookAWSCodeCommitTopicARNsKey = "webhook.awscodecommit.topicArns"
 	// settingsWebhookMaxPayloadSize is the key for the maximum payload size for webhooks in MB
 	settingsWebhookMaxPayloadSizeMB = "webhook.maxPayloadSizeMB"
 	// settingsWebhookRefreshJitter is the key for the maximum jitter duration for webhook-triggered refreshes
 	settingsWebhookRefreshJitter = "webhook.refresh.jitter"
 	// settingsWebhookRefreshJitterThreshold is the key for the minimum number of apps to trigger jitter
 	settingsWebhookRefreshJitterThreshold = "webhook.refresh.jitter.threshold"
+	// settingsWebhookRefreshScopeToSourcePath is the key to only refresh applications whose source path contains changed files
+	settingsWebhookRefreshScopeToSourcePath = "webhook.refresh.scopeToSourcePath"
+	// settingsInteractiveRefreshPerMinuteKey is the key for the number of refreshes per minute each user can request ahead of the periodic reconciliations
+	settingsInteractiveRefreshPerMinuteKey = "application.refresh.interactive.perMinute"
+	// settingsInteractiveRefreshBurstKey is the key for the number of interactive refreshes each user can request in excess of the rate
+	settingsInteractiveRefreshBurstKey = "application.refresh.interactive.burst"
+	// operationWebhooksKey is the key to the list of webhooks called on the phase transitions of application operations
+	operationWebhooksKey = "operation.webhooks"
+	// operationWebhooksAllowedURLsKey is the key to the list of URL patterns the operation webhooks of projects may call
+	operationWebhooksAllowedURLsKey = "operation.webhooks.allowedURLs"
+	// settingsClusterRegistrationTokenKey is the key for the bootstrap token of cluster registration agents
+	settingsClusterRegistrationTokenKey = "cluster.registration.token"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsApplicationInstanceLabelKey = "application.instanceLabelKey"
 	// settingsResourceTrackingMethodKey is the key to configure tracking method for application resources
 	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
 	// allowedNodeLabelsKey is the key to the list of allowed node labels for the application pod view
 	allowedNodeLabelsKey = "application.allowedNodeLabels"
+	// applicationColumnsKey is the key to the list of additional columns shown when listing applications
+	applicationColumnsKey = "application.columns"
 	// settingsInstallationID holds the key for the instance installation ID
 	settingsInstallationID = "installationID"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
@@ -483,6 +519,8 @@ const (
 	resourceExclusionsKey = "resource.exclusions"
 	// resourceInclusions is the key to the list of explicitly watched resources
 	resourceInclusionsKey = "resource.inclusions"
+	// resourceDriftExclusionsKey is the key to the list of drift exclusions applied to all applications
+	resourceDriftExclusionsKey = "resource.driftExclusions"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
@@ -527,6 +565,10 @@ const (
 	settingsCommitAuthorNameKey = "commit.author.name"
 	// settingsCommitAuthorEmailKey is the key for the commit author email
 	settingsCommitAuthorEmailKey = "commit.author.email"
+	// settingsSnapshotStoreKey is the key for the object store holding application snapshots
+	settingsSnapshotStoreKey = "snapshots.store"
+	// settingsExecRecordingStoreKey is the key for the object store holding the `exec` session recordings
+	settingsExecRecordingStoreKey = "exec.recording.store"
 	// globalProjectsKey designates the key for global project settings
 	globalProjectsKey = "globalProjects"
 	// initialPasswordSecretName is the name of the secret that will hold the initial admin password
@@ -553,6 +595,10 @@ const (
 	execEnabledKey = "exec.enabled"
 	// execShellsKey is the key to configure which shells are allowed for `exec` and in what order they are tried
 	execShellsKey = "exec.shells"
+	// execRecordingEnabledKey is the key to configure whether all `exec` sessions are recorded
+	execRecordingEnabledKey = "exec.recording.enabled"
+	// execRecordingPathKey is the key to configure the directory the `exec` session recordings are written to
+	execRecordingPathKey = "exec.recording.path"
 	// oidcTLSInsecureSkipVerifyKey is the key to configure whether TLS cert verification is skipped for OIDC connections
 	oidcTLSInsecureSkipVerifyKey = "oidc.tls.insecure.skip.verify"
 	// ApplicationDeepLinks is the application deep link key
@@ -572,6 +618,8 @@ const (
 	impersonationEnforcedKey = "application.sync.impersonation.enforced"
 	// requireOverridePrivilegeForRevisionSyncKey is the key to configure whether giving an external revision during sync is considered an override
 	requireOverridePrivilegeForRevisionSyncKey = "application.sync.requireOverridePrivilegeForRevisionSync"
+	// watchManagedGroupKindsOnlyKey is the key to configure whether the controller only watches the resource kinds managed by the applications of each cluster
+	watchManagedGroupKindsOnlyKey = "resource.watchManagedGroupKindsOnly"
 )
 
 const (
@@ -581,6 +629,9 @@ const (
 	// default webhook refresh jitter threshold
 	defaultWebhookRefreshJitterThreshold = 10
 
+	// default number of interactive refreshes per minute and user
+	defaultInteractiveRefreshPerMinute = 10
+
 	// application sync with impersonation feature is disabled by default.
 	defaultImpersonationEnabledFlag = false
 
@@ -1111,6 +1162,62 @@ func (mgr *SettingsManager) GetCommitAuthorName() (string, error) {
 	return argoCDCM.Data[settingsCommitAuthorNameKey], nil
 }
 
+// ObjectStoreSettings configures an S3 compatible object store, such as the store holding application snapshots
+type ObjectStoreSettings struct {
+	// Bucket is the name of the bucket
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the keys of the objects
+	Prefix string `json:"prefix,omitempty"`
+	// Endpoint is the URL of the object store. Defaults to the AWS S3 endpoint of the region.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region of the bucket
+	Region string `json:"region,omitempty"`
+	// AccessKeyID is the access key ID, or a reference to a secret key. The default AWS credential chain is used if
+	// empty.
+	AccessKeyID string `json:"accessKeyID,omitempty"`
+	// SecretAccessKey is the secret access key, or a reference to a secret key
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+}
+
+// GetSnapshotStoreSettings returns the settings of the application snapshot store with resolved secret references, or
+// nil if no store is configured.
+func (mgr *SettingsManager) GetSnapshotStoreSettings() (*ObjectStoreSettings, error) {
+	return mgr.getObjectStoreSettings(settingsSnapshotStoreKey)
+}
+
+// GetExecRecordingStoreSettings returns the settings of the object store holding the `exec` session recordings with
+// resolved secret references, or nil if no store is configured.
+func (mgr *SettingsManager) GetExecRecordingStoreSettings() (*ObjectStoreSettings, error) {
+	return mgr.getObjectStoreSettings(settingsExecRecordingStoreKey)
+}
+
+// getObjectStoreSettings returns the settings of the object store configured by the given key of argocd-cm with
+// resolved secret references, or nil if the key is not set.
+func (mgr *SettingsManager) getObjectStoreSettings(key string) (*ObjectStoreSettings, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	value, ok := argoCDCM.Data[key]
+	if !ok || value == "" {
+		return nil, nil
+	}
+	var storeSettings ObjectStoreSettings
+	if err := yaml.Unmarshal([]byte(value), &storeSettings); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", key, err)
+	}
+	if storeSettings.Bucket == "" {
+		return nil, fmt.Errorf("%s: bucket is required", key)
+	}
+	argoCDSettings, err := mgr.GetSettings()
+	if err != nil {
+		return nil, err
+	}
+	storeSettings.AccessKeyID = ReplaceStringSecret(storeSettings.AccessKeyID, argoCDSettings.Secrets)
+	storeSettings.SecretAccessKey = ReplaceStringSecret(storeSettings.SecretAccessKey, argoCDSettings.Secrets)
+	return &storeSettings, nil
+}
+
 func (mgr *SettingsManager) GetCommitAuthorEmail() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -1728,6 +1835,8 @@ func updateSettingsFromConfigMap(settings *ArgoCDSettings, argoCDCM *corev1.Conf
 		// Fall back to default. If you change this list, also change docs/operator-manual/argocd-cm.yaml.
 		settings.ExecShells = []string{"bash", "sh", "powershell", "cmd"}
 	}
+	settings.ExecRecordingEnabled = argoCDCM.Data[execRecordingEnabledKey] == "true"
+	settings.ExecRecordingPath = argoCDCM.Data[execRecordingPathKey]
 	settings.TrackingMethod = argoCDCM.Data[settingsResourceTrackingMethodKey]
 	settings.OIDCTLSInsecureSkipVerify = argoCDCM.Data[oidcTLSInsecureSkipVerifyKey] == "true"
 	settings.ExtensionConfig = getExtensionConfigs(argoCDCM.Data)
@@ -1802,6 +1911,8 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	settings.WebhookGogsSecret = string(argoCDSecret.Data[settingsWebhookGogsSecretKey])
 	settings.WebhookAzureDevOpsUsername = string(argoCDSecret.Data[settingsWebhookAzureDevOpsUsernameKey])
 	settings.WebhookAzureDevOpsPassword = string(argoCDSecret.Data[settingsWebhookAzureDevOpsPasswordKey])
+	settings.WebhookAWSCodeCommitTopicARNs = string(argoCDSecret.Data[settingsWebhookAWSCodeCommitTopicARNsKey])
+	settings.ClusterRegistrationToken = string(argoCDSecret.Data[settingsClusterRegistrationTokenKey])
 
 	if len(errs) > 0 {
 		return errors.Join(errs...)
@@ -2058,6 +2169,18 @@ func (a *ArgoCDSettings) GetWebhookAzureDevOpsPassword() string {
 	return ReplaceStringSecret(a.WebhookAzureDevOpsPassword, a.Secrets)
 }
 
+// GetWebhookAWSCodeCommitTopicARNs returns the resolved ARNs of the SNS topics AWS CodeCommit webhook events are
+// accepted from
+func (a *ArgoCDSettings) GetWebhookAWSCodeCommitTopicARNs() []string {
+	var arns []string
+	for arn := range strings.SplitSeq(ReplaceStringSecret(a.WebhookAWSCodeCommitTopicARNs, a.Secrets), ",") {
+		if arn = strings.TrimSpace(arn); arn != "" {
+			arns = append(arns, arn)
+		}
+	}
+	return arns
+}
+
 func unmarshalOIDCConfig(configStr string) (oidcConfig, error) {
 	var config oidcConfig
 	err := yaml.Unmarshal([]byte(configStr), &config)
@@ -2364,6 +2487,20 @@ func (a *ArgoCDSettings) RedirectURLForRequest(r *http.Request) (string, error)
 	return appendURLPath(base, common.CallbackEndpoint)
 }
 
+// AcceptsAudience returns whether a token restricted to the given audience is accepted by this Argo CD instance, i.e.
+// whether the audience contains the URL or one of the additional URLs of the instance
+func (a *ArgoCDSettings) AcceptsAudience(audience []string) bool {
+	urls := append([]string{a.URL}, a.AdditionalURLs...)
+	for _, aud := range audience {
+		for _, u := range urls {
+			if u != "" && strings.TrimSuffix(aud, "/") == strings.TrimSuffix(u, "/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (a *ArgoCDSettings) RedirectAdditionalURLs() ([]string, error) {
 	RedirectAdditionalURLs := []string{}
 	for _, url := range a.AdditionalURLs {
@@ -2647,6 +2784,101 @@ func (mgr *SettingsManager) GetGlobalProjectsSettings() ([]GlobalProjectSettings
 	return globalProjectSettings, nil
 }
 
+// GetApplicationColumns returns the additional columns shown when listing applications
+func (mgr *SettingsManager) GetApplicationColumns() ([]ApplicationColumn, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	columns := make([]ApplicationColumn, 0)
+	if value := argoCDCM.Data[applicationColumnsKey]; value != "" {
+		if err := yaml.Unmarshal([]byte(value), &columns); err != nil {
+			return nil, fmt.Errorf("error unmarshalling application columns: %w", err)
+		}
+	}
+	for _, column := range columns {
+		if err := validateApplicationColumn(column); err != nil {
+			return nil, fmt.Errorf("invalid application column %q: %w", column.Name, err)
+		}
+	}
+	return columns, nil
+}
+
+// getDriftExclusions returns the drift exclusions applied to all applications, without validating them
+func (mgr *SettingsManager) getDriftExclusions() ([]v1alpha1.DriftExclusion, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	exclusions := make([]v1alpha1.DriftExclusion, 0)
+	if value := argoCDCM.Data[resourceDriftExclusionsKey]; value != "" {
+		if err := yaml.Unmarshal([]byte(value), &exclusions); err != nil {
+			return nil, fmt.Errorf("error unmarshalling drift exclusions: %w", err)
+		}
+	}
+	return exclusions, nil
+}
+
+// GetDriftExclusions returns the valid drift exclusions applied to all applications. The invalid drift exclusions are
+// logged and skipped, so that a single invalid entry does not break the comparison of every application.
+func (mgr *SettingsManager) GetDriftExclusions() ([]v1alpha1.DriftExclusion, error) {
+	exclusions, err := mgr.getDriftExclusions()
+	if err != nil {
+		return nil, err
+	}
+	valid := make([]v1alpha1.DriftExclusion, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		if _, err := CompileDriftExclusion(exclusion); err != nil {
+			log.Warnf("Skipping invalid drift exclusion for %s/%s in %s: %v", exclusion.Group, exclusion.Kind, resourceDriftExclusionsKey, err)
+			continue
+		}
+		valid = append(valid, exclusion)
+	}
+	return valid, nil
+}
+
+// ValidateDriftExclusions returns an error if one of the drift exclusions applied to all applications is invalid
+func (mgr *SettingsManager) ValidateDriftExclusions() error {
+	exclusions, err := mgr.getDriftExclusions()
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, exclusion := range exclusions {
+		if _, err := CompileDriftExclusion(exclusion); err != nil {
+			errs = append(errs, fmt.Errorf("invalid drift exclusion for %s/%s: %w", exclusion.Group, exclusion.Kind, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var driftExclusionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return celutil.NewEnv("live", "desired")
+})
+
+// CompileDriftExclusion compiles the CEL expression of the given drift exclusion, returning an error if it is invalid
+// or does not evaluate to a boolean.
+func CompileDriftExclusion(exclusion v1alpha1.DriftExclusion) (cel.Program, error) {
+	env, err := driftExclusionEnv()
+	if err != nil {
+		return nil, err
+	}
+	return celutil.CompileBool(env, exclusion.Expression)
+}
+
+func validateApplicationColumn(column ApplicationColumn) error {
+	if column.Name == "" {
+		return errors.New("name must not be empty")
+	}
+	if !strings.HasPrefix(column.JSONPath, ".metadata.labels.") && !strings.HasPrefix(column.JSONPath, ".status.") {
+		return fmt.Errorf("jsonPath %q must refer to a label or a status field", column.JSONPath)
+	}
+	if _, err := jsonpath.Parse(column.Name, "{"+column.JSONPath+"}"); err != nil {
+		return fmt.Errorf("invalid jsonPath %q: %w", column.JSONPath, err)
+	}
+	return nil
+}
+
 func (mgr *SettingsManager) GetNamespace() string {
 	return mgr.namespace
 }
@@ -2780,6 +3012,75 @@ func (mgr *SettingsManager) GetWebhookRefreshJitterThreshold() int {
 	return threshold
 }
 
+// IsWebhookRefreshScopedToSourcePath returns true if webhook events only refresh the applications whose source path
+// contains changed files, also if they don't have the manifest-generate-paths annotation
+func (mgr *SettingsManager) IsWebhookRefreshScopedToSourcePath() (bool, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", settingsWebhookRefreshScopeToSourcePath, err)
+	}
+	return cm.Data[settingsWebhookRefreshScopeToSourcePath] == "true", nil
+}
+
+// GetInteractiveRefreshRateLimit returns the rate limit of the refreshes each user can request ahead of the periodic
+// reconciliations, or nil if refreshes requested by users are not prioritized
+func (mgr *SettingsManager) GetInteractiveRefreshRateLimit() (*v1alpha1.ReconcileRateLimit, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	limit := &v1alpha1.ReconcileRateLimit{PerMinute: defaultInteractiveRefreshPerMinute}
+	if value := argoCDCM.Data[settingsInteractiveRefreshPerMinuteKey]; value != "" {
+		perMinute, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || perMinute < 0 {
+			return nil, fmt.Errorf("invalid value %q for key %s: must be a non-negative integer", value, settingsInteractiveRefreshPerMinuteKey)
+		}
+		limit.PerMinute = int32(perMinute)
+	}
+	if value := argoCDCM.Data[settingsInteractiveRefreshBurstKey]; value != "" {
+		burst, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || burst < 0 {
+			return nil, fmt.Errorf("invalid value %q for key %s: must be a non-negative integer", value, settingsInteractiveRefreshBurstKey)
+		}
+		limit.Burst = int32(burst)
+	}
+	if limit.PerMinute == 0 {
+		return nil, nil
+	}
+	return limit, nil
+}
+
+// GetOperationWebhooks returns the webhooks which are called on the phase transitions of the operations of all applications
+func (mgr *SettingsManager) GetOperationWebhooks() ([]v1alpha1.OperationWebhook, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	var webhooks []v1alpha1.OperationWebhook
+	if value, ok := argoCDCM.Data[operationWebhooksKey]; ok {
+		if err := yaml.Unmarshal([]byte(value), &webhooks); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %w", operationWebhooksKey, err)
+		}
+	}
+	return webhooks, nil
+}
+
+// GetOperationWebhookAllowedURLs returns the glob patterns of the URLs the operation webhooks of projects may call. The
+// operation webhooks of projects are not called if no pattern is configured.
+func (mgr *SettingsManager) GetOperationWebhookAllowedURLs() ([]string, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving argocd-cm: %w", err)
+	}
+	var allowedURLs []string
+	if value, ok := argoCDCM.Data[operationWebhooksAllowedURLsKey]; ok {
+		if err := yaml.Unmarshal([]byte(value), &allowedURLs); err != nil {
+			return nil, fmt.Errorf("error unmarshalling %s: %w", operationWebhooksAllowedURLsKey, err)
+		}
+	}
+	return allowedURLs, nil
+}
+
 // IsImpersonationEnabled returns true if application sync with impersonation feature is enabled in argocd-cm configmap
 func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
 	cm, err := mgr.getConfigMap()
@@ -2801,6 +3102,16 @@ func (mgr *SettingsManager) IsImpersonationEnforced() (bool, error) {
 	return defaultImpersonationEnforcedFlag, nil
 }
 
+// IsWatchManagedGroupKindsOnlyEnabled returns true if the controller should only watch the resource kinds managed by the
+// applications targeting each cluster instead of all the resource kinds of the clusters
+func (mgr *SettingsManager) IsWatchManagedGroupKindsOnlyEnabled() (bool, error) {
+	cm, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error checking %s property in configmap: %w", watchManagedGroupKindsOnlyKey, err)
+	}
+	return cm.Data[watchManagedGroupKindsOnlyKey] == "true", nil
+}
+
 func (mgr *SettingsManager) GetAllowedNodeLabels() []string {
 	labelKeys := []string{}
 	argoCDCM, err := mgr.getConfigMap()
@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -263,6 +264,131 @@ func TestGetInstallationID(t *testing.T) {
 	assert.Equal(t, "123456789", id)
 }
 
+func TestGetDriftExclusions(t *testing.T) {
+	t.Run("should get drift exclusions", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: has(live.metadata.annotations) && 'sidecar.istio.io/status' in live.metadata.annotations
+`,
+		})
+		exclusions, err := settingsManager.GetDriftExclusions()
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.DriftExclusion{
+			{Group: "apps", Kind: "Deployment", Expression: "has(live.metadata.annotations) && 'sidecar.istio.io/status' in live.metadata.annotations"},
+		}, exclusions)
+	})
+
+	t.Run("should get no drift exclusions if not defined", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{})
+		exclusions, err := settingsManager.GetDriftExclusions()
+		require.NoError(t, err)
+		assert.Empty(t, exclusions)
+	})
+
+	t.Run("should reject invalid drift exclusions", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"resource.driftExclusions": "not a list",
+		})
+		_, err := settingsManager.GetDriftExclusions()
+		assert.ErrorContains(t, err, "error unmarshalling drift exclusions")
+	})
+
+	t.Run("should skip drift exclusions with an invalid expression", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: live.spec.replicas >
+- group: apps
+  kind: StatefulSet
+  expression: live.spec.replicas < desired.spec.replicas
+`,
+		})
+		exclusions, err := settingsManager.GetDriftExclusions()
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.DriftExclusion{
+			{Group: "apps", Kind: "StatefulSet", Expression: "live.spec.replicas < desired.spec.replicas"},
+		}, exclusions)
+	})
+}
+
+func TestValidateDriftExclusions(t *testing.T) {
+	t.Run("should accept valid drift exclusions", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: live.spec.replicas < desired.spec.replicas
+`,
+		})
+		require.NoError(t, settingsManager.ValidateDriftExclusions())
+	})
+
+	t.Run("should reject drift exclusions with an invalid expression", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"resource.driftExclusions": `
+- group: apps
+  kind: Deployment
+  expression: 1 + 1
+`,
+		})
+		err := settingsManager.ValidateDriftExclusions()
+		assert.ErrorContains(t, err, `invalid drift exclusion for apps/Deployment: expression "1 + 1" must evaluate to a boolean`)
+	})
+}
+
+func TestGetApplicationColumns(t *testing.T) {
+	t.Run("should get columns", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"application.columns": `
+- name: Team
+  jsonPath: .metadata.labels.team
+- name: Revision
+  jsonPath: .status.sync.revision
+`,
+		})
+		columns, err := settingsManager.GetApplicationColumns()
+		require.NoError(t, err)
+		assert.Equal(t, []ApplicationColumn{
+			{Name: "Team", JSONPath: ".metadata.labels.team"},
+			{Name: "Revision", JSONPath: ".status.sync.revision"},
+		}, columns)
+	})
+
+	t.Run("should get no columns if not defined", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{})
+		columns, err := settingsManager.GetApplicationColumns()
+		require.NoError(t, err)
+		assert.Empty(t, columns)
+	})
+
+	t.Run("should reject columns outside of labels and status", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"application.columns": `[{"name": "Repo", "jsonPath": ".spec.source.repoURL"}]`,
+		})
+		_, err := settingsManager.GetApplicationColumns()
+		assert.ErrorContains(t, err, `jsonPath ".spec.source.repoURL" must refer to a label or a status field`)
+	})
+
+	t.Run("should reject invalid JSONPath", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"application.columns": `[{"name": "Health", "jsonPath": ".status.health[status"}]`,
+		})
+		_, err := settingsManager.GetApplicationColumns()
+		assert.ErrorContains(t, err, "invalid jsonPath")
+	})
+
+	t.Run("should reject columns without name", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), map[string]string{
+			"application.columns": `[{"jsonPath": ".status.health.status"}]`,
+		})
+		_, err := settingsManager.GetApplicationColumns()
+		assert.ErrorContains(t, err, "name must not be empty")
+	})
+}
+
 func TestApplicationFineGrainedRBACInheritanceDisabledDefault(t *testing.T) {
 	_, settingsManager := fixtures(t.Context(), nil)
 	flag, err := settingsManager.ApplicationFineGrainedRBACInheritanceDisabled()
@@ -2080,6 +2206,16 @@ func TestRedirectURLForRequest(t *testing.T) {
 	}
 }
 
+func TestAcceptsAudience(t *testing.T) {
+	settings := &ArgoCDSettings{URL: "https://example.org", AdditionalURLs: []string{"https://anotherhost.org/"}}
+	assert.True(t, settings.AcceptsAudience([]string{"https://example.org"}))
+	assert.True(t, settings.AcceptsAudience([]string{"https://example.org/"}))
+	assert.True(t, settings.AcceptsAudience([]string{"https://other.org", "https://anotherhost.org"}))
+	assert.False(t, settings.AcceptsAudience([]string{"https://other.org"}))
+	assert.False(t, settings.AcceptsAudience(nil))
+	assert.False(t, (&ArgoCDSettings{}).AcceptsAudience([]string{""}))
+}
+
 func TestRedirectAdditionalURLs(t *testing.T) {
 	testCases := []struct {
 		Name           string
@@ -2423,6 +2559,20 @@ func TestIsImpersonationEnforced(t *testing.T) {
 		"when user specify invalid value for enforcement in argocd-cm config map, IsImpersonationEnforced() must not return any error")
 }
 
+func TestIsWatchManagedGroupKindsOnlyEnabled(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{})
+	enabled, err := settingsManager.IsWatchManagedGroupKindsOnlyEnabled()
+	require.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"resource.watchManagedGroupKindsOnly": "true",
+	})
+	enabled, err = settingsManager.IsWatchManagedGroupKindsOnlyEnabled()
+	require.NoError(t, err)
+	assert.True(t, enabled)
+}
+
 func TestIsInClusterEnabled(t *testing.T) {
 	// When there is no argocd-cm itself,
 	// Then IsInClusterEnabled() must return true (default value) and an error with appropriate error message.
@@ -2628,6 +2778,44 @@ func TestGetHydratorReadmeTemplate(t *testing.T) {
 	})
 }
 
+func TestGetSnapshotStoreSettings(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{})
+	storeSettings, err := settingsManager.GetSnapshotStoreSettings()
+	require.NoError(t, err)
+	assert.Nil(t, storeSettings)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		settingsSnapshotStoreKey: "bucket: snapshots\nregion: eu-west-1\naccessKeyID: $snapshots.accessKeyID\nsecretAccessKey: $snapshots.secretAccessKey\n",
+	}, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = []byte("secret-key")
+		secret.Data["snapshots.accessKeyID"] = []byte("access")
+		secret.Data["snapshots.secretAccessKey"] = []byte("secret")
+	})
+	storeSettings, err = settingsManager.GetSnapshotStoreSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &ObjectStoreSettings{Bucket: "snapshots", Region: "eu-west-1", AccessKeyID: "access", SecretAccessKey: "secret"}, storeSettings)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{settingsSnapshotStoreKey: "region: eu-west-1"})
+	_, err = settingsManager.GetSnapshotStoreSettings()
+	require.ErrorContains(t, err, "bucket is required")
+}
+
+func TestGetExecRecordingStoreSettings(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{})
+	storeSettings, err := settingsManager.GetExecRecordingStoreSettings()
+	require.NoError(t, err)
+	assert.Nil(t, storeSettings)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		settingsExecRecordingStoreKey: "bucket: recordings\nprefix: exec\n",
+	}, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = []byte("secret-key")
+	})
+	storeSettings, err = settingsManager.GetExecRecordingStoreSettings()
+	require.NoError(t, err)
+	assert.Equal(t, &ObjectStoreSettings{Bucket: "recordings", Prefix: "exec"}, storeSettings)
+}
+
 func TestSecretsInformerExcludesClusterSecrets(t *testing.T) {
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -2967,3 +3155,90 @@ func TestSettingsManager_GetWebhookRefreshJitter(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWebhookAWSCodeCommitTopicARNs(t *testing.T) {
+	s := &ArgoCDSettings{
+		WebhookAWSCodeCommitTopicARNs: "$topics",
+		Secrets:                       map[string]string{"topics": "arn:aws:sns:us-east-1:123456789012:a, arn:aws:sns:us-east-1:123456789012:b,"},
+	}
+	assert.Equal(t, []string{"arn:aws:sns:us-east-1:123456789012:a", "arn:aws:sns:us-east-1:123456789012:b"}, s.GetWebhookAWSCodeCommitTopicARNs())
+	assert.Empty(t, (&ArgoCDSettings{}).GetWebhookAWSCodeCommitTopicARNs())
+}
+
+func TestIsWebhookRefreshScopedToSourcePath(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{"webhook.refresh.scopeToSourcePath": "true"})
+	scoped, err := settingsManager.IsWebhookRefreshScopedToSourcePath()
+	require.NoError(t, err)
+	assert.True(t, scoped)
+
+	_, settingsManager = fixtures(t.Context(), nil)
+	scoped, err = settingsManager.IsWebhookRefreshScopedToSourcePath()
+	require.NoError(t, err)
+	assert.False(t, scoped)
+}
+
+func TestGetInteractiveRefreshRateLimit(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil)
+	limit, err := settingsManager.GetInteractiveRefreshRateLimit()
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ReconcileRateLimit{PerMinute: 10}, limit)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{"application.refresh.interactive.perMinute": "30", "application.refresh.interactive.burst": "5"})
+	limit, err = settingsManager.GetInteractiveRefreshRateLimit()
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ReconcileRateLimit{PerMinute: 30, Burst: 5}, limit)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{"application.refresh.interactive.perMinute": "0"})
+	limit, err = settingsManager.GetInteractiveRefreshRateLimit()
+	require.NoError(t, err)
+	assert.Nil(t, limit)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{"application.refresh.interactive.burst": "-1"})
+	_, err = settingsManager.GetInteractiveRefreshRateLimit()
+	require.ErrorContains(t, err, "application.refresh.interactive.burst")
+}
+
+func TestGetOperationWebhooks(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{"operation.webhooks": `
+- name: tracker
+  url: https://tracker.example.com/deployments
+  secret: $webhook.tracker.secret
+  phases: [Running, Succeeded]
+`})
+	webhooks, err := settingsManager.GetOperationWebhooks()
+	require.NoError(t, err)
+	assert.Equal(t, []v1alpha1.OperationWebhook{{
+		Name:   "tracker",
+		URL:    "https://tracker.example.com/deployments",
+		Secret: "$webhook.tracker.secret",
+		Phases: []synccommon.OperationPhase{synccommon.OperationRunning, synccommon.OperationSucceeded},
+	}}, webhooks)
+
+	_, settingsManager = fixtures(t.Context(), nil)
+	webhooks, err = settingsManager.GetOperationWebhooks()
+	require.NoError(t, err)
+	assert.Empty(t, webhooks)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{"operation.webhooks": "invalid"})
+	_, err = settingsManager.GetOperationWebhooks()
+	require.Error(t, err)
+}
+
+func TestGetOperationWebhookAllowedURLs(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), map[string]string{"operation.webhooks.allowedURLs": `
+- https://tracker.example.com/*
+- https://*.cmdb.example.com/hooks
+`})
+	allowedURLs, err := settingsManager.GetOperationWebhookAllowedURLs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://tracker.example.com/*", "https://*.cmdb.example.com/hooks"}, allowedURLs)
+
+	_, settingsManager = fixtures(t.Context(), nil)
+	allowedURLs, err = settingsManager.GetOperationWebhookAllowedURLs()
+	require.NoError(t, err)
+	assert.Empty(t, allowedURLs)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{"operation.webhooks.allowedURLs": "invalid"})
+	_, err = settingsManager.GetOperationWebhookAllowedURLs()
+	require.Error(t, err)
+}
@@ -104,3 +104,49 @@ func EndSpan(span oteltrace.Span, err error) {
 	}
 	span.End()
 }
+
+// annotationCarrier is a propagation.TextMapCarrier storing the propagated fields in annotations with a prefix
+type annotationCarrier struct {
+	annotations map[string]string
+	prefix      string
+}
+
+func (c annotationCarrier) Get(key string) string {
+	return c.annotations[c.prefix+key]
+}
+
+func (c annotationCarrier) Set(key, value string) {
+	c.annotations[c.prefix+key] = value
+}
+
+func (c annotationCarrier) Keys() []string {
+	var keys []string
+	for key := range c.annotations {
+		if field, ok := strings.CutPrefix(key, c.prefix); ok {
+			keys = append(keys, field)
+		}
+	}
+	return keys
+}
+
+// InjectIntoAnnotations replaces the trace context stored in the annotations with the given prefix by the trace
+// context of ctx, so that another component can continue the trace with ExtractFromAnnotations.
+func InjectIntoAnnotations(ctx context.Context, annotations map[string]string, prefix string) {
+	RemoveFromAnnotations(annotations, prefix)
+	otel.GetTextMapPropagator().Inject(ctx, annotationCarrier{annotations: annotations, prefix: prefix})
+}
+
+// ExtractFromAnnotations returns a copy of ctx carrying the trace context stored in the annotations with the given
+// prefix, if any.
+func ExtractFromAnnotations(ctx context.Context, annotations map[string]string, prefix string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, annotationCarrier{annotations: annotations, prefix: prefix})
+}
+
+// RemoveFromAnnotations removes the trace context stored in the annotations with the given prefix
+func RemoveFromAnnotations(annotations map[string]string, prefix string) {
+	for key := range annotations {
+		if strings.HasPrefix(key, prefix) {
+			delete(annotations, key)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-playground/webhooks/v6/azuredevops"
+	log "github.com/sirupsen/logrus"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// azureDevOpsMaxChanges is the maximum number of changed files requested for a single push
+const azureDevOpsMaxChanges = 5000
+
+// azureDevOpsCommitDiffs is the response of the diffs API of Azure DevOps
+type azureDevOpsCommitDiffs struct {
+	AllChangesIncluded bool `json:"allChangesIncluded"`
+	Changes            []struct {
+		Item struct {
+			Path     string `json:"path"`
+			IsFolder bool   `json:"isFolder"`
+		} `json:"item"`
+		SourceServerItem string `json:"sourceServerItem,omitempty"`
+	} `json:"changes"`
+}
+
+// fetchChangedFilesFromAzureDevOps gets the list of files changed between two commits, by calling the diffs API of
+// the Azure DevOps organization the webhook originated from.
+// See: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/diffs/get
+func fetchChangedFilesFromAzureDevOps(ctx context.Context, httpClient *http.Client, repoAPIURL, username, password, baseCommit, targetCommit string) ([]string, error) {
+	query := url.Values{
+		"baseVersion":       []string{baseCommit},
+		"baseVersionType":   []string{"commit"},
+		"targetVersion":     []string{targetCommit},
+		"targetVersionType": []string{"commit"},
+		"$top":              []string{fmt.Sprint(azureDevOpsMaxChanges)},
+		"api-version":       []string{"7.1"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(repoAPIURL, "/")+"/diffs/commits?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	diffs, err := getAzureDevOpsCommitDiffs(httpClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the diffs: %w", err)
+	}
+	if !diffs.AllChangesIncluded {
+		return nil, fmt.Errorf("more than %d changed files", azureDevOpsMaxChanges)
+	}
+	var changedFiles []string
+	for _, change := range diffs.Changes {
+		if change.Item.IsFolder {
+			continue
+		}
+		changedFiles = append(changedFiles, strings.TrimPrefix(change.Item.Path, "/"))
+		if change.SourceServerItem != "" && change.SourceServerItem != change.Item.Path {
+			changedFiles = append(changedFiles, strings.TrimPrefix(change.SourceServerItem, "/"))
+		}
+	}
+	log.Debugf("changed files between %s and %s: %v", baseCommit, targetCommit, changedFiles)
+	return changedFiles, nil
+}
+
+func getAzureDevOpsCommitDiffs(httpClient *http.Client, req *http.Request) (*azureDevOpsCommitDiffs, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from %s: %s", req.URL.Path, resp.Status)
+	}
+	var diffs azureDevOpsCommitDiffs
+	if err := json.NewDecoder(resp.Body).Decode(&diffs); err != nil {
+		return nil, err
+	}
+	return &diffs, nil
+}
+
+// azureDevOpsChangedFiles determines the changed files of the given push event, using the diffs API of Azure DevOps.
+// The API is only called for webhook events that were authenticated with the configured username and password, to
+// prevent Server-side request forgery (SSRF) attacks.
+func (a *ArgoCDWebhookHandler) azureDevOpsChangedFiles(payload azuredevops.GitPushEvent, change changeInfo) []string {
+	if a.settings.GetWebhookAzureDevOpsUsername() == "" || a.settings.GetWebhookAzureDevOpsPassword() == "" {
+		return nil
+	}
+	// created and deleted branches have no previous or next commit to compare
+	if change.shaBefore == "" || change.shaBefore == emptyCommitSHA || change.shaAfter == "" || change.shaAfter == emptyCommitSHA {
+		return nil
+	}
+	repoAPIURL, err := url.Parse(payload.Resource.Repository.URL)
+	if err != nil || repoAPIURL.Scheme != "https" {
+		log.Warnf("invalid Azure DevOps repository API URL %q", payload.Resource.Repository.URL)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	argoRepo, err := a.lookupRepository(ctx, payload.Resource.Repository.RemoteURL)
+	if err != nil {
+		log.Warnf("error trying to find a matching repo for URL %s: %v", payload.Resource.Repository.RemoteURL, err)
+		return nil
+	}
+	var username, password string
+	if argoRepo != nil {
+		username, password = argoRepo.Username, argoRepo.Password
+	} else {
+		// it could be a public repository with no repo creds stored.
+		log.Debugf("no Azure DevOps repository credentials configured for URL %s, using an anonymous client", payload.Resource.Repository.RemoteURL)
+	}
+	changedFiles, err := fetchChangedFilesFromAzureDevOps(ctx, &http.Client{Timeout: 10 * time.Second}, repoAPIURL.String(), username, password, change.shaBefore, change.shaAfter)
+	if err != nil {
+		log.Warnf("error fetching changed files using Azure DevOps diffs api: %v", err)
+		return nil
+	}
+	return changedFiles
+}
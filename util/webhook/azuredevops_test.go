@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/go-playground/webhooks/v6/azuredevops"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func Test_affectedRevisionInfo_azureDevOps_changed_files(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	const diffsURL = "https://dev.azure.com/alexander0053/_apis/git/repositories/ba2967cc-02c2-414c-8d10-1b99197cbaa6/diffs/commits"
+	allChangesIncluded := true
+	httpmock.RegisterResponder(http.MethodGet, diffsURL, func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "fa51eeb1e50b98293ce281e6d5492b9decae613b", req.URL.Query().Get("baseVersion"))
+		assert.Equal(t, "298a79aa1552799a70718a0ee914d153d5a1a76b", req.URL.Query().Get("targetVersion"))
+		_, password, _ := req.BasicAuth()
+		assert.Equal(t, "pat", password)
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+			"allChangesIncluded": allChangesIncluded,
+			"changes": []any{
+				map[string]any{"item": map[string]any{"path": "/apps", "isFolder": true}},
+				map[string]any{"item": map[string]any{"path": "/apps/guestbook/deployment.yaml"}},
+				map[string]any{"item": map[string]any{"path": "/apps/new.yaml"}, "sourceServerItem": "/apps/old.yaml"},
+			},
+		})
+	})
+
+	eventJSON, err := os.ReadFile("testdata/azuredevops-git-push-event.json")
+	require.NoError(t, err)
+	var payload azuredevops.GitPushEvent
+	require.NoError(t, json.Unmarshal(eventJSON, &payload))
+
+	newHandler := func(argoSettings *settings.ArgoCDSettings) *ArgoCDWebhookHandler {
+		mockDB := &mocks.ArgoDB{}
+		mockDB.EXPECT().ListRepositories(mock.Anything).Return([]*v1alpha1.Repository{
+			{Repo: "https://dev.azure.com/alexander0053/alex-test/_git/alex-test", Username: "user", Password: "pat"},
+		}, nil).Maybe()
+		return newMockHandler(nil, []string{}, int64(50)*1024*1024, mockDB, argoSettings)
+	}
+	authenticated := &settings.ArgoCDSettings{WebhookAzureDevOpsUsername: "user", WebhookAzureDevOpsPassword: "password"}
+
+	t.Run("Authenticated", func(t *testing.T) {
+		h := newHandler(authenticated)
+		defer h.Shutdown()
+		_, _, _, touchedHead, changedFiles := h.affectedRevisionInfo(payload)
+		assert.True(t, touchedHead)
+		assert.Equal(t, []string{"apps/guestbook/deployment.yaml", "apps/new.yaml", "apps/old.yaml"}, changedFiles)
+	})
+
+	t.Run("NotAllChangesIncluded", func(t *testing.T) {
+		allChangesIncluded = false
+		defer func() { allChangesIncluded = true }()
+		h := newHandler(authenticated)
+		defer h.Shutdown()
+		_, _, _, _, changedFiles := h.affectedRevisionInfo(payload)
+		assert.Empty(t, changedFiles)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		h := newHandler(&settings.ArgoCDSettings{})
+		defer h.Shutdown()
+		_, _, _, _, changedFiles := h.affectedRevisionInfo(payload)
+		assert.Empty(t, changedFiles)
+	})
+}
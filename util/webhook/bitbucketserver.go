@@ -0,0 +1,194 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// bitbucketServerChangesPageSize is the number of changed files requested per page
+	bitbucketServerChangesPageSize = 1000
+	// bitbucketServerMaxChangesPages is the maximum number of pages of changed files requested for a single push
+	bitbucketServerMaxChangesPages = 10
+	// emptyCommitSHA is the commit SHA reported for references that were created or deleted
+	emptyCommitSHA = "0000000000000000000000000000000000000000"
+)
+
+// bitbucketServerChangesPage is a page of the response of the compare changes API of Bitbucket Server
+type bitbucketServerChangesPage struct {
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+	Values        []struct {
+		Path struct {
+			ToString string `json:"toString"`
+		} `json:"path"`
+		SrcPath *struct {
+			ToString string `json:"toString"`
+		} `json:"srcPath,omitempty"`
+	} `json:"values"`
+}
+
+// bitbucketServerRef is a reference returned by the default branch API of Bitbucket Server
+type bitbucketServerRef struct {
+	ID string `json:"id"`
+}
+
+// bitbucketServerClient calls the REST API of the Bitbucket Server the webhook event originated from
+type bitbucketServerClient struct {
+	httpClient *http.Client
+	repoAPIURL string
+	repository *v1alpha1.Repository
+}
+
+// newBitbucketServerClient creates a client for the REST API of the repository of the given payload. The base URL of
+// the server is derived from the self link of the repository.
+func newBitbucketServerClient(payload bitbucketserver.RepositoryReferenceChangedPayload, repository *v1alpha1.Repository) (*bitbucketServerClient, error) {
+	var selfHref string
+	if self, ok := payload.Repository.Links["self"].([]any); ok && len(self) > 0 {
+		if link, ok := self[0].(map[string]any); ok {
+			selfHref, _ = link["href"].(string)
+		}
+	}
+	repoPath := fmt.Sprintf("/projects/%s/repos/%s", payload.Repository.Project.Key, payload.Repository.Slug)
+	idx := strings.Index(selfHref, repoPath)
+	if idx < 0 {
+		return nil, fmt.Errorf("failed to determine the Bitbucket Server API URL from the repository link %q", selfHref)
+	}
+	baseURL, err := url.Parse(selfHref[:idx])
+	if err != nil || (baseURL.Scheme != "https" && baseURL.Scheme != "http") {
+		return nil, fmt.Errorf("failed to parse Bitbucket Server URL %q", selfHref[:idx])
+	}
+	return &bitbucketServerClient{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		repoAPIURL: baseURL.String() + "/rest/api/1.0" + repoPath,
+		repository: repository,
+	}, nil
+}
+
+func (c *bitbucketServerClient) get(ctx context.Context, path string, query url.Values, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.repoAPIURL+path+"?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	switch {
+	case c.repository.Username != "" && c.repository.Password != "":
+		req.SetBasicAuth(c.repository.Username, c.repository.Password)
+	case c.repository.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+c.repository.BearerToken)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer utilio.Close(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response from %s: %s", req.URL.Path, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// fetchChangedFilesFromBitbucketServer gets the list of files changed between two commits, by calling the compare
+// changes API of the Bitbucket Server the webhook originated from.
+// See: https://developer.atlassian.com/server/bitbucket/rest/v906/api-group-repository/#api-api-latest-projects-projectkey-repos-repositoryslug-compare-changes-get
+func fetchChangedFilesFromBitbucketServer(ctx context.Context, client *bitbucketServerClient, fromHash, toHash string) ([]string, error) {
+	var changedFiles []string
+	start := 0
+	for range bitbucketServerMaxChangesPages {
+		var page bitbucketServerChangesPage
+		// the changes are compared from the new commit to the old commit
+		query := url.Values{
+			"from":  []string{toHash},
+			"to":    []string{fromHash},
+			"limit": []string{fmt.Sprint(bitbucketServerChangesPageSize)},
+			"start": []string{fmt.Sprint(start)},
+		}
+		if err := client.get(ctx, "/compare/changes", query, &page); err != nil {
+			return nil, fmt.Errorf("error getting the changes: %w", err)
+		}
+		for _, value := range page.Values {
+			changedFiles = append(changedFiles, value.Path.ToString)
+			if value.SrcPath != nil && value.SrcPath.ToString != "" {
+				changedFiles = append(changedFiles, value.SrcPath.ToString)
+			}
+		}
+		if page.IsLastPage {
+			log.Debugf("changed files between %s and %s: %v", fromHash, toHash, changedFiles)
+			return changedFiles, nil
+		}
+		start = page.NextPageStart
+	}
+	return nil, fmt.Errorf("more than %d changed files", bitbucketServerChangesPageSize*bitbucketServerMaxChangesPages)
+}
+
+// isBitbucketServerHeadTouched returns true if the given reference is the default branch of the repository
+func isBitbucketServerHeadTouched(ctx context.Context, client *bitbucketServerClient, refID string) (bool, error) {
+	var defaultBranch bitbucketServerRef
+	if err := client.get(ctx, "/default-branch", url.Values{}, &defaultBranch); err != nil {
+		return false, err
+	}
+	return defaultBranch.ID == refID, nil
+}
+
+// bitbucketServerChangedFiles determines the changed files and whether the default branch was updated by the given
+// change, using the REST API of the Bitbucket Server. The API is only called for webhook events that were signed
+// with the configured secret, to prevent Server-side request forgery (SSRF) attacks.
+func (a *ArgoCDWebhookHandler) bitbucketServerChangedFiles(payload bitbucketserver.RepositoryReferenceChangedPayload, change bitbucketserver.RepositoryChange, webURLs []string) (changedFiles []string, touchedHead bool) {
+	// Not actually sure how to check if the incoming change affected HEAD just by examining the
+	// payload alone. To be safe, we just return true and let the controller check for himself.
+	touchedHead = true
+	if a.settings.GetWebhookBitbucketServerSecret() == "" || len(webURLs) == 0 {
+		return nil, touchedHead
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var argoRepo *v1alpha1.Repository
+	for _, webURL := range webURLs {
+		repo, err := a.lookupRepository(ctx, webURL)
+		if err != nil {
+			log.Warnf("error trying to find a matching repo for URL %s: %v", webURL, err)
+			return nil, touchedHead
+		}
+		// only HTTP(S) credentials can be used for the API
+		if repo != nil && (repo.Password != "" || repo.BearerToken != "") {
+			argoRepo = repo
+			break
+		}
+	}
+	if argoRepo == nil {
+		// it could be a public repository with no repo creds stored.
+		log.Debugf("no Bitbucket Server repository credentials configured for URL %s, using an anonymous client", webURLs[0])
+		argoRepo = &v1alpha1.Repository{Repo: webURLs[0]}
+	}
+	client, err := newBitbucketServerClient(payload, argoRepo)
+	if err != nil {
+		log.Warnf("error creating Bitbucket Server client for repo %s: %v", payload.Repository.Slug, err)
+		return nil, touchedHead
+	}
+	if headTouched, err := isBitbucketServerHeadTouched(ctx, client, change.Reference.ID); err != nil {
+		log.Warnf("error fetching Bitbucket Server default branch: %v", err)
+	} else {
+		touchedHead = headTouched
+	}
+	// created and deleted references have no previous or next commit to compare
+	if change.FromHash == "" || change.FromHash == emptyCommitSHA || change.ToHash == "" || change.ToHash == emptyCommitSHA {
+		return nil, touchedHead
+	}
+	changedFiles, err = fetchChangedFilesFromBitbucketServer(ctx, client, change.FromHash, change.ToHash)
+	if err != nil {
+		log.Warnf("error fetching changed files using Bitbucket Server compare api: %v", err)
+		return nil, touchedHead
+	}
+	return changedFiles, touchedHead
+}
@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"testing"
+
+	bitbucketserver "github.com/go-playground/webhooks/v6/bitbucket-server"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func Test_affectedRevisionInfo_bitbucketServer_changed_files(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	const repoAPIURL = "https://bitbucketserver/rest/api/1.0/projects/MYPROJECT/repos/test-repo"
+	httpmock.RegisterResponder(http.MethodGet, repoAPIURL+"/compare/changes", func(req *http.Request) (*http.Response, error) {
+		user, password, _ := req.BasicAuth()
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "password", password)
+		if req.URL.Query().Get("start") == "0" {
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+				"isLastPage":    false,
+				"nextPageStart": 1,
+				"values":        []any{map[string]any{"path": map[string]any{"toString": "apps/guestbook/deployment.yaml"}}},
+			})
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, map[string]any{
+			"isLastPage": true,
+			"values":     []any{map[string]any{"path": map[string]any{"toString": "apps/new/service.yaml"}, "srcPath": map[string]any{"toString": "apps/old/service.yaml"}}},
+		})
+	})
+	httpmock.RegisterResponder(http.MethodGet, repoAPIURL+"/default-branch", httpmock.NewStringResponder(http.StatusOK, `{"id":"refs/heads/main"}`))
+
+	eventJSON, err := os.ReadFile("testdata/bitbucket-server-event.json")
+	require.NoError(t, err)
+	var payload bitbucketserver.RepositoryReferenceChangedPayload
+	require.NoError(t, json.Unmarshal(eventJSON, &payload))
+
+	newHandler := func(secret string) *ArgoCDWebhookHandler {
+		mockDB := &mocks.ArgoDB{}
+		mockDB.EXPECT().ListRepositories(mock.Anything).Return([]*v1alpha1.Repository{
+			{Repo: "ssh://git@bitbucketserver:7999/myproject/test-repo.git", SSHPrivateKey: "key"},
+			{Repo: "https://bitbucketserver/scm/myproject/test-repo.git", Username: "user", Password: "password"},
+		}, nil).Maybe()
+		return newMockHandler(nil, []string{}, int64(50)*1024*1024, mockDB, &settings.ArgoCDSettings{WebhookBitbucketServerSecret: secret})
+	}
+
+	t.Run("Signed", func(t *testing.T) {
+		h := newHandler("secret")
+		defer h.Shutdown()
+		_, revision, change, touchedHead, changedFiles := h.affectedRevisionInfo(payload)
+		assert.Equal(t, "master", revision)
+		assert.Equal(t, changeInfo{shaBefore: payload.Changes[0].FromHash, shaAfter: payload.Changes[0].ToHash}, change)
+		assert.False(t, touchedHead)
+		assert.Equal(t, []string{"apps/guestbook/deployment.yaml", "apps/new/service.yaml", "apps/old/service.yaml"}, changedFiles)
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		h := newHandler("")
+		defer h.Shutdown()
+		_, _, _, touchedHead, changedFiles := h.affectedRevisionInfo(payload)
+		assert.True(t, touchedHead)
+		assert.Empty(t, changedFiles)
+	})
+}
@@ -0,0 +1,240 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // SNS signature version 1 uses SHA1
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecommit"
+	codecommittypes "github.com/aws/aws-sdk-go-v2/service/codecommit/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db/mocks"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	testSNSCertURL   = "https://sns.us-east-1.amazonaws.com/SimpleNotificationService-test.pem"
+	testSNSTopicARN  = "arn:aws:sns:us-east-1:123456789012:codecommit"
+	testSubscribeURL = "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription&TopicArn=arn:aws:sns:us-east-1:123456789012:codecommit&Token=abc"
+)
+
+type snsSigner struct {
+	key     *rsa.PrivateKey
+	certPEM []byte
+}
+
+func newSNSSigner(t *testing.T) *snsSigner {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &snsSigner{key: key, certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (s *snsSigner) sign(t *testing.T, msg *snsMessage) []byte {
+	t.Helper()
+	msg.SigningCertURL = testSNSCertURL
+	var digest []byte
+	hash := crypto.SHA256
+	if msg.SignatureVersion == "1" {
+		sum := sha1.Sum([]byte(msg.stringToSign())) //nolint:gosec // SNS signature version 1 uses SHA1
+		digest, hash = sum[:], crypto.SHA1
+	} else {
+		msg.SignatureVersion = "2"
+		sum := sha256.Sum256([]byte(msg.stringToSign()))
+		digest = sum[:]
+	}
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, hash, digest)
+	require.NoError(t, err)
+	msg.Signature = base64.StdEncoding.EncodeToString(signature)
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	return data
+}
+
+func newSNSRequest(t *testing.T, messageType string, body []byte) *http.Request {
+	t.Helper()
+	req := httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/api/webhook", bytes.NewReader(body))
+	req.Header.Set(snsMessageTypeHeader, messageType)
+	return req
+}
+
+const testCodeCommitTrigger = `{"Records":[{"awsRegion":"us-east-1","codecommit":{"references":[{"commit":"5c4ef1049f1d27deadbeeff313e0730018be182b","ref":"refs/heads/main"}]},"eventName":"ReferenceChanges","eventSource":"aws:codecommit","eventSourceARN":"arn:aws:codecommit:us-east-1:123456789012:my-repo"}]}`
+
+const testCodeCommitStateChange = `{"version":"0","detail-type":"CodeCommit Repository State Change","source":"aws.codecommit","region":"eu-west-1","detail":{"event":"referenceUpdated","repositoryName":"my-repo","referenceFullName":"refs/heads/main","referenceType":"branch","commitId":"b2","oldCommitId":"b1"}}`
+
+func TestCodeCommitParser(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	signer := newSNSSigner(t)
+	httpmock.RegisterResponder(http.MethodGet, testSNSCertURL, httpmock.NewBytesResponder(http.StatusOK, signer.certPEM))
+	var confirmed atomic.Bool
+	httpmock.RegisterResponder(http.MethodGet, testSubscribeURL, func(*http.Request) (*http.Response, error) {
+		confirmed.Store(true)
+		return httpmock.NewStringResponse(http.StatusOK, ""), nil
+	})
+
+	t.Run("Notification", func(t *testing.T) {
+		for _, version := range []string{"1", "2"} {
+			body := signer.sign(t, &snsMessage{Type: snsMessageTypeNotification, MessageID: "1", TopicArn: testSNSTopicARN, Message: testCodeCommitTrigger, Timestamp: "2026-01-02T03:04:05.000Z", SignatureVersion: version})
+			payload, err := newCodeCommitParser(nil).Parse(newSNSRequest(t, snsMessageTypeNotification, body))
+			require.NoError(t, err)
+			assert.Equal(t, CodeCommitPushEvent{Region: "us-east-1", RepositoryName: "my-repo", Ref: "refs/heads/main", CommitID: "5c4ef1049f1d27deadbeeff313e0730018be182b"}, payload)
+		}
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		body := signer.sign(t, &snsMessage{Type: snsMessageTypeNotification, MessageID: "1", TopicArn: testSNSTopicARN, Message: testCodeCommitTrigger, Timestamp: "2026-01-02T03:04:05.000Z"})
+		body = bytes.Replace(body, []byte("my-repo"), []byte("other-repo"), 1)
+		_, err := newCodeCommitParser(nil).Parse(newSNSRequest(t, snsMessageTypeNotification, body))
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+	})
+
+	t.Run("UnexpectedTopic", func(t *testing.T) {
+		body := signer.sign(t, &snsMessage{Type: snsMessageTypeNotification, MessageID: "1", TopicArn: testSNSTopicARN, Message: testCodeCommitTrigger, Timestamp: "2026-01-02T03:04:05.000Z"})
+		_, err := newCodeCommitParser([]string{"arn:aws:sns:us-east-1:123456789012:other"}).Parse(newSNSRequest(t, snsMessageTypeNotification, body))
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+	})
+
+	t.Run("UntrustedCertificateURL", func(t *testing.T) {
+		msg := &snsMessage{Type: snsMessageTypeNotification, MessageID: "1", TopicArn: testSNSTopicARN, Message: testCodeCommitTrigger, Timestamp: "2026-01-02T03:04:05.000Z"}
+		body := signer.sign(t, msg)
+		body = bytes.Replace(body, []byte("sns.us-east-1.amazonaws.com"), []byte("sns.us-east-1.example.com"), 1)
+		_, err := newCodeCommitParser(nil).Parse(newSNSRequest(t, snsMessageTypeNotification, body))
+		require.ErrorIs(t, err, ErrSignatureVerificationFailed)
+		assert.ErrorContains(t, err, "is not an Amazon SNS URL")
+	})
+
+	t.Run("SubscriptionConfirmation", func(t *testing.T) {
+		body := signer.sign(t, &snsMessage{Type: snsMessageTypeSubscription, MessageID: "1", Token: "abc", TopicArn: testSNSTopicARN, Message: "confirm", SubscribeURL: testSubscribeURL, Timestamp: "2026-01-02T03:04:05.000Z"})
+		payload, err := newCodeCommitParser([]string{testSNSTopicARN}).Parse(newSNSRequest(t, snsMessageTypeSubscription, body))
+		require.NoError(t, err)
+		assert.Nil(t, payload)
+		assert.True(t, confirmed.Load())
+	})
+
+	t.Run("OtherNotification", func(t *testing.T) {
+		body := signer.sign(t, &snsMessage{Type: snsMessageTypeNotification, MessageID: "1", TopicArn: testSNSTopicARN, Message: `{"hello":"world"}`, Timestamp: "2026-01-02T03:04:05.000Z"})
+		payload, err := newCodeCommitParser(nil).Parse(newSNSRequest(t, snsMessageTypeNotification, body))
+		require.NoError(t, err)
+		assert.Nil(t, payload)
+	})
+}
+
+func TestParseCodeCommitMessage(t *testing.T) {
+	event, err := parseCodeCommitMessage(testCodeCommitStateChange)
+	require.NoError(t, err)
+	assert.Equal(t, &CodeCommitPushEvent{Region: "eu-west-1", RepositoryName: "my-repo", Ref: "refs/heads/main", CommitID: "b2", OldCommitID: "b1"}, event)
+	assert.Equal(t, "https://git-codecommit.eu-west-1.amazonaws.com/v1/repos/my-repo", event.WebURL())
+
+	event, err = parseCodeCommitMessage(`{"Records":[{"awsRegion":"cn-north-1","codecommit":{"references":[{"commit":"a","ref":"refs/heads/old","deleted":true},{"commit":"b","ref":"refs/tags/v1"}]},"eventSource":"aws:codecommit","eventSourceARN":"arn:aws-cn:codecommit:cn-north-1:123456789012:repo"}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, &CodeCommitPushEvent{Region: "cn-north-1", RepositoryName: "repo", Ref: "refs/tags/v1", CommitID: "b"}, event)
+	assert.Equal(t, "https://git-codecommit.cn-north-1.amazonaws.com.cn/v1/repos/repo", event.WebURL())
+
+	event, err = parseCodeCommitMessage(`{"detail-type":"CodeCommit Repository State Change","detail":{"event":"referenceDeleted"}}`)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+
+	_, err = parseCodeCommitMessage("not json")
+	require.Error(t, err)
+}
+
+type fakeCodeCommitClient struct {
+	changedFiles  []string
+	defaultBranch string
+}
+
+func (c *fakeCodeCommitClient) GetDifferences(_ context.Context, params *codecommit.GetDifferencesInput, _ ...func(*codecommit.Options)) (*codecommit.GetDifferencesOutput, error) {
+	output := &codecommit.GetDifferencesOutput{}
+	if aws.ToString(params.BeforeCommitSpecifier) != "b1" || aws.ToString(params.AfterCommitSpecifier) != "b2" {
+		return output, nil
+	}
+	for _, file := range c.changedFiles {
+		output.Differences = append(output.Differences, codecommittypes.Difference{AfterBlob: &codecommittypes.BlobMetadata{Path: aws.String(file)}})
+	}
+	return output, nil
+}
+
+func (c *fakeCodeCommitClient) GetRepository(_ context.Context, _ *codecommit.GetRepositoryInput, _ ...func(*codecommit.Options)) (*codecommit.GetRepositoryOutput, error) {
+	return &codecommit.GetRepositoryOutput{RepositoryMetadata: &codecommittypes.RepositoryMetadata{DefaultBranch: aws.String(c.defaultBranch)}}, nil
+}
+
+func TestHandleCodeCommitEvent(t *testing.T) {
+	newApp := func(path string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{
+				RepoURL:        "ssh://git-codecommit.eu-west-1.amazonaws.com/v1/repos/my-repo",
+				Path:           path,
+				TargetRevision: "HEAD",
+			}},
+		}
+	}
+	tests := []struct {
+		name              string
+		app               *v1alpha1.Application
+		topicARNs         string
+		scopeToSourcePath bool
+		hasRefresh        bool
+	}{
+		{name: "changed files unknown without topic ARNs", app: newApp("apps/other"), scopeToSourcePath: true, hasRefresh: true},
+		{name: "source path not scoped", app: newApp("apps/other"), topicARNs: testSNSTopicARN, hasRefresh: true},
+		{name: "source path without changes", app: newApp("apps/other"), topicARNs: testSNSTopicARN, scopeToSourcePath: true, hasRefresh: false},
+		{name: "source path with changes", app: newApp("apps/guestbook"), topicARNs: testSNSTopicARN, scopeToSourcePath: true, hasRefresh: true},
+		{name: "source at repository root", app: newApp("."), topicARNs: testSNSTopicARN, scopeToSourcePath: true, hasRefresh: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patched atomic.Bool
+			reaction := func(kubetesting.Action) (bool, runtime.Object, error) {
+				patched.Store(true)
+				return true, nil, nil
+			}
+			mockDB := &mocks.ArgoDB{}
+			mockDB.EXPECT().ListRepositories(mock.Anything).Return([]*v1alpha1.Repository{}, nil).Maybe()
+			h := newMockHandler(&reactorDef{"patch", "applications", reaction}, []string{}, int64(50)*1024*1024, mockDB, &settings.ArgoCDSettings{WebhookAWSCodeCommitTopicARNs: tt.topicARNs}, tt.app)
+			h.settingsSrc = &fakeSettingsSrc{scopeToSourcePath: tt.scopeToSourcePath}
+			h.newCodeCommitClient = func(context.Context, string) (codeCommitClient, error) {
+				return &fakeCodeCommitClient{changedFiles: []string{"apps/guestbook/deployment.yaml"}, defaultBranch: "main"}, nil
+			}
+
+			event, err := parseCodeCommitMessage(testCodeCommitStateChange)
+			require.NoError(t, err)
+			h.HandleEvent(*event)
+			h.Shutdown()
+			assert.Equal(t, tt.hasRefresh, patched.Load())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
@@ -50,6 +51,7 @@ type settingsSource interface {
 	GetAppInstanceLabelKey() (string, error)
 	GetTrackingMethod() (string, error)
 	GetInstallationID() (string, error)
+	IsWebhookRefreshScopedToSourcePath() (bool, error)
 }
 
 // https://www.rfc-editor.org/rfc/rfc3986#section-3.2.1
@@ -104,6 +106,7 @@ type ArgoCDWebhookHandler struct {
 	maxWebhookPayloadSizeB        int64
 	webhookRefreshJitter          time.Duration
 	webhookRefreshJitterThreshold int
+	newCodeCommitClient           func(ctx context.Context, region string) (codeCommitClient, error)
 }
 
 func NewHandler(namespace string, applicationNamespaces []string, webhookParallelism int, webhookRefreshWorkers int, appClientset appclientset.Interface, appsLister alpha1.ApplicationLister, set *settings.ArgoCDSettings, settingsSrc settingsSource, repoCache *cache.Cache, serverCache *servercache.Cache, argoDB db.ArgoDB, maxWebhookPayloadSizeB int64, webhookRefreshJitter time.Duration, webhookRefreshJitterThreshold int) *ArgoCDWebhookHandler {
@@ -154,6 +157,7 @@ func NewHandler(namespace string, applicationNamespaces []string, webhookParalle
 		parsers = append(parsers, &bitbucketServerParser{webhook: bitbucketserverWebhook})
 	}
 	parsers = append(parsers, newGHCRParser(set.GetWebhookGitHubSecret()))
+	parsers = append(parsers, newCodeCommitParser(set.GetWebhookAWSCodeCommitTopicARNs()))
 
 	log.Debugf("webhookRefreshJitter=%v", webhookRefreshJitter)
 	log.Debugf("webhookRefreshJitterThreshold=%d", webhookRefreshJitterThreshold)
@@ -174,6 +178,7 @@ func NewHandler(namespace string, applicationNamespaces []string, webhookParalle
 		appsLister:                    appsLister,
 		webhookRefreshJitter:          webhookRefreshJitter,
 		webhookRefreshJitterThreshold: webhookRefreshJitterThreshold,
+		newCodeCommitClient:           newCodeCommitClient,
 	}
 
 	acdWebhook.startWorkerPool(webhookParallelism)
@@ -251,7 +256,8 @@ func (a *ArgoCDWebhookHandler) affectedRevisionInfo(payloadIf any) (webURLs []st
 			change.shaBefore = ParseRevision(payload.Resource.RefUpdates[0].OldObjectID)
 			touchedHead = payload.Resource.RefUpdates[0].Name == payload.Resource.Repository.DefaultBranch
 		}
-		// unfortunately, Azure DevOps doesn't provide a list of changed files
+		// unfortunately, Azure DevOps doesn't provide a list of changed files, so it is fetched from the diffs API
+		changedFiles = a.azureDevOpsChangedFiles(payload, change)
 	case github.PushPayload:
 		// See: https://developer.github.com/v3/activity/events/types/#pushevent
 		webURLs = append(webURLs, payload.Repository.HTMLURL)
@@ -365,18 +371,30 @@ func (a *ArgoCDWebhookHandler) affectedRevisionInfo(payloadIf any) (webURLs []st
 			}
 		}
 
+		// Not actually sure how to check if the incoming change affected HEAD just by examining the
+		// payload alone. To be safe, we just return true and let the controller check for himself.
+		touchedHead = true
+
 		// TODO: bitbucket includes multiple changes as part of a single event.
 		// We only pick the first but need to consider how to handle multiple
-		for _, change := range payload.Changes {
-			revision = ParseRevision(change.Reference.ID)
+		for _, refChange := range payload.Changes {
+			revision = ParseRevision(refChange.Reference.ID)
+			change.shaBefore = refChange.FromHash
+			change.shaAfter = refChange.ToHash
+			// Bitbucket does not include a list of changed files anywhere in it's payload,
+			// so it is fetched from the compare API
+			changedFiles, touchedHead = a.bitbucketServerChangedFiles(payload, refChange, webURLs)
 			break
 		}
-		// Not actually sure how to check if the incoming change affected HEAD just by examining the
-		// payload alone. To be safe, we just return true and let the controller check for himself.
-		touchedHead = true
 
-		// Bitbucket does not include a list of changed files anywhere in it's payload
-		// so we cannot update changedFiles for this type of payload
+	case CodeCommitPushEvent:
+		webURLs = append(webURLs, payload.WebURL())
+		revision = ParseRevision(payload.Ref)
+		change.shaAfter = payload.CommitID
+		change.shaBefore = payload.OldCommitID
+		// CodeCommit does not include a list of changed files in its notifications,
+		// so it is fetched from the differences API
+		changedFiles, touchedHead = a.codeCommitChangedFiles(payload)
 
 	case gogsclient.PushPayload:
 		revision = ParseRevision(payload.Ref)
@@ -454,6 +472,11 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 		log.Errorf("Failed to get appInstanceLabelKey: %v", err)
 		return
 	}
+	scopeToSourcePath, err := a.settingsSrc.IsWebhookRefreshScopedToSourcePath()
+	if err != nil {
+		log.Errorf("Failed to get webhook refresh scope: %v", err)
+		return
+	}
 
 	// Skip any application that is neither in the control plane's namespace
 	// nor in the list of enabled namespaces.
@@ -494,7 +517,7 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 			// iterate over all sources and check if any files specified in refresh paths have changed
 			for _, source := range sources {
 				if sourceRevisionHasChanged(source, revision, touchedHead) && sourceUsesURL(source, webURL, repoRegexp) {
-					refreshPaths := path.GetSourceRefreshPaths(&app, source)
+					refreshPaths := getSourceRefreshPaths(&app, source, scopeToSourcePath)
 					if path.AppFilesHaveChanged(refreshPaths, changedFiles) {
 						var hydrateType *v1alpha1.HydrateType
 						if app.Spec.SourceHydrator != nil {
@@ -539,6 +562,20 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload any) {
 	}
 }
 
+// getSourceRefreshPaths returns the paths of the given source in which changes require a refresh of the application.
+// If scopeToSourcePath is true, the path of the source is used for applications without the manifest-generate-paths
+// annotation.
+func getSourceRefreshPaths(app *v1alpha1.Application, source v1alpha1.ApplicationSource, scopeToSourcePath bool) []string {
+	refreshPaths := path.GetSourceRefreshPaths(app, source)
+	if len(refreshPaths) == 0 && scopeToSourcePath {
+		// sources at the root of the repository, like ref sources, are affected by any change
+		if sourcePath := strings.Trim(filepath.Clean(source.Path), "/"); sourcePath != "" && sourcePath != "." {
+			refreshPaths = []string{sourcePath}
+		}
+	}
+	return refreshPaths
+}
+
 // GetWebURLRegex compiles a regex that will match any targetRevision referring to the same repo as
 // the given webURL. webURL is expected to be a URL from an SCM webhook payload pointing to the web
 // page for the repo.
@@ -787,6 +824,10 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "Unauthorized", http.StatusUnauthorized)
 			return
 		}
+		if errors.Is(err, ErrSignatureVerificationFailed) {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
 
 		// If the error is due to a large payload, return a more user-friendly error message
 		if isParsingPayloadError(err) {
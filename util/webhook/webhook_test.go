@@ -50,7 +50,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
-type fakeSettingsSrc struct{}
+type fakeSettingsSrc struct {
+	scopeToSourcePath bool
+}
 
 func (f fakeSettingsSrc) GetAppInstanceLabelKey() (string, error) {
 	return "mycompany.com/appname", nil
@@ -64,6 +66,10 @@ func (f fakeSettingsSrc) GetInstallationID() (string, error) {
 	return "", nil
 }
 
+func (f fakeSettingsSrc) IsWebhookRefreshScopedToSourcePath() (bool, error) {
+	return f.scopeToSourcePath, nil
+}
+
 type reactorDef struct {
 	verb     string
 	resource string